@@ -43,9 +43,10 @@ type Config struct {
 	// Namespace is a container for CloudWatch metrics.
 	// Metrics in different namespaces are isolated from each other.
 	Namespace string `mapstructure:"namespace"`
-	// DimensionRollupOption is the option for metrics dimension rollup. Three options are available, default option is "ZeroAndSingleDimensionRollup".
+	// DimensionRollupOption is the option for metrics dimension rollup. Four options are available, default option is "ZeroAndSingleDimensionRollup".
 	// "ZeroAndSingleDimensionRollup" - Enable both zero dimension rollup and single dimension rollup
 	// "SingleDimensionRollupOnly" - Enable single dimension rollup
+	// "AllDimensionCombinationsRollup" - Enable the rollup to every combination of fewer dimensions, for metrics with up to 5 dimensions
 	// "NoDimensionRollup" - No dimension rollup (only keep original metrics which contain all dimensions)
 	DimensionRollupOption string `mapstructure:"dimension_rollup_option"`
 	// ParseJSONEncodedAttributeValues is an array of attribute keys whose corresponding values are JSON-encoded as strings.
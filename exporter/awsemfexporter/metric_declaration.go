@@ -17,6 +17,7 @@ package awsemfexporter // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"regexp"
 	"sort"
 	"strings"
@@ -37,6 +38,12 @@ type MetricDeclaration struct {
 	// (Optional) List of label matchers that define matching rules to filter against
 	// the labels of incoming metrics.
 	LabelMatchers []*LabelMatcher `mapstructure:"label_matchers"`
+	// (Optional) DimensionRollupOption overrides the dimension rollup option of the exporter
+	// for the metrics matching this metric declaration rule.
+	DimensionRollupOption string `mapstructure:"dimension_rollup_option"`
+	// (Optional) List of dimensions retained in all the rolled-up dimension sets of the metrics
+	// matching this metric declaration rule, e.g. to only roll up the dimensions within a cluster.
+	RetainedDimensions []string `mapstructure:"retained_dimensions"`
 
 	// metricRegexList is a list of compiled regexes for metric name selectors.
 	metricRegexList []*regexp.Regexp
@@ -84,6 +91,12 @@ func (m *MetricDeclaration) init(logger *zap.Logger) (err error) {
 		return errors.New("invalid metric declaration: no metric name selectors defined")
 	}
 
+	switch m.DimensionRollupOption {
+	case "", noDimensionRollup, singleDimensionRollupOnly, zeroAndSingleDimensionRollup, allDimensionCombinationsRollup:
+	default:
+		return fmt.Errorf("invalid metric declaration: unknown dimension rollup option %q", m.DimensionRollupOption)
+	}
+
 	// Filter out duplicate dimension sets and those with more than 10 elements
 	validDims := make([][]string, 0, len(m.Dimensions))
 	seen := make(map[string]bool, len(m.Dimensions))
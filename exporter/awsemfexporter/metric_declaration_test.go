@@ -317,6 +317,16 @@ func TestMetricDeclarationInit(t *testing.T) {
 		assert.EqualError(t, err, "invalid metric declaration: no metric name selectors defined")
 	})
 
+	// Test invalid dimension rollup option
+	t.Run("invalid dimension rollup option", func(t *testing.T) {
+		m := &MetricDeclaration{
+			MetricNameSelectors:   []string{"foo"},
+			DimensionRollupOption: "AllRollups",
+		}
+		err := m.init(logger)
+		assert.EqualError(t, err, `invalid metric declaration: unknown dimension rollup option "AllRollups"`)
+	})
+
 	// Test initialization of label matchers
 	t.Run("initialization of label matchers", func(t *testing.T) {
 		m := &MetricDeclaration{
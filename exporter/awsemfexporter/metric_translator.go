@@ -33,8 +33,14 @@ const (
 	noInstrumentationLibraryName = "Undefined"
 
 	// DimensionRollupOptions
-	zeroAndSingleDimensionRollup = "ZeroAndSingleDimensionRollup"
-	singleDimensionRollupOnly    = "SingleDimensionRollupOnly"
+	noDimensionRollup              = "NoDimensionRollup"
+	zeroAndSingleDimensionRollup   = "ZeroAndSingleDimensionRollup"
+	singleDimensionRollupOnly      = "SingleDimensionRollupOnly"
+	allDimensionCombinationsRollup = "AllDimensionCombinationsRollup"
+
+	// maxAllCombinationsRollupLabels is the maximum number of labels of the metrics rolled up
+	// to all the combinations of their labels, the number of dimension sets doubling with each label.
+	maxAllCombinationsRollupLabels = 5
 
 	prometheusReceiver        = "prometheus"
 	attributeReceiver         = "receiver"
@@ -198,7 +204,7 @@ func groupedMetricToCWMeasurement(groupedMetric *groupedMetric, config *Config)
 	dimensions := [][]string{dimSet}
 
 	// Apply single/zero dimension rollup to labels
-	rollupDimensionArray := dimensionRollup(dimensionRollupOption, labels)
+	rollupDimensionArray := dimensionRollup(dimensionRollupOption, nil, labels)
 
 	if len(rollupDimensionArray) > 0 {
 		// Perform duplication check for edge case with a single label and single dimension roll-up
@@ -307,19 +313,21 @@ func groupedMetricToCWMeasurementsWithFilters(groupedMetric *groupedMetric, conf
 		return
 	}
 
-	// Apply single/zero dimension rollup to labels
-	rollupDimensionArray := dimensionRollup(config.DimensionRollupOption, labels)
-
 	// Translate each group into a CW Measurement
 	cWMeasurements = make([]cWMeasurement, 0, len(metricDeclGroups))
 	for _, group := range metricDeclGroups {
 		var dimensions [][]string
-		// Extract dimensions from matched metric declarations
+		// Extract dimensions from matched metric declarations, and apply their dimension rollup to labels
 		for _, metricDeclIdx := range group.metricDeclIdxList {
-			dims := metricDeclarations[metricDeclIdx].ExtractDimensions(labels)
+			metricDeclaration := metricDeclarations[metricDeclIdx]
+			dims := metricDeclaration.ExtractDimensions(labels)
 			dimensions = append(dimensions, dims...)
+			dimensionRollupOption := config.DimensionRollupOption
+			if metricDeclaration.DimensionRollupOption != "" {
+				dimensionRollupOption = metricDeclaration.DimensionRollupOption
+			}
+			dimensions = append(dimensions, dimensionRollup(dimensionRollupOption, metricDeclaration.RetainedDimensions, labels)...)
 		}
-		dimensions = append(dimensions, rollupDimensionArray...)
 
 		// De-duplicate dimensions
 		dimensions = dedupDimensions(dimensions)
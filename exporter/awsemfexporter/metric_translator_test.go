@@ -1254,6 +1254,57 @@ func TestGroupedMetricToCWMeasurement(t *testing.T) {
 				{oTellibDimensionKey},
 			},
 		},
+		{
+			"Single label, all combinations rollup, no otel dim",
+			map[string]string{"a": "foo"},
+			allDimensionCombinationsRollup,
+			[][]string{
+				{"a"},
+				{},
+			},
+		},
+		{
+			"Multiple label, all combinations rollup, w/ otel dim",
+			map[string]string{
+				"a":                   "foo",
+				"b":                   "bar",
+				"c":                   "car",
+				(oTellibDimensionKey): instrLibName,
+			},
+			allDimensionCombinationsRollup,
+			[][]string{
+				{"a", "b", "c", oTellibDimensionKey},
+				{oTellibDimensionKey, "a", "b"},
+				{oTellibDimensionKey, "a", "c"},
+				{oTellibDimensionKey, "b", "c"},
+				{oTellibDimensionKey, "a"},
+				{oTellibDimensionKey, "b"},
+				{oTellibDimensionKey, "c"},
+				{oTellibDimensionKey},
+			},
+		},
+		{
+			"Too many labels, all combinations rollup falls back to zero + single rollup",
+			map[string]string{
+				"a": "A",
+				"b": "B",
+				"c": "C",
+				"d": "D",
+				"e": "E",
+				"f": "F",
+			},
+			allDimensionCombinationsRollup,
+			[][]string{
+				{"a", "b", "c", "d", "e", "f"},
+				{"a"},
+				{"b"},
+				{"c"},
+				{"d"},
+				{"e"},
+				{"f"},
+				{},
+			},
+		},
 	}
 
 	for _, tc := range rollUpTestCases {
@@ -1927,6 +1978,53 @@ func TestGroupedMetricToCWMeasurementsWithFilters(t *testing.T) {
 				{oTellibDimensionKey},
 			},
 		},
+		{
+			"multiple labels w/ declaration all combinations rollup and retained dimension",
+			map[string]string{
+				"a":                   "foo",
+				"b":                   "bar",
+				"c":                   "car",
+				(oTellibDimensionKey): instrLibName,
+			},
+			[]*MetricDeclaration{
+				{
+					Dimensions:            [][]string{{"a", "b"}},
+					MetricNameSelectors:   []string{metricName},
+					DimensionRollupOption: allDimensionCombinationsRollup,
+					RetainedDimensions:    []string{"a"},
+				},
+			},
+			"",
+			[][]string{
+				{"a", "b"},
+				{oTellibDimensionKey, "a"},
+				{oTellibDimensionKey, "a", "b"},
+				{oTellibDimensionKey, "a", "c"},
+			},
+		},
+		{
+			"multiple labels w/ rollup and retained dimension",
+			map[string]string{
+				"a":                   "foo",
+				"b":                   "bar",
+				"c":                   "car",
+				(oTellibDimensionKey): instrLibName,
+			},
+			[]*MetricDeclaration{
+				{
+					Dimensions:          [][]string{{"a", "b"}},
+					MetricNameSelectors: []string{metricName},
+					RetainedDimensions:  []string{"a", "d"},
+				},
+			},
+			zeroAndSingleDimensionRollup,
+			[][]string{
+				{"a", "b"},
+				{oTellibDimensionKey, "a"},
+				{oTellibDimensionKey, "a", "b"},
+				{oTellibDimensionKey, "a", "c"},
+			},
+		},
 		{
 			"multiple metric declarations w/ no rollup",
 			map[string]string{
@@ -128,45 +128,82 @@ func dedupDimensions(dimensions [][]string) (deduped [][]string) {
 }
 
 // dimensionRollup creates rolled-up dimensions from the metric's label set.
+// The retained dimensions, like the OTel key, are part of every rolled-up dimension set.
 // The returned dimensions are sorted in alphabetical order within each dimension set
-func dimensionRollup(dimensionRollupOption string, labels map[string]string) [][]string {
+func dimensionRollup(dimensionRollupOption string, retainedDimensions []string, labels map[string]string) [][]string {
 	var rollupDimensionArray [][]string
 
 	// Empty dimension must be always present in a roll up.
 	dimensionZero := []string{}
 
-	instrLibName, hasOTelKey := labels[oTellibDimensionKey]
-	if hasOTelKey {
-		// If OTel key exists in labels, add it as a zero dimension but remove it
-		// temporarily from labels as it is not an original label
+	// If OTel key exists in labels, add it as a zero dimension as it is not an original label
+	if _, hasOTelKey := labels[oTellibDimensionKey]; hasOTelKey {
 		dimensionZero = append(dimensionZero, oTellibDimensionKey)
-		delete(labels, oTellibDimensionKey)
 	}
-
-	if dimensionRollupOption == zeroAndSingleDimensionRollup {
-		// "Zero" dimension rollup
-		if len(labels) > 0 {
-			rollupDimensionArray = append(rollupDimensionArray, dimensionZero)
+	for _, dim := range retainedDimensions {
+		if _, ok := labels[dim]; ok && dim != oTellibDimensionKey {
+			dimensionZero = append(dimensionZero, dim)
 		}
 	}
-	if dimensionRollupOption == zeroAndSingleDimensionRollup || dimensionRollupOption == singleDimensionRollupOnly {
-		// "One" dimension rollup
-		for labelName := range labels {
-			dimSet := dimensionZero
-			dimSet = append(dimSet, labelName)
-			sort.Strings(dimSet)
-			rollupDimensionArray = append(rollupDimensionArray, dimSet)
+	dimensionZero, _ = dedupDimensionSet(dimensionZero)
+
+	labelNames := make([]string, 0, len(labels))
+	for labelName := range labels {
+		if !containsDimension(dimensionZero, labelName) {
+			labelNames = append(labelNames, labelName)
 		}
 	}
+	sort.Strings(labelNames)
 
-	// Add back OTel key to labels if it was removed
-	if hasOTelKey {
-		labels[oTellibDimensionKey] = instrLibName
+	if dimensionRollupOption == allDimensionCombinationsRollup && len(labelNames) > maxAllCombinationsRollupLabels {
+		// Too many dimension sets, fall back to the zero and single dimension rollup
+		dimensionRollupOption = zeroAndSingleDimensionRollup
+	}
+
+	switch dimensionRollupOption {
+	case zeroAndSingleDimensionRollup, singleDimensionRollupOnly:
+		if dimensionRollupOption == zeroAndSingleDimensionRollup && len(labelNames) > 0 {
+			// "Zero" dimension rollup
+			rollupDimensionArray = append(rollupDimensionArray, rollupDimensionSet(dimensionZero))
+		}
+		// "One" dimension rollup
+		for _, labelName := range labelNames {
+			rollupDimensionArray = append(rollupDimensionArray, rollupDimensionSet(dimensionZero, labelName))
+		}
+	case allDimensionCombinationsRollup:
+		// Every combination of fewer labels than the metric has, from the "zero" dimension rollup
+		for mask := 0; mask < 1<<len(labelNames)-1; mask++ {
+			var dimSet []string
+			for i, labelName := range labelNames {
+				if mask&(1<<i) != 0 {
+					dimSet = append(dimSet, labelName)
+				}
+			}
+			rollupDimensionArray = append(rollupDimensionArray, rollupDimensionSet(dimensionZero, dimSet...))
+		}
 	}
 
 	return rollupDimensionArray
 }
 
+// rollupDimensionSet returns the sorted dimension set made of the zero dimensions and the given labels.
+func rollupDimensionSet(dimensionZero []string, labelNames ...string) []string {
+	dimSet := make([]string, 0, len(dimensionZero)+len(labelNames))
+	dimSet = append(dimSet, dimensionZero...)
+	dimSet = append(dimSet, labelNames...)
+	sort.Strings(dimSet)
+	return dimSet
+}
+
+func containsDimension(dimSet []string, dim string) bool {
+	for _, d := range dimSet {
+		if d == dim {
+			return true
+		}
+	}
+	return false
+}
+
 // unixNanoToMilliseconds converts a timestamp in nanoseconds to milliseconds.
 func unixNanoToMilliseconds(timestamp pcommon.Timestamp) int64 {
 	return int64(uint64(timestamp) / uint64(time.Millisecond))
@@ -96,7 +96,7 @@ func extractResourceSpans(config config.Exporter, logger *zap.Logger, td ptrace.
 			spans := rspans.ScopeSpans().At(j).Spans()
 			for k := 0; k < spans.Len(); k++ {
 				document, localErr := translator.MakeSegmentDocumentString(spans.At(k), resource,
-					config.(*Config).IndexedAttributes, config.(*Config).IndexAllAttributes)
+					config.(*Config).IndexedAttributes, config.(*Config).IndexAllAttributes, config.(*Config).TraceIDRemapping)
 				if localErr != nil {
 					logger.Debug("Error translating span.", zap.Error(localErr))
 					continue
@@ -31,6 +31,7 @@ import (
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter/internal/translator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/awsutil"
 )
 
@@ -73,6 +74,14 @@ func TestW3CSpanTraceResourceExtraction(t *testing.T) {
 	assert.Len(t, extractResourceSpans(generateConfig(t), logger, td), 0, "0 spans have xray trace id")
 }
 
+func TestW3CSpanTraceResourceExtractionWithRewriteEpoch(t *testing.T) {
+	td := constructW3CSpanData()
+	logger, _ := zap.NewProduction()
+	cfg := generateConfig(t)
+	cfg.(*Config).TraceIDRemapping = translator.TraceIDRemappingRewriteEpoch
+	assert.Len(t, extractResourceSpans(cfg, logger, td), 2, "2 spans have remapped trace id")
+}
+
 func BenchmarkForTracesExporter(b *testing.B) {
 	traceExporter := initializeTracesExporter(b)
 	for i := 0; i < b.N; i++ {
@@ -118,7 +127,6 @@ func constructSpanData() ptrace.Traces {
 	return traces
 }
 
-// nolint:unused
 func constructW3CSpanData() ptrace.Traces {
 	resource := constructResource()
 	traces := ptrace.NewTraces()
@@ -15,8 +15,11 @@
 package awsxrayexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter"
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/collector/config"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter/internal/translator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/awsutil"
 )
 
@@ -33,4 +36,22 @@ type Config struct {
 	// Set to true to convert all OpenTelemetry attributes to X-Ray annotation (indexed) ignoring the IndexedAttributes option.
 	// Default value: false
 	IndexAllAttributes bool `mapstructure:"index_all_attributes"`
+	// TraceIDRemapping is the strategy applied to the trace IDs whose epoch is outside the range accepted
+	// by X-Ray, such as randomly generated W3C trace IDs: `drop` drops their spans, `rewrite_epoch` replaces
+	// their epoch with the day of the span start time.
+	// Default value: drop
+	TraceIDRemapping translator.TraceIDRemapping `mapstructure:"trace_id_remapping"`
+}
+
+var _ config.Exporter = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid
+func (cfg *Config) Validate() error {
+	switch cfg.TraceIDRemapping {
+	case translator.TraceIDRemappingDrop, translator.TraceIDRemappingRewriteEpoch:
+		return nil
+	default:
+		return fmt.Errorf("invalid trace_id_remapping %q, must be %q or %q", cfg.TraceIDRemapping,
+			translator.TraceIDRemappingDrop, translator.TraceIDRemappingRewriteEpoch)
+	}
 }
@@ -24,6 +24,7 @@ import (
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/service/servicetest"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter/internal/translator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/awsutil"
 )
 
@@ -61,5 +62,17 @@ func TestLoadConfig(t *testing.T) {
 			},
 			IndexedAttributes:  []string{"indexed_attr_0", "indexed_attr_1"},
 			IndexAllAttributes: false,
+			TraceIDRemapping:   translator.TraceIDRemappingRewriteEpoch,
 		})
 }
+
+func TestConfigValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.TraceIDRemapping = translator.TraceIDRemappingRewriteEpoch
+	assert.NoError(t, cfg.Validate())
+
+	cfg.TraceIDRemapping = "random"
+	assert.EqualError(t, cfg.Validate(), `invalid trace_id_remapping "random", must be "drop" or "rewrite_epoch"`)
+}
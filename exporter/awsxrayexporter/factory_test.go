@@ -26,6 +26,7 @@ import (
 	"go.opentelemetry.io/collector/config/configtest"
 	"go.opentelemetry.io/collector/service/servicetest"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter/internal/translator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/awsutil"
 )
 
@@ -46,6 +47,7 @@ func TestCreateDefaultConfig(t *testing.T) {
 			ResourceARN:           "",
 			RoleARN:               "",
 		},
+		TraceIDRemapping: translator.TraceIDRemappingDrop,
 	}, "failed to create default config")
 	assert.NoError(t, configtest.CheckConfigStruct(cfg))
 }
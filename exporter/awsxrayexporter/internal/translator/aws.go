@@ -43,6 +43,7 @@ func makeAws(attributes map[string]pcommon.Value, resource pcommon.Resource) (ma
 		requestID    string
 		queueURL     string
 		tableName    string
+		samplingRule string
 		sdk          string
 		sdkName      string
 		sdkLanguage  string
@@ -154,6 +155,8 @@ func makeAws(attributes map[string]pcommon.Value, resource pcommon.Resource) (ma
 			fallthrough
 		case awsxray.AWSTableNameAttribute2:
 			tableName = value.Str()
+		case awsxray.AWSXRaySamplingRuleNameAttribute:
+			samplingRule = value.Str()
 		default:
 			filtered[key] = value
 		}
@@ -230,6 +233,7 @@ func makeAws(attributes map[string]pcommon.Value, resource pcommon.Resource) (ma
 		SDK:                 awsxray.String(sdk),
 		SDKVersion:          awsxray.String(sdkVersion),
 		AutoInstrumentation: aws.Bool(autoVersion != ""),
+		SamplingRuleName:    awsxray.String(samplingRule),
 	}
 
 	awsData := &awsxray.AWSData{
@@ -302,6 +302,18 @@ func TestAwsWithRequestIdAlternateAttribute(t *testing.T) {
 	assert.Equal(t, requestid, *awsData.RequestID)
 }
 
+func TestAwsWithSamplingRuleName(t *testing.T) {
+	attributes := make(map[string]pcommon.Value)
+	attributes[awsxray.AWSXRaySamplingRuleNameAttribute] = pcommon.NewValueStr("checkout-rule")
+
+	filtered, awsData := makeAws(attributes, pcommon.NewResource())
+
+	assert.NotNil(t, filtered)
+	assert.NotContains(t, filtered, awsxray.AWSXRaySamplingRuleNameAttribute)
+	assert.NotNil(t, awsData)
+	assert.Equal(t, "checkout-rule", *awsData.XRay.SamplingRuleName)
+}
+
 func TestJavaSDK(t *testing.T) {
 	attributes := make(map[string]pcommon.Value)
 	resource := pcommon.NewResource()
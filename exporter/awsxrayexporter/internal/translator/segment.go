@@ -65,9 +65,22 @@ var (
 	writers = newWriterPool(2048)
 )
 
+// TraceIDRemapping is the strategy applied to the trace IDs whose epoch is outside
+// the range accepted by X-Ray, such as randomly generated W3C trace IDs.
+type TraceIDRemapping string
+
+const (
+	// TraceIDRemappingDrop drops the spans of those traces.
+	TraceIDRemappingDrop TraceIDRemapping = "drop"
+	// TraceIDRemappingRewriteEpoch replaces the epoch of the trace ID with the start of the
+	// day (UTC) of the span start time, keeping the 96-bit identifier of the trace.
+	TraceIDRemappingRewriteEpoch TraceIDRemapping = "rewrite_epoch"
+)
+
 // MakeSegmentDocumentString converts an OpenTelemetry Span to an X-Ray Segment and then serialzies to JSON
-func MakeSegmentDocumentString(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool) (string, error) {
-	segment, err := MakeSegment(span, resource, indexedAttrs, indexAllAttrs)
+func MakeSegmentDocumentString(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool,
+	traceIDRemapping TraceIDRemapping) (string, error) {
+	segment, err := MakeSegment(span, resource, indexedAttrs, indexAllAttrs, traceIDRemapping)
 	if err != nil {
 		return "", err
 	}
@@ -81,7 +94,8 @@ func MakeSegmentDocumentString(span ptrace.Span, resource pcommon.Resource, inde
 }
 
 // MakeSegment converts an OpenTelemetry Span to an X-Ray Segment
-func MakeSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool) (*awsxray.Segment, error) {
+func MakeSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool,
+	traceIDRemapping TraceIDRemapping) (*awsxray.Segment, error) {
 	var segmentType string
 
 	storeResource := true
@@ -93,7 +107,7 @@ func MakeSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []str
 	}
 
 	// convert trace id
-	traceID, err := convertToAmazonTraceID(span.TraceID())
+	traceID, err := convertToAmazonTraceID(span.TraceID(), span.StartTimestamp(), traceIDRemapping)
 	if err != nil {
 		return nil, err
 	}
@@ -188,6 +202,16 @@ func MakeSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []str
 		namespace = "remote"
 	}
 
+	if span.Links().Len() > 0 {
+		if metadata == nil {
+			metadata = map[string]map[string]interface{}{}
+		}
+		if metadata["default"] == nil {
+			metadata["default"] = map[string]interface{}{}
+		}
+		metadata["default"][awsxray.AWSXRaySpanLinksMetadataKey] = makeLinks(span.Links(), span.StartTimestamp(), traceIDRemapping)
+	}
+
 	return &awsxray.Segment{
 		ID:          awsxray.String(span.SpanID().HexString()),
 		TraceID:     awsxray.String(traceID),
@@ -277,7 +301,38 @@ func determineAwsOrigin(resource pcommon.Resource) string {
 //   - For example, 10:00AM December 2nd, 2016 PST in epoch time is 1480615200 seconds,
 //     or 58406520 in hexadecimal.
 //   - A 96-bit identifier for the trace, globally unique, in 24 hexadecimal digits.
-func convertToAmazonTraceID(traceID pcommon.TraceID) (string, error) {
+//
+// The trace IDs whose epoch is outside the range accepted by X-Ray are handled
+// according to the traceIDRemapping strategy, the span start time being used to
+// rewrite their epoch.
+func convertToAmazonTraceID(traceID pcommon.TraceID, startTime pcommon.Timestamp, traceIDRemapping TraceIDRemapping) (string, error) {
+	// If AWS traceID originally came from AWS, no problem.  However, if oc generated
+	// the traceID, then the epoch may be outside the accepted AWS range of within the
+	// past 30 days.
+	//
+	// In that case, we either rewrite the epoch from the span start time, or return
+	// invalid traceid error
+	epoch := traceIDEpoch(traceID)
+	if !isValidXRayEpoch(epoch) {
+		if traceIDRemapping != TraceIDRemappingRewriteEpoch {
+			return "", fmt.Errorf("invalid xray traceid: %s", traceID.HexString())
+		}
+		// all the spans of a trace starting the same day get the same trace ID
+		epoch = startTime.AsTime().UTC().Truncate(24 * time.Hour).Unix()
+		if !isValidXRayEpoch(epoch) {
+			return "", fmt.Errorf("invalid xray traceid: %s, span start time out of range", traceID.HexString())
+		}
+	}
+	return formatAmazonTraceID(traceID, epoch), nil
+}
+
+// traceIDEpoch returns the epoch held by the first 32 bits of the trace ID.
+func traceIDEpoch(traceID pcommon.TraceID) int64 {
+	return int64(binary.BigEndian.Uint32(traceID[0:4]))
+}
+
+// isValidXRayEpoch returns whether the epoch is in the range accepted by X-Ray.
+func isValidXRayEpoch(epoch int64) bool {
 	const (
 		// maxAge of 28 days.  AWS has a 30 day limit, let's be conservative rather than
 		// hit the limit
@@ -286,33 +341,55 @@ func convertToAmazonTraceID(traceID pcommon.TraceID) (string, error) {
 		// maxSkew allows for 5m of clock skew
 		maxSkew = 60 * 5
 	)
+	delta := time.Now().Unix() - epoch
+	return delta <= maxAge && delta >= -maxSkew
+}
 
+// formatAmazonTraceID formats the identifier of the trace ID with the epoch in the Amazon format.
+func formatAmazonTraceID(traceID pcommon.TraceID, epoch int64) string {
 	var (
-		content      = [traceIDLength]byte{}
-		epochNow     = time.Now().Unix()
-		traceIDBytes = traceID
-		epoch        = int64(binary.BigEndian.Uint32(traceIDBytes[0:4]))
-		b            = [4]byte{}
+		content = [traceIDLength]byte{}
+		b       = [4]byte{}
 	)
-
-	// If AWS traceID originally came from AWS, no problem.  However, if oc generated
-	// the traceID, then the epoch may be outside the accepted AWS range of within the
-	// past 30 days.
-	//
-	// In that case, we return invalid traceid error
-	if delta := epochNow - epoch; delta > maxAge || delta < -maxSkew {
-		return "", fmt.Errorf("invalid xray traceid: %s", traceID.HexString())
-	}
-
 	binary.BigEndian.PutUint32(b[0:4], uint32(epoch))
 
 	content[0] = '1'
 	content[1] = '-'
 	hex.Encode(content[2:10], b[0:4])
 	content[10] = '-'
-	hex.Encode(content[identifierOffset:], traceIDBytes[4:16]) // overwrite with identifier
+	hex.Encode(content[identifierOffset:], traceID[4:16]) // overwrite with identifier
+
+	return string(content[0:traceIDLength])
+}
 
-	return string(content[0:traceIDLength]), nil
+// makeLinks converts the links of a span to metadata. The trace ID of a link is only remapped if its own
+// epoch is outside the range accepted by X-Ray, independently of the trace ID of the span: the epoch of a
+// valid trace ID is kept, so that the link matches the linked trace. The others are remapped like the trace
+// ID of the span, from its start time, the linked trace being assumed to start the same day, or kept as is
+// if they can't.
+func makeLinks(links ptrace.SpanLinkSlice, startTime pcommon.Timestamp, traceIDRemapping TraceIDRemapping) []interface{} {
+	converted := make([]interface{}, 0, links.Len())
+	for i := 0; i < links.Len(); i++ {
+		link := links.At(i)
+		var traceID string
+		if epoch := traceIDEpoch(link.TraceID()); isValidXRayEpoch(epoch) {
+			traceID = formatAmazonTraceID(link.TraceID(), epoch)
+		} else {
+			var err error
+			if traceID, err = convertToAmazonTraceID(link.TraceID(), startTime, traceIDRemapping); err != nil {
+				traceID = link.TraceID().HexString()
+			}
+		}
+		value := map[string]interface{}{
+			"trace_id": traceID,
+			"id":       link.SpanID().HexString(),
+		}
+		if link.Attributes().Len() > 0 {
+			value["attributes"] = link.Attributes().AsRaw()
+		}
+		converted = append(converted, value)
+	}
+	return converted
 }
 
 func timestampToFloatSeconds(ts pcommon.Timestamp) float64 {
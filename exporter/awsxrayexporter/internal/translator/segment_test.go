@@ -16,6 +16,7 @@ package translator
 
 import (
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -23,6 +24,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	conventions "go.opentelemetry.io/collector/semconv/v1.8.0"
@@ -60,13 +62,13 @@ func TestClientSpanWithRpcAwsSdkClientAttributes(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, 0, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 	assert.Equal(t, "DynamoDB", *segment.Name)
 	assert.Equal(t, conventions.AttributeCloudProviderAWS, *segment.Namespace)
 	assert.Equal(t, "GetItem", *segment.AWS.Operation)
 	assert.Equal(t, "subsegment", *segment.Type)
 
-	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false)
+	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, jsonStr)
 	assert.Nil(t, err)
@@ -93,13 +95,13 @@ func TestClientSpanWithLegacyAwsSdkClientAttributes(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, 0, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 	assert.Equal(t, "DynamoDB", *segment.Name)
 	assert.Equal(t, conventions.AttributeCloudProviderAWS, *segment.Namespace)
 	assert.Equal(t, "GetItem", *segment.AWS.Operation)
 	assert.Equal(t, "subsegment", *segment.Type)
 
-	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false)
+	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, jsonStr)
 	assert.Nil(t, err)
@@ -125,7 +127,7 @@ func TestClientSpanWithPeerService(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, 0, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 	assert.Equal(t, "cats-table", *segment.Name)
 }
 
@@ -148,7 +150,7 @@ func TestServerSpanWithInternalServerError(t *testing.T) {
 	timeEvents := constructTimedEventsWithSentMessageEvent(span.StartTimestamp())
 	timeEvents.CopyTo(span.Events())
 
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.NotNil(t, segment.Cause)
@@ -175,7 +177,7 @@ func TestServerSpanWithThrottle(t *testing.T) {
 	timeEvents := constructTimedEventsWithSentMessageEvent(span.StartTimestamp())
 	timeEvents.CopyTo(span.Events())
 
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.NotNil(t, segment.Cause)
@@ -191,7 +193,7 @@ func TestServerSpanNoParentId(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeOk, "OK", nil)
 
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 
 	assert.Empty(t, segment.ParentID)
 }
@@ -206,7 +208,7 @@ func TestSpanNoParentId(t *testing.T) {
 	span.SetStartTimestamp(pcommon.NewTimestampFromTime(time.Now()))
 	span.SetEndTimestamp(pcommon.NewTimestampFromTime(time.Now().Add(10)))
 	resource := pcommon.NewResource()
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 
 	assert.Empty(t, segment.ParentID)
 	assert.Nil(t, segment.Type)
@@ -222,7 +224,7 @@ func TestSpanWithNoStatus(t *testing.T) {
 	span.SetEndTimestamp(pcommon.NewTimestampFromTime(time.Now().Add(10)))
 
 	resource := pcommon.NewResource()
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 	assert.NotNil(t, segment)
 }
 
@@ -242,7 +244,7 @@ func TestClientSpanWithDbComponent(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, ptrace.StatusCodeUnset, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.NotNil(t, segment.SQL)
@@ -282,7 +284,7 @@ func TestClientSpanWithHttpHost(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, ptrace.StatusCodeUnset, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, "foo.com", *segment.Name)
@@ -301,7 +303,7 @@ func TestClientSpanWithoutHttpHost(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, ptrace.StatusCodeUnset, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, "bar.com", *segment.Name)
@@ -321,7 +323,7 @@ func TestClientSpanWithRpcHost(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, ptrace.StatusCodeUnset, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, "com.foo.AnimalService", *segment.Name)
@@ -343,7 +345,7 @@ func TestSpanWithInvalidTraceId(t *testing.T) {
 	traceID[0] = 0x11
 	span.SetTraceID(traceID)
 
-	_, err := MakeSegmentDocumentString(span, resource, nil, false)
+	_, err := MakeSegmentDocumentString(span, resource, nil, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, err)
 }
@@ -356,10 +358,100 @@ func TestSpanWithExpiredTraceId(t *testing.T) {
 	tempTraceID := newTraceID()
 	binary.BigEndian.PutUint32(tempTraceID[0:4], uint32(ExpiredEpoch))
 
-	_, err := convertToAmazonTraceID(tempTraceID)
+	_, err := convertToAmazonTraceID(tempTraceID, pcommon.NewTimestampFromTime(time.Now()), TraceIDRemappingDrop)
 	assert.NotNil(t, err)
 }
 
+func TestSpanWithExpiredTraceIdRewriteEpoch(t *testing.T) {
+	const maxAge = 60 * 60 * 24 * 30
+	ExpiredEpoch := time.Now().Unix() - maxAge - 1
+
+	tempTraceID := newTraceID()
+	binary.BigEndian.PutUint32(tempTraceID[0:4], uint32(ExpiredEpoch))
+	startTime := time.Now().Add(-time.Minute)
+
+	traceID, err := convertToAmazonTraceID(tempTraceID, pcommon.NewTimestampFromTime(startTime), TraceIDRemappingRewriteEpoch)
+	assert.NoError(t, err)
+	epoch := startTime.UTC().Truncate(24 * time.Hour).Unix()
+	assert.Equal(t, fmt.Sprintf("1-%08x-%s", epoch, hex.EncodeToString(tempTraceID[4:16])), traceID)
+
+	// the start time is out of range as well
+	_, err = convertToAmazonTraceID(tempTraceID, pcommon.NewTimestampFromTime(time.Unix(ExpiredEpoch, 0)), TraceIDRemappingRewriteEpoch)
+	assert.NotNil(t, err)
+}
+
+func TestSpanWithLinks(t *testing.T) {
+	spanName := "/api/locations"
+	parentSpanID := newSegmentID()
+	resource := constructDefaultResource()
+	span := constructClientSpan(parentSpanID, spanName, ptrace.StatusCodeUnset, "OK", map[string]interface{}{})
+	linkedTraceID := newTraceID()
+	linkedSpanID := newSegmentID()
+	link := span.Links().AppendEmpty()
+	link.SetTraceID(linkedTraceID)
+	link.SetSpanID(linkedSpanID)
+	link.Attributes().PutStr("link.kind", "follows_from")
+
+	segment, err := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
+
+	require.NoError(t, err)
+	expectedTraceID, _ := convertToAmazonTraceID(linkedTraceID, span.StartTimestamp(), TraceIDRemappingDrop)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"trace_id":   expectedTraceID,
+			"id":         linkedSpanID.HexString(),
+			"attributes": map[string]interface{}{"link.kind": "follows_from"},
+		},
+	}, segment.Metadata["default"][awsxray.AWSXRaySpanLinksMetadataKey])
+}
+
+func TestSpanWithLinksRewriteEpoch(t *testing.T) {
+	const maxAge = 60 * 60 * 24 * 30
+	span := constructClientSpan(newSegmentID(), "/api/locations", ptrace.StatusCodeUnset, "OK", map[string]interface{}{})
+	// The trace ID of the span is remapped from its start time.
+	traceID := newTraceID()
+	binary.BigEndian.PutUint32(traceID[0:4], uint32(time.Now().Unix()-maxAge-1))
+	span.SetTraceID(traceID)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(time.Now().Add(-time.Minute)))
+
+	// The epoch of a valid trace ID is kept, even if it isn't the day the span started.
+	validTraceID := newTraceID()
+	validEpoch := time.Now().Add(-3 * 24 * time.Hour).Unix()
+	binary.BigEndian.PutUint32(validTraceID[0:4], uint32(validEpoch))
+	validLink := span.Links().AppendEmpty()
+	validLink.SetTraceID(validTraceID)
+	validLink.SetSpanID(newSegmentID())
+
+	expiredTraceID := newTraceID()
+	binary.BigEndian.PutUint32(expiredTraceID[0:4], uint32(time.Now().Unix()-maxAge-1))
+	expiredLink := span.Links().AppendEmpty()
+	expiredLink.SetTraceID(expiredTraceID)
+	expiredLink.SetSpanID(newSegmentID())
+
+	segment, err := MakeSegment(span, constructDefaultResource(), nil, false, TraceIDRemappingRewriteEpoch)
+
+	require.NoError(t, err)
+	startDay := span.StartTimestamp().AsTime().UTC().Truncate(24 * time.Hour).Unix()
+	assert.Equal(t, fmt.Sprintf("1-%08x-%s", startDay, hex.EncodeToString(traceID[4:16])), *segment.TraceID)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"trace_id": fmt.Sprintf("1-%08x-%s", validEpoch, hex.EncodeToString(validTraceID[4:16])),
+			"id":       validLink.SpanID().HexString(),
+		},
+		map[string]interface{}{
+			"trace_id": fmt.Sprintf("1-%08x-%s", startDay, hex.EncodeToString(expiredTraceID[4:16])),
+			"id":       expiredLink.SpanID().HexString(),
+		},
+	}, segment.Metadata["default"][awsxray.AWSXRaySpanLinksMetadataKey])
+
+	// The trace IDs of the links which can't be remapped are kept as is.
+	segment, err = MakeSegment(span, constructDefaultResource(), nil, false, TraceIDRemappingDrop)
+	require.Error(t, err)
+	assert.Nil(t, segment)
+	links := makeLinks(span.Links(), span.StartTimestamp(), TraceIDRemappingDrop)
+	assert.Equal(t, expiredTraceID.HexString(), links[1].(map[string]interface{})["trace_id"])
+}
+
 func TestFixSegmentName(t *testing.T) {
 	validName := "EP @ test_15.testing-d\u00F6main.org#GO"
 	fixedName := fixSegmentName(validName)
@@ -391,7 +483,7 @@ func TestServerSpanWithNilAttributes(t *testing.T) {
 	timeEvents.CopyTo(span.Events())
 	pcommon.NewMap().CopyTo(span.Attributes())
 
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.NotNil(t, segment.Cause)
@@ -408,7 +500,7 @@ func TestSpanWithAttributesDefaultNotIndexed(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, 0, len(segment.Annotations))
@@ -435,7 +527,7 @@ func TestSpanWithResourceNotStoredIfSubsegment(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, ptrace.StatusCodeError, "ERROR", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, 0, len(segment.Annotations))
@@ -458,7 +550,7 @@ func TestSpanWithAttributesPartlyIndexed(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{"attr1@1", "not_exist"}, false)
+	segment, _ := MakeSegment(span, resource, []string{"attr1@1", "not_exist"}, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, 1, len(segment.Annotations))
@@ -475,7 +567,7 @@ func TestSpanWithAttributesAllIndexed(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeOk, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{"attr1@1", "not_exist"}, true)
+	segment, _ := MakeSegment(span, resource, []string{"attr1@1", "not_exist"}, true, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, "val1", segment.Annotations["attr1_1"])
@@ -496,7 +588,7 @@ func TestResourceAttributesCanBeIndexed(t *testing.T) {
 		"otel.resource.bool.key",
 		"otel.resource.map.key",
 		"otel.resource.array.key",
-	}, false)
+	}, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, 4, len(segment.Annotations))
@@ -528,7 +620,7 @@ func TestResourceAttributesNotIndexedIfSubsegment(t *testing.T) {
 		"otel.resource.bool.key",
 		"otel.resource.map.key",
 		"otel.resource.array.key",
-	}, false)
+	}, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Empty(t, segment.Annotations)
@@ -545,7 +637,7 @@ func TestOriginNotAws(t *testing.T) {
 	attrs.PutStr(conventions.AttributeHostID, "instance-123")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Nil(t, segment.Origin)
@@ -562,7 +654,7 @@ func TestOriginEc2(t *testing.T) {
 	attrs.PutStr(conventions.AttributeHostID, "instance-123")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginEC2, *segment.Origin)
@@ -580,7 +672,7 @@ func TestOriginEcs(t *testing.T) {
 	attrs.PutStr(conventions.AttributeContainerName, "container-123")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginECS, *segment.Origin)
@@ -599,7 +691,7 @@ func TestOriginEcsEc2(t *testing.T) {
 	attrs.PutStr(conventions.AttributeContainerName, "container-123")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginECSEC2, *segment.Origin)
@@ -618,7 +710,7 @@ func TestOriginEcsFargate(t *testing.T) {
 	attrs.PutStr(conventions.AttributeContainerName, "container-123")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginECSFargate, *segment.Origin)
@@ -637,7 +729,7 @@ func TestOriginEb(t *testing.T) {
 	attrs.PutStr(conventions.AttributeServiceInstanceID, "service-123")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginEB, *segment.Origin)
@@ -668,7 +760,7 @@ func TestOriginEks(t *testing.T) {
 	attrs.PutStr(conventions.AttributeHostType, "m5.xlarge")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginEKS, *segment.Origin)
@@ -684,7 +776,7 @@ func TestOriginAppRunner(t *testing.T) {
 	attrs.PutStr(conventions.AttributeCloudPlatform, conventions.AttributeCloudPlatformAWSAppRunner)
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginAppRunner, *segment.Origin)
@@ -699,7 +791,7 @@ func TestOriginBlank(t *testing.T) {
 	attrs.PutStr(conventions.AttributeCloudProvider, conventions.AttributeCloudProviderAWS)
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Nil(t, segment.Origin)
@@ -719,7 +811,7 @@ func TestOriginPrefersInfraService(t *testing.T) {
 	attrs.PutStr(conventions.AttributeServiceInstanceID, "service-123")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginEC2, *segment.Origin)
@@ -749,7 +841,7 @@ func TestFilteredAttributesMetadata(t *testing.T) {
 	mapValue.PutDouble("value1", -987.65)
 	mapValue.PutBool("value2", true)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, TraceIDRemappingDrop)
 
 	assert.NotNil(t, segment)
 	assert.Nil(t, segment.Metadata["default"]["null_value"])
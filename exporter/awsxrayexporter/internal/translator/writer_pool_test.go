@@ -37,7 +37,7 @@ func TestWriterPoolBasic(t *testing.T) {
 	assert.Equal(t, size, w.buffer.Cap())
 	assert.Equal(t, 0, w.buffer.Len())
 	resource := pcommon.NewResource()
-	segment, _ := MakeSegment(span, resource, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, TraceIDRemappingDrop)
 	if err := w.Encode(*segment); err != nil {
 		assert.Fail(t, "invalid json")
 	}
@@ -54,7 +54,7 @@ func BenchmarkWithoutPool(b *testing.B) {
 		b.StartTimer()
 		buffer := bytes.NewBuffer(make([]byte, 0, 2048))
 		encoder := json.NewEncoder(buffer)
-		segment, _ := MakeSegment(span, pcommon.NewResource(), nil, false)
+		segment, _ := MakeSegment(span, pcommon.NewResource(), nil, false, TraceIDRemappingDrop)
 		err := encoder.Encode(*segment)
 		assert.NoError(b, err)
 		logger.Info(buffer.String())
@@ -69,7 +69,7 @@ func BenchmarkWithPool(b *testing.B) {
 		span := constructWriterPoolSpan()
 		b.StartTimer()
 		w := wp.borrow()
-		segment, _ := MakeSegment(span, pcommon.NewResource(), nil, false)
+		segment, _ := MakeSegment(span, pcommon.NewResource(), nil, false, TraceIDRemappingDrop)
 		err := w.Encode(*segment)
 		assert.Nil(b, err)
 		logger.Info(w.String())
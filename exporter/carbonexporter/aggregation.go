@@ -0,0 +1,270 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package carbonexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter"
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// Aggregation functions supported by the aggregation rules.
+const (
+	aggregationSum = "sum"
+	aggregationAvg = "avg"
+	aggregationMax = "max"
+)
+
+type aggregationRule struct {
+	pattern  *regexp.Regexp
+	window   int64
+	function string
+}
+
+// windowKey identifies the aggregated point of a Carbon path in a window.
+type windowKey struct {
+	path  string
+	start int64
+}
+
+// window holds the state of the aggregation of the data points of a Carbon
+// path in a window.
+type window struct {
+	rule  *aggregationRule
+	sum   float64
+	max   float64
+	count int64
+}
+
+func (w *window) add(value float64) {
+	if w.count == 0 || value > w.max {
+		w.max = value
+	}
+	w.sum += value
+	w.count++
+}
+
+func (w *window) value() float64 {
+	switch w.rule.function {
+	case aggregationAvg:
+		return w.sum / float64(w.count)
+	case aggregationMax:
+		return w.max
+	default:
+		return w.sum
+	}
+}
+
+// aggregatedPoint is a data point waiting to be aggregated.
+type aggregatedPoint struct {
+	rule      *aggregationRule
+	path      string
+	value     float64
+	timestamp int64
+}
+
+// aggregator aggregates the data points of the metrics matching its rules in
+// windows aligned to the Unix epoch, as Graphite aligns the points of its
+// archives. The aggregated point of a window is emitted with the start of the
+// window as timestamp, once the window is over.
+type aggregator struct {
+	rules []*aggregationRule
+
+	mtx     sync.Mutex
+	windows map[windowKey]*window
+	// sentUntil is the Unix time until which the windows were sent.
+	sentUntil int64
+}
+
+func newAggregator(cfgs []AggregationRule) (*aggregator, error) {
+	if len(cfgs) == 0 {
+		return nil, nil
+	}
+
+	rules := make([]*aggregationRule, 0, len(cfgs))
+	for i, cfg := range cfgs {
+		pattern, err := regexp.Compile(cfg.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("aggregation rule %d has an invalid pattern: %w", i, err)
+		}
+		if cfg.Window <= 0 || cfg.Window%time.Second != 0 {
+			return nil, fmt.Errorf("aggregation rule %d requires a window multiple of a second", i)
+		}
+		switch cfg.Function {
+		case aggregationSum, aggregationAvg, aggregationMax:
+		default:
+			return nil, fmt.Errorf("aggregation rule %d has an unsupported function %q", i, cfg.Function)
+		}
+		rules = append(rules, &aggregationRule{
+			pattern:  pattern,
+			window:   int64(cfg.Window / time.Second),
+			function: cfg.Function,
+		})
+	}
+
+	return &aggregator{
+		rules:   rules,
+		windows: make(map[windowKey]*window),
+	}, nil
+}
+
+func (a *aggregator) match(name string) *aggregationRule {
+	for _, rule := range a.rules {
+		if rule.pattern.MatchString(name) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// split converts the metrics not matching any rule to Carbon lines, and returns
+// the data points of the matching metrics, to be aggregated once the lines are sent.
+func (a *aggregator) split(md pmetric.Metrics) (string, []aggregatedPoint) {
+	var sb strings.Builder
+	var points []aggregatedPoint
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				metric := sm.Metrics().At(k)
+				if metric.Name() == "" {
+					continue
+				}
+
+				var dps pmetric.NumberDataPointSlice
+				switch metric.Type() {
+				case pmetric.MetricTypeGauge:
+					dps = metric.Gauge().DataPoints()
+				case pmetric.MetricTypeSum:
+					dps = metric.Sum().DataPoints()
+				default:
+					formatMetric(&sb, metric)
+					continue
+				}
+
+				rule := a.match(metric.Name())
+				if rule == nil {
+					formatMetric(&sb, metric)
+					continue
+				}
+				for l := 0; l < dps.Len(); l++ {
+					dp := dps.At(l)
+					value := dp.DoubleValue()
+					if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+						value = float64(dp.IntValue())
+					}
+					points = append(points, aggregatedPoint{
+						rule:      rule,
+						path:      buildPath(metric.Name(), dp.Attributes()),
+						value:     value,
+						timestamp: int64(dp.Timestamp()) / 1e9,
+					})
+				}
+			}
+		}
+	}
+
+	return sb.String(), points
+}
+
+// push aggregates the data points in their windows and sends the lines along
+// with the windows over at now, or all the windows if now is zero. The lock is
+// held until the lines are sent, so that concurrent pushes don't send a window
+// twice. The data points whose window was already sent are late: they are
+// dropped and counted, so that a window is never sent again with a partial
+// value. When send fails, the aggregator is left unchanged, so that the data
+// points are not counted twice when they are retried.
+func (a *aggregator) push(lines string, points []aggregatedPoint, now time.Time, send func(string) error) (int, error) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	late := 0
+	updated := make(map[windowKey]*window)
+	for _, point := range points {
+		if math.IsNaN(point.value) {
+			continue
+		}
+		start := point.timestamp - point.timestamp%point.rule.window
+		if start+point.rule.window <= a.sentUntil {
+			late++
+			continue
+		}
+		key := windowKey{path: point.path, start: start}
+		w, ok := updated[key]
+		if !ok {
+			w = &window{rule: point.rule}
+			if current, found := a.windows[key]; found {
+				*w = *current
+			}
+			updated[key] = w
+		}
+		w.add(point.value)
+	}
+
+	over := make(map[windowKey]*window)
+	for _, windows := range []map[windowKey]*window{a.windows, updated} {
+		for key, w := range windows {
+			if now.IsZero() || key.start+w.rule.window <= now.Unix() {
+				over[key] = w
+			}
+		}
+	}
+
+	if payload := lines + formatWindows(over); payload != "" {
+		if err := send(payload); err != nil {
+			return 0, err
+		}
+	}
+
+	for key, w := range updated {
+		a.windows[key] = w
+	}
+	for key := range over {
+		delete(a.windows, key)
+	}
+	if now.Unix() > a.sentUntil {
+		a.sentUntil = now.Unix()
+	}
+	return late, nil
+}
+
+// formatWindows converts the aggregated windows into Carbon lines, sorted to
+// make the output deterministic.
+func formatWindows(windows map[windowKey]*window) string {
+	keys := make([]windowKey, 0, len(windows))
+	for key := range windows {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].start != keys[j].start {
+			return keys[i].start < keys[j].start
+		}
+		return keys[i].path < keys[j].path
+	})
+
+	var sb strings.Builder
+	for _, key := range keys {
+		sb.WriteString(buildLine(key.path, formatFloatForValue(windows[key].value()), formatInt64(key.start)))
+	}
+	return sb.String()
+}
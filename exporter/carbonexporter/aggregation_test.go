@@ -0,0 +1,204 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package carbonexporter
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func TestNewAggregator(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []AggregationRule
+		wantErr string
+	}{
+		{
+			name: "no_rules",
+		},
+		{
+			name:  "valid",
+			rules: []AggregationRule{{Pattern: "^cpu\\.", Window: time.Minute, Function: aggregationAvg}},
+		},
+		{
+			name:    "invalid_pattern",
+			rules:   []AggregationRule{{Pattern: "(", Window: time.Minute, Function: aggregationSum}},
+			wantErr: "aggregation rule 0 has an invalid pattern",
+		},
+		{
+			name:    "invalid_window",
+			rules:   []AggregationRule{{Pattern: ".*", Window: 1500 * time.Millisecond, Function: aggregationSum}},
+			wantErr: "aggregation rule 0 requires a window multiple of a second",
+		},
+		{
+			name:    "invalid_function",
+			rules:   []AggregationRule{{Pattern: ".*", Window: time.Minute, Function: "median"}},
+			wantErr: `aggregation rule 0 has an unsupported function "median"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agg, err := newAggregator(tt.rules)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.rules) == 0, agg == nil)
+		})
+	}
+}
+
+func TestAggregatorFunctions(t *testing.T) {
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	for _, name := range []string{"requests", "latency", "queue", "other"} {
+		m := ms.AppendEmpty()
+		m.SetName(name)
+		dps := m.SetEmptyGauge().DataPoints()
+		for i, value := range []int64{1, 5, 3} {
+			dp := dps.AppendEmpty()
+			dp.SetTimestamp(pcommon.Timestamp(int64(60+i*10) * 1e9))
+			dp.SetIntValue(value)
+		}
+	}
+	h := ms.AppendEmpty()
+	h.SetName("requests_histogram")
+	h.SetEmptyHistogram().DataPoints().AppendEmpty().SetTimestamp(pcommon.Timestamp(60 * 1e9))
+
+	agg, err := newAggregator([]AggregationRule{
+		{Pattern: "^requests", Window: time.Minute, Function: aggregationSum},
+		{Pattern: "^latency$", Window: time.Minute, Function: aggregationAvg},
+		{Pattern: "^queue$", Window: time.Minute, Function: aggregationMax},
+	})
+	require.NoError(t, err)
+
+	lines, points := agg.split(md)
+	assert.Equal(t,
+		"other 1 60\nother 5 70\nother 3 80\n"+
+			"requests_histogram.count 0 60\nrequests_histogram 0 60\n",
+		lines)
+	require.Len(t, points, 9)
+
+	sent := pushAggregated(t, agg, lines, points, time.Unix(119, 0))
+	assert.Equal(t, lines, sent)
+	assert.Equal(t, "latency 3 60\nqueue 5 60\nrequests 9 60\n", pushAggregated(t, agg, "", nil, time.Unix(120, 0)))
+	assert.Empty(t, pushAggregated(t, agg, "", nil, time.Time{}))
+}
+
+// pushAggregated pushes the data points and returns the lines sent.
+func pushAggregated(t *testing.T, agg *aggregator, lines string, points []aggregatedPoint, now time.Time) string {
+	var sent string
+	_, err := agg.push(lines, points, now, func(payload string) error {
+		sent = payload
+		return nil
+	})
+	require.NoError(t, err)
+	return sent
+}
+
+func TestAggregatorSendFailure(t *testing.T) {
+	agg, err := newAggregator([]AggregationRule{{Pattern: ".*", Window: 10 * time.Second, Function: aggregationSum}})
+	require.NoError(t, err)
+	rule := agg.rules[0]
+
+	pushAggregated(t, agg, "", []aggregatedPoint{{rule: rule, path: "a", value: 1, timestamp: 12}}, time.Unix(15, 0))
+
+	// the data points of a failed push are not counted, so that they are not counted twice when retried
+	points := []aggregatedPoint{{rule: rule, path: "a", value: 2, timestamp: 15}}
+	_, err = agg.push("", points, time.Unix(20, 0), func(string) error { return errors.New("connection refused") })
+	require.Error(t, err)
+	assert.Equal(t, "a 3 10\n", pushAggregated(t, agg, "", points, time.Unix(20, 0)))
+}
+
+func TestAggregatorLatePoints(t *testing.T) {
+	agg, err := newAggregator([]AggregationRule{{Pattern: ".*", Window: 10 * time.Second, Function: aggregationSum}})
+	require.NoError(t, err)
+	rule := agg.rules[0]
+
+	// the points of windows over are sent along with the push
+	assert.Equal(t, "a 1 10\n", pushAggregated(t, agg, "", []aggregatedPoint{{rule: rule, path: "a", value: 1, timestamp: 12}}, time.Unix(25, 0)))
+
+	// the windows sent are not reopened
+	late, err := agg.push("", []aggregatedPoint{
+		{rule: rule, path: "a", value: 2, timestamp: 15},
+		{rule: rule, path: "b", value: 3, timestamp: 18},
+		{rule: rule, path: "a", value: 4, timestamp: 22},
+	}, time.Unix(26, 0), func(payload string) error {
+		assert.Empty(t, payload)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, late)
+	assert.Equal(t, "a 4 20\n", pushAggregated(t, agg, "", nil, time.Time{}))
+}
+
+func TestAggregatedPushAndShutdown(t *testing.T) {
+	addr := testutil.GetAvailableLocalAddress(t)
+	ln, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+	defer ln.Close()
+
+	received := make(chan string, 10)
+	go func() {
+		conn, acceptErr := ln.Accept()
+		if acceptErr != nil {
+			return
+		}
+		defer conn.Close()
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			received <- scanner.Text()
+		}
+	}()
+
+	agg, err := newAggregator([]AggregationRule{{Pattern: "^cpu$", Window: time.Hour, Function: aggregationMax}})
+	require.NoError(t, err)
+	cs := carbonSender{connPool: newTCPConnPool(addr, time.Second), aggregator: agg, logger: zap.NewNop()}
+
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	now := time.Now()
+	cpu := ms.AppendEmpty()
+	cpu.SetName("cpu")
+	dp := cpu.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(now))
+	dp.SetDoubleValue(0.5)
+	mem := ms.AppendEmpty()
+	mem.SetName("mem")
+	dp = mem.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(now))
+	dp.SetIntValue(42)
+
+	require.NoError(t, cs.pushMetricsData(context.Background(), md))
+	assert.Equal(t, "mem 42 "+formatInt64(now.Unix()), <-received)
+
+	require.NoError(t, cs.Shutdown(context.Background()))
+	start := now.Unix() - now.Unix()%3600
+	assert.Equal(t, "cpu 0.5 "+formatInt64(start), <-received)
+}
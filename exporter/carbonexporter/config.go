@@ -38,4 +38,26 @@ type Config struct {
 	// data to the Carbon/Graphite backend.
 	// The default value is defined by the DefaultSendTimeout constant.
 	Timeout time.Duration `mapstructure:"timeout"`
+
+	// Aggregation lists the rules used to aggregate gauge and sum data points
+	// before sending them, so that the points match the resolution of the
+	// Graphite storage schemas. The first rule matching a metric is applied,
+	// metrics not matching any rule are sent as they are.
+	Aggregation []AggregationRule `mapstructure:"aggregation"`
+}
+
+// AggregationRule defines how the data points of the matching metrics are
+// aggregated.
+type AggregationRule struct {
+	// Pattern is the regular expression matched against the metric name.
+	Pattern string `mapstructure:"pattern"`
+
+	// Window is the duration of the aggregation window, it should match the
+	// finest retention of the storage schema of the metrics. It must be a
+	// multiple of a second.
+	Window time.Duration `mapstructure:"window"`
+
+	// Function is the aggregation function applied to the data points in each
+	// window, one of "sum", "avg" or "max".
+	Function string `mapstructure:"function"`
 }
@@ -48,6 +48,10 @@ func TestLoadConfig(t *testing.T) {
 		ExporterSettings: config.NewExporterSettings(config.NewComponentIDWithName(typeStr, "allsettings")),
 		Endpoint:         "localhost:8080",
 		Timeout:          10 * time.Second,
+		Aggregation: []AggregationRule{
+			{Pattern: `^system\.cpu\.`, Window: time.Minute, Function: "avg"},
+			{Pattern: `^http\.server\.requests$`, Window: 10 * time.Second, Function: "sum"},
+		},
 	}
 	assert.Equal(t, &expectedCfg, e1)
 
@@ -24,6 +24,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
 )
 
 // newCarbonExporter returns a new Carbon exporter.
@@ -39,8 +40,15 @@ func newCarbonExporter(cfg *Config, set component.ExporterCreateSettings) (compo
 		return nil, fmt.Errorf("%v exporter requires a positive timeout", cfg.ID())
 	}
 
+	agg, err := newAggregator(cfg.Aggregation)
+	if err != nil {
+		return nil, fmt.Errorf("%v exporter has an invalid aggregation: %w", cfg.ID(), err)
+	}
+
 	sender := carbonSender{
-		connPool: newTCPConnPool(cfg.Endpoint, cfg.Timeout),
+		connPool:   newTCPConnPool(cfg.Endpoint, cfg.Timeout),
+		aggregator: agg,
+		logger:     set.Logger,
 	}
 
 	return exporterhelper.NewMetricsExporter(
@@ -55,10 +63,16 @@ func newCarbonExporter(cfg *Config, set component.ExporterCreateSettings) (compo
 // connections into an implementations of exporterhelper.PushMetricsData so
 // the exporter can leverage the helper and get consistent observability.
 type carbonSender struct {
-	connPool *connPool
+	connPool   *connPool
+	aggregator *aggregator
+	logger     *zap.Logger
 }
 
 func (cs *carbonSender) pushMetricsData(_ context.Context, md pmetric.Metrics) error {
+	if cs.aggregator != nil {
+		return cs.pushAggregatedMetricsData(md)
+	}
+
 	lines := metricDataToPlaintext(md)
 
 	if _, err := cs.connPool.Write([]byte(lines)); err != nil {
@@ -69,8 +83,32 @@ func (cs *carbonSender) pushMetricsData(_ context.Context, md pmetric.Metrics) e
 	return nil
 }
 
+// pushAggregatedMetricsData sends the metrics not matching any aggregation rule
+// along with the windows that are over. The data points to aggregate are only
+// added once the write succeeds, so that retries don't count them twice.
+func (cs *carbonSender) pushAggregatedMetricsData(md pmetric.Metrics) error {
+	lines, points := cs.aggregator.split(md)
+	late, err := cs.aggregator.push(lines, points, time.Now(), cs.write)
+	if late > 0 {
+		cs.logger.Warn("Dropped data points whose aggregation window was already sent", zap.Int("dropped_data_points", late))
+	}
+	return err
+}
+
+func (cs *carbonSender) write(payload string) error {
+	_, err := cs.connPool.Write([]byte(payload))
+	return err
+}
+
 func (cs *carbonSender) Shutdown(context.Context) error {
-	cs.connPool.Close()
+	defer cs.connPool.Close()
+
+	// Send the windows that are not over yet, as they would be lost otherwise.
+	if cs.aggregator != nil {
+		if _, err := cs.aggregator.push("", nil, time.Time{}, cs.write); err != nil {
+			return fmt.Errorf("failed to send aggregated metrics on shutdown: %w", err)
+		}
+	}
 	return nil
 }
 
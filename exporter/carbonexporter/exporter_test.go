@@ -57,6 +57,15 @@ func TestNew(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid_aggregation",
+			config: &Config{
+				ExporterSettings: config.NewExporterSettings(config.NewComponentID(typeStr)),
+				Endpoint:         DefaultEndpoint,
+				Aggregation:      []AggregationRule{{Pattern: ".*", Function: "sum"}},
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid_timeout",
 			config: &Config{
@@ -97,16 +97,7 @@ func metricDataToPlaintext(md pmetric.Metrics) string {
 					// TODO: log error info
 					continue
 				}
-				switch metric.Type() {
-				case pmetric.MetricTypeGauge:
-					formatNumberDataPoints(&sb, metric.Name(), metric.Gauge().DataPoints())
-				case pmetric.MetricTypeSum:
-					formatNumberDataPoints(&sb, metric.Name(), metric.Sum().DataPoints())
-				case pmetric.MetricTypeHistogram:
-					formatHistogramDataPoints(&sb, metric.Name(), metric.Histogram().DataPoints())
-				case pmetric.MetricTypeSummary:
-					formatSummaryDataPoints(&sb, metric.Name(), metric.Summary().DataPoints())
-				}
+				formatMetric(&sb, metric)
 			}
 		}
 	}
@@ -114,6 +105,21 @@ func metricDataToPlaintext(md pmetric.Metrics) string {
 	return sb.String()
 }
 
+// formatMetric transforms the data points of a metric into Carbon metrics and
+// injects them into the string builder.
+func formatMetric(sb *strings.Builder, metric pmetric.Metric) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		formatNumberDataPoints(sb, metric.Name(), metric.Gauge().DataPoints())
+	case pmetric.MetricTypeSum:
+		formatNumberDataPoints(sb, metric.Name(), metric.Sum().DataPoints())
+	case pmetric.MetricTypeHistogram:
+		formatHistogramDataPoints(sb, metric.Name(), metric.Histogram().DataPoints())
+	case pmetric.MetricTypeSummary:
+		formatSummaryDataPoints(sb, metric.Name(), metric.Summary().DataPoints())
+	}
+}
+
 func formatNumberDataPoints(sb *strings.Builder, metricName string, dps pmetric.NumberDataPointSlice) {
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
 	"strings"
 
 	"go.opentelemetry.io/collector/config"
@@ -42,8 +43,25 @@ type Config struct {
 	LogsTableName string `mapstructure:"logs_table_name"`
 	// TracesTableName is the table name for logs. default is `otel_traces`.
 	TracesTableName string `mapstructure:"traces_table_name"`
+	// MetricsTableName is the prefix of the table names for metrics, each metric type
+	// having its own table, e.g. `otel_metrics_gauge`. default is `otel_metrics`.
+	MetricsTableName string `mapstructure:"metrics_table_name"`
 	// TTLDays is The data time-to-live in days, 0 means no ttl.
 	TTLDays uint `mapstructure:"ttl_days"`
+	// LogsTable overrides the settings of the logs table.
+	LogsTable TableSettings `mapstructure:"logs_table"`
+	// TracesTable overrides the settings of the traces tables.
+	TracesTable TableSettings `mapstructure:"traces_table"`
+	// MetricsTable overrides the settings of the metrics tables.
+	MetricsTable TableSettings `mapstructure:"metrics_table"`
+}
+
+// TableSettings defines the settings of the tables created by the exporter.
+type TableSettings struct {
+	// TTLDays overrides the data time-to-live in days of the table, 0 means no ttl.
+	TTLDays *uint `mapstructure:"ttl_days"`
+	// Codec is the compression codec of the columns of the table, e.g. `LZ4` or `ZSTD(3)`. default is `ZSTD(1)`.
+	Codec string `mapstructure:"codec"`
 }
 
 // QueueSettings is a subset of exporterhelper.QueueSettings.
@@ -54,6 +72,9 @@ type QueueSettings struct {
 
 var (
 	errConfigNoDSN = errors.New("dsn must be specified")
+
+	// codecPattern matches the compression codecs, with their optional level, e.g. `ZSTD(3)`.
+	codecPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*(\(\d+\))?$`)
 )
 
 // Validate validates the clickhouse server configuration.
@@ -65,9 +86,44 @@ func (cfg *Config) Validate() (err error) {
 	if e != nil {
 		err = multierr.Append(err, fmt.Errorf("invalid dsn format:%w", err))
 	}
+	for name, table := range map[string]TableSettings{
+		"logs_table":    cfg.LogsTable,
+		"traces_table":  cfg.TracesTable,
+		"metrics_table": cfg.MetricsTable,
+	} {
+		if table.Codec != "" && !codecPattern.MatchString(table.Codec) {
+			err = multierr.Append(err, fmt.Errorf("invalid %s codec %q", name, table.Codec))
+		}
+	}
 	return err
 }
 
+const defaultCodec = "ZSTD(1)"
+
+// ttlDays returns the time-to-live in days of the table, 0 meaning no ttl.
+func (cfg *Config) ttlDays(table TableSettings) uint {
+	if table.TTLDays != nil {
+		return *table.TTLDays
+	}
+	return cfg.TTLDays
+}
+
+// ttlExpr returns the TTL clause of the table for the given time column, or an empty string if there is no ttl.
+func (cfg *Config) ttlExpr(table TableSettings, timeColumn string) string {
+	if days := cfg.ttlDays(table); days > 0 {
+		return fmt.Sprintf(`TTL toDateTime(%s) + toIntervalDay(%d)`, timeColumn, days)
+	}
+	return ""
+}
+
+// withCodec replaces the default codec of the columns of the create table statement with the codec of the table.
+func withCodec(createTableSQL string, table TableSettings) string {
+	if table.Codec == "" {
+		return createTableSQL
+	}
+	return strings.ReplaceAll(createTableSQL, defaultCodec, table.Codec)
+}
+
 const defaultDatabase = "default"
 
 func parseDSNDatabase(dsn string) (string, error) {
@@ -44,6 +44,7 @@ func TestLoadConfig(t *testing.T) {
 	r0 := cfg.Exporters[config.NewComponentID(typeStr)]
 	assert.Equal(t, r0, defaultCfg)
 
+	metricsTTLDays := uint(30)
 	r1 := cfg.Exporters[config.NewComponentIDWithName(typeStr, "full")].(*Config)
 	assert.Equal(t, r1, &Config{
 		ExporterSettings: config.NewExporterSettings(config.NewComponentIDWithName(typeStr, "full")),
@@ -51,6 +52,9 @@ func TestLoadConfig(t *testing.T) {
 		TTLDays:          3,
 		LogsTableName:    "otel_logs",
 		TracesTableName:  "otel_traces",
+		MetricsTableName: "otel_metrics",
+		LogsTable:        TableSettings{Codec: "LZ4"},
+		MetricsTable:     TableSettings{TTLDays: &metricsTTLDays},
 		TimeoutSettings: exporterhelper.TimeoutSettings{
 			Timeout: 5 * time.Second,
 		},
@@ -66,6 +70,42 @@ func TestLoadConfig(t *testing.T) {
 	})
 }
 
+func TestConfig_Validate(t *testing.T) {
+	tests := map[string]struct {
+		table   TableSettings
+		wantErr string
+	}{
+		"default codec": {
+			table: TableSettings{},
+		},
+		"codec with level": {
+			table: TableSettings{Codec: "ZSTD(3)"},
+		},
+		"codec without level": {
+			table: TableSettings{Codec: "LZ4"},
+		},
+		"invalid codec": {
+			table:   TableSettings{Codec: "ZSTD(1)) TTL"},
+			wantErr: `invalid metrics_table codec "ZSTD(1)) TTL"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := withDefaultConfig(func(cfg *Config) {
+				cfg.DSN = defaultDSN
+				cfg.MetricsTable = test.table
+			})
+			err := cfg.Validate()
+			if test.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.wantErr)
+			}
+		})
+	}
+}
+
 func withDefaultConfig(fns ...func(*Config)) *Config {
 	cfg := createDefaultConfig().(*Config)
 	for _, fn := range fns {
@@ -214,11 +214,7 @@ func createLogsTable(cfg *Config, db *sql.DB) error {
 }
 
 func renderCreateLogsTableSQL(cfg *Config) string {
-	var ttlExpr string
-	if cfg.TTLDays > 0 {
-		ttlExpr = fmt.Sprintf(`TTL toDateTime(Timestamp) + toIntervalDay(%d)`, cfg.TTLDays)
-	}
-	return fmt.Sprintf(createLogsTableSQL, cfg.LogsTableName, ttlExpr)
+	return fmt.Sprintf(withCodec(createLogsTableSQL, cfg.LogsTable), cfg.LogsTableName, cfg.ttlExpr(cfg.LogsTable, "Timestamp"))
 }
 
 func renderInsertLogsSQL(cfg *Config) string {
@@ -0,0 +1,432 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhouseexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	_ "github.com/ClickHouse/clickhouse-go/v2" // For register database driver.
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
+)
+
+// metricTable is the table storing the data points of a metric type.
+type metricTable struct {
+	// suffix is appended to the metrics table name to get the name of the table.
+	suffix string
+	// columns are the definitions of the columns specific to the metric type.
+	columns string
+	// insertColumns are the names of the columns specific to the metric type.
+	insertColumns []string
+}
+
+var (
+	gaugeTable = &metricTable{
+		suffix: "gauge",
+		columns: `
+     Value Float64 CODEC(ZSTD(1)),`,
+		insertColumns: []string{"Value"},
+	}
+	sumTable = &metricTable{
+		suffix: "sum",
+		columns: `
+     Value Float64 CODEC(ZSTD(1)),
+     AggTemp Int32 CODEC(ZSTD(1)),
+     IsMonotonic Boolean CODEC(ZSTD(1)),`,
+		insertColumns: []string{"Value", "AggTemp", "IsMonotonic"},
+	}
+	histogramTable = &metricTable{
+		suffix: "histogram",
+		columns: `
+     Count UInt64 CODEC(Delta, ZSTD(1)),
+     Sum Float64 CODEC(ZSTD(1)),
+     BucketCounts Array(UInt64) CODEC(ZSTD(1)),
+     ExplicitBounds Array(Float64) CODEC(ZSTD(1)),
+     Min Nullable(Float64) CODEC(ZSTD(1)),
+     Max Nullable(Float64) CODEC(ZSTD(1)),
+     AggTemp Int32 CODEC(ZSTD(1)),`,
+		insertColumns: []string{"Count", "Sum", "BucketCounts", "ExplicitBounds", "Min", "Max", "AggTemp"},
+	}
+	exponentialHistogramTable = &metricTable{
+		suffix: "exponential_histogram",
+		columns: `
+     Count UInt64 CODEC(Delta, ZSTD(1)),
+     Sum Float64 CODEC(ZSTD(1)),
+     Scale Int32 CODEC(ZSTD(1)),
+     ZeroCount UInt64 CODEC(ZSTD(1)),
+     PositiveOffset Int32 CODEC(ZSTD(1)),
+     PositiveBucketCounts Array(UInt64) CODEC(ZSTD(1)),
+     NegativeOffset Int32 CODEC(ZSTD(1)),
+     NegativeBucketCounts Array(UInt64) CODEC(ZSTD(1)),
+     Min Nullable(Float64) CODEC(ZSTD(1)),
+     Max Nullable(Float64) CODEC(ZSTD(1)),
+     AggTemp Int32 CODEC(ZSTD(1)),`,
+		insertColumns: []string{"Count", "Sum", "Scale", "ZeroCount", "PositiveOffset", "PositiveBucketCounts",
+			"NegativeOffset", "NegativeBucketCounts", "Min", "Max", "AggTemp"},
+	}
+	summaryTable = &metricTable{
+		suffix: "summary",
+		columns: `
+     Count UInt64 CODEC(Delta, ZSTD(1)),
+     Sum Float64 CODEC(ZSTD(1)),
+     ValueAtQuantiles Nested (
+         Quantile Float64,
+         Value Float64
+     ) CODEC(ZSTD(1)),`,
+		insertColumns: []string{"Count", "Sum", "ValueAtQuantiles.Quantile", "ValueAtQuantiles.Value"},
+	}
+
+	metricTables = []*metricTable{gaugeTable, sumTable, histogramTable, exponentialHistogramTable, summaryTable}
+)
+
+// metricsCommonInsertColumns are the columns shared by all the metric tables, before the columns specific to the metric type.
+var metricsCommonInsertColumns = []string{
+	"ResourceAttributes",
+	"ResourceSchemaUrl",
+	"ScopeName",
+	"ScopeVersion",
+	"ScopeAttributes",
+	"ScopeSchemaUrl",
+	"ServiceName",
+	"MetricName",
+	"MetricDescription",
+	"MetricUnit",
+	"Attributes",
+	"StartTimeUnix",
+	"TimeUnix",
+	"Flags",
+}
+
+// metricsExemplarsInsertColumns are the columns of the exemplars, after the columns specific to the metric type.
+var metricsExemplarsInsertColumns = []string{
+	"Exemplars.FilteredAttributes",
+	"Exemplars.TimeUnix",
+	"Exemplars.Value",
+	"Exemplars.SpanId",
+	"Exemplars.TraceId",
+}
+
+type metricsExporter struct {
+	client     *sql.DB
+	insertSQLs map[*metricTable]string
+
+	logger *zap.Logger
+	cfg    *Config
+}
+
+func newMetricsExporter(logger *zap.Logger, cfg *Config) (*metricsExporter, error) {
+
+	if err := createDatabase(cfg); err != nil {
+		return nil, err
+	}
+
+	client, err := newClickhouseClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = createMetricsTables(cfg, client); err != nil {
+		return nil, err
+	}
+
+	insertSQLs := make(map[*metricTable]string, len(metricTables))
+	for _, table := range metricTables {
+		insertSQLs[table] = renderInsertMetricsSQL(cfg, table)
+	}
+
+	return &metricsExporter{
+		client:     client,
+		insertSQLs: insertSQLs,
+		logger:     logger,
+		cfg:        cfg,
+	}, nil
+}
+
+// Shutdown will shutdown the exporter.
+func (e *metricsExporter) Shutdown(_ context.Context) error {
+	if e.client != nil {
+		return e.client.Close()
+	}
+	return nil
+}
+
+// metricContext holds the values shared by the data points of a metric.
+type metricContext struct {
+	resAttr        map[string]string
+	resURL         string
+	scopeName      string
+	scopeVersion   string
+	scopeAttr      map[string]string
+	scopeURL       string
+	serviceName    string
+	name           string
+	description    string
+	unit           string
+	metricTypeRows map[*metricTable][][]interface{}
+}
+
+func (e *metricsExporter) pushMetricsData(ctx context.Context, md pmetric.Metrics) error {
+	start := time.Now()
+	rows := map[*metricTable][][]interface{}{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		res := rm.Resource()
+		var serviceName string
+		if v, ok := res.Attributes().Get(conventions.AttributeServiceName); ok {
+			serviceName = v.Str()
+		}
+		resAttr := attributesToMap(res.Attributes())
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			scopeAttr := attributesToMap(sm.Scope().Attributes())
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				m := sm.Metrics().At(k)
+				mc := &metricContext{
+					resAttr:        resAttr,
+					resURL:         rm.SchemaUrl(),
+					scopeName:      sm.Scope().Name(),
+					scopeVersion:   sm.Scope().Version(),
+					scopeAttr:      scopeAttr,
+					scopeURL:       sm.SchemaUrl(),
+					serviceName:    serviceName,
+					name:           m.Name(),
+					description:    m.Description(),
+					unit:           m.Unit(),
+					metricTypeRows: rows,
+				}
+				mc.appendDataPoints(m)
+			}
+		}
+	}
+
+	var err error
+	for _, table := range metricTables {
+		if len(rows[table]) == 0 {
+			continue
+		}
+		if err = e.insert(ctx, table, rows[table]); err != nil {
+			break
+		}
+	}
+	duration := time.Since(start)
+	e.logger.Info("insert metrics", zap.Int("records", md.DataPointCount()),
+		zap.String("cost", duration.String()))
+	return err
+}
+
+func (e *metricsExporter) insert(ctx context.Context, table *metricTable, rows [][]interface{}) error {
+	return doWithTx(ctx, e.client, func(tx *sql.Tx) error {
+		statement, err := tx.PrepareContext(ctx, e.insertSQLs[table])
+		if err != nil {
+			return fmt.Errorf("PrepareContext:%w", err)
+		}
+		defer func() {
+			_ = statement.Close()
+		}()
+		for _, row := range rows {
+			if _, err = statement.ExecContext(ctx, row...); err != nil {
+				return fmt.Errorf("ExecContext:%w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// appendDataPoints appends the rows of the data points of the metric to the rows of its table.
+func (mc *metricContext) appendDataPoints(m pmetric.Metric) {
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := m.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			mc.appendRow(gaugeTable, dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), dp.Exemplars(),
+				numberValue(dp))
+		}
+	case pmetric.MetricTypeSum:
+		dps := m.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			mc.appendRow(sumTable, dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), dp.Exemplars(),
+				numberValue(dp), int32(m.Sum().AggregationTemporality()), m.Sum().IsMonotonic())
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := m.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			mc.appendRow(histogramTable, dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), dp.Exemplars(),
+				dp.Count(), dp.Sum(), dp.BucketCounts().AsRaw(), dp.ExplicitBounds().AsRaw(),
+				optionalValue(dp.HasMin(), dp.Min()), optionalValue(dp.HasMax(), dp.Max()),
+				int32(m.Histogram().AggregationTemporality()))
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := m.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			mc.appendRow(exponentialHistogramTable, dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), dp.Exemplars(),
+				dp.Count(), dp.Sum(), dp.Scale(), dp.ZeroCount(),
+				dp.Positive().Offset(), dp.Positive().BucketCounts().AsRaw(),
+				dp.Negative().Offset(), dp.Negative().BucketCounts().AsRaw(),
+				optionalValue(dp.HasMin(), dp.Min()), optionalValue(dp.HasMax(), dp.Max()),
+				int32(m.ExponentialHistogram().AggregationTemporality()))
+		}
+	case pmetric.MetricTypeSummary:
+		dps := m.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			quantiles := make([]float64, dp.QuantileValues().Len())
+			values := make([]float64, dp.QuantileValues().Len())
+			for j := 0; j < dp.QuantileValues().Len(); j++ {
+				quantiles[j] = dp.QuantileValues().At(j).Quantile()
+				values[j] = dp.QuantileValues().At(j).Value()
+			}
+			// the summaries don't have exemplars
+			mc.appendRow(summaryTable, dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), pmetric.NewExemplarSlice(),
+				dp.Count(), dp.Sum(), quantiles, values)
+		}
+	}
+}
+
+// appendRow appends the row of a data point, made of the common columns, the values of the columns
+// specific to the metric type, and the exemplars.
+func (mc *metricContext) appendRow(table *metricTable, attrs pcommon.Map, startTime, timestamp pcommon.Timestamp,
+	flags pmetric.DataPointFlags, exemplars pmetric.ExemplarSlice, values ...interface{}) {
+	row := make([]interface{}, 0, len(metricsCommonInsertColumns)+len(values)+len(metricsExemplarsInsertColumns))
+	row = append(row,
+		mc.resAttr,
+		mc.resURL,
+		mc.scopeName,
+		mc.scopeVersion,
+		mc.scopeAttr,
+		mc.scopeURL,
+		mc.serviceName,
+		mc.name,
+		mc.description,
+		mc.unit,
+		attributesToMap(attrs),
+		startTime.AsTime(),
+		timestamp.AsTime(),
+		uint32(flags),
+	)
+	row = append(row, values...)
+	exemplarAttrs, exemplarTimes, exemplarValues, exemplarSpanIDs, exemplarTraceIDs := convertExemplars(exemplars)
+	row = append(row, exemplarAttrs, exemplarTimes, exemplarValues, exemplarSpanIDs, exemplarTraceIDs)
+	mc.metricTypeRows[table] = append(mc.metricTypeRows[table], row)
+}
+
+func numberValue(dp pmetric.NumberDataPoint) float64 {
+	if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		return float64(dp.IntValue())
+	}
+	return dp.DoubleValue()
+}
+
+// optionalValue returns the value if it is set, or nil to insert a NULL.
+func optionalValue(isSet bool, value float64) interface{} {
+	if !isSet {
+		return nil
+	}
+	return value
+}
+
+func convertExemplars(exemplars pmetric.ExemplarSlice) ([]map[string]string, []time.Time, []float64, []string, []string) {
+	var (
+		attrs    = make([]map[string]string, 0, exemplars.Len())
+		times    = make([]time.Time, 0, exemplars.Len())
+		values   = make([]float64, 0, exemplars.Len())
+		spanIDs  = make([]string, 0, exemplars.Len())
+		traceIDs = make([]string, 0, exemplars.Len())
+	)
+	for i := 0; i < exemplars.Len(); i++ {
+		exemplar := exemplars.At(i)
+		attrs = append(attrs, attributesToMap(exemplar.FilteredAttributes()))
+		times = append(times, exemplar.Timestamp().AsTime())
+		if exemplar.ValueType() == pmetric.ExemplarValueTypeInt {
+			values = append(values, float64(exemplar.IntValue()))
+		} else {
+			values = append(values, exemplar.DoubleValue())
+		}
+		spanIDs = append(spanIDs, exemplar.SpanID().HexString())
+		traceIDs = append(traceIDs, exemplar.TraceID().HexString())
+	}
+	return attrs, times, values, spanIDs, traceIDs
+}
+
+const (
+	// language=ClickHouse SQL
+	createMetricsTableSQL = `
+CREATE TABLE IF NOT EXISTS %s_%s (
+     ResourceAttributes Map(LowCardinality(String), String) CODEC(ZSTD(1)),
+     ResourceSchemaUrl String CODEC(ZSTD(1)),
+     ScopeName String CODEC(ZSTD(1)),
+     ScopeVersion String CODEC(ZSTD(1)),
+     ScopeAttributes Map(LowCardinality(String), String) CODEC(ZSTD(1)),
+     ScopeSchemaUrl String CODEC(ZSTD(1)),
+     ServiceName LowCardinality(String) CODEC(ZSTD(1)),
+     MetricName String CODEC(ZSTD(1)),
+     MetricDescription String CODEC(ZSTD(1)),
+     MetricUnit String CODEC(ZSTD(1)),
+     Attributes Map(LowCardinality(String), String) CODEC(ZSTD(1)),
+     StartTimeUnix DateTime64(9) CODEC(Delta, ZSTD(1)),
+     TimeUnix DateTime64(9) CODEC(Delta, ZSTD(1)),
+     Flags UInt32 CODEC(ZSTD(1)),%s
+     Exemplars Nested (
+         FilteredAttributes Map(LowCardinality(String), String),
+         TimeUnix DateTime64(9),
+         Value Float64,
+         SpanId String,
+         TraceId String
+     ) CODEC(ZSTD(1)),
+     INDEX idx_res_attr_key mapKeys(ResourceAttributes) TYPE bloom_filter(0.01) GRANULARITY 1,
+     INDEX idx_res_attr_value mapValues(ResourceAttributes) TYPE bloom_filter(0.01) GRANULARITY 1,
+     INDEX idx_attr_key mapKeys(Attributes) TYPE bloom_filter(0.01) GRANULARITY 1,
+     INDEX idx_attr_value mapValues(Attributes) TYPE bloom_filter(0.01) GRANULARITY 1
+) ENGINE MergeTree()
+%s
+PARTITION BY toDate(TimeUnix)
+ORDER BY (ServiceName, MetricName, toUnixTimestamp64Nano(TimeUnix))
+SETTINGS index_granularity=8192, ttl_only_drop_parts = 1;
+`
+	// language=ClickHouse SQL
+	insertMetricsSQLTemplate = `INSERT INTO %s_%s (%s) VALUES (%s)`
+)
+
+func createMetricsTables(cfg *Config, db *sql.DB) error {
+	for _, table := range metricTables {
+		if _, err := db.Exec(renderCreateMetricsTableSQL(cfg, table)); err != nil {
+			return fmt.Errorf("exec create metrics %s table sql: %w", table.suffix, err)
+		}
+	}
+	return nil
+}
+
+func renderCreateMetricsTableSQL(cfg *Config, table *metricTable) string {
+	return fmt.Sprintf(withCodec(createMetricsTableSQL, cfg.MetricsTable), cfg.MetricsTableName, table.suffix,
+		withCodec(table.columns, cfg.MetricsTable), cfg.ttlExpr(cfg.MetricsTable, "TimeUnix"))
+}
+
+func renderInsertMetricsSQL(cfg *Config, table *metricTable) string {
+	columns := make([]string, 0, len(metricsCommonInsertColumns)+len(table.insertColumns)+len(metricsExemplarsInsertColumns))
+	columns = append(columns, metricsCommonInsertColumns...)
+	columns = append(columns, table.insertColumns...)
+	columns = append(columns, metricsExemplarsInsertColumns...)
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+	return fmt.Sprintf(insertMetricsSQLTemplate, cfg.MetricsTableName, table.suffix, strings.Join(columns, ", "), placeholders)
+}
@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhouseexporter
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestExporter_pushMetricsData(t *testing.T) {
+	t.Run("push success", func(t *testing.T) {
+		inserts := map[string][][]driver.Value{}
+		initClickhouseTestServer(t, func(query string, values []driver.Value) error {
+			if strings.HasPrefix(query, "INSERT") {
+				table := strings.Fields(query)[2]
+				inserts[table] = append(inserts[table], values)
+			}
+			return nil
+		})
+
+		exporter := newTestMetricsExporter(t, defaultDSN)
+		mustPushMetricsData(t, exporter, simpleMetrics(1))
+		mustPushMetricsData(t, exporter, simpleMetrics(2))
+
+		for _, table := range []string{"otel_metrics_gauge", "otel_metrics_sum", "otel_metrics_histogram",
+			"otel_metrics_exponential_histogram", "otel_metrics_summary"} {
+			assert.Len(t, inserts[table], 3, table)
+		}
+
+		// the exponential histogram data point, with its buckets and exemplar
+		values := inserts["otel_metrics_exponential_histogram"][0]
+		require.Len(t, values, len(metricsCommonInsertColumns)+len(exponentialHistogramTable.insertColumns)+len(metricsExemplarsInsertColumns))
+		assert.Equal(t, "test-service", values[6])
+		assert.Equal(t, "exponential_histogram", values[7])
+		specific := values[len(metricsCommonInsertColumns):]
+		assert.Equal(t, uint64(5), specific[0])
+		assert.Equal(t, int32(2), specific[2])
+		assert.Equal(t, int32(-1), specific[4])
+		assert.Equal(t, []uint64{1, 2}, specific[5])
+		assert.Equal(t, 0.5, specific[8])
+		assert.Nil(t, specific[9])
+		exemplars := specific[len(exponentialHistogramTable.insertColumns):]
+		assert.Equal(t, []float64{1.5}, exemplars[2])
+		assert.Equal(t, []string{"0102030405060708"}, exemplars[3])
+		assert.Equal(t, []string{"0102030405060708090a0b0c0d0e0f10"}, exemplars[4])
+	})
+}
+
+func TestRenderCreateMetricsTableSQL(t *testing.T) {
+	ttlDays := uint(30)
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.MetricsTable = TableSettings{TTLDays: &ttlDays, Codec: "LZ4"}
+	})
+
+	query := renderCreateMetricsTableSQL(cfg, exponentialHistogramTable)
+
+	assert.Contains(t, query, "CREATE TABLE IF NOT EXISTS otel_metrics_exponential_histogram (")
+	assert.Contains(t, query, "PositiveBucketCounts Array(UInt64) CODEC(LZ4),")
+	assert.Contains(t, query, "TimeUnix DateTime64(9) CODEC(Delta, LZ4),")
+	assert.Contains(t, query, "TTL toDateTime(TimeUnix) + toIntervalDay(30)")
+	assert.NotContains(t, query, "ZSTD(1)")
+}
+
+func TestRenderInsertMetricsSQL(t *testing.T) {
+	query := renderInsertMetricsSQL(withDefaultConfig(), gaugeTable)
+
+	assert.True(t, strings.HasPrefix(query, "INSERT INTO otel_metrics_gauge (ResourceAttributes, "))
+	assert.Contains(t, query, "Flags, Value, Exemplars.FilteredAttributes, ")
+	assert.Equal(t, len(metricsCommonInsertColumns)+1+len(metricsExemplarsInsertColumns), strings.Count(query, "?"))
+}
+
+func newTestMetricsExporter(t *testing.T, dsn string, fns ...func(*Config)) *metricsExporter {
+	exporter, err := newMetricsExporter(zaptest.NewLogger(t), withTestExporterConfig(fns...)(dsn))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = exporter.Shutdown(context.TODO()) })
+	return exporter
+}
+
+func simpleMetrics(count int) pmetric.Metrics {
+	metrics := pmetric.NewMetrics()
+	rm := metrics.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr(conventions.AttributeServiceName, "test-service")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("test-scope")
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for i := 0; i < count; i++ {
+		gauge := sm.Metrics().AppendEmpty()
+		gauge.SetName("gauge")
+		dp := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(now)
+		dp.SetIntValue(1)
+		appendExemplar(dp.Exemplars())
+
+		sum := sm.Metrics().AppendEmpty()
+		sum.SetName("sum")
+		sum.SetEmptySum().SetIsMonotonic(true)
+		sum.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		dp = sum.Sum().DataPoints().AppendEmpty()
+		dp.SetTimestamp(now)
+		dp.SetDoubleValue(1.5)
+
+		histogram := sm.Metrics().AppendEmpty()
+		histogram.SetName("histogram")
+		hdp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+		hdp.SetTimestamp(now)
+		hdp.SetCount(3)
+		hdp.SetSum(6)
+		hdp.BucketCounts().FromRaw([]uint64{1, 2})
+		hdp.ExplicitBounds().FromRaw([]float64{2})
+		appendExemplar(hdp.Exemplars())
+
+		expHistogram := sm.Metrics().AppendEmpty()
+		expHistogram.SetName("exponential_histogram")
+		ehdp := expHistogram.SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+		ehdp.SetTimestamp(now)
+		ehdp.SetCount(5)
+		ehdp.SetSum(10)
+		ehdp.SetScale(2)
+		ehdp.SetZeroCount(1)
+		ehdp.Positive().SetOffset(-1)
+		ehdp.Positive().BucketCounts().FromRaw([]uint64{1, 2})
+		ehdp.Negative().BucketCounts().FromRaw([]uint64{1})
+		ehdp.SetMin(0.5)
+		appendExemplar(ehdp.Exemplars())
+
+		summary := sm.Metrics().AppendEmpty()
+		summary.SetName("summary")
+		sdp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+		sdp.SetTimestamp(now)
+		sdp.SetCount(2)
+		sdp.SetSum(3)
+		quantile := sdp.QuantileValues().AppendEmpty()
+		quantile.SetQuantile(0.99)
+		quantile.SetValue(2)
+	}
+	return metrics
+}
+
+func appendExemplar(exemplars pmetric.ExemplarSlice) {
+	exemplar := exemplars.AppendEmpty()
+	exemplar.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	exemplar.SetDoubleValue(1.5)
+	exemplar.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	exemplar.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	exemplar.FilteredAttributes().PutStr("user.id", "42")
+}
+
+func mustPushMetricsData(t *testing.T, exporter *metricsExporter, md pmetric.Metrics) {
+	err := exporter.pushMetricsData(context.TODO(), md)
+	require.NoError(t, err)
+}
@@ -290,19 +290,11 @@ func renderInsertTracesSQL(cfg *Config) string {
 }
 
 func renderCreateTracesTableSQL(cfg *Config) string {
-	var ttlExpr string
-	if cfg.TTLDays > 0 {
-		ttlExpr = fmt.Sprintf(`TTL toDateTime(Timestamp) + toIntervalDay(%d)`, cfg.TTLDays)
-	}
-	return fmt.Sprintf(createTracesTableSQL, cfg.TracesTableName, ttlExpr)
+	return fmt.Sprintf(withCodec(createTracesTableSQL, cfg.TracesTable), cfg.TracesTableName, cfg.ttlExpr(cfg.TracesTable, "Timestamp"))
 }
 
 func renderCreateTraceIDTsTableSQL(cfg *Config) string {
-	var ttlExpr string
-	if cfg.TTLDays > 0 {
-		ttlExpr = fmt.Sprintf(`TTL toDateTime(Start) + toIntervalDay(%d)`, cfg.TTLDays)
-	}
-	return fmt.Sprintf(createTraceIDTsTableSQL, cfg.TracesTableName, ttlExpr)
+	return fmt.Sprintf(withCodec(createTraceIDTsTableSQL, cfg.TracesTable), cfg.TracesTableName, cfg.ttlExpr(cfg.TracesTable, "Start"))
 }
 
 func renderTraceIDTsMaterializedViewSQL(cfg *Config) string {
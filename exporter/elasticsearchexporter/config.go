@@ -58,6 +58,23 @@ type Config struct {
 	// This setting is required when traces pipelines used.
 	TracesIndex string `mapstructure:"traces_index"`
 
+	// DataStream configures the routing of the events to data streams, overriding the index settings.
+	//
+	// https://www.elastic.co/guide/en/fleet/current/data-streams.html#data-streams-naming-scheme
+	DataStream DataStreamSettings `mapstructure:"data_stream"`
+
+	// ILM configures the rollover of the logs and traces indices by an index lifecycle policy,
+	// the index settings being used as rollover aliases.
+	//
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/getting-started-index-lifecycle-management.html
+	ILM ILMSettings `mapstructure:"ilm"`
+
+	// RoutingAttribute is the attribute, looked up on the event and then on its resource, whose value
+	// is used as the routing key of the document.
+	//
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/mapping-routing-field.html
+	RoutingAttribute string `mapstructure:"routing_attribute"`
+
 	// Pipeline configures the ingest node pipeline name that should be used to process the
 	// events.
 	//
@@ -104,6 +121,30 @@ type AuthenticationSettings struct {
 	APIKey string `mapstructure:"api_key"`
 }
 
+// DataStreamSettings defines the routing of the events to the data streams named
+// after their data_stream.type, data_stream.dataset and data_stream.namespace attributes,
+// looked up on the event and then on its resource.
+type DataStreamSettings struct {
+	// Enabled routes the events to the data stream of their attributes.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Dataset is the dataset of the events without data_stream.dataset attribute.
+	Dataset string `mapstructure:"dataset"`
+
+	// Namespace is the namespace of the events without data_stream.namespace attribute.
+	Namespace string `mapstructure:"namespace"`
+}
+
+// ILMSettings defines the index lifecycle management related settings.
+type ILMSettings struct {
+	// Enabled makes the exporter write to the rollover aliases of the indices, bootstrapping
+	// the index template and the first index of each alias on start.
+	Enabled bool `mapstructure:"enabled"`
+
+	// PolicyName is the name of the existing lifecycle policy managing the indices.
+	PolicyName string `mapstructure:"policy_name"`
+}
+
 // DiscoverySettings defines Elasticsearch node discovery related settings.
 // The exporter will check Elasticsearch regularly for available nodes
 // and updates the list of hosts if discovery is enabled. Newly discovered
@@ -177,6 +218,8 @@ const (
 var (
 	errConfigNoEndpoint    = errors.New("endpoints or cloudid must be specified")
 	errConfigEmptyEndpoint = errors.New("endpoints must not include empty entries")
+	errConfigNoILMPolicy   = errors.New("ilm policy_name must be specified")
+	errConfigILMDataStream = errors.New("ilm can't be enabled with data streams, their lifecycle is managed by their index template")
 )
 
 func (m MappingMode) String() string {
@@ -226,5 +269,14 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("unknown mapping mode %v", cfg.Mapping.Mode)
 	}
 
+	if cfg.ILM.Enabled {
+		if cfg.ILM.PolicyName == "" {
+			return errConfigNoILMPolicy
+		}
+		if cfg.DataStream.Enabled {
+			return errConfigILMDataStream
+		}
+	}
+
 	return nil
 }
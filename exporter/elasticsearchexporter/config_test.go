@@ -45,6 +45,10 @@ func TestLoad_DeprecatedIndexConfigOption(t *testing.T) {
 		LogsIndex:        "logs-generic-default",
 		TracesIndex:      "traces-generic-default",
 		Pipeline:         "mypipeline",
+		DataStream: DataStreamSettings{
+			Dataset:   "generic",
+			Namespace: "default",
+		},
 		HTTPClientSettings: HTTPClientSettings{
 			Authentication: AuthenticationSettings{
 				User:     "elastic",
@@ -102,6 +106,10 @@ func TestLoadConfig(t *testing.T) {
 		LogsIndex:        "logs-generic-default",
 		TracesIndex:      "trace_index",
 		Pipeline:         "mypipeline",
+		DataStream: DataStreamSettings{
+			Dataset:   "generic",
+			Namespace: "default",
+		},
 		HTTPClientSettings: HTTPClientSettings{
 			Authentication: AuthenticationSettings{
 				User:     "elastic",
@@ -141,6 +149,10 @@ func TestLoadConfig(t *testing.T) {
 		LogsIndex:        "my_log_index",
 		TracesIndex:      "traces-generic-default",
 		Pipeline:         "mypipeline",
+		DataStream: DataStreamSettings{
+			Dataset:   "generic",
+			Namespace: "default",
+		},
 		HTTPClientSettings: HTTPClientSettings{
 			Authentication: AuthenticationSettings{
 				User:     "elastic",
@@ -173,6 +185,44 @@ func TestLoadConfig(t *testing.T) {
 
 }
 
+func TestConfig_Validate(t *testing.T) {
+	tests := map[string]struct {
+		config *Config
+		err    error
+	}{
+		"data streams": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.DataStream.Enabled = true
+			}),
+		},
+		"ilm": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.ILM = ILMSettings{Enabled: true, PolicyName: "otel"}
+			}),
+		},
+		"ilm without policy": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.ILM.Enabled = true
+			}),
+			err: errConfigNoILMPolicy,
+		},
+		"ilm with data streams": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.ILM = ILMSettings{Enabled: true, PolicyName: "otel"}
+				cfg.DataStream.Enabled = true
+			}),
+			err: errConfigILMDataStream,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.config.Endpoints = []string{"http://localhost:9200"}
+			assert.Equal(t, test.err, test.config.Validate())
+		})
+	}
+}
+
 func withDefaultConfig(fns ...func(*Config)) *Config {
 	cfg := createDefaultConfig().(*Config)
 	for _, fn := range fns {
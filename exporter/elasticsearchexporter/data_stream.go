@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+const (
+	dataStreamTypeAttribute      = "data_stream.type"
+	dataStreamDatasetAttribute   = "data_stream.dataset"
+	dataStreamNamespaceAttribute = "data_stream.namespace"
+
+	dataStreamTypeLogs   = "logs"
+	dataStreamTypeTraces = "traces"
+
+	defaultDataStreamDataset   = "generic"
+	defaultDataStreamNamespace = "default"
+
+	// maxDataStreamFieldLength is the maximum length of the type, dataset and namespace of a data stream.
+	maxDataStreamFieldLength = 100
+)
+
+var (
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/reroute-processor.html
+	dataStreamNamespaceReplacer = strings.NewReplacer(`\`, "_", "/", "_", "*", "_", "?", "_", `"`, "_",
+		"<", "_", ">", "_", "|", "_", " ", "_", ",", "_", "#", "_", ":", "_")
+	dataStreamDatasetReplacer = strings.NewReplacer(`\`, "_", "/", "_", "*", "_", "?", "_", `"`, "_",
+		"<", "_", ">", "_", "|", "_", " ", "_", ",", "_", "#", "_", ":", "_", "-", "_")
+)
+
+// dataStream identifies the data stream of an event.
+type dataStream struct {
+	typ       string
+	dataset   string
+	namespace string
+}
+
+// index returns the name of the data stream, following the {type}-{dataset}-{namespace} naming scheme.
+func (ds dataStream) index() string {
+	return ds.typ + "-" + ds.dataset + "-" + ds.namespace
+}
+
+// dataStreamRouter routes the events to the data stream of their attributes.
+type dataStreamRouter struct {
+	typ       string
+	dataset   string
+	namespace string
+}
+
+func newDataStreamRouter(typ string, settings DataStreamSettings) *dataStreamRouter {
+	router := &dataStreamRouter{
+		typ:       typ,
+		dataset:   settings.Dataset,
+		namespace: settings.Namespace,
+	}
+	if router.dataset == "" {
+		router.dataset = defaultDataStreamDataset
+	}
+	if router.namespace == "" {
+		router.namespace = defaultDataStreamNamespace
+	}
+	return router
+}
+
+// route returns the data stream of the event, from the attributes of the event and then of its resource,
+// the values being sanitized to be valid in a data stream name.
+func (r *dataStreamRouter) route(attributes pcommon.Map, resource pcommon.Resource) dataStream {
+	lookup := func(name, fallback string) string {
+		if value, ok := attributes.Get(name); ok && value.AsString() != "" {
+			return value.AsString()
+		}
+		if value, ok := resource.Attributes().Get(name); ok && value.AsString() != "" {
+			return value.AsString()
+		}
+		return fallback
+	}
+
+	return dataStream{
+		typ:       sanitizeDataStreamField(lookup(dataStreamTypeAttribute, r.typ), dataStreamNamespaceReplacer),
+		dataset:   sanitizeDataStreamField(lookup(dataStreamDatasetAttribute, r.dataset), dataStreamDatasetReplacer),
+		namespace: sanitizeDataStreamField(lookup(dataStreamNamespaceAttribute, r.namespace), dataStreamNamespaceReplacer),
+	}
+}
+
+// sanitizeDataStreamField lower cases the value, replaces the characters that are not allowed in
+// a data stream name, and truncates it.
+func sanitizeDataStreamField(value string, replacer *strings.Replacer) string {
+	value = replacer.Replace(strings.ToLower(value))
+	if len(value) > maxDataStreamFieldLength {
+		value = value[:maxDataStreamFieldLength]
+	}
+	return value
+}
+
+// routingKey returns the value of the attribute, looked up on the event and then on its resource,
+// or an empty string if the attribute isn't set.
+func routingKey(attribute string, attributes pcommon.Map, resource pcommon.Resource) string {
+	if attribute == "" {
+		return ""
+	}
+	if value, ok := attributes.Get(attribute); ok {
+		return value.AsString()
+	}
+	if value, ok := resource.Attributes().Get(attribute); ok {
+		return value.AsString()
+	}
+	return ""
+}
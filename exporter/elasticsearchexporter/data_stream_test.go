@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchexporter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestDataStreamRouter_Route(t *testing.T) {
+	tests := map[string]struct {
+		settings   DataStreamSettings
+		attributes map[string]string
+		resource   map[string]string
+		want       string
+	}{
+		"defaults": {
+			want: "logs-generic-default",
+		},
+		"configured defaults": {
+			settings: DataStreamSettings{Dataset: "otel", Namespace: "prod"},
+			want:     "logs-otel-prod",
+		},
+		"resource attributes": {
+			resource: map[string]string{"data_stream.dataset": "nginx.access", "data_stream.namespace": "prod"},
+			want:     "logs-nginx.access-prod",
+		},
+		"event attributes override resource attributes": {
+			attributes: map[string]string{"data_stream.dataset": "nginx.error"},
+			resource:   map[string]string{"data_stream.dataset": "nginx.access", "data_stream.namespace": "prod"},
+			want:       "logs-nginx.error-prod",
+		},
+		"type attribute": {
+			attributes: map[string]string{"data_stream.type": "synthetics"},
+			want:       "synthetics-generic-default",
+		},
+		"empty attributes": {
+			attributes: map[string]string{"data_stream.dataset": ""},
+			want:       "logs-generic-default",
+		},
+		"sanitized attributes": {
+			attributes: map[string]string{"data_stream.dataset": "My-App/Access", "data_stream.namespace": "Team A:prod-1"},
+			want:       "logs-my_app_access-team_a_prod-1",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			attributes := pcommon.NewMap()
+			for k, v := range test.attributes {
+				attributes.PutStr(k, v)
+			}
+			resource := pcommon.NewResource()
+			for k, v := range test.resource {
+				resource.Attributes().PutStr(k, v)
+			}
+
+			router := newDataStreamRouter(dataStreamTypeLogs, test.settings)
+			assert.Equal(t, test.want, router.route(attributes, resource).index())
+		})
+	}
+}
+
+func TestDataStreamRouter_RouteTruncates(t *testing.T) {
+	attributes := pcommon.NewMap()
+	attributes.PutStr("data_stream.namespace", strings.Repeat("a", 150))
+
+	router := newDataStreamRouter(dataStreamTypeTraces, DataStreamSettings{})
+	ds := router.route(attributes, pcommon.NewResource())
+	assert.Len(t, ds.namespace, maxDataStreamFieldLength)
+	assert.Equal(t, "traces", ds.typ)
+}
+
+func TestRoutingKey(t *testing.T) {
+	attributes := pcommon.NewMap()
+	attributes.PutStr("tenant", "event-tenant")
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("tenant", "resource-tenant")
+	resource.Attributes().PutStr("host.name", "host-1")
+
+	assert.Equal(t, "", routingKey("", attributes, resource))
+	assert.Equal(t, "event-tenant", routingKey("tenant", attributes, resource))
+	assert.Equal(t, "host-1", routingKey("host.name", attributes, resource))
+	assert.Equal(t, "", routingKey("missing", attributes, resource))
+}
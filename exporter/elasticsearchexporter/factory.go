@@ -58,6 +58,10 @@ func createDefaultConfig() config.Exporter {
 			InitialInterval: 100 * time.Millisecond,
 			MaxInterval:     1 * time.Minute,
 		},
+		DataStream: DataStreamSettings{
+			Dataset:   defaultDataStreamDataset,
+			Namespace: defaultDataStreamNamespace,
+		},
 		Mapping: MappingsSettings{
 			Mode:  "ecs",
 			Dedup: true,
@@ -88,6 +92,7 @@ func createLogsExporter(
 		set,
 		cfg,
 		exporter.pushLogsData,
+		exporterhelper.WithStart(exporter.Start),
 		exporterhelper.WithShutdown(exporter.Shutdown),
 	)
 }
@@ -101,5 +106,6 @@ func createTracesExporter(ctx context.Context,
 		return nil, fmt.Errorf("cannot configure Elasticsearch traces exporter: %w", err)
 	}
 	return exporterhelper.NewTracesExporter(ctx, set, cfg, exporter.pushTraceData,
+		exporterhelper.WithStart(exporter.Start),
 		exporterhelper.WithShutdown(exporter.Shutdown))
 }
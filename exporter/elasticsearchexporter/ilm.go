@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	esapi7 "github.com/elastic/go-elasticsearch/v7/esapi"
+	"go.uber.org/zap"
+)
+
+const (
+	// ilmIndexTemplatePriority is higher than the priority of the built-in templates of Elasticsearch,
+	// e.g. the logs-*-* template creating data streams, so that the indices of the alias match the template.
+	ilmIndexTemplatePriority = 200
+
+	// ilmFirstIndexSuffix is the suffix of the first index of a rollover alias, incremented by each rollover.
+	ilmFirstIndexSuffix = "-000001"
+)
+
+// bootstrapILM creates the index template setting the lifecycle policy of the indices of the rollover
+// alias, and the first index of the alias if the alias doesn't exist yet.
+func bootstrapILM(ctx context.Context, logger *zap.Logger, client *esClientCurrent, alias string, policyName string) error {
+	template := map[string]interface{}{
+		"index_patterns": []string{alias + "-*"},
+		"priority":       ilmIndexTemplatePriority,
+		"template": map[string]interface{}{
+			"settings": map[string]interface{}{
+				"index.lifecycle.name":           policyName,
+				"index.lifecycle.rollover_alias": alias,
+			},
+		},
+	}
+	body, err := json.Marshal(template)
+	if err != nil {
+		return err
+	}
+	resp, err := esapi7.IndicesPutIndexTemplateRequest{Name: alias, Body: bytes.NewReader(body)}.Do(ctx, client)
+	if err = checkResponse(resp, err); err != nil {
+		return fmt.Errorf("failed to create the index template of the rollover alias %q: %w", alias, err)
+	}
+
+	resp, err = esapi7.IndicesExistsAliasRequest{Name: []string{alias}}.Do(ctx, client)
+	if err != nil {
+		return fmt.Errorf("failed to check the rollover alias %q: %w", alias, err)
+	}
+	closeResponse(resp)
+	if resp.StatusCode == http.StatusOK {
+		logger.Debug("The rollover alias already exists", zap.String("alias", alias))
+		return nil
+	}
+
+	body, err = json.Marshal(map[string]interface{}{
+		"aliases": map[string]interface{}{
+			alias: map[string]interface{}{"is_write_index": true},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	resp, err = esapi7.IndicesCreateRequest{Index: alias + ilmFirstIndexSuffix, Body: bytes.NewReader(body)}.Do(ctx, client)
+	if err = checkResponse(resp, err); err != nil {
+		// the index may have been created concurrently, e.g. by another collector
+		if strings.Contains(err.Error(), "resource_already_exists_exception") {
+			return nil
+		}
+		return fmt.Errorf("failed to create the first index of the rollover alias %q: %w", alias, err)
+	}
+	logger.Info("Bootstrapped the rollover alias", zap.String("alias", alias), zap.String("index", alias+ilmFirstIndexSuffix))
+	return nil
+}
+
+// checkResponse returns an error if the request failed, and closes the body of the response.
+func checkResponse(resp *esapi7.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	defer closeResponse(resp)
+	if resp.IsError() {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s: %s", resp.Status(), msg)
+	}
+	return nil
+}
+
+func closeResponse(resp *esapi7.Response) {
+	if resp.Body != nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchexporter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ilmRecorder struct {
+	mu       sync.Mutex
+	requests map[string]map[string]interface{}
+}
+
+func newILMTestServer(t *testing.T, aliasExists bool, createStatus int) (*httptest.Server, *ilmRecorder) {
+	rec := &ilmRecorder{requests: map[string]map[string]interface{}{}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("X-Elastic-Product", "Elasticsearch")
+
+		body := map[string]interface{}{}
+		if data, _ := io.ReadAll(req.Body); len(data) > 0 {
+			if err := json.Unmarshal(data, &body); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		rec.mu.Lock()
+		rec.requests[req.Method+" "+req.URL.Path] = body
+		rec.mu.Unlock()
+
+		switch {
+		case req.URL.Path == "/":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"version": map[string]interface{}{"number": currentESVersion},
+			})
+		case req.Method == http.MethodHead && req.URL.Path == "/_alias/otel-logs":
+			if !aliasExists {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case req.Method == http.MethodPut && req.URL.Path == "/otel-logs-000001":
+			w.WriteHeader(createStatus)
+			if createStatus != http.StatusOK {
+				_, _ = w.Write([]byte(`{"error":{"type":"resource_already_exists_exception"}}`))
+			}
+		case req.Method == http.MethodPut && req.URL.Path == "/_index_template/otel-logs":
+		default:
+			http.Error(w, "unexpected request", http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, rec
+}
+
+func TestBootstrapILM(t *testing.T) {
+	tests := map[string]struct {
+		aliasExists  bool
+		createStatus int
+		wantCreated  bool
+	}{
+		"new alias": {
+			createStatus: http.StatusOK,
+			wantCreated:  true,
+		},
+		"existing alias": {
+			aliasExists: true,
+		},
+		"alias created concurrently": {
+			createStatus: http.StatusBadRequest,
+			wantCreated:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server, rec := newILMTestServer(t, test.aliasExists, test.createStatus)
+			exporter := newTestExporter(t, server.URL, func(cfg *Config) {
+				cfg.LogsIndex = "otel-logs"
+				cfg.ILM = ILMSettings{Enabled: true, PolicyName: "otel-policy"}
+			})
+
+			// test
+			err := exporter.Start(context.Background(), nil)
+
+			// verify
+			require.NoError(t, err)
+			rec.mu.Lock()
+			defer rec.mu.Unlock()
+
+			template, ok := rec.requests["PUT /_index_template/otel-logs"]
+			require.True(t, ok)
+			assert.Equal(t, []interface{}{"otel-logs-*"}, template["index_patterns"])
+			assert.Equal(t, map[string]interface{}{
+				"settings": map[string]interface{}{
+					"index.lifecycle.name":           "otel-policy",
+					"index.lifecycle.rollover_alias": "otel-logs",
+				},
+			}, template["template"])
+
+			index, created := rec.requests["PUT /otel-logs-000001"]
+			require.Equal(t, test.wantCreated, created)
+			if created {
+				assert.Equal(t, map[string]interface{}{
+					"otel-logs": map[string]interface{}{"is_write_index": true},
+				}, index["aliases"])
+			}
+		})
+	}
+}
+
+func TestBootstrapILMFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("X-Elastic-Product", "Elasticsearch")
+		if req.URL.Path == "/" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"version": map[string]interface{}{"number": currentESVersion},
+			})
+			return
+		}
+		http.Error(w, `{"error":"forbidden"}`, http.StatusForbidden)
+	}))
+	t.Cleanup(server.Close)
+
+	exporter := newTestExporter(t, server.URL, func(cfg *Config) {
+		cfg.ILM = ILMSettings{Enabled: true, PolicyName: "otel-policy"}
+	})
+
+	// test
+	err := exporter.Start(context.Background(), nil)
+
+	// verify
+	assert.ErrorContains(t, err, `failed to create the index template of the rollover alias "logs-generic-default"`)
+}
+
+func TestBootstrapILMDisabled(t *testing.T) {
+	exporter := newTestExporter(t, "http://localhost:9200")
+
+	// the exporter doesn't send any request to Elasticsearch
+	assert.NoError(t, exporter.Start(context.Background(), nil))
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/multierr"
@@ -32,6 +33,11 @@ type elasticsearchLogsExporter struct {
 	index       string
 	maxAttempts int
 
+	// dataStream routes the events to data streams if enabled.
+	dataStream       *dataStreamRouter
+	routingAttribute string
+	ilm              ILMSettings
+
 	client      *esClientCurrent
 	bulkIndexer esBulkIndexerCurrent
 	model       mappingModel
@@ -75,10 +81,23 @@ func newLogsExporter(logger *zap.Logger, cfg *Config) (*elasticsearchLogsExporte
 		index:       indexStr,
 		maxAttempts: maxAttempts,
 		model:       model,
+
+		routingAttribute: cfg.RoutingAttribute,
+		ilm:              cfg.ILM,
+	}
+	if cfg.DataStream.Enabled {
+		esLogsExp.dataStream = newDataStreamRouter(dataStreamTypeLogs, cfg.DataStream)
 	}
 	return esLogsExp, nil
 }
 
+func (e *elasticsearchLogsExporter) Start(ctx context.Context, _ component.Host) error {
+	if !e.ilm.Enabled {
+		return nil
+	}
+	return bootstrapILM(ctx, e.logger, e.client, e.index, e.ilm.PolicyName)
+}
+
 func (e *elasticsearchLogsExporter) Shutdown(ctx context.Context) error {
 	return e.bulkIndexer.Close(ctx)
 }
@@ -109,9 +128,17 @@ func (e *elasticsearchLogsExporter) pushLogsData(ctx context.Context, ld plog.Lo
 }
 
 func (e *elasticsearchLogsExporter) pushLogRecord(ctx context.Context, resource pcommon.Resource, record plog.LogRecord) error {
-	document, err := e.model.encodeLog(resource, record)
+	index := e.index
+	var ds *dataStream
+	if e.dataStream != nil {
+		route := e.dataStream.route(record.Attributes(), resource)
+		index, ds = route.index(), &route
+	}
+
+	document, err := e.model.encodeLog(resource, record, ds)
 	if err != nil {
 		return fmt.Errorf("Failed to encode log event: %w", err)
 	}
-	return pushDocuments(ctx, e.logger, e.index, document, e.bulkIndexer, e.maxAttempts)
+	routing := routingKey(e.routingAttribute, record.Attributes(), resource)
+	return pushDocuments(ctx, e.logger, index, routing, document, e.bulkIndexer, e.maxAttempts)
 }
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"runtime"
 	"sync"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
@@ -315,6 +317,51 @@ func TestExporter_PushEvent(t *testing.T) {
 	})
 }
 
+func TestExporter_PushEventDataStream(t *testing.T) {
+	rec := newBulkRecorder()
+	server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+		rec.Record(docs)
+		return itemsAllOK(docs)
+	})
+
+	exporter := newTestExporter(t, server.URL, func(cfg *Config) {
+		cfg.DataStream.Enabled = true
+		cfg.RoutingAttribute = "tenant.id"
+	})
+
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("data_stream.dataset", "nginx.access")
+	rl.Resource().Attributes().PutStr("tenant.id", "tenant-1")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().Body().SetStr("access")
+	errorRecord := records.AppendEmpty()
+	errorRecord.Body().SetStr("error")
+	errorRecord.Attributes().PutStr("data_stream.dataset", "nginx.error")
+	errorRecord.Attributes().PutStr("data_stream.namespace", "Prod")
+
+	require.NoError(t, exporter.pushLogsData(context.TODO(), logs))
+
+	rec.WaitItems(2)
+	var indices []string
+	for _, item := range rec.Items() {
+		var action struct {
+			Create struct {
+				Index   string `json:"_index"`
+				Routing string `json:"routing"`
+			} `json:"create"`
+		}
+		require.NoError(t, json.Unmarshal(item.Action, &action))
+		assert.Equal(t, "tenant-1", action.Create.Routing)
+		indices = append(indices, action.Create.Index)
+
+		var doc map[string]interface{}
+		require.NoError(t, json.Unmarshal(item.Document, &doc))
+		assert.Equal(t, action.Create.Index, fmt.Sprintf("%v-%v-%v", doc["data_stream.type"], doc["data_stream.dataset"], doc["data_stream.namespace"]))
+	}
+	assert.ElementsMatch(t, []string{"logs-nginx.access-default", "logs-nginx.error-prod"}, indices)
+}
+
 func newTestExporter(t *testing.T, url string, fns ...func(*Config)) *elasticsearchLogsExporter {
 	exporter, err := newLogsExporter(zaptest.NewLogger(t), withTestExporterConfig(fns...)(url))
 	require.NoError(t, err)
@@ -339,6 +386,6 @@ func withTestExporterConfig(fns ...func(*Config)) func(string) *Config {
 }
 
 func mustSend(t *testing.T, exporter *elasticsearchLogsExporter, contents string) {
-	err := pushDocuments(context.TODO(), zap.L(), exporter.index, []byte(contents), exporter.bulkIndexer, exporter.maxAttempts)
+	err := pushDocuments(context.TODO(), zap.L(), exporter.index, "", []byte(contents), exporter.bulkIndexer, exporter.maxAttempts)
 	require.NoError(t, err)
 }
@@ -26,8 +26,10 @@ import (
 )
 
 type mappingModel interface {
-	encodeLog(pcommon.Resource, plog.LogRecord) ([]byte, error)
-	encodeSpan(pcommon.Resource, ptrace.Span) ([]byte, error)
+	// encodeLog encodes the log record, with the fields of its data stream if ds isn't nil.
+	encodeLog(resource pcommon.Resource, record plog.LogRecord, ds *dataStream) ([]byte, error)
+	// encodeSpan encodes the span, with the fields of its data stream if ds isn't nil.
+	encodeSpan(resource pcommon.Resource, span ptrace.Span, ds *dataStream) ([]byte, error)
 }
 
 // encodeModel tries to keep the event as close to the original open telemetry semantics as is.
@@ -47,7 +49,7 @@ const (
 	attributeField = "attribute"
 )
 
-func (m *encodeModel) encodeLog(resource pcommon.Resource, record plog.LogRecord) ([]byte, error) {
+func (m *encodeModel) encodeLog(resource pcommon.Resource, record plog.LogRecord, ds *dataStream) ([]byte, error) {
 	var document objmodel.Document
 	document.AddTimestamp("@timestamp", record.Timestamp()) // We use @timestamp in order to ensure that we can index if the default data stream logs template is used.
 	document.AddID("TraceId", record.TraceID())
@@ -58,6 +60,7 @@ func (m *encodeModel) encodeLog(resource pcommon.Resource, record plog.LogRecord
 	document.AddAttribute("Body", record.Body())
 	document.AddAttributes("Attributes", record.Attributes())
 	document.AddAttributes("Resource", resource.Attributes())
+	addDataStreamFields(&document, ds)
 
 	if m.dedup {
 		document.Dedup()
@@ -70,7 +73,7 @@ func (m *encodeModel) encodeLog(resource pcommon.Resource, record plog.LogRecord
 	return buf.Bytes(), err
 }
 
-func (m *encodeModel) encodeSpan(resource pcommon.Resource, span ptrace.Span) ([]byte, error) {
+func (m *encodeModel) encodeSpan(resource pcommon.Resource, span ptrace.Span, ds *dataStream) ([]byte, error) {
 	var document objmodel.Document
 	document.AddTimestamp("@timestamp", span.StartTimestamp()) // We use @timestamp in order to ensure that we can index if the default data stream logs template is used.
 	document.AddTimestamp("EndTimestamp", span.EndTimestamp())
@@ -83,6 +86,7 @@ func (m *encodeModel) encodeSpan(resource pcommon.Resource, span ptrace.Span) ([
 	document.AddString("Link", spanLinksToString(span.Links()))
 	document.AddAttributes("Attributes", span.Attributes())
 	document.AddAttributes("Resource", resource.Attributes())
+	addDataStreamFields(&document, ds)
 
 	if m.dedup {
 		document.Dedup()
@@ -95,6 +99,17 @@ func (m *encodeModel) encodeSpan(resource pcommon.Resource, span ptrace.Span) ([
 	return buf.Bytes(), err
 }
 
+// addDataStreamFields adds the fields identifying the data stream of the event, which must match
+// the data stream the event is indexed in.
+func addDataStreamFields(document *objmodel.Document, ds *dataStream) {
+	if ds == nil {
+		return
+	}
+	document.AddString(dataStreamTypeAttribute, ds.typ)
+	document.AddString(dataStreamDatasetAttribute, ds.dataset)
+	document.AddString(dataStreamNamespaceAttribute, ds.namespace)
+}
+
 func spanLinksToString(spanLinkSlice ptrace.SpanLinkSlice) string {
 	linkArray := make([]map[string]interface{}, 0, spanLinkSlice.Len())
 	for i := 0; i < spanLinkSlice.Len(); i++ {
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/multierr"
@@ -32,6 +33,11 @@ type elasticsearchTracesExporter struct {
 	index       string
 	maxAttempts int
 
+	// dataStream routes the events to data streams if enabled.
+	dataStream       *dataStreamRouter
+	routingAttribute string
+	ilm              ILMSettings
+
 	client      *esClientCurrent
 	bulkIndexer esBulkIndexerCurrent
 	model       mappingModel
@@ -60,7 +66,7 @@ func newTracesExporter(logger *zap.Logger, cfg *Config) (*elasticsearchTracesExp
 	// TODO: Apply encoding and field mapping settings.
 	model := &encodeModel{dedup: true, dedot: false}
 
-	return &elasticsearchTracesExporter{
+	esTracesExp := &elasticsearchTracesExporter{
 		logger:      logger,
 		client:      client,
 		bulkIndexer: bulkIndexer,
@@ -68,7 +74,21 @@ func newTracesExporter(logger *zap.Logger, cfg *Config) (*elasticsearchTracesExp
 		index:       cfg.TracesIndex,
 		maxAttempts: maxAttempts,
 		model:       model,
-	}, nil
+
+		routingAttribute: cfg.RoutingAttribute,
+		ilm:              cfg.ILM,
+	}
+	if cfg.DataStream.Enabled {
+		esTracesExp.dataStream = newDataStreamRouter(dataStreamTypeTraces, cfg.DataStream)
+	}
+	return esTracesExp, nil
+}
+
+func (e *elasticsearchTracesExporter) Start(ctx context.Context, _ component.Host) error {
+	if !e.ilm.Enabled {
+		return nil
+	}
+	return bootstrapILM(ctx, e.logger, e.client, e.index, e.ilm.PolicyName)
 }
 
 func (e *elasticsearchTracesExporter) Shutdown(ctx context.Context) error {
@@ -102,9 +122,17 @@ func (e *elasticsearchTracesExporter) pushTraceData(
 }
 
 func (e *elasticsearchTracesExporter) pushTraceRecord(ctx context.Context, resource pcommon.Resource, span ptrace.Span) error {
-	document, err := e.model.encodeSpan(resource, span)
+	index := e.index
+	var ds *dataStream
+	if e.dataStream != nil {
+		route := e.dataStream.route(span.Attributes(), resource)
+		index, ds = route.index(), &route
+	}
+
+	document, err := e.model.encodeSpan(resource, span, ds)
 	if err != nil {
 		return fmt.Errorf("Failed to encode trace record: %w", err)
 	}
-	return pushDocuments(ctx, e.logger, e.index, document, e.bulkIndexer, e.maxAttempts)
+	routing := routingKey(e.routingAttribute, span.Attributes(), resource)
+	return pushDocuments(ctx, e.logger, index, routing, document, e.bulkIndexer, e.maxAttempts)
 }
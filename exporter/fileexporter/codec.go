@@ -14,7 +14,12 @@
 
 package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
 
-import "github.com/klauspost/compress/zstd"
+import (
+	"bytes"
+	"compress/gzip"
+
+	"github.com/klauspost/compress/zstd"
+)
 
 // compressFunc defines how to compress encoded telemetry data.
 type compressFunc func(src []byte) []byte
@@ -23,6 +28,7 @@ var encoder, _ = zstd.NewWriter(nil)
 
 var encoders = map[string]compressFunc{
 	compressionZSTD: zstdCompress,
+	compressionGZIP: gzipCompress,
 }
 
 func buildCompressor(compression string) compressFunc {
@@ -37,6 +43,16 @@ func zstdCompress(src []byte) []byte {
 	return encoder.EncodeAll(src, make([]byte, 0, len(src)))
 }
 
+// gzipCompress compress a buffer with gzip
+func gzipCompress(src []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	// writing to a bytes.Buffer can't fail
+	_, _ = w.Write(src)
+	_ = w.Close()
+	return buf.Bytes()
+}
+
 // noneCompress return src
 func noneCompress(src []byte) []byte {
 	return src
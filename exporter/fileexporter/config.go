@@ -16,6 +16,7 @@ package fileexporter // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/confmap"
@@ -43,7 +44,7 @@ type Config struct {
 	FormatType string `mapstructure:"format"`
 
 	// Compression Codec used to export telemetry data
-	// Supported compression algorithms:`zstd`
+	// Supported compression algorithms:`zstd`, `gzip`
 	Compression string `mapstructure:"compression"`
 }
 
@@ -68,6 +69,11 @@ type Rotation struct {
 	// backup files is the computer's local time.  The default is to use UTC
 	// time.
 	LocalTime bool `mapstructure:"localtime"`
+
+	// Interval is the maximum time after which the file is rotated, whatever its size.
+	// The file is not rotated if nothing was written to it since the last rotation.
+	// The default is to only rotate the file based on its size.
+	Interval time.Duration `mapstructure:"interval"`
 }
 
 var _ config.Exporter = (*Config)(nil)
@@ -80,9 +86,12 @@ func (cfg *Config) Validate() error {
 	if cfg.FormatType != formatTypeJSON && cfg.FormatType != formatTypeProto {
 		return errors.New("format type is not supported")
 	}
-	if cfg.Compression != "" && cfg.Compression != compressionZSTD {
+	if _, ok := encoders[cfg.Compression]; cfg.Compression != "" && !ok {
 		return errors.New("compression is not supported")
 	}
+	if cfg.Rotation != nil && cfg.Rotation.Interval < 0 {
+		return errors.New("rotation interval must not be negative")
+	}
 	return nil
 }
 
@@ -17,6 +17,7 @@ package fileexporter
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -64,6 +65,20 @@ func TestLoadConfig(t *testing.T) {
 				Compression: compressionZSTD,
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "4"),
+			expected: &Config{
+				ExporterSettings: config.NewExporterSettings(config.NewComponentID(typeStr)),
+				Path:             "./filename",
+				Rotation: &Rotation{
+					MaxMegabytes: 100,
+					MaxBackups:   defaultMaxBackups,
+					Interval:     time.Hour,
+				},
+				FormatType:  formatTypeProto,
+				Compression: compressionGZIP,
+			},
+		},
 		{
 			id: config.NewComponentIDWithName(typeStr, "rotation_with_default_settings"),
 			expected: &Config{
@@ -91,6 +106,10 @@ func TestLoadConfig(t *testing.T) {
 			id:           config.NewComponentIDWithName(typeStr, "compression_error"),
 			errorMessage: "compression is not supported",
 		},
+		{
+			id:           config.NewComponentIDWithName(typeStr, "rotation_interval_error"),
+			errorMessage: "rotation interval must not be negative",
+		},
 		{
 			id:           config.NewComponentIDWithName(typeStr, "format_error"),
 			errorMessage: "format type is not supported",
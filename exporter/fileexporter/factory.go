@@ -18,6 +18,7 @@ import (
 	"context"
 	"io"
 	"os"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
@@ -42,6 +43,7 @@ const (
 
 	// the type of compression codec
 	compressionZSTD = "zstd"
+	compressionGZIP = "gzip"
 )
 
 // NewFactory creates a factory for OTLP exporter.
@@ -74,13 +76,15 @@ func createTracesExporter(
 	}
 	fe := exporters.GetOrAdd(cfg, func() component.Component {
 		return &fileExporter{
-			path:            conf.Path,
-			formatType:      conf.FormatType,
-			file:            writer,
-			tracesMarshaler: tracesMarshalers[conf.FormatType],
-			exporter:        buildExportFunc(conf),
-			compression:     conf.Compression,
-			compressor:      buildCompressor(conf.Compression),
+			path:             conf.Path,
+			formatType:       conf.FormatType,
+			file:             writer,
+			tracesMarshaler:  tracesMarshalers[conf.FormatType],
+			exporter:         buildExportFunc(conf),
+			compression:      conf.Compression,
+			compressor:       buildCompressor(conf.Compression),
+			rotationInterval: rotationInterval(conf),
+			logger:           set.Logger,
 		}
 	})
 	return exporterhelper.NewTracesExporter(
@@ -112,6 +116,8 @@ func createMetricsExporter(
 			exporter:         buildExportFunc(conf),
 			compression:      conf.Compression,
 			compressor:       buildCompressor(conf.Compression),
+			rotationInterval: rotationInterval(conf),
+			logger:           set.Logger,
 		}
 	})
 	return exporterhelper.NewMetricsExporter(
@@ -136,13 +142,15 @@ func createLogsExporter(
 	}
 	fe := exporters.GetOrAdd(cfg, func() component.Component {
 		return &fileExporter{
-			path:          conf.Path,
-			formatType:    conf.FormatType,
-			file:          writer,
-			logsMarshaler: logsMarshalers[conf.FormatType],
-			exporter:      buildExportFunc(conf),
-			compression:   conf.Compression,
-			compressor:    buildCompressor(conf.Compression),
+			path:             conf.Path,
+			formatType:       conf.FormatType,
+			file:             writer,
+			logsMarshaler:    logsMarshalers[conf.FormatType],
+			exporter:         buildExportFunc(conf),
+			compression:      conf.Compression,
+			compressor:       buildCompressor(conf.Compression),
+			rotationInterval: rotationInterval(conf),
+			logger:           set.Logger,
 		}
 	})
 	return exporterhelper.NewLogsExporter(
@@ -155,6 +163,14 @@ func createLogsExporter(
 	)
 }
 
+// rotationInterval returns the interval of the time-based rotation, or 0 if it is disabled.
+func rotationInterval(cfg *Config) time.Duration {
+	if cfg.Rotation == nil {
+		return 0
+	}
+	return cfg.Rotation.Interval
+}
+
 func buildFileWriter(cfg *Config) (io.WriteCloser, error) {
 	if cfg.Rotation == nil {
 		return os.OpenFile(cfg.Path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
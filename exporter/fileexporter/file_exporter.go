@@ -19,12 +19,14 @@ import (
 	"encoding/binary"
 	"io"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
 )
 
 // Marshaler configuration used for marhsaling Protobuf
@@ -41,6 +43,11 @@ var logsMarshalers = map[string]plog.Marshaler{
 	formatTypeProto: plog.NewProtoMarshaler(),
 }
 
+// rotator is implemented by the file writers that can be rotated on demand.
+type rotator interface {
+	Rotate() error
+}
+
 // exportFunc defines how to export encoded telemetry data.
 type exportFunc func(e *fileExporter, buf []byte) error
 
@@ -59,6 +66,14 @@ type fileExporter struct {
 
 	formatType string
 	exporter   exportFunc
+
+	// rotationInterval is the interval at which the file is rotated, if positive.
+	rotationInterval time.Duration
+	// written indicates whether data was written to the file since the last rotation.
+	written      bool
+	stopRotation chan struct{}
+	rotationDone chan struct{}
+	logger       *zap.Logger
 }
 
 func (e *fileExporter) Capabilities() consumer.Capabilities {
@@ -102,6 +117,7 @@ func exportMessageAsLine(e *fileExporter, buf []byte) error {
 	if _, err := io.WriteString(e.file, "\n"); err != nil {
 		return err
 	}
+	e.written = true
 	return nil
 }
 
@@ -117,15 +133,62 @@ func exportMessageAsBuffer(e *fileExporter, buf []byte) error {
 	if err := binary.Write(e.file, binary.BigEndian, data); err != nil {
 		return err
 	}
+	e.written = true
 	return nil
 }
 
 func (e *fileExporter) Start(context.Context, component.Host) error {
+	if e.rotationInterval <= 0 {
+		return nil
+	}
+	r, ok := e.file.(rotator)
+	if !ok {
+		return nil
+	}
+	e.stopRotation = make(chan struct{})
+	e.rotationDone = make(chan struct{})
+	go e.rotatePeriodically(r)
+	return nil
+}
+
+// rotatePeriodically rotates the file every rotation interval until the exporter is shut down.
+func (e *fileExporter) rotatePeriodically(r rotator) {
+	defer close(e.rotationDone)
+	ticker := time.NewTicker(e.rotationInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := e.rotate(r); err != nil {
+				e.logger.Warn("Failed to rotate the file", zap.String("path", e.path), zap.Error(err))
+			}
+		case <-e.stopRotation:
+			return
+		}
+	}
+}
+
+// rotate rotates the file, unless nothing was written to it since the last rotation.
+func (e *fileExporter) rotate(r rotator) error {
+	// Ensure the file is not rotated in the middle of a write operation.
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if !e.written {
+		return nil
+	}
+	if err := r.Rotate(); err != nil {
+		return err
+	}
+	e.written = false
 	return nil
 }
 
 // Shutdown stops the exporter and is invoked during shutdown.
 func (e *fileExporter) Shutdown(context.Context) error {
+	if e.stopRotation != nil {
+		close(e.stopRotation)
+		<-e.rotationDone
+	}
 	return e.file.Close()
 }
 
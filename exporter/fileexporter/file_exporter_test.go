@@ -15,6 +15,8 @@ package fileexporter
 
 import (
 	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/binary"
 	"errors"
@@ -22,6 +24,7 @@ import (
 	"os"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
@@ -30,14 +33,18 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
 	"gopkg.in/natefinch/lumberjack.v2"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
 )
 
 func buildUnCompressor(compressor string) func([]byte) ([]byte, error) {
-	if compressor == compressionZSTD {
+	switch compressor {
+	case compressionZSTD:
 		return decompress
+	case compressionGZIP:
+		return gunzip
 	}
 	return func(src []byte) ([]byte, error) {
 		return src, nil
@@ -95,6 +102,17 @@ func TestFileTracesExporter(t *testing.T) {
 				unmarshaler: ptrace.NewProtoUnmarshaler(),
 			},
 		},
+		{
+			name: "Proto: gzip compression configuration",
+			args: args{
+				conf: &Config{
+					Path:        tempFileName(t),
+					FormatType:  "proto",
+					Compression: compressionGZIP,
+				},
+				unmarshaler: ptrace.NewProtoUnmarshaler(),
+			},
+		},
 		{
 			name: "Proto: compression configuration--rotation",
 			args: args{
@@ -546,6 +564,96 @@ func decompress(src []byte) ([]byte, error) {
 	return decoder.DecodeAll(src, nil)
 }
 
+// gunzip decompresses a gzip buffer.
+func gunzip(src []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(src))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+func TestGzipCompress(t *testing.T) {
+	ld := testdata.GenerateLogsTwoLogRecordsSameResource()
+	buf, err := logsMarshalers[formatTypeJSON].MarshalLogs(ld)
+	require.NoError(t, err)
+
+	uncompressed, err := gunzip(gzipCompress(buf))
+	require.NoError(t, err)
+	assert.Equal(t, buf, uncompressed)
+}
+
+// rotatingWriter is an io.WriteCloser counting its rotations.
+type rotatingWriter struct {
+	io.WriteCloser
+	mutex     sync.Mutex
+	rotations int
+}
+
+func (w *rotatingWriter) Rotate() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.rotations++
+	return nil
+}
+
+func (w *rotatingWriter) rotationCount() int {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.rotations
+}
+
+func TestFileExporterRotate(t *testing.T) {
+	writer := &rotatingWriter{WriteCloser: nopWriteCloser{io.Discard}}
+	fe := &fileExporter{
+		file:       writer,
+		formatType: formatTypeJSON,
+		exporter:   exportMessageAsLine,
+		compressor: noneCompress,
+	}
+
+	// nothing was written yet
+	require.NoError(t, fe.rotate(writer))
+	assert.Equal(t, 0, writer.rotationCount())
+
+	require.NoError(t, exportMessageAsLine(fe, []byte("{}")))
+	require.NoError(t, fe.rotate(writer))
+	assert.Equal(t, 1, writer.rotationCount())
+
+	// nothing was written since the last rotation
+	require.NoError(t, fe.rotate(writer))
+	assert.Equal(t, 1, writer.rotationCount())
+}
+
+func TestFileExporterRotationInterval(t *testing.T) {
+	writer := &rotatingWriter{WriteCloser: nopWriteCloser{io.Discard}}
+	fe := &fileExporter{
+		file:             writer,
+		formatType:       formatTypeJSON,
+		exporter:         exportMessageAsLine,
+		logsMarshaler:    logsMarshalers[formatTypeJSON],
+		compressor:       noneCompress,
+		rotationInterval: 10 * time.Millisecond,
+		logger:           zap.NewNop(),
+	}
+
+	require.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, fe.ConsumeLogs(context.Background(), testdata.GenerateLogsOneLogRecord()))
+	assert.Eventually(t, func() bool {
+		return writer.rotationCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, fe.Shutdown(context.Background()))
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
 func TestConcurrentlyCompress(t *testing.T) {
 	wg := sync.WaitGroup{}
 	wg.Add(3)
@@ -15,6 +15,7 @@
 package googlecloudexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/googlecloudexporter"
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/collector"
@@ -31,6 +32,21 @@ type Config struct {
 	exporterhelper.TimeoutSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
 	exporterhelper.QueueSettings   `mapstructure:"sending_queue"`
 	exporterhelper.RetrySettings   `mapstructure:"retry_on_failure"`
+
+	// ResourceLabels controls which resource attributes of metrics become monitored resource labels
+	// and which become metric labels.
+	ResourceLabels ResourceLabelsConfig `mapstructure:"resource_labels"`
+}
+
+// ResourceLabelsConfig controls how the resource attributes of metrics are exported.
+type ResourceLabelsConfig struct {
+	// MetricLabels are the resource attributes exported as labels of every time series of the resource.
+	// When set, it replaces metric.resource_filters and metric.service_resource_labels, and the resource
+	// attributes that aren't listed are only used to build the monitored resource.
+	MetricLabels []string `mapstructure:"metric_labels"`
+	// MonitoredResource maps resource attributes to the attributes the monitored resource is built from,
+	// e.g. `node: host.id`. The mapped attributes aren't exported as metric labels.
+	MonitoredResource map[string]string `mapstructure:"monitored_resource"`
 }
 
 func (cfg *Config) Validate() error {
@@ -40,5 +56,13 @@ func (cfg *Config) Validate() error {
 	if err := collector.ValidateConfig(cfg.Config); err != nil {
 		return fmt.Errorf("googlecloud exporter settings are invalid :%w", err)
 	}
+	if cfg.ResourceLabels.MetricLabels != nil && len(cfg.MetricConfig.ResourceFilters) > 0 {
+		return errors.New("resource_labels.metric_labels and metric.resource_filters can't be both set")
+	}
+	for source, target := range cfg.ResourceLabels.MonitoredResource {
+		if source == "" || target == "" {
+			return fmt.Errorf("resource_labels.monitored_resource: invalid mapping %q: %q", source, target)
+		}
+	}
 	return nil
 }
@@ -94,9 +94,28 @@ func TestLoadConfig(t *testing.T) {
 				NumConsumers: 2,
 				QueueSize:    10,
 			},
+			ResourceLabels: ResourceLabelsConfig{
+				MetricLabels:      []string{"deployment.environment"},
+				MonitoredResource: map[string]string{"node": "host.id"},
+			},
 		})
 }
 
+func TestValidateResourceLabels(t *testing.T) {
+	defer setPdataFeatureGateForTest(t, true)()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.ResourceLabels.MetricLabels = []string{"deployment.environment"}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.MetricConfig.ResourceFilters = []collector.ResourceFilter{{Prefix: "k8s."}}
+	assert.EqualError(t, cfg.Validate(), "resource_labels.metric_labels and metric.resource_filters can't be both set")
+
+	cfg = createDefaultConfig().(*Config)
+	cfg.ResourceLabels.MonitoredResource = map[string]string{"node": ""}
+	assert.Error(t, cfg.Validate())
+}
+
 func sanitize(cfg *Config) *Config {
 	cfg.Config.MetricConfig.MapMonitoredResource = nil
 	cfg.Config.MetricConfig.GetMetricName = nil
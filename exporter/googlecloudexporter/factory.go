@@ -23,6 +23,7 @@ import (
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
 const (
@@ -138,15 +139,23 @@ func createMetricsExporter(
 		return newLegacyGoogleCloudMetricsExporter(eCfg, params)
 	}
 	eCfg := cfg.(*Config)
-	mExp, err := collector.NewGoogleCloudMetricsExporter(ctx, eCfg.Config, params.TelemetrySettings.Logger, params.BuildInfo.Version, eCfg.Timeout)
+	gcCfg := eCfg.Config
+	if eCfg.ResourceLabels.MetricLabels != nil {
+		// The metric labels of resource attributes are added by the translator instead.
+		gcCfg.MetricConfig.ServiceResourceLabels = false
+	}
+	mExp, err := collector.NewGoogleCloudMetricsExporter(ctx, gcCfg, params.TelemetrySettings.Logger, params.BuildInfo.Version, eCfg.Timeout)
 	if err != nil {
 		return nil, err
 	}
+	translator := newMetricsTranslator(eCfg.ResourceLabels)
 	return exporterhelper.NewMetricsExporter(
 		ctx,
 		params,
 		cfg,
-		mExp.PushMetrics,
+		func(ctx context.Context, md pmetric.Metrics) error {
+			return mExp.PushMetrics(ctx, translator.translate(md))
+		},
 		exporterhelper.WithShutdown(mExp.Shutdown),
 		// Disable exporterhelper Timeout, since we are using a custom mechanism
 		// within exporter itself
@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package googlecloudexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/googlecloudexporter"
+
+import (
+	"math"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// metricsTranslator adapts metrics to what the Cloud Monitoring exporter supports before they're
+// exported: it moves resource attributes to the monitored resource or metric labels according to
+// the resource_labels configuration, and converts exponential histograms to explicit bucket histograms.
+type metricsTranslator struct {
+	metricLabels      []string
+	monitoredResource map[string]string
+}
+
+func newMetricsTranslator(cfg ResourceLabelsConfig) *metricsTranslator {
+	return &metricsTranslator{
+		metricLabels:      cfg.MetricLabels,
+		monitoredResource: cfg.MonitoredResource,
+	}
+}
+
+// translate returns the metrics to export. The given metrics are left untouched: they're copied
+// if they need to be modified.
+func (t *metricsTranslator) translate(md pmetric.Metrics) pmetric.Metrics {
+	if len(t.metricLabels) == 0 && len(t.monitoredResource) == 0 && !hasExponentialHistograms(md) {
+		return md
+	}
+	translated := pmetric.NewMetrics()
+	md.ResourceMetrics().CopyTo(translated.ResourceMetrics())
+
+	rms := translated.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		t.mapMonitoredResource(rm.Resource())
+		labels := t.resourceMetricLabels(rm.Resource())
+
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				metric := metrics.At(k)
+				if metric.Type() == pmetric.MetricTypeExponentialHistogram {
+					convertExponentialHistogram(metric)
+				}
+				if labels.Len() > 0 {
+					addLabels(metric, labels)
+				}
+			}
+		}
+	}
+	return translated
+}
+
+// mapMonitoredResource renames the resource attributes configured in resource_labels.monitored_resource.
+func (t *metricsTranslator) mapMonitoredResource(resource pcommon.Resource) {
+	attrs := resource.Attributes()
+	for source, target := range t.monitoredResource {
+		value, ok := attrs.Get(source)
+		if !ok {
+			continue
+		}
+		value.CopyTo(attrs.PutEmpty(target))
+		attrs.Remove(source)
+	}
+}
+
+// resourceMetricLabels returns the resource attributes configured in resource_labels.metric_labels.
+func (t *metricsTranslator) resourceMetricLabels(resource pcommon.Resource) pcommon.Map {
+	labels := pcommon.NewMap()
+	for _, key := range t.metricLabels {
+		if value, ok := resource.Attributes().Get(key); ok {
+			value.CopyTo(labels.PutEmpty(key))
+		}
+	}
+	return labels
+}
+
+// addLabels adds the labels to the attributes of the data points of the metric. The attributes
+// of the data points take precedence.
+func addLabels(metric pmetric.Metric, labels pcommon.Map) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			mergeAttributes(dps.At(i).Attributes(), labels)
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			mergeAttributes(dps.At(i).Attributes(), labels)
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			mergeAttributes(dps.At(i).Attributes(), labels)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			mergeAttributes(dps.At(i).Attributes(), labels)
+		}
+	}
+}
+
+func mergeAttributes(attrs pcommon.Map, labels pcommon.Map) {
+	labels.Range(func(key string, value pcommon.Value) bool {
+		if _, ok := attrs.Get(key); !ok {
+			value.CopyTo(attrs.PutEmpty(key))
+		}
+		return true
+	})
+}
+
+func hasExponentialHistograms(md pmetric.Metrics) bool {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				if metrics.At(k).Type() == pmetric.MetricTypeExponentialHistogram {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// convertExponentialHistogram replaces the exponential histogram of the metric by an explicit bucket
+// histogram, which is exported as a Cloud Monitoring distribution. The bounds of the histogram are the
+// bounds of the exponential buckets, so the counts of the buckets are preserved.
+func convertExponentialHistogram(metric pmetric.Metric) {
+	expHistogram := pmetric.NewExponentialHistogram()
+	metric.ExponentialHistogram().CopyTo(expHistogram)
+
+	histogram := metric.SetEmptyHistogram()
+	histogram.SetAggregationTemporality(expHistogram.AggregationTemporality())
+	expDps := expHistogram.DataPoints()
+	dps := histogram.DataPoints()
+	dps.EnsureCapacity(expDps.Len())
+	for i := 0; i < expDps.Len(); i++ {
+		convertExponentialHistogramDataPoint(expDps.At(i), dps.AppendEmpty())
+	}
+}
+
+func convertExponentialHistogramDataPoint(expDp pmetric.ExponentialHistogramDataPoint, dp pmetric.HistogramDataPoint) {
+	expDp.Attributes().CopyTo(dp.Attributes())
+	expDp.Exemplars().CopyTo(dp.Exemplars())
+	dp.SetStartTimestamp(expDp.StartTimestamp())
+	dp.SetTimestamp(expDp.Timestamp())
+	dp.SetFlags(expDp.Flags())
+	dp.SetCount(expDp.Count())
+	if expDp.HasSum() {
+		dp.SetSum(expDp.Sum())
+	}
+	if expDp.HasMin() {
+		dp.SetMin(expDp.Min())
+	}
+	if expDp.HasMax() {
+		dp.SetMax(expDp.Max())
+	}
+
+	base := math.Exp2(math.Exp2(-float64(expDp.Scale())))
+	negative := expDp.Negative()
+	positive := expDp.Positive()
+	numNegative := negative.BucketCounts().Len()
+	numPositive := positive.BucketCounts().Len()
+
+	// The bucket i of the positive range holds the values in (base^(offset+i), base^(offset+i+1)],
+	// and the one of the negative range the opposite values. The values between the lowest negative
+	// and the lowest positive bounds, including zero, are counted in the zero bucket.
+	bounds := make([]float64, 0, numNegative+numPositive+2)
+	counts := make([]uint64, 0, numNegative+numPositive+3)
+	if numNegative > 0 {
+		offset := int(negative.Offset())
+		bounds = append(bounds, -math.Pow(base, float64(offset+numNegative)))
+		counts = append(counts, 0)
+		for i := numNegative - 1; i >= 0; i-- {
+			bounds = append(bounds, -math.Pow(base, float64(offset+i)))
+			counts = append(counts, negative.BucketCounts().At(i))
+		}
+	}
+	counts = append(counts, expDp.ZeroCount())
+	if numPositive > 0 {
+		offset := int(positive.Offset())
+		bounds = append(bounds, math.Pow(base, float64(offset)))
+		for i := 0; i < numPositive; i++ {
+			bounds = append(bounds, math.Pow(base, float64(offset+i+1)))
+			counts = append(counts, positive.BucketCounts().At(i))
+		}
+		counts = append(counts, 0)
+	}
+	dp.ExplicitBounds().FromRaw(bounds)
+	dp.BucketCounts().FromRaw(counts)
+}
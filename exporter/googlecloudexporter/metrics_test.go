@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package googlecloudexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestTranslateWithoutChanges(t *testing.T) {
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge()
+
+	translated := newMetricsTranslator(ResourceLabelsConfig{}).translate(md)
+	assert.Equal(t, md, translated)
+}
+
+func TestTranslateResourceLabels(t *testing.T) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("deployment.environment", "production")
+	rm.Resource().Attributes().PutStr("node", "node-1")
+	rm.Resource().Attributes().PutStr("k8s.pod.uid", "0f5b8f9e")
+	metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+	sum := metrics.AppendEmpty().SetEmptySum()
+	sum.DataPoints().AppendEmpty().SetIntValue(1)
+	gaugeDp := metrics.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	gaugeDp.Attributes().PutStr("deployment.environment", "staging")
+
+	translator := newMetricsTranslator(ResourceLabelsConfig{
+		MetricLabels:      []string{"deployment.environment", "missing"},
+		MonitoredResource: map[string]string{"node": "host.id"},
+	})
+	translated := translator.translate(md)
+
+	// The original metrics are left untouched.
+	_, ok := md.ResourceMetrics().At(0).Resource().Attributes().Get("host.id")
+	assert.False(t, ok)
+	assert.Equal(t, 0, md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).Attributes().Len())
+
+	resource := translated.ResourceMetrics().At(0).Resource()
+	assert.Equal(t, map[string]interface{}{
+		"deployment.environment": "production",
+		"host.id":                "node-1",
+		"k8s.pod.uid":            "0f5b8f9e",
+	}, resource.Attributes().AsRaw())
+
+	translatedMetrics := translated.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, map[string]interface{}{"deployment.environment": "production"},
+		translatedMetrics.At(0).Sum().DataPoints().At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{"deployment.environment": "staging"},
+		translatedMetrics.At(1).Gauge().DataPoints().At(0).Attributes().AsRaw())
+}
+
+func TestConvertExponentialHistogram(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(dp pmetric.ExponentialHistogramDataPoint)
+		wantBounds []float64
+		wantCounts []uint64
+	}{
+		{
+			name:       "empty",
+			setup:      func(dp pmetric.ExponentialHistogramDataPoint) { dp.SetZeroCount(2) },
+			wantBounds: []float64{},
+			wantCounts: []uint64{2},
+		},
+		{
+			name: "positive buckets",
+			setup: func(dp pmetric.ExponentialHistogramDataPoint) {
+				dp.SetScale(0)
+				dp.SetZeroCount(1)
+				dp.Positive().SetOffset(1)
+				dp.Positive().BucketCounts().FromRaw([]uint64{3, 4})
+			},
+			wantBounds: []float64{2, 4, 8},
+			wantCounts: []uint64{1, 3, 4, 0},
+		},
+		{
+			name: "negative and positive buckets",
+			setup: func(dp pmetric.ExponentialHistogramDataPoint) {
+				dp.SetScale(1)
+				dp.SetZeroCount(1)
+				dp.Negative().SetOffset(0)
+				dp.Negative().BucketCounts().FromRaw([]uint64{5, 6})
+				dp.Positive().SetOffset(2)
+				dp.Positive().BucketCounts().FromRaw([]uint64{7})
+			},
+			wantBounds: []float64{-2, -1.4142135623730951, -1, 2, 2.8284271247461903},
+			wantCounts: []uint64{0, 6, 5, 1, 7, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := pmetric.NewMetrics()
+			metric := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+			metric.SetName("latency")
+			expHistogram := metric.SetEmptyExponentialHistogram()
+			expHistogram.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+			dp := expHistogram.DataPoints().AppendEmpty()
+			dp.Attributes().PutStr("route", "/checkout")
+			dp.SetTimestamp(pcommon.Timestamp(10))
+			dp.SetCount(42)
+			dp.SetSum(128)
+			tt.setup(dp)
+
+			translated := newMetricsTranslator(ResourceLabelsConfig{}).translate(md)
+			assert.Equal(t, pmetric.MetricTypeExponentialHistogram, metric.Type())
+
+			translatedMetric := translated.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+			require.Equal(t, pmetric.MetricTypeHistogram, translatedMetric.Type())
+			assert.Equal(t, "latency", translatedMetric.Name())
+			assert.Equal(t, pmetric.AggregationTemporalityDelta, translatedMetric.Histogram().AggregationTemporality())
+
+			hdp := translatedMetric.Histogram().DataPoints().At(0)
+			assert.Equal(t, map[string]interface{}{"route": "/checkout"}, hdp.Attributes().AsRaw())
+			assert.Equal(t, pcommon.Timestamp(10), hdp.Timestamp())
+			assert.Equal(t, uint64(42), hdp.Count())
+			assert.Equal(t, 128.0, hdp.Sum())
+			require.Equal(t, len(tt.wantBounds), hdp.ExplicitBounds().Len())
+			for i, bound := range tt.wantBounds {
+				assert.InDelta(t, bound, hdp.ExplicitBounds().At(i), 1e-9)
+			}
+			assert.Equal(t, tt.wantCounts, hdp.BucketCounts().AsRaw())
+		})
+	}
+}
@@ -15,6 +15,7 @@
 package influxdbexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/influxdbexporter"
 
 import (
+	"errors"
 	"fmt"
 
 	"go.opentelemetry.io/collector/component"
@@ -30,6 +31,21 @@ const (
 	stability = component.StabilityLevelBeta
 )
 
+// V1Compatibility defines the settings used to write to the InfluxDB 1.x API.
+type V1Compatibility struct {
+	// Enabled indicates that the exporter should write to the InfluxDB 1.x /write endpoint
+	// instead of the 2.x /api/v2/write endpoint. Org, bucket and token are ignored when enabled.
+	Enabled bool `mapstructure:"enabled"`
+	// DB is the name of the database that telemetry will be written to.
+	DB string `mapstructure:"db"`
+	// RetentionPolicy is the name of the retention policy of the database. Optional.
+	RetentionPolicy string `mapstructure:"retention_policy"`
+	// Username is used for basic authentication. Optional.
+	Username string `mapstructure:"username"`
+	// Password is used for basic authentication. Optional.
+	Password string `mapstructure:"password"`
+}
+
 // Config defines configuration for the InfluxDB exporter.
 type Config struct {
 	config.ExporterSettings       `mapstructure:",squash"`
@@ -43,6 +59,8 @@ type Config struct {
 	Bucket string `mapstructure:"bucket"`
 	// Token is used to identify InfluxDB permissions within the organization.
 	Token string `mapstructure:"token"`
+	// V1Compatibility is used to write to InfluxDB 1.x and compatible endpoints.
+	V1Compatibility V1Compatibility `mapstructure:"v1_compatibility"`
 
 	// MetricsSchema indicates the metrics schema to emit to line protocol.
 	// Options:
@@ -55,5 +73,8 @@ func (cfg *Config) Validate() error {
 	if err := cfg.ExporterSettings.Validate(); err != nil {
 		return fmt.Errorf("exporter settings are invalid :%w", err)
 	}
+	if cfg.V1Compatibility.Enabled && cfg.V1Compatibility.DB == "" {
+		return errors.New("v1_compatibility.db is required when v1_compatibility is enabled")
+	}
 	return nil
 }
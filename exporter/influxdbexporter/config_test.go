@@ -67,6 +67,21 @@ func TestLoadConfig(t *testing.T) {
 				MetricsSchema: "telegraf-prometheus-v2",
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "v1-compatibility"),
+			expected: func() config.Exporter {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Endpoint = "http://localhost:8086"
+				cfg.V1Compatibility = V1Compatibility{
+					Enabled:         true,
+					DB:              "my-db",
+					RetentionPolicy: "my-rp",
+					Username:        "my-user",
+					Password:        "my-password",
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -83,3 +98,12 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.V1Compatibility.Enabled = true
+	assert.EqualError(t, cfg.Validate(), "v1_compatibility.db is required when v1_compatibility is enabled")
+
+	cfg.V1Compatibility.DB = "my-db"
+	assert.NoError(t, cfg.Validate())
+}
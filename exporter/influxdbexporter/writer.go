@@ -17,6 +17,7 @@ package influxdbexporter // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"net/http"
@@ -40,23 +41,17 @@ type influxHTTPWriter struct {
 }
 
 func newInfluxHTTPWriter(logger common.Logger, config *Config, host component.Host, settings component.TelemetrySettings) (*influxHTTPWriter, error) {
-	writeURL, err := url.Parse(config.HTTPClientSettings.Endpoint)
+	writeURL, err := composeWriteURL(config)
 	if err != nil {
 		return nil, err
 	}
-	if writeURL.Path == "" || writeURL.Path == "/" {
-		writeURL, err = writeURL.Parse("api/v2/write")
-		if err != nil {
-			return nil, err
-		}
-	}
-	queryValues := writeURL.Query()
-	queryValues.Set("org", config.Org)
-	queryValues.Set("bucket", config.Bucket)
-	queryValues.Set("precision", "ns")
-	writeURL.RawQuery = queryValues.Encode()
 
-	if config.Token != "" {
+	if config.V1Compatibility.Enabled {
+		if config.V1Compatibility.Username != "" || config.V1Compatibility.Password != "" {
+			credentials := config.V1Compatibility.Username + ":" + config.V1Compatibility.Password
+			config.HTTPClientSettings.Headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials))
+		}
+	} else if config.Token != "" {
 		config.HTTPClientSettings.Headers["Authorization"] = "Token " + config.Token
 	}
 
@@ -75,11 +70,45 @@ func newInfluxHTTPWriter(logger common.Logger, config *Config, host component.Ho
 			},
 		},
 		httpClient: httpClient,
-		writeURL:   writeURL.String(),
+		writeURL:   writeURL,
 		logger:     logger,
 	}, nil
 }
 
+// composeWriteURL returns the line protocol write URL, targeting either the
+// InfluxDB 2.x API or, in v1 compatibility mode, the InfluxDB 1.x API.
+func composeWriteURL(config *Config) (string, error) {
+	writeURL, err := url.Parse(config.HTTPClientSettings.Endpoint)
+	if err != nil {
+		return "", err
+	}
+	if writeURL.Path == "" || writeURL.Path == "/" {
+		path := "api/v2/write"
+		if config.V1Compatibility.Enabled {
+			path = "write"
+		}
+		writeURL, err = writeURL.Parse(path)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	queryValues := writeURL.Query()
+	if config.V1Compatibility.Enabled {
+		queryValues.Set("db", config.V1Compatibility.DB)
+		if config.V1Compatibility.RetentionPolicy != "" {
+			queryValues.Set("rp", config.V1Compatibility.RetentionPolicy)
+		}
+	} else {
+		queryValues.Set("org", config.Org)
+		queryValues.Set("bucket", config.Bucket)
+	}
+	queryValues.Set("precision", "ns")
+	writeURL.RawQuery = queryValues.Encode()
+
+	return writeURL.String(), nil
+}
+
 func (w *influxHTTPWriter) newBatch() *influxHTTPWriterBatch {
 	return &influxHTTPWriterBatch{
 		w:       w,
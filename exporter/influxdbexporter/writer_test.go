@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influxdbexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComposeWriteURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		v1       V1Compatibility
+		want     string
+	}{
+		{
+			name:     "v2 default path",
+			endpoint: "http://localhost:8086",
+			want:     "http://localhost:8086/api/v2/write?bucket=my-bucket&org=my-org&precision=ns",
+		},
+		{
+			name:     "v2 custom path",
+			endpoint: "http://localhost:8086/custom/write",
+			want:     "http://localhost:8086/custom/write?bucket=my-bucket&org=my-org&precision=ns",
+		},
+		{
+			name:     "v1 default path",
+			endpoint: "http://localhost:8086/",
+			v1:       V1Compatibility{Enabled: true, DB: "my-db"},
+			want:     "http://localhost:8086/write?db=my-db&precision=ns",
+		},
+		{
+			name:     "v1 with retention policy",
+			endpoint: "http://localhost:8428",
+			v1:       V1Compatibility{Enabled: true, DB: "my-db", RetentionPolicy: "my-rp"},
+			want:     "http://localhost:8428/write?db=my-db&precision=ns&rp=my-rp",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = tt.endpoint
+			cfg.Org = "my-org"
+			cfg.Bucket = "my-bucket"
+			cfg.V1Compatibility = tt.v1
+
+			got, err := composeWriteURL(cfg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
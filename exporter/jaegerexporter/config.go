@@ -15,6 +15,8 @@
 package jaegerexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/jaegerexporter"
 
 import (
+	"errors"
+
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
@@ -28,11 +30,39 @@ type Config struct {
 	exporterhelper.RetrySettings   `mapstructure:"retry_on_failure"`
 
 	configgrpc.GRPCClientSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+
+	// TraceState configures the tags holding the entries of the trace state of the spans.
+	TraceState TraceStateSettings `mapstructure:"trace_state"`
+	// Baggage configures the tags holding the baggage entries of the export requests.
+	Baggage BaggageSettings `mapstructure:"baggage"`
+}
+
+// TraceStateSettings defines how the entries of the W3C trace state of the spans are preserved.
+type TraceStateSettings struct {
+	// Enabled adds a tag for each entry of the trace state, in addition to the
+	// w3c.tracestate tag holding the whole trace state.
+	Enabled bool `mapstructure:"enabled"`
+	// TagPrefix is prepended to the keys of the entries to name their tags.
+	TagPrefix string `mapstructure:"tag_prefix"`
+}
+
+// BaggageSettings defines which entries of the W3C baggage of the export requests are preserved.
+type BaggageSettings struct {
+	// Keys lists the baggage entries added as tags to all the spans of a request.
+	Keys []string `mapstructure:"keys"`
+	// TagPrefix is prepended to the keys of the entries to name their tags.
+	TagPrefix string `mapstructure:"tag_prefix"`
 }
 
 var _ config.Exporter = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
 func (cfg *Config) Validate() error {
+	if cfg.TraceState.Enabled && cfg.TraceState.TagPrefix == "" {
+		return errors.New("trace_state.tag_prefix must not be empty")
+	}
+	if len(cfg.Baggage.Keys) > 0 && cfg.Baggage.TagPrefix == "" {
+		return errors.New("baggage.tag_prefix must not be empty")
+	}
 	return nil
 }
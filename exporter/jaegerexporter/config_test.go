@@ -64,6 +64,14 @@ func TestLoadConfig(t *testing.T) {
 					WriteBufferSize: 512 * 1024,
 					BalancerName:    "round_robin",
 				},
+				TraceState: TraceStateSettings{
+					Enabled:   true,
+					TagPrefix: "w3c.tracestate.",
+				},
+				Baggage: BaggageSettings{
+					Keys:      []string{"tenant"},
+					TagPrefix: "baggage.",
+				},
 			},
 		},
 	}
@@ -82,3 +90,44 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(*Config)
+		expectedErr string
+	}{
+		{
+			name:   "default",
+			modify: func(*Config) {},
+		},
+		{
+			name: "empty trace state prefix",
+			modify: func(cfg *Config) {
+				cfg.TraceState.Enabled = true
+				cfg.TraceState.TagPrefix = ""
+			},
+			expectedErr: "trace_state.tag_prefix must not be empty",
+		},
+		{
+			name: "empty baggage prefix",
+			modify: func(cfg *Config) {
+				cfg.Baggage.Keys = []string{"tenant"}
+				cfg.Baggage.TagPrefix = ""
+			},
+			expectedErr: "baggage.tag_prefix must not be empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			err := cfg.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -61,6 +61,8 @@ type protoGRPCSender struct {
 	client       jaegerproto.CollectorServiceClient
 	metadata     metadata.MD
 	waitForReady bool
+	traceState   TraceStateSettings
+	baggage      BaggageSettings
 
 	conn                      stateReporter
 	connStateReporterInterval time.Duration
@@ -78,6 +80,8 @@ func newProtoGRPCSender(cfg *Config, settings component.TelemetrySettings) *prot
 		settings:                  settings,
 		metadata:                  metadata.New(cfg.GRPCClientSettings.Headers),
 		waitForReady:              cfg.WaitForReady,
+		traceState:                cfg.TraceState,
+		baggage:                   cfg.Baggage,
 		connStateReporterInterval: time.Second,
 		stopCh:                    make(chan struct{}),
 		clientSettings:            &cfg.GRPCClientSettings,
@@ -99,6 +103,7 @@ func (s *protoGRPCSender) pushTraces(
 	if err != nil {
 		return consumererror.NewPermanent(fmt.Errorf("failed to push trace data via Jaeger exporter: %w", err))
 	}
+	s.appendPreservedTags(ctx, batches)
 
 	if s.metadata.Len() > 0 {
 		ctx = metadata.NewOutgoingContext(ctx, s.metadata)
@@ -29,6 +29,9 @@ const (
 	typeStr = "jaeger"
 	// The stability level of the exporter.
 	stability = component.StabilityLevelBeta
+
+	defaultTraceStateTagPrefix = "tracestate."
+	defaultBaggageTagPrefix    = "baggage."
 )
 
 // NewFactory creates a factory for Jaeger exporter
@@ -49,6 +52,12 @@ func createDefaultConfig() config.Exporter {
 			// We almost read 0 bytes, so no need to tune ReadBufferSize.
 			WriteBufferSize: 512 * 1024,
 		},
+		TraceState: TraceStateSettings{
+			TagPrefix: defaultTraceStateTagPrefix,
+		},
+		Baggage: BaggageSettings{
+			TagPrefix: defaultBaggageTagPrefix,
+		},
 	}
 }
 
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaegerexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/jaegerexporter"
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jaegertracing/jaeger/model"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/otel/baggage"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/tracetranslator"
+)
+
+// baggageMetadataKey is the metadata of the export requests holding their W3C baggage
+const baggageMetadataKey = "baggage"
+
+// appendPreservedTags adds to the spans the tags holding the entries of their
+// trace state and the selected baggage entries of the request, as configured.
+func (s *protoGRPCSender) appendPreservedTags(ctx context.Context, batches []*model.Batch) {
+	var baggageTags []model.KeyValue
+	if len(s.baggage.Keys) > 0 {
+		baggageTags = s.baggageTags(ctx)
+	}
+	if !s.traceState.Enabled && len(baggageTags) == 0 {
+		return
+	}
+
+	for _, batch := range batches {
+		for _, span := range batch.Spans {
+			if s.traceState.Enabled {
+				span.Tags = appendTraceStateTags(span.Tags, s.traceState.TagPrefix)
+			}
+			span.Tags = append(span.Tags, baggageTags...)
+		}
+	}
+}
+
+// appendTraceStateTags adds a tag for each entry of the trace state tag
+func appendTraceStateTags(tags []model.KeyValue, prefix string) []model.KeyValue {
+	var traceState string
+	for _, tag := range tags {
+		if tag.Key == tracetranslator.TagW3CTraceState {
+			traceState = tag.VStr
+			break
+		}
+	}
+
+	for _, member := range strings.Split(traceState, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(member), "=")
+		if !ok || key == "" {
+			continue
+		}
+		tags = append(tags, model.String(prefix+key, value))
+	}
+	return tags
+}
+
+// baggageTags returns the tags of the selected entries of the baggage of the request
+func (s *protoGRPCSender) baggageTags(ctx context.Context) []model.KeyValue {
+	values := client.FromContext(ctx).Metadata.Get(baggageMetadataKey)
+	if len(values) == 0 {
+		return nil
+	}
+
+	bag, err := baggage.Parse(strings.Join(values, ","))
+	if err != nil {
+		s.settings.Logger.Debug("failed to parse the baggage of the request", zap.Error(err))
+		return nil
+	}
+
+	var tags []model.KeyValue
+	for _, key := range s.baggage.Keys {
+		member := bag.Member(key)
+		if member.Key() == "" {
+			continue
+		}
+		tags = append(tags, model.String(s.baggage.TagPrefix+key, member.Value()))
+	}
+	return tags
+}
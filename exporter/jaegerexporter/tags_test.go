@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaegerexporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaegertracing/jaeger/model"
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configgrpc"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"google.golang.org/grpc"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/tracetranslator"
+)
+
+func TestAppendTraceStateTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     []model.KeyValue
+		expected []model.KeyValue
+	}{
+		{
+			name: "no trace state",
+			tags: []model.KeyValue{model.String("key", "value")},
+			expected: []model.KeyValue{
+				model.String("key", "value"),
+			},
+		},
+		{
+			name: "entries",
+			tags: []model.KeyValue{model.String(tracetranslator.TagW3CTraceState, "congo=t61rcWkgMzE, rojo=00f067aa0ba902b7")},
+			expected: []model.KeyValue{
+				model.String(tracetranslator.TagW3CTraceState, "congo=t61rcWkgMzE, rojo=00f067aa0ba902b7"),
+				model.String("ts.congo", "t61rcWkgMzE"),
+				model.String("ts.rojo", "00f067aa0ba902b7"),
+			},
+		},
+		{
+			name: "invalid entries",
+			tags: []model.KeyValue{model.String(tracetranslator.TagW3CTraceState, "invalid,,=value,vendor@tenant=a=b")},
+			expected: []model.KeyValue{
+				model.String(tracetranslator.TagW3CTraceState, "invalid,,=value,vendor@tenant=a=b"),
+				model.String("ts.vendor@tenant", "a=b"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, appendTraceStateTags(tt.tags, "ts."))
+		})
+	}
+}
+
+func TestPreservedTags(t *testing.T) {
+	spanHandler := &mockSpanHandler{}
+	server, serverAddr := initializeGRPCTestServer(t, func(server *grpc.Server) {
+		api_v2.RegisterCollectorServiceServer(server, spanHandler)
+	})
+	defer server.GracefulStop()
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	// The request context, holding the baggage, is not kept by the queue
+	cfg.QueueSettings.Enabled = false
+	cfg.GRPCClientSettings = configgrpc.GRPCClientSettings{
+		Endpoint: serverAddr.String(),
+		TLSSetting: configtls.TLSClientSetting{
+			Insecure: true,
+		},
+	}
+	cfg.TraceState.Enabled = true
+	cfg.Baggage.Keys = []string{"tenant", "missing", "sampling.priority"}
+
+	exporter, err := factory.CreateTracesExporter(context.Background(), componenttest.NewNopExporterCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exporter.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, exporter.Shutdown(context.Background())) })
+
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.TraceState().FromRaw("vendor=state")
+
+	ctx := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{
+			"baggage": {"tenant=acme%20corp;ttl=1,other=1", "sampling.priority=1"},
+		}),
+	})
+	require.NoError(t, exporter.ConsumeTraces(ctx, td))
+
+	requests := spanHandler.getRequests()
+	require.Len(t, requests, 1)
+	require.Len(t, requests[0].GetBatch().Spans, 1)
+	tags := requests[0].GetBatch().Spans[0].Tags
+	assert.Contains(t, tags, model.String("tracestate.vendor", "state"))
+	assert.Contains(t, tags, model.String("baggage.tenant", "acme corp"))
+	assert.Contains(t, tags, model.String("baggage.sampling.priority", "1"))
+	for _, tag := range tags {
+		assert.NotEqual(t, "baggage.other", tag.Key)
+		assert.NotEqual(t, "baggage.missing", tag.Key)
+	}
+}
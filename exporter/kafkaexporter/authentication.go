@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configtls"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter/internal/awsmsk"
@@ -45,10 +46,11 @@ type SASLConfig struct {
 	Username string `mapstructure:"username"`
 	// Password to be used on authentication
 	Password string `mapstructure:"password"`
-	// SASL Mechanism to be used, possible values are: (PLAIN, AWS_MSK_IAM, SCRAM-SHA-256 or SCRAM-SHA-512).
+	// SASL Mechanism to be used, possible values are: (PLAIN, AWS_MSK_IAM, OAUTHBEARER, SCRAM-SHA-256 or SCRAM-SHA-512).
 	Mechanism string `mapstructure:"mechanism"`
 
-	AWSMSK AWSMSKConfig `mapstructure:"aws_msk"`
+	AWSMSK      AWSMSKConfig      `mapstructure:"aws_msk"`
+	OAuthBearer OAuthBearerConfig `mapstructure:"oauthbearer"`
 }
 
 // AWSMSKConfig defines the additional SASL authentication
@@ -60,6 +62,13 @@ type AWSMSKConfig struct {
 	BrokerAddr string `mapstructure:"broker_addr"`
 }
 
+// OAuthBearerConfig defines the additional SASL authentication
+// measures needed to use OAUTHBEARER mechanism
+type OAuthBearerConfig struct {
+	// Authenticator is the ID of the client auth extension providing the bearer tokens
+	Authenticator config.ComponentID `mapstructure:"authenticator"`
+}
+
 // KerberosConfig defines kereros configuration.
 type KerberosConfig struct {
 	ServiceName string `mapstructure:"service_name"`
@@ -101,12 +110,16 @@ func configurePlaintext(config PlainTextConfig, saramaConfig *sarama.Config) {
 
 func configureSASL(config SASLConfig, saramaConfig *sarama.Config) error {
 
-	if config.Username == "" {
-		return fmt.Errorf("username have to be provided")
-	}
+	// The AWS_MSK_IAM credentials can also be retrieved from the environment,
+	// and the OAUTHBEARER tokens are provided by an authenticator.
+	if config.Mechanism != awsmsk.Mechanism && config.Mechanism != sarama.SASLTypeOAuth {
+		if config.Username == "" {
+			return fmt.Errorf("username have to be provided")
+		}
 
-	if config.Password == "" {
-		return fmt.Errorf("password have to be provided")
+		if config.Password == "" {
+			return fmt.Errorf("password have to be provided")
+		}
 	}
 
 	saramaConfig.Net.SASL.Enable = true
@@ -127,8 +140,16 @@ func configureSASL(config SASLConfig, saramaConfig *sarama.Config) error {
 			return awsmsk.NewIAMSASLClient(config.AWSMSK.BrokerAddr, config.AWSMSK.Region, saramaConfig.ClientID)
 		}
 		saramaConfig.Net.SASL.Mechanism = awsmsk.Mechanism
+	case sarama.SASLTypeOAuth:
+		if config.OAuthBearer.Authenticator.Type() == "" {
+			return fmt.Errorf("authenticator have to be provided")
+		}
+		saramaConfig.Net.SASL.TokenProvider = &authenticatorTokenProvider{authenticatorID: config.OAuthBearer.Authenticator}
+		saramaConfig.Net.SASL.Mechanism = sarama.SASLTypeOAuth
+		// OAUTHBEARER is only supported by brokers accepting the version 1 of the handshake.
+		saramaConfig.Net.SASL.Version = sarama.SASLHandshakeV1
 	default:
-		return fmt.Errorf(`invalid SASL Mechanism %q: can be either "PLAIN", "AWS_MSK_IAM", "SCRAM-SHA-256" or "SCRAM-SHA-512"`, config.Mechanism)
+		return fmt.Errorf(`invalid SASL Mechanism %q: can be either "PLAIN", "AWS_MSK_IAM", "OAUTHBEARER", "SCRAM-SHA-256" or "SCRAM-SHA-512"`, config.Mechanism)
 	}
 
 	return nil
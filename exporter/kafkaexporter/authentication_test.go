@@ -20,6 +20,7 @@ import (
 	"github.com/Shopify/sarama"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configtls"
 )
 
@@ -48,6 +49,16 @@ func TestAuthentication(t *testing.T) {
 
 	saramaSASLPLAINConfig.Net.SASL.Mechanism = sarama.SASLTypePlaintext
 
+	saramaSASLAWSIAMConfig := &sarama.Config{}
+	saramaSASLAWSIAMConfig.Net.SASL.Enable = true
+	saramaSASLAWSIAMConfig.Net.SASL.Mechanism = "AWS_MSK_IAM"
+
+	saramaSASLOAuthBearerConfig := &sarama.Config{}
+	saramaSASLOAuthBearerConfig.Net.SASL.Enable = true
+	saramaSASLOAuthBearerConfig.Net.SASL.Mechanism = sarama.SASLTypeOAuth
+	saramaSASLOAuthBearerConfig.Net.SASL.Version = sarama.SASLHandshakeV1
+	saramaSASLOAuthBearerConfig.Net.SASL.TokenProvider = &authenticatorTokenProvider{authenticatorID: config.NewComponentID("oauth2client")}
+
 	saramaTLSCfg := &sarama.Config{}
 	saramaTLSCfg.Net.TLS.Enable = true
 	tlsClient := configtls.TLSClientSetting{}
@@ -108,6 +119,19 @@ func TestAuthentication(t *testing.T) {
 			auth:         Authentication{SASL: &SASLConfig{Username: "jdoe", Password: "pass", Mechanism: "PLAIN"}},
 			saramaConfig: saramaSASLPLAINConfig,
 		},
+		{
+			auth:         Authentication{SASL: &SASLConfig{Mechanism: "AWS_MSK_IAM", AWSMSK: AWSMSKConfig{Region: "us-east-1", BrokerAddr: "broker:9098"}}},
+			saramaConfig: saramaSASLAWSIAMConfig,
+		},
+		{
+			auth:         Authentication{SASL: &SASLConfig{Mechanism: "OAUTHBEARER", OAuthBearer: OAuthBearerConfig{Authenticator: config.NewComponentID("oauth2client")}}},
+			saramaConfig: saramaSASLOAuthBearerConfig,
+		},
+		{
+			auth:         Authentication{SASL: &SASLConfig{Mechanism: "OAUTHBEARER"}},
+			saramaConfig: saramaSASLOAuthBearerConfig,
+			err:          "authenticator have to be provided",
+		},
 		{
 			auth:         Authentication{SASL: &SASLConfig{Username: "jdoe", Password: "pass", Mechanism: "SCRAM-SHA-222"}},
 			saramaConfig: saramaSASLSCRAM512Config,
@@ -36,6 +36,12 @@ type Config struct {
 	ProtocolVersion string `mapstructure:"protocol_version"`
 	// The name of the kafka topic to export to (default otlp_spans for traces, otlp_metrics for metrics)
 	Topic string `mapstructure:"topic"`
+	// The resource attribute holding the name of the topic to export the telemetry of the resource to.
+	// Topic is used for the resources without the attribute.
+	TopicFromAttribute string `mapstructure:"topic_from_attribute"`
+	// The OTTL statement computing the name of the topic to export the telemetry of a resource to.
+	// Topic is used when the statement is not executed or doesn't return a string.
+	TopicStatement string `mapstructure:"topic_statement"`
 
 	// Encoding of messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
@@ -107,6 +113,10 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("producer.required_acks has to be between -1 and 1. configured value %v", cfg.Producer.RequiredAcks)
 	}
 
+	if cfg.TopicFromAttribute != "" && cfg.TopicStatement != "" {
+		return fmt.Errorf("topic_from_attribute and topic_statement can't be both set")
+	}
+
 	_, err := saramaProducerCompressionCodec(cfg.Producer.Compression)
 	if err != nil {
 		return err
@@ -56,9 +56,10 @@ func TestLoadConfig(t *testing.T) {
 					NumConsumers: 2,
 					QueueSize:    10,
 				},
-				Topic:    "spans",
-				Encoding: "otlp_proto",
-				Brokers:  []string{"foo:123", "bar:456"},
+				Topic:              "spans",
+				TopicFromAttribute: "tenant.id",
+				Encoding:           "otlp_proto",
+				Brokers:            []string{"foo:123", "bar:456"},
 				Authentication: Authentication{
 					PlainText: &PlainTextConfig{
 						Username: "jdoe",
@@ -108,6 +109,19 @@ func TestValidate_err_compression(t *testing.T) {
 	assert.Equal(t, err.Error(), "producer.compression should be one of 'none', 'gzip', 'snappy', 'lz4', or 'zstd'. configured value idk")
 }
 
+func TestValidate_err_topic_selection(t *testing.T) {
+	config := &Config{
+		TopicFromAttribute: "tenant.id",
+		TopicStatement:     `Concat("_", "otlp_spans", resource.attributes["tenant.id"])`,
+		Producer: Producer{
+			Compression: "none",
+		},
+	}
+
+	err := config.Validate()
+	assert.EqualError(t, err, "topic_from_attribute and topic_statement can't be both set")
+}
+
 func Test_saramaProducerCompressionCodec(t *testing.T) {
 	tests := map[string]struct {
 		compression         string
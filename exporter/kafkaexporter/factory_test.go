@@ -114,9 +114,9 @@ func TestCreateTracesExporter_err(t *testing.T) {
 	cfg.ProtocolVersion = "2.0.0"
 	f := kafkaExporterFactory{tracesMarshalers: tracesMarshalers()}
 	r, err := f.createTracesExporter(context.Background(), componenttest.NewNopExporterCreateSettings(), cfg)
+	require.NoError(t, err)
 	// no available broker
-	require.Error(t, err)
-	assert.Nil(t, r)
+	require.Error(t, r.Start(context.Background(), componenttest.NewNopHost()))
 }
 
 func TestCreateMetricsExporter_err(t *testing.T) {
@@ -125,8 +125,9 @@ func TestCreateMetricsExporter_err(t *testing.T) {
 	cfg.ProtocolVersion = "2.0.0"
 	mf := kafkaExporterFactory{metricsMarshalers: metricsMarshalers()}
 	mr, err := mf.createMetricsExporter(context.Background(), componenttest.NewNopExporterCreateSettings(), cfg)
-	require.Error(t, err)
-	assert.Nil(t, mr)
+	require.NoError(t, err)
+	// no available broker
+	require.Error(t, mr.Start(context.Background(), componenttest.NewNopHost()))
 }
 
 func TestCreateLogsExporter_err(t *testing.T) {
@@ -135,8 +136,9 @@ func TestCreateLogsExporter_err(t *testing.T) {
 	cfg.ProtocolVersion = "2.0.0"
 	mf := kafkaExporterFactory{logsMarshalers: logsMarshalers()}
 	mr, err := mf.createLogsExporter(context.Background(), componenttest.NewNopExporterCreateSettings(), cfg)
-	require.Error(t, err)
-	assert.Nil(t, mr)
+	require.NoError(t, err)
+	// no available broker
+	require.Error(t, mr.Start(context.Background(), componenttest.NewNopHost()))
 }
 
 func TestWithMarshalers(t *testing.T) {
@@ -54,11 +54,10 @@ type IAMSASLClient struct {
 	Region      string
 	UserAgent   string
 
-	signer *sign.StreamSigner
+	signer      *sign.StreamSigner
+	credentials *credentials.Credentials
 
-	state     int32
-	accessKey string
-	secretKey string
+	state int32
 }
 
 type payload struct {
@@ -68,6 +67,7 @@ type payload struct {
 	Action        string `json:"action"`
 	Algorithm     string `json:"x-amz-algorithm"`
 	Credentials   string `json:"x-amz-credential"`
+	SecurityToken string `json:"x-amz-security-token,omitempty"`
 	Date          string `json:"x-amz-date"`
 	Expires       string `json:"x-amz-expires"`
 	SignedHeaders string `json:"x-amz-signedheaders"`
@@ -102,22 +102,21 @@ func (sc *IAMSASLClient) Begin(username, password, _ string) error {
 		return errors.New("missing value for MSK user agent")
 	}
 
-	sc.signer = sign.NewStreamSigner(
-		sc.Region,
-		service,
-		nil,
-		credentials.NewChainCredentials([]credentials.Provider{
-			&credentials.EnvProvider{},
-			&credentials.StaticProvider{
-				Value: credentials.Value{
-					AccessKeyID:     username,
-					SecretAccessKey: password,
-				},
+	// The credentials configured as username and password are used when they are not set in the environment.
+	// If neither is set, the shared credentials file is used.
+	providers := []credentials.Provider{&credentials.EnvProvider{}}
+	if username != "" {
+		providers = append(providers, &credentials.StaticProvider{
+			Value: credentials.Value{
+				AccessKeyID:     username,
+				SecretAccessKey: password,
 			},
-		}),
-	)
-	sc.accessKey = username
-	sc.secretKey = password
+		})
+	}
+	providers = append(providers, &credentials.SharedCredentialsProvider{})
+
+	sc.credentials = credentials.NewChainCredentials(providers)
+	sc.signer = sign.NewStreamSigner(sc.Region, service, nil, sc.credentials)
 	sc.state = initMessage
 	return nil
 }
@@ -168,6 +167,11 @@ func (sc *IAMSASLClient) Done() bool { return sc.state == complete }
 func (sc *IAMSASLClient) getAuthPayload() ([]byte, error) {
 	ts := time.Now().UTC()
 
+	creds, err := sc.credentials.Get()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve AWS credentials: %w", err)
+	}
+
 	headers := []byte("host:" + sc.MSKHostname)
 
 	sig, err := sc.signer.GetSignature(headers, nil, ts)
@@ -184,7 +188,8 @@ func (sc *IAMSASLClient) getAuthPayload() ([]byte, error) {
 		UserAgent:     sc.UserAgent,
 		Action:        "kafka-cluster:Connect",
 		Algorithm:     "AWS4-HMAC-SHA256",
-		Credentials:   fmt.Sprintf(scopeFormat, sc.accessKey, date[:8], sc.Region),
+		Credentials:   fmt.Sprintf(scopeFormat, creds.AccessKeyID, date[:8], sc.Region),
+		SecurityToken: creds.SessionToken,
 		Date:          date,
 		SignedHeaders: "host",
 		Expires:       "300", // Seconds => 5 Minutes
@@ -72,6 +72,23 @@ func TestAuthentication(t *testing.T) {
 	assert.True(t, mskAuth.Done(), "Must have completed auth")
 }
 
+func TestAuthenticationFromEnvironment(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "environment")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "hunter2")
+	t.Setenv("AWS_SESSION_TOKEN", "session")
+
+	mskAuth := NewIAMSASLClient("http://localhost:8089", "us-east-1", "kafka-exporter").(*IAMSASLClient)
+	require.NoError(t, mskAuth.Begin("", "", ""))
+
+	payload, err := mskAuth.Step("")
+	require.NoError(t, err)
+
+	var request map[string]string
+	require.NoError(t, json.NewDecoder(strings.NewReader(payload)).Decode(&request))
+	assert.True(t, strings.HasPrefix(request["x-amz-credential"], "environment/"), "Must use the credentials of the environment")
+	assert.Equal(t, "session", request["x-amz-security-token"])
+}
+
 func TestValidatingServerResponse(t *testing.T) {
 	t.Parallel()
 
@@ -32,10 +32,12 @@ var errUnrecognizedEncoding = fmt.Errorf("unrecognized encoding")
 
 // kafkaTracesProducer uses sarama to produce trace messages to Kafka.
 type kafkaTracesProducer struct {
-	producer  sarama.SyncProducer
-	topic     string
-	marshaler TracesMarshaler
-	logger    *zap.Logger
+	config       Config
+	saramaConfig *sarama.Config
+	producer     sarama.SyncProducer
+	topics       topicSelector
+	marshaler    TracesMarshaler
+	logger       *zap.Logger
 }
 
 type kafkaErrors struct {
@@ -48,11 +50,15 @@ func (ke kafkaErrors) Error() string {
 }
 
 func (e *kafkaTracesProducer) tracesPusher(_ context.Context, td ptrace.Traces) error {
-	messages, err := e.marshaler.Marshal(td, e.topic)
-	if err != nil {
-		return consumererror.NewPermanent(err)
+	var messages []*sarama.ProducerMessage
+	for topic, traces := range e.topics.splitTraces(td) {
+		topicMessages, err := e.marshaler.Marshal(traces, topic)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		messages = append(messages, topicMessages...)
 	}
-	err = e.producer.SendMessages(messages)
+	err := e.producer.SendMessages(messages)
 	if err != nil {
 		var prodErr sarama.ProducerErrors
 		if errors.As(err, &prodErr) {
@@ -65,24 +71,42 @@ func (e *kafkaTracesProducer) tracesPusher(_ context.Context, td ptrace.Traces)
 	return nil
 }
 
+func (e *kafkaTracesProducer) start(_ context.Context, host component.Host) error {
+	producer, err := newSaramaProducer(e.config, e.saramaConfig, host)
+	if err != nil {
+		return err
+	}
+	e.producer = producer
+	return nil
+}
+
 func (e *kafkaTracesProducer) Close(context.Context) error {
+	if e.producer == nil {
+		return nil
+	}
 	return e.producer.Close()
 }
 
 // kafkaMetricsProducer uses sarama to produce metrics messages to kafka
 type kafkaMetricsProducer struct {
-	producer  sarama.SyncProducer
-	topic     string
-	marshaler MetricsMarshaler
-	logger    *zap.Logger
+	config       Config
+	saramaConfig *sarama.Config
+	producer     sarama.SyncProducer
+	topics       topicSelector
+	marshaler    MetricsMarshaler
+	logger       *zap.Logger
 }
 
 func (e *kafkaMetricsProducer) metricsDataPusher(_ context.Context, md pmetric.Metrics) error {
-	messages, err := e.marshaler.Marshal(md, e.topic)
-	if err != nil {
-		return consumererror.NewPermanent(err)
+	var messages []*sarama.ProducerMessage
+	for topic, metrics := range e.topics.splitMetrics(md) {
+		topicMessages, err := e.marshaler.Marshal(metrics, topic)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		messages = append(messages, topicMessages...)
 	}
-	err = e.producer.SendMessages(messages)
+	err := e.producer.SendMessages(messages)
 	if err != nil {
 		var prodErr sarama.ProducerErrors
 		if errors.As(err, &prodErr) {
@@ -95,24 +119,42 @@ func (e *kafkaMetricsProducer) metricsDataPusher(_ context.Context, md pmetric.M
 	return nil
 }
 
+func (e *kafkaMetricsProducer) start(_ context.Context, host component.Host) error {
+	producer, err := newSaramaProducer(e.config, e.saramaConfig, host)
+	if err != nil {
+		return err
+	}
+	e.producer = producer
+	return nil
+}
+
 func (e *kafkaMetricsProducer) Close(context.Context) error {
+	if e.producer == nil {
+		return nil
+	}
 	return e.producer.Close()
 }
 
 // kafkaLogsProducer uses sarama to produce logs messages to kafka
 type kafkaLogsProducer struct {
-	producer  sarama.SyncProducer
-	topic     string
-	marshaler LogsMarshaler
-	logger    *zap.Logger
+	config       Config
+	saramaConfig *sarama.Config
+	producer     sarama.SyncProducer
+	topics       topicSelector
+	marshaler    LogsMarshaler
+	logger       *zap.Logger
 }
 
 func (e *kafkaLogsProducer) logsDataPusher(_ context.Context, ld plog.Logs) error {
-	messages, err := e.marshaler.Marshal(ld, e.topic)
-	if err != nil {
-		return consumererror.NewPermanent(err)
+	var messages []*sarama.ProducerMessage
+	for topic, logs := range e.topics.splitLogs(ld) {
+		topicMessages, err := e.marshaler.Marshal(logs, topic)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		messages = append(messages, topicMessages...)
 	}
-	err = e.producer.SendMessages(messages)
+	err := e.producer.SendMessages(messages)
 	if err != nil {
 		var prodErr sarama.ProducerErrors
 		if errors.As(err, &prodErr) {
@@ -125,11 +167,25 @@ func (e *kafkaLogsProducer) logsDataPusher(_ context.Context, ld plog.Logs) erro
 	return nil
 }
 
+func (e *kafkaLogsProducer) start(_ context.Context, host component.Host) error {
+	producer, err := newSaramaProducer(e.config, e.saramaConfig, host)
+	if err != nil {
+		return err
+	}
+	e.producer = producer
+	return nil
+}
+
 func (e *kafkaLogsProducer) Close(context.Context) error {
+	if e.producer == nil {
+		return nil
+	}
 	return e.producer.Close()
 }
 
-func newSaramaProducer(config Config) (sarama.SyncProducer, error) {
+// newSaramaConfig creates the sarama configuration of the producer, so that configuration
+// errors are reported when the exporter is created.
+func newSaramaConfig(config Config) (*sarama.Config, error) {
 	c := sarama.NewConfig()
 	// These setting are required by the sarama.SyncProducer implementation.
 	c.Producer.Return.Successes = true
@@ -160,12 +216,16 @@ func newSaramaProducer(config Config) (sarama.SyncProducer, error) {
 		return nil, err
 	}
 	c.Producer.Compression = compression
+	return c, nil
+}
 
-	producer, err := sarama.NewSyncProducer(config.Brokers, c)
-	if err != nil {
+// newSaramaProducer creates the producer once the authenticator of the OAUTHBEARER
+// SASL mechanism, if any, is resolved from the host extensions.
+func newSaramaProducer(config Config, saramaConfig *sarama.Config, host component.Host) (sarama.SyncProducer, error) {
+	if err := ResolveAuthenticator(saramaConfig, host); err != nil {
 		return nil, err
 	}
-	return producer, nil
+	return sarama.NewSyncProducer(config.Brokers, saramaConfig)
 }
 
 func newMetricsExporter(config Config, set component.ExporterCreateSettings, marshalers map[string]MetricsMarshaler) (*kafkaMetricsProducer, error) {
@@ -173,16 +233,21 @@ func newMetricsExporter(config Config, set component.ExporterCreateSettings, mar
 	if marshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
-	producer, err := newSaramaProducer(config)
+	topics, err := newTopicSelector(config, set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	saramaConfig, err := newSaramaConfig(config)
 	if err != nil {
 		return nil, err
 	}
 
 	return &kafkaMetricsProducer{
-		producer:  producer,
-		topic:     config.Topic,
-		marshaler: marshaler,
-		logger:    set.Logger,
+		config:       config,
+		saramaConfig: saramaConfig,
+		topics:       topics,
+		marshaler:    marshaler,
+		logger:       set.Logger,
 	}, nil
 
 }
@@ -193,15 +258,20 @@ func newTracesExporter(config Config, set component.ExporterCreateSettings, mars
 	if marshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
-	producer, err := newSaramaProducer(config)
+	topics, err := newTopicSelector(config, set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	saramaConfig, err := newSaramaConfig(config)
 	if err != nil {
 		return nil, err
 	}
 	return &kafkaTracesProducer{
-		producer:  producer,
-		topic:     config.Topic,
-		marshaler: marshaler,
-		logger:    set.Logger,
+		config:       config,
+		saramaConfig: saramaConfig,
+		topics:       topics,
+		marshaler:    marshaler,
+		logger:       set.Logger,
 	}, nil
 }
 
@@ -210,16 +280,21 @@ func newLogsExporter(config Config, set component.ExporterCreateSettings, marsha
 	if marshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
-	producer, err := newSaramaProducer(config)
+	topics, err := newTopicSelector(config, set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	saramaConfig, err := newSaramaConfig(config)
 	if err != nil {
 		return nil, err
 	}
 
 	return &kafkaLogsProducer{
-		producer:  producer,
-		topic:     config.Topic,
-		marshaler: marshaler,
-		logger:    set.Logger,
+		config:       config,
+		saramaConfig: saramaConfig,
+		topics:       topics,
+		marshaler:    marshaler,
+		logger:       set.Logger,
 	}, nil
 
 }
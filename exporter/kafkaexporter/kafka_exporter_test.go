@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -135,6 +136,33 @@ func TestNewExporter_err_compression(t *testing.T) {
 	assert.Nil(t, texp)
 }
 
+func TestNewExporter_unresolved_authenticator(t *testing.T) {
+	c := Config{
+		Encoding: defaultEncoding,
+		Authentication: Authentication{
+			SASL: &SASLConfig{
+				Mechanism:   sarama.SASLTypeOAuth,
+				OAuthBearer: OAuthBearerConfig{Authenticator: config.NewComponentID("oauth2client")},
+			},
+		},
+		Producer: Producer{
+			Compression: "none",
+		},
+	}
+	texp, err := newTracesExporter(c, componenttest.NewNopExporterCreateSettings(), tracesMarshalers())
+	require.NoError(t, err)
+	assert.EqualError(t, texp.start(context.Background(), componenttest.NewNopHost()), `authenticator "oauth2client" not found`)
+	assert.NoError(t, texp.Close(context.Background()))
+
+	mexp, err := newMetricsExporter(c, componenttest.NewNopExporterCreateSettings(), metricsMarshalers())
+	require.NoError(t, err)
+	assert.EqualError(t, mexp.start(context.Background(), componenttest.NewNopHost()), `authenticator "oauth2client" not found`)
+
+	lexp, err := newLogsExporter(c, componenttest.NewNopExporterCreateSettings(), logsMarshalers())
+	require.NoError(t, err)
+	assert.EqualError(t, lexp.start(context.Background(), componenttest.NewNopHost()), `authenticator "oauth2client" not found`)
+}
+
 func TestTracesPusher(t *testing.T) {
 	c := sarama.NewConfig()
 	producer := mocks.NewSyncProducer(t, c)
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configauth"
+)
+
+// authenticatorTokenProvider provides the tokens of the OAUTHBEARER mechanism.
+// The tokens are the bearer tokens that a client auth extension sets on HTTP requests,
+// so that they are refreshed by the extension when they expire.
+type authenticatorTokenProvider struct {
+	authenticatorID config.ComponentID
+	roundTripper    http.RoundTripper
+}
+
+var _ sarama.AccessTokenProvider = (*authenticatorTokenProvider)(nil)
+
+// ResolveAuthenticator binds the OAUTHBEARER token provider of the sarama configuration
+// to the client auth extension it refers to. It must be called before creating the sarama clients.
+func ResolveAuthenticator(saramaConfig *sarama.Config, host component.Host) error {
+	provider, ok := saramaConfig.Net.SASL.TokenProvider.(*authenticatorTokenProvider)
+	if !ok {
+		return nil
+	}
+
+	extension, ok := host.GetExtensions()[provider.authenticatorID]
+	if !ok {
+		return fmt.Errorf("authenticator %q not found", provider.authenticatorID)
+	}
+	authenticator, ok := extension.(configauth.ClientAuthenticator)
+	if !ok {
+		return fmt.Errorf("extension %q is not a client authenticator", provider.authenticatorID)
+	}
+
+	roundTripper, err := authenticator.RoundTripper(tokenRecorder{})
+	if err != nil {
+		return fmt.Errorf("failed to create the round tripper of authenticator %q: %w", provider.authenticatorID, err)
+	}
+	provider.roundTripper = roundTripper
+	return nil
+}
+
+// Token returns the bearer token of the authorization header set by the authenticator.
+func (p *authenticatorTokenProvider) Token() (*sarama.AccessToken, error) {
+	if p.roundTripper == nil {
+		return nil, fmt.Errorf("authenticator %q is not resolved", p.authenticatorID)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://localhost", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.roundTripper.RoundTrip(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a token from authenticator %q: %w", p.authenticatorID, err)
+	}
+	_ = resp.Body.Close()
+
+	scheme, token, _ := strings.Cut(resp.Request.Header.Get("Authorization"), " ")
+	if !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return nil, fmt.Errorf("authenticator %q did not provide a bearer token", p.authenticatorID)
+	}
+	return &sarama.AccessToken{Token: token}, nil
+}
+
+// tokenRecorder is the base round tripper of the authenticators, which returns the request
+// as it was modified by the authenticator instead of sending it.
+type tokenRecorder struct{}
+
+func (tokenRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
+}
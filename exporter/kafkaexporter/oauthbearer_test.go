@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configauth"
+)
+
+type extensionsHost struct {
+	component.Host
+	extensions map[config.ComponentID]component.Extension
+}
+
+func (h extensionsHost) GetExtensions() map[config.ComponentID]component.Extension {
+	return h.extensions
+}
+
+type nopExtension struct{}
+
+func (nopExtension) Start(context.Context, component.Host) error { return nil }
+
+func (nopExtension) Shutdown(context.Context) error { return nil }
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newTestAuthenticator returns an authenticator setting the authorization header returned by the function
+func newTestAuthenticator(authorization func() (string, error)) configauth.ClientAuthenticator {
+	return configauth.NewClientAuthenticator(configauth.WithClientRoundTripper(func(base http.RoundTripper) (http.RoundTripper, error) {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			value, err := authorization()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Header.Set("Authorization", value)
+			return base.RoundTrip(req)
+		}), nil
+	}))
+}
+
+func TestOAuthBearerToken(t *testing.T) {
+	authenticatorID := config.NewComponentID("oauth2client")
+	tokens := []string{"first", "second"}
+	calls := 0
+	authenticator := newTestAuthenticator(func() (string, error) {
+		token := tokens[calls]
+		calls++
+		return "Bearer " + token, nil
+	})
+
+	saramaConfig := &sarama.Config{}
+	require.NoError(t, ConfigureAuthentication(Authentication{SASL: &SASLConfig{
+		Mechanism:   "OAUTHBEARER",
+		OAuthBearer: OAuthBearerConfig{Authenticator: authenticatorID},
+	}}, saramaConfig))
+
+	_, err := saramaConfig.Net.SASL.TokenProvider.Token()
+	assert.EqualError(t, err, `authenticator "oauth2client" is not resolved`)
+
+	host := extensionsHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[config.ComponentID]component.Extension{authenticatorID: authenticator},
+	}
+	require.NoError(t, ResolveAuthenticator(saramaConfig, host))
+
+	// The token is requested from the authenticator every time, so that it is refreshed by the authenticator.
+	for _, expected := range tokens {
+		token, err := saramaConfig.Net.SASL.TokenProvider.Token()
+		require.NoError(t, err)
+		assert.Equal(t, &sarama.AccessToken{Token: expected}, token)
+	}
+}
+
+func TestOAuthBearerTokenErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization func() (string, error)
+		err           string
+	}{
+		{
+			name:          "authenticator error",
+			authorization: func() (string, error) { return "", errors.New("token endpoint unavailable") },
+			err:           `failed to get a token from authenticator "oauth2client": token endpoint unavailable`,
+		},
+		{
+			name:          "no authorization",
+			authorization: func() (string, error) { return "", nil },
+			err:           `authenticator "oauth2client" did not provide a bearer token`,
+		},
+		{
+			name:          "basic authorization",
+			authorization: func() (string, error) { return "Basic amRvZTpwYXNz", nil },
+			err:           `authenticator "oauth2client" did not provide a bearer token`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authenticatorID := config.NewComponentID("oauth2client")
+			saramaConfig := &sarama.Config{}
+			require.NoError(t, ConfigureAuthentication(Authentication{SASL: &SASLConfig{
+				Mechanism:   "OAUTHBEARER",
+				OAuthBearer: OAuthBearerConfig{Authenticator: authenticatorID},
+			}}, saramaConfig))
+			host := extensionsHost{
+				Host:       componenttest.NewNopHost(),
+				extensions: map[config.ComponentID]component.Extension{authenticatorID: newTestAuthenticator(tt.authorization)},
+			}
+			require.NoError(t, ResolveAuthenticator(saramaConfig, host))
+
+			_, err := saramaConfig.Net.SASL.TokenProvider.Token()
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestResolveAuthenticator(t *testing.T) {
+	authenticatorID := config.NewComponentID("oauth2client")
+	tests := []struct {
+		name       string
+		extensions map[config.ComponentID]component.Extension
+		err        string
+	}{
+		{
+			name:       "missing authenticator",
+			extensions: map[config.ComponentID]component.Extension{},
+			err:        `authenticator "oauth2client" not found`,
+		},
+		{
+			name:       "not an authenticator",
+			extensions: map[config.ComponentID]component.Extension{authenticatorID: nopExtension{}},
+			err:        `extension "oauth2client" is not a client authenticator`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saramaConfig := &sarama.Config{}
+			require.NoError(t, ConfigureAuthentication(Authentication{SASL: &SASLConfig{
+				Mechanism:   "OAUTHBEARER",
+				OAuthBearer: OAuthBearerConfig{Authenticator: authenticatorID},
+			}}, saramaConfig))
+			host := extensionsHost{Host: componenttest.NewNopHost(), extensions: tt.extensions}
+			assert.EqualError(t, ResolveAuthenticator(saramaConfig, host), tt.err)
+		})
+	}
+}
+
+func TestResolveAuthenticatorWithoutOAuthBearer(t *testing.T) {
+	saramaConfig := &sarama.Config{}
+	require.NoError(t, ConfigureAuthentication(Authentication{PlainText: &PlainTextConfig{Username: "jdoe", Password: "pass"}}, saramaConfig))
+	assert.NoError(t, ResolveAuthenticator(saramaConfig, componenttest.NewNopHost()))
+}
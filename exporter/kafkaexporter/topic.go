@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// topicSelector selects the topic the telemetry of each resource is exported to.
+type topicSelector struct {
+	// fallback is the topic used when the topic can't be computed from the resource.
+	fallback  string
+	attribute string
+	statement *ottl.Statement[ottlresource.TransformContext]
+}
+
+func newTopicSelector(config Config, settings component.TelemetrySettings) (topicSelector, error) {
+	selector := topicSelector{
+		fallback:  config.Topic,
+		attribute: config.TopicFromAttribute,
+	}
+	if config.TopicStatement == "" {
+		return selector, nil
+	}
+	parser := ottlresource.NewParser(topicFunctions(), settings)
+	statements, err := parser.ParseStatements([]string{config.TopicStatement})
+	if err != nil {
+		return topicSelector{}, fmt.Errorf("failed to parse topic_statement: %w", err)
+	}
+	selector.statement = statements[0]
+	return selector, nil
+}
+
+// topicFunctions returns the OTTL functions available to compute the topic.
+func topicFunctions() map[string]interface{} {
+	return map[string]interface{}{
+		"Concat":    ottlfuncs.Concat[ottlresource.TransformContext],
+		"Hex":       ottlfuncs.Hex[ottlresource.TransformContext],
+		"IsMatch":   ottlfuncs.IsMatch[ottlresource.TransformContext],
+		"HasPrefix": ottlfuncs.HasPrefix[ottlresource.TransformContext],
+	}
+}
+
+// dynamic returns true if the topic depends on the resources.
+func (s *topicSelector) dynamic() bool {
+	return s.attribute != "" || s.statement != nil
+}
+
+// topic returns the topic of the telemetry of the resource, or the fallback topic if the attribute
+// is missing or empty, or if the statement doesn't return a non-empty string.
+func (s *topicSelector) topic(resource pcommon.Resource) string {
+	if s.attribute != "" {
+		if value, ok := resource.Attributes().Get(s.attribute); ok && value.AsString() != "" {
+			return value.AsString()
+		}
+		return s.fallback
+	}
+	if s.statement != nil {
+		result, executed := s.statement.Execute(ottlresource.NewTransformContext(resource))
+		if topic, ok := result.(string); executed && ok && topic != "" {
+			return topic
+		}
+	}
+	return s.fallback
+}
+
+// splitTraces returns the traces of each topic.
+func (s *topicSelector) splitTraces(td ptrace.Traces) map[string]ptrace.Traces {
+	if !s.dynamic() {
+		return map[string]ptrace.Traces{s.fallback: td}
+	}
+	tracesByTopic := map[string]ptrace.Traces{}
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		topic := s.topic(rs.Resource())
+		traces, ok := tracesByTopic[topic]
+		if !ok {
+			traces = ptrace.NewTraces()
+			tracesByTopic[topic] = traces
+		}
+		rs.CopyTo(traces.ResourceSpans().AppendEmpty())
+	}
+	return tracesByTopic
+}
+
+// splitMetrics returns the metrics of each topic.
+func (s *topicSelector) splitMetrics(md pmetric.Metrics) map[string]pmetric.Metrics {
+	if !s.dynamic() {
+		return map[string]pmetric.Metrics{s.fallback: md}
+	}
+	metricsByTopic := map[string]pmetric.Metrics{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		topic := s.topic(rm.Resource())
+		metrics, ok := metricsByTopic[topic]
+		if !ok {
+			metrics = pmetric.NewMetrics()
+			metricsByTopic[topic] = metrics
+		}
+		rm.CopyTo(metrics.ResourceMetrics().AppendEmpty())
+	}
+	return metricsByTopic
+}
+
+// splitLogs returns the logs of each topic.
+func (s *topicSelector) splitLogs(ld plog.Logs) map[string]plog.Logs {
+	if !s.dynamic() {
+		return map[string]plog.Logs{s.fallback: ld}
+	}
+	logsByTopic := map[string]plog.Logs{}
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		topic := s.topic(rl.Resource())
+		logs, ok := logsByTopic[topic]
+		if !ok {
+			logs = plog.NewLogs()
+			logsByTopic[topic] = logs
+		}
+		rl.CopyTo(logs.ResourceLogs().AppendEmpty())
+	}
+	return logsByTopic
+}
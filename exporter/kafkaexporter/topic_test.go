@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestTopicSelector(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    Config
+		resources []map[string]interface{}
+		expected  []string
+	}{
+		{
+			name:      "static topic",
+			config:    Config{Topic: "otlp_spans"},
+			resources: []map[string]interface{}{{"tenant.id": "acme"}, {}},
+			expected:  []string{"otlp_spans", "otlp_spans"},
+		},
+		{
+			name:      "topic from attribute",
+			config:    Config{Topic: "otlp_spans", TopicFromAttribute: "tenant.id"},
+			resources: []map[string]interface{}{{"tenant.id": "acme"}, {"tenant.id": ""}, {}, {"tenant.id": 42}},
+			expected:  []string{"acme", "otlp_spans", "otlp_spans", "42"},
+		},
+		{
+			name: "topic statement",
+			config: Config{
+				Topic:          "otlp_spans",
+				TopicStatement: `Concat("_", "otlp_spans", resource.attributes["tenant.id"]) where resource.attributes["tenant.id"] != nil`,
+			},
+			resources: []map[string]interface{}{{"tenant.id": "acme"}, {}},
+			expected:  []string{"otlp_spans_acme", "otlp_spans"},
+		},
+		{
+			name: "topic statement not returning a string",
+			config: Config{
+				Topic:          "otlp_spans",
+				TopicStatement: `IsMatch(resource.attributes["tenant.id"], "acme")`,
+			},
+			resources: []map[string]interface{}{{"tenant.id": "acme"}},
+			expected:  []string{"otlp_spans"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := newTopicSelector(tt.config, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+			var topics []string
+			for _, attributes := range tt.resources {
+				resource := pcommon.NewResource()
+				resource.Attributes().FromRaw(attributes)
+				topics = append(topics, selector.topic(resource))
+			}
+			assert.Equal(t, tt.expected, topics)
+		})
+	}
+}
+
+func TestTopicSelector_invalid_statement(t *testing.T) {
+	_, err := newTopicSelector(Config{TopicStatement: `Unknown(resource.attributes["tenant.id"])`}, componenttest.NewNopTelemetrySettings())
+	assert.ErrorContains(t, err, "failed to parse topic_statement")
+}
+
+func TestTopicSelector_split(t *testing.T) {
+	selector, err := newTopicSelector(Config{Topic: "default", TopicFromAttribute: "tenant.id"}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	tenants := []string{"acme", "", "acme", "globex"}
+
+	td := ptrace.NewTraces()
+	md := pmetric.NewMetrics()
+	ld := plog.NewLogs()
+	for _, tenant := range tenants {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(tenant)
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName(tenant)
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(tenant)
+		if tenant != "" {
+			rs.Resource().Attributes().PutStr("tenant.id", tenant)
+			rm.Resource().Attributes().PutStr("tenant.id", tenant)
+			rl.Resource().Attributes().PutStr("tenant.id", tenant)
+		}
+	}
+
+	tracesByTopic := selector.splitTraces(td)
+	assert.Len(t, tracesByTopic, 3)
+	assert.Equal(t, 2, tracesByTopic["acme"].ResourceSpans().Len())
+	assert.Equal(t, 1, tracesByTopic["default"].ResourceSpans().Len())
+	assert.Equal(t, "globex", tracesByTopic["globex"].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+
+	metricsByTopic := selector.splitMetrics(md)
+	assert.Len(t, metricsByTopic, 3)
+	assert.Equal(t, 2, metricsByTopic["acme"].ResourceMetrics().Len())
+	assert.Equal(t, 1, metricsByTopic["default"].ResourceMetrics().Len())
+	assert.Equal(t, "globex", metricsByTopic["globex"].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+
+	logsByTopic := selector.splitLogs(ld)
+	assert.Len(t, logsByTopic, 3)
+	assert.Equal(t, 2, logsByTopic["acme"].ResourceLogs().Len())
+	assert.Equal(t, 1, logsByTopic["default"].ResourceLogs().Len())
+	assert.Equal(t, "globex", logsByTopic["globex"].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+
+	// The original data is left unchanged.
+	assert.Equal(t, 4, td.ResourceSpans().Len())
+	assert.Equal(t, 4, md.ResourceMetrics().Len())
+	assert.Equal(t, 4, ld.ResourceLogs().Len())
+}
+
+func TestTracesPusher_topic_from_attribute(t *testing.T) {
+	c := sarama.NewConfig()
+	producer := mocks.NewSyncProducer(t, c)
+	producer.ExpectSendMessageAndSucceed()
+	producer.ExpectSendMessageAndSucceed()
+
+	selector, err := newTopicSelector(Config{Topic: "otlp_spans", TopicFromAttribute: "tenant.id"}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	marshaler := &topicsRecorderMarshaler{TracesMarshaler: newPdataTracesMarshaler(ptrace.NewProtoMarshaler(), defaultEncoding)}
+	p := kafkaTracesProducer{
+		producer:  producer,
+		topics:    selector,
+		marshaler: marshaler,
+	}
+	t.Cleanup(func() {
+		require.NoError(t, p.Close(context.Background()))
+	})
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().Resource().Attributes().PutStr("tenant.id", "acme")
+	td.ResourceSpans().AppendEmpty()
+	require.NoError(t, p.tracesPusher(context.Background(), td))
+
+	sort.Strings(marshaler.topics)
+	assert.Equal(t, []string{"acme", "otlp_spans"}, marshaler.topics)
+}
+
+// topicsRecorderMarshaler records the topics of the marshaled traces.
+type topicsRecorderMarshaler struct {
+	TracesMarshaler
+	topics []string
+}
+
+func (m *topicsRecorderMarshaler) Marshal(traces ptrace.Traces, topic string) ([]*sarama.ProducerMessage, error) {
+	m.topics = append(m.topics, topic)
+	return m.TracesMarshaler.Marshal(traces, topic)
+}
@@ -19,6 +19,8 @@ import (
 
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
 type routingKey int
@@ -26,6 +28,7 @@ type routingKey int
 const (
 	traceIDRouting routingKey = iota
 	svcRouting
+	resourceRouting
 )
 
 // Config defines configuration for the exporter.
@@ -34,6 +37,28 @@ type Config struct {
 	Protocol                Protocol         `mapstructure:"protocol"`
 	Resolver                ResolverSettings `mapstructure:"resolver"`
 	RoutingKey              string           `mapstructure:"routing_key"`
+
+	// RoutingAttributes are the resource attributes whose values make the routing key with the "resource"
+	// routing_key. If empty, all the resource attributes are used.
+	RoutingAttributes []string `mapstructure:"routing_attributes"`
+
+	// RoutingStatement is an OTTL statement computing the routing key from the resource with the "resource"
+	// routing_key, e.g. `Concat("/", attributes["k8s.namespace.name"], attributes["k8s.pod.name"])`.
+	// It can't be used with RoutingAttributes.
+	RoutingStatement string `mapstructure:"routing_statement"`
+
+	// ConsistentHashing configures the consistent hash ring distributing the data among the backends.
+	ConsistentHashing ConsistentHashingSettings `mapstructure:"consistent_hashing"`
+}
+
+// ConsistentHashingSettings defines the configuration for the consistent hash ring
+type ConsistentHashingSettings struct {
+	// VirtualNodes is the number of positions in the ring for each backend with a weight of 1.
+	VirtualNodes int `mapstructure:"virtual_nodes"`
+
+	// Weights defines the relative weights of the backends, by endpoint. The backends without a weight
+	// have a weight of 1.
+	Weights map[string]float64 `mapstructure:"weights"`
 }
 
 // Protocol holds the individual protocol-specific settings. Only OTLP is supported at the moment.
@@ -45,6 +70,7 @@ type Protocol struct {
 type ResolverSettings struct {
 	Static *StaticResolver `mapstructure:"static"`
 	DNS    *DNSResolver    `mapstructure:"dns"`
+	K8s    *K8sSvcResolver `mapstructure:"k8s"`
 }
 
 // StaticResolver defines the configuration for the resolver providing a fixed list of backends
@@ -59,3 +85,14 @@ type DNSResolver struct {
 	Interval time.Duration `mapstructure:"interval"`
 	Timeout  time.Duration `mapstructure:"timeout"`
 }
+
+// K8sSvcResolver defines the configuration for the resolver watching the EndpointSlices of a Kubernetes service
+type K8sSvcResolver struct {
+	k8sconfig.APIConfig `mapstructure:",squash"`
+
+	// Service is the name of the service, as "name.namespace". The namespace defaults to "default".
+	Service string `mapstructure:"service"`
+
+	// Ports are the ports of the backends on the pods, each ready pod being a backend for each port.
+	Ports []int32 `mapstructure:"ports"`
+}
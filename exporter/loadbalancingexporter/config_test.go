@@ -18,6 +18,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
@@ -34,3 +35,21 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, config.UnmarshalExporter(sub, cfg))
 	require.NotNil(t, cfg)
 }
+
+func TestLoadK8sConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(config.NewComponentIDWithName(typeStr, "4").String())
+	require.NoError(t, err)
+	require.NoError(t, config.UnmarshalExporter(sub, cfg))
+
+	lbCfg := cfg.(*Config)
+	require.NotNil(t, lbCfg.Resolver.K8s)
+	assert.Equal(t, "lb-svc.observability", lbCfg.Resolver.K8s.Service)
+	assert.Equal(t, []int32{4317, 55690}, lbCfg.Resolver.K8s.Ports)
+	assert.Equal(t, 200, lbCfg.ConsistentHashing.VirtualNodes)
+	assert.Equal(t, map[string]float64{"10.0.0.1:4317": 2}, lbCfg.ConsistentHashing.Weights)
+}
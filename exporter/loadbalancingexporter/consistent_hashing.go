@@ -16,6 +16,7 @@ package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"hash/crc32"
+	"math"
 	"sort"
 )
 
@@ -46,6 +47,22 @@ func newHashRing(endpoints []string) *hashRing {
 	}
 }
 
+// newWeightedHashRing builds a new immutable consistent hash ring based on the given endpoints, where each endpoint
+// gets virtualNodes positions multiplied by its weight. The endpoints without a weight have a weight of 1.
+func newWeightedHashRing(endpoints []string, virtualNodes int, weights map[string]float64) *hashRing {
+	items := weightedPositionsForEndpoints(endpoints, func(endpoint string) int {
+		weight, ok := weights[endpointWithPort(endpoint)]
+		if !ok {
+			return virtualNodes
+		}
+		// an endpoint with a positive weight always gets at least one position
+		return int(math.Max(1, math.Round(float64(virtualNodes)*weight)))
+	})
+	return &hashRing{
+		items: items,
+	}
+}
+
 // endpointFor calculates which backend is responsible for the given traceID
 func (h *hashRing) endpointFor(identifier []byte) string {
 	if h == nil {
@@ -116,6 +133,10 @@ func positionsFor(endpoint string, numPoints int) []position {
 		h := crc32.NewIEEE()
 		h.Write([]byte(endpoint))
 		h.Write([]byte{byte(i)})
+		// the higher bytes are only written when set, so that the first 256 positions are kept unchanged
+		for rest := i >> 8; rest > 0; rest >>= 8 {
+			h.Write([]byte{byte(rest)})
+		}
 		hash := h.Sum32()
 		pos := hash % maxPositions
 		res = append(res, position(pos))
@@ -126,11 +147,16 @@ func positionsFor(endpoint string, numPoints int) []position {
 
 // positionsForEndpoints calculates all the positions for all the given endpoints
 func positionsForEndpoints(endpoints []string, weight int) []ringItem {
+	return weightedPositionsForEndpoints(endpoints, func(string) int { return weight })
+}
+
+// weightedPositionsForEndpoints calculates all the positions for all the given endpoints,
+// numPoints indicating how many positions to calculate for each endpoint
+func weightedPositionsForEndpoints(endpoints []string, numPoints func(endpoint string) int) []ringItem {
 	var items []ringItem
 	positions := map[position]bool{} // tracking the used positions
 	for _, endpoint := range endpoints {
-		// for this initial implementation, we don't allow endpoints to have custom weights
-		for _, pos := range positionsFor(endpoint, weight) {
+		for _, pos := range positionsFor(endpoint, numPoints(endpoint)) {
 			// if this position is occupied already, skip this item
 			if _, found := positions[pos]; found {
 				continue
@@ -32,6 +32,39 @@ func TestNewHashRing(t *testing.T) {
 	assert.Len(t, ring.items, 2*defaultWeight)
 }
 
+func TestNewWeightedHashRing(t *testing.T) {
+	// prepare
+	endpoints := []string{"endpoint-1", "endpoint-2:55678", "endpoint-3"}
+	weights := map[string]float64{
+		"endpoint-1:4317":  2,
+		"endpoint-2:55678": 0.5,
+	}
+
+	// test
+	ring := newWeightedHashRing(endpoints, 400, weights)
+
+	// verify
+	counts := map[string]int{}
+	for _, item := range ring.items {
+		counts[item.endpoint]++
+	}
+	// some positions might have been taken by another endpoint already
+	assert.InDelta(t, 800, counts["endpoint-1"], 40)
+	assert.InDelta(t, 200, counts["endpoint-2:55678"], 40)
+	assert.InDelta(t, 400, counts["endpoint-3"], 40)
+}
+
+func TestNewWeightedHashRingKeepsDefaultPositions(t *testing.T) {
+	// prepare
+	endpoints := []string{"endpoint-1", "endpoint-2"}
+
+	// test
+	ring := newWeightedHashRing(endpoints, defaultWeight, nil)
+
+	// verify
+	assert.True(t, ring.equal(newHashRing(endpoints)))
+}
+
 func TestEndpointFor(t *testing.T) {
 	// prepare
 	endpoints := []string{"endpoint-1", "endpoint-2"}
@@ -69,7 +102,57 @@ func TestPositionsFor(t *testing.T) {
 	assert.Len(t, positions, 10)
 }
 
-func TestBinarySearch(t *testing.T) {
+func TestPositionsForBeyond256Points(t *testing.T) {
+	// prepare
+	endpoint := "host1"
+
+	// test
+	positions := positionsFor(endpoint, 1000)
+
+	// verify
+	assert.Equal(t, positionsFor(endpoint, 256), positions[:256])
+	unique := map[position]bool{}
+	for _, pos := range positions {
+		unique[pos] = true
+	}
+	// the positions are spread over 36000 angles, so that only a few of them collide
+	assert.Greater(t, len(unique), 950)
+}
+
+func TestNewWeightedHashRing(t *testing.T) {
+	// prepare
+	endpoints := []string{"endpoint-1", "endpoint-2:55678", "endpoint-3"}
+	weights := map[string]float64{
+		"endpoint-1:4317":  2,
+		"endpoint-2:55678": 0.5,
+	}
+
+	// test
+	ring := newWeightedHashRing(endpoints, 400, weights)
+
+	// verify
+	counts := map[string]int{}
+	for _, item := range ring.items {
+		counts[item.endpoint]++
+	}
+	// some positions might have been taken by another endpoint already
+	assert.InDelta(t, 800, counts["endpoint-1"], 40)
+	assert.InDelta(t, 200, counts["endpoint-2:55678"], 40)
+	assert.InDelta(t, 400, counts["endpoint-3"], 40)
+}
+
+func TestNewWeightedHashRingKeepsDefaultPositions(t *testing.T) {
+	// prepare
+	endpoints := []string{"endpoint-1", "endpoint-2"}
+
+	// test
+	ring := newWeightedHashRing(endpoints, defaultWeight, nil)
+
+	// verify
+	assert.True(t, ring.equal(newHashRing(endpoints)))
+}
+
+func TestEndpointFor(t *testing.T) {
 	// prepare
 	items := []ringItem{
 		{pos: 14},
@@ -39,6 +39,7 @@ func NewFactory() component.ExporterFactory {
 		createDefaultConfig,
 		component.WithTracesExporter(createTracesExporter, stability),
 		component.WithLogsExporter(createLogsExporter, stability),
+		component.WithMetricsExporter(createMetricsExporter, stability),
 	)
 }
 
@@ -51,6 +52,9 @@ func createDefaultConfig() config.Exporter {
 		Protocol: Protocol{
 			OTLP: *otlpDefaultCfg,
 		},
+		ConsistentHashing: ConsistentHashingSettings{
+			VirtualNodes: defaultWeight,
+		},
 	}
 }
 
@@ -61,3 +65,7 @@ func createTracesExporter(_ context.Context, params component.ExporterCreateSett
 func createLogsExporter(_ context.Context, params component.ExporterCreateSettings, cfg config.Exporter) (component.LogsExporter, error) {
 	return newLogsExporter(params, cfg)
 }
+
+func createMetricsExporter(_ context.Context, params component.ExporterCreateSettings, cfg config.Exporter) (component.MetricsExporter, error) {
+	return newMetricsExporter(params, cfg)
+}
@@ -24,6 +24,8 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
 const (
@@ -33,6 +35,7 @@ const (
 var (
 	errNoResolver                = errors.New("no resolvers specified for the exporter")
 	errMultipleResolversProvided = errors.New("only one resolver should be specified")
+	errNegativeVirtualNodes      = errors.New("the number of virtual nodes can't be negative")
 )
 
 var _ loadBalancer = (*loadBalancerImp)(nil)
@@ -52,6 +55,9 @@ type loadBalancerImp struct {
 	res  resolver
 	ring *hashRing
 
+	virtualNodes int
+	weights      map[string]float64
+
 	componentFactory componentFactory
 	exporters        map[string]component.Exporter
 
@@ -63,10 +69,31 @@ type loadBalancerImp struct {
 func newLoadBalancer(params component.ExporterCreateSettings, cfg config.Exporter, factory componentFactory) (*loadBalancerImp, error) {
 	oCfg := cfg.(*Config)
 
-	if oCfg.Resolver.DNS != nil && oCfg.Resolver.Static != nil {
+	numResolvers := 0
+	for _, configured := range []bool{oCfg.Resolver.Static != nil, oCfg.Resolver.DNS != nil, oCfg.Resolver.K8s != nil} {
+		if configured {
+			numResolvers++
+		}
+	}
+	if numResolvers > 1 {
 		return nil, errMultipleResolversProvided
 	}
 
+	virtualNodes := oCfg.ConsistentHashing.VirtualNodes
+	if virtualNodes < 0 {
+		return nil, errNegativeVirtualNodes
+	}
+	if virtualNodes == 0 {
+		virtualNodes = defaultWeight
+	}
+	weights := make(map[string]float64, len(oCfg.ConsistentHashing.Weights))
+	for endpoint, weight := range oCfg.ConsistentHashing.Weights {
+		if weight <= 0 {
+			return nil, fmt.Errorf("the weight of the endpoint %q must be positive", endpoint)
+		}
+		weights[endpointWithPort(endpoint)] = weight
+	}
+
 	var res resolver
 	if oCfg.Resolver.Static != nil {
 		var err error
@@ -85,6 +112,23 @@ func newLoadBalancer(params component.ExporterCreateSettings, cfg config.Exporte
 		}
 	}
 
+	if oCfg.Resolver.K8s != nil {
+		k8sLogger := params.Logger.With(zap.String("resolver", "k8s service"))
+
+		apiConfig := oCfg.Resolver.K8s.APIConfig
+		if apiConfig.AuthType == "" {
+			apiConfig.AuthType = k8sconfig.AuthTypeServiceAccount
+		}
+		clt, err := k8sconfig.MakeClient(apiConfig)
+		if err != nil {
+			return nil, err
+		}
+		res, err = newK8sResolver(clt, k8sLogger, oCfg.Resolver.K8s.Service, oCfg.Resolver.K8s.Ports)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if res == nil {
 		return nil, errNoResolver
 	}
@@ -92,6 +136,8 @@ func newLoadBalancer(params component.ExporterCreateSettings, cfg config.Exporte
 	return &loadBalancerImp{
 		logger:           params.Logger,
 		res:              res,
+		virtualNodes:     virtualNodes,
+		weights:          weights,
 		componentFactory: factory,
 		exporters:        map[string]component.Exporter{},
 	}, nil
@@ -104,7 +150,7 @@ func (lb *loadBalancerImp) Start(ctx context.Context, host component.Host) error
 }
 
 func (lb *loadBalancerImp) onBackendChanges(resolved []string) {
-	newRing := newHashRing(resolved)
+	newRing := newWeightedHashRing(resolved, lb.virtualNodes, lb.weights)
 
 	if !newRing.equal(lb.ring) {
 		lb.updateLock.Lock()
@@ -39,6 +39,84 @@ func TestNewLoadBalancerNoResolver(t *testing.T) {
 	require.Equal(t, errNoResolver, err)
 }
 
+func TestNewLoadBalancerMultipleResolvers(t *testing.T) {
+	// prepare
+	cfg := &Config{
+		Resolver: ResolverSettings{
+			DNS: &DNSResolver{Hostname: "service-1"},
+			K8s: &K8sSvcResolver{Service: "lb-svc.observability"},
+		},
+	}
+
+	// test
+	p, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), cfg, nil)
+
+	// verify
+	require.Nil(t, p)
+	require.Equal(t, errMultipleResolversProvided, err)
+}
+
+func TestNewLoadBalancerInvalidConsistentHashing(t *testing.T) {
+	for _, tt := range []struct {
+		desc     string
+		hashing  ConsistentHashingSettings
+		expected string
+	}{
+		{
+			desc:     "negative virtual nodes",
+			hashing:  ConsistentHashingSettings{VirtualNodes: -1},
+			expected: errNegativeVirtualNodes.Error(),
+		},
+		{
+			desc:     "zero weight",
+			hashing:  ConsistentHashingSettings{Weights: map[string]float64{"endpoint-1": 0}},
+			expected: `the weight of the endpoint "endpoint-1" must be positive`,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// prepare
+			cfg := &Config{
+				Resolver: ResolverSettings{
+					Static: &StaticResolver{Hostnames: []string{"endpoint-1"}},
+				},
+				ConsistentHashing: tt.hashing,
+			}
+
+			// test
+			p, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), cfg, nil)
+
+			// verify
+			require.Nil(t, p)
+			require.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func TestWeightedRingOnBackendChanges(t *testing.T) {
+	// prepare
+	cfg := &Config{
+		Resolver: ResolverSettings{
+			Static: &StaticResolver{Hostnames: []string{"endpoint-1"}},
+		},
+		ConsistentHashing: ConsistentHashingSettings{
+			VirtualNodes: 10,
+			Weights:      map[string]float64{"endpoint-2:4317": 3},
+		},
+	}
+	componentFactory := func(ctx context.Context, endpoint string) (component.Exporter, error) {
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// test
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+
+	// verify
+	assert.True(t, p.ring.equal(newWeightedHashRing([]string{"endpoint-1", "endpoint-2"}, 10, map[string]float64{"endpoint-2:4317": 3})))
+}
+
 func TestNewLoadBalancerInvalidStaticResolver(t *testing.T) {
 	// prepare
 	cfg := &Config{
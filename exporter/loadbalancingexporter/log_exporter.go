@@ -18,7 +18,6 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
-	"sync"
 	"time"
 
 	"go.opencensus.io/stats"
@@ -38,9 +37,6 @@ var _ component.LogsExporter = (*logExporterImp)(nil)
 
 type logExporterImp struct {
 	loadBalancer loadBalancer
-
-	stopped    bool
-	shutdownWg sync.WaitGroup
 }
 
 // Create new logs exporter
@@ -69,8 +65,6 @@ func (e *logExporterImp) Start(ctx context.Context, host component.Host) error {
 }
 
 func (e *logExporterImp) Shutdown(context.Context) error {
-	e.stopped = true
-	e.shutdownWg.Wait()
 	return nil
 }
 
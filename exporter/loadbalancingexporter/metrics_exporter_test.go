@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestNewMetricsExporter(t *testing.T) {
+	for _, tt := range []struct {
+		desc   string
+		config *Config
+		err    error
+	}{
+		{
+			"simple",
+			simpleConfig(),
+			nil,
+		},
+		{
+			"service",
+			serviceBasedRoutingConfig(),
+			nil,
+		},
+		{
+			"traceID",
+			func() *Config {
+				cfg := simpleConfig()
+				cfg.RoutingKey = "traceID"
+				return cfg
+			}(),
+			errors.New("unsupported routing_key for metrics: traceID"),
+		},
+		{
+			"empty",
+			&Config{
+				ExporterSettings: config.NewExporterSettings(config.NewComponentID(typeStr)),
+			},
+			errNoResolver,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// test
+			_, err := newMetricsExporter(componenttest.NewNopExporterCreateSettings(), tt.config)
+
+			// verify
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestMetricsExporterShutdown(t *testing.T) {
+	p, err := newMetricsExporter(componenttest.NewNopExporterCreateSettings(), simpleConfig())
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// test
+	res := p.Shutdown(context.Background())
+
+	// verify
+	assert.Nil(t, res)
+}
+
+func TestConsumeMetricsRoutedByResource(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string][]string{}
+	componentFactory := func(ctx context.Context, endpoint string) (component.Exporter, error) {
+		return newMockMetricsExporter(func(ctx context.Context, md pmetric.Metrics) error {
+			mu.Lock()
+			defer mu.Unlock()
+			for i := 0; i < md.ResourceMetrics().Len(); i++ {
+				host, _ := md.ResourceMetrics().At(i).Resource().Attributes().Get("host.name")
+				received[endpoint] = append(received[endpoint], host.Str())
+			}
+			return nil
+		}), nil
+	}
+	cfg := simpleConfig()
+	cfg.RoutingAttributes = []string{"host.name"}
+	lb, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, lb)
+	require.NoError(t, err)
+
+	p, err := newMetricsExporter(componenttest.NewNopExporterCreateSettings(), cfg)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(ctx context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2", "endpoint-3"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	err = p.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test
+	for i := 0; i < 3; i++ {
+		require.NoError(t, p.ConsumeMetrics(context.Background(), metricsWithHosts("host-1", "host-2", "host-3", "host-4")))
+	}
+
+	// verify: the metrics of each host were always sent to the same endpoint
+	mu.Lock()
+	defer mu.Unlock()
+	endpointOfHost := map[string]string{}
+	total := 0
+	for endpoint, hosts := range received {
+		for _, host := range hosts {
+			total++
+			if previous, ok := endpointOfHost[host]; ok {
+				assert.Equal(t, previous, endpoint, "the metrics of %s were sent to several endpoints", host)
+			}
+			endpointOfHost[host] = endpoint
+		}
+	}
+	assert.Equal(t, 12, total)
+	assert.Len(t, endpointOfHost, 4)
+}
+
+func TestConsumeMetricsUnexpectedExporterType(t *testing.T) {
+	componentFactory := func(ctx context.Context, endpoint string) (component.Exporter, error) {
+		return newNopMockExporter(), nil
+	}
+	lb, err := newLoadBalancer(componenttest.NewNopExporterCreateSettings(), simpleConfig(), componentFactory)
+	require.NotNil(t, lb)
+	require.NoError(t, err)
+
+	p, err := newMetricsExporter(componenttest.NewNopExporterCreateSettings(), simpleConfig())
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// pre-load an exporter here, so that we don't use the actual OTLP exporter
+	lb.addMissingExporters(context.Background(), []string{"endpoint-1"})
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(ctx context.Context) ([]string, error) {
+			return []string{"endpoint-1"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	err = p.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test
+	res := p.ConsumeMetrics(context.Background(), metricsWithHosts("host-1"))
+
+	// verify
+	assert.EqualError(t, res, fmt.Sprintf("unable to export metrics, unexpected exporter type: expected *component.MetricsExporter but got %T", newNopMockExporter()))
+}
+
+func metricsWithHosts(hosts ...string) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	for _, host := range hosts {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("host.name", host)
+		m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("requests")
+		m.SetEmptySum().DataPoints().AppendEmpty().SetIntValue(1)
+	}
+	return md
+}
+
+type mockMetricsExporter struct {
+	component.Component
+	consumeMetricsFn func(ctx context.Context, md pmetric.Metrics) error
+}
+
+func newMockMetricsExporter(consumeMetricsFn func(ctx context.Context, md pmetric.Metrics) error) component.MetricsExporter {
+	return &mockMetricsExporter{
+		Component:        mockComponent{},
+		consumeMetricsFn: consumeMetricsFn,
+	}
+}
+
+func (e *mockMetricsExporter) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (e *mockMetricsExporter) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	if e.consumeMetricsFn == nil {
+		return nil
+	}
+	return e.consumeMetricsFn(ctx, md)
+}
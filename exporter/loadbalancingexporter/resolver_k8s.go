@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ resolver = (*k8sResolver)(nil)
+
+const (
+	defaultK8sNamespace   = "default"
+	defaultK8sPort        = 4317
+	defaultK8sSyncTimeout = 10 * time.Second
+)
+
+var (
+	errNoSvc = errors.New("no service specified to resolve the backends")
+
+	k8sResolverMutator = tag.Upsert(tag.MustNewKey("resolver"), "k8s")
+
+	k8sResolverSuccessTrueMutators  = []tag.Mutator{k8sResolverMutator, successTrueMutator}
+	k8sResolverSuccessFalseMutators = []tag.Mutator{k8sResolverMutator, successFalseMutator}
+)
+
+// k8sResolver resolves the backends from the EndpointSlices of a Kubernetes service. Only the ready endpoints
+// are used, so that the pods being started or terminated during a rollout don't receive data.
+type k8sResolver struct {
+	logger  *zap.Logger
+	svcName string
+	svcNs   string
+	ports   []int32
+
+	informer cache.SharedIndexInformer
+	lister   discoverylisters.EndpointSliceLister
+
+	endpoints         []string
+	onChangeCallbacks []func([]string)
+
+	stopCh             chan struct{}
+	updateLock         sync.Mutex
+	shutdownWg         sync.WaitGroup
+	changeCallbackLock sync.RWMutex
+}
+
+func newK8sResolver(clt kubernetes.Interface, logger *zap.Logger, service string, ports []int32) (*k8sResolver, error) {
+	if len(service) == 0 {
+		return nil, errNoSvc
+	}
+
+	nAddr := strings.SplitN(service, ".", 2)
+	name, namespace := nAddr[0], defaultK8sNamespace
+	if len(nAddr) > 1 {
+		namespace = nAddr[1]
+	}
+	if len(ports) == 0 {
+		ports = []int32{defaultK8sPort}
+	}
+
+	factory := informers.NewSharedInformerFactoryWithOptions(clt, 0,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = discoveryv1.LabelServiceName + "=" + name
+		}),
+	)
+	endpointSlices := factory.Discovery().V1().EndpointSlices()
+
+	r := &k8sResolver{
+		logger:   logger,
+		svcName:  name,
+		svcNs:    namespace,
+		ports:    ports,
+		informer: endpointSlices.Informer(),
+		lister:   endpointSlices.Lister(),
+		stopCh:   make(chan struct{}),
+	}
+	r.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { r.onEndpointSlicesChange() },
+		UpdateFunc: func(interface{}, interface{}) { r.onEndpointSlicesChange() },
+		DeleteFunc: func(interface{}) { r.onEndpointSlicesChange() },
+	})
+	return r, nil
+}
+
+func (r *k8sResolver) start(ctx context.Context) error {
+	go r.informer.Run(r.stopCh)
+
+	syncCtx, cancel := context.WithTimeout(ctx, defaultK8sSyncTimeout)
+	defer cancel()
+	if !cache.WaitForCacheSync(syncCtx.Done(), r.informer.HasSynced) {
+		r.logger.Warn("failed to sync the endpoint slices of the service, the backends will be resolved once synced")
+	} else if _, err := r.resolve(ctx); err != nil {
+		r.logger.Warn("failed to resolve", zap.Error(err))
+	}
+
+	r.logger.Debug("Kubernetes service resolver started",
+		zap.String("service", r.svcName), zap.String("namespace", r.svcNs), zap.Int32s("ports", r.ports))
+	return nil
+}
+
+func (r *k8sResolver) shutdown(ctx context.Context) error {
+	r.changeCallbackLock.Lock()
+	r.onChangeCallbacks = nil
+	r.changeCallbackLock.Unlock()
+
+	close(r.stopCh)
+	r.shutdownWg.Wait()
+	return nil
+}
+
+// onEndpointSlicesChange resolves the backends again, once the initial list of endpoint slices is known.
+func (r *k8sResolver) onEndpointSlicesChange() {
+	if !r.informer.HasSynced() {
+		return
+	}
+	if _, err := r.resolve(context.Background()); err != nil {
+		r.logger.Warn("failed to resolve", zap.Error(err))
+	}
+}
+
+func (r *k8sResolver) resolve(ctx context.Context) ([]string, error) {
+	r.shutdownWg.Add(1)
+	defer r.shutdownWg.Done()
+
+	// the resolutions triggered by the start and by the informer are serialized,
+	// so that an outdated list of backends never replaces a newer one
+	r.updateLock.Lock()
+	defer r.updateLock.Unlock()
+
+	slices, err := r.lister.EndpointSlices(r.svcNs).List(labels.Everything())
+	if err != nil {
+		_ = stats.RecordWithTags(ctx, k8sResolverSuccessFalseMutators, mNumResolutions.M(1))
+		return nil, err
+	}
+
+	_ = stats.RecordWithTags(ctx, k8sResolverSuccessTrueMutators, mNumResolutions.M(1))
+
+	seen := map[string]bool{}
+	var backends []string
+	for _, slice := range slices {
+		for _, endpoint := range slice.Endpoints {
+			// a nil condition means that the endpoint is ready
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			for _, addr := range endpoint.Addresses {
+				for _, port := range r.ports {
+					backend := net.JoinHostPort(addr, strconv.Itoa(int(port)))
+					if !seen[backend] {
+						seen[backend] = true
+						backends = append(backends, backend)
+					}
+				}
+			}
+		}
+	}
+
+	// keep it always in the same order
+	sort.Strings(backends)
+
+	if equalStringSlice(r.endpoints, backends) {
+		return backends, nil
+	}
+
+	// the list has changed!
+	r.endpoints = backends
+	_ = stats.RecordWithTags(ctx, k8sResolverSuccessTrueMutators, mNumBackends.M(int64(len(backends))))
+
+	// propagate the change
+	r.changeCallbackLock.RLock()
+	for _, callback := range r.onChangeCallbacks {
+		callback(backends)
+	}
+	r.changeCallbackLock.RUnlock()
+
+	return backends, nil
+}
+
+func (r *k8sResolver) onChange(f func([]string)) {
+	r.changeCallbackLock.Lock()
+	defer r.changeCallbackLock.Unlock()
+	r.onChangeCallbacks = append(r.onChangeCallbacks, f)
+}
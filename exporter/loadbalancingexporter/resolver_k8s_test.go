@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newEndpointSlice(service string, ready map[string]*bool) *discoveryv1.EndpointSlice {
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      service + "-abcde",
+			Namespace: "observability",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: service},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+	}
+	for addr, isReady := range ready {
+		slice.Endpoints = append(slice.Endpoints, discoveryv1.Endpoint{
+			Addresses:  []string{addr},
+			Conditions: discoveryv1.EndpointConditions{Ready: isReady},
+		})
+	}
+	return slice
+}
+
+func boolp(b bool) *bool {
+	return &b
+}
+
+func TestK8sResolve(t *testing.T) {
+	// prepare
+	slice := newEndpointSlice("lb", map[string]*bool{
+		"10.0.0.1": boolp(true),
+		"10.0.0.2": nil, // no condition means ready
+		"10.0.0.3": boolp(false),
+	})
+	otherSlice := newEndpointSlice("other", map[string]*bool{"10.0.1.1": boolp(true)})
+	clt := fake.NewSimpleClientset(slice, otherSlice)
+
+	res, err := newK8sResolver(clt, zap.NewNop(), "lb.observability", []int32{4317, 55690})
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	var resolved []string
+	res.onChange(func(endpoints []string) {
+		mu.Lock()
+		defer mu.Unlock()
+		resolved = endpoints
+	})
+	getResolved := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return resolved
+	}
+
+	// test
+	require.NoError(t, res.start(context.Background()))
+	defer func() {
+		require.NoError(t, res.shutdown(context.Background()))
+	}()
+
+	// verify
+	assert.Equal(t, []string{"10.0.0.1:4317", "10.0.0.1:55690", "10.0.0.2:4317", "10.0.0.2:55690"}, getResolved())
+
+	// test: the pod becomes ready
+	updated := newEndpointSlice("lb", map[string]*bool{
+		"10.0.0.1": boolp(true),
+		"10.0.0.2": boolp(true),
+		"10.0.0.3": boolp(true),
+	})
+	_, err = clt.DiscoveryV1().EndpointSlices("observability").Update(context.Background(), updated, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// verify
+	assert.Eventually(t, func() bool {
+		return len(getResolved()) == 6
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{
+		"10.0.0.1:4317", "10.0.0.1:55690",
+		"10.0.0.2:4317", "10.0.0.2:55690",
+		"10.0.0.3:4317", "10.0.0.3:55690",
+	}, getResolved())
+}
+
+func TestK8sResolverDefaults(t *testing.T) {
+	// test
+	res, err := newK8sResolver(fake.NewSimpleClientset(), zap.NewNop(), "lb", nil)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, "lb", res.svcName)
+	assert.Equal(t, "default", res.svcNs)
+	assert.Equal(t, []int32{4317}, res.ports)
+}
+
+func TestK8sResolverNoService(t *testing.T) {
+	// test
+	res, err := newK8sResolver(fake.NewSimpleClientset(), zap.NewNop(), "", nil)
+
+	// verify
+	assert.Nil(t, res)
+	assert.Equal(t, errNoSvc, err)
+}
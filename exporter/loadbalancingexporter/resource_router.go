@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+var errRoutingAttributesAndStatement = errors.New("routing_attributes and routing_statement can't be both specified")
+
+// resourceRouter computes the routing key of a resource, from some of its attributes or from an OTTL statement.
+type resourceRouter struct {
+	attributes []string
+	statement  *ottl.Statement[ottlresource.TransformContext]
+}
+
+func newResourceRouter(cfg *Config, settings component.TelemetrySettings) (*resourceRouter, error) {
+	if cfg.RoutingStatement == "" {
+		return &resourceRouter{attributes: cfg.RoutingAttributes}, nil
+	}
+	if len(cfg.RoutingAttributes) > 0 {
+		return nil, errRoutingAttributesAndStatement
+	}
+	parser := ottlresource.NewParser(routingFunctions(), settings)
+	statements, err := parser.ParseStatements([]string{cfg.RoutingStatement})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse routing_statement: %w", err)
+	}
+	return &resourceRouter{statement: statements[0]}, nil
+}
+
+// routingFunctions returns the OTTL functions available to compute the routing key.
+func routingFunctions() map[string]interface{} {
+	return map[string]interface{}{
+		"Concat":  ottlfuncs.Concat[ottlresource.TransformContext],
+		"IsMatch": ottlfuncs.IsMatch[ottlresource.TransformContext],
+	}
+}
+
+// routingKey returns the routing key of the resource. When the statement doesn't return a non-empty string,
+// the routing key is made of all the resource attributes.
+func (r *resourceRouter) routingKey(resource pcommon.Resource) string {
+	if r.statement != nil {
+		result, executed := r.statement.Execute(ottlresource.NewTransformContext(resource))
+		if key, ok := result.(string); executed && ok && key != "" {
+			return key
+		}
+		return allAttributesKey(resource.Attributes())
+	}
+	if len(r.attributes) == 0 {
+		return allAttributesKey(resource.Attributes())
+	}
+
+	var sb strings.Builder
+	for _, name := range r.attributes {
+		if value, ok := resource.Attributes().Get(name); ok {
+			sb.WriteString(value.AsString())
+		}
+		// separate the values, so that different values don't make the same key
+		sb.WriteByte(0)
+	}
+	return sb.String()
+}
+
+// allAttributesKey returns a key identifying the attributes, regardless of their order.
+func allAttributesKey(attrs pcommon.Map) string {
+	pairs := make([]string, 0, attrs.Len())
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		pairs = append(pairs, k+"="+v.AsString())
+		return true
+	})
+	sort.Strings(pairs)
+	return strings.Join(pairs, "\x00")
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadbalancingexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestResourceRouterRoutingKey(t *testing.T) {
+	newResource := func(attrs map[string]string) pcommon.Resource {
+		res := pcommon.NewResource()
+		for k, v := range attrs {
+			res.Attributes().PutStr(k, v)
+		}
+		return res
+	}
+
+	for _, tt := range []struct {
+		desc   string
+		config *Config
+		res1   pcommon.Resource
+		res2   pcommon.Resource
+		same   bool
+	}{
+		{
+			desc:   "all attributes in a different order",
+			config: simpleConfig(),
+			res1:   newResource(map[string]string{"service.name": "svc", "host.name": "host-1"}),
+			res2: func() pcommon.Resource {
+				res := pcommon.NewResource()
+				res.Attributes().PutStr("host.name", "host-1")
+				res.Attributes().PutStr("service.name", "svc")
+				return res
+			}(),
+			same: true,
+		},
+		{
+			desc:   "all attributes with different values",
+			config: simpleConfig(),
+			res1:   newResource(map[string]string{"service.name": "svc", "host.name": "host-1"}),
+			res2:   newResource(map[string]string{"service.name": "svc", "host.name": "host-2"}),
+			same:   false,
+		},
+		{
+			desc: "selected attributes",
+			config: func() *Config {
+				cfg := simpleConfig()
+				cfg.RoutingAttributes = []string{"host.name"}
+				return cfg
+			}(),
+			res1: newResource(map[string]string{"service.name": "svc-1", "host.name": "host-1"}),
+			res2: newResource(map[string]string{"service.name": "svc-2", "host.name": "host-1"}),
+			same: true,
+		},
+		{
+			desc: "selected attributes with values moved between attributes",
+			config: func() *Config {
+				cfg := simpleConfig()
+				cfg.RoutingAttributes = []string{"k8s.namespace.name", "k8s.pod.name"}
+				return cfg
+			}(),
+			res1: newResource(map[string]string{"k8s.namespace.name": "ab", "k8s.pod.name": "c"}),
+			res2: newResource(map[string]string{"k8s.namespace.name": "a", "k8s.pod.name": "bc"}),
+			same: false,
+		},
+		{
+			desc: "statement",
+			config: func() *Config {
+				cfg := simpleConfig()
+				cfg.RoutingStatement = `Concat("/", attributes["k8s.namespace.name"], attributes["k8s.pod.name"])`
+				return cfg
+			}(),
+			res1: newResource(map[string]string{"k8s.namespace.name": "ns", "k8s.pod.name": "pod", "service.name": "svc-1"}),
+			res2: newResource(map[string]string{"k8s.namespace.name": "ns", "k8s.pod.name": "pod", "service.name": "svc-2"}),
+			same: true,
+		},
+		{
+			desc: "statement not executed",
+			config: func() *Config {
+				cfg := simpleConfig()
+				cfg.RoutingStatement = `Concat("/", attributes["k8s.pod.name"]) where attributes["k8s.pod.name"] != nil`
+				return cfg
+			}(),
+			res1: newResource(map[string]string{"service.name": "svc-1"}),
+			res2: newResource(map[string]string{"service.name": "svc-2"}),
+			same: false,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			router, err := newResourceRouter(tt.config, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			// test
+			key1 := router.routingKey(tt.res1)
+			key2 := router.routingKey(tt.res2)
+
+			// verify
+			assert.NotEmpty(t, key1)
+			if tt.same {
+				assert.Equal(t, key1, key2)
+			} else {
+				assert.NotEqual(t, key1, key2)
+			}
+		})
+	}
+}
+
+func TestNewResourceRouterInvalidConfig(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.RoutingAttributes = []string{"host.name"}
+	cfg.RoutingStatement = `Concat("/", attributes["host.name"])`
+
+	// test
+	_, err := newResourceRouter(cfg, componenttest.NewNopTelemetrySettings())
+
+	// verify
+	assert.Equal(t, errRoutingAttributesAndStatement, err)
+
+	cfg.RoutingAttributes = nil
+	cfg.RoutingStatement = `Unknown(attributes["host.name"])`
+
+	// test
+	_, err = newResourceRouter(cfg, componenttest.NewNopTelemetrySettings())
+
+	// verify
+	assert.ErrorContains(t, err, "failed to parse routing_statement")
+}
@@ -18,7 +18,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sync"
 	"time"
 
 	"go.opencensus.io/stats"
@@ -38,9 +37,7 @@ var _ component.TracesExporter = (*traceExporterImp)(nil)
 type traceExporterImp struct {
 	loadBalancer loadBalancer
 	routingKey   routingKey
-
-	stopped    bool
-	shutdownWg sync.WaitGroup
+	router       *resourceRouter
 }
 
 // Create new traces exporter
@@ -60,6 +57,12 @@ func newTracesExporter(params component.ExporterCreateSettings, cfg config.Expor
 	switch cfg.(*Config).RoutingKey {
 	case "service":
 		traceExporter.routingKey = svcRouting
+	case "resource":
+		traceExporter.routingKey = resourceRouting
+		traceExporter.router, err = newResourceRouter(cfg.(*Config), params.TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
 	case "traceID", "":
 	default:
 		return nil, fmt.Errorf("unsupported routing_key: %s", cfg.(*Config).RoutingKey)
@@ -83,8 +86,6 @@ func (e *traceExporterImp) Start(ctx context.Context, host component.Host) error
 }
 
 func (e *traceExporterImp) Shutdown(context.Context) error {
-	e.stopped = true
-	e.shutdownWg.Wait()
 	return nil
 }
 
@@ -100,7 +101,7 @@ func (e *traceExporterImp) ConsumeTraces(ctx context.Context, td ptrace.Traces)
 
 func (e *traceExporterImp) consumeTrace(ctx context.Context, td ptrace.Traces) error {
 	var exp component.Exporter
-	routingIds, err := routingIdentifiersFromTraces(td, e.routingKey)
+	routingIds, err := e.routingIdentifiers(td)
 	if err != nil {
 		return err
 	}
@@ -136,6 +137,21 @@ func (e *traceExporterImp) consumeTrace(ctx context.Context, td ptrace.Traces) e
 	return err
 }
 
+func (e *traceExporterImp) routingIdentifiers(td ptrace.Traces) (map[string]bool, error) {
+	if e.routingKey != resourceRouting {
+		return routingIdentifiersFromTraces(td, e.routingKey)
+	}
+	rs := td.ResourceSpans()
+	if rs.Len() == 0 {
+		return nil, errors.New("empty resource spans")
+	}
+	ids := make(map[string]bool)
+	for i := 0; i < rs.Len(); i++ {
+		ids[e.router.routingKey(rs.At(i).Resource())] = true
+	}
+	return ids, nil
+}
+
 func routingIdentifiersFromTraces(td ptrace.Traces, key routingKey) (map[string]bool, error) {
 	ids := make(map[string]bool)
 	rs := td.ResourceSpans()
@@ -199,6 +199,22 @@ func TestConsumeTracesServiceBased(t *testing.T) {
 	assert.Nil(t, res)
 }
 
+func TestResourceBasedRoutingForSameTraceId(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.RoutingKey = "resource"
+	cfg.RoutingAttributes = []string{"service.name"}
+	p, err := newTracesExporter(componenttest.NewNopExporterCreateSettings(), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, resourceRouting, p.routingKey)
+
+	// test
+	res, err := p.routingIdentifiers(twoServicesWithSameTraceID())
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"ad-service-1\x00": true, "get-recommendations-7\x00": true}, res)
+}
+
 func TestServiceBasedRoutingForSameTraceId(t *testing.T) {
 	b := pcommon.TraceID([16]byte{1, 2, 3, 4})
 	for _, tt := range []struct {
@@ -23,6 +23,13 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 )
 
+const (
+	// protocolLoki sends the logs to the push API of Loki.
+	protocolLoki = "loki"
+	// protocolOTLP sends the logs to the native OTLP endpoint of Loki.
+	protocolOTLP = "otlp"
+)
+
 // Config defines configuration for Loki exporter.
 type Config struct {
 	config.ExporterSettings       `mapstructure:",squash"`
@@ -30,6 +37,10 @@ type Config struct {
 	exporterhelper.QueueSettings  `mapstructure:"sending_queue"`
 	exporterhelper.RetrySettings  `mapstructure:"retry_on_failure"`
 
+	// Protocol defines how the logs are sent to Loki: "loki", the default, uses the push API of Loki,
+	// while "otlp" uses the native OTLP endpoint of Loki, which stores the attributes as structured metadata.
+	Protocol string `mapstructure:"protocol"`
+
 	// TenantID defines the tenant ID to associate log streams with.
 	// Deprecated: [v0.57.0] use the attribute processor to add a `loki.tenant` hint.
 	// See this component's documentation for more information on how to specify the hint.
@@ -57,6 +68,16 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("\"endpoint\" must be a valid URL")
 	}
 
+	switch c.Protocol {
+	case "", protocolLoki:
+	case protocolOTLP:
+		if c.isLegacy() {
+			return fmt.Errorf("the %q protocol can't be used with the deprecated settings", protocolOTLP)
+		}
+	default:
+		return fmt.Errorf("invalid protocol, must be one of '%s', '%s', but is %s", protocolLoki, protocolOTLP, c.Protocol)
+	}
+
 	// further validation is needed only if we are in legacy mode
 	if !c.isLegacy() {
 		return nil
@@ -72,6 +72,15 @@ func TestLoadConfigNewExporter(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "otlp"),
+			expected: func() config.Exporter {
+				cfg := createDefaultLegacyConfig().(*Config)
+				cfg.Endpoint = "https://loki:3100/otlp/v1/logs"
+				cfg.Protocol = protocolOTLP
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -89,6 +98,56 @@ func TestLoadConfigNewExporter(t *testing.T) {
 	}
 }
 
+func TestValidateProtocol(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		cfg      *Config
+		expected string
+	}{
+		{
+			desc: "loki protocol",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "https://loki.example.com"},
+				Protocol:           protocolLoki,
+			},
+		},
+		{
+			desc: "otlp protocol",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "https://loki.example.com"},
+				Protocol:           protocolOTLP,
+			},
+		},
+		{
+			desc: "otlp protocol with deprecated settings",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "https://loki.example.com"},
+				Protocol:           protocolOTLP,
+				Format:             stringp("body"),
+			},
+			expected: `the "otlp" protocol can't be used with the deprecated settings`,
+		},
+		{
+			desc: "unknown protocol",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "https://loki.example.com"},
+				Protocol:           "grpc",
+			},
+			expected: "invalid protocol, must be one of 'loki', 'otlp', but is grpc",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			err := tC.cfg.Validate()
+			if tC.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tC.expected)
+		})
+	}
+}
+
 func TestIsLegacy(t *testing.T) {
 	testCases := []struct {
 		desc    string
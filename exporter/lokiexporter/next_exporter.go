@@ -26,6 +26,8 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/loki"
@@ -48,6 +50,10 @@ func newNextExporter(config *Config, settings component.TelemetrySettings) *next
 }
 
 func (l *nextLokiExporter) pushLogData(ctx context.Context, ld plog.Logs) error {
+	if l.config.Protocol == protocolOTLP {
+		return l.pushOTLPLogData(ctx, ld)
+	}
+
 	pushReq, report := loki.LogsToLoki(ld)
 	if len(pushReq.Streams) == 0 {
 		return consumererror.NewPermanent(fmt.Errorf("failed to transform logs into Loki log streams"))
@@ -65,6 +71,44 @@ func (l *nextLokiExporter) pushLogData(ctx context.Context, ld plog.Logs) error
 		return consumererror.NewPermanent(err)
 	}
 
+	if err = l.send(ctx, buf, ""); err != nil && !consumererror.IsPermanent(err) {
+		return consumererror.NewLogs(err, ld)
+	}
+	return err
+}
+
+// pushOTLPLogData sends the logs of each tenant to the native OTLP endpoint of Loki.
+// Only the logs of the tenants whose request failed with a retryable error are retried.
+func (l *nextLokiExporter) pushOTLPLogData(ctx context.Context, ld plog.Logs) error {
+	var permanentErrs, retryableErrs error
+	failed := plog.NewLogs()
+	for tenant, logs := range loki.LogsToLokiOTLP(ld) {
+		buf, err := plogotlp.NewRequestFromLogs(logs).MarshalProto()
+		if err != nil {
+			permanentErrs = multierr.Append(permanentErrs, consumererror.NewPermanent(err))
+			continue
+		}
+		if err = l.send(ctx, buf, tenant); err != nil {
+			if consumererror.IsPermanent(err) {
+				permanentErrs = multierr.Append(permanentErrs, err)
+				continue
+			}
+			retryableErrs = multierr.Append(retryableErrs, err)
+			logs.ResourceLogs().MoveAndAppendTo(failed.ResourceLogs())
+		}
+	}
+
+	if retryableErrs == nil {
+		return permanentErrs
+	}
+	if permanentErrs != nil {
+		l.settings.Logger.Error("dropping the logs of some tenants", zap.Error(permanentErrs))
+	}
+	return consumererror.NewLogs(retryableErrs, failed)
+}
+
+// send posts the encoded logs to Loki, setting the tenant header if the tenant isn't empty.
+func (l *nextLokiExporter) send(ctx context.Context, buf []byte, tenant string) error {
 	req, err := http.NewRequestWithContext(ctx, "POST", l.config.HTTPClientSettings.Endpoint, bytes.NewReader(buf))
 	if err != nil {
 		return consumererror.NewPermanent(err)
@@ -74,10 +118,13 @@ func (l *nextLokiExporter) pushLogData(ctx context.Context, ld plog.Logs) error
 		req.Header.Set(k, v)
 	}
 	req.Header.Set("Content-Type", "application/x-protobuf")
+	if tenant != "" {
+		req.Header.Set("X-Scope-OrgID", tenant)
+	}
 
 	resp, err := l.client.Do(req)
 	if err != nil {
-		return consumererror.NewLogs(err, ld)
+		return err
 	}
 
 	defer func() {
@@ -91,8 +138,7 @@ func (l *nextLokiExporter) pushLogData(ctx context.Context, ld plog.Logs) error
 		if scanner.Scan() {
 			line = scanner.Text()
 		}
-		err = fmt.Errorf("HTTP %d %q: %s", resp.StatusCode, http.StatusText(resp.StatusCode), line)
-		return consumererror.NewLogs(err, ld)
+		return fmt.Errorf("HTTP %d %q: %s", resp.StatusCode, http.StatusText(resp.StatusCode), line)
 	}
 
 	return nil
@@ -30,6 +30,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 )
 
 func TestPushLogData(t *testing.T) {
@@ -133,3 +134,57 @@ func TestPushLogData(t *testing.T) {
 		})
 	}
 }
+
+func TestPushOTLPLogData(t *testing.T) {
+	received := map[string]plog.Logs{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		payload, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		req := plogotlp.NewRequest()
+		require.NoError(t, req.UnmarshalProto(payload))
+		received[r.Header.Get("X-Scope-OrgID")] = req.Logs()
+	}))
+	defer ts.Close()
+
+	cfg := &Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: ts.URL,
+		},
+		Protocol: protocolOTLP,
+	}
+
+	f := NewFactory()
+	exp, err := f.CreateLogsExporter(context.Background(), componenttest.NewNopExporterCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	rl.Resource().Attributes().PutStr("loki.tenant", "tenant.id")
+	rl.Resource().Attributes().PutStr("tenant.id", "acme")
+	logRecord := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	logRecord.Body().SetStr("payment processed")
+	logRecord.Attributes().PutStr("loki.attribute.labels", "http.method")
+	logRecord.Attributes().PutStr("http.method", "POST")
+	logRecord.Attributes().PutStr("order.id", "1234")
+
+	require.NoError(t, exp.ConsumeLogs(context.Background(), ld))
+	require.NoError(t, exp.Shutdown(context.Background()))
+
+	require.Contains(t, received, "acme")
+	logs := received["acme"]
+	require.Equal(t, 1, logs.ResourceLogs().Len())
+	assert.Equal(t, map[string]interface{}{
+		"service.name": "checkout",
+		"tenant.id":    "acme",
+		"http.method":  "POST",
+	}, logs.ResourceLogs().At(0).Resource().Attributes().AsRaw())
+
+	records := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 1, records.Len())
+	assert.Equal(t, "payment processed", records.At(0).Body().Str())
+	assert.Equal(t, map[string]interface{}{"order.id": "1234"}, records.At(0).Attributes().AsRaw())
+}
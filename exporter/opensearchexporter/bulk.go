@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/collector/consumer/consumererror"
+)
+
+// bulkRequest accumulates documents in the newline delimited JSON format of the bulk API.
+type bulkRequest struct {
+	action string
+	body   bytes.Buffer
+	enc    *json.Encoder
+	items  int
+}
+
+func newBulkRequest(action string) *bulkRequest {
+	r := &bulkRequest{action: action}
+	r.enc = json.NewEncoder(&r.body)
+	return r
+}
+
+// add appends the action metadata and the document to the request, each of them
+// terminated with the newline expected by the bulk API. The document is marshaled
+// first, so that the request is left unchanged if it can't be.
+func (r *bulkRequest) add(index string, document interface{}) error {
+	doc, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+	meta := map[string]map[string]string{r.action: {"_index": index}}
+	if err = r.enc.Encode(meta); err != nil {
+		return err
+	}
+	r.body.Write(doc)
+	r.body.WriteByte('\n')
+	r.items++
+	return nil
+}
+
+type bulkResponse struct {
+	Errors bool                          `json:"errors"`
+	Items  []map[string]bulkResponseItem `json:"items"`
+}
+
+type bulkResponseItem struct {
+	Index  string             `json:"_index"`
+	Status int                `json:"status"`
+	Error  *bulkResponseError `json:"error,omitempty"`
+}
+
+type bulkResponseError struct {
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+// send posts the bulk request and checks the status of every item. Items rejected
+// because of the document itself are dropped, while any throttled or failed item
+// makes the whole request retryable.
+func (e *opensearchExporter) send(ctx context.Context, r *bulkRequest) error {
+	if r.items == 0 {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.bulkURL, bytes.NewReader(r.body.Bytes()))
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+		return fmt.Errorf("bulk request returned %q %q", resp.Status, string(body))
+	case resp.StatusCode >= http.StatusMultipleChoices:
+		return consumererror.NewPermanent(fmt.Errorf("bulk request returned %q %q", resp.Status, string(body)))
+	}
+
+	var bulkResp bulkResponse
+	if err = json.Unmarshal(body, &bulkResp); err != nil {
+		return consumererror.NewPermanent(fmt.Errorf("failed to decode bulk response: %w", err))
+	}
+	if !bulkResp.Errors {
+		return nil
+	}
+
+	var retryable, rejected int
+	var firstErr *bulkResponseError
+	for _, item := range bulkResp.Items {
+		for _, result := range item {
+			if result.Error == nil {
+				continue
+			}
+			if firstErr == nil {
+				firstErr = result.Error
+			}
+			if result.Status == http.StatusTooManyRequests || result.Status >= http.StatusInternalServerError {
+				retryable++
+			} else {
+				rejected++
+			}
+		}
+	}
+	if firstErr == nil {
+		return nil
+	}
+
+	err = fmt.Errorf("%d of %d documents failed (%d retryable), first error: %s: %s",
+		retryable+rejected, r.items, retryable, firstErr.Type, firstErr.Reason)
+	if retryable == 0 {
+		return consumererror.NewPermanent(err)
+	}
+	return err
+}
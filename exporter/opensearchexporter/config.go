@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	// bulkActionCreate only adds new documents, it is the only action accepted by data streams.
+	bulkActionCreate = "create"
+	// bulkActionIndex adds or replaces documents, it can be used to write to regular indices.
+	bulkActionIndex = "index"
+)
+
+var (
+	errEndpointRequired  = errors.New("endpoint must be specified")
+	errDatasetRequired   = errors.New("dataset must be specified")
+	errNamespaceRequired = errors.New("namespace must be specified")
+	errDatasetHyphen     = errors.New("dataset must not contain a hyphen")
+)
+
+// Config defines configuration for the OpenSearch exporter.
+type Config struct {
+	config.ExporterSettings       `mapstructure:",squash"`
+	confighttp.HTTPClientSettings `mapstructure:",squash"`
+	exporterhelper.QueueSettings  `mapstructure:"sending_queue"`
+	exporterhelper.RetrySettings  `mapstructure:"retry_on_failure"`
+
+	// Dataset identifies the source of the data. Together with Namespace, it
+	// composes the SS4O data stream name: ss4o_{type}-{dataset}-{namespace}.
+	Dataset string `mapstructure:"dataset"`
+
+	// Namespace is a user-defined grouping of the data, e.g. an environment.
+	Namespace string `mapstructure:"namespace"`
+
+	// LogsIndex, if set, is the index or data stream logs are written to
+	// instead of the SS4O logs data stream.
+	LogsIndex string `mapstructure:"logs_index"`
+
+	// TracesIndex, if set, is the index or data stream spans are written to
+	// instead of the SS4O traces data stream.
+	TracesIndex string `mapstructure:"traces_index"`
+
+	// BulkAction is the bulk API action used to write documents, either
+	// "create" or "index". Data streams only accept "create".
+	BulkAction string `mapstructure:"bulk_action"`
+}
+
+var _ config.Exporter = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errEndpointRequired
+	}
+	if cfg.Dataset == "" {
+		return errDatasetRequired
+	}
+	if strings.Contains(cfg.Dataset, "-") {
+		return errDatasetHyphen
+	}
+	if cfg.Namespace == "" {
+		return errNamespaceRequired
+	}
+	if cfg.BulkAction != bulkActionCreate && cfg.BulkAction != bulkActionIndex {
+		return fmt.Errorf("bulk_action must be either %q or %q, got %q", bulkActionCreate, bulkActionIndex, cfg.BulkAction)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearchexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	defaultCfg := createDefaultConfig().(*Config)
+	defaultCfg.Endpoint = "https://opensearch.example.com:9200"
+
+	tests := []struct {
+		id       config.ComponentID
+		expected config.Exporter
+	}{
+		{
+			id:       config.NewComponentIDWithName(typeStr, ""),
+			expected: defaultCfg,
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "customname"),
+			expected: &Config{
+				ExporterSettings: config.NewExporterSettings(config.NewComponentID(typeStr)),
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "https://search-domain.us-east-1.es.amazonaws.com",
+					Timeout:  2 * time.Minute,
+					Headers:  map[string]string{"myheader": "test"},
+					Auth:     &configauth.Authentication{AuthenticatorID: config.NewComponentID("sigv4auth")},
+				},
+				QueueSettings: exporterhelper.QueueSettings{
+					Enabled:      true,
+					NumConsumers: 2,
+					QueueSize:    10,
+				},
+				RetrySettings: exporterhelper.RetrySettings{
+					Enabled:         true,
+					InitialInterval: 10 * time.Second,
+					MaxInterval:     1 * time.Minute,
+					MaxElapsedTime:  10 * time.Minute,
+				},
+				Dataset:     "ngnix",
+				Namespace:   "eu",
+				LogsIndex:   "otel-logs",
+				TracesIndex: "otel-traces",
+				BulkAction:  bulkActionIndex,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, config.UnmarshalExporter(sub, cfg))
+
+			assert.NoError(t, cfg.Validate())
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(cfg *Config)
+		wantErr string
+	}{
+		{
+			name:    "missing endpoint",
+			mutate:  func(cfg *Config) { cfg.Endpoint = "" },
+			wantErr: errEndpointRequired.Error(),
+		},
+		{
+			name:    "missing dataset",
+			mutate:  func(cfg *Config) { cfg.Dataset = "" },
+			wantErr: errDatasetRequired.Error(),
+		},
+		{
+			name:    "dataset with hyphen",
+			mutate:  func(cfg *Config) { cfg.Dataset = "my-app" },
+			wantErr: errDatasetHyphen.Error(),
+		},
+		{
+			name:    "missing namespace",
+			mutate:  func(cfg *Config) { cfg.Namespace = "" },
+			wantErr: errNamespaceRequired.Error(),
+		},
+		{
+			name:    "invalid bulk action",
+			mutate:  func(cfg *Config) { cfg.BulkAction = "update" },
+			wantErr: `bulk_action must be either "create" or "index", got "update"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = "http://localhost:9200"
+			tt.mutate(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter"
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	dataStreamTypeLogs   = "logs"
+	dataStreamTypeTraces = "traces"
+
+	// The resource attributes overriding the configured dataset and namespace.
+	dataStreamDatasetAttribute   = "data_stream.dataset"
+	dataStreamNamespaceAttribute = "data_stream.namespace"
+)
+
+type opensearchExporter struct {
+	cfg      *Config
+	settings component.TelemetrySettings
+	client   *http.Client
+	bulkURL  string
+}
+
+func newOpenSearchExporter(cfg *Config, set component.ExporterCreateSettings) *opensearchExporter {
+	return &opensearchExporter{
+		cfg:      cfg,
+		settings: set.TelemetrySettings,
+	}
+}
+
+// start creates the HTTP client, which applies the configured authenticator (e.g. sigv4auth).
+func (e *opensearchExporter) start(_ context.Context, host component.Host) error {
+	bulkURL, err := url.Parse(e.cfg.Endpoint)
+	if err != nil {
+		return err
+	}
+	bulkURL.Path = path.Join(bulkURL.Path, "_bulk")
+	e.bulkURL = bulkURL.String()
+
+	client, err := e.cfg.HTTPClientSettings.ToClient(host, e.settings)
+	if err != nil {
+		return err
+	}
+	e.client = client
+	return nil
+}
+
+func (e *opensearchExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	req := newBulkRequest(e.cfg.BulkAction)
+	var errs error
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		resource := rl.Resource()
+		ds := e.dataStream(dataStreamTypeLogs, resource)
+		index := e.index(e.cfg.LogsIndex, ds)
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			logs := sl.LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				doc := newSSORecord(logs.At(k), sl.Scope(), sl.SchemaUrl(), resource, ds)
+				if err := req.add(index, doc); err != nil {
+					errs = multierr.Append(errs, err)
+				}
+			}
+		}
+	}
+
+	e.logDropped(dataStreamTypeLogs, errs)
+	return e.send(ctx, req)
+}
+
+func (e *opensearchExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
+	req := newBulkRequest(e.cfg.BulkAction)
+	var errs error
+
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		resource := rs.Resource()
+		ds := e.dataStream(dataStreamTypeTraces, resource)
+		index := e.index(e.cfg.TracesIndex, ds)
+		sss := rs.ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			ss := sss.At(j)
+			spans := ss.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				doc := newSSOSpan(spans.At(k), ss.Scope(), ss.SchemaUrl(), resource, ds)
+				if err := req.add(index, doc); err != nil {
+					errs = multierr.Append(errs, err)
+				}
+			}
+		}
+	}
+
+	e.logDropped(dataStreamTypeTraces, errs)
+	return e.send(ctx, req)
+}
+
+// logDropped reports the documents which could not be marshaled. They are not
+// part of the returned error, which would otherwise be permanent and prevent the
+// bulk request from being retried.
+func (e *opensearchExporter) logDropped(typ string, errs error) {
+	if errs == nil {
+		return
+	}
+	e.settings.Logger.Warn("dropping documents which could not be marshaled",
+		zap.String("type", typ),
+		zap.Int("dropped", len(multierr.Errors(errs))),
+		zap.Error(errs))
+}
+
+// dataStream returns the SS4O data stream of the resource, whose attributes can
+// override the configured dataset and namespace.
+func (e *opensearchExporter) dataStream(typ string, resource pcommon.Resource) ssoDataStream {
+	ds := ssoDataStream{
+		Type:      typ,
+		Dataset:   e.cfg.Dataset,
+		Namespace: e.cfg.Namespace,
+	}
+	if v, ok := resource.Attributes().Get(dataStreamDatasetAttribute); ok && v.AsString() != "" {
+		// Hyphens separate the parts of the data stream name.
+		ds.Dataset = strings.ReplaceAll(strings.ToLower(v.AsString()), "-", "_")
+	}
+	if v, ok := resource.Attributes().Get(dataStreamNamespaceAttribute); ok && v.AsString() != "" {
+		ds.Namespace = strings.ToLower(v.AsString())
+	}
+	return ds
+}
+
+// index returns the configured index if any, or the name of the SS4O data stream.
+func (e *opensearchExporter) index(configured string, ds ssoDataStream) string {
+	if configured != "" {
+		return configured
+	}
+	return "ss4o_" + ds.Type + "-" + ds.Dataset + "-" + ds.Namespace
+}
@@ -0,0 +1,238 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearchexporter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// bulkLine is a bulk request line, either action metadata or a document.
+type bulkLine map[string]interface{}
+
+// newBulkServer starts a server answering bulk requests with the given response,
+// and returns the lines of the last received request.
+func newBulkServer(t *testing.T, status int, response string) (*httptest.Server, *[]bulkLine) {
+	var lines []bulkLine
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/_bulk", r.URL.Path)
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		lines = nil
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var line bulkLine
+			assert.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+			lines = append(lines, line)
+		}
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, response)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &lines
+}
+
+func newTestExporter(t *testing.T, endpoint string, mutate func(cfg *Config)) *opensearchExporter {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	if mutate != nil {
+		mutate(cfg)
+	}
+	exp := newOpenSearchExporter(cfg, componenttest.NewNopExporterCreateSettings())
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	return exp
+}
+
+func TestPushLogs(t *testing.T) {
+	srv, lines := newBulkServer(t, http.StatusOK, `{"errors":false,"items":[]}`)
+	exp := newTestExporter(t, srv.URL, nil)
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	rl.Resource().Attributes().PutStr(dataStreamDatasetAttribute, "Web-App")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("scope")
+	sl.SetSchemaUrl("https://opentelemetry.io/schemas/1.6.1")
+	record := sl.LogRecords().AppendEmpty()
+	record.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Unix(1, 0)))
+	record.SetSeverityText("INFO")
+	record.SetSeverityNumber(plog.SeverityNumberInfo)
+	record.Body().SetStr("hello")
+	record.Attributes().PutStr("key", "value")
+	record.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+
+	require.NoError(t, exp.pushLogs(context.Background(), ld))
+	require.Len(t, *lines, 2)
+
+	assert.Equal(t, bulkLine{"create": map[string]interface{}{"_index": "ss4o_logs-web_app-namespace"}}, (*lines)[0])
+	doc := (*lines)[1]
+	assert.Equal(t, "1970-01-01T00:00:01Z", doc["@timestamp"])
+	assert.Equal(t, "1970-01-01T00:00:01Z", doc["observedTimestamp"])
+	assert.Equal(t, "hello", doc["body"])
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", doc["traceId"])
+	assert.NotContains(t, doc, "spanId")
+	assert.Equal(t, map[string]interface{}{"text": "INFO", "number": float64(9)}, doc["severity"])
+	assert.Equal(t, map[string]interface{}{
+		"key": "value",
+		"data_stream": map[string]interface{}{
+			"type":      "logs",
+			"dataset":   "web_app",
+			"namespace": "namespace",
+		},
+	}, doc["attributes"])
+	assert.Equal(t, "checkout", doc["resource"].(map[string]interface{})["service.name"])
+	assert.Equal(t, map[string]interface{}{"name": "scope", "schemaUrl": "https://opentelemetry.io/schemas/1.6.1"}, doc["instrumentationScope"])
+}
+
+func TestPushTraces(t *testing.T) {
+	srv, lines := newBulkServer(t, http.StatusOK, `{"errors":false,"items":[]}`)
+	exp := newTestExporter(t, srv.URL, func(cfg *Config) {
+		cfg.TracesIndex = "otel-traces"
+		cfg.BulkAction = bulkActionIndex
+	})
+
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("GET /cart")
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetTraceID([16]byte{1})
+	span.SetSpanID([8]byte{2})
+	span.SetParentSpanID([8]byte{3})
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(time.Unix(10, 0)))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(time.Unix(12, 0)))
+	span.Status().SetCode(ptrace.StatusCodeError)
+	span.Status().SetMessage("boom")
+	event := span.Events().AppendEmpty()
+	event.SetName("exception")
+	event.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(11, 0)))
+	link := span.Links().AppendEmpty()
+	link.SetTraceID([16]byte{4})
+	link.SetSpanID([8]byte{5})
+
+	require.NoError(t, exp.pushTraces(context.Background(), td))
+	require.Len(t, *lines, 2)
+
+	assert.Equal(t, bulkLine{"index": map[string]interface{}{"_index": "otel-traces"}}, (*lines)[0])
+	doc := (*lines)[1]
+	assert.Equal(t, "GET /cart", doc["name"])
+	assert.Equal(t, ptrace.SpanKindServer.String(), doc["kind"])
+	assert.Equal(t, "01000000000000000000000000000000", doc["traceId"])
+	assert.Equal(t, "0200000000000000", doc["spanId"])
+	assert.Equal(t, "0300000000000000", doc["parentSpanId"])
+	assert.Equal(t, "1970-01-01T00:00:10Z", doc["startTime"])
+	assert.Equal(t, "1970-01-01T00:00:12Z", doc["endTime"])
+	assert.Equal(t, float64(2e9), doc["durationInNanos"])
+	assert.Equal(t, map[string]interface{}{"code": ptrace.StatusCodeError.String(), "message": "boom"}, doc["status"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"@timestamp": "1970-01-01T00:00:11Z", "name": "exception"}}, doc["events"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"traceId": "04000000000000000000000000000000", "spanId": "0500000000000000"}}, doc["links"])
+}
+
+func TestPushErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		response      string
+		wantErr       string
+		wantPermanent bool
+	}{
+		{
+			name:     "throttled",
+			status:   http.StatusTooManyRequests,
+			response: "too many requests",
+			wantErr:  `bulk request returned "429 Too Many Requests" "too many requests"`,
+		},
+		{
+			name:          "unauthorized",
+			status:        http.StatusForbidden,
+			response:      "forbidden",
+			wantErr:       `Permanent error: bulk request returned "403 Forbidden" "forbidden"`,
+			wantPermanent: true,
+		},
+		{
+			name:          "rejected document",
+			status:        http.StatusOK,
+			response:      `{"errors":true,"items":[{"create":{"status":400,"error":{"type":"mapper_parsing_exception","reason":"failed to parse"}}}]}`,
+			wantErr:       "Permanent error: 1 of 1 documents failed (0 retryable), first error: mapper_parsing_exception: failed to parse",
+			wantPermanent: true,
+		},
+		{
+			name:     "rejected by full queue",
+			status:   http.StatusOK,
+			response: `{"errors":true,"items":[{"create":{"status":429,"error":{"type":"es_rejected_execution_exception","reason":"queue full"}}}]}`,
+			wantErr:  "1 of 1 documents failed (1 retryable), first error: es_rejected_execution_exception: queue full",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _ := newBulkServer(t, tt.status, tt.response)
+			exp := newTestExporter(t, srv.URL, nil)
+
+			ld := plog.NewLogs()
+			ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("hello")
+
+			err := exp.pushLogs(context.Background(), ld)
+			assert.EqualError(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantPermanent, consumererror.IsPermanent(err))
+		})
+	}
+}
+
+func TestPushRetriesDespiteDroppedDocuments(t *testing.T) {
+	srv, lines := newBulkServer(t, http.StatusServiceUnavailable, "unavailable")
+	exp := newTestExporter(t, srv.URL, nil)
+	core, logs := observer.New(zap.WarnLevel)
+	exp.settings.Logger = zap.New(core)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().Attributes().PutDouble("ratio", math.NaN())
+	records.AppendEmpty().Body().SetStr("hello")
+
+	err := exp.pushLogs(context.Background(), ld)
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+	// The document which could not be marshaled is left out of the request
+	assert.Len(t, *lines, 2)
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, int64(1), logs.All()[0].ContextMap()["dropped"])
+}
+
+func TestPushEmpty(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected")
+	}))
+	defer srv.Close()
+	exp := newTestExporter(t, srv.URL, nil)
+
+	assert.NoError(t, exp.pushLogs(context.Background(), plog.NewLogs()))
+	assert.NoError(t, exp.pushTraces(context.Background(), ptrace.NewTraces()))
+}
@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opensearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// The documents below follow the Simple Schema for Observability (SS4O):
+// https://github.com/opensearch-project/observability/tree/main/schema/observability
+
+const dataStreamAttribute = "data_stream"
+
+type ssoDataStream struct {
+	Type      string `json:"type"`
+	Dataset   string `json:"dataset"`
+	Namespace string `json:"namespace"`
+}
+
+type ssoScope struct {
+	Name      string `json:"name,omitempty"`
+	Version   string `json:"version,omitempty"`
+	SchemaURL string `json:"schemaUrl,omitempty"`
+}
+
+type ssoSeverity struct {
+	Text   string `json:"text,omitempty"`
+	Number int32  `json:"number,omitempty"`
+}
+
+type ssoRecord struct {
+	Timestamp            time.Time              `json:"@timestamp"`
+	ObservedTimestamp    *time.Time             `json:"observedTimestamp,omitempty"`
+	TraceID              string                 `json:"traceId,omitempty"`
+	SpanID               string                 `json:"spanId,omitempty"`
+	Severity             ssoSeverity            `json:"severity"`
+	Body                 string                 `json:"body"`
+	Attributes           map[string]interface{} `json:"attributes"`
+	Resource             map[string]interface{} `json:"resource,omitempty"`
+	InstrumentationScope ssoScope               `json:"instrumentationScope"`
+	SchemaURL            string                 `json:"schemaUrl,omitempty"`
+}
+
+type ssoSpanStatus struct {
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type ssoSpanEvent struct {
+	Timestamp  time.Time              `json:"@timestamp"`
+	Name       string                 `json:"name"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+type ssoSpanLink struct {
+	TraceID    string                 `json:"traceId"`
+	SpanID     string                 `json:"spanId"`
+	TraceState string                 `json:"traceState,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+type ssoSpan struct {
+	TraceID                string                 `json:"traceId"`
+	SpanID                 string                 `json:"spanId"`
+	ParentSpanID           string                 `json:"parentSpanId"`
+	TraceState             string                 `json:"traceState,omitempty"`
+	Name                   string                 `json:"name"`
+	Kind                   string                 `json:"kind"`
+	StartTime              time.Time              `json:"startTime"`
+	EndTime                time.Time              `json:"endTime"`
+	DurationInNanos        int64                  `json:"durationInNanos"`
+	Status                 ssoSpanStatus          `json:"status"`
+	Attributes             map[string]interface{} `json:"attributes"`
+	DroppedAttributesCount uint32                 `json:"droppedAttributesCount"`
+	Events                 []ssoSpanEvent         `json:"events,omitempty"`
+	DroppedEventsCount     uint32                 `json:"droppedEventsCount"`
+	Links                  []ssoSpanLink          `json:"links,omitempty"`
+	DroppedLinksCount      uint32                 `json:"droppedLinksCount"`
+	Resource               map[string]interface{} `json:"resource,omitempty"`
+	InstrumentationScope   ssoScope               `json:"instrumentationScope"`
+}
+
+func newSSORecord(record plog.LogRecord, scope pcommon.InstrumentationScope, scopeSchemaURL string, resource pcommon.Resource, ds ssoDataStream) ssoRecord {
+	doc := ssoRecord{
+		Timestamp: record.Timestamp().AsTime(),
+		Severity: ssoSeverity{
+			Text:   record.SeverityText(),
+			Number: int32(record.SeverityNumber()),
+		},
+		Body:                 record.Body().AsString(),
+		Attributes:           withDataStream(record.Attributes(), ds),
+		Resource:             resource.Attributes().AsRaw(),
+		InstrumentationScope: newSSOScope(scope, scopeSchemaURL),
+		SchemaURL:            scopeSchemaURL,
+	}
+	// The timestamp is optional in OTLP but is required by data streams.
+	if record.Timestamp() == 0 {
+		doc.Timestamp = record.ObservedTimestamp().AsTime()
+	}
+	if record.ObservedTimestamp() != 0 {
+		observed := record.ObservedTimestamp().AsTime()
+		doc.ObservedTimestamp = &observed
+	}
+	if !record.TraceID().IsEmpty() {
+		doc.TraceID = record.TraceID().HexString()
+	}
+	if !record.SpanID().IsEmpty() {
+		doc.SpanID = record.SpanID().HexString()
+	}
+	return doc
+}
+
+func newSSOSpan(span ptrace.Span, scope pcommon.InstrumentationScope, scopeSchemaURL string, resource pcommon.Resource, ds ssoDataStream) ssoSpan {
+	doc := ssoSpan{
+		TraceID:      span.TraceID().HexString(),
+		SpanID:       span.SpanID().HexString(),
+		ParentSpanID: span.ParentSpanID().HexString(),
+		TraceState:   span.TraceState().AsRaw(),
+		Name:         span.Name(),
+		Kind:         span.Kind().String(),
+		StartTime:    span.StartTimestamp().AsTime(),
+		EndTime:      span.EndTimestamp().AsTime(),
+		Status: ssoSpanStatus{
+			Code:    span.Status().Code().String(),
+			Message: span.Status().Message(),
+		},
+		Attributes:             withDataStream(span.Attributes(), ds),
+		DroppedAttributesCount: span.DroppedAttributesCount(),
+		DroppedEventsCount:     span.DroppedEventsCount(),
+		DroppedLinksCount:      span.DroppedLinksCount(),
+		Resource:               resource.Attributes().AsRaw(),
+		InstrumentationScope:   newSSOScope(scope, scopeSchemaURL),
+	}
+	if span.EndTimestamp() > span.StartTimestamp() {
+		doc.DurationInNanos = int64(span.EndTimestamp() - span.StartTimestamp())
+	}
+
+	events := span.Events()
+	for i := 0; i < events.Len(); i++ {
+		event := events.At(i)
+		doc.Events = append(doc.Events, ssoSpanEvent{
+			Timestamp:  event.Timestamp().AsTime(),
+			Name:       event.Name(),
+			Attributes: event.Attributes().AsRaw(),
+		})
+	}
+
+	links := span.Links()
+	for i := 0; i < links.Len(); i++ {
+		link := links.At(i)
+		doc.Links = append(doc.Links, ssoSpanLink{
+			TraceID:    link.TraceID().HexString(),
+			SpanID:     link.SpanID().HexString(),
+			TraceState: link.TraceState().AsRaw(),
+			Attributes: link.Attributes().AsRaw(),
+		})
+	}
+	return doc
+}
+
+func newSSOScope(scope pcommon.InstrumentationScope, schemaURL string) ssoScope {
+	return ssoScope{
+		Name:      scope.Name(),
+		Version:   scope.Version(),
+		SchemaURL: schemaURL,
+	}
+}
+
+// withDataStream returns the raw attributes with the SS4O data stream fields added.
+func withDataStream(attributes pcommon.Map, ds ssoDataStream) map[string]interface{} {
+	raw := attributes.AsRaw()
+	raw[dataStreamAttribute] = ds
+	return raw
+}
@@ -16,6 +16,7 @@ package prometheusexporter // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -49,6 +50,12 @@ type accumulator interface {
 	Collect() (metrics []pmetric.Metric, resourceAttrs []pcommon.Map)
 }
 
+// metricExpiration is the expiration of the metrics whose name match pattern.
+type metricExpiration struct {
+	pattern    *regexp.Regexp
+	expiration time.Duration
+}
+
 // LastValueAccumulator keeps last value for accumulated metrics
 type lastValueAccumulator struct {
 	logger *zap.Logger
@@ -58,13 +65,21 @@ type lastValueAccumulator struct {
 	// metricExpiration contains duration for which metric
 	// should be served after it was updated
 	metricExpiration time.Duration
+
+	// expirationOverrides contains the durations for which the
+	// metrics matching their pattern should be served instead
+	expirationOverrides []metricExpiration
+
+	// expirations caches the expiration of each metric name
+	expirations sync.Map
 }
 
 // NewAccumulator returns LastValueAccumulator
-func newAccumulator(logger *zap.Logger, metricExpiration time.Duration) accumulator {
+func newAccumulator(logger *zap.Logger, metricExpiration time.Duration, expirationOverrides []metricExpiration) accumulator {
 	return &lastValueAccumulator{
-		logger:           logger,
-		metricExpiration: metricExpiration,
+		logger:              logger,
+		metricExpiration:    metricExpiration,
+		expirationOverrides: expirationOverrides,
 	}
 }
 
@@ -280,11 +295,11 @@ func (a *lastValueAccumulator) Collect() ([]pmetric.Metric, []pcommon.Map) {
 
 	var metrics []pmetric.Metric
 	var resourceAttrs []pcommon.Map
-	expirationTime := time.Now().Add(-a.metricExpiration)
+	now := time.Now()
 
 	a.registeredMetrics.Range(func(key, value interface{}) bool {
 		v := value.(*accumulatedValue)
-		if expirationTime.After(v.updated) {
+		if now.Sub(v.updated) > a.expiration(v.value.Name()) {
 			a.logger.Debug(fmt.Sprintf("metric expired: %s", v.value.Name()))
 			a.registeredMetrics.Delete(key)
 			return true
@@ -298,6 +313,25 @@ func (a *lastValueAccumulator) Collect() ([]pmetric.Metric, []pcommon.Map) {
 	return metrics, resourceAttrs
 }
 
+// expiration returns the duration for which the metric with the given name should be served after it was updated.
+func (a *lastValueAccumulator) expiration(name string) time.Duration {
+	if len(a.expirationOverrides) == 0 {
+		return a.metricExpiration
+	}
+	if v, ok := a.expirations.Load(name); ok {
+		return v.(time.Duration)
+	}
+	expiration := a.metricExpiration
+	for _, override := range a.expirationOverrides {
+		if override.pattern.MatchString(name) {
+			expiration = override.expiration
+			break
+		}
+	}
+	a.expirations.Store(name, expiration)
+	return expiration
+}
+
 func timeseriesSignature(ilmName string, metric pmetric.Metric, attributes pcommon.Map, resourceAttrs pcommon.Map) string {
 	var b strings.Builder
 	b.WriteString(metric.Type().String())
@@ -16,6 +16,7 @@ package prometheusexporter
 
 import (
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -56,7 +57,7 @@ func TestAccumulateDeltaAggregation(t *testing.T) {
 			ilm.Scope().SetName("test")
 			tt.fillMetric(time.Now(), ilm.Metrics().AppendEmpty())
 
-			a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+			a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 			n := a.Accumulate(resourceMetrics)
 			require.Equal(t, 0, n)
 
@@ -282,7 +283,7 @@ func TestAccumulateMetrics(t *testing.T) {
 			tt.metric(ts2, 21, ilm2.Metrics())
 			tt.metric(ts1, 13, ilm2.Metrics())
 
-			a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+			a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 
 			// 2 metric arrived
 			n := a.Accumulate(resourceMetrics2)
@@ -388,7 +389,7 @@ func TestAccumulateDeltaToCumulative(t *testing.T) {
 			resourceMetrics := pmetric.NewResourceMetrics()
 			ilm := resourceMetrics.ScopeMetrics().AppendEmpty()
 			ilm.Scope().SetName("test")
-			a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+			a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 
 			dataPointValue1 := float64(11)
 			dataPointValue2 := float64(32)
@@ -497,7 +498,7 @@ func TestAccumulateDroppedMetrics(t *testing.T) {
 			ilm.Scope().SetName("test")
 			tt.fillMetric(time.Now(), ilm.Metrics().AppendEmpty())
 
-			a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+			a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 			n := a.Accumulate(resourceMetrics)
 			require.Equal(t, 0, n)
 
@@ -509,6 +510,34 @@ func TestAccumulateDroppedMetrics(t *testing.T) {
 	}
 }
 
+func TestCollectExpirationOverrides(t *testing.T) {
+	resourceMetrics := pmetric.NewResourceMetrics()
+	ilm := resourceMetrics.ScopeMetrics().AppendEmpty()
+	ilm.Scope().SetName("test")
+	for _, name := range []string{"short_lived", "short_lived_total", "long_lived"} {
+		metric := ilm.Metrics().AppendEmpty()
+		metric.SetName(name)
+		dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetIntValue(42)
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	}
+
+	a := newAccumulator(zap.NewNop(), 1*time.Hour, []metricExpiration{
+		{pattern: regexp.MustCompile("^(?:short_lived)$"), expiration: 1 * time.Millisecond},
+	}).(*lastValueAccumulator)
+	require.Equal(t, 3, a.Accumulate(resourceMetrics))
+	time.Sleep(10 * time.Millisecond)
+
+	metrics, _ := a.Collect()
+	var names []string
+	for _, metric := range metrics {
+		names = append(names, metric.Name())
+	}
+	require.ElementsMatch(t, []string{"short_lived_total", "long_lived"}, names)
+	require.Equal(t, 1*time.Millisecond, a.expiration("short_lived"))
+	require.Equal(t, 1*time.Hour, a.expiration("long_lived"))
+}
+
 func getMetricProperties(metric pmetric.Metric) (
 	attributes pcommon.Map,
 	ts time.Time,
@@ -45,14 +45,18 @@ type collector struct {
 	constLabels    prometheus.Labels
 }
 
-func newCollector(config *Config, logger *zap.Logger) *collector {
+func newCollector(config *Config, logger *zap.Logger) (*collector, error) {
+	expirationOverrides, err := config.metricExpirations()
+	if err != nil {
+		return nil, err
+	}
 	return &collector{
-		accumulator:    newAccumulator(logger, config.MetricExpiration),
+		accumulator:    newAccumulator(logger, config.MetricExpiration, expirationOverrides),
 		logger:         logger,
 		namespace:      prometheustranslator.CleanUpString(config.Namespace),
 		sendTimestamps: config.SendTimestamps,
 		constLabels:    config.ConstLabels,
-	}
+	}, nil
 }
 
 // Describe is a no-op, because the collector dynamically allocates metrics.
@@ -15,6 +15,8 @@
 package prometheusexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter"
 
 import (
+	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -41,6 +43,10 @@ type Config struct {
 	// MetricExpiration defines how long metrics are kept without updates
 	MetricExpiration time.Duration `mapstructure:"metric_expiration"`
 
+	// MetricExpirationOverrides defines how long the metrics whose name match a pattern are kept without updates,
+	// instead of MetricExpiration. The first matching override is used.
+	MetricExpirationOverrides []MetricExpirationOverride `mapstructure:"metric_expiration_overrides"`
+
 	// ResourceToTelemetrySettings defines configuration for converting resource attributes to metric labels.
 	ResourceToTelemetrySettings resourcetotelemetry.Settings `mapstructure:"resource_to_telemetry_conversion"`
 
@@ -48,9 +54,35 @@ type Config struct {
 	EnableOpenMetrics bool `mapstructure:"enable_open_metrics"`
 }
 
+// MetricExpirationOverride defines how long the metrics whose name match a pattern are kept without updates.
+type MetricExpirationOverride struct {
+	// MetricNamePattern is a regular expression that must match the whole name of the metrics.
+	MetricNamePattern string `mapstructure:"metric_name_pattern"`
+
+	// Expiration defines how long the matching metrics are kept without updates.
+	Expiration time.Duration `mapstructure:"expiration"`
+}
+
 var _ config.Exporter = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
 func (cfg *Config) Validate() error {
-	return nil
+	_, err := cfg.metricExpirations()
+	return err
+}
+
+// metricExpirations compiles the metric expiration overrides.
+func (cfg *Config) metricExpirations() ([]metricExpiration, error) {
+	expirations := make([]metricExpiration, 0, len(cfg.MetricExpirationOverrides))
+	for _, override := range cfg.MetricExpirationOverrides {
+		if override.Expiration <= 0 {
+			return nil, fmt.Errorf("metric expiration override %q: expiration must be positive", override.MetricNamePattern)
+		}
+		pattern, err := regexp.Compile("^(?:" + override.MetricNamePattern + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("metric expiration override %q: %w", override.MetricNamePattern, err)
+		}
+		expirations = append(expirations, metricExpiration{pattern: pattern, expiration: override.Expiration})
+	}
+	return expirations, nil
 }
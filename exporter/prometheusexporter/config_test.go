@@ -64,6 +64,21 @@ func TestLoadConfig(t *testing.T) {
 				MetricExpiration: 60 * time.Minute,
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "expiration_overrides"),
+			expected: &Config{
+				ExporterSettings: config.NewExporterSettings(config.NewComponentID(typeStr)),
+				HTTPServerSettings: confighttp.HTTPServerSettings{
+					Endpoint: "1.2.3.4:1234",
+				},
+				ConstLabels:      map[string]string{},
+				MetricExpiration: 5 * time.Minute,
+				MetricExpirationOverrides: []MetricExpirationOverride{
+					{MetricNamePattern: "batch_.*", Expiration: 2 * time.Hour},
+					{MetricNamePattern: "http_server_duration", Expiration: 30 * time.Second},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -80,3 +95,39 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides []MetricExpirationOverride
+		wantErr   string
+	}{
+		{
+			name:      "valid",
+			overrides: []MetricExpirationOverride{{MetricNamePattern: "batch_.*", Expiration: time.Minute}},
+		},
+		{
+			name:      "invalid pattern",
+			overrides: []MetricExpirationOverride{{MetricNamePattern: "batch_(", Expiration: time.Minute}},
+			wantErr:   "metric expiration override \"batch_(\": error parsing regexp: missing closing ): `^(?:batch_()$`",
+		},
+		{
+			name:      "zero expiration",
+			overrides: []MetricExpirationOverride{{MetricNamePattern: "batch_.*"}},
+			wantErr:   "metric expiration override \"batch_.*\": expiration must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.MetricExpirationOverrides = tt.overrides
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
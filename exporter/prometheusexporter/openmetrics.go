@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"go.uber.org/zap"
+
+	prometheustranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheus"
+)
+
+// units returns the Prometheus units of the metric families served by the collector, derived from the units
+// of their metrics. A family has no unit if its metrics disagree.
+func (c *collector) units() map[string]string {
+	inMetrics, _ := c.accumulator.Collect()
+
+	units := make(map[string]string)
+	for _, metric := range inMetrics {
+		name := prometheustranslator.BuildPromCompliantName(metric, c.namespace)
+		unit := prometheustranslator.BuildPromUnit(metric.Unit())
+		if u, ok := units[name]; ok && u != unit {
+			unit = ""
+		}
+		units[name] = unit
+	}
+	return units
+}
+
+// openMetricsHandler serves the metrics in the OpenMetrics format, with the units of the metric families,
+// when it is negotiated by the scraper. The OpenMetrics encoder of the Prometheus client can't write the units,
+// as its metric families don't have any. The other formats are served by the Prometheus client handler.
+type openMetricsHandler struct {
+	handler   http.Handler
+	gatherer  prometheus.Gatherer
+	collector *collector
+	logger    *zap.Logger
+}
+
+func (h *openMetricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if expfmt.NegotiateIncludingOpenMetrics(r.Header) != expfmt.FmtOpenMetrics {
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	mfs, err := h.gatherer.Gather()
+	if err != nil {
+		// Serve the metric families that were gathered, as the Prometheus client handler does.
+		h.logger.Error("error gathering metrics", zap.Error(err))
+	}
+	units := h.collector.units()
+
+	var buf bytes.Buffer
+	for _, mf := range mfs {
+		if err = writeOpenMetricsFamily(&buf, mf, units[mf.GetName()]); err != nil {
+			h.logger.Error("error encoding metric family", zap.String("name", mf.GetName()), zap.Error(err))
+		}
+	}
+	_, _ = expfmt.FinalizeOpenMetrics(&buf)
+
+	w.Header().Set("Content-Type", string(expfmt.FmtOpenMetrics))
+	if !gzipAccepted(r.Header) {
+		_, _ = w.Write(buf.Bytes())
+		return
+	}
+	w.Header().Set("Content-Encoding", "gzip")
+	gz := gzip.NewWriter(w)
+	_, _ = gz.Write(buf.Bytes())
+	_ = gz.Close()
+}
+
+// writeOpenMetricsFamily writes the metric family to buf in the OpenMetrics format, with its unit if not empty.
+// The unit is only written if the family name is suffixed with it, as required by OpenMetrics.
+func writeOpenMetricsFamily(buf *bytes.Buffer, mf *dto.MetricFamily, unit string) error {
+	var family bytes.Buffer
+	if _, err := expfmt.MetricFamilyToOpenMetrics(&family, mf); err != nil {
+		return err
+	}
+	out := family.Bytes()
+
+	// The _total suffix of the counters is dropped from the family name by the encoder.
+	name := mf.GetName()
+	if mf.GetType() == dto.MetricType_COUNTER {
+		name = strings.TrimSuffix(name, "_total")
+	}
+	if unit == "" || !strings.HasSuffix(name, "_"+unit) {
+		buf.Write(out)
+		return nil
+	}
+
+	typeLine := []byte("# TYPE " + name + " ")
+	for _, line := range bytes.SplitAfter(out, []byte("\n")) {
+		buf.Write(line)
+		if bytes.HasPrefix(line, typeLine) {
+			buf.WriteString("# UNIT " + name + " " + unit + "\n")
+		}
+	}
+	return nil
+}
+
+// gzipAccepted returns whether the client accepts gzip encoded content.
+func gzipAccepted(header http.Header) bool {
+	for _, part := range strings.Split(header.Get("Accept-Encoding"), ",") {
+		if strings.TrimSpace(strings.SplitN(part, ";", 2)[0]) == "gzip" {
+			return true
+		}
+	}
+	return false
+}
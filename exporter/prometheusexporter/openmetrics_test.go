@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusexporter
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+func TestCollectorUnits(t *testing.T) {
+	latency := pmetric.NewMetric()
+	latency.SetName("latency")
+	latency.SetUnit("ms")
+	latency.SetEmptyHistogram()
+
+	throughput := pmetric.NewMetric()
+	throughput.SetName("throughput")
+	throughput.SetUnit("By/s")
+	throughput.SetEmptyGauge()
+
+	packets := pmetric.NewMetric()
+	packets.SetName("packets")
+	packets.SetUnit("{packets}")
+	packets.SetEmptySum().SetIsMonotonic(true)
+
+	size := pmetric.NewMetric()
+	size.SetName("size")
+	size.SetUnit("By")
+	size.SetEmptyGauge()
+	otherSize := pmetric.NewMetric()
+	otherSize.SetName("size")
+	otherSize.SetUnit("KiBy")
+	otherSize.SetEmptyGauge()
+
+	c := collector{
+		namespace: "test",
+		accumulator: &mockAccumulator{
+			[]pmetric.Metric{latency, latency, throughput, packets, size, otherSize},
+			pcommon.NewMap(),
+		},
+		logger: zap.NewNop(),
+	}
+
+	assert.Equal(t, map[string]string{
+		"test_latency":    "milliseconds",
+		"test_throughput": "bytes_per_second",
+		"test_packets":    "",
+		"test_size":       "",
+	}, c.units())
+}
+
+func TestOpenMetricsHandler(t *testing.T) {
+	latency := pmetric.NewMetric()
+	latency.SetName("latency_seconds")
+	latency.SetDescription("Latency of the requests")
+	latency.SetUnit("s")
+	latency.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(0.5)
+
+	requests := pmetric.NewMetric()
+	requests.SetName("requests_total")
+	requests.SetUnit("1")
+	sum := requests.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	sum.DataPoints().AppendEmpty().SetIntValue(3)
+
+	c := &collector{
+		accumulator: &mockAccumulator{
+			[]pmetric.Metric{latency, requests},
+			pcommon.NewMap(),
+		},
+		logger: zap.NewNop(),
+	}
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(c))
+	h := &openMetricsHandler{
+		handler:   promhttp.HandlerFor(registry, promhttp.HandlerOpts{EnableOpenMetrics: true}),
+		gatherer:  registry,
+		collector: c,
+		logger:    zap.NewNop(),
+	}
+
+	const openMetrics = `# HELP latency_seconds Latency of the requests
+# TYPE latency_seconds gauge
+# UNIT latency_seconds seconds
+latency_seconds 0.5
+# HELP requests 
+# TYPE requests counter
+requests_total 3.0
+# EOF
+`
+
+	t.Run("openmetrics", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		req.Header.Set("Accept", "application/openmetrics-text; version=0.0.1")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, string(expfmt.FmtOpenMetrics), rec.Header().Get("Content-Type"))
+		assert.Equal(t, openMetrics, rec.Body.String())
+	})
+
+	t.Run("openmetrics gzip", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		req.Header.Set("Accept", "application/openmetrics-text; version=0.0.1")
+		req.Header.Set("Accept-Encoding", "deflate, gzip;q=1.0")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+		gz, err := gzip.NewReader(rec.Body)
+		require.NoError(t, err)
+		body, err := io.ReadAll(gz)
+		require.NoError(t, err)
+		assert.Equal(t, openMetrics, string(body))
+	})
+
+	t.Run("text", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, string(expfmt.FmtText), rec.Header().Get("Content-Type"))
+		assert.Contains(t, rec.Body.String(), "# TYPE latency_seconds gauge\nlatency_seconds 0.5\n")
+		assert.NotContains(t, rec.Body.String(), "# UNIT")
+	})
+}
@@ -45,9 +45,28 @@ func newPrometheusExporter(config *Config, set component.ExporterCreateSettings)
 		return nil, errBlankPrometheusAddress
 	}
 
-	collector := newCollector(config, set.Logger)
+	collector, err := newCollector(config, set.Logger)
+	if err != nil {
+		return nil, err
+	}
 	registry := prometheus.NewRegistry()
 	_ = registry.Register(collector)
+	handler := promhttp.HandlerFor(
+		registry,
+		promhttp.HandlerOpts{
+			ErrorHandling:     promhttp.ContinueOnError,
+			ErrorLog:          newPromLogger(set.Logger),
+			EnableOpenMetrics: config.EnableOpenMetrics,
+		},
+	)
+	if config.EnableOpenMetrics {
+		handler = &openMetricsHandler{
+			handler:   handler,
+			gatherer:  registry,
+			collector: collector,
+			logger:    set.Logger,
+		}
+	}
 	return &prometheusExporter{
 		config:       *config,
 		name:         config.ID().String(),
@@ -55,14 +74,8 @@ func newPrometheusExporter(config *Config, set component.ExporterCreateSettings)
 		collector:    collector,
 		registry:     registry,
 		shutdownFunc: func() error { return nil },
-		handler: promhttp.HandlerFor(
-			registry,
-			promhttp.HandlerOpts{
-				ErrorHandling:     promhttp.ContinueOnError,
-				ErrorLog:          newPromLogger(set.Logger),
-				EnableOpenMetrics: config.EnableOpenMetrics,
-			},
-		),
+		settings:     set.TelemetrySettings,
+		handler:      handler,
 	}, nil
 }
 
@@ -16,6 +16,7 @@ package prometheusremotewriteexporter // import "github.com/open-telemetry/opent
 
 import (
 	"fmt"
+	"net/http"
 
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -51,6 +52,12 @@ type Config struct {
 
 	// TargetInfo allows customizing the target_info metric
 	TargetInfo *TargetInfo `mapstructure:"target_info,omitempty"`
+
+	// LabelInterning allows reusing the label names and values of the exported time series across batches.
+	LabelInterning LabelInterning `mapstructure:"label_interning"`
+
+	// Tenant allows routing the metrics of each resource to its tenant, set in a request header.
+	Tenant *TenantConfig `mapstructure:"tenant"`
 }
 
 type TargetInfo struct {
@@ -58,6 +65,41 @@ type TargetInfo struct {
 	Enabled bool `mapstructure:"enabled"`
 }
 
+// LabelInterning allows to configure the interning of label names and values.
+type LabelInterning struct {
+	// Enabled if true the label names and values are interned, which reduces
+	// the allocations when the same attributes are exported in every batch.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MaxSize is the maximum number of interned label names and values.
+	// Ignored if Enabled is false.
+	MaxSize int `mapstructure:"max_size"`
+}
+
+// defaultTenantHeader is the header used by Cortex and Mimir to identify the tenant of a request.
+const defaultTenantHeader = "X-Scope-OrgID"
+
+// TenantConfig allows to configure the routing of the metrics to their tenant.
+type TenantConfig struct {
+	// ResourceAttribute is the resource attribute holding the tenant of the metrics.
+	ResourceAttribute string `mapstructure:"resource_attribute"`
+
+	// Header is the HTTP header set to the tenant of the metrics. Default is X-Scope-OrgID.
+	Header string `mapstructure:"header"`
+
+	// Default is the tenant of the metrics whose resource doesn't have the attribute.
+	// If empty, the header isn't set for these metrics.
+	Default string `mapstructure:"default"`
+}
+
+// header returns the HTTP header set to the tenant of the metrics.
+func (tc *TenantConfig) header() string {
+	if tc.Header == "" {
+		return defaultTenantHeader
+	}
+	return tc.Header
+}
+
 // RemoteWriteQueue allows to configure the remote write queue.
 type RemoteWriteQueue struct {
 	// Enabled if false the queue is not enabled, the export requests
@@ -91,6 +133,14 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("remote write consumer number can't be negative")
 	}
 
+	if cfg.LabelInterning.Enabled && cfg.LabelInterning.MaxSize <= 0 {
+		return fmt.Errorf("label interning max size must be positive")
+	}
+
+	if err := cfg.validateTenant(); err != nil {
+		return err
+	}
+
 	if cfg.TargetInfo == nil {
 		cfg.TargetInfo = &TargetInfo{
 			Enabled: true,
@@ -98,3 +148,30 @@ func (cfg *Config) Validate() error {
 	}
 	return nil
 }
+
+// reservedHeaders are the headers set by the exporter on every request.
+var reservedHeaders = []string{"Content-Encoding", "Content-Type", "X-Prometheus-Remote-Write-Version", "User-Agent"}
+
+func (cfg *Config) validateTenant() error {
+	if cfg.Tenant == nil {
+		return nil
+	}
+	if cfg.Tenant.ResourceAttribute == "" {
+		return fmt.Errorf("tenant resource attribute must be set")
+	}
+	if cfg.WAL != nil {
+		return fmt.Errorf("tenant routing is not supported with the WAL")
+	}
+	header := http.CanonicalHeaderKey(cfg.Tenant.header())
+	for _, reserved := range reservedHeaders {
+		if header == reserved {
+			return fmt.Errorf("tenant header %q can't be changed", header)
+		}
+	}
+	for key := range cfg.HTTPClientSettings.Headers {
+		if http.CanonicalHeaderKey(key) == header {
+			return fmt.Errorf("tenant header %q is already set in the headers", header)
+		}
+	}
+	return nil
+}
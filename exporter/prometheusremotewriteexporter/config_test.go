@@ -82,6 +82,10 @@ func TestLoadConfig(t *testing.T) {
 				TargetInfo: &TargetInfo{
 					Enabled: true,
 				},
+				LabelInterning: LabelInterning{
+					Enabled: true,
+					MaxSize: 50000,
+				},
 			},
 		},
 		{
@@ -92,6 +96,39 @@ func TestLoadConfig(t *testing.T) {
 			id:           config.NewComponentIDWithName(typeStr, "negative_num_consumers"),
 			errorMessage: "remote write consumer number can't be negative",
 		},
+		{
+			id:           config.NewComponentIDWithName(typeStr, "invalid_label_interning"),
+			errorMessage: "label interning max size must be positive",
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "tenant"),
+			expected: func() config.Exporter {
+				cfg := createDefaultConfig().(*Config)
+				cfg.HTTPClientSettings.Endpoint = "localhost:8888"
+				cfg.Tenant = &TenantConfig{
+					ResourceAttribute: "tenant.id",
+					Header:            "X-Tenant",
+					Default:           "anonymous",
+				}
+				return cfg
+			}(),
+		},
+		{
+			id:           config.NewComponentIDWithName(typeStr, "tenant_without_resource_attribute"),
+			errorMessage: "tenant resource attribute must be set",
+		},
+		{
+			id:           config.NewComponentIDWithName(typeStr, "tenant_with_wal"),
+			errorMessage: "tenant routing is not supported with the WAL",
+		},
+		{
+			id:           config.NewComponentIDWithName(typeStr, "tenant_header_in_headers"),
+			errorMessage: `tenant header "X-Scope-Orgid" is already set in the headers`,
+		},
+		{
+			id:           config.NewComponentIDWithName(typeStr, "tenant_reserved_header"),
+			errorMessage: `tenant header "Content-Type" can't be changed`,
+		},
 	}
 
 	for _, tt := range tests {
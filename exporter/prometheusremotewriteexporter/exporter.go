@@ -29,11 +29,14 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
 	"github.com/prometheus/prometheus/prompb"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/multierr"
+	"go.uber.org/zap"
 
 	prometheustranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheus"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
@@ -43,6 +46,7 @@ const maxBatchByteSize = 3000000
 
 // prwExporter converts OTLP metrics to Prometheus remote write TimeSeries and sends them to a remote endpoint.
 type prwExporter struct {
+	exporterName      string
 	namespace         string
 	externalLabels    map[string]string
 	endpointURL       *url.URL
@@ -54,6 +58,8 @@ type prwExporter struct {
 	clientSettings    *confighttp.HTTPClientSettings
 	settings          component.TelemetrySettings
 	disableTargetInfo bool
+	interner          *prometheusremotewrite.Interner
+	tenant            *TenantConfig
 
 	wal *prweWAL
 }
@@ -73,6 +79,7 @@ func newPRWExporter(cfg *Config, set component.ExporterCreateSettings) (*prwExpo
 	userAgentHeader := fmt.Sprintf("%s/%s", strings.ReplaceAll(strings.ToLower(set.BuildInfo.Description), " ", "-"), set.BuildInfo.Version)
 
 	prwe := &prwExporter{
+		exporterName:      cfg.ID().String(),
 		namespace:         cfg.Namespace,
 		externalLabels:    sanitizedLabels,
 		endpointURL:       endpointURL,
@@ -83,6 +90,10 @@ func newPRWExporter(cfg *Config, set component.ExporterCreateSettings) (*prwExpo
 		clientSettings:    &cfg.HTTPClientSettings,
 		settings:          set.TelemetrySettings,
 		disableTargetInfo: !cfg.TargetInfo.Enabled,
+		tenant:            cfg.Tenant,
+	}
+	if cfg.LabelInterning.Enabled {
+		prwe.interner = prometheusremotewrite.NewInterner(cfg.LabelInterning.MaxSize)
 	}
 	if cfg.WAL == nil {
 		return prwe, nil
@@ -135,15 +146,82 @@ func (prwe *prwExporter) PushMetrics(ctx context.Context, md pmetric.Metrics) er
 	case <-prwe.closeChan:
 		return errors.New("shutdown has been called")
 	default:
-		tsMap, err := prometheusremotewrite.FromMetrics(md, prometheusremotewrite.Settings{Namespace: prwe.namespace, ExternalLabels: prwe.externalLabels, DisableTargetInfo: prwe.disableTargetInfo})
-		if err != nil {
-			err = consumererror.NewPermanent(err)
+		if prwe.tenant == nil {
+			return prwe.pushMetrics(ctx, md)
+		}
+		// Each tenant is exported separately, so that its requests are sent with its own tenant header.
+		// Only the metrics of the tenants which failed with a retryable error are returned to be retried,
+		// so that the tenants which were exported are not sent again.
+		var errs, permanentErrs error
+		failed := pmetric.NewMetrics()
+		for tenant, tenantMetrics := range splitMetricsByTenant(md, prwe.tenant) {
+			err := prwe.pushMetrics(contextWithTenant(ctx, tenant), tenantMetrics)
+			switch {
+			case err == nil:
+			case consumererror.IsPermanent(err):
+				permanentErrs = multierr.Append(permanentErrs, err)
+			default:
+				errs = multierr.Append(errs, err)
+				tenantMetrics.ResourceMetrics().MoveAndAppendTo(failed.ResourceMetrics())
+			}
+		}
+		if errs == nil {
+			return permanentErrs
+		}
+		if permanentErrs != nil {
+			// Returning the permanent errors would prevent the retry of the other tenants
+			prwe.settings.Logger.Error("Dropping the metrics of the tenants which can't be exported", zap.Error(permanentErrs))
 		}
-		// Call export even if a conversion error, since there may be points that were successfully converted.
-		return multierr.Combine(err, prwe.handleExport(ctx, tsMap))
+		return consumererror.NewMetrics(errs, failed)
 	}
 }
 
+func (prwe *prwExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	tsMap, err := prometheusremotewrite.FromMetrics(md, prometheusremotewrite.Settings{Namespace: prwe.namespace, ExternalLabels: prwe.externalLabels, DisableTargetInfo: prwe.disableTargetInfo, Interner: prwe.interner})
+	prwe.recordInternerStats(ctx)
+	if prometheusremotewrite.IsPermanent(err) {
+		err = consumererror.NewPermanent(err)
+	}
+	// Call export even if a conversion error, since there may be points that were successfully converted.
+	return multierr.Combine(err, prwe.handleExport(ctx, tsMap))
+}
+
+// splitMetricsByTenant groups the resource metrics by the tenant held by their resource attribute.
+// The resources without the attribute belong to the default tenant.
+func splitMetricsByTenant(md pmetric.Metrics, tc *TenantConfig) map[string]pmetric.Metrics {
+	byTenant := make(map[string]pmetric.Metrics)
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		tenant := tc.Default
+		if v, ok := rm.Resource().Attributes().Get(tc.ResourceAttribute); ok && v.AsString() != "" {
+			tenant = v.AsString()
+		}
+		tenantMetrics, ok := byTenant[tenant]
+		if !ok {
+			tenantMetrics = pmetric.NewMetrics()
+			byTenant[tenant] = tenantMetrics
+		}
+		rm.CopyTo(tenantMetrics.ResourceMetrics().AppendEmpty())
+	}
+	return byTenant
+}
+
+// recordInternerStats records the statistics of the label interner, if enabled.
+func (prwe *prwExporter) recordInternerStats(ctx context.Context) {
+	if prwe.interner == nil {
+		return
+	}
+	internerStats := prwe.interner.Stats()
+	_ = stats.RecordWithTags(
+		ctx,
+		[]tag.Mutator{tag.Upsert(tagExporterName, prwe.exporterName)},
+		statLabelInternerHits.M(internerStats.Hits),
+		statLabelInternerMisses.M(internerStats.Misses),
+		statLabelInternerSize.M(int64(internerStats.Size)),
+	)
+}
+
 func validateAndSanitizeExternalLabels(cfg *Config) (map[string]string, error) {
 	sanitizedLabels := make(map[string]string)
 	for key, value := range cfg.ExternalLabels {
@@ -180,7 +258,9 @@ func (prwe *prwExporter) handleExport(ctx context.Context, tsMap map[string]*pro
 	return nil
 }
 
-// export sends a Snappy-compressed WriteRequest containing TimeSeries to a remote write endpoint in order
+// export sends a Snappy-compressed WriteRequest containing TimeSeries to a remote write endpoint in order.
+// The errors which may be resolved by retrying, e.g. a 5xx status, are kept transient, so that only the
+// requests failing with them are retried.
 func (prwe *prwExporter) export(ctx context.Context, requests []*prompb.WriteRequest) error {
 	input := make(chan *prompb.WriteRequest, len(requests))
 	for _, request := range requests {
@@ -211,7 +291,7 @@ func (prwe *prwExporter) export(ctx context.Context, requests []*prompb.WriteReq
 					}
 					if errExecute := prwe.execute(ctx, request); errExecute != nil {
 						mu.Lock()
-						errs = multierr.Append(errs, consumererror.NewPermanent(errExecute))
+						errs = multierr.Append(errs, errExecute)
 						mu.Unlock()
 					}
 				}
@@ -244,10 +324,14 @@ func (prwe *prwExporter) execute(ctx context.Context, writeReq *prompb.WriteRequ
 	req.Header.Set("Content-Type", "application/x-protobuf")
 	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
 	req.Header.Set("User-Agent", prwe.userAgentHeader)
+	if tenant := tenantFromContext(ctx); tenant != "" && prwe.tenant != nil {
+		req.Header.Set(prwe.tenant.header(), tenant)
+	}
 
 	resp, err := prwe.client.Do(req)
 	if err != nil {
-		return consumererror.NewPermanent(err)
+		// The endpoint may be reachable again later.
+		return err
 	}
 	defer resp.Body.Close()
 
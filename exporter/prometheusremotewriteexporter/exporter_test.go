@@ -16,6 +16,7 @@ package prometheusremotewriteexporter
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -35,6 +36,7 @@ import (
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
@@ -218,6 +220,159 @@ func Test_Start(t *testing.T) {
 	}
 }
 
+// Test_PushMetricsWithLabelInterning checks that the label interner is shared by the exported batches.
+func Test_PushMetricsWithLabelInterning(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.HTTPClientSettings.Endpoint = server.URL
+	cfg.RemoteWriteQueue.NumConsumers = 1
+	cfg.LabelInterning.Enabled = true
+
+	prwe, err := newPRWExporter(cfg, componenttest.NewNopExporterCreateSettings())
+	require.NoError(t, err)
+	require.NotNil(t, prwe.interner)
+	require.NoError(t, prwe.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, prwe.Shutdown(context.Background()))
+	}()
+
+	md := getMetricsFromMetricList(validMetrics1[validDoubleGauge])
+	require.NoError(t, prwe.PushMetrics(context.Background(), md))
+	misses := prwe.interner.Stats().Misses
+	assert.Positive(t, misses)
+
+	require.NoError(t, prwe.PushMetrics(context.Background(), md))
+	stats := prwe.interner.Stats()
+	assert.Equal(t, misses, stats.Misses)
+	assert.Positive(t, stats.Hits)
+}
+
+// Test_PushMetricsWithTenant checks that the metrics of each tenant are sent with their tenant header.
+func Test_PushMetricsWithTenant(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string][]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		buf, err := snappy.Decode(nil, body)
+		require.NoError(t, err)
+		var wr prompb.WriteRequest
+		require.NoError(t, proto.Unmarshal(buf, &wr))
+
+		mu.Lock()
+		defer mu.Unlock()
+		tenant := r.Header.Get("X-Tenant")
+		for _, ts := range wr.Timeseries {
+			for _, label := range ts.Labels {
+				if label.Name == "__name__" {
+					received[tenant] = append(received[tenant], label.Value)
+				}
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.HTTPClientSettings.Endpoint = server.URL
+	cfg.RemoteWriteQueue.NumConsumers = 1
+	cfg.TargetInfo.Enabled = false
+	cfg.Tenant = &TenantConfig{
+		ResourceAttribute: "tenant.id",
+		Header:            "X-Tenant",
+		Default:           "anonymous",
+	}
+	require.NoError(t, cfg.Validate())
+
+	prwe, err := newPRWExporter(cfg, componenttest.NewNopExporterCreateSettings())
+	require.NoError(t, err)
+	require.NoError(t, prwe.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, prwe.Shutdown(context.Background()))
+	}()
+
+	md := pmetric.NewMetrics()
+	for _, tenant := range []string{"a", "b", "", "a"} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		name := "gauge_default"
+		if tenant != "" {
+			rm.Resource().Attributes().PutStr("tenant.id", tenant)
+			name = "gauge_" + tenant
+		}
+		metric := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		getDoubleGaugeMetric(name, lbs1, floatVal1, time1).CopyTo(metric)
+	}
+	require.NoError(t, prwe.PushMetrics(context.Background(), md))
+
+	assert.Equal(t, map[string][]string{
+		"a":         {"gauge_a"},
+		"b":         {"gauge_b"},
+		"anonymous": {"gauge_default"},
+	}, received)
+}
+
+func Test_PushMetricsWithTenantRetriesFailedTenants(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		tenant := r.Header.Get("X-Tenant")
+		received[tenant]++
+		switch tenant {
+		case "b":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "c":
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.HTTPClientSettings.Endpoint = server.URL
+	cfg.RemoteWriteQueue.NumConsumers = 1
+	cfg.TargetInfo.Enabled = false
+	cfg.Tenant = &TenantConfig{
+		ResourceAttribute: "tenant.id",
+		Header:            "X-Tenant",
+	}
+	require.NoError(t, cfg.Validate())
+
+	prwe, err := newPRWExporter(cfg, componenttest.NewNopExporterCreateSettings())
+	require.NoError(t, err)
+	require.NoError(t, prwe.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, prwe.Shutdown(context.Background()))
+	}()
+
+	md := pmetric.NewMetrics()
+	for _, tenant := range []string{"a", "b", "c"} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("tenant.id", tenant)
+		metric := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		getDoubleGaugeMetric("gauge_"+tenant, lbs1, floatVal1, time1).CopyTo(metric)
+	}
+	err = prwe.PushMetrics(context.Background(), md)
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+	assert.Equal(t, map[string]int{"a": 1, "b": 1, "c": 1}, received)
+
+	// Only the metrics of the tenant which failed with a retryable error are retried
+	var metricsErr consumererror.Metrics
+	require.True(t, errors.As(err, &metricsErr))
+	failed := metricsErr.GetMetrics()
+	require.Equal(t, 1, failed.ResourceMetrics().Len())
+	tenant, ok := failed.ResourceMetrics().At(0).Resource().Attributes().Get("tenant.id")
+	require.True(t, ok)
+	assert.Equal(t, "b", tenant.AsString())
+}
+
 // Test_Shutdown checks after Shutdown is called, incoming calls to PushMetrics return error.
 func Test_Shutdown(t *testing.T) {
 	prwe := &prwExporter{
@@ -448,6 +603,7 @@ func Test_PushMetrics(t *testing.T) {
 		expectedTimeSeries int
 		httpResponseCode   int
 		returnErr          bool
+		permanentErr       bool
 		isStaleMarker      bool
 		skipForWAL         bool
 	}{
@@ -456,6 +612,7 @@ func Test_PushMetrics(t *testing.T) {
 			metrics:          invalidTypeBatch,
 			httpResponseCode: http.StatusAccepted,
 			returnErr:        true,
+			permanentErr:     true,
 		},
 		{
 			name:               "intSum_case",
@@ -536,6 +693,7 @@ func Test_PushMetrics(t *testing.T) {
 			reqTestFunc:      checkFunc,
 			httpResponseCode: http.StatusAccepted,
 			returnErr:        true,
+			permanentErr:     true,
 		},
 		{
 			name:             "emptyCumulativeSum_case",
@@ -543,6 +701,7 @@ func Test_PushMetrics(t *testing.T) {
 			reqTestFunc:      checkFunc,
 			httpResponseCode: http.StatusAccepted,
 			returnErr:        true,
+			permanentErr:     true,
 		},
 		{
 			name:             "emptyCumulativeHistogram_case",
@@ -550,6 +709,7 @@ func Test_PushMetrics(t *testing.T) {
 			reqTestFunc:      checkFunc,
 			httpResponseCode: http.StatusAccepted,
 			returnErr:        true,
+			permanentErr:     true,
 		},
 		{
 			name:             "emptySummary_case",
@@ -557,6 +717,7 @@ func Test_PushMetrics(t *testing.T) {
 			reqTestFunc:      checkFunc,
 			httpResponseCode: http.StatusAccepted,
 			returnErr:        true,
+			permanentErr:     true,
 		},
 		{
 			name:               "staleNaNIntGauge_case",
@@ -680,6 +841,7 @@ func Test_PushMetrics(t *testing.T) {
 					err := prwe.PushMetrics(ctx, tt.metrics)
 					if tt.returnErr {
 						assert.Error(t, err)
+						assert.Equal(t, tt.permanentErr, consumererror.IsPermanent(err))
 						return
 					}
 					assert.NoError(t, err)
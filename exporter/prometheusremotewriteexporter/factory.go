@@ -19,6 +19,7 @@ import (
 	"errors"
 	"time"
 
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -36,6 +37,8 @@ const (
 
 // NewFactory creates a new Prometheus Remote Write exporter.
 func NewFactory() component.ExporterFactory {
+	_ = view.Register(MetricViews()...)
+
 	return component.NewExporterFactory(
 		typeStr,
 		createDefaultConfig,
@@ -111,5 +114,9 @@ func createDefaultConfig() config.Exporter {
 		TargetInfo: &TargetInfo{
 			Enabled: true,
 		},
+		LabelInterning: LabelInterning{
+			Enabled: false,
+			MaxSize: 100000,
+		},
 	}
 }
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewriteexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+var (
+	tagExporterName, _ = tag.NewKey("exporter")
+
+	statLabelInternerHits   = stats.Int64("prometheusremotewrite_label_interner_hits", "Number of label names and values found in the interner", stats.UnitDimensionless)
+	statLabelInternerMisses = stats.Int64("prometheusremotewrite_label_interner_misses", "Number of label names and values not found in the interner", stats.UnitDimensionless)
+	statLabelInternerSize   = stats.Int64("prometheusremotewrite_label_interner_size", "Number of label names and values held by the interner", stats.UnitDimensionless)
+)
+
+// MetricViews return metric views for the Prometheus remote write exporter.
+func MetricViews() []*view.View {
+	tagKeys := []tag.Key{tagExporterName}
+
+	// The hits and misses are recorded as the totals since the start of the exporter.
+	lastValueHits := &view.View{
+		Name:        statLabelInternerHits.Name(),
+		Measure:     statLabelInternerHits,
+		Description: statLabelInternerHits.Description(),
+		TagKeys:     tagKeys,
+		Aggregation: view.LastValue(),
+	}
+
+	lastValueMisses := &view.View{
+		Name:        statLabelInternerMisses.Name(),
+		Measure:     statLabelInternerMisses,
+		Description: statLabelInternerMisses.Description(),
+		TagKeys:     tagKeys,
+		Aggregation: view.LastValue(),
+	}
+
+	lastValueSize := &view.View{
+		Name:        statLabelInternerSize.Name(),
+		Measure:     statLabelInternerSize,
+		Description: statLabelInternerSize.Description(),
+		TagKeys:     tagKeys,
+		Aggregation: view.LastValue(),
+	}
+
+	return []*view.View{
+		lastValueHits,
+		lastValueMisses,
+		lastValueSize,
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewriteexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	metricViews := MetricViews()
+	viewNames := []string{
+		"prometheusremotewrite_label_interner_hits",
+		"prometheusremotewrite_label_interner_misses",
+		"prometheusremotewrite_label_interner_size",
+	}
+	for i, viewName := range viewNames {
+		assert.Equal(t, viewName, metricViews[i].Name)
+	}
+}
@@ -18,14 +18,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/fsnotify/fsnotify"
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/prometheus/prompb"
 	"github.com/tidwall/wal"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.uber.org/atomic"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -38,6 +43,8 @@ type prweWAL struct {
 	walPath   string
 
 	exportSink func(ctx context.Context, reqL []*prompb.WriteRequest) error
+	// retryBackoff spaces out the attempts to export the requests of the WAL after a failure.
+	retryBackoff *backoff.ExponentialBackOff
 
 	stopOnce  sync.Once
 	stopChan  chan struct{}
@@ -48,6 +55,9 @@ type prweWAL struct {
 const (
 	defaultWALBufferSize        = 300
 	defaultWALTruncateFrequency = 1 * time.Minute
+
+	// walPollInterval is how often the WAL is checked for new requests, once all of them were read.
+	walPollInterval = 100 * time.Millisecond
 )
 
 type WALConfig struct {
@@ -77,12 +87,18 @@ func newWAL(walConfig *WALConfig, exportSink func(context.Context, []*prompb.Wri
 		return nil, errNilConfig
 	}
 
+	retryBackoff := backoff.NewExponentialBackOff()
+	// Retry until the requests are exported, they are kept in the WAL in the meantime.
+	// The requests failing with permanent errors aren't retried, see exportThenFrontTruncateWAL.
+	retryBackoff.MaxElapsedTime = 0
+
 	return &prweWAL{
-		exportSink: exportSink,
-		walConfig:  walConfig,
-		stopChan:   make(chan struct{}),
-		rWALIndex:  atomic.NewUint64(0),
-		wWALIndex:  atomic.NewUint64(0),
+		exportSink:   exportSink,
+		retryBackoff: retryBackoff,
+		walConfig:    walConfig,
+		stopChan:     make(chan struct{}),
+		rWALIndex:    atomic.NewUint64(0),
+		wWALIndex:    atomic.NewUint64(0),
 	}, nil
 }
 
@@ -98,6 +114,33 @@ func (wc *WALConfig) createWAL() (*wal.Log, string, error) {
 	return log, walPath, nil
 }
 
+// committedIndexPath returns the path of the file holding the index of the last exported request of the WAL.
+func (wc *WALConfig) committedIndexPath() string {
+	return filepath.Join(wc.Directory, "prom_remotewrite_committed")
+}
+
+// readCommittedIndex returns the index of the last exported request, or 0 if none was recorded.
+func readCommittedIndex(path string) (uint64, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// writeCommittedIndex records the index of the last exported request.
+// Losing the record in a crash only leads to the requests being exported again.
+func writeCommittedIndex(path string, index uint64) error {
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, []byte(strconv.FormatUint(index, 10)), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
 var (
 	errAlreadyClosed = errors.New("already closed")
 	errNilWAL        = errors.New("wal is nil")
@@ -126,12 +169,27 @@ func (prwe *prweWAL) retrieveWALIndices() (err error) {
 	if err != nil {
 		return fmt.Errorf("prometheusremotewriteexporter: failed to retrieve the first WAL index: %w", err)
 	}
-	prwe.rWALIndex.Store(rIndex)
 
 	wIndex, err := prwe.wal.LastIndex()
 	if err != nil {
 		return fmt.Errorf("prometheusremotewriteexporter: failed to retrieve the last WAL index: %w", err)
 	}
+
+	// The WAL always keeps its last request, even once exported, so resume
+	// reading after the last exported request if it is still in the WAL.
+	committed, err := readCommittedIndex(prwe.walConfig.committedIndexPath())
+	if err != nil {
+		return fmt.Errorf("prometheusremotewriteexporter: failed to read the last exported WAL index: %w", err)
+	}
+	if committed >= rIndex && committed <= wIndex {
+		rIndex = committed + 1
+	}
+	if rIndex == 0 {
+		// The WAL is empty, its first request will be written at index 1.
+		rIndex = 1
+	}
+
+	prwe.rWALIndex.Store(rIndex)
 	prwe.wWALIndex.Store(wIndex)
 	return nil
 }
@@ -178,9 +236,17 @@ func (prwe *prweWAL) run(ctx context.Context) (err error) {
 				err := prwe.continuallyPopWALThenExport(runCtx, signalStart)
 				signalStart = func() {}
 				if err != nil {
-					// log err
-					logger.Error("error processing WAL entries", zap.Error(err))
-					// Restart WAL
+					retryIn := prwe.retryBackoff.NextBackOff()
+					logger.Error("error processing WAL entries, retrying the requests that weren't exported",
+						zap.Error(err), zap.Duration("retry_in", retryIn))
+					select {
+					case <-runCtx.Done():
+						return
+					case <-prwe.stopChan:
+						return
+					case <-time.After(retryIn):
+					}
+					// Restart WAL, from the first request that wasn't exported.
 					if errS := prwe.retrieveWALIndices(); errS != nil {
 						logger.Error("unable to re-start write-ahead log after error", zap.Error(errS))
 						return
@@ -198,15 +264,10 @@ func (prwe *prweWAL) run(ctx context.Context) (err error) {
 // buffer size is exceeded. When either of the two conditions are matched, it then exports
 // the requests to the Remote-Write endpoint, and then truncates the head of the WAL to where
 // it last read from.
+// Requests are only truncated once exported: the ones read but not exported when returning,
+// on errors or when stopping, are read again from the WAL on the next run.
 func (prwe *prweWAL) continuallyPopWALThenExport(ctx context.Context, signalStart func()) (err error) {
 	var reqL []*prompb.WriteRequest
-	defer func() {
-		// Keeping it within a closure to ensure that the later
-		// updated value of reqL is always flushed to disk.
-		if errL := prwe.exportSink(ctx, reqL); errL != nil {
-			err = multierr.Append(err, errL)
-		}
-	}()
 
 	freshTimer := func() *time.Timer {
 		return time.NewTimer(prwe.walConfig.truncateFrequency())
@@ -231,6 +292,25 @@ func (prwe *prweWAL) continuallyPopWALThenExport(ctx context.Context, signalStar
 		default:
 		}
 
+		if prwe.rWALIndex.Load() > prwe.wWALIndex.Load() {
+			// All the requests were read, wait for new ones, and export
+			// the pending ones if the buffer period expires in the meantime.
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-prwe.stopChan:
+				return nil
+			case <-timer.C:
+				timer = freshTimer()
+				if err = prwe.exportThenFrontTruncateWAL(ctx, reqL); err != nil {
+					return err
+				}
+				reqL = reqL[:0]
+			case <-time.After(walPollInterval):
+			}
+			continue
+		}
+
 		var req *prompb.WriteRequest
 		req, err = prwe.readPrompbFromWAL(ctx, prwe.rWALIndex.Load())
 		if err != nil {
@@ -271,7 +351,7 @@ func (prwe *prweWAL) closeWAL() error {
 	return nil
 }
 
-func (prwe *prweWAL) syncAndTruncateFront() error {
+func (prwe *prweWAL) commitAndTruncateFront() error {
 	prwe.mu.Lock()
 	defer prwe.mu.Unlock()
 
@@ -283,9 +363,21 @@ func (prwe *prweWAL) syncAndTruncateFront() error {
 	if err := prwe.wal.Sync(); err != nil {
 		return err
 	}
-	// Truncate the WAL from the front for the entries that we already
-	// read from the WAL and had already exported.
-	if err := prwe.wal.TruncateFront(prwe.rWALIndex.Load()); err != nil && !errors.Is(err, wal.ErrOutOfRange) {
+	// Record that the requests that were read were exported, so that they aren't exported again on restart.
+	rIndex := prwe.rWALIndex.Load()
+	if err := writeCommittedIndex(prwe.walConfig.committedIndexPath(), rIndex-1); err != nil {
+		return err
+	}
+	// Truncate the WAL from the front for the entries that we already read from the WAL
+	// and had already exported. The WAL can't be emptied, so the last one may be kept.
+	lastIndex, err := prwe.wal.LastIndex()
+	if err != nil {
+		return err
+	}
+	if rIndex > lastIndex {
+		rIndex = lastIndex
+	}
+	if err := prwe.wal.TruncateFront(rIndex); err != nil && !errors.Is(err, wal.ErrOutOfRange) {
 		return err
 	}
 	return nil
@@ -300,13 +392,20 @@ func (prwe *prweWAL) exportThenFrontTruncateWAL(ctx context.Context, reqL []*pro
 	}
 
 	if errL := prwe.exportSink(ctx, reqL); errL != nil {
-		return errL
+		if !isPermanent(errL) {
+			return errL
+		}
+		// Retrying can't export the requests, skip them rather than blocking the WAL behind them forever.
+		if logger, err := loggerFromContext(ctx); err == nil {
+			logger.Error("dropping WAL requests that can't be exported",
+				zap.Int("dropped_requests", len(reqL)), zap.Error(errL))
+		}
 	}
-	if err := prwe.syncAndTruncateFront(); err != nil {
+	if err := prwe.commitAndTruncateFront(); err != nil {
 		return err
 	}
-	// Reset by retrieving the respective read and write WAL indices.
-	return prwe.retrieveWALIndices()
+	prwe.retryBackoff.Reset()
+	return nil
 }
 
 // persistToWAL is the routine that'll be hooked into the exporter's receiving side and it'll
@@ -434,3 +533,13 @@ func (prwe *prweWAL) readPrompbFromWAL(ctx context.Context, index uint64) (wreq
 	}
 	return nil, err
 }
+
+// isPermanent reports whether all the errors combined in err are permanent.
+func isPermanent(err error) bool {
+	for _, e := range multierr.Errors(err) {
+		if !consumererror.IsPermanent(e) {
+			return false
+		}
+	}
+	return true
+}
@@ -16,13 +16,19 @@ package prometheusremotewriteexporter
 
 import (
 	"context"
+	"errors"
 	"sort"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/prometheus/prometheus/prompb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.uber.org/atomic"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
 )
 
 func doNothingExportSink(_ context.Context, reqL []*prompb.WriteRequest) error {
@@ -167,3 +173,141 @@ func TestWAL_persist(t *testing.T) {
 	require.Equal(t, reqLFromWAL[0], reqL[0])
 	require.Equal(t, reqLFromWAL[1], reqL[1])
 }
+
+func TestWALReplaysUnexportedRequests(t *testing.T) {
+	config := &WALConfig{
+		Directory:         t.TempDir(),
+		BufferSize:        10,
+		TruncateFrequency: 10 * time.Millisecond,
+	}
+	ctx := contextWithLogger(context.Background(), zap.NewNop())
+	newRequest := func(value float64) *prompb.WriteRequest {
+		return &prompb.WriteRequest{
+			Timeseries: []prompb.TimeSeries{{
+				Labels:  []prompb.Label{{Name: "__name__", Value: "test"}},
+				Samples: []prompb.Sample{{Value: value, Timestamp: 100}},
+			}},
+		}
+	}
+
+	// 1. The remote write endpoint is unavailable, the requests are kept in the WAL.
+	attempts := atomic.NewInt32(0)
+	failingSink := func(context.Context, []*prompb.WriteRequest) error {
+		attempts.Inc()
+		return errors.New("remote write endpoint unavailable")
+	}
+	pwal, err := newWAL(config, failingSink)
+	require.NoError(t, err)
+	require.NoError(t, pwal.run(ctx))
+	require.NoError(t, pwal.persistToWAL([]*prompb.WriteRequest{newRequest(1), newRequest(2)}))
+	require.Eventually(t, func() bool { return attempts.Load() > 0 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, pwal.stop())
+
+	// 2. On restart, the requests that weren't exported are replayed.
+	var mu sync.Mutex
+	var exported []*prompb.WriteRequest
+	recordingSink := func(_ context.Context, reqL []*prompb.WriteRequest) error {
+		mu.Lock()
+		defer mu.Unlock()
+		exported = append(exported, reqL...)
+		return nil
+	}
+	exportedValues := func() []float64 {
+		mu.Lock()
+		defer mu.Unlock()
+		var values []float64
+		for _, req := range exported {
+			values = append(values, req.Timeseries[0].Samples[0].Value)
+		}
+		return values
+	}
+
+	pwal, err = newWAL(config, recordingSink)
+	require.NoError(t, err)
+	require.NoError(t, pwal.run(ctx))
+	require.Eventually(t, func() bool { return len(exportedValues()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, pwal.stop())
+	assert.Equal(t, []float64{1, 2}, exportedValues())
+
+	// 3. Once exported, the requests aren't replayed anymore.
+	pwal, err = newWAL(config, recordingSink)
+	require.NoError(t, err)
+	require.NoError(t, pwal.run(ctx))
+	require.NoError(t, pwal.persistToWAL([]*prompb.WriteRequest{newRequest(3)}))
+	require.Eventually(t, func() bool { return len(exportedValues()) == 3 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, pwal.stop())
+	assert.Equal(t, []float64{1, 2, 3}, exportedValues())
+}
+
+func TestWALSkipsPermanentlyFailingRequests(t *testing.T) {
+	config := &WALConfig{
+		Directory:         t.TempDir(),
+		BufferSize:        1,
+		TruncateFrequency: 10 * time.Millisecond,
+	}
+	ctx := contextWithLogger(context.Background(), zap.NewNop())
+	newRequest := func(value float64) *prompb.WriteRequest {
+		return &prompb.WriteRequest{
+			Timeseries: []prompb.TimeSeries{{
+				Labels:  []prompb.Label{{Name: "__name__", Value: "test"}},
+				Samples: []prompb.Sample{{Value: value, Timestamp: 100}},
+			}},
+		}
+	}
+
+	// The first request is rejected by the endpoint, it mustn't block the following ones.
+	var mu sync.Mutex
+	var attempted []float64
+	sink := func(_ context.Context, reqL []*prompb.WriteRequest) error {
+		mu.Lock()
+		defer mu.Unlock()
+		var errs error
+		for _, req := range reqL {
+			value := req.Timeseries[0].Samples[0].Value
+			attempted = append(attempted, value)
+			if value == 1 {
+				errs = multierr.Append(errs, consumererror.NewPermanent(errors.New("bad request")))
+			}
+		}
+		return errs
+	}
+	attemptedValues := func() []float64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]float64(nil), attempted...)
+	}
+
+	pwal, err := newWAL(config, sink)
+	require.NoError(t, err)
+	require.NoError(t, pwal.run(ctx))
+	require.NoError(t, pwal.persistToWAL([]*prompb.WriteRequest{newRequest(1), newRequest(2)}))
+	require.Eventually(t, func() bool { return len(attemptedValues()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, pwal.stop())
+	assert.Equal(t, []float64{1, 2}, attemptedValues())
+
+	committed, err := readCommittedIndex(config.committedIndexPath())
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, committed)
+}
+
+func TestIsPermanent(t *testing.T) {
+	permanentErr := consumererror.NewPermanent(errors.New("bad request"))
+	transientErr := errors.New("service unavailable")
+	assert.True(t, isPermanent(permanentErr))
+	assert.True(t, isPermanent(multierr.Combine(permanentErr, permanentErr)))
+	assert.False(t, isPermanent(transientErr))
+	assert.False(t, isPermanent(multierr.Combine(permanentErr, transientErr)))
+}
+
+func TestCommittedIndex(t *testing.T) {
+	path := (&WALConfig{Directory: t.TempDir()}).committedIndexPath()
+
+	index, err := readCommittedIndex(path)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), index, "no request was exported yet")
+
+	require.NoError(t, writeCommittedIndex(path, 42))
+	index, err = readCommittedIndex(path)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), index)
+}
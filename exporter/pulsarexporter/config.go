@@ -15,6 +15,9 @@
 package pulsarexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/pulsarexporter"
 
 import (
+	"errors"
+	"strings"
+
 	"github.com/apache/pulsar-client-go/pulsar"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
@@ -30,15 +33,21 @@ type Config struct {
 
 	// Endpoint of pulsar broker (default "pulsar://localhost:6650")
 	Endpoint string `mapstructure:"endpoint"`
-	// The name of the pulsar topic to export to (default otlp_spans for traces, otlp_metrics for metrics)
+	// The name of the pulsar topic to export to (default otlp_spans for traces, otlp_metrics for metrics).
+	// The {signal} placeholder is replaced with spans, metrics or logs, so that a single configuration
+	// can be shared by the pipelines of all signals.
 	Topic string `mapstructure:"topic"`
 	// Encoding of messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
 	// Set the path to the trusted TLS certificate file
 	TLSTrustCertsFilePath string `mapstructure:"tls_trust_certs_file_path"`
 	// Configure whether the Pulsar client accept untrusted TLS certificate from broker (default: false)
-	TLSAllowInsecureConnection bool           `mapstructure:"tls_allow_insecure_connection"`
-	Authentication             Authentication `mapstructure:"auth"`
+	TLSAllowInsecureConnection bool `mapstructure:"tls_allow_insecure_connection"`
+	// Configure whether the Pulsar client verifies that the broker hostname matches its TLS certificate (default: false)
+	TLSValidateHostname bool           `mapstructure:"tls_validate_hostname"`
+	Authentication      Authentication `mapstructure:"auth"`
+	// Schema of the produced messages, declared to the Pulsar schema registry.
+	Schema Schema `mapstructure:"schema"`
 }
 
 type Authentication struct {
@@ -71,14 +80,33 @@ type OAuth2 struct {
 	IssuerURL string `mapstructure:"issuer_url"`
 	ClientID  string `mapstructure:"client_id"`
 	Audience  string `mapstructure:"audience"`
+	// PrivateKey is the URL of the client credentials file, e.g. file:///path/to/credentials.json.
+	PrivateKey string `mapstructure:"private_key"`
+	Scope      string `mapstructure:"scope"`
 }
 
 var _ config.Exporter = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
 func (cfg *Config) Validate() error {
+	if oauth2 := cfg.Authentication.OAuth2; oauth2 != nil {
+		if oauth2.IssuerURL == "" {
+			return errors.New("auth.oauth2.issuer_url must be specified")
+		}
+		if oauth2.PrivateKey == "" {
+			return errors.New("auth.oauth2.private_key must be specified")
+		}
+	}
+	return cfg.Schema.validate(cfg.Encoding)
+}
 
-	return nil
+// topic returns the topic to export the signal to, or the default topic of the signal
+// if none is configured.
+func (cfg *Config) topic(signal, defaultTopic string) string {
+	if cfg.Topic == "" {
+		return defaultTopic
+	}
+	return strings.ReplaceAll(cfg.Topic, topicSignalPlaceholder, signal)
 }
 
 func (cfg *Config) auth() pulsar.Authentication {
@@ -91,9 +119,12 @@ func (cfg *Config) auth() pulsar.Authentication {
 	}
 	if authentication.OAuth2 != nil {
 		return pulsar.NewAuthenticationOAuth2(map[string]string{
-			"issuerUrl": authentication.OAuth2.IssuerURL,
-			"clientId":  authentication.OAuth2.ClientID,
-			"audience":  authentication.OAuth2.Audience,
+			"type":       "client_credentials",
+			"issuerUrl":  authentication.OAuth2.IssuerURL,
+			"clientId":   authentication.OAuth2.ClientID,
+			"audience":   authentication.OAuth2.Audience,
+			"privateKey": authentication.OAuth2.PrivateKey,
+			"scope":      authentication.OAuth2.Scope,
 		})
 	}
 	if authentication.Athenz != nil {
@@ -117,6 +148,7 @@ func (cfg *Config) clientOptions() pulsar.ClientOptions {
 	}
 
 	options.TLSAllowInsecureConnection = cfg.TLSAllowInsecureConnection
+	options.TLSValidateHostname = cfg.TLSValidateHostname
 	if len(cfg.TLSTrustCertsFilePath) > 0 {
 		options.TLSTrustCertsFilePath = cfg.TLSTrustCertsFilePath
 	}
@@ -62,6 +62,29 @@ func TestLoadConfig(t *testing.T) {
 				Authentication:        Authentication{TLS: &TLS{CertFile: "cert.pem", KeyFile: "key.pem"}},
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "schema"),
+			expected: func() config.Exporter {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Topic = "persistent://public/otel/{signal}"
+				cfg.Endpoint = "pulsar+ssl://localhost:6651"
+				cfg.Encoding = "otlp_json"
+				cfg.TLSValidateHostname = true
+				cfg.Authentication = Authentication{OAuth2: &OAuth2{
+					IssuerURL:  "https://auth.example.com",
+					ClientID:   "otel",
+					Audience:   "urn:pulsar:cluster",
+					PrivateKey: "file:///etc/pulsar/credentials.json",
+					Scope:      "produce",
+				}}
+				cfg.Schema = Schema{
+					Type:       schemaTypeJSON,
+					Definition: `{"type":"record","name":"ExportTraceServiceRequest","fields":[]}`,
+					Properties: map[string]string{"source": "otel"},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -98,3 +121,76 @@ func TestClientOptions(t *testing.T) {
 	}, &options)
 
 }
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{
+			name: "default",
+			cfg:  Config{Encoding: defaultEncoding},
+		},
+		{
+			name:    "oauth2 without issuer",
+			cfg:     Config{Authentication: Authentication{OAuth2: &OAuth2{PrivateKey: "file:///key.json"}}},
+			wantErr: "auth.oauth2.issuer_url must be specified",
+		},
+		{
+			name:    "oauth2 without private key",
+			cfg:     Config{Authentication: Authentication{OAuth2: &OAuth2{IssuerURL: "https://auth.example.com"}}},
+			wantErr: "auth.oauth2.private_key must be specified",
+		},
+		{
+			name:    "unsupported schema type",
+			cfg:     Config{Encoding: defaultEncoding, Schema: Schema{Type: "avro", Definition: "{}"}},
+			wantErr: `unsupported schema type "avro", must be "protobuf" or "json"`,
+		},
+		{
+			name:    "schema without definition",
+			cfg:     Config{Encoding: defaultEncoding, Schema: Schema{Type: schemaTypeProtobuf}},
+			wantErr: "schema.definition must be specified",
+		},
+		{
+			name:    "schema not matching encoding",
+			cfg:     Config{Encoding: "otlp_json", Schema: Schema{Type: schemaTypeProtobuf, Definition: "{}"}},
+			wantErr: `schema type "protobuf" requires one of the encodings [otlp_proto jaeger_proto], got "otlp_json"`,
+		},
+		{
+			name: "protobuf schema",
+			cfg:  Config{Encoding: "jaeger_proto", Schema: Schema{Type: schemaTypeProtobuf, Definition: "{}"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfig_topic(t *testing.T) {
+	assert.Equal(t, defaultTracesTopic, (&Config{}).topic("spans", defaultTracesTopic))
+	assert.Equal(t, "spans", (&Config{Topic: "spans"}).topic("spans", defaultTracesTopic))
+	assert.Equal(t, "persistent://public/otel/metrics", (&Config{Topic: "persistent://public/otel/{signal}"}).topic("metrics", defaultMetricsTopic))
+}
+
+func TestSchema_pulsarSchema(t *testing.T) {
+	assert.Nil(t, Schema{}.pulsarSchema())
+
+	schema := Schema{Type: schemaTypeProtobuf, Definition: "{}", Properties: map[string]string{"k": "v"}}.pulsarSchema()
+	require.NotNil(t, schema)
+	assert.Equal(t, &pulsar.SchemaInfo{Name: "protobuf", Schema: "{}", Type: pulsar.PROTOBUF, Properties: map[string]string{"k": "v"}}, schema.GetSchemaInfo())
+
+	payload, err := schema.Encode(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, payload)
+	payload, err = schema.Encode([]byte("payload"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("payload"), payload)
+}
@@ -92,9 +92,7 @@ func (f *pulsarExporterFactory) createTracesExporter(
 	cfg config.Exporter,
 ) (component.TracesExporter, error) {
 	oCfg := *(cfg.(*Config))
-	if oCfg.Topic == "" {
-		oCfg.Topic = defaultTracesTopic
-	}
+	oCfg.Topic = oCfg.topic("spans", defaultTracesTopic)
 	if oCfg.Encoding == "otlp_json" {
 		set.Logger.Info("otlp_json is considered experimental and should not be used in a production environment")
 	}
@@ -122,9 +120,7 @@ func (f *pulsarExporterFactory) createMetricsExporter(
 	cfg config.Exporter,
 ) (component.MetricsExporter, error) {
 	oCfg := *(cfg.(*Config))
-	if oCfg.Topic == "" {
-		oCfg.Topic = defaultMetricsTopic
-	}
+	oCfg.Topic = oCfg.topic("metrics", defaultMetricsTopic)
 	if oCfg.Encoding == "otlp_json" {
 		set.Logger.Info("otlp_json is considered experimental and should not be used in a production environment")
 	}
@@ -152,9 +148,7 @@ func (f *pulsarExporterFactory) createLogsExporter(
 	cfg config.Exporter,
 ) (component.LogsExporter, error) {
 	oCfg := *(cfg.(*Config))
-	if oCfg.Topic == "" {
-		oCfg.Topic = defaultLogsTopic
-	}
+	oCfg.Topic = oCfg.topic("logs", defaultLogsTopic)
 	if oCfg.Encoding == "otlp_json" {
 		set.Logger.Info("otlp_json is considered experimental and should not be used in a production environment")
 	}
@@ -144,6 +144,7 @@ func newPulsarProducer(config Config) (pulsar.Client, pulsar.Producer, error) {
 	producer, err := client.CreateProducer(pulsar.ProducerOptions{
 		Topic:       config.Topic,
 		SendTimeout: config.Timeout,
+		Schema:      config.Schema.pulsarSchema(),
 	})
 
 	if err != nil {
@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsarexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/pulsarexporter"
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+)
+
+const (
+	schemaTypeProtobuf = "protobuf"
+	schemaTypeJSON     = "json"
+
+	// topicSignalPlaceholder is replaced with the signal in the configured topic.
+	topicSignalPlaceholder = "{signal}"
+)
+
+// schemaEncodings are the encodings producing payloads of each schema type.
+var schemaEncodings = map[string][]string{
+	schemaTypeProtobuf: {"otlp_proto", "jaeger_proto"},
+	schemaTypeJSON:     {"otlp_json", "jaeger_json"},
+}
+
+var pulsarSchemaTypes = map[string]pulsar.SchemaType{
+	schemaTypeProtobuf: pulsar.PROTOBUF,
+	schemaTypeJSON:     pulsar.JSON,
+}
+
+// Schema declares the schema of the produced messages to the Pulsar schema registry,
+// so that the broker can enforce it and consumers can discover it.
+type Schema struct {
+	// Type of the schema, protobuf or json. No schema is declared if empty.
+	Type string `mapstructure:"type"`
+	// Definition of the schema, in the Avro JSON format used by Pulsar for protobuf and json schemas.
+	Definition string `mapstructure:"definition"`
+	// Properties attached to the schema.
+	Properties map[string]string `mapstructure:"properties"`
+}
+
+func (s Schema) validate(encoding string) error {
+	if s.Type == "" {
+		return nil
+	}
+	encodings, ok := schemaEncodings[s.Type]
+	if !ok {
+		return fmt.Errorf("unsupported schema type %q, must be %q or %q", s.Type, schemaTypeProtobuf, schemaTypeJSON)
+	}
+	if s.Definition == "" {
+		return errors.New("schema.definition must be specified")
+	}
+	for _, e := range encodings {
+		if e == encoding {
+			return nil
+		}
+	}
+	return fmt.Errorf("schema type %q requires one of the encodings %v, got %q", s.Type, encodings, encoding)
+}
+
+// pulsarSchema returns the schema to declare when creating the producer, or nil.
+func (s Schema) pulsarSchema() pulsar.Schema {
+	if s.Type == "" {
+		return nil
+	}
+	return &payloadSchema{
+		info: pulsar.SchemaInfo{
+			Name:       s.Type,
+			Schema:     s.Definition,
+			Type:       pulsarSchemaTypes[s.Type],
+			Properties: s.Properties,
+		},
+	}
+}
+
+// payloadSchema declares a schema for messages whose payload is already serialized
+// by the marshaler, unlike the schemas of the Pulsar client which serialize message values.
+type payloadSchema struct {
+	info pulsar.SchemaInfo
+}
+
+var _ pulsar.Schema = (*payloadSchema)(nil)
+
+// Encode is called with the nil value of the messages, their payload being sent as is.
+func (s *payloadSchema) Encode(v interface{}) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if b, ok := v.([]byte); ok {
+		return b, nil
+	}
+	return nil, fmt.Errorf("unsupported message value of type %T", v)
+}
+
+func (s *payloadSchema) Decode([]byte, interface{}) error {
+	return errors.New("decoding is not supported by the exporter schema")
+}
+
+func (s *payloadSchema) Validate([]byte) error {
+	return nil
+}
+
+func (s *payloadSchema) GetSchemaInfo() *pulsar.SchemaInfo {
+	return &s.info
+}
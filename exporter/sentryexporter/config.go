@@ -14,7 +14,12 @@
 
 package sentryexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sentryexporter"
 
-import "go.opentelemetry.io/collector/config"
+import (
+	"fmt"
+
+	"github.com/getsentry/sentry-go"
+	"go.opentelemetry.io/collector/config"
+)
 
 // Config defines the configuration for the Sentry Exporter.
 type Config struct {
@@ -23,4 +28,35 @@ type Config struct {
 	DSN string `mapstructure:"dsn"`
 	// InsecureSkipVerify controls whether the client verifies the Sentry server certificate chain
 	InsecureSkipVerify bool `mapstructure:"insecure_skip_verify"`
+	// Logs configures how log records are converted to Sentry events.
+	Logs LogsSettings `mapstructure:"logs"`
+}
+
+// LogsSettings configures the conversion of log records to Sentry events.
+type LogsSettings struct {
+	// FingerprintStatements are OTTL statements evaluated against each log record. The non-empty
+	// strings they return form the fingerprint Sentry groups the event by. Sentry's default
+	// grouping is used when none of the statements returns a string.
+	FingerprintStatements []string `mapstructure:"fingerprint_statements"`
+	// LevelMapping maps log severity texts to Sentry levels. Severity texts that aren't mapped
+	// get the level matching the severity number of the log record.
+	LevelMapping map[string]string `mapstructure:"level_mapping"`
+}
+
+var validLevels = map[sentry.Level]bool{
+	sentry.LevelDebug:   true,
+	sentry.LevelInfo:    true,
+	sentry.LevelWarning: true,
+	sentry.LevelError:   true,
+	sentry.LevelFatal:   true,
+}
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	for severityText, level := range cfg.Logs.LevelMapping {
+		if !validLevels[sentry.Level(level)] {
+			return fmt.Errorf("logs.level_mapping: invalid Sentry level %q for severity text %q", level, severityText)
+		}
+	}
+	return nil
 }
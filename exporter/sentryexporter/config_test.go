@@ -45,6 +45,17 @@ func TestLoadConfig(t *testing.T) {
 				DSN:              "https://key@host/path/42",
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "logs"),
+			expected: &Config{
+				ExporterSettings: config.NewExporterSettings(config.NewComponentID(typeStr)),
+				DSN:              "https://key@host/path/42",
+				Logs: LogsSettings{
+					FingerprintStatements: []string{`Concat("/", resource.attributes["service.name"], attributes["exception.type"])`},
+					LevelMapping:          map[string]string{"critical": "fatal"},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -61,3 +72,9 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateInvalidLevelMapping(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Logs.LevelMapping = map[string]string{"critical": "panic"}
+	assert.EqualError(t, cfg.Validate(), `logs.level_mapping: invalid Sentry level "panic" for severity text "critical"`)
+}
@@ -26,6 +26,8 @@ const (
 	typeStr = "sentry"
 	// The stability level of the exporter.
 	stability = component.StabilityLevelBeta
+	// The stability level of the logs exporter.
+	logsStability = component.StabilityLevelInDevelopment
 )
 
 // NewFactory creates a factory for Sentry exporter.
@@ -34,6 +36,7 @@ func NewFactory() component.ExporterFactory {
 		typeStr,
 		createDefaultConfig,
 		component.WithTracesExporter(createTracesExporter, stability),
+		component.WithLogsExporter(createLogsExporter, logsStability),
 	)
 }
 
@@ -57,3 +60,16 @@ func createTracesExporter(
 	exp, err := CreateSentryExporter(sentryConfig, params)
 	return exp, err
 }
+
+func createLogsExporter(
+	_ context.Context,
+	params component.ExporterCreateSettings,
+	config config.Exporter,
+) (component.LogsExporter, error) {
+	sentryConfig, ok := config.(*Config)
+	if !ok {
+		return nil, fmt.Errorf("unexpected config type: %T", config)
+	}
+
+	return CreateSentryLogsExporter(sentryConfig, params)
+}
@@ -79,7 +79,8 @@ var canonicalCodesGrpcMap = map[string]sentry.SpanStatus{
 
 // SentryExporter defines the Sentry Exporter.
 type SentryExporter struct {
-	transport transport
+	transport    transport
+	logConverter *logConverter
 }
 
 // pushTraceData takes an incoming OpenTelemetry trace, converts them into Sentry spans and transactions
@@ -475,6 +476,45 @@ func generateEventID() sentry.EventID {
 
 // CreateSentryExporter returns a new Sentry Exporter.
 func CreateSentryExporter(config *Config, set component.ExporterCreateSettings) (component.TracesExporter, error) {
+	transport := newConfiguredTransport(config)
+
+	s := &SentryExporter{
+		transport: transport,
+	}
+
+	return exporterhelper.NewTracesExporter(
+		context.TODO(),
+		set,
+		config,
+		s.pushTraceData,
+		exporterhelper.WithShutdown(flushOnShutdown(transport, set)),
+	)
+}
+
+// CreateSentryLogsExporter returns a new Sentry Exporter sending log records as Sentry events.
+func CreateSentryLogsExporter(config *Config, set component.ExporterCreateSettings) (component.LogsExporter, error) {
+	converter, err := newLogConverter(config.Logs, set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := newConfiguredTransport(config)
+
+	s := &SentryExporter{
+		transport:    transport,
+		logConverter: converter,
+	}
+
+	return exporterhelper.NewLogsExporter(
+		context.TODO(),
+		set,
+		config,
+		s.pushLogData,
+		exporterhelper.WithShutdown(flushOnShutdown(transport, set)),
+	)
+}
+
+func newConfiguredTransport(config *Config) transport {
 	transport := newSentryTransport()
 
 	clientOptions := sentry.ClientOptions{
@@ -487,23 +527,17 @@ func CreateSentryExporter(config *Config, set component.ExporterCreateSettings)
 
 	transport.Configure(clientOptions)
 
-	s := &SentryExporter{
-		transport: transport,
-	}
+	return transport
+}
 
-	return exporterhelper.NewTracesExporter(
-		context.TODO(),
-		set,
-		config,
-		s.pushTraceData,
-		exporterhelper.WithShutdown(func(ctx context.Context) error {
-			allEventsFlushed := transport.Flush(ctx)
+func flushOnShutdown(transport transport, set component.ExporterCreateSettings) component.ShutdownFunc {
+	return func(ctx context.Context) error {
+		allEventsFlushed := transport.Flush(ctx)
 
-			if !allEventsFlushed {
-				set.Logger.Warn("Could not flush all events, reached timeout")
-			}
+		if !allEventsFlushed {
+			set.Logger.Warn("Could not flush all events, reached timeout")
+		}
 
-			return nil
-		}),
-	)
+		return nil
+	}
 }
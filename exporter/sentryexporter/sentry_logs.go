@@ -0,0 +1,183 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sentryexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sentryexporter"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/getsentry/sentry-go"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllogs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// logConverter converts log records to Sentry events.
+type logConverter struct {
+	fingerprint  []*ottl.Statement[ottllogs.TransformContext]
+	levelMapping map[string]sentry.Level
+}
+
+func newLogConverter(settings LogsSettings, telemetrySettings component.TelemetrySettings) (*logConverter, error) {
+	converter := &logConverter{
+		levelMapping: make(map[string]sentry.Level, len(settings.LevelMapping)),
+	}
+	for severityText, level := range settings.LevelMapping {
+		converter.levelMapping[strings.ToLower(severityText)] = sentry.Level(level)
+	}
+	if len(settings.FingerprintStatements) == 0 {
+		return converter, nil
+	}
+	parser := ottllogs.NewParser(fingerprintFunctions(), telemetrySettings)
+	statements, err := parser.ParseStatements(settings.FingerprintStatements)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse logs.fingerprint_statements: %w", err)
+	}
+	converter.fingerprint = statements
+	return converter, nil
+}
+
+// fingerprintFunctions returns the OTTL functions available to compute the fingerprint.
+func fingerprintFunctions() map[string]interface{} {
+	return map[string]interface{}{
+		"Concat":    ottlfuncs.Concat[ottllogs.TransformContext],
+		"Hex":       ottlfuncs.Hex[ottllogs.TransformContext],
+		"IsMatch":   ottlfuncs.IsMatch[ottllogs.TransformContext],
+		"HasPrefix": ottlfuncs.HasPrefix[ottllogs.TransformContext],
+	}
+}
+
+// pushLogData takes incoming OpenTelemetry logs, converts each log record into a Sentry event
+// and sends them using Sentry's transport.
+func (s *SentryExporter) pushLogData(_ context.Context, ld plog.Logs) error {
+	events := make([]*sentry.Event, 0, ld.LogRecordCount())
+
+	resourceLogs := ld.ResourceLogs()
+	for i := 0; i < resourceLogs.Len(); i++ {
+		rl := resourceLogs.At(i)
+		resource := rl.Resource()
+		resourceTags := generateTagsFromResource(resource)
+
+		scopeLogs := rl.ScopeLogs()
+		for j := 0; j < scopeLogs.Len(); j++ {
+			sl := scopeLogs.At(j)
+			logRecords := sl.LogRecords()
+			for k := 0; k < logRecords.Len(); k++ {
+				events = append(events, s.logConverter.eventFromLogRecord(logRecords.At(k), sl.Scope(), resource, resourceTags))
+			}
+		}
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	s.transport.SendEvents(events)
+
+	return nil
+}
+
+// eventFromLogRecord converts a log record to a Sentry event. The event carries the trace context
+// of the log record, so that Sentry links it to the transaction of the span it was emitted in.
+func (c *logConverter) eventFromLogRecord(logRecord plog.LogRecord, scope pcommon.InstrumentationScope, resource pcommon.Resource, resourceTags map[string]string) *sentry.Event {
+	tags := generateTagsFromAttributes(logRecord.Attributes())
+	for k, v := range resourceTags {
+		tags[k] = v
+	}
+	tags["library_name"] = scope.Name()
+	tags["library_version"] = scope.Version()
+
+	event := sentry.NewEvent()
+	event.EventID = generateEventID()
+
+	event.Level = c.level(logRecord)
+	event.Message = logRecord.Body().AsString()
+	event.Logger = scope.Name()
+	event.Tags = tags
+
+	timestamp := logRecord.Timestamp()
+	if timestamp == 0 {
+		timestamp = logRecord.ObservedTimestamp()
+	}
+	event.Timestamp = unixNanoToTime(timestamp)
+
+	if traceID := logRecord.TraceID(); !traceID.IsEmpty() {
+		event.Contexts["trace"] = sentry.TraceContext{
+			TraceID: sentry.TraceID(traceID),
+			SpanID:  sentry.SpanID(logRecord.SpanID()),
+		}.Map()
+	}
+
+	exceptionType := tags[conventions.AttributeExceptionType]
+	exceptionMessage := tags[conventions.AttributeExceptionMessage]
+	if exceptionType != "" || exceptionMessage != "" {
+		event.Exception = []sentry.Exception{{
+			Type:  exceptionType,
+			Value: exceptionMessage,
+		}}
+	}
+
+	event.Sdk.Name = otelSentryExporterName
+	event.Sdk.Version = otelSentryExporterVersion
+
+	event.Fingerprint = c.fingerprintOf(logRecord, scope, resource)
+
+	return event
+}
+
+// level returns the Sentry level of the log record: the level mapped to its severity text if any,
+// otherwise the level matching its severity number.
+func (c *logConverter) level(logRecord plog.LogRecord) sentry.Level {
+	if level, ok := c.levelMapping[strings.ToLower(logRecord.SeverityText())]; ok {
+		return level
+	}
+	switch severity := logRecord.SeverityNumber(); {
+	case severity >= plog.SeverityNumberFatal:
+		return sentry.LevelFatal
+	case severity >= plog.SeverityNumberError:
+		return sentry.LevelError
+	case severity >= plog.SeverityNumberWarn:
+		return sentry.LevelWarning
+	case severity >= plog.SeverityNumberInfo:
+		return sentry.LevelInfo
+	case severity >= plog.SeverityNumberTrace:
+		return sentry.LevelDebug
+	default:
+		return sentry.LevelInfo
+	}
+}
+
+// fingerprintOf returns the non-empty strings returned by the fingerprint statements, or nil
+// when none of them returned one.
+func (c *logConverter) fingerprintOf(logRecord plog.LogRecord, scope pcommon.InstrumentationScope, resource pcommon.Resource) []string {
+	if len(c.fingerprint) == 0 {
+		return nil
+	}
+	var fingerprint []string
+	ctx := ottllogs.NewTransformContext(logRecord, scope, resource)
+	for _, statement := range c.fingerprint {
+		result, executed := statement.Execute(ctx)
+		if value, ok := result.(string); executed && ok && value != "" {
+			fingerprint = append(fingerprint, value)
+		}
+	}
+	return fingerprint
+}
@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sentryexporter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+func newTestLogs() (plog.Logs, plog.LogRecord) {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr(conventions.AttributeServiceName, "checkout")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("checkout.payments")
+	sl.Scope().SetVersion("1.0.0")
+	logRecord := sl.LogRecords().AppendEmpty()
+	logRecord.Body().SetStr("payment declined")
+	logRecord.Attributes().PutStr("payment.provider", "acme")
+	logRecord.SetTimestamp(pcommon.Timestamp(1666000000000000000))
+	logRecord.SetSeverityNumber(plog.SeverityNumberError)
+	logRecord.SetSeverityText("ERROR")
+	return logs, logRecord
+}
+
+func TestPushLogData(t *testing.T) {
+	logs, logRecord := newTestLogs()
+	logRecord.SetTraceID(pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+	logRecord.SetSpanID(pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	logRecord.Attributes().PutStr(conventions.AttributeExceptionType, "PaymentError")
+	logRecord.Attributes().PutStr(conventions.AttributeExceptionMessage, "card expired")
+
+	converter, err := newLogConverter(LogsSettings{
+		FingerprintStatements: []string{
+			`Concat("/", resource.attributes["service.name"], attributes["payment.provider"])`,
+			`Concat("", attributes["missing"]) where attributes["missing"] != nil`,
+		},
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	transport := &mockTransport{}
+	s := &SentryExporter{
+		transport:    transport,
+		logConverter: converter,
+	}
+	require.NoError(t, s.pushLogData(context.Background(), logs))
+	require.True(t, transport.called)
+	require.Len(t, transport.transactions, 1)
+
+	event := transport.transactions[0]
+	assert.Equal(t, sentry.LevelError, event.Level)
+	assert.Equal(t, "payment declined", event.Message)
+	assert.Equal(t, "checkout.payments", event.Logger)
+	assert.Equal(t, unixNanoToTime(pcommon.Timestamp(1666000000000000000)), event.Timestamp)
+	assert.Equal(t, "checkout", event.Tags[conventions.AttributeServiceName])
+	assert.Equal(t, "acme", event.Tags["payment.provider"])
+	assert.Equal(t, "1.0.0", event.Tags["library_version"])
+	assert.Equal(t, []string{"checkout/acme"}, event.Fingerprint)
+	assert.Equal(t, []sentry.Exception{{Type: "PaymentError", Value: "card expired"}}, event.Exception)
+	assert.Equal(t, sentry.TraceContext{
+		TraceID: sentry.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}),
+		SpanID:  sentry.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+	}.Map(), event.Contexts["trace"])
+}
+
+func TestPushLogDataWithoutLogRecords(t *testing.T) {
+	converter, err := newLogConverter(LogsSettings{}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	logs := plog.NewLogs()
+	logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+
+	transport := &mockTransport{}
+	s := &SentryExporter{
+		transport:    transport,
+		logConverter: converter,
+	}
+	require.NoError(t, s.pushLogData(context.Background(), logs))
+	assert.False(t, transport.called)
+}
+
+func TestEventFromLogRecordWithoutTraceContext(t *testing.T) {
+	logs, logRecord := newTestLogs()
+	logRecord.SetTimestamp(0)
+	logRecord.SetObservedTimestamp(pcommon.Timestamp(1666000000000000000))
+
+	converter, err := newLogConverter(LogsSettings{}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	rl := logs.ResourceLogs().At(0)
+	sl := rl.ScopeLogs().At(0)
+	event := converter.eventFromLogRecord(logRecord, sl.Scope(), rl.Resource(), generateTagsFromResource(rl.Resource()))
+	assert.NotContains(t, event.Contexts, "trace")
+	assert.Nil(t, event.Fingerprint)
+	assert.Nil(t, event.Exception)
+	assert.Equal(t, unixNanoToTime(pcommon.Timestamp(1666000000000000000)), event.Timestamp)
+}
+
+func TestLogLevel(t *testing.T) {
+	converter, err := newLogConverter(LogsSettings{
+		LevelMapping: map[string]string{"Critical": "fatal", "notice": "warning"},
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	tests := []struct {
+		severityNumber plog.SeverityNumber
+		severityText   string
+		want           sentry.Level
+	}{
+		{severityNumber: plog.SeverityNumberUnspecified, want: sentry.LevelInfo},
+		{severityNumber: plog.SeverityNumberTrace2, want: sentry.LevelDebug},
+		{severityNumber: plog.SeverityNumberDebug, want: sentry.LevelDebug},
+		{severityNumber: plog.SeverityNumberInfo4, want: sentry.LevelInfo},
+		{severityNumber: plog.SeverityNumberWarn, want: sentry.LevelWarning},
+		{severityNumber: plog.SeverityNumberError3, want: sentry.LevelError},
+		{severityNumber: plog.SeverityNumberFatal, want: sentry.LevelFatal},
+		{severityNumber: plog.SeverityNumberError, severityText: "CRITICAL", want: sentry.LevelFatal},
+		{severityNumber: plog.SeverityNumberInfo, severityText: "Notice", want: sentry.LevelWarning},
+		{severityNumber: plog.SeverityNumberInfo, severityText: "unmapped", want: sentry.LevelInfo},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d/%s", tt.severityNumber, tt.severityText), func(t *testing.T) {
+			logRecord := plog.NewLogRecord()
+			logRecord.SetSeverityNumber(tt.severityNumber)
+			logRecord.SetSeverityText(tt.severityText)
+			assert.Equal(t, tt.want, converter.level(logRecord))
+		})
+	}
+}
+
+func TestNewLogConverterInvalidStatement(t *testing.T) {
+	_, err := newLogConverter(LogsSettings{
+		FingerprintStatements: []string{`Unknown(body)`},
+	}, componenttest.NewNopTelemetrySettings())
+	assert.Error(t, err)
+}
@@ -101,6 +101,11 @@ type Config struct {
 	// See ./translation/default_metrics.go for a list of metrics that are dropped by default.
 	IncludeMetrics []dpfilters.MetricFilter `mapstructure:"include_metrics"`
 
+	// HistogramTranslations defines how histograms are translated to SignalFx datapoints, per metric name.
+	// The histograms not matching any of them are translated to count, sum, min, max and cumulative
+	// bucket counters.
+	HistogramTranslations []translation.HistogramTranslation `mapstructure:"histogram_translations"`
+
 	// Correlation configuration for syncing traces service and environment to metrics.
 	Correlation *correlation.Config `mapstructure:"correlation"`
 
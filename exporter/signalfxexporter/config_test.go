@@ -156,6 +156,13 @@ func TestLoadConfig(t *testing.T) {
 						MetricNames: []string{"metric2", "metric3"},
 					},
 				},
+				HistogramTranslations: []translation.HistogramTranslation{
+					{
+						MetricNames: []string{"http.server.duration", "/^rpc\\..*/"},
+						Mode:        translation.HistogramModeBucketsAndQuantiles,
+						Quantiles:   []float64{0.5, 0.95},
+					},
+				},
 				DeltaTranslationTTL: 3600,
 				Correlation: &correlation.Config{
 					HTTPClientSettings: confighttp.HTTPClientSettings{
@@ -98,6 +98,7 @@ func newSignalFxExporter(
 		config.ExcludeMetrics,
 		config.IncludeMetrics,
 		config.NonAlphanumericDimensionChars,
+		config.HistogramTranslations,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create metric converter: %w", err)
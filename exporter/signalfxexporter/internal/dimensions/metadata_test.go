@@ -215,6 +215,7 @@ func TestGetDimensionUpdateFromMetadata(t *testing.T) {
 				nil,
 				nil,
 				"-_.",
+				nil,
 			)
 			require.NoError(t, err)
 			got := getDimensionUpdateFromMetadata(tt.args.metadata, *converter)
@@ -44,32 +44,40 @@ var (
 // MetricsConverter converts MetricsData to sfxpb DataPoints. It holds an optional
 // MetricTranslator to translate SFx metrics using translation rules.
 type MetricsConverter struct {
-	logger             *zap.Logger
-	metricTranslator   *MetricTranslator
-	filterSet          *dpfilters.FilterSet
-	datapointValidator *datapointValidator
-	translator         *signalfx.FromTranslator
+	logger              *zap.Logger
+	metricTranslator    *MetricTranslator
+	filterSet           *dpfilters.FilterSet
+	datapointValidator  *datapointValidator
+	translator          *signalfx.FromTranslator
+	histogramTranslator *histogramTranslator
 }
 
 // NewMetricsConverter creates a MetricsConverter from the passed in logger and
 // MetricTranslator. Pass in a nil MetricTranslator to not use translation
-// rules.
+// rules. The histograms not matching any of the histogramTranslations are
+// translated to bucket counters.
 func NewMetricsConverter(
 	logger *zap.Logger,
 	t *MetricTranslator,
 	excludes []dpfilters.MetricFilter,
 	includes []dpfilters.MetricFilter,
-	nonAlphanumericDimChars string) (*MetricsConverter, error) {
+	nonAlphanumericDimChars string,
+	histogramTranslations []HistogramTranslation) (*MetricsConverter, error) {
 	fs, err := dpfilters.NewFilterSet(excludes, includes)
 	if err != nil {
 		return nil, err
 	}
+	ht, err := newHistogramTranslator(histogramTranslations)
+	if err != nil {
+		return nil, err
+	}
 	return &MetricsConverter{
-		logger:             logger,
-		metricTranslator:   t,
-		filterSet:          fs,
-		datapointValidator: newDatapointValidator(logger, nonAlphanumericDimChars),
-		translator:         &signalfx.FromTranslator{},
+		logger:              logger,
+		metricTranslator:    t,
+		filterSet:           fs,
+		datapointValidator:  newDatapointValidator(logger, nonAlphanumericDimChars),
+		translator:          &signalfx.FromTranslator{},
+		histogramTranslator: ht,
 	}, nil
 }
 
@@ -89,7 +97,11 @@ func (c *MetricsConverter) MetricsToSignalFxV2(md pmetric.Metrics) []*sfxpb.Data
 			var initialDps []*sfxpb.DataPoint
 
 			for k := 0; k < ilm.Metrics().Len(); k++ {
-				dps := c.translator.FromMetric(ilm.Metrics().At(k), extraDimensions)
+				m := ilm.Metrics().At(k)
+				dps := c.translator.FromMetric(m, extraDimensions)
+				if m.Type() == pmetric.MetricTypeHistogram {
+					dps = c.histogramTranslator.translate(m, dps, extraDimensions)
+				}
 				initialDps = append(initialDps, dps...)
 			}
 
@@ -0,0 +1,210 @@
+// Copyright OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation"
+
+import (
+	"fmt"
+	"strconv"
+
+	sfxpb "github.com/signalfx/com_signalfx_metrics_protobuf/model"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/signalfxexporter/internal/translation/dpfilters"
+)
+
+// HistogramTranslationMode defines the datapoints a histogram is translated to.
+type HistogramTranslationMode string
+
+const (
+	// HistogramModeBuckets translates histograms to count, sum, min, max and
+	// cumulative bucket counters with the "le" dimension. This is the default.
+	HistogramModeBuckets HistogramTranslationMode = "buckets"
+	// HistogramModeBucketsAndQuantiles adds quantile estimates to the datapoints
+	// of HistogramModeBuckets.
+	HistogramModeBucketsAndQuantiles HistogramTranslationMode = "buckets_and_quantiles"
+	// HistogramModeQuantiles translates histograms to count, sum, min, max and
+	// quantile estimates, without the bucket counters.
+	HistogramModeQuantiles HistogramTranslationMode = "quantiles"
+)
+
+// quantileDimensionKey is the dimension key of the quantile estimates, as for summaries.
+const quantileDimensionKey = "quantile"
+
+var defaultHistogramQuantiles = []float64{0.5, 0.9, 0.99}
+
+// HistogramTranslation defines how the histograms matching its metric names are translated.
+type HistogramTranslation struct {
+	// MetricNames is the list of histogram names the translation applies to. Globs
+	// and regexes are supported, as in exclude_metrics.
+	MetricNames []string `mapstructure:"metric_names"`
+	// Mode is the HistogramTranslationMode of the histograms.
+	Mode HistogramTranslationMode `mapstructure:"mode"`
+	// Quantiles are the quantiles estimated from the buckets of the histograms,
+	// sent as "<name>_quantile" gauges. Default is [0.5, 0.9, 0.99].
+	Quantiles []float64 `mapstructure:"quantiles"`
+
+	metricNamesFilter *dpfilters.StringFilter
+}
+
+// init validates the histogram translation and compiles its metric names filter.
+func (ht *HistogramTranslation) init() error {
+	if len(ht.MetricNames) == 0 {
+		return fmt.Errorf("histogram translation must have at least one metric name")
+	}
+	switch ht.Mode {
+	case HistogramModeBuckets, HistogramModeBucketsAndQuantiles, HistogramModeQuantiles:
+	default:
+		return fmt.Errorf("invalid histogram translation mode %q", ht.Mode)
+	}
+	if len(ht.Quantiles) == 0 {
+		ht.Quantiles = defaultHistogramQuantiles
+	}
+	for _, q := range ht.Quantiles {
+		if q < 0 || q > 1 {
+			return fmt.Errorf("invalid histogram quantile %v, must be between 0 and 1", q)
+		}
+	}
+	filter, err := dpfilters.NewStringFilter(ht.MetricNames)
+	if err != nil {
+		return err
+	}
+	ht.metricNamesFilter = filter
+	return nil
+}
+
+// histogramTranslator applies the first histogram translation matching the name of a histogram.
+type histogramTranslator struct {
+	translations []HistogramTranslation
+}
+
+func newHistogramTranslator(translations []HistogramTranslation) (*histogramTranslator, error) {
+	initialized := make([]HistogramTranslation, len(translations))
+	for i, ht := range translations {
+		if err := ht.init(); err != nil {
+			return nil, err
+		}
+		initialized[i] = ht
+	}
+	return &histogramTranslator{translations: initialized}, nil
+}
+
+// translate updates the datapoints of the histogram translated by the default translation
+// according to the histogram translation matching its name.
+func (t *histogramTranslator) translate(m pmetric.Metric, dps []*sfxpb.DataPoint, extraDims []*sfxpb.Dimension) []*sfxpb.DataPoint {
+	var translation *HistogramTranslation
+	for i := range t.translations {
+		if t.translations[i].metricNamesFilter.Matches(m.Name()) {
+			translation = &t.translations[i]
+			break
+		}
+	}
+	if translation == nil || translation.Mode == HistogramModeBuckets {
+		return dps
+	}
+
+	if translation.Mode == HistogramModeQuantiles {
+		bucketMetricName := m.Name() + "_bucket"
+		filtered := dps[:0]
+		for _, dp := range dps {
+			if dp.Metric != bucketMetricName {
+				filtered = append(filtered, dp)
+			}
+		}
+		dps = filtered
+	}
+
+	histDPs := m.Histogram().DataPoints()
+	for i := 0; i < histDPs.Len(); i++ {
+		histDP := histDPs.At(i)
+		if histDP.Count() == 0 || histDP.ExplicitBounds().Len() == 0 ||
+			histDP.BucketCounts().Len() != histDP.ExplicitBounds().Len()+1 {
+			continue
+		}
+		dims := attributesToDimensions(histDP.Attributes(), extraDims)
+		ts := int64(histDP.Timestamp()) / 1e6
+		for _, q := range translation.Quantiles {
+			v := estimateQuantile(histDP, q)
+			quantileDims := make([]*sfxpb.Dimension, len(dims)+1)
+			copy(quantileDims, dims)
+			quantileDims[len(dims)] = &sfxpb.Dimension{
+				Key:   quantileDimensionKey,
+				Value: strconv.FormatFloat(q, 'f', -1, 64),
+			}
+			dps = append(dps, &sfxpb.DataPoint{
+				Metric:     m.Name() + "_quantile",
+				Timestamp:  ts,
+				Value:      sfxpb.Datum{DoubleValue: &v},
+				MetricType: &sfxMetricTypeGauge,
+				Dimensions: quantileDims,
+			})
+		}
+	}
+	return dps
+}
+
+// estimateQuantile estimates the quantile of the histogram datapoint by linear interpolation
+// within the bucket containing it, like the histogram_quantile function of Prometheus.
+// The lower bound of the first bucket and the upper bound of the overflow bucket are the
+// min and the max of the datapoint if set, 0 and the highest explicit bound otherwise.
+func estimateQuantile(histDP pmetric.HistogramDataPoint, q float64) float64 {
+	bounds := histDP.ExplicitBounds()
+	counts := histDP.BucketCounts()
+	rank := q * float64(histDP.Count())
+
+	var cumulative uint64
+	for i := 0; i < counts.Len(); i++ {
+		count := counts.At(i)
+		if count == 0 || float64(cumulative+count) < rank {
+			cumulative += count
+			continue
+		}
+
+		var lower, upper float64
+		switch {
+		case i == 0:
+			upper = bounds.At(0)
+			if histDP.HasMin() {
+				lower = histDP.Min()
+			} else if upper > 0 {
+				lower = 0
+			} else {
+				return upper
+			}
+		case i == bounds.Len():
+			if !histDP.HasMax() {
+				return bounds.At(i - 1)
+			}
+			lower, upper = bounds.At(i-1), histDP.Max()
+		default:
+			lower, upper = bounds.At(i-1), bounds.At(i)
+		}
+		return lower + (upper-lower)*(rank-float64(cumulative))/float64(count)
+	}
+	return bounds.At(bounds.Len() - 1)
+}
+
+func attributesToDimensions(attributes pcommon.Map, extraDims []*sfxpb.Dimension) []*sfxpb.Dimension {
+	dimensions := make([]*sfxpb.Dimension, len(extraDims), attributes.Len()+len(extraDims))
+	copy(dimensions, extraDims)
+	attributes.Range(func(k string, v pcommon.Value) bool {
+		dimensions = append(dimensions, &sfxpb.Dimension{
+			Key:   k,
+			Value: v.AsString(),
+		})
+		return true
+	})
+	return dimensions
+}
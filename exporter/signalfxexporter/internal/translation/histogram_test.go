@@ -0,0 +1,165 @@
+// Copyright OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translation
+
+import (
+	"testing"
+
+	sfxpb "github.com/signalfx/com_signalfx_metrics_protobuf/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+func TestEstimateQuantile(t *testing.T) {
+	tests := []struct {
+		name     string
+		min      *float64
+		max      *float64
+		quantile float64
+		want     float64
+	}{
+		{name: "zero quantile", quantile: 0, want: 0},
+		{name: "first bucket with min", min: floatPtr(0.5), quantile: 0.1, want: 0.75},
+		{name: "median", quantile: 0.5, want: 1.75},
+		{name: "bucket upper bound", quantile: 0.9, want: 5},
+		{name: "overflow bucket without max", quantile: 0.99, want: 5},
+		{name: "overflow bucket with max", max: floatPtr(8), quantile: 0.99, want: 7.7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dp := pmetric.NewHistogramDataPoint()
+			dp.SetCount(10)
+			dp.ExplicitBounds().FromRaw([]float64{1, 2, 5})
+			dp.BucketCounts().FromRaw([]uint64{2, 4, 3, 1})
+			if tt.min != nil {
+				dp.SetMin(*tt.min)
+			}
+			if tt.max != nil {
+				dp.SetMax(*tt.max)
+			}
+			assert.InDelta(t, tt.want, estimateQuantile(dp, tt.quantile), 1e-9)
+		})
+	}
+}
+
+func TestMetricsConverter_HistogramTranslations(t *testing.T) {
+	tests := []struct {
+		name         string
+		translations []HistogramTranslation
+		wantMetrics  map[string]int
+		wantMedian   *float64
+	}{
+		{
+			name:        "default buckets",
+			wantMetrics: map[string]int{"latency_count": 1, "latency_sum": 1, "latency_bucket": 4},
+		},
+		{
+			name: "not matching",
+			translations: []HistogramTranslation{
+				{MetricNames: []string{"other.*"}, Mode: HistogramModeQuantiles},
+			},
+			wantMetrics: map[string]int{"latency_count": 1, "latency_sum": 1, "latency_bucket": 4},
+		},
+		{
+			name: "buckets and quantiles",
+			translations: []HistogramTranslation{
+				{MetricNames: []string{"lat*"}, Mode: HistogramModeBucketsAndQuantiles, Quantiles: []float64{0.5}},
+			},
+			wantMetrics: map[string]int{"latency_count": 1, "latency_sum": 1, "latency_bucket": 4, "latency_quantile": 1},
+			wantMedian:  floatPtr(1.75),
+		},
+		{
+			name: "quantiles with default quantiles",
+			translations: []HistogramTranslation{
+				{MetricNames: []string{"/^lat/"}, Mode: HistogramModeQuantiles},
+			},
+			wantMetrics: map[string]int{"latency_count": 1, "latency_sum": 1, "latency_quantile": 3},
+			wantMedian:  floatPtr(1.75),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewMetricsConverter(zap.NewNop(), nil, nil, nil, "", tt.translations)
+			require.NoError(t, err)
+
+			dps := c.MetricsToSignalFxV2(histogramMetrics())
+
+			gotMetrics := map[string]int{}
+			for _, dp := range dps {
+				gotMetrics[dp.Metric]++
+				if dp.Metric == "latency_quantile" {
+					assert.Equal(t, sfxpb.MetricType_GAUGE, *dp.MetricType)
+					assert.Equal(t, &sfxpb.Dimension{Key: "k", Value: "v"}, dp.Dimensions[0])
+					if dp.Dimensions[1].Value == "0.5" {
+						assert.Equal(t, *tt.wantMedian, *dp.Value.DoubleValue)
+					}
+				}
+			}
+			assert.Equal(t, tt.wantMetrics, gotMetrics)
+		})
+	}
+}
+
+func TestNewMetricsConverter_InvalidHistogramTranslations(t *testing.T) {
+	tests := []struct {
+		name        string
+		translation HistogramTranslation
+		wantErr     string
+	}{
+		{
+			name:        "no metric names",
+			translation: HistogramTranslation{Mode: HistogramModeQuantiles},
+			wantErr:     "histogram translation must have at least one metric name",
+		},
+		{
+			name:        "invalid mode",
+			translation: HistogramTranslation{MetricNames: []string{"latency"}, Mode: "percentiles"},
+			wantErr:     `invalid histogram translation mode "percentiles"`,
+		},
+		{
+			name:        "invalid quantile",
+			translation: HistogramTranslation{MetricNames: []string{"latency"}, Mode: HistogramModeQuantiles, Quantiles: []float64{99}},
+			wantErr:     "invalid histogram quantile 99, must be between 0 and 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMetricsConverter(zap.NewNop(), nil, nil, nil, "", []HistogramTranslation{tt.translation})
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func histogramMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("latency")
+	m.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp := m.Histogram().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.Timestamp(unixSecs*1e9 + unixNSecs))
+	dp.Attributes().PutStr("k", "v")
+	dp.SetCount(10)
+	dp.SetSum(25)
+	dp.ExplicitBounds().FromRaw([]float64{1, 2, 5})
+	dp.BucketCounts().FromRaw([]uint64{2, 4, 3, 1})
+	return md
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
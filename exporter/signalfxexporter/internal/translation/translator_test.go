@@ -2965,7 +2965,7 @@ func testConverter(t *testing.T, mapping map[string]string) *MetricsConverter {
 	tr, err := NewMetricTranslator(rules, 1)
 	require.NoError(t, err)
 
-	c, err := NewMetricsConverter(zap.NewNop(), tr, nil, nil, "")
+	c, err := NewMetricsConverter(zap.NewNop(), tr, nil, nil, "", nil)
 	require.NoError(t, err)
 	return c
 }
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splunkhecexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
+)
+
+// hecChannelHeader identifies the HEC channel used to track indexer acknowledgements.
+const hecChannelHeader = "X-Splunk-Request-Channel"
+
+// eventResponse is the HEC response to an event request.
+type eventResponse struct {
+	Text  string  `json:"text"`
+	Code  int     `json:"code"`
+	AckID *uint64 `json:"ackId"`
+}
+
+// ackRequest is the body of a HEC indexer acknowledgement status request.
+type ackRequest struct {
+	Acks []uint64 `json:"acks"`
+}
+
+// ackResponse is the HEC response to an indexer acknowledgement status request.
+type ackResponse struct {
+	Acks map[string]bool `json:"acks"`
+}
+
+// waitForAck reads the ack ID from the event response body and polls the HEC
+// acknowledgement endpoint until the events are indexed or the ack timeout expires.
+func (c *client) waitForAck(ctx context.Context, body io.Reader, headers map[string]string) error {
+	var resp eventResponse
+	if err := jsoniter.NewDecoder(body).Decode(&resp); err != nil {
+		return consumererror.NewPermanent(fmt.Errorf("failed to decode HEC response: %w", err))
+	}
+	// The events were accepted, retrying them would only duplicate data.
+	if resp.AckID == nil {
+		return consumererror.NewPermanent(errors.New("HEC response has no ack ID, indexer acknowledgement must be enabled on the HEC token"))
+	}
+	ackID := *resp.AckID
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Ack.Timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(c.config.Ack.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("events with ack ID %d were not acknowledged: %w", ackID, ctx.Err())
+		case <-ticker.C:
+		}
+
+		acked, err := c.queryAck(ctx, ackID, headers)
+		if err != nil {
+			c.logger.Debug("Failed to query HEC indexer acknowledgement", zap.Uint64("ack_id", ackID), zap.Error(err))
+			continue
+		}
+		if acked {
+			return nil
+		}
+	}
+}
+
+// queryAck returns whether the events with the given ack ID have been indexed.
+func (c *client) queryAck(ctx context.Context, ackID uint64, headers map[string]string) (bool, error) {
+	body, err := jsoniter.Marshal(ackRequest{Acks: []uint64{ackID}})
+	if err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.ackURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if err = splunk.HandleHTTPCode(resp); err != nil {
+		return false, err
+	}
+
+	var ackResp ackResponse
+	if err = jsoniter.NewDecoder(resp.Body).Decode(&ackResp); err != nil {
+		return false, fmt.Errorf("failed to decode HEC ack response: %w", err)
+	}
+	return ackResp.Acks[strconv.FormatUint(ackID, 10)], nil
+}
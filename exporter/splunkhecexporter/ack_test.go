@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splunkhecexporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.uber.org/zap"
+)
+
+func newAckTestClient(t *testing.T, handler http.Handler) *client {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = srv.URL + "/services/collector"
+	cfg.Token = "1234"
+	cfg.Ack.Enabled = true
+	cfg.Ack.PollInterval = 10 * time.Millisecond
+	cfg.Ack.Timeout = 200 * time.Millisecond
+
+	options, err := cfg.getOptionsFromConfig()
+	require.NoError(t, err)
+	c, err := buildClient(options, cfg, zap.NewNop())
+	require.NoError(t, err)
+	return c
+}
+
+func TestWaitForAck(t *testing.T) {
+	var polls int32
+	var channels []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/services/collector", func(w http.ResponseWriter, r *http.Request) {
+		channels = append(channels, r.Header.Get(hecChannelHeader))
+		_, _ = io.WriteString(w, `{"text":"Success","code":0,"ackId":3}`)
+	})
+	mux.HandleFunc("/services/collector/ack", func(w http.ResponseWriter, r *http.Request) {
+		channels = append(channels, r.Header.Get(hecChannelHeader))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"acks":[3]}`, string(body))
+		if atomic.AddInt32(&polls, 1) < 3 {
+			_, _ = io.WriteString(w, `{"acks":{"3":false}}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"acks":{"3":true}}`)
+	})
+	c := newAckTestClient(t, mux)
+
+	require.NoError(t, c.postEvents(context.Background(), strings.NewReader("{}"), nil, false))
+	assert.EqualValues(t, 3, atomic.LoadInt32(&polls))
+	require.Len(t, channels, 4)
+	assert.NotEmpty(t, channels[0])
+	for _, ch := range channels {
+		assert.Equal(t, channels[0], ch)
+	}
+}
+
+func TestWaitForAck_Timeout(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/services/collector", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"text":"Success","code":0,"ackId":7}`)
+	})
+	mux.HandleFunc("/services/collector/ack", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"acks":{"7":false}}`)
+	})
+	c := newAckTestClient(t, mux)
+
+	err := c.postEvents(context.Background(), strings.NewReader("{}"), nil, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "events with ack ID 7 were not acknowledged")
+	assert.False(t, consumererror.IsPermanent(err))
+}
+
+func TestWaitForAck_MissingAckID(t *testing.T) {
+	c := newAckTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"text":"Success","code":0}`)
+	}))
+
+	err := c.postEvents(context.Background(), strings.NewReader("{}"), nil, false)
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+}
@@ -40,6 +40,7 @@ import (
 type client struct {
 	config  *Config
 	url     *url.URL
+	ackURL  *url.URL
 	client  *http.Client
 	logger  *zap.Logger
 	zippers sync.Pool
@@ -622,6 +623,10 @@ func (c *client) postEvents(ctx context.Context, events io.Reader, headers map[s
 		return err
 	}
 
+	if c.ackURL != nil {
+		return c.waitForAck(ctx, resp.Body, headers)
+	}
+
 	_, errCopy := io.Copy(io.Discard, resp.Body)
 	return multierr.Combine(err, errCopy)
 }
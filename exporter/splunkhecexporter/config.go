@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configtls"
@@ -36,6 +38,8 @@ const (
 	maxContentLengthLogsLimit        = 800 * 1024 * 1024
 	maxContentLengthMetricsLimit     = 800 * 1024 * 1024
 	maxContentLengthTracesLimit      = 800 * 1024 * 1024
+	// ackPath is the HEC indexer acknowledgement path, relative to the HEC collector path.
+	ackPath = "ack"
 )
 
 // OtelToHecFields defines the mapping of attributes to HEC fields
@@ -48,6 +52,19 @@ type OtelToHecFields struct {
 	Name string `mapstructure:"name"`
 }
 
+// AckSettings defines the HEC indexer acknowledgement settings.
+type AckSettings struct {
+	// Enabled makes the exporter wait until each batch is acknowledged by the indexers
+	// before reporting it as sent. Requires indexer acknowledgement to be enabled on the HEC token.
+	Enabled bool `mapstructure:"enabled"`
+
+	// PollInterval is the interval between two acknowledgement status requests. Defaults to 1s.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+
+	// Timeout is the maximum time to wait for a batch to be acknowledged. Defaults to 5s.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
 // Config defines configuration for Splunk exporter.
 type Config struct {
 	config.ExporterSettings        `mapstructure:",squash"`
@@ -107,6 +124,8 @@ type Config struct {
 	HecToOtelAttrs splunk.HecToOtelAttrs `mapstructure:"hec_metadata_to_otel_attrs"`
 	// HecFields creates a mapping from attributes to HEC fields.
 	HecFields OtelToHecFields `mapstructure:"otel_to_hec_fields"`
+	// Ack configures HEC indexer acknowledgement.
+	Ack AckSettings `mapstructure:"ack"`
 }
 
 func (cfg *Config) getOptionsFromConfig() (*exporterOptions, error) {
@@ -146,6 +165,15 @@ func (cfg *Config) validateConfig() error {
 		return fmt.Errorf(`requires "max_content_length_traces <= #{maxContentLengthTracesLimit}`)
 	}
 
+	if cfg.Ack.Enabled {
+		if cfg.Ack.PollInterval <= 0 {
+			return errors.New(`requires "ack.poll_interval" > 0`)
+		}
+		if cfg.Ack.Timeout < cfg.Ack.PollInterval {
+			return errors.New(`requires "ack.timeout" >= "ack.poll_interval"`)
+		}
+	}
+
 	return nil
 }
 
@@ -162,6 +190,15 @@ func (cfg *Config) getURL() (out *url.URL, err error) {
 	return
 }
 
+// getAckURL returns the HEC indexer acknowledgement endpoint matching the given event endpoint.
+func getAckURL(eventURL *url.URL) *url.URL {
+	out := *eventURL
+	p := strings.TrimSuffix(strings.TrimSuffix(out.Path, "/"), "/event")
+	p = strings.TrimSuffix(p, "/raw")
+	out.Path = path.Join(p, ackPath)
+	return &out
+}
+
 // Validate checks if the exporter configuration is valid.
 func (cfg *Config) Validate() error {
 	if err := cfg.QueueSettings.Validate(); err != nil {
@@ -99,6 +99,11 @@ func TestLoadConfig(t *testing.T) {
 					SeverityNumber: "myseveritynumfield",
 					Name:           "mynamefield",
 				},
+				Ack: AckSettings{
+					Enabled:      true,
+					PollInterval: 2 * time.Second,
+					Timeout:      20 * time.Second,
+				},
 			},
 		},
 	}
@@ -128,6 +133,7 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 		MaxContentLengthLogs    uint
 		MaxContentLengthMetrics uint
 		MaxContentLengthTraces  uint
+		Ack                     AckSettings
 	}
 	tests := []struct {
 		name    string
@@ -202,6 +208,43 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "Test ack enabled",
+			fields: fields{
+				Token:    "1234",
+				Endpoint: "https://example.com:8000/services/collector/event",
+				Ack:      AckSettings{Enabled: true, PollInterval: time.Second, Timeout: 5 * time.Second},
+			},
+			want: &exporterOptions{
+				token: "1234",
+				url: &url.URL{
+					Scheme: "https",
+					Host:   "example.com:8000",
+					Path:   "/services/collector/event",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test ack poll interval not set",
+			fields: fields{
+				Token:    "1234",
+				Endpoint: "https://example.com:8000",
+				Ack:      AckSettings{Enabled: true, Timeout: 5 * time.Second},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test ack timeout lower than poll interval",
+			fields: fields{
+				Token:    "1234",
+				Endpoint: "https://example.com:8000",
+				Ack:      AckSettings{Enabled: true, PollInterval: 5 * time.Second, Timeout: time.Second},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -214,6 +257,7 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 				MaxContentLengthLogs:    tt.fields.MaxContentLengthLogs,
 				MaxContentLengthMetrics: tt.fields.MaxContentLengthMetrics,
 				MaxContentLengthTraces:  tt.fields.MaxContentLengthTraces,
+				Ack:                     tt.fields.Ack,
 			}
 			got, err := cfg.getOptionsFromConfig()
 			if (err != nil) != tt.wantErr {
@@ -224,3 +268,16 @@ func TestConfig_getOptionsFromConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAckURL(t *testing.T) {
+	for _, endpoint := range []string{
+		"https://example.com:8000/services/collector",
+		"https://example.com:8000/services/collector/",
+		"https://example.com:8000/services/collector/event",
+		"https://example.com:8000/services/collector/raw",
+	} {
+		eventURL, err := url.Parse(endpoint)
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com:8000/services/collector/ack", getAckURL(eventURL).String(), endpoint)
+	}
+}
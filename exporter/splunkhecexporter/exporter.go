@@ -25,6 +25,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -102,8 +103,23 @@ func buildClient(options *exporterOptions, config *Config, logger *zap.Logger) (
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve TLS config for Splunk HEC Exporter: %w", err)
 	}
+	headers := map[string]string{
+		"Connection":           "keep-alive",
+		"Content-Type":         "application/json",
+		"User-Agent":           config.SplunkAppName + "/" + config.SplunkAppVersion,
+		"Authorization":        splunk.HECTokenHeader + " " + config.Token,
+		"__splunk_app_name":    config.SplunkAppName,
+		"__splunk_app_version": config.SplunkAppVersion,
+	}
+	var ackURL *url.URL
+	if config.Ack.Enabled {
+		ackURL = getAckURL(options.url)
+		// Acknowledgements are tracked per channel, so all requests of this client share one.
+		headers[hecChannelHeader] = uuid.NewString()
+	}
 	return &client{
-		url: options.url,
+		url:    options.url,
+		ackURL: ackURL,
 		client: &http.Client{
 			Timeout: config.Timeout,
 			Transport: &http.Transport{
@@ -123,14 +139,7 @@ func buildClient(options *exporterOptions, config *Config, logger *zap.Logger) (
 		zippers: sync.Pool{New: func() interface{} {
 			return gzip.NewWriter(nil)
 		}},
-		headers: map[string]string{
-			"Connection":           "keep-alive",
-			"Content-Type":         "application/json",
-			"User-Agent":           config.SplunkAppName + "/" + config.SplunkAppVersion,
-			"Authorization":        splunk.HECTokenHeader + " " + config.Token,
-			"__splunk_app_name":    config.SplunkAppName,
-			"__splunk_app_version": config.SplunkAppVersion,
-		},
-		config: config,
+		headers: headers,
+		config:  config,
 	}, nil
 }
@@ -33,9 +33,11 @@ const (
 	// The value of "type" key in configuration.
 	typeStr = "splunk_hec"
 	// The stability level of the exporter.
-	stability          = component.StabilityLevelBeta
-	defaultMaxIdleCons = 100
-	defaultHTTPTimeout = 10 * time.Second
+	stability              = component.StabilityLevelBeta
+	defaultMaxIdleCons     = 100
+	defaultHTTPTimeout     = 10 * time.Second
+	defaultAckPollInterval = time.Second
+	defaultAckTimeout      = 5 * time.Second
 )
 
 // TODO: Find a place for this to be shared.
@@ -86,6 +88,10 @@ func createDefaultConfig() config.Exporter {
 			SeverityNumber: splunk.DefaultSeverityNumberLabel,
 			Name:           splunk.DefaultNameLabel,
 		},
+		Ack: AckSettings{
+			PollInterval: defaultAckPollInterval,
+			Timeout:      defaultAckTimeout,
+		},
 	}
 }
 
@@ -16,6 +16,7 @@ package healthcheckextension // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -35,6 +36,10 @@ type Config struct {
 
 	// CheckCollectorPipeline contains the list of settings of collector pipeline health check
 	CheckCollectorPipeline checkCollectorPipelineSettings `mapstructure:"check_collector_pipeline"`
+
+	// ComponentHealth contains the settings of the readiness and liveness endpoints reporting
+	// the health of each pipeline
+	ComponentHealth componentHealthSettings `mapstructure:"component_health"`
 }
 
 var _ config.Extension = (*Config)(nil)
@@ -42,6 +47,8 @@ var (
 	errNoEndpointProvided                      = errors.New("bad config: endpoint must be specified")
 	errInvalidExporterFailureThresholdProvided = errors.New("bad config: exporter_failure_threshold expects a positive number")
 	errInvalidPath                             = errors.New("bad config: path must start with /")
+	errDuplicatePath                           = errors.New("bad config: path, ready_path and live_path must be distinct")
+	errInvalidPipeline                         = errors.New("bad config: invalid pipeline")
 )
 
 // Validate checks if the extension configuration is valid
@@ -59,6 +66,31 @@ func (cfg *Config) Validate() error {
 	if !strings.HasPrefix(cfg.Path, "/") {
 		return errInvalidPath
 	}
+	if cfg.ComponentHealth.Enabled {
+		if !strings.HasPrefix(cfg.ComponentHealth.ReadyPath, "/") || !strings.HasPrefix(cfg.ComponentHealth.LivePath, "/") {
+			return errInvalidPath
+		}
+		if cfg.ComponentHealth.ReadyPath == cfg.Path || cfg.ComponentHealth.LivePath == cfg.Path ||
+			cfg.ComponentHealth.ReadyPath == cfg.ComponentHealth.LivePath {
+			return errDuplicatePath
+		}
+		for pipeline, exporters := range cfg.ComponentHealth.Pipelines {
+			id, err := config.NewComponentIDFromString(pipeline)
+			if err != nil {
+				return fmt.Errorf("%w %q: %v", errInvalidPipeline, pipeline, err)
+			}
+			switch config.DataType(id.Type()) {
+			case config.TracesDataType, config.MetricsDataType, config.LogsDataType:
+			default:
+				return fmt.Errorf("%w %q: unknown data type %q", errInvalidPipeline, pipeline, id.Type())
+			}
+			for _, exporter := range exporters {
+				if _, err := config.NewComponentIDFromString(exporter); err != nil {
+					return fmt.Errorf("%w %q: %v", errInvalidPipeline, pipeline, err)
+				}
+			}
+		}
+	}
 	return nil
 }
 
@@ -70,3 +102,19 @@ type checkCollectorPipelineSettings struct {
 	// ExporterFailureThreshold is the threshold of exporter failure numbers during the Interval
 	ExporterFailureThreshold int `mapstructure:"exporter_failure_threshold"`
 }
+
+type componentHealthSettings struct {
+	// Enabled indicates whether to serve the readiness and liveness endpoints.
+	Enabled bool `mapstructure:"enabled"`
+	// ReadyPath is the path of the readiness endpoint, it reports an error when the collector
+	// is not ready or any exporter failed more than exporter_failure_threshold times during the
+	// check_collector_pipeline interval
+	ReadyPath string `mapstructure:"ready_path"`
+	// LivePath is the path of the liveness endpoint, it reports an error only when the
+	// collector process is shutting down
+	LivePath string `mapstructure:"live_path"`
+	// Pipelines lists the exporters of each pipeline of the service, keyed by pipeline ID,
+	// as extensions cannot read the pipelines of the service. When empty, the exporters of
+	// each data type are reported as a single pipeline named after the data type.
+	Pipelines map[string][]string `mapstructure:"pipelines"`
+}
@@ -53,9 +53,30 @@ func TestLoadConfig(t *testing.T) {
 					},
 				},
 				CheckCollectorPipeline: defaultCheckCollectorPipelineSettings(),
+				ComponentHealth:        defaultComponentHealthSettings(),
 				Path:                   "/",
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "componenthealth"),
+			expected: &Config{
+				ExtensionSettings: config.NewExtensionSettings(config.NewComponentID(typeStr)),
+				HTTPServerSettings: confighttp.HTTPServerSettings{
+					Endpoint: "localhost:13",
+				},
+				CheckCollectorPipeline: defaultCheckCollectorPipelineSettings(),
+				ComponentHealth: componentHealthSettings{
+					Enabled:   true,
+					ReadyPath: "/health/ready",
+					LivePath:  "/health/live",
+					Pipelines: map[string][]string{
+						"traces":         {"otlp"},
+						"traces/backend": {"otlp/backend", "logging"},
+					},
+				},
+				Path: "/",
+			},
+		},
 		{
 			id:          config.NewComponentIDWithName(typeStr, "missingendpoint"),
 			expectedErr: errNoEndpointProvided,
@@ -68,6 +89,14 @@ func TestLoadConfig(t *testing.T) {
 			id:          config.NewComponentIDWithName(typeStr, "invalidpath"),
 			expectedErr: errInvalidPath,
 		},
+		{
+			id:          config.NewComponentIDWithName(typeStr, "duplicatepath"),
+			expectedErr: errDuplicatePath,
+		},
+		{
+			id:          config.NewComponentIDWithName(typeStr, "invalidpipeline"),
+			expectedErr: errInvalidPipeline,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -23,12 +23,16 @@ import (
 
 const (
 	exporterFailureView = "exporter/send_failed_requests"
+	// exporterTagKey is the tag holding the ID of the exporter in the exporter views
+	exporterTagKey = "exporter"
 )
 
 // healthCheckExporter is a struct implement the exporter interface in open census that could export metrics
 type healthCheckExporter struct {
 	mu                   sync.Mutex
 	exporterFailureQueue []*view.Data
+	// exporterFailures holds the times failures were reported, keyed by exporter ID
+	exporterFailures map[string][]time.Time
 }
 
 func newHealthCheckExporter() *healthCheckExporter {
@@ -42,9 +46,37 @@ func (e *healthCheckExporter) ExportView(vd *view.Data) {
 
 	if vd.View.Name == exporterFailureView {
 		e.exporterFailureQueue = append(e.exporterFailureQueue, vd)
+		e.recordExporterFailures(vd)
 	}
 }
 
+// recordExporterFailures records a failure for each exporter found in the rows of vd
+func (e *healthCheckExporter) recordExporterFailures(vd *view.Data) {
+	for _, row := range vd.Rows {
+		for _, t := range row.Tags {
+			if t.Key.Name() != exporterTagKey {
+				continue
+			}
+			if e.exporterFailures == nil {
+				e.exporterFailures = make(map[string][]time.Time)
+			}
+			e.exporterFailures[t.Value] = append(e.exporterFailures[t.Value], vd.End)
+		}
+	}
+}
+
+// exporterFailureCounts returns the number of failures in the current interval, keyed by exporter ID
+func (e *healthCheckExporter) exporterFailureCounts() map[string]int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	counts := make(map[string]int, len(e.exporterFailures))
+	for id, failures := range e.exporterFailures {
+		counts[id] = len(failures)
+	}
+	return counts
+}
+
 func (e *healthCheckExporter) checkHealthStatus(exporterFailureThreshold int) bool {
 	e.mu.Lock()
 	defer e.mu.Unlock()
@@ -66,4 +98,18 @@ func (e *healthCheckExporter) rotate(interval time.Duration) {
 		}
 		e.exporterFailureQueue = e.exporterFailureQueue[1:]
 	}
+
+	for id, failures := range e.exporterFailures {
+		var kept []time.Time
+		for _, failure := range failures {
+			if failure.Add(interval).After(currentTime) {
+				kept = append(kept, failure)
+			}
+		}
+		if len(kept) == 0 {
+			delete(e.exporterFailures, id)
+			continue
+		}
+		e.exporterFailures[id] = kept
+	}
 }
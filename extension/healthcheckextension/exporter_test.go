@@ -20,6 +20,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
 )
 
 func TestHealthCheckExporter_ExportView(t *testing.T) {
@@ -59,3 +60,30 @@ func TestHealthCheckExporter_rotate(t *testing.T) {
 	exporter.rotate(5 * time.Minute)
 	assert.Equal(t, 1, len(exporter.exporterFailureQueue))
 }
+
+func TestHealthCheckExporter_exporterFailureCounts(t *testing.T) {
+	exporter := newHealthCheckExporter()
+	currentTime := time.Now()
+	newView := view.View{Name: exporterFailureView}
+	failure := func(end time.Time, exporterIDs ...string) *view.Data {
+		vd := &view.Data{View: &newView, Start: end, End: end}
+		for _, id := range exporterIDs {
+			vd.Rows = append(vd.Rows, &view.Row{
+				Tags: []tag.Tag{{Key: tag.MustNewKey(exporterTagKey), Value: id}},
+				Data: &view.SumData{Value: 1},
+			})
+		}
+		return vd
+	}
+
+	exporter.ExportView(failure(currentTime.Add(-10*time.Minute), "otlp"))
+	exporter.ExportView(failure(currentTime.Add(-time.Minute), "otlp", "otlp/2"))
+	exporter.ExportView(&view.Data{View: &view.View{Name: "exporter/sent_spans"}, End: currentTime})
+	assert.Equal(t, map[string]int{"otlp": 2, "otlp/2": 1}, exporter.exporterFailureCounts())
+
+	exporter.rotate(5 * time.Minute)
+	assert.Equal(t, map[string]int{"otlp": 1, "otlp/2": 1}, exporter.exporterFailureCounts())
+
+	exporter.rotate(30 * time.Second)
+	assert.Empty(t, exporter.exporterFailureCounts())
+}
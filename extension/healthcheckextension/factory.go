@@ -48,6 +48,7 @@ func createDefaultConfig() config.Extension {
 			Endpoint: defaultEndpoint,
 		},
 		CheckCollectorPipeline: defaultCheckCollectorPipelineSettings(),
+		ComponentHealth:        defaultComponentHealthSettings(),
 		Path:                   "/",
 	}
 }
@@ -66,3 +67,12 @@ func defaultCheckCollectorPipelineSettings() checkCollectorPipelineSettings {
 		ExporterFailureThreshold: 5,
 	}
 }
+
+// defaultComponentHealthSettings returns the default settings for ComponentHealth.
+func defaultComponentHealthSettings() componentHealthSettings {
+	return componentHealthSettings{
+		Enabled:   false,
+		ReadyPath: "/ready",
+		LivePath:  "/live",
+	}
+}
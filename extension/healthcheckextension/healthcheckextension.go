@@ -24,6 +24,7 @@ import (
 	"github.com/jaegertracing/jaeger/pkg/healthcheck"
 	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -35,6 +36,11 @@ type healthCheckExtension struct {
 	stopCh   chan struct{}
 	exporter *healthCheckExporter
 	settings component.TelemetrySettings
+
+	// host and startTime are set on Start, they are used by the component health endpoints
+	host         component.Host
+	startTime    time.Time
+	shuttingDown *atomic.Bool
 }
 
 var _ component.PipelineWatcher = (*healthCheckExtension)(nil)
@@ -52,12 +58,24 @@ func (hc *healthCheckExtension) Start(_ context.Context, host component.Host) er
 		return err
 	}
 
+	hc.host = host
+	hc.startTime = time.Now()
+
+	mux := http.NewServeMux()
 	if !hc.config.CheckCollectorPipeline.Enabled {
 		// Mount HC handler
-		mux := http.NewServeMux()
 		mux.Handle(hc.config.Path, hc.state.Handler())
-		hc.server.Handler = mux
-		hc.stopCh = make(chan struct{})
+	} else {
+		mux.Handle(hc.config.Path, hc.handler())
+	}
+	if hc.config.ComponentHealth.Enabled {
+		mux.Handle(hc.config.ComponentHealth.ReadyPath, hc.readyHandler())
+		mux.Handle(hc.config.ComponentHealth.LivePath, hc.liveHandler())
+	}
+	hc.server.Handler = mux
+	hc.stopCh = make(chan struct{})
+
+	if !hc.config.CheckCollectorPipeline.Enabled && !hc.config.ComponentHealth.Enabled {
 		go func() {
 			defer close(hc.stopCh)
 
@@ -79,10 +97,6 @@ func (hc *healthCheckExtension) Start(_ context.Context, host component.Host) er
 		// ticker used by collector pipeline health check for rotation
 		ticker := time.NewTicker(time.Second)
 
-		mux := http.NewServeMux()
-		mux.Handle(hc.config.Path, hc.handler())
-		hc.server.Handler = mux
-		hc.stopCh = make(chan struct{})
 		go func() {
 			defer close(hc.stopCh)
 			defer view.UnregisterExporter(hc.exporter)
@@ -127,6 +141,7 @@ func (hc *healthCheckExtension) Shutdown(context.Context) error {
 	if hc.server == nil {
 		return nil
 	}
+	hc.shuttingDown.Store(true)
 	err := hc.server.Close()
 	if hc.stopCh != nil {
 		<-hc.stopCh
@@ -150,6 +165,8 @@ func newServer(config Config, settings component.TelemetrySettings) *healthCheck
 		logger:   settings.Logger,
 		state:    healthcheck.New(),
 		settings: settings,
+
+		shuttingDown: atomic.NewBool(false),
 	}
 
 	hc.state.SetLogger(settings.Logger)
@@ -16,6 +16,7 @@ package healthcheckextension
 
 import (
 	"context"
+	"encoding/json"
 	"net"
 	"net/http"
 	"runtime"
@@ -25,8 +26,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confighttp"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
@@ -246,6 +249,138 @@ func TestHealthCheckExtensionUsageWithCustomPathWithCheckCollectorPipeline(t *te
 	require.NoError(t, resp3.Body.Close(), "Must be able to close the response")
 }
 
+func TestHealthCheckExtensionComponentHealth(t *testing.T) {
+	cfg := Config{
+		HTTPServerSettings: confighttp.HTTPServerSettings{
+			Endpoint: testutil.GetAvailableLocalAddress(t),
+		},
+		CheckCollectorPipeline: checkCollectorPipelineSettings{
+			Interval:                 "5m",
+			ExporterFailureThreshold: 1,
+		},
+		ComponentHealth: defaultComponentHealthSettings(),
+		Path:            "/",
+	}
+	cfg.ComponentHealth.Enabled = true
+	cfg.ComponentHealth.Pipelines = map[string][]string{
+		"traces/backend": {"otlp"},
+		"traces/debug":   {"logging"},
+		"metrics":        {"otlp", "logging"},
+	}
+
+	hcExt := newServer(cfg, componenttest.NewNopTelemetrySettings())
+	require.NotNil(t, hcExt)
+
+	host := &exportersHost{
+		Host: componenttest.NewNopHost(),
+		exporters: map[config.DataType]map[config.ComponentID]component.Exporter{
+			config.TracesDataType: {
+				config.NewComponentID("otlp"): nil,
+			},
+			config.MetricsDataType: {
+				config.NewComponentID("otlp"):    nil,
+				config.NewComponentID("logging"): nil,
+			},
+		},
+	}
+	require.NoError(t, hcExt.Start(context.Background(), host))
+	t.Cleanup(func() { require.NoError(t, hcExt.Shutdown(context.Background())) })
+	require.Eventuallyf(t, ensureServerRunning(cfg.Endpoint), 30*time.Second, 1*time.Second, "Failed to start the testing server.")
+
+	client := &http.Client{}
+	get := func(path string, body interface{}) int {
+		resp, err := client.Get("http://" + cfg.Endpoint + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(body))
+		return resp.StatusCode
+	}
+
+	var ready readinessStatus
+	require.Equal(t, http.StatusServiceUnavailable, get("/ready", &ready))
+	assert.False(t, ready.Ready)
+	assert.True(t, ready.Pipelines["traces/backend"].Healthy)
+
+	var live livenessStatus
+	require.Equal(t, http.StatusOK, get("/live", &live))
+	assert.True(t, live.Live)
+
+	require.NoError(t, hcExt.Ready())
+	require.Equal(t, http.StatusOK, get("/ready", &ready))
+	assert.True(t, ready.Ready)
+	assert.Len(t, ready.Pipelines, 3)
+
+	newView := view.View{Name: exporterFailureView}
+	for i := 0; i < 2; i++ {
+		hcExt.exporter.ExportView(&view.Data{
+			View:  &newView,
+			Start: time.Now(),
+			End:   time.Now(),
+			Rows: []*view.Row{{
+				Tags: []tag.Tag{{Key: tag.MustNewKey(exporterTagKey), Value: "otlp"}},
+				Data: &view.SumData{Value: 1},
+			}},
+		})
+	}
+
+	ready = readinessStatus{}
+	require.Equal(t, http.StatusServiceUnavailable, get("/ready", &ready))
+	assert.False(t, ready.Ready)
+	assert.Equal(t, &pipelineHealth{
+		Healthy: false,
+		Exporters: map[string]*exporterHealth{
+			"otlp": {Healthy: false, Failures: 2},
+		},
+	}, ready.Pipelines["traces/backend"])
+	assert.Equal(t, &pipelineHealth{
+		Healthy: true,
+		Exporters: map[string]*exporterHealth{
+			"logging": {Healthy: true, Failures: 0},
+		},
+	}, ready.Pipelines["traces/debug"])
+	assert.Equal(t, &pipelineHealth{
+		Healthy: false,
+		Exporters: map[string]*exporterHealth{
+			"otlp":    {Healthy: false, Failures: 2},
+			"logging": {Healthy: true, Failures: 0},
+		},
+	}, ready.Pipelines["metrics"])
+
+	// exporter failures do not affect liveness
+	require.Equal(t, http.StatusOK, get("/live", &live))
+	assert.True(t, live.Live)
+
+	// the default path keeps its behavior when the pipeline check is disabled
+	resp, err := client.Get("http://" + cfg.Endpoint + cfg.Path)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestReadinessWithoutConfiguredPipelines(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	hcExt := newServer(*cfg, componenttest.NewNopTelemetrySettings())
+	hcExt.exporter = newHealthCheckExporter()
+	hcExt.host = &exportersHost{
+		Host: componenttest.NewNopHost(),
+		exporters: map[config.DataType]map[config.ComponentID]component.Exporter{
+			config.LogsDataType: {
+				config.NewComponentIDWithName("otlp", "logs"): nil,
+			},
+		},
+	}
+
+	assert.Equal(t, map[string]*pipelineHealth{
+		"logs": {
+			Healthy: true,
+			Exporters: map[string]*exporterHealth{
+				"otlp/logs": {Healthy: true, Failures: 0},
+			},
+		},
+	}, hcExt.readiness().Pipelines)
+}
+
 func TestHealthCheckExtensionPortAlreadyInUse(t *testing.T) {
 	endpoint := testutil.GetAvailableLocalAddress(t)
 
@@ -336,3 +471,13 @@ func newAssertNoErrorHost(t *testing.T) component.Host {
 func (aneh *assertNoErrorHost) ReportFatalError(err error) {
 	assert.NoError(aneh, err)
 }
+
+// exportersHost implements a component.Host that returns the given exporters.
+type exportersHost struct {
+	component.Host
+	exporters map[config.DataType]map[config.ComponentID]component.Exporter
+}
+
+func (h *exportersHost) GetExporters() map[config.DataType]map[config.ComponentID]component.Exporter {
+	return h.exporters
+}
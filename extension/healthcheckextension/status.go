@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheckextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckextension"
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/jaegertracing/jaeger/pkg/healthcheck"
+	"go.uber.org/zap"
+)
+
+// exporterHealth is the health of an exporter during the check_collector_pipeline interval
+type exporterHealth struct {
+	Healthy  bool `json:"healthy"`
+	Failures int  `json:"failures"`
+}
+
+// pipelineHealth is the health of the exporters of a pipeline, it is healthy when all of them
+// are healthy
+type pipelineHealth struct {
+	Healthy   bool                       `json:"healthy"`
+	Exporters map[string]*exporterHealth `json:"exporters"`
+}
+
+// readinessStatus is the body of the readiness endpoint
+type readinessStatus struct {
+	Ready     bool                       `json:"ready"`
+	Status    string                     `json:"status"`
+	Pipelines map[string]*pipelineHealth `json:"pipelines"`
+}
+
+// livenessStatus is the body of the liveness endpoint
+type livenessStatus struct {
+	Live      bool      `json:"live"`
+	StartedAt time.Time `json:"started_at"`
+	Uptime    string    `json:"uptime"`
+}
+
+// readiness aggregates the state reported by the service with the exporter failures of each pipeline.
+func (hc *healthCheckExtension) readiness() readinessStatus {
+	state := hc.state.Get()
+	status := readinessStatus{
+		Ready:     state == healthcheck.Ready,
+		Status:    state.String(),
+		Pipelines: make(map[string]*pipelineHealth),
+	}
+
+	failures := hc.exporter.exporterFailureCounts()
+	threshold := hc.config.CheckCollectorPipeline.ExporterFailureThreshold
+	for pipeline, exporters := range hc.pipelineExporters() {
+		health := &pipelineHealth{
+			Healthy:   true,
+			Exporters: make(map[string]*exporterHealth, len(exporters)),
+		}
+		for _, id := range exporters {
+			count := failures[id]
+			healthy := threshold >= count
+			health.Exporters[id] = &exporterHealth{Healthy: healthy, Failures: count}
+			if !healthy {
+				health.Healthy = false
+				status.Ready = false
+			}
+		}
+		status.Pipelines[pipeline] = health
+	}
+	return status
+}
+
+// pipelineExporters returns the IDs of the exporters of each pipeline, keyed by pipeline ID. The
+// pipelines configured in component_health are used when set, otherwise the exporters of each data
+// type are reported under a pipeline named after the data type.
+func (hc *healthCheckExtension) pipelineExporters() map[string][]string {
+	if len(hc.config.ComponentHealth.Pipelines) > 0 {
+		return hc.config.ComponentHealth.Pipelines
+	}
+	pipelines := make(map[string][]string)
+	if hc.host == nil {
+		return pipelines
+	}
+	for dataType, exps := range hc.host.GetExporters() {
+		for id := range exps {
+			pipelines[string(dataType)] = append(pipelines[string(dataType)], id.String())
+		}
+	}
+	return pipelines
+}
+
+// readyHandler serves the readiness of the collector, including the health of the exporters of each pipeline.
+func (hc *healthCheckExtension) readyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		status := hc.readiness()
+		code := http.StatusOK
+		if !status.Ready {
+			code = http.StatusServiceUnavailable
+		}
+		hc.writeJSON(w, code, status)
+	})
+}
+
+// liveHandler serves the liveness of the collector process, it does not depend on the health
+// of the pipelines so that failing exporters do not get the collector restarted.
+func (hc *healthCheckExtension) liveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		status := livenessStatus{
+			Live:      !hc.shuttingDown.Load(),
+			StartedAt: hc.startTime,
+			Uptime:    time.Since(hc.startTime).String(),
+		}
+		code := http.StatusOK
+		if !status.Live {
+			code = http.StatusServiceUnavailable
+		}
+		hc.writeJSON(w, code, status)
+	})
+}
+
+func (hc *healthCheckExtension) writeJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		hc.logger.Warn("Failed to write health check response", zap.Error(err))
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pprofextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+const (
+	capturePath           = "/debug/pprof/capture"
+	defaultCaptureSeconds = 30
+	uploadTimeout         = time.Minute
+)
+
+// captureResponse is the body of the response of the capture endpoint.
+type captureResponse struct {
+	Profile  string `json:"profile"`
+	Duration string `json:"duration,omitempty"`
+	Name     string `json:"name"`
+}
+
+// profileCapturer serves the capture endpoint, it captures a single profile at a
+// time in the background and stores it to the configured destinations.
+type profileCapturer struct {
+	settings CaptureSettings
+	logger   *zap.Logger
+	client   *http.Client
+
+	busy   *atomic.Bool
+	wg     sync.WaitGroup
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newProfileCapturer(settings CaptureSettings, logger *zap.Logger) *profileCapturer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &profileCapturer{
+		settings: settings,
+		logger:   logger,
+		client:   &http.Client{Timeout: uploadTimeout},
+		busy:     atomic.NewBool(false),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// ServeHTTP triggers the capture of a profile. The "profile" query parameter selects
+// the cpu, heap or goroutine profile, "seconds" the duration of a cpu capture. The heap
+// and goroutine profiles are snapshots taken when the request is received.
+func (c *profileCapturer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !c.authorized(r) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	profile := r.URL.Query().Get("profile")
+	if profile == "" {
+		profile = "cpu"
+	}
+	if profile != "cpu" && profile != "heap" && profile != "goroutine" {
+		http.Error(w, fmt.Sprintf("unsupported profile %q, must be one of cpu, heap or goroutine", profile), http.StatusBadRequest)
+		return
+	}
+
+	duration := defaultCaptureSeconds * time.Second
+	if s := r.URL.Query().Get("seconds"); s != "" {
+		seconds, err := strconv.Atoi(s)
+		if err != nil || seconds <= 0 {
+			http.Error(w, fmt.Sprintf("invalid seconds %q", s), http.StatusBadRequest)
+			return
+		}
+		duration = time.Duration(seconds) * time.Second
+	}
+	if duration > c.settings.MaxDuration {
+		http.Error(w, fmt.Sprintf("capture duration %s exceeds max_duration %s", duration, c.settings.MaxDuration), http.StatusBadRequest)
+		return
+	}
+
+	if !c.busy.CompareAndSwap(false, true) {
+		http.Error(w, "a profile capture is already in progress", http.StatusConflict)
+		return
+	}
+
+	resp := captureResponse{
+		Profile: profile,
+		Name:    fmt.Sprintf("%s-%s.pprof", profile, time.Now().UTC().Format("20060102T150405.000Z")),
+	}
+	buf := new(bytes.Buffer)
+	if profile == "cpu" {
+		// Start the profile before answering so that conflicts with other CPU profiles,
+		// e.g. save_to_file, are reported to the caller.
+		if err := pprof.StartCPUProfile(buf); err != nil {
+			c.busy.Store(false)
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		resp.Duration = duration.String()
+	}
+
+	c.wg.Add(1)
+	go c.capture(profile, duration, resp.Name, buf)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// authorized checks the bearer token of the request, when one is configured.
+func (c *profileCapturer) authorized(r *http.Request) bool {
+	if c.settings.BearerToken == "" {
+		return true
+	}
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, "Bearer ") {
+		return false
+	}
+	token := strings.TrimPrefix(authorization, "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(c.settings.BearerToken)) == 1
+}
+
+func (c *profileCapturer) capture(profile string, duration time.Duration, name string, buf *bytes.Buffer) {
+	defer c.wg.Done()
+	defer c.busy.Store(false)
+
+	if profile == "cpu" {
+		timer := time.NewTimer(duration)
+		select {
+		case <-timer.C:
+		case <-c.ctx.Done():
+			timer.Stop()
+		}
+		pprof.StopCPUProfile()
+	} else if err := pprof.Lookup(profile).WriteTo(buf, 0); err != nil {
+		c.logger.Error("Failed to capture profile", zap.String("profile", profile), zap.Error(err))
+		return
+	}
+
+	c.store(name, buf.Bytes())
+}
+
+// store writes the profile to the configured directory and uploads it to the configured endpoint.
+func (c *profileCapturer) store(name string, data []byte) {
+	if c.settings.Directory != "" {
+		path := filepath.Join(c.settings.Directory, name)
+		if err := writeProfile(path, data); err != nil {
+			c.logger.Error("Failed to write profile", zap.String("path", path), zap.Error(err))
+		} else {
+			c.logger.Info("Profile written", zap.String("path", path))
+		}
+	}
+
+	if c.settings.UploadEndpoint != "" {
+		url := strings.TrimSuffix(c.settings.UploadEndpoint, "/") + "/" + name
+		if err := c.upload(url, data); err != nil {
+			c.logger.Error("Failed to upload profile", zap.String("url", url), zap.Error(err))
+		} else {
+			c.logger.Info("Profile uploaded", zap.String("url", url))
+		}
+	}
+}
+
+func writeProfile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+func (c *profileCapturer) upload(url string, data []byte) error {
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	for k, v := range c.settings.UploadHeaders {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("upload failed with status %s", resp.Status)
+	}
+	return nil
+}
+
+// shutdown stops the capture in progress, if any, and waits for its profile to be stored.
+func (c *profileCapturer) shutdown() {
+	c.cancel()
+	c.wg.Wait()
+}
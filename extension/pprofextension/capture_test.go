@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pprofextension
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func triggerCapture(t *testing.T, c *profileCapturer, method, query string) (int, captureResponse) {
+	rec := httptest.NewRecorder()
+	c.ServeHTTP(rec, httptest.NewRequest(method, capturePath+query, nil))
+	var resp captureResponse
+	if rec.Code == http.StatusAccepted {
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	}
+	return rec.Code, resp
+}
+
+func TestCaptureToDirectory(t *testing.T) {
+	for _, profile := range []string{"heap", "goroutine"} {
+		t.Run(profile, func(t *testing.T) {
+			dir := t.TempDir()
+			c := newProfileCapturer(CaptureSettings{
+				Directory:   filepath.Join(dir, "profiles"),
+				MaxDuration: time.Minute,
+			}, zap.NewNop())
+
+			code, resp := triggerCapture(t, c, http.MethodPost, "?profile="+profile)
+			require.Equal(t, http.StatusAccepted, code)
+			assert.Equal(t, profile, resp.Profile)
+			c.shutdown()
+
+			data, err := os.ReadFile(filepath.Join(dir, "profiles", resp.Name))
+			require.NoError(t, err)
+			assert.NotEmpty(t, data)
+		})
+	}
+}
+
+func TestCaptureCPUUpload(t *testing.T) {
+	type upload struct {
+		path          string
+		authorization string
+		size          int
+	}
+	uploads := make(chan upload, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		uploads <- upload{path: r.URL.Path, authorization: r.Header.Get("Authorization"), size: len(body)}
+	}))
+	defer srv.Close()
+
+	c := newProfileCapturer(CaptureSettings{
+		UploadEndpoint: srv.URL + "/bucket/",
+		UploadHeaders:  map[string]string{"Authorization": "Bearer token"},
+		MaxDuration:    time.Minute,
+	}, zap.NewNop())
+
+	code, resp := triggerCapture(t, c, http.MethodPost, "?seconds=1")
+	require.Equal(t, http.StatusAccepted, code)
+	assert.Equal(t, "cpu", resp.Profile)
+	assert.Equal(t, "1s", resp.Duration)
+
+	// only a single capture runs at a time
+	code, _ = triggerCapture(t, c, http.MethodPost, "?profile=heap")
+	assert.Equal(t, http.StatusConflict, code)
+
+	select {
+	case u := <-uploads:
+		assert.Equal(t, "/bucket/"+resp.Name, u.path)
+		assert.Equal(t, "Bearer token", u.authorization)
+		assert.NotZero(t, u.size)
+	case <-time.After(10 * time.Second):
+		t.Fatal("profile was not uploaded")
+	}
+	c.shutdown()
+
+	code, _ = triggerCapture(t, c, http.MethodPost, "?profile=heap")
+	assert.Equal(t, http.StatusAccepted, code)
+	c.shutdown()
+}
+
+func TestCaptureShutdownStopsCPUProfile(t *testing.T) {
+	dir := t.TempDir()
+	c := newProfileCapturer(CaptureSettings{
+		Directory:   dir,
+		MaxDuration: time.Hour,
+	}, zap.NewNop())
+
+	code, resp := triggerCapture(t, c, http.MethodPost, "?profile=cpu&seconds=3600")
+	require.Equal(t, http.StatusAccepted, code)
+	c.shutdown()
+
+	_, err := os.Stat(filepath.Join(dir, resp.Name))
+	require.NoError(t, err)
+}
+
+func TestCaptureInvalidRequests(t *testing.T) {
+	c := newProfileCapturer(CaptureSettings{
+		Directory:   t.TempDir(),
+		MaxDuration: time.Minute,
+	}, zap.NewNop())
+	defer c.shutdown()
+
+	testCases := []struct {
+		desc   string
+		method string
+		query  string
+		code   int
+	}{
+		{desc: "wrong method", method: http.MethodGet, query: "?profile=heap", code: http.StatusMethodNotAllowed},
+		{desc: "unsupported profile", method: http.MethodPost, query: "?profile=block", code: http.StatusBadRequest},
+		{desc: "invalid seconds", method: http.MethodPost, query: "?seconds=abc", code: http.StatusBadRequest},
+		{desc: "negative seconds", method: http.MethodPost, query: "?seconds=-1", code: http.StatusBadRequest},
+		{desc: "seconds above max_duration", method: http.MethodPost, query: "?seconds=120", code: http.StatusBadRequest},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			code, _ := triggerCapture(t, c, tc.method, tc.query)
+			assert.Equal(t, tc.code, code)
+		})
+	}
+}
+
+func TestCaptureBearerToken(t *testing.T) {
+	c := newProfileCapturer(CaptureSettings{
+		BearerToken: "token",
+		Directory:   t.TempDir(),
+		MaxDuration: time.Minute,
+	}, zap.NewNop())
+	defer c.shutdown()
+
+	for _, authorization := range []string{"", "Bearer other", "token"} {
+		rec := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, capturePath+"?profile=heap", nil)
+		if authorization != "" {
+			r.Header.Set("Authorization", authorization)
+		}
+		c.ServeHTTP(rec, r)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code, authorization)
+	}
+
+	rec := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, capturePath+"?profile=heap", nil)
+	r.Header.Set("Authorization", "Bearer token")
+	c.ServeHTTP(rec, r)
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+}
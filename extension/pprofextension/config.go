@@ -15,6 +15,12 @@
 package pprofextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
 
 import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confignet"
 )
@@ -41,11 +47,87 @@ type Config struct {
 	// Optional file name to save the CPU profile to. The profiling starts when the
 	// Collector starts and is saved to the file when the Collector is terminated.
 	SaveToFile string `mapstructure:"save_to_file"`
+
+	// Capture contains the settings of the endpoint triggering on-demand profile captures.
+	Capture CaptureSettings `mapstructure:"capture"`
+}
+
+// CaptureSettings defines how the capture endpoint is served and where the profiles
+// captured on demand are stored.
+type CaptureSettings struct {
+	// Enabled indicates whether to serve the capture endpoint.
+	Enabled bool `mapstructure:"enabled"`
+
+	// TCPAddr is the address and port in which the capture endpoint will be listening to,
+	// separately from the net/http/pprof handlers.
+	TCPAddr confignet.TCPAddr `mapstructure:",squash"`
+
+	// BearerToken is the token the capture requests must carry in their Authorization
+	// header. It is required when the capture endpoint is not listening on a loopback address.
+	BearerToken string `mapstructure:"bearer_token"`
+
+	// Directory in which the captured profiles are written.
+	Directory string `mapstructure:"directory"`
+
+	// UploadEndpoint is the URL the captured profiles are uploaded to with an HTTP PUT
+	// request, the name of the profile being appended to its path. This is typically
+	// a bucket of an object store.
+	UploadEndpoint string `mapstructure:"upload_endpoint"`
+
+	// UploadHeaders are added to the upload requests, e.g. for authentication.
+	UploadHeaders map[string]string `mapstructure:"upload_headers"`
+
+	// MaxDuration is the maximum duration of a capture.
+	MaxDuration time.Duration `mapstructure:"max_duration"`
 }
 
 var _ config.Extension = (*Config)(nil)
 
+var (
+	errNoCaptureDestination   = errors.New("capture requires a directory or an upload_endpoint")
+	errInvalidCaptureDuration = errors.New("capture max_duration must be positive")
+	errNoCaptureEndpoint      = errors.New("capture requires an endpoint")
+	errNoCaptureBearerToken   = errors.New("capture requires a bearer_token when its endpoint is not a loopback address")
+)
+
 // Validate checks if the extension configuration is valid
 func (cfg *Config) Validate() error {
+	if !cfg.Capture.Enabled {
+		return nil
+	}
+	if cfg.Capture.Directory == "" && cfg.Capture.UploadEndpoint == "" {
+		return errNoCaptureDestination
+	}
+	if cfg.Capture.MaxDuration <= 0 {
+		return errInvalidCaptureDuration
+	}
+	if cfg.Capture.TCPAddr.Endpoint == "" {
+		return errNoCaptureEndpoint
+	}
+	if cfg.Capture.BearerToken == "" && !isLoopbackEndpoint(cfg.Capture.TCPAddr.Endpoint) {
+		return errNoCaptureBearerToken
+	}
+	if cfg.Capture.UploadEndpoint != "" {
+		u, err := url.Parse(cfg.Capture.UploadEndpoint)
+		if err != nil {
+			return fmt.Errorf("invalid capture upload_endpoint: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("invalid capture upload_endpoint %q: scheme must be http or https", cfg.Capture.UploadEndpoint)
+		}
+	}
 	return nil
 }
+
+// isLoopbackEndpoint tells whether the host:port endpoint only listens on a loopback address.
+func isLoopbackEndpoint(endpoint string) bool {
+	host, _, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -17,6 +17,7 @@ package pprofextension
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -43,6 +44,26 @@ func TestLoadConfig(t *testing.T) {
 				TCPAddr:              confignet.TCPAddr{Endpoint: "0.0.0.0:1777"},
 				BlockProfileFraction: 3,
 				MutexProfileFraction: 5,
+				Capture: CaptureSettings{
+					TCPAddr:     confignet.TCPAddr{Endpoint: defaultCaptureEndpoint},
+					MaxDuration: defaultCaptureMaxDuration,
+				},
+			},
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "capture"),
+			expected: &Config{
+				ExtensionSettings: config.NewExtensionSettings(config.NewComponentID(typeStr)),
+				TCPAddr:           confignet.TCPAddr{Endpoint: defaultEndpoint},
+				Capture: CaptureSettings{
+					Enabled:        true,
+					TCPAddr:        confignet.TCPAddr{Endpoint: "0.0.0.0:1778"},
+					BearerToken:    "token",
+					Directory:      "/var/lib/otelcol/profiles",
+					UploadEndpoint: "https://bucket.example.com/profiles",
+					UploadHeaders:  map[string]string{"x-api-key": "secret"},
+					MaxDuration:    2 * time.Minute,
+				},
 			},
 		},
 	}
@@ -61,3 +82,65 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate(t *testing.T) {
+	localhost := confignet.TCPAddr{Endpoint: defaultCaptureEndpoint}
+	testCases := []struct {
+		desc        string
+		capture     CaptureSettings
+		expectedErr string
+	}{
+		{
+			desc:    "capture disabled",
+			capture: CaptureSettings{},
+		},
+		{
+			desc:    "directory",
+			capture: CaptureSettings{Enabled: true, TCPAddr: localhost, Directory: "/tmp", MaxDuration: time.Minute},
+		},
+		{
+			desc:        "no destination",
+			capture:     CaptureSettings{Enabled: true, TCPAddr: localhost, MaxDuration: time.Minute},
+			expectedErr: errNoCaptureDestination.Error(),
+		},
+		{
+			desc:        "no max duration",
+			capture:     CaptureSettings{Enabled: true, TCPAddr: localhost, Directory: "/tmp"},
+			expectedErr: errInvalidCaptureDuration.Error(),
+		},
+		{
+			desc:        "invalid upload endpoint scheme",
+			capture:     CaptureSettings{Enabled: true, TCPAddr: localhost, UploadEndpoint: "s3://bucket", MaxDuration: time.Minute},
+			expectedErr: `invalid capture upload_endpoint "s3://bucket": scheme must be http or https`,
+		},
+		{
+			desc:        "no endpoint",
+			capture:     CaptureSettings{Enabled: true, Directory: "/tmp", MaxDuration: time.Minute},
+			expectedErr: errNoCaptureEndpoint.Error(),
+		},
+		{
+			desc:    "loopback address without bearer token",
+			capture: CaptureSettings{Enabled: true, TCPAddr: confignet.TCPAddr{Endpoint: "127.0.0.1:1778"}, Directory: "/tmp", MaxDuration: time.Minute},
+		},
+		{
+			desc:        "all interfaces without bearer token",
+			capture:     CaptureSettings{Enabled: true, TCPAddr: confignet.TCPAddr{Endpoint: ":1778"}, Directory: "/tmp", MaxDuration: time.Minute},
+			expectedErr: errNoCaptureBearerToken.Error(),
+		},
+		{
+			desc:    "all interfaces with bearer token",
+			capture: CaptureSettings{Enabled: true, TCPAddr: confignet.TCPAddr{Endpoint: ":1778"}, BearerToken: "token", Directory: "/tmp", MaxDuration: time.Minute},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := &Config{Capture: tc.capture}
+			err := cfg.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
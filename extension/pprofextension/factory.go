@@ -17,6 +17,7 @@ package pprofextension // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
@@ -27,7 +28,10 @@ const (
 	// The value of extension "type" in configuration.
 	typeStr = "pprof"
 
-	defaultEndpoint = "localhost:1777"
+	defaultEndpoint        = "localhost:1777"
+	defaultCaptureEndpoint = "localhost:1778"
+
+	defaultCaptureMaxDuration = 5 * time.Minute
 )
 
 // NewFactory creates a factory for pprof extension.
@@ -46,6 +50,12 @@ func createDefaultConfig() config.Extension {
 		TCPAddr: confignet.TCPAddr{
 			Endpoint: defaultEndpoint,
 		},
+		Capture: CaptureSettings{
+			TCPAddr: confignet.TCPAddr{
+				Endpoint: defaultCaptureEndpoint,
+			},
+			MaxDuration: defaultCaptureMaxDuration,
+		},
 	}
 }
 
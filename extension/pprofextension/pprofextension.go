@@ -26,6 +26,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.uber.org/atomic"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
@@ -37,6 +38,10 @@ type pprofExtension struct {
 	file   *os.File
 	server http.Server
 	stopCh chan struct{}
+
+	capturer      *profileCapturer
+	captureServer http.Server
+	captureStopCh chan struct{}
 }
 
 func (p *pprofExtension) Start(_ context.Context, host component.Host) error {
@@ -68,6 +73,21 @@ func (p *pprofExtension) Start(_ context.Context, host component.Host) error {
 	runtime.SetBlockProfileRate(p.config.BlockProfileFraction)
 	runtime.SetMutexProfileFraction(p.config.MutexProfileFraction)
 
+	// The capture endpoint is served on its own listener, so that it can be exposed
+	// without exposing the net/http/pprof handlers.
+	var captureLn net.Listener
+	if p.config.Capture.Enabled {
+		captureLn, startErr = p.config.Capture.TCPAddr.Listen()
+		if startErr != nil {
+			_ = ln.Close()
+			return startErr
+		}
+		p.capturer = newProfileCapturer(p.config.Capture, p.logger)
+		mux := http.NewServeMux()
+		mux.Handle(capturePath, p.capturer)
+		p.captureServer.Handler = mux
+	}
+
 	p.logger.Info("Starting net/http/pprof server", zap.Any("config", p.config))
 	p.stopCh = make(chan struct{})
 	go func() {
@@ -82,6 +102,17 @@ func (p *pprofExtension) Start(_ context.Context, host component.Host) error {
 		}
 	}()
 
+	if captureLn != nil {
+		p.captureStopCh = make(chan struct{})
+		go func() {
+			defer close(p.captureStopCh)
+
+			if errHTTP := p.captureServer.Serve(captureLn); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+				host.ReportFatalError(errHTTP)
+			}
+		}()
+	}
+
 	if p.config.SaveToFile != "" {
 		var f *os.File
 		f, startErr = os.Create(p.config.SaveToFile)
@@ -105,6 +136,11 @@ func (p *pprofExtension) Shutdown(context.Context) error {
 	if p.stopCh != nil {
 		<-p.stopCh
 	}
+	if p.capturer != nil {
+		err = multierr.Append(err, p.captureServer.Close())
+		<-p.captureStopCh
+		p.capturer.shutdown()
+	}
 	return err
 }
 
@@ -19,8 +19,10 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -59,6 +61,53 @@ func TestPerformanceProfilerExtensionUsage(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestPerformanceProfilerExtensionCapture(t *testing.T) {
+	dir := t.TempDir()
+	config := Config{
+		TCPAddr: confignet.TCPAddr{
+			Endpoint: testutil.GetAvailableLocalAddress(t),
+		},
+		Capture: CaptureSettings{
+			Enabled: true,
+			TCPAddr: confignet.TCPAddr{
+				Endpoint: testutil.GetAvailableLocalAddress(t),
+			},
+			Directory:   dir,
+			MaxDuration: time.Minute,
+		},
+	}
+
+	pprofExt := newServer(config, zap.NewNop())
+	require.NotNil(t, pprofExt)
+
+	require.NoError(t, pprofExt.Start(context.Background(), componenttest.NewNopHost()))
+
+	// Give a chance for the server goroutine to run.
+	runtime.Gosched()
+
+	client := &http.Client{}
+	resp, err := client.Get("http://" + config.TCPAddr.Endpoint + "/debug/pprof")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// the net/http/pprof handlers are not served on the capture endpoint
+	resp, err = client.Get("http://" + config.Capture.TCPAddr.Endpoint + "/debug/pprof")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, err = client.Post("http://"+config.Capture.TCPAddr.Endpoint+capturePath+"?profile=goroutine", "", nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	require.NoError(t, pprofExt.Shutdown(context.Background()))
+	files, err := filepath.Glob(filepath.Join(dir, "goroutine-*.pprof"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+}
+
 func TestPerformanceProfilerExtensionPortAlreadyInUse(t *testing.T) {
 	endpoint := testutil.GetAvailableLocalAddress(t)
 	ln, err := net.Listen("tcp", endpoint)
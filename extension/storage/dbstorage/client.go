@@ -19,7 +19,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	// MySQL driver
+	_ "github.com/go-sql-driver/mysql"
 	// Postgres driver
 	_ "github.com/jackc/pgx/v4/stdlib"
 	// SQLite driver
@@ -28,90 +32,231 @@ import (
 )
 
 const (
-	createTable     = "create table if not exists %s (key text primary key, value blob)"
-	getQueryText    = "select value from %s where key=?"
-	setQueryText    = "insert into %s(key, value) values(?,?) on conflict(key) do update set value=?"
-	deleteQueryText = "delete from %s where key=?"
+	getQueryText    = "select value from %[1]s where %[2]s=?"
+	setQueryText    = "insert into %[1]s(%[2]s, value, updated_at) values(?,?,?) %[3]s"
+	deleteQueryText = "delete from %[1]s where %[2]s=?"
+	expireQueryText = "delete from %s where updated_at < ?"
+
+	batchSetQueryText    = "insert into %[1]s(%[2]s, value, updated_at) values%[3]s %[4]s"
+	batchDeleteQueryText = "delete from %[1]s where %[2]s in (%[3]s)"
+
+	// maxBatchRows bounds the number of rows combined into a single statement,
+	// keeping the bound parameter count below SQLite's default limit of 999.
+	maxBatchRows = 300
 )
 
 type dbStorageClient struct {
 	db          *sql.DB
+	dialect     dialect
+	tableName   string
+	timeout     time.Duration
+	retry       RetryConfig
+	cipher      *valueCipher
 	getQuery    *sql.Stmt
 	setQuery    *sql.Stmt
 	deleteQuery *sql.Stmt
 }
 
-func newClient(ctx context.Context, db *sql.DB, tableName string) (*dbStorageClient, error) {
-	var err error
-	_, err = db.ExecContext(ctx, fmt.Sprintf(createTable, tableName))
+func newClient(ctx context.Context, db *sql.DB, d dialect, tableName string, timeout time.Duration, retry RetryConfig, cipher *valueCipher) (*dbStorageClient, error) {
+	selectQuery, err := db.PrepareContext(ctx, d.rebind(fmt.Sprintf(getQueryText, tableName, d.keyColumn)))
 	if err != nil {
 		return nil, err
 	}
-
-	selectQuery, err := db.PrepareContext(ctx, fmt.Sprintf(getQueryText, tableName))
+	setQuery, err := db.PrepareContext(ctx, d.rebind(fmt.Sprintf(setQueryText, tableName, d.keyColumn, d.upsert(d.keyColumn, "value", "updated_at"))))
 	if err != nil {
 		return nil, err
 	}
-	setQuery, err := db.PrepareContext(ctx, fmt.Sprintf(setQueryText, tableName))
+	deleteQuery, err := db.PrepareContext(ctx, d.rebind(fmt.Sprintf(deleteQueryText, tableName, d.keyColumn)))
 	if err != nil {
 		return nil, err
 	}
-	deleteQuery, err := db.PrepareContext(ctx, fmt.Sprintf(deleteQueryText, tableName))
+	return &dbStorageClient{
+		db:          db,
+		dialect:     d,
+		tableName:   tableName,
+		timeout:     timeout,
+		retry:       retry,
+		cipher:      cipher.forTable(tableName),
+		getQuery:    selectQuery,
+		setQuery:    setQuery,
+		deleteQuery: deleteQuery,
+	}, nil
+}
+
+// expire deletes the records of the table that were last updated before the specified time
+func expire(ctx context.Context, db *sql.DB, d dialect, tableName string, before time.Time) (int64, error) {
+	result, err := db.ExecContext(ctx, d.rebind(fmt.Sprintf(expireQueryText, tableName)), before.UnixNano())
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return &dbStorageClient{db, selectQuery, setQuery, deleteQuery}, nil
+	return result.RowsAffected()
+}
+
+// do executes fn within the configured operation timeout, retrying transient errors,
+// and records the latency and the outcome of the operation
+func (c *dbStorageClient) do(ctx context.Context, operation string, fn func(ctx context.Context) error) error {
+	start := time.Now()
+	opCtx := ctx
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		opCtx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	err := withRetry(opCtx, c.retry, fn)
+	recordOperation(ctx, c.tableName, operation, start, err)
+	return err
 }
 
 // Get will retrieve data from storage that corresponds to the specified key
 func (c *dbStorageClient) Get(ctx context.Context, key string) ([]byte, error) {
-	rows, err := c.getQuery.QueryContext(ctx, key)
-	if err != nil {
-		return nil, err
-	}
-	if !rows.Next() {
-		return nil, nil
-	}
 	var result []byte
-	err = rows.Scan(&result)
+	err := c.do(ctx, "get", func(ctx context.Context) error {
+		rows, err := c.getQuery.QueryContext(ctx, key)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		result = nil
+		if !rows.Next() {
+			return rows.Err()
+		}
+		if err = rows.Scan(&result); err != nil {
+			return err
+		}
+		return rows.Close()
+	})
 	if err != nil {
-		return result, err
+		return nil, err
 	}
-	err = rows.Close()
-	return result, err
+	return c.cipher.open(key, result)
 }
 
 // Set will store data. The data can be retrieved using the same key
 func (c *dbStorageClient) Set(ctx context.Context, key string, value []byte) error {
-	_, err := c.setQuery.ExecContext(ctx, key, value, value)
-	return err
+	value, err := c.cipher.seal(key, value)
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, "set", func(ctx context.Context) error {
+		_, err := c.setQuery.ExecContext(ctx, key, value, time.Now().UnixNano())
+		return err
+	})
 }
 
 // Delete will delete data associated with the specified key
 func (c *dbStorageClient) Delete(ctx context.Context, key string) error {
-	_, err := c.deleteQuery.ExecContext(ctx, key)
-	return err
+	return c.do(ctx, "delete", func(ctx context.Context) error {
+		_, err := c.deleteQuery.ExecContext(ctx, key)
+		return err
+	})
 }
 
-// Batch executes the specified operations in order. Get operation results are updated in place
+// Batch executes the specified operations in order within a single transaction.
+// Consecutive Set and Delete operations are combined into multi-row statements.
+// Get operation results are updated in place
 func (c *dbStorageClient) Batch(ctx context.Context, ops ...storage.Operation) error {
-	var err error
 	for _, op := range ops {
 		switch op.Type {
+		case storage.Get, storage.Set, storage.Delete:
+		default:
+			return errors.New("wrong operation type")
+		}
+	}
+
+	return c.do(ctx, "batch", func(ctx context.Context) error {
+		return c.batch(ctx, ops)
+	})
+}
+
+func (c *dbStorageClient) batch(ctx context.Context, ops []storage.Operation) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(ops); {
+		end := start + 1
+		for end < len(ops) && end-start < maxBatchRows && ops[end].Type == ops[start].Type {
+			end++
+		}
+
+		switch ops[start].Type {
 		case storage.Get:
-			op.Value, err = c.Get(ctx, op.Key)
+			err = c.batchGet(ctx, tx, ops[start:end])
 		case storage.Set:
-			err = c.Set(ctx, op.Key, op.Value)
+			err = c.batchSet(ctx, tx, ops[start:end])
 		case storage.Delete:
-			err = c.Delete(ctx, op.Key)
+			err = c.batchDelete(ctx, tx, ops[start:end])
+		}
+
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		start = end
+	}
+
+	return tx.Commit()
+}
+
+func (c *dbStorageClient) batchGet(ctx context.Context, tx *sql.Tx, ops []storage.Operation) error {
+	stmt := tx.StmtContext(ctx, c.getQuery)
+	for _, op := range ops {
+		var value []byte
+		err := stmt.QueryRowContext(ctx, op.Key).Scan(&value)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			op.Value = nil
+		case err != nil:
+			return err
 		default:
-			return errors.New("wrong operation type")
+			if op.Value, err = c.cipher.open(op.Key, value); err != nil {
+				return err
+			}
 		}
+	}
+	return nil
+}
+
+func (c *dbStorageClient) batchSet(ctx context.Context, tx *sql.Tx, ops []storage.Operation) error {
+	// A single upsert statement may not touch the same row twice, so only the
+	// last value written for each key is kept.
+	latest := make(map[string]int, len(ops))
+	for i, op := range ops {
+		latest[op.Key] = i
+	}
 
+	now := time.Now().UnixNano()
+	placeholders := make([]string, 0, len(latest))
+	args := make([]interface{}, 0, 3*len(latest))
+	for i, op := range ops {
+		if latest[op.Key] != i {
+			continue
+		}
+		value, err := c.cipher.seal(op.Key, op.Value)
 		if err != nil {
 			return err
 		}
+		placeholders = append(placeholders, "(?,?,?)")
+		args = append(args, op.Key, value, now)
+	}
+
+	query := fmt.Sprintf(batchSetQueryText, c.tableName, c.dialect.keyColumn, strings.Join(placeholders, ","), c.dialect.upsert(c.dialect.keyColumn, "value", "updated_at"))
+	_, err := tx.ExecContext(ctx, c.dialect.rebind(query), args...)
+	return err
+}
+
+func (c *dbStorageClient) batchDelete(ctx context.Context, tx *sql.Tx, ops []storage.Operation) error {
+	placeholders := make([]string, len(ops))
+	args := make([]interface{}, len(ops))
+	for i, op := range ops {
+		placeholders[i] = "?"
+		args[i] = op.Key
 	}
+
+	query := fmt.Sprintf(batchDeleteQueryText, c.tableName, c.dialect.keyColumn, strings.Join(placeholders, ","))
+	_, err := tx.ExecContext(ctx, c.dialect.rebind(query), args...)
 	return err
 }
 
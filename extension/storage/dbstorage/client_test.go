@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Skip tests on Windows temporarily, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/11451
+//go:build !windows
+// +build !windows
+
+package dbstorage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+func TestClientBatch(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	require.NoError(t, client.Set(ctx, "existing", []byte("old")))
+
+	getExisting := storage.GetOperation("existing")
+	getMissing := storage.GetOperation("missing")
+	getAfterSet := storage.GetOperation("a")
+	getAfterDelete := storage.GetOperation("existing")
+
+	err := client.Batch(ctx,
+		getExisting,
+		getMissing,
+		storage.SetOperation("a", []byte("1")),
+		storage.SetOperation("b", []byte("2")),
+		storage.SetOperation("a", []byte("3")),
+		getAfterSet,
+		storage.DeleteOperation("existing"),
+		storage.DeleteOperation("b"),
+		getAfterDelete,
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, []byte("old"), getExisting.Value)
+	require.Nil(t, getMissing.Value)
+	require.Equal(t, []byte("3"), getAfterSet.Value)
+	require.Nil(t, getAfterDelete.Value)
+
+	value, err := client.Get(ctx, "b")
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
+
+func TestClientBatchLarge(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	numOps := 3*maxBatchRows + 1
+	ops := make([]storage.Operation, 0, numOps)
+	for i := 0; i < numOps; i++ {
+		ops = append(ops, storage.SetOperation(fmt.Sprintf("key%d", i), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.NoError(t, client.Batch(ctx, ops...))
+
+	ops = ops[:0]
+	for i := 0; i < numOps; i++ {
+		ops = append(ops, storage.GetOperation(fmt.Sprintf("key%d", i)))
+	}
+	require.NoError(t, client.Batch(ctx, ops...))
+	for i, op := range ops {
+		require.Equal(t, []byte(fmt.Sprintf("value%d", i)), op.Value)
+	}
+}
+
+func TestClientBatchWrongOperationType(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	invalid := storage.GetOperation("b")
+	invalid.Type = 100
+	err := client.Batch(ctx, storage.SetOperation("a", []byte("1")), invalid)
+	require.Error(t, err)
+
+	value, err := client.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
+
+func newTestClient(t *testing.T) *dbStorageClient {
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s/foo.db?_busy_timeout=10000&_journal=WAL&_sync=NORMAL", t.TempDir()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	migrateTestTable(t, db, "test")
+	client, err := newClient(context.Background(), db, sqliteDialect, "test", time.Second, RetryConfig{}, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(context.Background()))
+	})
+	return client
+}
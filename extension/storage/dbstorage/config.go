@@ -15,9 +15,15 @@
 package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/dbstorage"
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagecache"
 )
 
 // Config defines configuration for dbstorage extension.
@@ -25,6 +31,134 @@ type Config struct {
 	config.ExtensionSettings `mapstructure:",squash"`
 	DriverName               string `mapstructure:"driver,omitempty"`
 	DataSource               string `mapstructure:"datasource,omitempty"`
+
+	// MaxOpenConnections is the maximum number of open connections to the database. 0 means unlimited.
+	MaxOpenConnections int `mapstructure:"max_open_connections,omitempty"`
+	// MaxIdleConnections is the maximum number of connections kept in the idle pool.
+	MaxIdleConnections int `mapstructure:"max_idle_connections,omitempty"`
+	// ConnectionMaxLifetime is the maximum amount of time a connection may be reused. 0 means forever.
+	ConnectionMaxLifetime time.Duration `mapstructure:"connection_max_lifetime,omitempty"`
+	// ConnectionMaxIdleTime is the maximum amount of time a connection may be idle. 0 means forever.
+	ConnectionMaxIdleTime time.Duration `mapstructure:"connection_max_idle_time,omitempty"`
+
+	// Timeout bounds the duration of each storage operation, including retries. 0 disables the timeout.
+	Timeout time.Duration `mapstructure:"timeout,omitempty"`
+
+	Retry RetryConfig `mapstructure:"retry,omitempty"`
+
+	TTL TTLConfig `mapstructure:"ttl,omitempty"`
+
+	Encryption EncryptionConfig `mapstructure:"encryption,omitempty"`
+
+	Migration MigrationConfig `mapstructure:"migration,omitempty"`
+
+	SQLite SQLiteConfig `mapstructure:"sqlite,omitempty"`
+
+	// UsageMetricsInterval is the frequency at which the number of records of each component
+	// and the size of the database are recorded. 0 disables these metrics.
+	UsageMetricsInterval time.Duration `mapstructure:"usage_metrics_interval,omitempty"`
+
+	// Cache specifies the optional cache of the clients, reducing the queries to the database
+	Cache storagecache.Config `mapstructure:"cache"`
+}
+
+// SQLiteConfig defines options applied when using the sqlite3 driver.
+// Options also set as parameters of the datasource are ignored.
+type SQLiteConfig struct {
+	// JournalMode is the journal mode of the database, such as WAL. Empty keeps the driver default.
+	JournalMode string `mapstructure:"journal_mode,omitempty"`
+	// BusyTimeout is the time to wait for a lock to be released before failing with "database is locked". 0 keeps the driver default.
+	BusyTimeout time.Duration `mapstructure:"busy_timeout,omitempty"`
+	// Synchronous is the synchronous level of the database, such as NORMAL. Empty keeps the driver default.
+	Synchronous string `mapstructure:"synchronous,omitempty"`
+	// VacuumOnStart rebuilds the database when the extension starts, to reclaim unused space.
+	VacuumOnStart bool `mapstructure:"vacuum_on_start"`
+}
+
+// MigrationConfig defines how the schema of existing tables is upgraded when the extension starts.
+type MigrationConfig struct {
+	// DryRun logs the pending migrations instead of applying them.
+	DryRun bool `mapstructure:"dry_run"`
+	// FailClosed fails the extension, or the creation of a client, when a table cannot be upgraded
+	// to the latest schema version. Otherwise, the table is used as is and a warning is logged.
+	FailClosed bool `mapstructure:"fail_closed"`
+}
+
+// EncryptionConfig defines the key used to encrypt stored values with AES-GCM.
+// The key must be base64 encoded and decode to 16, 24 or 32 bytes.
+type EncryptionConfig struct {
+	// KeyFile is the path to a file containing the encryption key.
+	KeyFile string `mapstructure:"key_file,omitempty"`
+	// KeyEnv is the name of an environment variable containing the encryption key.
+	KeyEnv string `mapstructure:"key_env,omitempty"`
+}
+
+// enabled returns true if stored values are encrypted
+func (cfg EncryptionConfig) enabled() bool {
+	return cfg.KeyFile != "" || cfg.KeyEnv != ""
+}
+
+// TTLConfig defines how long records are kept after their last update.
+type TTLConfig struct {
+	// Default is the TTL of the records of every component without a specific TTL. 0 means records never expire.
+	Default time.Duration `mapstructure:"default,omitempty"`
+	// Components maps component IDs, such as "filelog" or "otlp/backend", to the TTL of their records.
+	Components map[string]time.Duration `mapstructure:"components,omitempty"`
+	// CleanupInterval is the frequency at which expired records are deleted.
+	CleanupInterval time.Duration `mapstructure:"cleanup_interval,omitempty"`
+}
+
+// forComponent returns the TTL of the records of the specified component
+func (cfg TTLConfig) forComponent(id config.ComponentID) time.Duration {
+	if ttl, ok := cfg.Components[id.String()]; ok {
+		return ttl
+	}
+	return cfg.Default
+}
+
+// forTable returns the TTL of the records of a table without a client, such as the table of a removed
+// or renamed component. Since the table names don't delimit the component type and name unambiguously,
+// the component whose table name prefix is the longest match is used, and the default TTL otherwise.
+func (cfg TTLConfig) forTable(table string) time.Duration {
+	ttl, matched := cfg.Default, 0
+	for id, componentTTL := range cfg.Components {
+		cid, err := config.NewComponentIDFromString(id)
+		if err != nil {
+			continue
+		}
+		for _, kind := range []component.Kind{component.KindReceiver, component.KindProcessor, component.KindExporter, component.KindExtension} {
+			prefix := tableName(kind, cid, "")
+			if (table == prefix || strings.HasPrefix(table, prefix+"_")) && len(prefix) > matched {
+				ttl, matched = componentTTL, len(prefix)
+			}
+		}
+	}
+	return ttl
+}
+
+// enabled returns true if the records of any component may expire
+func (cfg TTLConfig) enabled() bool {
+	if cfg.Default > 0 {
+		return true
+	}
+	for _, ttl := range cfg.Components {
+		if ttl > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryConfig defines how operations failing with transient errors are retried.
+type RetryConfig struct {
+	// Enabled indicates whether transient errors are retried.
+	Enabled bool `mapstructure:"enabled"`
+	// InitialInterval is the time to wait before the first retry. It doubles after each retry.
+	InitialInterval time.Duration `mapstructure:"initial_interval,omitempty"`
+	// MaxInterval is the upper bound of the time to wait between retries.
+	MaxInterval time.Duration `mapstructure:"max_interval,omitempty"`
+	// MaxAttempts is the maximum number of times an operation is attempted, including the first attempt.
+	MaxAttempts int `mapstructure:"max_attempts,omitempty"`
 }
 
 func (cfg *Config) Validate() error {
@@ -34,6 +168,62 @@ func (cfg *Config) Validate() error {
 	if cfg.DriverName == "" {
 		return fmt.Errorf(fmt.Sprintf("missing driver name for %s", cfg.ID()))
 	}
+	if cfg.MaxOpenConnections < 0 {
+		return errors.New("max open connections cannot be negative")
+	}
+	if cfg.MaxIdleConnections < 0 {
+		return errors.New("max idle connections cannot be negative")
+	}
+	if cfg.ConnectionMaxLifetime < 0 {
+		return errors.New("connection max lifetime cannot be negative")
+	}
+	if cfg.ConnectionMaxIdleTime < 0 {
+		return errors.New("connection max idle time cannot be negative")
+	}
+	if cfg.Timeout < 0 {
+		return errors.New("timeout cannot be negative")
+	}
+	if cfg.UsageMetricsInterval < 0 {
+		return errors.New("usage metrics interval cannot be negative")
+	}
+
+	if cfg.TTL.Default < 0 {
+		return errors.New("default ttl cannot be negative")
+	}
+	for id, ttl := range cfg.TTL.Components {
+		if ttl < 0 {
+			return fmt.Errorf("ttl for component %s cannot be negative", id)
+		}
+	}
+	if cfg.TTL.enabled() && cfg.TTL.CleanupInterval <= 0 {
+		return errors.New("ttl cleanup interval must be positive when a ttl is set")
+	}
+
+	if !validSQLiteOption(cfg.SQLite.JournalMode, sqliteJournalModes) {
+		return fmt.Errorf("invalid sqlite journal mode %q, must be one of %s", cfg.SQLite.JournalMode, strings.Join(sqliteJournalModes, ", "))
+	}
+	if !validSQLiteOption(cfg.SQLite.Synchronous, sqliteSynchronousLevels) {
+		return fmt.Errorf("invalid sqlite synchronous level %q, must be one of %s", cfg.SQLite.Synchronous, strings.Join(sqliteSynchronousLevels, ", "))
+	}
+	if cfg.SQLite.BusyTimeout < 0 {
+		return errors.New("sqlite busy timeout cannot be negative")
+	}
+
+	if cfg.Encryption.KeyFile != "" && cfg.Encryption.KeyEnv != "" {
+		return errors.New("encryption key file and key environment variable cannot both be set")
+	}
+
+	if cfg.Retry.Enabled {
+		if cfg.Retry.InitialInterval <= 0 {
+			return errors.New("retry initial interval must be positive")
+		}
+		if cfg.Retry.MaxInterval < cfg.Retry.InitialInterval {
+			return errors.New("retry max interval cannot be less than the initial interval")
+		}
+		if cfg.Retry.MaxAttempts < 1 {
+			return errors.New("retry max attempts must be at least 1")
+		}
+	}
 
-	return nil
+	return cfg.Cache.Validate()
 }
@@ -20,6 +20,7 @@ package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-c
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -45,6 +46,81 @@ func TestConfig_Validate(t *testing.T) {
 			Config{DriverName: "foo", DataSource: "bar"},
 			nil,
 		},
+		{
+			"Negative max open connections",
+			Config{DriverName: "foo", DataSource: "bar", MaxOpenConnections: -1},
+			errors.New("max open connections cannot be negative"),
+		},
+		{
+			"Negative max idle connections",
+			Config{DriverName: "foo", DataSource: "bar", MaxIdleConnections: -1},
+			errors.New("max idle connections cannot be negative"),
+		},
+		{
+			"Negative timeout",
+			Config{DriverName: "foo", DataSource: "bar", Timeout: -time.Second},
+			errors.New("timeout cannot be negative"),
+		},
+		{
+			"Retry without initial interval",
+			Config{DriverName: "foo", DataSource: "bar", Retry: RetryConfig{Enabled: true, MaxInterval: time.Second, MaxAttempts: 3}},
+			errors.New("retry initial interval must be positive"),
+		},
+		{
+			"Retry max interval below initial interval",
+			Config{DriverName: "foo", DataSource: "bar", Retry: RetryConfig{Enabled: true, InitialInterval: time.Second, MaxInterval: time.Millisecond, MaxAttempts: 3}},
+			errors.New("retry max interval cannot be less than the initial interval"),
+		},
+		{
+			"Retry without attempts",
+			Config{DriverName: "foo", DataSource: "bar", Retry: RetryConfig{Enabled: true, InitialInterval: time.Millisecond, MaxInterval: time.Second}},
+			errors.New("retry max attempts must be at least 1"),
+		},
+		{
+			"Invalid sqlite journal mode",
+			Config{DriverName: "foo", DataSource: "bar", SQLite: SQLiteConfig{JournalMode: "fast"}},
+			errors.New(`invalid sqlite journal mode "fast", must be one of DELETE, TRUNCATE, PERSIST, MEMORY, WAL, OFF`),
+		},
+		{
+			"Invalid sqlite synchronous level",
+			Config{DriverName: "foo", DataSource: "bar", SQLite: SQLiteConfig{JournalMode: "wal", Synchronous: "always"}},
+			errors.New(`invalid sqlite synchronous level "always", must be one of OFF, NORMAL, FULL, EXTRA`),
+		},
+		{
+			"Negative sqlite busy timeout",
+			Config{DriverName: "foo", DataSource: "bar", SQLite: SQLiteConfig{BusyTimeout: -time.Second}},
+			errors.New("sqlite busy timeout cannot be negative"),
+		},
+		{
+			"Negative usage metrics interval",
+			Config{DriverName: "foo", DataSource: "bar", UsageMetricsInterval: -time.Second},
+			errors.New("usage metrics interval cannot be negative"),
+		},
+		{
+			"Encryption key file and env",
+			Config{DriverName: "foo", DataSource: "bar", Encryption: EncryptionConfig{KeyFile: "key", KeyEnv: "KEY"}},
+			errors.New("encryption key file and key environment variable cannot both be set"),
+		},
+		{
+			"Negative ttl",
+			Config{DriverName: "foo", DataSource: "bar", TTL: TTLConfig{Default: -time.Second, CleanupInterval: time.Second}},
+			errors.New("default ttl cannot be negative"),
+		},
+		{
+			"Negative component ttl",
+			Config{DriverName: "foo", DataSource: "bar", TTL: TTLConfig{Components: map[string]time.Duration{"filelog": -time.Second}, CleanupInterval: time.Second}},
+			errors.New("ttl for component filelog cannot be negative"),
+		},
+		{
+			"Ttl without cleanup interval",
+			Config{DriverName: "foo", DataSource: "bar", TTL: TTLConfig{Components: map[string]time.Duration{"filelog": time.Second}}},
+			errors.New("ttl cleanup interval must be positive when a ttl is set"),
+		},
+		{
+			"Disabled retry is not validated",
+			Config{DriverName: "foo", DataSource: "bar", Retry: RetryConfig{Enabled: false}},
+			nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -57,3 +133,22 @@ func TestConfig_Validate(t *testing.T) {
 		}
 	}
 }
+
+func TestTTLForTable(t *testing.T) {
+	cfg := TTLConfig{
+		Default: time.Minute,
+		Components: map[string]time.Duration{
+			"otlp":         time.Hour,
+			"otlp/backend": 2 * time.Hour,
+			"filelog":      0,
+		},
+	}
+
+	assert.Equal(t, time.Hour, cfg.forTable("exporter_otlp_"))
+	assert.Equal(t, time.Hour, cfg.forTable("exporter_otlp__sending_queue"))
+	assert.Equal(t, 2*time.Hour, cfg.forTable("exporter_otlp_backend"))
+	assert.Equal(t, 2*time.Hour, cfg.forTable("exporter_otlp_backend_sending_queue"))
+	assert.Equal(t, time.Duration(0), cfg.forTable("receiver_filelog_"))
+	assert.Equal(t, time.Minute, cfg.forTable("exporter_otlphttp_"))
+	assert.Equal(t, time.Minute, cfg.forTable("receiver_removed_"))
+}
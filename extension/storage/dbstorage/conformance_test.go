@@ -0,0 +1,306 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Skip tests on Windows temporarily, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/11451
+//go:build !windows
+// +build !windows
+
+package dbstorage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+func TestConformanceSQLite(t *testing.T) {
+	testConformance(t, sqliteDriverName, fmt.Sprintf("file:%s/foo.db?_busy_timeout=10000&_journal=WAL&_sync=NORMAL", t.TempDir()))
+}
+
+// testConformance checks that the clients of the extension using the driver
+// fulfill the storage.Client contract, so that all drivers behave the same.
+// Each test uses its own client, so the database may be shared between them.
+func testConformance(t *testing.T, driverName, dataSource string) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, ds *databaseStorage, client storage.Client)
+	}{
+		{name: "get_set_delete", run: testConformanceGetSetDelete},
+		{name: "keys", run: testConformanceKeys},
+		{name: "empty_value", run: testConformanceEmptyValue},
+		{name: "large_value", run: testConformanceLargeValue},
+		{name: "batch", run: testConformanceBatch},
+		{name: "batch_large", run: testConformanceBatchLarge},
+		{name: "batch_wrong_operation_type", run: testConformanceBatchWrongOperationType},
+		{name: "isolation", run: testConformanceIsolation},
+		{name: "concurrency", run: testConformanceConcurrency},
+		{name: "close", run: testConformanceClose},
+	}
+
+	ctx := context.Background()
+	ds := newConformanceExtension(t, driverName, dataSource)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := ds.GetClient(ctx, component.KindReceiver, newTestEntity("conformance"), tt.name)
+			require.NoError(t, err)
+			tt.run(t, ds, client)
+		})
+	}
+
+	t.Run("shutdown", func(t *testing.T) {
+		client, err := ds.GetClient(ctx, component.KindReceiver, newTestEntity("conformance"), "shutdown")
+		require.NoError(t, err)
+		require.NoError(t, client.Set(ctx, "key", []byte("value")))
+		require.NoError(t, client.Close(ctx))
+		require.NoError(t, ds.Shutdown(ctx))
+
+		_, err = ds.GetClient(ctx, component.KindReceiver, newTestEntity("conformance"), "shutdown")
+		require.Error(t, err)
+
+		// The values are persisted across restarts
+		restarted := newConformanceExtension(t, driverName, dataSource)
+		client, err = restarted.GetClient(ctx, component.KindReceiver, newTestEntity("conformance"), "shutdown")
+		require.NoError(t, err)
+		defer client.Close(ctx)
+		value, err := client.Get(ctx, "key")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value"), value)
+		require.NoError(t, restarted.Shutdown(ctx))
+	})
+}
+
+func testConformanceGetSetDelete(t *testing.T, _ *databaseStorage, client storage.Client) {
+	ctx := context.Background()
+	defer client.Close(ctx)
+
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	require.NoError(t, client.Set(ctx, "key", []byte("1")))
+	require.NoError(t, client.Set(ctx, "key", []byte("2")))
+	value, err = client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2"), value)
+
+	require.NoError(t, client.Delete(ctx, "key"))
+	value, err = client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	// Deleting a missing key is not an error
+	require.NoError(t, client.Delete(ctx, "key"))
+}
+
+func testConformanceKeys(t *testing.T, _ *databaseStorage, client storage.Client) {
+	ctx := context.Background()
+	defer client.Close(ctx)
+
+	keys := []string{"key", "Key", "key ", "key'quote", "key\"double", "key;drop", "ключ", "key%_"}
+	for i, key := range keys {
+		require.NoError(t, client.Set(ctx, key, []byte{byte(i)}))
+	}
+	for i, key := range keys {
+		value, err := client.Get(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{byte(i)}, value, key)
+	}
+}
+
+func testConformanceEmptyValue(t *testing.T, _ *databaseStorage, client storage.Client) {
+	ctx := context.Background()
+	defer client.Close(ctx)
+
+	require.NoError(t, client.Set(ctx, "key", []byte{}))
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Empty(t, value)
+}
+
+func testConformanceLargeValue(t *testing.T, _ *databaseStorage, client storage.Client) {
+	ctx := context.Background()
+	defer client.Close(ctx)
+
+	large := bytes.Repeat([]byte{0, 1, 2, 0xff}, 1<<18)
+	require.NoError(t, client.Set(ctx, "key", large))
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, large, value)
+}
+
+func testConformanceBatch(t *testing.T, _ *databaseStorage, client storage.Client) {
+	ctx := context.Background()
+	defer client.Close(ctx)
+
+	require.NoError(t, client.Set(ctx, "existing", []byte("old")))
+
+	getExisting := storage.GetOperation("existing")
+	getMissing := storage.GetOperation("missing")
+	getAfterSet := storage.GetOperation("a")
+	getAfterDelete := storage.GetOperation("existing")
+	require.NoError(t, client.Batch(ctx,
+		getExisting,
+		getMissing,
+		storage.SetOperation("a", []byte("1")),
+		storage.SetOperation("b", []byte("2")),
+		storage.SetOperation("a", []byte("3")),
+		getAfterSet,
+		storage.DeleteOperation("existing"),
+		storage.DeleteOperation("b"),
+		getAfterDelete,
+	))
+	assert.Equal(t, []byte("old"), getExisting.Value)
+	assert.Nil(t, getMissing.Value)
+	assert.Equal(t, []byte("3"), getAfterSet.Value)
+	assert.Nil(t, getAfterDelete.Value)
+
+	value, err := client.Get(ctx, "b")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	require.NoError(t, client.Batch(ctx))
+}
+
+func testConformanceBatchLarge(t *testing.T, _ *databaseStorage, client storage.Client) {
+	ctx := context.Background()
+	defer client.Close(ctx)
+
+	numOps := 3*maxBatchRows + 1
+	ops := make([]storage.Operation, 0, numOps)
+	for i := 0; i < numOps; i++ {
+		ops = append(ops, storage.SetOperation(fmt.Sprintf("key%d", i), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.NoError(t, client.Batch(ctx, ops...))
+
+	ops = ops[:0]
+	for i := 0; i < numOps; i++ {
+		ops = append(ops, storage.GetOperation(fmt.Sprintf("key%d", i)))
+	}
+	require.NoError(t, client.Batch(ctx, ops...))
+	for i, op := range ops {
+		require.Equal(t, []byte(fmt.Sprintf("value%d", i)), op.Value)
+	}
+
+	ops = ops[:0]
+	for i := 0; i < numOps; i++ {
+		ops = append(ops, storage.DeleteOperation(fmt.Sprintf("key%d", i)))
+	}
+	require.NoError(t, client.Batch(ctx, ops...))
+	value, err := client.Get(ctx, "key0")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func testConformanceBatchWrongOperationType(t *testing.T, _ *databaseStorage, client storage.Client) {
+	ctx := context.Background()
+	defer client.Close(ctx)
+
+	invalid := storage.GetOperation("b")
+	invalid.Type = 100
+	require.Error(t, client.Batch(ctx, storage.SetOperation("a", []byte("1")), invalid))
+
+	value, err := client.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func testConformanceIsolation(t *testing.T, ds *databaseStorage, client storage.Client) {
+	ctx := context.Background()
+	defer client.Close(ctx)
+
+	other, err := ds.GetClient(ctx, component.KindExporter, newTestEntity("conformance"), "isolation")
+	require.NoError(t, err)
+	defer other.Close(ctx)
+
+	require.NoError(t, client.Set(ctx, "key", []byte("receiver")))
+	require.NoError(t, other.Set(ctx, "key", []byte("exporter")))
+
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("receiver"), value)
+	value, err = other.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("exporter"), value)
+}
+
+func testConformanceConcurrency(t *testing.T, _ *databaseStorage, client storage.Client) {
+	ctx := context.Background()
+	defer client.Close(ctx)
+
+	const workers, iterations = 8, 25
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			key := fmt.Sprintf("worker%d", w)
+			for i := 0; i < iterations; i++ {
+				value := []byte(fmt.Sprintf("%d", i))
+				if err := client.Batch(ctx, storage.SetOperation(key, value), storage.SetOperation("shared", value)); err != nil {
+					errs <- err
+					return
+				}
+				got, err := client.Get(ctx, key)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if !bytes.Equal(value, got) {
+					errs <- fmt.Errorf("expected %q for %s, got %q", value, key, got)
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	value, err := client.Get(ctx, "shared")
+	require.NoError(t, err)
+	assert.Equal(t, []byte(fmt.Sprintf("%d", iterations-1)), value)
+}
+
+func testConformanceClose(t *testing.T, _ *databaseStorage, client storage.Client) {
+	ctx := context.Background()
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	require.NoError(t, client.Close(ctx))
+
+	_, err := client.Get(ctx, "key")
+	assert.Error(t, err)
+	assert.Error(t, client.Set(ctx, "key", []byte("value")))
+}
+
+func newConformanceExtension(t *testing.T, driverName, dataSource string) *databaseStorage {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.DriverName = driverName
+	cfg.DataSource = dataSource
+
+	ext, err := f.CreateExtension(context.Background(), componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	return ext.(*databaseStorage)
+}
@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/dbstorage"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	postgresDriverName = "pgx"
+	mysqlDriverName    = "mysql"
+)
+
+// dialect holds the SQL syntax that differs between the supported databases.
+// Statements are written with ? placeholders and rebound for the database.
+type dialect struct {
+	// numberedPlaceholders specifies that placeholders are written $1, $2, ...
+	numberedPlaceholders bool
+	// keyColumn is the name of the key column, quoted if it is a reserved word
+	keyColumn string
+	// keyType is the type of the key columns, which must be usable as primary key
+	keyType string
+	// valueType is the type of the value column
+	valueType string
+	// upsertOnDuplicateKey specifies that upserts use the MySQL syntax
+	upsertOnDuplicateKey bool
+	// createIndex is the statement creating the index of a table column
+	createIndex string
+}
+
+var (
+	sqliteDialect = dialect{
+		keyColumn:   "key",
+		keyType:     "text",
+		valueType:   "blob",
+		createIndex: "create index if not exists %[1]s_%[2]s on %[1]s(%[2]s)",
+	}
+	postgresDialect = dialect{
+		numberedPlaceholders: true,
+		keyColumn:            "key",
+		keyType:              "text",
+		valueType:            "bytea",
+		createIndex:          "create index if not exists %[1]s_%[2]s on %[1]s(%[2]s)",
+	}
+	mysqlDialect = dialect{
+		keyColumn: "`key`",
+		// Binary strings are compared byte by byte, as in the other databases
+		keyType:              "varbinary(255)",
+		valueType:            "longblob",
+		upsertOnDuplicateKey: true,
+		createIndex:          "create index %[1]s_%[2]s on %[1]s(%[2]s)",
+	}
+)
+
+// dialectFor returns the dialect of the driver, the SQLite one for unknown drivers
+func dialectFor(driverName string) dialect {
+	switch driverName {
+	case postgresDriverName:
+		return postgresDialect
+	case mysqlDriverName:
+		return mysqlDialect
+	default:
+		return sqliteDialect
+	}
+}
+
+// rebind replaces the ? placeholders of the statement with the ones of the database
+func (d dialect) rebind(query string) string {
+	if !d.numberedPlaceholders {
+		return query
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r != '?' {
+			b.WriteRune(r)
+			continue
+		}
+		n++
+		b.WriteByte('$')
+		b.WriteString(strconv.Itoa(n))
+	}
+	return b.String()
+}
+
+// upsert returns the clause of an insert statement updating the columns of the
+// row which already has the same conflictColumn
+func (d dialect) upsert(conflictColumn string, columns ...string) string {
+	assignments := make([]string, len(columns))
+	for i, column := range columns {
+		if d.upsertOnDuplicateKey {
+			assignments[i] = fmt.Sprintf("%[1]s=values(%[1]s)", column)
+		} else {
+			assignments[i] = fmt.Sprintf("%[1]s=excluded.%[1]s", column)
+		}
+	}
+
+	if d.upsertOnDuplicateKey {
+		return "on duplicate key update " + strings.Join(assignments, ", ")
+	}
+	return fmt.Sprintf("on conflict(%s) do update set %s", conflictColumn, strings.Join(assignments, ", "))
+}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/dbstorage"
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// encryptionVersion prefixes every encrypted value, so that the format can evolve
+const encryptionVersion byte = 1
+
+var errInvalidCiphertext = errors.New("stored value is not a valid encrypted value")
+
+// valueCipher encrypts values with AES-GCM before they are persisted.
+// The table and the key of each record are authenticated along with its value,
+// so that encrypted values cannot be swapped between keys or components unnoticed.
+type valueCipher struct {
+	aead  cipher.AEAD
+	table string
+}
+
+// newValueCipher returns the cipher configured by cfg, or nil if encryption is disabled
+func newValueCipher(cfg EncryptionConfig) (*valueCipher, error) {
+	if !cfg.enabled() {
+		return nil, nil
+	}
+
+	key, err := loadKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &valueCipher{aead: aead}, nil
+}
+
+// loadKey reads the base64 encoded key from the configured file or environment variable
+func loadKey(cfg EncryptionConfig) ([]byte, error) {
+	var encoded string
+	switch {
+	case cfg.KeyFile != "":
+		content, err := os.ReadFile(cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read encryption key file: %w", err)
+		}
+		encoded = string(content)
+	default:
+		value, ok := os.LookupEnv(cfg.KeyEnv)
+		if !ok {
+			return nil, fmt.Errorf("environment variable %s containing the encryption key is not set", cfg.KeyEnv)
+		}
+		encoded = value
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("encryption key is not base64 encoded: %w", err)
+	}
+	return key, nil
+}
+
+// forTable returns the cipher of the values stored in the specified table
+func (c *valueCipher) forTable(table string) *valueCipher {
+	if c == nil {
+		return nil
+	}
+	return &valueCipher{aead: c.aead, table: table}
+}
+
+// additionalData returns the data authenticated along with the value of the specified key.
+// The table name is length prefixed, so that a table and key pair cannot be confused with another.
+func (c *valueCipher) additionalData(key string) []byte {
+	data := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(c.table)+len(key))
+	data = data[:binary.PutUvarint(data, uint64(len(c.table)))]
+	data = append(data, c.table...)
+	return append(data, key...)
+}
+
+// seal encrypts the value of the specified key. Nil values are stored as is.
+func (c *valueCipher) seal(key string, value []byte) ([]byte, error) {
+	if c == nil || value == nil {
+		return value, nil
+	}
+
+	nonceSize := c.aead.NonceSize()
+	out := make([]byte, 1+nonceSize, 1+nonceSize+len(value)+c.aead.Overhead())
+	out[0] = encryptionVersion
+	if _, err := io.ReadFull(rand.Reader, out[1:]); err != nil {
+		return nil, err
+	}
+	return c.aead.Seal(out, out[1:1+nonceSize], value, c.additionalData(key)), nil
+}
+
+// open decrypts the value of the specified key
+func (c *valueCipher) open(key string, value []byte) ([]byte, error) {
+	if c == nil || value == nil {
+		return value, nil
+	}
+
+	nonceSize := c.aead.NonceSize()
+	if len(value) < 1+nonceSize || value[0] != encryptionVersion {
+		return nil, errInvalidCiphertext
+	}
+	plaintext, err := c.aead.Open(nil, value[1:1+nonceSize], value[1+nonceSize:], c.additionalData(key))
+	if err != nil {
+		return nil, errInvalidCiphertext
+	}
+	return plaintext, nil
+}
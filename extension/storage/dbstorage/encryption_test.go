@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Skip tests on Windows temporarily, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/11451
+//go:build !windows
+// +build !windows
+
+package dbstorage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+func TestValueCipher(t *testing.T) {
+	c := newTestCipher(t).forTable("table")
+
+	sealed, err := c.seal("key", []byte("secret"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed), "secret")
+
+	value, err := c.open("key", sealed)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), value)
+
+	_, err = c.open("other", sealed)
+	assert.ErrorIs(t, err, errInvalidCiphertext)
+
+	_, err = c.forTable("other").open("key", sealed)
+	assert.ErrorIs(t, err, errInvalidCiphertext)
+
+	_, err = c.forTable("tablek").open("ey", sealed)
+	assert.ErrorIs(t, err, errInvalidCiphertext)
+
+	sealed[len(sealed)-1] ^= 0xff
+	_, err = c.open("key", sealed)
+	assert.ErrorIs(t, err, errInvalidCiphertext)
+
+	_, err = c.open("key", []byte("plaintext"))
+	assert.ErrorIs(t, err, errInvalidCiphertext)
+
+	value, err = c.open("key", nil)
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestNewValueCipher(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(key+"\n"), 0600))
+	t.Setenv("DBSTORAGE_TEST_KEY", key)
+	t.Setenv("DBSTORAGE_TEST_SHORT_KEY", base64.StdEncoding.EncodeToString([]byte("short")))
+	t.Setenv("DBSTORAGE_TEST_INVALID_KEY", "not base64!")
+
+	tests := []struct {
+		name    string
+		config  EncryptionConfig
+		enabled bool
+		err     string
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name:    "key file",
+			config:  EncryptionConfig{KeyFile: keyFile},
+			enabled: true,
+		},
+		{
+			name:    "key env",
+			config:  EncryptionConfig{KeyEnv: "DBSTORAGE_TEST_KEY"},
+			enabled: true,
+		},
+		{
+			name:   "missing key file",
+			config: EncryptionConfig{KeyFile: filepath.Join(t.TempDir(), "missing")},
+			err:    "failed to read encryption key file",
+		},
+		{
+			name:   "missing key env",
+			config: EncryptionConfig{KeyEnv: "DBSTORAGE_TEST_MISSING_KEY"},
+			err:    "environment variable DBSTORAGE_TEST_MISSING_KEY containing the encryption key is not set",
+		},
+		{
+			name:   "invalid key length",
+			config: EncryptionConfig{KeyEnv: "DBSTORAGE_TEST_SHORT_KEY"},
+			err:    "invalid encryption key",
+		},
+		{
+			name:   "invalid key encoding",
+			config: EncryptionConfig{KeyEnv: "DBSTORAGE_TEST_INVALID_KEY"},
+			err:    "encryption key is not base64 encoded",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := newValueCipher(test.config)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.enabled, c != nil)
+		})
+	}
+}
+
+func TestClientEncryption(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s/foo.db?_busy_timeout=10000&_journal=WAL&_sync=NORMAL", t.TempDir()))
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrateTestTable(t, db, "encrypted")
+	client, err := newClient(ctx, db, sqliteDialect, "encrypted", time.Second, RetryConfig{}, newTestCipher(t))
+	require.NoError(t, err)
+	defer client.Close(ctx)
+
+	require.NoError(t, client.Set(ctx, "single", []byte("secret")))
+	require.NoError(t, client.Batch(ctx, storage.SetOperation("batched", []byte("secret"))))
+
+	rows, err := db.QueryContext(ctx, "select value from encrypted")
+	require.NoError(t, err)
+	defer rows.Close()
+	for rows.Next() {
+		var stored []byte
+		require.NoError(t, rows.Scan(&stored))
+		assert.NotContains(t, string(stored), "secret")
+	}
+	require.NoError(t, rows.Err())
+
+	value, err := client.Get(ctx, "single")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), value)
+
+	get := storage.GetOperation("batched")
+	require.NoError(t, client.Batch(ctx, get))
+	assert.Equal(t, []byte("secret"), get.Value)
+}
+
+func newTestCipher(t *testing.T) *valueCipher {
+	t.Setenv("DBSTORAGE_TEST_CIPHER_KEY", base64.StdEncoding.EncodeToString([]byte("0123456789abcdef")))
+	c, err := newValueCipher(EncryptionConfig{KeyEnv: "DBSTORAGE_TEST_CIPHER_KEY"})
+	require.NoError(t, err)
+	return c
+}
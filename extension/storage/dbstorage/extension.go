@@ -19,18 +19,33 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagecache"
 )
 
 type databaseStorage struct {
 	driverName     string
 	datasourceName string
+	dialect        dialect
+	cfg            *Config
 	logger         *zap.Logger
 	db             *sql.DB
+	cipher         *valueCipher
+
+	// tables holds the tables of all the clients, for the usage metrics
+	tables map[string]struct{}
+	// expiringTables maps the tables of the clients with a TTL to that TTL
+	expiringTables map[string]time.Duration
+	mu             sync.Mutex
+	cancel         context.CancelFunc
+	wg             sync.WaitGroup
 }
 
 // Ensure this storage extension implements the appropriate interface
@@ -40,39 +55,175 @@ func newDBStorage(logger *zap.Logger, config *Config) (component.Extension, erro
 	return &databaseStorage{
 		driverName:     config.DriverName,
 		datasourceName: config.DataSource,
+		dialect:        dialectFor(config.DriverName),
+		cfg:            config,
 		logger:         logger,
+		tables:         make(map[string]struct{}),
+		expiringTables: make(map[string]time.Duration),
 	}, nil
 }
 
 // Start opens a connection to the database
-func (ds *databaseStorage) Start(context.Context, component.Host) error {
-	db, err := sql.Open(ds.driverName, ds.datasourceName)
+func (ds *databaseStorage) Start(ctx context.Context, _ component.Host) error {
+	cipher, err := newValueCipher(ds.cfg.Encryption)
 	if err != nil {
 		return err
 	}
+	ds.cipher = cipher
+
+	dataSource := ds.datasourceName
+	if ds.driverName == sqliteDriverName {
+		if dataSource, err = sqliteDataSource(dataSource, ds.cfg.SQLite); err != nil {
+			return err
+		}
+	}
 
-	if err := db.Ping(); err != nil {
+	db, err := sql.Open(ds.driverName, dataSource)
+	if err != nil {
+		return err
+	}
+	db.SetMaxOpenConns(ds.cfg.MaxOpenConnections)
+	db.SetMaxIdleConns(ds.cfg.MaxIdleConnections)
+	db.SetConnMaxLifetime(ds.cfg.ConnectionMaxLifetime)
+	db.SetConnMaxIdleTime(ds.cfg.ConnectionMaxIdleTime)
+
+	if err := withRetry(ctx, ds.cfg.Retry, db.PingContext); err != nil {
+		_ = db.Close()
 		return err
 	}
 	ds.db = db
+
+	if ds.driverName == sqliteDriverName && ds.cfg.SQLite.VacuumOnStart {
+		if _, err := db.ExecContext(ctx, "vacuum"); err != nil {
+			_ = db.Close()
+			return fmt.Errorf("failed to vacuum the database: %w", err)
+		}
+	}
+
+	// Tables of the components that are not started yet are migrated when their client is created
+	if err := ds.migrator().migrateAll(ctx); err != nil {
+		_ = db.Close()
+		return err
+	}
+
+	bgCtx, cancel := context.WithCancel(context.Background())
+	ds.cancel = cancel
+	if ds.cfg.TTL.enabled() {
+		ds.wg.Add(1)
+		go ds.runPeriodically(bgCtx, ds.cfg.TTL.CleanupInterval, ds.cleanup)
+	}
+	if ds.cfg.UsageMetricsInterval > 0 {
+		ds.wg.Add(1)
+		go ds.runPeriodically(bgCtx, ds.cfg.UsageMetricsInterval, ds.recordUsage)
+	}
 	return nil
 }
 
-// Shutdown closes the connection to the database
+// Shutdown stops the background jobs and closes the connection to the database
 func (ds *databaseStorage) Shutdown(context.Context) error {
+	if ds.cancel != nil {
+		ds.cancel()
+		ds.wg.Wait()
+	}
 	return ds.db.Close()
 }
 
+func (ds *databaseStorage) migrator() *migrator {
+	return &migrator{db: ds.db, dialect: ds.dialect, cfg: ds.cfg.Migration, logger: ds.logger}
+}
+
+// runPeriodically calls fn at the specified interval until the context is canceled
+func (ds *databaseStorage) runPeriodically(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	defer ds.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fn(ctx)
+		}
+	}
+}
+
+// cleanup deletes the expired records of the clients with a TTL. The tables of the components
+// without a client in this process, such as removed or renamed components, are swept as well.
+func (ds *databaseStorage) cleanup(ctx context.Context) {
+	tables, err := ds.migrator().tables(ctx)
+	if err != nil {
+		ds.logger.Warn("failed to list the tables to clean up", zap.Error(err))
+	}
+	ttls := make(map[string]time.Duration, len(tables))
+	for _, table := range tables {
+		ttls[table] = ds.cfg.TTL.forTable(table)
+	}
+
+	ds.mu.Lock()
+	for table, ttl := range ds.expiringTables {
+		ttls[table] = ttl
+	}
+	for table := range ds.tables {
+		if _, ok := ds.expiringTables[table]; !ok {
+			// the component of the client has no TTL
+			delete(ttls, table)
+		}
+	}
+	ds.mu.Unlock()
+
+	now := time.Now()
+	for table, ttl := range ttls {
+		if ttl <= 0 {
+			continue
+		}
+		deleted, err := expire(ctx, ds.db, ds.dialect, table, now.Add(-ttl))
+		if err != nil {
+			ds.logger.Warn("failed to delete expired records", zap.String("table", table), zap.Error(err))
+			continue
+		}
+		if deleted > 0 {
+			ds.logger.Debug("deleted expired records", zap.String("table", table), zap.Int64("count", deleted))
+		}
+	}
+}
+
 // GetClient returns a storage client for an individual component
 func (ds *databaseStorage) GetClient(ctx context.Context, kind component.Kind, ent config.ComponentID, name string) (storage.Client, error) {
+	fullName := tableName(kind, ent, name)
+	if err := ds.migrator().migrate(ctx, fullName); err != nil {
+		return nil, err
+	}
+	client, err := newClient(ctx, ds.db, ds.dialect, fullName, ds.cfg.Timeout, ds.cfg.Retry, ds.cipher)
+	if err != nil {
+		return nil, err
+	}
+
+	// Expired records keep being deleted after the client is closed, so that
+	// the entries of a component that stops using its client don't linger.
+	ds.mu.Lock()
+	ds.tables[fullName] = struct{}{}
+	if ttl := ds.cfg.TTL.forComponent(ent); ttl > 0 {
+		ds.expiringTables[fullName] = ttl
+	}
+	ds.mu.Unlock()
+
+	if ds.cfg.Cache.Enabled {
+		return storagecache.NewClient(client, ds.cfg.Cache, ds.logger), nil
+	}
+	return client, nil
+}
+
+// tableName returns the name of the table of the client of a component
+func tableName(kind component.Kind, ent config.ComponentID, name string) string {
 	var fullName string
 	if name == "" {
 		fullName = fmt.Sprintf("%s_%s_%s", kindString(kind), ent.Type(), ent.Name())
 	} else {
 		fullName = fmt.Sprintf("%s_%s_%s_%s", kindString(kind), ent.Type(), ent.Name(), name)
 	}
-	fullName = strings.ReplaceAll(fullName, " ", "")
-	return newClient(ctx, ds.db, fullName)
+	return strings.ReplaceAll(fullName, " ", "")
 }
 
 func kindString(k component.Kind) string {
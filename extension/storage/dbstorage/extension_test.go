@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -112,6 +113,95 @@ func TestExtensionIntegrity(t *testing.T) {
 	wg.Wait()
 }
 
+func TestExtensionTTL(t *testing.T) {
+	ctx := context.Background()
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.DriverName = "sqlite3"
+	cfg.DataSource = fmt.Sprintf("file:%s/foo.db?_busy_timeout=10000&_journal=WAL&_sync=NORMAL", t.TempDir())
+	cfg.TTL = TTLConfig{
+		Default:         time.Millisecond,
+		Components:      map[string]time.Duration{"nop/long_lived": time.Hour},
+		CleanupInterval: time.Hour,
+	}
+
+	ext, err := f.CreateExtension(ctx, componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+	se := ext.(*databaseStorage)
+	require.NoError(t, se.Start(ctx, componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, se.Shutdown(ctx))
+	}()
+
+	shortLived, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("short_lived"), "")
+	require.NoError(t, err)
+	longLived, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("long_lived"), "")
+	require.NoError(t, err)
+
+	require.NoError(t, shortLived.Set(ctx, "key", []byte("value")))
+	require.NoError(t, longLived.Set(ctx, "key", []byte("value")))
+
+	time.Sleep(10 * time.Millisecond)
+	se.cleanup(ctx)
+
+	value, err := shortLived.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = longLived.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	require.NoError(t, shortLived.Close(ctx))
+	require.NoError(t, longLived.Close(ctx))
+}
+
+func TestExtensionTTLOrphanedTables(t *testing.T) {
+	ctx := context.Background()
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.DriverName = "sqlite3"
+	cfg.DataSource = fmt.Sprintf("file:%s/foo.db?_busy_timeout=10000&_journal=WAL&_sync=NORMAL", t.TempDir())
+	cfg.TTL = TTLConfig{
+		Default:         time.Millisecond,
+		Components:      map[string]time.Duration{"nop/long_lived": time.Hour},
+		CleanupInterval: time.Hour,
+	}
+
+	// A first run stores the records of components that are later removed from the configuration
+	ext, err := f.CreateExtension(ctx, componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+	se := ext.(*databaseStorage)
+	require.NoError(t, se.Start(ctx, componenttest.NewNopHost()))
+	for _, name := range []string{"removed", "long_lived"} {
+		client, err := se.GetClient(ctx, component.KindExporter, newTestEntity(name), "queue")
+		require.NoError(t, err)
+		require.NoError(t, client.Set(ctx, "key", []byte("value")))
+		require.NoError(t, client.Close(ctx))
+	}
+	require.NoError(t, se.Shutdown(ctx))
+
+	// The next run has no client for these components
+	ext, err = f.CreateExtension(ctx, componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+	se = ext.(*databaseStorage)
+	require.NoError(t, se.Start(ctx, componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, se.Shutdown(ctx))
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	se.cleanup(ctx)
+
+	count := func(table string) int {
+		var n int
+		require.NoError(t, se.db.QueryRowContext(ctx, fmt.Sprintf("select count(*) from %s", table)).Scan(&n))
+		return n
+	}
+	assert.Equal(t, 0, count("exporter_nop_removed_queue"))
+	assert.Equal(t, 1, count("exporter_nop_long_lived_queue"))
+}
+
 func newTestExtension(t *testing.T) storage.Extension {
 	f := NewFactory()
 	cfg := f.CreateDefaultConfig().(*Config)
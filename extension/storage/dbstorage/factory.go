@@ -16,16 +16,37 @@ package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-c
 
 import (
 	"context"
+	"time"
 
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagecache"
 )
 
 // The value of extension "type" in configuration.
 const typeStr config.Type = "db_storage"
 
+const (
+	defaultMaxOpenConnections   = 10
+	defaultMaxIdleConnections   = 10
+	defaultTimeout              = 10 * time.Second
+	defaultRetryInitialInterval = 50 * time.Millisecond
+	defaultRetryMaxInterval     = time.Second
+	defaultRetryMaxAttempts     = 5
+	defaultTTLCleanupInterval   = time.Minute
+	defaultUsageMetricsInterval = time.Minute
+	defaultSQLiteJournalMode    = "WAL"
+	defaultSQLiteBusyTimeout    = 10 * time.Second
+	defaultSQLiteSynchronous    = "NORMAL"
+)
+
 // NewFactory creates a factory for DBStorage extension.
 func NewFactory() component.ExtensionFactory {
+	// TODO: find a more appropriate way to get this done, as we are swallowing the error here
+	_ = view.Register(MetricViews()...)
+
 	return component.NewExtensionFactory(
 		typeStr,
 		createDefaultConfig,
@@ -36,7 +57,29 @@ func NewFactory() component.ExtensionFactory {
 
 func createDefaultConfig() config.Extension {
 	return &Config{
-		ExtensionSettings: config.NewExtensionSettings(config.NewComponentID(typeStr)),
+		ExtensionSettings:  config.NewExtensionSettings(config.NewComponentID(typeStr)),
+		MaxOpenConnections: defaultMaxOpenConnections,
+		MaxIdleConnections: defaultMaxIdleConnections,
+		Timeout:            defaultTimeout,
+		Retry: RetryConfig{
+			Enabled:         true,
+			InitialInterval: defaultRetryInitialInterval,
+			MaxInterval:     defaultRetryMaxInterval,
+			MaxAttempts:     defaultRetryMaxAttempts,
+		},
+		TTL: TTLConfig{
+			CleanupInterval: defaultTTLCleanupInterval,
+		},
+		Migration: MigrationConfig{
+			FailClosed: true,
+		},
+		SQLite: SQLiteConfig{
+			JournalMode: defaultSQLiteJournalMode,
+			BusyTimeout: defaultSQLiteBusyTimeout,
+			Synchronous: defaultSQLiteSynchronous,
+		},
+		UsageMetricsInterval: defaultUsageMetricsInterval,
+		Cache:                storagecache.NewDefaultConfig(),
 	}
 }
 
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration && !windows
+// +build integration,!windows
+
+package dbstorage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+func TestConformancePostgres(t *testing.T) {
+	container := getContainer(t, testcontainers.ContainerRequest{
+		Image: "postgres:15",
+		Env: map[string]string{
+			"POSTGRES_USER":     "otel",
+			"POSTGRES_PASSWORD": "otel",
+			"POSTGRES_DB":       "otel",
+		},
+		ExposedPorts: []string{"5432/tcp"},
+		// The server is restarted once the database is initialized
+		WaitingFor: wait.ForLog("database system is ready to accept connections").
+			WithOccurrence(2).
+			WithStartupTimeout(2 * time.Minute),
+	})
+
+	endpoint, err := container.PortEndpoint(context.Background(), "5432/tcp", "")
+	require.NoError(t, err)
+	testConformance(t, postgresDriverName, fmt.Sprintf("postgres://otel:otel@%s/otel?sslmode=disable", endpoint))
+}
+
+func TestConformanceMySQL(t *testing.T) {
+	container := getContainer(t, testcontainers.ContainerRequest{
+		Image: "mysql:8.0",
+		Env: map[string]string{
+			"MYSQL_ROOT_PASSWORD": "otel",
+			"MYSQL_USER":          "otel",
+			"MYSQL_PASSWORD":      "otel",
+			"MYSQL_DATABASE":      "otel",
+		},
+		ExposedPorts: []string{"3306/tcp"},
+		// The server is restarted once the database is initialized
+		WaitingFor: wait.ForLog("ready for connections").
+			WithOccurrence(2).
+			WithStartupTimeout(2 * time.Minute),
+	})
+
+	endpoint, err := container.PortEndpoint(context.Background(), "3306/tcp", "")
+	require.NoError(t, err)
+	testConformance(t, mysqlDriverName, fmt.Sprintf("otel:otel@tcp(%s)/otel", endpoint))
+}
+
+func getContainer(t *testing.T, req testcontainers.ContainerRequest) testcontainers.Container {
+	require.NoError(t, req.Validate())
+	container, err := testcontainers.GenericContainer(
+		context.Background(),
+		testcontainers.GenericContainerRequest{
+			ContainerRequest: req,
+			Started:          true,
+		})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(context.Background()))
+	})
+	return container
+}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/dbstorage"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+)
+
+const (
+	rowCountQueryText = "select count(*) from %s"
+
+	sqliteSizeQueryText   = "select page_count * page_size from pragma_page_count(), pragma_page_size()"
+	postgresSizeQueryText = "select pg_database_size(current_database())"
+	mysqlSizeQueryText    = "select coalesce(sum(data_length + index_length), 0) from information_schema.tables where table_schema = database()"
+)
+
+var (
+	tagComponentKey, _ = tag.NewKey("component")
+	tagOperationKey, _ = tag.NewKey("operation")
+
+	mOperationLatency = stats.Float64("dbstorage_operation_latency", "Latency of storage operations", stats.UnitMilliseconds)
+	mOperationErrors  = stats.Int64("dbstorage_operation_errors", "Number of storage operations that failed", stats.UnitDimensionless)
+	mRows             = stats.Int64("dbstorage_rows", "Number of records stored by a component", stats.UnitDimensionless)
+	mDatabaseSize     = stats.Int64("dbstorage_database_size", "Size of the database", stats.UnitBytes)
+)
+
+// MetricViews returns the metrics views related to the database storage.
+func MetricViews() []*view.View {
+	operationTagKeys := []tag.Key{tagComponentKey, tagOperationKey}
+	return []*view.View{
+		{
+			Name:        buildCustomMetricName(mOperationLatency.Name()),
+			Measure:     mOperationLatency,
+			Description: mOperationLatency.Description(),
+			TagKeys:     operationTagKeys,
+			Aggregation: view.Distribution(0, 1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000),
+		},
+		{
+			Name:        buildCustomMetricName(mOperationErrors.Name()),
+			Measure:     mOperationErrors,
+			Description: mOperationErrors.Description(),
+			TagKeys:     operationTagKeys,
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        buildCustomMetricName(mRows.Name()),
+			Measure:     mRows,
+			Description: mRows.Description(),
+			TagKeys:     []tag.Key{tagComponentKey},
+			Aggregation: view.LastValue(),
+		},
+		{
+			Name:        buildCustomMetricName(mDatabaseSize.Name()),
+			Measure:     mDatabaseSize,
+			Description: mDatabaseSize.Description(),
+			Aggregation: view.LastValue(),
+		},
+	}
+}
+
+func buildCustomMetricName(metric string) string {
+	return fmt.Sprintf("extension/%s/%s", typeStr, metric)
+}
+
+// recordOperation records the latency and the outcome of a storage operation of a component
+func recordOperation(ctx context.Context, component string, operation string, start time.Time, err error) {
+	mutators := []tag.Mutator{tag.Upsert(tagComponentKey, component), tag.Upsert(tagOperationKey, operation)}
+	measurements := []stats.Measurement{mOperationLatency.M(float64(time.Since(start)) / float64(time.Millisecond))}
+	if err != nil {
+		measurements = append(measurements, mOperationErrors.M(1))
+	}
+	_ = stats.RecordWithTags(ctx, mutators, measurements...)
+}
+
+// databaseSizeQuery returns the query computing the size of the database in bytes, if the driver supports one
+func databaseSizeQuery(driverName string) (string, bool) {
+	switch driverName {
+	case sqliteDriverName:
+		return sqliteSizeQueryText, true
+	case postgresDriverName:
+		return postgresSizeQueryText, true
+	case mysqlDriverName:
+		return mysqlSizeQueryText, true
+	default:
+		return "", false
+	}
+}
+
+// recordUsage records the number of records of each client and the size of the database
+func (ds *databaseStorage) recordUsage(ctx context.Context) {
+	ds.mu.Lock()
+	tables := make([]string, 0, len(ds.tables))
+	for table := range ds.tables {
+		tables = append(tables, table)
+	}
+	ds.mu.Unlock()
+
+	for _, table := range tables {
+		var rows int64
+		if err := ds.db.QueryRowContext(ctx, fmt.Sprintf(rowCountQueryText, table)).Scan(&rows); err != nil {
+			ds.logger.Debug("failed to count records", zap.String("table", table), zap.Error(err))
+			continue
+		}
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(tagComponentKey, table)}, mRows.M(rows))
+	}
+
+	query, ok := databaseSizeQuery(ds.driverName)
+	if !ok {
+		return
+	}
+	var size int64
+	if err := ds.db.QueryRowContext(ctx, query).Scan(&size); err != nil {
+		ds.logger.Debug("failed to compute database size", zap.Error(err))
+		return
+	}
+	stats.Record(ctx, mDatabaseSize.M(size))
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Skip tests on Windows temporarily, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/11451
+//go:build !windows
+// +build !windows
+
+package dbstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func TestMetricViews(t *testing.T) {
+	expectedViewNames := []string{
+		"extension/db_storage/dbstorage_operation_latency",
+		"extension/db_storage/dbstorage_operation_errors",
+		"extension/db_storage/dbstorage_rows",
+		"extension/db_storage/dbstorage_database_size",
+	}
+
+	views := MetricViews()
+	require.Len(t, views, len(expectedViewNames))
+	for i, viewName := range expectedViewNames {
+		assert.Equal(t, viewName, views[i].Name)
+	}
+}
+
+func TestRecordOperation(t *testing.T) {
+	resetViews(t)
+
+	ctx := context.Background()
+	recordOperation(ctx, "receiver_nop_metrics", "set", time.Now(), nil)
+	recordOperation(ctx, "receiver_nop_metrics", "set", time.Now(), errors.New("failed"))
+
+	rows, err := view.RetrieveData(buildCustomMetricName(mOperationLatency.Name()))
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, int64(2), rows[0].Data.(*view.DistributionData).Count)
+	assert.ElementsMatch(t, []tag.Tag{
+		{Key: tagComponentKey, Value: "receiver_nop_metrics"},
+		{Key: tagOperationKey, Value: "set"},
+	}, rows[0].Tags)
+
+	rows, err = view.RetrieveData(buildCustomMetricName(mOperationErrors.Name()))
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, float64(1), rows[0].Data.(*view.SumData).Value)
+}
+
+func TestRecordUsage(t *testing.T) {
+	resetViews(t)
+
+	ctx := context.Background()
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.DriverName = "sqlite3"
+	cfg.DataSource = fmt.Sprintf("file:%s/foo.db?_busy_timeout=10000&_journal=WAL&_sync=NORMAL", t.TempDir())
+
+	ext, err := f.CreateExtension(ctx, componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+	se := ext.(*databaseStorage)
+	require.NoError(t, se.Start(ctx, componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, se.Shutdown(ctx))
+	}()
+
+	client, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("usage"), "")
+	require.NoError(t, err)
+	defer client.Close(ctx)
+	require.NoError(t, client.Set(ctx, "a", []byte("1")))
+	require.NoError(t, client.Set(ctx, "b", []byte("2")))
+
+	resetViews(t)
+	se.recordUsage(ctx)
+
+	rows, err := view.RetrieveData(buildCustomMetricName(mRows.Name()))
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []tag.Tag{{Key: tagComponentKey, Value: "receiver_nop_usage"}}, rows[0].Tags)
+	assert.Equal(t, float64(2), rows[0].Data.(*view.LastValueData).Value)
+
+	rows, err = view.RetrieveData(buildCustomMetricName(mDatabaseSize.Name()))
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Greater(t, rows[0].Data.(*view.LastValueData).Value, float64(0))
+}
+
+// resetViews discards the data recorded by the views of previous tests
+func resetViews(t *testing.T) {
+	views := MetricViews()
+	view.Unregister(views...)
+	require.NoError(t, view.Register(views...))
+	t.Cleanup(func() {
+		view.Unregister(views...)
+	})
+}
@@ -0,0 +1,233 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/dbstorage"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	createVersionsTable = "create table if not exists dbstorage_schema_versions (table_name %s primary key, version integer not null)"
+	selectVersion       = "select version from dbstorage_schema_versions where table_name=?"
+	selectVersions      = "select table_name from dbstorage_schema_versions"
+	setVersion          = "insert into dbstorage_schema_versions(table_name, version) values(?,?) %s"
+
+	selectKey       = "select %s from %s limit 1"
+	selectUpdatedAt = "select updated_at from %s limit 1"
+
+	createTableV1     = "create table if not exists %s (%s %s primary key, value %s)"
+	addUpdatedAt      = "alter table %s add column updated_at bigint"
+	backfillUpdatedAt = "update %s set updated_at=? where updated_at is null"
+)
+
+// migration upgrades the schema of a client table from the previous version
+type migration struct {
+	version     int
+	description string
+	up          func(ctx context.Context, tx *sql.Tx, d dialect, tableName string) error
+}
+
+// migrations lists every schema version of the client tables, in order.
+// Migrations must never be modified once released: format changes require a new migration.
+var migrations = []migration{
+	{
+		version:     1,
+		description: "create the table",
+		up: func(ctx context.Context, tx *sql.Tx, d dialect, tableName string) error {
+			_, err := tx.ExecContext(ctx, fmt.Sprintf(createTableV1, tableName, d.keyColumn, d.keyType, d.valueType))
+			return err
+		},
+	},
+	{
+		version:     2,
+		description: "add the updated_at column used to expire records",
+		up: func(ctx context.Context, tx *sql.Tx, d dialect, tableName string) error {
+			if _, err := tx.ExecContext(ctx, fmt.Sprintf(addUpdatedAt, tableName)); err != nil {
+				return err
+			}
+			// Existing records are considered updated now, so that they are not expired right away.
+			if _, err := tx.ExecContext(ctx, d.rebind(fmt.Sprintf(backfillUpdatedAt, tableName)), time.Now().UnixNano()); err != nil {
+				return err
+			}
+			_, err := tx.ExecContext(ctx, fmt.Sprintf(d.createIndex, tableName, "updated_at"))
+			return err
+		},
+	},
+}
+
+// latestSchemaVersion is the schema version expected by the clients
+var latestSchemaVersion = migrations[len(migrations)-1].version
+
+// migrator upgrades the schema of the client tables to the latest version
+type migrator struct {
+	db      *sql.DB
+	dialect dialect
+	cfg     MigrationConfig
+	logger  *zap.Logger
+}
+
+// migrateAll upgrades every table whose schema version is recorded
+func (m *migrator) migrateAll(ctx context.Context) error {
+	if _, err := m.db.ExecContext(ctx, m.createVersionsTableQuery()); err != nil {
+		return err
+	}
+
+	tables, err := m.tables(ctx)
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err = m.migrate(ctx, table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tables returns every client table whose schema version is recorded, including the tables
+// of the components that are no longer configured
+func (m *migrator) tables(ctx context.Context) ([]string, error) {
+	rows, err := m.db.QueryContext(ctx, selectVersions)
+	if err != nil {
+		return nil, err
+	}
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err = rows.Scan(&table); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	if err = rows.Close(); err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+// migrate upgrades the schema of the table to the latest version.
+// If the schema cannot be upgraded, an error is returned only when failing closed.
+func (m *migrator) migrate(ctx context.Context, tableName string) error {
+	if _, err := m.db.ExecContext(ctx, m.createVersionsTableQuery()); err != nil {
+		return err
+	}
+
+	version, recorded, err := m.schemaVersion(ctx, tableName)
+	if err != nil {
+		return err
+	}
+
+	if version > latestSchemaVersion {
+		return m.fail(fmt.Errorf("table %s has schema version %d, which is newer than the latest supported version %d", tableName, version, latestSchemaVersion))
+	}
+
+	pending := migrations[version:]
+	if m.cfg.DryRun {
+		for _, mig := range pending {
+			m.logger.Info("Pending schema migration",
+				zap.String("table", tableName), zap.Int("version", mig.version), zap.String("description", mig.description))
+		}
+		if len(pending) > 0 {
+			return m.fail(fmt.Errorf("table %s has %d pending schema migrations, which are not applied in dry run mode", tableName, len(pending)))
+		}
+		return nil
+	}
+
+	for _, mig := range pending {
+		if err = m.apply(ctx, tableName, mig); err != nil {
+			return m.fail(fmt.Errorf("failed to migrate table %s to schema version %d: %w", tableName, mig.version, err))
+		}
+		m.logger.Info("Migrated schema",
+			zap.String("table", tableName), zap.Int("version", mig.version), zap.String("description", mig.description))
+	}
+
+	if len(pending) == 0 && !recorded {
+		_, err = m.db.ExecContext(ctx, m.setVersionQuery(), tableName, version)
+		return err
+	}
+	return nil
+}
+
+// fail returns the error when failing closed, and logs it otherwise
+func (m *migrator) fail(err error) error {
+	if m.cfg.FailClosed {
+		return err
+	}
+	m.logger.Warn("Using table without the latest schema", zap.Error(err))
+	return nil
+}
+
+// apply runs a migration and records the new schema version within a single transaction
+func (m *migrator) apply(ctx context.Context, tableName string, mig migration) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err = mig.up(ctx, tx, m.dialect, tableName); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, m.setVersionQuery(), tableName, mig.version); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// schemaVersion returns the schema version of the table, and whether that version is recorded.
+// The version of the tables created before schemas were versioned is inferred from their columns.
+func (m *migrator) schemaVersion(ctx context.Context, tableName string) (int, bool, error) {
+	var version int
+	err := m.db.QueryRowContext(ctx, m.dialect.rebind(selectVersion), tableName).Scan(&version)
+	switch {
+	case err == nil:
+		return version, true, nil
+	case !errors.Is(err, sql.ErrNoRows):
+		return 0, false, err
+	}
+
+	switch {
+	case !m.queryable(ctx, fmt.Sprintf(selectKey, m.dialect.keyColumn, tableName)):
+		return 0, false, nil
+	case !m.queryable(ctx, fmt.Sprintf(selectUpdatedAt, tableName)):
+		return 1, false, nil
+	default:
+		return 2, false, nil
+	}
+}
+
+func (m *migrator) createVersionsTableQuery() string {
+	return fmt.Sprintf(createVersionsTable, m.dialect.keyType)
+}
+
+func (m *migrator) setVersionQuery() string {
+	return m.dialect.rebind(fmt.Sprintf(setVersion, m.dialect.upsert("table_name", "version")))
+}
+
+// queryable returns true if the query can be executed
+func (m *migrator) queryable(ctx context.Context, query string) bool {
+	rows, err := m.db.QueryContext(ctx, query)
+	if err != nil {
+		return false
+	}
+	return rows.Close() == nil
+}
@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Skip tests on Windows temporarily, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/11451
+//go:build !windows
+// +build !windows
+
+package dbstorage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.uber.org/zap"
+)
+
+func TestMigrateNewTable(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	m := &migrator{db: db, dialect: sqliteDialect, cfg: MigrationConfig{FailClosed: true}, logger: zap.NewNop()}
+	require.NoError(t, m.migrate(ctx, "fresh"))
+	assert.Equal(t, latestSchemaVersion, recordedVersion(t, db, "fresh"))
+
+	// migrating again is a no-op
+	require.NoError(t, m.migrate(ctx, "fresh"))
+	assert.Equal(t, latestSchemaVersion, recordedVersion(t, db, "fresh"))
+}
+
+func TestMigrateLegacyTable(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	_, err := db.Exec("create table legacy (key text primary key, value blob)")
+	require.NoError(t, err)
+	_, err = db.Exec("insert into legacy(key, value) values('key', 'value')")
+	require.NoError(t, err)
+
+	m := &migrator{db: db, dialect: sqliteDialect, cfg: MigrationConfig{FailClosed: true}, logger: zap.NewNop()}
+	require.NoError(t, m.migrate(ctx, "legacy"))
+	assert.Equal(t, latestSchemaVersion, recordedVersion(t, db, "legacy"))
+
+	client, err := newClient(ctx, db, sqliteDialect, "legacy", time.Second, RetryConfig{}, nil)
+	require.NoError(t, err)
+	defer client.Close(ctx)
+
+	// existing records are considered updated when the column is added
+	deleted, err := expire(ctx, db, sqliteDialect, "legacy", time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	deleted, err = expire(ctx, db, sqliteDialect, "legacy", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, deleted)
+}
+
+func TestMigrateDryRun(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	_, err := db.Exec("create table legacy (key text primary key, value blob)")
+	require.NoError(t, err)
+
+	m := &migrator{db: db, dialect: sqliteDialect, cfg: MigrationConfig{DryRun: true, FailClosed: true}, logger: zap.NewNop()}
+	require.ErrorContains(t, m.migrate(ctx, "legacy"), "1 pending schema migrations")
+
+	m.cfg.FailClosed = false
+	require.NoError(t, m.migrate(ctx, "legacy"))
+
+	// nothing is applied nor recorded
+	_, err = db.Exec("select updated_at from legacy")
+	require.Error(t, err)
+	err = db.QueryRow(selectVersion, "legacy").Scan(new(int))
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestMigrateNewerVersion(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	m := &migrator{db: db, dialect: sqliteDialect, cfg: MigrationConfig{FailClosed: true}, logger: zap.NewNop()}
+	require.NoError(t, m.migrate(ctx, "future"))
+	_, err := db.Exec(m.setVersionQuery(), "future", latestSchemaVersion+1)
+	require.NoError(t, err)
+
+	require.ErrorContains(t, m.migrate(ctx, "future"), "newer than the latest supported version")
+
+	m.cfg.FailClosed = false
+	require.NoError(t, m.migrate(ctx, "future"))
+	assert.Equal(t, latestSchemaVersion+1, recordedVersion(t, db, "future"))
+}
+
+func TestExtensionMigratesOnStart(t *testing.T) {
+	ctx := context.Background()
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.DriverName = "sqlite3"
+	cfg.DataSource = fmt.Sprintf("file:%s/foo.db?_busy_timeout=10000&_journal=WAL&_sync=NORMAL", t.TempDir())
+
+	db, err := sql.Open(cfg.DriverName, cfg.DataSource)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// a table recorded by a previous version of the extension
+	m := &migrator{db: db, dialect: sqliteDialect, cfg: cfg.Migration, logger: zap.NewNop()}
+	_, err = db.Exec(m.createVersionsTableQuery())
+	require.NoError(t, err)
+	require.NoError(t, m.apply(ctx, "receiver_nop_old", migrations[0]))
+
+	ext, err := f.CreateExtension(ctx, componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, ext.Start(ctx, componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, ext.Shutdown(ctx))
+	}()
+
+	assert.Equal(t, latestSchemaVersion, recordedVersion(t, db, "receiver_nop_old"))
+}
+
+// migrateTestTable upgrades the table to the latest schema version
+func migrateTestTable(t *testing.T, db *sql.DB, tableName string) {
+	m := &migrator{db: db, dialect: sqliteDialect, cfg: MigrationConfig{FailClosed: true}, logger: zap.NewNop()}
+	require.NoError(t, m.migrate(context.Background(), tableName))
+}
+
+func newTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s/foo.db?_busy_timeout=10000&_journal=WAL&_sync=NORMAL", t.TempDir()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	return db
+}
+
+func recordedVersion(t *testing.T, db *sql.DB, tableName string) int {
+	var version int
+	require.NoError(t, db.QueryRow(selectVersion, tableName).Scan(&version))
+	return version
+}
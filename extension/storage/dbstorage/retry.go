@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/dbstorage"
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/mattn/go-sqlite3"
+)
+
+// withRetry calls fn until it succeeds, returns a non-transient error, or the
+// retry attempts are exhausted. The wait between attempts grows exponentially.
+func withRetry(ctx context.Context, cfg RetryConfig, fn func(ctx context.Context) error) error {
+	interval := cfg.InitialInterval
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || !cfg.Enabled || attempt >= cfg.MaxAttempts || !isTransient(err) {
+			return err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > cfg.MaxInterval {
+			interval = cfg.MaxInterval
+		}
+	}
+}
+
+// isTransient reports whether err is likely to go away if the operation is retried,
+// such as a locked SQLite database or a dropped Postgres connection.
+func isTransient(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) {
+		return true
+	}
+
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "40001", // serialization_failure
+			"40P01", // deadlock_detected
+			"53300", // too_many_connections
+			"57P03": // cannot_connect_now
+			return true
+		}
+		// Class 08 - Connection Exception
+		return strings.HasPrefix(pgErr.Code, "08")
+	}
+
+	return false
+}
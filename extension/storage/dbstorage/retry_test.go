@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Skip tests on Windows temporarily, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/11451
+//go:build !windows
+// +build !windows
+
+package dbstorage
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "bad connection", err: driver.ErrBadConn, want: true},
+		{name: "wrapped bad connection", err: fmt.Errorf("exec: %w", driver.ErrBadConn), want: true},
+		{name: "sqlite busy", err: sqlite3.Error{Code: sqlite3.ErrBusy}, want: true},
+		{name: "sqlite locked", err: sqlite3.Error{Code: sqlite3.ErrLocked}, want: true},
+		{name: "sqlite constraint", err: sqlite3.Error{Code: sqlite3.ErrConstraint}, want: false},
+		{name: "postgres serialization failure", err: &pgconn.PgError{Code: "40001"}, want: true},
+		{name: "postgres connection failure", err: &pgconn.PgError{Code: "08006"}, want: true},
+		{name: "postgres syntax error", err: &pgconn.PgError{Code: "42601"}, want: false},
+		{name: "other", err: errors.New("boom"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isTransient(tt.err))
+		})
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	cfg := RetryConfig{
+		Enabled:         true,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     2 * time.Millisecond,
+		MaxAttempts:     3,
+	}
+
+	t.Run("succeeds after transient errors", func(t *testing.T) {
+		attempts := 0
+		err := withRetry(context.Background(), cfg, func(context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return driver.ErrBadConn
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		attempts := 0
+		err := withRetry(context.Background(), cfg, func(context.Context) error {
+			attempts++
+			return driver.ErrBadConn
+		})
+		assert.ErrorIs(t, err, driver.ErrBadConn)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("does not retry permanent errors", func(t *testing.T) {
+		attempts := 0
+		err := withRetry(context.Background(), cfg, func(context.Context) error {
+			attempts++
+			return errors.New("boom")
+		})
+		assert.Error(t, err)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("does not retry when disabled", func(t *testing.T) {
+		attempts := 0
+		err := withRetry(context.Background(), RetryConfig{}, func(context.Context) error {
+			attempts++
+			return driver.ErrBadConn
+		})
+		assert.ErrorIs(t, err, driver.ErrBadConn)
+		assert.Equal(t, 1, attempts)
+	})
+}
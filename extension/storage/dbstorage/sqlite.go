@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/dbstorage"
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const sqliteDriverName = "sqlite3"
+
+var (
+	sqliteJournalModes      = []string{"DELETE", "TRUNCATE", "PERSIST", "MEMORY", "WAL", "OFF"}
+	sqliteSynchronousLevels = []string{"OFF", "NORMAL", "FULL", "EXTRA"}
+)
+
+// sqliteDataSource adds the connection parameters of the SQLite options to the datasource.
+// Parameters already set in the datasource, under their name or alias, take precedence.
+func sqliteDataSource(dataSource string, cfg SQLiteConfig) (string, error) {
+	var rawQuery string
+	if i := strings.IndexByte(dataSource, '?'); i >= 0 {
+		rawQuery = dataSource[i+1:]
+	}
+	params, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", fmt.Errorf("invalid datasource parameters: %w", err)
+	}
+
+	var added []string
+	addParam := func(value string, names ...string) {
+		if value == "" {
+			return
+		}
+		for _, name := range names {
+			if params.Has(name) {
+				return
+			}
+		}
+		added = append(added, names[0]+"="+url.QueryEscape(value))
+	}
+	addParam(strings.ToUpper(cfg.JournalMode), "_journal_mode", "_journal")
+	if cfg.BusyTimeout > 0 {
+		addParam(strconv.FormatInt(cfg.BusyTimeout.Milliseconds(), 10), "_busy_timeout", "_timeout")
+	}
+	addParam(strings.ToUpper(cfg.Synchronous), "_synchronous", "_sync")
+
+	if len(added) == 0 {
+		return dataSource, nil
+	}
+	separator := "?"
+	if strings.Contains(dataSource, "?") {
+		separator = "&"
+	}
+	return dataSource + separator + strings.Join(added, "&"), nil
+}
+
+// validSQLiteOption returns true if the option is empty or one of the allowed values, ignoring case
+func validSQLiteOption(value string, allowed []string) bool {
+	if value == "" {
+		return true
+	}
+	for _, a := range allowed {
+		if strings.EqualFold(value, a) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Skip tests on Windows temporarily, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/11451
+//go:build !windows
+// +build !windows
+
+package dbstorage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func TestSQLiteDataSource(t *testing.T) {
+	tuned := SQLiteConfig{JournalMode: "wal", BusyTimeout: 10 * time.Second, Synchronous: "normal"}
+	tests := []struct {
+		name       string
+		dataSource string
+		cfg        SQLiteConfig
+		expected   string
+	}{
+		{
+			name:       "no options",
+			dataSource: "foo.db",
+			expected:   "foo.db",
+		},
+		{
+			name:       "no parameters",
+			dataSource: "foo.db",
+			cfg:        tuned,
+			expected:   "foo.db?_journal_mode=WAL&_busy_timeout=10000&_synchronous=NORMAL",
+		},
+		{
+			name:       "other parameters",
+			dataSource: "file:foo.db?cache=shared",
+			cfg:        tuned,
+			expected:   "file:foo.db?cache=shared&_journal_mode=WAL&_busy_timeout=10000&_synchronous=NORMAL",
+		},
+		{
+			name:       "parameters set in the datasource",
+			dataSource: "foo.db?_journal=DELETE&_busy_timeout=500",
+			cfg:        tuned,
+			expected:   "foo.db?_journal=DELETE&_busy_timeout=500&_synchronous=NORMAL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := sqliteDataSource(tt.dataSource, tt.cfg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+
+	_, err := sqliteDataSource("foo.db?%zz", tuned)
+	assert.Error(t, err)
+}
+
+func TestExtensionSQLiteOptions(t *testing.T) {
+	ctx := context.Background()
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.DriverName = "sqlite3"
+	dbPath := filepath.Join(t.TempDir(), "foo.db")
+	cfg.DataSource = fmt.Sprintf("file:%s", dbPath)
+	cfg.SQLite.VacuumOnStart = true
+
+	ext, err := f.CreateExtension(ctx, componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+	se := ext.(*databaseStorage)
+	require.NoError(t, se.Start(ctx, componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, se.Shutdown(ctx))
+	}()
+
+	var journalMode, synchronous string
+	require.NoError(t, se.db.QueryRowContext(ctx, "pragma journal_mode").Scan(&journalMode))
+	assert.Equal(t, "wal", journalMode)
+	// 1 is NORMAL
+	require.NoError(t, se.db.QueryRowContext(ctx, "pragma synchronous").Scan(&synchronous))
+	assert.Equal(t, "1", synchronous)
+
+	client, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("sqlite"), "")
+	require.NoError(t, err)
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	require.NoError(t, client.Close(ctx))
+
+	_, err = os.Stat(dbPath + "-wal")
+	assert.NoError(t, err)
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 	"syscall"
 	"time"
@@ -46,6 +47,8 @@ type fileStorageClient struct {
 	openTimeout     time.Duration
 	cancel          context.CancelFunc
 	closed          bool
+	name            string
+	lastCompaction  time.Time
 }
 
 func bboltOptions(timeout time.Duration) *bbolt.Options {
@@ -73,8 +76,15 @@ func newClient(logger *zap.Logger, filePath string, timeout time.Duration, compa
 		return nil, err
 	}
 
-	client := &fileStorageClient{logger: logger, db: db, compactionCfg: compactionCfg, openTimeout: timeout}
-	if compactionCfg.OnRebound {
+	client := &fileStorageClient{
+		logger:         logger,
+		db:             db,
+		compactionCfg:  compactionCfg,
+		openTimeout:    timeout,
+		name:           filepath.Base(filePath),
+		lastCompaction: time.Now(),
+	}
+	if compactionCfg.online() {
 		client.startCompactionLoop(context.Background())
 	}
 
@@ -248,6 +258,30 @@ func (c *fileStorageClient) Compact(compactionDirectory string, timeout time.Dur
 	return nil
 }
 
+// compact runs the compaction and records its outcome, the trigger describes what caused it
+func (c *fileStorageClient) compact(ctx context.Context, trigger string) error {
+	c.compactionMutex.RLock()
+	sizeBefore, _, sizeErr := c.getDbSize()
+	c.compactionMutex.RUnlock()
+
+	start := time.Now()
+	err := c.Compact(c.compactionCfg.Directory, c.openTimeout, c.compactionCfg.MaxTransactionSize)
+
+	var reclaimed int64
+	c.compactionMutex.Lock()
+	c.lastCompaction = time.Now()
+	if err == nil && sizeErr == nil && !c.closed {
+		if sizeAfter, _, afterErr := c.getDbSize(); afterErr == nil {
+			reclaimed = sizeBefore - sizeAfter
+			recordDatabaseSize(ctx, c.name, sizeAfter)
+		}
+	}
+	c.compactionMutex.Unlock()
+	recordCompaction(ctx, c.name, trigger, start, reclaimed, err)
+
+	return err
+}
+
 // startCompactionLoop provides asynchronous compaction function
 func (c *fileStorageClient) startCompactionLoop(ctx context.Context) {
 	ctx, c.cancel = context.WithCancel(ctx)
@@ -262,11 +296,12 @@ func (c *fileStorageClient) startCompactionLoop(ctx context.Context) {
 		for {
 			select {
 			case <-compactionTicker.C:
-				if c.shouldCompact() {
-					err := c.Compact(c.compactionCfg.Directory, c.openTimeout, c.compactionCfg.MaxTransactionSize)
+				if trigger := c.compactionTrigger(ctx); trigger != "" {
+					err := c.compact(ctx, trigger)
 					if err != nil {
 						c.logger.Error("compaction failure",
 							zap.String(directoryKey, c.compactionCfg.Directory),
+							zap.String("trigger", trigger),
 							zap.Error(err))
 					}
 				}
@@ -278,15 +313,39 @@ func (c *fileStorageClient) startCompactionLoop(ctx context.Context) {
 	}()
 }
 
-// shouldCompact checks whether the conditions for online compaction are met
-func (c *fileStorageClient) shouldCompact() bool {
-	if !c.compactionCfg.OnRebound {
-		return false
+// compactionTrigger checks whether the conditions for online compaction are met
+// and returns the trigger that caused it, or an empty string if compaction is not needed
+func (c *fileStorageClient) compactionTrigger(ctx context.Context) string {
+	c.compactionMutex.RLock()
+	if c.closed {
+		c.compactionMutex.RUnlock()
+		return ""
+	}
+	if c.compactionCfg.ScheduleInterval > 0 && time.Since(c.lastCompaction) >= c.compactionCfg.ScheduleInterval {
+		c.compactionMutex.RUnlock()
+		return triggerSchedule
 	}
-
 	totalSizeBytes, dataSizeBytes, err := c.getDbSize()
+	c.compactionMutex.RUnlock()
 	if err != nil {
 		c.logger.Error("failed to get db size", zap.Error(err))
+		return ""
+	}
+	recordDatabaseSize(ctx, c.name, totalSizeBytes)
+
+	switch {
+	case c.shouldCompact(totalSizeBytes, dataSizeBytes):
+		return triggerRebound
+	case c.shouldCompactOnSize(totalSizeBytes, dataSizeBytes):
+		return triggerSize
+	default:
+		return ""
+	}
+}
+
+// shouldCompact checks whether the conditions for rebound compaction are met
+func (c *fileStorageClient) shouldCompact(totalSizeBytes, dataSizeBytes int64) bool {
+	if !c.compactionCfg.OnRebound {
 		return false
 	}
 
@@ -306,6 +365,17 @@ func (c *fileStorageClient) shouldCompact() bool {
 	return true
 }
 
+// shouldCompactOnSize checks whether the allocated size exceeds the configured threshold
+// and there is enough empty space to be reclaimed
+func (c *fileStorageClient) shouldCompactOnSize(totalSizeBytes, dataSizeBytes int64) bool {
+	if c.compactionCfg.SizeThresholdMiB <= 0 {
+		return false
+	}
+
+	return totalSizeBytes >= c.compactionCfg.SizeThresholdMiB*oneMiB &&
+		totalSizeBytes-dataSizeBytes >= c.compactionCfg.SizeReclaimableThresholdMiB*oneMiB
+}
+
 func (c *fileStorageClient) getDbSize() (totalSizeResult int64, dataSizeResult int64, errResult error) {
 	var totalSize int64
 
@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.etcd.io/bbolt"
 	"go.opentelemetry.io/collector/extension/experimental/storage"
@@ -285,6 +286,81 @@ func TestClientReboundCompaction(t *testing.T) {
 	)
 }
 
+func TestClientSizeCompaction(t *testing.T) {
+	tempDir := t.TempDir()
+	dbFile := filepath.Join(tempDir, "my_db")
+
+	logger, _ := zap.NewDevelopment()
+	client, err := newClient(logger, dbFile, time.Second, &CompactionConfig{
+		Directory:                   tempDir,
+		CheckInterval:               100 * time.Millisecond,
+		SizeThresholdMiB:            2,
+		SizeReclaimableThresholdMiB: 1,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(context.TODO()))
+	})
+
+	ctx := context.Background()
+	entrySize := int64(1048576)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, client.Set(ctx, fmt.Sprintf("foo-%d", i), make([]byte, entrySize)))
+	}
+	for i := 0; i < 5; i++ {
+		require.NoError(t, client.Delete(ctx, fmt.Sprintf("foo-%d", i)))
+	}
+
+	require.Eventually(t,
+		func() bool {
+			client.compactionMutex.Lock()
+			defer client.compactionMutex.Unlock()
+
+			totalSize, _, dbErr := client.getDbSize()
+			require.NoError(t, dbErr)
+			return totalSize < entrySize
+		},
+		10*time.Second, 5*time.Millisecond, "database cleaned up not used space",
+	)
+}
+
+func TestClientCompactionTrigger(t *testing.T) {
+	tempDir := t.TempDir()
+	dbFile := filepath.Join(tempDir, "my_db")
+
+	client, err := newClient(zap.NewNop(), dbFile, time.Second, &CompactionConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(context.TODO()))
+	})
+
+	ctx := context.Background()
+	assert.Equal(t, "", client.compactionTrigger(ctx))
+
+	client.compactionCfg.ScheduleInterval = time.Hour
+	assert.Equal(t, "", client.compactionTrigger(ctx))
+
+	client.lastCompaction = time.Now().Add(-2 * time.Hour)
+	assert.Equal(t, triggerSchedule, client.compactionTrigger(ctx))
+
+	require.NoError(t, client.compact(ctx, triggerSchedule))
+	assert.Equal(t, "", client.compactionTrigger(ctx))
+}
+
+func TestClientShouldCompactOnSize(t *testing.T) {
+	client := &fileStorageClient{compactionCfg: &CompactionConfig{
+		SizeThresholdMiB:            10,
+		SizeReclaimableThresholdMiB: 2,
+	}}
+
+	assert.False(t, client.shouldCompactOnSize(5*oneMiB, oneMiB))
+	assert.False(t, client.shouldCompactOnSize(10*oneMiB, 9*oneMiB))
+	assert.True(t, client.shouldCompactOnSize(10*oneMiB, 8*oneMiB))
+
+	client.compactionCfg.SizeThresholdMiB = 0
+	assert.False(t, client.shouldCompactOnSize(100*oneMiB, 0))
+}
+
 func TestClientConcurrentCompaction(t *testing.T) {
 	logCore, logObserver := observer.New(zap.DebugLevel)
 	logger := zap.New(logCore)
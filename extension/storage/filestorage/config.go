@@ -22,6 +22,8 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/config"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagecache"
 )
 
 // Config defines configuration for file storage extension.
@@ -32,6 +34,9 @@ type Config struct {
 	Timeout   time.Duration `mapstructure:"timeout,omitempty"`
 
 	Compaction *CompactionConfig `mapstructure:"compaction,omitempty"`
+
+	// Cache specifies the optional cache of the clients, reducing the writes to the files
+	Cache storagecache.Config `mapstructure:"cache"`
 }
 
 // CompactionConfig defines configuration for optional file storage compaction.
@@ -57,6 +62,19 @@ type CompactionConfig struct {
 	MaxTransactionSize int64 `mapstructure:"max_transaction_size,omitempty"`
 	// CheckInterval specifies frequency of compaction check
 	CheckInterval time.Duration `mapstructure:"check_interval,omitempty"`
+	// SizeThresholdMiB specifies the total allocated size (both used and empty) above which compaction
+	// is attempted online, regardless of how much data is still being stored. Zero disables this trigger
+	SizeThresholdMiB int64 `mapstructure:"size_threshold_mib"`
+	// SizeReclaimableThresholdMiB specifies the minimum empty allocated space needed for size triggered
+	// compaction to start, so that it's not repeated when the database is large because of the stored data
+	SizeReclaimableThresholdMiB int64 `mapstructure:"size_reclaimable_threshold_mib"`
+	// ScheduleInterval specifies the period of scheduled online compaction. Zero disables this trigger
+	ScheduleInterval time.Duration `mapstructure:"schedule_interval,omitempty"`
+}
+
+// online returns true if any of the online compaction triggers is set
+func (cfg *CompactionConfig) online() bool {
+	return cfg.OnRebound || cfg.SizeThresholdMiB > 0 || cfg.ScheduleInterval > 0
 }
 
 func (cfg *Config) Validate() error {
@@ -87,9 +105,21 @@ func (cfg *Config) Validate() error {
 		return errors.New("max transaction size for compaction cannot be less than 0")
 	}
 
-	if cfg.Compaction.OnRebound && cfg.Compaction.CheckInterval <= 0 {
-		return errors.New("compaction check interval must be positive when rebound compaction is set")
+	if cfg.Compaction.SizeThresholdMiB < 0 {
+		return errors.New("size threshold for compaction cannot be less than 0")
+	}
+
+	if cfg.Compaction.SizeReclaimableThresholdMiB < 0 {
+		return errors.New("reclaimable size threshold for compaction cannot be less than 0")
+	}
+
+	if cfg.Compaction.ScheduleInterval < 0 {
+		return errors.New("compaction schedule interval cannot be less than 0")
+	}
+
+	if cfg.Compaction.online() && cfg.Compaction.CheckInterval <= 0 {
+		return errors.New("compaction check interval must be positive when online compaction is set")
 	}
 
-	return nil
+	return cfg.Cache.Validate()
 }
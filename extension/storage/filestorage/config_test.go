@@ -25,6 +25,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagecache"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -48,13 +50,22 @@ func TestLoadConfig(t *testing.T) {
 				ExtensionSettings: config.NewExtensionSettings(config.NewComponentID(typeStr)),
 				Directory:         ".",
 				Compaction: &CompactionConfig{
-					Directory:                  ".",
-					OnStart:                    true,
-					OnRebound:                  true,
-					MaxTransactionSize:         2048,
-					ReboundTriggerThresholdMiB: 16,
-					ReboundNeededThresholdMiB:  128,
-					CheckInterval:              time.Second * 5,
+					Directory:                   ".",
+					OnStart:                     true,
+					OnRebound:                   true,
+					MaxTransactionSize:          2048,
+					ReboundTriggerThresholdMiB:  16,
+					ReboundNeededThresholdMiB:   128,
+					CheckInterval:               time.Second * 5,
+					SizeThresholdMiB:            256,
+					SizeReclaimableThresholdMiB: 32,
+					ScheduleInterval:            time.Hour,
+				},
+				Cache: storagecache.Config{
+					Enabled:         true,
+					FlushInterval:   5 * time.Second,
+					MaxDirtyEntries: 100,
+					MaxEntries:      500,
 				},
 				Timeout: 2 * time.Second,
 			},
@@ -103,3 +114,61 @@ func TestHandleProvidingFilePathAsDirWithAnError(t *testing.T) {
 	require.Error(t, err)
 	require.EqualError(t, err, file.Name()+" is not a directory")
 }
+
+func TestCompactionConfigValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(*CompactionConfig)
+		expectedErr string
+	}{
+		{
+			name: "size threshold",
+			modify: func(cfg *CompactionConfig) {
+				cfg.SizeThresholdMiB = 64
+			},
+		},
+		{
+			name: "negative size threshold",
+			modify: func(cfg *CompactionConfig) {
+				cfg.SizeThresholdMiB = -1
+			},
+			expectedErr: "size threshold for compaction cannot be less than 0",
+		},
+		{
+			name: "negative reclaimable size threshold",
+			modify: func(cfg *CompactionConfig) {
+				cfg.SizeReclaimableThresholdMiB = -1
+			},
+			expectedErr: "reclaimable size threshold for compaction cannot be less than 0",
+		},
+		{
+			name: "negative schedule interval",
+			modify: func(cfg *CompactionConfig) {
+				cfg.ScheduleInterval = -time.Second
+			},
+			expectedErr: "compaction schedule interval cannot be less than 0",
+		},
+		{
+			name: "schedule without check interval",
+			modify: func(cfg *CompactionConfig) {
+				cfg.ScheduleInterval = time.Hour
+				cfg.CheckInterval = 0
+			},
+			expectedErr: "compaction check interval must be positive when online compaction is set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			cfg.Directory = "."
+			tt.modify(cfg.Compaction)
+
+			err := cfg.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
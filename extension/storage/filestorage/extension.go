@@ -23,6 +23,8 @@ import (
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagecache"
 )
 
 type localFileStorage struct {
@@ -70,12 +72,16 @@ func (lfs *localFileStorage) GetClient(ctx context.Context, kind component.Kind,
 
 	// return if compaction is not required
 	if lfs.cfg.Compaction.OnStart {
-		compactionErr := client.Compact(lfs.cfg.Compaction.Directory, lfs.cfg.Timeout, lfs.cfg.Compaction.MaxTransactionSize)
+		compactionErr := client.compact(ctx, triggerOnStart)
 		if compactionErr != nil {
 			lfs.logger.Error("compaction on start failed", zap.Error(compactionErr))
 		}
 	}
 
+	if lfs.cfg.Cache.Enabled {
+		return storagecache.NewClient(client, lfs.cfg.Cache, lfs.logger), nil
+	}
+
 	return client, nil
 }
 
@@ -27,6 +27,8 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/extension/experimental/storage"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagecache"
 )
 
 func TestExtensionIntegrity(t *testing.T) {
@@ -151,6 +153,38 @@ func TestClientHandlesSimpleCases(t *testing.T) {
 
 }
 
+func TestClientWithCache(t *testing.T) {
+	ctx := context.Background()
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	cfg.Cache.Enabled = true
+
+	extension, err := f.CreateExtension(ctx, componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+	se, ok := extension.(storage.Extension)
+	require.True(t, ok)
+
+	client, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("my_component"), "")
+	require.NoError(t, err)
+	_, ok = client.(*storagecache.Client)
+	require.True(t, ok)
+
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	require.NoError(t, client.Close(ctx))
+
+	// The cached value was written to the file on close
+	client, err = se.GetClient(ctx, component.KindReceiver, newTestEntity("my_component"), "")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(ctx))
+	})
+
+	data, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), data)
+}
+
 func TestTwoClientsWithDifferentNames(t *testing.T) {
 	ctx := context.Background()
 	se := newTestExtension(t)
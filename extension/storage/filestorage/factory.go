@@ -18,8 +18,11 @@ import (
 	"context"
 	"time"
 
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagecache"
 )
 
 // The value of extension "type" in configuration.
@@ -28,14 +31,17 @@ const typeStr config.Type = "file_storage"
 const (
 	// use default bbolt value
 	// https://github.com/etcd-io/bbolt/blob/d5db64bdbfdee1cb410894605f42ffef898f395d/cmd/bbolt/main.go#L1955
-	defaultMaxTransactionSize         = 65536
-	defaultReboundTriggerThresholdMib = 10
-	defaultReboundNeededThresholdMib  = 100
-	defaultCompactionInterval         = time.Second * 5
+	defaultMaxTransactionSize          = 65536
+	defaultReboundTriggerThresholdMib  = 10
+	defaultReboundNeededThresholdMib   = 100
+	defaultCompactionInterval          = time.Second * 5
+	defaultSizeReclaimableThresholdMib = 10
 )
 
 // NewFactory creates a factory for HostObserver extension.
 func NewFactory() component.ExtensionFactory {
+	_ = view.Register(MetricViews()...)
+
 	return component.NewExtensionFactory(
 		typeStr,
 		createDefaultConfig,
@@ -49,14 +55,16 @@ func createDefaultConfig() config.Extension {
 		ExtensionSettings: config.NewExtensionSettings(config.NewComponentID(typeStr)),
 		Directory:         getDefaultDirectory(),
 		Compaction: &CompactionConfig{
-			Directory:                  getDefaultDirectory(),
-			OnStart:                    false,
-			OnRebound:                  false,
-			MaxTransactionSize:         defaultMaxTransactionSize,
-			ReboundNeededThresholdMiB:  defaultReboundTriggerThresholdMib,
-			ReboundTriggerThresholdMiB: defaultReboundNeededThresholdMib,
-			CheckInterval:              defaultCompactionInterval,
+			Directory:                   getDefaultDirectory(),
+			OnStart:                     false,
+			OnRebound:                   false,
+			MaxTransactionSize:          defaultMaxTransactionSize,
+			ReboundNeededThresholdMiB:   defaultReboundTriggerThresholdMib,
+			ReboundTriggerThresholdMiB:  defaultReboundNeededThresholdMib,
+			CheckInterval:               defaultCompactionInterval,
+			SizeReclaimableThresholdMiB: defaultSizeReclaimableThresholdMib,
 		},
+		Cache:   storagecache.NewDefaultConfig(),
 		Timeout: time.Second,
 	}
 }
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+const (
+	triggerOnStart  = "on_start"
+	triggerRebound  = "rebound"
+	triggerSize     = "size"
+	triggerSchedule = "schedule"
+
+	resultSuccess = "success"
+	resultFailure = "failure"
+)
+
+var (
+	tagComponentKey, _ = tag.NewKey("component")
+	tagTriggerKey, _   = tag.NewKey("trigger")
+	tagResultKey, _    = tag.NewKey("result")
+
+	mCompactions        = stats.Int64("filestorage_compactions", "Number of compactions attempted", stats.UnitDimensionless)
+	mCompactionDuration = stats.Float64("filestorage_compaction_duration", "Duration of compactions", stats.UnitMilliseconds)
+	mReclaimedSize      = stats.Int64("filestorage_compaction_reclaimed_size", "Allocated space reclaimed by compactions", stats.UnitBytes)
+	mDatabaseSize       = stats.Int64("filestorage_database_size", "Total allocated size of the database of a component", stats.UnitBytes)
+)
+
+// MetricViews returns the metrics views related to the file storage.
+func MetricViews() []*view.View {
+	compactionTagKeys := []tag.Key{tagComponentKey, tagTriggerKey, tagResultKey}
+	return []*view.View{
+		{
+			Name:        buildCustomMetricName(mCompactions.Name()),
+			Measure:     mCompactions,
+			Description: mCompactions.Description(),
+			TagKeys:     compactionTagKeys,
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        buildCustomMetricName(mCompactionDuration.Name()),
+			Measure:     mCompactionDuration,
+			Description: mCompactionDuration.Description(),
+			TagKeys:     compactionTagKeys,
+			Aggregation: view.Distribution(0, 10, 50, 100, 500, 1000, 5000, 10000, 30000, 60000, 300000),
+		},
+		{
+			Name:        buildCustomMetricName(mReclaimedSize.Name()),
+			Measure:     mReclaimedSize,
+			Description: mReclaimedSize.Description(),
+			TagKeys:     []tag.Key{tagComponentKey},
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        buildCustomMetricName(mDatabaseSize.Name()),
+			Measure:     mDatabaseSize,
+			Description: mDatabaseSize.Description(),
+			TagKeys:     []tag.Key{tagComponentKey},
+			Aggregation: view.LastValue(),
+		},
+	}
+}
+
+func buildCustomMetricName(metric string) string {
+	return fmt.Sprintf("extension/%s/%s", typeStr, metric)
+}
+
+// recordCompaction records the outcome, the duration and the reclaimed space of a compaction of a component
+func recordCompaction(ctx context.Context, component string, trigger string, start time.Time, reclaimed int64, err error) {
+	result := resultSuccess
+	if err != nil {
+		result = resultFailure
+	}
+	mutators := []tag.Mutator{
+		tag.Upsert(tagComponentKey, component),
+		tag.Upsert(tagTriggerKey, trigger),
+		tag.Upsert(tagResultKey, result),
+	}
+	measurements := []stats.Measurement{
+		mCompactions.M(1),
+		mCompactionDuration.M(float64(time.Since(start)) / float64(time.Millisecond)),
+	}
+	if err == nil && reclaimed > 0 {
+		measurements = append(measurements, mReclaimedSize.M(reclaimed))
+	}
+	_ = stats.RecordWithTags(ctx, mutators, measurements...)
+}
+
+// recordDatabaseSize records the total allocated size of the database of a component
+func recordDatabaseSize(ctx context.Context, component string, size int64) {
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(tagComponentKey, component)}, mDatabaseSize.M(size))
+}
@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestorage
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+)
+
+func TestMetricViews(t *testing.T) {
+	expectedViewNames := []string{
+		"extension/file_storage/filestorage_compactions",
+		"extension/file_storage/filestorage_compaction_duration",
+		"extension/file_storage/filestorage_compaction_reclaimed_size",
+		"extension/file_storage/filestorage_database_size",
+	}
+
+	views := MetricViews()
+	require.Len(t, views, len(expectedViewNames))
+	for i, viewName := range expectedViewNames {
+		assert.Equal(t, viewName, views[i].Name)
+	}
+}
+
+func TestRecordCompaction(t *testing.T) {
+	resetViews(t)
+
+	ctx := context.Background()
+	recordCompaction(ctx, "receiver_nop_", triggerSize, time.Now(), 1024, nil)
+	recordCompaction(ctx, "receiver_nop_", triggerSize, time.Now(), 0, errors.New("failed"))
+
+	rows, err := view.RetrieveData(buildCustomMetricName(mCompactions.Name()))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	for _, row := range rows {
+		assert.Contains(t, row.Tags, tag.Tag{Key: tagTriggerKey, Value: triggerSize})
+		assert.Equal(t, float64(1), row.Data.(*view.SumData).Value)
+	}
+
+	rows, err = view.RetrieveData(buildCustomMetricName(mReclaimedSize.Name()))
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []tag.Tag{{Key: tagComponentKey, Value: "receiver_nop_"}}, rows[0].Tags)
+	assert.Equal(t, float64(1024), rows[0].Data.(*view.SumData).Value)
+}
+
+func TestClientCompactionMetrics(t *testing.T) {
+	resetViews(t)
+
+	tempDir := t.TempDir()
+	client, err := newClient(zap.NewNop(), filepath.Join(tempDir, "receiver_nop_"), time.Second, &CompactionConfig{
+		Directory: tempDir,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(context.TODO()))
+	})
+
+	ctx := context.Background()
+	require.NoError(t, client.compact(ctx, triggerOnStart))
+
+	rows, err := view.RetrieveData(buildCustomMetricName(mCompactions.Name()))
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.ElementsMatch(t, []tag.Tag{
+		{Key: tagComponentKey, Value: "receiver_nop_"},
+		{Key: tagTriggerKey, Value: triggerOnStart},
+		{Key: tagResultKey, Value: resultSuccess},
+	}, rows[0].Tags)
+
+	rows, err = view.RetrieveData(buildCustomMetricName(mDatabaseSize.Name()))
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Greater(t, rows[0].Data.(*view.LastValueData).Value, float64(0))
+}
+
+// resetViews discards the data recorded by the views of previous tests
+func resetViews(t *testing.T) {
+	views := MetricViews()
+	view.Unregister(views...)
+	require.NoError(t, view.Register(views...))
+	t.Cleanup(func() {
+		view.Unregister(views...)
+	})
+}
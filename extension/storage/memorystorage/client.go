@@ -0,0 +1,183 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memorystorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/memorystorage"
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+// snapshotSuffix is appended to the name of a component to build the name of its snapshot file.
+const snapshotSuffix = ".snapshot"
+
+// memoryClient keeps the data of a component in memory. The client outlives
+// calls to Close, so that a component getting its client again finds its data.
+type memoryClient struct {
+	snapshotPath string
+	// snapshotMu orders the snapshots, so an older snapshot never replaces a newer one
+	snapshotMu sync.Mutex
+
+	mu    sync.RWMutex
+	data  map[string][]byte
+	dirty bool
+}
+
+// newClient creates a client, loading the data of the latest snapshot if any
+func newClient(snapshotPath string) (*memoryClient, error) {
+	data, err := loadSnapshot(snapshotPath)
+	if err != nil {
+		return nil, err
+	}
+	return &memoryClient{snapshotPath: snapshotPath, data: data}, nil
+}
+
+// Get will retrieve data from storage that corresponds to the specified key
+func (c *memoryClient) Get(ctx context.Context, key string) ([]byte, error) {
+	op := storage.GetOperation(key)
+	if err := c.Batch(ctx, op); err != nil {
+		return nil, err
+	}
+	return op.Value, nil
+}
+
+// Set will store data. The data can be retrieved using the same key
+func (c *memoryClient) Set(ctx context.Context, key string, value []byte) error {
+	return c.Batch(ctx, storage.SetOperation(key, value))
+}
+
+// Delete will delete data associated with the specified key
+func (c *memoryClient) Delete(ctx context.Context, key string) error {
+	return c.Batch(ctx, storage.DeleteOperation(key))
+}
+
+// Batch executes the specified operations in order. Get operation results are updated in place
+func (c *memoryClient) Batch(_ context.Context, ops ...storage.Operation) error {
+	// Operations are checked first, so that a batch is applied entirely or not at all
+	for _, op := range ops {
+		if op.Type != storage.Get && op.Type != storage.Set && op.Type != storage.Delete {
+			return errors.New("wrong operation type")
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value = copyBytes(c.data[op.Key])
+		case storage.Set:
+			c.data[op.Key] = copyBytes(op.Value)
+			c.dirty = true
+		case storage.Delete:
+			if _, ok := c.data[op.Key]; ok {
+				delete(c.data, op.Key)
+				c.dirty = true
+			}
+		}
+	}
+	return nil
+}
+
+// Close persists the data modified since the previous snapshot
+func (c *memoryClient) Close(context.Context) error {
+	return c.snapshot()
+}
+
+// snapshot persists the data if it was modified since the previous snapshot
+func (c *memoryClient) snapshot() error {
+	c.snapshotMu.Lock()
+	defer c.snapshotMu.Unlock()
+
+	c.mu.Lock()
+	if !c.dirty {
+		c.mu.Unlock()
+		return nil
+	}
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(c.data)
+	c.dirty = false
+	c.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+
+	if err = writeFileAtomic(c.snapshotPath, buf.Bytes()); err != nil {
+		// The data is written again by the next snapshot
+		c.mu.Lock()
+		c.dirty = true
+		c.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// loadSnapshot reads the data of a snapshot, a missing snapshot is treated as empty
+func loadSnapshot(path string) (map[string][]byte, error) {
+	data := make(map[string][]byte)
+	file, err := os.Open(filepath.Clean(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return data, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if err = gob.NewDecoder(file).Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// writeFileAtomic writes the file to a temporary file first, so that a crash
+// while writing never leaves a partial snapshot behind
+func writeFileAtomic(path string, content []byte) error {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err = file.Write(content); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err = file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+func copyBytes(value []byte) []byte {
+	if value == nil {
+		return nil
+	}
+	result := make([]byte, len(value))
+	copy(result, value)
+	return result
+}
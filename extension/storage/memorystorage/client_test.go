@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memorystorage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+func TestClientOperations(t *testing.T) {
+	ctx := context.Background()
+	client, err := newClient(filepath.Join(t.TempDir(), "client"+snapshotSuffix))
+	require.NoError(t, err)
+
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	original := []byte("value")
+	require.NoError(t, client.Set(ctx, "key", original))
+	original[0] = 'V'
+
+	value, err = client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	require.NoError(t, client.Delete(ctx, "key"))
+	value, err = client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestClientBatchOperations(t *testing.T) {
+	ctx := context.Background()
+	client, err := newClient(filepath.Join(t.TempDir(), "client"+snapshotSuffix))
+	require.NoError(t, err)
+
+	ops := []storage.Operation{
+		storage.SetOperation("a", []byte("1")),
+		storage.GetOperation("a"),
+		storage.DeleteOperation("a"),
+		storage.GetOperation("a"),
+	}
+	require.NoError(t, client.Batch(ctx, ops...))
+	assert.Equal(t, []byte("1"), ops[1].Value)
+	assert.Nil(t, ops[3].Value)
+
+}
+
+func TestClientBatchWrongOperationType(t *testing.T) {
+	ctx := context.Background()
+	client, err := newClient(filepath.Join(t.TempDir(), "client"+snapshotSuffix))
+	require.NoError(t, err)
+
+	invalid := storage.GetOperation("b")
+	invalid.Type = 100
+	assert.EqualError(t, client.Batch(ctx, storage.SetOperation("a", []byte("1")), invalid), "wrong operation type")
+
+	// Batches are applied entirely or not at all
+	value, err := client.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestClientSnapshot(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "client"+snapshotSuffix)
+
+	client, err := newClient(path)
+	require.NoError(t, err)
+
+	// Nothing is written until the data is modified
+	require.NoError(t, client.Close(ctx))
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+
+	require.NoError(t, client.Set(ctx, "a", []byte("1")))
+	require.NoError(t, client.Set(ctx, "b", []byte("2")))
+	require.NoError(t, client.Close(ctx))
+
+	restored, err := newClient(path)
+	require.NoError(t, err)
+	value, err := restored.Get(ctx, "b")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2"), value)
+	assert.Len(t, restored.data, 2)
+	assert.False(t, restored.dirty)
+}
+
+func TestClientCorruptedSnapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "client"+snapshotSuffix)
+	require.NoError(t, os.WriteFile(path, []byte("not a snapshot"), 0600))
+
+	_, err := newClient(path)
+	assert.ErrorContains(t, err, "failed to decode snapshot")
+}
+
+func TestClientSnapshotFailure(t *testing.T) {
+	ctx := context.Background()
+	client, err := newClient(filepath.Join(t.TempDir(), "missing", "client"+snapshotSuffix))
+	require.NoError(t, err)
+
+	require.NoError(t, client.Set(ctx, "a", []byte("1")))
+	assert.Error(t, client.Close(ctx))
+	assert.True(t, client.dirty)
+}
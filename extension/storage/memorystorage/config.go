@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memorystorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/memorystorage"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+)
+
+// Config defines configuration for the memory storage extension.
+type Config struct {
+	config.ExtensionSettings `mapstructure:",squash"`
+
+	// Directory is where the snapshots of the data of each component are stored.
+	Directory string `mapstructure:"directory"`
+
+	// SnapshotInterval is the frequency at which the data modified since the previous
+	// snapshot is persisted. Data is also persisted when a client is closed and on shutdown.
+	// 0 disables the periodic snapshots.
+	SnapshotInterval time.Duration `mapstructure:"snapshot_interval,omitempty"`
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.Directory == "" {
+		return errors.New("directory must be specified")
+	}
+	info, err := os.Stat(cfg.Directory)
+	if err != nil {
+		return fmt.Errorf("directory must exist: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", cfg.Directory)
+	}
+	if cfg.SnapshotInterval < 0 {
+		return errors.New("snapshot interval cannot be less than 0")
+	}
+	return nil
+}
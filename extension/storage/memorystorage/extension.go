@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memorystorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/memorystorage"
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+type memoryStorage struct {
+	cfg    *Config
+	logger *zap.Logger
+
+	mu      sync.Mutex
+	clients map[string]*memoryClient
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// Ensure this storage extension implements the appropriate interface
+var _ storage.Extension = (*memoryStorage)(nil)
+
+func newMemoryStorage(logger *zap.Logger, config *Config) *memoryStorage {
+	return &memoryStorage{
+		cfg:     config,
+		logger:  logger,
+		clients: make(map[string]*memoryClient),
+	}
+}
+
+// Start begins the periodic snapshots
+func (ms *memoryStorage) Start(context.Context, component.Host) error {
+	if ms.cfg.SnapshotInterval <= 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ms.cancel = cancel
+	ms.wg.Add(1)
+	go ms.snapshotPeriodically(ctx)
+	return nil
+}
+
+// Shutdown stops the periodic snapshots and persists the data of all the clients
+func (ms *memoryStorage) Shutdown(context.Context) error {
+	if ms.cancel != nil {
+		ms.cancel()
+		ms.wg.Wait()
+	}
+	return ms.snapshotAll()
+}
+
+func (ms *memoryStorage) snapshotPeriodically(ctx context.Context) {
+	defer ms.wg.Done()
+
+	ticker := time.NewTicker(ms.cfg.SnapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := ms.snapshotAll(); err != nil {
+				ms.logger.Warn("failed to snapshot storage", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (ms *memoryStorage) snapshotAll() error {
+	ms.mu.Lock()
+	clients := make([]*memoryClient, 0, len(ms.clients))
+	for _, client := range ms.clients {
+		clients = append(clients, client)
+	}
+	ms.mu.Unlock()
+
+	var errs error
+	for _, client := range clients {
+		errs = multierr.Append(errs, client.snapshot())
+	}
+	return errs
+}
+
+// GetClient returns a storage client for an individual component
+func (ms *memoryStorage) GetClient(_ context.Context, kind component.Kind, ent config.ComponentID, name string) (storage.Client, error) {
+	var rawName string
+	if name == "" {
+		rawName = fmt.Sprintf("%s_%s_%s", kindString(kind), ent.Type(), ent.Name())
+	} else {
+		rawName = fmt.Sprintf("%s_%s_%s_%s", kindString(kind), ent.Type(), ent.Name(), name)
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if client, ok := ms.clients[rawName]; ok {
+		return client, nil
+	}
+	client, err := newClient(filepath.Join(ms.cfg.Directory, rawName+snapshotSuffix))
+	if err != nil {
+		return nil, err
+	}
+	ms.clients[rawName] = client
+	return client, nil
+}
+
+func kindString(k component.Kind) string {
+	switch k {
+	case component.KindReceiver:
+		return "receiver"
+	case component.KindProcessor:
+		return "processor"
+	case component.KindExporter:
+		return "exporter"
+	case component.KindExtension:
+		return "extension"
+	default:
+		return "other" // not expected
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memorystorage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+func TestExtensionIntegrity(t *testing.T) {
+	ctx := context.Background()
+	se := newTestExtension(t, t.TempDir(), 0)
+
+	receiverClient, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("one"), "")
+	require.NoError(t, err)
+	exporterClient, err := se.GetClient(ctx, component.KindExporter, newTestEntity("one"), "queue")
+	require.NoError(t, err)
+
+	require.NoError(t, receiverClient.Set(ctx, "key", []byte("receiver")))
+	require.NoError(t, exporterClient.Set(ctx, "key", []byte("exporter")))
+
+	value, err := receiverClient.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("receiver"), value)
+
+	// Getting the client again returns the same data, even after it's closed
+	require.NoError(t, receiverClient.Close(ctx))
+	receiverClient, err = se.GetClient(ctx, component.KindReceiver, newTestEntity("one"), "")
+	require.NoError(t, err)
+	value, err = receiverClient.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("receiver"), value)
+}
+
+func TestExtensionSnapshotOnShutdown(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	se := newTestExtension(t, dir, 0)
+	require.NoError(t, se.Start(ctx, componenttest.NewNopHost()))
+	client, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("one"), "")
+	require.NoError(t, err)
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	require.NoError(t, se.Shutdown(ctx))
+
+	restarted := newTestExtension(t, dir, 0)
+	client, err = restarted.GetClient(ctx, component.KindReceiver, newTestEntity("one"), "")
+	require.NoError(t, err)
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestExtensionPeriodicSnapshot(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	se := newTestExtension(t, dir, 10*time.Millisecond)
+	require.NoError(t, se.Start(ctx, componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, se.Shutdown(ctx))
+	})
+	client, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("one"), "")
+	require.NoError(t, err)
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+
+	require.Eventually(t, func() bool {
+		restarted := newTestExtension(t, dir, 0)
+		restartedClient, getErr := restarted.GetClient(ctx, component.KindReceiver, newTestEntity("one"), "")
+		require.NoError(t, getErr)
+		value, getErr := restartedClient.Get(ctx, "key")
+		require.NoError(t, getErr)
+		return string(value) == "value"
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func newTestExtension(t *testing.T, dir string, snapshotInterval time.Duration) storage.Extension {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = dir
+	cfg.SnapshotInterval = snapshotInterval
+
+	extension, err := f.CreateExtension(context.Background(), componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	se, ok := extension.(storage.Extension)
+	require.True(t, ok)
+	return se
+}
+
+func newTestEntity(name string) config.ComponentID {
+	return config.NewComponentIDWithName("nop", name)
+}
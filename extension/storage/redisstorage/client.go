@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+type redisClient struct {
+	client     redis.UniversalClient
+	prefix     string
+	expiration time.Duration
+}
+
+func newClient(client redis.UniversalClient, prefix string, expiration time.Duration) *redisClient {
+	return &redisClient{client: client, prefix: prefix, expiration: expiration}
+}
+
+// Get will retrieve data from storage that corresponds to the specified key
+func (c *redisClient) Get(ctx context.Context, key string) ([]byte, error) {
+	op := storage.GetOperation(key)
+	if err := c.Batch(ctx, op); err != nil {
+		return nil, err
+	}
+	return op.Value, nil
+}
+
+// Set will store data. The data can be retrieved using the same key
+func (c *redisClient) Set(ctx context.Context, key string, value []byte) error {
+	return c.Batch(ctx, storage.SetOperation(key, value))
+}
+
+// Delete will delete data associated with the specified key
+func (c *redisClient) Delete(ctx context.Context, key string) error {
+	return c.Batch(ctx, storage.DeleteOperation(key))
+}
+
+// Batch executes the specified operations in order, in a single transaction.
+// Get operation results are updated in place
+func (c *redisClient) Batch(_ context.Context, ops ...storage.Operation) error {
+	if len(ops) == 0 {
+		return nil
+	}
+
+	pipe := c.client.TxPipeline()
+	gets := make([]*redis.StringCmd, len(ops))
+	for i, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			gets[i] = pipe.Get(c.prefix + op.Key)
+		case storage.Set:
+			pipe.Set(c.prefix+op.Key, op.Value, c.expiration)
+		case storage.Delete:
+			pipe.Del(c.prefix + op.Key)
+		default:
+			_ = pipe.Close()
+			return errors.New("wrong operation type")
+		}
+	}
+
+	// Missing keys make the transaction return redis.Nil, which is not a failure
+	if _, err := pipe.Exec(); err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	for i, cmd := range gets {
+		if cmd == nil {
+			continue
+		}
+		op := ops[i]
+		value, err := cmd.Bytes()
+		switch {
+		case errors.Is(err, redis.Nil):
+			op.Value = nil
+		case err != nil:
+			return err
+		default:
+			op.Value = value
+		}
+	}
+	return nil
+}
+
+// Close does nothing, as the connections to Redis are shared by all the clients of the extension
+func (c *redisClient) Close(context.Context) error {
+	return nil
+}
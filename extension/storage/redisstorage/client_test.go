@@ -0,0 +1,216 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisstorage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.uber.org/zap"
+)
+
+func TestClientMissingKey(t *testing.T) {
+	ctx := context.Background()
+	client := newClient(newFakeRedis(), "prefix:", 0)
+
+	value, err := client.Get(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	// A missing key doesn't fail the other operations of the transaction
+	get := storage.GetOperation("missing")
+	require.NoError(t, client.Batch(ctx, storage.SetOperation("a", []byte("1")), get))
+	assert.Nil(t, get.Value)
+	value, err = client.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), value)
+
+	require.NoError(t, client.Delete(ctx, "missing"))
+}
+
+func TestClientBatchOrder(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeRedis()
+	client := newClient(fake, "prefix:", time.Hour)
+
+	getAfterSet := storage.GetOperation("a")
+	getAfterDelete := storage.GetOperation("a")
+	require.NoError(t, client.Batch(ctx,
+		storage.SetOperation("a", []byte("1")),
+		getAfterSet,
+		storage.DeleteOperation("a"),
+		getAfterDelete,
+		storage.SetOperation("a", []byte("2")),
+	))
+	assert.Equal(t, []byte("1"), getAfterSet.Value)
+	assert.Nil(t, getAfterDelete.Value)
+	assert.Equal(t, 1, fake.execs)
+	assert.Equal(t, map[string][]byte{"prefix:a": []byte("2")}, fake.values)
+	assert.Equal(t, time.Hour, fake.expirations["prefix:a"])
+}
+
+func TestClientInvalidOpType(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeRedis()
+	client := newClient(fake, "prefix:", 0)
+
+	invalid := storage.GetOperation("b")
+	invalid.Type = 100
+	assert.EqualError(t, client.Batch(ctx, storage.SetOperation("a", []byte("1")), invalid), "wrong operation type")
+	// The transaction is discarded
+	assert.Equal(t, 0, fake.execs)
+	assert.Empty(t, fake.values)
+}
+
+func TestClientExecFailure(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeRedis()
+	fake.err = errors.New("connection refused")
+	client := newClient(fake, "prefix:", 0)
+
+	_, err := client.Get(ctx, "a")
+	assert.EqualError(t, err, "connection refused")
+	assert.EqualError(t, client.Set(ctx, "a", []byte("1")), "connection refused")
+}
+
+func TestClientKeyPrefixIsolation(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeRedis()
+	rs := newRedisStorage(zap.NewNop(), createDefaultConfig().(*Config))
+	rs.client = fake
+
+	receiver, err := rs.GetClient(ctx, component.KindReceiver, config.NewComponentID("filelog"), "")
+	require.NoError(t, err)
+	exporter, err := rs.GetClient(ctx, component.KindExporter, config.NewComponentID("filelog"), "")
+	require.NoError(t, err)
+	named, err := rs.GetClient(ctx, component.KindReceiver, config.NewComponentID("filelog"), "queue")
+	require.NoError(t, err)
+
+	require.NoError(t, receiver.Set(ctx, "key", []byte("receiver")))
+	require.NoError(t, exporter.Set(ctx, "key", []byte("exporter")))
+
+	value, err := receiver.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("receiver"), value)
+	value, err = exporter.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("exporter"), value)
+	value, err = named.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	require.NoError(t, exporter.Delete(ctx, "key"))
+	value, err = receiver.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("receiver"), value)
+	assert.Len(t, fake.values, 1)
+}
+
+// fakeRedis is an in memory redis.UniversalClient supporting the transactions
+// used by the client. The other methods are not implemented.
+type fakeRedis struct {
+	redis.UniversalClient
+	values      map[string][]byte
+	expirations map[string]time.Duration
+	execs       int
+	// err is returned by the transactions if set
+	err error
+}
+
+func newFakeRedis() *fakeRedis {
+	return &fakeRedis{
+		values:      make(map[string][]byte),
+		expirations: make(map[string]time.Duration),
+	}
+}
+
+func (f *fakeRedis) TxPipeline() redis.Pipeliner {
+	return &fakePipeline{redis: f}
+}
+
+// fakePipeline queues the commands and applies them in order on Exec
+type fakePipeline struct {
+	redis.Pipeliner
+	redis  *fakeRedis
+	cmds   []func() error
+	closed bool
+}
+
+func (p *fakePipeline) Get(key string) *redis.StringCmd {
+	cmd := redis.NewStringCmd("get", key)
+	p.cmds = append(p.cmds, func() error {
+		value, ok := p.redis.values[key]
+		if !ok {
+			*cmd = *redis.NewStringResult("", redis.Nil)
+			return redis.Nil
+		}
+		*cmd = *redis.NewStringResult(string(value), nil)
+		return nil
+	})
+	return cmd
+}
+
+func (p *fakePipeline) Set(key string, value interface{}, expiration time.Duration) *redis.StatusCmd {
+	p.cmds = append(p.cmds, func() error {
+		p.redis.values[key] = append([]byte{}, value.([]byte)...)
+		p.redis.expirations[key] = expiration
+		return nil
+	})
+	return redis.NewStatusResult("OK", nil)
+}
+
+func (p *fakePipeline) Del(keys ...string) *redis.IntCmd {
+	p.cmds = append(p.cmds, func() error {
+		for _, key := range keys {
+			delete(p.redis.values, key)
+			delete(p.redis.expirations, key)
+		}
+		return nil
+	})
+	return redis.NewIntResult(int64(len(keys)), nil)
+}
+
+// Exec applies the queued commands and returns the first error, like Redis
+func (p *fakePipeline) Exec() ([]redis.Cmder, error) {
+	if p.closed {
+		return nil, errors.New("redis: client is closed")
+	}
+	if p.redis.err != nil {
+		return nil, p.redis.err
+	}
+	p.redis.execs++
+	var firstErr error
+	for _, cmd := range p.cmds {
+		if err := cmd(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	p.cmds = nil
+	return nil, firstErr
+}
+
+func (p *fakePipeline) Close() error {
+	p.closed = true
+	p.cmds = nil
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtls"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagecache"
+)
+
+// Config defines configuration for the Redis storage extension.
+type Config struct {
+	config.ExtensionSettings `mapstructure:",squash"`
+
+	// Endpoints are the addresses of the Redis server, or of the seed nodes of the Redis Cluster.
+	Endpoints []string `mapstructure:"endpoints"`
+	// Cluster specifies that the endpoints belong to a Redis Cluster.
+	Cluster bool `mapstructure:"cluster,omitempty"`
+	// Password is the optional password matching the requirepass server configuration option.
+	Password string `mapstructure:"password,omitempty"`
+	// DB is the database selected after connecting. It must be 0 for Redis Cluster.
+	DB int `mapstructure:"db,omitempty"`
+
+	TLS configtls.TLSClientSetting `mapstructure:"tls,omitempty"`
+
+	// Prefix is prepended to the keys of all components.
+	Prefix string `mapstructure:"prefix,omitempty"`
+	// Expiration is the time to live of the stored keys, refreshed on each write. 0 means keys never expire.
+	Expiration time.Duration `mapstructure:"expiration,omitempty"`
+	// Timeout bounds the duration of dialing, reading and writing to Redis.
+	Timeout time.Duration `mapstructure:"timeout,omitempty"`
+
+	// Cache specifies the optional cache of the clients, reducing the round trips to Redis
+	Cache storagecache.Config `mapstructure:"cache"`
+}
+
+func (cfg *Config) Validate() error {
+	if len(cfg.Endpoints) == 0 {
+		return errors.New("at least one endpoint must be specified")
+	}
+	for _, endpoint := range cfg.Endpoints {
+		if strings.TrimSpace(endpoint) == "" {
+			return errors.New("endpoints cannot be empty")
+		}
+	}
+	if !cfg.Cluster && len(cfg.Endpoints) > 1 {
+		return errors.New("multiple endpoints are only supported in cluster mode")
+	}
+	if cfg.Cluster && cfg.DB != 0 {
+		return errors.New("db cannot be selected in cluster mode")
+	}
+	if cfg.DB < 0 {
+		return errors.New("db cannot be less than 0")
+	}
+	if cfg.Expiration < 0 {
+		return errors.New("expiration cannot be less than 0")
+	}
+	if cfg.Timeout <= 0 {
+		return errors.New("timeout must be positive")
+	}
+	return cfg.Cache.Validate()
+}
@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisstorage
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagecache"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id       config.ComponentID
+		expected config.Extension
+	}{
+		{
+			id:       config.NewComponentID(typeStr),
+			expected: NewFactory().CreateDefaultConfig(),
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "all_settings"),
+			expected: &Config{
+				ExtensionSettings: config.NewExtensionSettings(config.NewComponentID(typeStr)),
+				Endpoints:         []string{"redis-0:6379", "redis-1:6379", "redis-2:6379"},
+				Cluster:           true,
+				Password:          "secret",
+				TLS: configtls.TLSClientSetting{
+					TLSSetting: configtls.TLSSetting{
+						CAFile: "/etc/redis/ca.pem",
+					},
+				},
+				Prefix:     "collector",
+				Expiration: 24 * time.Hour,
+				Timeout:    2 * time.Second,
+				Cache: storagecache.Config{
+					Enabled:         true,
+					FlushInterval:   5 * time.Second,
+					MaxDirtyEntries: 100,
+					MaxEntries:      500,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, config.UnmarshalExtension(sub, cfg))
+
+			assert.NoError(t, cfg.Validate())
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(*Config)
+		expectedErr string
+	}{
+		{
+			name:        "no endpoints",
+			modify:      func(cfg *Config) { cfg.Endpoints = nil },
+			expectedErr: "at least one endpoint must be specified",
+		},
+		{
+			name:        "empty endpoint",
+			modify:      func(cfg *Config) { cfg.Endpoints = []string{" "} },
+			expectedErr: "endpoints cannot be empty",
+		},
+		{
+			name:        "multiple endpoints without cluster",
+			modify:      func(cfg *Config) { cfg.Endpoints = []string{"a:6379", "b:6379"} },
+			expectedErr: "multiple endpoints are only supported in cluster mode",
+		},
+		{
+			name: "db in cluster mode",
+			modify: func(cfg *Config) {
+				cfg.Cluster = true
+				cfg.DB = 1
+			},
+			expectedErr: "db cannot be selected in cluster mode",
+		},
+		{
+			name:        "negative db",
+			modify:      func(cfg *Config) { cfg.DB = -1 },
+			expectedErr: "db cannot be less than 0",
+		},
+		{
+			name:        "negative expiration",
+			modify:      func(cfg *Config) { cfg.Expiration = -time.Second },
+			expectedErr: "expiration cannot be less than 0",
+		},
+		{
+			name:        "no timeout",
+			modify:      func(cfg *Config) { cfg.Timeout = 0 },
+			expectedErr: "timeout must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.expectedErr)
+		})
+	}
+}
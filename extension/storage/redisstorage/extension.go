@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-redis/redis/v7"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagecache"
+)
+
+type redisStorage struct {
+	cfg    *Config
+	logger *zap.Logger
+	client redis.UniversalClient
+}
+
+// Ensure this storage extension implements the appropriate interface
+var _ storage.Extension = (*redisStorage)(nil)
+
+func newRedisStorage(logger *zap.Logger, config *Config) *redisStorage {
+	return &redisStorage{
+		cfg:    config,
+		logger: logger,
+	}
+}
+
+// Start connects to the Redis server or cluster
+func (rs *redisStorage) Start(context.Context, component.Host) error {
+	tlsConfig, err := rs.cfg.TLS.LoadTLSConfig()
+	if err != nil {
+		return err
+	}
+
+	if rs.cfg.Cluster {
+		rs.client = redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:        rs.cfg.Endpoints,
+			Password:     rs.cfg.Password,
+			TLSConfig:    tlsConfig,
+			DialTimeout:  rs.cfg.Timeout,
+			ReadTimeout:  rs.cfg.Timeout,
+			WriteTimeout: rs.cfg.Timeout,
+		})
+	} else {
+		rs.client = redis.NewClient(&redis.Options{
+			Addr:         rs.cfg.Endpoints[0],
+			Password:     rs.cfg.Password,
+			DB:           rs.cfg.DB,
+			TLSConfig:    tlsConfig,
+			DialTimeout:  rs.cfg.Timeout,
+			ReadTimeout:  rs.cfg.Timeout,
+			WriteTimeout: rs.cfg.Timeout,
+		})
+	}
+
+	if err := rs.client.Ping().Err(); err != nil {
+		_ = rs.client.Close()
+		rs.client = nil
+		return fmt.Errorf("failed to connect to redis: %w", err)
+	}
+	return nil
+}
+
+// Shutdown closes the connections to Redis
+func (rs *redisStorage) Shutdown(context.Context) error {
+	if rs.client == nil {
+		return nil
+	}
+	return rs.client.Close()
+}
+
+// GetClient returns a storage client for an individual component
+func (rs *redisStorage) GetClient(_ context.Context, kind component.Kind, ent config.ComponentID, name string) (storage.Client, error) {
+	if rs.client == nil {
+		return nil, fmt.Errorf("%s extension is not started", typeStr)
+	}
+	client := newClient(rs.client, keyPrefix(rs.cfg.Prefix, kind, ent, name), rs.cfg.Expiration)
+	if rs.cfg.Cache.Enabled {
+		return storagecache.NewClient(client, rs.cfg.Cache, rs.logger), nil
+	}
+	return client, nil
+}
+
+// keyPrefix returns the prefix of the keys of a component. The component name is wrapped
+// in a hash tag, so that all its keys are stored in the same slot of a Redis Cluster and
+// batches can be executed as transactions.
+func keyPrefix(prefix string, kind component.Kind, ent config.ComponentID, name string) string {
+	var fullName string
+	if name == "" {
+		fullName = fmt.Sprintf("%s_%s_%s", kindString(kind), ent.Type(), ent.Name())
+	} else {
+		fullName = fmt.Sprintf("%s_%s_%s_%s", kindString(kind), ent.Type(), ent.Name(), name)
+	}
+	fullName = strings.NewReplacer("{", "", "}", "").Replace(fullName)
+	if prefix == "" {
+		return fmt.Sprintf("{%s}:", fullName)
+	}
+	return fmt.Sprintf("%s:{%s}:", prefix, fullName)
+}
+
+func kindString(k component.Kind) string {
+	switch k {
+	case component.KindReceiver:
+		return "receiver"
+	case component.KindProcessor:
+		return "processor"
+	case component.KindExporter:
+		return "exporter"
+	case component.KindExtension:
+		return "extension"
+	default:
+		return "other" // not expected
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisstorage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.uber.org/zap"
+)
+
+func TestExtensionIntegrity(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+
+	ext, err := f.CreateExtension(context.Background(), componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NotNil(t, ext)
+	assert.Equal(t, typeStr, f.Type())
+}
+
+func TestGetClientNotStarted(t *testing.T) {
+	rs := newRedisStorage(zap.NewNop(), createDefaultConfig().(*Config))
+	_, err := rs.GetClient(context.Background(), component.KindReceiver, config.NewComponentID("nop"), "")
+	assert.EqualError(t, err, "redis_storage extension is not started")
+	assert.NoError(t, rs.Shutdown(context.Background()))
+}
+
+func TestStartUnreachable(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoints = []string{"127.0.0.1:1"}
+	rs := newRedisStorage(zap.NewNop(), cfg)
+	assert.Error(t, rs.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, rs.Shutdown(context.Background()))
+}
+
+func TestKeyPrefix(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		kind     component.Kind
+		id       config.ComponentID
+		client   string
+		expected string
+	}{
+		{
+			name:     "default",
+			prefix:   "otelcol",
+			kind:     component.KindReceiver,
+			id:       config.NewComponentID("filelog"),
+			expected: "otelcol:{receiver_filelog_}:",
+		},
+		{
+			name:     "named",
+			prefix:   "otelcol",
+			kind:     component.KindExporter,
+			id:       config.NewComponentIDWithName("otlp", "backup"),
+			client:   "queue",
+			expected: "otelcol:{exporter_otlp_backup_queue}:",
+		},
+		{
+			name:     "no prefix",
+			kind:     component.KindProcessor,
+			id:       config.NewComponentID("batch"),
+			expected: "{processor_batch_}:",
+		},
+		{
+			name:     "hash tag characters removed",
+			prefix:   "otelcol",
+			kind:     component.KindReceiver,
+			id:       config.NewComponentIDWithName("filelog", "{a}"),
+			expected: "otelcol:{receiver_filelog_a}:",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, keyPrefix(tt.prefix, tt.kind, tt.id, tt.client))
+		})
+	}
+}
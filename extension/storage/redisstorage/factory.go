@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtls"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagecache"
+)
+
+// The value of extension "type" in configuration.
+const typeStr config.Type = "redis_storage"
+
+const (
+	defaultEndpoint = "localhost:6379"
+	defaultPrefix   = "otelcol"
+	defaultTimeout  = 5 * time.Second
+)
+
+// NewFactory creates a factory for Redis storage extension.
+func NewFactory() component.ExtensionFactory {
+	return component.NewExtensionFactory(
+		typeStr,
+		createDefaultConfig,
+		createExtension,
+		component.StabilityLevelAlpha,
+	)
+}
+
+func createDefaultConfig() config.Extension {
+	return &Config{
+		ExtensionSettings: config.NewExtensionSettings(config.NewComponentID(typeStr)),
+		Endpoints:         []string{defaultEndpoint},
+		TLS: configtls.TLSClientSetting{
+			Insecure: true,
+		},
+		Prefix:  defaultPrefix,
+		Timeout: defaultTimeout,
+		Cache:   storagecache.NewDefaultConfig(),
+	}
+}
+
+func createExtension(
+	_ context.Context,
+	params component.ExtensionCreateSettings,
+	cfg config.Extension,
+) (component.Extension, error) {
+	return newRedisStorage(params.Logger, cfg.(*Config)), nil
+}
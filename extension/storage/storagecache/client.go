@@ -0,0 +1,311 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagecache // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagecache"
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+var (
+	errClientClosed = errors.New("client closed")
+	errWrongOpType  = errors.New("wrong operation type")
+)
+
+// Client is a storage.Client reading through and writing behind a cache.
+// Values read from and written to the wrapped client are kept in memory,
+// and the modified entries are written to the wrapped client in a single
+// batch every flush interval, when there are too many of them, or when the
+// client is closed. Modifications which were not flushed yet are lost if
+// the collector is killed. The least recently used entries are evicted
+// once written, so that the cache keeps at most MaxEntries entries besides
+// the modified ones. The values are copied in and out of the cache, so
+// that the callers can't modify the cached values.
+type Client struct {
+	client storage.Client
+	cfg    Config
+	logger *zap.Logger
+
+	mu sync.Mutex
+	// values holds the elements of lru by key
+	values map[string]*list.Element
+	// lru holds the known values of the keys, the most recently used first
+	lru *list.List
+	// dirty holds the last modification of the keys not written to the wrapped client yet
+	dirty map[string]storage.Operation
+	// inflight holds the keys being written to the wrapped client by a flush
+	inflight map[string]struct{}
+	closed   bool
+
+	// flushMu ensures the flushes are written to the wrapped client in order
+	flushMu sync.Mutex
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// Ensure the cache implements the storage client interface
+var _ storage.Client = (*Client)(nil)
+
+// entry is a value of the cache, nil for a key known to be missing
+type entry struct {
+	key   string
+	value []byte
+}
+
+// NewClient wraps the client with a cache and starts flushing it periodically.
+// The returned client owns the wrapped one, which is closed along with it.
+func NewClient(client storage.Client, cfg Config, logger *zap.Logger) *Client {
+	c := &Client{
+		client:   client,
+		cfg:      cfg,
+		logger:   logger,
+		values:   make(map[string]*list.Element),
+		lru:      list.New(),
+		dirty:    make(map[string]storage.Operation),
+		inflight: make(map[string]struct{}),
+		done:     make(chan struct{}),
+	}
+
+	c.wg.Add(1)
+	go c.flushPeriodically()
+	return c
+}
+
+// Get returns the cached value of the key, reading it from the wrapped client if unknown
+func (c *Client) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, errClientClosed
+	}
+
+	value, err := c.get(ctx, key)
+	return clone(value), err
+}
+
+// Set caches the value of the key, it is written to the wrapped client on the next flush
+func (c *Client) Set(ctx context.Context, key string, value []byte) error {
+	return c.Batch(ctx, storage.SetOperation(key, value))
+}
+
+// Delete caches the deletion of the key, it is written to the wrapped client on the next flush
+func (c *Client) Delete(ctx context.Context, key string) error {
+	return c.Batch(ctx, storage.DeleteOperation(key))
+}
+
+// Batch applies the operations to the cache in order. The values of the Get
+// operations are read before any modification is applied, so that the cache
+// is left unchanged if one of them fails.
+func (c *Client) Batch(ctx context.Context, ops ...storage.Operation) error {
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get, storage.Set, storage.Delete:
+		default:
+			return errWrongOpType
+		}
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return errClientClosed
+	}
+
+	// pending holds the values set by the previous operations of the batch
+	pending := make(map[string][]byte)
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			value, ok := pending[op.Key]
+			if !ok {
+				var err error
+				if value, err = c.get(ctx, op.Key); err != nil {
+					c.mu.Unlock()
+					return err
+				}
+			}
+			op.Value = clone(value)
+		case storage.Set:
+			pending[op.Key] = clone(op.Value)
+		case storage.Delete:
+			pending[op.Key] = nil
+		}
+	}
+
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Set:
+			value := clone(op.Value)
+			c.dirty[op.Key] = storage.SetOperation(op.Key, value)
+			c.store(op.Key, value)
+		case storage.Delete:
+			c.dirty[op.Key] = storage.DeleteOperation(op.Key)
+			c.store(op.Key, nil)
+		}
+	}
+
+	full := len(c.dirty) >= c.cfg.MaxDirtyEntries
+	c.mu.Unlock()
+
+	if full {
+		return c.flush(ctx)
+	}
+	return nil
+}
+
+// Close flushes the cache, stops the periodic flushes and closes the wrapped client
+func (c *Client) Close(ctx context.Context) error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	c.mu.Unlock()
+
+	close(c.done)
+	c.wg.Wait()
+
+	return multierr.Append(c.flush(ctx), c.client.Close(ctx))
+}
+
+// get returns the value of the key, the caller must hold the lock
+func (c *Client) get(ctx context.Context, key string) ([]byte, error) {
+	if elem, ok := c.values[key]; ok {
+		c.lru.MoveToFront(elem)
+		return elem.Value.(*entry).value, nil
+	}
+
+	value, err := c.client.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	c.store(key, value)
+	return value, nil
+}
+
+// store caches the value of the key as the most recently used, the caller must hold the lock
+func (c *Client) store(key string, value []byte) {
+	if elem, ok := c.values[key]; ok {
+		elem.Value.(*entry).value = value
+		c.lru.MoveToFront(elem)
+	} else {
+		c.values[key] = c.lru.PushFront(&entry{key: key, value: value})
+	}
+	c.evict()
+}
+
+// forget removes the key from the cache, the caller must hold the lock
+func (c *Client) forget(key string) {
+	if elem, ok := c.values[key]; ok {
+		c.lru.Remove(elem)
+		delete(c.values, key)
+	}
+}
+
+// evict removes the least recently used entries written to the wrapped client
+// while the cache holds more than MaxEntries entries, the caller must hold the lock.
+// The entries being flushed are kept until the write completes, otherwise they
+// would be read from the wrapped client before it holds their values.
+func (c *Client) evict() {
+	elem := c.lru.Back()
+	for len(c.values) > c.cfg.MaxEntries && elem != nil {
+		prev := elem.Prev()
+		key := elem.Value.(*entry).key
+		_, dirty := c.dirty[key]
+		_, inflight := c.inflight[key]
+		if !dirty && !inflight {
+			c.lru.Remove(elem)
+			delete(c.values, key)
+		}
+		elem = prev
+	}
+}
+
+// flush writes the modified entries to the wrapped client. The entries are
+// kept as modified if the write fails, unless they were modified again since.
+func (c *Client) flush(ctx context.Context) error {
+	c.flushMu.Lock()
+	defer c.flushMu.Unlock()
+
+	c.mu.Lock()
+	if len(c.dirty) == 0 {
+		c.mu.Unlock()
+		return nil
+	}
+	dirty := c.dirty
+	c.dirty = make(map[string]storage.Operation)
+	for key := range dirty {
+		c.inflight[key] = struct{}{}
+	}
+	c.mu.Unlock()
+
+	ops := make([]storage.Operation, 0, len(dirty))
+	for _, op := range dirty {
+		ops = append(ops, op)
+	}
+	err := c.client.Batch(ctx, ops...)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, op := range dirty {
+		delete(c.inflight, key)
+		if _, ok := c.dirty[key]; ok {
+			continue
+		}
+		if err != nil {
+			c.dirty[key] = op
+		} else if op.Type == storage.Delete {
+			// Forget deleted keys, so that the cache does not grow with keys used only once
+			c.forget(key)
+		}
+	}
+	c.evict()
+	return err
+}
+
+func (c *Client) flushPeriodically() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.flush(context.Background()); err != nil {
+				c.logger.Warn("failed to flush the storage cache", zap.Error(err))
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// clone copies the value, keeping nil values nil
+func clone(value []byte) []byte {
+	if value == nil {
+		return nil
+	}
+	return append(make([]byte, 0, len(value)), value...)
+}
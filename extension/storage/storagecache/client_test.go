@@ -0,0 +1,334 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagecache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+func TestClientWriteBehind(t *testing.T) {
+	ctx := context.Background()
+	backend := newCountingClient()
+	cfg := NewDefaultConfig()
+	cfg.FlushInterval = time.Hour
+	client := NewClient(backend, cfg, zap.NewNop())
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, client.Set(ctx, "offset", []byte{byte(i)}))
+	}
+	require.NoError(t, client.Set(ctx, "missing", []byte("1")))
+	require.NoError(t, client.Delete(ctx, "missing"))
+
+	value, err := client.Get(ctx, "offset")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{99}, value)
+	value, err = client.Get(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+	assert.Equal(t, 0, backend.batches)
+
+	require.NoError(t, client.flush(ctx))
+	assert.Equal(t, 1, backend.batches)
+	value, err = backend.Get(ctx, "offset")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{99}, value)
+	assert.NotContains(t, client.values, "missing")
+
+	// Nothing to write
+	require.NoError(t, client.flush(ctx))
+	assert.Equal(t, 1, backend.batches)
+
+	require.NoError(t, client.Close(ctx))
+	assert.True(t, backend.closed)
+}
+
+func TestClientReadThrough(t *testing.T) {
+	ctx := context.Background()
+	backend := newCountingClient()
+	require.NoError(t, backend.Set(ctx, "existing", []byte("1")))
+	client := NewClient(backend, NewDefaultConfig(), zap.NewNop())
+	defer client.Close(ctx)
+
+	for i := 0; i < 3; i++ {
+		value, err := client.Get(ctx, "existing")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("1"), value)
+
+		value, err = client.Get(ctx, "missing")
+		require.NoError(t, err)
+		assert.Nil(t, value)
+	}
+	assert.Equal(t, 2, backend.gets)
+}
+
+func TestClientBatch(t *testing.T) {
+	ctx := context.Background()
+	backend := newCountingClient()
+	require.NoError(t, backend.Set(ctx, "existing", []byte("old")))
+	client := NewClient(backend, NewDefaultConfig(), zap.NewNop())
+	defer client.Close(ctx)
+
+	getExisting := storage.GetOperation("existing")
+	getAfterSet := storage.GetOperation("a")
+	getAfterDelete := storage.GetOperation("existing")
+	require.NoError(t, client.Batch(ctx,
+		getExisting,
+		storage.SetOperation("a", []byte("1")),
+		getAfterSet,
+		storage.DeleteOperation("existing"),
+		getAfterDelete,
+	))
+	assert.Equal(t, []byte("old"), getExisting.Value)
+	assert.Equal(t, []byte("1"), getAfterSet.Value)
+	assert.Nil(t, getAfterDelete.Value)
+
+	// A failed read leaves the cache unchanged
+	backend.getErr = errors.New("failed")
+	require.Error(t, client.Batch(ctx, storage.SetOperation("c", []byte("3")), storage.GetOperation("unknown")))
+	backend.getErr = nil
+	assert.NotContains(t, client.dirty, "c")
+	value, err := client.Get(ctx, "c")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	invalid := storage.GetOperation("b")
+	invalid.Type = 100
+	require.Error(t, client.Batch(ctx, storage.SetOperation("b", []byte("2")), invalid))
+	value, err = client.Get(ctx, "b")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestClientMaxDirtyEntries(t *testing.T) {
+	ctx := context.Background()
+	backend := newCountingClient()
+	cfg := NewDefaultConfig()
+	cfg.FlushInterval = time.Hour
+	cfg.MaxDirtyEntries = 2
+	client := NewClient(backend, cfg, zap.NewNop())
+	defer client.Close(ctx)
+
+	require.NoError(t, client.Set(ctx, "a", []byte("1")))
+	require.NoError(t, client.Set(ctx, "a", []byte("2")))
+	assert.Equal(t, 0, backend.batches)
+
+	require.NoError(t, client.Set(ctx, "b", []byte("1")))
+	assert.Equal(t, 1, backend.batches)
+}
+
+func TestClientFlushPeriodically(t *testing.T) {
+	ctx := context.Background()
+	backend := newCountingClient()
+	cfg := NewDefaultConfig()
+	cfg.FlushInterval = 10 * time.Millisecond
+	client := NewClient(backend, cfg, zap.NewNop())
+	defer client.Close(ctx)
+
+	require.NoError(t, client.Set(ctx, "a", []byte("1")))
+	require.Eventually(t, func() bool {
+		value, err := backend.Get(ctx, "a")
+		return err == nil && value != nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestClientFlushFailure(t *testing.T) {
+	ctx := context.Background()
+	backend := newCountingClient()
+	cfg := NewDefaultConfig()
+	cfg.FlushInterval = time.Hour
+	client := NewClient(backend, cfg, zap.NewNop())
+	defer client.Close(ctx)
+
+	require.NoError(t, client.Set(ctx, "a", []byte("1")))
+	require.NoError(t, client.Set(ctx, "b", []byte("1")))
+
+	backend.err = errors.New("failed")
+	require.Error(t, client.flush(ctx))
+	require.NoError(t, client.Set(ctx, "b", []byte("2")))
+
+	backend.err = nil
+	require.NoError(t, client.flush(ctx))
+	value, err := backend.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), value)
+	value, err = backend.Get(ctx, "b")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2"), value)
+}
+
+func TestClientCopiesValues(t *testing.T) {
+	ctx := context.Background()
+	client := NewClient(newCountingClient(), NewDefaultConfig(), zap.NewNop())
+	defer client.Close(ctx)
+
+	value := []byte("1")
+	require.NoError(t, client.Set(ctx, "a", value))
+	value[0] = '2'
+
+	got, err := client.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), got)
+	got[0] = '3'
+
+	got, err = client.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), got)
+
+	require.NoError(t, client.Set(ctx, "empty", []byte{}))
+	got, err = client.Get(ctx, "empty")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{}, got)
+}
+
+func TestClientMaxEntries(t *testing.T) {
+	ctx := context.Background()
+	backend := newCountingClient()
+	for _, key := range []string{"a", "b", "c"} {
+		require.NoError(t, backend.Set(ctx, key, []byte(key)))
+	}
+	cfg := NewDefaultConfig()
+	cfg.FlushInterval = time.Hour
+	cfg.MaxEntries = 2
+	client := NewClient(backend, cfg, zap.NewNop())
+	defer client.Close(ctx)
+
+	for _, key := range []string{"a", "b", "a", "c"} {
+		_, err := client.Get(ctx, key)
+		require.NoError(t, err)
+	}
+	// b is the least recently used
+	assert.Len(t, client.values, 2)
+	assert.NotContains(t, client.values, "b")
+	assert.Equal(t, 3, backend.gets)
+
+	// the modified entries are only evicted once written
+	require.NoError(t, client.Set(ctx, "d", []byte("d")))
+	require.NoError(t, client.Set(ctx, "e", []byte("e")))
+	require.NoError(t, client.Set(ctx, "f", []byte("f")))
+	assert.Len(t, client.values, 3)
+
+	require.NoError(t, client.flush(ctx))
+	assert.Len(t, client.values, 2)
+	assert.Contains(t, client.values, "e")
+	assert.Contains(t, client.values, "f")
+}
+
+func TestClientKeepsEntriesWhileFlushing(t *testing.T) {
+	ctx := context.Background()
+	backend := newCountingClient()
+	for _, key := range []string{"b", "c"} {
+		require.NoError(t, backend.Set(ctx, key, []byte(key)))
+	}
+	cfg := NewDefaultConfig()
+	cfg.FlushInterval = time.Hour
+	cfg.MaxEntries = 1
+	client := NewClient(backend, cfg, zap.NewNop())
+	defer client.Close(ctx)
+
+	require.NoError(t, client.Set(ctx, "a", []byte("1")))
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	backend.beforeBatch = func() {
+		close(started)
+		<-release
+	}
+	flushed := make(chan error)
+	go func() {
+		flushed <- client.flush(ctx)
+	}()
+	<-started
+
+	// a is not written yet, reading it from the wrapped client would miss it
+	for _, key := range []string{"b", "c"} {
+		_, err := client.Get(ctx, key)
+		require.NoError(t, err)
+	}
+	assert.Contains(t, client.values, "a")
+	value, err := client.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), value)
+
+	close(release)
+	require.NoError(t, <-flushed)
+	assert.Empty(t, client.inflight)
+	assert.Len(t, client.values, 1)
+}
+
+func TestClientClosed(t *testing.T) {
+	ctx := context.Background()
+	client := NewClient(newCountingClient(), NewDefaultConfig(), zap.NewNop())
+	require.NoError(t, client.Close(ctx))
+	require.NoError(t, client.Close(ctx))
+
+	_, err := client.Get(ctx, "a")
+	assert.ErrorIs(t, err, errClientClosed)
+	assert.ErrorIs(t, client.Set(ctx, "a", []byte("1")), errClientClosed)
+	assert.ErrorIs(t, client.Delete(ctx, "a"), errClientClosed)
+}
+
+// countingClient counts the operations reaching the wrapped client
+type countingClient struct {
+	*storagetest.TestClient
+	gets    int
+	batches int
+	getErr  error
+	err     error
+	closed  bool
+	// beforeBatch is called when a batch reaches the wrapped client, if set
+	beforeBatch func()
+}
+
+func newCountingClient() *countingClient {
+	return &countingClient{
+		TestClient: storagetest.NewInMemoryClient(component.KindReceiver, config.NewComponentID("test"), ""),
+	}
+}
+
+func (c *countingClient) Get(ctx context.Context, key string) ([]byte, error) {
+	c.gets++
+	if c.getErr != nil {
+		return nil, c.getErr
+	}
+	return c.TestClient.Get(ctx, key)
+}
+
+func (c *countingClient) Batch(ctx context.Context, ops ...storage.Operation) error {
+	if c.beforeBatch != nil {
+		c.beforeBatch()
+	}
+	if c.err != nil {
+		return c.err
+	}
+	c.batches++
+	return c.TestClient.Batch(ctx, ops...)
+}
+
+func (c *countingClient) Close(ctx context.Context) error {
+	c.closed = true
+	return c.TestClient.Close(ctx)
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storagecache implements a cache that storage extensions can wrap
+// their clients with to reduce the I/O of components writing the same keys
+// frequently, e.g. receivers checkpointing offsets.
+package storagecache // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagecache"
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	defaultFlushInterval   = time.Second
+	defaultMaxDirtyEntries = 1000
+	defaultMaxEntries      = 10000
+)
+
+// Config defines configuration for the cache of storage clients.
+type Config struct {
+	// Enabled specifies that the clients are wrapped with the cache
+	Enabled bool `mapstructure:"enabled"`
+	// FlushInterval specifies how often the modified entries are written to the storage
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+	// MaxDirtyEntries specifies the number of modified entries which causes them to be written
+	// to the storage before the flush interval has elapsed
+	MaxDirtyEntries int `mapstructure:"max_dirty_entries"`
+	// MaxEntries specifies the number of entries kept in memory, the least recently used
+	// entries being evicted once they are written to the storage
+	MaxEntries int `mapstructure:"max_entries"`
+}
+
+// NewDefaultConfig returns the default configuration, with the cache disabled.
+func NewDefaultConfig() Config {
+	return Config{
+		Enabled:         false,
+		FlushInterval:   defaultFlushInterval,
+		MaxDirtyEntries: defaultMaxDirtyEntries,
+		MaxEntries:      defaultMaxEntries,
+	}
+}
+
+// Validate checks that the configuration is valid when the cache is enabled.
+func (cfg *Config) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	if cfg.FlushInterval <= 0 {
+		return errors.New("cache flush interval must be positive")
+	}
+
+	if cfg.MaxDirtyEntries <= 0 {
+		return errors.New("cache max dirty entries must be positive")
+	}
+
+	if cfg.MaxEntries <= 0 {
+		return errors.New("cache max entries must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagecache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(*Config)
+		expectedErr string
+	}{
+		{
+			name:   "disabled",
+			modify: func(cfg *Config) { cfg.FlushInterval = 0 },
+		},
+		{
+			name:   "enabled",
+			modify: func(cfg *Config) { cfg.Enabled = true },
+		},
+		{
+			name: "flush interval",
+			modify: func(cfg *Config) {
+				cfg.Enabled = true
+				cfg.FlushInterval = 0
+			},
+			expectedErr: "cache flush interval must be positive",
+		},
+		{
+			name: "max dirty entries",
+			modify: func(cfg *Config) {
+				cfg.Enabled = true
+				cfg.MaxDirtyEntries = -1
+			},
+			expectedErr: "cache max dirty entries must be positive",
+		},
+		{
+			name: "max entries",
+			modify: func(cfg *Config) {
+				cfg.Enabled = true
+				cfg.MaxEntries = 0
+			},
+			expectedErr: "cache max entries must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewDefaultConfig()
+			tt.modify(&cfg)
+			err := cfg.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -41,6 +41,13 @@ const (
 	// AWSXRayTracedAttribute is the `traced` field in an X-Ray subsegment
 	AWSXRayTracedAttribute = "aws.xray.traced"
 
+	// AWSXRaySamplingRuleNameAttribute is the `sampling_rule_name` field of the `aws.xray` object in an X-Ray segment
+	AWSXRaySamplingRuleNameAttribute = "aws.xray.sampling_rule_name"
+
+	// AWSXRaySpanLinksMetadataKey is the key, in the `default` metadata namespace, of the
+	// span links the X-Ray exporter stores in a segment.
+	AWSXRaySpanLinksMetadataKey = "otel.span.links"
+
 	// AWSXraySegmentMetadataAttributePrefix is the prefix of the attribute that
 	// will be treated by the X-Ray exporter as metadata. The key of a metadata
 	// will be AWSXraySegmentMetadataAttributePrefix + <metadata_key>.
@@ -63,6 +63,7 @@ type Segment struct {
 	Annotations map[string]interface{}            `json:"annotations,omitempty"`
 	Metadata    map[string]map[string]interface{} `json:"metadata,omitempty"`
 	Subsegments []Segment                         `json:"subsegments,omitempty"`
+	Links       []SpanLinkData                    `json:"links,omitempty"`
 
 	// (for both embedded and independent) subsegment-only (optional) fields.
 	// Please refer to https://docs.aws.amazon.com/xray/latest/devguide/xray-api-segmentdocuments.html#api-segmentdocuments-subsegments
@@ -260,6 +261,14 @@ type XRayMetaData struct {
 	SDK                 *string `json:"sdk,omitempty"`
 	SDKVersion          *string `json:"sdk_version,omitempty"`
 	AutoInstrumentation *bool   `json:"auto_instrumentation"`
+	SamplingRuleName    *string `json:"sampling_rule_name,omitempty"`
+}
+
+// SpanLinkData provides the shape for unmarshalling the links of a segment.
+type SpanLinkData struct {
+	TraceID    *string                `json:"trace_id"`
+	SpanID     *string                `json:"id"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
 }
 
 // SQLData provides the shape for unmarshalling the sql field.
@@ -624,6 +624,28 @@ func TestTraceBodyUnMarshalling(t *testing.T) {
 					testCase+": invalid `cause` implies invalid segment, so unmarshalling should've failed")
 			},
 		},
+		{
+			testCase:   "TestTraceBodyLinksAndSamplingRuleUnmarshalled",
+			samplePath: filepath.Join("testdata", "linksAndSamplingRule.txt"),
+			verification: func(testCase string, actualSeg Segment, err error) {
+				assert.NoError(t, err, testCase+": JSON Unmarshalling should've succeeded")
+
+				assert.Equal(t, String("checkout-rule"), actualSeg.AWS.XRay.SamplingRuleName,
+					testCase+": unmarshalled sampling rule name is different from the expected")
+				assert.Equal(t, []SpanLinkData{
+					{
+						TraceID: String("1-5f187250-2a4e9c3b8d7f1e6a5b0c9d8e"),
+						SpanID:  String("a1b2c3d4e5f60718"),
+						Attributes: map[string]interface{}{
+							"messaging.operation": "process",
+						},
+					},
+				}, actualSeg.Links, testCase+": unmarshalled links are different from the expected")
+				assert.Len(t, actualSeg.Subsegments, 2, testCase+": unmarshalled subsegments have incorrect size")
+				assert.Equal(t, []string{"6a3d2e8f4b1c9a07"}, actualSeg.Subsegments[1].PrecursorIDs,
+					testCase+": unmarshalled precursor ids are different from the expected")
+			},
+		},
 		{
 			testCase:   "TestTraceBodyCorrectlyUnmarshalledForInstrumentedServer",
 			samplePath: filepath.Join("testdata", "serverSample.txt"),
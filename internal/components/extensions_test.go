@@ -47,6 +47,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/sigv4authextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/dbstorage"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/memorystorage"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
 )
 
@@ -201,6 +202,18 @@ func TestDefaultExtensions(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			extension: "memory_storage",
+			getConfigFn: func() config.Extension {
+				cfg := extFactories["memory_storage"].CreateDefaultConfig().(*memorystorage.Config)
+				cfg.Directory = t.TempDir()
+				return cfg
+			},
+		},
+		{
+			extension:     "redis_storage",
+			skipLifecycle: true, // Requires a running Redis server
+		},
 		{
 			extension: "host_observer",
 			getConfigFn: func() config.Extension {
@@ -62,6 +62,9 @@ func TestDefaultProcessors(t *testing.T) {
 		{
 			processor: "batch",
 		},
+		{
+			processor: "deltatocumulative",
+		},
 		{
 			processor: "deltatorate",
 		},
@@ -74,10 +77,16 @@ func TestDefaultProcessors(t *testing.T) {
 		{
 			processor: "groupbytrace",
 		},
+		{
+			processor: "interval",
+		},
 		{
 			processor:     "k8sattributes",
 			skipLifecycle: true, // Requires a k8s API to communicate with
 		},
+		{
+			processor: "logdedup",
+		},
 		{
 			processor: "memory_limiter",
 			getConfigFn: func() config.Processor {
@@ -375,6 +375,9 @@ func TestDefaultReceivers(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			receiver: "tlscheck",
+		},
 		{
 			receiver: "udplog",
 			getConfigFn: func() config.Receiver {
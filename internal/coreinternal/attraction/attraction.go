@@ -24,7 +24,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.uber.org/zap"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterhelper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterhelper"
 )
 
 // Settings specifies the processor settings.
@@ -59,6 +59,13 @@ type ActionKeyValue struct {
 	// the value. If the attribute doesn't exist, no action is performed.
 	FromAttribute string `mapstructure:"from_attribute"`
 
+	// FromEntity specifies the entity whose attributes FromAttribute is read
+	// from: "record" (the default) for the processed attributes, "scope" for
+	// the attributes of the instrumentation scope, or "resource" for the
+	// attributes of the resource. The scope and resource attributes are only
+	// available when the attributes are processed with ProcessWithEntities.
+	FromEntity string `mapstructure:"from_entity"`
+
 	// FromContext specifies the context value to use to populate
 	// the value. The values would be searched in client.Info.Metadata.
 	// If the key doesn't exist, no action is performed.
@@ -70,6 +77,13 @@ type ActionKeyValue struct {
 	// If the value cannot be converted, the original value will be left as-is
 	ConvertedType string `mapstructure:"converted_type"`
 
+	// OnFailure specifies what to do when the action fails: "ignore" (the
+	// default) leaves the attributes as-is, and "delete" deletes the attribute
+	// `key`. A CONVERT action fails when the value cannot be converted, and an
+	// INSERT, UPDATE or UPSERT action fails when the attribute or context value
+	// it reads doesn't exist.
+	OnFailure string `mapstructure:"on_failure"`
+
 	// Action specifies the type of action to perform.
 	// The set of values are {INSERT, UPDATE, UPSERT, DELETE, HASH}.
 	// Both lower case and upper case are supported.
@@ -91,7 +105,8 @@ type ActionKeyValue struct {
 	// EXTRACT - Extracts values using a regular expression rule from the input
 	//           'key' to target keys specified in the 'rule'. If a target key
 	//           already exists, it will be overridden.
-	// CONVERT  - converts the type of an existing attribute, if convertable
+	// CONVERT  - converts the type of an existing attribute, if convertable,
+	//           to the type set in ConvertedType: string, int, double or bool
 	// This is a required field.
 	Action Action `mapstructure:"action"`
 }
@@ -148,11 +163,22 @@ const (
 	CONVERT Action = "convert"
 )
 
+const (
+	recordEntity   = "record"
+	scopeEntity    = "scope"
+	resourceEntity = "resource"
+
+	ignoreOnFailure = "ignore"
+	deleteOnFailure = "delete"
+)
+
 type attributeAction struct {
 	Key           string
 	FromAttribute string
+	FromEntity    string
 	FromContext   string
 	ConvertedType string
+	OnFailure     string
 	// Compiled regex if provided
 	Regex *regexp.Regexp
 	// Attribute names extracted from the regexp's subexpressions.
@@ -276,6 +302,7 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 			case stringConversionTarget:
 			case intConversionTarget:
 			case doubleConversionTarget:
+			case boolConversionTarget:
 			case "":
 				return nil, fmt.Errorf("error creating AttrProc due to missing required field \"converted_type\" for action \"%s\" at the %d-th action", a.Action, i)
 			default:
@@ -286,13 +313,56 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 			return nil, fmt.Errorf("error creating AttrProc due to unsupported action %q at the %d-th actions", a.Action, i)
 		}
 
+		switch a.FromEntity {
+		case "", recordEntity, scopeEntity, resourceEntity:
+		default:
+			return nil, fmt.Errorf("error creating AttrProc due to invalid value \"%s\" in field \"from_entity\" at the %d-th action", a.FromEntity, i)
+		}
+		if a.FromEntity != "" && a.FromAttribute == "" {
+			return nil, fmt.Errorf("error creating AttrProc. Field \"from_entity\" requires the field \"from_attribute\" to be set for %d-th action", i)
+		}
+		action.FromEntity = a.FromEntity
+
+		switch a.OnFailure {
+		case "", ignoreOnFailure, deleteOnFailure:
+		default:
+			return nil, fmt.Errorf("error creating AttrProc due to invalid value \"%s\" in field \"on_failure\" at the %d-th action", a.OnFailure, i)
+		}
+		if a.OnFailure != "" && !canFail(a) {
+			return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" cannot fail and does not use the \"on_failure\" field. This must not be specified for %d-th action", a.Action, i)
+		}
+		action.OnFailure = a.OnFailure
+
 		attributeActions = append(attributeActions, action)
 	}
 	return &AttrProc{actions: attributeActions}, nil
 }
 
+// canFail returns true for the actions reading a value that may not exist or
+// may not be convertible.
+func canFail(a ActionKeyValue) bool {
+	switch a.Action {
+	case INSERT, UPDATE, UPSERT:
+		return a.Value == nil
+	case CONVERT:
+		return true
+	}
+	return false
+}
+
 // Process applies the AttrProc to an attribute map.
 func (ap *AttrProc) Process(ctx context.Context, logger *zap.Logger, attrs pcommon.Map) {
+	ap.process(ctx, logger, attrs, nil, nil)
+}
+
+// ProcessWithEntities applies the AttrProc to the attribute map of a span,
+// log record or data point. The attributes of its resource and instrumentation
+// scope can be read by the actions setting FromEntity.
+func (ap *AttrProc) ProcessWithEntities(ctx context.Context, logger *zap.Logger, attrs, resource, scope pcommon.Map) {
+	ap.process(ctx, logger, attrs, &resource, &scope)
+}
+
+func (ap *AttrProc) process(ctx context.Context, logger *zap.Logger, attrs pcommon.Map, resource, scope *pcommon.Map) {
 	for _, action := range ap.actions {
 		// TODO https://go.opentelemetry.io/collector/issues/296
 		// Do benchmark testing between having action be of type string vs integer.
@@ -306,8 +376,9 @@ func (ap *AttrProc) Process(ctx context.Context, logger *zap.Logger, attrs pcomm
 				attrs.Remove(k)
 			}
 		case INSERT:
-			av, found := getSourceAttributeValue(ctx, action, attrs)
+			av, found := getSourceAttributeValue(ctx, action, attrs, resource, scope)
 			if !found {
+				onFailure(action, attrs)
 				continue
 			}
 			if _, found = attrs.Get(action.Key); found {
@@ -315,8 +386,9 @@ func (ap *AttrProc) Process(ctx context.Context, logger *zap.Logger, attrs pcomm
 			}
 			av.CopyTo(attrs.PutEmpty(action.Key))
 		case UPDATE:
-			av, found := getSourceAttributeValue(ctx, action, attrs)
+			av, found := getSourceAttributeValue(ctx, action, attrs, resource, scope)
 			if !found {
+				onFailure(action, attrs)
 				continue
 			}
 			val, found := attrs.Get(action.Key)
@@ -325,8 +397,9 @@ func (ap *AttrProc) Process(ctx context.Context, logger *zap.Logger, attrs pcomm
 			}
 			av.CopyTo(val)
 		case UPSERT:
-			av, found := getSourceAttributeValue(ctx, action, attrs)
+			av, found := getSourceAttributeValue(ctx, action, attrs, resource, scope)
 			if !found {
+				onFailure(action, attrs)
 				continue
 			}
 			val, found := attrs.Get(action.Key)
@@ -344,7 +417,9 @@ func (ap *AttrProc) Process(ctx context.Context, logger *zap.Logger, attrs pcomm
 		case EXTRACT:
 			extractAttributes(action, attrs)
 		case CONVERT:
-			convertAttribute(logger, action, attrs)
+			if !convertAttribute(logger, action, attrs) {
+				onFailure(action, attrs)
+			}
 		}
 	}
 }
@@ -391,7 +466,14 @@ func getAttributeValueFromContext(ctx context.Context, key string) (pcommon.Valu
 	return pcommon.NewValueStr(strings.Join(vals, ";")), true
 }
 
-func getSourceAttributeValue(ctx context.Context, action attributeAction, attrs pcommon.Map) (pcommon.Value, bool) {
+// onFailure applies the failure policy of a failed action.
+func onFailure(action attributeAction, attrs pcommon.Map) {
+	if action.OnFailure == deleteOnFailure {
+		attrs.Remove(action.Key)
+	}
+}
+
+func getSourceAttributeValue(ctx context.Context, action attributeAction, attrs pcommon.Map, resource, scope *pcommon.Map) (pcommon.Value, bool) {
 	// Set the key with a value from the configuration.
 	if action.AttributeValue != nil {
 		return *action.AttributeValue, true
@@ -401,6 +483,18 @@ func getSourceAttributeValue(ctx context.Context, action attributeAction, attrs
 		return getAttributeValueFromContext(ctx, action.FromContext)
 	}
 
+	switch action.FromEntity {
+	case resourceEntity:
+		if resource == nil {
+			return pcommon.Value{}, false
+		}
+		return resource.Get(action.FromAttribute)
+	case scopeEntity:
+		if scope == nil {
+			return pcommon.Value{}, false
+		}
+		return scope.Get(action.FromAttribute)
+	}
 	return attrs.Get(action.FromAttribute)
 }
 
@@ -410,10 +504,13 @@ func hashAttribute(key string, attrs pcommon.Map) {
 	}
 }
 
-func convertAttribute(logger *zap.Logger, action attributeAction, attrs pcommon.Map) {
+// convertAttribute converts an existing attribute, and returns false if it
+// cannot be converted.
+func convertAttribute(logger *zap.Logger, action attributeAction, attrs pcommon.Map) bool {
 	if value, exists := attrs.Get(action.Key); exists {
-		convertValue(logger, action.Key, action.ConvertedType, value)
+		return convertValue(logger, action.Key, action.ConvertedType, value)
 	}
+	return true
 }
 
 func extractAttributes(action attributeAction, attrs pcommon.Map) {
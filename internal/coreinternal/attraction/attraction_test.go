@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
 )
 
 // Common structure for all the Tests
@@ -811,6 +812,86 @@ func TestAttributes_Ordering(t *testing.T) {
 	}
 }
 
+func TestAttributes_FromEntity(t *testing.T) {
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{Key: "service.name", Action: UPSERT, FromAttribute: "service.name", FromEntity: "resource"},
+			{Key: "scope.version", Action: INSERT, FromAttribute: "version", FromEntity: "scope"},
+			{Key: "copy", Action: INSERT, FromAttribute: "original", FromEntity: "record"},
+		},
+	}
+	ap, err := NewAttrProc(cfg)
+	require.NoError(t, err)
+
+	resource := pcommon.NewMap()
+	resource.PutStr("service.name", "checkout")
+	scope := pcommon.NewMap()
+	scope.PutStr("version", "1.2.3")
+	attrs := pcommon.NewMap()
+	attrs.PutStr("original", "value")
+	ap.ProcessWithEntities(context.TODO(), zap.NewNop(), attrs, resource, scope)
+	assert.Equal(t, map[string]interface{}{
+		"service.name":  "checkout",
+		"scope.version": "1.2.3",
+		"original":      "value",
+		"copy":          "value",
+	}, attrs.AsRaw())
+
+	// The resource and scope attributes are not available to Process.
+	attrs = pcommon.NewMap()
+	ap.Process(context.TODO(), zap.NewNop(), attrs)
+	assert.Equal(t, map[string]interface{}{}, attrs.AsRaw())
+}
+
+func TestAttributes_OnFailure(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "ConvertedValues",
+			inputAttributes: map[string]interface{}{
+				"enabled": "true",
+				"count":   "12",
+				"copy":    "stale",
+				"source":  "fresh",
+			},
+			expectedAttributes: map[string]interface{}{
+				"enabled": true,
+				"count":   int64(12),
+				"copy":    "fresh",
+				"source":  "fresh",
+			},
+		},
+		{
+			name: "FailedActions",
+			inputAttributes: map[string]interface{}{
+				"enabled": "maybe",
+				"count":   "twelve",
+				"copy":    "stale",
+			},
+			expectedAttributes: map[string]interface{}{
+				"count": "twelve",
+			},
+		},
+	}
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{Key: "enabled", Action: CONVERT, ConvertedType: "bool", OnFailure: "delete"},
+			{Key: "count", Action: CONVERT, ConvertedType: "int", OnFailure: "ignore"},
+			{Key: "copy", Action: UPDATE, FromAttribute: "source", OnFailure: "delete"},
+		},
+	}
+	ap, err := NewAttrProc(cfg)
+	require.NoError(t, err)
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			inputMap := pcommon.NewMap()
+			inputMap.FromRaw(tt.inputAttributes)
+			ap.Process(context.TODO(), zap.NewNop(), inputMap)
+			require.Equal(t, tt.expectedAttributes, inputMap.AsRaw())
+		})
+	}
+}
+
 func TestInvalidConfig(t *testing.T) {
 	testcase := []struct {
 		name        string
@@ -901,6 +982,34 @@ func TestInvalidConfig(t *testing.T) {
 			},
 			errorString: "error creating AttrProc. Field \"pattern\" contains at least one unnamed matcher group at the 0-th actions",
 		},
+		{
+			name: "invalid from entity",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", FromAttribute: "bb", FromEntity: "span", Action: INSERT},
+			},
+			errorString: "error creating AttrProc due to invalid value \"span\" in field \"from_entity\" at the 0-th action",
+		},
+		{
+			name: "from entity without from attribute",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Value: "bb", FromEntity: "resource", Action: INSERT},
+			},
+			errorString: "error creating AttrProc. Field \"from_entity\" requires the field \"from_attribute\" to be set for 0-th action",
+		},
+		{
+			name: "invalid on failure",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", ConvertedType: "int", OnFailure: "drop", Action: CONVERT},
+			},
+			errorString: "error creating AttrProc due to invalid value \"drop\" in field \"on_failure\" at the 0-th action",
+		},
+		{
+			name: "on failure for an action that cannot fail",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Value: "bb", OnFailure: "delete", Action: UPSERT},
+			},
+			errorString: "error creating AttrProc. Action \"upsert\" cannot fail and does not use the \"on_failure\" field. This must not be specified for 0-th action",
+		},
 	}
 
 	for _, tc := range testcase {
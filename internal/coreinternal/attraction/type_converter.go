@@ -25,9 +25,12 @@ const (
 	stringConversionTarget = "string"
 	intConversionTarget    = "int"
 	doubleConversionTarget = "double"
+	boolConversionTarget   = "bool"
 )
 
-func convertValue(logger *zap.Logger, key string, to string, v pcommon.Value) {
+// convertValue converts v to the target type in place, and returns false if
+// the value cannot be converted. Values that cannot be converted are left as-is.
+func convertValue(logger *zap.Logger, key string, to string, v pcommon.Value) bool {
 	switch to {
 	case stringConversionTarget:
 		switch v.Type() {
@@ -53,9 +56,11 @@ func convertValue(logger *zap.Logger, key string, to string, v pcommon.Value) {
 				v.SetInt(n)
 			} else {
 				logger.Debug("String could not be converted to int", zap.String("key", key), zap.String("value", s), zap.Error(err))
+				return false
 			}
 		default:
 			logger.Debug("Unable to convert type", zap.String("key", key), zap.String("from", v.Type().String()), zap.String("to", intConversionTarget))
+			return false
 		}
 	case doubleConversionTarget:
 		switch v.Type() {
@@ -75,10 +80,33 @@ func convertValue(logger *zap.Logger, key string, to string, v pcommon.Value) {
 				v.SetDouble(n)
 			} else {
 				logger.Debug("String could not be converted to double", zap.String("key", key), zap.String("value", s), zap.Error(err))
+				return false
 			}
 		default:
 			logger.Debug("Unable to convert type", zap.String("key", key), zap.String("from", v.Type().String()), zap.String("to", doubleConversionTarget))
+			return false
+		}
+	case boolConversionTarget:
+		switch v.Type() {
+		case pcommon.ValueTypeInt:
+			v.SetBool(v.Int() != 0)
+		case pcommon.ValueTypeDouble:
+			v.SetBool(v.Double() != 0)
+		case pcommon.ValueTypeBool:
+		case pcommon.ValueTypeStr:
+			s := v.Str()
+			b, err := strconv.ParseBool(s)
+			if err == nil {
+				v.SetBool(b)
+			} else {
+				logger.Debug("String could not be converted to bool", zap.String("key", key), zap.String("value", s), zap.Error(err))
+				return false
+			}
+		default:
+			logger.Debug("Unable to convert type", zap.String("key", key), zap.String("from", v.Type().String()), zap.String("to", boolConversionTarget))
+			return false
 		}
 	default: // No-op
 	}
+	return true
 }
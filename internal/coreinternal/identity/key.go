@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identity // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/identity"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// Separator separates the fields of a key. It is the ASCII record separator, which is
+// not expected in the names and values being identified.
+const Separator = "\x1e"
+
+// ResourceKey returns the key identifying the resource.
+func ResourceKey(resource pcommon.Resource) string {
+	var b strings.Builder
+	WriteAttributes(&b, resource.Attributes())
+	return b.String()
+}
+
+// ScopeKey returns the key identifying the scope within the resource of the given key.
+func ScopeKey(resourceKey string, scope pcommon.InstrumentationScope) string {
+	var b strings.Builder
+	b.WriteString(resourceKey)
+	b.WriteString(Separator)
+	b.WriteString(scope.Name())
+	b.WriteString(Separator)
+	b.WriteString(scope.Version())
+	return b.String()
+}
+
+// WriteAttributes writes the attributes to the key, sorted by name.
+func WriteAttributes(b *strings.Builder, attributes pcommon.Map) {
+	b.WriteString(Separator)
+	attributes.Sort().Range(func(k string, v pcommon.Value) bool {
+		b.WriteString(Separator)
+		b.WriteString(k)
+		b.WriteByte(':')
+		WriteValue(b, v)
+		return true
+	})
+}
+
+// WriteValue writes the value to the key along with its type, so that values of different
+// types with the same string representation, such as 1 and "1", have different keys.
+func WriteValue(b *strings.Builder, v pcommon.Value) {
+	b.WriteString(v.Type().String())
+	b.WriteByte(':')
+	b.WriteString(v.AsString())
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestResourceKey(t *testing.T) {
+	r1 := pcommon.NewResource()
+	r1.Attributes().PutStr("service.name", "a")
+	r1.Attributes().PutStr("host.name", "b")
+	r2 := pcommon.NewResource()
+	r2.Attributes().PutStr("host.name", "b")
+	r2.Attributes().PutStr("service.name", "a")
+	assert.Equal(t, ResourceKey(r1), ResourceKey(r2))
+
+	r2.Attributes().PutStr("service.name", "c")
+	assert.NotEqual(t, ResourceKey(r1), ResourceKey(r2))
+}
+
+func TestResourceKeyValueTypes(t *testing.T) {
+	r1 := pcommon.NewResource()
+	r1.Attributes().PutInt("port", 1)
+	r2 := pcommon.NewResource()
+	r2.Attributes().PutStr("port", "1")
+	assert.NotEqual(t, ResourceKey(r1), ResourceKey(r2))
+}
+
+func TestScopeKey(t *testing.T) {
+	s1 := pcommon.NewInstrumentationScope()
+	s1.SetName("a")
+	s1.SetVersion("1")
+	s2 := pcommon.NewInstrumentationScope()
+	s2.SetName("a")
+	s2.SetVersion("2")
+	assert.Equal(t, ScopeKey("r", s1), ScopeKey("r", s1))
+	assert.NotEqual(t, ScopeKey("r", s1), ScopeKey("r", s2))
+	assert.NotEqual(t, ScopeKey("r1", s1), ScopeKey("r2", s1))
+}
@@ -22,7 +22,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filtermatcher"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 )
 
 // MatchConfig has two optional MatchProperties one to define what is processed
@@ -197,32 +198,19 @@ func (mp *MatchProperties) ValidateForLogs() error {
 		return ErrMissingRequiredLogField
 	}
 
+	if mp.LogSeverityNumber != nil && mp.LogSeverityNumber.Max != plog.SeverityNumberUnspecified &&
+		mp.LogSeverityNumber.Max < mp.LogSeverityNumber.Min {
+		return errors.New("log_severity_number max should not be lower than min")
+	}
+
 	return nil
 }
 
 // Attribute specifies the attribute key and optional value to match against.
-type Attribute struct {
-	// Key specifies the attribute key.
-	Key string `mapstructure:"key"`
-
-	// Values specifies the value to match against.
-	// If it is not set, any value will match.
-	Value interface{} `mapstructure:"value"`
-}
+type Attribute = filtermatcher.Attribute
 
 // InstrumentationLibrary specifies the instrumentation library and optional version to match against.
-type InstrumentationLibrary struct {
-	Name string `mapstructure:"name"`
-	// version match
-	//  expected actual  match
-	//  nil      <blank> yes
-	//  nil      1       yes
-	//  <blank>  <blank> yes
-	//  <blank>  1       no
-	//  1        <blank> no
-	//  1        1       yes
-	Version *string `mapstructure:"version"`
-}
+type InstrumentationLibrary = filtermatcher.InstrumentationLibrary
 
 // LogSeverityNumberMatchProperties defines how to match based on a log record's SeverityNumber field.
 type LogSeverityNumberMatchProperties struct {
@@ -230,6 +218,11 @@ type LogSeverityNumberMatchProperties struct {
 	// e.g. if this is plog.SeverityNumberInfo, INFO, WARN, ERROR, and FATAL logs will match.
 	Min plog.SeverityNumber `mapstructure:"min"`
 
+	// Max is the highest severity that may be matched.
+	// e.g. if this is plog.SeverityNumberWarn, TRACE, DEBUG, INFO, and WARN logs will match.
+	// If this is plog.SeverityNumberUnspecified, there is no upper bound.
+	Max plog.SeverityNumber `mapstructure:"max"`
+
 	// MatchUndefined controls whether logs with "undefined" severity matches.
 	// If this is true, entries with undefined severity will match.
 	MatchUndefined bool `mapstructure:"match_undefined"`
@@ -21,8 +21,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermatcher"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filtermatcher"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 )
 
 // Matcher is an interface that allows matching a log record against a
@@ -39,13 +39,13 @@ type propertiesMatcher struct {
 	filtermatcher.PropertiesMatcher
 
 	// log bodies to compare to.
-	bodyFilters filterset.FilterSet
+	bodyMatcher *filtermatcher.BodyMatcher
 
 	// log severity texts to compare to
 	severityTextFilters filterset.FilterSet
 
 	// matcher for severity number
-	severityNumberMatcher Matcher
+	severityNumberMatcher *filtermatcher.SeverityNumberMatcher
 }
 
 // NewMatcher creates a LogRecord Matcher that matches based on the given MatchProperties.
@@ -58,17 +58,21 @@ func NewMatcher(mp *filterconfig.MatchProperties) (Matcher, error) {
 		return nil, err
 	}
 
-	rm, err := filtermatcher.NewMatcher(mp)
+	rm, err := filtermatcher.NewMatcher(mp.Config,
+		filtermatcher.WithLibraries(mp.Libraries...),
+		filtermatcher.WithAttributes(mp.Attributes...),
+		filtermatcher.WithResources(mp.Resources...))
 	if err != nil {
 		return nil, err
 	}
 
-	var bodyFS filterset.FilterSet
+	var bodyMatcher *filtermatcher.BodyMatcher
 	if len(mp.LogBodies) > 0 {
-		bodyFS, err = filterset.CreateFilterSet(mp.LogBodies, &mp.Config)
+		bm, err := filtermatcher.NewBodyMatcher(mp.LogBodies, &mp.Config)
 		if err != nil {
-			return nil, fmt.Errorf("error creating log record body filters: %w", err)
+			return nil, err
 		}
+		bodyMatcher = &bm
 	}
 	var severitytextFS filterset.FilterSet
 	if len(mp.LogSeverityTexts) > 0 {
@@ -78,14 +82,15 @@ func NewMatcher(mp *filterconfig.MatchProperties) (Matcher, error) {
 		}
 	}
 
-	var severityNumberMatcher Matcher
+	var severityNumberMatcher *filtermatcher.SeverityNumberMatcher
 	if mp.LogSeverityNumber != nil {
-		severityNumberMatcher = newSeverityNumberMatcher(mp.LogSeverityNumber.Min, mp.LogSeverityNumber.MatchUndefined)
+		snm := filtermatcher.NewSeverityNumberMatcher(mp.LogSeverityNumber.Min, mp.LogSeverityNumber.Max, mp.LogSeverityNumber.MatchUndefined)
+		severityNumberMatcher = &snm
 	}
 
 	return &propertiesMatcher{
 		PropertiesMatcher:     rm,
-		bodyFilters:           bodyFS,
+		bodyMatcher:           bodyMatcher,
 		severityTextFilters:   severitytextFS,
 		severityNumberMatcher: severityNumberMatcher,
 	}, nil
@@ -100,13 +105,13 @@ func NewMatcher(mp *filterconfig.MatchProperties) (Matcher, error) {
 // supported to have more than one of these specified, and all specified must
 // evaluate to true for a match to occur.
 func (mp *propertiesMatcher) MatchLogRecord(lr plog.LogRecord, resource pcommon.Resource, library pcommon.InstrumentationScope) bool {
-	if lr.Body().Type() == pcommon.ValueTypeStr && mp.bodyFilters != nil && !mp.bodyFilters.Matches(lr.Body().Str()) {
+	if mp.bodyMatcher != nil && !mp.bodyMatcher.Match(lr.Body()) {
 		return false
 	}
 	if mp.severityTextFilters != nil && !mp.severityTextFilters.Matches(lr.SeverityText()) {
 		return false
 	}
-	if mp.severityNumberMatcher != nil && !mp.severityNumberMatcher.MatchLogRecord(lr, resource, library) {
+	if mp.severityNumberMatcher != nil && !mp.severityNumberMatcher.Match(lr.SeverityNumber()) {
 		return false
 	}
 
@@ -23,7 +23,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 )
 
 func createConfig(matchType filterset.MatchType) *filterset.Config {
@@ -81,6 +81,25 @@ func TestLogRecord_validateMatchesConfiguration_InvalidConfig(t *testing.T) {
 			},
 			errorString: "error creating attribute filters: error parsing regexp: missing closing ]: `[`",
 		},
+		{
+			name: "invalid_log_body_regexp_pattern",
+			property: filterconfig.MatchProperties{
+				Config:    *createConfig(filterset.Regexp),
+				LogBodies: []string{"["},
+			},
+			errorString: "error creating log record body filters: error parsing regexp: missing closing ]: `[`",
+		},
+		{
+			name: "log_severity_number_max_lower_than_min",
+			property: filterconfig.MatchProperties{
+				Config: *createConfig(filterset.Regexp),
+				LogSeverityNumber: &filterconfig.LogSeverityNumberMatchProperties{
+					Min: plog.SeverityNumberWarn,
+					Max: plog.SeverityNumberInfo,
+				},
+			},
+			errorString: "log_severity_number max should not be lower than min",
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -133,6 +152,16 @@ func TestLogRecord_Matching_False(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "log_max_severity_trace_dont_match",
+			properties: &filterconfig.MatchProperties{
+				Config: *createConfig(filterset.Regexp),
+				LogSeverityNumber: &filterconfig.LogSeverityNumberMatchProperties{
+					Min: plog.SeverityNumberTrace2,
+					Max: plog.SeverityNumberDebug,
+				},
+			},
+		},
 	}
 
 	lr := plog.NewLogRecord()
@@ -193,6 +222,16 @@ func TestLogRecord_Matching_True(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "log_severity_range_match",
+			properties: &filterconfig.MatchProperties{
+				Config: *createConfig(filterset.Regexp),
+				LogSeverityNumber: &filterconfig.LogSeverityNumberMatchProperties{
+					Min: plog.SeverityNumberDebug,
+					Max: plog.SeverityNumberInfo,
+				},
+			},
+		},
 	}
 
 	lr := plog.NewLogRecord()
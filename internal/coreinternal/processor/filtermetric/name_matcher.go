@@ -17,7 +17,7 @@ package filtermetric // import "github.com/open-telemetry/opentelemetry-collecto
 import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 )
 
 // nameMatcher matches metrics by metric properties against prespecified values for each property.
@@ -22,8 +22,8 @@ import (
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermatcher"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filtermatcher"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 )
 
 // Matcher is an interface that allows matching a span against a configuration
@@ -59,7 +59,10 @@ func NewMatcher(mp *filterconfig.MatchProperties) (Matcher, error) {
 		return nil, err
 	}
 
-	rm, err := filtermatcher.NewMatcher(mp)
+	rm, err := filtermatcher.NewMatcher(mp.Config,
+		filtermatcher.WithLibraries(mp.Libraries...),
+		filtermatcher.WithAttributes(mp.Attributes...),
+		filtermatcher.WithResources(mp.Resources...))
 	if err != nil {
 		return nil, err
 	}
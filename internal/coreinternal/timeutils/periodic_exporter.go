@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timeutils // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/timeutils"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// PeriodicExporter calls an export function at the end of every interval while it is started,
+// and a last time when it is shut down, so that the data aggregated so far is not lost.
+type PeriodicExporter struct {
+	interval time.Duration
+	export   func(context.Context)
+
+	cancelFunc context.CancelFunc
+	done       chan struct{}
+}
+
+// NewPeriodicExporter creates a PeriodicExporter calling export every interval.
+func NewPeriodicExporter(interval time.Duration, export func(context.Context)) *PeriodicExporter {
+	return &PeriodicExporter{interval: interval, export: export}
+}
+
+// Start starts the periodic export.
+func (e *PeriodicExporter) Start(context.Context, component.Host) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	e.cancelFunc = cancel
+	e.done = make(chan struct{})
+	go e.run(ctx)
+	return nil
+}
+
+// Shutdown stops the periodic export and exports the data aggregated so far.
+func (e *PeriodicExporter) Shutdown(ctx context.Context) error {
+	if e.cancelFunc != nil {
+		e.cancelFunc()
+		<-e.done
+	}
+	e.export(ctx)
+	return nil
+}
+
+func (e *PeriodicExporter) run(ctx context.Context) {
+	defer close(e.done)
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			e.export(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
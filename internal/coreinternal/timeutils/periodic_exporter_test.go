@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timeutils
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodicExporter(t *testing.T) {
+	var exports int64
+	e := NewPeriodicExporter(time.Millisecond, func(context.Context) { atomic.AddInt64(&exports, 1) })
+
+	require.NoError(t, e.Start(context.Background(), nil))
+	assert.Eventually(t, func() bool { return atomic.LoadInt64(&exports) >= 2 }, time.Second, time.Millisecond)
+
+	require.NoError(t, e.Shutdown(context.Background()))
+	stopped := atomic.LoadInt64(&exports)
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, stopped, atomic.LoadInt64(&exports))
+}
+
+func TestPeriodicExporterShutdownWithoutStart(t *testing.T) {
+	exports := 0
+	e := NewPeriodicExporter(time.Minute, func(context.Context) { exports++ })
+
+	require.NoError(t, e.Shutdown(context.Background()))
+	assert.Equal(t, 1, exports)
+}
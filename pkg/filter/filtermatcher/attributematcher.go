@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package filtermatcher // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermatcher"
+package filtermatcher // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filtermatcher"
 
 import (
 	"errors"
@@ -21,11 +21,21 @@ import (
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterhelper"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterhelper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 )
 
+// Attribute specifies the attribute key and optional value to match against.
+type Attribute struct {
+	// Key specifies the attribute key.
+	Key string `mapstructure:"key"`
+
+	// Values specifies the value to match against.
+	// If it is not set, any value will match.
+	Value interface{} `mapstructure:"value"`
+}
+
+// AttributesMatcher matches attributes against a list of AttributeMatcher, all of which must match.
 type AttributesMatcher []AttributeMatcher
 
 // AttributeMatcher is a attribute key/value pair to match to.
@@ -39,7 +49,9 @@ type AttributeMatcher struct {
 
 var errUnexpectedAttributeType = errors.New("unexpected attribute type")
 
-func NewAttributesMatcher(config filterset.Config, attributes []filterconfig.Attribute) (AttributesMatcher, error) {
+// NewAttributesMatcher creates an AttributesMatcher from the attributes, comparing the values as configured by config.
+// Values are compared using strict equality, unless the match type is regexp, in which case only string values are allowed.
+func NewAttributesMatcher(config filterset.Config, attributes []Attribute) (AttributesMatcher, error) {
 	// Convert attribute values from mp representation to in-memory representation.
 	var rawAttributes []AttributeMatcher
 	for _, attribute := range attributes {
@@ -12,15 +12,14 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package filtermatcher // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermatcher"
+package filtermatcher // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filtermatcher"
 
 import (
 	"fmt"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 )
 
 type instrumentationLibraryMatcher struct {
@@ -40,18 +39,68 @@ type PropertiesMatcher struct {
 	resources AttributesMatcher
 }
 
-// NewMatcher creates a span Matcher that matches based on the given MatchProperties.
-func NewMatcher(mp *filterconfig.MatchProperties) (PropertiesMatcher, error) {
+// InstrumentationLibrary specifies the instrumentation library and optional version to match against.
+type InstrumentationLibrary struct {
+	Name string `mapstructure:"name"`
+	// version match
+	//  expected actual  match
+	//  nil      <blank> yes
+	//  nil      1       yes
+	//  <blank>  <blank> yes
+	//  <blank>  1       no
+	//  1        <blank> no
+	//  1        1       yes
+	Version *string `mapstructure:"version"`
+}
+
+// Option configures the properties matched by a PropertiesMatcher.
+type Option func(*matcherOptions)
+
+type matcherOptions struct {
+	libraries  []InstrumentationLibrary
+	attributes []Attribute
+	resources  []Attribute
+}
+
+// WithLibraries matches the instrumentation scope against all the given libraries.
+func WithLibraries(libraries ...InstrumentationLibrary) Option {
+	return func(o *matcherOptions) {
+		o.libraries = append(o.libraries, libraries...)
+	}
+}
+
+// WithAttributes matches the attributes of the span or log record against all the given attributes.
+func WithAttributes(attributes ...Attribute) Option {
+	return func(o *matcherOptions) {
+		o.attributes = append(o.attributes, attributes...)
+	}
+}
+
+// WithResources matches the resource attributes against all the given attributes.
+func WithResources(resources ...Attribute) Option {
+	return func(o *matcherOptions) {
+		o.resources = append(o.resources, resources...)
+	}
+}
+
+// NewMatcher creates a PropertiesMatcher that matches the properties set by the options,
+// comparing the strings as configured by cfg.
+func NewMatcher(cfg filterset.Config, opts ...Option) (PropertiesMatcher, error) {
+	var mo matcherOptions
+	for _, opt := range opts {
+		opt(&mo)
+	}
+
 	var lm []instrumentationLibraryMatcher
-	for _, library := range mp.Libraries {
-		name, err := filterset.CreateFilterSet([]string{library.Name}, &mp.Config)
+	for _, library := range mo.libraries {
+		name, err := filterset.CreateFilterSet([]string{library.Name}, &cfg)
 		if err != nil {
 			return PropertiesMatcher{}, fmt.Errorf("error creating library name filters: %w", err)
 		}
 
 		var version filterset.FilterSet
 		if library.Version != nil {
-			filter, err := filterset.CreateFilterSet([]string{*library.Version}, &mp.Config)
+			filter, err := filterset.CreateFilterSet([]string{*library.Version}, &cfg)
 			if err != nil {
 				return PropertiesMatcher{}, fmt.Errorf("error creating library version filters: %w", err)
 			}
@@ -63,16 +112,16 @@ func NewMatcher(mp *filterconfig.MatchProperties) (PropertiesMatcher, error) {
 
 	var err error
 	var am AttributesMatcher
-	if len(mp.Attributes) > 0 {
-		am, err = NewAttributesMatcher(mp.Config, mp.Attributes)
+	if len(mo.attributes) > 0 {
+		am, err = NewAttributesMatcher(cfg, mo.attributes)
 		if err != nil {
 			return PropertiesMatcher{}, fmt.Errorf("error creating attribute filters: %w", err)
 		}
 	}
 
 	var rm AttributesMatcher
-	if len(mp.Resources) > 0 {
-		rm, err = NewAttributesMatcher(mp.Config, mp.Resources)
+	if len(mo.resources) > 0 {
+		rm, err = NewAttributesMatcher(cfg, mo.resources)
 		if err != nil {
 			return PropertiesMatcher{}, fmt.Errorf("error creating resource filters: %w", err)
 		}
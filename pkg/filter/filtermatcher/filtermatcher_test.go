@@ -22,10 +22,24 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 )
 
+// matchProperties groups the options of a PropertiesMatcher for the table driven tests.
+type matchProperties struct {
+	Config     filterset.Config
+	Libraries  []InstrumentationLibrary
+	Attributes []Attribute
+	Resources  []Attribute
+}
+
+func newMatcher(mp *matchProperties) (PropertiesMatcher, error) {
+	return NewMatcher(mp.Config,
+		WithLibraries(mp.Libraries...),
+		WithAttributes(mp.Attributes...),
+		WithResources(mp.Resources...))
+}
+
 func createConfig(matchType filterset.MatchType) *filterset.Config {
 	return &filterset.Config{
 		MatchType: matchType,
@@ -36,14 +50,14 @@ func Test_validateMatchesConfiguration_InvalidConfig(t *testing.T) {
 	version := "["
 	testcases := []struct {
 		name        string
-		property    filterconfig.MatchProperties
+		property    matchProperties
 		errorString string
 	}{
 		{
 			name: "regexp_match_type_for_int_attribute",
-			property: filterconfig.MatchProperties{
+			property: matchProperties{
 				Config: *createConfig(filterset.Regexp),
-				Attributes: []filterconfig.Attribute{
+				Attributes: []Attribute{
 					{Key: "key", Value: 1},
 				},
 			},
@@ -51,9 +65,9 @@ func Test_validateMatchesConfiguration_InvalidConfig(t *testing.T) {
 		},
 		{
 			name: "unknown_attribute_value",
-			property: filterconfig.MatchProperties{
+			property: matchProperties{
 				Config: *createConfig(filterset.Strict),
-				Attributes: []filterconfig.Attribute{
+				Attributes: []Attribute{
 					{Key: "key", Value: []string{}},
 				},
 			},
@@ -61,42 +75,41 @@ func Test_validateMatchesConfiguration_InvalidConfig(t *testing.T) {
 		},
 		{
 			name: "invalid_regexp_pattern_attribute",
-			property: filterconfig.MatchProperties{
+			property: matchProperties{
 				Config:     *createConfig(filterset.Regexp),
-				Attributes: []filterconfig.Attribute{{Key: "key", Value: "["}},
+				Attributes: []Attribute{{Key: "key", Value: "["}},
 			},
 			errorString: "error creating attribute filters: error parsing regexp: missing closing ]: `[`",
 		},
 		{
 			name: "invalid_regexp_pattern_resource",
-			property: filterconfig.MatchProperties{
+			property: matchProperties{
 				Config:    *createConfig(filterset.Regexp),
-				Resources: []filterconfig.Attribute{{Key: "key", Value: "["}},
+				Resources: []Attribute{{Key: "key", Value: "["}},
 			},
 			errorString: "error creating resource filters: error parsing regexp: missing closing ]: `[`",
 		},
 		{
 			name: "invalid_regexp_pattern_library_name",
-			property: filterconfig.MatchProperties{
+			property: matchProperties{
 				Config:    *createConfig(filterset.Regexp),
-				Libraries: []filterconfig.InstrumentationLibrary{{Name: "["}},
+				Libraries: []InstrumentationLibrary{{Name: "["}},
 			},
 			errorString: "error creating library name filters: error parsing regexp: missing closing ]: `[`",
 		},
 		{
 			name: "invalid_regexp_pattern_library_version",
-			property: filterconfig.MatchProperties{
+			property: matchProperties{
 				Config:    *createConfig(filterset.Regexp),
-				Libraries: []filterconfig.InstrumentationLibrary{{Name: "lib", Version: &version}},
+				Libraries: []InstrumentationLibrary{{Name: "lib", Version: &version}},
 			},
 			errorString: "error creating library version filters: error parsing regexp: missing closing ]: `[`",
 		},
 		{
 			name: "empty_key_name_in_attributes_list",
-			property: filterconfig.MatchProperties{
-				Config:   *createConfig(filterset.Strict),
-				Services: []string{"a"},
-				Attributes: []filterconfig.Attribute{
+			property: matchProperties{
+				Config: *createConfig(filterset.Strict),
+				Attributes: []Attribute{
 					{
 						Key: "",
 					},
@@ -107,7 +120,7 @@ func Test_validateMatchesConfiguration_InvalidConfig(t *testing.T) {
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
-			output, err := NewMatcher(&tc.property)
+			output, err := newMatcher(&tc.property)
 			assert.Zero(t, output)
 			assert.EqualError(t, err, tc.errorString)
 		})
@@ -118,31 +131,28 @@ func Test_Matching_False(t *testing.T) {
 	version := "wrong"
 	testcases := []struct {
 		name       string
-		properties *filterconfig.MatchProperties
+		properties *matchProperties
 	}{
 		{
 			name: "wrong_library_name",
-			properties: &filterconfig.MatchProperties{
+			properties: &matchProperties{
 				Config:    *createConfig(filterset.Strict),
-				Services:  []string{},
-				Libraries: []filterconfig.InstrumentationLibrary{{Name: "wrong"}},
+				Libraries: []InstrumentationLibrary{{Name: "wrong"}},
 			},
 		},
 		{
 			name: "wrong_library_version",
-			properties: &filterconfig.MatchProperties{
+			properties: &matchProperties{
 				Config:    *createConfig(filterset.Strict),
-				Services:  []string{},
-				Libraries: []filterconfig.InstrumentationLibrary{{Name: "lib", Version: &version}},
+				Libraries: []InstrumentationLibrary{{Name: "lib", Version: &version}},
 			},
 		},
 
 		{
 			name: "wrong_attribute_value",
-			properties: &filterconfig.MatchProperties{
-				Config:   *createConfig(filterset.Strict),
-				Services: []string{},
-				Attributes: []filterconfig.Attribute{
+			properties: &matchProperties{
+				Config: *createConfig(filterset.Strict),
+				Attributes: []Attribute{
 					{
 						Key:   "keyInt",
 						Value: 1234,
@@ -152,10 +162,9 @@ func Test_Matching_False(t *testing.T) {
 		},
 		{
 			name: "wrong_resource_value",
-			properties: &filterconfig.MatchProperties{
-				Config:   *createConfig(filterset.Strict),
-				Services: []string{},
-				Resources: []filterconfig.Attribute{
+			properties: &matchProperties{
+				Config: *createConfig(filterset.Strict),
+				Resources: []Attribute{
 					{
 						Key:   "keyInt",
 						Value: 1234,
@@ -165,10 +174,9 @@ func Test_Matching_False(t *testing.T) {
 		},
 		{
 			name: "incompatible_attribute_value",
-			properties: &filterconfig.MatchProperties{
-				Config:   *createConfig(filterset.Strict),
-				Services: []string{},
-				Attributes: []filterconfig.Attribute{
+			properties: &matchProperties{
+				Config: *createConfig(filterset.Strict),
+				Attributes: []Attribute{
 					{
 						Key:   "keyInt",
 						Value: "123",
@@ -178,10 +186,9 @@ func Test_Matching_False(t *testing.T) {
 		},
 		{
 			name: "unsupported_attribute_value",
-			properties: &filterconfig.MatchProperties{
-				Config:   *createConfig(filterset.Regexp),
-				Services: []string{},
-				Attributes: []filterconfig.Attribute{
+			properties: &matchProperties{
+				Config: *createConfig(filterset.Regexp),
+				Attributes: []Attribute{
 					{
 						Key:   "keyMap",
 						Value: "123",
@@ -191,10 +198,9 @@ func Test_Matching_False(t *testing.T) {
 		},
 		{
 			name: "property_key_does_not_exist",
-			properties: &filterconfig.MatchProperties{
-				Config:   *createConfig(filterset.Strict),
-				Services: []string{},
-				Attributes: []filterconfig.Attribute{
+			properties: &matchProperties{
+				Config: *createConfig(filterset.Strict),
+				Attributes: []Attribute{
 					{
 						Key:   "doesnotexist",
 						Value: nil,
@@ -216,7 +222,7 @@ func Test_Matching_False(t *testing.T) {
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
-			matcher, err := NewMatcher(tc.properties)
+			matcher, err := newMatcher(tc.properties)
 			require.NoError(t, err)
 			assert.NotNil(t, matcher)
 
@@ -226,9 +232,9 @@ func Test_Matching_False(t *testing.T) {
 }
 
 func Test_MatchingCornerCases(t *testing.T) {
-	cfg := &filterconfig.MatchProperties{
+	cfg := &matchProperties{
 		Config: *createConfig(filterset.Strict),
-		Attributes: []filterconfig.Attribute{
+		Attributes: []Attribute{
 			{
 				Key:   "keyOne",
 				Value: nil,
@@ -236,7 +242,7 @@ func Test_MatchingCornerCases(t *testing.T) {
 		},
 	}
 
-	mp, err := NewMatcher(cfg)
+	mp, err := newMatcher(cfg)
 	assert.Nil(t, err)
 	assert.NotNil(t, mp)
 
@@ -248,30 +254,29 @@ func Test_Matching_True(t *testing.T) {
 
 	testcases := []struct {
 		name       string
-		properties *filterconfig.MatchProperties
+		properties *matchProperties
 	}{
 		{
 			name: "library_match",
-			properties: &filterconfig.MatchProperties{
+			properties: &matchProperties{
 				Config:     *createConfig(filterset.Regexp),
-				Libraries:  []filterconfig.InstrumentationLibrary{{Name: "li.*"}},
-				Attributes: []filterconfig.Attribute{},
+				Libraries:  []InstrumentationLibrary{{Name: "li.*"}},
+				Attributes: []Attribute{},
 			},
 		},
 		{
 			name: "library_match_with_version",
-			properties: &filterconfig.MatchProperties{
+			properties: &matchProperties{
 				Config:     *createConfig(filterset.Regexp),
-				Libraries:  []filterconfig.InstrumentationLibrary{{Name: "li.*", Version: &ver}},
-				Attributes: []filterconfig.Attribute{},
+				Libraries:  []InstrumentationLibrary{{Name: "li.*", Version: &ver}},
+				Attributes: []Attribute{},
 			},
 		},
 		{
 			name: "attribute_exact_value_match",
-			properties: &filterconfig.MatchProperties{
-				Config:   *createConfig(filterset.Strict),
-				Services: []string{},
-				Attributes: []filterconfig.Attribute{
+			properties: &matchProperties{
+				Config: *createConfig(filterset.Strict),
+				Attributes: []Attribute{
 					{
 						Key:   "keyString",
 						Value: "arithmetic",
@@ -293,9 +298,9 @@ func Test_Matching_True(t *testing.T) {
 		},
 		{
 			name: "attribute_regex_value_match",
-			properties: &filterconfig.MatchProperties{
+			properties: &matchProperties{
 				Config: *createConfig(filterset.Regexp),
-				Attributes: []filterconfig.Attribute{
+				Attributes: []Attribute{
 					{
 						Key:   "keyString",
 						Value: "arith.*",
@@ -317,9 +322,9 @@ func Test_Matching_True(t *testing.T) {
 		},
 		{
 			name: "resource_exact_value_match",
-			properties: &filterconfig.MatchProperties{
+			properties: &matchProperties{
 				Config: *createConfig(filterset.Strict),
-				Resources: []filterconfig.Attribute{
+				Resources: []Attribute{
 					{
 						Key:   "resString",
 						Value: "arithmetic",
@@ -329,10 +334,9 @@ func Test_Matching_True(t *testing.T) {
 		},
 		{
 			name: "property_exists",
-			properties: &filterconfig.MatchProperties{
-				Config:   *createConfig(filterset.Strict),
-				Services: []string{"svcA"},
-				Attributes: []filterconfig.Attribute{
+			properties: &matchProperties{
+				Config: *createConfig(filterset.Strict),
+				Attributes: []Attribute{
 					{
 						Key:   "keyExists",
 						Value: nil,
@@ -342,10 +346,9 @@ func Test_Matching_True(t *testing.T) {
 		},
 		{
 			name: "match_all_settings_exists",
-			properties: &filterconfig.MatchProperties{
-				Config:   *createConfig(filterset.Strict),
-				Services: []string{"svcA"},
-				Attributes: []filterconfig.Attribute{
+			properties: &matchProperties{
+				Config: *createConfig(filterset.Strict),
+				Attributes: []Attribute{
 					{
 						Key:   "keyExists",
 						Value: nil,
@@ -378,7 +381,7 @@ func Test_Matching_True(t *testing.T) {
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
-			mp, err := NewMatcher(tc.properties)
+			mp, err := newMatcher(tc.properties)
 			require.NoError(t, err)
 			assert.NotNil(t, mp)
 
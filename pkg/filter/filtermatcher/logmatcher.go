@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtermatcher // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filtermatcher"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
+)
+
+// SeverityNumberMatcher matches log record severity numbers against an inclusive range.
+type SeverityNumberMatcher struct {
+	minSeverityNumber plog.SeverityNumber
+	maxSeverityNumber plog.SeverityNumber
+	matchUndefined    bool
+}
+
+// NewSeverityNumberMatcher creates a SeverityNumberMatcher matching the severities between min and max.
+// A max of plog.SeverityNumberUnspecified means that the range has no upper bound.
+// Whether undefined severities match is controlled by matchUndefined only.
+func NewSeverityNumberMatcher(min, max plog.SeverityNumber, matchUndefined bool) SeverityNumberMatcher {
+	return SeverityNumberMatcher{
+		minSeverityNumber: min,
+		maxSeverityNumber: max,
+		matchUndefined:    matchUndefined,
+	}
+}
+
+// Match returns true if the severity number is within the range.
+func (m SeverityNumberMatcher) Match(severity plog.SeverityNumber) bool {
+	// behavior on SeverityNumberUNDEFINED is explicitly defined by matchUndefined
+	if severity == plog.SeverityNumberUnspecified {
+		return m.matchUndefined
+	}
+
+	if severity < m.minSeverityNumber {
+		return false
+	}
+	return m.maxSeverityNumber == plog.SeverityNumberUnspecified || severity <= m.maxSeverityNumber
+}
+
+// BodyMatcher matches log record bodies against a list of strings or regular expressions.
+type BodyMatcher struct {
+	filters filterset.FilterSet
+}
+
+// NewBodyMatcher creates a BodyMatcher matching the bodies according to the match type of the config.
+func NewBodyMatcher(bodies []string, cfg *filterset.Config) (BodyMatcher, error) {
+	fs, err := filterset.CreateFilterSet(bodies, cfg)
+	if err != nil {
+		return BodyMatcher{}, fmt.Errorf("error creating log record body filters: %w", err)
+	}
+	return BodyMatcher{filters: fs}, nil
+}
+
+// Match returns true if the body matches at least one of the strings or regular expressions.
+// Only string bodies can be compared, so bodies of any other type always match.
+func (m BodyMatcher) Match(body pcommon.Value) bool {
+	if body.Type() != pcommon.ValueTypeStr {
+		return true
+	}
+	return m.filters.Matches(body.Str())
+}
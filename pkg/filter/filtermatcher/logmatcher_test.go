@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtermatcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
+)
+
+func TestSeverityNumberMatcher_Match(t *testing.T) {
+	testCases := []struct {
+		name           string
+		minSeverity    plog.SeverityNumber
+		maxSeverity    plog.SeverityNumber
+		matchUndefined bool
+		inputSeverity  plog.SeverityNumber
+		matches        bool
+	}{
+		{
+			name:          "INFO matches if TRACE is min",
+			minSeverity:   plog.SeverityNumberTrace,
+			inputSeverity: plog.SeverityNumberInfo,
+			matches:       true,
+		},
+		{
+			name:          "INFO matches if INFO is min",
+			minSeverity:   plog.SeverityNumberInfo,
+			inputSeverity: plog.SeverityNumberInfo,
+			matches:       true,
+		},
+		{
+			name:          "INFO does not match if WARN is min",
+			minSeverity:   plog.SeverityNumberWarn,
+			inputSeverity: plog.SeverityNumberInfo,
+			matches:       false,
+		},
+		{
+			name:          "INFO does not match if INFO2 is min",
+			minSeverity:   plog.SeverityNumberInfo2,
+			inputSeverity: plog.SeverityNumberInfo,
+			matches:       false,
+		},
+		{
+			name:          "INFO2 matches if INFO is min",
+			minSeverity:   plog.SeverityNumberInfo,
+			inputSeverity: plog.SeverityNumberInfo2,
+			matches:       true,
+		},
+		{
+			name:          "UNDEFINED does not match if TRACE is min",
+			minSeverity:   plog.SeverityNumberTrace,
+			inputSeverity: plog.SeverityNumberUnspecified,
+			matches:       false,
+		},
+		{
+			name:          "UNDEFINED does not match if UNDEFINED is min",
+			minSeverity:   plog.SeverityNumberUnspecified,
+			inputSeverity: plog.SeverityNumberUnspecified,
+			matches:       false,
+		},
+		{
+			name:           "UNDEFINED matches if matchUndefined is true",
+			minSeverity:    plog.SeverityNumberUnspecified,
+			matchUndefined: true,
+			inputSeverity:  plog.SeverityNumberUnspecified,
+			matches:        true,
+		},
+		{
+			name:          "WARN matches if INFO is min and ERROR is max",
+			minSeverity:   plog.SeverityNumberInfo,
+			maxSeverity:   plog.SeverityNumberError,
+			inputSeverity: plog.SeverityNumberWarn,
+			matches:       true,
+		},
+		{
+			name:          "ERROR matches if ERROR is max",
+			minSeverity:   plog.SeverityNumberInfo,
+			maxSeverity:   plog.SeverityNumberError,
+			inputSeverity: plog.SeverityNumberError,
+			matches:       true,
+		},
+		{
+			name:          "ERROR2 does not match if ERROR is max",
+			minSeverity:   plog.SeverityNumberInfo,
+			maxSeverity:   plog.SeverityNumberError,
+			inputSeverity: plog.SeverityNumberError2,
+			matches:       false,
+		},
+		{
+			name:           "UNDEFINED matches within a range if matchUndefined is true",
+			minSeverity:    plog.SeverityNumberInfo,
+			maxSeverity:    plog.SeverityNumberError,
+			matchUndefined: true,
+			inputSeverity:  plog.SeverityNumberUnspecified,
+			matches:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher := NewSeverityNumberMatcher(tc.minSeverity, tc.maxSeverity, tc.matchUndefined)
+			require.Equal(t, tc.matches, matcher.Match(tc.inputSeverity))
+		})
+	}
+}
+
+func TestBodyMatcher_Match(t *testing.T) {
+	testCases := []struct {
+		name      string
+		matchType filterset.MatchType
+		bodies    []string
+		body      pcommon.Value
+		matches   bool
+	}{
+		{
+			name:      "strict match",
+			matchType: filterset.Strict,
+			bodies:    []string{"hello"},
+			body:      pcommon.NewValueStr("hello"),
+			matches:   true,
+		},
+		{
+			name:      "strict mismatch",
+			matchType: filterset.Strict,
+			bodies:    []string{"hello"},
+			body:      pcommon.NewValueStr("hello world"),
+			matches:   false,
+		},
+		{
+			name:      "regexp match",
+			matchType: filterset.Regexp,
+			bodies:    []string{"^hello"},
+			body:      pcommon.NewValueStr("hello world"),
+			matches:   true,
+		},
+		{
+			name:      "regexp mismatch",
+			matchType: filterset.Regexp,
+			bodies:    []string{"^world"},
+			body:      pcommon.NewValueStr("hello world"),
+			matches:   false,
+		},
+		{
+			name:      "non string body",
+			matchType: filterset.Strict,
+			bodies:    []string{"hello"},
+			body:      pcommon.NewValueInt(1),
+			matches:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher, err := NewBodyMatcher(tc.bodies, &filterset.Config{MatchType: tc.matchType})
+			require.NoError(t, err)
+			assert.Equal(t, tc.matches, matcher.Match(tc.body))
+		})
+	}
+}
+
+func TestNewBodyMatcher_InvalidRegexp(t *testing.T) {
+	_, err := NewBodyMatcher([]string{"("}, &filterset.Config{MatchType: filterset.Regexp})
+	assert.Error(t, err)
+}
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package regexp // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset/regexp"
+package regexp // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset/regexp"
 
 import (
 	"regexp"
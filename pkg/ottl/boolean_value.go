@@ -121,6 +121,15 @@ func (p *Parser[K]) newBooleanValueEvaluator(value *booleanValue) (boolExpressio
 	if value == nil {
 		return alwaysTrue[K], nil
 	}
+	if value.Negation != nil {
+		f, err := p.newBooleanValueEvaluator(&booleanValue{Comparison: value.Comparison, ConstExpr: value.ConstExpr, SubExpr: value.SubExpr})
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx K) bool {
+			return !f(ctx)
+		}, nil
+	}
 	switch {
 	case value.Comparison != nil:
 		comparison, err := p.newComparisonEvaluator(value.Comparison)
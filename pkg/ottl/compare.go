@@ -17,6 +17,7 @@ package ottl // import "github.com/open-telemetry/opentelemetry-collector-contri
 import (
 	"bytes"
 
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.uber.org/zap"
 	"golang.org/x/exp/constraints"
 )
@@ -24,6 +25,7 @@ import (
 // The functions in this file implement a general-purpose comparison of two
 // values of type any, which for the purposes of OTTL mean values that are one of
 // int, float, string, bool, or pointers to those, or []byte, or nil.
+// Trace and span IDs are compared as their bytes.
 
 // invalidComparison returns false for everything except NE (where it returns true to indicate that the
 // objects were definitely not equivalent).
@@ -146,9 +148,23 @@ func (p *Parser[K]) compareFloat64(a float64, b any, op compareOp) bool {
 	}
 }
 
+// idBytes returns the bytes of trace and span IDs, so that they can be compared
+// with each other and with byte literals. Other values are returned unchanged.
+func idBytes(v any) any {
+	switch id := v.(type) {
+	case pcommon.TraceID:
+		return id[:]
+	case pcommon.SpanID:
+		return id[:]
+	default:
+		return v
+	}
+}
+
 // a and b are the return values from a Getter; we try to compare them
 // according to the given operator.
 func (p *Parser[K]) compare(a any, b any, op compareOp) bool {
+	a, b = idBytes(a), idBytes(b)
 	// nils are equal to each other and never equal to anything else,
 	// so if they're both nil, report equality.
 	if a == nil && b == nil {
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
 // Our types are bool, int, float, string, Bytes, nil, so we compare all types in both directions.
@@ -35,6 +36,10 @@ var (
 	i64b = int64(2)
 	f64a = float64(1)
 	f64b = float64(2)
+	tida = pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	tidb = pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 17})
+	sida = pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	sidb = pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 9})
 )
 
 type testA struct {
@@ -106,6 +111,18 @@ func Test_compare(t *testing.T) {
 		{"float64 nil", f64a, nil, []bool{false, true, false, false, false, false}},
 		{"float64 int64", f64a, i64b, []bool{false, true, true, true, false, false}},
 
+		{"identity trace id", tida, tida, []bool{true, false, false, true, true, false}},
+		{"diff trace ids", tida, tidb, []bool{false, true, true, true, false, false}},
+		{"trace id bytes", tida, tida[:], []bool{true, false, false, true, true, false}},
+		{"bytes trace id", tidb[:], tida, []bool{false, true, false, false, true, true}},
+		{"trace id shorter bytes", tida, tida[:8], []bool{false, true, false, false, true, true}},
+		{"trace id string", tida, tida.HexString(), []bool{false, true, false, false, false, false}},
+		{"trace id nil", tida, nil, []bool{false, true, false, false, false, false}},
+		{"identity span id", sida, sida, []bool{true, false, false, true, true, false}},
+		{"diff span ids", sida, sidb, []bool{false, true, true, true, false, false}},
+		{"span id bytes", sida, sida[:], []bool{true, false, false, true, true, false}},
+		{"trace id span id", tida, sida, []bool{false, true, false, false, true, true}},
+
 		{"non-prim, same type, equal", testA{"hi"}, testA{"hi"}, []bool{true, false, false, false, false, false}},
 		{"non-prim, same type, not equal", testA{"hi"}, testA{"byte"}, []bool{false, true, false, false, false, false}},
 		{"non-prim, diff type", testA{"hi"}, testB{"hi"}, []bool{false, true, false, false, false, false}},
@@ -0,0 +1,183 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"fmt"
+)
+
+// FeatureStage is the stability level of a grammar feature.
+type FeatureStage int8
+
+const (
+	// FeatureStageAlpha features are disabled unless the embedding component enables them.
+	// Their syntax and semantics may change, or they may be removed.
+	FeatureStageAlpha FeatureStage = iota
+	// FeatureStageBeta features are enabled unless the embedding component disables them.
+	FeatureStageBeta
+	// FeatureStageStable features are always enabled.
+	FeatureStageStable
+)
+
+func (s FeatureStage) String() string {
+	switch s {
+	case FeatureStageAlpha:
+		return "alpha"
+	case FeatureStageBeta:
+		return "beta"
+	case FeatureStageStable:
+		return "stable"
+	default:
+		return "unknown"
+	}
+}
+
+// Feature is a part of the grammar that is still evolving. Statements using a feature
+// that is not enabled fail to parse, so that configurations written against the stable
+// grammar keep their meaning when new features are introduced.
+type Feature struct {
+	ID          string
+	Stage       FeatureStage
+	Description string
+}
+
+// FeatureNotOperator is the ID of the feature negating boolean values in conditions with the not operator,
+// such as `drop() where not (name == "ping")`.
+const FeatureNotOperator = "not_operator"
+
+var features = []Feature{
+	{
+		ID:          FeatureNotOperator,
+		Stage:       FeatureStageAlpha,
+		Description: "Negate boolean values in conditions with the not operator.",
+	},
+}
+
+// Features returns the grammar features that can be enabled or disabled with WithFeatures and WithoutFeatures.
+func Features() []Feature {
+	return append([]Feature(nil), features...)
+}
+
+func lookupFeature(id string) (Feature, bool) {
+	for _, f := range features {
+		if f.ID == id {
+			return f, true
+		}
+	}
+	return Feature{}, false
+}
+
+// Option configures a Parser.
+type Option[K any] func(*Parser[K])
+
+// WithFeatures enables grammar features for the statements of the parser.
+// Enabling a beta or stable feature has no effect.
+func WithFeatures[K any](ids ...string) Option[K] {
+	return func(p *Parser[K]) {
+		for _, id := range ids {
+			if _, ok := lookupFeature(id); !ok {
+				p.featureErrs = append(p.featureErrs, fmt.Errorf("unknown grammar feature %q", id))
+				continue
+			}
+			p.enabledFeatures[id] = true
+		}
+	}
+}
+
+// WithoutFeatures disables beta grammar features for the statements of the parser.
+// Stable features cannot be disabled.
+func WithoutFeatures[K any](ids ...string) Option[K] {
+	return func(p *Parser[K]) {
+		for _, id := range ids {
+			f, ok := lookupFeature(id)
+			switch {
+			case !ok:
+				p.featureErrs = append(p.featureErrs, fmt.Errorf("unknown grammar feature %q", id))
+			case f.Stage == FeatureStageStable:
+				p.featureErrs = append(p.featureErrs, fmt.Errorf("grammar feature %q is stable and cannot be disabled", id))
+			default:
+				p.disabledFeatures[id] = true
+			}
+		}
+	}
+}
+
+func (p *Parser[K]) featureEnabled(f Feature) bool {
+	switch f.Stage {
+	case FeatureStageStable:
+		return true
+	case FeatureStageBeta:
+		return !p.disabledFeatures[f.ID]
+	default:
+		return p.enabledFeatures[f.ID]
+	}
+}
+
+// checkFeatures returns an error if the statement uses a grammar feature that is not enabled
+func (p *Parser[K]) checkFeatures(parsed *parsedStatement) error {
+	for _, id := range parsed.features() {
+		f, _ := lookupFeature(id)
+		if !p.featureEnabled(f) {
+			return fmt.Errorf("the %s grammar feature is %s and must be enabled by the component to be used", id, f.Stage)
+		}
+	}
+	return nil
+}
+
+// features returns the IDs of the grammar features used by the statement
+func (s *parsedStatement) features() []string {
+	var ids []string
+	if s.WhereClause.usesNegation() {
+		ids = append(ids, FeatureNotOperator)
+	}
+	return ids
+}
+
+func (e *booleanExpression) usesNegation() bool {
+	if e == nil {
+		return false
+	}
+	if e.Left.usesNegation() {
+		return true
+	}
+	for _, rhs := range e.Right {
+		if rhs.Term.usesNegation() {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *term) usesNegation() bool {
+	if t == nil {
+		return false
+	}
+	if t.Left.usesNegation() {
+		return true
+	}
+	for _, rhs := range t.Right {
+		if rhs.Value.usesNegation() {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *booleanValue) usesNegation() bool {
+	if v == nil {
+		return false
+	}
+	return v.Negation != nil || v.SubExpr.usesNegation()
+}
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+)
+
+func Test_Features(t *testing.T) {
+	for _, f := range Features() {
+		assert.NotEmpty(t, f.ID)
+		assert.NotEqual(t, "unknown", f.Stage.String())
+		assert.NotEmpty(t, f.Description)
+	}
+}
+
+func Test_NotOperator(t *testing.T) {
+	tests := []struct {
+		name      string
+		options   []Option[interface{}]
+		statement string
+		wantErr   string
+		matches   map[string]bool
+	}{
+		{
+			name:      "disabled by default",
+			statement: `testing_string("foo") where not name == "bar"`,
+			wantErr:   "the not_operator grammar feature is alpha and must be enabled by the component to be used",
+		},
+		{
+			name:      "disabled in subexpression",
+			statement: `testing_string("foo") where name == "baz" or (true and not name == "bar")`,
+			wantErr:   "the not_operator grammar feature is alpha and must be enabled by the component to be used",
+		},
+		{
+			name:      "comparison",
+			options:   []Option[interface{}]{WithFeatures[interface{}](FeatureNotOperator)},
+			statement: `testing_string("foo") where not name == "bar"`,
+			matches:   map[string]bool{"bar": false, "baz": true},
+		},
+		{
+			name:      "subexpression",
+			options:   []Option[interface{}]{WithFeatures[interface{}](FeatureNotOperator)},
+			statement: `testing_string("foo") where not (name == "bar" or name == "baz")`,
+			matches:   map[string]bool{"bar": false, "baz": false, "qux": true},
+		},
+		{
+			name:      "constant",
+			options:   []Option[interface{}]{WithFeatures[interface{}](FeatureNotOperator)},
+			statement: `testing_string("foo") where not false and name == "bar"`,
+			matches:   map[string]bool{"bar": true, "baz": false},
+		},
+		{
+			name:      "unknown feature",
+			options:   []Option[interface{}]{WithFeatures[interface{}]("lambdas")},
+			statement: `testing_string("foo")`,
+			wantErr:   `unknown grammar feature "lambdas"`,
+		},
+		{
+			name:      "disabled alpha feature",
+			options:   []Option[interface{}]{WithoutFeatures[interface{}](FeatureNotOperator)},
+			statement: `testing_string("foo") where not name == "bar"`,
+			wantErr:   "the not_operator grammar feature is alpha and must be enabled by the component to be used",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewParser(
+				defaultFunctionsForTests(),
+				testParsePath,
+				testParseEnum,
+				component.TelemetrySettings{},
+				tt.options...,
+			)
+
+			statements, err := p.ParseStatements([]string{tt.statement})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, statements, 1)
+			for ctx, expected := range tt.matches {
+				_, matched := statements[0].Execute(ctx)
+				assert.Equal(t, expected, matched, ctx)
+			}
+		})
+	}
+}
+
+func Test_NotOperatorDisabledIdentifier(t *testing.T) {
+	statement := `set(not, "foo")`
+	p := NewParser(defaultFunctionsForTests(), testParsePath, testParseEnum, component.TelemetrySettings{})
+
+	// not is only a keyword once the feature is enabled
+	parsed, err := p.parseStatement(statement)
+	require.NoError(t, err)
+	require.NotNil(t, parsed.Invocation.Arguments[0].Path)
+	assert.Equal(t, "not", parsed.Invocation.Arguments[0].Path.Fields[0].Name)
+
+	p = NewParser(defaultFunctionsForTests(), testParsePath, testParseEnum, component.TelemetrySettings{},
+		WithFeatures[interface{}](FeatureNotOperator))
+	_, err = p.parseStatement(statement)
+	assert.Error(t, err)
+}
+
+func Test_featureEnabled(t *testing.T) {
+	p := NewParser[interface{}](nil, nil, nil, component.TelemetrySettings{})
+	assert.False(t, p.featureEnabled(Feature{ID: "alpha", Stage: FeatureStageAlpha}))
+	assert.True(t, p.featureEnabled(Feature{ID: "beta", Stage: FeatureStageBeta}))
+	assert.True(t, p.featureEnabled(Feature{ID: "stable", Stage: FeatureStageStable}))
+
+	p.enabledFeatures["alpha"] = true
+	p.disabledFeatures["beta"] = true
+	assert.True(t, p.featureEnabled(Feature{ID: "alpha", Stage: FeatureStageAlpha}))
+	assert.False(t, p.featureEnabled(Feature{ID: "beta", Stage: FeatureStageBeta}))
+}
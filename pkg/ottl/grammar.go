@@ -28,8 +28,9 @@ type parsedStatement struct {
 
 // booleanValue represents something that evaluates to a boolean --
 // either an equality or inequality, explicit true or false, or
-// a parenthesized subexpression.
+// a parenthesized subexpression, optionally negated.
 type booleanValue struct {
+	Negation   *string            `parser:"@OpNot?"`
 	Comparison *comparison        `parser:"( @@"`
 	ConstExpr  *boolean           `parser:"| @Boolean"`
 	SubExpr    *booleanExpression `parser:"| '(' @@ ')' )"`
@@ -185,14 +186,22 @@ type EnumSymbol string
 // buildLexer constructs a SimpleLexer definition.
 // Note that the ordering of these rules matters.
 // It's in a separate function so it can be easily tested alone (see lexer_test.go).
-func buildLexer() *lexer.StatefulDefinition {
-	return lexer.MustSimple([]lexer.SimpleRule{
+// `not` is only reserved when notOperator is set. Otherwise the OpNot rule is placed after
+// Lowercase, which always matches first, so `not` stays a valid identifier.
+func buildLexer(notOperator bool) *lexer.StatefulDefinition {
+	opNot := lexer.SimpleRule{Name: `OpNot`, Pattern: `\b(not)\b`}
+	rules := []lexer.SimpleRule{
 		{Name: `Bytes`, Pattern: `0x[a-fA-F0-9]+`},
 		{Name: `Float`, Pattern: `[-+]?\d*\.\d+([eE][-+]?\d+)?`},
 		{Name: `Int`, Pattern: `[-+]?\d+`},
 		{Name: `String`, Pattern: `"(\\"|[^"])*"`},
 		{Name: `OpOr`, Pattern: `\b(or)\b`},
 		{Name: `OpAnd`, Pattern: `\b(and)\b`},
+	}
+	if notOperator {
+		rules = append(rules, opNot)
+	}
+	rules = append(rules, []lexer.SimpleRule{
 		{Name: `OpComparison`, Pattern: `==|!=|>=|<=|>|<`},
 		{Name: `Boolean`, Pattern: `\b(true|false)\b`},
 		{Name: `LParen`, Pattern: `\(`},
@@ -201,5 +210,9 @@ func buildLexer() *lexer.StatefulDefinition {
 		{Name: `Uppercase`, Pattern: `[A-Z_][A-Z0-9_]*`},
 		{Name: `Lowercase`, Pattern: `[a-z_][a-z0-9_]*`},
 		{Name: "whitespace", Pattern: `\s+`},
-	})
+	}...)
+	if !notOperator {
+		rules = append(rules, opNot)
+	}
+	return lexer.MustSimple(rules)
 }
@@ -19,6 +19,7 @@ import (
 
 	"github.com/alecthomas/participle/v2/lexer"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func nameOf(def *lexer.StatefulDefinition, val lexer.TokenType) string {
@@ -83,6 +84,10 @@ func Test_lexer(t *testing.T) {
 			{"OpOr", "or"},
 			{"Lowercase", "but"},
 		}},
+		{"parse_not", "not nothing", false, []result{
+			{"OpNot", "not"},
+			{"Lowercase", "nothing"}, // should not parse "not" as an operator
+		}},
 		{"nothing_recognizable", "{}", true, []result{
 			{"", ""},
 		}},
@@ -106,7 +111,7 @@ func Test_lexer(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			lexDef := buildLexer()
+			lexDef := buildLexer(true)
 			symbols := lexDef.Symbols()
 			x, err := lexDef.LexString(tt.name, tt.input)
 			assert.NoError(t, err)
@@ -126,3 +131,16 @@ func Test_lexer(t *testing.T) {
 		})
 	}
 }
+
+func Test_lexer_withoutNotOperator(t *testing.T) {
+	lexDef := buildLexer(false)
+	symbols := lexDef.Symbols()
+	x, err := lexDef.LexString("without_not", "not nothing")
+	require.NoError(t, err)
+	for _, val := range []string{"not", "nothing"} {
+		tok, err := x.Next()
+		require.NoError(t, err)
+		assert.Equal(t, val, tok.String())
+		assert.Equal(t, symbols["Lowercase"], tok.Type, "expected '%s' to be Lowercase, got %v", val, nameOf(lexDef, tok.Type))
+	}
+}
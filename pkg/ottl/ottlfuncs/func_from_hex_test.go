@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_fromHex(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "lowercase",
+			value:    "0abcff",
+			expected: []byte{0x0a, 0xbc, 0xff},
+		},
+		{
+			name:     "uppercase",
+			value:    "0ABCFF",
+			expected: []byte{0x0a, 0xbc, 0xff},
+		},
+		{
+			name:     "empty string",
+			value:    "",
+			expected: []byte{},
+		},
+		{
+			name:     "odd length",
+			value:    "abc",
+			expected: nil,
+		},
+		{
+			name:     "not hex",
+			value:    "zz",
+			expected: nil,
+		},
+		{
+			name:     "not a string",
+			value:    int64(1),
+			expected: nil,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) interface{} {
+					return tt.value
+				},
+			}
+			exprFunc, err := FromHex[interface{}](target)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, exprFunc(nil))
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func HasPrefix[K any](target ottl.Getter[K], prefix ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) interface{} {
+		val := target.Get(ctx)
+		switch p := prefix.Get(ctx).(type) {
+		case string:
+			if s, ok := val.(string); ok {
+				return strings.HasPrefix(s, p)
+			}
+			// Byte values are matched against their lowercase hex representation,
+			// which allows prefixes of an odd number of hex digits.
+			if b, ok := toBytes(val); ok {
+				return strings.HasPrefix(hex.EncodeToString(b), strings.ToLower(p))
+			}
+		case []byte:
+			if b, ok := toBytes(val); ok {
+				return bytes.HasPrefix(b, p)
+			}
+		}
+		return false
+	}, nil
+}
@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_hasPrefix(t *testing.T) {
+	traceID := pcommon.TraceID([16]byte{0xab, 0xcd, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	spanID := pcommon.SpanID([8]byte{0xab, 0xcd, 3, 4, 5, 6, 7, 8})
+	tests := []struct {
+		name     string
+		value    interface{}
+		prefix   interface{}
+		expected bool
+	}{
+		{
+			name:     "string prefix",
+			value:    "hello world",
+			prefix:   "hello",
+			expected: true,
+		},
+		{
+			name:     "string not prefix",
+			value:    "hello world",
+			prefix:   "world",
+			expected: false,
+		},
+		{
+			name:     "bytes prefix",
+			value:    []byte{1, 2, 3},
+			prefix:   []byte{1, 2},
+			expected: true,
+		},
+		{
+			name:     "bytes not prefix",
+			value:    []byte{1, 2, 3},
+			prefix:   []byte{2},
+			expected: false,
+		},
+		{
+			name:     "trace id bytes prefix",
+			value:    traceID,
+			prefix:   []byte{0xab, 0xcd},
+			expected: true,
+		},
+		{
+			name:     "trace id bytes not prefix",
+			value:    traceID,
+			prefix:   []byte{0xab, 0xce},
+			expected: false,
+		},
+		{
+			name:     "trace id hex prefix",
+			value:    traceID,
+			prefix:   "abcd03",
+			expected: true,
+		},
+		{
+			name:     "trace id odd hex prefix",
+			value:    traceID,
+			prefix:   "abc",
+			expected: true,
+		},
+		{
+			name:     "trace id uppercase hex prefix",
+			value:    traceID,
+			prefix:   "ABCD",
+			expected: true,
+		},
+		{
+			name:     "trace id hex not prefix",
+			value:    traceID,
+			prefix:   "abce",
+			expected: false,
+		},
+		{
+			name:     "span id bytes prefix",
+			value:    spanID,
+			prefix:   []byte{0xab},
+			expected: true,
+		},
+		{
+			name:     "span id hex prefix",
+			value:    spanID,
+			prefix:   "abcd",
+			expected: true,
+		},
+		{
+			name:     "string bytes prefix",
+			value:    "hello",
+			prefix:   []byte("he"),
+			expected: false,
+		},
+		{
+			name:     "unsupported target",
+			value:    int64(1),
+			prefix:   "1",
+			expected: false,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			prefix:   "",
+			expected: false,
+		},
+		{
+			name:     "nil prefix",
+			value:    "hello",
+			prefix:   nil,
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) interface{} {
+					return tt.value
+				},
+			}
+			prefix := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) interface{} {
+					return tt.prefix
+				},
+			}
+			exprFunc, err := HasPrefix[interface{}](target, prefix)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, exprFunc(nil))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_hex(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "bytes",
+			value:    []byte{0x0a, 0xbc, 0xff},
+			expected: "0abcff",
+		},
+		{
+			name:     "empty bytes",
+			value:    []byte{},
+			expected: "",
+		},
+		{
+			name:     "trace id",
+			value:    pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}),
+			expected: "0102030405060708090a0b0c0d0e0f10",
+		},
+		{
+			name:     "span id",
+			value:    pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+			expected: "0102030405060708",
+		},
+		{
+			name:     "string",
+			value:    "0102",
+			expected: nil,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) interface{} {
+					return tt.value
+				},
+			}
+			exprFunc, err := Hex[interface{}](target)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, exprFunc(nil))
+		})
+	}
+}
+
+func Test_hex_traceIDString(t *testing.T) {
+	id := pcommon.TraceID([16]byte{0xde, 0xad, 0xbe, 0xef, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) interface{} {
+			return id
+		},
+	}
+	exprFunc, err := Hex[interface{}](target)
+	require.NoError(t, err)
+	assert.Equal(t, id.HexString(), exprFunc(nil))
+}
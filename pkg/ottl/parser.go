@@ -15,6 +15,8 @@
 package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 
 import (
+	"fmt"
+
 	"github.com/alecthomas/participle/v2"
 	"go.opentelemetry.io/collector/component"
 	"go.uber.org/multierr"
@@ -25,6 +27,10 @@ type Parser[K any] struct {
 	pathParser        PathExpressionParser[K]
 	enumParser        EnumParser
 	telemetrySettings component.TelemetrySettings
+
+	enabledFeatures  map[string]bool
+	disabledFeatures map[string]bool
+	featureErrs      []error
 }
 
 // Statement holds a top level statement for processing telemetry data.
@@ -46,25 +52,39 @@ func (s *Statement[K]) Execute(ctx K) (any, bool) {
 	return result, condition
 }
 
-func NewParser[K any](functions map[string]interface{}, pathParser PathExpressionParser[K], enumParser EnumParser, telemetrySettings component.TelemetrySettings) Parser[K] {
-	return Parser[K]{
+func NewParser[K any](functions map[string]interface{}, pathParser PathExpressionParser[K], enumParser EnumParser, telemetrySettings component.TelemetrySettings, options ...Option[K]) Parser[K] {
+	p := Parser[K]{
 		functions:         functions,
 		pathParser:        pathParser,
 		enumParser:        enumParser,
 		telemetrySettings: telemetrySettings,
+		enabledFeatures:   map[string]bool{},
+		disabledFeatures:  map[string]bool{},
 	}
+	for _, option := range options {
+		option(&p)
+	}
+	return p
 }
 
 func (p *Parser[K]) ParseStatements(statements []string) ([]*Statement[K], error) {
+	if len(p.featureErrs) > 0 {
+		return nil, multierr.Combine(p.featureErrs...)
+	}
+
 	var parsedStatements []*Statement[K]
 	var errors error
 
 	for _, statement := range statements {
-		parsed, err := parseStatement(statement)
+		parsed, err := p.parseStatement(statement)
 		if err != nil {
 			errors = multierr.Append(errors, err)
 			continue
 		}
+		if err = p.checkFeatures(parsed); err != nil {
+			errors = multierr.Append(errors, fmt.Errorf("%w: %s", err, statement))
+			continue
+		}
 		function, err := p.newFunctionCall(parsed.Invocation)
 		if err != nil {
 			errors = multierr.Append(errors, err)
@@ -87,7 +107,28 @@ func (p *Parser[K]) ParseStatements(statements []string) ([]*Statement[K], error
 	return parsedStatements, nil
 }
 
-var parser = newParser()
+var (
+	parser = newParser(true)
+	// parserWithoutNot reads the statements when the not_operator feature is not enabled,
+	// so that `not` can still be used as an identifier.
+	parserWithoutNot = newParser(false)
+)
+
+// parseStatement reads the statement with the grammar features enabled on the Parser.
+func (p *Parser[K]) parseStatement(raw string) (*parsedStatement, error) {
+	if notOperator, _ := lookupFeature(FeatureNotOperator); p.featureEnabled(notOperator) {
+		return parseStatement(raw)
+	}
+	parsed, err := parserWithoutNot.ParseString("", raw)
+	if err != nil {
+		// Report the use of the disabled not operator rather than a syntax error.
+		if withNot, notErr := parseStatement(raw); notErr == nil {
+			return withNot, nil
+		}
+		return nil, err
+	}
+	return parsed, nil
+}
 
 func parseStatement(raw string) (*parsedStatement, error) {
 	parsed, err := parser.ParseString("", raw)
@@ -99,8 +140,8 @@ func parseStatement(raw string) (*parsedStatement, error) {
 
 // newParser returns a parser that can be used to read a string into a parsedStatement. An error will be returned if the string
 // is not formatted for the DSL.
-func newParser() *participle.Parser[parsedStatement] {
-	lex := buildLexer()
+func newParser(notOperator bool) *participle.Parser[parsedStatement] {
+	lex := buildLexer(notOperator)
 	parser, err := participle.Build[parsedStatement](
 		participle.Lexer(lex),
 		participle.Unquote("String"),
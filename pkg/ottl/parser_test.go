@@ -828,6 +828,10 @@ func Test_parseStatement(t *testing.T) {
 		{`drop() where ==`, true},
 		{`drop() where == animal`, true},
 		{`drop() where attributes["path"] == "/healthcheck"`, false},
+		{`drop() where not attributes["path"] == "/healthcheck"`, false},
+		{`drop() where not (animal == "cat" or animal == "dog")`, false},
+		{`drop() where not`, true},
+		{`drop() where animal not == "cat"`, true},
 	}
 	pat := regexp.MustCompile("[^a-zA-Z0-9]+")
 	for _, tt := range tests {
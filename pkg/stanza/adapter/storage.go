@@ -24,6 +24,12 @@ import (
 )
 
 func GetStorageClient(ctx context.Context, host component.Host, storageID *config.ComponentID, componentID config.ComponentID) (storage.Client, error) {
+	return GetStorageClientForKind(ctx, host, storageID, component.KindReceiver, componentID)
+}
+
+// GetStorageClientForKind returns a client of the storage extension for a component of the given kind,
+// or a no-op client if no storage extension is configured.
+func GetStorageClientForKind(ctx context.Context, host component.Host, storageID *config.ComponentID, kind component.Kind, componentID config.ComponentID) (storage.Client, error) {
 	if storageID == nil {
 		return storage.NewNopClient(), nil
 	}
@@ -38,8 +44,7 @@ func GetStorageClient(ctx context.Context, host component.Host, storageID *confi
 		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
 	}
 
-	return storageExtension.GetClient(ctx, component.KindReceiver, componentID, "")
-
+	return storageExtension.GetClient(ctx, kind, componentID, "")
 }
 
 func (r *receiver) setStorageClient(ctx context.Context, host component.Host) error {
@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"context"
+	"os"
+	"sort"
+
+	"go.uber.org/zap"
+)
+
+const archiveFilesKey = "archiveFiles"
+
+// startArchiveReader kicks off a goroutine that reads the archived files once
+func (m *Manager) startArchiveReader(ctx context.Context) {
+	// Archived files are always read from the beginning, regardless of start_at
+	factory := m.readerFactory
+	factory.fromBeginning = true
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.readArchive(ctx, &factory)
+	}()
+}
+
+// readArchive reads each file matched by the archive finder to its end, oldest
+// modification time first. The offset of each file is checkpointed once it has
+// been read, or when reading is interrupted, so that a restarted ingestion
+// resumes where the previous one left off.
+func (m *Manager) readArchive(ctx context.Context, factory *readerFactory) {
+	checkpoints, err := m.loadReaders(ctx, archiveFilesKey)
+	if err != nil {
+		m.Errorw("Failed to load archive checkpoints", zap.Error(err))
+		return
+	}
+
+	paths := sortByModTime(m.archiveFinder.FindFiles())
+	m.Infow("Reading archived files", "count", len(paths))
+	for _, path := range paths {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		reader, index, err := m.newArchiveReader(factory, path, checkpoints)
+		if err != nil {
+			m.Errorw("Failed to create archive reader", "path", path, zap.Error(err))
+			continue
+		}
+
+		reader.ReadToEnd(ctx)
+		reader.Close()
+
+		if index >= 0 {
+			checkpoints[index] = reader
+		} else {
+			checkpoints = append(checkpoints, reader)
+		}
+		// The context is cancelled when the operator stops, which must not prevent
+		// the checkpoint of an interrupted file from being saved
+		m.syncReaders(context.Background(), archiveFilesKey, checkpoints)
+	}
+	m.Infow("Finished reading archived files", "count", len(paths))
+}
+
+// newArchiveReader creates a reader for the archived file at path, resuming from the
+// checkpoint with a matching fingerprint if there is one. The index of the checkpoint
+// is returned, or -1 if the file has not been read before.
+func (m *Manager) newArchiveReader(factory *readerFactory, path string, checkpoints []*Reader) (*Reader, int, error) {
+	file, err := os.Open(path) // #nosec - operator must read in files defined by user
+	if err != nil {
+		return nil, -1, err
+	}
+
+	fp, err := factory.newFingerprint(file)
+	if err != nil {
+		_ = file.Close()
+		return nil, -1, err
+	}
+
+	for i := len(checkpoints) - 1; i >= 0; i-- {
+		if fp.StartsWith(checkpoints[i].Fingerprint) {
+			reader, err := factory.copy(checkpoints[i], file)
+			if err != nil {
+				_ = file.Close()
+				return nil, -1, err
+			}
+			return reader, i, nil
+		}
+	}
+
+	reader, err := factory.newReader(file, fp)
+	if err != nil {
+		_ = file.Close()
+		return nil, -1, err
+	}
+	return reader, -1, nil
+}
+
+// sortByModTime sorts the paths from the oldest to the newest modification time,
+// discarding the paths which cannot be stat'd
+func sortByModTime(paths []string) []string {
+	type pathInfo struct {
+		path    string
+		modTime int64
+	}
+
+	infos := make([]pathInfo, 0, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		infos = append(infos, pathInfo{path: path, modTime: info.ModTime().UnixNano()})
+	}
+
+	sort.SliceStable(infos, func(i, j int) bool {
+		return infos[i].modTime < infos[j].modTime
+	})
+
+	sorted := make([]string, 0, len(infos))
+	for _, info := range infos {
+		sorted = append(sorted, info.path)
+	}
+	return sorted
+}
@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileconsumer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+// cancelAwarePersister fails to set values with a cancelled context, like a
+// storage extension which honors the context would
+type cancelAwarePersister struct {
+	operator.Persister
+}
+
+func (p cancelAwarePersister) Set(ctx context.Context, key string, value []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return p.Persister.Set(ctx, key, value)
+}
+
+func TestSortByModTime(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	now := time.Now()
+	paths := []string{
+		filepath.Join(tempDir, "b.log"),
+		filepath.Join(tempDir, "a.log"),
+		filepath.Join(tempDir, "c.log"),
+	}
+	for i, path := range paths {
+		writeString(t, openFile(t, path), "testlog\n")
+		modTime := now.Add(-time.Duration(i) * time.Hour)
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	sorted := sortByModTime(append(paths, filepath.Join(tempDir, "missing.log")))
+	require.Equal(t, []string{paths[2], paths[1], paths[0]}, sorted)
+}
+
+func TestReadArchive(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	archiveDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.Archive.Include = []string{filepath.Join(archiveDir, "*")}
+	cfg.Compression = "auto"
+	operator, emitCalls := buildTestManager(t, cfg)
+
+	now := time.Now()
+	newer := filepath.Join(archiveDir, "a.log.gz")
+	writeGzipFile(t, newer, "newer1\nnewer2\n")
+	require.NoError(t, os.Chtimes(newer, now, now))
+	older := filepath.Join(archiveDir, "b.log")
+	writeString(t, openFile(t, older), "older1\nolder2\n")
+	require.NoError(t, os.Chtimes(older, now.Add(-time.Hour), now.Add(-time.Hour)))
+
+	require.NoError(t, operator.Start(testutil.NewMockPersister("test")))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	// Archived files are read from the beginning, oldest first, regardless of start_at
+	waitForToken(t, emitCalls, []byte("older1"))
+	waitForToken(t, emitCalls, []byte("older2"))
+	waitForToken(t, emitCalls, []byte("newer1"))
+	waitForToken(t, emitCalls, []byte("newer2"))
+	expectNoTokens(t, emitCalls)
+}
+
+func TestReadArchiveResumes(t *testing.T) {
+	t.Parallel()
+
+	archiveDir := t.TempDir()
+	cfg := NewConfig().includeDir(t.TempDir())
+	cfg.Archive.Include = []string{filepath.Join(archiveDir, "*")}
+	persister := testutil.NewMockPersister("test")
+
+	writeString(t, openFile(t, filepath.Join(archiveDir, "a.log")), "testlog1\n")
+
+	operatorOne, emitCallsOne := buildTestManager(t, cfg)
+	require.NoError(t, operatorOne.Start(persister))
+	waitForToken(t, emitCallsOne, []byte("testlog1"))
+	expectNoTokens(t, emitCallsOne)
+	require.NoError(t, operatorOne.Stop())
+
+	// Only the files and lines which were not read yet are read after a restart
+	writeString(t, openFile(t, filepath.Join(archiveDir, "b.log")), "testlog2\n")
+
+	operatorTwo, emitCallsTwo := buildTestManager(t, cfg)
+	require.NoError(t, operatorTwo.Start(persister))
+	defer func() {
+		require.NoError(t, operatorTwo.Stop())
+	}()
+	waitForToken(t, emitCallsTwo, []byte("testlog2"))
+	expectNoTokens(t, emitCallsTwo)
+}
+
+func TestReadArchiveCheckpointsOnStop(t *testing.T) {
+	t.Parallel()
+
+	archiveDir := t.TempDir()
+	cfg := NewConfig().includeDir(t.TempDir())
+	cfg.Archive.Include = []string{filepath.Join(archiveDir, "*")}
+	persister := cancelAwarePersister{Persister: testutil.NewMockPersister("test")}
+
+	writeString(t, openFile(t, filepath.Join(archiveDir, "a.log")), "testlog1\ntestlog2\ntestlog3\n")
+
+	// Block the emits so that the operator stops while the file is being read
+	emitCallsOne := make(chan *emitParams)
+	operatorOne := buildTestManagerWithEmit(t, cfg, emitCallsOne)
+	require.NoError(t, operatorOne.Start(persister))
+	waitForToken(t, emitCallsOne, []byte("testlog1"))
+
+	stopped := make(chan error)
+	go func() {
+		stopped <- operatorOne.Stop()
+	}()
+	for done := false; !done; {
+		select {
+		case <-emitCallsOne:
+		case err := <-stopped:
+			require.NoError(t, err)
+			done = true
+		}
+	}
+
+	// The lines read before stopping are not read again after a restart
+	operatorTwo, emitCallsTwo := buildTestManager(t, cfg)
+	require.NoError(t, operatorTwo.Start(persister))
+	defer func() {
+		require.NoError(t, operatorTwo.Stop())
+	}()
+	select {
+	case call := <-emitCallsTwo:
+		require.NotEqual(t, []byte("testlog1"), call.token)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
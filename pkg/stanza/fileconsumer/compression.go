@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	compressionNone = ""
+	compressionGzip = "gzip"
+	compressionZstd = "zstd"
+	compressionAuto = "auto"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// detectCompression returns the compression of the file. When the configured
+// compression is auto, it is detected from the magic number of the file.
+func detectCompression(configured string, file *os.File) (string, error) {
+	if configured != compressionAuto {
+		return configured, nil
+	}
+
+	buf := make([]byte, len(zstdMagic))
+	n, err := file.ReadAt(buf, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("reading magic number: %w", err)
+	}
+
+	switch {
+	case bytes.HasPrefix(buf[:n], gzipMagic):
+		return compressionGzip, nil
+	case bytes.HasPrefix(buf[:n], zstdMagic):
+		return compressionZstd, nil
+	default:
+		return compressionNone, nil
+	}
+}
+
+// newDecompressor returns a reader of the decompressed contents of the file,
+// starting from the beginning of the file
+func newDecompressor(compression string, file *os.File) (io.ReadCloser, error) {
+	src := io.NewSectionReader(file, 0, 1<<63-1)
+	switch compression {
+	case compressionGzip:
+		return gzip.NewReader(src)
+	case compressionZstd:
+		dec, err := zstd.NewReader(src, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return dec.IOReadCloser(), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression '%s'", compression)
+	}
+}
+
+// newCompressedFingerprint creates a fingerprint from the first decompressed bytes of a file,
+// so that a file which is compressed after being rotated keeps the fingerprint of its contents
+func newCompressedFingerprint(file *os.File, compression string, size int) (*Fingerprint, error) {
+	dec, err := newDecompressor(compression, file)
+	if err != nil {
+		return nil, fmt.Errorf("decompressing fingerprint bytes: %w", err)
+	}
+	defer dec.Close()
+
+	buf := make([]byte, size)
+	n, err := io.ReadFull(dec, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, fmt.Errorf("reading fingerprint bytes: %w", err)
+	}
+
+	return &Fingerprint{
+		FirstBytes: buf[:n],
+	}, nil
+}
@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileconsumer
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func writeGzipFile(t testing.TB, path string, s string) {
+	file := openFile(t, path)
+	w := gzip.NewWriter(file)
+	_, err := w.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+}
+
+func writeZstdFile(t testing.TB, path string, s string) {
+	file := openFile(t, path)
+	w, err := zstd.NewWriter(file)
+	require.NoError(t, err)
+	_, err = w.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+}
+
+func TestReadCompressedFiles(t *testing.T) {
+	testCases := []struct {
+		name        string
+		compression string
+		write       func(testing.TB, string, string)
+	}{
+		{"gzip", "gzip", writeGzipFile},
+		{"zstd", "zstd", writeZstdFile},
+		{"auto_gzip", "auto", writeGzipFile},
+		{"auto_zstd", "auto", writeZstdFile},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tempDir := t.TempDir()
+			cfg := NewConfig().includeDir(tempDir)
+			cfg.StartAt = "beginning"
+			cfg.Compression = tc.compression
+			operator, emitCalls := buildTestManager(t, cfg)
+
+			tc.write(t, filepath.Join(tempDir, "test.log.1"), "testlog1\ntestlog2\n")
+
+			require.NoError(t, operator.Start(testutil.NewMockPersister("test")))
+			defer func() {
+				require.NoError(t, operator.Stop())
+			}()
+
+			waitForToken(t, emitCalls, []byte("testlog1"))
+			waitForToken(t, emitCalls, []byte("testlog2"))
+			expectNoTokensUntil(t, emitCalls, 2*cfg.PollInterval)
+		})
+	}
+}
+
+func TestReadAutoDetectsPlainFiles(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Compression = "auto"
+	operator, emitCalls := buildTestManager(t, cfg)
+
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "testlog1\n")
+
+	require.NoError(t, operator.Start(testutil.NewMockPersister("test")))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	waitForToken(t, emitCalls, []byte("testlog1"))
+	writeString(t, temp, "testlog2\n")
+	waitForToken(t, emitCalls, []byte("testlog2"))
+}
+
+// TestRotatedFileCompressed tests that a file which is compressed after being
+// rotated is recognized by its contents, so only the lines written after the
+// last poll are read from the compressed file
+func TestRotatedFileCompressed(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Compression = "auto"
+	operator, emitCalls := buildTestManager(t, cfg)
+
+	logPath := filepath.Join(tempDir, "test.log")
+	logFile := openFile(t, logPath)
+	writeString(t, logFile, "testlog1\n")
+
+	require.NoError(t, operator.Start(testutil.NewMockPersister("test")))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	waitForToken(t, emitCalls, []byte("testlog1"))
+
+	// Rotate the file into a compressed file, including a line which was not read yet
+	writeGzipFile(t, filepath.Join(tempDir, "test.log.1.gz"), "testlog1\ntestlog2\n")
+	require.NoError(t, logFile.Close())
+	require.NoError(t, os.Remove(logPath))
+
+	waitForToken(t, emitCalls, []byte("testlog2"))
+	expectNoTokensUntil(t, emitCalls, 5*cfg.PollInterval)
+}
@@ -15,6 +15,7 @@
 package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
 
 import (
+	"bufio"
 	"fmt"
 	"time"
 
@@ -58,23 +59,57 @@ type Config struct {
 	MaxLogSize              helper.ByteSize       `mapstructure:"max_log_size,omitempty"`
 	MaxConcurrentFiles      int                   `mapstructure:"max_concurrent_files,omitempty"`
 	Splitter                helper.SplitterConfig `mapstructure:",squash,omitempty"`
+	Compression             string                `mapstructure:"compression,omitempty"`
+	Archive                 Finder                `mapstructure:"archive,omitempty"`
 }
 
 // Build will build a file input operator from the supplied configuration
 func (c Config) Build(logger *zap.SugaredLogger, emit EmitFunc) (*Manager, error) {
+	if err := c.validate(emit); err != nil {
+		return nil, err
+	}
+
+	// Ensure that splitter is buildable
+	factory := newMultilineSplitterFactory(c.Splitter.EncodingConfig, c.Splitter.Flusher, c.Splitter.Multiline)
+	if _, err := factory.Build(int(c.MaxLogSize)); err != nil {
+		return nil, err
+	}
+
+	return c.buildManager(logger, emit, factory)
+}
+
+// BuildWithSplitFunc will build a file input operator from the supplied configuration,
+// tokenizing the files with the split functions returned by newSplitFunc instead of the
+// configured multiline splitter. A split function is built for each file, with the
+// max_log_size setting, so that it can keep a state per file. The tokens are still
+// decoded with the configured encoding.
+func (c Config) BuildWithSplitFunc(logger *zap.SugaredLogger, emit EmitFunc, newSplitFunc func(maxLogSize int) bufio.SplitFunc) (*Manager, error) {
+	if err := c.validate(emit); err != nil {
+		return nil, err
+	}
+
+	if newSplitFunc == nil {
+		return nil, fmt.Errorf("must provide split function")
+	}
+
+	return c.buildManager(logger, emit, newCustomSplitterFactory(newSplitFunc))
+}
+
+// validate checks the settings shared by all the splitters
+func (c Config) validate(emit EmitFunc) error {
 	if emit == nil {
-		return nil, fmt.Errorf("must provide emit function")
+		return fmt.Errorf("must provide emit function")
 	}
 
 	if len(c.Include) == 0 {
-		return nil, fmt.Errorf("required argument `include` is empty")
+		return fmt.Errorf("required argument `include` is empty")
 	}
 
 	// Ensure includes can be parsed as globs
 	for _, include := range c.Include {
 		_, err := doublestar.PathMatch(include, "matchstring")
 		if err != nil {
-			return nil, fmt.Errorf("parse include glob: %w", err)
+			return fmt.Errorf("parse include glob: %w", err)
 		}
 	}
 
@@ -82,40 +117,51 @@ func (c Config) Build(logger *zap.SugaredLogger, emit EmitFunc) (*Manager, error
 	for _, exclude := range c.Exclude {
 		_, err := doublestar.PathMatch(exclude, "matchstring")
 		if err != nil {
-			return nil, fmt.Errorf("parse exclude glob: %w", err)
+			return fmt.Errorf("parse exclude glob: %w", err)
 		}
 	}
 
-	if c.MaxLogSize <= 0 {
-		return nil, fmt.Errorf("`max_log_size` must be positive")
+	// Ensure archive globs can be parsed
+	for _, pattern := range append(c.Archive.Include, c.Archive.Exclude...) {
+		_, err := doublestar.PathMatch(pattern, "matchstring")
+		if err != nil {
+			return fmt.Errorf("parse archive glob: %w", err)
+		}
 	}
 
-	if c.MaxConcurrentFiles <= 1 {
-		return nil, fmt.Errorf("`max_concurrent_files` must be greater than 1")
+	switch c.Compression {
+	case compressionNone, compressionGzip, compressionZstd, compressionAuto:
+	default:
+		return fmt.Errorf("invalid compression '%s'", c.Compression)
 	}
 
-	if c.FingerprintSize == 0 {
-		c.FingerprintSize = DefaultFingerprintSize
-	} else if c.FingerprintSize < MinFingerprintSize {
-		return nil, fmt.Errorf("`fingerprint_size` must be at least %d bytes", MinFingerprintSize)
+	if c.MaxLogSize <= 0 {
+		return fmt.Errorf("`max_log_size` must be positive")
 	}
 
-	// Ensure that splitter is buildable
-	factory := newMultilineSplitterFactory(c.Splitter.EncodingConfig, c.Splitter.Flusher, c.Splitter.Multiline)
-	_, err := factory.Build(int(c.MaxLogSize))
-	if err != nil {
-		return nil, err
+	if c.MaxConcurrentFiles <= 1 {
+		return fmt.Errorf("`max_concurrent_files` must be greater than 1")
+	}
+
+	if c.FingerprintSize != 0 && c.FingerprintSize < MinFingerprintSize {
+		return fmt.Errorf("`fingerprint_size` must be at least %d bytes", MinFingerprintSize)
 	}
 
-	var startAtBeginning bool
 	switch c.StartAt {
-	case "beginning":
-		startAtBeginning = true
-	case "end":
-		startAtBeginning = false
+	case "beginning", "end":
 	default:
-		return nil, fmt.Errorf("invalid start_at location '%s'", c.StartAt)
+		return fmt.Errorf("invalid start_at location '%s'", c.StartAt)
+	}
+
+	return nil
+}
+
+// buildManager builds the Manager of a validated configuration
+func (c Config) buildManager(logger *zap.SugaredLogger, emit EmitFunc, factory splitterFactory) (*Manager, error) {
+	if c.FingerprintSize == 0 {
+		c.FingerprintSize = DefaultFingerprintSize
 	}
+	startAtBeginning := c.StartAt == "beginning"
 
 	return &Manager{
 		SugaredLogger: logger.With("component", "fileconsumer"),
@@ -130,8 +176,10 @@ func (c Config) Build(logger *zap.SugaredLogger, emit EmitFunc) (*Manager, error
 			fromBeginning:   startAtBeginning,
 			splitterFactory: factory,
 			encodingConfig:  c.Splitter.EncodingConfig,
+			compression:     c.Compression,
 		},
 		finder:        c.Finder,
+		archiveFinder: c.Archive,
 		roller:        newRoller(),
 		pollInterval:  c.PollInterval,
 		maxBatchFiles: c.MaxConcurrentFiles / 2,
@@ -15,6 +15,7 @@
 package fileconsumer
 
 import (
+	"bufio"
 	"context"
 	"path/filepath"
 	"testing"
@@ -32,6 +33,23 @@ func TestUnmarshal(t *testing.T) {
 		DefaultConfig: newMockOperatorConfig(NewConfig()),
 		TestsFile:     filepath.Join(".", "testdata", "config.yaml"),
 		Tests: []operatortest.ConfigUnmarshalTest{
+			{
+				Name: "archive",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.Archive.Include = []string{"/var/log/archive/*.gz"}
+					cfg.Archive.Exclude = []string{"/var/log/archive/skip*.gz"}
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
+			{
+				Name: "compression_auto",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.Compression = "auto"
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
 			{
 				Name: "include_one",
 				Expect: func() *mockOperatorConfig {
@@ -483,6 +501,42 @@ func TestBuild(t *testing.T) {
 			require.Error,
 			nil,
 		},
+		{
+			"Compression",
+			func(f *Config) {
+				f.Compression = "gzip"
+			},
+			require.NoError,
+			func(t *testing.T, f *Manager) {
+				require.Equal(t, "gzip", f.readerFactory.compression)
+			},
+		},
+		{
+			"InvalidCompression",
+			func(f *Config) {
+				f.Compression = "lz4"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"Archive",
+			func(f *Config) {
+				f.Archive.Include = []string{"/var/log/archive/*.gz"}
+			},
+			require.NoError,
+			func(t *testing.T, f *Manager) {
+				require.Equal(t, []string{"/var/log/archive/*.gz"}, f.archiveFinder.Include)
+			},
+		},
+		{
+			"BadArchiveGlob",
+			func(f *Config) {
+				f.Archive.Include = []string{"["}
+			},
+			require.Error,
+			nil,
+		},
 	}
 
 	for _, tc := range cases {
@@ -504,3 +558,39 @@ func TestBuild(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildWithSplitFunc(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewConfig()
+	cfg.Include = []string{"/var/log/testpath.*"}
+	cfg.Splitter.Multiline = helper.MultilineConfig{
+		LineEndPattern: "(",
+	}
+
+	nopEmit := func(_ context.Context, _ *FileAttributes, _ []byte) {}
+
+	var maxLogSize int
+	newSplitFunc := func(size int) bufio.SplitFunc {
+		maxLogSize = size
+		return bufio.ScanWords
+	}
+
+	// the multiline settings are ignored
+	input, err := cfg.BuildWithSplitFunc(testutil.Logger(t), nopEmit, newSplitFunc)
+	require.NoError(t, err)
+	splitFunc, err := input.readerFactory.splitterFactory.Build(int(cfg.MaxLogSize))
+	require.NoError(t, err)
+	require.Equal(t, int(cfg.MaxLogSize), maxLogSize)
+	advance, token, err := splitFunc([]byte("hello world"), false)
+	require.NoError(t, err)
+	require.Equal(t, 6, advance)
+	require.Equal(t, []byte("hello"), token)
+
+	_, err = cfg.BuildWithSplitFunc(testutil.Logger(t), nopEmit, nil)
+	require.EqualError(t, err, "must provide split function")
+
+	cfg.StartAt = "middle"
+	_, err = cfg.BuildWithSplitFunc(testutil.Logger(t), nopEmit, newSplitFunc)
+	require.EqualError(t, err, "invalid start_at location 'middle'")
+}
@@ -37,6 +37,7 @@ type Manager struct {
 
 	readerFactory readerFactory
 	finder        Finder
+	archiveFinder Finder
 	roller        roller
 	persister     operator.Persister
 
@@ -63,6 +64,11 @@ func (m *Manager) Start(persister operator.Persister) error {
 			"exclude", m.finder.Exclude)
 	}
 
+	// Start reading archived files before polling changes the reader factory
+	if len(m.archiveFinder.Include) > 0 {
+		m.startArchiveReader(ctx)
+	}
+
 	// Start polling goroutine
 	m.startPoller(ctx)
 
@@ -267,37 +273,57 @@ const knownFilesKey = "knownFiles"
 
 // syncLastPollFiles syncs the most recent set of files to the database
 func (m *Manager) syncLastPollFiles(ctx context.Context) {
+	m.syncReaders(ctx, knownFilesKey, m.knownFiles)
+}
+
+// syncReaders syncs the offsets and fingerprints of the readers to the database under key
+func (m *Manager) syncReaders(ctx context.Context, key string, readers []*Reader) {
 	var buf bytes.Buffer
 	enc := json.NewEncoder(&buf)
 
 	// Encode the number of known files
-	if err := enc.Encode(len(m.knownFiles)); err != nil {
+	if err := enc.Encode(len(readers)); err != nil {
 		m.Errorw("Failed to encode known files", zap.Error(err))
 		return
 	}
 
 	// Encode each known file
-	for _, fileReader := range m.knownFiles {
+	for _, fileReader := range readers {
 		if err := enc.Encode(fileReader); err != nil {
 			m.Errorw("Failed to encode known files", zap.Error(err))
 		}
 	}
 
-	if err := m.persister.Set(ctx, knownFilesKey, buf.Bytes()); err != nil {
+	if err := m.persister.Set(ctx, key, buf.Bytes()); err != nil {
 		m.Errorw("Failed to sync to database", zap.Error(err))
 	}
 }
 
 // syncLastPollFiles loads the most recent set of files to the database
 func (m *Manager) loadLastPollFiles(ctx context.Context) error {
-	encoded, err := m.persister.Get(ctx, knownFilesKey)
+	knownFiles, err := m.loadReaders(ctx, knownFilesKey)
 	if err != nil {
 		return err
 	}
 
+	if len(knownFiles) > 0 {
+		m.Infow("Resuming from previously known offset(s). 'start_at' setting is not applicable.")
+		m.readerFactory.fromBeginning = true
+	}
+
+	m.knownFiles = knownFiles
+	return nil
+}
+
+// loadReaders loads the readers stored in the database under key
+func (m *Manager) loadReaders(ctx context.Context, key string) ([]*Reader, error) {
+	encoded, err := m.persister.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
 	if encoded == nil {
-		m.knownFiles = make([]*Reader, 0, 10)
-		return nil
+		return make([]*Reader, 0, 10), nil
 	}
 
 	dec := json.NewDecoder(bytes.NewReader(encoded))
@@ -305,28 +331,23 @@ func (m *Manager) loadLastPollFiles(ctx context.Context) error {
 	// Decode the number of entries
 	var knownFileCount int
 	if err := dec.Decode(&knownFileCount); err != nil {
-		return fmt.Errorf("decoding file count: %w", err)
-	}
-
-	if knownFileCount > 0 {
-		m.Infow("Resuming from previously known offset(s). 'start_at' setting is not applicable.")
-		m.readerFactory.fromBeginning = true
+		return nil, fmt.Errorf("decoding file count: %w", err)
 	}
 
 	// Decode each of the known files
-	m.knownFiles = make([]*Reader, 0, knownFileCount)
+	readers := make([]*Reader, 0, knownFileCount)
 	for i := 0; i < knownFileCount; i++ {
 		// Only the offset, fingerprint, and splitter
 		// will be used before this reader is discarded
 		unsafeReader, err := m.readerFactory.unsafeReader()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if err = dec.Decode(unsafeReader); err != nil {
-			return err
+			return nil, err
 		}
-		m.knownFiles = append(m.knownFiles, unsafeReader)
+		readers = append(readers, unsafeReader)
 	}
 
-	return nil
+	return readers, nil
 }
@@ -17,7 +17,9 @@ package fileconsumer // import "github.com/open-telemetry/opentelemetry-collecto
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"go.uber.org/zap"
@@ -43,10 +45,32 @@ type Reader struct {
 	generation     int
 	file           *os.File
 	fileAttributes *FileAttributes
+
+	// compression is the compression of the file, and decompressor
+	// the reader of its decompressed contents while it is being read
+	compression  string
+	decompressor io.ReadCloser
+	// compressedSize is the size of the compressed file when it was last read to the end
+	compressedSize int64
 }
 
 // offsetToEnd sets the starting offset
 func (r *Reader) offsetToEnd() error {
+	if r.compression != compressionNone {
+		dec, err := newDecompressor(r.compression, r.file)
+		if err != nil {
+			return fmt.Errorf("decompress: %w", err)
+		}
+		defer dec.Close()
+
+		n, err := io.Copy(io.Discard, dec)
+		if err != nil {
+			return fmt.Errorf("decompress: %w", err)
+		}
+		r.Offset = n
+		return nil
+	}
+
 	info, err := r.file.Stat()
 	if err != nil {
 		return fmt.Errorf("stat: %w", err)
@@ -55,9 +79,45 @@ func (r *Reader) offsetToEnd() error {
 	return nil
 }
 
+// seek positions the file at the current offset. Compressed files cannot be seeked,
+// so they are decompressed from the beginning, discarding the bytes before the offset.
+func (r *Reader) seek() error {
+	if r.compression == compressionNone {
+		_, err := r.file.Seek(r.Offset, 0)
+		return err
+	}
+
+	r.closeDecompressor()
+	dec, err := newDecompressor(r.compression, r.file)
+	if err != nil {
+		return err
+	}
+	r.decompressor = dec
+
+	if _, err := io.CopyN(io.Discard, dec, r.Offset); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
 // ReadToEnd will read until the end of the file
 func (r *Reader) ReadToEnd(ctx context.Context) {
-	if _, err := r.file.Seek(r.Offset, 0); err != nil {
+	var compressedSize int64
+	if r.compression != compressionNone {
+		info, err := r.file.Stat()
+		if err != nil {
+			r.Errorw("Failed to stat", zap.Error(err))
+			return
+		}
+		// Skip decompressing the whole file again if it has not changed
+		if info.Size() == r.compressedSize {
+			return
+		}
+		compressedSize = info.Size()
+		defer r.closeDecompressor()
+	}
+
+	if err := r.seek(); err != nil {
 		r.Errorw("Failed to seek", zap.Error(err))
 		return
 	}
@@ -76,6 +136,8 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 		if !ok {
 			if err := scanner.getError(); err != nil {
 				r.Errorw("Failed during scan", zap.Error(err))
+			} else {
+				r.compressedSize = compressedSize
 			}
 			break
 		}
@@ -93,6 +155,7 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 
 // Close will close the file
 func (r *Reader) Close() {
+	r.closeDecompressor()
 	if r.file != nil {
 		if err := r.file.Close(); err != nil {
 			r.Debugw("Problem closing reader", zap.Error(err))
@@ -100,14 +163,31 @@ func (r *Reader) Close() {
 	}
 }
 
+func (r *Reader) closeDecompressor() {
+	if r.decompressor != nil {
+		if err := r.decompressor.Close(); err != nil {
+			r.Debugw("Problem closing decompressor", zap.Error(err))
+		}
+		r.decompressor = nil
+	}
+}
+
+// source returns the reader of the file contents
+func (r *Reader) source() io.Reader {
+	if r.decompressor != nil {
+		return r.decompressor
+	}
+	return r.file
+}
+
 // Read from the file and update the fingerprint if necessary
 func (r *Reader) Read(dst []byte) (int, error) {
 	// Skip if fingerprint is already built
 	// or if fingerprint is behind Offset
 	if len(r.Fingerprint.FirstBytes) == r.fingerprintSize || int(r.Offset) > len(r.Fingerprint.FirstBytes) {
-		return r.file.Read(dst)
+		return r.source().Read(dst)
 	}
-	n, err := r.file.Read(dst)
+	n, err := r.source().Read(dst)
 	appendCount := min0(n, r.fingerprintSize-int(r.Offset))
 	// return for n == 0 or r.Offset >= r.fileInput.fingerprintSize
 	if appendCount == 0 {
@@ -29,6 +29,7 @@ type readerFactory struct {
 	fromBeginning   bool
 	splitterFactory splitterFactory
 	encodingConfig  helper.EncodingConfig
+	compression     string
 }
 
 func (f *readerFactory) newReader(file *os.File, fp *Fingerprint) (*Reader, error) {
@@ -40,12 +41,17 @@ func (f *readerFactory) newReader(file *os.File, fp *Fingerprint) (*Reader, erro
 
 // copy creates a deep copy of a Reader
 func (f *readerFactory) copy(old *Reader, newFile *os.File) (*Reader, error) {
-	return f.newReaderBuilder().
+	r, err := f.newReaderBuilder().
 		withFile(newFile).
 		withFingerprint(old.Fingerprint.Copy()).
 		withOffset(old.Offset).
 		withSplitterFunc(old.splitFunc).
 		build()
+	if err != nil {
+		return nil, err
+	}
+	r.compressedSize = old.compressedSize
+	return r, nil
 }
 
 func (f *readerFactory) unsafeReader() (*Reader, error) {
@@ -53,6 +59,13 @@ func (f *readerFactory) unsafeReader() (*Reader, error) {
 }
 
 func (f *readerFactory) newFingerprint(file *os.File) (*Fingerprint, error) {
+	compression, err := detectCompression(f.compression, file)
+	if err != nil {
+		return nil, err
+	}
+	if compression != compressionNone {
+		return newCompressedFingerprint(file, compression, f.readerConfig.fingerprintSize)
+	}
 	return NewFingerprint(file, f.readerConfig.fingerprintSize)
 }
 
@@ -117,6 +130,11 @@ func (b *readerBuilder) build() (r *Reader, err error) {
 			b.Errorf("resolve attributes: %w", err)
 		}
 
+		r.compression, err = detectCompression(b.compression, b.file)
+		if err != nil {
+			return nil, err
+		}
+
 		// unsafeReader has the file set to nil, so don't try emending its offset.
 		if !b.fromBeginning {
 			if err := r.offsetToEnd(); err != nil {
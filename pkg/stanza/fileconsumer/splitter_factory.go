@@ -57,3 +57,18 @@ func (factory *multilineSplitterFactory) Build(maxLogSize int) (bufio.SplitFunc,
 	}
 	return splitter, nil
 }
+
+type customSplitterFactory struct {
+	newSplitFunc func(maxLogSize int) bufio.SplitFunc
+}
+
+var _ splitterFactory = (*customSplitterFactory)(nil)
+
+func newCustomSplitterFactory(newSplitFunc func(maxLogSize int) bufio.SplitFunc) *customSplitterFactory {
+	return &customSplitterFactory{newSplitFunc: newSplitFunc}
+}
+
+// Build returns a new custom split function
+func (factory *customSplitterFactory) Build(maxLogSize int) (bufio.SplitFunc, error) {
+	return factory.newSplitFunc(maxLogSize), nil
+}
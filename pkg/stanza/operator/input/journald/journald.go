@@ -25,6 +25,7 @@ import (
 	"io"
 	"os/exec"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -60,11 +61,12 @@ func NewConfigWithID(operatorID string) *Config {
 type Config struct {
 	helper.InputConfig `mapstructure:",squash"`
 
-	Directory *string  `mapstructure:"directory,omitempty"`
-	Files     []string `mapstructure:"files,omitempty"`
-	StartAt   string   `mapstructure:"start_at,omitempty"`
-	Units     []string `mapstructure:"units,omitempty"`
-	Priority  string   `mapstructure:"priority,omitempty"`
+	Directory   *string  `mapstructure:"directory,omitempty"`
+	Files       []string `mapstructure:"files,omitempty"`
+	StartAt     string   `mapstructure:"start_at,omitempty"`
+	Units       []string `mapstructure:"units,omitempty"`
+	Identifiers []string `mapstructure:"identifiers,omitempty"`
+	Priority    string   `mapstructure:"priority,omitempty"`
 }
 
 // Build will build a journald input operator from the supplied configuration
@@ -74,6 +76,25 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, err
 	}
 
+	args, err := c.buildArgs()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Input{
+		InputOperator: inputOperator,
+		newCmd: func(ctx context.Context, cursor []byte) cmd {
+			if cursor != nil {
+				args = append(args, "--after-cursor", string(cursor))
+			}
+			return exec.CommandContext(ctx, "journalctl", args...) // #nosec - ...
+			// journalctl is an executable that is required for this operator to function
+		},
+		json: jsoniter.ConfigFastest,
+	}, nil
+}
+
+func (c Config) buildArgs() ([]string, error) {
 	args := make([]string, 0, 10)
 
 	// Export logs in UTC time
@@ -97,6 +118,13 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		args = append(args, "--unit", unit)
 	}
 
+	for _, identifier := range c.Identifiers {
+		args = append(args, "--identifier", identifier)
+	}
+
+	if err := validatePriority(c.Priority); err != nil {
+		return nil, err
+	}
 	args = append(args, "--priority", c.Priority)
 
 	switch {
@@ -108,17 +136,35 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		}
 	}
 
-	return &Input{
-		InputOperator: inputOperator,
-		newCmd: func(ctx context.Context, cursor []byte) cmd {
-			if cursor != nil {
-				args = append(args, "--after-cursor", string(cursor))
-			}
-			return exec.CommandContext(ctx, "journalctl", args...) // #nosec - ...
-			// journalctl is an executable that is required for this operator to function
-		},
-		json: jsoniter.ConfigFastest,
-	}, nil
+	return args, nil
+}
+
+// priorities are the journald priority levels, in the order of their numeric values.
+var priorities = []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"}
+
+// validatePriority checks that priority is either a single priority level or a
+// FROM..TO range of levels, as accepted by journalctl's --priority flag. Levels
+// may be given by name or by number.
+func validatePriority(priority string) error {
+	levels := strings.Split(priority, "..")
+	if len(levels) > 2 {
+		return fmt.Errorf("invalid value '%s' for parameter 'priority'", priority)
+	}
+	for _, level := range levels {
+		if !isPriorityLevel(level) {
+			return fmt.Errorf("invalid value '%s' for parameter 'priority'", priority)
+		}
+	}
+	return nil
+}
+
+func isPriorityLevel(level string) bool {
+	for i, name := range priorities {
+		if level == name || level == strconv.Itoa(i) {
+			return true
+		}
+	}
+	return false
 }
 
 // Input is an operator that process logs using journald
@@ -185,10 +231,12 @@ func (operator *Input) Start(persister operator.Persister) error {
 				operator.Warnw("Failed to parse journal entry", zap.Error(err))
 				continue
 			}
+			operator.Write(ctx, entry)
+			// The cursor is only saved once the entry has been handed off, so that
+			// a restart resumes from the first entry that was not yet emitted.
 			if err := operator.persister.Set(ctx, lastReadCursorKey, []byte(cursor)); err != nil {
 				operator.Warnw("Failed to set offset", zap.Error(err))
 			}
-			operator.Write(ctx, entry)
 		}
 	}()
 
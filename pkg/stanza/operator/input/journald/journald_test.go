@@ -115,3 +115,118 @@ func TestInputJournald(t *testing.T) {
 		require.FailNow(t, "Timed out waiting for entry to be read")
 	}
 }
+
+func TestInputJournaldResumesFromCursor(t *testing.T) {
+	cfg := NewConfigWithID("my_journald_input")
+	cfg.OutputIDs = []string{"output"}
+
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	mockOutput := testutil.NewMockOperator("output")
+	received := make(chan *entry.Entry)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		received <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+
+	err = op.SetOutputs([]operator.Operator{mockOutput})
+	require.NoError(t, err)
+
+	var startCursor []byte
+	op.(*Input).newCmd = func(ctx context.Context, cursor []byte) cmd {
+		startCursor = cursor
+		return &fakeJournaldCmd{}
+	}
+
+	persister := testutil.NewMockPersister("test")
+	require.NoError(t, persister.Set(context.Background(), lastReadCursorKey, []byte("previous-cursor")))
+
+	err = op.Start(persister)
+	require.NoError(t, err)
+	require.Equal(t, []byte("previous-cursor"), startCursor)
+
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for entry to be read")
+	}
+	require.NoError(t, op.Stop())
+
+	cursor, err := persister.Get(context.Background(), lastReadCursorKey)
+	require.NoError(t, err)
+	require.Equal(t, "s=b1e713b587ae4001a9ca482c4b12c005;i=1eed30;b=c4fa36de06824d21835c05ff80c54468;m=9f9d630205;t=5a369604ee333;x=16c2d4fd4fdb7c36", string(cursor))
+}
+
+func TestBuildConfig(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Config        func(cfg *Config)
+		Expected      []string
+		ExpectedError string
+	}{
+		{
+			Name:     "empty config",
+			Config:   func(cfg *Config) {},
+			Expected: []string{"--utc", "--output=json", "--follow", "--priority", "info"},
+		},
+		{
+			Name: "units and identifiers",
+			Config: func(cfg *Config) {
+				cfg.Units = []string{"ssh", "kubelet"}
+				cfg.Identifiers = []string{"sshd", "sudo"}
+			},
+			Expected: []string{"--utc", "--output=json", "--follow", "--unit", "ssh", "--unit", "kubelet", "--identifier", "sshd", "--identifier", "sudo", "--priority", "info"},
+		},
+		{
+			Name: "priority range",
+			Config: func(cfg *Config) {
+				cfg.Priority = "emerg..warning"
+			},
+			Expected: []string{"--utc", "--output=json", "--follow", "--priority", "emerg..warning"},
+		},
+		{
+			Name: "numeric priority range",
+			Config: func(cfg *Config) {
+				cfg.StartAt = "beginning"
+				cfg.Priority = "0..3"
+			},
+			Expected: []string{"--utc", "--output=json", "--follow", "--no-tail", "--priority", "0..3"},
+		},
+		{
+			Name: "invalid priority",
+			Config: func(cfg *Config) {
+				cfg.Priority = "loud"
+			},
+			ExpectedError: "invalid value 'loud' for parameter 'priority'",
+		},
+		{
+			Name: "invalid priority range",
+			Config: func(cfg *Config) {
+				cfg.Priority = "err..info..debug"
+			},
+			ExpectedError: "invalid value 'err..info..debug' for parameter 'priority'",
+		},
+		{
+			Name: "out of range numeric priority",
+			Config: func(cfg *Config) {
+				cfg.Priority = "0..8"
+			},
+			ExpectedError: "invalid value '0..8' for parameter 'priority'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			cfg := NewConfigWithID("my_journald_input")
+			tc.Config(cfg)
+
+			args, err := cfg.buildArgs()
+			if tc.ExpectedError != "" {
+				require.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.Expected, args)
+		})
+	}
+}
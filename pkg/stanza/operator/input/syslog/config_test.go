@@ -62,6 +62,26 @@ func TestUnmarshal(t *testing.T) {
 					return cfg
 				}(),
 			},
+			{
+				Name:      "tcp_octet_counting",
+				ExpectErr: false,
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.Protocol = "rfc5424"
+					cfg.OctetCounting = true
+					cfg.TCP = &tcp.NewConfig().BaseConfig
+					cfg.TCP.ListenAddress = "10.0.0.1:6514"
+					cfg.TCP.AllowedClientCommonNames = []string{"forwarder"}
+					cfg.TCP.TLS = &configtls.TLSServerSetting{
+						TLSSetting: configtls.TLSSetting{
+							CertFile: "foo",
+							KeyFile:  "foo2",
+						},
+						ClientCAFile: "foo4",
+					}
+					return cfg
+				}(),
+			},
 			{
 				Name:      "udp",
 				ExpectErr: false,
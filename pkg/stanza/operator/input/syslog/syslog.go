@@ -15,7 +15,9 @@
 package syslog // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/syslog"
 
 import (
+	"bytes"
 	"fmt"
+	"strconv"
 
 	"go.uber.org/zap"
 
@@ -49,6 +51,7 @@ type Config struct {
 	syslog.BaseConfig  `mapstructure:",squash"`
 	TCP                *tcp.BaseConfig `mapstructure:"tcp"`
 	UDP                *udp.BaseConfig `mapstructure:"udp"`
+	OctetCounting      bool            `mapstructure:"enable_octet_counting,omitempty"`
 }
 
 func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
@@ -70,7 +73,12 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		tcpInputCfg := tcp.NewConfigWithID(inputBase.ID() + "_internal_tcp")
 		tcpInputCfg.BaseConfig = *c.TCP
 
-		tcpInput, err := tcpInputCfg.Build(logger)
+		var tcpInput operator.Operator
+		if c.OctetCounting {
+			tcpInput, err = tcpInputCfg.BuildWithSplitFunc(logger, OctetCountingSplitFunc)
+		} else {
+			tcpInput, err = tcpInputCfg.Build(logger)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("failed to resolve tcp config: %w", err)
 		}
@@ -88,6 +96,10 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 	}
 
 	if c.UDP != nil {
+		if c.OctetCounting {
+			return nil, fmt.Errorf("octet counting is only supported over tcp")
+		}
+
 		udpInputCfg := udp.NewConfigWithID(inputBase.ID() + "_internal_udp")
 		udpInputCfg.BaseConfig = *c.UDP
 
@@ -140,3 +152,42 @@ func (t *Input) SetOutputs(operators []operator.Operator) error {
 	t.parser.SetOutputIDs(t.GetOutputIDs())
 	return t.parser.SetOutputs(operators)
 }
+
+// OctetCountingSplitFunc splits the messages framed with the octet counting
+// method of RFC6587, where each message is preceded by its length in bytes
+// and a space. The whitespace some senders put between the frames is skipped.
+func OctetCountingSplitFunc(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	start := 0
+	for start < len(data) && (data[start] == ' ' || data[start] == '\n' || data[start] == '\r') {
+		start++
+	}
+	if start == len(data) {
+		return start, nil, nil
+	}
+
+	space := bytes.IndexByte(data[start:], ' ')
+	if space == -1 {
+		if atEOF {
+			return 0, nil, fmt.Errorf("missing octet count delimiter")
+		}
+		// Request more data
+		return start, nil, nil
+	}
+
+	length, err := strconv.Atoi(string(data[start : start+space]))
+	if err != nil || length <= 0 {
+		return 0, nil, fmt.Errorf("invalid octet count '%s'", data[start:start+space])
+	}
+
+	msgStart := start + space + 1
+	msgEnd := msgStart + length
+	if len(data) < msgEnd {
+		if atEOF {
+			return 0, nil, fmt.Errorf("message is shorter than its octet count %d", length)
+		}
+		// Request more data
+		return start, nil, nil
+	}
+
+	return msgEnd, data[msgStart:msgEnd], nil
+}
@@ -15,8 +15,10 @@
 package syslog
 
 import (
+	"bufio"
 	"fmt"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -95,6 +97,74 @@ func InputTest(t *testing.T, cfg *Config, tc syslog.Case) {
 	}
 }
 
+func TestInputOctetCounting(t *testing.T) {
+	basicConfig := func() *syslog.Config {
+		cfg := syslog.NewConfigWithID("test_syslog_parser")
+		return cfg
+	}
+
+	cases, err := syslog.CreateCases(basicConfig)
+	require.NoError(t, err)
+
+	for _, tc := range cases {
+		body, ok := tc.Input.Body.(string)
+		if !ok {
+			continue
+		}
+
+		t.Run(fmt.Sprintf("TCP-%s", tc.Name), func(t *testing.T) {
+			cfg := NewConfigWithTCP(&tc.Config.BaseConfig)
+			cfg.OctetCounting = true
+			tc.Input.Body = fmt.Sprintf("%d %s", len(body), body)
+			InputTest(t, cfg, tc)
+		})
+	}
+}
+
+func TestOctetCountingSplitFunc(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected []string
+		err      bool
+	}{
+		{"single", "5 hello", []string{"hello"}, false},
+		{"multiple", "5 hello11 hello world", []string{"hello", "hello world"}, false},
+		{"newline-separated", "5 hello\n3 foo\n", []string{"hello", "foo"}, false},
+		{"message-with-newline", "11 hello\nworld", []string{"hello\nworld"}, false},
+		{"invalid-count", "abc hello", nil, true},
+		{"truncated", "10 hello", nil, true},
+		{"missing-delimiter", "10", nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			scanner := bufio.NewScanner(strings.NewReader(tc.input))
+			scanner.Split(OctetCountingSplitFunc)
+
+			var tokens []string
+			for scanner.Scan() {
+				tokens = append(tokens, scanner.Text())
+			}
+
+			if tc.err {
+				require.Error(t, scanner.Err())
+				return
+			}
+			require.NoError(t, scanner.Err())
+			require.Equal(t, tc.expected, tokens)
+		})
+	}
+}
+
+func TestOctetCountingRequiresTCP(t *testing.T) {
+	cfg := NewConfigWithUDP(&syslog.NewConfigWithID("test_syslog_parser").BaseConfig)
+	cfg.Protocol = "rfc5424"
+	cfg.OctetCounting = true
+	_, err := cfg.Build(testutil.Logger(t))
+	require.Error(t, err)
+}
+
 func TestSyslogIDs(t *testing.T) {
 	basicConfig := func() *syslog.BaseConfig {
 		cfg := syslog.NewConfigWithID("test_syslog_parser")
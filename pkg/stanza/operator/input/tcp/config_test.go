@@ -54,6 +54,7 @@ func TestUnmarshal(t *testing.T) {
 						},
 						ClientCAFile: "foo4",
 					}
+					cfg.AllowedClientCommonNames = []string{"client1", "client2"}
 					return cfg
 				}(),
 			},
@@ -19,6 +19,7 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
 	"strconv"
@@ -73,16 +74,31 @@ type Config struct {
 
 // BaseConfig is the detailed configuration of a tcp input operator.
 type BaseConfig struct {
-	MaxLogSize    helper.ByteSize             `mapstructure:"max_log_size,omitempty"`
-	ListenAddress string                      `mapstructure:"listen_address,omitempty"`
-	TLS           *configtls.TLSServerSetting `mapstructure:"tls,omitempty"`
-	AddAttributes bool                        `mapstructure:"add_attributes,omitempty"`
-	Encoding      helper.EncodingConfig       `mapstructure:",squash,omitempty"`
-	Multiline     helper.MultilineConfig      `mapstructure:"multiline,omitempty"`
+	MaxLogSize               helper.ByteSize             `mapstructure:"max_log_size,omitempty"`
+	ListenAddress            string                      `mapstructure:"listen_address,omitempty"`
+	TLS                      *configtls.TLSServerSetting `mapstructure:"tls,omitempty"`
+	AllowedClientCommonNames []string                    `mapstructure:"allowed_client_common_names,omitempty"`
+	AddAttributes            bool                        `mapstructure:"add_attributes,omitempty"`
+	Encoding                 helper.EncodingConfig       `mapstructure:",squash,omitempty"`
+	Multiline                helper.MultilineConfig      `mapstructure:"multiline,omitempty"`
 }
 
 // Build will build a tcp input operator.
 func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	return c.build(logger, nil)
+}
+
+// BuildWithSplitFunc will build a tcp input operator, tokenizing the messages with
+// splitFunc instead of the configured multiline splitter. The tokens are still decoded
+// with the configured encoding.
+func (c Config) BuildWithSplitFunc(logger *zap.SugaredLogger, splitFunc bufio.SplitFunc) (operator.Operator, error) {
+	if splitFunc == nil {
+		return nil, fmt.Errorf("must provide split function")
+	}
+	return c.build(logger, splitFunc)
+}
+
+func (c Config) build(logger *zap.SugaredLogger, splitFunc bufio.SplitFunc) (operator.Operator, error) {
 	inputOperator, err := c.InputConfig.Build(logger)
 	if err != nil {
 		return nil, err
@@ -112,9 +128,15 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 	}
 
 	// Build multiline
-	splitFunc, err := c.Multiline.Build(encoding.Encoding, true, nil, int(c.MaxLogSize))
-	if err != nil {
-		return nil, err
+	if splitFunc == nil {
+		splitFunc, err = c.Multiline.Build(encoding.Encoding, true, nil, int(c.MaxLogSize))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(c.AllowedClientCommonNames) > 0 && (c.TLS == nil || c.TLS.ClientCAFile == "") {
+		return nil, fmt.Errorf("'allowed_client_common_names' requires 'tls.client_ca_file' to authenticate the clients")
 	}
 
 	var resolver *helper.IPResolver
@@ -140,11 +162,37 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		if len(c.AllowedClientCommonNames) > 0 {
+			tcpInput.tls.VerifyPeerCertificate = verifyClientCommonName(c.AllowedClientCommonNames)
+		}
 	}
 
 	return tcpInput, nil
 }
 
+// verifyClientCommonName returns a function rejecting the client certificates
+// whose common name is not one of the allowed ones. It is called once the
+// certificate chain is verified against the client CAs.
+func verifyClientCommonName(allowed []string) func([][]byte, [][]*x509.Certificate) error {
+	allowedNames := make(map[string]struct{}, len(allowed))
+	for _, name := range allowed {
+		allowedNames[name] = struct{}{}
+	}
+
+	return func(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+		for _, chain := range verifiedChains {
+			if len(chain) == 0 {
+				continue
+			}
+			if _, ok := allowedNames[chain[0].Subject.CommonName]; ok {
+				return nil
+			}
+		}
+		return fmt.Errorf("client certificate common name is not allowed")
+	}
+}
+
 // Input is an operator that listens for log entries over tcp.
 type Input struct {
 	helper.InputOperator
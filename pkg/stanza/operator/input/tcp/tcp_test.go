@@ -15,10 +15,18 @@
 package tcp
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	crand "crypto/rand"
 	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"math/rand"
 	"net"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -330,6 +338,17 @@ func TestBuild(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"allowed-client-common-names-without-client-ca-error",
+			Config{
+				BaseConfig: BaseConfig{
+					MaxLogSize:               65536,
+					ListenAddress:            "10.0.0.1:9000",
+					AllowedClientCommonNames: []string{"client"},
+				},
+			},
+			true,
+		},
 		{
 			"tls-enabled-with-no-such-file-error",
 			Config{
@@ -354,6 +373,7 @@ func TestBuild(t *testing.T) {
 			cfg.ListenAddress = tc.inputBody.ListenAddress
 			cfg.MaxLogSize = tc.inputBody.MaxLogSize
 			cfg.TLS = tc.inputBody.TLS
+			cfg.AllowedClientCommonNames = tc.inputBody.AllowedClientCommonNames
 			_, err := cfg.Build(testutil.Logger(t))
 			if tc.expectErr {
 				require.Error(t, err)
@@ -379,6 +399,116 @@ func TestTLSTCPInput(t *testing.T) {
 	t.Run("CarriageReturn", tlsInputTest([]byte("message\r\n"), []string{"message"}))
 }
 
+// writeClientCertificates writes a CA to caFile, and returns a client certificate
+// with the common name, signed by the CA
+func writeClientCertificates(t *testing.T, caFile string, commonName string) tls.Certificate {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), crand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(crand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}), 0600))
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), crand.Reader)
+	require.NoError(t, err)
+	clientTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	clientDER, err := x509.CreateCertificate(crand.Reader, clientTemplate, caTemplate, &clientKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	return tls.Certificate{
+		Certificate: [][]byte{clientDER},
+		PrivateKey:  clientKey,
+	}
+}
+
+func TestTLSAllowedClientCommonNames(t *testing.T) {
+	cases := []struct {
+		name       string
+		commonName string
+		allowed    bool
+	}{
+		{"allowed", "client1", true},
+		{"not-allowed", "client3", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			certFile := filepath.Join(dir, "test.crt")
+			keyFile := filepath.Join(dir, "test.key")
+			caFile := filepath.Join(dir, "ca.crt")
+			require.NoError(t, os.WriteFile(certFile, []byte(testTLSCertificate+"\n"), 0600))
+			require.NoError(t, os.WriteFile(keyFile, []byte(testTLSPrivateKey+"\n"), 0600))
+			clientCert := writeClientCertificates(t, caFile, tc.commonName)
+
+			cfg := NewConfigWithID("test_id")
+			cfg.ListenAddress = ":0"
+			cfg.TLS = &configtls.TLSServerSetting{
+				TLSSetting: configtls.TLSSetting{
+					CertFile: certFile,
+					KeyFile:  keyFile,
+				},
+				ClientCAFile: caFile,
+			}
+			cfg.AllowedClientCommonNames = []string{"client1", "client2"}
+
+			op, err := cfg.Build(testutil.Logger(t))
+			require.NoError(t, err)
+
+			mockOutput := testutil.Operator{}
+			tcpInput := op.(*Input)
+			tcpInput.InputOperator.OutputOperators = []operator.Operator{&mockOutput}
+
+			entryChan := make(chan *entry.Entry, 1)
+			mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				entryChan <- args.Get(1).(*entry.Entry)
+			}).Return(nil)
+
+			require.NoError(t, tcpInput.Start(testutil.NewMockPersister("test")))
+			defer func() {
+				require.NoError(t, tcpInput.Stop(), "expected to stop tcp input operator without error")
+			}()
+
+			conn, err := tls.Dial("tcp", tcpInput.listener.Addr().String(), &tls.Config{
+				InsecureSkipVerify: true, // #nosec - the server uses a self-signed test certificate
+				Certificates:       []tls.Certificate{clientCert},
+			})
+			if err != nil {
+				require.False(t, tc.allowed, "Failed to connect: %s", err)
+				return
+			}
+			defer conn.Close()
+
+			// The client certificate is verified during the handshake, which
+			// only completes on the server once the first message is read
+			_, _ = conn.Write([]byte("message\n"))
+
+			select {
+			case entry := <-entryChan:
+				require.True(t, tc.allowed, "Unexpected entry: %s", entry)
+				require.Equal(t, "message", entry.Body)
+			case <-time.After(time.Second):
+				require.False(t, tc.allowed, "Timed out waiting for message to be written")
+			}
+		})
+	}
+}
+
 func TestFailToBind(t *testing.T) {
 	ip := "localhost"
 	port := 0
@@ -54,9 +54,12 @@ func NewConfigWithID(operatorID string) *Config {
 type Config struct {
 	helper.InputConfig `mapstructure:",squash"`
 	Channel            string        `mapstructure:"channel"`
+	Query              string        `mapstructure:"query,omitempty"`
 	MaxReads           int           `mapstructure:"max_reads,omitempty"`
 	StartAt            string        `mapstructure:"start_at,omitempty"`
 	PollInterval       time.Duration `mapstructure:"poll_interval,omitempty"`
+	LanguageID         uint32        `mapstructure:"language_id,omitempty"`
+	Remote             RemoteConfig  `mapstructure:"remote,omitempty"`
 }
 
 // Build will build a windows event log operator.
@@ -66,8 +69,8 @@ func (c *Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, err
 	}
 
-	if c.Channel == "" {
-		return nil, fmt.Errorf("missing required `channel` field")
+	if c.Channel == "" && c.Query == "" {
+		return nil, fmt.Errorf("either the `channel` or the `query` field must be set")
 	}
 
 	if c.MaxReads < 1 {
@@ -78,13 +81,20 @@ func (c *Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, fmt.Errorf("the `start_at` field must be set to `beginning` or `end`")
 	}
 
+	if c.Remote.Server == "" && (c.Remote.Username != "" || c.Remote.Password != "" || c.Remote.Domain != "") {
+		return nil, fmt.Errorf("the `remote.server` field must be set when remote credentials are provided")
+	}
+
 	return &Input{
 		InputOperator: inputOperator,
 		buffer:        NewBuffer(),
 		channel:       c.Channel,
+		query:         c.Query,
 		maxReads:      c.MaxReads,
 		startAt:       c.StartAt,
 		pollInterval:  c.PollInterval,
+		languageID:    c.LanguageID,
+		remote:        c.Remote,
 	}, nil
 }
 
@@ -93,11 +103,15 @@ type Input struct {
 	helper.InputOperator
 	bookmark     Bookmark
 	subscription Subscription
+	session      Session
 	buffer       Buffer
 	channel      string
+	query        string
 	maxReads     int
 	startAt      string
 	pollInterval time.Duration
+	languageID   uint32
+	remote       RemoteConfig
 	persister    operator.Persister
 	cancel       context.CancelFunc
 	wg           sync.WaitGroup
@@ -110,11 +124,18 @@ func (e *Input) Start(persister operator.Persister) error {
 
 	e.persister = persister
 
+	e.session = NewSession()
+	if e.remote.Server != "" {
+		if err := e.session.Open(e.remote); err != nil {
+			return fmt.Errorf("failed to open remote session: %w", err)
+		}
+	}
+
 	e.bookmark = NewBookmark()
 	offsetXML, err := e.getBookmarkOffset(ctx)
 	if err != nil {
 		e.Errorf("Failed to open bookmark, continuing without previous bookmark: %s", err)
-		e.persister.Delete(ctx, e.channel)
+		e.persister.Delete(ctx, e.bookmarkKey())
 	}
 
 	if offsetXML != "" {
@@ -124,7 +145,7 @@ func (e *Input) Start(persister operator.Persister) error {
 	}
 
 	e.subscription = NewSubscription()
-	if err := e.subscription.Open(e.channel, e.startAt, e.bookmark); err != nil {
+	if err := e.subscription.Open(e.session.handle, e.channel, e.query, e.startAt, e.bookmark); err != nil {
 		return fmt.Errorf("failed to open subscription: %w", err)
 	}
 
@@ -146,6 +167,10 @@ func (e *Input) Stop() error {
 		return fmt.Errorf("failed to close bookmark: %w", err)
 	}
 
+	if err := e.session.Close(); err != nil {
+		return fmt.Errorf("failed to close remote session: %w", err)
+	}
+
 	return nil
 }
 
@@ -208,7 +233,7 @@ func (e *Input) processEvent(ctx context.Context, event Event) {
 	}
 
 	publisher := NewPublisher()
-	if err := publisher.Open(simpleEvent.Provider.Name); err != nil {
+	if err := publisher.Open(e.session.handle, simpleEvent.Provider.Name, e.languageID); err != nil {
 		e.Errorf("Failed to open publisher: %s: writing log entry to pipeline without metadata", err)
 		e.sendEvent(ctx, simpleEvent)
 		return
@@ -239,9 +264,22 @@ func (e *Input) sendEvent(ctx context.Context, eventXML EventXML) {
 	e.Write(ctx, entry)
 }
 
+// bookmarkKey is the key the bookmark is stored under in the offsets database.
+// Bookmarks of remote computers are kept apart from the ones of the local computer.
+func (e *Input) bookmarkKey() string {
+	key := e.channel
+	if key == "" {
+		key = e.query
+	}
+	if e.remote.Server != "" {
+		key = e.remote.Server + "/" + key
+	}
+	return key
+}
+
 // getBookmarkXML will get the bookmark xml from the offsets database.
 func (e *Input) getBookmarkOffset(ctx context.Context) (string, error) {
-	bytes, err := e.persister.Get(ctx, e.channel)
+	bytes, err := e.persister.Get(ctx, e.bookmarkKey())
 	return string(bytes), err
 }
 
@@ -258,7 +296,7 @@ func (e *Input) updateBookmarkOffset(ctx context.Context, event Event) {
 		return
 	}
 
-	if err := e.persister.Set(ctx, e.channel, []byte(bookmarkXML)); err != nil {
+	if err := e.persister.Set(ctx, e.bookmarkKey(), []byte(bookmarkXML)); err != nil {
 		e.Errorf("failed to set offsets: %s", err)
 		return
 	}
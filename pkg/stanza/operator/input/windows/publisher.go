@@ -27,8 +27,11 @@ type Publisher struct {
 	handle uintptr
 }
 
-// Open will open the publisher handle using the supplied provider.
-func (p *Publisher) Open(provider string) error {
+// Open will open the publisher handle using the supplied provider. The session
+// is the handle of the remote session the events were read from, or 0 for the
+// local computer, and languageID is the locale used to render messages, or 0 for
+// the default locale.
+func (p *Publisher) Open(session uintptr, provider string, languageID uint32) error {
 	if p.handle != 0 {
 		return fmt.Errorf("publisher handle is already open")
 	}
@@ -38,7 +41,7 @@ func (p *Publisher) Open(provider string) error {
 		return fmt.Errorf("failed to convert provider to utf16: %w", err)
 	}
 
-	handle, err := evtOpenPublisherMetadata(0, utf16, nil, 0, 0)
+	handle, err := evtOpenPublisherMetadata(session, utf16, nil, languageID, 0)
 	if err != nil {
 		return fmt.Errorf("failed to open publisher handle: %w", err)
 	}
@@ -25,7 +25,7 @@ import (
 
 func TestPublisherOpenPreexisting(t *testing.T) {
 	publisher := Publisher{handle: 5}
-	err := publisher.Open("")
+	err := publisher.Open(0, "", 0)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "publisher handle is already open")
 }
@@ -33,7 +33,7 @@ func TestPublisherOpenPreexisting(t *testing.T) {
 func TestPublisherOpenInvalidUTF8(t *testing.T) {
 	publisher := NewPublisher()
 	invalidUTF8 := "\u0000"
-	err := publisher.Open(invalidUTF8)
+	err := publisher.Open(0, invalidUTF8, 0)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to convert provider to utf16")
 }
@@ -42,7 +42,7 @@ func TestPublisherOpenSyscallFailure(t *testing.T) {
 	publisher := NewPublisher()
 	provider := "provider"
 	openPublisherMetadataProc = SimpleMockProc(0, 0, ErrorNotSupported)
-	err := publisher.Open(provider)
+	err := publisher.Open(0, provider, 0)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to open publisher handle")
 }
@@ -51,7 +51,7 @@ func TestPublisherOpenSuccess(t *testing.T) {
 	publisher := NewPublisher()
 	provider := "provider"
 	openPublisherMetadataProc = SimpleMockProc(5, 0, ErrorSuccess)
-	err := publisher.Open(provider)
+	err := publisher.Open(0, provider, 0)
 	require.NoError(t, err)
 	require.Equal(t, uintptr(5), publisher.handle)
 }
@@ -77,3 +77,19 @@ func TestPublisherCloseSuccess(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, uintptr(0), publisher.handle)
 }
+
+func TestPublisherOpenRemoteWithLocale(t *testing.T) {
+	publisher := NewPublisher()
+	var args []uintptr
+	openPublisherMetadataProc = MockProc{
+		call: func(a ...uintptr) (uintptr, uintptr, error) {
+			args = a
+			return 5, 0, ErrorSuccess
+		},
+	}
+	err := publisher.Open(7, "provider", 1031)
+	require.NoError(t, err)
+	require.Equal(t, uintptr(5), publisher.handle)
+	require.Equal(t, uintptr(7), args[0])
+	require.Equal(t, uintptr(1031), args[3])
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+// +build windows
+
+package windows // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/windows"
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// RemoteConfig is the configuration of a remote computer to read events from.
+type RemoteConfig struct {
+	Server   string `mapstructure:"server"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	Domain   string `mapstructure:"domain"`
+}
+
+// Session is a session to a remote computer's event log service.
+type Session struct {
+	handle uintptr
+}
+
+// Open will open a session to the remote computer described by the supplied config.
+// Events are then read over RPC, which requires the remote event log management
+// firewall rules to be enabled on the remote computer.
+func (s *Session) Open(remote RemoteConfig) error {
+	if s.handle != 0 {
+		return fmt.Errorf("session handle is already open")
+	}
+
+	login := &EvtRPCLoginInfo{}
+	var err error
+	if login.Server, err = utf16PtrOrNil(remote.Server); err != nil {
+		return fmt.Errorf("failed to convert server to utf16: %w", err)
+	}
+	if login.User, err = utf16PtrOrNil(remote.Username); err != nil {
+		return fmt.Errorf("failed to convert username to utf16: %w", err)
+	}
+	if login.Domain, err = utf16PtrOrNil(remote.Domain); err != nil {
+		return fmt.Errorf("failed to convert domain to utf16: %w", err)
+	}
+	if login.Password, err = utf16PtrOrNil(remote.Password); err != nil {
+		return fmt.Errorf("failed to convert password to utf16: %w", err)
+	}
+
+	handle, err := evtOpenSession(EvtRPCLogin, login, 0, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open session to %s: %w", remote.Server, err)
+	}
+
+	s.handle = handle
+	return nil
+}
+
+// Close will close the session handle.
+func (s *Session) Close() error {
+	if s.handle == 0 {
+		return nil
+	}
+
+	if err := evtClose(s.handle); err != nil {
+		return fmt.Errorf("failed to close session handle: %w", err)
+	}
+
+	s.handle = 0
+	return nil
+}
+
+// NewSession will create a new session with an empty handle, which refers to the local computer.
+func NewSession() Session {
+	return Session{
+		handle: 0,
+	}
+}
+
+// utf16PtrOrNil converts a string to utf16, leaving empty strings as nil so
+// that the API falls back to its defaults.
+func utf16PtrOrNil(s string) (*uint16, error) {
+	if s == "" {
+		return nil, nil
+	}
+	return syscall.UTF16PtrFromString(s)
+}
@@ -20,7 +20,6 @@ package windows // import "github.com/open-telemetry/opentelemetry-collector-con
 import (
 	"errors"
 	"fmt"
-	"syscall"
 
 	"golang.org/x/sys/windows"
 )
@@ -30,8 +29,10 @@ type Subscription struct {
 	handle uintptr
 }
 
-// Open will open the subscription handle.
-func (s *Subscription) Open(channel string, startAt string, bookmark Bookmark) error {
+// Open will open the subscription handle. The session is the handle of a remote
+// session, or 0 for the local computer. The query is an optional XPath query or
+// structured XML query used to filter the events of the channel.
+func (s *Subscription) Open(session uintptr, channel string, query string, startAt string, bookmark Bookmark) error {
 	if s.handle != 0 {
 		return fmt.Errorf("subscription handle is already open")
 	}
@@ -42,13 +43,18 @@ func (s *Subscription) Open(channel string, startAt string, bookmark Bookmark) e
 	}
 	defer windows.CloseHandle(signalEvent)
 
-	channelPtr, err := syscall.UTF16PtrFromString(channel)
+	channelPtr, err := utf16PtrOrNil(channel)
 	if err != nil {
 		return fmt.Errorf("failed to convert channel to utf16: %w", err)
 	}
 
+	queryPtr, err := utf16PtrOrNil(query)
+	if err != nil {
+		return fmt.Errorf("failed to convert query to utf16: %w", err)
+	}
+
 	flags := s.createFlags(startAt, bookmark)
-	subscriptionHandle, err := evtSubscribe(0, signalEvent, channelPtr, nil, bookmark.handle, 0, 0, flags)
+	subscriptionHandle, err := evtSubscribe(session, signalEvent, channelPtr, queryPtr, bookmark.handle, 0, 0, flags)
 	if err != nil {
 		return fmt.Errorf("failed to subscribe to %s channel: %w", channel, err)
 	}
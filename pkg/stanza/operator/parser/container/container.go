@@ -0,0 +1,356 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/container"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	operatorType = "container"
+
+	dockerFormat     = "docker"
+	containerdFormat = "containerd"
+	crioFormat       = "crio"
+
+	criPartialTag = "P"
+
+	iostreamAttribute = "log.iostream"
+	filePathAttribute = "log.file.path"
+)
+
+var (
+	// criLogPattern matches the log lines written by containerd and cri-o,
+	// e.g. "2022-10-16T08:30:00.123456789Z stdout F message".
+	criLogPattern = regexp.MustCompile(`^(?P<time>[^ ]+) (?P<stream>stdout|stderr) (?P<logtag>[^ ]*) ?(?P<log>.*)$`)
+
+	// filePathPattern matches the paths of the log files of the kubernetes containers,
+	// e.g. "/var/log/pods/<namespace>_<pod_name>_<pod_uid>/<container_name>/<restart_count>.log".
+	filePathPattern = regexp.MustCompile(`^.*/(?P<namespace>[^_/]+)_(?P<pod_name>[^_/]+)_(?P<uid>[a-f0-9-]+)/(?P<container_name>[^._/]+)/(?P<restart_count>\d+)\.log$`)
+)
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new container parser config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new container parser config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		TransformerConfig:       helper.NewTransformerConfig(operatorID, operatorType),
+		AddMetadataFromFilePath: true,
+		MaxLogSize:              1024 * 1024,
+		ForceFlushPeriod:        5 * time.Second,
+	}
+}
+
+// Config is the configuration of a container parser operator.
+type Config struct {
+	helper.TransformerConfig `mapstructure:",squash"`
+
+	// Format is the format of the container logs, one of "docker", "containerd" and "crio".
+	// The format is detected for each entry when it is empty.
+	Format string `mapstructure:"format"`
+
+	// AddMetadataFromFilePath adds the kubernetes metadata found in the path of the log file
+	// to the resource of the entries.
+	AddMetadataFromFilePath bool `mapstructure:"add_metadata_from_filepath"`
+
+	// MaxLogSize is the maximum size of a log reassembled from partial lines. A partial log
+	// is sent as is once it reaches this size.
+	MaxLogSize helper.ByteSize `mapstructure:"max_log_size"`
+
+	// ForceFlushPeriod is the time after which a partial log is sent as is when no following
+	// line was received.
+	ForceFlushPeriod time.Duration `mapstructure:"force_flush_period"`
+}
+
+// Build will build a container parser operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	transformer, err := c.TransformerConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	switch c.Format {
+	case "", dockerFormat, containerdFormat, crioFormat:
+	default:
+		return nil, fmt.Errorf("invalid format '%s', must be one of '%s', '%s' or '%s'", c.Format, dockerFormat, containerdFormat, crioFormat)
+	}
+
+	if c.MaxLogSize <= 0 {
+		return nil, fmt.Errorf("max_log_size must be positive")
+	}
+
+	if c.ForceFlushPeriod <= 0 {
+		return nil, fmt.Errorf("force_flush_period must be positive")
+	}
+
+	return &Parser{
+		TransformerOperator:     transformer,
+		format:                  c.Format,
+		addMetadataFromFilePath: c.AddMetadataFromFilePath,
+		maxLogSize:              int(c.MaxLogSize),
+		forceFlushPeriod:        c.ForceFlushPeriod,
+		json:                    jsoniter.ConfigFastest,
+		partials:                make(map[string]*partialLog),
+		chClose:                 make(chan struct{}),
+	}, nil
+}
+
+// Parser is an operator that parses the logs of the container runtimes.
+type Parser struct {
+	helper.TransformerOperator
+	format                  string
+	addMetadataFromFilePath bool
+	maxLogSize              int
+	forceFlushPeriod        time.Duration
+	json                    jsoniter.API
+	chClose                 chan struct{}
+
+	mu sync.Mutex
+	// partials holds the logs being reassembled from partial lines, by file path and stream.
+	partials map[string]*partialLog
+}
+
+// partialLog is a log being reassembled from partial lines.
+type partialLog struct {
+	entry *entry.Entry
+	log   strings.Builder
+	// lastLineTime is the time the last partial line was processed.
+	lastLineTime time.Time
+}
+
+// containerLog is a line of a container log file.
+type containerLog struct {
+	time    time.Time
+	stream  string
+	log     string
+	partial bool
+}
+
+type dockerLog struct {
+	Log    string `json:"log"`
+	Stream string `json:"stream"`
+	Time   string `json:"time"`
+}
+
+// Start starts sending the partial logs which did not receive any line within the force flush period.
+func (p *Parser) Start(_ operator.Persister) error {
+	go p.flushLoop()
+	return nil
+}
+
+func (p *Parser) flushLoop() {
+	ticker := time.NewTicker(p.forceFlushPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.flushExpired()
+		case <-p.chClose:
+			return
+		}
+	}
+}
+
+// flushExpired sends the partial logs whose last line is older than the force flush period.
+func (p *Parser) flushExpired() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	now := time.Now()
+	for key, partial := range p.partials {
+		if now.Sub(partial.lastLineTime) < p.forceFlushPeriod {
+			continue
+		}
+		delete(p.partials, key)
+		p.writePartial(ctx, partial)
+	}
+}
+
+// Stop sends the logs which are still being reassembled.
+func (p *Parser) Stop() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for key, partial := range p.partials {
+		p.writePartial(ctx, partial)
+		delete(p.partials, key)
+	}
+	close(p.chClose)
+	return nil
+}
+
+// Process will parse an entry written by a container runtime.
+func (p *Parser) Process(ctx context.Context, e *entry.Entry) error {
+	skip, err := p.Skip(ctx, e)
+	if err != nil {
+		return p.HandleEntryError(ctx, e, err)
+	}
+	if skip {
+		p.Write(ctx, e)
+		return nil
+	}
+
+	line, ok := e.Body.(string)
+	if !ok {
+		return p.HandleEntryError(ctx, e, fmt.Errorf("type %T cannot be parsed as a container log", e.Body))
+	}
+
+	format := p.format
+	if format == "" {
+		format = detectFormat(line)
+	}
+
+	var parsed containerLog
+	if format == dockerFormat {
+		parsed, err = p.parseDocker(line)
+	} else {
+		parsed, err = parseCRI(line)
+	}
+	if err != nil {
+		return p.HandleEntryError(ctx, e, err)
+	}
+
+	if p.addMetadataFromFilePath {
+		addMetadataFromFilePath(e)
+	}
+
+	e.Timestamp = parsed.time
+	e.AddAttribute(iostreamAttribute, parsed.stream)
+	p.reassemble(ctx, e, parsed)
+	return nil
+}
+
+// reassemble writes the entry once its log is complete. The logs split over partial lines
+// are combined into the first entry, which is written along with the last line, or once
+// no line was received within the force flush period.
+func (p *Parser) reassemble(ctx context.Context, e *entry.Entry, parsed containerLog) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var filePath string
+	_ = e.Read(entry.NewAttributeField(filePathAttribute), &filePath)
+	key := filePath + "\x00" + parsed.stream
+
+	partial, ok := p.partials[key]
+	if !ok && !parsed.partial {
+		e.Body = parsed.log
+		p.Write(ctx, e)
+		return
+	}
+	if !ok {
+		partial = &partialLog{entry: e}
+		p.partials[key] = partial
+	}
+	partial.log.WriteString(parsed.log)
+	partial.lastLineTime = time.Now()
+
+	if !parsed.partial || partial.log.Len() >= p.maxLogSize {
+		delete(p.partials, key)
+		p.writePartial(ctx, partial)
+	}
+}
+
+func (p *Parser) writePartial(ctx context.Context, partial *partialLog) {
+	partial.entry.Body = partial.log.String()
+	p.Write(ctx, partial.entry)
+}
+
+// detectFormat returns the format of the line, the docker logs being JSON objects.
+func detectFormat(line string) string {
+	if strings.HasPrefix(line, "{") {
+		return dockerFormat
+	}
+	return containerdFormat
+}
+
+// parseDocker parses a line written by the json-file logging driver of docker. The lines
+// which don't end with a newline are partial.
+func (p *Parser) parseDocker(line string) (containerLog, error) {
+	var parsed dockerLog
+	if err := p.json.UnmarshalFromString(line, &parsed); err != nil {
+		return containerLog{}, fmt.Errorf("parse docker log: %w", err)
+	}
+	t, err := time.Parse(time.RFC3339Nano, parsed.Time)
+	if err != nil {
+		return containerLog{}, fmt.Errorf("parse docker log time: %w", err)
+	}
+	log := strings.TrimSuffix(parsed.Log, "\n")
+	return containerLog{
+		time:    t,
+		stream:  parsed.Stream,
+		log:     log,
+		partial: len(log) == len(parsed.Log),
+	}, nil
+}
+
+// parseCRI parses a line written by containerd or cri-o. The lines tagged with "P" are partial.
+func parseCRI(line string) (containerLog, error) {
+	matches := criLogPattern.FindStringSubmatch(line)
+	if matches == nil {
+		return containerLog{}, fmt.Errorf("parse cri log: line does not match the cri log format")
+	}
+	t, err := time.Parse(time.RFC3339Nano, matches[criLogPattern.SubexpIndex("time")])
+	if err != nil {
+		return containerLog{}, fmt.Errorf("parse cri log time: %w", err)
+	}
+	tags := strings.Split(matches[criLogPattern.SubexpIndex("logtag")], ":")
+	return containerLog{
+		time:    t,
+		stream:  matches[criLogPattern.SubexpIndex("stream")],
+		log:     matches[criLogPattern.SubexpIndex("log")],
+		partial: tags[0] == criPartialTag,
+	}, nil
+}
+
+// addMetadataFromFilePath adds the kubernetes metadata found in the path of the log file to
+// the resource of the entry. Entries without a file path, or read from other files, are left untouched.
+func addMetadataFromFilePath(e *entry.Entry) {
+	var filePath string
+	if err := e.Read(entry.NewAttributeField(filePathAttribute), &filePath); err != nil {
+		return
+	}
+	matches := filePathPattern.FindStringSubmatch(filePath)
+	if matches == nil {
+		return
+	}
+	e.AddResourceKey("k8s.namespace.name", matches[filePathPattern.SubexpIndex("namespace")])
+	e.AddResourceKey("k8s.pod.name", matches[filePathPattern.SubexpIndex("pod_name")])
+	e.AddResourceKey("k8s.pod.uid", matches[filePathPattern.SubexpIndex("uid")])
+	e.AddResourceKey("k8s.container.name", matches[filePathPattern.SubexpIndex("container_name")])
+	e.AddResourceKey("k8s.container.restart_count", matches[filePathPattern.SubexpIndex("restart_count")])
+}
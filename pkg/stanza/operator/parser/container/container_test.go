@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+const podLogPath = "/var/log/pods/default_web-7d4b9c_0f4c6b5e-1d2a-4c3b-9e8f-7a6b5c4d3e2f/nginx/2.log"
+
+func newTestParser(t *testing.T, configure func(*Config)) (operator.Operator, *testutil.FakeOutput) {
+	cfg := NewConfigWithID("test")
+	cfg.OutputIDs = []string{"fake"}
+	if configure != nil {
+		configure(cfg)
+	}
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+	return op, fake
+}
+
+func newEntry(body string) *entry.Entry {
+	e := entry.New()
+	e.Body = body
+	e.AddAttribute(filePathAttribute, podLogPath)
+	return e
+}
+
+func TestConfigBuildFailure(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.Format = "podman"
+	_, err := cfg.Build(testutil.Logger(t))
+	require.EqualError(t, err, "invalid format 'podman', must be one of 'docker', 'containerd' or 'crio'")
+
+	cfg = NewConfigWithID("test")
+	cfg.MaxLogSize = 0
+	_, err = cfg.Build(testutil.Logger(t))
+	require.EqualError(t, err, "max_log_size must be positive")
+
+	cfg = NewConfigWithID("test")
+	cfg.ForceFlushPeriod = 0
+	_, err = cfg.Build(testutil.Logger(t))
+	require.EqualError(t, err, "force_flush_period must be positive")
+}
+
+func TestContainerImplementations(t *testing.T) {
+	require.Implements(t, (*operator.Operator)(nil), new(Parser))
+}
+
+func TestParser(t *testing.T) {
+	expectedTime := time.Date(2022, 10, 16, 8, 30, 0, 123456789, time.UTC)
+	cases := []struct {
+		name string
+		body string
+	}{
+		{
+			name: "docker",
+			body: `{"log":"GET /index.html 200\n","stream":"stdout","time":"2022-10-16T08:30:00.123456789Z"}`,
+		},
+		{
+			name: "containerd",
+			body: "2022-10-16T08:30:00.123456789Z stdout F GET /index.html 200",
+		},
+		{
+			name: "crio",
+			body: "2022-10-16T08:30:00.123456789+00:00 stdout F GET /index.html 200",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			op, fake := newTestParser(t, nil)
+
+			require.NoError(t, op.Process(context.Background(), newEntry(tc.body)))
+
+			select {
+			case e := <-fake.Received:
+				require.Equal(t, "GET /index.html 200", e.Body)
+				require.True(t, expectedTime.Equal(e.Timestamp))
+				require.Equal(t, "stdout", e.Attributes[iostreamAttribute])
+				require.Equal(t, map[string]interface{}{
+					"k8s.namespace.name":          "default",
+					"k8s.pod.name":                "web-7d4b9c",
+					"k8s.pod.uid":                 "0f4c6b5e-1d2a-4c3b-9e8f-7a6b5c4d3e2f",
+					"k8s.container.name":          "nginx",
+					"k8s.container.restart_count": "2",
+				}, e.Resource)
+			case <-time.After(time.Second):
+				require.FailNow(t, "Timed out waiting for entry")
+			}
+		})
+	}
+}
+
+func TestParserWithoutMetadata(t *testing.T) {
+	op, fake := newTestParser(t, func(cfg *Config) {
+		cfg.AddMetadataFromFilePath = false
+	})
+
+	require.NoError(t, op.Process(context.Background(), newEntry("2022-10-16T08:30:00.123456789Z stderr F failed")))
+
+	select {
+	case e := <-fake.Received:
+		require.Equal(t, "failed", e.Body)
+		require.Equal(t, "stderr", e.Attributes[iostreamAttribute])
+		require.Nil(t, e.Resource)
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for entry")
+	}
+}
+
+func TestParserReassemblesPartialLines(t *testing.T) {
+	t.Run("docker", func(t *testing.T) {
+		op, fake := newTestParser(t, nil)
+
+		require.NoError(t, op.Process(context.Background(), newEntry(`{"log":"first ","stream":"stdout","time":"2022-10-16T08:30:00Z"}`)))
+		require.NoError(t, op.Process(context.Background(), newEntry(`{"log":"other stream\n","stream":"stderr","time":"2022-10-16T08:30:01Z"}`)))
+		require.NoError(t, op.Process(context.Background(), newEntry(`{"log":"second\n","stream":"stdout","time":"2022-10-16T08:30:02Z"}`)))
+
+		fake.ExpectBody(t, "other stream")
+		fake.ExpectBody(t, "first second")
+	})
+
+	t.Run("cri", func(t *testing.T) {
+		op, fake := newTestParser(t, nil)
+
+		require.NoError(t, op.Process(context.Background(), newEntry("2022-10-16T08:30:00Z stdout P first ")))
+		require.NoError(t, op.Process(context.Background(), newEntry("2022-10-16T08:30:01Z stdout P second ")))
+		fake.ExpectNoEntry(t, 100*time.Millisecond)
+		require.NoError(t, op.Process(context.Background(), newEntry("2022-10-16T08:30:02Z stdout F third")))
+
+		fake.ExpectBody(t, "first second third")
+	})
+
+	t.Run("max log size", func(t *testing.T) {
+		op, fake := newTestParser(t, func(cfg *Config) {
+			cfg.MaxLogSize = 8
+		})
+
+		require.NoError(t, op.Process(context.Background(), newEntry("2022-10-16T08:30:00Z stdout P 12345")))
+		require.NoError(t, op.Process(context.Background(), newEntry("2022-10-16T08:30:01Z stdout P 67890")))
+		require.NoError(t, op.Process(context.Background(), newEntry("2022-10-16T08:30:02Z stdout F end")))
+
+		fake.ExpectBody(t, "1234567890")
+		fake.ExpectBody(t, "end")
+	})
+
+	t.Run("force flush period", func(t *testing.T) {
+		op, fake := newTestParser(t, func(cfg *Config) {
+			cfg.ForceFlushPeriod = 100 * time.Millisecond
+		})
+		require.NoError(t, op.Start(nil))
+		defer func() {
+			require.NoError(t, op.Stop())
+		}()
+
+		require.NoError(t, op.Process(context.Background(), newEntry("2022-10-16T08:30:00Z stdout P pending")))
+		fake.ExpectNoEntry(t, 50*time.Millisecond)
+		fake.ExpectBody(t, "pending")
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		op, fake := newTestParser(t, nil)
+
+		require.NoError(t, op.Process(context.Background(), newEntry("2022-10-16T08:30:00Z stdout P pending")))
+		require.NoError(t, op.Stop())
+
+		fake.ExpectBody(t, "pending")
+	})
+}
+
+func TestParserInvalidLine(t *testing.T) {
+	op, fake := newTestParser(t, func(cfg *Config) {
+		cfg.OnError = "drop"
+	})
+
+	require.Error(t, op.Process(context.Background(), newEntry("not a container log")))
+	require.Error(t, op.Process(context.Background(), newEntry(`{"log":"x\n","stream":"stdout","time":"yesterday"}`)))
+	fake.ExpectNoEntry(t, 100*time.Millisecond)
+}
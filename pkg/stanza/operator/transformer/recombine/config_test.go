@@ -17,6 +17,7 @@ package recombine
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/operatortest"
 )
@@ -85,6 +86,17 @@ func TestUnmarshal(t *testing.T) {
 					return cfg
 				}(),
 			},
+			{
+				Name:      "first_entry_regex",
+				ExpectErr: false,
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.FirstEntryRegex = `^\S`
+					cfg.MaxLogSize = 1024 * 1024
+					cfg.MaxWait = 10 * time.Second
+					return cfg
+				}(),
+			},
 		},
 	}.Run(t)
 }
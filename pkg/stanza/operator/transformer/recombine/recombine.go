@@ -17,6 +17,7 @@ package recombine // import "github.com/open-telemetry/opentelemetry-collector-c
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -60,15 +61,19 @@ func NewConfigWithID(operatorID string) *Config {
 // Config is the configuration of a recombine operator
 type Config struct {
 	helper.TransformerConfig `mapstructure:",squash"`
-	IsFirstEntry             string        `mapstructure:"is_first_entry"`
-	IsLastEntry              string        `mapstructure:"is_last_entry"`
-	MaxBatchSize             int           `mapstructure:"max_batch_size"`
-	CombineField             entry.Field   `mapstructure:"combine_field"`
-	CombineWith              string        `mapstructure:"combine_with"`
-	SourceIdentifier         entry.Field   `mapstructure:"source_identifier"`
-	OverwriteWith            string        `mapstructure:"overwrite_with"`
-	ForceFlushTimeout        time.Duration `mapstructure:"force_flush_period"`
-	MaxSources               int           `mapstructure:"max_sources"`
+	IsFirstEntry             string          `mapstructure:"is_first_entry"`
+	IsLastEntry              string          `mapstructure:"is_last_entry"`
+	FirstEntryRegex          string          `mapstructure:"first_entry_regex"`
+	LastEntryRegex           string          `mapstructure:"last_entry_regex"`
+	MaxBatchSize             int             `mapstructure:"max_batch_size"`
+	MaxLogSize               helper.ByteSize `mapstructure:"max_log_size,omitempty"`
+	CombineField             entry.Field     `mapstructure:"combine_field"`
+	CombineWith              string          `mapstructure:"combine_with"`
+	SourceIdentifier         entry.Field     `mapstructure:"source_identifier"`
+	OverwriteWith            string          `mapstructure:"overwrite_with"`
+	ForceFlushTimeout        time.Duration   `mapstructure:"force_flush_period"`
+	MaxWait                  time.Duration   `mapstructure:"max_wait"`
+	MaxSources               int             `mapstructure:"max_sources"`
 }
 
 // Build creates a new Transformer from a config
@@ -78,28 +83,49 @@ func (c *Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, fmt.Errorf("failed to build transformer config: %w", err)
 	}
 
-	if c.IsLastEntry != "" && c.IsFirstEntry != "" {
-		return nil, fmt.Errorf("only one of is_first_entry and is_last_entry can be set")
+	conditions := 0
+	for _, condition := range []string{c.IsFirstEntry, c.IsLastEntry, c.FirstEntryRegex, c.LastEntryRegex} {
+		if condition != "" {
+			conditions++
+		}
+	}
+
+	if conditions > 1 {
+		return nil, fmt.Errorf("only one of is_first_entry, is_last_entry, first_entry_regex and last_entry_regex can be set")
 	}
 
-	if c.IsLastEntry == "" && c.IsFirstEntry == "" {
-		return nil, fmt.Errorf("one of is_first_entry and is_last_entry must be set")
+	if conditions == 0 {
+		return nil, fmt.Errorf("one of is_first_entry, is_last_entry, first_entry_regex and last_entry_regex must be set")
 	}
 
 	var matchesFirst bool
 	var prog *vm.Program
-	if c.IsFirstEntry != "" {
+	var regex *regexp.Regexp
+	switch {
+	case c.IsFirstEntry != "":
 		matchesFirst = true
 		prog, err = expr.Compile(c.IsFirstEntry, expr.AsBool(), expr.AllowUndefinedVariables())
 		if err != nil {
 			return nil, fmt.Errorf("failed to compile is_first_entry: %w", err)
 		}
-	} else {
+	case c.IsLastEntry != "":
 		matchesFirst = false
 		prog, err = expr.Compile(c.IsLastEntry, expr.AsBool(), expr.AllowUndefinedVariables())
 		if err != nil {
 			return nil, fmt.Errorf("failed to compile is_last_entry: %w", err)
 		}
+	case c.FirstEntryRegex != "":
+		matchesFirst = true
+		regex, err = regexp.Compile(c.FirstEntryRegex)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile first_entry_regex: %w", err)
+		}
+	default:
+		matchesFirst = false
+		regex, err = regexp.Compile(c.LastEntryRegex)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile last_entry_regex: %w", err)
+		}
 	}
 
 	if c.CombineField.FieldInterface == nil {
@@ -116,18 +142,36 @@ func (c *Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, fmt.Errorf("invalid value '%s' for parameter 'overwrite_with'", c.OverwriteWith)
 	}
 
+	if c.ForceFlushTimeout <= 0 {
+		return nil, fmt.Errorf("'force_flush_period' must be positive")
+	}
+
+	if c.MaxWait < 0 {
+		return nil, fmt.Errorf("'max_wait' must not be negative")
+	}
+
+	// Check the batches often enough to honor both the force flush period and the max wait
+	tickerPeriod := c.ForceFlushTimeout
+	if c.MaxWait > 0 && c.MaxWait < tickerPeriod {
+		tickerPeriod = c.MaxWait
+	}
+
 	return &Transformer{
 		TransformerOperator: transformer,
 		matchFirstLine:      matchesFirst,
 		prog:                prog,
+		regex:               regex,
 		maxBatchSize:        c.MaxBatchSize,
+		maxLogSize:          int64(c.MaxLogSize),
 		maxSources:          c.MaxSources,
 		overwriteWithOldest: overwriteWithOldest,
-		batchMap:            make(map[string][]*entry.Entry),
+		batchMap:            make(map[string]*sourceBatch),
 		combineField:        c.CombineField,
 		combineWith:         c.CombineWith,
 		forceFlushTimeout:   c.ForceFlushTimeout,
-		ticker:              time.NewTicker(c.ForceFlushTimeout),
+		maxWait:             c.MaxWait,
+		tickerPeriod:        tickerPeriod,
+		ticker:              time.NewTicker(tickerPeriod),
 		chClose:             make(chan struct{}),
 		sourceIdentifier:    c.SourceIdentifier,
 	}, nil
@@ -139,18 +183,34 @@ type Transformer struct {
 	helper.TransformerOperator
 	matchFirstLine      bool
 	prog                *vm.Program
+	regex               *regexp.Regexp
 	maxBatchSize        int
+	maxLogSize          int64
 	maxSources          int
 	overwriteWithOldest bool
 	combineField        entry.Field
 	combineWith         string
 	ticker              *time.Ticker
+	tickerPeriod        time.Duration
 	forceFlushTimeout   time.Duration
+	maxWait             time.Duration
 	chClose             chan struct{}
 	sourceIdentifier    entry.Field
 
 	sync.Mutex
-	batchMap map[string][]*entry.Entry
+	batchMap map[string]*sourceBatch
+}
+
+// sourceBatch is the batch of entries of a single source which will be combined
+type sourceBatch struct {
+	entries []*entry.Entry
+	// recombinedSize is the size of the combine_field of the entries
+	recombinedSize int64
+	// firstEntryTime and lastEntryTime are the times the first and the last entries
+	// were added to the batch. The times the entries were processed are used rather
+	// than their observed timestamps, which lag behind when the pipeline is under load.
+	firstEntryTime time.Time
+	lastEntryTime  time.Time
 }
 
 func (r *Transformer) Start(_ operator.Persister) error {
@@ -165,10 +225,10 @@ func (r *Transformer) flushLoop() {
 		case <-r.ticker.C:
 			r.Lock()
 			timeNow := time.Now()
-			for source, entries := range r.batchMap {
-				lastEntryTs := entries[len(entries)-1].ObservedTimestamp
-				timeSinceLastEntry := timeNow.Sub(lastEntryTs)
-				if timeSinceLastEntry < r.forceFlushTimeout {
+			for source, batch := range r.batchMap {
+				timeSinceLastEntry := timeNow.Sub(batch.lastEntryTime)
+				timeSinceFirstEntry := timeNow.Sub(batch.firstEntryTime)
+				if timeSinceLastEntry < r.forceFlushTimeout && (r.maxWait == 0 || timeSinceFirstEntry < r.maxWait) {
 					continue
 				}
 				if err := r.flushSource(source); err != nil {
@@ -176,7 +236,7 @@ func (r *Transformer) flushLoop() {
 				}
 			}
 
-			r.ticker.Reset(r.forceFlushTimeout)
+			r.ticker.Reset(r.tickerPeriod)
 			r.Unlock()
 		case <-r.chClose:
 			r.ticker.Stop()
@@ -205,20 +265,11 @@ func (r *Transformer) Process(ctx context.Context, e *entry.Entry) error {
 	r.Lock()
 	defer r.Unlock()
 
-	// Get the environment for executing the expression.
-	// In the future, we may want to provide access to the currently
-	// batched entries so users can do comparisons to other entries
-	// rather than just use absolute rules.
-	env := helper.GetExprEnv(e)
-	defer helper.PutExprEnv(env)
-
-	m, err := expr.Run(r.prog, env)
+	matches, err := r.matches(e)
 	if err != nil {
 		return r.HandleEntryError(ctx, e, err)
 	}
 
-	// this is guaranteed to be a boolean because of expr.AsBool
-	matches := m.(bool)
 	var s string
 	err = e.Read(r.sourceIdentifier, &s)
 	if err != nil {
@@ -246,7 +297,7 @@ func (r *Transformer) Process(ctx context.Context, e *entry.Entry) error {
 	case matches && r.matchIndicatesLast():
 		fallthrough
 	// When matching on first entry, never batch partial first. Just emit immediately
-	case !matches && r.matchIndicatesFirst() && len(r.batchMap[s]) == 0:
+	case !matches && r.matchIndicatesFirst() && r.batchMap[s] == nil:
 		r.addToBatch(ctx, e, s)
 		return r.flushSource(s)
 	}
@@ -257,6 +308,32 @@ func (r *Transformer) Process(ctx context.Context, e *entry.Entry) error {
 	return nil
 }
 
+// matches returns whether the entry matches the is_first_entry or is_last_entry condition
+func (r *Transformer) matches(e *entry.Entry) (bool, error) {
+	if r.regex != nil {
+		var value string
+		if err := e.Read(r.combineField, &value); err != nil {
+			return false, err
+		}
+		return r.regex.MatchString(value), nil
+	}
+
+	// Get the environment for executing the expression.
+	// In the future, we may want to provide access to the currently
+	// batched entries so users can do comparisons to other entries
+	// rather than just use absolute rules.
+	env := helper.GetExprEnv(e)
+	defer helper.PutExprEnv(env)
+
+	m, err := expr.Run(r.prog, env)
+	if err != nil {
+		return false, err
+	}
+
+	// this is guaranteed to be a boolean because of expr.AsBool
+	return m.(bool), nil
+}
+
 func (r *Transformer) matchIndicatesFirst() bool {
 	return r.matchFirstLine
 }
@@ -267,17 +344,28 @@ func (r *Transformer) matchIndicatesLast() bool {
 
 // addToBatch adds the current entry to the current batch of entries that will be combined
 func (r *Transformer) addToBatch(_ context.Context, e *entry.Entry, source string) {
-	if _, ok := r.batchMap[source]; !ok {
-		r.batchMap[source] = []*entry.Entry{e}
-		if len(r.batchMap) >= r.maxSources {
-			r.Error("Batched source exceeds max source size. Flushing all batched logs. Consider increasing max_sources parameter")
-			r.flushUncombined(context.Background())
-		}
+	now := time.Now()
+	batch, ok := r.batchMap[source]
+	if !ok {
+		batch = &sourceBatch{firstEntryTime: now}
+		r.batchMap[source] = batch
+	}
+
+	batch.entries = append(batch.entries, e)
+	batch.lastEntryTime = now
+
+	var s string
+	if err := e.Read(r.combineField, &s); err == nil {
+		batch.recombinedSize += int64(len(s))
+	}
+
+	if !ok && len(r.batchMap) >= r.maxSources {
+		r.Error("Batched source exceeds max source size. Flushing all batched logs. Consider increasing max_sources parameter")
+		r.flushUncombined(context.Background())
 		return
 	}
 
-	r.batchMap[source] = append(r.batchMap[source], e)
-	if len(r.batchMap[source]) >= r.maxBatchSize {
+	if len(batch.entries) >= r.maxBatchSize || (r.maxLogSize > 0 && batch.recombinedSize >= r.maxLogSize) {
 		if err := r.flushSource(source); err != nil {
 			r.Errorf("there was error flushing combined logs %s", err)
 		}
@@ -289,25 +377,27 @@ func (r *Transformer) addToBatch(_ context.Context, e *entry.Entry, source strin
 // or at shutdown to avoid dropping the logs.
 func (r *Transformer) flushUncombined(ctx context.Context) {
 	for source := range r.batchMap {
-		for _, entry := range r.batchMap[source] {
+		for _, entry := range r.batchMap[source].entries {
 			r.Write(ctx, entry)
 		}
 	}
-	r.batchMap = make(map[string][]*entry.Entry)
-	r.ticker.Reset(r.forceFlushTimeout)
+	r.batchMap = make(map[string]*sourceBatch)
+	r.ticker.Reset(r.tickerPeriod)
 }
 
 // flushSource combines the entries currently in the batch into a single entry,
 // then forwards them to the next operator in the pipeline
 func (r *Transformer) flushSource(source string) error {
 	// Skip flushing a combined log if the batch is empty
-	if len(r.batchMap[source]) == 0 {
+	batch, ok := r.batchMap[source]
+	if !ok || len(batch.entries) == 0 {
+		delete(r.batchMap, source)
 		return nil
 	}
 
 	// Choose which entry we want to keep the rest of the fields from
 	var base *entry.Entry
-	entries := r.batchMap[source]
+	entries := batch.entries
 
 	if r.overwriteWithOldest {
 		base = entries[0]
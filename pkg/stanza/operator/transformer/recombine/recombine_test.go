@@ -285,6 +285,64 @@ func TestTransformer(t *testing.T) {
 				entryWithBodyAttr(t2, "end", map[string]string{"file.path": "file2"}),
 			},
 		},
+		{
+			"FirstEntryRegexStackTrace",
+			func() *Config {
+				cfg := NewConfig()
+				cfg.CombineField = entry.NewBodyField()
+				cfg.FirstEntryRegex = `^\S`
+				cfg.OutputIDs = []string{"fake"}
+				return cfg
+			}(),
+			[]*entry.Entry{
+				entryWithBody(t1, "Exception in thread \"main\" java.lang.NullPointerException"),
+				entryWithBody(t1, "\tat com.example.Main.run(Main.java:16)"),
+				entryWithBody(t1, "\tat com.example.Main.main(Main.java:5)"),
+				entryWithBody(t2, "Next log"),
+			},
+			[]*entry.Entry{
+				entryWithBody(t1, "Exception in thread \"main\" java.lang.NullPointerException\n\tat com.example.Main.run(Main.java:16)\n\tat com.example.Main.main(Main.java:5)"),
+			},
+		},
+		{
+			"LastEntryRegexStackTrace",
+			func() *Config {
+				cfg := NewConfig()
+				cfg.CombineField = entry.NewBodyField()
+				cfg.LastEntryRegex = `^\w+Error: `
+				cfg.OutputIDs = []string{"fake"}
+				return cfg
+			}(),
+			[]*entry.Entry{
+				entryWithBody(t1, "Traceback (most recent call last):"),
+				entryWithBody(t1, `  File "main.py", line 1, in <module>`),
+				entryWithBody(t2, "ZeroDivisionError: division by zero"),
+			},
+			[]*entry.Entry{
+				entryWithBody(t1, "Traceback (most recent call last):\n  File \"main.py\", line 1, in <module>\nZeroDivisionError: division by zero"),
+			},
+		},
+		{
+			"TestMaxLogSize",
+			func() *Config {
+				cfg := NewConfig()
+				cfg.CombineField = entry.NewBodyField()
+				cfg.IsLastEntry = "body == 'end'"
+				cfg.OutputIDs = []string{"fake"}
+				cfg.MaxLogSize = 10
+				return cfg
+			}(),
+			[]*entry.Entry{
+				entryWithBody(t1, "event1"),
+				entryWithBody(t1, "event2"),
+				entryWithBody(t2, "event3"),
+				entryWithBody(t2, "end"),
+			},
+			[]*entry.Entry{
+				entryWithBody(t1, "event1\nevent2"),
+				entryWithBody(t2, "event3\nend"),
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -422,3 +480,40 @@ func TestTimeout(t *testing.T) {
 
 	require.NoError(t, recombine.Stop())
 }
+
+func TestMaxWait(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewConfig()
+	cfg.CombineField = entry.NewBodyField()
+	cfg.IsLastEntry = "false"
+	cfg.OutputIDs = []string{"fake"}
+	cfg.ForceFlushTimeout = time.Minute
+	cfg.MaxWait = 100 * time.Millisecond
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	recombine := op.(*Transformer)
+
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, recombine.SetOutputs([]operator.Operator{fake}))
+
+	ctx := context.Background()
+	require.NoError(t, recombine.Start(nil))
+	defer func() {
+		require.NoError(t, recombine.Stop())
+	}()
+
+	// Keep adding entries faster than the force flush period
+	for i := 0; i < 5; i++ {
+		e := entry.New()
+		e.Body = "body"
+		require.NoError(t, recombine.Process(ctx, e))
+	}
+
+	select {
+	case e := <-fake.Received:
+		require.Equal(t, "body\nbody\nbody\nbody\nbody", e.Body)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "The batch should be flushed after max_wait")
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loki // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/loki"
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// LogsToLokiOTLP prepares a Logs pipeline data to be sent to the native OTLP endpoint of Loki,
+// grouping it by tenant. The tenant value is inferred from the `loki.tenant` hint, like for
+// LogsToLokiRequests.
+// Loki only indexes resource attributes as labels, and stores the other attributes as structured
+// metadata. The record attributes listed by the "loki.attribute.labels" hint are therefore moved
+// to the resource of the record, the records with different values for these attributes being
+// split into distinct resources. The resource attributes listed by the "loki.resource.labels"
+// hint are already part of the resource. Whether these attributes are used as labels is
+// decided by the OTLP configuration of Loki.
+// The hints themselves are removed from the resource and record attributes.
+func LogsToLokiOTLP(ld plog.Logs) map[string]plog.Logs {
+	tenants := map[string]*otlpTenantGroup{}
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		ills := rl.ScopeLogs()
+
+		for j := 0; j < ills.Len(); j++ {
+			logs := ills.At(j).LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				log := logs.At(k)
+
+				tenant := getTenantFromTenantHint(log.Attributes(), rl.Resource().Attributes())
+				group, ok := tenants[tenant]
+				if !ok {
+					group = &otlpTenantGroup{
+						logs:      plog.NewLogs(),
+						resources: map[string]plog.ResourceLogs{},
+						scopes:    map[string]plog.ScopeLogs{},
+					}
+					tenants[tenant] = group
+				}
+
+				promoted := attributesToPromote(log.Attributes())
+				resourceKey := strconv.Itoa(i) + promoted.key
+				resource, ok := group.resources[resourceKey]
+				if !ok {
+					resource = group.logs.ResourceLogs().AppendEmpty()
+					rl.Resource().CopyTo(resource.Resource())
+					resource.SetSchemaUrl(rl.SchemaUrl())
+					for _, name := range promoted.names {
+						if v, found := log.Attributes().Get(name); found {
+							v.CopyTo(resource.Resource().Attributes().PutEmpty(name))
+						}
+					}
+					removeHints(resource.Resource().Attributes())
+					group.resources[resourceKey] = resource
+				}
+
+				scopeKey := resourceKey + "/" + strconv.Itoa(j)
+				scope, ok := group.scopes[scopeKey]
+				if !ok {
+					scope = resource.ScopeLogs().AppendEmpty()
+					ills.At(j).Scope().CopyTo(scope.Scope())
+					scope.SetSchemaUrl(ills.At(j).SchemaUrl())
+					group.scopes[scopeKey] = scope
+				}
+
+				record := scope.LogRecords().AppendEmpty()
+				log.CopyTo(record)
+				record.Attributes().RemoveIf(func(k string, _ pcommon.Value) bool {
+					_, isPromoted := promoted.set[k]
+					return isPromoted
+				})
+				removeHints(record.Attributes())
+			}
+		}
+	}
+
+	requests := make(map[string]plog.Logs, len(tenants))
+	for tenant, group := range tenants {
+		requests[tenant] = group.logs
+	}
+	return requests
+}
+
+type otlpTenantGroup struct {
+	logs plog.Logs
+	// resources holds the resource logs by source resource and promoted attributes
+	resources map[string]plog.ResourceLogs
+	// scopes holds the scope logs by resource logs and source scope
+	scopes map[string]plog.ScopeLogs
+}
+
+type promotedAttributes struct {
+	names []string
+	set   map[string]struct{}
+	// key identifies the names and values of the promoted attributes
+	key string
+}
+
+// attributesToPromote returns the record attributes listed by the "loki.attribute.labels" hint.
+func attributesToPromote(attrs pcommon.Map) promotedAttributes {
+	promoted := promotedAttributes{set: map[string]struct{}{}}
+	hint, found := attrs.Get(hintAttributes)
+	if !found {
+		return promoted
+	}
+
+	for _, name := range parseAttributeNames(hint) {
+		name = strings.TrimSpace(name)
+		if _, ok := attrs.Get(name); !ok {
+			continue
+		}
+		if _, ok := promoted.set[name]; ok {
+			continue
+		}
+		promoted.set[name] = struct{}{}
+		promoted.names = append(promoted.names, name)
+	}
+	sort.Strings(promoted.names)
+
+	var b strings.Builder
+	for _, name := range promoted.names {
+		v, _ := attrs.Get(name)
+		b.WriteString("*" + name + "*" + v.AsString())
+	}
+	promoted.key = b.String()
+	return promoted
+}
+
+func removeHints(attrs pcommon.Map) {
+	attrs.RemoveIf(func(s string, _ pcommon.Value) bool {
+		return s == hintAttributes || s == hintResources || s == hintTenant
+	})
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loki // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/loki"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestLogsToLokiOTLP(t *testing.T) {
+	tests := []struct {
+		name     string
+		logs     plog.Logs
+		expected map[string]plog.Logs
+	}{
+		{
+			name: "without hints",
+			logs: func() plog.Logs {
+				logs := plog.NewLogs()
+				rl := logs.ResourceLogs().AppendEmpty()
+				rl.Resource().Attributes().PutStr("host.name", "guarana")
+				logRecord := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+				logRecord.Body().SetStr("hello")
+				logRecord.Attributes().PutInt("http.status", 200)
+				return logs
+			}(),
+			expected: map[string]plog.Logs{
+				"": func() plog.Logs {
+					logs := plog.NewLogs()
+					rl := logs.ResourceLogs().AppendEmpty()
+					rl.Resource().Attributes().PutStr("host.name", "guarana")
+					logRecord := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+					logRecord.Body().SetStr("hello")
+					logRecord.Attributes().PutInt("http.status", 200)
+					return logs
+				}(),
+			},
+		},
+		{
+			name: "attributes promoted to the resource",
+			logs: func() plog.Logs {
+				logs := plog.NewLogs()
+				rl := logs.ResourceLogs().AppendEmpty()
+				rl.Resource().Attributes().PutStr("host.name", "guarana")
+				rl.Resource().Attributes().PutStr(hintResources, "host.name")
+				sl := rl.ScopeLogs().AppendEmpty()
+				sl.Scope().SetName("scope")
+				for _, status := range []int64{200, 500, 200} {
+					logRecord := sl.LogRecords().AppendEmpty()
+					logRecord.Attributes().PutStr(hintAttributes, "http.status, http.method")
+					logRecord.Attributes().PutInt("http.status", status)
+					logRecord.Attributes().PutStr("user.id", "42")
+				}
+				return logs
+			}(),
+			expected: map[string]plog.Logs{
+				"": func() plog.Logs {
+					logs := plog.NewLogs()
+					for _, statuses := range []int{2, 1} {
+						rl := logs.ResourceLogs().AppendEmpty()
+						rl.Resource().Attributes().PutStr("host.name", "guarana")
+						status := int64(200)
+						if statuses == 1 {
+							status = 500
+						}
+						rl.Resource().Attributes().PutInt("http.status", status)
+						sl := rl.ScopeLogs().AppendEmpty()
+						sl.Scope().SetName("scope")
+						for i := 0; i < statuses; i++ {
+							sl.LogRecords().AppendEmpty().Attributes().PutStr("user.id", "42")
+						}
+					}
+					return logs
+				}(),
+			},
+		},
+		{
+			name: "grouped by tenant",
+			logs: func() plog.Logs {
+				logs := plog.NewLogs()
+				rl := logs.ResourceLogs().AppendEmpty()
+				sl := rl.ScopeLogs().AppendEmpty()
+				for _, tenant := range []string{"1", "2"} {
+					logRecord := sl.LogRecords().AppendEmpty()
+					logRecord.Attributes().PutStr(hintTenant, "tenant.id")
+					logRecord.Attributes().PutStr("tenant.id", tenant)
+				}
+				return logs
+			}(),
+			expected: map[string]plog.Logs{
+				"1": func() plog.Logs {
+					logs := plog.NewLogs()
+					logRecord := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+					logRecord.Attributes().PutStr("tenant.id", "1")
+					return logs
+				}(),
+				"2": func() plog.Logs {
+					logs := plog.NewLogs()
+					logRecord := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+					logRecord.Attributes().PutStr("tenant.id", "2")
+					return logs
+				}(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := LogsToLokiOTLP(tt.logs)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
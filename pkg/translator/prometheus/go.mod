@@ -30,4 +30,6 @@ require (
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal => ../../../internal/coreinternal
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter => ../../filter
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/common => ../../../internal/common
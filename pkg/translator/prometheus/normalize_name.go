@@ -192,6 +192,31 @@ func normalizeName(metric pmetric.Metric, namespace string) string {
 	return normalizedName
 }
 
+// BuildPromUnit returns the Prometheus unit of the specified OpenTelemetry unit, as appended
+// to the metric names by the full normalization, or an empty string if no unit is appended.
+func BuildPromUnit(unit string) string {
+	var unitTokens []string
+	otelUnitTokens := strings.SplitN(unit, "/", 2)
+
+	mainUnitOtel := strings.TrimSpace(otelUnitTokens[0])
+	if mainUnitOtel != "" && !strings.ContainsAny(mainUnitOtel, "{}") {
+		if mainUnitProm := CleanUpString(unitMapGetOrDefault(mainUnitOtel)); mainUnitProm != "" {
+			unitTokens = append(unitTokens, mainUnitProm)
+		}
+	}
+
+	if len(otelUnitTokens) > 1 {
+		perUnitOtel := strings.TrimSpace(otelUnitTokens[1])
+		if perUnitOtel != "" && !strings.ContainsAny(perUnitOtel, "{}") {
+			if perUnitProm := CleanUpString(perUnitMapGetOrDefault(perUnitOtel)); perUnitProm != "" {
+				unitTokens = append(unitTokens, "per", perUnitProm)
+			}
+		}
+	}
+
+	return strings.Join(unitTokens, "_")
+}
+
 // Clean up specified string so it's Prometheus compliant
 func CleanUpString(s string) string {
 	return strings.Join(strings.FieldsFunc(s, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }), "_")
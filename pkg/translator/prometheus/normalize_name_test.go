@@ -176,6 +176,20 @@ func TestPerUnitMapGetOrDefault(t *testing.T) {
 
 }
 
+func TestBuildPromUnit(t *testing.T) {
+
+	require.Equal(t, "", BuildPromUnit(""))
+	require.Equal(t, "seconds", BuildPromUnit("s"))
+	require.Equal(t, "bytes_per_second", BuildPromUnit("By/s"))
+	require.Equal(t, "meters_per_second", BuildPromUnit("m/s"))
+	require.Equal(t, "packets", BuildPromUnit("packets"))
+	require.Equal(t, "", BuildPromUnit("{packets}"))
+	require.Equal(t, "per_second", BuildPromUnit("{packets}/s"))
+	require.Equal(t, "", BuildPromUnit("1"))
+	require.Equal(t, "percent", BuildPromUnit("%"))
+
+}
+
 func TestRemoveItem(t *testing.T) {
 
 	require.Equal(t, []string{}, removeItem([]string{}, "test"))
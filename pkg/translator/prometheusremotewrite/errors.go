@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewrite // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
+
+import "errors"
+
+var (
+	// ErrInvalidMetric is returned for metrics that cannot be converted, such as metrics
+	// without data points or with an invalid temporality and type combination.
+	ErrInvalidMetric = errors.New("invalid metric")
+	// ErrTooManySeries is returned for metrics dropped because the number of time series reached Settings.MaxSeries.
+	ErrTooManySeries = errors.New("too many series")
+	// ErrUnsupportedType is returned for metrics whose type cannot be represented in the remote write format.
+	ErrUnsupportedType = errors.New("unsupported metric type")
+)
+
+// IsPermanent returns true if err is caused by a conversion failure that would fail again
+// if the same metrics were converted, so that they should not be retried.
+// Errors combined with go.uber.org/multierr are permanent if any of them is permanent.
+func IsPermanent(err error) bool {
+	return errors.Is(err, ErrInvalidMetric) ||
+		errors.Is(err, ErrTooManySeries) ||
+		errors.Is(err, ErrUnsupportedType)
+}
@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewrite
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/multierr"
+)
+
+func TestIsPermanent(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		permanent bool
+	}{
+		{"nil", nil, false},
+		{"unknown", errors.New("connection reset"), false},
+		{"invalid metric", fmt.Errorf("%w: empty data points", ErrInvalidMetric), true},
+		{"too many series", fmt.Errorf("%w: limit of 1 reached", ErrTooManySeries), true},
+		{"unsupported type", ErrUnsupportedType, true},
+		{"combined", multierr.Combine(errors.New("connection reset"), ErrInvalidMetric), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.permanent, IsPermanent(tt.err))
+		})
+	}
+}
+
+func TestFromMetricsErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		metrics  func() pmetric.MetricSlice
+		settings Settings
+		wantErr  error
+		wantTS   int
+	}{
+		{
+			name: "empty gauge",
+			metrics: func() pmetric.MetricSlice {
+				ms := pmetric.NewMetricSlice()
+				getEmptyGaugeMetric("empty").MoveTo(ms.AppendEmpty())
+				return ms
+			},
+			settings: Settings{DisableTargetInfo: true},
+			wantErr:  ErrInvalidMetric,
+		},
+		{
+			name: "unsupported type",
+			metrics: func() pmetric.MetricSlice {
+				ms := pmetric.NewMetricSlice()
+				ms.AppendEmpty().SetName("unset")
+				return ms
+			},
+			settings: Settings{DisableTargetInfo: true},
+			wantErr:  ErrUnsupportedType,
+		},
+		{
+			name: "exponential histogram",
+			metrics: func() pmetric.MetricSlice {
+				ms := pmetric.NewMetricSlice()
+				m := ms.AppendEmpty()
+				m.SetName("exponential")
+				m.SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+				return ms
+			},
+			settings: Settings{DisableTargetInfo: true},
+			wantErr:  ErrUnsupportedType,
+		},
+		{
+			name: "delta sum",
+			metrics: func() pmetric.MetricSlice {
+				ms := pmetric.NewMetricSlice()
+				m := ms.AppendEmpty()
+				m.SetName("delta")
+				sum := m.SetEmptySum()
+				sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+				sum.DataPoints().AppendEmpty().SetIntValue(1)
+				return ms
+			},
+			settings: Settings{DisableTargetInfo: true},
+			wantErr:  ErrInvalidMetric,
+		},
+		{
+			name: "too many series",
+			metrics: func() pmetric.MetricSlice {
+				ms := pmetric.NewMetricSlice()
+				getIntGaugeMetric("first", pcommon.NewMap(), 1, time1).MoveTo(ms.AppendEmpty())
+				getIntGaugeMetric("second", pcommon.NewMap(), 2, time1).MoveTo(ms.AppendEmpty())
+				return ms
+			},
+			settings: Settings{DisableTargetInfo: true, MaxSeries: 1},
+			wantErr:  ErrTooManySeries,
+			wantTS:   1,
+		},
+		{
+			name: "below max series",
+			metrics: func() pmetric.MetricSlice {
+				ms := pmetric.NewMetricSlice()
+				getIntGaugeMetric("first", pcommon.NewMap(), 1, time1).MoveTo(ms.AppendEmpty())
+				getIntGaugeMetric("second", pcommon.NewMap(), 2, time1).MoveTo(ms.AppendEmpty())
+				return ms
+			},
+			settings: Settings{DisableTargetInfo: true, MaxSeries: 2},
+			wantTS:   2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := pmetric.NewMetrics()
+			tt.metrics().MoveAndAppendTo(md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics())
+
+			tsMap, err := FromMetrics(md, tt.settings)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.True(t, IsPermanent(err))
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Len(t, tsMap, tt.wantTS)
+		})
+	}
+}
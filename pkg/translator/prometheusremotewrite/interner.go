@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewrite // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
+
+import (
+	"sync"
+	"sync/atomic"
+
+	prometheustranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheus"
+)
+
+// Interner interns the label names and values of the time series produced by successive conversions.
+// When the same attributes recur in every batch, their sanitized label names are computed once, and the
+// time series share the same strings instead of referencing the memory of each batch.
+// The number of interned strings is bounded: once it is reached, new strings are not interned anymore.
+// An Interner is safe for concurrent use.
+type Interner struct {
+	maxSize int
+
+	mu     sync.RWMutex
+	names  map[string]string
+	values map[string]string
+
+	hits   int64
+	misses int64
+}
+
+// InternerStats holds the statistics of an Interner.
+type InternerStats struct {
+	// Hits is the number of lookups of a string that was already interned.
+	Hits int64
+	// Misses is the number of lookups of a string that was not interned yet.
+	Misses int64
+	// Size is the number of interned strings.
+	Size int
+}
+
+// NewInterner returns an Interner holding at most maxSize strings.
+func NewInterner(maxSize int) *Interner {
+	return &Interner{
+		maxSize: maxSize,
+		names:   map[string]string{},
+		values:  map[string]string{},
+	}
+}
+
+// Stats returns the statistics of the interner since its creation.
+func (i *Interner) Stats() InternerStats {
+	i.mu.RLock()
+	size := len(i.names) + len(i.values)
+	i.mu.RUnlock()
+	return InternerStats{
+		Hits:   atomic.LoadInt64(&i.hits),
+		Misses: atomic.LoadInt64(&i.misses),
+		Size:   size,
+	}
+}
+
+// labelName returns the sanitized label name of an attribute key.
+func (i *Interner) labelName(key string) string {
+	if i == nil {
+		return prometheustranslator.NormalizeLabel(key)
+	}
+	if name, ok := i.lookup(i.names, key); ok {
+		return name
+	}
+	return i.store(i.names, key, prometheustranslator.NormalizeLabel(key))
+}
+
+// labelValue returns the interned copy of a label value.
+func (i *Interner) labelValue(value string) string {
+	if i == nil {
+		return value
+	}
+	if interned, ok := i.lookup(i.values, value); ok {
+		return interned
+	}
+	return i.store(i.values, value, value)
+}
+
+func (i *Interner) lookup(m map[string]string, key string) (string, bool) {
+	i.mu.RLock()
+	interned, ok := m[key]
+	i.mu.RUnlock()
+	if ok {
+		atomic.AddInt64(&i.hits, 1)
+	} else {
+		atomic.AddInt64(&i.misses, 1)
+	}
+	return interned, ok
+}
+
+// store interns the value of the key if the interner is not full, and returns the interned value.
+func (i *Interner) store(m map[string]string, key string, value string) string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if interned, ok := m[key]; ok {
+		return interned
+	}
+	if len(i.names)+len(i.values) >= i.maxSize {
+		return value
+	}
+	// The strings are copied, as they can reference the memory of the batch being converted.
+	interned := cloneString(value)
+	if key == value {
+		m[interned] = interned
+	} else {
+		m[cloneString(key)] = interned
+	}
+	return interned
+}
+
+// cloneString returns a copy of s which does not share its memory.
+func cloneString(s string) string {
+	return string([]byte(s))
+}
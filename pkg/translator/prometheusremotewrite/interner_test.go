@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewrite
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestInterner(t *testing.T) {
+	interner := NewInterner(10)
+
+	assert.Equal(t, "http_method", interner.labelName("http.method"))
+	assert.Equal(t, "http_method", interner.labelName("http.method"))
+	assert.Equal(t, "GET", interner.labelValue("GET"))
+	assert.Equal(t, "GET", interner.labelValue("GET"))
+	assert.Equal(t, "POST", interner.labelValue("POST"))
+
+	assert.Equal(t, InternerStats{Hits: 2, Misses: 3, Size: 3}, interner.Stats())
+}
+
+func TestInternerMaxSize(t *testing.T) {
+	interner := NewInterner(2)
+
+	assert.Equal(t, "a", interner.labelName("a"))
+	assert.Equal(t, "b", interner.labelValue("b"))
+	// The interner is full, so the strings are returned without being interned.
+	assert.Equal(t, "c_d", interner.labelName("c.d"))
+	assert.Equal(t, "e", interner.labelValue("e"))
+	assert.Equal(t, "e", interner.labelValue("e"))
+	// The interned strings are still found.
+	assert.Equal(t, "b", interner.labelValue("b"))
+
+	assert.Equal(t, InternerStats{Hits: 1, Misses: 5, Size: 2}, interner.Stats())
+}
+
+func TestInternerNil(t *testing.T) {
+	var interner *Interner
+	assert.Equal(t, "http_method", interner.labelName("http.method"))
+	assert.Equal(t, "GET", interner.labelValue("GET"))
+}
+
+func TestFromMetricsWithInterner(t *testing.T) {
+	md := generateMetricsWithAttributes(10, 5)
+
+	expected, err := FromMetrics(md, Settings{})
+	require.NoError(t, err)
+
+	interner := NewInterner(1000)
+	for i := 0; i < 2; i++ {
+		tsMap, err := FromMetrics(md, Settings{Interner: interner})
+		require.NoError(t, err)
+		assert.Equal(t, expected, tsMap)
+	}
+
+	stats := interner.Stats()
+	// 5 attribute names and 5 attribute values are interned.
+	assert.Equal(t, 10, stats.Size)
+	assert.Equal(t, int64(10), stats.Misses)
+	assert.Equal(t, int64(2*10*10-10), stats.Hits)
+}
+
+// generateMetricsWithAttributes returns gauges whose data points have the same attributes
+func generateMetricsWithAttributes(metricCount int, attributeCount int) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	labels := make([]string, 0, 2*attributeCount)
+	for i := 0; i < attributeCount; i++ {
+		labels = append(labels, fmt.Sprintf("attribute.%d", i), fmt.Sprintf("value-%d", i))
+	}
+	attributes := getAttributes(labels...)
+	for i := 0; i < metricCount; i++ {
+		getDoubleGaugeMetric(fmt.Sprintf("gauge_%d", i), attributes, float64(i), time1).CopyTo(metrics.AppendEmpty())
+	}
+	return md
+}
+
+func BenchmarkFromMetrics(b *testing.B) {
+	md := generateMetricsWithAttributes(100, 10)
+	b.Run("without_interner", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = FromMetrics(md, Settings{})
+		}
+	})
+	b.Run("with_interner", func(b *testing.B) {
+		settings := Settings{Interner: NewInterner(1000)}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = FromMetrics(md, settings)
+		}
+	})
+}
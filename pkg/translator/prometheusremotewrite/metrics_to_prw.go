@@ -15,7 +15,6 @@
 package prometheusremotewrite // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/prometheus/prometheus/prompb"
@@ -38,9 +37,16 @@ type Settings struct {
 	Namespace         string
 	ExternalLabels    map[string]string
 	DisableTargetInfo bool
+	// MaxSeries is the maximum number of time series produced by a single conversion. 0 means unlimited.
+	// The limit is checked before each metric is converted, so it can be exceeded by the series of a single metric.
+	MaxSeries int
+	// Interner, if not nil, interns the label names and values of the attributes across conversions.
+	Interner *Interner
 }
 
 // FromMetrics converts pmetric.Metrics to prometheus remote write format.
+// The metrics that cannot be converted are dropped, and the returned error wraps
+// ErrInvalidMetric, ErrTooManySeries or ErrUnsupportedType. Use IsPermanent to classify it.
 func FromMetrics(md pmetric.Metrics, settings Settings) (tsMap map[string]*prompb.TimeSeries, errs error) {
 	tsMap = make(map[string]*prompb.TimeSeries)
 
@@ -61,9 +67,21 @@ func FromMetrics(md pmetric.Metrics, settings Settings) (tsMap map[string]*promp
 				metric := metricSlice.At(k)
 				mostRecentTimestamp = maxTimestamp(mostRecentTimestamp, mostRecentTimestampInMetric(metric))
 
+				if settings.MaxSeries > 0 && len(tsMap) >= settings.MaxSeries {
+					errs = multierr.Append(errs, fmt.Errorf("%w: limit of %d reached. %s is dropped", ErrTooManySeries, settings.MaxSeries, metric.Name()))
+					continue
+				}
+
+				switch metric.Type() {
+				case pmetric.MetricTypeGauge, pmetric.MetricTypeSum, pmetric.MetricTypeHistogram, pmetric.MetricTypeSummary:
+				default:
+					errs = multierr.Append(errs, fmt.Errorf("%w %s. %s is dropped", ErrUnsupportedType, metric.Type(), metric.Name()))
+					continue
+				}
+
 				// check for valid type and temporality combination and for matching data field and type
 				if ok := validateMetrics(metric); !ok {
-					errs = multierr.Append(errs, errors.New("invalid temporality and type combination"))
+					errs = multierr.Append(errs, fmt.Errorf("%w: invalid temporality and type combination. %s is dropped", ErrInvalidMetric, metric.Name()))
 					continue
 				}
 
@@ -83,7 +101,7 @@ func FromMetrics(md pmetric.Metrics, settings Settings) (tsMap map[string]*promp
 				case pmetric.MetricTypeHistogram:
 					dataPoints := metric.Histogram().DataPoints()
 					if dataPoints.Len() == 0 {
-						errs = multierr.Append(errs, fmt.Errorf("empty data points. %s is dropped", metric.Name()))
+						errs = multierr.Append(errs, fmt.Errorf("%w: empty data points. %s is dropped", ErrInvalidMetric, metric.Name()))
 					}
 					for x := 0; x < dataPoints.Len(); x++ {
 						addSingleHistogramDataPoint(dataPoints.At(x), resource, metric, settings, tsMap)
@@ -91,13 +109,11 @@ func FromMetrics(md pmetric.Metrics, settings Settings) (tsMap map[string]*promp
 				case pmetric.MetricTypeSummary:
 					dataPoints := metric.Summary().DataPoints()
 					if dataPoints.Len() == 0 {
-						errs = multierr.Append(errs, fmt.Errorf("empty data points. %s is dropped", metric.Name()))
+						errs = multierr.Append(errs, fmt.Errorf("%w: empty data points. %s is dropped", ErrInvalidMetric, metric.Name()))
 					}
 					for x := 0; x < dataPoints.Len(); x++ {
 						addSingleSummaryDataPoint(dataPoints.At(x), resource, metric, settings, tsMap)
 					}
-				default:
-					errs = multierr.Append(errs, errors.New("unsupported metric type"))
 				}
 			}
 		}
@@ -111,7 +127,7 @@ func addNumberDataPointSlice(dataPoints pmetric.NumberDataPointSlice,
 	resource pcommon.Resource, metric pmetric.Metric,
 	settings Settings, tsMap map[string]*prompb.TimeSeries) error {
 	if dataPoints.Len() == 0 {
-		return fmt.Errorf("empty data points. %s is dropped", metric.Name())
+		return fmt.Errorf("%w: empty data points. %s is dropped", ErrInvalidMetric, metric.Name())
 	}
 	for x := 0; x < dataPoints.Len(); x++ {
 		addSingleNumberDataPoint(dataPoints.At(x), resource, metric, settings, tsMap)
@@ -60,7 +60,7 @@ func (a *logAttributesProcessor) processLogs(ctx context.Context, ld plog.Logs)
 					continue
 				}
 
-				a.attrProc.Process(ctx, a.logger, lr.Attributes())
+				a.attrProc.ProcessWithEntities(ctx, a.logger, lr.Attributes(), resource.Attributes(), library.Attributes())
 			}
 		}
 	}
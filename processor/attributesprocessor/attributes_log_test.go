@@ -27,8 +27,8 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 )
 
 // Common structure for all the Tests
@@ -443,6 +443,48 @@ func TestLogAttributes_Convert(t *testing.T) {
 	}
 }
 
+func TestLogAttributes_FromEntityAndOnFailure(t *testing.T) {
+	testCases := []logTestCase{
+		{
+			name: "copy_resource_attribute",
+			inputAttributes: map[string]interface{}{
+				"to.bool": "true",
+			},
+			expectedAttributes: map[string]interface{}{
+				"resource.name": "copy_resource_attribute",
+				"to.bool":       true,
+			},
+		},
+		{
+			name: "delete_unconvertible",
+			inputAttributes: map[string]interface{}{
+				"to.bool":  "maybe",
+				"scope.id": "stale",
+			},
+			expectedAttributes: map[string]interface{}{
+				"resource.name": "delete_unconvertible",
+			},
+		},
+	}
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.Actions = []attraction.ActionKeyValue{
+		{Key: "resource.name", Action: attraction.UPSERT, FromAttribute: "name", FromEntity: "resource"},
+		{Key: "scope.id", Action: attraction.UPSERT, FromAttribute: "id", FromEntity: "scope", OnFailure: "delete"},
+		{Key: "to.bool", Action: attraction.CONVERT, ConvertedType: "bool", OnFailure: "delete"},
+	}
+
+	tp, err := factory.CreateLogsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, consumertest.NewNop())
+	require.Nil(t, err)
+	require.NotNil(t, tp)
+
+	for _, tt := range testCases {
+		runIndividualLogTestCase(t, tt, tp)
+	}
+}
+
 func BenchmarkAttributes_FilterLogsByName(b *testing.B) {
 	testCases := []logTestCase{
 		{
@@ -17,6 +17,7 @@ package attributesprocessor // import "github.com/open-telemetry/opentelemetry-c
 import (
 	"context"
 
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 
@@ -57,7 +58,7 @@ func (a *metricAttributesProcessor) processMetrics(ctx context.Context, md pmetr
 					continue
 				}
 
-				a.processMetricAttributes(ctx, mr)
+				a.processMetricAttributes(ctx, mr, rs.Resource().Attributes(), ils.Scope().Attributes())
 			}
 		}
 	}
@@ -66,7 +67,7 @@ func (a *metricAttributesProcessor) processMetrics(ctx context.Context, md pmetr
 
 // Attributes are provided for each log and trace, but not at the metric level
 // Need to process attributes for every data point within a metric.
-func (a *metricAttributesProcessor) processMetricAttributes(ctx context.Context, m pmetric.Metric) {
+func (a *metricAttributesProcessor) processMetricAttributes(ctx context.Context, m pmetric.Metric, resource, scope pcommon.Map) {
 
 	// This is a lot of repeated code, but since there is no single parent superclass
 	// between metric data types, we can't use polymorphism.
@@ -74,27 +75,27 @@ func (a *metricAttributesProcessor) processMetricAttributes(ctx context.Context,
 	case pmetric.MetricTypeGauge:
 		dps := m.Gauge().DataPoints()
 		for i := 0; i < dps.Len(); i++ {
-			a.attrProc.Process(ctx, a.logger, dps.At(i).Attributes())
+			a.attrProc.ProcessWithEntities(ctx, a.logger, dps.At(i).Attributes(), resource, scope)
 		}
 	case pmetric.MetricTypeSum:
 		dps := m.Sum().DataPoints()
 		for i := 0; i < dps.Len(); i++ {
-			a.attrProc.Process(ctx, a.logger, dps.At(i).Attributes())
+			a.attrProc.ProcessWithEntities(ctx, a.logger, dps.At(i).Attributes(), resource, scope)
 		}
 	case pmetric.MetricTypeHistogram:
 		dps := m.Histogram().DataPoints()
 		for i := 0; i < dps.Len(); i++ {
-			a.attrProc.Process(ctx, a.logger, dps.At(i).Attributes())
+			a.attrProc.ProcessWithEntities(ctx, a.logger, dps.At(i).Attributes(), resource, scope)
 		}
 	case pmetric.MetricTypeExponentialHistogram:
 		dps := m.ExponentialHistogram().DataPoints()
 		for i := 0; i < dps.Len(); i++ {
-			a.attrProc.Process(ctx, a.logger, dps.At(i).Attributes())
+			a.attrProc.ProcessWithEntities(ctx, a.logger, dps.At(i).Attributes(), resource, scope)
 		}
 	case pmetric.MetricTypeSummary:
 		dps := m.Summary().DataPoints()
 		for i := 0; i < dps.Len(); i++ {
-			a.attrProc.Process(ctx, a.logger, dps.At(i).Attributes())
+			a.attrProc.ProcessWithEntities(ctx, a.logger, dps.At(i).Attributes(), resource, scope)
 		}
 	}
 }
@@ -59,7 +59,7 @@ func (a *spanAttributesProcessor) processTraces(ctx context.Context, td ptrace.T
 					continue
 				}
 
-				a.attrProc.Process(ctx, a.logger, span.Attributes())
+				a.attrProc.ProcessWithEntities(ctx, a.logger, span.Attributes(), resource.Attributes(), library.Attributes())
 			}
 		}
 	}
@@ -25,7 +25,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -219,6 +219,19 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "entities"),
+			expected: &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				Settings: attraction.Settings{
+					Actions: []attraction.ActionKeyValue{
+						{Key: "service.name", Action: attraction.INSERT, FromAttribute: "service.name", FromEntity: "resource"},
+						{Key: "library.version", Action: attraction.UPSERT, FromAttribute: "version", FromEntity: "scope", OnFailure: "delete"},
+						{Key: "cache.hit", Action: attraction.CONVERT, ConvertedType: "bool", OnFailure: "delete"},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -191,7 +191,7 @@ func TestMetricIdentity_IsSupportedMetricType(t *testing.T) {
 			fields: fields{
 				MetricType: pmetric.MetricTypeExponentialHistogram,
 			},
-			want: false,
+			want: true,
 		},
 		{
 			name: "summary",
@@ -41,10 +41,11 @@ type State struct {
 }
 
 type DeltaValue struct {
-	StartTimestamp pcommon.Timestamp
-	FloatValue     float64
-	IntValue       int64
-	HistogramValue *HistogramPoint
+	StartTimestamp    pcommon.Timestamp
+	FloatValue        float64
+	IntValue          int64
+	HistogramValue    *HistogramPoint
+	ExpHistogramValue *ExpHistogramPoint
 }
 
 func NewMetricTracker(ctx context.Context, logger *zap.Logger, maxStaleness time.Duration) *MetricTracker {
@@ -92,10 +93,11 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 	if !ok {
 		if metricID.MetricIsMonotonic {
 			out = DeltaValue{
-				StartTimestamp: metricPoint.ObservedTimestamp,
-				FloatValue:     metricPoint.FloatValue,
-				IntValue:       metricPoint.IntValue,
-				HistogramValue: metricPoint.HistogramValue,
+				StartTimestamp:    metricPoint.ObservedTimestamp,
+				FloatValue:        metricPoint.FloatValue,
+				IntValue:          metricPoint.IntValue,
+				HistogramValue:    metricPoint.HistogramValue,
+				ExpHistogramValue: metricPoint.ExpHistogramValue,
 			}
 			valid = true
 		}
@@ -123,8 +125,8 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 
 		delta := value.Clone()
 
-		// Calculate deltas unless histogram count was reset
-		if valid && delta.Count >= prevValue.Count {
+		// Calculate deltas unless histogram count or any bucket count was reset
+		if valid && !isHistogramReset(value, prevValue) {
 			delta.Count -= prevValue.Count
 			delta.Sum -= prevValue.Sum
 			for index, prevBucket := range prevValue.Buckets {
@@ -133,6 +135,30 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 		}
 
 		out.HistogramValue = &delta
+	case pmetric.MetricTypeExponentialHistogram:
+		value := metricPoint.ExpHistogramValue
+		prevValue := state.PrevPoint.ExpHistogramValue
+		if math.IsNaN(value.Sum) {
+			value.Sum = prevValue.Sum
+		}
+
+		delta := value.Clone()
+
+		// Calculate deltas unless the histogram was reset. A change of scale is
+		// handled as a reset, as the buckets of both points cannot be compared.
+		if value.Scale == prevValue.Scale &&
+			value.Count >= prevValue.Count &&
+			value.ZeroCount >= prevValue.ZeroCount &&
+			delta.Positive.subtract(prevValue.Positive) &&
+			delta.Negative.subtract(prevValue.Negative) {
+			delta.Count -= prevValue.Count
+			delta.Sum -= prevValue.Sum
+			delta.ZeroCount -= prevValue.ZeroCount
+		} else {
+			delta = value.Clone()
+		}
+
+		out.ExpHistogramValue = &delta
 	case pmetric.MetricTypeSum:
 		if metricID.IsFloatVal() {
 			value := metricPoint.FloatValue
@@ -163,6 +189,20 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 	return
 }
 
+// isHistogramReset returns whether the cumulative histogram was reset since the
+// previous point, detected by a decrease of its count or of any of its bucket counts.
+func isHistogramReset(value, prevValue *HistogramPoint) bool {
+	if value.Count < prevValue.Count {
+		return true
+	}
+	for index, prevBucket := range prevValue.Buckets {
+		if value.Buckets[index] < prevBucket {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *MetricTracker) removeStale(staleBefore pcommon.Timestamp) {
 	t.states.Range(func(key, value interface{}) bool {
 		s := value.(*State)
@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/atomic"
@@ -257,3 +258,107 @@ func Test_metricTracker_sweeper(t *testing.T) {
 		t.Errorf("Sweeper did not terminate.")
 	}
 }
+
+func TestMetricTracker_ConvertHistogramBucketReset(t *testing.T) {
+	mi := MetricIdentity{
+		Resource:               pcommon.NewResource(),
+		InstrumentationLibrary: pcommon.NewInstrumentationScope(),
+		MetricType:             pmetric.MetricTypeHistogram,
+		MetricIsMonotonic:      true,
+		Attributes:             pcommon.NewMap(),
+	}
+	m := NewMetricTracker(context.Background(), zap.NewNop(), 0)
+
+	_, valid := m.Convert(MetricPoint{Identity: mi, Value: ValuePoint{
+		ObservedTimestamp: 10,
+		HistogramValue:    &HistogramPoint{Count: 10, Sum: 100, Buckets: []uint64{5, 5}},
+	}})
+	assert.True(t, valid)
+
+	// The count increased but a bucket count decreased, so the histogram was reset.
+	out, valid := m.Convert(MetricPoint{Identity: mi, Value: ValuePoint{
+		ObservedTimestamp: 20,
+		HistogramValue:    &HistogramPoint{Count: 12, Sum: 50, Buckets: []uint64{1, 11}},
+	}})
+	assert.True(t, valid)
+	assert.Equal(t, pcommon.Timestamp(10), out.StartTimestamp)
+	assert.Equal(t, &HistogramPoint{Count: 12, Sum: 50, Buckets: []uint64{1, 11}}, out.HistogramValue)
+}
+
+func TestMetricTracker_ConvertExpHistogram(t *testing.T) {
+	mi := MetricIdentity{
+		Resource:               pcommon.NewResource(),
+		InstrumentationLibrary: pcommon.NewInstrumentationScope(),
+		MetricType:             pmetric.MetricTypeExponentialHistogram,
+		MetricIsMonotonic:      true,
+		Attributes:             pcommon.NewMap(),
+	}
+	m := NewMetricTracker(context.Background(), zap.NewNop(), 0)
+
+	tests := []struct {
+		name    string
+		value   ExpHistogramPoint
+		wantOut ExpHistogramPoint
+	}{
+		{
+			name: "Initial value recorded",
+			value: ExpHistogramPoint{
+				Count: 6, Sum: 60, Scale: 2, ZeroCount: 1,
+				Positive: ExpHistogramBuckets{Offset: 3, Counts: []uint64{2, 3}},
+				Negative: ExpHistogramBuckets{Counts: []uint64{}},
+			},
+			wantOut: ExpHistogramPoint{
+				Count: 6, Sum: 60, Scale: 2, ZeroCount: 1,
+				Positive: ExpHistogramBuckets{Offset: 3, Counts: []uint64{2, 3}},
+				Negative: ExpHistogramBuckets{Counts: []uint64{}},
+			},
+		},
+		{
+			name: "Buckets grow on both sides",
+			value: ExpHistogramPoint{
+				Count: 12, Sum: 100, Scale: 2, ZeroCount: 2,
+				Positive: ExpHistogramBuckets{Offset: 2, Counts: []uint64{1, 4, 3, 1}},
+				Negative: ExpHistogramBuckets{Offset: -1, Counts: []uint64{1}},
+			},
+			wantOut: ExpHistogramPoint{
+				Count: 6, Sum: 40, Scale: 2, ZeroCount: 1,
+				Positive: ExpHistogramBuckets{Offset: 2, Counts: []uint64{1, 2, 0, 1}},
+				Negative: ExpHistogramBuckets{Offset: -1, Counts: []uint64{1}},
+			},
+		},
+		{
+			name: "Bucket count decreased",
+			value: ExpHistogramPoint{
+				Count: 14, Sum: 120, Scale: 2, ZeroCount: 2,
+				Positive: ExpHistogramBuckets{Offset: 2, Counts: []uint64{1, 1, 10, 1}},
+				Negative: ExpHistogramBuckets{Offset: -1, Counts: []uint64{1}},
+			},
+			wantOut: ExpHistogramPoint{
+				Count: 14, Sum: 120, Scale: 2, ZeroCount: 2,
+				Positive: ExpHistogramBuckets{Offset: 2, Counts: []uint64{1, 1, 10, 1}},
+				Negative: ExpHistogramBuckets{Offset: -1, Counts: []uint64{1}},
+			},
+		},
+		{
+			name: "Scale changed",
+			value: ExpHistogramPoint{
+				Count: 20, Sum: 200, Scale: 1, ZeroCount: 2,
+				Positive: ExpHistogramBuckets{Offset: 1, Counts: []uint64{2, 16}},
+				Negative: ExpHistogramBuckets{Offset: -1, Counts: []uint64{1}},
+			},
+			wantOut: ExpHistogramPoint{
+				Count: 20, Sum: 200, Scale: 1, ZeroCount: 2,
+				Positive: ExpHistogramBuckets{Offset: 1, Counts: []uint64{2, 16}},
+				Negative: ExpHistogramBuckets{Offset: -1, Counts: []uint64{1}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := tt.value
+			out, valid := m.Convert(MetricPoint{Identity: mi, Value: ValuePoint{ExpHistogramValue: &value}})
+			assert.True(t, valid)
+			assert.Equal(t, &tt.wantOut, out.ExpHistogramValue)
+		})
+	}
+}
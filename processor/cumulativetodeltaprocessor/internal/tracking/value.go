@@ -21,6 +21,7 @@ type ValuePoint struct {
 	FloatValue        float64
 	IntValue          int64
 	HistogramValue    *HistogramPoint
+	ExpHistogramValue *ExpHistogramPoint
 }
 
 type HistogramPoint struct {
@@ -39,3 +40,59 @@ func (point *HistogramPoint) Clone() HistogramPoint {
 		Buckets: bucketValues,
 	}
 }
+
+type ExpHistogramPoint struct {
+	Count     uint64
+	Sum       float64
+	Scale     int32
+	ZeroCount uint64
+	Positive  ExpHistogramBuckets
+	Negative  ExpHistogramBuckets
+}
+
+type ExpHistogramBuckets struct {
+	Offset int32
+	Counts []uint64
+}
+
+func (point *ExpHistogramPoint) Clone() ExpHistogramPoint {
+	return ExpHistogramPoint{
+		Count:     point.Count,
+		Sum:       point.Sum,
+		Scale:     point.Scale,
+		ZeroCount: point.ZeroCount,
+		Positive:  point.Positive.Clone(),
+		Negative:  point.Negative.Clone(),
+	}
+}
+
+func (buckets *ExpHistogramBuckets) Clone() ExpHistogramBuckets {
+	counts := make([]uint64, len(buckets.Counts))
+	copy(counts, buckets.Counts)
+
+	return ExpHistogramBuckets{
+		Offset: buckets.Offset,
+		Counts: counts,
+	}
+}
+
+// subtract removes the previous bucket counts from the bucket counts. It returns false,
+// leaving the bucket counts untouched, if a previous bucket count is higher than the
+// current one, which means the cumulative histogram was reset.
+func (buckets *ExpHistogramBuckets) subtract(prev ExpHistogramBuckets) bool {
+	for i, prevCount := range prev.Counts {
+		if prevCount == 0 {
+			continue
+		}
+		index := int(prev.Offset) + i - int(buckets.Offset)
+		if index < 0 || index >= len(buckets.Counts) || buckets.Counts[index] < prevCount {
+			return false
+		}
+	}
+	for i, prevCount := range prev.Counts {
+		if prevCount != 0 {
+			buckets.Counts[int(prev.Offset)+i-int(buckets.Offset)] -= prevCount
+		}
+	}
+	return true
+}
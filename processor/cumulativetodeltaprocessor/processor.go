@@ -22,7 +22,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor/internal/tracking"
 )
 
@@ -30,8 +30,8 @@ const enableHistogramSupportGateID = "processor.cumulativetodeltaprocessor.Enabl
 
 var enableHistogramSupportGate = featuregate.Gate{
 	ID:          enableHistogramSupportGateID,
-	Enabled:     false,
-	Description: "wip",
+	Enabled:     true,
+	Description: "Controls whether cumulative histograms and exponential histograms are converted to delta",
 }
 
 func init() {
@@ -121,6 +121,34 @@ func (ctdp *cumulativeToDeltaProcessor) processMetrics(_ context.Context, md pme
 
 					ctdp.convertHistogramDataPoints(ms.DataPoints(), baseIdentity)
 
+					ms.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+					return ms.DataPoints().Len() == 0
+				case pmetric.MetricTypeExponentialHistogram:
+					if !ctdp.histogramSupportEnabled {
+						return false
+					}
+
+					ms := m.ExponentialHistogram()
+					if ms.AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
+						return false
+					}
+
+					if ms.DataPoints().Len() == 0 {
+						return false
+					}
+
+					baseIdentity := tracking.MetricIdentity{
+						Resource:               rm.Resource(),
+						InstrumentationLibrary: ilm.Scope(),
+						MetricType:             m.Type(),
+						MetricName:             m.Name(),
+						MetricUnit:             m.Unit(),
+						MetricIsMonotonic:      true,
+						MetricValueType:        pmetric.NumberDataPointValueTypeInt,
+					}
+
+					ctdp.convertExpHistogramDataPoints(ms.DataPoints(), baseIdentity)
+
 					ms.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
 					return ms.DataPoints().Len() == 0
 				default:
@@ -224,3 +252,50 @@ func (ctdp *cumulativeToDeltaProcessor) convertHistogramDataPoints(in interface{
 		})
 	}
 }
+
+func (ctdp *cumulativeToDeltaProcessor) convertExpHistogramDataPoints(dps pmetric.ExponentialHistogramDataPointSlice, baseIdentity tracking.MetricIdentity) {
+	dps.RemoveIf(func(dp pmetric.ExponentialHistogramDataPoint) bool {
+		id := baseIdentity
+		id.StartTimestamp = dp.StartTimestamp()
+		id.Attributes = dp.Attributes()
+
+		point := tracking.ValuePoint{
+			ObservedTimestamp: dp.Timestamp(),
+			ExpHistogramValue: &tracking.ExpHistogramPoint{
+				Count:     dp.Count(),
+				Sum:       dp.Sum(),
+				Scale:     dp.Scale(),
+				ZeroCount: dp.ZeroCount(),
+				Positive: tracking.ExpHistogramBuckets{
+					Offset: dp.Positive().Offset(),
+					Counts: dp.Positive().BucketCounts().AsRaw(),
+				},
+				Negative: tracking.ExpHistogramBuckets{
+					Offset: dp.Negative().Offset(),
+					Counts: dp.Negative().BucketCounts().AsRaw(),
+				},
+			},
+		}
+
+		trackingPoint := tracking.MetricPoint{
+			Identity: id,
+			Value:    point,
+		}
+		delta, valid := ctdp.deltaCalculator.Convert(trackingPoint)
+		if !valid {
+			return true
+		}
+
+		dp.SetStartTimestamp(delta.StartTimestamp)
+		dp.SetCount(delta.ExpHistogramValue.Count)
+		if dp.HasSum() && !math.IsNaN(dp.Sum()) {
+			dp.SetSum(delta.ExpHistogramValue.Sum)
+		}
+		dp.SetZeroCount(delta.ExpHistogramValue.ZeroCount)
+		dp.Positive().SetOffset(delta.ExpHistogramValue.Positive.Offset)
+		dp.Positive().BucketCounts().FromRaw(delta.ExpHistogramValue.Positive.Counts)
+		dp.Negative().SetOffset(delta.ExpHistogramValue.Negative.Offset)
+		dp.Negative().BucketCounts().FromRaw(delta.ExpHistogramValue.Negative.Counts)
+		return false
+	})
+}
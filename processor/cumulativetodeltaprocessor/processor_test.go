@@ -31,7 +31,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 )
 
 type testSumMetric struct {
@@ -473,3 +473,47 @@ func BenchmarkConsumeMetrics(b *testing.B) {
 		assert.NoError(b, p.ConsumeMetrics(context.Background(), metrics))
 	}
 }
+
+func TestCumulativeToDeltaProcessorExponentialHistogram(t *testing.T) {
+	require.NoError(t, featuregate.GetRegistry().Apply(map[string]bool{
+		enableHistogramSupportGateID: true,
+	}))
+	next := new(consumertest.MetricsSink)
+	cfg := createDefaultConfig().(*Config)
+	mgp, err := NewFactory().CreateMetricsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, mgp.Start(context.Background(), nil))
+
+	generate := func(count uint64, sum float64, zeroCount uint64, offset int32, buckets []uint64) pmetric.Metrics {
+		md := pmetric.NewMetrics()
+		m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("metric_1")
+		m.SetEmptyExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		dp := m.ExponentialHistogram().DataPoints().AppendEmpty()
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+		dp.SetCount(count)
+		dp.SetSum(sum)
+		dp.SetScale(3)
+		dp.SetZeroCount(zeroCount)
+		dp.Positive().SetOffset(offset)
+		dp.Positive().BucketCounts().FromRaw(buckets)
+		return md
+	}
+
+	require.NoError(t, mgp.ConsumeMetrics(context.Background(), generate(5, 50, 1, 4, []uint64{2, 2})))
+	require.NoError(t, mgp.ConsumeMetrics(context.Background(), generate(9, 80, 1, 3, []uint64{1, 3, 4})))
+
+	got := next.AllMetrics()
+	require.Len(t, got, 2)
+
+	m := got[1].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, m.ExponentialHistogram().AggregationTemporality())
+	dp := m.ExponentialHistogram().DataPoints().At(0)
+	assert.Equal(t, uint64(4), dp.Count())
+	assert.Equal(t, float64(30), dp.Sum())
+	assert.Equal(t, uint64(0), dp.ZeroCount())
+	assert.Equal(t, int32(3), dp.Positive().Offset())
+	assert.Equal(t, []uint64{1, 1, 2}, dp.Positive().BucketCounts().AsRaw())
+
+	require.NoError(t, mgp.Shutdown(context.Background()))
+}
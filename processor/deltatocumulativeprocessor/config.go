@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deltatocumulativeprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor"
+
+import (
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+)
+
+// Config defines the configuration for the processor.
+type Config struct {
+	config.ProcessorSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// MaxStale is the time after which a stream that did not receive any data point is removed.
+	// A data point received after the removal of its stream starts a new cumulative stream.
+	MaxStale time.Duration `mapstructure:"max_stale"`
+
+	// MaxStreams is the maximum number of streams tracked at the same time. The data points
+	// of new streams are dropped once the limit is reached.
+	MaxStreams int `mapstructure:"max_streams"`
+
+	// MaxExponentialHistogramBuckets is the maximum number of positive, and of negative, buckets
+	// of the cumulative exponential histograms. The scale of a histogram is reduced until its
+	// buckets fit within the limit.
+	MaxExponentialHistogramBuckets int `mapstructure:"max_exponential_histogram_buckets"`
+}
+
+// Validate checks whether the input configuration has all of the required fields for the processor.
+// An error is returned if there are any invalid inputs.
+func (config *Config) Validate() error {
+	if config.MaxStale <= 0 {
+		return fmt.Errorf("max_stale must be positive")
+	}
+	if config.MaxStreams <= 0 {
+		return fmt.Errorf("max_streams must be positive")
+	}
+	// Two buckets are needed to hold both sides of the zero index at the lowest scale.
+	if config.MaxExponentialHistogramBuckets < 2 {
+		return fmt.Errorf("max_exponential_histogram_buckets must be at least 2")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deltatocumulativeprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/identity"
+)
+
+// metricIdentity identifies the streams of a metric.
+type metricIdentity struct {
+	resource pcommon.Resource
+	scope    pcommon.InstrumentationScope
+	metric   pmetric.Metric
+}
+
+// key returns the key of the stream of the data point with the given attributes.
+func (id metricIdentity) key(attributes pcommon.Map) string {
+	var b strings.Builder
+	b.WriteString(id.metric.Type().String())
+	b.WriteString(identity.Separator)
+	b.WriteString(id.metric.Name())
+	b.WriteString(identity.Separator)
+	b.WriteString(id.metric.Unit())
+	if id.metric.Type() == pmetric.MetricTypeSum {
+		b.WriteString(identity.Separator)
+		if id.metric.Sum().IsMonotonic() {
+			b.WriteByte('Y')
+		} else {
+			b.WriteByte('N')
+		}
+	}
+	identity.WriteAttributes(&b, id.resource.Attributes())
+	b.WriteString(identity.Separator)
+	b.WriteString(id.scope.Name())
+	b.WriteString(identity.Separator)
+	b.WriteString(id.scope.Version())
+	identity.WriteAttributes(&b, attributes)
+	return b.String()
+}
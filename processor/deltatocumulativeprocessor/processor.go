@@ -0,0 +1,418 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deltatocumulativeprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+type deltaToCumulativeProcessor struct {
+	logger     *zap.Logger
+	maxStale   time.Duration
+	maxStreams int
+	maxBuckets int
+
+	mu      sync.Mutex
+	streams map[string]*stream
+
+	now        func() time.Time
+	cancelFunc context.CancelFunc
+	done       chan struct{}
+}
+
+// stream holds the cumulative state of a series, identified by its resource, scope,
+// metric and data point attributes.
+type stream struct {
+	// start is the start timestamp of the cumulative series.
+	start pcommon.Timestamp
+	// last is the timestamp of the last data point aggregated into the series.
+	last pcommon.Timestamp
+	// lastSeen is the time the last data point was received, used for the staleness expiry.
+	lastSeen time.Time
+
+	number       pmetric.NumberDataPoint
+	histogram    pmetric.HistogramDataPoint
+	expHistogram pmetric.ExponentialHistogramDataPoint
+}
+
+func newDeltaToCumulativeProcessor(config *Config, logger *zap.Logger) *deltaToCumulativeProcessor {
+	return &deltaToCumulativeProcessor{
+		logger:     logger,
+		maxStale:   config.MaxStale,
+		maxStreams: config.MaxStreams,
+		maxBuckets: config.MaxExponentialHistogramBuckets,
+		streams:    make(map[string]*stream),
+		now:        time.Now,
+	}
+}
+
+func (p *deltaToCumulativeProcessor) start(context.Context, component.Host) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancelFunc = cancel
+	p.done = make(chan struct{})
+	go p.sweep(ctx)
+	return nil
+}
+
+func (p *deltaToCumulativeProcessor) shutdown(context.Context) error {
+	if p.cancelFunc != nil {
+		p.cancelFunc()
+		<-p.done
+	}
+	return nil
+}
+
+// sweep periodically removes the streams that did not receive any data point within maxStale.
+func (p *deltaToCumulativeProcessor) sweep(ctx context.Context) {
+	defer close(p.done)
+	ticker := time.NewTicker(p.maxStale)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.removeStale()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (p *deltaToCumulativeProcessor) removeStale() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	staleBefore := p.now().Add(-p.maxStale)
+	for key, s := range p.streams {
+		if s.lastSeen.Before(staleBefore) {
+			delete(p.streams, key)
+		}
+	}
+}
+
+// processMetrics implements the ProcessMetricsFunc type.
+func (p *deltaToCumulativeProcessor) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
+			sm.Metrics().RemoveIf(func(m pmetric.Metric) bool {
+				id := metricIdentity{resource: rm.Resource(), scope: sm.Scope(), metric: m}
+				switch m.Type() {
+				case pmetric.MetricTypeSum:
+					ms := m.Sum()
+					if ms.AggregationTemporality() != pmetric.AggregationTemporalityDelta {
+						return false
+					}
+					ms.DataPoints().RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+						return !p.convertNumberDataPoint(id.key(dp.Attributes()), dp)
+					})
+					ms.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+					return ms.DataPoints().Len() == 0
+				case pmetric.MetricTypeHistogram:
+					ms := m.Histogram()
+					if ms.AggregationTemporality() != pmetric.AggregationTemporalityDelta {
+						return false
+					}
+					ms.DataPoints().RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
+						return !p.convertHistogramDataPoint(id.key(dp.Attributes()), dp)
+					})
+					ms.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+					return ms.DataPoints().Len() == 0
+				case pmetric.MetricTypeExponentialHistogram:
+					ms := m.ExponentialHistogram()
+					if ms.AggregationTemporality() != pmetric.AggregationTemporalityDelta {
+						return false
+					}
+					ms.DataPoints().RemoveIf(func(dp pmetric.ExponentialHistogramDataPoint) bool {
+						return !p.convertExpHistogramDataPoint(id.key(dp.Attributes()), dp)
+					})
+					ms.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+					return ms.DataPoints().Len() == 0
+				default:
+					return false
+				}
+			})
+			return sm.Metrics().Len() == 0
+		})
+		return rm.ScopeMetrics().Len() == 0
+	})
+	return md, nil
+}
+
+// getStream returns the stream of the key, and whether it was just created. It returns nil if the
+// stream does not exist and the maximum number of streams is reached, or if the data point is older
+// than the last data point of the stream.
+func (p *deltaToCumulativeProcessor) getStream(key string, startTimestamp, timestamp pcommon.Timestamp) (*stream, bool) {
+	s, ok := p.streams[key]
+	if !ok {
+		if len(p.streams) >= p.maxStreams {
+			p.logger.Debug("dropping data point of new stream, the maximum number of streams is reached",
+				zap.Int("max_streams", p.maxStreams))
+			return nil, false
+		}
+		start := startTimestamp
+		if start == 0 {
+			start = timestamp
+		}
+		s = &stream{start: start, last: timestamp, lastSeen: p.now()}
+		p.streams[key] = s
+		return s, true
+	}
+
+	if timestamp <= s.last {
+		p.logger.Debug("dropping out of order data point",
+			zap.Stringer("timestamp", timestamp), zap.Stringer("last_timestamp", s.last))
+		return nil, false
+	}
+	s.last = timestamp
+	s.lastSeen = p.now()
+	return s, false
+}
+
+func (p *deltaToCumulativeProcessor) convertNumberDataPoint(key string, dp pmetric.NumberDataPoint) bool {
+	s, created := p.getStream(key, dp.StartTimestamp(), dp.Timestamp())
+	if s == nil {
+		return false
+	}
+
+	if created || s.number.ValueType() != dp.ValueType() {
+		// A change of the value type restarts the cumulative series.
+		if !created {
+			s.start = dp.StartTimestamp()
+			if s.start == 0 {
+				s.start = dp.Timestamp()
+			}
+		}
+		s.number = pmetric.NewNumberDataPoint()
+		dp.CopyTo(s.number)
+	} else {
+		switch dp.ValueType() {
+		case pmetric.NumberDataPointValueTypeInt:
+			s.number.SetIntValue(s.number.IntValue() + dp.IntValue())
+		case pmetric.NumberDataPointValueTypeDouble:
+			s.number.SetDoubleValue(s.number.DoubleValue() + dp.DoubleValue())
+		}
+	}
+
+	dp.SetStartTimestamp(s.start)
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeInt:
+		dp.SetIntValue(s.number.IntValue())
+	case pmetric.NumberDataPointValueTypeDouble:
+		dp.SetDoubleValue(s.number.DoubleValue())
+	}
+	return true
+}
+
+func (p *deltaToCumulativeProcessor) convertHistogramDataPoint(key string, dp pmetric.HistogramDataPoint) bool {
+	s, created := p.getStream(key, dp.StartTimestamp(), dp.Timestamp())
+	if s == nil {
+		return false
+	}
+
+	if created || !equalBounds(s.histogram.ExplicitBounds(), dp.ExplicitBounds()) {
+		// A change of the bucket boundaries restarts the cumulative series.
+		if !created {
+			s.start = dp.StartTimestamp()
+			if s.start == 0 {
+				s.start = dp.Timestamp()
+			}
+		}
+		s.histogram = pmetric.NewHistogramDataPoint()
+		dp.CopyTo(s.histogram)
+		s.histogram.Exemplars().RemoveIf(func(pmetric.Exemplar) bool { return true })
+	} else {
+		acc := s.histogram
+		acc.SetCount(acc.Count() + dp.Count())
+		if dp.HasSum() {
+			acc.SetSum(acc.Sum() + dp.Sum())
+		}
+		if dp.HasMin() && (!acc.HasMin() || dp.Min() < acc.Min()) {
+			acc.SetMin(dp.Min())
+		}
+		if dp.HasMax() && (!acc.HasMax() || dp.Max() > acc.Max()) {
+			acc.SetMax(dp.Max())
+		}
+		counts := acc.BucketCounts()
+		for i := 0; i < counts.Len() && i < dp.BucketCounts().Len(); i++ {
+			counts.SetAt(i, counts.At(i)+dp.BucketCounts().At(i))
+		}
+	}
+
+	acc := s.histogram
+	dp.SetStartTimestamp(s.start)
+	dp.SetCount(acc.Count())
+	if acc.HasSum() {
+		dp.SetSum(acc.Sum())
+	}
+	if acc.HasMin() {
+		dp.SetMin(acc.Min())
+	}
+	if acc.HasMax() {
+		dp.SetMax(acc.Max())
+	}
+	acc.BucketCounts().CopyTo(dp.BucketCounts())
+	return true
+}
+
+func (p *deltaToCumulativeProcessor) convertExpHistogramDataPoint(key string, dp pmetric.ExponentialHistogramDataPoint) bool {
+	s, created := p.getStream(key, dp.StartTimestamp(), dp.Timestamp())
+	if s == nil {
+		return false
+	}
+
+	if created {
+		s.expHistogram = pmetric.NewExponentialHistogramDataPoint()
+		dp.CopyTo(s.expHistogram)
+		s.expHistogram.Exemplars().RemoveIf(func(pmetric.Exemplar) bool { return true })
+	} else {
+		acc := s.expHistogram
+		// Both histograms are brought to the lowest of their scales before being merged.
+		if dp.Scale() < acc.Scale() {
+			downscaleBuckets(acc.Positive(), acc.Scale()-dp.Scale())
+			downscaleBuckets(acc.Negative(), acc.Scale()-dp.Scale())
+			acc.SetScale(dp.Scale())
+		} else if dp.Scale() > acc.Scale() {
+			downscaleBuckets(dp.Positive(), dp.Scale()-acc.Scale())
+			downscaleBuckets(dp.Negative(), dp.Scale()-acc.Scale())
+			dp.SetScale(acc.Scale())
+		}
+		acc.SetCount(acc.Count() + dp.Count())
+		if dp.HasSum() {
+			acc.SetSum(acc.Sum() + dp.Sum())
+		}
+		if dp.HasMin() && (!acc.HasMin() || dp.Min() < acc.Min()) {
+			acc.SetMin(dp.Min())
+		}
+		if dp.HasMax() && (!acc.HasMax() || dp.Max() > acc.Max()) {
+			acc.SetMax(dp.Max())
+		}
+		acc.SetZeroCount(acc.ZeroCount() + dp.ZeroCount())
+		addBuckets(acc.Positive(), dp.Positive())
+		addBuckets(acc.Negative(), dp.Negative())
+	}
+
+	acc := s.expHistogram
+	fitBuckets(acc, p.maxBuckets)
+	dp.SetStartTimestamp(s.start)
+	dp.SetCount(acc.Count())
+	if acc.HasSum() {
+		dp.SetSum(acc.Sum())
+	}
+	if acc.HasMin() {
+		dp.SetMin(acc.Min())
+	}
+	if acc.HasMax() {
+		dp.SetMax(acc.Max())
+	}
+	dp.SetScale(acc.Scale())
+	dp.SetZeroCount(acc.ZeroCount())
+	acc.Positive().CopyTo(dp.Positive())
+	acc.Negative().CopyTo(dp.Negative())
+	return true
+}
+
+func equalBounds(a, b pcommon.Float64Slice) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	for i := 0; i < a.Len(); i++ {
+		if a.At(i) != b.At(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// downscaleBuckets reduces the scale of the buckets by the given amount, merging 2^by adjacent buckets together.
+func downscaleBuckets(buckets pmetric.ExponentialHistogramDataPointBuckets, by int32) {
+	counts := buckets.BucketCounts()
+	if counts.Len() == 0 {
+		buckets.SetOffset(buckets.Offset() >> by)
+		return
+	}
+	offset := buckets.Offset() >> by
+	last := (buckets.Offset() + int32(counts.Len()) - 1) >> by
+	merged := make([]uint64, last-offset+1)
+	for i := 0; i < counts.Len(); i++ {
+		merged[((buckets.Offset()+int32(i))>>by)-offset] += counts.At(i)
+	}
+	buckets.SetOffset(offset)
+	counts.FromRaw(merged)
+}
+
+// fitBuckets reduces the scale of the histogram until both its positive and negative buckets
+// hold at most maxBuckets buckets.
+func fitBuckets(dp pmetric.ExponentialHistogramDataPoint, maxBuckets int) {
+	by := int32(0)
+	for bucketsLen(dp.Positive(), by) > maxBuckets || bucketsLen(dp.Negative(), by) > maxBuckets {
+		by++
+	}
+	if by == 0 {
+		return
+	}
+	downscaleBuckets(dp.Positive(), by)
+	downscaleBuckets(dp.Negative(), by)
+	dp.SetScale(dp.Scale() - by)
+}
+
+// bucketsLen returns the number of buckets once their scale is reduced by the given amount.
+func bucketsLen(buckets pmetric.ExponentialHistogramDataPointBuckets, by int32) int {
+	n := buckets.BucketCounts().Len()
+	if n == 0 {
+		return 0
+	}
+	return int((buckets.Offset()+int32(n)-1)>>by-buckets.Offset()>>by) + 1
+}
+
+// addBuckets adds the bucket counts of from to the bucket counts of to, both at the same scale.
+func addBuckets(to, from pmetric.ExponentialHistogramDataPointBuckets) {
+	fromCounts := from.BucketCounts()
+	if fromCounts.Len() == 0 {
+		return
+	}
+	toCounts := to.BucketCounts()
+	if toCounts.Len() == 0 {
+		to.SetOffset(from.Offset())
+		fromCounts.CopyTo(toCounts)
+		return
+	}
+
+	offset := to.Offset()
+	if from.Offset() < offset {
+		offset = from.Offset()
+	}
+	last := to.Offset() + int32(toCounts.Len()) - 1
+	if fromLast := from.Offset() + int32(fromCounts.Len()) - 1; fromLast > last {
+		last = fromLast
+	}
+	merged := make([]uint64, last-offset+1)
+	for i := 0; i < toCounts.Len(); i++ {
+		merged[to.Offset()-offset+int32(i)] += toCounts.At(i)
+	}
+	for i := 0; i < fromCounts.Len(); i++ {
+		merged[from.Offset()-offset+int32(i)] += fromCounts.At(i)
+	}
+	to.SetOffset(offset)
+	toCounts.FromRaw(merged)
+}
@@ -0,0 +1,297 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deltatocumulativeprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+func newTestProcessor(maxStreams int) *deltaToCumulativeProcessor {
+	return newDeltaToCumulativeProcessor(&Config{
+		MaxStale:                       time.Minute,
+		MaxStreams:                     maxStreams,
+		MaxExponentialHistogramBuckets: defaultMaxExponentialHistogramBuckets,
+	}, zap.NewNop())
+}
+
+func sumMetrics(temporality pmetric.AggregationTemporality, points ...sumPoint) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "test")
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("requests")
+	m.SetEmptySum().SetAggregationTemporality(temporality)
+	m.Sum().SetIsMonotonic(true)
+	for _, p := range points {
+		dp := m.Sum().DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(p.start)
+		dp.SetTimestamp(p.timestamp)
+		dp.SetIntValue(p.value)
+		dp.Attributes().PutStr("path", p.path)
+	}
+	return md
+}
+
+type sumPoint struct {
+	path      string
+	start     pcommon.Timestamp
+	timestamp pcommon.Timestamp
+	value     int64
+}
+
+func sumDataPoints(t *testing.T, md pmetric.Metrics) pmetric.NumberDataPointSlice {
+	require.Equal(t, 1, md.MetricCount())
+	m := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, m.Sum().AggregationTemporality())
+	return m.Sum().DataPoints()
+}
+
+func TestProcessSums(t *testing.T) {
+	p := newTestProcessor(defaultMaxStreams)
+
+	md, err := p.processMetrics(context.Background(), sumMetrics(pmetric.AggregationTemporalityDelta,
+		sumPoint{path: "/a", start: 10, timestamp: 20, value: 3},
+		sumPoint{path: "/b", start: 10, timestamp: 20, value: 1},
+	))
+	require.NoError(t, err)
+	dps := sumDataPoints(t, md)
+	require.Equal(t, 2, dps.Len())
+	assert.Equal(t, int64(3), dps.At(0).IntValue())
+	assert.Equal(t, pcommon.Timestamp(10), dps.At(0).StartTimestamp())
+	assert.Equal(t, int64(1), dps.At(1).IntValue())
+
+	md, err = p.processMetrics(context.Background(), sumMetrics(pmetric.AggregationTemporalityDelta,
+		sumPoint{path: "/a", start: 20, timestamp: 30, value: 4},
+	))
+	require.NoError(t, err)
+	dps = sumDataPoints(t, md)
+	require.Equal(t, 1, dps.Len())
+	assert.Equal(t, int64(7), dps.At(0).IntValue())
+	assert.Equal(t, pcommon.Timestamp(10), dps.At(0).StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(30), dps.At(0).Timestamp())
+}
+
+func TestProcessSumsOutOfOrder(t *testing.T) {
+	p := newTestProcessor(defaultMaxStreams)
+
+	_, err := p.processMetrics(context.Background(), sumMetrics(pmetric.AggregationTemporalityDelta,
+		sumPoint{path: "/a", start: 10, timestamp: 20, value: 3},
+	))
+	require.NoError(t, err)
+
+	md, err := p.processMetrics(context.Background(), sumMetrics(pmetric.AggregationTemporalityDelta,
+		sumPoint{path: "/a", start: 5, timestamp: 15, value: 4},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, 0, md.MetricCount())
+}
+
+func TestProcessSumsCumulativeUntouched(t *testing.T) {
+	p := newTestProcessor(defaultMaxStreams)
+
+	in := sumMetrics(pmetric.AggregationTemporalityCumulative,
+		sumPoint{path: "/a", start: 10, timestamp: 20, value: 3},
+	)
+	expected := pmetric.NewMetrics()
+	in.CopyTo(expected)
+
+	md, err := p.processMetrics(context.Background(), in)
+	require.NoError(t, err)
+	assert.Equal(t, expected, md)
+	assert.Empty(t, p.streams)
+}
+
+func TestProcessSumsValueTypeChange(t *testing.T) {
+	p := newTestProcessor(defaultMaxStreams)
+
+	_, err := p.processMetrics(context.Background(), sumMetrics(pmetric.AggregationTemporalityDelta,
+		sumPoint{path: "/a", start: 10, timestamp: 20, value: 3},
+	))
+	require.NoError(t, err)
+	md := sumMetrics(pmetric.AggregationTemporalityDelta, sumPoint{path: "/a", start: 20, timestamp: 30})
+	md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).SetDoubleValue(1.5)
+	md, err = p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	dps := sumDataPoints(t, md)
+	require.Equal(t, 1, dps.Len())
+	assert.Equal(t, 1.5, dps.At(0).DoubleValue())
+	assert.Equal(t, pcommon.Timestamp(20), dps.At(0).StartTimestamp())
+}
+
+func TestProcessSumsMaxStreams(t *testing.T) {
+	p := newTestProcessor(1)
+
+	md, err := p.processMetrics(context.Background(), sumMetrics(pmetric.AggregationTemporalityDelta,
+		sumPoint{path: "/a", start: 10, timestamp: 20, value: 3},
+		sumPoint{path: "/b", start: 10, timestamp: 20, value: 1},
+	))
+	require.NoError(t, err)
+	dps := sumDataPoints(t, md)
+	require.Equal(t, 1, dps.Len())
+	path, _ := dps.At(0).Attributes().Get("path")
+	assert.Equal(t, "/a", path.Str())
+	assert.Len(t, p.streams, 1)
+}
+
+func TestRemoveStale(t *testing.T) {
+	p := newTestProcessor(defaultMaxStreams)
+	now := time.Unix(1000, 0)
+	p.now = func() time.Time { return now }
+
+	_, err := p.processMetrics(context.Background(), sumMetrics(pmetric.AggregationTemporalityDelta,
+		sumPoint{path: "/a", start: 10, timestamp: 20, value: 3},
+	))
+	require.NoError(t, err)
+
+	now = now.Add(30 * time.Second)
+	p.removeStale()
+	assert.Len(t, p.streams, 1)
+
+	now = now.Add(time.Minute)
+	p.removeStale()
+	assert.Empty(t, p.streams)
+
+	// A data point of a removed stream starts a new cumulative stream.
+	md, err := p.processMetrics(context.Background(), sumMetrics(pmetric.AggregationTemporalityDelta,
+		sumPoint{path: "/a", start: 20, timestamp: 30, value: 4},
+	))
+	require.NoError(t, err)
+	dps := sumDataPoints(t, md)
+	assert.Equal(t, int64(4), dps.At(0).IntValue())
+	assert.Equal(t, pcommon.Timestamp(20), dps.At(0).StartTimestamp())
+}
+
+func histogramMetrics(start, timestamp pcommon.Timestamp, bounds []float64, counts []uint64, sum float64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("latency")
+	m.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dp := m.Histogram().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(timestamp)
+	dp.ExplicitBounds().FromRaw(bounds)
+	dp.BucketCounts().FromRaw(counts)
+	var count uint64
+	for _, c := range counts {
+		count += c
+	}
+	dp.SetCount(count)
+	dp.SetSum(sum)
+	dp.SetMin(1)
+	dp.SetMax(sum)
+	return md
+}
+
+func TestProcessHistograms(t *testing.T) {
+	p := newTestProcessor(defaultMaxStreams)
+
+	_, err := p.processMetrics(context.Background(), histogramMetrics(10, 20, []float64{1, 10}, []uint64{1, 2, 0}, 10))
+	require.NoError(t, err)
+
+	md, err := p.processMetrics(context.Background(), histogramMetrics(20, 30, []float64{1, 10}, []uint64{0, 1, 1}, 20))
+	require.NoError(t, err)
+	m := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, m.Histogram().AggregationTemporality())
+	dp := m.Histogram().DataPoints().At(0)
+	assert.Equal(t, pcommon.Timestamp(10), dp.StartTimestamp())
+	assert.Equal(t, uint64(5), dp.Count())
+	assert.Equal(t, float64(30), dp.Sum())
+	assert.Equal(t, float64(1), dp.Min())
+	assert.Equal(t, float64(20), dp.Max())
+	assert.Equal(t, []uint64{1, 3, 1}, dp.BucketCounts().AsRaw())
+
+	// A change of the bucket boundaries restarts the series.
+	md, err = p.processMetrics(context.Background(), histogramMetrics(30, 40, []float64{5}, []uint64{1, 1}, 8))
+	require.NoError(t, err)
+	dp = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Histogram().DataPoints().At(0)
+	assert.Equal(t, pcommon.Timestamp(30), dp.StartTimestamp())
+	assert.Equal(t, uint64(2), dp.Count())
+	assert.Equal(t, []uint64{1, 1}, dp.BucketCounts().AsRaw())
+}
+
+func expHistogramMetrics(timestamp pcommon.Timestamp, scale int32, offset int32, counts []uint64, zeroCount uint64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("latency")
+	m.SetEmptyExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dp := m.ExponentialHistogram().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(timestamp - 10)
+	dp.SetTimestamp(timestamp)
+	dp.SetScale(scale)
+	dp.SetZeroCount(zeroCount)
+	dp.Positive().SetOffset(offset)
+	dp.Positive().BucketCounts().FromRaw(counts)
+	count := zeroCount
+	for _, c := range counts {
+		count += c
+	}
+	dp.SetCount(count)
+	return md
+}
+
+func TestProcessExpHistograms(t *testing.T) {
+	p := newTestProcessor(defaultMaxStreams)
+
+	_, err := p.processMetrics(context.Background(), expHistogramMetrics(20, 2, 4, []uint64{1, 2}, 1))
+	require.NoError(t, err)
+
+	// Same scale, the buckets are merged by index.
+	md, err := p.processMetrics(context.Background(), expHistogramMetrics(30, 2, 3, []uint64{1, 0, 0, 2}, 0))
+	require.NoError(t, err)
+	dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).ExponentialHistogram().DataPoints().At(0)
+	assert.Equal(t, pcommon.Timestamp(10), dp.StartTimestamp())
+	assert.Equal(t, uint64(7), dp.Count())
+	assert.Equal(t, uint64(1), dp.ZeroCount())
+	assert.Equal(t, int32(2), dp.Scale())
+	assert.Equal(t, int32(3), dp.Positive().Offset())
+	assert.Equal(t, []uint64{1, 1, 2, 2}, dp.Positive().BucketCounts().AsRaw())
+
+	// A lower scale downscales the accumulated buckets: indexes 3..6 at scale 2 become 1..3 at scale 1.
+	md, err = p.processMetrics(context.Background(), expHistogramMetrics(40, 1, 1, []uint64{1}, 0))
+	require.NoError(t, err)
+	dp = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).ExponentialHistogram().DataPoints().At(0)
+	assert.Equal(t, uint64(8), dp.Count())
+	assert.Equal(t, int32(1), dp.Scale())
+	assert.Equal(t, int32(1), dp.Positive().Offset())
+	assert.Equal(t, []uint64{2, 3, 2}, dp.Positive().BucketCounts().AsRaw())
+}
+
+func TestProcessExpHistogramsMaxBuckets(t *testing.T) {
+	p := newDeltaToCumulativeProcessor(&Config{
+		MaxStale:                       time.Minute,
+		MaxStreams:                     defaultMaxStreams,
+		MaxExponentialHistogramBuckets: 4,
+	}, zap.NewNop())
+
+	_, err := p.processMetrics(context.Background(), expHistogramMetrics(20, 2, 0, []uint64{1, 1, 1, 1}, 0))
+	require.NoError(t, err)
+
+	// The merged indexes 0..7 exceed the limit, the histogram is downscaled to indexes 0..3 at scale 1.
+	md, err := p.processMetrics(context.Background(), expHistogramMetrics(30, 2, 4, []uint64{1, 2, 1, 2}, 0))
+	require.NoError(t, err)
+	dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).ExponentialHistogram().DataPoints().At(0)
+	assert.Equal(t, uint64(10), dp.Count())
+	assert.Equal(t, int32(1), dp.Scale())
+	assert.Equal(t, int32(0), dp.Positive().Offset())
+	assert.Equal(t, []uint64{2, 2, 3, 3}, dp.Positive().BucketCounts().AsRaw())
+}
@@ -25,8 +25,8 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermetric"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset/regexp"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset/regexp"
 )
 
 // Config defines configuration for Resource processor.
@@ -142,7 +142,10 @@ var severityToNumber = map[string]plog.SeverityNumber{
 	"FATAL4": plog.SeverityNumberFatal4,
 }
 
-var errInvalidSeverity = errors.New("not a valid severity")
+var (
+	errInvalidSeverity      = errors.New("not a valid severity")
+	errInvalidSeverityRange = errors.New("max severity is lower than min severity")
+)
 
 // logSeverity is a type that represents a SeverityNumber as a string
 type logSeverity string
@@ -225,6 +228,7 @@ func (lmp LogMatchProperties) matchProperties() *filterconfig.MatchProperties {
 	if lmp.SeverityNumberProperties != nil {
 		mp.LogSeverityNumber = &filterconfig.LogSeverityNumberMatchProperties{
 			Min:            lmp.SeverityNumberProperties.Min.severityNumber(),
+			Max:            lmp.SeverityNumberProperties.Max.severityNumber(),
 			MatchUndefined: lmp.SeverityNumberProperties.MatchUndefined,
 		}
 	}
@@ -239,6 +243,10 @@ type LogSeverityNumberMatchProperties struct {
 	// this field is case-insensitive ("INFO" == "info")
 	Min logSeverity `mapstructure:"min"`
 
+	// Max is the maximum severity with which the log record can match.
+	// It accepts the same values as Min. If not set, there is no upper bound.
+	Max logSeverity `mapstructure:"max"`
+
 	// MatchUndefined lets logs records with "unknown" severity match.
 	// If MinSeverity is not set, this field is ignored, as fields are not matched based on severity.
 	MatchUndefined bool `mapstructure:"match_undefined"`
@@ -246,7 +254,16 @@ type LogSeverityNumberMatchProperties struct {
 
 // validate checks that the LogMatchProperties is valid
 func (lmp LogSeverityNumberMatchProperties) validate() error {
-	return lmp.Min.validate()
+	if err := lmp.Min.validate(); err != nil {
+		return err
+	}
+	if err := lmp.Max.validate(); err != nil {
+		return err
+	}
+	if lmp.Max != "" && lmp.Max.severityNumber() < lmp.Min.severityNumber() {
+		return fmt.Errorf("'%s' to '%s' is not a valid severity range: %w", lmp.Min, lmp.Max, errInvalidSeverityRange)
+	}
+	return nil
 }
 
 var _ config.Processor = (*Config)(nil)
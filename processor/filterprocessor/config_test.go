@@ -26,8 +26,8 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filtermetric"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
-	fsregexp "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset/regexp"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
+	fsregexp "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset/regexp"
 )
 
 // TestLoadingConfigRegexp tests loading testdata/config_strict.yaml
@@ -840,3 +840,45 @@ func TestLogSeverity_severityValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestLogSeverityNumberMatchProperties_validate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		props       LogSeverityNumberMatchProperties
+		expectedErr error
+	}{
+		{
+			name:  "min only",
+			props: LogSeverityNumberMatchProperties{Min: logSeverity("INFO")},
+		},
+		{
+			name:  "min and max",
+			props: LogSeverityNumberMatchProperties{Min: logSeverity("INFO"), Max: logSeverity("error4")},
+		},
+		{
+			name:  "equal min and max",
+			props: LogSeverityNumberMatchProperties{Min: logSeverity("WARN"), Max: logSeverity("WARN")},
+		},
+		{
+			name:        "invalid max",
+			props:       LogSeverityNumberMatchProperties{Min: logSeverity("INFO"), Max: logSeverity("unknown")},
+			expectedErr: errInvalidSeverity,
+		},
+		{
+			name:        "max lower than min",
+			props:       LogSeverityNumberMatchProperties{Min: logSeverity("ERROR"), Max: logSeverity("INFO")},
+			expectedErr: errInvalidSeverityRange,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.props.validate()
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
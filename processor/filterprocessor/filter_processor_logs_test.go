@@ -530,6 +530,21 @@ var (
 				{"log4"},
 			},
 		},
+		{
+			name: "includeSeverityDEBUGtoINFO",
+			inc: &LogMatchProperties{
+				LogMatchType: Regexp,
+				SeverityNumberProperties: &LogSeverityNumberMatchProperties{
+					Min: logSeverity("DEBUG"),
+					Max: logSeverity("INFO4"),
+				},
+			},
+			inLogs: testResourceLogs(inLogForSeverityNumber),
+			outLN: [][]string{
+				{"log1"},
+				{"log2"},
+			},
+		},
 		{
 			name: "excludeMinSeverityINFO",
 			exc: &LogMatchProperties{
@@ -15,6 +15,7 @@
 package groupbytraceprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor"
 
 import (
+	"errors"
 	"time"
 
 	"go.opentelemetry.io/collector/config"
@@ -45,6 +46,38 @@ type Config struct {
 	// StoreOnDisk tells the processor to keep only the trace ID in memory, serializing the trace spans to disk.
 	// Useful when the duration to wait for traces to complete is high.
 	// Default: false.
-	// Not yet implemented, and an error will be returned when this option is used.
+	// Not yet implemented, and an error will be returned when this option is used. Use Storage instead.
 	StoreOnDisk bool `mapstructure:"store_on_disk"`
+
+	// Storage is the ID of the storage extension the spans of the traces waiting to be released are
+	// persisted to, so that they are grouped and released after a restart of the collector.
+	// Default: nil, the spans are only kept in memory.
+	Storage *config.ComponentID `mapstructure:"storage"`
+
+	// EarlyRelease releases traces that are considered complete before the wait duration expires.
+	EarlyRelease EarlyReleaseConfig `mapstructure:"early_release"`
+}
+
+// EarlyReleaseConfig defines the heuristics used to consider a trace complete before the wait duration expires.
+type EarlyReleaseConfig struct {
+	// QuietPeriod releases a trace once no span was received for it during this duration.
+	// Default: 0, traces are only released once the wait duration expires.
+	QuietPeriod time.Duration `mapstructure:"quiet_period"`
+
+	// RequireRootSpan only releases a trace early once its root span, a span without parent span, was received.
+	// Default: true.
+	RequireRootSpan bool `mapstructure:"require_root_span"`
+}
+
+var _ config.Processor = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.EarlyRelease.QuietPeriod < 0 {
+		return errors.New("early_release::quiet_period must not be negative")
+	}
+	if cfg.EarlyRelease.QuietPeriod > 0 && cfg.EarlyRelease.QuietPeriod >= cfg.WaitDuration {
+		return errors.New("early_release::quiet_period must be shorter than wait_duration")
+	}
+	return nil
 }
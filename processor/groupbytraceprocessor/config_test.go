@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupbytraceprocessor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateConfig(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		earlyRelease EarlyReleaseConfig
+		expectedErr  string
+	}{
+		{
+			name: "default",
+		},
+		{
+			name:         "quiet period",
+			earlyRelease: EarlyReleaseConfig{QuietPeriod: 100 * time.Millisecond, RequireRootSpan: true},
+		},
+		{
+			name:         "negative quiet period",
+			earlyRelease: EarlyReleaseConfig{QuietPeriod: -time.Second},
+			expectedErr:  "early_release::quiet_period must not be negative",
+		},
+		{
+			name:         "quiet period not shorter than wait duration",
+			earlyRelease: EarlyReleaseConfig{QuietPeriod: time.Second},
+			expectedErr:  "early_release::quiet_period must be shorter than wait_duration",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.EarlyRelease = tt.earlyRelease
+
+			err := cfg.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
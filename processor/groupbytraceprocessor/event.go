@@ -41,6 +41,9 @@ const (
 
 	// traceID to be removed
 	traceRemoved
+
+	// traceID that didn't receive spans for the early release quiet period
+	traceQuiet
 )
 
 var (
@@ -87,6 +90,7 @@ type eventMachine struct {
 	onTraceExpired  func(traceID pcommon.TraceID, worker *eventMachineWorker) error
 	onTraceReleased func(rss []ptrace.ResourceSpans) error
 	onTraceRemoved  func(traceID pcommon.TraceID) error
+	onTraceQuiet    func(traceID pcommon.TraceID, worker *eventMachineWorker) error
 
 	onError func(event)
 
@@ -108,6 +112,7 @@ func newEventMachine(logger *zap.Logger, bufferSize int, numWorkers int, numTrac
 		em.workers[i] = &eventMachineWorker{
 			machine: em,
 			buffer:  newRingBuffer(numTraces / numWorkers),
+			traces:  make(map[pcommon.TraceID]*traceState),
 			events:  make(chan event, bufferSize/numWorkers),
 		}
 	}
@@ -216,6 +221,22 @@ func (em *eventMachine) handleEvent(e event, w *eventMachineWorker) {
 		em.handleEventWithObservability("onTraceRemoved", func() error {
 			return em.onTraceRemoved(payload)
 		})
+	case traceQuiet:
+		if em.onTraceQuiet == nil {
+			em.logger.Debug("onTraceQuiet not set, skipping event")
+			em.callOnError(e)
+			return
+		}
+		payload, ok := e.payload.(pcommon.TraceID)
+		if !ok {
+			// the payload had an unexpected type!
+			em.callOnError(e)
+			return
+		}
+
+		em.handleEventWithObservability("onTraceQuiet", func() error {
+			return em.onTraceQuiet(payload, w)
+		})
 	default:
 		em.logger.Info("unknown event type", zap.Any("event", e.typ))
 		em.callOnError(e)
@@ -318,6 +339,9 @@ type eventMachineWorker struct {
 	// the ring buffer holds the IDs for all the in-flight traces
 	buffer *ringBuffer
 
+	// the state of the in-flight traces, used to release them before the wait duration expires
+	traces map[pcommon.TraceID]*traceState
+
 	events chan event
 }
 
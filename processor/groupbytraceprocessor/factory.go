@@ -36,10 +36,12 @@ const (
 	defaultNumWorkers     = 1
 	defaultDiscardOrphans = false
 	defaultStoreOnDisk    = false
+
+	defaultRequireRootSpan = true
 )
 
 var (
-	errDiskStorageNotSupported    = fmt.Errorf("option 'disk storage' not supported in this release")
+	errDiskStorageNotSupported    = fmt.Errorf("option 'disk storage' not supported in this release, use 'storage' instead")
 	errDiscardOrphansNotSupported = fmt.Errorf("option 'discard orphans' not supported in this release")
 )
 
@@ -62,6 +64,10 @@ func createDefaultConfig() config.Processor {
 		NumWorkers:        defaultNumWorkers,
 		WaitDuration:      defaultWaitDuration,
 
+		EarlyRelease: EarlyReleaseConfig{
+			RequireRootSpan: defaultRequireRootSpan,
+		},
+
 		// not supported for now
 		DiscardOrphans: defaultDiscardOrphans,
 		StoreOnDisk:    defaultStoreOnDisk,
@@ -85,8 +91,11 @@ func createTracesProcessor(
 		return nil, errDiscardOrphansNotSupported
 	}
 
-	// the only supported storage for now
-	st = newMemoryStorage()
+	if oCfg.Storage != nil {
+		st = newPersistentStorage(*oCfg.Storage, oCfg.ID())
+	} else {
+		st = newMemoryStorage()
+	}
 
 	return newGroupByTraceProcessor(params.Logger, st, nextConsumer, *oCfg), nil
 }
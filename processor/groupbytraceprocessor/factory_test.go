@@ -32,6 +32,8 @@ func TestDefaultConfiguration(t *testing.T) {
 	assert.Equal(t, defaultWaitDuration, c.WaitDuration)
 	assert.Equal(t, defaultDiscardOrphans, c.DiscardOrphans)
 	assert.Equal(t, defaultStoreOnDisk, c.StoreOnDisk)
+	assert.Equal(t, defaultRequireRootSpan, c.EarlyRelease.RequireRootSpan)
+	assert.Nil(t, c.Storage)
 }
 
 func TestCreateTestProcessor(t *testing.T) {
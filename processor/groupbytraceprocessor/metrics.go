@@ -29,6 +29,7 @@ var (
 	mReleasedSpans      = stats.Int64("processor_groupbytrace_spans_released", "Spans released to the next consumer", stats.UnitDimensionless)
 	mReleasedTraces     = stats.Int64("processor_groupbytrace_traces_released", "Traces released to the next consumer", stats.UnitDimensionless)
 	mIncompleteReleases = stats.Int64("processor_groupbytrace_incomplete_releases", "Releases that are suspected to have been incomplete", stats.UnitDimensionless)
+	mEarlyReleases      = stats.Int64("processor_groupbytrace_early_releases", "Traces released before the wait duration expired, as they were considered complete", stats.UnitDimensionless)
 	mEventLatency       = stats.Int64("processor_groupbytrace_event_latency", "How long the queue events are taking to be processed", stats.UnitMilliseconds)
 )
 
@@ -78,6 +79,12 @@ func MetricViews() []*view.View {
 			Description: mIncompleteReleases.Description(),
 			Aggregation: view.Sum(),
 		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(typeStr), mEarlyReleases.Name()),
+			Measure:     mEarlyReleases,
+			Description: mEarlyReleases.Description(),
+			Aggregation: view.Sum(),
+		},
 		{
 			Name:        obsreport.BuildProcessorCustomMetricName(string(typeStr), mEventLatency.Name()),
 			Measure:     mEventLatency,
@@ -76,6 +76,7 @@ func newGroupByTraceProcessor(logger *zap.Logger, st storage, nextConsumer consu
 	eventMachine.onTraceExpired = sp.onTraceExpired
 	eventMachine.onTraceReleased = sp.onTraceReleased
 	eventMachine.onTraceRemoved = sp.onTraceRemoved
+	eventMachine.onTraceQuiet = sp.onTraceQuiet
 
 	return sp
 }
@@ -93,14 +94,49 @@ func (sp *groupByTraceProcessor) Capabilities() consumer.Capabilities {
 }
 
 // Start is invoked during service startup.
-func (sp *groupByTraceProcessor) Start(context.Context, component.Host) error {
+func (sp *groupByTraceProcessor) Start(ctx context.Context, host component.Host) error {
 	// start these metrics, as it might take a while for them to receive their first event
 	stats.Record(context.Background(), mTracesEvicted.M(0))
 	stats.Record(context.Background(), mIncompleteReleases.M(0))
+	stats.Record(context.Background(), mEarlyReleases.M(0))
 	stats.Record(context.Background(), mNumTracesConf.M(int64(sp.config.NumTraces)))
 
+	if err := sp.st.start(ctx, host); err != nil {
+		return err
+	}
 	sp.eventMachine.startInBackground()
-	return sp.st.start()
+	sp.recoverTraces()
+	return nil
+}
+
+// recoverTraces schedules the traces found in the storage when starting, i.e. the traces
+// persisted before a restart, as if they had just been received.
+func (sp *groupByTraceProcessor) recoverTraces() {
+	traceIDs := sp.st.traceIDs()
+	if len(traceIDs) == 0 {
+		return
+	}
+	sp.logger.Info("recovering traces from the storage", zap.Int("traces", len(traceIDs)))
+
+	for _, traceID := range traceIDs {
+		// the trace is stored again once it's received by the event machine
+		rss, err := sp.st.delete(traceID)
+		if err != nil {
+			sp.logger.Warn("couldn't recover trace from the storage", zap.String("traceID", traceID.HexString()), zap.Error(err))
+			continue
+		}
+		if len(rss) == 0 {
+			continue
+		}
+
+		trace := ptrace.NewTraces()
+		for _, rs := range rss {
+			rs.MoveTo(trace.ResourceSpans().AppendEmpty())
+		}
+		if err = sp.eventMachine.consume(trace); err != nil {
+			sp.logger.Warn("couldn't recover trace from the storage", zap.String("traceID", traceID.HexString()), zap.Error(err))
+		}
+	}
 }
 
 // Shutdown is invoked during service shutdown.
@@ -118,6 +154,7 @@ func (sp *groupByTraceProcessor) onTraceReceived(trace tracesWithID, worker *eve
 		if err := sp.addSpans(traceID, trace.td); err != nil {
 			return fmt.Errorf("couldn't add spans to existing trace: %w", err)
 		}
+		sp.spansReceived(traceID, trace.td, worker)
 
 		// we are done with this trace, move on
 		return nil
@@ -134,6 +171,10 @@ func (sp *groupByTraceProcessor) onTraceReceived(trace tracesWithID, worker *eve
 			typ:     traceRemoved,
 			payload: evicted,
 		})
+		if state, ok := worker.traces[evicted]; ok && state.quiet != nil {
+			state.quiet.Stop()
+		}
+		delete(worker.traces, evicted)
 
 		stats.Record(context.Background(), mTracesEvicted.M(1))
 
@@ -148,13 +189,66 @@ func (sp *groupByTraceProcessor) onTraceReceived(trace tracesWithID, worker *eve
 
 	sp.logger.Debug("scheduled to release trace", zap.Duration("duration", sp.config.WaitDuration))
 
-	time.AfterFunc(sp.config.WaitDuration, func() {
+	expiry := time.AfterFunc(sp.config.WaitDuration, func() {
 		// if the event machine has stopped, it will just discard the event
 		worker.fire(event{
 			typ:     traceExpired,
 			payload: traceID,
 		})
 	})
+	worker.traces[traceID] = &traceState{expiry: expiry}
+	sp.spansReceived(traceID, trace.td, worker)
+	return nil
+}
+
+// spansReceived updates the state of the trace with the received spans, and schedules the check
+// of the early release once no spans were received for the quiet period.
+func (sp *groupByTraceProcessor) spansReceived(traceID pcommon.TraceID, td ptrace.Traces, worker *eventMachineWorker) {
+	quietPeriod := sp.config.EarlyRelease.QuietPeriod
+	state, ok := worker.traces[traceID]
+	if !ok || quietPeriod <= 0 {
+		return
+	}
+
+	state.lastReceived = time.Now()
+	if !state.rootSpanReceived {
+		state.rootSpanReceived = hasRootSpan(td)
+	}
+
+	if state.quiet != nil {
+		state.quiet.Reset(quietPeriod)
+		return
+	}
+	state.quiet = time.AfterFunc(quietPeriod, func() {
+		worker.fire(event{
+			typ:     traceQuiet,
+			payload: traceID,
+		})
+	})
+}
+
+// onTraceQuiet releases the trace if it's considered complete, i.e. no spans were received for
+// the quiet period and, unless not required, its root span was received.
+func (sp *groupByTraceProcessor) onTraceQuiet(traceID pcommon.TraceID, worker *eventMachineWorker) error {
+	state, ok := worker.traces[traceID]
+	if !ok || !worker.buffer.contains(traceID) {
+		// the trace was released or evicted in the meantime
+		return nil
+	}
+	if time.Since(state.lastReceived) < sp.config.EarlyRelease.QuietPeriod {
+		// spans were received after the check was scheduled, a new check is scheduled already
+		return nil
+	}
+	if sp.config.EarlyRelease.RequireRootSpan && !state.rootSpanReceived {
+		sp.logger.Debug("root span not received yet, waiting for the trace to expire",
+			zap.String("traceID", traceID.HexString()))
+		return nil
+	}
+
+	sp.logger.Debug("releasing complete trace before expiration",
+		zap.String("traceID", traceID.HexString()))
+	stats.Record(context.Background(), mEarlyReleases.M(1))
+	sp.releaseTrace(traceID, worker)
 	return nil
 }
 
@@ -172,8 +266,17 @@ func (sp *groupByTraceProcessor) onTraceExpired(traceID pcommon.TraceID, worker
 		return nil
 	}
 
+	sp.releaseTrace(traceID, worker)
+	return nil
+}
+
+func (sp *groupByTraceProcessor) releaseTrace(traceID pcommon.TraceID, worker *eventMachineWorker) {
 	// delete from the map and erase its memory entry
 	worker.buffer.delete(traceID)
+	if state, ok := worker.traces[traceID]; ok {
+		state.stop()
+		delete(worker.traces, traceID)
+	}
 
 	// this might block, but we don't need to wait
 	sp.logger.Debug("marking the trace as released",
@@ -181,8 +284,6 @@ func (sp *groupByTraceProcessor) onTraceExpired(traceID pcommon.TraceID, worker
 	go func() {
 		_ = sp.markAsReleased(traceID, worker.fire)
 	}()
-
-	return nil
 }
 
 func (sp *groupByTraceProcessor) markAsReleased(traceID pcommon.TraceID, fire func(...event)) error {
@@ -248,3 +349,38 @@ func (sp *groupByTraceProcessor) addSpans(traceID pcommon.TraceID, trace ptrace.
 	sp.logger.Debug("creating trace at the storage", zap.String("traceID", traceID.HexString()))
 	return sp.st.createOrAppend(traceID, trace)
 }
+
+// traceState holds the state of an in-flight trace, used to release it before the wait duration expires.
+type traceState struct {
+	// expiry releases the trace once the wait duration expires
+	expiry *time.Timer
+	// quiet checks whether the trace can be released once no spans were received for the quiet period
+	quiet *time.Timer
+
+	lastReceived     time.Time
+	rootSpanReceived bool
+}
+
+// stop stops the pending release and checks of the trace.
+func (s *traceState) stop() {
+	s.expiry.Stop()
+	if s.quiet != nil {
+		s.quiet.Stop()
+	}
+}
+
+func hasRootSpan(td ptrace.Traces) bool {
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		ilss := rss.At(i).ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				if spans.At(k).ParentSpanID().IsEmpty() {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
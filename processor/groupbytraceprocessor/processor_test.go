@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
@@ -541,6 +542,109 @@ func TestErrorOnProcessResourceSpansContinuesProcessing(t *testing.T) {
 	assert.True(t, returnedError)
 }
 
+func TestTraceIsReleasedEarlyAfterQuietPeriod(t *testing.T) {
+	// prepare
+	config := Config{
+		WaitDuration: time.Hour,
+		NumTraces:    10,
+		NumWorkers:   1,
+		EarlyRelease: EarlyReleaseConfig{
+			QuietPeriod:     10 * time.Millisecond,
+			RequireRootSpan: true,
+		},
+	}
+	next := new(consumertest.TracesSink)
+	p := newGroupByTraceProcessor(zap.NewNop(), newMemoryStorage(), next, config)
+
+	ctx := context.Background()
+	require.NoError(t, p.Start(ctx, nil))
+	defer func() {
+		assert.NoError(t, p.Shutdown(ctx))
+	}()
+
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	child := simpleTracesWithID(traceID)
+	child.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SetParentSpanID([8]byte{1, 2, 3, 4})
+
+	// test
+	require.NoError(t, p.ConsumeTraces(ctx, child))
+
+	// verify: the trace is held as the root span wasn't received yet
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 0, next.SpanCount())
+
+	require.NoError(t, p.ConsumeTraces(ctx, simpleTracesWithID(traceID)))
+	assert.Eventually(t, func() bool {
+		return next.SpanCount() == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Len(t, next.AllTraces(), 1)
+}
+
+func TestTraceIsReleasedEarlyWithoutRootSpan(t *testing.T) {
+	// prepare
+	config := Config{
+		WaitDuration: time.Hour,
+		NumTraces:    10,
+		NumWorkers:   1,
+		EarlyRelease: EarlyReleaseConfig{
+			QuietPeriod: 10 * time.Millisecond,
+		},
+	}
+	next := new(consumertest.TracesSink)
+	p := newGroupByTraceProcessor(zap.NewNop(), newMemoryStorage(), next, config)
+
+	ctx := context.Background()
+	require.NoError(t, p.Start(ctx, nil))
+	defer func() {
+		assert.NoError(t, p.Shutdown(ctx))
+	}()
+
+	child := simpleTraces()
+	child.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SetParentSpanID([8]byte{1, 2, 3, 4})
+
+	// test
+	require.NoError(t, p.ConsumeTraces(ctx, child))
+
+	// verify
+	assert.Eventually(t, func() bool {
+		return next.SpanCount() == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestTracesAreRecoveredFromPersistentStorage(t *testing.T) {
+	// prepare
+	client := &mapStorageClient{data: map[string][]byte{}}
+	previous := newStartedPersistentStorage(t, client)
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	require.NoError(t, previous.createOrAppend(traceID, simpleTracesWithID(traceID)))
+	require.NoError(t, previous.shutdown())
+
+	config := Config{
+		WaitDuration: time.Millisecond,
+		NumTraces:    10,
+		NumWorkers:   1,
+	}
+	next := new(consumertest.TracesSink)
+	st := newPersistentStorage(testStorageID, config.ID())
+	p := newGroupByTraceProcessor(zap.NewNop(), st, next, config)
+
+	// test
+	ctx := context.Background()
+	require.NoError(t, p.Start(ctx, newTestStorageHost(client)))
+	defer func() {
+		assert.NoError(t, p.Shutdown(ctx))
+	}()
+
+	// verify
+	assert.Eventually(t, func() bool {
+		return next.SpanCount() == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, traceID, next.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).TraceID())
+	assert.Eventually(t, func() bool {
+		return client.len() == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
 func TestAsyncOnRelease(t *testing.T) {
 	blockCh := make(chan struct{})
 	blocker := &blockingConsumer{
@@ -616,6 +720,7 @@ type mockStorage struct {
 	onCreateOrAppend func(pcommon.TraceID, ptrace.Traces) error
 	onGet            func(pcommon.TraceID) ([]ptrace.ResourceSpans, error)
 	onDelete         func(pcommon.TraceID) ([]ptrace.ResourceSpans, error)
+	onTraceIDs       func() []pcommon.TraceID
 	onStart          func() error
 	onShutdown       func() error
 }
@@ -640,7 +745,13 @@ func (st *mockStorage) delete(traceID pcommon.TraceID) ([]ptrace.ResourceSpans,
 	}
 	return nil, nil
 }
-func (st *mockStorage) start() error {
+func (st *mockStorage) traceIDs() []pcommon.TraceID {
+	if st.onTraceIDs != nil {
+		return st.onTraceIDs()
+	}
+	return nil
+}
+func (st *mockStorage) start(context.Context, component.Host) error {
 	if st.onStart != nil {
 		return st.onStart()
 	}
@@ -0,0 +1,299 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupbytraceprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor"
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	extstorage "go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	// indexRangeKey is the key of the range of the index slots which may hold a trace
+	indexRangeKey = "index_range"
+	// indexReadBatchSize is the number of index slots read at once when the storage starts
+	indexReadBatchSize = 1000
+)
+
+// persistentStorage keeps the traces in a storage extension, so that the traces waiting to be released
+// survive a restart of the collector. Each batch of spans received for a trace is stored under its own
+// key, and the batches are merged when the trace is read. The stored traces are indexed by slots
+// numbered in sequence, each holding a trace ID and its number of batches, so that neither a new trace
+// nor a new batch rewrites more than a fixed number of keys. The range of the slots in use is stored as
+// well, so that the index can be read back when the processor starts.
+type persistentStorage struct {
+	storageID   config.ComponentID
+	processorID config.ComponentID
+	marshaler   ptrace.Marshaler
+	unmarshaler ptrace.Unmarshaler
+
+	// mu serializes the updates of the stored traces and of the index
+	mu     sync.Mutex
+	client extstorage.Client
+	traces map[pcommon.TraceID]*storedTrace
+	// slots holds the index slots in use, every slot below lowestSlot is free
+	slots      map[uint64]struct{}
+	lowestSlot uint64
+	nextSlot   uint64
+}
+
+// storedTrace is the index entry of a stored trace
+type storedTrace struct {
+	slot    uint64
+	batches uint64
+}
+
+var _ storage = (*persistentStorage)(nil)
+
+func newPersistentStorage(storageID config.ComponentID, processorID config.ComponentID) *persistentStorage {
+	return &persistentStorage{
+		storageID:   storageID,
+		processorID: processorID,
+		marshaler:   ptrace.NewProtoMarshaler(),
+		unmarshaler: ptrace.NewProtoUnmarshaler(),
+		traces:      make(map[pcommon.TraceID]*storedTrace),
+		slots:       make(map[uint64]struct{}),
+	}
+}
+
+func batchKey(traceID pcommon.TraceID, batch uint64) string {
+	return fmt.Sprintf("trace/%s/%d", traceID.HexString(), batch)
+}
+
+func slotKey(slot uint64) string {
+	return fmt.Sprintf("index/%d", slot)
+}
+
+// slotValue encodes the trace ID and the number of batches of an index slot
+func slotValue(traceID pcommon.TraceID, batches uint64) []byte {
+	data := make([]byte, len(traceID)+8)
+	copy(data, traceID[:])
+	binary.BigEndian.PutUint64(data[len(traceID):], batches)
+	return data
+}
+
+// rangeValue encodes the range of the index slots in use
+func rangeValue(lowest, next uint64) []byte {
+	data := make([]byte, 16)
+	binary.BigEndian.PutUint64(data, lowest)
+	binary.BigEndian.PutUint64(data[8:], next)
+	return data
+}
+
+func (st *persistentStorage) start(ctx context.Context, host component.Host) error {
+	extension, ok := host.GetExtensions()[st.storageID]
+	if !ok {
+		return fmt.Errorf("storage extension '%s' not found", st.storageID)
+	}
+	storageExtension, ok := extension.(extstorage.Extension)
+	if !ok {
+		return fmt.Errorf("non-storage extension '%s' found", st.storageID)
+	}
+	client, err := storageExtension.GetClient(ctx, component.KindProcessor, st.processorID, "")
+	if err != nil {
+		return fmt.Errorf("failed to get storage client: %w", err)
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.client = client
+	if err = st.readIndex(ctx); err != nil {
+		return fmt.Errorf("failed to read the index of the stored traces: %w", err)
+	}
+	return nil
+}
+
+// readIndex loads the index slots in the stored range, the caller must hold the lock
+func (st *persistentStorage) readIndex(ctx context.Context) error {
+	data, err := st.client.Get(ctx, indexRangeKey)
+	if err != nil {
+		return err
+	}
+	if len(data) != 16 {
+		return nil
+	}
+	st.lowestSlot = binary.BigEndian.Uint64(data)
+	st.nextSlot = binary.BigEndian.Uint64(data[8:])
+
+	for first := st.lowestSlot; first < st.nextSlot; first += indexReadBatchSize {
+		ops := make([]extstorage.Operation, 0, indexReadBatchSize)
+		for slot := first; slot < st.nextSlot && slot < first+indexReadBatchSize; slot++ {
+			ops = append(ops, extstorage.GetOperation(slotKey(slot)))
+		}
+		if err = st.client.Batch(ctx, ops...); err != nil {
+			return err
+		}
+		for i, op := range ops {
+			if len(op.Value) != len(pcommon.TraceID{})+8 {
+				continue
+			}
+			var traceID pcommon.TraceID
+			copy(traceID[:], op.Value)
+			slot := first + uint64(i)
+			st.traces[traceID] = &storedTrace{slot: slot, batches: binary.BigEndian.Uint64(op.Value[len(traceID):])}
+			st.slots[slot] = struct{}{}
+		}
+	}
+	return nil
+}
+
+func (st *persistentStorage) shutdown() error {
+	if st.client == nil {
+		return nil
+	}
+	return st.client.Close(context.Background())
+}
+
+func (st *persistentStorage) createOrAppend(traceID pcommon.TraceID, td ptrace.Traces) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	data, err := st.marshaler.MarshalTraces(td)
+	if err != nil {
+		return fmt.Errorf("failed to marshal trace: %w", err)
+	}
+
+	trace, ok := st.traces[traceID]
+	if !ok {
+		trace = &storedTrace{slot: st.nextSlot}
+	}
+	ops := []extstorage.Operation{
+		extstorage.SetOperation(batchKey(traceID, trace.batches), data),
+		extstorage.SetOperation(slotKey(trace.slot), slotValue(traceID, trace.batches+1)),
+	}
+	if !ok {
+		ops = append(ops, extstorage.SetOperation(indexRangeKey, rangeValue(st.lowestSlot, st.nextSlot+1)))
+	}
+	if err = st.client.Batch(context.Background(), ops...); err != nil {
+		return fmt.Errorf("failed to store trace: %w", err)
+	}
+
+	trace.batches++
+	if !ok {
+		st.traces[traceID] = trace
+		st.slots[trace.slot] = struct{}{}
+		st.nextSlot++
+	}
+	return nil
+}
+
+func (st *persistentStorage) get(traceID pcommon.TraceID) ([]ptrace.ResourceSpans, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	trace, ok := st.traces[traceID]
+	if !ok {
+		return nil, nil
+	}
+	return st.read(context.Background(), traceID, trace)
+}
+
+func (st *persistentStorage) delete(traceID pcommon.TraceID) ([]ptrace.ResourceSpans, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	trace, ok := st.traces[traceID]
+	if !ok {
+		return nil, nil
+	}
+	ctx := context.Background()
+	rss, err := st.read(ctx, traceID, trace)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := make([]extstorage.Operation, 0, trace.batches+2)
+	for batch := uint64(0); batch < trace.batches; batch++ {
+		ops = append(ops, extstorage.DeleteOperation(batchKey(traceID, batch)))
+	}
+	ops = append(ops, extstorage.DeleteOperation(slotKey(trace.slot)))
+	// the lowest slot in use moves up once the slots below it are all free
+	lowest := st.lowestSlot
+	for lowest < st.nextSlot {
+		if _, used := st.slots[lowest]; used && lowest != trace.slot {
+			break
+		}
+		lowest++
+	}
+	next := st.nextSlot
+	switch {
+	case lowest == next:
+		// no slot is in use anymore, the numbering starts over
+		lowest, next = 0, 0
+		ops = append(ops, extstorage.DeleteOperation(indexRangeKey))
+	case lowest != st.lowestSlot:
+		ops = append(ops, extstorage.SetOperation(indexRangeKey, rangeValue(lowest, next)))
+	}
+	if err = st.client.Batch(ctx, ops...); err != nil {
+		return nil, fmt.Errorf("failed to delete trace: %w", err)
+	}
+
+	delete(st.traces, traceID)
+	delete(st.slots, trace.slot)
+	st.lowestSlot, st.nextSlot = lowest, next
+	return rss, nil
+}
+
+func (st *persistentStorage) traceIDs() []pcommon.TraceID {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	traceIDs := make([]pcommon.TraceID, 0, len(st.traces))
+	for traceID := range st.traces {
+		traceIDs = append(traceIDs, traceID)
+	}
+	return traceIDs
+}
+
+// read returns the resource spans of the stored batches of the trace, in the order they were received.
+// The batches missing from the storage are skipped.
+func (st *persistentStorage) read(ctx context.Context, traceID pcommon.TraceID, trace *storedTrace) ([]ptrace.ResourceSpans, error) {
+	ops := make([]extstorage.Operation, 0, trace.batches)
+	for batch := uint64(0); batch < trace.batches; batch++ {
+		ops = append(ops, extstorage.GetOperation(batchKey(traceID, batch)))
+	}
+	if err := st.client.Batch(ctx, ops...); err != nil {
+		return nil, fmt.Errorf("failed to read trace: %w", err)
+	}
+
+	result := make([]ptrace.ResourceSpans, 0, len(ops))
+	for _, op := range ops {
+		if op.Value == nil {
+			continue
+		}
+		td, err := st.unmarshaler.UnmarshalTraces(op.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal trace: %w", err)
+		}
+		result = append(result, resourceSpans(td)...)
+	}
+	return result, nil
+}
+
+func resourceSpans(trace ptrace.Traces) []ptrace.ResourceSpans {
+	result := make([]ptrace.ResourceSpans, 0, trace.ResourceSpans().Len())
+	for i := 0; i < trace.ResourceSpans().Len(); i++ {
+		result = append(result, trace.ResourceSpans().At(i))
+	}
+	return result
+}
@@ -0,0 +1,220 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupbytraceprocessor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	extstorage "go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+type mapStorageClient struct {
+	sync.Mutex
+	data map[string][]byte
+	// writes counts the keys set or deleted
+	writes int
+}
+
+func (c *mapStorageClient) Get(_ context.Context, key string) ([]byte, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.data[key], nil
+}
+
+func (c *mapStorageClient) Set(_ context.Context, key string, value []byte) error {
+	c.Lock()
+	defer c.Unlock()
+	c.data[key] = value
+	c.writes++
+	return nil
+}
+
+func (c *mapStorageClient) Delete(_ context.Context, key string) error {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.data, key)
+	c.writes++
+	return nil
+}
+
+func (c *mapStorageClient) Batch(_ context.Context, ops ...extstorage.Operation) error {
+	c.Lock()
+	defer c.Unlock()
+	for _, op := range ops {
+		switch op.Type {
+		case extstorage.Get:
+			op.Value = c.data[op.Key]
+		case extstorage.Set:
+			c.data[op.Key] = op.Value
+			c.writes++
+		case extstorage.Delete:
+			delete(c.data, op.Key)
+			c.writes++
+		default:
+			return errors.New("wrong operation type")
+		}
+	}
+	return nil
+}
+
+func (c *mapStorageClient) Close(context.Context) error {
+	return nil
+}
+
+func (c *mapStorageClient) len() int {
+	c.Lock()
+	defer c.Unlock()
+	return len(c.data)
+}
+
+type mapStorageExtension struct {
+	client *mapStorageClient
+}
+
+func (e *mapStorageExtension) Start(context.Context, component.Host) error {
+	return nil
+}
+
+func (e *mapStorageExtension) Shutdown(context.Context) error {
+	return nil
+}
+
+func (e *mapStorageExtension) GetClient(context.Context, component.Kind, config.ComponentID, string) (extstorage.Client, error) {
+	return e.client, nil
+}
+
+type storageHost struct {
+	component.Host
+	extensions map[config.ComponentID]component.Extension
+}
+
+func (h *storageHost) GetExtensions() map[config.ComponentID]component.Extension {
+	return h.extensions
+}
+
+var testStorageID = config.NewComponentID("file_storage")
+
+func newTestStorageHost(client *mapStorageClient) component.Host {
+	return &storageHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[config.ComponentID]component.Extension{testStorageID: &mapStorageExtension{client: client}},
+	}
+}
+
+func newStartedPersistentStorage(t *testing.T, client *mapStorageClient) *persistentStorage {
+	st := newPersistentStorage(testStorageID, config.NewComponentID(typeStr))
+	require.NoError(t, st.start(context.Background(), newTestStorageHost(client)))
+	return st
+}
+
+func TestPersistentStorageCreateAndAppend(t *testing.T) {
+	client := &mapStorageClient{data: map[string][]byte{}}
+	st := newStartedPersistentStorage(t, client)
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+
+	assert.NoError(t, st.createOrAppend(traceID, simpleTracesWithID(traceID)))
+	assert.NoError(t, st.createOrAppend(traceID, simpleTracesWithID(traceID)))
+
+	rss, err := st.get(traceID)
+	require.NoError(t, err)
+	assert.Len(t, rss, 2)
+	assert.Equal(t, traceID, rss[1].ScopeSpans().At(0).Spans().At(0).TraceID())
+	assert.Equal(t, []pcommon.TraceID{traceID}, st.traceIDs())
+	assert.NoError(t, st.shutdown())
+}
+
+func TestPersistentStorageSurvivesRestart(t *testing.T) {
+	client := &mapStorageClient{data: map[string][]byte{}}
+	st := newStartedPersistentStorage(t, client)
+	traceID1 := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	traceID2 := pcommon.TraceID([16]byte{5, 6, 7, 8})
+	require.NoError(t, st.createOrAppend(traceID1, simpleTracesWithID(traceID1)))
+	require.NoError(t, st.createOrAppend(traceID2, simpleTracesWithID(traceID2)))
+	require.NoError(t, st.shutdown())
+
+	restarted := newStartedPersistentStorage(t, client)
+	assert.ElementsMatch(t, []pcommon.TraceID{traceID1, traceID2}, restarted.traceIDs())
+
+	rss, err := restarted.delete(traceID1)
+	require.NoError(t, err)
+	require.Len(t, rss, 1)
+	assert.Equal(t, traceID1, rss[0].ScopeSpans().At(0).Spans().At(0).TraceID())
+
+	rss, err = restarted.get(traceID1)
+	require.NoError(t, err)
+	assert.Nil(t, rss)
+
+	// only the second trace, its index slot and the index range are left
+	assert.Equal(t, []pcommon.TraceID{traceID2}, restarted.traceIDs())
+	assert.Equal(t, 3, client.len())
+
+	_, err = restarted.delete(traceID2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, client.len())
+}
+
+func TestPersistentStorageWritesPerBatch(t *testing.T) {
+	client := &mapStorageClient{data: map[string][]byte{}}
+	st := newStartedPersistentStorage(t, client)
+
+	for i := 0; i < 10; i++ {
+		traceID := pcommon.TraceID([16]byte{byte(i)})
+		writes := client.writes
+		require.NoError(t, st.createOrAppend(traceID, simpleTracesWithID(traceID)))
+		// the batch, the index slot and the index range
+		assert.Equal(t, 3, client.writes-writes)
+	}
+
+	traceID := pcommon.TraceID([16]byte{1})
+	for i := 0; i < 10; i++ {
+		writes := client.writes
+		require.NoError(t, st.createOrAppend(traceID, simpleTracesWithID(traceID)))
+		// the batch and the index slot
+		assert.Equal(t, 2, client.writes-writes)
+	}
+
+	rss, err := st.get(traceID)
+	require.NoError(t, err)
+	assert.Len(t, rss, 11)
+
+	restarted := newStartedPersistentStorage(t, client)
+	rss, err = restarted.get(traceID)
+	require.NoError(t, err)
+	assert.Len(t, rss, 11)
+	assert.Len(t, restarted.traceIDs(), 10)
+}
+
+func TestPersistentStorageDeleteUnknownTrace(t *testing.T) {
+	client := &mapStorageClient{data: map[string][]byte{}}
+	st := newStartedPersistentStorage(t, client)
+
+	rss, err := st.delete(pcommon.TraceID([16]byte{1, 2, 3, 4}))
+	assert.NoError(t, err)
+	assert.Nil(t, rss)
+}
+
+func TestPersistentStorageMissingExtension(t *testing.T) {
+	st := newPersistentStorage(testStorageID, config.NewComponentID(typeStr))
+	assert.EqualError(t, st.start(context.Background(), componenttest.NewNopHost()), "storage extension 'file_storage' not found")
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intervalprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/intervalprocessor"
+
+import (
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/identity"
+)
+
+// metricKey returns the key identifying the metric within the scope of the given key.
+func metricKey(scopeKey string, metric pmetric.Metric) string {
+	var b strings.Builder
+	b.WriteString(scopeKey)
+	b.WriteString(identity.Separator)
+	b.WriteString(metric.Type().String())
+	b.WriteString(identity.Separator)
+	b.WriteString(metric.Name())
+	b.WriteString(identity.Separator)
+	b.WriteString(metric.Unit())
+	switch metric.Type() {
+	case pmetric.MetricTypeSum:
+		b.WriteString(identity.Separator)
+		b.WriteString(metric.Sum().AggregationTemporality().String())
+		if metric.Sum().IsMonotonic() {
+			b.WriteByte('Y')
+		} else {
+			b.WriteByte('N')
+		}
+	case pmetric.MetricTypeHistogram:
+		b.WriteString(identity.Separator)
+		b.WriteString(metric.Histogram().AggregationTemporality().String())
+	case pmetric.MetricTypeExponentialHistogram:
+		b.WriteString(identity.Separator)
+		b.WriteString(metric.ExponentialHistogram().AggregationTemporality().String())
+	}
+	return b.String()
+}
+
+// dataPointKey returns the key identifying the series of the data point with the given
+// attributes within the metric of the given key.
+func dataPointKey(metricKey string, attributes pcommon.Map) string {
+	var b strings.Builder
+	b.WriteString(metricKey)
+	identity.WriteAttributes(&b, attributes)
+	return b.String()
+}
+
+// histogramDataPointKey returns the key identifying the series of the histogram data point.
+// The explicit bounds are part of the key, so only the data points with the same buckets
+// are merged together.
+func histogramDataPointKey(metricKey string, dp pmetric.HistogramDataPoint) string {
+	var b strings.Builder
+	b.WriteString(dataPointKey(metricKey, dp.Attributes()))
+	b.WriteString(identity.Separator)
+	for i := 0; i < dp.ExplicitBounds().Len(); i++ {
+		b.WriteString(strconv.FormatFloat(dp.ExplicitBounds().At(i), 'g', -1, 64))
+		b.WriteByte(',')
+	}
+	return b.String()
+}
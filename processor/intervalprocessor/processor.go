@@ -0,0 +1,306 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intervalprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/intervalprocessor"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/identity"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/timeutils"
+)
+
+type intervalProcessor struct {
+	logger       *zap.Logger
+	nextConsumer consumer.Metrics
+	passThrough  PassThrough
+	exporter     *timeutils.PeriodicExporter
+
+	mu sync.Mutex
+	// md holds the metrics aggregated during the current interval. The lookup maps below
+	// index its resources, scopes, metrics and data points by their identity.
+	md                     pmetric.Metrics
+	resourceMetrics        map[string]pmetric.ResourceMetrics
+	scopeMetrics           map[string]pmetric.ScopeMetrics
+	metrics                map[string]pmetric.Metric
+	numberDataPoints       map[string]pmetric.NumberDataPoint
+	histogramDataPoints    map[string]pmetric.HistogramDataPoint
+	expHistogramDataPoints map[string]pmetric.ExponentialHistogramDataPoint
+	summaryDataPoints      map[string]pmetric.SummaryDataPoint
+}
+
+func newIntervalProcessor(config *Config, logger *zap.Logger, nextConsumer consumer.Metrics) *intervalProcessor {
+	p := &intervalProcessor{
+		logger:       logger,
+		nextConsumer: nextConsumer,
+		passThrough:  config.PassThrough,
+	}
+	p.exporter = timeutils.NewPeriodicExporter(config.Interval, p.export)
+	p.reset()
+	return p
+}
+
+// reset starts a new interval with no aggregated metrics.
+func (p *intervalProcessor) reset() {
+	p.md = pmetric.NewMetrics()
+	p.resourceMetrics = make(map[string]pmetric.ResourceMetrics)
+	p.scopeMetrics = make(map[string]pmetric.ScopeMetrics)
+	p.metrics = make(map[string]pmetric.Metric)
+	p.numberDataPoints = make(map[string]pmetric.NumberDataPoint)
+	p.histogramDataPoints = make(map[string]pmetric.HistogramDataPoint)
+	p.expHistogramDataPoints = make(map[string]pmetric.ExponentialHistogramDataPoint)
+	p.summaryDataPoints = make(map[string]pmetric.SummaryDataPoint)
+}
+
+// export sends the metrics aggregated during the current interval to the next consumer,
+// and starts a new interval.
+func (p *intervalProcessor) export(ctx context.Context) {
+	p.mu.Lock()
+	md := p.md
+	p.reset()
+	p.mu.Unlock()
+
+	if md.ResourceMetrics().Len() == 0 {
+		return
+	}
+	if err := p.nextConsumer.ConsumeMetrics(ctx, md); err != nil {
+		p.logger.Error("failed to export the aggregated metrics", zap.Error(err))
+	}
+}
+
+// processMetrics implements the ProcessMetricsFunc type. The aggregated metrics are removed
+// from md and only the metrics which are passed through are returned.
+func (p *intervalProcessor) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		rk := identity.ResourceKey(rm.Resource())
+		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
+			sk := identity.ScopeKey(rk, sm.Scope())
+			sm.Metrics().RemoveIf(func(m pmetric.Metric) bool {
+				if !p.aggregates(m) {
+					return false
+				}
+				mk := metricKey(sk, m)
+				out := p.getMetric(rk, sk, mk, rm, sm, m)
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					p.aggregateNumberDataPoints(mk, m.Gauge().DataPoints(), out.Gauge().DataPoints(), false)
+				case pmetric.MetricTypeSum:
+					delta := m.Sum().AggregationTemporality() == pmetric.AggregationTemporalityDelta
+					p.aggregateNumberDataPoints(mk, m.Sum().DataPoints(), out.Sum().DataPoints(), delta)
+				case pmetric.MetricTypeHistogram:
+					delta := m.Histogram().AggregationTemporality() == pmetric.AggregationTemporalityDelta
+					p.aggregateHistogramDataPoints(mk, m.Histogram().DataPoints(), out.Histogram().DataPoints(), delta)
+				case pmetric.MetricTypeExponentialHistogram:
+					p.aggregateExpHistogramDataPoints(mk, m.ExponentialHistogram().DataPoints(), out.ExponentialHistogram().DataPoints())
+				case pmetric.MetricTypeSummary:
+					p.aggregateSummaryDataPoints(mk, m.Summary().DataPoints(), out.Summary().DataPoints())
+				}
+				return true
+			})
+			return sm.Metrics().Len() == 0
+		})
+		return rm.ScopeMetrics().Len() == 0
+	})
+
+	if md.ResourceMetrics().Len() == 0 {
+		return md, processorhelper.ErrSkipProcessingData
+	}
+	return md, nil
+}
+
+// aggregates returns whether the data points of the metric are aggregated over the interval.
+// Delta exponential histograms are passed through, since merging them requires their scales to match.
+func (p *intervalProcessor) aggregates(m pmetric.Metric) bool {
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		return !p.passThrough.Gauge
+	case pmetric.MetricTypeSummary:
+		return !p.passThrough.Summary
+	case pmetric.MetricTypeSum, pmetric.MetricTypeHistogram:
+		return true
+	case pmetric.MetricTypeExponentialHistogram:
+		return m.ExponentialHistogram().AggregationTemporality() == pmetric.AggregationTemporalityCumulative
+	default:
+		return false
+	}
+}
+
+// getMetric returns the aggregated metric of the given key, creating it along with its
+// resource and scope if they don't exist yet.
+func (p *intervalProcessor) getMetric(rk, sk, mk string, rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, m pmetric.Metric) pmetric.Metric {
+	if out, ok := p.metrics[mk]; ok {
+		return out
+	}
+
+	outSM, ok := p.scopeMetrics[sk]
+	if !ok {
+		outRM, ok := p.resourceMetrics[rk]
+		if !ok {
+			outRM = p.md.ResourceMetrics().AppendEmpty()
+			rm.Resource().CopyTo(outRM.Resource())
+			outRM.SetSchemaUrl(rm.SchemaUrl())
+			p.resourceMetrics[rk] = outRM
+		}
+		outSM = outRM.ScopeMetrics().AppendEmpty()
+		sm.Scope().CopyTo(outSM.Scope())
+		outSM.SetSchemaUrl(sm.SchemaUrl())
+		p.scopeMetrics[sk] = outSM
+	}
+
+	out := outSM.Metrics().AppendEmpty()
+	out.SetName(m.Name())
+	out.SetDescription(m.Description())
+	out.SetUnit(m.Unit())
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		out.SetEmptyGauge()
+	case pmetric.MetricTypeSum:
+		out.SetEmptySum().SetAggregationTemporality(m.Sum().AggregationTemporality())
+		out.Sum().SetIsMonotonic(m.Sum().IsMonotonic())
+	case pmetric.MetricTypeHistogram:
+		out.SetEmptyHistogram().SetAggregationTemporality(m.Histogram().AggregationTemporality())
+	case pmetric.MetricTypeExponentialHistogram:
+		out.SetEmptyExponentialHistogram().SetAggregationTemporality(m.ExponentialHistogram().AggregationTemporality())
+	case pmetric.MetricTypeSummary:
+		out.SetEmptySummary()
+	}
+	p.metrics[mk] = out
+	return out
+}
+
+// aggregateNumberDataPoints keeps the latest data point of each series, or adds up the
+// data points of each series if delta is true. A change of the value type of a delta series
+// resets its aggregation to the data point.
+func (p *intervalProcessor) aggregateNumberDataPoints(mk string, from, to pmetric.NumberDataPointSlice, delta bool) {
+	for i := 0; i < from.Len(); i++ {
+		dp := from.At(i)
+		key := dataPointKey(mk, dp.Attributes())
+		acc, ok := p.numberDataPoints[key]
+		switch {
+		case !ok:
+			acc = to.AppendEmpty()
+			dp.CopyTo(acc)
+			p.numberDataPoints[key] = acc
+		case delta && acc.ValueType() != dp.ValueType():
+			p.logger.Debug("resetting the aggregation of a series whose value type changed",
+				zap.Stringer("value_type", dp.ValueType()))
+			dp.CopyTo(acc)
+		case delta:
+			switch dp.ValueType() {
+			case pmetric.NumberDataPointValueTypeInt:
+				acc.SetIntValue(acc.IntValue() + dp.IntValue())
+			case pmetric.NumberDataPointValueTypeDouble:
+				acc.SetDoubleValue(acc.DoubleValue() + dp.DoubleValue())
+			}
+			dp.Exemplars().MoveAndAppendTo(acc.Exemplars())
+			mergeTimestamps(acc, dp)
+		case dp.Timestamp() >= acc.Timestamp():
+			dp.CopyTo(acc)
+		}
+	}
+}
+
+// aggregateHistogramDataPoints keeps the latest data point of each series, or merges the
+// data points of each series if delta is true.
+func (p *intervalProcessor) aggregateHistogramDataPoints(mk string, from, to pmetric.HistogramDataPointSlice, delta bool) {
+	for i := 0; i < from.Len(); i++ {
+		dp := from.At(i)
+		key := histogramDataPointKey(mk, dp)
+		acc, ok := p.histogramDataPoints[key]
+		switch {
+		case !ok:
+			acc = to.AppendEmpty()
+			dp.CopyTo(acc)
+			p.histogramDataPoints[key] = acc
+		case delta:
+			acc.SetCount(acc.Count() + dp.Count())
+			if dp.HasSum() {
+				acc.SetSum(acc.Sum() + dp.Sum())
+			}
+			if dp.HasMin() && (!acc.HasMin() || dp.Min() < acc.Min()) {
+				acc.SetMin(dp.Min())
+			}
+			if dp.HasMax() && (!acc.HasMax() || dp.Max() > acc.Max()) {
+				acc.SetMax(dp.Max())
+			}
+			counts := acc.BucketCounts()
+			for i := 0; i < counts.Len() && i < dp.BucketCounts().Len(); i++ {
+				counts.SetAt(i, counts.At(i)+dp.BucketCounts().At(i))
+			}
+			dp.Exemplars().MoveAndAppendTo(acc.Exemplars())
+			mergeTimestamps(acc, dp)
+		case dp.Timestamp() >= acc.Timestamp():
+			dp.CopyTo(acc)
+		}
+	}
+}
+
+// aggregateExpHistogramDataPoints keeps the latest data point of each series.
+func (p *intervalProcessor) aggregateExpHistogramDataPoints(mk string, from, to pmetric.ExponentialHistogramDataPointSlice) {
+	for i := 0; i < from.Len(); i++ {
+		dp := from.At(i)
+		key := dataPointKey(mk, dp.Attributes())
+		acc, ok := p.expHistogramDataPoints[key]
+		if !ok {
+			acc = to.AppendEmpty()
+			p.expHistogramDataPoints[key] = acc
+		} else if dp.Timestamp() < acc.Timestamp() {
+			continue
+		}
+		dp.CopyTo(acc)
+	}
+}
+
+// aggregateSummaryDataPoints keeps the latest data point of each series.
+func (p *intervalProcessor) aggregateSummaryDataPoints(mk string, from, to pmetric.SummaryDataPointSlice) {
+	for i := 0; i < from.Len(); i++ {
+		dp := from.At(i)
+		key := dataPointKey(mk, dp.Attributes())
+		acc, ok := p.summaryDataPoints[key]
+		if !ok {
+			acc = to.AppendEmpty()
+			p.summaryDataPoints[key] = acc
+		} else if dp.Timestamp() < acc.Timestamp() {
+			continue
+		}
+		dp.CopyTo(acc)
+	}
+}
+
+type timestamped interface {
+	StartTimestamp() pcommon.Timestamp
+	SetStartTimestamp(pcommon.Timestamp)
+	Timestamp() pcommon.Timestamp
+	SetTimestamp(pcommon.Timestamp)
+}
+
+// mergeTimestamps extends the time range of acc to cover the time range of dp.
+func mergeTimestamps(acc, dp timestamped) {
+	if dp.StartTimestamp() < acc.StartTimestamp() {
+		acc.SetStartTimestamp(dp.StartTimestamp())
+	}
+	if dp.Timestamp() > acc.Timestamp() {
+		acc.SetTimestamp(dp.Timestamp())
+	}
+}
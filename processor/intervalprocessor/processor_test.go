@@ -0,0 +1,232 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intervalprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/zap"
+)
+
+func newTestProcessor(passThrough PassThrough) (*intervalProcessor, *consumertest.MetricsSink) {
+	sink := &consumertest.MetricsSink{}
+	return newIntervalProcessor(&Config{Interval: time.Minute, PassThrough: passThrough}, zap.NewNop(), sink), sink
+}
+
+type point struct {
+	path      string
+	start     pcommon.Timestamp
+	timestamp pcommon.Timestamp
+	value     int64
+}
+
+func sumMetrics(temporality pmetric.AggregationTemporality, points ...point) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "test")
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("requests")
+	m.SetEmptySum().SetAggregationTemporality(temporality)
+	m.Sum().SetIsMonotonic(true)
+	for _, p := range points {
+		dp := m.Sum().DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(p.start)
+		dp.SetTimestamp(p.timestamp)
+		dp.SetIntValue(p.value)
+		dp.Attributes().PutStr("path", p.path)
+	}
+	return md
+}
+
+func histogramMetrics(points ...point) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "test")
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("latency")
+	m.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	for _, p := range points {
+		dp := m.Histogram().DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(p.start)
+		dp.SetTimestamp(p.timestamp)
+		dp.SetCount(uint64(p.value))
+		dp.SetSum(float64(p.value))
+		dp.SetMin(float64(p.value))
+		dp.SetMax(float64(p.value))
+		dp.ExplicitBounds().FromRaw([]float64{10})
+		dp.BucketCounts().FromRaw([]uint64{uint64(p.value), 0})
+		dp.Attributes().PutStr("path", p.path)
+	}
+	return md
+}
+
+func gaugeMetrics(value int64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("queue_size")
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(10)
+	dp.SetIntValue(value)
+	return md
+}
+
+func TestProcessCumulativeSumsKeepsLatestDataPoint(t *testing.T) {
+	p, sink := newTestProcessor(PassThrough{})
+
+	_, err := p.processMetrics(context.Background(), sumMetrics(pmetric.AggregationTemporalityCumulative,
+		point{path: "/a", start: 10, timestamp: 20, value: 3},
+		point{path: "/b", start: 10, timestamp: 20, value: 1},
+	))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+	_, err = p.processMetrics(context.Background(), sumMetrics(pmetric.AggregationTemporalityCumulative,
+		point{path: "/a", start: 10, timestamp: 30, value: 7},
+		point{path: "/b", start: 10, timestamp: 15, value: 0},
+	))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+	assert.Len(t, sink.AllMetrics(), 0)
+
+	p.export(context.Background())
+	require.Len(t, sink.AllMetrics(), 1)
+	md := sink.AllMetrics()[0]
+	require.Equal(t, 1, md.MetricCount())
+	m := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "requests", m.Name())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, m.Sum().AggregationTemporality())
+	assert.True(t, m.Sum().IsMonotonic())
+	dps := m.Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	assert.Equal(t, int64(7), dps.At(0).IntValue())
+	assert.Equal(t, pcommon.Timestamp(30), dps.At(0).Timestamp())
+	assert.Equal(t, int64(1), dps.At(1).IntValue(), "older data point must be ignored")
+
+	// a new interval starts empty
+	p.export(context.Background())
+	assert.Len(t, sink.AllMetrics(), 1)
+}
+
+func TestProcessDeltaSumsAddsDataPoints(t *testing.T) {
+	p, sink := newTestProcessor(PassThrough{})
+
+	_, err := p.processMetrics(context.Background(), sumMetrics(pmetric.AggregationTemporalityDelta,
+		point{path: "/a", start: 10, timestamp: 20, value: 3},
+	))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+	_, err = p.processMetrics(context.Background(), sumMetrics(pmetric.AggregationTemporalityDelta,
+		point{path: "/a", start: 20, timestamp: 30, value: 4},
+	))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+
+	p.export(context.Background())
+	require.Len(t, sink.AllMetrics(), 1)
+	m := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, m.Sum().AggregationTemporality())
+	require.Equal(t, 1, m.Sum().DataPoints().Len())
+	dp := m.Sum().DataPoints().At(0)
+	assert.Equal(t, int64(7), dp.IntValue())
+	assert.Equal(t, pcommon.Timestamp(10), dp.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(30), dp.Timestamp())
+}
+
+func TestProcessDeltaSumsResetOnValueTypeChange(t *testing.T) {
+	p, sink := newTestProcessor(PassThrough{})
+
+	_, err := p.processMetrics(context.Background(), sumMetrics(pmetric.AggregationTemporalityDelta,
+		point{path: "/a", start: 10, timestamp: 20, value: 3},
+	))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+	md := sumMetrics(pmetric.AggregationTemporalityDelta, point{path: "/a", start: 5, timestamp: 15})
+	md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).SetDoubleValue(1.5)
+	_, err = p.processMetrics(context.Background(), md)
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+
+	p.export(context.Background())
+	require.Len(t, sink.AllMetrics(), 1)
+	m := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, 1, m.Sum().DataPoints().Len())
+	dp := m.Sum().DataPoints().At(0)
+	assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+	assert.Equal(t, 1.5, dp.DoubleValue())
+	assert.Equal(t, pcommon.Timestamp(5), dp.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(15), dp.Timestamp())
+}
+
+func TestProcessDeltaHistogramsMergesDataPoints(t *testing.T) {
+	p, sink := newTestProcessor(PassThrough{})
+
+	_, err := p.processMetrics(context.Background(), histogramMetrics(
+		point{path: "/a", start: 10, timestamp: 20, value: 2},
+	))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+	_, err = p.processMetrics(context.Background(), histogramMetrics(
+		point{path: "/a", start: 20, timestamp: 30, value: 5},
+	))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+
+	p.export(context.Background())
+	require.Len(t, sink.AllMetrics(), 1)
+	m := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, 1, m.Histogram().DataPoints().Len())
+	dp := m.Histogram().DataPoints().At(0)
+	assert.Equal(t, uint64(7), dp.Count())
+	assert.Equal(t, 7.0, dp.Sum())
+	assert.Equal(t, 2.0, dp.Min())
+	assert.Equal(t, 5.0, dp.Max())
+	assert.Equal(t, []uint64{7, 0}, dp.BucketCounts().AsRaw())
+	assert.Equal(t, pcommon.Timestamp(10), dp.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(30), dp.Timestamp())
+}
+
+func TestProcessGauges(t *testing.T) {
+	t.Run("aggregated", func(t *testing.T) {
+		p, sink := newTestProcessor(PassThrough{})
+
+		_, err := p.processMetrics(context.Background(), gaugeMetrics(1))
+		assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+
+		p.export(context.Background())
+		require.Len(t, sink.AllMetrics(), 1)
+		assert.Equal(t, 1, sink.AllMetrics()[0].DataPointCount())
+	})
+
+	t.Run("passed through", func(t *testing.T) {
+		p, sink := newTestProcessor(PassThrough{Gauge: true})
+
+		md, err := p.processMetrics(context.Background(), gaugeMetrics(1))
+		require.NoError(t, err)
+		assert.Equal(t, 1, md.DataPointCount())
+
+		p.export(context.Background())
+		assert.Len(t, sink.AllMetrics(), 0)
+	})
+}
+
+func TestShutdownExportsAggregatedMetrics(t *testing.T) {
+	p, sink := newTestProcessor(PassThrough{})
+	require.NoError(t, p.exporter.Start(context.Background(), nil))
+
+	_, err := p.processMetrics(context.Background(), gaugeMetrics(1))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+
+	require.NoError(t, p.exporter.Shutdown(context.Background()))
+	require.Len(t, sink.AllMetrics(), 1)
+}
@@ -34,6 +34,7 @@ type fakeClient struct {
 	Informer          cache.SharedInformer
 	NamespaceInformer cache.SharedInformer
 	Namespaces        map[string]*kube.Namespace
+	Nodes             map[string]*kube.Node
 	StopCh            chan struct{}
 }
 
@@ -70,6 +71,11 @@ func (f *fakeClient) GetNamespace(namespace string) (*kube.Namespace, bool) {
 	return ns, ok
 }
 
+func (f *fakeClient) GetNode(nodeName string) (*kube.Node, bool) {
+	node, ok := f.Nodes[nodeName]
+	return node, ok
+}
+
 // Start is a noop for FakeClient.
 func (f *fakeClient) Start() {
 	if f.Informer != nil {
@@ -107,6 +107,8 @@ type ExtractConfig struct {
 //	    When not specified a default tag name will be used of the format:
 //	    k8s.pod.annotations.<annotation key>
 //	    k8s.pod.labels.<label key>
+//	    k8s.namespace.annotations.<annotation key> and k8s.node.annotations.<annotation key>
+//	    when extracted from a namespace or a node, and similarly for labels.
 //	    For example, if tag_name is not specified and the key is git_sha,
 //	    then the attribute name will be `k8s.pod.annotations.git_sha`.
 //	    When key_regex is present, tag_name supports back reference to both named capturing and positioned capturing.
@@ -161,7 +163,8 @@ type FieldExtractConfig struct {
 	KeyRegex string `mapstructure:"key_regex"`
 	Regex    string `mapstructure:"regex"`
 	// From represents the source of the labels/annotations.
-	// Allowed values are "pod" and "namespace". The default is pod.
+	// Allowed values are "pod", "namespace" and "node". The default is pod.
+	// The "node" source extracts the labels/annotations of the node the pod runs on.
 	From string `mapstructure:"from"`
 }
 
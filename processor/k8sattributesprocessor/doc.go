@@ -75,7 +75,11 @@
 // This config represents a list of annotations/labels that are extracted from pods/namespaces and added to spans, metrics and logs.
 // Each item is specified as a config of tag_name (representing the tag name to tag the spans with),
 // key (representing the key used to extract value) and from (representing the kubernetes object used to extract the value).
-// The "from" field has only two possible values "pod" and "namespace" and defaults to "pod" if none is specified.
+// The "from" field has three possible values "pod", "namespace" and "node" and defaults to "pod" if none is specified.
+// With "node", the value is extracted from the node the pod runs on, or from the node named by the `k8s.node.name`
+// resource attribute when no pod is associated with the resource.
+// Instead of key, key_regex selects all the annotations/labels whose keys fully match a regular expression.
+// The capture groups of key_regex can be referenced in tag_name to rename the extracted keys, e.g. `$$1`.
 //
 // A few examples to use this config are as follows:
 // annotations:
@@ -95,10 +99,18 @@
 //     key: label2
 //     regex: field=(?P<value>.+)
 //     from: pod
+//   - tag_name: $$1 # extracts all the labels from nodes with a key starting with `topology.kubernetes.io/` and inserts them without this prefix
+//     key_regex: topology\.kubernetes\.io/(.*)
+//     from: node
+//
+// Multiple pod associations can be configured as fallbacks: the associations are tried in order and the first one
+// matching a known pod is used. For example, the pod can be associated by its UID when set by the instrumentation,
+// and by the connection IP address otherwise.
 //
 // # RBAC
 //
 // The k8sattributesprocessor needs `get`, `watch` and `list` permissions on both `pods` and `namespaces` resources, for all namespaces and pods included in the configured filters.
+// The same permissions are needed on `nodes` when labels or annotations are extracted from nodes.
 // Here is an example of a `ClusterRole` to give a `ServiceAccount` the necessary permissions for all pods and namespaces in the cluster (replace `<OTEL_COL_NAMESPACE>` with a namespace where collector is deployed):
 //
 //	apiVersion: v1
@@ -113,7 +125,7 @@
 //	  name: otel-collector
 //	rules:
 //	- apiGroups: [""]
-//	  resources: ["pods", "namespaces"]
+//	  resources: ["pods", "namespaces", "nodes"]
 //	  verbs: ["get", "watch", "list"]
 //	---
 //	apiVersion: rbac.authorization.k8s.io/v1
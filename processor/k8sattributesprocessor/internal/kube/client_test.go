@@ -878,6 +878,54 @@ func TestNamespaceExtractionRules(t *testing.T) {
 	}
 }
 
+func TestNodeExtractionRules(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, ExtractionRules{
+		Labels: []FieldExtractionRule{{
+			Name:                 "$1",
+			KeyRegex:             regexp.MustCompile(`^(?:topology\.kubernetes\.io/(.*))$`),
+			HasKeyRegexReference: true,
+			From:                 MetadataFromNode,
+		}, {
+			Name: "l1",
+			Key:  "label1",
+			From: MetadataFromPod,
+		}},
+		Annotations: []FieldExtractionRule{{
+			KeyRegex: regexp.MustCompile("^(?:owner.*)$"),
+			From:     MetadataFromNode,
+		}},
+	}, Filters{})
+	assert.True(t, c.extractNodeLabelsAnnotations())
+
+	node := &api_v1.Node{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "node-1",
+			UID:  "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			Labels: map[string]string{
+				"topology.kubernetes.io/zone":   "us-east-1a",
+				"topology.kubernetes.io/region": "us-east-1",
+				"label1":                        "lv1",
+			},
+			Annotations: map[string]string{
+				"owner.team": "platform",
+				"other":      "value",
+			},
+		},
+	}
+	c.handleNodeAdd(node)
+	n, ok := c.GetNode("node-1")
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{
+		"zone":                            "us-east-1a",
+		"region":                          "us-east-1",
+		"k8s.node.annotations.owner.team": "platform",
+	}, n.Attributes)
+
+	c.handleNodeDelete(node)
+	_, ok = c.GetNode("node-1")
+	assert.False(t, ok)
+}
+
 func TestFilters(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -90,6 +90,39 @@ func newNamespaceSharedInformer(
 	return informer
 }
 
+func newNodeSharedInformer(
+	client kubernetes.Interface,
+	nodeName string,
+) cache.SharedInformer {
+	informer := cache.NewSharedInformer(
+		&cache.ListWatch{
+			ListFunc:  nodeInformerListFunc(client, nodeName),
+			WatchFunc: nodeInformerWatchFunc(client, nodeName),
+		},
+		&api_v1.Node{},
+		watchSyncPeriod,
+	)
+	return informer
+}
+
+func nodeInformerListFunc(client kubernetes.Interface, nodeName string) cache.ListFunc {
+	return func(opts metav1.ListOptions) (runtime.Object, error) {
+		if nodeName != "" {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", nodeName).String()
+		}
+		return client.CoreV1().Nodes().List(context.Background(), opts)
+	}
+}
+
+func nodeInformerWatchFunc(client kubernetes.Interface, nodeName string) cache.WatchFunc {
+	return func(opts metav1.ListOptions) (watch.Interface, error) {
+		if nodeName != "" {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", nodeName).String()
+		}
+		return client.CoreV1().Nodes().Watch(context.Background(), opts)
+	}
+}
+
 func namespaceInformerListFunc(client kubernetes.Interface) cache.ListFunc {
 	return func(opts metav1.ListOptions) (runtime.Object, error) {
 		return client.CoreV1().Namespaces().List(context.Background(), opts)
@@ -26,13 +26,18 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/kube"
 )
 
-// extractPodIds returns pod identifier for first association matching all sources
-func extractPodID(ctx context.Context, attrs pcommon.Map, associations []kube.Association) kube.PodIdentifier {
+// extractPodIDs returns the pod identifiers of the associations matching all sources, in the order
+// of the associations, so that the following ones are used as fallbacks when no pod is known for the first one.
+func extractPodIDs(ctx context.Context, attrs pcommon.Map, associations []kube.Association) []kube.PodIdentifier {
 	// If pod association is not set
 	if len(associations) == 0 {
-		return extractPodIDNoAssociations(ctx, attrs)
+		if id := extractPodIDNoAssociations(ctx, attrs); id.IsNotEmpty() {
+			return []kube.PodIdentifier{id}
+		}
+		return nil
 	}
 
+	var ids []kube.PodIdentifier
 	connectionIP := connectionIP(ctx)
 	for _, asso := range associations {
 		skip := false
@@ -67,12 +72,12 @@ func extractPodID(ctx context.Context, attrs pcommon.Map, associations []kube.As
 			}
 		}
 
-		// If all association sources has been resolved, return result
+		// If all association sources has been resolved, add result
 		if !skip {
-			return ret
+			ids = append(ids, ret)
 		}
 	}
-	return kube.PodIdentifier{}
+	return ids
 }
 
 // extractPodIds returns pod identifier for first association matching all sources
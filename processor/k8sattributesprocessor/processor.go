@@ -106,32 +106,42 @@ func (kp *kubernetesprocessor) processLogs(ctx context.Context, ld plog.Logs) (p
 
 // processResource adds Pod metadata tags to resource based on pod association configuration
 func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pcommon.Resource) {
-	podIdentifierValue := extractPodID(ctx, resource.Attributes(), kp.podAssociations)
-	kp.logger.Debug("evaluating pod identifier", zap.Any("value", podIdentifierValue))
-
-	for i := range podIdentifierValue {
-		if podIdentifierValue[i].Source.From == kube.ConnectionSource && podIdentifierValue[i].Value != "" {
-			if _, found := resource.Attributes().Get(kube.K8sIPLabelName); !found {
-				resource.Attributes().PutStr(kube.K8sIPLabelName, podIdentifierValue[i].Value)
+	podIdentifierValues := extractPodIDs(ctx, resource.Attributes(), kp.podAssociations)
+	kp.logger.Debug("evaluating pod identifiers", zap.Any("values", podIdentifierValues))
+
+	if len(podIdentifierValues) > 0 {
+		podIdentifierValue := podIdentifierValues[0]
+		for i := range podIdentifierValue {
+			if podIdentifierValue[i].Source.From == kube.ConnectionSource && podIdentifierValue[i].Value != "" {
+				if _, found := resource.Attributes().Get(kube.K8sIPLabelName); !found {
+					resource.Attributes().PutStr(kube.K8sIPLabelName, podIdentifierValue[i].Value)
+				}
+				break
 			}
-			break
 		}
 	}
 	if kp.passthroughMode {
 		return
 	}
 
-	if podIdentifierValue.IsNotEmpty() {
-		if pod, ok := kp.kc.GetPod(podIdentifierValue); ok {
-			kp.logger.Debug("getting the pod", zap.Any("pod", pod))
+	nodeName := stringAttributeFromMap(resource.Attributes(), conventions.AttributeK8SNodeName)
+	for _, podIdentifierValue := range podIdentifierValues {
+		pod, ok := kp.kc.GetPod(podIdentifierValue)
+		if !ok {
+			continue
+		}
+		kp.logger.Debug("getting the pod", zap.Any("pod", pod))
 
-			for key, val := range pod.Attributes {
-				if _, found := resource.Attributes().Get(key); !found {
-					resource.Attributes().PutStr(key, val)
-				}
+		for key, val := range pod.Attributes {
+			if _, found := resource.Attributes().Get(key); !found {
+				resource.Attributes().PutStr(key, val)
 			}
-			kp.addContainerAttributes(resource.Attributes(), pod)
 		}
+		kp.addContainerAttributes(resource.Attributes(), pod)
+		if pod.NodeName != "" {
+			nodeName = pod.NodeName
+		}
+		break
 	}
 
 	namespace := stringAttributeFromMap(resource.Attributes(), conventions.AttributeK8SNamespaceName)
@@ -143,6 +153,15 @@ func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pco
 			}
 		}
 	}
+
+	if nodeName != "" {
+		attrsToAdd := kp.getAttributesForPodsNode(nodeName)
+		for key, val := range attrsToAdd {
+			if _, found := resource.Attributes().Get(key); !found {
+				resource.Attributes().PutStr(key, val)
+			}
+		}
+	}
 }
 
 // addContainerAttributes looks if pod has any container identifiers and adds additional container attributes
@@ -190,6 +209,14 @@ func (kp *kubernetesprocessor) getAttributesForPodsNamespace(namespace string) m
 	return ns.Attributes
 }
 
+func (kp *kubernetesprocessor) getAttributesForPodsNode(nodeName string) map[string]string {
+	node, ok := kp.kc.GetNode(nodeName)
+	if !ok {
+		return nil
+	}
+	return node.Attributes
+}
+
 // intFromAttribute extracts int value from an attribute stored as string or int
 func intFromAttribute(val pcommon.Value) (int, error) {
 	switch val.Type() {
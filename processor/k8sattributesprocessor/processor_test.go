@@ -301,6 +301,12 @@ func withPodUID(uid string) generateResourceFunc {
 	}
 }
 
+func withPodName(name string) generateResourceFunc {
+	return func(res pcommon.Resource) {
+		res.Attributes().PutStr(conventions.AttributeK8SPodName, name)
+	}
+}
+
 func withContainerName(containerName string) generateResourceFunc {
 	return func(res pcommon.Resource) {
 		res.Attributes().PutStr(conventions.AttributeK8SContainerName, containerName)
@@ -674,6 +680,63 @@ func TestPodUID(t *testing.T) {
 	})
 }
 
+func TestPodAssociationFallbackAndNodeAttributes(t *testing.T) {
+	m := newMultiTest(
+		t,
+		NewFactory().CreateDefaultConfig(),
+		nil,
+	)
+	m.kubernetesProcessorOperation(func(kp *kubernetesprocessor) {
+		kp.podAssociations = []kube.Association{
+			{
+				Sources: []kube.AssociationSource{
+					{
+						From: "resource_attribute",
+						Name: "k8s.pod.name",
+					},
+				},
+			},
+			{
+				Sources: []kube.AssociationSource{
+					{
+						From: "resource_attribute",
+						Name: "k8s.pod.uid",
+					},
+				},
+			},
+		}
+		kp.kc.(*fakeClient).Pods[newPodIdentifier("resource_attribute", "k8s.pod.uid", "ef10d10b-2da5-4030-812e-5f45c1531227")] = &kube.Pod{
+			Name:     "PodA",
+			NodeName: "node-1",
+			Attributes: map[string]string{
+				"team": "payments",
+			},
+		}
+		kp.kc.(*fakeClient).Nodes = map[string]*kube.Node{
+			"node-1": {
+				Name: "node-1",
+				Attributes: map[string]string{
+					"k8s.node.labels.topology.kubernetes.io/zone": "us-east-1a",
+				},
+			},
+		}
+	})
+
+	// The pod is not known by its name, the association on its UID is used instead.
+	m.testConsume(context.Background(),
+		generateTraces(withPodUID("ef10d10b-2da5-4030-812e-5f45c1531227"), withPodName("PodB")),
+		generateMetrics(withPodUID("ef10d10b-2da5-4030-812e-5f45c1531227"), withPodName("PodB")),
+		generateLogs(withPodUID("ef10d10b-2da5-4030-812e-5f45c1531227"), withPodName("PodB")),
+		nil)
+
+	m.assertBatchesLen(1)
+	m.assertResourceObjectLen(0)
+	m.assertResource(0, func(r pcommon.Resource) {
+		assertResourceHasStringAttribute(t, r, "team", "payments")
+		assertResourceHasStringAttribute(t, r, "k8s.node.labels.topology.kubernetes.io/zone", "us-east-1a")
+	})
+}
+
 func TestProcessorAddLabels(t *testing.T) {
 	m := newMultiTest(
 		t,
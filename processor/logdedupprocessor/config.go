@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+)
+
+const (
+	bodyField       = "body"
+	severityField   = "severity"
+	attributePrefix = "attributes."
+)
+
+// Config defines the configuration for the processor.
+type Config struct {
+	config.ProcessorSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Interval is the time window over which identical log records are aggregated.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// LogCountAttribute is the name of the attribute holding the number of aggregated log records.
+	LogCountAttribute string `mapstructure:"log_count_attribute"`
+
+	// KeyFields are the fields of the log records compared to find identical records. The allowed
+	// values are "body", "severity" and "attributes.<name>". When empty, the body, the severity
+	// and all the attributes are compared.
+	KeyFields []string `mapstructure:"key_fields"`
+}
+
+// Validate checks whether the input configuration has all of the required fields for the processor.
+// An error is returned if there are any invalid inputs.
+func (config *Config) Validate() error {
+	if config.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if config.LogCountAttribute == "" {
+		return errors.New("log_count_attribute must not be empty")
+	}
+	for _, field := range config.KeyFields {
+		switch {
+		case field == bodyField, field == severityField:
+		case strings.HasPrefix(field, attributePrefix) && len(field) > len(attributePrefix):
+		default:
+			return fmt.Errorf("invalid key field %q, must be %q, %q or %q", field, bodyField, severityField, attributePrefix+"<name>")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/identity"
+)
+
+// recordKey returns the key identifying the log records which are identical to the given one
+// within the scope of the given key. Only the key fields are compared, or the body, the severity
+// and all the attributes if there are no key fields.
+func recordKey(scopeKey string, keyFields []string, lr plog.LogRecord) string {
+	var b strings.Builder
+	b.WriteString(scopeKey)
+	if len(keyFields) == 0 {
+		writeBody(&b, lr)
+		writeSeverity(&b, lr)
+		identity.WriteAttributes(&b, lr.Attributes())
+		return b.String()
+	}
+	for _, field := range keyFields {
+		switch field {
+		case bodyField:
+			writeBody(&b, lr)
+		case severityField:
+			writeSeverity(&b, lr)
+		default:
+			b.WriteString(identity.Separator)
+			if v, ok := lr.Attributes().Get(strings.TrimPrefix(field, attributePrefix)); ok {
+				b.WriteByte('=')
+				identity.WriteValue(&b, v)
+			}
+		}
+	}
+	return b.String()
+}
+
+func writeBody(b *strings.Builder, lr plog.LogRecord) {
+	b.WriteString(identity.Separator)
+	identity.WriteValue(b, lr.Body())
+}
+
+func writeSeverity(b *strings.Builder, lr plog.LogRecord) {
+	b.WriteString(identity.Separator)
+	b.WriteString(strconv.Itoa(int(lr.SeverityNumber())))
+	b.WriteByte(':')
+	b.WriteString(lr.SeverityText())
+}
@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/identity"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/timeutils"
+)
+
+type logDedupProcessor struct {
+	logger            *zap.Logger
+	nextConsumer      consumer.Logs
+	logCountAttribute string
+	keyFields         []string
+	exporter          *timeutils.PeriodicExporter
+
+	mu sync.Mutex
+	// ld holds the first log record of each set of identical records received during the
+	// current interval. The lookup maps below index its resources, scopes and records.
+	ld           plog.Logs
+	resourceLogs map[string]plog.ResourceLogs
+	scopeLogs    map[string]plog.ScopeLogs
+	records      map[string]*aggregatedRecord
+}
+
+// aggregatedRecord is a log record along with the number of identical records received.
+type aggregatedRecord struct {
+	record plog.LogRecord
+	count  int64
+}
+
+func newLogDedupProcessor(config *Config, logger *zap.Logger, nextConsumer consumer.Logs) *logDedupProcessor {
+	p := &logDedupProcessor{
+		logger:            logger,
+		nextConsumer:      nextConsumer,
+		logCountAttribute: config.LogCountAttribute,
+		keyFields:         config.KeyFields,
+	}
+	p.exporter = timeutils.NewPeriodicExporter(config.Interval, p.export)
+	p.reset()
+	return p
+}
+
+// reset starts a new interval with no aggregated log records.
+func (p *logDedupProcessor) reset() {
+	p.ld = plog.NewLogs()
+	p.resourceLogs = make(map[string]plog.ResourceLogs)
+	p.scopeLogs = make(map[string]plog.ScopeLogs)
+	p.records = make(map[string]*aggregatedRecord)
+}
+
+// export sends the log records aggregated during the current interval to the next consumer,
+// each with the number of identical records received, and starts a new interval.
+func (p *logDedupProcessor) export(ctx context.Context) {
+	p.mu.Lock()
+	ld := p.ld
+	for _, r := range p.records {
+		r.record.Attributes().PutInt(p.logCountAttribute, r.count)
+	}
+	p.reset()
+	p.mu.Unlock()
+
+	if ld.ResourceLogs().Len() == 0 {
+		return
+	}
+	if err := p.nextConsumer.ConsumeLogs(ctx, ld); err != nil {
+		p.logger.Error("failed to export the deduplicated logs", zap.Error(err))
+	}
+}
+
+// processLogs implements the ProcessLogsFunc type. All the log records are aggregated and
+// removed from ld, which is only forwarded at the end of the interval.
+func (p *logDedupProcessor) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		rk := identity.ResourceKey(rl.Resource())
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			sk := identity.ScopeKey(rk, sl.Scope())
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				key := recordKey(sk, p.keyFields, lr)
+				if r, ok := p.records[key]; ok {
+					r.count++
+					if lr.ObservedTimestamp() > r.record.ObservedTimestamp() {
+						r.record.SetObservedTimestamp(lr.ObservedTimestamp())
+					}
+					continue
+				}
+				out := p.getScopeLogs(rk, sk, rl, sl).LogRecords().AppendEmpty()
+				lr.CopyTo(out)
+				p.records[key] = &aggregatedRecord{record: out, count: 1}
+			}
+		}
+	}
+	return ld, processorhelper.ErrSkipProcessingData
+}
+
+// getScopeLogs returns the aggregated scope logs of the given key, creating them along with
+// their resource if they don't exist yet.
+func (p *logDedupProcessor) getScopeLogs(rk, sk string, rl plog.ResourceLogs, sl plog.ScopeLogs) plog.ScopeLogs {
+	if out, ok := p.scopeLogs[sk]; ok {
+		return out
+	}
+	outRL, ok := p.resourceLogs[rk]
+	if !ok {
+		outRL = p.ld.ResourceLogs().AppendEmpty()
+		rl.Resource().CopyTo(outRL.Resource())
+		outRL.SetSchemaUrl(rl.SchemaUrl())
+		p.resourceLogs[rk] = outRL
+	}
+	out := outRL.ScopeLogs().AppendEmpty()
+	sl.Scope().CopyTo(out.Scope())
+	out.SetSchemaUrl(sl.SchemaUrl())
+	p.scopeLogs[sk] = out
+	return out
+}
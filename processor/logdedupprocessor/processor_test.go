@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logdedupprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/zap"
+)
+
+func newTestProcessor(keyFields ...string) (*logDedupProcessor, *consumertest.LogsSink) {
+	sink := &consumertest.LogsSink{}
+	cfg := &Config{Interval: time.Minute, LogCountAttribute: "log_count", KeyFields: keyFields}
+	return newLogDedupProcessor(cfg, zap.NewNop(), sink), sink
+}
+
+type record struct {
+	body     string
+	pod      string
+	observed pcommon.Timestamp
+	severity plog.SeverityNumber
+}
+
+func newLogs(records ...record) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "test")
+	sl := rl.ScopeLogs().AppendEmpty()
+	for _, r := range records {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.Body().SetStr(r.body)
+		lr.SetSeverityNumber(r.severity)
+		lr.SetObservedTimestamp(r.observed)
+		lr.Attributes().PutStr("pod", r.pod)
+	}
+	return ld
+}
+
+func TestProcessLogsDeduplicatesIdenticalRecords(t *testing.T) {
+	p, sink := newTestProcessor()
+
+	_, err := p.processLogs(context.Background(), newLogs(
+		record{body: "crash", pod: "a", observed: 10},
+		record{body: "crash", pod: "a", observed: 20},
+		record{body: "crash", pod: "b", observed: 20},
+	))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+	_, err = p.processLogs(context.Background(), newLogs(
+		record{body: "crash", pod: "a", observed: 30},
+		record{body: "crash", pod: "a", observed: 30, severity: plog.SeverityNumberError},
+	))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+	assert.Len(t, sink.AllLogs(), 0)
+
+	p.export(context.Background())
+	require.Len(t, sink.AllLogs(), 1)
+	ld := sink.AllLogs()[0]
+	require.Equal(t, 1, ld.ResourceLogs().Len())
+	require.Equal(t, 1, ld.ResourceLogs().At(0).ScopeLogs().Len())
+	records := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 3, records.Len())
+
+	expected := []struct {
+		pod      string
+		count    int64
+		observed pcommon.Timestamp
+	}{
+		{pod: "a", count: 3, observed: 30},
+		{pod: "b", count: 1, observed: 20},
+		{pod: "a", count: 1, observed: 30},
+	}
+	for i, e := range expected {
+		lr := records.At(i)
+		pod, _ := lr.Attributes().Get("pod")
+		assert.Equal(t, e.pod, pod.Str())
+		count, ok := lr.Attributes().Get("log_count")
+		require.True(t, ok)
+		assert.Equal(t, e.count, count.Int())
+		assert.Equal(t, e.observed, lr.ObservedTimestamp())
+	}
+
+	// a new interval starts empty
+	p.export(context.Background())
+	assert.Len(t, sink.AllLogs(), 1)
+}
+
+func TestProcessLogsComparesKeyFields(t *testing.T) {
+	p, sink := newTestProcessor("body")
+
+	_, err := p.processLogs(context.Background(), newLogs(
+		record{body: "crash", pod: "a"},
+		record{body: "crash", pod: "b"},
+		record{body: "restart", pod: "a"},
+	))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+
+	p.export(context.Background())
+	require.Len(t, sink.AllLogs(), 1)
+	records := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+	count, _ := records.At(0).Attributes().Get("log_count")
+	assert.Equal(t, int64(2), count.Int())
+	assert.Equal(t, "restart", records.At(1).Body().Str())
+}
+
+func TestProcessLogsComparesValueTypes(t *testing.T) {
+	p, sink := newTestProcessor()
+
+	ld := newLogs(record{body: "crash"}, record{body: "crash"})
+	records := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	records.At(0).Attributes().PutInt("code", 1)
+	records.At(1).Attributes().PutStr("code", "1")
+	_, err := p.processLogs(context.Background(), ld)
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+
+	p.export(context.Background())
+	require.Len(t, sink.AllLogs(), 1)
+	assert.Equal(t, 2, sink.AllLogs()[0].LogRecordCount())
+}
+
+func TestShutdownExportsAggregatedLogs(t *testing.T) {
+	p, sink := newTestProcessor()
+	require.NoError(t, p.exporter.Start(context.Background(), nil))
+
+	_, err := p.processLogs(context.Background(), newLogs(record{body: "crash"}))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+
+	require.NoError(t, p.exporter.Shutdown(context.Background()))
+	require.Len(t, sink.AllLogs(), 1)
+}
@@ -37,6 +37,7 @@ func TestLoadConfig(t *testing.T) {
 	factory := NewFactory()
 	cfg := factory.CreateDefaultConfig()
 	assert.NoError(t, config.UnmarshalProcessor(cm, cfg))
+	storageID := config.NewComponentID("file_storage")
 	assert.Equal(t, &Config{
 		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
 		BaseConfig: adapter.BaseConfig{
@@ -63,6 +64,7 @@ func TestLoadConfig(t *testing.T) {
 				MaxFlushCount: 100,
 				FlushInterval: 100 * time.Millisecond,
 			},
+			StorageID: &storageID,
 		},
 	}, cfg)
 }
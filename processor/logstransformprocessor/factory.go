@@ -74,9 +74,10 @@ func createLogsProcessor(
 	}
 
 	proc := &logsTransformProcessor{
-		id:     cfg.ID(),
-		logger: set.Logger,
-		config: pCfg,
+		id:           cfg.ID(),
+		logger:       set.Logger,
+		config:       pCfg,
+		nextConsumer: nextConsumer,
 	}
 	return processorhelper.NewLogsProcessor(
 		ctx,
@@ -17,15 +17,17 @@ package logstransformprocessor // import "github.com/open-telemetry/opentelemetr
 import (
 	"context"
 	"errors"
-	"fmt"
 	"math"
 	"runtime"
 	"sync"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
@@ -33,38 +35,53 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/pipeline"
 )
 
-type outputType struct {
-	logs plog.Logs
-	err  error
-}
-
 type logsTransformProcessor struct {
-	logger *zap.Logger
-	config *Config
-	id     config.ComponentID
+	logger       *zap.Logger
+	config       *Config
+	id           config.ComponentID
+	nextConsumer consumer.Logs
 
 	pipe          *pipeline.DirectedPipeline
 	firstOperator operator.Operator
 	emitter       *adapter.LogEmitter
 	converter     *adapter.Converter
 	fromConverter *adapter.FromPdataConverter
+	storageClient storage.Client
 	wg            sync.WaitGroup
-	outputChannel chan outputType
+	cancel        context.CancelFunc
 }
 
 func (ltp *logsTransformProcessor) Shutdown(ctx context.Context) error {
 	ltp.logger.Info("Stopping logs transform processor")
-	pipelineErr := ltp.pipe.Stop()
-	ltp.converter.Stop()
-	ltp.fromConverter.Stop()
+	var errs error
+	if ltp.pipe != nil {
+		errs = multierr.Append(errs, ltp.pipe.Stop())
+	}
+	if ltp.converter != nil {
+		ltp.converter.Stop()
+	}
+	if ltp.fromConverter != nil {
+		ltp.fromConverter.Stop()
+	}
 	ltp.wg.Wait()
-
-	return pipelineErr
+	if ltp.cancel != nil {
+		ltp.cancel()
+	}
+	if ltp.storageClient != nil {
+		errs = multierr.Append(errs, ltp.storageClient.Close(ctx))
+	}
+	return errs
 }
 
 func (ltp *logsTransformProcessor) Start(ctx context.Context, host component.Host) error {
 	baseCfg := ltp.config.BaseConfig
 
+	storageClient, err := adapter.GetStorageClientForKind(ctx, host, baseCfg.StorageID, component.KindProcessor, ltp.id)
+	if err != nil {
+		return err
+	}
+	ltp.storageClient = storageClient
+
 	emitterOpts := []adapter.LogEmitterOption{
 		adapter.LogEmitterWithLogger(ltp.logger.Sugar()),
 	}
@@ -83,8 +100,9 @@ func (ltp *logsTransformProcessor) Start(ctx context.Context, host component.Hos
 		return err
 	}
 
-	// There is no need for this processor to use storage
-	err = pipe.Start(storage.NewNopClient())
+	// The operators keep their state, e.g. the batches of the recombine operator,
+	// in the configured storage extension.
+	err = pipe.Start(ltp.storageClient)
 	if err != nil {
 		return err
 	}
@@ -110,7 +128,9 @@ func (ltp *logsTransformProcessor) Start(ctx context.Context, host component.Hos
 	ltp.fromConverter = adapter.NewFromPdataConverter(wkrCount, ltp.logger)
 	ltp.fromConverter.Start()
 
-	ltp.outputChannel = make(chan outputType)
+	// The loops outlive the context of Start, they are stopped on Shutdown once
+	// the channels they read from are closed.
+	ctx, ltp.cancel = context.WithCancel(context.Background())
 
 	// Below we're starting 3 loops:
 	// * first which reads all the logs translated by the fromConverter and then forwards
@@ -127,37 +147,23 @@ func (ltp *logsTransformProcessor) Start(ctx context.Context, host component.Hos
 
 	// ...
 	// * third which reads all the logs produced by the converter
-	//   (aggregated by Resource) and then places them on the outputChannel
+	//   (aggregated by Resource) and then forwards them to the next consumer
 	ltp.wg.Add(1)
 	go ltp.consumerLoop(ctx)
 
 	return nil
 }
 
-func (ltp *logsTransformProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+// processLogs adds the logs to the operators pipeline. The operators may drop, split or
+// combine the log entries, so the logs they emit are forwarded to the next consumer
+// asynchronously by the consumerLoop. The delivery is therefore at-most-once: the logs
+// are reported as consumed before reaching the next consumer, whose errors are only logged.
+func (ltp *logsTransformProcessor) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
 	// Add the logs to the chain
-	err := ltp.fromConverter.Batch(ld)
-	if err != nil {
+	if err := ltp.fromConverter.Batch(ld); err != nil {
 		return ld, err
 	}
-
-	doneChan := ctx.Done()
-	for {
-		select {
-		case <-doneChan:
-			ltp.logger.Debug("loop stopped")
-			return ld, errors.New("processor interrupted")
-		case output, ok := <-ltp.outputChannel:
-			if !ok {
-				return ld, errors.New("processor encountered an issue receiving logs from stanza operators pipeline")
-			}
-			if output.err != nil {
-				return ld, err
-			}
-
-			return output.logs, nil
-		}
-	}
+	return ld, processorhelper.ErrSkipProcessingData
 }
 
 // converterLoop reads the log entries produced by the fromConverter and sends them
@@ -180,7 +186,7 @@ func (ltp *logsTransformProcessor) converterLoop(ctx context.Context) {
 			for _, e := range entries {
 				// Add item to the first operator of the pipeline manually
 				if err := ltp.firstOperator.Process(ctx, e); err != nil {
-					ltp.outputChannel <- outputType{err: fmt.Errorf("processor encountered an issue with the pipeline: %w", err)}
+					ltp.logger.Error("processor encountered an issue with the pipeline", zap.Error(err))
 					break
 				}
 			}
@@ -205,13 +211,13 @@ func (ltp *logsTransformProcessor) emitterLoop(ctx context.Context) {
 			}
 
 			if err := ltp.converter.Batch(e); err != nil {
-				ltp.outputChannel <- outputType{err: fmt.Errorf("processor encountered an issue with the converter: %w", err)}
+				ltp.logger.Error("processor encountered an issue with the converter", zap.Error(err))
 			}
 		}
 	}
 }
 
-// consumerLoop reads converter log entries and calls the consumer to consumer them.
+// consumerLoop reads converter log entries and calls the next consumer to consume them.
 func (ltp *logsTransformProcessor) consumerLoop(ctx context.Context) {
 	defer ltp.wg.Done()
 
@@ -227,7 +233,11 @@ func (ltp *logsTransformProcessor) consumerLoop(ctx context.Context) {
 				return
 			}
 
-			ltp.outputChannel <- outputType{logs: pLogs, err: nil}
+			// The logs were already reported as consumed, they can only be dropped.
+			if err := ltp.nextConsumer.ConsumeLogs(ctx, pLogs); err != nil {
+				ltp.logger.Error("processor encountered an issue with the next consumer, dropping the logs",
+					zap.Int("dropped_log_records", pLogs.LogRecordCount()), zap.Error(err))
+			}
 		}
 	}
 }
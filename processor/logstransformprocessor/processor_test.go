@@ -33,6 +33,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/regex"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/transformer/filter"
 )
 
 var (
@@ -169,8 +170,10 @@ func TestLogsTransformProcessor(t *testing.T) {
 			wantLogData := generateLogData(tt.parsedMessages)
 			err = ltp.ConsumeLogs(context.Background(), sourceLogData)
 			require.NoError(t, err)
+			require.Eventually(t, func() bool {
+				return len(tln.AllLogs()) == 1
+			}, time.Second, 10*time.Millisecond)
 			logs := tln.AllLogs()
-			require.Len(t, logs, 1)
 
 			for i := 0; i < logs[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().Len(); i++ {
 				logs[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(i).Attributes().Sort()
@@ -180,6 +183,54 @@ func TestLogsTransformProcessor(t *testing.T) {
 	}
 }
 
+func TestLogsTransformProcessorDroppingEntries(t *testing.T) {
+	filterCfg := filter.NewConfig()
+	filterCfg.Expression = `body matches "^DEBUG"`
+	pCfg := &Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		BaseConfig: adapter.BaseConfig{
+			Operators: []operator.Config{{Builder: filterCfg}},
+			Converter: adapter.ConverterConfig{
+				MaxFlushCount: 100,
+				FlushInterval: 100 * time.Millisecond,
+			},
+		},
+	}
+
+	tln := new(consumertest.LogsSink)
+	factory := NewFactory()
+	ltp, err := factory.CreateLogsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), pCfg, tln)
+	require.NoError(t, err)
+	require.NoError(t, ltp.Start(context.Background(), componenttest.NewNopHost()))
+
+	// the processor must not wait for the logs dropped by the operators
+	require.NoError(t, ltp.ConsumeLogs(context.Background(), generateLogData([]testLogMessage{
+		{body: pcommon.NewValueStr("DEBUG dropped")},
+	})))
+	require.NoError(t, ltp.ConsumeLogs(context.Background(), generateLogData([]testLogMessage{
+		{body: pcommon.NewValueStr("DEBUG dropped")},
+		{body: pcommon.NewValueStr("INFO kept")},
+	})))
+
+	require.Eventually(t, func() bool {
+		return tln.LogRecordCount() == 1
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, ltp.Shutdown(context.Background()))
+	assert.Equal(t, "INFO kept", tln.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+}
+
+func TestLogsTransformProcessorMissingStorage(t *testing.T) {
+	storageID := config.NewComponentIDWithName("file_storage", "missing")
+	pCfg := *cfg
+	pCfg.BaseConfig.StorageID = &storageID
+
+	factory := NewFactory()
+	ltp, err := factory.CreateLogsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), &pCfg, new(consumertest.LogsSink))
+	require.NoError(t, err)
+	assert.EqualError(t, ltp.Start(context.Background(), componenttest.NewNopHost()), "storage extension 'file_storage/missing' not found")
+	require.NoError(t, ltp.Shutdown(context.Background()))
+}
+
 func generateLogData(messages []testLogMessage) plog.Logs {
 	ld := testdata.GenerateLogsOneEmptyResourceLogs()
 	scope := ld.ResourceLogs().At(0).ScopeLogs().AppendEmpty()
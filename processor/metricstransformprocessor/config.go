@@ -82,6 +82,8 @@ type Transform struct {
 	// --- SPECIFY HOW TO TRANSFORM THE METRIC GENERATED AS A RESULT OF APPLYING THE ABOVE ACTION ---
 
 	// NewName specifies the name of the new metric when inserting or updating.
+	// It can reference the submatches of a regexp filter. When combining, the matched
+	// metrics are combined separately for each distinct expanded name.
 	// REQUIRED only if Action is INSERT.
 	NewName string `mapstructure:"new_name"`
 
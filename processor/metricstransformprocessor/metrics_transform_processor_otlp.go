@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -250,22 +251,30 @@ func (mtp *metricsTransformProcessor) processMetrics(_ context.Context, md pmetr
 					initResourceMetrics(groupedRM, rm.Resource(), sm.Scope(), transform)
 					extractAndRemoveMatchedMetrics(groupedRM.ScopeMetrics().At(0).Metrics(), transform.MetricIncludeFilter, metrics)
 				case Combine:
-					matchedMetrics := matchMetrics(transform.MetricIncludeFilter, metrics)
-					if len(matchedMetrics) == 0 {
-						continue
-					}
+					// The matched metrics are combined separately for each distinct new name,
+					// as new_name can reference the submatches of the include regexp.
+					for _, newName := range combinedNames(transform, metrics) {
+						combineTransform := transform
+						combineTransform.NewName = newName
+						combineTransform.MetricIncludeFilter = combinedNameFilter{
+							internalFilter: transform.MetricIncludeFilter,
+							template:       transform.NewName,
+							newName:        newName,
+						}
 
-					if err := canBeCombined(matchedMetrics); err != nil {
-						// TODO: report via trace / metric instead
-						mtp.logger.Warn(err.Error())
-						continue
-					}
+						matchedMetrics := matchMetrics(combineTransform.MetricIncludeFilter, metrics)
+						if err := canBeCombined(matchedMetrics); err != nil {
+							// TODO: report via trace / metric instead
+							mtp.logger.Warn(err.Error())
+							continue
+						}
 
-					extractedMetrics := pmetric.NewMetricSlice()
-					extractAndRemoveMatchedMetrics(extractedMetrics, transform.MetricIncludeFilter, metrics)
-					combinedMetric := combine(transform, extractedMetrics)
-					if transformMetric(combinedMetric, transform) {
-						combinedMetric.MoveTo(metrics.AppendEmpty())
+						extractedMetrics := pmetric.NewMetricSlice()
+						extractAndRemoveMatchedMetrics(extractedMetrics, combineTransform.MetricIncludeFilter, metrics)
+						combinedMetric := combine(combineTransform, transform.NewName, extractedMetrics)
+						if transformMetric(combinedMetric, combineTransform) {
+							combinedMetric.MoveTo(metrics.AppendEmpty())
+						}
 					}
 				case Insert:
 					// Save len, so we don't iterate over the newly generated metrics that are appended at the end.
@@ -404,9 +413,89 @@ func metricAttributeKeys(metric pmetric.Metric) map[string]struct{} {
 	return attrKeys
 }
 
-// combine combines the metrics based on the supplied filter.
+// combinedNameFilter matches the metrics of a combine transform whose expanded new name is newName.
+type combinedNameFilter struct {
+	internalFilter
+	template string
+	newName  string
+}
+
+func (f combinedNameFilter) extractMatchedMetric(metric pmetric.Metric) pmetric.Metric {
+	if expandName(f.internalFilter, f.template, metric.Name()) != f.newName {
+		return pmetric.Metric{}
+	}
+	return f.internalFilter.extractMatchedMetric(metric)
+}
+
+func (f combinedNameFilter) matchMetric(metric pmetric.Metric) bool {
+	return expandName(f.internalFilter, f.template, metric.Name()) == f.newName && f.internalFilter.matchMetric(metric)
+}
+
+// expandName returns the template expanded with the submatches of the metric name,
+// or the template as is if the filter does not support submatches.
+func expandName(f internalFilter, template, metricName string) string {
+	if name := f.expand(template, metricName); name != "" {
+		return name
+	}
+	return template
+}
+
+// combinedNames returns the distinct new names of the metrics matching the combine transform,
+// in the order of the metrics.
+func combinedNames(transform internalTransform, metrics pmetric.MetricSlice) []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, metric := range matchMetrics(transform.MetricIncludeFilter, metrics) {
+		name := expandName(transform.MetricIncludeFilter, transform.NewName, metric.Name())
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// templateReferences returns the names and indexes of the regexp submatches referenced by the
+// template, using the $name, ${name}, $1 and ${1} notations of regexp.Expand.
+func templateReferences(template string) map[string]bool {
+	refs := map[string]bool{}
+	for i := 0; i < len(template); i++ {
+		if template[i] != '$' || i+1 == len(template) {
+			continue
+		}
+		if template[i+1] == '$' {
+			// escaped dollar sign
+			i++
+			continue
+		}
+		rest := template[i+1:]
+		if rest[0] == '{' {
+			if end := strings.IndexByte(rest, '}'); end > 0 {
+				refs[rest[1:end]] = true
+				i += end + 1
+			}
+			continue
+		}
+		end := 0
+		for end < len(rest) && isNameByte(rest[end]) {
+			end++
+		}
+		if end > 0 {
+			refs[rest[:end]] = true
+			i += end
+		}
+	}
+	return refs
+}
+
+func isNameByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// combine combines the metrics based on the supplied filter. Submatches referenced by the name
+// template are part of the new metric name and are not added as attributes.
 // canBeCombined must be called before.
-func combine(transform internalTransform, metrics pmetric.MetricSlice) pmetric.Metric {
+func combine(transform internalTransform, nameTemplate string, metrics pmetric.MetricSlice) pmetric.Metric {
 	firstMetric := metrics.At(0)
 
 	// create combined metric with relevant name & descriptor
@@ -416,8 +505,12 @@ func combine(transform internalTransform, metrics pmetric.MetricSlice) pmetric.M
 
 	// append attribute keys based on the transform filter's named capturing groups
 	subexprNames := transform.MetricIncludeFilter.getSubexpNames()
+	nameRefs := templateReferences(nameTemplate)
 	reAttrKeys := make([]string, len(subexprNames))
 	for i := 1; i < len(subexprNames); i++ {
+		if nameRefs[strconv.Itoa(i)] || subexprNames[i] != "" && nameRefs[subexprNames[i]] {
+			continue
+		}
 		// if the subexpression is not named, use regexp notation, e.g. $1
 		name := subexprNames[i]
 		if name == "" {
@@ -436,7 +529,7 @@ func combine(transform internalTransform, metrics pmetric.MetricSlice) pmetric.M
 				for i := 1; i < len(submatches)/2; i++ {
 					submatch := metric.Name()[submatches[2*i]:submatches[2*i+1]]
 					submatch = replaceCaseOfSubmatch(transform.SubmatchCase, submatch)
-					if submatch != "" {
+					if submatch != "" && reAttrKeys[i] != "" {
 						m.PutStr(reAttrKeys[i], submatch)
 					}
 				}
@@ -1258,6 +1258,75 @@ var (
 				metricBuilder(pmetric.MetricTypeGauge, "metric3").addIntDatapoint(1, 1, 3).build(),
 			},
 		},
+		{
+			name: "combine_aggregate_across_submatches",
+			transforms: []internalTransform{
+				{
+					MetricIncludeFilter: internalFilterRegexp{include: regexp.MustCompile(`^queue_(?P<queue>[a-z]+)_shard_\d+_depth$`)},
+					Action:              Combine,
+					NewName:             "queue.depth",
+					AggregationType:     Sum,
+				},
+			},
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeGauge, "queue_orders_shard_0_depth").addIntDatapoint(1, 1, 1).build(),
+				metricBuilder(pmetric.MetricTypeGauge, "queue_orders_shard_1_depth").addIntDatapoint(1, 1, 2).build(),
+				metricBuilder(pmetric.MetricTypeGauge, "queue_payments_shard_0_depth").addIntDatapoint(1, 1, 4).build(),
+				metricBuilder(pmetric.MetricTypeGauge, "metric3").addIntDatapoint(1, 1, 3).build(),
+			},
+			out: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeGauge, "metric3").addIntDatapoint(1, 1, 3).build(),
+				metricBuilder(pmetric.MetricTypeGauge, "queue.depth", "queue").
+					addIntDatapoint(1, 1, 3, "orders").
+					addIntDatapoint(1, 1, 4, "payments").build(),
+			},
+		},
+		{
+			name: "combine_new_name_submatches",
+			transforms: []internalTransform{
+				{
+					MetricIncludeFilter: internalFilterRegexp{include: regexp.MustCompile(`^shard_(?P<shard>\d+)_(requests|errors)$`)},
+					Action:              Combine,
+					NewName:             "http.${2}",
+				},
+			},
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeSum, "shard_1_requests").addIntDatapoint(1, 2, 1).build(),
+				metricBuilder(pmetric.MetricTypeSum, "shard_1_errors").addIntDatapoint(1, 2, 5).build(),
+				metricBuilder(pmetric.MetricTypeSum, "shard_2_requests").addIntDatapoint(1, 2, 2).build(),
+				metricBuilder(pmetric.MetricTypeSum, "metric3").addIntDatapoint(1, 2, 3).build(),
+			},
+			out: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeSum, "metric3").addIntDatapoint(1, 2, 3).build(),
+				metricBuilder(pmetric.MetricTypeSum, "http.requests", "shard").
+					addIntDatapoint(1, 2, 1, "1").
+					addIntDatapoint(1, 2, 2, "2").build(),
+				metricBuilder(pmetric.MetricTypeSum, "http.errors", "shard").
+					addIntDatapoint(1, 2, 5, "1").build(),
+			},
+		},
+		{
+			name: "combine_new_name_submatches_error_per_name",
+			transforms: []internalTransform{
+				{
+					MetricIncludeFilter: internalFilterRegexp{include: regexp.MustCompile(`^shard_\d+_(?P<kind>requests|errors)$`)},
+					Action:              Combine,
+					NewName:             "http.$kind",
+					AggregationType:     Sum,
+				},
+			},
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeGauge, "shard_1_requests").addIntDatapoint(1, 1, 1).build(),
+				metricBuilder(pmetric.MetricTypeGauge, "shard_2_requests").addIntDatapoint(1, 1, 2).build(),
+				metricBuilder(pmetric.MetricTypeGauge, "shard_1_errors").addIntDatapoint(1, 1, 5).build(),
+				metricBuilder(pmetric.MetricTypeSum, "shard_2_errors").addIntDatapoint(1, 1, 6).build(),
+			},
+			out: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeGauge, "shard_1_errors").addIntDatapoint(1, 1, 5).build(),
+				metricBuilder(pmetric.MetricTypeSum, "shard_2_errors").addIntDatapoint(1, 1, 6).build(),
+				metricBuilder(pmetric.MetricTypeGauge, "http.requests").addIntDatapoint(1, 1, 3).build(),
+			},
+		},
 		// Toggle Data Type
 		{
 			name: "metric_toggle_scalar_data_type_int64_to_double",
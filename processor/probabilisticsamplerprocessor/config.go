@@ -15,6 +15,8 @@
 package probabilisticsamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/probabilisticsamplerprocessor"
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/collector/config"
 )
 
@@ -30,11 +32,28 @@ type Config struct {
 	// have different sampling rates: if they use the same seed all passing one layer may pass the other even if they have
 	// different sampling rates, configuring different seeds avoids that.
 	HashSeed uint32 `mapstructure:"hash_seed"`
+
+	// SeveritySamplingPercentage overrides the SamplingPercentage of the log records per severity band, e.g. to keep
+	// all the errors while downsampling the debug logs. The keys are the severity bands: trace, debug, info, warn,
+	// error and fatal. Log records without severity number, or of a band not listed, use SamplingPercentage.
+	SeveritySamplingPercentage map[string]float32 `mapstructure:"severity_sampling_percentage"`
+
+	// FromAttribute is the name of a log record attribute whose value is hashed to make the sampling decision,
+	// instead of the trace ID of the log record. Log records without this attribute fall back to their trace ID.
+	FromAttribute string `mapstructure:"from_attribute"`
 }
 
 var _ config.Processor = (*Config)(nil)
 
 // Validate checks if the processor configuration is valid
 func (cfg *Config) Validate() error {
+	for band, percentage := range cfg.SeveritySamplingPercentage {
+		if _, ok := severityBands[band]; !ok {
+			return fmt.Errorf("invalid severity band %q in severity_sampling_percentage, must be one of trace, debug, info, warn, error or fatal", band)
+		}
+		if percentage < 0 {
+			return fmt.Errorf("negative sampling percentage for severity band %q: %v", band, percentage)
+		}
+	}
 	return nil
 }
@@ -43,6 +43,19 @@ func TestLoadConfig(t *testing.T) {
 			id:       config.NewComponentIDWithName(typeStr, "empty"),
 			expected: createDefaultConfig(),
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "logs"),
+			expected: &Config{
+				ProcessorSettings:  config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				SamplingPercentage: 10,
+				SeveritySamplingPercentage: map[string]float32{
+					"debug": 1,
+					"error": 100,
+					"fatal": 100,
+				},
+				FromAttribute: "request.id",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -62,3 +75,13 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SeveritySamplingPercentage = map[string]float32{"verbose": 10}
+	assert.EqualError(t, cfg.Validate(),
+		`invalid severity band "verbose" in severity_sampling_percentage, must be one of trace, debug, info, warn, error or fatal`)
+
+	cfg.SeveritySamplingPercentage = map[string]float32{"info": -1}
+	assert.EqualError(t, cfg.Validate(), `negative sampling percentage for severity band "info": -1`)
+}
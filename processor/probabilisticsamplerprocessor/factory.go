@@ -30,6 +30,8 @@ const (
 	typeStr = "probabilistic_sampler"
 	// The stability level of the processor.
 	stability = component.StabilityLevelBeta
+	// The stability level of the logs processor.
+	logsStability = component.StabilityLevelAlpha
 )
 
 var onceMetrics sync.Once
@@ -44,7 +46,8 @@ func NewFactory() component.ProcessorFactory {
 	return component.NewProcessorFactory(
 		typeStr,
 		createDefaultConfig,
-		component.WithTracesProcessor(createTracesProcessor, stability))
+		component.WithTracesProcessor(createTracesProcessor, stability),
+		component.WithLogsProcessor(createLogsProcessor, logsStability))
 }
 
 func createDefaultConfig() config.Processor {
@@ -62,3 +65,13 @@ func createTracesProcessor(
 ) (component.TracesProcessor, error) {
 	return newTracesProcessor(ctx, set, cfg.(*Config), nextConsumer)
 }
+
+// createLogsProcessor creates a log processor based on this config.
+func createLogsProcessor(
+	ctx context.Context,
+	set component.ProcessorCreateSettings,
+	cfg config.Processor,
+	nextConsumer consumer.Logs,
+) (component.LogsProcessor, error) {
+	return newLogsProcessor(ctx, set, cfg.(*Config), nextConsumer)
+}
@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package probabilisticsamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/probabilisticsamplerprocessor"
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/zap"
+)
+
+// severityBands maps the severity bands to the lowest severity number of the band. Each band
+// holds four severity numbers, e.g. debug holds DEBUG, DEBUG2, DEBUG3 and DEBUG4.
+var severityBands = map[string]plog.SeverityNumber{
+	"trace": plog.SeverityNumberTrace,
+	"debug": plog.SeverityNumberDebug,
+	"info":  plog.SeverityNumberInfo,
+	"warn":  plog.SeverityNumberWarn,
+	"error": plog.SeverityNumberError,
+	"fatal": plog.SeverityNumberFatal,
+}
+
+type logsamplerprocessor struct {
+	scaledSamplingRate uint32
+	// severitySamplingRates holds the scaled sampling rate of each severity number, indexed by severity number.
+	severitySamplingRates [plog.SeverityNumberFatal4 + 1]uint32
+	hashSeed              uint32
+	fromAttribute         string
+	logger                *zap.Logger
+	randomHash            func() uint32
+}
+
+// newLogsProcessor returns a processor.LogsProcessor that will perform head sampling according to the given
+// configuration.
+func newLogsProcessor(ctx context.Context, set component.ProcessorCreateSettings, cfg *Config, nextConsumer consumer.Logs) (component.LogsProcessor, error) {
+	lsp := &logsamplerprocessor{
+		// Adjust sampling percentage on private so recalculations are avoided.
+		scaledSamplingRate: uint32(cfg.SamplingPercentage * percentageScaleFactor),
+		hashSeed:           cfg.HashSeed,
+		fromAttribute:      cfg.FromAttribute,
+		logger:             set.Logger,
+		randomHash:         rand.Uint32,
+	}
+	for sev := range lsp.severitySamplingRates {
+		lsp.severitySamplingRates[sev] = lsp.scaledSamplingRate
+	}
+	for band, percentage := range cfg.SeveritySamplingPercentage {
+		lowest := severityBands[band]
+		for sev := lowest; sev < lowest+4; sev++ {
+			lsp.severitySamplingRates[sev] = uint32(percentage * percentageScaleFactor)
+		}
+	}
+
+	return processorhelper.NewLogsProcessor(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		lsp.processLogs,
+		processorhelper.WithCapabilities(consumer.Capabilities{MutatesData: true}))
+}
+
+func (lsp *logsamplerprocessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	ld.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		rl.ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool {
+			sl.LogRecords().RemoveIf(func(lr plog.LogRecord) bool {
+				policy, hashed := lsp.hashLogRecord(lr)
+				sampled := hashed&bitMaskHashBuckets < lsp.samplingRate(lr.SeverityNumber())
+
+				_ = stats.RecordWithTags(
+					ctx,
+					[]tag.Mutator{tag.Upsert(tagPolicyKey, policy), tag.Upsert(tagSampledKey, strconv.FormatBool(sampled))},
+					statCountLogsSampled.M(int64(1)),
+				)
+				return !sampled
+			})
+			// Filter out empty ScopeLogs
+			return sl.LogRecords().Len() == 0
+		})
+		// Filter out empty ResourceLogs
+		return rl.ScopeLogs().Len() == 0
+	})
+	if ld.ResourceLogs().Len() == 0 {
+		return ld, processorhelper.ErrSkipProcessingData
+	}
+	return ld, nil
+}
+
+// samplingRate returns the scaled sampling rate of the severity number.
+func (lsp *logsamplerprocessor) samplingRate(sev plog.SeverityNumber) uint32 {
+	if sev < 0 || int(sev) >= len(lsp.severitySamplingRates) {
+		return lsp.scaledSamplingRate
+	}
+	return lsp.severitySamplingRates[sev]
+}
+
+// hashLogRecord returns the hash the sampling decision of the log record is made on, and the
+// policy it was computed with. The value of the configured attribute is hashed, or the trace ID
+// when the attribute is missing, so that all the log records of a trace get the same decision.
+// Log records with neither of them are sampled at random.
+func (lsp *logsamplerprocessor) hashLogRecord(lr plog.LogRecord) (string, uint32) {
+	if lsp.fromAttribute != "" {
+		if value, ok := lr.Attributes().Get(lsp.fromAttribute); ok {
+			return "attribute_hash", hash([]byte(value.AsString()), lsp.hashSeed)
+		}
+	}
+	if traceID := lr.TraceID(); !traceID.IsEmpty() {
+		return "trace_id_hash", hash(traceID[:], lsp.hashSeed)
+	}
+	return "random", lsp.randomHash()
+}
@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package probabilisticsamplerprocessor
+
+import (
+	"context"
+	"encoding/binary"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func newTestLogsProcessor(t *testing.T, cfg *Config) (*consumertest.LogsSink, func(plog.Logs) error) {
+	sink := new(consumertest.LogsSink)
+	cfg.ProcessorSettings = config.NewProcessorSettings(config.NewComponentID(typeStr))
+	lp, err := newLogsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	return sink, func(ld plog.Logs) error {
+		return lp.ConsumeLogs(context.Background(), ld)
+	}
+}
+
+func traceIDFromInt(i int) pcommon.TraceID {
+	var traceID [16]byte
+	binary.BigEndian.PutUint64(traceID[8:], uint64(i)*0x9e3779b97f4a7c15)
+	return traceID
+}
+
+func TestLogsSamplingPerSeverity(t *testing.T) {
+	sink, consume := newTestLogsProcessor(t, &Config{
+		SamplingPercentage: 0,
+		SeveritySamplingPercentage: map[string]float32{
+			"error": 100,
+			"fatal": 100,
+		},
+	})
+
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for i, sev := range []plog.SeverityNumber{
+		plog.SeverityNumberUnspecified,
+		plog.SeverityNumberDebug,
+		plog.SeverityNumberInfo4,
+		plog.SeverityNumberError,
+		plog.SeverityNumberError4,
+		plog.SeverityNumberFatal2,
+	} {
+		lr := lrs.AppendEmpty()
+		lr.SetSeverityNumber(sev)
+		lr.SetTraceID(traceIDFromInt(i + 1))
+	}
+
+	require.NoError(t, consume(ld))
+
+	require.Len(t, sink.AllLogs(), 1)
+	sampled := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 3, sampled.Len())
+	assert.Equal(t, plog.SeverityNumberError, sampled.At(0).SeverityNumber())
+	assert.Equal(t, plog.SeverityNumberError4, sampled.At(1).SeverityNumber())
+	assert.Equal(t, plog.SeverityNumberFatal2, sampled.At(2).SeverityNumber())
+}
+
+func TestLogsSamplingAllDropped(t *testing.T) {
+	sink, consume := newTestLogsProcessor(t, &Config{SamplingPercentage: 0})
+
+	ld := plog.NewLogs()
+	lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTraceID(traceIDFromInt(1))
+
+	require.NoError(t, consume(ld))
+	assert.Empty(t, sink.AllLogs())
+}
+
+func TestLogsSamplingByTraceID(t *testing.T) {
+	const numTraces = 1000
+	sink, consume := newTestLogsProcessor(t, &Config{SamplingPercentage: 50, HashSeed: 22})
+
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for i := 0; i < numTraces; i++ {
+		// two log records per trace
+		lrs.AppendEmpty().SetTraceID(traceIDFromInt(i))
+		lrs.AppendEmpty().SetTraceID(traceIDFromInt(i))
+	}
+
+	require.NoError(t, consume(ld))
+
+	require.Len(t, sink.AllLogs(), 1)
+	sampled := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	traces := map[pcommon.TraceID]int{}
+	for i := 0; i < sampled.Len(); i++ {
+		traces[sampled.At(i).TraceID()]++
+	}
+	for traceID, count := range traces {
+		assert.Equal(t, 2, count, "all the log records of trace %s should get the same decision", traceID.HexString())
+	}
+	assert.InDelta(t, numTraces/2, len(traces), numTraces*0.05)
+}
+
+func TestLogsSamplingFromAttribute(t *testing.T) {
+	const numRequests = 1000
+	sink, consume := newTestLogsProcessor(t, &Config{SamplingPercentage: 50, FromAttribute: "request.id"})
+
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for i := 0; i < numRequests; i++ {
+		// two log records per request, from different traces
+		for j := 0; j < 2; j++ {
+			lr := lrs.AppendEmpty()
+			lr.SetTraceID(traceIDFromInt(2*i + j))
+			lr.Attributes().PutStr("request.id", strconv.Itoa(i))
+		}
+	}
+
+	require.NoError(t, consume(ld))
+
+	require.Len(t, sink.AllLogs(), 1)
+	sampled := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	requests := map[string]int{}
+	for i := 0; i < sampled.Len(); i++ {
+		requestID, ok := sampled.At(i).Attributes().Get("request.id")
+		require.True(t, ok)
+		requests[requestID.Str()]++
+	}
+	for requestID, count := range requests {
+		assert.Equal(t, 2, count, "all the log records of request %s should get the same decision", requestID)
+	}
+	assert.InDelta(t, numRequests/2, len(requests), numRequests*0.05)
+}
+
+func TestLogsSamplingWithoutTraceID(t *testing.T) {
+	lsp := &logsamplerprocessor{
+		scaledSamplingRate: uint32(50 * percentageScaleFactor),
+		fromAttribute:      "request.id",
+	}
+	for sev := range lsp.severitySamplingRates {
+		lsp.severitySamplingRates[sev] = lsp.scaledSamplingRate
+	}
+
+	for _, tt := range []struct {
+		name       string
+		randomHash uint32
+		sampled    bool
+	}{
+		{
+			name:       "sampled",
+			randomHash: 0,
+			sampled:    true,
+		},
+		{
+			name:       "not sampled",
+			randomHash: bitMaskHashBuckets,
+			sampled:    false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			lsp.randomHash = func() uint32 { return tt.randomHash }
+
+			ld := plog.NewLogs()
+			ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+
+			_, err := lsp.processLogs(context.Background(), ld)
+			if tt.sampled {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, ld.LogRecordCount())
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, 0, ld.LogRecordCount())
+			}
+		})
+	}
+}
@@ -15,9 +15,30 @@
 package redactionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor"
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+
 	"go.opentelemetry.io/collector/config"
 )
 
+const (
+	actionMask   = "mask"
+	actionHash   = "hash"
+	actionRemove = "remove"
+
+	signalTraces  = "traces"
+	signalMetrics = "metrics"
+	signalLogs    = "logs"
+)
+
+// builtinPatterns are the predefined patterns of the value rules
+var builtinPatterns = map[string]string{
+	// Visa, MasterCard, American Express and Discover card numbers
+	"credit_card": `\b(?:4[0-9]{12}(?:[0-9]{3})?|5[1-5][0-9]{14}|3[47][0-9]{13}|6(?:011|5[0-9]{2})[0-9]{12})\b`,
+	"email":       `[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`,
+}
+
 type Config struct {
 	config.ProcessorSettings `mapstructure:",squash"`
 
@@ -41,4 +62,84 @@ type Config struct {
 	// information, while it is valuable when integrating and testing a new
 	// configuration. Possible values are `debug`, `info`, and `silent`.
 	Summary string `mapstructure:"summary"`
+
+	// ValueRules is a list of rules detecting sensitive values and masking,
+	// hashing or removing them. The rules apply to the values of the allowed
+	// attributes of spans, log records, data points and resources, and to the
+	// bodies of log records. Unlike BlockedValues, a rule can be restricted to
+	// some signals.
+	ValueRules []ValueRule `mapstructure:"value_rules"`
+
+	// HashKey is the secret key of the HMAC-SHA256 hashes computed by the
+	// `hash` action, it must be set when a value rule uses that action. A
+	// plain hash of a value with little entropy, such as an email address or
+	// a card number, could be reversed by hashing all the candidate values.
+	HashKey string `mapstructure:"hash_key"`
+}
+
+// ValueRule detects sensitive values with a regular expression and redacts
+// them.
+type ValueRule struct {
+	// Pattern is the regular expression matching the sensitive values. Either
+	// Pattern or Builtin must be set.
+	Pattern string `mapstructure:"pattern"`
+
+	// Builtin is the name of a predefined pattern: `credit_card` or `email`.
+	Builtin string `mapstructure:"builtin"`
+
+	// Action is what is done with the matching values. Possible values are
+	// `mask` (the default), which replaces the matching part of the value with
+	// asterisks, `hash`, which replaces it with its HMAC-SHA256 hash keyed with
+	// the HashKey, and `remove`,
+	// which removes the whole attribute or clears the whole log body.
+	Action string `mapstructure:"action"`
+
+	// Signals restricts the rule to some signals: `traces`, `metrics` or
+	// `logs`. The rule applies to all the signals if it's empty.
+	Signals []string `mapstructure:"signals"`
+}
+
+var _ config.Processor = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	for i, rule := range cfg.ValueRules {
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf("value_rules[%d]: %w", i, err)
+		}
+		if rule.Action == actionHash && cfg.HashKey == "" {
+			return fmt.Errorf("value_rules[%d]: hash_key must be set to use the hash action", i)
+		}
+	}
+	return nil
+}
+
+func (rule *ValueRule) validate() error {
+	switch {
+	case rule.Pattern == "" && rule.Builtin == "":
+		return errors.New("either pattern or builtin must be set")
+	case rule.Pattern != "" && rule.Builtin != "":
+		return errors.New("pattern and builtin cannot be both set")
+	case rule.Builtin != "":
+		if _, ok := builtinPatterns[rule.Builtin]; !ok {
+			return fmt.Errorf("unknown builtin pattern %q", rule.Builtin)
+		}
+	default:
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+	}
+	switch rule.Action {
+	case "", actionMask, actionHash, actionRemove:
+	default:
+		return fmt.Errorf("unknown action %q", rule.Action)
+	}
+	for _, signal := range rule.Signals {
+		switch signal {
+		case signalTraces, signalMetrics, signalLogs:
+		default:
+			return fmt.Errorf("unknown signal %q", signal)
+		}
+	}
+	return nil
 }
@@ -28,8 +28,9 @@ func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		id       config.ComponentID
-		expected config.Processor
+		id           config.ComponentID
+		expected     config.Processor
+		errorMessage string
 	}{
 		{
 			id: config.NewComponentIDWithName(typeStr, ""),
@@ -45,6 +46,39 @@ func TestLoadConfig(t *testing.T) {
 			id:       config.NewComponentIDWithName(typeStr, "empty"),
 			expected: createDefaultConfig(),
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "value_rules"),
+			expected: &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				AllowAllKeys:      true,
+				ValueRules: []ValueRule{
+					{Builtin: "credit_card"},
+					{Builtin: "email", Action: actionHash, Signals: []string{signalTraces, signalLogs}},
+					{Pattern: "secret-[0-9a-f]+", Action: actionRemove},
+				},
+				HashKey: "0123456789abcdef0123456789abcdef",
+			},
+		},
+		{
+			id:           config.NewComponentIDWithName(typeStr, "missing_hash_key"),
+			errorMessage: "value_rules[1]: hash_key must be set to use the hash action",
+		},
+		{
+			id:           config.NewComponentIDWithName(typeStr, "unknown_builtin"),
+			errorMessage: `value_rules[0]: unknown builtin pattern "phone_number"`,
+		},
+		{
+			id:           config.NewComponentIDWithName(typeStr, "unknown_action"),
+			errorMessage: `value_rules[0]: unknown action "encrypt"`,
+		},
+		{
+			id:           config.NewComponentIDWithName(typeStr, "unknown_signal"),
+			errorMessage: `value_rules[0]: unknown signal "profiles"`,
+		},
+		{
+			id:           config.NewComponentIDWithName(typeStr, "no_pattern"),
+			errorMessage: "value_rules[0]: either pattern or builtin must be set",
+		},
 	}
 
 	for _, tt := range tests {
@@ -59,6 +93,10 @@ func TestLoadConfig(t *testing.T) {
 			require.NoError(t, err)
 			require.NoError(t, config.UnmarshalProcessor(sub, cfg))
 
+			if tt.errorMessage != "" {
+				assert.EqualError(t, cfg.Validate(), tt.errorMessage)
+				return
+			}
 			assert.NoError(t, cfg.Validate())
 			assert.Equal(t, tt.expected, cfg)
 		})
@@ -16,6 +16,9 @@ package redactionprocessor // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"regexp"
 	"sort"
@@ -24,6 +27,8 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 )
@@ -37,11 +42,16 @@ type redaction struct {
 	allowList map[string]string
 	// Attribute values blocked in a span
 	blockRegexList map[string]*regexp.Regexp
+	// Rules detecting sensitive values, in the configuration order
+	valueRules []valueRule
+	// Secret key of the hashes of the hash action
+	hashKey []byte
 	// Redaction processor configuration
 	config *Config
 	// Logger
 	logger *zap.Logger
-	// Next trace consumer in line
+	// Next trace consumer in line, only set when the processor consumes
+	// traces directly
 	next consumer.Traces
 }
 
@@ -53,10 +63,16 @@ func newRedaction(ctx context.Context, config *Config, logger *zap.Logger, next
 		// TODO: Placeholder for an error metric in the next PR
 		return nil, fmt.Errorf("failed to process block list: %w", err)
 	}
+	valueRules, err := makeValueRules(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process value rules: %w", err)
+	}
 
 	return &redaction{
 		allowList:      allowList,
 		blockRegexList: blockRegexList,
+		valueRules:     valueRules,
+		hashKey:        []byte(config.HashKey),
 		config:         config,
 		logger:         logger,
 		next:           next,
@@ -73,13 +89,47 @@ func (s *redaction) processTraces(ctx context.Context, batch ptrace.Traces) (ptr
 	return batch, nil
 }
 
+// processLogs implements ProcessLogsFunc. It redacts the attributes and the
+// bodies of the log records
+func (s *redaction) processLogs(ctx context.Context, batch plog.Logs) (plog.Logs, error) {
+	for i := 0; i < batch.ResourceLogs().Len(); i++ {
+		rl := batch.ResourceLogs().At(i)
+		s.processAttrs(ctx, signalLogs, rl.Resource().Attributes())
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				s.processAttrs(ctx, signalLogs, lr.Attributes())
+				s.processBody(lr)
+			}
+		}
+	}
+	return batch, nil
+}
+
+// processMetrics implements ProcessMetricsFunc. It redacts the attributes of
+// the resources and of the data points
+func (s *redaction) processMetrics(ctx context.Context, batch pmetric.Metrics) (pmetric.Metrics, error) {
+	for i := 0; i < batch.ResourceMetrics().Len(); i++ {
+		rm := batch.ResourceMetrics().At(i)
+		s.processAttrs(ctx, signalMetrics, rm.Resource().Attributes())
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				s.processDataPoints(sm.Metrics().At(k))
+			}
+		}
+	}
+	return batch, nil
+}
+
 // processResourceSpan processes the RS and all of its spans and then returns the last
 // view metric context. The context can be used for tests
 func (s *redaction) processResourceSpan(ctx context.Context, rs ptrace.ResourceSpans) {
 	rsAttrs := rs.Resource().Attributes()
 
 	// Attributes can be part of a resource span
-	s.processAttrs(ctx, rsAttrs)
+	s.processAttrs(ctx, signalTraces, rsAttrs)
 
 	for j := 0; j < rs.ScopeSpans().Len(); j++ {
 		ils := rs.ScopeSpans().At(j)
@@ -88,17 +138,59 @@ func (s *redaction) processResourceSpan(ctx context.Context, rs ptrace.ResourceS
 			spanAttrs := span.Attributes()
 
 			// Attributes can also be part of span
-			s.processAttrs(ctx, spanAttrs)
+			s.processAttrs(ctx, signalTraces, spanAttrs)
 		}
 	}
 }
 
-// processAttrs redacts the attributes of a resource span or a span
-func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
+// processDataPoints redacts the attributes of the data points of a metric.
+// The diagnostic attributes are not added to data points, because they would
+// change the identity of the time series
+func (s *redaction) processDataPoints(metric pmetric.Metric) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			s.redactAttrs(signalMetrics, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			s.redactAttrs(signalMetrics, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			s.redactAttrs(signalMetrics, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			s.redactAttrs(signalMetrics, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			s.redactAttrs(signalMetrics, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+// processAttrs redacts the attributes of a resource, a span or a log record,
+// and adds the diagnostic attributes summarizing the changes
+func (s *redaction) processAttrs(_ context.Context, signal string, attributes pcommon.Map) {
 	// TODO: Use the context for recording metrics
-	var toDelete []string
-	var toBlock []string
+	toDelete, toBlock := s.redactAttrs(signal, attributes)
 
+	// Add diagnostic information to the span
+	s.addMetaAttrs(toDelete, attributes, redactedKeys, redactedKeyCount)
+	s.addMetaAttrs(toBlock, attributes, maskedValues, maskedValueCount)
+}
+
+// redactAttrs redacts the attributes and returns the keys of the removed
+// attributes and of the attributes whose value was masked
+func (s *redaction) redactAttrs(signal string, attributes pcommon.Map) (toDelete []string, toBlock []string) {
 	// Identify attributes to redact and mask in the following sequence
 	// 1. Make a list of attribute keys to redact
 	// 2. Mask any blocked values for the other attributes
@@ -128,6 +220,19 @@ func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 				value.SetStr(maskedValue)
 			}
 		}
+
+		// Apply the value rules of the signal
+		if value.Type() != pcommon.ValueTypeStr {
+			return true
+		}
+		redacted, remove := s.applyValueRules(signal, value.Str())
+		switch {
+		case remove:
+			toDelete = append(toDelete, k)
+		case redacted != value.Str():
+			toBlock = append(toBlock, k)
+			value.SetStr(redacted)
+		}
 		return true
 	})
 
@@ -135,9 +240,50 @@ func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 	for _, k := range toDelete {
 		attributes.Remove(k)
 	}
-	// Add diagnostic information to the span
-	s.addMetaAttrs(toDelete, attributes, redactedKeys, redactedKeyCount)
-	s.addMetaAttrs(toBlock, attributes, maskedValues, maskedValueCount)
+	return toDelete, toBlock
+}
+
+// processBody applies the value rules to the body of a log record. Only
+// string bodies are redacted
+func (s *redaction) processBody(lr plog.LogRecord) {
+	body := lr.Body()
+	if body.Type() != pcommon.ValueTypeStr {
+		return
+	}
+	redacted, remove := s.applyValueRules(signalLogs, body.Str())
+	switch {
+	case remove:
+		pcommon.NewValueEmpty().CopyTo(body)
+	case redacted != body.Str():
+		body.SetStr(redacted)
+	}
+}
+
+// applyValueRules applies the value rules of the signal to a value, and returns
+// the redacted value, or true if the value must be removed
+func (s *redaction) applyValueRules(signal string, value string) (string, bool) {
+	for _, rule := range s.valueRules {
+		if !rule.appliesTo(signal) || !rule.re.MatchString(value) {
+			continue
+		}
+		switch rule.action {
+		case actionRemove:
+			return "", true
+		case actionHash:
+			value = rule.re.ReplaceAllStringFunc(value, s.hashValue)
+		default:
+			value = rule.re.ReplaceAllString(value, "****")
+		}
+	}
+	return value, false
+}
+
+// hashValue returns the hex encoded HMAC-SHA256 hash of a value keyed with the
+// configured hash key
+func (s *redaction) hashValue(value string) string {
+	mac := hmac.New(sha256.New, s.hashKey)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
 }
 
 // ConsumeTraces implements the SpanProcessor interface
@@ -223,6 +369,42 @@ func makeBlockRegexList(_ context.Context, config *Config) (map[string]*regexp.R
 	return blockRegexList, nil
 }
 
+// valueRule is a compiled ValueRule
+type valueRule struct {
+	re     *regexp.Regexp
+	action string
+	// signals the rule applies to, or nil for all the signals
+	signals map[string]bool
+}
+
+func (r valueRule) appliesTo(signal string) bool {
+	return r.signals == nil || r.signals[signal]
+}
+
+// makeValueRules compiles the value rules of the configuration
+func makeValueRules(config *Config) ([]valueRule, error) {
+	rules := make([]valueRule, 0, len(config.ValueRules))
+	for i, rule := range config.ValueRules {
+		pattern := rule.Pattern
+		if rule.Builtin != "" {
+			pattern = builtinPatterns[rule.Builtin]
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling regex of value rule %d: %w", i, err)
+		}
+		compiled := valueRule{re: re, action: rule.Action}
+		if len(rule.Signals) > 0 {
+			compiled.signals = make(map[string]bool, len(rule.Signals))
+			for _, signal := range rule.Signals {
+				compiled.signals[signal] = true
+			}
+		}
+		rules = append(rules, compiled)
+	}
+	return rules, nil
+}
+
 // Capabilities specifies what this processor does, such as whether it mutates data
 func (s *redaction) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: true}
@@ -25,6 +25,8 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap/zaptest"
 )
@@ -373,7 +375,7 @@ func TestProcessAttrsAppliedTwice(t *testing.T) {
 		maskedValues:     "mystery",
 		maskedValueCount: 1,
 	})
-	processor.processAttrs(context.TODO(), attrs)
+	processor.processAttrs(context.TODO(), signalTraces, attrs)
 
 	assert.Equal(t, 7, attrs.Len())
 	val, found := attrs.Get(redactedKeys)
@@ -390,6 +392,132 @@ func TestProcessAttrsAppliedTwice(t *testing.T) {
 	assert.Equal(t, int64(2), val.Int())
 }
 
+// TestValueRulesSpans validates that the value rules mask and hash the
+// matching parts of the span attribute values
+func TestValueRulesSpans(t *testing.T) {
+	config := &Config{
+		AllowAllKeys: true,
+		ValueRules: []ValueRule{
+			{Builtin: "credit_card"},
+			{Builtin: "email", Action: actionHash},
+			{Pattern: "secret-[0-9a-f]+", Action: actionRemove},
+		},
+		HashKey: "key",
+		Summary: debug,
+	}
+	masked := map[string]pcommon.Value{
+		"card": pcommon.NewValueStr("paid with 4111111111111111"),
+		"user": pcommon.NewValueStr("contact: jane@example.com"),
+	}
+	redacted := map[string]pcommon.Value{
+		"token": pcommon.NewValueStr("secret-deadbeef"),
+	}
+	allowed := map[string]pcommon.Value{
+		"id":   pcommon.NewValueInt(4111111111111111),
+		"name": pcommon.NewValueStr("checkout"),
+	}
+
+	_, span, _ := runTest(t, allowed, redacted, masked, config)
+
+	attrs := span.Attributes()
+	val, _ := attrs.Get("card")
+	assert.Equal(t, "paid with ****", val.Str())
+	val, _ = attrs.Get("user")
+	// HMAC-SHA256 of jane@example.com keyed with "key"
+	assert.Equal(t, "contact: 43c915a5fb2a55c3ccab3ef8b4ceec305b515df308878ece0d42526f5e24484a", val.Str())
+	val, _ = attrs.Get("id")
+	assert.Equal(t, int64(4111111111111111), val.Int())
+	val, _ = attrs.Get("name")
+	assert.Equal(t, "checkout", val.Str())
+	_, found := attrs.Get("token")
+	assert.False(t, found)
+
+	val, _ = attrs.Get(maskedValues)
+	assert.Equal(t, "card,user", val.Str())
+	val, _ = attrs.Get(redactedKeys)
+	assert.Equal(t, "token", val.Str())
+}
+
+// TestValueRulesLogs validates that the value rules apply to the attributes
+// and the bodies of log records
+func TestValueRulesLogs(t *testing.T) {
+	config := &Config{
+		AllowAllKeys: true,
+		ValueRules: []ValueRule{
+			{Builtin: "email", Action: actionMask},
+			{Pattern: "password=\\S+", Action: actionRemove},
+		},
+		Summary: info,
+	}
+	processor, err := newRedaction(context.Background(), config, zaptest.NewLogger(t), nil)
+	require.NoError(t, err)
+
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("owner", "ops@example.com")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	lr := records.AppendEmpty()
+	lr.Body().SetStr("login of jane@example.com failed")
+	lr.Attributes().PutStr("user", "jane@example.com")
+	removed := records.AppendEmpty()
+	removed.Body().SetStr("login with password=hunter2")
+	structured := records.AppendEmpty()
+	structured.Body().SetEmptyMap().PutStr("user", "jane@example.com")
+
+	_, err = processor.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+
+	val, _ := rl.Resource().Attributes().Get("owner")
+	assert.Equal(t, "****", val.Str())
+	assert.Equal(t, "login of **** failed", lr.Body().Str())
+	val, _ = lr.Attributes().Get("user")
+	assert.Equal(t, "****", val.Str())
+	val, _ = lr.Attributes().Get(maskedValueCount)
+	assert.Equal(t, int64(1), val.Int())
+	assert.Equal(t, pcommon.ValueTypeEmpty, removed.Body().Type())
+
+	// Only string bodies are redacted
+	val, _ = structured.Body().Map().Get("user")
+	assert.Equal(t, "jane@example.com", val.Str())
+}
+
+// TestValueRulesMetrics validates that the rules restricted to other signals
+// are skipped and that no diagnostic attribute is added to data points
+func TestValueRulesMetrics(t *testing.T) {
+	config := &Config{
+		AllowedKeys: []string{"card", "user"},
+		ValueRules: []ValueRule{
+			{Builtin: "credit_card", Signals: []string{signalMetrics}},
+			{Builtin: "email", Signals: []string{signalTraces, signalLogs}},
+		},
+		Summary: debug,
+	}
+	processor, err := newRedaction(context.Background(), config, zaptest.NewLogger(t), nil)
+	require.NoError(t, err)
+
+	metrics := pmetric.NewMetrics()
+	rm := metrics.ResourceMetrics().AppendEmpty()
+	ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+	sum := ms.AppendEmpty()
+	sum.SetName("payments")
+	dp := sum.SetEmptySum().DataPoints().AppendEmpty()
+	dp.Attributes().PutStr("card", "4111111111111111")
+	dp.Attributes().PutStr("user", "jane@example.com")
+	dp.Attributes().PutStr("region", "eu")
+	histogram := ms.AppendEmpty()
+	histogram.SetName("payment_amounts")
+	hdp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+	hdp.Attributes().PutStr("card", "5500000000000004")
+
+	_, err = processor.processMetrics(context.Background(), metrics)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"card": "****", "user": "jane@example.com"}, dp.Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{"card": "****"}, hdp.Attributes().AsRaw())
+	_, found := rm.Resource().Attributes().Get(redactedKeys)
+	assert.False(t, found)
+}
+
 // runTest transforms the test input data and passes it through the processor
 func runTest(
 	t *testing.T,
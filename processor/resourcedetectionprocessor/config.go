@@ -54,6 +54,10 @@ type DetectorConfig struct {
 
 	// SystemConfig contains user-specified configurations for the System detector
 	SystemConfig system.Config `mapstructure:"system"`
+
+	// DetectorAttributes is an allowlist of attributes to add per detector type.
+	// Detectors without an entry add all the attributes they detect.
+	DetectorAttributes map[string][]string `mapstructure:"detector_attributes"`
 }
 
 func (d *DetectorConfig) GetConfigFromType(detectorType internal.DetectorType) internal.DetectorConfig {
@@ -69,6 +73,11 @@ func (d *DetectorConfig) GetConfigFromType(detectorType internal.DetectorType) i
 	}
 }
 
+// GetAttributesFromType returns the allowlist of attributes for the given detector type
+func (d *DetectorConfig) GetAttributesFromType(detectorType internal.DetectorType) []string {
+	return d.DetectorAttributes[string(detectorType)]
+}
+
 // Validate config
 func (cfg *Config) Validate() error {
 	return cfg.DetectorConfig.SystemConfig.Validate()
@@ -79,6 +79,20 @@ func TestLoadConfig(t *testing.T) {
 				Attributes:         []string{"a", "b"},
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "detector_attributes"),
+			expected: &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				Detectors:         []string{"env", "azure_vmss", "heroku"},
+				DetectorConfig: DetectorConfig{
+					DetectorAttributes: map[string][]string{
+						"azure_vmss": {"cloud.region", "azure.vm.scaleset.name"},
+					},
+				},
+				HTTPClientSettings: cfg,
+				Override:           false,
+			},
+		},
 		{
 			id:           config.NewComponentIDWithName(typeStr, "invalid"),
 			errorMessage: "hostname_sources contains invalid value: \"invalid_source\"",
@@ -156,3 +170,13 @@ func TestGetConfigFromType(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAttributesFromType(t *testing.T) {
+	cfg := DetectorConfig{
+		DetectorAttributes: map[string][]string{
+			"heroku": {"service.name"},
+		},
+	}
+	assert.Equal(t, []string{"service.name"}, cfg.GetAttributesFromType("heroku"))
+	assert.Nil(t, cfg.GetAttributesFromType("azure"))
+}
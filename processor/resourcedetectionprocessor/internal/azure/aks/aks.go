@@ -17,6 +17,7 @@ package aks // import "github.com/open-telemetry/opentelemetry-collector-contrib
 import (
 	"context"
 	"os"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -51,13 +52,23 @@ func (d *Detector) Detect(ctx context.Context) (resource pcommon.Resource, schem
 	}
 
 	// If we can't get a response from the metadata endpoint, we're not running in Azure
-	if !azureMetadataAvailable(ctx, d.provider) {
+	compute, err := d.provider.Metadata(ctx)
+	if err != nil {
 		return res, "", nil
 	}
 
 	attrs := res.Attributes()
 	attrs.PutStr(conventions.AttributeCloudProvider, conventions.AttributeCloudProviderAzure)
 	attrs.PutStr(conventions.AttributeCloudPlatform, conventions.AttributeCloudPlatformAzureAKS)
+	if compute.Location != "" {
+		attrs.PutStr(conventions.AttributeCloudRegion, compute.Location)
+	}
+	if compute.SubscriptionID != "" {
+		attrs.PutStr(conventions.AttributeCloudAccountID, compute.SubscriptionID)
+	}
+	if clusterName := parseClusterName(compute.ResourceGroupName); clusterName != "" {
+		attrs.PutStr(conventions.AttributeK8SClusterName, clusterName)
+	}
 
 	return res, conventions.SchemaURL, nil
 }
@@ -66,7 +77,14 @@ func onK8s() bool {
 	return os.Getenv(kubernetesServiceHostEnvVar) != ""
 }
 
-func azureMetadataAvailable(ctx context.Context, p azure.Provider) bool {
-	_, err := p.Metadata(ctx)
-	return err == nil
+// parseClusterName extracts the cluster name from the name of the resource group
+// AKS creates for the cluster nodes, which has the form MC_<resource group>_<cluster>_<location>.
+// It returns an empty string if the resource group does not follow this form, for
+// instance because a custom node resource group was configured.
+func parseClusterName(resourceGroup string) string {
+	parts := strings.Split(resourceGroup, "_")
+	if len(parts) != 4 || !strings.EqualFold(parts[0], "mc") {
+		return ""
+	}
+	return parts[2]
 }
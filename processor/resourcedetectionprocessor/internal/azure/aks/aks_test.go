@@ -47,6 +47,44 @@ func TestDetector_Detect_K8s_Azure(t *testing.T) {
 	}, internal.AttributesToMap(res.Attributes()), "Resource attrs returned are incorrect")
 }
 
+func TestDetector_Detect_K8s_Azure_Metadata(t *testing.T) {
+	t.Setenv("KUBERNETES_SERVICE_HOST", "localhost")
+	mp := &azure.MockProvider{}
+	mp.On("Metadata").Return(&azure.ComputeMetadata{
+		Location:          "westeurope",
+		SubscriptionID:    "subscription-id",
+		ResourceGroupName: "MC_myResourceGroup_myCluster_westeurope",
+	}, nil)
+	detector := &Detector{provider: mp}
+	res, _, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"cloud.provider":   "azure",
+		"cloud.platform":   "azure_aks",
+		"cloud.region":     "westeurope",
+		"cloud.account.id": "subscription-id",
+		"k8s.cluster.name": "myCluster",
+	}, internal.AttributesToMap(res.Attributes()), "Resource attrs returned are incorrect")
+}
+
+func TestParseClusterName(t *testing.T) {
+	tests := []struct {
+		resourceGroup string
+		expected      string
+	}{
+		{resourceGroup: "MC_myResourceGroup_myCluster_westeurope", expected: "myCluster"},
+		{resourceGroup: "mc_rg_cluster_eastus", expected: "cluster"},
+		{resourceGroup: "myCustomNodeResourceGroup", expected: ""},
+		{resourceGroup: "MC_my_Resource_Group_myCluster_westeurope", expected: ""},
+		{resourceGroup: "", expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.resourceGroup, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseClusterName(tt.resourceGroup))
+		})
+	}
+}
+
 func TestDetector_Detect_K8s_NonAzure(t *testing.T) {
 	t.Setenv("KUBERNETES_SERVICE_HOST", "localhost")
 	mp := &azure.MockProvider{}
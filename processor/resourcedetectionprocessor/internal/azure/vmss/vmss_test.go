@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vmss
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/metadataproviders/azure"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+)
+
+func TestNewDetector(t *testing.T) {
+	d, err := NewDetector(componenttest.NewNopProcessorCreateSettings(), nil)
+	require.NoError(t, err)
+	assert.NotNil(t, d)
+}
+
+func TestDetectScaleSet(t *testing.T) {
+	mp := &azure.MockProvider{}
+	mp.On("Metadata").Return(&azure.ComputeMetadata{
+		Location:          "location",
+		Name:              "myScaleset_0",
+		VMID:              "vmID",
+		VMSize:            "vmSize",
+		SubscriptionID:    "subscriptionID",
+		ResourceGroupName: "resourceGroup",
+		VMScaleSetName:    "myScaleset",
+	}, nil)
+
+	detector := &Detector{provider: mp, logger: zap.NewNop()}
+	res, schemaURL, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, conventions.SchemaURL, schemaURL)
+	mp.AssertExpectations(t)
+
+	assert.Equal(t, map[string]interface{}{
+		conventions.AttributeCloudProvider:  conventions.AttributeCloudProviderAzure,
+		conventions.AttributeCloudPlatform:  conventions.AttributeCloudPlatformAzureVM,
+		conventions.AttributeCloudRegion:    "location",
+		conventions.AttributeCloudAccountID: "subscriptionID",
+		conventions.AttributeHostID:         "vmID",
+		conventions.AttributeHostName:       "myScaleset_0",
+		"azure.vm.scaleset.name":            "myScaleset",
+		"azure.resourcegroup.name":          "resourceGroup",
+	}, internal.AttributesToMap(res.Attributes()))
+}
+
+func TestDetectNotScaleSet(t *testing.T) {
+	mp := &azure.MockProvider{}
+	mp.On("Metadata").Return(&azure.ComputeMetadata{
+		Location: "location",
+		Name:     "name",
+		VMID:     "vmID",
+	}, nil)
+
+	detector := &Detector{provider: mp, logger: zap.NewNop()}
+	res, _, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.True(t, internal.IsEmptyResource(res))
+}
+
+func TestDetectError(t *testing.T) {
+	mp := &azure.MockProvider{}
+	mp.On("Metadata").Return(&azure.ComputeMetadata{}, fmt.Errorf("mock error"))
+
+	detector := &Detector{provider: mp, logger: zap.NewNop()}
+	res, _, err := detector.Detect(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, internal.IsEmptyResource(res))
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package heroku // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/heroku"
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+)
+
+const (
+	// TypeStr is type of detector.
+	TypeStr = "heroku"
+
+	cloudProviderHeroku = "heroku"
+
+	// Environment variables set by the Heroku dyno metadata feature,
+	// see https://devcenter.heroku.com/articles/dyno-metadata
+	herokuDynoID                 = "HEROKU_DYNO_ID"
+	herokuAppID                  = "HEROKU_APP_ID"
+	herokuAppName                = "HEROKU_APP_NAME"
+	herokuReleaseCreatedAt       = "HEROKU_RELEASE_CREATED_AT"
+	herokuReleaseVersion         = "HEROKU_RELEASE_VERSION"
+	herokuSlugCommit             = "HEROKU_SLUG_COMMIT"
+	attributeHerokuAppID         = "heroku.app.id"
+	attributeHerokuReleaseTime   = "heroku.release.creation_timestamp"
+	attributeHerokuReleaseCommit = "heroku.release.commit"
+)
+
+var _ internal.Detector = (*Detector)(nil)
+
+// Detector is a Heroku dyno metadata detector
+type Detector struct{}
+
+// NewDetector creates a new Heroku dyno metadata detector
+func NewDetector(component.ProcessorCreateSettings, internal.DetectorConfig) (internal.Detector, error) {
+	return &Detector{}, nil
+}
+
+// Detect detects the dyno metadata set in the environment and returns a resource with the available ones
+func (d *Detector) Detect(context.Context) (resource pcommon.Resource, schemaURL string, err error) {
+	res := pcommon.NewResource()
+
+	dynoID, ok := os.LookupEnv(herokuDynoID)
+	if !ok {
+		// Not running on Heroku, or the dyno metadata feature is not enabled.
+		return res, "", nil
+	}
+
+	attrs := res.Attributes()
+	attrs.PutStr(conventions.AttributeCloudProvider, cloudProviderHeroku)
+	attrs.PutStr(conventions.AttributeServiceInstanceID, dynoID)
+	putEnv(attrs, conventions.AttributeServiceName, herokuAppName)
+	putEnv(attrs, conventions.AttributeServiceVersion, herokuReleaseVersion)
+	putEnv(attrs, attributeHerokuAppID, herokuAppID)
+	putEnv(attrs, attributeHerokuReleaseTime, herokuReleaseCreatedAt)
+	putEnv(attrs, attributeHerokuReleaseCommit, herokuSlugCommit)
+
+	return res, conventions.SchemaURL, nil
+}
+
+func putEnv(attrs pcommon.Map, key string, envVar string) {
+	if v := os.Getenv(envVar); v != "" {
+		attrs.PutStr(key, v)
+	}
+}
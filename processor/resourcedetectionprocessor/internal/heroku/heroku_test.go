@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package heroku
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+)
+
+func TestNewDetector(t *testing.T) {
+	d, err := NewDetector(componenttest.NewNopProcessorCreateSettings(), nil)
+	require.NoError(t, err)
+	assert.NotNil(t, d)
+}
+
+func TestDetectHeroku(t *testing.T) {
+	t.Setenv("HEROKU_DYNO_ID", "foo")
+	t.Setenv("HEROKU_APP_ID", "appid")
+	t.Setenv("HEROKU_APP_NAME", "appname")
+	t.Setenv("HEROKU_RELEASE_CREATED_AT", "createdat")
+	t.Setenv("HEROKU_RELEASE_VERSION", "v1")
+	t.Setenv("HEROKU_SLUG_COMMIT", "23456")
+
+	detector := &Detector{}
+	res, schemaURL, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, conventions.SchemaURL, schemaURL)
+	assert.Equal(t, map[string]interface{}{
+		"cloud.provider":                    "heroku",
+		"service.instance.id":               "foo",
+		"service.name":                      "appname",
+		"service.version":                   "v1",
+		"heroku.app.id":                     "appid",
+		"heroku.release.creation_timestamp": "createdat",
+		"heroku.release.commit":             "23456",
+	}, internal.AttributesToMap(res.Attributes()))
+}
+
+func TestDetectHerokuMissingVariables(t *testing.T) {
+	t.Setenv("HEROKU_DYNO_ID", "foo")
+
+	detector := &Detector{}
+	res, _, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"cloud.provider":      "heroku",
+		"service.instance.id": "foo",
+	}, internal.AttributesToMap(res.Attributes()))
+}
+
+func TestNotHeroku(t *testing.T) {
+	detector := &Detector{}
+	res, schemaURL, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "", schemaURL)
+	assert.Equal(t, 0, res.Attributes().Len())
+}
@@ -38,6 +38,9 @@ type DetectorConfig interface{}
 
 type ResourceDetectorConfig interface {
 	GetConfigFromType(DetectorType) DetectorConfig
+	// GetAttributesFromType returns the attributes to keep from the resource returned
+	// by the given detector type. All attributes are kept if it returns an empty list.
+	GetAttributesFromType(DetectorType) []string
 }
 
 type DetectorFactory func(component.ProcessorCreateSettings, DetectorConfig) (Detector, error)
@@ -86,12 +89,36 @@ func (f *ResourceProviderFactory) getDetectors(params component.ProcessorCreateS
 			return nil, fmt.Errorf("failed creating detector type %q: %w", detectorType, err)
 		}
 
+		if attributes := detectorConfigs.GetAttributesFromType(detectorType); len(attributes) > 0 {
+			attributesToKeep := make(map[string]struct{}, len(attributes))
+			for _, attribute := range attributes {
+				attributesToKeep[attribute] = struct{}{}
+			}
+			detector = &filteringDetector{detector: detector, attributesToKeep: attributesToKeep}
+		}
+
 		detectors = append(detectors, detector)
 	}
 
 	return detectors, nil
 }
 
+// filteringDetector keeps only the allowed attributes of the resource returned
+// by the wrapped detector.
+type filteringDetector struct {
+	detector         Detector
+	attributesToKeep map[string]struct{}
+}
+
+func (d *filteringDetector) Detect(ctx context.Context) (resource pcommon.Resource, schemaURL string, err error) {
+	resource, schemaURL, err = d.detector.Detect(ctx)
+	if err != nil {
+		return resource, schemaURL, err
+	}
+	filterAttributes(resource.Attributes(), d.attributesToKeep)
+	return resource, schemaURL, nil
+}
+
 type ResourceProvider struct {
 	logger           *zap.Logger
 	timeout          time.Duration
@@ -119,7 +146,11 @@ func NewResourceProvider(logger *zap.Logger, timeout time.Duration, attributesTo
 func (p *ResourceProvider) Get(ctx context.Context, client *http.Client) (resource pcommon.Resource, schemaURL string, err error) {
 	p.once.Do(func() {
 		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, client.Timeout)
+		if client.Timeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, client.Timeout)
+		} else {
+			ctx, cancel = context.WithCancel(ctx)
+		}
 		defer cancel()
 		p.detectResource(ctx)
 	})
@@ -135,14 +166,31 @@ func (p *ResourceProvider) detectResource(ctx context.Context) {
 
 	p.logger.Info("began detecting resource information")
 
-	for _, detector := range p.detectors {
-		r, schemaURL, err := detector.Detect(ctx)
-		if err != nil {
-			p.logger.Warn("failed to detect resource", zap.Error(err))
-		} else {
-			mergedSchemaURL = MergeSchemaURL(mergedSchemaURL, schemaURL)
-			MergeResource(res, r, false)
+	// All detectors run in parallel and share the timeout budget of the context. The
+	// results are merged in the configured order, so that the precedence of the detectors
+	// does not depend on which one finishes first.
+	results := make([]chan *resourceResult, len(p.detectors))
+	for i, detector := range p.detectors {
+		results[i] = make(chan *resourceResult, 1)
+		go func(detector Detector, result chan<- *resourceResult) {
+			r, schemaURL, err := detector.Detect(ctx)
+			result <- &resourceResult{resource: r, schemaURL: schemaURL, err: err}
+		}(detector, results[i])
+	}
+
+	for i, result := range results {
+		r, ok := waitResult(ctx, result)
+		if !ok {
+			p.logger.Warn("detector did not finish within the timeout, skipping it",
+				zap.Int("detector index", i), zap.Error(ctx.Err()))
+			continue
+		}
+		if r.err != nil {
+			p.logger.Warn("failed to detect resource", zap.Error(r.err))
+			continue
 		}
+		mergedSchemaURL = MergeSchemaURL(mergedSchemaURL, r.schemaURL)
+		MergeResource(res, r.resource, false)
 	}
 
 	droppedAttributes := filterAttributes(res.Attributes(), p.attributesToKeep)
@@ -156,6 +204,22 @@ func (p *ResourceProvider) detectResource(ctx context.Context) {
 	p.detectedResource.schemaURL = mergedSchemaURL
 }
 
+// waitResult waits for the result of a detector until the context is done. A result
+// that is already available is returned even if the context is done.
+func waitResult(ctx context.Context, result <-chan *resourceResult) (*resourceResult, bool) {
+	select {
+	case r := <-result:
+		return r, true
+	default:
+	}
+	select {
+	case r := <-result:
+		return r, true
+	case <-ctx.Done():
+		return nil, false
+	}
+}
+
 func AttributesToMap(am pcommon.Map) map[string]interface{} {
 	mp := make(map[string]interface{}, am.Len())
 	am.Range(func(k string, v pcommon.Value) bool {
@@ -41,12 +41,18 @@ func (p *MockDetector) Detect(ctx context.Context) (pcommon.Resource, string, er
 	return args.Get(0).(pcommon.Resource), "", args.Error(1)
 }
 
-type mockDetectorConfig struct{}
+type mockDetectorConfig struct {
+	attributes map[DetectorType][]string
+}
 
 func (d *mockDetectorConfig) GetConfigFromType(detectorType DetectorType) DetectorConfig {
 	return nil
 }
 
+func (d *mockDetectorConfig) GetAttributesFromType(detectorType DetectorType) []string {
+	return d.attributes[detectorType]
+}
+
 func TestDetect(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -201,6 +207,52 @@ func (p *MockParallelDetector) Detect(ctx context.Context) (pcommon.Resource, st
 	return args.Get(0).(pcommon.Resource), "", args.Error(1)
 }
 
+func TestDetectResource_DetectorAttributes(t *testing.T) {
+	md1 := &MockDetector{}
+	md1.On("Detect").Return(NewResource(map[string]interface{}{"a": "1", "b": "2"}), nil)
+	md2 := &MockDetector{}
+	md2.On("Detect").Return(NewResource(map[string]interface{}{"a": "11", "c": "3"}), nil)
+
+	f := NewProviderFactory(map[DetectorType]DetectorFactory{
+		"md1": func(component.ProcessorCreateSettings, DetectorConfig) (Detector, error) { return md1, nil },
+		"md2": func(component.ProcessorCreateSettings, DetectorConfig) (Detector, error) { return md2, nil },
+	})
+	cfg := &mockDetectorConfig{attributes: map[DetectorType][]string{"md1": {"b"}}}
+	p, err := f.CreateResourceProvider(componenttest.NewNopProcessorCreateSettings(), time.Second, nil, cfg, "md1", "md2")
+	require.NoError(t, err)
+
+	got, _, err := p.Get(context.Background(), http.DefaultClient)
+	require.NoError(t, err)
+
+	expected := NewResource(map[string]interface{}{"a": "11", "b": "2", "c": "3"})
+	expected.Attributes().Sort()
+	got.Attributes().Sort()
+	assert.Equal(t, expected, got)
+}
+
+type blockingDetector struct {
+	release chan struct{}
+}
+
+func (d *blockingDetector) Detect(context.Context) (pcommon.Resource, string, error) {
+	<-d.release
+	return NewResource(map[string]interface{}{"a": "late"}), "", nil
+}
+
+func TestDetectResource_Timeout(t *testing.T) {
+	md := &MockDetector{}
+	md.On("Detect").Return(NewResource(map[string]interface{}{"b": "2"}), nil)
+
+	bd := &blockingDetector{release: make(chan struct{})}
+	defer close(bd.release)
+
+	p := NewResourceProvider(zap.NewNop(), time.Second, nil, bd, md)
+
+	got, _, err := p.Get(context.Background(), &http.Client{Timeout: 50 * time.Millisecond})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"b": "2"}, AttributesToMap(got.Attributes()))
+}
+
 // TestDetectResource_Parallel validates that Detect is only called once, even if there
 // are multiple calls to ResourceProvider.Get
 func TestDetectResource_Parallel(t *testing.T) {
@@ -27,6 +27,7 @@ var (
 	errNoExporters            = errors.New("no exporters defined for the route")
 	errNoTableItems           = errors.New("the routing table is empty")
 	errNoMissingFromAttribute = errors.New("the FromAttribute property is empty")
+	errOTTLWithContextSource  = errors.New("statement and condition routes require the resource attribute source when from_attribute is set")
 )
 
 // Config defines configuration for the Routing processor.
@@ -61,6 +62,14 @@ type Config struct {
 	// Table contains the routing table for this processor.
 	// Required.
 	Table []RoutingTableItem `mapstructure:"table"`
+
+	// MatchMode controls how the routes with a statement or a condition are matched.
+	// The allowed values are:
+	// - "all" - the data is routed to the exporters of all the matching routes
+	// - "first" - the data is routed to the exporters of the first matching route, in the table order
+	// The default value is "all".
+	// Optional.
+	MatchMode MatchMode `mapstructure:"match_mode"`
 }
 
 // Validate checks if the processor configuration is valid.
@@ -72,8 +81,9 @@ func (c *Config) Validate() error {
 
 	// validate that every route has a value for the routing attribute and has
 	// at least one exporter
+	hasValueRoutes, hasOTTLRoutes := false, false
 	for _, item := range c.Table {
-		if len(item.Value) == 0 && len(item.Statement) == 0 {
+		if len(item.Value) == 0 && len(item.Statement) == 0 && len(item.Condition) == 0 {
 			return fmt.Errorf("invalid (empty) route : %w", errEmptyRoute)
 		}
 
@@ -81,19 +91,40 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("invalid route: both statement (%s) and value (%s) provided", item.Statement, item.Value)
 		}
 
+		if len(item.Condition) != 0 && (len(item.Value) != 0 || len(item.Statement) != 0) {
+			return fmt.Errorf("invalid route: condition (%s) provided with a statement or a value", item.Condition)
+		}
+
 		if len(item.Exporters) == 0 {
-			return fmt.Errorf("invalid route %s: %w", item.Value, errNoExporters)
+			return fmt.Errorf("invalid route %s: %w", key(item), errNoExporters)
+		}
+
+		if len(item.Value) != 0 {
+			hasValueRoutes = true
+		} else {
+			hasOTTLRoutes = true
 		}
 	}
 
-	// we also need a "FromAttribute" value
-	if len(c.FromAttribute) == 0 {
+	// we also need a "FromAttribute" value to route by value
+	if hasValueRoutes && len(c.FromAttribute) == 0 {
 		return fmt.Errorf(
 			"invalid attribute to read the route's value from: %w",
 			errNoMissingFromAttribute,
 		)
 	}
 
+	// statements and conditions are only evaluated against the resource
+	if hasOTTLRoutes && len(c.FromAttribute) != 0 && c.AttributeSource != resourceAttributeSource {
+		return errOTTLWithContextSource
+	}
+
+	switch c.MatchMode {
+	case "", allMatchMode, firstMatchMode:
+	default:
+		return fmt.Errorf("invalid match_mode %q, must be %q or %q", c.MatchMode, allMatchMode, firstMatchMode)
+	}
+
 	if c.AttributeSource != resourceAttributeSource && c.DropRoutingResourceAttribute {
 		return errors.New("using a different attribute source than 'attribute' and drop_resource_routing_attribute is set to true")
 	}
@@ -110,6 +141,13 @@ const (
 	defaultAttributeSource = contextAttributeSource
 )
 
+type MatchMode string
+
+const (
+	allMatchMode   = MatchMode("all")
+	firstMatchMode = MatchMode("first")
+)
+
 // RoutingTableItem specifies how data should be routed to the different exporters
 type RoutingTableItem struct {
 	// Value represents a possible value for the field specified under FromAttribute.
@@ -117,9 +155,14 @@ type RoutingTableItem struct {
 	Value string `mapstructure:"value"`
 
 	// Statement is a OTTL statement used for making a routing decision.
-	// Required when 'Value' isn't provided.
+	// Required when neither 'Value' nor 'Condition' is provided.
 	Statement string `mapstructure:"statement"`
 
+	// Condition is a OTTL boolean expression used for making a routing decision,
+	// e.g. `resource.attributes["region"] == "eu" and resource.attributes["tier"] != "free"`.
+	// Required when neither 'Value' nor 'Statement' is provided.
+	Condition string `mapstructure:"condition"`
+
 	// Exporters contains the list of exporters to use when the value from the FromAttribute field matches this table item.
 	// When no exporters are specified, the ones specified under DefaultExporters are used, if any.
 	// The routing processor will fail upon the first failure from these exporters.
@@ -127,9 +170,10 @@ type RoutingTableItem struct {
 	Exporters []string `mapstructure:"exporters"`
 }
 
-// rewriteRoutingEntriesToOTTL translates the attributes-based routing into OTTL
+// rewriteRoutingEntriesToOTTL translates the attributes-based routing and the
+// routing conditions into OTTL statements
 func rewriteRoutingEntriesToOTTL(cfg *Config) *Config {
-	if cfg.AttributeSource != resourceAttributeSource {
+	if cfg.AttributeSource != resourceAttributeSource && cfg.FromAttribute != "" {
 		return cfg
 	}
 	table := make([]RoutingTableItem, 0, len(cfg.Table))
@@ -138,6 +182,15 @@ func rewriteRoutingEntriesToOTTL(cfg *Config) *Config {
 			table = append(table, e)
 			continue
 		}
+		if e.Condition != "" {
+			// conditions can't be parsed on their own, so they are wrapped
+			// into a statement invoking the noop route function
+			table = append(table, RoutingTableItem{
+				Statement: fmt.Sprintf("route() where %s", e.Condition),
+				Exporters: e.Exporters,
+			})
+			continue
+		}
 		var s strings.Builder
 		if cfg.DropRoutingResourceAttribute {
 			s.WriteString(
@@ -164,5 +217,6 @@ func rewriteRoutingEntriesToOTTL(cfg *Config) *Config {
 	return &Config{
 		DefaultExporters: cfg.DefaultExporters,
 		Table:            table,
+		MatchMode:        cfg.MatchMode,
 	}
 }
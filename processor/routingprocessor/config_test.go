@@ -140,6 +140,62 @@ func TestValidateConfig(t *testing.T) {
 			},
 			error: "invalid (empty) route : empty routing attribute provided",
 		},
+		{
+			name: "both condition and statement specified",
+			config: &Config{
+				Table: []RoutingTableItem{
+					{
+						Exporters: []string{"otlp"},
+						Statement: `route() where resource.attributes["attr"] == "acme"`,
+						Condition: `resource.attributes["attr"] == "acme"`,
+					},
+				},
+			},
+			error: "invalid route: condition (resource.attributes[\"attr\"] == \"acme\") provided with a statement or a value",
+		},
+		{
+			name: "value route without from attribute",
+			config: &Config{
+				Table: []RoutingTableItem{
+					{
+						Exporters: []string{"otlp"},
+						Value:     "acme",
+					},
+				},
+			},
+			error: "invalid attribute to read the route's value from: the FromAttribute property is empty",
+		},
+		{
+			name: "condition route with context as routing attribute source",
+			config: &Config{
+				FromAttribute:   "attr",
+				AttributeSource: contextAttributeSource,
+				Table: []RoutingTableItem{
+					{
+						Exporters: []string{"otlp"},
+						Value:     "acme",
+					},
+					{
+						Exporters: []string{"otlp/2"},
+						Condition: `resource.attributes["attr"] == "ecorp"`,
+					},
+				},
+			},
+			error: errOTTLWithContextSource.Error(),
+		},
+		{
+			name: "invalid match mode",
+			config: &Config{
+				MatchMode: "any",
+				Table: []RoutingTableItem{
+					{
+						Exporters: []string{"otlp"},
+						Condition: `resource.attributes["attr"] == "acme"`,
+					},
+				},
+			},
+			error: `invalid match_mode "any", must be "all" or "first"`,
+		},
 		{
 			name: "drop routing attribute with context as routing attribute source",
 			config: &Config{
@@ -294,6 +350,37 @@ func TestRewriteLegacyConfigToOTTL(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "rewrite conditions",
+			config: Config{
+				DefaultExporters: []string{"otlp"},
+				MatchMode:        firstMatchMode,
+				Table: []RoutingTableItem{
+					{
+						Exporters: []string{"otlp/eu"},
+						Condition: `resource.attributes["region"] == "eu" and resource.attributes["tier"] != "free"`,
+					},
+					{
+						Exporters: []string{"otlp/2"},
+						Statement: `route() where resource.attributes["attr"] == "ecorp"`,
+					},
+				},
+			},
+			want: Config{
+				DefaultExporters: []string{"otlp"},
+				MatchMode:        firstMatchMode,
+				Table: []RoutingTableItem{
+					{
+						Exporters: []string{"otlp/eu"},
+						Statement: `route() where resource.attributes["region"] == "eu" and resource.attributes["tier"] != "free"`,
+					},
+					{
+						Exporters: []string{"otlp/2"},
+						Statement: `route() where resource.attributes["attr"] == "ecorp"`,
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
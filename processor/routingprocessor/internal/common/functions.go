@@ -22,6 +22,9 @@ import (
 func Functions[K any]() map[string]interface{} {
 	return map[string]interface{}{
 		"IsMatch":              ottlfuncs.IsMatch[K],
+		"HasPrefix":            ottlfuncs.HasPrefix[K],
+		"Hex":                  ottlfuncs.Hex[K],
+		"FromHex":              ottlfuncs.FromHex[K],
 		"delete_key":           ottlfuncs.DeleteKey[K],
 		"delete_matching_keys": ottlfuncs.DeleteMatchingKeys[K],
 		// noop function, it is required since the parsing of conditions is not implemented yet,
@@ -101,16 +101,20 @@ func (p *metricsProcessor) route(ctx context.Context, tm pmetric.Metrics) error
 			rmetrics.Resource(),
 		)
 
-		matchCount := len(p.router.routes)
-		for key, route := range p.router.routes {
+		matched := false
+		for _, key := range p.router.routeKeys {
+			route := p.router.routes[key]
 			if _, isMatch := route.statement.Execute(mtx); !isMatch {
-				matchCount--
 				continue
 			}
+			matched = true
 			p.group(key, groups, route.exporters, rmetrics)
+			if p.config.MatchMode == firstMatchMode {
+				break
+			}
 		}
 
-		if matchCount == 0 {
+		if !matched {
 			// no route conditions are matched, add resource metrics to default exporters group
 			p.group("", groups, p.router.defaultExporters, rmetrics)
 		}
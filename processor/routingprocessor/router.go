@@ -39,6 +39,8 @@ type router[E component.Exporter, K any] struct {
 
 	defaultExporters []E
 	routes           map[string]routingItem[E, K]
+	// routeKeys holds the keys of the routes in the routing table order
+	routeKeys []string
 }
 
 // newRouter creates a new router instance with its type parameter constrained
@@ -110,6 +112,7 @@ func (r *router[E, K]) registerRouteExporters(available map[config.ComponentID]c
 		route, ok := r.routes[key(item)]
 		if !ok {
 			route.statement = statement
+			r.routeKeys = append(r.routeKeys, key(item))
 		}
 
 		for _, name := range item.Exporters {
@@ -149,6 +152,9 @@ func key(entry RoutingTableItem) string {
 	if entry.Value != "" {
 		return entry.Value
 	}
+	if entry.Condition != "" {
+		return entry.Condition
+	}
 	return entry.Statement
 }
 
@@ -99,16 +99,20 @@ func (p *tracesProcessor) route(ctx context.Context, t ptrace.Traces) error {
 			rspans.Resource(),
 		)
 
-		matchCount := len(p.router.routes)
-		for key, route := range p.router.routes {
+		matched := false
+		for _, key := range p.router.routeKeys {
+			route := p.router.routes[key]
 			if _, isMatch := route.statement.Execute(stx); !isMatch {
-				matchCount--
 				continue
 			}
+			matched = true
 			p.group(key, groups, route.exporters, rspans)
+			if p.config.MatchMode == firstMatchMode {
+				break
+			}
 		}
 
-		if matchCount == 0 {
+		if !matched {
 			// no route conditions are matched, add resource spans to default exporters group
 			p.group("", groups, p.router.defaultExporters, rspans)
 		}
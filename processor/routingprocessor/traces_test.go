@@ -461,6 +461,86 @@ func TestTracesAreCorrectlySplitPerResourceAttributeWithOTTL(t *testing.T) {
 	})
 }
 
+func TestTracesAreRoutedToFirstMatchingCondition(t *testing.T) {
+	defaultExp := &mockTracesExporter{}
+	firstExp := &mockTracesExporter{}
+	secondExp := &mockTracesExporter{}
+
+	host := &mockHost{
+		Host: componenttest.NewNopHost(),
+		GetExportersFunc: func() map[config.DataType]map[config.ComponentID]component.Exporter {
+			return map[config.DataType]map[config.ComponentID]component.Exporter{
+				config.TracesDataType: {
+					config.NewComponentID("otlp"):              defaultExp,
+					config.NewComponentIDWithName("otlp", "1"): firstExp,
+					config.NewComponentIDWithName("otlp", "2"): secondExp,
+				},
+			}
+		},
+	}
+
+	exp := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+		DefaultExporters: []string{"otlp"},
+		MatchMode:        firstMatchMode,
+		Table: []RoutingTableItem{
+			{
+				Condition: `resource.attributes["region"] == "eu" and resource.attributes["tier"] != "free"`,
+				Exporters: []string{"otlp/1"},
+			},
+			{
+				Condition: `resource.attributes["region"] == "eu"`,
+				Exporters: []string{"otlp/2"},
+			},
+		},
+	})
+	require.NoError(t, exp.Start(context.Background(), host))
+
+	newTraces := func(region, tier string) ptrace.Traces {
+		tr := ptrace.NewTraces()
+		rl := tr.ResourceSpans().AppendEmpty()
+		rl.Resource().Attributes().PutStr("region", region)
+		rl.Resource().Attributes().PutStr("tier", tier)
+		rl.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+		return tr
+	}
+
+	t.Run("span matched by both conditions is routed to the first route", func(t *testing.T) {
+		defaultExp.Reset()
+		firstExp.Reset()
+		secondExp.Reset()
+
+		require.NoError(t, exp.ConsumeTraces(context.Background(), newTraces("eu", "paid")))
+
+		assert.Len(t, defaultExp.AllTraces(), 0)
+		assert.Len(t, firstExp.AllTraces(), 1)
+		assert.Len(t, secondExp.AllTraces(), 0)
+	})
+
+	t.Run("span falls through to the second route", func(t *testing.T) {
+		defaultExp.Reset()
+		firstExp.Reset()
+		secondExp.Reset()
+
+		require.NoError(t, exp.ConsumeTraces(context.Background(), newTraces("eu", "free")))
+
+		assert.Len(t, defaultExp.AllTraces(), 0)
+		assert.Len(t, firstExp.AllTraces(), 0)
+		assert.Len(t, secondExp.AllTraces(), 1)
+	})
+
+	t.Run("span matched by no conditions is routed to the default exporters", func(t *testing.T) {
+		defaultExp.Reset()
+		firstExp.Reset()
+		secondExp.Reset()
+
+		require.NoError(t, exp.ConsumeTraces(context.Background(), newTraces("us", "paid")))
+
+		assert.Len(t, defaultExp.AllTraces(), 1)
+		assert.Len(t, firstExp.AllTraces(), 0)
+		assert.Len(t, secondExp.AllTraces(), 0)
+	})
+}
+
 func TestTraceProcessorCapabilities(t *testing.T) {
 	// prepare
 	config := &Config{
@@ -44,6 +44,13 @@ type Config struct {
 	// translated to, allowing older and newer formats
 	// to conform to the target schema identifier.
 	Targets []string `mapstructure:"targets"`
+
+	// OriginalExporters lists the exporters that receive the data
+	// as it was before translation. When set, a copy of the data is
+	// translated and forwarded down the pipeline while the original
+	// is sent unchanged to these exporters, which allows exporting
+	// both the old and the new conventions during a migration. (Optional field)
+	OriginalExporters []string `mapstructure:"original_exporters"`
 }
 
 func (c *Config) Validate() error {
@@ -72,5 +79,11 @@ func (c *Config) Validate() error {
 		families[family] = struct{}{}
 	}
 
+	for _, exporter := range c.OriginalExporters {
+		if _, err := config.NewComponentIDFromString(exporter); err != nil {
+			return fmt.Errorf("invalid original exporter %q: %w", exporter, err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/multierr"
+	"gopkg.in/yaml.v3"
+)
+
+// schemaFile is the part of the schema file format used to translate metrics.
+type schemaFile struct {
+	SchemaURL string                       `yaml:"schema_url"`
+	Versions  map[string]schemaFileVersion `yaml:"versions"`
+}
+
+type schemaFileVersion struct {
+	Metrics struct {
+		Changes []struct {
+			UnitChange *struct {
+				Metrics []string `yaml:"metrics"`
+				From    string   `yaml:"from"`
+				To      string   `yaml:"to"`
+			} `yaml:"unit_change"`
+		} `yaml:"changes"`
+	} `yaml:"metrics"`
+}
+
+// revision holds the unit changes applied when upgrading from the previous version to version.
+type revision struct {
+	version     *Version
+	unitChanges []*UnitChange
+}
+
+// Schema translates metrics between the versions of a schema family.
+type Schema struct {
+	family    string
+	version   *Version
+	revisions []*revision
+}
+
+// ParseSchema reads a schema file. Only the unit_change sections of the metrics changes
+// are currently used, the other sections are ignored.
+func ParseSchema(r io.Reader) (*Schema, error) {
+	var file schemaFile
+	if err := yaml.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("failed to parse schema file: %w", err)
+	}
+	family, version, err := GetFamilyAndVersion(file.SchemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema_url %q: %w", file.SchemaURL, err)
+	}
+
+	s := &Schema{family: family, version: version}
+	for v, changes := range file.Versions {
+		rev := &revision{}
+		if rev.version, err = NewVersion(v); err != nil {
+			return nil, fmt.Errorf("invalid version %q: %w", v, err)
+		}
+		for _, change := range changes.Metrics.Changes {
+			if change.UnitChange == nil {
+				continue
+			}
+			uc, err := NewUnitChange(change.UnitChange.Metrics, change.UnitChange.From, change.UnitChange.To)
+			if err != nil {
+				return nil, fmt.Errorf("invalid unit_change of version %s: %w", v, err)
+			}
+			rev.unitChanges = append(rev.unitChanges, uc)
+		}
+		s.revisions = append(s.revisions, rev)
+	}
+	sort.Slice(s.revisions, func(i, j int) bool {
+		return s.revisions[i].version.LessThan(s.revisions[j].version)
+	})
+	return s, nil
+}
+
+// Family returns the schema family, which is the schema URL without its version.
+func (s *Schema) Family() string {
+	return s.family
+}
+
+// Version returns the version the schema file is published at, which is its latest version.
+func (s *Schema) Version() *Version {
+	return s.version
+}
+
+// Covers reports whether the schema describes the version, which is any version up to the one
+// the schema file is published at.
+func (s *Schema) Covers(v *Version) bool {
+	return !v.GreaterThan(s.version)
+}
+
+// TranslateMetrics translates the metrics that follow the from version of the schema to the to version.
+// The changes of the versions between both are applied in order, upgrading or downgrading the metrics.
+// Both versions must be covered by the schema. A metric that fails to be translated is left unchanged
+// by the failing change, and the error returned once the other changes are applied.
+func (s *Schema) TranslateMetrics(from, to *Version, metrics pmetric.MetricSlice) error {
+	if !s.Covers(from) || !s.Covers(to) {
+		return fmt.Errorf("schema %s/%s can't translate from %s to %s: %w", s.family, s.version, from, to, ErrInvalidVersion)
+	}
+
+	var errs error
+	switch {
+	case from.LessThan(to):
+		for _, rev := range s.revisions {
+			if !rev.version.GreaterThan(from) || rev.version.GreaterThan(to) {
+				continue
+			}
+			for _, uc := range rev.unitChanges {
+				errs = multierr.Append(errs, uc.Upgrade(metrics))
+			}
+		}
+	case from.GreaterThan(to):
+		for i := len(s.revisions) - 1; i >= 0; i-- {
+			rev := s.revisions[i]
+			if rev.version.GreaterThan(from) || !rev.version.GreaterThan(to) {
+				continue
+			}
+			for j := len(rev.unitChanges) - 1; j >= 0; j-- {
+				errs = multierr.Append(errs, rev.unitChanges[j].Downgrade(metrics))
+			}
+		}
+	}
+	return errs
+}
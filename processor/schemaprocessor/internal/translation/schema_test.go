@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translation
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func newDurationMetrics(unit string, value float64) pmetric.MetricSlice {
+	metrics := pmetric.NewMetricSlice()
+	for _, name := range []string{"http.server.duration", "http.client.duration", "http.server.active_requests"} {
+		m := metrics.AppendEmpty()
+		m.SetName(name)
+		m.SetUnit(unit)
+		m.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(value)
+	}
+	return metrics
+}
+
+func loadSchema(t *testing.T) *Schema {
+	f, err := os.Open(filepath.Join("testdata", "unit_changes.yml"))
+	require.NoError(t, err)
+	defer f.Close()
+	schema, err := ParseSchema(f)
+	require.NoError(t, err)
+	return schema
+}
+
+func TestParseSchema(t *testing.T) {
+	schema := loadSchema(t)
+	assert.Equal(t, "https://example.com/schemas", schema.Family())
+	assert.Equal(t, &Version{1, 2, 0}, schema.Version())
+	assert.True(t, schema.Covers(&Version{1, 0, 0}))
+	assert.False(t, schema.Covers(&Version{1, 3, 0}))
+
+	_, err := ParseSchema(strings.NewReader("schema_url: https://example.com/schemas/1.0.0\nversions:\n  1.0.0:\n    metrics:\n      changes:\n        - unit_change:\n            metrics: [m]\n            from: ms\n            to: furlong\n"))
+	assert.ErrorIs(t, err, ErrUnsupportedUnitChange)
+
+	_, err = ParseSchema(strings.NewReader("schema_url: not a url\n"))
+	assert.Error(t, err)
+}
+
+func TestSchemaTranslateMetrics(t *testing.T) {
+	schema := loadSchema(t)
+
+	testCases := []struct {
+		desc     string
+		from, to *Version
+		unit     string
+		value    float64
+		expected map[string]float64
+		units    map[string]string
+	}{
+		{
+			desc: "upgrade one version",
+			from: &Version{1, 0, 0}, to: &Version{1, 1, 0},
+			unit: "ms", value: 1500,
+			expected: map[string]float64{"http.server.duration": 1.5, "http.client.duration": 1.5, "http.server.active_requests": 1500},
+			units:    map[string]string{"http.server.duration": "s", "http.client.duration": "s", "http.server.active_requests": "ms"},
+		},
+		{
+			desc: "upgrade two versions",
+			from: &Version{1, 0, 0}, to: &Version{1, 2, 0},
+			unit: "ms", value: 1500,
+			expected: map[string]float64{"http.server.duration": 1500, "http.client.duration": 1.5, "http.server.active_requests": 1500},
+			units:    map[string]string{"http.server.duration": "ms", "http.client.duration": "s", "http.server.active_requests": "ms"},
+		},
+		{
+			desc: "downgrade",
+			from: &Version{1, 1, 0}, to: &Version{1, 0, 0},
+			unit: "s", value: 2,
+			expected: map[string]float64{"http.server.duration": 2000, "http.client.duration": 2000, "http.server.active_requests": 2},
+			units:    map[string]string{"http.server.duration": "ms", "http.client.duration": "ms", "http.server.active_requests": "s"},
+		},
+		{
+			desc: "same version",
+			from: &Version{1, 1, 0}, to: &Version{1, 1, 0},
+			unit: "ms", value: 3,
+			expected: map[string]float64{"http.server.duration": 3, "http.client.duration": 3, "http.server.active_requests": 3},
+			units:    map[string]string{"http.server.duration": "ms", "http.client.duration": "ms", "http.server.active_requests": "ms"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			metrics := newDurationMetrics(tc.unit, tc.value)
+			require.NoError(t, schema.TranslateMetrics(tc.from, tc.to, metrics))
+			for i := 0; i < metrics.Len(); i++ {
+				m := metrics.At(i)
+				assert.Equal(t, tc.units[m.Name()], m.Unit(), m.Name())
+				assert.InDelta(t, tc.expected[m.Name()], m.Gauge().DataPoints().At(0).DoubleValue(), 1e-9, m.Name())
+			}
+		})
+	}
+
+	assert.ErrorIs(t, schema.TranslateMetrics(&Version{1, 3, 0}, &Version{1, 0, 0}, newDurationMetrics("s", 1)), ErrInvalidVersion)
+}
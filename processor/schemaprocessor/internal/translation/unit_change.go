@@ -0,0 +1,215 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"fmt"
+	"math"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// UnitChange is a change of the unit of metrics between two schema versions.
+// Translating a metric across the change sets its new unit and scales all its values,
+// including the bounds of the histogram buckets, so that they keep the same meaning.
+type UnitChange struct {
+	metrics map[string]struct{}
+	from    string
+	to      string
+
+	upgradeScale   float64
+	downgradeScale float64
+}
+
+// NewUnitChange creates the change of the unit of the named metrics from the from unit,
+// used by the older version, to the to unit, used by the newer version.
+func NewUnitChange(metrics []string, from, to string) (*UnitChange, error) {
+	upgradeScale, err := UnitScale(from, to)
+	if err != nil {
+		return nil, err
+	}
+	downgradeScale, err := UnitScale(to, from)
+	if err != nil {
+		return nil, err
+	}
+	uc := &UnitChange{
+		metrics:        make(map[string]struct{}, len(metrics)),
+		from:           from,
+		to:             to,
+		upgradeScale:   upgradeScale,
+		downgradeScale: downgradeScale,
+	}
+	for _, name := range metrics {
+		uc.metrics[name] = struct{}{}
+	}
+	return uc, nil
+}
+
+// Upgrade converts the values of the matching metrics that use the older unit to the newer unit.
+func (uc *UnitChange) Upgrade(metrics pmetric.MetricSlice) error {
+	return uc.apply(metrics, uc.from, uc.to, uc.upgradeScale)
+}
+
+// Downgrade converts the values of the matching metrics that use the newer unit to the older unit.
+func (uc *UnitChange) Downgrade(metrics pmetric.MetricSlice) error {
+	return uc.apply(metrics, uc.to, uc.from, uc.downgradeScale)
+}
+
+// apply scales the values of the matching metrics using the from unit, and sets their unit to the to unit.
+// The metrics already using another unit are left unchanged, so that a change is never applied twice.
+func (uc *UnitChange) apply(metrics pmetric.MetricSlice, from, to string, scale float64) error {
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if _, ok := uc.metrics[metric.Name()]; !ok || metric.Unit() != from {
+			continue
+		}
+		if err := scaleMetric(metric, scale); err != nil {
+			return fmt.Errorf("metric %q from %q to %q: %w", metric.Name(), from, to, err)
+		}
+		metric.SetUnit(to)
+	}
+	return nil
+}
+
+// scaleMetric multiplies the values of the metric by scale.
+// The metric is left unchanged if an error is returned.
+func scaleMetric(metric pmetric.Metric, scale float64) error {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		scaleNumberDataPoints(metric.Gauge().DataPoints(), scale)
+	case pmetric.MetricTypeSum:
+		scaleNumberDataPoints(metric.Sum().DataPoints(), scale)
+	case pmetric.MetricTypeHistogram:
+		scaleHistogramDataPoints(metric.Histogram().DataPoints(), scale)
+	case pmetric.MetricTypeExponentialHistogram:
+		return scaleExponentialHistogramDataPoints(metric.ExponentialHistogram().DataPoints(), scale)
+	case pmetric.MetricTypeSummary:
+		scaleSummaryDataPoints(metric.Summary().DataPoints(), scale)
+	}
+	return nil
+}
+
+// scaleInt multiplies value by scale, returning false if the result is not an integer
+// or does not fit in an int64.
+func scaleInt(value int64, scale float64) (int64, bool) {
+	if scale < 1 || scale != math.Trunc(scale) || scale > math.MaxInt64 {
+		return 0, false
+	}
+	factor := int64(scale)
+	if value > math.MaxInt64/factor || value < math.MinInt64/factor {
+		return 0, false
+	}
+	return value * factor, true
+}
+
+// scaleNumberDataPoints multiplies the values of the data points by scale.
+// Integer values are converted to floating point values, unless the scaled value is still an integer.
+func scaleNumberDataPoints(dps pmetric.NumberDataPointSlice, scale float64) {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		switch dp.ValueType() {
+		case pmetric.NumberDataPointValueTypeInt:
+			if scaled, ok := scaleInt(dp.IntValue(), scale); ok {
+				dp.SetIntValue(scaled)
+			} else {
+				dp.SetDoubleValue(float64(dp.IntValue()) * scale)
+			}
+		case pmetric.NumberDataPointValueTypeDouble:
+			dp.SetDoubleValue(dp.DoubleValue() * scale)
+		}
+		scaleExemplars(dp.Exemplars(), scale)
+	}
+}
+
+func scaleHistogramDataPoints(dps pmetric.HistogramDataPointSlice, scale float64) {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		bounds := dp.ExplicitBounds()
+		for j := 0; j < bounds.Len(); j++ {
+			bounds.SetAt(j, bounds.At(j)*scale)
+		}
+		if dp.HasSum() {
+			dp.SetSum(dp.Sum() * scale)
+		}
+		if dp.HasMin() {
+			dp.SetMin(dp.Min() * scale)
+		}
+		if dp.HasMax() {
+			dp.SetMax(dp.Max() * scale)
+		}
+		scaleExemplars(dp.Exemplars(), scale)
+	}
+}
+
+// scaleExponentialHistogramDataPoints multiplies the values of the data points by scale, by shifting
+// their buckets. Since the bucket boundaries are powers of the base of the data point, scale must be
+// an integer power of that base, such as a power of 2 for the binary units.
+func scaleExponentialHistogramDataPoints(dps pmetric.ExponentialHistogramDataPointSlice, scale float64) error {
+	shifts := make([]int32, dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		// The base is 2^(2^-scale), so the shift is log2(scale) * 2^scale buckets.
+		shift := math.Log2(scale) * math.Exp2(float64(dps.At(i).Scale()))
+		rounded := math.Round(shift)
+		if math.Abs(shift-rounded) > 1e-9 || math.Abs(rounded) > math.MaxInt32 {
+			return fmt.Errorf("can't scale the buckets of exponential histogram scale %d by %g: %w",
+				dps.At(i).Scale(), scale, ErrUnsupportedUnitChange)
+		}
+		shifts[i] = int32(rounded)
+	}
+
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		dp.Positive().SetOffset(dp.Positive().Offset() + shifts[i])
+		dp.Negative().SetOffset(dp.Negative().Offset() + shifts[i])
+		if dp.HasSum() {
+			dp.SetSum(dp.Sum() * scale)
+		}
+		if dp.HasMin() {
+			dp.SetMin(dp.Min() * scale)
+		}
+		if dp.HasMax() {
+			dp.SetMax(dp.Max() * scale)
+		}
+		scaleExemplars(dp.Exemplars(), scale)
+	}
+	return nil
+}
+
+func scaleSummaryDataPoints(dps pmetric.SummaryDataPointSlice, scale float64) {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		dp.SetSum(dp.Sum() * scale)
+		quantiles := dp.QuantileValues()
+		for j := 0; j < quantiles.Len(); j++ {
+			quantiles.At(j).SetValue(quantiles.At(j).Value() * scale)
+		}
+	}
+}
+
+func scaleExemplars(exemplars pmetric.ExemplarSlice, scale float64) {
+	for i := 0; i < exemplars.Len(); i++ {
+		exemplar := exemplars.At(i)
+		switch exemplar.ValueType() {
+		case pmetric.ExemplarValueTypeInt:
+			if scaled, ok := scaleInt(exemplar.IntValue(), scale); ok {
+				exemplar.SetIntValue(scaled)
+			} else {
+				exemplar.SetDoubleValue(float64(exemplar.IntValue()) * scale)
+			}
+		case pmetric.ExemplarValueTypeDouble:
+			exemplar.SetDoubleValue(exemplar.DoubleValue() * scale)
+		}
+	}
+}
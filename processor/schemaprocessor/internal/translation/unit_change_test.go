@@ -0,0 +1,330 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translation
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestUnitScale(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		scenario string
+		from, to string
+		scale    float64
+		err      error
+	}{
+		{scenario: "milliseconds to seconds", from: "ms", to: "s", scale: 1e-3},
+		{scenario: "seconds to milliseconds", from: "s", to: "ms", scale: 1e3},
+		{scenario: "hours to minutes", from: "h", to: "min", scale: 60},
+		{scenario: "kibibytes to bytes", from: "KiBy", to: "By", scale: 1024},
+		{scenario: "bytes to bits", from: "By", to: "bit", scale: 8},
+		{scenario: "percent to ratio", from: "%", to: "1", scale: 1e-2},
+		{scenario: "same unit", from: "s", to: "s", scale: 1},
+		{scenario: "different dimensions", from: "s", to: "By", err: ErrUnsupportedUnitChange},
+		{scenario: "unknown unit", from: "s", to: "fortnight", err: ErrUnsupportedUnitChange},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scenario, func(t *testing.T) {
+			scale, err := UnitScale(tc.from, tc.to)
+			assert.ErrorIs(t, err, tc.err, "MUST have the expected error")
+			assert.InDelta(t, tc.scale, scale, tc.scale*1e-12, "MUST match the expected scale")
+		})
+	}
+}
+
+func TestUnitChangeUpgrade(t *testing.T) {
+	t.Parallel()
+
+	uc, err := NewUnitChange([]string{"http.server.duration", "process.runtime.uptime"}, "ms", "s")
+	require.NoError(t, err)
+
+	metrics := pmetric.NewMetricSlice()
+
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("process.runtime.uptime")
+	gauge.SetUnit("ms")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1500)
+
+	histogram := metrics.AppendEmpty()
+	histogram.SetName("http.server.duration")
+	histogram.SetUnit("ms")
+	hdp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+	hdp.ExplicitBounds().FromRaw([]float64{5, 50, 500})
+	hdp.BucketCounts().FromRaw([]uint64{1, 2, 3, 4})
+	hdp.SetCount(10)
+	hdp.SetSum(2000)
+	hdp.SetMin(1)
+	hdp.SetMax(900)
+	hdp.Exemplars().AppendEmpty().SetDoubleValue(250)
+
+	unmatched := metrics.AppendEmpty()
+	unmatched.SetName("http.client.duration")
+	unmatched.SetUnit("ms")
+	unmatched.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(42)
+
+	alreadyUpgraded := metrics.AppendEmpty()
+	alreadyUpgraded.SetName("http.server.duration")
+	alreadyUpgraded.SetUnit("s")
+	alreadyUpgraded.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(2)
+
+	require.NoError(t, uc.Upgrade(metrics))
+
+	assert.Equal(t, "s", gauge.Unit())
+	assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, gauge.Gauge().DataPoints().At(0).ValueType())
+	assert.Equal(t, 1.5, gauge.Gauge().DataPoints().At(0).DoubleValue())
+
+	assert.Equal(t, "s", histogram.Unit())
+	assert.Equal(t, []float64{0.005, 0.05, 0.5}, hdp.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{1, 2, 3, 4}, hdp.BucketCounts().AsRaw())
+	assert.Equal(t, uint64(10), hdp.Count())
+	assert.Equal(t, 2.0, hdp.Sum())
+	assert.Equal(t, 0.001, hdp.Min())
+	assert.Equal(t, 0.9, hdp.Max())
+	assert.Equal(t, 0.25, hdp.Exemplars().At(0).DoubleValue())
+
+	assert.Equal(t, "ms", unmatched.Unit(), "MUST NOT change the unit of other metrics")
+	assert.Equal(t, 42.0, unmatched.Gauge().DataPoints().At(0).DoubleValue())
+
+	assert.Equal(t, 2.0, alreadyUpgraded.Gauge().DataPoints().At(0).DoubleValue(), "MUST NOT apply the change twice")
+}
+
+func TestUnitChangeDowngradeWholeScale(t *testing.T) {
+	t.Parallel()
+
+	uc, err := NewUnitChange([]string{"system.memory.usage"}, "By", "KiBy")
+	require.NoError(t, err)
+
+	metrics := pmetric.NewMetricSlice()
+	sum := metrics.AppendEmpty()
+	sum.SetName("system.memory.usage")
+	sum.SetUnit("KiBy")
+	dp := sum.SetEmptySum().DataPoints().AppendEmpty()
+	dp.SetIntValue(3)
+	dp.Exemplars().AppendEmpty().SetIntValue(2)
+
+	require.NoError(t, uc.Downgrade(metrics))
+
+	assert.Equal(t, "By", sum.Unit())
+	assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType(), "MUST keep integer values for whole scales")
+	assert.Equal(t, int64(3072), dp.IntValue())
+	assert.Equal(t, int64(2048), dp.Exemplars().At(0).IntValue())
+}
+
+func TestUnitChangeSummary(t *testing.T) {
+	t.Parallel()
+
+	uc, err := NewUnitChange([]string{"rpc.duration"}, "s", "ms")
+	require.NoError(t, err)
+
+	metrics := pmetric.NewMetricSlice()
+	summary := metrics.AppendEmpty()
+	summary.SetName("rpc.duration")
+	summary.SetUnit("s")
+	dp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	dp.SetCount(4)
+	dp.SetSum(2)
+	quantile := dp.QuantileValues().AppendEmpty()
+	quantile.SetQuantile(0.99)
+	quantile.SetValue(1.5)
+
+	require.NoError(t, uc.Upgrade(metrics))
+
+	assert.Equal(t, uint64(4), dp.Count())
+	assert.Equal(t, 2000.0, dp.Sum())
+	assert.Equal(t, 0.99, dp.QuantileValues().At(0).Quantile())
+	assert.Equal(t, 1500.0, dp.QuantileValues().At(0).Value())
+}
+
+func TestUnitChangeExponentialHistogram(t *testing.T) {
+	t.Parallel()
+
+	newMetrics := func(unit string) (pmetric.MetricSlice, pmetric.ExponentialHistogramDataPoint) {
+		metrics := pmetric.NewMetricSlice()
+		metric := metrics.AppendEmpty()
+		metric.SetName("network.io.size")
+		metric.SetUnit(unit)
+		dp := metric.SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+		dp.SetScale(1)
+		dp.SetSum(4096)
+		dp.Positive().SetOffset(3)
+		dp.Positive().BucketCounts().FromRaw([]uint64{1, 2})
+		dp.Negative().SetOffset(-2)
+		return metrics, dp
+	}
+
+	binary, err := NewUnitChange([]string{"network.io.size"}, "By", "KiBy")
+	require.NoError(t, err)
+	metrics, dp := newMetrics("By")
+	require.NoError(t, binary.Upgrade(metrics))
+	assert.Equal(t, "KiBy", metrics.At(0).Unit())
+	// 1024 is 2^10, which is 20 buckets of base sqrt(2)
+	assert.Equal(t, int32(-17), dp.Positive().Offset())
+	assert.Equal(t, int32(-22), dp.Negative().Offset())
+	assert.Equal(t, []uint64{1, 2}, dp.Positive().BucketCounts().AsRaw())
+	assert.Equal(t, 4.0, dp.Sum())
+
+	decimal, err := NewUnitChange([]string{"network.io.size"}, "By", "KBy")
+	require.NoError(t, err)
+	metrics, dp = newMetrics("By")
+	err = decimal.Upgrade(metrics)
+	assert.ErrorIs(t, err, ErrUnsupportedUnitChange)
+	assert.Equal(t, "By", metrics.At(0).Unit(), "MUST leave the metric unchanged on error")
+	assert.Equal(t, int32(3), dp.Positive().Offset())
+	assert.Equal(t, 4096.0, dp.Sum())
+}
+
+func TestNewUnitChangeUnsupported(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewUnitChange([]string{"http.server.duration"}, "ms", "By")
+	assert.ErrorIs(t, err, ErrUnsupportedUnitChange)
+}
+
+// unitPair is a random pair of units of the same dimension, used by the property based tests.
+type unitPair struct {
+	from, to string
+}
+
+func (unitPair) Generate(r *rand.Rand, _ int) reflect.Value {
+	byDimension := make(map[string][]string)
+	for name, u := range units {
+		byDimension[u.dimension] = append(byDimension[u.dimension], name)
+	}
+	dimensions := make([]string, 0, len(byDimension))
+	for dimension := range byDimension {
+		sort.Strings(byDimension[dimension])
+		dimensions = append(dimensions, dimension)
+	}
+	sort.Strings(dimensions)
+	names := byDimension[dimensions[r.Intn(len(dimensions))]]
+	return reflect.ValueOf(unitPair{from: names[r.Intn(len(names))], to: names[r.Intn(len(names))]})
+}
+
+// closeTo returns true if the values are equal, up to the rounding errors of the scaling.
+func closeTo(expected, actual float64) bool {
+	return math.Abs(expected-actual) <= 1e-9*math.Max(math.Abs(expected), 1)
+}
+
+func TestUnitChangeRoundTripProperty(t *testing.T) {
+	t.Parallel()
+
+	// float32 inputs keep the scaled values within the range of float64
+	property := func(pair unitPair, v float32, b []float32, q float32) bool {
+		value, quantile := float64(v), float64(q)
+		bounds := make([]float64, len(b))
+		for i := range b {
+			bounds[i] = float64(b[i])
+		}
+		sort.Float64s(bounds)
+
+		uc, err := NewUnitChange([]string{"metric"}, pair.from, pair.to)
+		if err != nil {
+			return false
+		}
+
+		metrics := pmetric.NewMetricSlice()
+		gauge := metrics.AppendEmpty()
+		gauge.SetName("metric")
+		gauge.SetUnit(pair.from)
+		gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(value)
+
+		histogram := metrics.AppendEmpty()
+		histogram.SetName("metric")
+		histogram.SetUnit(pair.from)
+		hdp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+		hdp.ExplicitBounds().FromRaw(append([]float64(nil), bounds...))
+		hdp.SetSum(value)
+
+		summary := metrics.AppendEmpty()
+		summary.SetName("metric")
+		summary.SetUnit(pair.from)
+		sdp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+		sdp.QuantileValues().AppendEmpty().SetValue(quantile)
+
+		if uc.Upgrade(metrics) != nil {
+			return false
+		}
+		for i := 0; i < metrics.Len(); i++ {
+			if metrics.At(i).Unit() != pair.to {
+				return false
+			}
+		}
+		upgradedBounds := hdp.ExplicitBounds().AsRaw()
+		if !sort.Float64sAreSorted(upgradedBounds) {
+			return false
+		}
+
+		if uc.Downgrade(metrics) != nil {
+			return false
+		}
+		for i := 0; i < metrics.Len(); i++ {
+			if metrics.At(i).Unit() != pair.from {
+				return false
+			}
+		}
+		for i, bound := range hdp.ExplicitBounds().AsRaw() {
+			if !closeTo(bounds[i], bound) {
+				return false
+			}
+		}
+		return closeTo(value, gauge.Gauge().DataPoints().At(0).DoubleValue()) &&
+			closeTo(value, hdp.Sum()) &&
+			closeTo(quantile, sdp.QuantileValues().At(0).Value())
+	}
+
+	require.NoError(t, quick.Check(property, &quick.Config{MaxCount: 1000}))
+}
+
+func TestUnitChangeIntegerRoundTripProperty(t *testing.T) {
+	t.Parallel()
+
+	property := func(pair unitPair, value int32) bool {
+		uc, err := NewUnitChange([]string{"metric"}, pair.from, pair.to)
+		if err != nil {
+			return false
+		}
+
+		metrics := pmetric.NewMetricSlice()
+		sum := metrics.AppendEmpty()
+		sum.SetName("metric")
+		sum.SetUnit(pair.from)
+		dp := sum.SetEmptySum().DataPoints().AppendEmpty()
+		dp.SetIntValue(int64(value))
+
+		if uc.Upgrade(metrics) != nil || uc.Downgrade(metrics) != nil {
+			return false
+		}
+		if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+			return dp.IntValue() == int64(value)
+		}
+		// the values of the changes to smaller units are kept as integers,
+		// the others are converted to floating point values
+		return closeTo(float64(value), dp.DoubleValue())
+	}
+
+	require.NoError(t, quick.Check(property, &quick.Config{MaxCount: 1000}))
+}
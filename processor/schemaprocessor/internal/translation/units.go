@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrUnsupportedUnitChange is returned when the values of a unit can't be converted to another unit.
+var ErrUnsupportedUnitChange = errors.New("unsupported unit change")
+
+// unit is a unit of measure, defined by its dimension and its factor to the base unit of the dimension.
+type unit struct {
+	dimension string
+	factor    float64
+}
+
+// units lists the units, using their UCUM case sensitive symbols, whose values can be converted
+// into another unit of the same dimension.
+var units = map[string]unit{
+	"ns":  {dimension: "time", factor: 1e-9},
+	"us":  {dimension: "time", factor: 1e-6},
+	"ms":  {dimension: "time", factor: 1e-3},
+	"s":   {dimension: "time", factor: 1},
+	"min": {dimension: "time", factor: 60},
+	"h":   {dimension: "time", factor: 3600},
+	"d":   {dimension: "time", factor: 86400},
+
+	"bit":  {dimension: "information", factor: 1},
+	"By":   {dimension: "information", factor: 8},
+	"KBy":  {dimension: "information", factor: 8e3},
+	"MBy":  {dimension: "information", factor: 8e6},
+	"GBy":  {dimension: "information", factor: 8e9},
+	"TBy":  {dimension: "information", factor: 8e12},
+	"KiBy": {dimension: "information", factor: 8 << 10},
+	"MiBy": {dimension: "information", factor: 8 << 20},
+	"GiBy": {dimension: "information", factor: 8 << 30},
+	"TiBy": {dimension: "information", factor: 8 << 40},
+
+	"1": {dimension: "ratio", factor: 1},
+	"%": {dimension: "ratio", factor: 1e-2},
+}
+
+// UnitScale returns the factor by which a value in the from unit is multiplied to be expressed in the to unit.
+func UnitScale(from, to string) (float64, error) {
+	fromUnit, ok := units[from]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit %q: %w", from, ErrUnsupportedUnitChange)
+	}
+	toUnit, ok := units[to]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit %q: %w", to, ErrUnsupportedUnitChange)
+	}
+	if fromUnit.dimension != toUnit.dimension {
+		return 0, fmt.Errorf("can't convert %q to %q: %w", from, to, ErrUnsupportedUnitChange)
+	}
+	scale := fromUnit.factor / toUnit.factor
+	// remove the rounding errors of the factors, so that changes to smaller units keep integer values
+	if rounded := math.Round(scale); rounded >= 1 && math.Abs(scale-rounded) <= 1e-9*rounded {
+		scale = rounded
+	}
+	return scale, nil
+}
@@ -17,18 +17,39 @@ package schemaprocessor // import "github.com/open-telemetry/opentelemetry-colle
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
 )
 
+var errOriginalExporterNotFound = errors.New("original exporter not found")
+
 type transformer struct {
-	targets []string
-	log     *zap.Logger
+	targets  []string
+	prefetch []string
+	log      *zap.Logger
+
+	httpSettings confighttp.HTTPClientSettings
+	telemetry    component.TelemetrySettings
+	// targetVersions holds the target version of each schema family, and schemas the
+	// newest schema file fetched for each family, used to translate to the target version.
+	targetVersions map[string]*translation.Version
+	targetURLs     map[string]string
+	schemas        map[string]*translation.Schema
+
+	originalExporterIDs []string
+	originalTraces      []component.TracesExporter
+	originalMetrics     []component.MetricsExporter
+	originalLogs        []component.LogsExporter
 }
 
 func newTransformer(
@@ -41,28 +62,226 @@ func newTransformer(
 		return nil, errors.New("invalid configuration provided")
 	}
 	return &transformer{
-		log:     set.Logger,
-		targets: cfg.Targets,
+		log:                 set.Logger,
+		targets:             cfg.Targets,
+		prefetch:            cfg.Prefetch,
+		httpSettings:        cfg.HTTPClientSettings,
+		telemetry:           set.TelemetrySettings,
+		originalExporterIDs: cfg.OriginalExporters,
 	}, nil
 }
 
-func (t transformer) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+func (t *transformer) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	if len(t.originalLogs) > 0 {
+		translated := plog.NewLogs()
+		ld.CopyTo(translated)
+		for _, exp := range t.originalLogs {
+			if err := exp.ConsumeLogs(ctx, ld); err != nil {
+				t.log.Error("Failed to forward original logs", zap.Error(err))
+			}
+		}
+		ld = translated
+	}
 	return ld, nil
 }
 
-func (t transformer) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+func (t *transformer) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	if len(t.originalMetrics) > 0 {
+		translated := pmetric.NewMetrics()
+		md.CopyTo(translated)
+		for _, exp := range t.originalMetrics {
+			if err := exp.ConsumeMetrics(ctx, md); err != nil {
+				t.log.Error("Failed to forward original metrics", zap.Error(err))
+			}
+		}
+		md = translated
+	}
+	t.translateMetrics(md)
 	return md, nil
 }
 
-func (t transformer) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+// translateMetrics translates the metrics of the scopes whose schema family has a target to
+// the target version, and sets their schema URL to the target.
+func (t *transformer) translateMetrics(md pmetric.Metrics) {
+	if len(t.schemas) == 0 {
+		return
+	}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		translatedAll := true
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			schemaURL := sm.SchemaUrl()
+			if schemaURL == "" {
+				schemaURL = rm.SchemaUrl()
+			}
+			target, ok := t.translate(schemaURL, sm.Metrics())
+			if !ok {
+				translatedAll = false
+				continue
+			}
+			sm.SetSchemaUrl(target)
+		}
+		if translatedAll && rm.ScopeMetrics().Len() > 0 {
+			rm.SetSchemaUrl(rm.ScopeMetrics().At(0).SchemaUrl())
+		}
+	}
+}
+
+// translate translates the metrics following schemaURL to the target of its family.
+// It returns the target schema URL, and false when the metrics were not translated.
+func (t *transformer) translate(schemaURL string, metrics pmetric.MetricSlice) (string, bool) {
+	if schemaURL == "" {
+		return "", false
+	}
+	family, version, err := translation.GetFamilyAndVersion(schemaURL)
+	if err != nil {
+		t.log.Debug("Invalid schema url", zap.String("schema-url", schemaURL), zap.Error(err))
+		return "", false
+	}
+	target, ok := t.targetVersions[family]
+	if !ok {
+		return "", false
+	}
+	if version.Equal(target) {
+		return t.targetURLs[family], true
+	}
+	schema, ok := t.schemas[family]
+	if !ok || !schema.Covers(version) {
+		t.log.Debug("Schema version is newer than the fetched schemas, add it to prefetch to translate it",
+			zap.String("schema-url", schemaURL))
+		return "", false
+	}
+	if err := schema.TranslateMetrics(version, target, metrics); err != nil {
+		t.log.Warn("Failed to translate metrics", zap.String("schema-url", schemaURL), zap.Error(err))
+	}
+	return t.targetURLs[family], true
+}
+
+func (t *transformer) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	if len(t.originalTraces) > 0 {
+		translated := ptrace.NewTraces()
+		td.CopyTo(translated)
+		for _, exp := range t.originalTraces {
+			if err := exp.ConsumeTraces(ctx, td); err != nil {
+				t.log.Error("Failed to forward original traces", zap.Error(err))
+			}
+		}
+		td = translated
+	}
 	return td, nil
 }
 
 // start will load the remote file definition if it isn't already cached
 // and resolve the schema translation file
 func (t *transformer) start(ctx context.Context, host component.Host) error {
+	if err := t.fetchSchemas(ctx, host); err != nil {
+		return err
+	}
+	return t.registerOriginalExporters(host)
+}
+
+// fetchSchemas downloads the schema files of the targets and of the prefetched schema URLs.
+// The newest schema file of each family is kept, since it describes all the previous versions.
+func (t *transformer) fetchSchemas(ctx context.Context, host component.Host) error {
+	if len(t.targets) == 0 {
+		return nil
+	}
+	client, err := t.httpSettings.ToClient(host, t.telemetry)
+	if err != nil {
+		return err
+	}
+
+	t.targetVersions = make(map[string]*translation.Version, len(t.targets))
+	t.targetURLs = make(map[string]string, len(t.targets))
+	t.schemas = make(map[string]*translation.Schema, len(t.targets))
 	for _, target := range t.targets {
-		t.log.Info("Fetching remote schema url", zap.String("schema-url", target))
+		family, version, err := translation.GetFamilyAndVersion(target)
+		if err != nil {
+			return err
+		}
+		t.targetVersions[family] = version
+		t.targetURLs[family] = target
+	}
+
+	for _, schemaURL := range append(append([]string{}, t.targets...), t.prefetch...) {
+		t.log.Info("Fetching remote schema url", zap.String("schema-url", schemaURL))
+		schema, err := fetchSchema(ctx, client, schemaURL)
+		if err != nil {
+			return err
+		}
+		if _, ok := t.targetVersions[schema.Family()]; !ok {
+			continue
+		}
+		if current, ok := t.schemas[schema.Family()]; !ok || schema.Version().GreaterThan(current.Version()) {
+			t.schemas[schema.Family()] = schema
+		}
+	}
+	return nil
+}
+
+func fetchSchema(ctx context.Context, client *http.Client, schemaURL string) (*translation.Schema, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, schemaURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch schema %q: %w", schemaURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch schema %q: %s", schemaURL, resp.Status)
+	}
+	schema, err := translation.ParseSchema(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("schema %q: %w", schemaURL, err)
+	}
+	return schema, nil
+}
+
+// registerOriginalExporters looks up the exporters receiving the untranslated data.
+// An exporter only receives the signals of the pipelines it is configured in.
+func (t *transformer) registerOriginalExporters(host component.Host) error {
+	if len(t.originalExporterIDs) == 0 {
+		return nil
+	}
+
+	available := host.GetExporters()
+	for _, name := range t.originalExporterIDs {
+		id, err := config.NewComponentIDFromString(name)
+		if err != nil {
+			return err
+		}
+
+		found := false
+		if exp, ok := available[config.TracesDataType][id]; ok {
+			traces, ok := exp.(component.TracesExporter)
+			if !ok {
+				return fmt.Errorf("the exporter %q isn't a traces exporter", name)
+			}
+			t.originalTraces = append(t.originalTraces, traces)
+			found = true
+		}
+		if exp, ok := available[config.MetricsDataType][id]; ok {
+			metrics, ok := exp.(component.MetricsExporter)
+			if !ok {
+				return fmt.Errorf("the exporter %q isn't a metrics exporter", name)
+			}
+			t.originalMetrics = append(t.originalMetrics, metrics)
+			found = true
+		}
+		if exp, ok := available[config.LogsDataType][id]; ok {
+			logs, ok := exp.(component.LogsExporter)
+			if !ok {
+				return fmt.Errorf("the exporter %q isn't a logs exporter", name)
+			}
+			t.originalLogs = append(t.originalLogs, logs)
+			found = true
+		}
+		if !found {
+			return fmt.Errorf("%w: %q", errOriginalExporterNotFound, name)
+		}
 	}
 	return nil
 }
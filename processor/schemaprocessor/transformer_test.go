@@ -17,12 +17,18 @@ package schemaprocessor
 import (
 	"context"
 	_ "embed"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -108,3 +114,184 @@ func TestTransformerProcessing(t *testing.T) {
 		assert.Equal(t, in, out, "Must return the same data (subject to change)")
 	})
 }
+
+type tracesExporterSink struct {
+	consumertest.TracesSink
+}
+
+func (*tracesExporterSink) Start(context.Context, component.Host) error { return nil }
+
+func (*tracesExporterSink) Shutdown(context.Context) error { return nil }
+
+type exportersHost struct {
+	component.Host
+	exporters map[config.DataType]map[config.ComponentID]component.Exporter
+}
+
+func (h *exportersHost) GetExporters() map[config.DataType]map[config.ComponentID]component.Exporter {
+	return h.exporters
+}
+
+func TestTransformerOriginalExporters(t *testing.T) {
+	t.Parallel()
+
+	cfg := newDefaultConfiguration().(*Config)
+	cfg.OriginalExporters = []string{"otlp/legacy"}
+	trans, err := newTransformer(context.Background(), cfg, component.ProcessorCreateSettings{
+		TelemetrySettings: component.TelemetrySettings{
+			Logger: zaptest.NewLogger(t),
+		},
+	})
+	require.NoError(t, err)
+
+	sink := &tracesExporterSink{}
+	host := &exportersHost{
+		Host: componenttest.NewNopHost(),
+		exporters: map[config.DataType]map[config.ComponentID]component.Exporter{
+			config.TracesDataType: {
+				config.NewComponentIDWithName("otlp", "legacy"): sink,
+			},
+		},
+	}
+	require.NoError(t, trans.start(context.Background(), host))
+
+	in := ptrace.NewTraces()
+	in.ResourceSpans().AppendEmpty().SetSchemaUrl("http://opentelemetry.io/schemas/1.9.0")
+	in.ResourceSpans().At(0).ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("http.request")
+
+	out, err := trans.processTraces(context.Background(), in)
+	require.NoError(t, err)
+
+	require.Len(t, sink.AllTraces(), 1)
+	assert.Equal(t, in, sink.AllTraces()[0], "Must forward the original data")
+	assert.Equal(t, in, out, "Must return an equal copy (subject to change)")
+
+	out.ResourceSpans().At(0).SetSchemaUrl("http://opentelemetry.io/schemas/1.6.1")
+	assert.Equal(t, "http://opentelemetry.io/schemas/1.9.0", sink.AllTraces()[0].ResourceSpans().At(0).SchemaUrl(),
+		"Must not share data between the original and the translated copy")
+}
+
+func TestTransformerOriginalExporterNotFound(t *testing.T) {
+	t.Parallel()
+
+	cfg := newDefaultConfiguration().(*Config)
+	cfg.OriginalExporters = []string{"otlp/legacy"}
+	trans, err := newTransformer(context.Background(), cfg, component.ProcessorCreateSettings{
+		TelemetrySettings: component.TelemetrySettings{
+			Logger: zaptest.NewLogger(t),
+		},
+	})
+	require.NoError(t, err)
+
+	host := &exportersHost{Host: componenttest.NewNopHost()}
+	assert.ErrorIs(t, trans.start(context.Background(), host), errOriginalExporterNotFound)
+}
+
+// unitChangesSchema serves the schema files of a family where the unit of http.server.duration
+// changes from ms to s in version 1.1.0.
+func unitChangesSchema(t *testing.T) *httptest.Server {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		version := strings.TrimPrefix(r.URL.Path, "/schemas/")
+		content := fmt.Sprintf("file_format: 1.0.0\nschema_url: %s/schemas/%s\nversions:\n  1.0.0:\n", srv.URL, version)
+		if version == "1.1.0" {
+			content += "  1.1.0:\n    metrics:\n      changes:\n        - unit_change:\n            metrics: [http.server.duration]\n            from: ms\n            to: s\n"
+		}
+		_, err := w.Write([]byte(content))
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestProcessorUnitChanges(t *testing.T) {
+	t.Parallel()
+
+	srv := unitChangesSchema(t)
+	testCases := []struct {
+		desc          string
+		target        string
+		prefetch      []string
+		schemaURL     string
+		unit          string
+		value         float64
+		expectedURL   string
+		expectedUnit  string
+		expectedValue float64
+	}{
+		{
+			desc:          "upgrade",
+			target:        srv.URL + "/schemas/1.1.0",
+			schemaURL:     srv.URL + "/schemas/1.0.0",
+			unit:          "ms",
+			value:         1500,
+			expectedURL:   srv.URL + "/schemas/1.1.0",
+			expectedUnit:  "s",
+			expectedValue: 1.5,
+		},
+		{
+			desc:          "downgrade with the newer schema prefetched",
+			target:        srv.URL + "/schemas/1.0.0",
+			prefetch:      []string{srv.URL + "/schemas/1.1.0"},
+			schemaURL:     srv.URL + "/schemas/1.1.0",
+			unit:          "s",
+			value:         2,
+			expectedURL:   srv.URL + "/schemas/1.0.0",
+			expectedUnit:  "ms",
+			expectedValue: 2000,
+		},
+		{
+			desc:          "downgrade without the newer schema",
+			target:        srv.URL + "/schemas/1.0.0",
+			schemaURL:     srv.URL + "/schemas/1.1.0",
+			unit:          "s",
+			value:         2,
+			expectedURL:   srv.URL + "/schemas/1.1.0",
+			expectedUnit:  "s",
+			expectedValue: 2,
+		},
+		{
+			desc:          "other family",
+			target:        srv.URL + "/schemas/1.1.0",
+			schemaURL:     "https://opentelemetry.io/schemas/1.0.0",
+			unit:          "ms",
+			value:         1500,
+			expectedURL:   "https://opentelemetry.io/schemas/1.0.0",
+			expectedUnit:  "ms",
+			expectedValue: 1500,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := newDefaultConfiguration().(*Config)
+			cfg.Targets = []string{tc.target}
+			cfg.Prefetch = tc.prefetch
+			require.NoError(t, cfg.Validate())
+
+			sink := new(consumertest.MetricsSink)
+			proc, err := NewFactory().CreateMetricsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, sink)
+			require.NoError(t, err)
+			require.NoError(t, proc.Start(context.Background(), componenttest.NewNopHost()))
+			t.Cleanup(func() { require.NoError(t, proc.Shutdown(context.Background())) })
+
+			in := pmetric.NewMetrics()
+			rm := in.ResourceMetrics().AppendEmpty()
+			rm.SetSchemaUrl(tc.schemaURL)
+			m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+			m.SetName("http.server.duration")
+			m.SetUnit(tc.unit)
+			m.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(tc.value)
+			require.NoError(t, proc.ConsumeMetrics(context.Background(), in))
+
+			require.Len(t, sink.AllMetrics(), 1)
+			out := sink.AllMetrics()[0].ResourceMetrics().At(0)
+			assert.Equal(t, tc.expectedURL, out.SchemaUrl())
+			metric := out.ScopeMetrics().At(0).Metrics().At(0)
+			assert.Equal(t, tc.expectedUnit, metric.Unit())
+			assert.InDelta(t, tc.expectedValue, metric.Gauge().DataPoints().At(0).DoubleValue(), 1e-9)
+		})
+	}
+}
@@ -58,12 +58,48 @@ type Config struct {
 	// Optional. See defaultDimensionsCacheSize in processor.go for the default value.
 	DimensionsCacheSize int `mapstructure:"dimensions_cache_size"`
 
+	// DimensionsCacheExpiry defines how long the dimensions of a metric are kept in the cache after the last
+	// span with these dimensions was seen. Expired dimensions are evicted and their metrics are no longer reported.
+	// Optional. Zero, the default, keeps dimensions until they are evicted because the cache is full.
+	DimensionsCacheExpiry time.Duration `mapstructure:"dimensions_cache_expiry"`
+
 	AggregationTemporality string `mapstructure:"aggregation_temporality"`
 
+	// Histogram defines the type of the latency histogram.
+	Histogram HistogramConfig `mapstructure:"histogram"`
+
+	// Exemplars defines the exemplars attached to the latency histogram data points.
+	Exemplars ExemplarsConfig `mapstructure:"exemplars"`
+
 	// skipSanitizeLabel if enabled, labels that start with _ are not sanitized
 	skipSanitizeLabel bool
 }
 
+// HistogramConfig defines the type of the latency histogram.
+type HistogramConfig struct {
+	// Exponential, if set, produces the latency histogram as an exponential histogram
+	// instead of an explicit bucket histogram.
+	Exponential *ExponentialHistogramConfig `mapstructure:"exponential"`
+}
+
+// ExponentialHistogramConfig defines the exponential latency histogram.
+type ExponentialHistogramConfig struct {
+	// MaxSize is the maximum number of buckets of the histogram. The histogram is downscaled
+	// whenever the recorded latencies need more buckets.
+	// Optional. See defaultExponentialHistogramMaxSize in exponential_histogram.go for the default value.
+	MaxSize int32 `mapstructure:"max_size"`
+}
+
+// ExemplarsConfig defines the exemplars attached to the latency histogram data points.
+type ExemplarsConfig struct {
+	// Disabled turns off the exemplars carrying the trace and span IDs of the spans.
+	Disabled bool `mapstructure:"disabled"`
+
+	// MaxPerDataPoint is the maximum number of exemplars per data point. The most recent spans
+	// are kept. Optional. Zero, the default, keeps an exemplar for each span.
+	MaxPerDataPoint int `mapstructure:"max_per_data_point"`
+}
+
 var dropSanitizationGate = featuregate.Gate{
 	ID:          "processor.spanmetrics.PermissiveLabelSanitization",
 	Enabled:     false,
@@ -42,7 +42,9 @@ func TestLoadConfig(t *testing.T) {
 		wantLatencyHistogramBuckets []time.Duration
 		wantDimensions              []Dimension
 		wantDimensionsCacheSize     int
+		wantDimensionsCacheExpiry   time.Duration
 		wantAggregationTemporality  string
+		wantExemplars               ExemplarsConfig
 	}{
 		{
 			configFile:                 "config-2-pipelines.yaml",
@@ -73,7 +75,9 @@ func TestLoadConfig(t *testing.T) {
 				{"http.status_code", nil},
 			},
 			wantDimensionsCacheSize:    1500,
+			wantDimensionsCacheExpiry:  10 * time.Minute,
 			wantAggregationTemporality: delta,
+			wantExemplars:              ExemplarsConfig{MaxPerDataPoint: 5},
 		},
 	}
 	for _, tc := range testcases {
@@ -105,7 +109,9 @@ func TestLoadConfig(t *testing.T) {
 					LatencyHistogramBuckets: tc.wantLatencyHistogramBuckets,
 					Dimensions:              tc.wantDimensions,
 					DimensionsCacheSize:     tc.wantDimensionsCacheSize,
+					DimensionsCacheExpiry:   tc.wantDimensionsCacheExpiry,
 					AggregationTemporality:  tc.wantAggregationTemporality,
+					Exemplars:               tc.wantExemplars,
 				},
 				cfg.Processors[config.NewComponentID(typeStr)],
 			)
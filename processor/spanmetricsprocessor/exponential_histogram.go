@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanmetricsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanmetricsprocessor"
+
+import (
+	"math"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const (
+	// maxExponentialHistogramScale is the highest scale defined by the OpenTelemetry data model.
+	maxExponentialHistogramScale int32 = 20
+	// defaultExponentialHistogramMaxSize is the default maximum number of buckets of an exponential histogram.
+	defaultExponentialHistogramMaxSize int32 = 160
+)
+
+// exponentialHistogram is a base-2 exponential histogram of positive values. It starts at the
+// highest scale and is downscaled whenever the recorded values need more than maxSize buckets.
+type exponentialHistogram struct {
+	maxSize   int32
+	scale     int32
+	offset    int32
+	counts    []uint64
+	zeroCount uint64
+	count     uint64
+	sum       float64
+}
+
+func newExponentialHistogram(maxSize int32) *exponentialHistogram {
+	return &exponentialHistogram{
+		maxSize: maxSize,
+		scale:   maxExponentialHistogramScale,
+	}
+}
+
+// record adds the value to the histogram. Negative values are not expected for latencies and
+// are recorded in the zero bucket.
+func (h *exponentialHistogram) record(value float64) {
+	h.count++
+	h.sum += value
+
+	if value <= 0 {
+		h.zeroCount++
+		return
+	}
+
+	index := bucketIndex(value, h.scale)
+	if len(h.counts) == 0 {
+		h.offset = index
+		h.counts = []uint64{1}
+		return
+	}
+
+	low, high := h.offset, h.offset+int32(len(h.counts))-1
+	if index < low {
+		low = index
+	}
+	if index > high {
+		high = index
+	}
+
+	var by int32
+	for (high>>by)-(low>>by)+1 > h.maxSize {
+		by++
+	}
+	if by > 0 {
+		h.downscale(by)
+		index >>= by
+	}
+
+	if index < h.offset {
+		counts := make([]uint64, int(h.offset-index)+len(h.counts))
+		copy(counts[h.offset-index:], h.counts)
+		h.counts = counts
+		h.offset = index
+	} else if last := h.offset + int32(len(h.counts)) - 1; index > last {
+		h.counts = append(h.counts, make([]uint64, index-last)...)
+	}
+	h.counts[index-h.offset]++
+}
+
+// downscale reduces the scale of the histogram by the given amount, merging 2^by adjacent buckets together.
+func (h *exponentialHistogram) downscale(by int32) {
+	offset := h.offset >> by
+	last := (h.offset + int32(len(h.counts)) - 1) >> by
+	counts := make([]uint64, last-offset+1)
+	for i, c := range h.counts {
+		counts[((h.offset+int32(i))>>by)-offset] += c
+	}
+	h.scale -= by
+	h.offset = offset
+	h.counts = counts
+}
+
+// copyTo writes the histogram data into the data point.
+func (h *exponentialHistogram) copyTo(dp pmetric.ExponentialHistogramDataPoint) {
+	dp.SetScale(h.scale)
+	dp.SetZeroCount(h.zeroCount)
+	dp.SetCount(h.count)
+	dp.SetSum(h.sum)
+	dp.Positive().SetOffset(h.offset)
+	dp.Positive().BucketCounts().FromRaw(h.counts)
+}
+
+// bucketIndex returns the index of the bucket holding the value at the given scale, such that
+// the bucket at index i holds the values in (base^i, base^(i+1)] with base = 2^(2^-scale).
+func bucketIndex(value float64, scale int32) int32 {
+	return int32(math.Ceil(math.Log2(value)*math.Ldexp(1, int(scale)))) - 1
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanmetricsprocessor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestBucketIndex(t *testing.T) {
+	// At scale 0 the bucket at index i holds the values in (2^i, 2^(i+1)].
+	assert.Equal(t, int32(-1), bucketIndex(1, 0))
+	assert.Equal(t, int32(0), bucketIndex(1.5, 0))
+	assert.Equal(t, int32(0), bucketIndex(2, 0))
+	assert.Equal(t, int32(1), bucketIndex(3, 0))
+	assert.Equal(t, int32(1), bucketIndex(4, 0))
+	assert.Equal(t, int32(-2), bucketIndex(0.5, 0))
+
+	// At scale 1 the base is sqrt(2).
+	assert.Equal(t, int32(1), bucketIndex(2, 1))
+	assert.Equal(t, int32(2), bucketIndex(2.5, 1))
+}
+
+func TestExponentialHistogramRecord(t *testing.T) {
+	h := newExponentialHistogram(defaultExponentialHistogramMaxSize)
+	h.record(0)
+	h.record(1.5)
+	h.record(1.5)
+
+	assert.Equal(t, uint64(3), h.count)
+	assert.Equal(t, uint64(1), h.zeroCount)
+	assert.Equal(t, float64(3), h.sum)
+	assert.Equal(t, maxExponentialHistogramScale, h.scale)
+	assert.Equal(t, []uint64{2}, h.counts)
+	assert.Equal(t, bucketIndex(1.5, maxExponentialHistogramScale), h.offset)
+}
+
+func TestExponentialHistogramDownscale(t *testing.T) {
+	h := newExponentialHistogram(4)
+	values := []float64{1.5, 3, 6, 12, 24, 48, 1000}
+	for _, v := range values {
+		h.record(v)
+	}
+
+	assert.LessOrEqual(t, len(h.counts), 4)
+	assert.Equal(t, uint64(len(values)), h.count)
+
+	var total uint64
+	for _, c := range h.counts {
+		total += c
+	}
+	assert.Equal(t, uint64(len(values)), total)
+
+	// Every value falls in the bucket of its index at the final scale.
+	for _, v := range values {
+		index := bucketIndex(v, h.scale)
+		require.GreaterOrEqual(t, index, h.offset)
+		require.Less(t, int(index-h.offset), len(h.counts))
+		assert.NotZero(t, h.counts[index-h.offset])
+	}
+
+	// The lower bound of the first bucket is below the smallest value, the upper bound of the
+	// last bucket is above the largest value.
+	base := math.Pow(2, math.Pow(2, -float64(h.scale)))
+	assert.Less(t, math.Pow(base, float64(h.offset)), 1.5)
+	assert.GreaterOrEqual(t, math.Pow(base, float64(h.offset+int32(len(h.counts)))), float64(1000))
+}
+
+func TestExponentialHistogramCopyTo(t *testing.T) {
+	h := newExponentialHistogram(defaultExponentialHistogramMaxSize)
+	h.record(0)
+	h.record(10)
+
+	dp := pmetric.NewExponentialHistogramDataPoint()
+	h.copyTo(dp)
+
+	assert.Equal(t, h.scale, dp.Scale())
+	assert.Equal(t, uint64(1), dp.ZeroCount())
+	assert.Equal(t, uint64(2), dp.Count())
+	assert.Equal(t, float64(10), dp.Sum())
+	assert.Equal(t, h.offset, dp.Positive().Offset())
+	assert.Equal(t, []uint64{1}, dp.Positive().BucketCounts().AsRaw())
+}
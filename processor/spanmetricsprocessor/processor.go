@@ -84,6 +84,14 @@ type processorImp struct {
 	latencyBounds        []float64
 	latencyExemplarsData map[metricKey][]exemplarData
 
+	// Exponential latency histogram, used instead of the latency bucket counts if
+	// expHistogramMaxSize is positive.
+	latencyExpHistograms map[metricKey]*exponentialHistogram
+	expHistogramMaxSize  int32
+
+	// The last time a span was seen for each metric key, only tracked if the dimensions cache expiry is set.
+	lastSeen map[metricKey]time.Time
+
 	// An LRU cache of dimension key-value maps keyed by a unique identifier formed by a concatenation of its values:
 	// e.g. { "foo/barOK": { "serviceName": "foo", "operation": "/bar", "status_code": "OK" }}
 	metricKeyToDimensions *cache.Cache
@@ -113,6 +121,24 @@ func newProcessor(logger *zap.Logger, config config.Processor, nextConsumer cons
 		return nil, err
 	}
 
+	var expHistogramMaxSize int32
+	if exp := pConfig.Histogram.Exponential; exp != nil {
+		if pConfig.LatencyHistogramBuckets != nil {
+			return nil, fmt.Errorf("latency_histogram_buckets cannot be used with an exponential histogram")
+		}
+		expHistogramMaxSize = exp.MaxSize
+		if expHistogramMaxSize == 0 {
+			expHistogramMaxSize = defaultExponentialHistogramMaxSize
+		}
+		if expHistogramMaxSize < 2 {
+			return nil, fmt.Errorf("invalid exponential histogram max size: %v, it should be at least 2", exp.MaxSize)
+		}
+	}
+
+	if pConfig.Exemplars.MaxPerDataPoint < 0 {
+		return nil, fmt.Errorf("invalid exemplars max per data point: %v, it should not be negative", pConfig.Exemplars.MaxPerDataPoint)
+	}
+
 	return &processorImp{
 		logger:                logger,
 		config:                *pConfig,
@@ -123,6 +149,9 @@ func newProcessor(logger *zap.Logger, config config.Processor, nextConsumer cons
 		latencyCount:          make(map[metricKey]uint64),
 		latencyBucketCounts:   make(map[metricKey][]uint64),
 		latencyExemplarsData:  make(map[metricKey][]exemplarData),
+		latencyExpHistograms:  make(map[metricKey]*exponentialHistogram),
+		expHistogramMaxSize:   expHistogramMaxSize,
+		lastSeen:              make(map[metricKey]time.Time),
 		nextConsumer:          nextConsumer,
 		dimensions:            pConfig.Dimensions,
 		metricKeyToDimensions: metricKeyToDimensionsCache,
@@ -256,6 +285,8 @@ func (p *processorImp) buildMetrics() (pmetric.Metrics, error) {
 	ilm := m.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
 	ilm.Scope().SetName("spanmetricsprocessor")
 
+	p.removeExpiredMetrics(time.Now())
+
 	if err := p.collectCallMetrics(ilm); err != nil {
 		return pmetric.Metrics{}, err
 	}
@@ -282,10 +313,29 @@ func (p *processorImp) collectLatencyMetrics(ilm pmetric.ScopeMetrics) error {
 		mLatency := ilm.Metrics().AppendEmpty()
 		mLatency.SetName("latency")
 		mLatency.SetUnit("ms")
-		mLatency.SetEmptyHistogram().SetAggregationTemporality(p.config.GetAggregationTemporality())
 
 		timestamp := pcommon.NewTimestampFromTime(time.Now())
 
+		dimensions, err := p.getDimensionsByMetricKey(key)
+		if err != nil {
+			p.logger.Error(err.Error())
+			return err
+		}
+
+		if p.expHistogramMaxSize > 0 {
+			mLatency.SetEmptyExponentialHistogram().SetAggregationTemporality(p.config.GetAggregationTemporality())
+
+			dpLatency := mLatency.ExponentialHistogram().DataPoints().AppendEmpty()
+			dpLatency.SetStartTimestamp(pcommon.NewTimestampFromTime(p.startTime))
+			dpLatency.SetTimestamp(timestamp)
+			p.latencyExpHistograms[key].copyTo(dpLatency)
+			setLatencyExemplars(p.latencyExemplarsData[key], timestamp, dpLatency.Exemplars())
+			dimensions.CopyTo(dpLatency.Attributes())
+			continue
+		}
+
+		mLatency.SetEmptyHistogram().SetAggregationTemporality(p.config.GetAggregationTemporality())
+
 		dpLatency := mLatency.Histogram().DataPoints().AppendEmpty()
 		dpLatency.SetStartTimestamp(pcommon.NewTimestampFromTime(p.startTime))
 		dpLatency.SetTimestamp(timestamp)
@@ -296,12 +346,6 @@ func (p *processorImp) collectLatencyMetrics(ilm pmetric.ScopeMetrics) error {
 
 		setLatencyExemplars(p.latencyExemplarsData[key], timestamp, dpLatency.Exemplars())
 
-		dimensions, err := p.getDimensionsByMetricKey(key)
-		if err != nil {
-			p.logger.Error(err.Error())
-			return err
-		}
-
 		dimensions.CopyTo(dpLatency.Attributes())
 	}
 	return nil
@@ -388,6 +432,9 @@ func (p *processorImp) aggregateMetricsForSpan(serviceName string, span ptrace.S
 	key := buildKey(serviceName, span, p.dimensions, resourceAttr)
 
 	p.cache(serviceName, span, key, resourceAttr)
+	if p.config.DimensionsCacheExpiry > 0 {
+		p.lastSeen[key] = time.Now()
+	}
 	p.updateCallMetrics(key)
 	p.updateLatencyMetrics(key, latencyInMilliseconds, index)
 	p.updateLatencyExemplars(key, latencyInMilliseconds, span.TraceID(), span.SpanID())
@@ -405,11 +452,41 @@ func (p *processorImp) resetAccumulatedMetrics() {
 	p.latencyCount = make(map[metricKey]uint64)
 	p.latencySum = make(map[metricKey]float64)
 	p.latencyBucketCounts = make(map[metricKey][]uint64)
+	p.latencyExpHistograms = make(map[metricKey]*exponentialHistogram)
+	p.lastSeen = make(map[metricKey]time.Time)
 	p.metricKeyToDimensions.Purge()
 }
 
+// removeMetrics removes the metric data accumulated for the given metric key.
+func (p *processorImp) removeMetrics(key metricKey) {
+	delete(p.callSum, key)
+	delete(p.latencyCount, key)
+	delete(p.latencySum, key)
+	delete(p.latencyBucketCounts, key)
+	delete(p.latencyExpHistograms, key)
+	delete(p.lastSeen, key)
+}
+
+// removeExpiredMetrics evicts the dimensions of the metric keys for which no span was seen
+// within the configured expiry, and removes their metric data so that they are no longer reported.
+func (p *processorImp) removeExpiredMetrics(now time.Time) {
+	if p.config.DimensionsCacheExpiry <= 0 {
+		return
+	}
+	for key, lastSeen := range p.lastSeen {
+		if now.Sub(lastSeen) > p.config.DimensionsCacheExpiry {
+			p.metricKeyToDimensions.Remove(key)
+			p.removeMetrics(key)
+		}
+	}
+}
+
 // updateLatencyExemplars sets the histogram exemplars for the given metric key and append the exemplar data.
+// If a maximum number of exemplars per data point is configured, the oldest exemplar is dropped to make room.
 func (p *processorImp) updateLatencyExemplars(key metricKey, value float64, traceID pcommon.TraceID, spanID pcommon.SpanID) {
+	if p.config.Exemplars.Disabled {
+		return
+	}
 	if _, ok := p.latencyExemplarsData[key]; !ok {
 		p.latencyExemplarsData[key] = []exemplarData{}
 	}
@@ -419,7 +496,11 @@ func (p *processorImp) updateLatencyExemplars(key metricKey, value float64, trac
 		spanID:  spanID,
 		value:   value,
 	}
-	p.latencyExemplarsData[key] = append(p.latencyExemplarsData[key], e)
+	exemplars := p.latencyExemplarsData[key]
+	if maxExemplars := p.config.Exemplars.MaxPerDataPoint; maxExemplars > 0 && len(exemplars) >= maxExemplars {
+		exemplars = exemplars[len(exemplars)-maxExemplars+1:]
+	}
+	p.latencyExemplarsData[key] = append(exemplars, e)
 }
 
 // resetExemplarData resets the entire exemplars map so the next trace will recreate all
@@ -431,11 +512,22 @@ func (p *processorImp) resetExemplarData() {
 
 // updateLatencyMetrics increments the histogram counts for the given metric key and bucket index.
 func (p *processorImp) updateLatencyMetrics(key metricKey, latency float64, index int) {
+	p.latencySum[key] += latency
+	p.latencyCount[key]++
+
+	if p.expHistogramMaxSize > 0 {
+		h, ok := p.latencyExpHistograms[key]
+		if !ok {
+			h = newExponentialHistogram(p.expHistogramMaxSize)
+			p.latencyExpHistograms[key] = h
+		}
+		h.record(latency)
+		return
+	}
+
 	if _, ok := p.latencyBucketCounts[key]; !ok {
 		p.latencyBucketCounts[key] = make([]uint64, len(p.latencyBounds)+1)
 	}
-	p.latencySum[key] += latency
-	p.latencyCount[key]++
 	p.latencyBucketCounts[key][index]++
 }
 
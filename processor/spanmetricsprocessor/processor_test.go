@@ -901,3 +901,98 @@ func TestProcessorResetExemplarData(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Empty(t, p.latencyExemplarsData[key])
 }
+
+func TestProcessorExponentialHistogram(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Histogram.Exponential = &ExponentialHistogramConfig{MaxSize: 10}
+	p, err := newProcessor(zaptest.NewLogger(t), cfg, new(consumertest.TracesSink))
+	require.NoError(t, err)
+
+	p.aggregateMetrics(buildSampleTrace())
+	m, err := p.buildMetrics()
+	require.NoError(t, err)
+
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	var latencyMetrics int
+	for i := 0; i < ms.Len(); i++ {
+		metric := ms.At(i)
+		if metric.Name() != "latency" {
+			continue
+		}
+		latencyMetrics++
+		require.Equal(t, pmetric.MetricTypeExponentialHistogram, metric.Type())
+		dp := metric.ExponentialHistogram().DataPoints().At(0)
+		assert.Equal(t, uint64(1), dp.Count())
+		assert.Equal(t, sampleLatency, dp.Sum())
+		assert.Equal(t, bucketIndex(sampleLatency, dp.Scale()), dp.Positive().Offset())
+		assert.Equal(t, []uint64{1}, dp.Positive().BucketCounts().AsRaw())
+		assert.Equal(t, 1, dp.Exemplars().Len())
+	}
+	assert.Equal(t, 3, latencyMetrics)
+}
+
+func TestProcessorExponentialHistogramWithBuckets(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Histogram.Exponential = &ExponentialHistogramConfig{}
+	cfg.LatencyHistogramBuckets = []time.Duration{time.Millisecond}
+	_, err := newProcessor(zaptest.NewLogger(t), cfg, new(consumertest.TracesSink))
+	assert.EqualError(t, err, "latency_histogram_buckets cannot be used with an exponential histogram")
+}
+
+func TestProcessorMaxExemplarsPerDataPoint(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Exemplars.MaxPerDataPoint = 2
+	p, err := newProcessor(zaptest.NewLogger(t), cfg, new(consumertest.TracesSink))
+	require.NoError(t, err)
+
+	key := metricKey("metricKey")
+	for i := 0; i < 5; i++ {
+		p.updateLatencyExemplars(key, float64(i), pcommon.TraceID([16]byte{byte(i + 1)}), pcommon.SpanID([8]byte{byte(i + 1)}))
+	}
+
+	require.Len(t, p.latencyExemplarsData[key], 2)
+	assert.Equal(t, float64(3), p.latencyExemplarsData[key][0].value)
+	assert.Equal(t, float64(4), p.latencyExemplarsData[key][1].value)
+}
+
+func TestProcessorExemplarsDisabled(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Exemplars.Disabled = true
+	p, err := newProcessor(zaptest.NewLogger(t), cfg, new(consumertest.TracesSink))
+	require.NoError(t, err)
+
+	key := metricKey("metricKey")
+	p.updateLatencyExemplars(key, 42, pcommon.TraceID([16]byte{1}), pcommon.SpanID([8]byte{1}))
+	assert.Empty(t, p.latencyExemplarsData[key])
+}
+
+func TestProcessorDimensionsCacheExpiry(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.DimensionsCacheExpiry = time.Minute
+	p, err := newProcessor(zaptest.NewLogger(t), cfg, new(consumertest.TracesSink))
+	require.NoError(t, err)
+
+	p.aggregateMetrics(buildSampleTrace())
+	require.Len(t, p.callSum, 3)
+
+	// Mark one of the metric keys as not seen for longer than the expiry.
+	var expiredKey metricKey
+	for key := range p.lastSeen {
+		expiredKey = key
+		break
+	}
+	p.lastSeen[expiredKey] = time.Now().Add(-2 * time.Minute)
+
+	m, err := p.buildMetrics()
+	require.NoError(t, err)
+	assert.Equal(t, 4, m.MetricCount())
+	assert.Len(t, p.callSum, 2)
+	assert.NotContains(t, p.callSum, expiredKey)
+	_, ok := p.metricKeyToDimensions.Get(expiredKey)
+	assert.False(t, ok)
+}
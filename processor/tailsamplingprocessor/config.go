@@ -15,6 +15,8 @@
 package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/config"
@@ -48,6 +50,8 @@ const (
 	SpanCount PolicyType = "span_count"
 	// TraceState sample traces with specified values by the given key
 	TraceState PolicyType = "trace_state"
+	// OTTLCondition sample traces with a span matching one of the given OTTL conditions.
+	OTTLCondition PolicyType = "ottl_condition"
 )
 
 // sharedPolicyCfg holds the common configuration to all policies that are used in derivative policy configurations
@@ -73,6 +77,8 @@ type sharedPolicyCfg struct {
 	SpanCountCfg SpanCountCfg `mapstructure:"span_count"`
 	// Configs for defining trace_state policy
 	TraceStateCfg TraceStateCfg `mapstructure:"trace_state"`
+	// Configs for OTTL condition filter sampling policy evaluator.
+	OTTLConditionCfg OTTLConditionCfg `mapstructure:"ottl_condition"`
 }
 
 // CompositeSubPolicyCfg holds the common configuration to all policies under composite policy.
@@ -122,6 +128,29 @@ type PolicyCfg struct {
 	CompositeCfg CompositeCfg `mapstructure:"composite"`
 	// Configs for defining and policy
 	AndCfg AndCfg `mapstructure:"and"`
+	// RateLimit limits the rate of the spans of the traces sampled by this policy. The traces
+	// sampled by the policy above the limit are considered as not sampled by it.
+	RateLimit *RateLimitCfg `mapstructure:"rate_limit"`
+}
+
+// RateLimitCfg holds the configurable settings of the token bucket limiting the rate of
+// the spans of sampled traces.
+type RateLimitCfg struct {
+	// SpansPerSecond is the number of spans the bucket is refilled with each second.
+	SpansPerSecond int64 `mapstructure:"spans_per_second"`
+	// Burst is the maximum number of spans the bucket holds, allowing short traffic spikes above
+	// SpansPerSecond. Defaults to SpansPerSecond.
+	Burst int64 `mapstructure:"burst"`
+}
+
+func (cfg *RateLimitCfg) validate() error {
+	if cfg.SpansPerSecond <= 0 {
+		return errors.New("spans_per_second must be positive")
+	}
+	if cfg.Burst < 0 {
+		return errors.New("burst must not be negative")
+	}
+	return nil
 }
 
 // LatencyCfg holds the configurable settings to create a latency filter sampling policy
@@ -180,6 +209,14 @@ type StringAttributeCfg struct {
 	InvertMatch bool `mapstructure:"invert_match"`
 }
 
+// OTTLConditionCfg holds the configurable settings to create an OTTL condition filter
+// sampling policy evaluator.
+type OTTLConditionCfg struct {
+	// SpanConditions are the OTTL conditions evaluated against each span of the trace, with its
+	// instrumentation scope and resource. The trace is sampled when a span matches any of them.
+	SpanConditions []string `mapstructure:"span"`
+}
+
 // RateLimitingCfg holds the configurable settings to create a rate limiting
 // sampling policy evaluator.
 type RateLimitingCfg struct {
@@ -209,4 +246,38 @@ type Config struct {
 	// PolicyCfgs sets the tail-based sampling policy which makes a sampling decision
 	// for a given trace when requested.
 	PolicyCfgs []PolicyCfg `mapstructure:"policies"`
+	// Storage is the ID of the storage extension the spans of the traces waiting for a decision
+	// are spilled to when more than MaxSpansInMemory spans are held in memory.
+	Storage *config.ComponentID `mapstructure:"storage"`
+	// MaxSpansInMemory is the number of spans held in memory above which the spans are spilled
+	// to the storage extension.
+	MaxSpansInMemory int64 `mapstructure:"max_spans_in_memory"`
+	// RateLimit limits the rate of the spans of all the sampled traces, whichever policy
+	// sampled them.
+	RateLimit *RateLimitCfg `mapstructure:"rate_limit"`
+}
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Storage != nil && cfg.MaxSpansInMemory <= 0 {
+		return errors.New("max_spans_in_memory must be positive when storage is set")
+	}
+	if cfg.Storage == nil && cfg.MaxSpansInMemory != 0 {
+		return errors.New("max_spans_in_memory requires storage to be set")
+	}
+	if cfg.RateLimit != nil {
+		if err := cfg.RateLimit.validate(); err != nil {
+			return fmt.Errorf("rate_limit: %w", err)
+		}
+	}
+	for i := range cfg.PolicyCfgs {
+		policyCfg := &cfg.PolicyCfgs[i]
+		if policyCfg.RateLimit == nil {
+			continue
+		}
+		if err := policyCfg.RateLimit.validate(); err != nil {
+			return fmt.Errorf("policy %q rate_limit: %w", policyCfg.Name, err)
+		}
+	}
+	return nil
 }
@@ -108,6 +108,16 @@ func TestLoadConfig(t *testing.T) {
 						TraceStateCfg: TraceStateCfg{Key: "key3", Values: []string{"value1", "value2"}},
 					},
 				},
+				{
+					sharedPolicyCfg: sharedPolicyCfg{
+						Name: "test-policy-10",
+						Type: OTTLCondition,
+						OTTLConditionCfg: OTTLConditionCfg{SpanConditions: []string{
+							`status.code == STATUS_CODE_ERROR`,
+							`DurationMillis() > 2000 and (resource.attributes["tenant"] == "a" or resource.attributes["tenant"] == "b")`,
+						}},
+					},
+				},
 				{
 					sharedPolicyCfg: sharedPolicyCfg{
 						Name: "and-policy-1",
@@ -177,3 +187,35 @@ func TestLoadConfig(t *testing.T) {
 			},
 		})
 }
+
+func TestValidateStorage(t *testing.T) {
+	storageID := config.NewComponentID("file_storage")
+
+	cfg := createDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Storage = &storageID
+	assert.EqualError(t, cfg.Validate(), "max_spans_in_memory must be positive when storage is set")
+
+	cfg.MaxSpansInMemory = 100000
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Storage = nil
+	assert.EqualError(t, cfg.Validate(), "max_spans_in_memory requires storage to be set")
+}
+
+func TestValidateRateLimit(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.RateLimit = &RateLimitCfg{}
+	assert.EqualError(t, cfg.Validate(), "rate_limit: spans_per_second must be positive")
+	cfg.RateLimit = &RateLimitCfg{SpansPerSecond: 1000, Burst: 5000}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.PolicyCfgs = []PolicyCfg{{
+		sharedPolicyCfg: sharedPolicyCfg{Name: "errors", Type: AlwaysSample},
+		RateLimit:       &RateLimitCfg{SpansPerSecond: 100, Burst: -1},
+	}}
+	assert.EqualError(t, cfg.Validate(), `policy "errors" rate_limit: burst must not be negative`)
+	cfg.PolicyCfgs[0].RateLimit.Burst = 0
+	assert.NoError(t, cfg.Validate())
+}
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltraces"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+type ottlConditionFilter struct {
+	logger     *zap.Logger
+	statements []*ottl.Statement[ottltraces.TransformContext]
+}
+
+var _ PolicyEvaluator = (*ottlConditionFilter)(nil)
+
+// NewOTTLConditionFilter creates a policy evaluator sampling traces with a span matching any of the
+// given OTTL conditions. The conditions are evaluated with the span, its instrumentation scope and
+// its resource.
+func NewOTTLConditionFilter(logger *zap.Logger, spanConditions []string) (PolicyEvaluator, error) {
+	if len(spanConditions) == 0 {
+		return nil, fmt.Errorf("at least one span condition is required")
+	}
+	// A condition can't be parsed on its own: it's parsed as the where clause of a statement
+	// invoking a function that does nothing, so that the statement is executed only when the
+	// condition is true.
+	statements := make([]string, 0, len(spanConditions))
+	for _, condition := range spanConditions {
+		statements = append(statements, fmt.Sprintf("match() where %s", condition))
+	}
+	parser := ottltraces.NewParser(ottlConditionFunctions(), component.TelemetrySettings{Logger: logger})
+	parsed, err := parser.ParseStatements(statements)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse span conditions: %w", err)
+	}
+	return &ottlConditionFilter{
+		logger:     logger,
+		statements: parsed,
+	}, nil
+}
+
+// ottlConditionFunctions returns the OTTL functions available in the conditions.
+func ottlConditionFunctions() map[string]interface{} {
+	return map[string]interface{}{
+		"match":          match,
+		"DurationMillis": durationMillis,
+		"IsMatch":        ottlfuncs.IsMatch[ottltraces.TransformContext],
+		"HasPrefix":      ottlfuncs.HasPrefix[ottltraces.TransformContext],
+		"Concat":         ottlfuncs.Concat[ottltraces.TransformContext],
+	}
+}
+
+func match() (ottl.ExprFunc[ottltraces.TransformContext], error) {
+	return func(ottltraces.TransformContext) interface{} {
+		return nil
+	}, nil
+}
+
+// durationMillis returns the duration of the span in milliseconds.
+func durationMillis() (ottl.ExprFunc[ottltraces.TransformContext], error) {
+	return func(ctx ottltraces.TransformContext) interface{} {
+		span := ctx.GetSpan()
+		return span.EndTimestamp().AsTime().Sub(span.StartTimestamp().AsTime()).Milliseconds()
+	}, nil
+}
+
+// Evaluate looks at the trace data and returns a corresponding SamplingDecision.
+func (ocf *ottlConditionFilter) Evaluate(_ pcommon.TraceID, trace *TraceData) (Decision, error) {
+	ocf.logger.Debug("Evaluating spans in OTTL condition filter")
+
+	trace.Lock()
+	batches := trace.ReceivedBatches
+	trace.Unlock()
+
+	rss := batches.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		ilss := rs.ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			ils := ilss.At(j)
+			spans := ils.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				if ocf.matches(spans.At(k), ils.Scope(), rs.Resource()) {
+					return Sampled, nil
+				}
+			}
+		}
+	}
+	return NotSampled, nil
+}
+
+func (ocf *ottlConditionFilter) matches(span ptrace.Span, scope pcommon.InstrumentationScope, resource pcommon.Resource) bool {
+	ctx := ottltraces.NewTransformContext(span, scope, resource)
+	for _, statement := range ocf.statements {
+		if _, matched := statement.Execute(ctx); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func TestOTTLConditionFilter(t *testing.T) {
+	conditions := []string{
+		`status.code == STATUS_CODE_ERROR`,
+		`DurationMillis() > 2000 and (resource.attributes["tenant"] == "a" or resource.attributes["tenant"] == "b")`,
+	}
+
+	cases := []struct {
+		Desc     string
+		Tenant   string
+		Status   ptrace.StatusCode
+		Duration time.Duration
+		Decision Decision
+	}{
+		{
+			Desc:     "error span",
+			Tenant:   "c",
+			Status:   ptrace.StatusCodeError,
+			Duration: time.Millisecond,
+			Decision: Sampled,
+		},
+		{
+			Desc:     "slow span of a matching tenant",
+			Tenant:   "b",
+			Status:   ptrace.StatusCodeOk,
+			Duration: 3 * time.Second,
+			Decision: Sampled,
+		},
+		{
+			Desc:     "slow span of another tenant",
+			Tenant:   "c",
+			Status:   ptrace.StatusCodeOk,
+			Duration: 3 * time.Second,
+			Decision: NotSampled,
+		},
+		{
+			Desc:     "fast span of a matching tenant",
+			Tenant:   "a",
+			Status:   ptrace.StatusCodeUnset,
+			Duration: time.Second,
+			Decision: NotSampled,
+		},
+	}
+
+	filter, err := NewOTTLConditionFilter(zap.NewNop(), conditions)
+	require.NoError(t, err)
+
+	for _, c := range cases {
+		t.Run(c.Desc, func(t *testing.T) {
+			traces := ptrace.NewTraces()
+			rs := traces.ResourceSpans().AppendEmpty()
+			rs.Resource().Attributes().PutStr("tenant", c.Tenant)
+			span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+			start := time.Unix(1666000000, 0)
+			span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+			span.SetEndTimestamp(pcommon.NewTimestampFromTime(start.Add(c.Duration)))
+			span.Status().SetCode(c.Status)
+
+			decision, err := filter.Evaluate(pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}), &TraceData{ReceivedBatches: traces})
+			assert.NoError(t, err)
+			assert.Equal(t, c.Decision, decision)
+		})
+	}
+}
+
+func TestOTTLConditionFilterInvalidConditions(t *testing.T) {
+	_, err := NewOTTLConditionFilter(zap.NewNop(), nil)
+	assert.Error(t, err)
+
+	_, err = NewOTTLConditionFilter(zap.NewNop(), []string{`Unknown() == true`})
+	assert.Error(t, err)
+}
@@ -36,6 +36,9 @@ type TraceData struct {
 	SpanCount *atomic.Int64
 	// ReceivedBatches stores all the batches received for the trace.
 	ReceivedBatches ptrace.Traces
+	// Spills are the numbers of the spills of the received batches to the storage extension
+	// since the arrival of the trace.
+	Spills []uint64
 }
 
 // Decision gives the status of sampling decision.
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+
+import (
+	"sync"
+	"time"
+)
+
+// TokenBucket limits the rate of the spans of sampled traces. It holds up to burst
+// tokens, one per span, and is refilled with spansPerSecond tokens each second.
+// A trace with more spans than the burst costs the whole burst, so that it can
+// still be sampled when the bucket is full.
+type TokenBucket struct {
+	mu             sync.Mutex
+	spansPerSecond float64
+	burst          float64
+	tokens         float64
+	last           time.Time
+	now            func() time.Time
+}
+
+// NewTokenBucket creates a full token bucket. A burst smaller than spansPerSecond
+// is raised to spansPerSecond.
+func NewTokenBucket(spansPerSecond, burst int64) *TokenBucket {
+	return newTokenBucket(spansPerSecond, burst, time.Now)
+}
+
+func newTokenBucket(spansPerSecond, burst int64, now func() time.Time) *TokenBucket {
+	if burst < spansPerSecond {
+		burst = spansPerSecond
+	}
+	return &TokenBucket{
+		spansPerSecond: float64(spansPerSecond),
+		burst:          float64(burst),
+		tokens:         float64(burst),
+		last:           now(),
+		now:            now,
+	}
+}
+
+// Allow returns true if the bucket holds enough tokens for spanCount spans, without removing them.
+func (b *TokenBucket) Allow(spanCount int64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill()
+	return b.cost(spanCount) <= b.tokens
+}
+
+// Take removes the tokens of spanCount spans from the bucket and returns true, or
+// returns false, leaving the bucket untouched, if not enough tokens are left.
+func (b *TokenBucket) Take(spanCount int64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill()
+	cost := b.cost(spanCount)
+	if cost > b.tokens {
+		return false
+	}
+	b.tokens -= cost
+	return true
+}
+
+func (b *TokenBucket) refill() {
+	now := b.now()
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.spansPerSecond
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+}
+
+// cost returns the tokens of spanCount spans, clamped to the burst.
+func (b *TokenBucket) cost(spanCount int64) float64 {
+	if cost := float64(spanCount); cost < b.burst {
+		return cost
+	}
+	return b.burst
+}
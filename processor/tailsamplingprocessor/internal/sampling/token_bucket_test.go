@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Unix(1000, 0)
+	b := newTokenBucket(10, 20, func() time.Time { return now })
+
+	// The bucket starts full with the burst.
+	assert.True(t, b.Take(15))
+	assert.True(t, b.Take(5))
+	assert.False(t, b.Take(1))
+
+	// Half a second refills half of the spans per second.
+	now = now.Add(500 * time.Millisecond)
+	assert.False(t, b.Take(6))
+	assert.True(t, b.Take(5))
+	assert.False(t, b.Take(1))
+
+	// The refill is capped by the burst.
+	now = now.Add(time.Minute)
+	assert.True(t, b.Allow(20))
+	assert.True(t, b.Take(20))
+	assert.False(t, b.Allow(1))
+
+	// A trace larger than the burst costs the whole burst.
+	now = now.Add(time.Second)
+	assert.False(t, b.Take(21))
+	now = now.Add(time.Second)
+	assert.True(t, b.Take(21))
+	assert.False(t, b.Take(1))
+}
+
+func TestTokenBucketBurstDefaultsToRate(t *testing.T) {
+	now := time.Unix(1000, 0)
+	b := newTokenBucket(10, 0, func() time.Time { return now })
+
+	assert.True(t, b.Take(4))
+	assert.False(t, b.Take(7))
+	assert.True(t, b.Take(6))
+}
@@ -27,6 +27,7 @@ var (
 	tagPolicyKey, _    = tag.NewKey("policy")
 	tagSampledKey, _   = tag.NewKey("sampled")
 	tagSourceFormat, _ = tag.NewKey("source_format")
+	tagLimiterKey, _   = tag.NewKey("limiter")
 
 	statDecisionLatencyMicroSec  = stats.Int64("sampling_decision_latency", "Latency (in microseconds) of a given sampling policy", "µs")
 	statOverallDecisionLatencyUs = stats.Int64("sampling_decision_timer_latency", "Latency (in microseconds) of each run of the sampling decision timer", "µs")
@@ -38,9 +39,14 @@ var (
 
 	statCountTracesSampled = stats.Int64("count_traces_sampled", "Count of traces that were sampled or not", stats.UnitDimensionless)
 
+	statCountTracesRateLimited = stats.Int64("count_traces_rate_limited", "Count of traces that were not sampled because of a rate limit", stats.UnitDimensionless)
+	statPolicySampledRatio     = stats.Float64("sampling_policy_sampled_ratio", "Ratio of the traces evaluated by the last run of the sampling decision timer that were sampled by a given policy", stats.UnitDimensionless)
+
 	statDroppedTooEarlyCount    = stats.Int64("sampling_trace_dropped_too_early", "Count of traces that needed to be dropped the configured wait time", stats.UnitDimensionless)
 	statNewTraceIDReceivedCount = stats.Int64("new_trace_id_received", "Counts the arrival of new traces", stats.UnitDimensionless)
 	statTracesOnMemoryGauge     = stats.Int64("sampling_traces_on_memory", "Tracks the number of traces current on memory", stats.UnitDimensionless)
+
+	statSpillsDroppedCount = stats.Int64("sampling_spills_dropped", "Count of batches of spans spilled to the storage that could not be restored for the sampling decision", stats.UnitDimensionless)
 )
 
 // SamplingProcessorMetricViews return the metrics views according to given telemetry level.
@@ -97,6 +103,21 @@ func SamplingProcessorMetricViews(level configtelemetry.Level) []*view.View {
 		Aggregation: view.Sum(),
 	}
 
+	countTracesRateLimitedView := &view.View{
+		Name:        obsreport.BuildProcessorCustomMetricName(typeStr, statCountTracesRateLimited.Name()),
+		Measure:     statCountTracesRateLimited,
+		Description: statCountTracesRateLimited.Description(),
+		TagKeys:     []tag.Key{tagPolicyKey, tagLimiterKey},
+		Aggregation: view.Sum(),
+	}
+	policySampledRatioView := &view.View{
+		Name:        obsreport.BuildProcessorCustomMetricName(typeStr, statPolicySampledRatio.Name()),
+		Measure:     statPolicySampledRatio,
+		Description: statPolicySampledRatio.Description(),
+		TagKeys:     policyTagKeys,
+		Aggregation: view.LastValue(),
+	}
+
 	countTraceDroppedTooEarlyView := &view.View{
 		Name:        obsreport.BuildProcessorCustomMetricName(typeStr, statDroppedTooEarlyCount.Name()),
 		Measure:     statDroppedTooEarlyCount,
@@ -109,6 +130,12 @@ func SamplingProcessorMetricViews(level configtelemetry.Level) []*view.View {
 		Description: statNewTraceIDReceivedCount.Description(),
 		Aggregation: view.Sum(),
 	}
+	countSpillsDroppedView := &view.View{
+		Name:        obsreport.BuildProcessorCustomMetricName(typeStr, statSpillsDroppedCount.Name()),
+		Measure:     statSpillsDroppedCount,
+		Description: statSpillsDroppedCount.Description(),
+		Aggregation: view.Sum(),
+	}
 	trackTracesOnMemorylView := &view.View{
 		Name:        obsreport.BuildProcessorCustomMetricName(typeStr, statTracesOnMemoryGauge.Name()),
 		Measure:     statTracesOnMemoryGauge,
@@ -126,9 +153,13 @@ func SamplingProcessorMetricViews(level configtelemetry.Level) []*view.View {
 		countPolicyEvaluationErrorView,
 
 		countTracesSampledView,
+		countTracesRateLimitedView,
+		policySampledRatioView,
 
 		countTraceDroppedTooEarlyView,
 		countTraceIDArrivalView,
 		trackTracesOnMemorylView,
+
+		countSpillsDroppedView,
 	}
 }
@@ -44,6 +44,8 @@ type policy struct {
 	evaluator sampling.PolicyEvaluator
 	// ctx used to carry metric tags of each policy.
 	ctx context.Context
+	// rateLimiter is nil when the traces sampled by the policy are not rate limited.
+	rateLimiter *sampling.TokenBucket
 }
 
 // tailSamplingSpanProcessor handles the incoming trace data and uses the given sampling
@@ -60,6 +62,10 @@ type tailSamplingSpanProcessor struct {
 	decisionBatcher idbatcher.Batcher
 	deleteChan      chan pcommon.TraceID
 	numTracesOnMap  *atomic.Uint64
+	// spanStorage is nil when the spans are only held in memory.
+	spanStorage *spanStorage
+	// rateLimiter is nil when the sampled traces are not rate limited globally.
+	rateLimiter *sampling.TokenBucket
 }
 
 const (
@@ -96,6 +102,9 @@ func newTracesProcessor(logger *zap.Logger, nextConsumer consumer.Traces, cfg Co
 			evaluator: eval,
 			ctx:       policyCtx,
 		}
+		if policyCfg.RateLimit != nil {
+			p.rateLimiter = sampling.NewTokenBucket(policyCfg.RateLimit.SpansPerSecond, policyCfg.RateLimit.Burst)
+		}
 		policies = append(policies, p)
 	}
 
@@ -108,6 +117,10 @@ func newTracesProcessor(logger *zap.Logger, nextConsumer consumer.Traces, cfg Co
 		policies:        policies,
 		tickerFrequency: time.Second,
 		numTracesOnMap:  atomic.NewUint64(0),
+		spanStorage:     newSpanStorage(cfg, logger),
+	}
+	if cfg.RateLimit != nil {
+		tsp.rateLimiter = sampling.NewTokenBucket(cfg.RateLimit.SpansPerSecond, cfg.RateLimit.Burst)
 	}
 
 	tsp.policyTicker = &timeutils.PolicyTicker{OnTickFunc: tsp.samplingPolicyOnTick}
@@ -155,17 +168,22 @@ func getSharedPolicyEvaluator(logger *zap.Logger, cfg *sharedPolicyCfg) (samplin
 	case TraceState:
 		tsfCfg := cfg.TraceStateCfg
 		return sampling.NewTraceStateFilter(logger, tsfCfg.Key, tsfCfg.Values), nil
+	case OTTLCondition:
+		ocfCfg := cfg.OTTLConditionCfg
+		return sampling.NewOTTLConditionFilter(logger, ocfCfg.SpanConditions)
 	default:
 		return nil, fmt.Errorf("unknown sampling policy type %s", cfg.Type)
 	}
 }
 
 type policyMetrics struct {
-	idNotFoundOnMapCount, evaluateErrorCount, decisionSampled, decisionNotSampled int64
+	idNotFoundOnMapCount, evaluateErrorCount, decisionSampled, decisionNotSampled, rateLimitedCount int64
+	// policySampled counts the traces sampled by each policy, after its rate limit.
+	policySampled []int64
 }
 
 func (tsp *tailSamplingSpanProcessor) samplingPolicyOnTick() {
-	metrics := policyMetrics{}
+	metrics := policyMetrics{policySampled: make([]int64, len(tsp.policies))}
 
 	startTime := time.Now()
 	batch, _ := tsp.decisionBatcher.CloseCurrentAndTakeFirstBatch()
@@ -178,7 +196,12 @@ func (tsp *tailSamplingSpanProcessor) samplingPolicyOnTick() {
 			continue
 		}
 		trace := d.(*sampling.TraceData)
+		trace.Lock()
 		trace.DecisionTime = time.Now()
+		if tsp.spanStorage != nil {
+			tsp.spanStorage.restore(trace)
+		}
+		trace.Unlock()
 
 		decision, policy := tsp.makeDecision(id, trace, &metrics)
 
@@ -186,6 +209,9 @@ func (tsp *tailSamplingSpanProcessor) samplingPolicyOnTick() {
 		trace.Lock()
 		allSpans := ptrace.NewTraces()
 		trace.ReceivedBatches.MoveTo(allSpans)
+		if tsp.spanStorage != nil {
+			tsp.spanStorage.removed(trace, int64(allSpans.SpanCount()))
+		}
 		trace.Unlock()
 
 		if decision == sampling.Sampled {
@@ -199,12 +225,19 @@ func (tsp *tailSamplingSpanProcessor) samplingPolicyOnTick() {
 		statPolicyEvaluationErrorCount.M(metrics.evaluateErrorCount),
 		statTracesOnMemoryGauge.M(int64(tsp.numTracesOnMap.Load())))
 
+	if evaluated := int64(batchLen) - metrics.idNotFoundOnMapCount; evaluated > 0 {
+		for i, p := range tsp.policies {
+			stats.Record(p.ctx, statPolicySampledRatio.M(float64(metrics.policySampled[i])/float64(evaluated)))
+		}
+	}
+
 	tsp.logger.Debug("Sampling policy evaluation completed",
 		zap.Int("batch.len", batchLen),
 		zap.Int64("sampled", metrics.decisionSampled),
 		zap.Int64("notSampled", metrics.decisionNotSampled),
 		zap.Int64("droppedPriorToEvaluation", metrics.idNotFoundOnMapCount),
 		zap.Int64("policyEvaluationErrors", metrics.evaluateErrorCount),
+		zap.Int64("rateLimited", metrics.rateLimitedCount),
 	)
 }
 
@@ -233,6 +266,18 @@ func (tsp *tailSamplingSpanProcessor) makeDecision(id pcommon.TraceID, trace *sa
 			metrics.evaluateErrorCount++
 			tsp.logger.Debug("Sampling policy error", zap.Error(err))
 		} else {
+			if decision == sampling.Sampled || decision == sampling.InvertSampled {
+				// A policy above its rate limit abstains, so that it neither samples the trace
+				// nor prevents the other inverted policies from sampling it. Its tokens are only
+				// taken once the trace is actually sampled.
+				if p.rateLimiter != nil && !p.rateLimiter.Allow(trace.SpanCount.Load()) {
+					tsp.recordRateLimited(p.ctx, "policy", metrics)
+					trace.Decisions[i] = sampling.NotSampled
+					continue
+				}
+				metrics.policySampled[i]++
+			}
+
 			switch decision {
 			case sampling.Sampled:
 				samplingDecision[sampling.Sampled] = true
@@ -263,6 +308,18 @@ func (tsp *tailSamplingSpanProcessor) makeDecision(id pcommon.TraceID, trace *sa
 		finalDecision = sampling.Sampled
 	}
 
+	if finalDecision == sampling.Sampled && tsp.rateLimiter != nil && !tsp.rateLimiter.Take(trace.SpanCount.Load()) {
+		tsp.recordRateLimited(tsp.ctx, "global", metrics)
+		finalDecision = sampling.NotSampled
+	}
+	if finalDecision == sampling.Sampled {
+		for i, p := range tsp.policies {
+			if p.rateLimiter != nil && trace.Decisions[i] == sampling.Sampled {
+				p.rateLimiter.Take(trace.SpanCount.Load())
+			}
+		}
+	}
+
 	for _, p := range tsp.policies {
 		switch finalDecision {
 		case sampling.Sampled:
@@ -292,6 +349,15 @@ func (tsp *tailSamplingSpanProcessor) makeDecision(id pcommon.TraceID, trace *sa
 	return finalDecision, matchingPolicy
 }
 
+func (tsp *tailSamplingSpanProcessor) recordRateLimited(ctx context.Context, limiter string, metrics *policyMetrics) {
+	_ = stats.RecordWithTags(
+		ctx,
+		[]tag.Mutator{tag.Upsert(tagLimiterKey, limiter)},
+		statCountTracesRateLimited.M(int64(1)),
+	)
+	metrics.rateLimitedCount++
+}
+
 // ConsumeTraces is required by the component.TracesProcessor interface.
 func (tsp *tailSamplingSpanProcessor) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
 	resourceSpans := td.ResourceSpans()
@@ -365,6 +431,9 @@ func (tsp *tailSamplingSpanProcessor) processTraces(resourceSpans ptrace.Resourc
 				// Add the spans to the trace, but only once for all policy, otherwise same spans will
 				// be duplicated in the final trace.
 				appendToTraces(actualData.ReceivedBatches, resourceSpans, spans)
+				if tsp.spanStorage != nil {
+					tsp.spanStorage.added(actualData, lenSpans)
+				}
 				actualData.Unlock()
 				break
 			}
@@ -405,15 +474,23 @@ func (tsp *tailSamplingSpanProcessor) Capabilities() consumer.Capabilities {
 }
 
 // Start is invoked during service startup.
-func (tsp *tailSamplingSpanProcessor) Start(context.Context, component.Host) error {
+func (tsp *tailSamplingSpanProcessor) Start(ctx context.Context, host component.Host) error {
+	if tsp.spanStorage != nil {
+		if err := tsp.spanStorage.start(ctx, host); err != nil {
+			return err
+		}
+	}
 	tsp.policyTicker.Start(tsp.tickerFrequency)
 	return nil
 }
 
 // Shutdown is invoked during service shutdown.
-func (tsp *tailSamplingSpanProcessor) Shutdown(context.Context) error {
+func (tsp *tailSamplingSpanProcessor) Shutdown(ctx context.Context) error {
 	tsp.decisionBatcher.Stop()
 	tsp.policyTicker.Stop()
+	if tsp.spanStorage != nil {
+		return tsp.spanStorage.shutdown(ctx)
+	}
 	return nil
 }
 
@@ -430,6 +507,12 @@ func (tsp *tailSamplingSpanProcessor) dropTrace(traceID pcommon.TraceID, deletio
 		return
 	}
 
+	if tsp.spanStorage != nil {
+		trace.Lock()
+		tsp.spanStorage.removed(trace, int64(trace.ReceivedBatches.SpanCount()))
+		trace.Unlock()
+	}
+
 	stats.Record(tsp.ctx, statTraceRemovalAgeSec.M(int64(deletionTime.Sub(trace.ArrivalTime)/time.Second)))
 }
 
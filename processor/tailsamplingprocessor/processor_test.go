@@ -704,3 +704,107 @@ func simpleTracesWithID(traceID pcommon.TraceID) ptrace.Traces {
 	traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetTraceID(traceID)
 	return traces
 }
+
+func TestMakeDecisionRateLimited(t *testing.T) {
+	newTrace := func() *sampling.TraceData {
+		return &sampling.TraceData{
+			Decisions: []sampling.Decision{sampling.Pending, sampling.Pending},
+			SpanCount: atomic.NewInt64(2),
+		}
+	}
+
+	tests := []struct {
+		name              string
+		policyRateLimiter *sampling.TokenBucket
+		globalRateLimiter *sampling.TokenBucket
+		wantDecisions     []sampling.Decision
+		wantRateLimited   int64
+		wantPolicySampled []int64
+	}{
+		{
+			name:              "policy rate limit",
+			policyRateLimiter: sampling.NewTokenBucket(1, 3),
+			wantDecisions:     []sampling.Decision{sampling.Sampled, sampling.NotSampled, sampling.NotSampled},
+			wantRateLimited:   2,
+			wantPolicySampled: []int64{1, 0},
+		},
+		{
+			name:              "global rate limit",
+			globalRateLimiter: sampling.NewTokenBucket(1, 5),
+			wantDecisions:     []sampling.Decision{sampling.Sampled, sampling.Sampled, sampling.NotSampled},
+			wantRateLimited:   1,
+			wantPolicySampled: []int64{3, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tsp := &tailSamplingSpanProcessor{
+				ctx:    context.Background(),
+				logger: zap.NewNop(),
+				policies: []*policy{
+					{name: "limited", evaluator: &mockPolicyEvaluator{NextDecision: sampling.Sampled}, ctx: context.TODO(), rateLimiter: tt.policyRateLimiter},
+					{name: "never", evaluator: &mockPolicyEvaluator{NextDecision: sampling.NotSampled}, ctx: context.TODO()},
+				},
+				rateLimiter: tt.globalRateLimiter,
+			}
+
+			metrics := policyMetrics{policySampled: make([]int64, len(tsp.policies))}
+			for i, want := range tt.wantDecisions {
+				decision, _ := tsp.makeDecision(pcommon.TraceID{byte(i + 1)}, newTrace(), &metrics)
+				require.Equal(t, want, decision, "decision %d", i)
+			}
+			require.Equal(t, tt.wantRateLimited, metrics.rateLimitedCount)
+			require.Equal(t, tt.wantPolicySampled, metrics.policySampled)
+		})
+	}
+}
+
+func TestMakeDecisionRateLimitedTokens(t *testing.T) {
+	newTrace := func(spanCount int64) *sampling.TraceData {
+		return &sampling.TraceData{
+			Decisions: []sampling.Decision{sampling.Pending, sampling.Pending},
+			SpanCount: atomic.NewInt64(spanCount),
+		}
+	}
+	newProcessor := func(limited, other sampling.Decision, rateLimiter *sampling.TokenBucket) *tailSamplingSpanProcessor {
+		return &tailSamplingSpanProcessor{
+			ctx:    context.Background(),
+			logger: zap.NewNop(),
+			policies: []*policy{
+				{name: "limited", evaluator: &mockPolicyEvaluator{NextDecision: limited}, ctx: context.TODO(), rateLimiter: rateLimiter},
+				{name: "other", evaluator: &mockPolicyEvaluator{NextDecision: other}, ctx: context.TODO()},
+			},
+		}
+	}
+
+	t.Run("not sampled traces are not charged", func(t *testing.T) {
+		rateLimiter := sampling.NewTokenBucket(1, 3)
+		tsp := newProcessor(sampling.Sampled, sampling.InvertNotSampled, rateLimiter)
+		metrics := policyMetrics{policySampled: make([]int64, len(tsp.policies))}
+		for i := 0; i < 3; i++ {
+			decision, _ := tsp.makeDecision(pcommon.TraceID{byte(i + 1)}, newTrace(2), &metrics)
+			require.Equal(t, sampling.NotSampled, decision)
+		}
+		require.True(t, rateLimiter.Allow(3))
+	})
+
+	t.Run("rate limited inverted policy abstains", func(t *testing.T) {
+		tsp := newProcessor(sampling.InvertSampled, sampling.InvertSampled, sampling.NewTokenBucket(1, 3))
+		metrics := policyMetrics{policySampled: make([]int64, len(tsp.policies))}
+		for i := 0; i < 3; i++ {
+			decision, _ := tsp.makeDecision(pcommon.TraceID{byte(i + 1)}, newTrace(2), &metrics)
+			require.Equal(t, sampling.Sampled, decision, "decision %d", i)
+		}
+		require.Equal(t, int64(2), metrics.rateLimitedCount)
+		require.Equal(t, []int64{1, 3}, metrics.policySampled)
+	})
+
+	t.Run("traces larger than the burst", func(t *testing.T) {
+		tsp := newProcessor(sampling.Sampled, sampling.NotSampled, sampling.NewTokenBucket(1, 3))
+		metrics := policyMetrics{policySampled: make([]int64, len(tsp.policies))}
+		decision, _ := tsp.makeDecision(pcommon.TraceID{1}, newTrace(10), &metrics)
+		require.Equal(t, sampling.Sampled, decision)
+		decision, _ = tsp.makeDecision(pcommon.TraceID{2}, newTrace(10), &metrics)
+		require.Equal(t, sampling.NotSampled, decision)
+	})
+}
@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"go.opencensus.io/stats"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+const (
+	// spillKeyPrefix prefixes the keys of the spilled spans.
+	spillKeyPrefix = "spill/"
+	// spillRangeKey is the key of the range of the spills which may be in the storage, so that the
+	// spills left by a collector which did not shut down cleanly are deleted on start.
+	spillRangeKey = "spill_range"
+	// sweepBatchSize is the number of spills deleted by each batch when sweeping the storage.
+	sweepBatchSize = 1000
+)
+
+// spanStorage spills the spans of the traces waiting for a sampling decision to a storage extension
+// when the number of spans held in memory exceeds a limit. Only the spans are spilled: the trace
+// data indexing them stays in memory, and is bounded by the number of traces kept on memory.
+type spanStorage struct {
+	storageID        config.ComponentID
+	processorID      config.ComponentID
+	maxSpansInMemory int64
+	numSpansInMemory *atomic.Int64
+	client           storage.Client
+	logger           *zap.Logger
+	marshaler        ptrace.Marshaler
+	unmarshaler      ptrace.Unmarshaler
+
+	// The spills are numbered in sequence, so that the spills which may be in the storage are the
+	// ones from the lowest spill not deleted yet to the next one.
+	spillsMu    sync.Mutex
+	liveSpills  map[uint64]struct{}
+	lowestSpill uint64
+	nextSpill   uint64
+}
+
+func newSpanStorage(cfg Config, logger *zap.Logger) *spanStorage {
+	if cfg.Storage == nil {
+		return nil
+	}
+	return &spanStorage{
+		storageID:        *cfg.Storage,
+		processorID:      cfg.ID(),
+		maxSpansInMemory: cfg.MaxSpansInMemory,
+		numSpansInMemory: atomic.NewInt64(0),
+		logger:           logger,
+		marshaler:        ptrace.NewProtoMarshaler(),
+		unmarshaler:      ptrace.NewProtoUnmarshaler(),
+		liveSpills:       make(map[uint64]struct{}),
+	}
+}
+
+func (s *spanStorage) start(ctx context.Context, host component.Host) error {
+	extension, ok := host.GetExtensions()[s.storageID]
+	if !ok {
+		return fmt.Errorf("storage extension '%s' not found", s.storageID)
+	}
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return fmt.Errorf("non-storage extension '%s' found", s.storageID)
+	}
+	client, err := storageExtension.GetClient(ctx, component.KindProcessor, s.processorID, "")
+	if err != nil {
+		return fmt.Errorf("failed to get storage client: %w", err)
+	}
+	s.client = client
+	return s.sweep(ctx)
+}
+
+// sweep deletes the spills left in the storage by a previous run which did not shut down cleanly.
+func (s *spanStorage) sweep(ctx context.Context) error {
+	data, err := s.client.Get(ctx, spillRangeKey)
+	if err != nil {
+		return fmt.Errorf("failed to read the range of the spilled spans: %w", err)
+	}
+	if len(data) != 16 {
+		return nil
+	}
+	lowest, next := binary.BigEndian.Uint64(data), binary.BigEndian.Uint64(data[8:])
+
+	ops := make([]storage.Operation, 0, sweepBatchSize)
+	for n := lowest; n < next; n++ {
+		ops = append(ops, storage.DeleteOperation(spillKey(n)))
+		if len(ops) == sweepBatchSize || n == next-1 {
+			if err = s.client.Batch(ctx, ops...); err != nil {
+				return fmt.Errorf("failed to delete the spans left in storage: %w", err)
+			}
+			ops = ops[:0]
+		}
+	}
+	if next > lowest {
+		s.logger.Info("Deleted the spans left in storage by the previous run", zap.Uint64("spills", next-lowest))
+	}
+	// Numbering the new spills after the previous ones keeps the recorded range valid
+	s.lowestSpill, s.nextSpill = next, next
+	return nil
+}
+
+func (s *spanStorage) shutdown(ctx context.Context) error {
+	if s.client == nil {
+		return nil
+	}
+	return s.client.Close(ctx)
+}
+
+// spillKey returns the key the spans of the nth spill are stored at.
+func spillKey(n uint64) string {
+	return spillKeyPrefix + strconv.FormatUint(n, 10)
+}
+
+// spill stores the spans as the next spill, along with the range of the spills which may be in
+// the storage, and returns the number of the spill.
+func (s *spanStorage) spill(data []byte) (uint64, error) {
+	s.spillsMu.Lock()
+	defer s.spillsMu.Unlock()
+
+	n := s.nextSpill
+	spillRange := make([]byte, 16)
+	binary.BigEndian.PutUint64(spillRange, s.lowestSpill)
+	binary.BigEndian.PutUint64(spillRange[8:], n+1)
+	if err := s.client.Batch(context.Background(),
+		storage.SetOperation(spillKey(n), data),
+		storage.SetOperation(spillRangeKey, spillRange),
+	); err != nil {
+		return 0, err
+	}
+	s.nextSpill++
+	s.liveSpills[n] = struct{}{}
+	return n, nil
+}
+
+// deleteSpill deletes the spill from the storage.
+func (s *spanStorage) deleteSpill(n uint64) {
+	if err := s.client.Delete(context.Background(), spillKey(n)); err != nil {
+		// The spill stays in the range to delete on the next start
+		s.logger.Warn("Failed to delete spans from storage", zap.Error(err))
+		return
+	}
+
+	s.spillsMu.Lock()
+	defer s.spillsMu.Unlock()
+	delete(s.liveSpills, n)
+	for s.lowestSpill < s.nextSpill {
+		if _, ok := s.liveSpills[s.lowestSpill]; ok {
+			break
+		}
+		s.lowestSpill++
+	}
+}
+
+// added records spans added to the memory, and spills the spans of the trace to the storage if
+// the spans in memory exceed the limit, unless the trace is being decided. It must be called with
+// the trace locked.
+func (s *spanStorage) added(trace *sampling.TraceData, numSpans int64) {
+	if s.numSpansInMemory.Add(numSpans) <= s.maxSpansInMemory || !trace.DecisionTime.IsZero() {
+		return
+	}
+	numTraceSpans := int64(trace.ReceivedBatches.SpanCount())
+	if numTraceSpans == 0 {
+		return
+	}
+	data, err := s.marshaler.MarshalTraces(trace.ReceivedBatches)
+	if err != nil {
+		s.logger.Warn("Failed to marshal spans to spill to storage", zap.Error(err))
+		return
+	}
+	n, err := s.spill(data)
+	if err != nil {
+		s.logger.Warn("Failed to spill spans to storage", zap.Error(err))
+		return
+	}
+	trace.Spills = append(trace.Spills, n)
+	trace.ReceivedBatches = ptrace.NewTraces()
+	s.numSpansInMemory.Sub(numTraceSpans)
+}
+
+// restore moves the spans of the trace spilled to the storage back to memory, so that the policies
+// can evaluate them. The spills are deleted from the storage, including the ones which can't be
+// restored: the decision is made without their spans, which are dropped and counted as such. It
+// must be called with the trace locked.
+func (s *spanStorage) restore(trace *sampling.TraceData) {
+	var dropped int64
+	for _, n := range trace.Spills {
+		spilled, err := s.read(n)
+		s.deleteSpill(n)
+		if err != nil {
+			s.logger.Warn("Dropping spans which could not be restored from storage", zap.Uint64("spill", n), zap.Error(err))
+			dropped++
+			continue
+		}
+		s.numSpansInMemory.Add(int64(spilled.SpanCount()))
+		spilled.ResourceSpans().MoveAndAppendTo(trace.ReceivedBatches.ResourceSpans())
+	}
+	trace.Spills = nil
+	if dropped > 0 {
+		stats.Record(context.Background(), statSpillsDroppedCount.M(dropped))
+	}
+}
+
+// read returns the spans of the spill.
+func (s *spanStorage) read(n uint64) (ptrace.Traces, error) {
+	data, err := s.client.Get(context.Background(), spillKey(n))
+	if err != nil {
+		return ptrace.Traces{}, fmt.Errorf("failed to read spans: %w", err)
+	}
+	if data == nil {
+		return ptrace.Traces{}, errors.New("spans not found")
+	}
+	spilled, err := s.unmarshaler.UnmarshalTraces(data)
+	if err != nil {
+		return ptrace.Traces{}, fmt.Errorf("failed to unmarshal spans: %w", err)
+	}
+	return spilled, nil
+}
+
+// removed records the spans of a trace removed from memory, and deletes the spans of the trace
+// left in the storage. It must be called with the trace locked.
+func (s *spanStorage) removed(trace *sampling.TraceData, numSpans int64) {
+	s.numSpansInMemory.Sub(numSpans)
+	for _, n := range trace.Spills {
+		s.deleteSpill(n)
+	}
+	trace.Spills = nil
+}
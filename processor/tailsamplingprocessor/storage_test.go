@@ -0,0 +1,287 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tailsamplingprocessor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtelemetry"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+type mapStorageClient struct {
+	sync.Mutex
+	data map[string][]byte
+	// getErr is returned by Get if set
+	getErr error
+}
+
+func (c *mapStorageClient) Get(_ context.Context, key string) ([]byte, error) {
+	c.Lock()
+	defer c.Unlock()
+	if c.getErr != nil {
+		return nil, c.getErr
+	}
+	return c.data[key], nil
+}
+
+func (c *mapStorageClient) Set(_ context.Context, key string, value []byte) error {
+	c.Lock()
+	defer c.Unlock()
+	c.data[key] = value
+	return nil
+}
+
+func (c *mapStorageClient) Delete(_ context.Context, key string) error {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.data, key)
+	return nil
+}
+
+func (c *mapStorageClient) Batch(_ context.Context, ops ...storage.Operation) error {
+	c.Lock()
+	defer c.Unlock()
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value = c.data[op.Key]
+		case storage.Set:
+			c.data[op.Key] = op.Value
+		case storage.Delete:
+			delete(c.data, op.Key)
+		}
+	}
+	return nil
+}
+
+func (c *mapStorageClient) Close(context.Context) error {
+	return nil
+}
+
+func (c *mapStorageClient) spills() int {
+	c.Lock()
+	defer c.Unlock()
+	spills := 0
+	for key := range c.data {
+		if strings.HasPrefix(key, spillKeyPrefix) {
+			spills++
+		}
+	}
+	return spills
+}
+
+type mapStorageExtension struct {
+	client *mapStorageClient
+}
+
+func (e *mapStorageExtension) Start(context.Context, component.Host) error {
+	return nil
+}
+
+func (e *mapStorageExtension) Shutdown(context.Context) error {
+	return nil
+}
+
+func (e *mapStorageExtension) GetClient(context.Context, component.Kind, config.ComponentID, string) (storage.Client, error) {
+	return e.client, nil
+}
+
+type storageHost struct {
+	component.Host
+	extensions map[config.ComponentID]component.Extension
+}
+
+func (h *storageHost) GetExtensions() map[config.ComponentID]component.Extension {
+	return h.extensions
+}
+
+func newTestStorageHost(client *mapStorageClient) (component.Host, Config) {
+	storageID := config.NewComponentID("file_storage")
+	host := &storageHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[config.ComponentID]component.Extension{storageID: &mapStorageExtension{client: client}},
+	}
+	return host, Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		Storage:           &storageID,
+	}
+}
+
+func newTestStorageProcessor(t *testing.T, maxSpansInMemory int64) (*tailSamplingSpanProcessor, *mapStorageClient, *consumertest.TracesSink, *mockPolicyEvaluator) {
+	const maxSize = 100
+	client := &mapStorageClient{data: map[string][]byte{}}
+	host, cfg := newTestStorageHost(client)
+	cfg.MaxSpansInMemory = maxSpansInMemory
+
+	msp := new(consumertest.TracesSink)
+	mpe := &mockPolicyEvaluator{}
+	tsp := &tailSamplingSpanProcessor{
+		ctx:             context.Background(),
+		nextConsumer:    msp,
+		maxNumTraces:    maxSize,
+		logger:          zap.NewNop(),
+		decisionBatcher: newSyncIDBatcher(1),
+		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:      make(chan pcommon.TraceID, maxSize),
+		policyTicker:    &manualTTicker{},
+		tickerFrequency: 100 * time.Millisecond,
+		numTracesOnMap:  atomic.NewUint64(0),
+		spanStorage:     newSpanStorage(cfg, zap.NewNop()),
+	}
+	require.NoError(t, tsp.Start(context.Background(), host))
+	t.Cleanup(func() {
+		require.NoError(t, tsp.Shutdown(context.Background()))
+	})
+	return tsp, client, msp, mpe
+}
+
+func TestSpansAreSpilledToStorage(t *testing.T) {
+	tsp, client, msp, mpe := newTestStorageProcessor(t, 2)
+	mpe.NextDecision = sampling.Sampled
+
+	traceIds, batches := generateIdsAndBatches(3)
+	for _, batch := range batches {
+		require.NoError(t, tsp.ConsumeTraces(context.Background(), batch))
+	}
+
+	// The second spans of the second and third traces brought the spans in memory above the limit.
+	assert.Equal(t, 2, client.spills())
+	assert.EqualValues(t, 2, tsp.spanStorage.numSpansInMemory.Load())
+
+	tsp.samplingPolicyOnTick()
+	tsp.samplingPolicyOnTick()
+
+	require.Len(t, msp.AllTraces(), 3)
+	for i, traceID := range traceIds {
+		trace := findTrace(t, msp.AllTraces(), traceID)
+		assert.EqualValues(t, i+1, trace.SpanCount(), "the spilled spans should be restored")
+	}
+	assert.Equal(t, 0, client.spills())
+	assert.EqualValues(t, 0, tsp.spanStorage.numSpansInMemory.Load())
+}
+
+func TestSpilledSpansAreDeletedWithDroppedTraces(t *testing.T) {
+	tsp, client, _, _ := newTestStorageProcessor(t, 1)
+
+	traceIds, batches := generateIdsAndBatches(2)
+	for _, batch := range batches {
+		require.NoError(t, tsp.ConsumeTraces(context.Background(), batch))
+	}
+	require.Equal(t, 2, client.spills())
+
+	tsp.dropTrace(traceIds[1], time.Now())
+	assert.Equal(t, 0, client.spills())
+	assert.EqualValues(t, 1, tsp.spanStorage.numSpansInMemory.Load())
+}
+
+func TestSpillsFailingToRestoreAreDropped(t *testing.T) {
+	client := &mapStorageClient{data: map[string][]byte{}}
+	host, cfg := newTestStorageHost(client)
+	cfg.MaxSpansInMemory = 1
+
+	droppedName := obsreport.BuildProcessorCustomMetricName(typeStr, statSpillsDroppedCount.Name())
+	var droppedView *view.View
+	for _, v := range SamplingProcessorMetricViews(configtelemetry.LevelNormal) {
+		if v.Name == droppedName {
+			droppedView = v
+		}
+	}
+	require.NotNil(t, droppedView)
+	require.NoError(t, view.Register(droppedView))
+	defer view.Unregister(droppedView)
+
+	s := newSpanStorage(cfg, zap.NewNop())
+	require.NoError(t, s.start(context.Background(), host))
+	_, batches := generateIdsAndBatches(2)
+	trace := &sampling.TraceData{ReceivedBatches: ptrace.NewTraces()}
+	for _, batch := range batches[1:] {
+		batch.ResourceSpans().MoveAndAppendTo(trace.ReceivedBatches.ResourceSpans())
+		s.added(trace, 1)
+	}
+	require.Len(t, trace.Spills, 1)
+
+	client.Lock()
+	client.getErr = errors.New("failed")
+	client.Unlock()
+	s.restore(trace)
+
+	assert.Empty(t, trace.Spills)
+	assert.Equal(t, 0, trace.ReceivedBatches.SpanCount())
+	assert.Equal(t, 0, client.spills())
+
+	rows, err := view.RetrieveData(droppedName)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, float64(1), rows[0].Data.(*view.SumData).Value)
+}
+
+func TestSpillsLeftInStorageAreDeletedOnStart(t *testing.T) {
+	client := &mapStorageClient{data: map[string][]byte{}}
+	host, cfg := newTestStorageHost(client)
+	cfg.MaxSpansInMemory = 1
+
+	// The previous run spilled the spans of two traces and didn't shut down cleanly
+	previous := newSpanStorage(cfg, zap.NewNop())
+	require.NoError(t, previous.start(context.Background(), host))
+	_, batches := generateIdsAndBatches(3)
+	for _, batch := range batches[:3] {
+		previous.added(&sampling.TraceData{ReceivedBatches: batch}, int64(batch.SpanCount()))
+	}
+	require.Equal(t, 2, client.spills())
+
+	s := newSpanStorage(cfg, zap.NewNop())
+	require.NoError(t, s.start(context.Background(), host))
+	assert.Equal(t, 0, client.spills())
+
+	// The new spills are numbered after the previous ones
+	var trace *sampling.TraceData
+	for _, batch := range batches[3:5] {
+		trace = &sampling.TraceData{ReceivedBatches: batch}
+		s.added(trace, int64(batch.SpanCount()))
+	}
+	assert.Equal(t, []uint64{2}, trace.Spills)
+	assert.Equal(t, 1, client.spills())
+}
+
+func TestStorageExtensionNotFound(t *testing.T) {
+	storageID := config.NewComponentID("file_storage")
+	cfg := Config{
+		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		Storage:           &storageID,
+		MaxSpansInMemory:  1,
+	}
+	s := newSpanStorage(cfg, zap.NewNop())
+	assert.EqualError(t, s.start(context.Background(), componenttest.NewNopHost()), "storage extension 'file_storage' not found")
+}
@@ -15,14 +15,14 @@
 package transformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
 
 import (
+	"errors"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoints"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllogs"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltraces"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/logs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metrics"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/traces"
@@ -32,6 +32,21 @@ type Config struct {
 	config.ProcessorSettings `mapstructure:",squash"`
 
 	OTTLConfig `mapstructure:",squash"`
+
+	// Debug configures the change events recorded for a sample of the transformed items.
+	Debug DebugConfig `mapstructure:"debug"`
+}
+
+// DebugConfig configures the change events, log records holding the values of the attributes
+// before and after the transformation of an item. They are sent to a logs exporter, so that
+// the statements can be verified against live telemetry.
+type DebugConfig struct {
+	// LogsExporter is the name of the logs exporter the change events are sent to.
+	// No change events are recorded if empty.
+	LogsExporter string `mapstructure:"logs_exporter"`
+
+	// SamplingPercentage is the percentage of the transformed items whose changes are recorded.
+	SamplingPercentage float64 `mapstructure:"sampling_percentage"`
 }
 
 type OTTLConfig struct {
@@ -42,27 +57,63 @@ type OTTLConfig struct {
 
 type SignalConfig struct {
 	Statements []string `mapstructure:"statements"`
+
+	// StatementGroups are executed after Statements, and only against telemetry
+	// whose resource matches the group's resource selector.
+	StatementGroups []StatementGroup `mapstructure:"statement_groups"`
+}
+
+// StatementGroup is a list of statements scoped to matching resources.
+type StatementGroup struct {
+	// Resource lists the resource attribute conditions that must all match for
+	// the statements to be executed. The conditions are evaluated once per resource.
+	Resource []ResourceAttribute `mapstructure:"resource"`
+
+	Statements []string `mapstructure:"statements"`
+}
+
+// ResourceAttribute matches a resource attribute by exact value or by regular expression.
+// If neither Value nor Regex is set, the attribute only needs to be present.
+type ResourceAttribute struct {
+	Key   string  `mapstructure:"key"`
+	Value *string `mapstructure:"value"`
+	Regex *string `mapstructure:"regex"`
+}
+
+func (sc SignalConfig) statementGroups() []common.StatementGroup {
+	groups := make([]common.StatementGroup, 0, len(sc.StatementGroups))
+	for _, group := range sc.StatementGroups {
+		resource := make([]common.ResourceAttribute, 0, len(group.Resource))
+		for _, attr := range group.Resource {
+			resource = append(resource, common.ResourceAttribute{Key: attr.Key, Value: attr.Value, Regex: attr.Regex})
+		}
+		groups = append(groups, common.StatementGroup{Resource: resource, Statements: group.Statements})
+	}
+	return groups
 }
 
 var _ config.Processor = (*Config)(nil)
 
+var errInvalidSamplingPercentage = errors.New("debug::sampling_percentage must be greater than 0 and at most 100")
+
 func (c *Config) Validate() error {
 	var errors error
+	if c.Debug.LogsExporter != "" && (c.Debug.SamplingPercentage <= 0 || c.Debug.SamplingPercentage > 100) {
+		errors = multierr.Append(errors, errInvalidSamplingPercentage)
+	}
+	settings := component.TelemetrySettings{Logger: zap.NewNop()}
 
-	ottltracesp := ottltraces.NewParser(traces.Functions(), component.TelemetrySettings{Logger: zap.NewNop()})
-	_, err := ottltracesp.ParseStatements(c.Traces.Statements)
+	_, err := traces.NewProcessor(c.Traces.Statements, c.Traces.statementGroups(), traces.Functions(), settings)
 	if err != nil {
 		errors = multierr.Append(errors, err)
 	}
 
-	ottlmetricsp := ottldatapoints.NewParser(metrics.Functions(), component.TelemetrySettings{Logger: zap.NewNop()})
-	_, err = ottlmetricsp.ParseStatements(c.Metrics.Statements)
+	_, err = metrics.NewProcessor(c.Metrics.Statements, c.Metrics.statementGroups(), metrics.Functions(), settings)
 	if err != nil {
 		errors = multierr.Append(errors, err)
 	}
 
-	ottllogsp := ottllogs.NewParser(logs.Functions(), component.TelemetrySettings{Logger: zap.NewNop()})
-	_, err = ottllogsp.ParseStatements(c.Logs.Statements)
+	_, err = logs.NewProcessor(c.Logs.Statements, c.Logs.statementGroups(), logs.Functions(), settings)
 	if err != nil {
 		errors = multierr.Append(errors, err)
 	}
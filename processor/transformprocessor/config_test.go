@@ -36,6 +36,9 @@ func TestLoadConfig(t *testing.T) {
 			id: config.NewComponentIDWithName(typeStr, ""),
 			expected: &Config{
 				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				Debug: DebugConfig{
+					SamplingPercentage: 1,
+				},
 				OTTLConfig: OTTLConfig{
 					Traces: SignalConfig{
 						Statements: []string{
@@ -58,6 +61,70 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "statement_groups"),
+			expected: &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				Debug: DebugConfig{
+					SamplingPercentage: 1,
+				},
+				OTTLConfig: OTTLConfig{
+					Traces: SignalConfig{
+						Statements: []string{},
+					},
+					Metrics: SignalConfig{
+						Statements: []string{},
+					},
+					Logs: SignalConfig{
+						Statements: []string{
+							`set(body, "bear") where attributes["http.path"] == "/animal"`,
+						},
+						StatementGroups: []StatementGroup{
+							{
+								Resource: []ResourceAttribute{
+									{Key: "tenant", Value: strp("acme")},
+									{Key: "service.name", Regex: strp("^checkout-.*")},
+								},
+								Statements: []string{
+									`keep_keys(attributes, "http.method", "http.path")`,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "debug"),
+			expected: &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				Debug: DebugConfig{
+					LogsExporter:       "logging/changes",
+					SamplingPercentage: 0.5,
+				},
+				OTTLConfig: OTTLConfig{
+					Traces: SignalConfig{
+						Statements: []string{
+							`set(attributes["http.route"], "/animal") where attributes["http.path"] == "/animal"`,
+						},
+					},
+					Metrics: SignalConfig{
+						Statements: []string{},
+					},
+					Logs: SignalConfig{
+						Statements: []string{},
+					},
+				},
+			},
+		},
+		{
+			id:           config.NewComponentIDWithName(typeStr, "bad_debug_sampling_percentage"),
+			errorMessage: "debug::sampling_percentage must be greater than 0 and at most 100",
+		},
+		{
+			id:           config.NewComponentIDWithName(typeStr, "bad_statement_group_regex"),
+			errorMessage: "invalid regex for resource attribute \"service.name\": error parsing regexp: missing closing ): `(`",
+		},
 		{
 			id:           config.NewComponentIDWithName(typeStr, "bad_syntax_trace"),
 			errorMessage: "1:18: unexpected token \"where\" (expected \")\")",
@@ -105,3 +172,7 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func strp(s string) *string {
+	return &s
+}
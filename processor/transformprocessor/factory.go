@@ -23,6 +23,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/processor/processorhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/logs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metrics"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/traces"
@@ -48,6 +49,9 @@ func NewFactory() component.ProcessorFactory {
 func createDefaultConfig() config.Processor {
 	return &Config{
 		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		Debug: DebugConfig{
+			SamplingPercentage: 1,
+		},
 		OTTLConfig: OTTLConfig{
 			Logs: SignalConfig{
 				Statements: []string{},
@@ -70,17 +74,22 @@ func createLogsProcessor(
 ) (component.LogsProcessor, error) {
 	oCfg := cfg.(*Config)
 
-	proc, err := logs.NewProcessor(oCfg.Logs.Statements, logs.Functions(), set.TelemetrySettings)
+	proc, err := logs.NewProcessor(oCfg.Logs.Statements, oCfg.Logs.statementGroups(), logs.Functions(), set.TelemetrySettings)
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
+	opts := []processorhelper.Option{processorhelper.WithCapabilities(processorCapabilities)}
+	if recorder := newChangeRecorder(oCfg, "logs", set); recorder != nil {
+		proc.RecordChanges(recorder)
+		opts = append(opts, processorhelper.WithStart(recorder.Start))
+	}
 	return processorhelper.NewLogsProcessor(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
 		proc.ProcessLogs,
-		processorhelper.WithCapabilities(processorCapabilities))
+		opts...)
 }
 
 func createTracesProcessor(
@@ -91,17 +100,22 @@ func createTracesProcessor(
 ) (component.TracesProcessor, error) {
 	oCfg := cfg.(*Config)
 
-	proc, err := traces.NewProcessor(oCfg.Traces.Statements, traces.Functions(), set.TelemetrySettings)
+	proc, err := traces.NewProcessor(oCfg.Traces.Statements, oCfg.Traces.statementGroups(), traces.Functions(), set.TelemetrySettings)
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
+	opts := []processorhelper.Option{processorhelper.WithCapabilities(processorCapabilities)}
+	if recorder := newChangeRecorder(oCfg, "traces", set); recorder != nil {
+		proc.RecordChanges(recorder)
+		opts = append(opts, processorhelper.WithStart(recorder.Start))
+	}
 	return processorhelper.NewTracesProcessor(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
 		proc.ProcessTraces,
-		processorhelper.WithCapabilities(processorCapabilities))
+		opts...)
 }
 
 func createMetricsProcessor(
@@ -112,15 +126,28 @@ func createMetricsProcessor(
 ) (component.MetricsProcessor, error) {
 	oCfg := cfg.(*Config)
 
-	proc, err := metrics.NewProcessor(oCfg.Metrics.Statements, metrics.Functions(), set.TelemetrySettings)
+	proc, err := metrics.NewProcessor(oCfg.Metrics.Statements, oCfg.Metrics.statementGroups(), metrics.Functions(), set.TelemetrySettings)
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
+	opts := []processorhelper.Option{processorhelper.WithCapabilities(processorCapabilities)}
+	if recorder := newChangeRecorder(oCfg, "metrics", set); recorder != nil {
+		proc.RecordChanges(recorder)
+		opts = append(opts, processorhelper.WithStart(recorder.Start))
+	}
 	return processorhelper.NewMetricsProcessor(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
 		proc.ProcessMetrics,
-		processorhelper.WithCapabilities(processorCapabilities))
+		opts...)
+}
+
+// newChangeRecorder returns the recorder of the change events of the signal, or nil if they are disabled.
+func newChangeRecorder(cfg *Config, signal string, set component.ProcessorCreateSettings) *common.ChangeRecorder {
+	if cfg.Debug.LogsExporter == "" {
+		return nil
+	}
+	return common.NewChangeRecorder(cfg.ID(), signal, cfg.Debug.LogsExporter, cfg.Debug.SamplingPercentage, set.Logger)
 }
@@ -19,6 +19,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configtest"
@@ -38,6 +40,9 @@ func TestFactory_CreateDefaultConfig(t *testing.T) {
 	cfg := factory.CreateDefaultConfig()
 	assert.Equal(t, cfg, &Config{
 		ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+		Debug: DebugConfig{
+			SamplingPercentage: 1,
+		},
 		OTTLConfig: OTTLConfig{
 			Traces: SignalConfig{
 				Statements: []string{},
@@ -164,3 +169,112 @@ func TestFactoryCreateLogsProcessor_InvalidActions(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, ap)
 }
+
+type sinkExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	*consumertest.LogsSink
+}
+
+type exportersHost struct {
+	component.Host
+	exporters map[config.DataType]map[config.ComponentID]component.Exporter
+}
+
+func (h exportersHost) GetExporters() map[config.DataType]map[config.ComponentID]component.Exporter {
+	return h.exporters
+}
+
+func TestFactoryCreateProcessorsWithChangeEvents(t *testing.T) {
+	tests := []struct {
+		signal  string
+		create  func(t *testing.T, factory component.ProcessorFactory, cfg config.Processor) component.Processor
+		consume func(t *testing.T, p component.Processor)
+	}{
+		{
+			signal: "traces",
+			create: func(t *testing.T, factory component.ProcessorFactory, cfg config.Processor) component.Processor {
+				cfg.(*Config).Traces.Statements = []string{`set(attributes["test"], "pass") where name == "operationA"`}
+				p, err := factory.CreateTracesProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, consumertest.NewNop())
+				require.NoError(t, err)
+				return p
+			},
+			consume: func(t *testing.T, p component.Processor) {
+				td := ptrace.NewTraces()
+				td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("operationA")
+				require.NoError(t, p.(component.TracesProcessor).ConsumeTraces(context.Background(), td))
+			},
+		},
+		{
+			signal: "metrics",
+			create: func(t *testing.T, factory component.ProcessorFactory, cfg config.Processor) component.Processor {
+				cfg.(*Config).Metrics.Statements = []string{`set(attributes["test"], "pass") where metric.name == "operationA"`}
+				p, err := factory.CreateMetricsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, consumertest.NewNop())
+				require.NoError(t, err)
+				return p
+			},
+			consume: func(t *testing.T, p component.Processor) {
+				md := pmetric.NewMetrics()
+				metric := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+				metric.SetName("operationA")
+				metric.SetEmptySum().DataPoints().AppendEmpty()
+				require.NoError(t, p.(component.MetricsProcessor).ConsumeMetrics(context.Background(), md))
+			},
+		},
+		{
+			signal: "logs",
+			create: func(t *testing.T, factory component.ProcessorFactory, cfg config.Processor) component.Processor {
+				cfg.(*Config).Logs.Statements = []string{`set(attributes["test"], "pass") where body == "operationA"`}
+				p, err := factory.CreateLogsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, consumertest.NewNop())
+				require.NoError(t, err)
+				return p
+			},
+			consume: func(t *testing.T, p component.Processor) {
+				ld := plog.NewLogs()
+				ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("operationA")
+				require.NoError(t, p.(component.LogsProcessor).ConsumeLogs(context.Background(), ld))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.signal, func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			cfg.(*Config).Debug = DebugConfig{LogsExporter: "logging/changes", SamplingPercentage: 100}
+			p := tt.create(t, factory, cfg)
+
+			// The logs exporter of the change events is looked up when the processor starts
+			assert.Error(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+			sink := new(consumertest.LogsSink)
+			host := exportersHost{
+				Host: componenttest.NewNopHost(),
+				exporters: map[config.DataType]map[config.ComponentID]component.Exporter{
+					config.LogsDataType: {
+						config.NewComponentIDWithName("logging", "changes"): sinkExporter{LogsSink: sink},
+					},
+				},
+			}
+			require.NoError(t, p.Start(context.Background(), host))
+			defer func() {
+				require.NoError(t, p.Shutdown(context.Background()))
+			}()
+
+			tt.consume(t, p)
+
+			require.Len(t, sink.AllLogs(), 1)
+			require.Equal(t, 1, sink.LogRecordCount())
+			record := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+			signal, ok := record.Attributes().Get("transform.signal")
+			require.True(t, ok)
+			assert.Equal(t, tt.signal, signal.Str())
+			assert.Equal(t, map[string]interface{}{
+				"attributes": map[string]interface{}{
+					"before": map[string]interface{}{},
+					"after":  map[string]interface{}{"test": "pass"},
+				},
+			}, record.Body().Map().AsRaw())
+		})
+	}
+}
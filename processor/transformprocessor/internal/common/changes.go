@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+const (
+	changesScopeName = "otelcol/transformprocessor"
+
+	changeProcessorAttribute = "transform.processor"
+	changeSignalAttribute    = "transform.signal"
+	changeItemAttribute      = "transform.item"
+)
+
+// ChangeRecorder samples the transformed items, and sends the changes of their attributes as
+// log records, called change events, to a logs exporter.
+type ChangeRecorder struct {
+	processorID        string
+	signal             string
+	exporterName       string
+	samplingPercentage float64
+	logger             *zap.Logger
+
+	// random returns a number in [0, 100), overridden in tests
+	random   func() float64
+	exporter component.LogsExporter
+}
+
+// NewChangeRecorder creates a ChangeRecorder sending the change events of the items of the signal
+// to the logs exporter named exporterName, which is looked up when the recorder is started.
+func NewChangeRecorder(processorID config.ComponentID, signal string, exporterName string, samplingPercentage float64, logger *zap.Logger) *ChangeRecorder {
+	return &ChangeRecorder{
+		processorID:        processorID.String(),
+		signal:             signal,
+		exporterName:       exporterName,
+		samplingPercentage: samplingPercentage,
+		logger:             logger,
+		random: func() float64 {
+			return rand.Float64() * 100 // #nosec
+		},
+	}
+}
+
+// Start looks up the logs exporter receiving the change events.
+func (r *ChangeRecorder) Start(_ context.Context, host component.Host) error {
+	var availableExporters []string
+	for id, exp := range host.GetExporters()[config.LogsDataType] {
+		availableExporters = append(availableExporters, id.String())
+		if id.String() != r.exporterName {
+			continue
+		}
+		logsExp, ok := exp.(component.LogsExporter)
+		if !ok {
+			return fmt.Errorf("the exporter %q isn't a logs exporter", r.exporterName)
+		}
+		r.exporter = logsExp
+		return nil
+	}
+	return fmt.Errorf("failed to find logs exporter %q for the change events; please configure debug.logs_exporter from one of: %+v",
+		r.exporterName, availableExporters)
+}
+
+// ChangeEvents holds the change events of a batch.
+type ChangeEvents struct {
+	logs    plog.Logs
+	records plog.LogRecordSlice
+}
+
+// NewEvents returns the change events of a new batch.
+func (r *ChangeRecorder) NewEvents() ChangeEvents {
+	if r == nil {
+		return ChangeEvents{}
+	}
+	logs := plog.NewLogs()
+	scopeLogs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	scopeLogs.Scope().SetName(changesScopeName)
+	return ChangeEvents{logs: logs, records: scopeLogs.LogRecords()}
+}
+
+// Track runs transform, which transforms the item named name, if it has a name. If the item is sampled, the changes of its
+// attributes and of the attributes of its resource are added to the events, and the change event is returned.
+func (r *ChangeRecorder) Track(events ChangeEvents, name string, attributes pcommon.Map, resourceAttributes pcommon.Map, transform func()) (plog.LogRecord, bool) {
+	if r == nil || r.random() >= r.samplingPercentage {
+		transform()
+		return plog.LogRecord{}, false
+	}
+
+	attributesBefore := pcommon.NewMap()
+	attributes.CopyTo(attributesBefore)
+	resourceAttributesBefore := pcommon.NewMap()
+	resourceAttributes.CopyTo(resourceAttributesBefore)
+
+	transform()
+
+	body := pcommon.NewMap()
+	attributesChanged := diffAttributes(attributesBefore, attributes, body, "attributes")
+	resourceAttributesChanged := diffAttributes(resourceAttributesBefore, resourceAttributes, body, "resource.attributes")
+	if !attributesChanged && !resourceAttributesChanged {
+		return plog.LogRecord{}, false
+	}
+
+	record := events.records.AppendEmpty()
+	record.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	record.Attributes().PutStr(changeProcessorAttribute, r.processorID)
+	record.Attributes().PutStr(changeSignalAttribute, r.signal)
+	if name != "" {
+		record.Attributes().PutStr(changeItemAttribute, name)
+	}
+	body.CopyTo(record.Body().SetEmptyMap())
+	return record, true
+}
+
+// Emit sends the change events to the logs exporter. The errors are logged, so that the transformed
+// data is not refused because of the change events.
+func (r *ChangeRecorder) Emit(ctx context.Context, events ChangeEvents) {
+	if r == nil || r.exporter == nil || events.records.Len() == 0 {
+		return
+	}
+	if err := r.exporter.ConsumeLogs(ctx, events.logs); err != nil {
+		r.logger.Warn("Failed to export the change events", zap.Error(err))
+	}
+}
+
+// diffAttributes puts the previous and current values of the attributes that changed between before and after
+// in the "before" and "after" maps of the key entry of body. It returns true if any attribute changed.
+func diffAttributes(before pcommon.Map, after pcommon.Map, body pcommon.Map, key string) bool {
+	changedBefore := pcommon.NewMap()
+	before.Range(func(k string, v pcommon.Value) bool {
+		if current, ok := after.Get(k); !ok || !current.Equal(v) {
+			v.CopyTo(changedBefore.PutEmpty(k))
+		}
+		return true
+	})
+	changedAfter := pcommon.NewMap()
+	after.Range(func(k string, v pcommon.Value) bool {
+		if previous, ok := before.Get(k); !ok || !previous.Equal(v) {
+			v.CopyTo(changedAfter.PutEmpty(k))
+		}
+		return true
+	})
+	if changedBefore.Len() == 0 && changedAfter.Len() == 0 {
+		return false
+	}
+	change := body.PutEmptyMap(key)
+	changedBefore.CopyTo(change.PutEmptyMap("before"))
+	changedAfter.CopyTo(change.PutEmptyMap("after"))
+	return true
+}
@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+)
+
+type sinkExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	*consumertest.LogsSink
+}
+
+type exportersHost struct {
+	component.Host
+	exporters map[config.DataType]map[config.ComponentID]component.Exporter
+}
+
+func (h exportersHost) GetExporters() map[config.DataType]map[config.ComponentID]component.Exporter {
+	return h.exporters
+}
+
+func newTestRecorder(t *testing.T, samplingPercentage float64) (*ChangeRecorder, *consumertest.LogsSink) {
+	sink := new(consumertest.LogsSink)
+	recorder := NewChangeRecorder(config.NewComponentID("transform"), "traces", "logging/changes", samplingPercentage, zap.NewNop())
+	host := exportersHost{
+		Host: componenttest.NewNopHost(),
+		exporters: map[config.DataType]map[config.ComponentID]component.Exporter{
+			config.LogsDataType: {
+				config.NewComponentIDWithName("logging", "changes"): sinkExporter{LogsSink: sink},
+			},
+		},
+	}
+	require.NoError(t, recorder.Start(context.Background(), host))
+	return recorder, sink
+}
+
+func TestChangeRecorderStartUnknownExporter(t *testing.T) {
+	recorder := NewChangeRecorder(config.NewComponentID("transform"), "traces", "logging/changes", 100, zap.NewNop())
+	err := recorder.Start(context.Background(), componenttest.NewNopHost())
+	assert.ErrorContains(t, err, `failed to find logs exporter "logging/changes"`)
+}
+
+func TestChangeRecorderTrack(t *testing.T) {
+	recorder, sink := newTestRecorder(t, 100)
+
+	attributes := pcommon.NewMap()
+	attributes.PutStr("http.path", "/animal")
+	attributes.PutStr("http.method", "GET")
+	attributes.PutStr("password", "secret")
+	resourceAttributes := pcommon.NewMap()
+	resourceAttributes.PutStr("service.name", "checkout")
+
+	events := recorder.NewEvents()
+	record, ok := recorder.Track(events, "GET /animal", attributes, resourceAttributes, func() {
+		attributes.PutStr("http.route", "/animal")
+		attributes.PutStr("http.method", "POST")
+		attributes.Remove("password")
+	})
+	require.True(t, ok)
+	record.SetSpanID(pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	recorder.Emit(context.Background(), events)
+
+	require.Equal(t, 1, sink.LogRecordCount())
+	got := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0)
+	assert.Equal(t, "otelcol/transformprocessor", got.Scope().Name())
+	gotRecord := got.LogRecords().At(0)
+	assert.Equal(t, map[string]interface{}{
+		"transform.processor": "transform",
+		"transform.signal":    "traces",
+		"transform.item":      "GET /animal",
+	}, gotRecord.Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{
+		"attributes": map[string]interface{}{
+			"before": map[string]interface{}{
+				"http.method": "GET",
+				"password":    "secret",
+			},
+			"after": map[string]interface{}{
+				"http.method": "POST",
+				"http.route":  "/animal",
+			},
+		},
+	}, gotRecord.Body().Map().AsRaw())
+	assert.Equal(t, pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}), gotRecord.SpanID())
+}
+
+func TestChangeRecorderTrackResource(t *testing.T) {
+	recorder, sink := newTestRecorder(t, 100)
+
+	attributes := pcommon.NewMap()
+	resourceAttributes := pcommon.NewMap()
+	resourceAttributes.PutStr("service.name", "checkout")
+
+	events := recorder.NewEvents()
+	_, ok := recorder.Track(events, "", attributes, resourceAttributes, func() {
+		resourceAttributes.PutStr("service.name", "checkout-api")
+	})
+	require.True(t, ok)
+	recorder.Emit(context.Background(), events)
+
+	require.Equal(t, 1, sink.LogRecordCount())
+	gotRecord := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	_, hasItem := gotRecord.Attributes().Get("transform.item")
+	assert.False(t, hasItem)
+	assert.Equal(t, map[string]interface{}{
+		"resource.attributes": map[string]interface{}{
+			"before": map[string]interface{}{"service.name": "checkout"},
+			"after":  map[string]interface{}{"service.name": "checkout-api"},
+		},
+	}, gotRecord.Body().Map().AsRaw())
+}
+
+func TestChangeRecorderNoChange(t *testing.T) {
+	recorder, sink := newTestRecorder(t, 100)
+
+	attributes := pcommon.NewMap()
+	attributes.PutStr("http.path", "/animal")
+
+	events := recorder.NewEvents()
+	transformed := false
+	_, ok := recorder.Track(events, "GET /animal", attributes, pcommon.NewMap(), func() {
+		transformed = true
+		attributes.PutStr("http.path", "/animal")
+	})
+	assert.True(t, transformed)
+	assert.False(t, ok)
+	recorder.Emit(context.Background(), events)
+	assert.Equal(t, 0, sink.LogRecordCount())
+}
+
+func TestChangeRecorderSampling(t *testing.T) {
+	recorder, sink := newTestRecorder(t, 25)
+	randoms := []float64{10, 30, 24.9, 25}
+	recorder.random = func() float64 {
+		r := randoms[0]
+		randoms = randoms[1:]
+		return r
+	}
+
+	events := recorder.NewEvents()
+	for i := 0; i < 4; i++ {
+		attributes := pcommon.NewMap()
+		transformed := false
+		recorder.Track(events, "item", attributes, pcommon.NewMap(), func() {
+			transformed = true
+			attributes.PutInt("index", int64(i))
+		})
+		assert.True(t, transformed)
+	}
+	recorder.Emit(context.Background(), events)
+
+	require.Equal(t, 2, sink.LogRecordCount())
+	records := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, map[string]interface{}{"index": int64(0)}, records.At(0).Body().Map().AsRaw()["attributes"].(map[string]interface{})["after"])
+	assert.Equal(t, map[string]interface{}{"index": int64(2)}, records.At(1).Body().Map().AsRaw()["attributes"].(map[string]interface{})["after"])
+}
+
+func TestChangeRecorderNil(t *testing.T) {
+	var recorder *ChangeRecorder
+	attributes := pcommon.NewMap()
+
+	events := recorder.NewEvents()
+	transformed := false
+	_, ok := recorder.Track(events, "item", attributes, pcommon.NewMap(), func() {
+		transformed = true
+		attributes.PutStr("key", "value")
+	})
+	assert.True(t, transformed)
+	assert.False(t, ok)
+	recorder.Emit(context.Background(), events)
+}
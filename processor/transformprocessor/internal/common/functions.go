@@ -23,6 +23,9 @@ func Functions[K any]() map[string]interface{} {
 		"TraceID":              ottlfuncs.TraceID[K],
 		"SpanID":               ottlfuncs.SpanID[K],
 		"IsMatch":              ottlfuncs.IsMatch[K],
+		"HasPrefix":            ottlfuncs.HasPrefix[K],
+		"Hex":                  ottlfuncs.Hex[K],
+		"FromHex":              ottlfuncs.FromHex[K],
 		"Concat":               ottlfuncs.Concat[K],
 		"Split":                ottlfuncs.Split[K],
 		"Int":                  ottlfuncs.Int[K],
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// ResourceAttribute describes a condition on a single resource attribute.
+// If neither Value nor Regex is set, only the presence of the key is checked.
+type ResourceAttribute struct {
+	Key   string
+	Value *string
+	Regex *string
+}
+
+// StatementGroup is a list of statements that only apply to resources matched by all of its attributes.
+type StatementGroup struct {
+	Resource   []ResourceAttribute
+	Statements []string
+}
+
+type attributeMatcher struct {
+	key   string
+	value *string
+	regex *regexp.Regexp
+}
+
+// ResourceSelector decides whether a statement group applies to a resource.
+type ResourceSelector []attributeMatcher
+
+func NewResourceSelector(attributes []ResourceAttribute) (ResourceSelector, error) {
+	selector := make(ResourceSelector, 0, len(attributes))
+	for _, attribute := range attributes {
+		if attribute.Key == "" {
+			return nil, errors.New("resource attribute key cannot be empty")
+		}
+		if attribute.Value != nil && attribute.Regex != nil {
+			return nil, fmt.Errorf("resource attribute %q cannot specify both value and regex", attribute.Key)
+		}
+
+		matcher := attributeMatcher{key: attribute.Key, value: attribute.Value}
+		if attribute.Regex != nil {
+			re, err := regexp.Compile(*attribute.Regex)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regex for resource attribute %q: %w", attribute.Key, err)
+			}
+			matcher.regex = re
+		}
+		selector = append(selector, matcher)
+	}
+	return selector, nil
+}
+
+// Matches returns true if the resource satisfies every attribute condition of the selector.
+func (s ResourceSelector) Matches(resource pcommon.Resource) bool {
+	attrs := resource.Attributes()
+	for _, matcher := range s {
+		val, ok := attrs.Get(matcher.key)
+		if !ok {
+			return false
+		}
+		switch {
+		case matcher.value != nil:
+			if val.AsString() != *matcher.value {
+				return false
+			}
+		case matcher.regex != nil:
+			if !matcher.regex.MatchString(val.AsString()) {
+				return false
+			}
+		}
+	}
+	return true
+}
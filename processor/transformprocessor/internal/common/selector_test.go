@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func strp(s string) *string {
+	return &s
+}
+
+func TestResourceSelector(t *testing.T) {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("tenant", "acme")
+	resource.Attributes().PutStr("service.name", "checkout-api")
+	resource.Attributes().PutInt("shard", 3)
+
+	tests := []struct {
+		name       string
+		attributes []ResourceAttribute
+		want       bool
+	}{
+		{
+			name: "empty selector",
+			want: true,
+		},
+		{
+			name:       "equality match",
+			attributes: []ResourceAttribute{{Key: "tenant", Value: strp("acme")}},
+			want:       true,
+		},
+		{
+			name:       "equality mismatch",
+			attributes: []ResourceAttribute{{Key: "tenant", Value: strp("other")}},
+			want:       false,
+		},
+		{
+			name:       "non string value",
+			attributes: []ResourceAttribute{{Key: "shard", Value: strp("3")}},
+			want:       true,
+		},
+		{
+			name:       "regex match",
+			attributes: []ResourceAttribute{{Key: "service.name", Regex: strp("^checkout-.*")}},
+			want:       true,
+		},
+		{
+			name:       "regex mismatch",
+			attributes: []ResourceAttribute{{Key: "service.name", Regex: strp("^cart-.*")}},
+			want:       false,
+		},
+		{
+			name:       "key presence",
+			attributes: []ResourceAttribute{{Key: "tenant"}},
+			want:       true,
+		},
+		{
+			name:       "missing key",
+			attributes: []ResourceAttribute{{Key: "region"}},
+			want:       false,
+		},
+		{
+			name: "all conditions must match",
+			attributes: []ResourceAttribute{
+				{Key: "tenant", Value: strp("acme")},
+				{Key: "service.name", Regex: strp("^cart-.*")},
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := NewResourceSelector(tt.attributes)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, selector.Matches(resource))
+		})
+	}
+}
+
+func TestNewResourceSelectorErrors(t *testing.T) {
+	_, err := NewResourceSelector([]ResourceAttribute{{Value: strp("acme")}})
+	assert.EqualError(t, err, "resource attribute key cannot be empty")
+
+	_, err = NewResourceSelector([]ResourceAttribute{{Key: "tenant", Value: strp("acme"), Regex: strp("acme")}})
+	assert.EqualError(t, err, `resource attribute "tenant" cannot specify both value and regex`)
+
+	_, err = NewResourceSelector([]ResourceAttribute{{Key: "tenant", Regex: strp("(")}})
+	assert.Error(t, err)
+}
@@ -23,26 +23,74 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoints"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
 type Processor struct {
 	statements []*ottl.Statement[ottldatapoints.TransformContext]
+	groups     []statementGroup
+	changes    *common.ChangeRecorder
 }
 
-func NewProcessor(statements []string, functions map[string]interface{}, settings component.TelemetrySettings) (*Processor, error) {
+type statementGroup struct {
+	selector   common.ResourceSelector
+	statements []*ottl.Statement[ottldatapoints.TransformContext]
+}
+
+func NewProcessor(statements []string, groups []common.StatementGroup, functions map[string]interface{}, settings component.TelemetrySettings) (*Processor, error) {
 	ottlp := ottldatapoints.NewParser(functions, settings)
 	parsedStatements, err := ottlp.ParseStatements(statements)
 	if err != nil {
 		return nil, err
 	}
+
+	parsedGroups := make([]statementGroup, 0, len(groups))
+	for _, group := range groups {
+		selector, err := common.NewResourceSelector(group.Resource)
+		if err != nil {
+			return nil, err
+		}
+		groupStatements, err := ottlp.ParseStatements(group.Statements)
+		if err != nil {
+			return nil, err
+		}
+		parsedGroups = append(parsedGroups, statementGroup{selector: selector, statements: groupStatements})
+	}
+
 	return &Processor{
 		statements: parsedStatements,
+		groups:     parsedGroups,
 	}, nil
 }
 
-func (p *Processor) ProcessMetrics(_ context.Context, td pmetric.Metrics) (pmetric.Metrics, error) {
+// RecordChanges makes the processor record the changes of the sampled items with the recorder.
+func (p *Processor) RecordChanges(recorder *common.ChangeRecorder) {
+	p.changes = recorder
+}
+
+// selectStatements returns the statements that apply to the resource: the ungrouped
+// statements followed by those of every group whose selector matches.
+func (p *Processor) selectStatements(resource pcommon.Resource) []*ottl.Statement[ottldatapoints.TransformContext] {
+	if len(p.groups) == 0 {
+		return p.statements
+	}
+	statements := p.statements
+	for _, group := range p.groups {
+		if group.selector.Matches(resource) {
+			statements = append(statements[:len(statements):len(statements)], group.statements...)
+		}
+	}
+	return statements
+}
+
+func (p *Processor) ProcessMetrics(ctx context.Context, td pmetric.Metrics) (pmetric.Metrics, error) {
+	events := p.changes.NewEvents()
 	for i := 0; i < td.ResourceMetrics().Len(); i++ {
 		rmetrics := td.ResourceMetrics().At(i)
+		statements := p.selectStatements(rmetrics.Resource())
+		if len(statements) == 0 {
+			continue
+		}
 		for j := 0; j < rmetrics.ScopeMetrics().Len(); j++ {
 			smetrics := rmetrics.ScopeMetrics().At(j)
 			metrics := smetrics.Metrics()
@@ -50,52 +98,65 @@ func (p *Processor) ProcessMetrics(_ context.Context, td pmetric.Metrics) (pmetr
 				metric := metrics.At(k)
 				switch metric.Type() {
 				case pmetric.MetricTypeSum:
-					p.handleNumberDataPoints(metric.Sum().DataPoints(), metrics.At(k), metrics, smetrics.Scope(), rmetrics.Resource())
+					p.handleNumberDataPoints(events, statements, metric.Sum().DataPoints(), metrics.At(k), metrics, smetrics.Scope(), rmetrics.Resource())
 				case pmetric.MetricTypeGauge:
-					p.handleNumberDataPoints(metric.Gauge().DataPoints(), metrics.At(k), metrics, smetrics.Scope(), rmetrics.Resource())
+					p.handleNumberDataPoints(events, statements, metric.Gauge().DataPoints(), metrics.At(k), metrics, smetrics.Scope(), rmetrics.Resource())
 				case pmetric.MetricTypeHistogram:
-					p.handleHistogramDataPoints(metric.Histogram().DataPoints(), metrics.At(k), metrics, smetrics.Scope(), rmetrics.Resource())
+					p.handleHistogramDataPoints(events, statements, metric.Histogram().DataPoints(), metrics.At(k), metrics, smetrics.Scope(), rmetrics.Resource())
 				case pmetric.MetricTypeExponentialHistogram:
-					p.handleExponetialHistogramDataPoints(metric.ExponentialHistogram().DataPoints(), metrics.At(k), metrics, smetrics.Scope(), rmetrics.Resource())
+					p.handleExponetialHistogramDataPoints(events, statements, metric.ExponentialHistogram().DataPoints(), metrics.At(k), metrics, smetrics.Scope(), rmetrics.Resource())
 				case pmetric.MetricTypeSummary:
-					p.handleSummaryDataPoints(metric.Summary().DataPoints(), metrics.At(k), metrics, smetrics.Scope(), rmetrics.Resource())
+					p.handleSummaryDataPoints(events, statements, metric.Summary().DataPoints(), metrics.At(k), metrics, smetrics.Scope(), rmetrics.Resource())
 				}
 			}
 		}
 	}
+	p.changes.Emit(ctx, events)
 	return td, nil
 }
 
-func (p *Processor) handleNumberDataPoints(dps pmetric.NumberDataPointSlice, metric pmetric.Metric, metrics pmetric.MetricSlice, is pcommon.InstrumentationScope, resource pcommon.Resource) {
+func (p *Processor) handleNumberDataPoints(events common.ChangeEvents, statements []*ottl.Statement[ottldatapoints.TransformContext], dps pmetric.NumberDataPointSlice, metric pmetric.Metric, metrics pmetric.MetricSlice, is pcommon.InstrumentationScope, resource pcommon.Resource) {
 	for i := 0; i < dps.Len(); i++ {
-		ctx := ottldatapoints.NewTransformContext(dps.At(i), metric, metrics, is, resource)
-		p.callFunctions(ctx)
+		dp := dps.At(i)
+		ctx := ottldatapoints.NewTransformContext(dp, metric, metrics, is, resource)
+		p.changes.Track(events, metric.Name(), dp.Attributes(), resource.Attributes(), func() {
+			callFunctions(statements, ctx)
+		})
 	}
 }
 
-func (p *Processor) handleHistogramDataPoints(dps pmetric.HistogramDataPointSlice, metric pmetric.Metric, metrics pmetric.MetricSlice, is pcommon.InstrumentationScope, resource pcommon.Resource) {
+func (p *Processor) handleHistogramDataPoints(events common.ChangeEvents, statements []*ottl.Statement[ottldatapoints.TransformContext], dps pmetric.HistogramDataPointSlice, metric pmetric.Metric, metrics pmetric.MetricSlice, is pcommon.InstrumentationScope, resource pcommon.Resource) {
 	for i := 0; i < dps.Len(); i++ {
-		ctx := ottldatapoints.NewTransformContext(dps.At(i), metric, metrics, is, resource)
-		p.callFunctions(ctx)
+		dp := dps.At(i)
+		ctx := ottldatapoints.NewTransformContext(dp, metric, metrics, is, resource)
+		p.changes.Track(events, metric.Name(), dp.Attributes(), resource.Attributes(), func() {
+			callFunctions(statements, ctx)
+		})
 	}
 }
 
-func (p *Processor) handleExponetialHistogramDataPoints(dps pmetric.ExponentialHistogramDataPointSlice, metric pmetric.Metric, metrics pmetric.MetricSlice, is pcommon.InstrumentationScope, resource pcommon.Resource) {
+func (p *Processor) handleExponetialHistogramDataPoints(events common.ChangeEvents, statements []*ottl.Statement[ottldatapoints.TransformContext], dps pmetric.ExponentialHistogramDataPointSlice, metric pmetric.Metric, metrics pmetric.MetricSlice, is pcommon.InstrumentationScope, resource pcommon.Resource) {
 	for i := 0; i < dps.Len(); i++ {
-		ctx := ottldatapoints.NewTransformContext(dps.At(i), metric, metrics, is, resource)
-		p.callFunctions(ctx)
+		dp := dps.At(i)
+		ctx := ottldatapoints.NewTransformContext(dp, metric, metrics, is, resource)
+		p.changes.Track(events, metric.Name(), dp.Attributes(), resource.Attributes(), func() {
+			callFunctions(statements, ctx)
+		})
 	}
 }
 
-func (p *Processor) handleSummaryDataPoints(dps pmetric.SummaryDataPointSlice, metric pmetric.Metric, metrics pmetric.MetricSlice, is pcommon.InstrumentationScope, resource pcommon.Resource) {
+func (p *Processor) handleSummaryDataPoints(events common.ChangeEvents, statements []*ottl.Statement[ottldatapoints.TransformContext], dps pmetric.SummaryDataPointSlice, metric pmetric.Metric, metrics pmetric.MetricSlice, is pcommon.InstrumentationScope, resource pcommon.Resource) {
 	for i := 0; i < dps.Len(); i++ {
-		ctx := ottldatapoints.NewTransformContext(dps.At(i), metric, metrics, is, resource)
-		p.callFunctions(ctx)
+		dp := dps.At(i)
+		ctx := ottldatapoints.NewTransformContext(dp, metric, metrics, is, resource)
+		p.changes.Track(events, metric.Name(), dp.Attributes(), resource.Attributes(), func() {
+			callFunctions(statements, ctx)
+		})
 	}
 }
 
-func (p *Processor) callFunctions(ctx ottldatapoints.TransformContext) {
-	for _, statement := range p.statements {
+func callFunctions(statements []*ottl.Statement[ottldatapoints.TransformContext], ctx ottldatapoints.TransformContext) {
+	for _, statement := range statements {
 		statement.Execute(ctx)
 	}
 }
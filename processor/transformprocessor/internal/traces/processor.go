@@ -18,40 +18,97 @@ import (
 	"context"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltraces"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
 type Processor struct {
 	statements []*ottl.Statement[ottltraces.TransformContext]
+	groups     []statementGroup
+	changes    *common.ChangeRecorder
 }
 
-func NewProcessor(statements []string, functions map[string]interface{}, settings component.TelemetrySettings) (*Processor, error) {
+type statementGroup struct {
+	selector   common.ResourceSelector
+	statements []*ottl.Statement[ottltraces.TransformContext]
+}
+
+func NewProcessor(statements []string, groups []common.StatementGroup, functions map[string]interface{}, settings component.TelemetrySettings) (*Processor, error) {
 	ottlp := ottltraces.NewParser(functions, settings)
 	parsedStatements, err := ottlp.ParseStatements(statements)
 	if err != nil {
 		return nil, err
 	}
+
+	parsedGroups := make([]statementGroup, 0, len(groups))
+	for _, group := range groups {
+		selector, err := common.NewResourceSelector(group.Resource)
+		if err != nil {
+			return nil, err
+		}
+		groupStatements, err := ottlp.ParseStatements(group.Statements)
+		if err != nil {
+			return nil, err
+		}
+		parsedGroups = append(parsedGroups, statementGroup{selector: selector, statements: groupStatements})
+	}
+
 	return &Processor{
 		statements: parsedStatements,
+		groups:     parsedGroups,
 	}, nil
 }
 
-func (p *Processor) ProcessTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+// RecordChanges makes the processor record the changes of the sampled items with the recorder.
+func (p *Processor) RecordChanges(recorder *common.ChangeRecorder) {
+	p.changes = recorder
+}
+
+// selectStatements returns the statements that apply to the resource: the ungrouped
+// statements followed by those of every group whose selector matches.
+func (p *Processor) selectStatements(resource pcommon.Resource) []*ottl.Statement[ottltraces.TransformContext] {
+	if len(p.groups) == 0 {
+		return p.statements
+	}
+	statements := p.statements
+	for _, group := range p.groups {
+		if group.selector.Matches(resource) {
+			statements = append(statements[:len(statements):len(statements)], group.statements...)
+		}
+	}
+	return statements
+}
+
+func (p *Processor) ProcessTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	events := p.changes.NewEvents()
 	for i := 0; i < td.ResourceSpans().Len(); i++ {
 		rspans := td.ResourceSpans().At(i)
+		statements := p.selectStatements(rspans.Resource())
+		if len(statements) == 0 {
+			continue
+		}
 		for j := 0; j < rspans.ScopeSpans().Len(); j++ {
 			sspan := rspans.ScopeSpans().At(j)
 			spans := sspan.Spans()
 			for k := 0; k < spans.Len(); k++ {
-				ctx := ottltraces.NewTransformContext(spans.At(k), sspan.Scope(), rspans.Resource())
-				for _, statement := range p.statements {
-					statement.Execute(ctx)
+				span := spans.At(k)
+				tCtx := ottltraces.NewTransformContext(span, sspan.Scope(), rspans.Resource())
+				record, ok := p.changes.Track(events, span.Name(), span.Attributes(), rspans.Resource().Attributes(), func() {
+					for _, statement := range statements {
+						statement.Execute(tCtx)
+					}
+				})
+				if ok {
+					record.SetTraceID(span.TraceID())
+					record.SetSpanID(span.SpanID())
 				}
 			}
 		}
 	}
+	p.changes.Emit(ctx, events)
 	return td, nil
 }
@@ -23,6 +23,8 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
 var (
@@ -239,7 +241,72 @@ func TestProcess(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.statement, func(t *testing.T) {
 			td := constructTraces()
-			processor, err := NewProcessor([]string{tt.statement}, Functions(), componenttest.NewNopTelemetrySettings())
+			processor, err := NewProcessor([]string{tt.statement}, nil, Functions(), componenttest.NewNopTelemetrySettings())
+			assert.NoError(t, err)
+
+			_, err = processor.ProcessTraces(context.Background(), td)
+			assert.NoError(t, err)
+
+			exTd := constructTraces()
+			tt.want(exTd)
+
+			assert.Equal(t, exTd, td)
+		})
+	}
+}
+
+func TestProcess_StatementGroups(t *testing.T) {
+	localhost := "localhost"
+	remote := "remote"
+	tests := []struct {
+		name   string
+		groups []common.StatementGroup
+		want   func(td ptrace.Traces)
+	}{
+		{
+			name: "matching value",
+			groups: []common.StatementGroup{
+				{
+					Resource:   []common.ResourceAttribute{{Key: "host.name", Value: &localhost}},
+					Statements: []string{`set(attributes["test"], "pass") where name == "operationA"`},
+				},
+			},
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			name: "non matching value",
+			groups: []common.StatementGroup{
+				{
+					Resource:   []common.ResourceAttribute{{Key: "host.name", Value: &remote}},
+					Statements: []string{`set(attributes["test"], "pass")`},
+				},
+			},
+			want: func(td ptrace.Traces) {},
+		},
+		{
+			name: "only matching groups are executed",
+			groups: []common.StatementGroup{
+				{
+					Resource:   []common.ResourceAttribute{{Key: "host.name", Value: &remote}},
+					Statements: []string{`set(attributes["test"], "fail")`},
+				},
+				{
+					Resource:   []common.ResourceAttribute{{Key: "host.name"}},
+					Statements: []string{`set(attributes["test"], "pass") where name == "operationB"`},
+				},
+			},
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Attributes().PutStr("test", "pass")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := constructTraces()
+			processor, err := NewProcessor(nil, tt.groups, Functions(), componenttest.NewNopTelemetrySettings())
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessTraces(context.Background(), td)
@@ -289,7 +356,7 @@ func BenchmarkTwoSpans(b *testing.B) {
 
 	for _, tt := range tests {
 		b.Run(tt.name, func(b *testing.B) {
-			processor, err := NewProcessor(tt.statements, Functions(), componenttest.NewNopTelemetrySettings())
+			processor, err := NewProcessor(tt.statements, nil, Functions(), componenttest.NewNopTelemetrySettings())
 			assert.NoError(b, err)
 			b.ResetTimer()
 			for n := 0; n < b.N; n++ {
@@ -331,7 +398,7 @@ func BenchmarkHundredSpans(b *testing.B) {
 	}
 	for _, tt := range tests {
 		b.Run(tt.name, func(b *testing.B) {
-			processor, err := NewProcessor(tt.statements, Functions(), componenttest.NewNopTelemetrySettings())
+			processor, err := NewProcessor(tt.statements, nil, Functions(), componenttest.NewNopTelemetrySettings())
 			assert.NoError(b, err)
 			b.ResetTimer()
 			for n := 0; n < b.N; n++ {
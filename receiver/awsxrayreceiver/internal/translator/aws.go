@@ -75,5 +75,8 @@ func addAWSToSpan(aws *awsxray.AWSData, attrs pcommon.Map) {
 		addString(aws.QueueURL, awsxray.AWSQueueURLAttribute, attrs)
 		addString(aws.TableName, awsxray.AWSTableNameAttribute, attrs)
 		addInt64(aws.Retries, awsxray.AWSXrayRetriesAttribute, attrs)
+		if aws.XRay != nil {
+			addString(aws.XRay.SamplingRuleName, awsxray.AWSXRaySamplingRuleNameAttribute, attrs)
+		}
 	}
 }
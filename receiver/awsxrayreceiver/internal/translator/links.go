@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsxrayreceiver/internal/translator"
+
+import (
+	"encoding/hex"
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	awsxray "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray"
+)
+
+const defaultMetadataNamespace = "default"
+
+// addLinks adds the links of the segment to the span. The links are read from
+// the `links` field, from the `precursor_ids` of a subsegment, which are the
+// ids of the subsegments preceding it in the same trace, and from the metadata
+// written by the X-Ray exporter. The metadata of the segment is returned
+// without the links converted from it.
+func addLinks(seg *awsxray.Segment, traceID [16]byte, span ptrace.Span) (map[string]map[string]interface{}, error) {
+	for _, l := range seg.Links {
+		linkTraceID, err := decodeLinkTraceID(l.TraceID)
+		if err != nil {
+			return nil, err
+		}
+		linkSpanID, err := decodeXRaySpanID(l.SpanID)
+		if err != nil {
+			return nil, err
+		}
+		link := span.Links().AppendEmpty()
+		link.SetTraceID(linkTraceID)
+		link.SetSpanID(linkSpanID)
+		if len(l.Attributes) > 0 {
+			link.Attributes().FromRaw(l.Attributes)
+		}
+	}
+
+	for i := range seg.PrecursorIDs {
+		precursorID, err := decodeXRaySpanID(&seg.PrecursorIDs[i])
+		if err != nil {
+			return nil, err
+		}
+		link := span.Links().AppendEmpty()
+		link.SetTraceID(traceID)
+		link.SetSpanID(precursorID)
+	}
+
+	return addLinksFromMetadata(seg.Metadata, span), nil
+}
+
+// addLinksFromMetadata adds the links stored in the metadata by the X-Ray exporter
+// to the span. The metadata is returned as is if the links are malformed.
+func addLinksFromMetadata(meta map[string]map[string]interface{}, span ptrace.Span) map[string]map[string]interface{} {
+	rawLinks, ok := meta[defaultMetadataNamespace][awsxray.AWSXRaySpanLinksMetadataKey].([]interface{})
+	if !ok {
+		return meta
+	}
+
+	links := ptrace.NewSpanLinkSlice()
+	for _, rawLink := range rawLinks {
+		fields, ok := rawLink.(map[string]interface{})
+		if !ok {
+			return meta
+		}
+		traceID, _ := fields["trace_id"].(string)
+		spanID, _ := fields["id"].(string)
+		linkTraceID, err := decodeLinkTraceID(&traceID)
+		if err != nil {
+			return meta
+		}
+		linkSpanID, err := decodeXRaySpanID(&spanID)
+		if err != nil {
+			return meta
+		}
+		link := links.AppendEmpty()
+		link.SetTraceID(linkTraceID)
+		link.SetSpanID(linkSpanID)
+		if attrs, ok := fields["attributes"].(map[string]interface{}); ok {
+			link.Attributes().FromRaw(attrs)
+		}
+	}
+	links.MoveAndAppendTo(span.Links())
+
+	// copy the metadata rather than modifying the segment
+	filtered := make(map[string]map[string]interface{}, len(meta))
+	for namespace, values := range meta {
+		if namespace != defaultMetadataNamespace {
+			filtered[namespace] = values
+			continue
+		}
+		defaults := make(map[string]interface{}, len(values))
+		for k, v := range values {
+			if k != awsxray.AWSXRaySpanLinksMetadataKey {
+				defaults[k] = v
+			}
+		}
+		if len(defaults) > 0 {
+			filtered[namespace] = defaults
+		}
+	}
+	return filtered
+}
+
+// decodeLinkTraceID decodes the trace id of a link, which is either in the X-Ray
+// format or, if the X-Ray exporter could not convert it, a 32 digit hex string.
+func decodeLinkTraceID(traceID *string) ([16]byte, error) {
+	if traceID == nil {
+		return [16]byte{}, errors.New("link traceID is null")
+	}
+	if len(*traceID) != 32 {
+		return decodeXRayTraceID(traceID)
+	}
+	tid := [16]byte{}
+	_, err := hex.Decode(tid[:], []byte(*traceID))
+	return tid, err
+}
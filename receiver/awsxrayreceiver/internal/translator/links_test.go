@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	awsxray "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray"
+)
+
+func TestLinksAndSamplingRule(t *testing.T) {
+	content, err := os.ReadFile(filepath.Join("../../../../internal/aws/xray", "testdata", "linksAndSamplingRule.txt"))
+	require.NoError(t, err)
+
+	traces, count, err := ToTraces(content)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	spans := traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	require.Equal(t, 3, spans.Len())
+	traceID := "5f1872536a106696d56b1f4ef9eba2ed"
+
+	root := spans.At(0)
+	assert.Equal(t, ptrace.SpanKindServer, root.Kind(), "a segment with a propagated parent id is a server span")
+	assert.Equal(t, "defdfd9912dc5a56", root.ParentSpanID().HexString())
+	ruleName, ok := root.Attributes().Get(awsxray.AWSXRaySamplingRuleNameAttribute)
+	require.True(t, ok)
+	assert.Equal(t, "checkout-rule", ruleName.Str())
+	assert.Equal(t, []linkProps{
+		{
+			traceID: "5f1872502a4e9c3b8d7f1e6a5b0c9d8e",
+			spanID:  "a1b2c3d4e5f60718",
+			attrs:   map[string]interface{}{"messaging.operation": "process"},
+		},
+	}, toLinkProps(root.Links()))
+
+	validate := spans.At(1)
+	assert.Equal(t, ptrace.SpanKindInternal, validate.Kind())
+	assert.Equal(t, 0, validate.Links().Len())
+
+	charge := spans.At(2)
+	assert.Equal(t, ptrace.SpanKindInternal, charge.Kind())
+	assert.Equal(t, []linkProps{
+		{
+			traceID: traceID,
+			spanID:  "6a3d2e8f4b1c9a07",
+			attrs:   map[string]interface{}{},
+		},
+		{
+			traceID: "5f1872517c8d9e0f1a2b3c4d5e6f7a8b",
+			spanID:  "0f1e2d3c4b5a6978",
+			attrs:   map[string]interface{}{"link.kind": "follows_from"},
+		},
+		{
+			traceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+			spanID:  "00f067aa0ba902b7",
+			attrs:   map[string]interface{}{},
+		},
+	}, toLinkProps(charge.Links()))
+	metadata, ok := charge.Attributes().Get(awsxray.AWSXraySegmentMetadataAttributePrefix + "default")
+	require.True(t, ok)
+	assert.JSONEq(t, `{"order":"1234"}`, metadata.Str(), "the links should be removed from the metadata")
+}
+
+func TestAddLinksFromMalformedMetadata(t *testing.T) {
+	meta := map[string]map[string]interface{}{
+		"default": {
+			awsxray.AWSXRaySpanLinksMetadataKey: []interface{}{
+				map[string]interface{}{"trace_id": "not-a-trace-id", "id": "0f1e2d3c4b5a6978"},
+			},
+		},
+	}
+
+	span := ptrace.NewSpan()
+	assert.Equal(t, meta, addLinksFromMetadata(meta, span), "malformed links should be kept as metadata")
+	assert.Equal(t, 0, span.Links().Len())
+}
+
+func TestAddLinksInvalidLink(t *testing.T) {
+	seg := &awsxray.Segment{
+		Links: []awsxray.SpanLinkData{
+			{
+				TraceID: awsxray.String("1-5f187250-2a4e9c3b8d7f1e6a5b0c9d8e"),
+				SpanID:  awsxray.String("a1b2"),
+			},
+		},
+	}
+
+	_, err := addLinks(seg, [16]byte{}, ptrace.NewSpan())
+	assert.EqualError(t, err, "spanID length is wrong")
+}
+
+type linkProps struct {
+	traceID string
+	spanID  string
+	attrs   map[string]interface{}
+}
+
+func toLinkProps(links ptrace.SpanLinkSlice) []linkProps {
+	props := make([]linkProps, 0, links.Len())
+	for i := 0; i < links.Len(); i++ {
+		link := links.At(i)
+		props = append(props, linkProps{
+			traceID: link.TraceID().HexString(),
+			spanID:  link.SpanID().HexString(),
+			attrs:   link.Attributes().AsRaw(),
+		})
+	}
+	return props
+}
@@ -170,7 +170,10 @@ func populateSpan(seg *awsxray.Segment, traceID, parentID *string, span ptrace.S
 
 	if parentIDBytes != [8]byte{} {
 		span.SetParentSpanID(parentIDBytes)
-	} else {
+	}
+	// A segment records the work done by a service to serve a request, its
+	// parent id being the id of the caller propagated in the tracing header.
+	if parentIDBytes == [8]byte{} || (parentID == nil && !isSubsegment(seg)) {
 		span.SetKind(ptrace.SpanKindServer)
 	}
 
@@ -189,8 +192,19 @@ func populateSpan(seg *awsxray.Segment, traceID, parentID *string, span ptrace.S
 
 	addBool(seg.Traced, awsxray.AWSXRayTracedAttribute, attrs)
 
+	metadata, err := addLinks(seg, traceIDBytes, span)
+	if err != nil {
+		return err
+	}
+
 	addAnnotations(seg.Annotations, attrs)
-	return addMetadata(seg.Metadata, attrs)
+	return addMetadata(metadata, attrs)
+}
+
+// isSubsegment returns true if seg is an independent subsegment. Embedded
+// subsegments don't have their type set.
+func isSubsegment(seg *awsxray.Segment) bool {
+	return seg.Type != nil && *seg.Type == "subsegment"
 }
 
 func populateResource(seg *awsxray.Segment, rs pcommon.Resource) {
@@ -22,6 +22,7 @@ import (
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
 )
 
@@ -53,6 +54,11 @@ type Config struct {
 	// A list of filters whose matching images are to be excluded.  Supports literals, globs, and regex.
 	ExcludedImages []string `mapstructure:"excluded_images"`
 
+	// Include specifies a filter on the names of the containers that should be scraped.
+	Include MatchConfig `mapstructure:"include"`
+	// Exclude specifies a filter on the names of the containers that should not be scraped.
+	Exclude MatchConfig `mapstructure:"exclude"`
+
 	// Whether to report all CPU metrics.  Default is false
 	ProvidePerCoreCPUMetrics bool `mapstructure:"provide_per_core_cpu_metrics"`
 
@@ -63,6 +69,12 @@ type Config struct {
 	MetricsConfig metadata.MetricsSettings `mapstructure:"metrics"`
 }
 
+type MatchConfig struct {
+	filterset.Config `mapstructure:",squash"`
+
+	Names []string `mapstructure:"names"`
+}
+
 func (config Config) Validate() error {
 	if config.Endpoint == "" {
 		return errors.New("endpoint must be specified")
@@ -25,6 +25,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
 )
 
@@ -56,6 +57,14 @@ func TestLoadConfig(t *testing.T) {
 					"undesired-container",
 					"another-*-container",
 				},
+				Include: MatchConfig{
+					Config: filterset.Config{MatchType: filterset.Regexp},
+					Names:  []string{"^app-.*"},
+				},
+				Exclude: MatchConfig{
+					Config: filterset.Config{MatchType: filterset.Strict},
+					Names:  []string{"app-sidecar"},
+				},
 
 				ContainerLabelsToMetricLabels: map[string]string{
 					"my.container.label":       "my-metric-label",
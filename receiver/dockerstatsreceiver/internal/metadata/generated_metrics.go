@@ -35,6 +35,7 @@ type MetricsSettings struct {
 	ContainerCPUUsageSystem                    MetricSettings `mapstructure:"container.cpu.usage.system"`
 	ContainerCPUUsageTotal                     MetricSettings `mapstructure:"container.cpu.usage.total"`
 	ContainerCPUUsageUsermode                  MetricSettings `mapstructure:"container.cpu.usage.usermode"`
+	ContainerHealthStatus                      MetricSettings `mapstructure:"container.health.status"`
 	ContainerMemoryActiveAnon                  MetricSettings `mapstructure:"container.memory.active_anon"`
 	ContainerMemoryActiveFile                  MetricSettings `mapstructure:"container.memory.active_file"`
 	ContainerMemoryCache                       MetricSettings `mapstructure:"container.memory.cache"`
@@ -81,6 +82,7 @@ type MetricsSettings struct {
 	ContainerNetworkIoUsageTxDropped           MetricSettings `mapstructure:"container.network.io.usage.tx_dropped"`
 	ContainerNetworkIoUsageTxErrors            MetricSettings `mapstructure:"container.network.io.usage.tx_errors"`
 	ContainerNetworkIoUsageTxPackets           MetricSettings `mapstructure:"container.network.io.usage.tx_packets"`
+	ContainerRestarts                          MetricSettings `mapstructure:"container.restarts"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -136,6 +138,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		ContainerCPUUsageUsermode: MetricSettings{
 			Enabled: true,
 		},
+		ContainerHealthStatus: MetricSettings{
+			Enabled: false,
+		},
 		ContainerMemoryActiveAnon: MetricSettings{
 			Enabled: false,
 		},
@@ -274,7 +279,40 @@ func DefaultMetricsSettings() MetricsSettings {
 		ContainerNetworkIoUsageTxPackets: MetricSettings{
 			Enabled: false,
 		},
+		ContainerRestarts: MetricSettings{
+			Enabled: false,
+		},
+	}
+}
+
+// AttributeHealthStatus specifies the a value health_status attribute.
+type AttributeHealthStatus int
+
+const (
+	_ AttributeHealthStatus = iota
+	AttributeHealthStatusStarting
+	AttributeHealthStatusHealthy
+	AttributeHealthStatusUnhealthy
+)
+
+// String returns the string representation of the AttributeHealthStatus.
+func (av AttributeHealthStatus) String() string {
+	switch av {
+	case AttributeHealthStatusStarting:
+		return "starting"
+	case AttributeHealthStatusHealthy:
+		return "healthy"
+	case AttributeHealthStatusUnhealthy:
+		return "unhealthy"
 	}
+	return ""
+}
+
+// MapAttributeHealthStatus is a helper map of string to AttributeHealthStatus attribute value.
+var MapAttributeHealthStatus = map[string]AttributeHealthStatus{
+	"starting":  AttributeHealthStatusStarting,
+	"healthy":   AttributeHealthStatusHealthy,
+	"unhealthy": AttributeHealthStatusUnhealthy,
 }
 
 type metricContainerBlockioIoMergedRecursive struct {
@@ -1176,6 +1214,57 @@ func newMetricContainerCPUUsageUsermode(settings MetricSettings) metricContainer
 	return m
 }
 
+type metricContainerHealthStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.health.status metric with initial data.
+func (m *metricContainerHealthStatus) init() {
+	m.data.SetName("container.health.status")
+	m.data.SetDescription("Health check status of the container. The data point for the current status is 1, the others are 0.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerHealthStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, healthStatusAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("health_status", healthStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerHealthStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerHealthStatus) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerHealthStatus(settings MetricSettings) metricContainerHealthStatus {
+	m := metricContainerHealthStatus{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricContainerMemoryActiveAnon struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -3536,6 +3625,57 @@ func newMetricContainerNetworkIoUsageTxPackets(settings MetricSettings) metricCo
 	return m
 }
 
+type metricContainerRestarts struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.restarts metric with initial data.
+func (m *metricContainerRestarts) init() {
+	m.data.SetName("container.restarts")
+	m.data.SetDescription("Number of times the container has been restarted by docker.")
+	m.data.SetUnit("{restarts}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricContainerRestarts) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerRestarts) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerRestarts) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerRestarts(settings MetricSettings) metricContainerRestarts {
+	m := metricContainerRestarts{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
@@ -3561,6 +3701,7 @@ type MetricsBuilder struct {
 	metricContainerCPUUsageSystem                    metricContainerCPUUsageSystem
 	metricContainerCPUUsageTotal                     metricContainerCPUUsageTotal
 	metricContainerCPUUsageUsermode                  metricContainerCPUUsageUsermode
+	metricContainerHealthStatus                      metricContainerHealthStatus
 	metricContainerMemoryActiveAnon                  metricContainerMemoryActiveAnon
 	metricContainerMemoryActiveFile                  metricContainerMemoryActiveFile
 	metricContainerMemoryCache                       metricContainerMemoryCache
@@ -3607,6 +3748,7 @@ type MetricsBuilder struct {
 	metricContainerNetworkIoUsageTxDropped           metricContainerNetworkIoUsageTxDropped
 	metricContainerNetworkIoUsageTxErrors            metricContainerNetworkIoUsageTxErrors
 	metricContainerNetworkIoUsageTxPackets           metricContainerNetworkIoUsageTxPackets
+	metricContainerRestarts                          metricContainerRestarts
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -3641,6 +3783,7 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricContainerCPUUsageSystem:                    newMetricContainerCPUUsageSystem(settings.ContainerCPUUsageSystem),
 		metricContainerCPUUsageTotal:                     newMetricContainerCPUUsageTotal(settings.ContainerCPUUsageTotal),
 		metricContainerCPUUsageUsermode:                  newMetricContainerCPUUsageUsermode(settings.ContainerCPUUsageUsermode),
+		metricContainerHealthStatus:                      newMetricContainerHealthStatus(settings.ContainerHealthStatus),
 		metricContainerMemoryActiveAnon:                  newMetricContainerMemoryActiveAnon(settings.ContainerMemoryActiveAnon),
 		metricContainerMemoryActiveFile:                  newMetricContainerMemoryActiveFile(settings.ContainerMemoryActiveFile),
 		metricContainerMemoryCache:                       newMetricContainerMemoryCache(settings.ContainerMemoryCache),
@@ -3687,6 +3830,7 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricContainerNetworkIoUsageTxDropped:           newMetricContainerNetworkIoUsageTxDropped(settings.ContainerNetworkIoUsageTxDropped),
 		metricContainerNetworkIoUsageTxErrors:            newMetricContainerNetworkIoUsageTxErrors(settings.ContainerNetworkIoUsageTxErrors),
 		metricContainerNetworkIoUsageTxPackets:           newMetricContainerNetworkIoUsageTxPackets(settings.ContainerNetworkIoUsageTxPackets),
+		metricContainerRestarts:                          newMetricContainerRestarts(settings.ContainerRestarts),
 	}
 	for _, op := range options {
 		op(mb)
@@ -3792,6 +3936,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricContainerCPUUsageSystem.emit(ils.Metrics())
 	mb.metricContainerCPUUsageTotal.emit(ils.Metrics())
 	mb.metricContainerCPUUsageUsermode.emit(ils.Metrics())
+	mb.metricContainerHealthStatus.emit(ils.Metrics())
 	mb.metricContainerMemoryActiveAnon.emit(ils.Metrics())
 	mb.metricContainerMemoryActiveFile.emit(ils.Metrics())
 	mb.metricContainerMemoryCache.emit(ils.Metrics())
@@ -3838,6 +3983,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricContainerNetworkIoUsageTxDropped.emit(ils.Metrics())
 	mb.metricContainerNetworkIoUsageTxErrors.emit(ils.Metrics())
 	mb.metricContainerNetworkIoUsageTxPackets.emit(ils.Metrics())
+	mb.metricContainerRestarts.emit(ils.Metrics())
 	for _, op := range rmo {
 		op(rm)
 	}
@@ -3942,6 +4088,11 @@ func (mb *MetricsBuilder) RecordContainerCPUUsageUsermodeDataPoint(ts pcommon.Ti
 	mb.metricContainerCPUUsageUsermode.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordContainerHealthStatusDataPoint adds a data point to container.health.status metric.
+func (mb *MetricsBuilder) RecordContainerHealthStatusDataPoint(ts pcommon.Timestamp, val int64, healthStatusAttributeValue AttributeHealthStatus) {
+	mb.metricContainerHealthStatus.recordDataPoint(mb.startTime, ts, val, healthStatusAttributeValue.String())
+}
+
 // RecordContainerMemoryActiveAnonDataPoint adds a data point to container.memory.active_anon metric.
 func (mb *MetricsBuilder) RecordContainerMemoryActiveAnonDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricContainerMemoryActiveAnon.recordDataPoint(mb.startTime, ts, val)
@@ -4172,6 +4323,11 @@ func (mb *MetricsBuilder) RecordContainerNetworkIoUsageTxPacketsDataPoint(ts pco
 	mb.metricContainerNetworkIoUsageTxPackets.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue)
 }
 
+// RecordContainerRestartsDataPoint adds a data point to container.restarts metric.
+func (mb *MetricsBuilder) RecordContainerRestartsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricContainerRestarts.recordDataPoint(mb.startTime, ts, val)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
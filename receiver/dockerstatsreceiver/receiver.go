@@ -16,6 +16,8 @@ package dockerstatsreceiver // import "github.com/open-telemetry/opentelemetry-c
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,6 +28,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
 )
 
@@ -39,6 +42,9 @@ type receiver struct {
 	settings component.ReceiverCreateSettings
 	client   *docker.Client
 	mb       *metadata.MetricsBuilder
+
+	includeFS filterset.FilterSet
+	excludeFS filterset.FilterSet
 }
 
 func newReceiver(set component.ReceiverCreateSettings, config *Config) *receiver {
@@ -53,6 +59,10 @@ func newReceiver(set component.ReceiverCreateSettings, config *Config) *receiver
 }
 
 func (r *receiver) start(ctx context.Context, _ component.Host) error {
+	if err := r.createContainerFilters(); err != nil {
+		return err
+	}
+
 	dConfig, err := docker.NewConfig(r.config.Endpoint, r.config.Timeout, r.config.ExcludedImages, r.config.DockerAPIVersion)
 	if err != nil {
 		return err
@@ -77,7 +87,7 @@ type result struct {
 }
 
 func (r *receiver) scrape(ctx context.Context) (pmetric.Metrics, error) {
-	containers := r.client.Containers()
+	containers := r.filterContainers(r.client.Containers())
 	results := make(chan result, len(containers))
 
 	wg := &sync.WaitGroup{}
@@ -113,3 +123,38 @@ func (r *receiver) scrape(ctx context.Context) (pmetric.Metrics, error) {
 
 	return md, errs
 }
+
+func (r *receiver) createContainerFilters() error {
+	var err error
+	if len(r.config.Include.Names) > 0 {
+		r.includeFS, err = filterset.CreateFilterSet(r.config.Include.Names, &r.config.Include.Config)
+		if err != nil {
+			return fmt.Errorf("error creating container include filters: %w", err)
+		}
+	}
+	if len(r.config.Exclude.Names) > 0 {
+		r.excludeFS, err = filterset.CreateFilterSet(r.config.Exclude.Names, &r.config.Exclude.Config)
+		if err != nil {
+			return fmt.Errorf("error creating container exclude filters: %w", err)
+		}
+	}
+	return nil
+}
+
+// filterContainers returns the containers whose name matches the include filters, if any,
+// and doesn't match the exclude filters.
+func (r *receiver) filterContainers(containers []docker.Container) []docker.Container {
+	if r.includeFS == nil && r.excludeFS == nil {
+		return containers
+	}
+
+	filtered := make([]docker.Container, 0, len(containers))
+	for _, c := range containers {
+		name := strings.TrimPrefix(c.Name, "/")
+		if (r.includeFS == nil || r.includeFS.Matches(name)) &&
+			(r.excludeFS == nil || !r.excludeFS.Matches(name)) {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
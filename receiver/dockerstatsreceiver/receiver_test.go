@@ -28,13 +28,16 @@ import (
 	"testing"
 	"time"
 
+	dtypes "github.com/docker/docker/api/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver/internal/metadata"
 )
 
@@ -60,6 +63,7 @@ var (
 		ContainerCPUUsageSystem:                    metricEnabled,
 		ContainerCPUUsageTotal:                     metricEnabled,
 		ContainerCPUUsageUsermode:                  metricEnabled,
+		ContainerHealthStatus:                      metricEnabled,
 		ContainerMemoryActiveAnon:                  metricEnabled,
 		ContainerMemoryActiveFile:                  metricEnabled,
 		ContainerMemoryCache:                       metricEnabled,
@@ -106,6 +110,7 @@ var (
 		ContainerNetworkIoUsageTxDropped:           metricEnabled,
 		ContainerNetworkIoUsageTxErrors:            metricEnabled,
 		ContainerNetworkIoUsageTxPackets:           metricEnabled,
+		ContainerRestarts:                          metricEnabled,
 	}
 )
 
@@ -209,6 +214,83 @@ func TestScrapeV2(t *testing.T) {
 	}
 }
 
+func TestFilterContainers(t *testing.T) {
+	containers := []docker.Container{
+		{ContainerJSON: &dtypes.ContainerJSON{ContainerJSONBase: &dtypes.ContainerJSONBase{Name: "/app-web"}}},
+		{ContainerJSON: &dtypes.ContainerJSON{ContainerJSONBase: &dtypes.ContainerJSONBase{Name: "/app-sidecar"}}},
+		{ContainerJSON: &dtypes.ContainerJSON{ContainerJSONBase: &dtypes.ContainerJSONBase{Name: "/db"}}},
+	}
+	names := func(cs []docker.Container) []string {
+		var result []string
+		for _, c := range cs {
+			result = append(result, c.Name)
+		}
+		return result
+	}
+
+	testCases := []struct {
+		desc     string
+		include  MatchConfig
+		exclude  MatchConfig
+		expected []string
+	}{
+		{
+			desc:     "no filters",
+			expected: []string{"/app-web", "/app-sidecar", "/db"},
+		},
+		{
+			desc: "include",
+			include: MatchConfig{
+				Config: filterset.Config{MatchType: filterset.Regexp},
+				Names:  []string{"^app-.*"},
+			},
+			expected: []string{"/app-web", "/app-sidecar"},
+		},
+		{
+			desc: "exclude",
+			exclude: MatchConfig{
+				Config: filterset.Config{MatchType: filterset.Strict},
+				Names:  []string{"db"},
+			},
+			expected: []string{"/app-web", "/app-sidecar"},
+		},
+		{
+			desc: "include and exclude",
+			include: MatchConfig{
+				Config: filterset.Config{MatchType: filterset.Regexp},
+				Names:  []string{"^app-.*"},
+			},
+			exclude: MatchConfig{
+				Config: filterset.Config{MatchType: filterset.Strict},
+				Names:  []string{"app-sidecar"},
+			},
+			expected: []string{"/app-web"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Include = tc.include
+			cfg.Exclude = tc.exclude
+
+			recv := newReceiver(componenttest.NewNopReceiverCreateSettings(), cfg)
+			require.NoError(t, recv.createContainerFilters())
+			assert.Equal(t, tc.expected, names(recv.filterContainers(containers)))
+		})
+	}
+}
+
+func TestInvalidContainerFilter(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = MatchConfig{Names: []string{"app"}}
+
+	recv := newReceiver(componenttest.NewNopReceiverCreateSettings(), cfg)
+	err := recv.start(context.Background(), componenttest.NewNopHost())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error creating container include filters")
+}
+
 func dockerMockServer(urlToFile *map[string]string) (*httptest.Server, error) {
 	urlToFileContents := make(map[string][]byte, len(*urlToFile))
 	for urlPath, filePath := range *urlToFile {
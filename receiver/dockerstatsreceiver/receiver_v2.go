@@ -41,7 +41,7 @@ type resultV2 struct {
 }
 
 func (r *receiver) scrapeV2(ctx context.Context) (pmetric.Metrics, error) {
-	containers := r.client.Containers()
+	containers := r.filterContainers(r.client.Containers())
 	results := make(chan resultV2, len(containers))
 
 	wg := &sync.WaitGroup{}
@@ -85,6 +85,7 @@ func (r *receiver) recordContainerStats(now pcommon.Timestamp, containerStats *d
 	r.recordMemoryMetrics(now, &containerStats.MemoryStats)
 	r.recordBlkioMetrics(now, &containerStats.BlkioStats)
 	r.recordNetworkMetrics(now, &containerStats.Networks)
+	r.recordStateMetrics(now, container)
 
 	// Always-present resource attrs + the user-configured resource attrs
 	resourceCapacity := defaultResourcesLen + len(r.config.EnvVarsToMetricLabels) + len(r.config.ContainerLabelsToMetricLabels)
@@ -207,6 +208,28 @@ func (r *receiver) recordNetworkMetrics(now pcommon.Timestamp, networks *map[str
 	}
 }
 
+func (r *receiver) recordStateMetrics(now pcommon.Timestamp, container *docker.Container) {
+	if container.ContainerJSONBase == nil {
+		return
+	}
+	r.mb.RecordContainerRestartsDataPoint(now, int64(container.RestartCount))
+
+	if container.State == nil || container.State.Health == nil {
+		return
+	}
+	current, ok := metadata.MapAttributeHealthStatus[container.State.Health.Status]
+	if !ok {
+		return
+	}
+	for _, status := range metadata.MapAttributeHealthStatus {
+		var val int64
+		if status == current {
+			val = 1
+		}
+		r.mb.RecordContainerHealthStatusDataPoint(now, val, status)
+	}
+}
+
 func (r *receiver) recordCPUMetrics(now pcommon.Timestamp, cpuStats *dtypes.CPUStats, prevStats *dtypes.CPUStats) {
 	r.mb.RecordContainerCPUUsageSystemDataPoint(now, int64(cpuStats.SystemUsage))
 	r.mb.RecordContainerCPUUsageTotalDataPoint(now, int64(cpuStats.CPUUsage.TotalUsage))
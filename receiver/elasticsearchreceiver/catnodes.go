@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver"
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver/internal/model"
+)
+
+// catNodeRoles maps the roles usable in node filters to their abbreviations in the node.role column of _cat/nodes.
+// A data node may have any of the data roles.
+var catNodeRoles = map[string]string{
+	"master":                "m",
+	"data":                  "dshwcf",
+	"ingest":                "i",
+	"voting_only":           "v",
+	"ml":                    "l",
+	"transform":             "t",
+	"remote_cluster_client": "r",
+}
+
+// resolveCatNodes returns the IDs of the nodes selected by the node filters, resolved in order like the
+// nodes API does (https://www.elastic.co/guide/en/elasticsearch/reference/7.9/cluster.html#cluster-nodes).
+// The local node is the one named localNode. The nodes are matched by ID, name and IP address, since
+// _cat/nodes doesn't return their host names and custom attributes: the filters which can't be resolved
+// are returned in the error, and select no node.
+func resolveCatNodes(filters []string, nodes []model.CatNode, localNode string) (map[string]bool, error) {
+	selected := make(map[string]bool, len(nodes))
+	var unresolved []string
+	for _, filter := range filters {
+		switch {
+		case filter == "_all" || filter == "*":
+			for _, node := range nodes {
+				selected[node.ID] = true
+			}
+		case filter == "_local":
+			// the local node is only known if the cluster metadata is accessible
+			if localNode == "" {
+				unresolved = append(unresolved, filter)
+				continue
+			}
+			for _, node := range nodes {
+				if node.Name == localNode {
+					selected[node.ID] = true
+				}
+			}
+		case filter == "_master":
+			for _, node := range nodes {
+				if node.Master == "*" {
+					selected[node.ID] = true
+				}
+			}
+		case strings.Contains(filter, ":"):
+			role, value, _ := strings.Cut(filter, ":")
+			include := value == "true"
+			if value != "true" && value != "false" {
+				unresolved = append(unresolved, filter)
+				continue
+			}
+			var matches func(model.CatNode) bool
+			if role == "coordinating_only" {
+				matches = func(node model.CatNode) bool { return node.Roles == "-" }
+			} else if abbreviations, ok := catNodeRoles[role]; ok {
+				matches = func(node model.CatNode) bool { return strings.ContainsAny(node.Roles, abbreviations) }
+			} else {
+				// a custom node attribute
+				unresolved = append(unresolved, filter)
+				continue
+			}
+			for _, node := range nodes {
+				if !matches(node) {
+					continue
+				}
+				if include {
+					selected[node.ID] = true
+				} else {
+					delete(selected, node.ID)
+				}
+			}
+		default:
+			pattern := regexp.MustCompile("^" + strings.ReplaceAll(regexp.QuoteMeta(filter), `\*`, ".*") + "$")
+			for _, node := range nodes {
+				if pattern.MatchString(node.ID) || pattern.MatchString(node.Name) || pattern.MatchString(node.IP) {
+					selected[node.ID] = true
+				}
+			}
+		}
+	}
+	if len(unresolved) > 0 {
+		return selected, fmt.Errorf("node filters %q can't be resolved from _cat/nodes", unresolved)
+	}
+	return selected, nil
+}
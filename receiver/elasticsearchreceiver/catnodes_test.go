@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver/internal/model"
+)
+
+func TestResolveCatNodes(t *testing.T) {
+	nodes := []model.CatNode{
+		{ID: "id-master", IP: "10.0.0.1", Roles: "m", Master: "*", Name: "es-master"},
+		{ID: "id-data-1", IP: "10.0.0.2", Roles: "dim", Master: "-", Name: "es-data-1"},
+		{ID: "id-data-2", IP: "10.0.0.3", Roles: "hs", Master: "-", Name: "es-data-2"},
+		{ID: "id-coord", IP: "10.0.0.4", Roles: "-", Master: "-", Name: "es-coord"},
+	}
+
+	tests := []struct {
+		name      string
+		filters   []string
+		localNode string
+		expected  []string
+		err       string
+	}{
+		{
+			name:     "all",
+			filters:  []string{"_all"},
+			expected: []string{"id-master", "id-data-1", "id-data-2", "id-coord"},
+		},
+		{
+			name:      "local",
+			filters:   []string{"_local"},
+			localNode: "es-data-1",
+			expected:  []string{"id-data-1"},
+		},
+		{
+			name:    "local unknown",
+			filters: []string{"_local", "es-coord"},
+			// the other filters still select their nodes
+			expected: []string{"id-coord"},
+			err:      `node filters ["_local"] can't be resolved from _cat/nodes`,
+		},
+		{
+			name:     "elected master",
+			filters:  []string{"_master"},
+			expected: []string{"id-master"},
+		},
+		{
+			name:     "id, wildcard name and address",
+			filters:  []string{"id-master", "es-data-*", "10.0.0.4"},
+			expected: []string{"id-master", "id-data-1", "id-data-2", "id-coord"},
+		},
+		{
+			name:     "roles",
+			filters:  []string{"data:true"},
+			expected: []string{"id-data-1", "id-data-2"},
+		},
+		{
+			name:     "roles removed in order",
+			filters:  []string{"_all", "master:false", "coordinating_only:false"},
+			expected: []string{"id-data-2"},
+		},
+		{
+			name:     "coordinating only",
+			filters:  []string{"coordinating_only:true"},
+			expected: []string{"id-coord"},
+		},
+		{
+			name:     "custom attribute",
+			filters:  []string{"rack:r1", "ingest:true"},
+			expected: []string{"id-data-1"},
+			err:      `node filters ["rack:r1"] can't be resolved from _cat/nodes`,
+		},
+		{
+			name:    "unknown name",
+			filters: []string{"es-other"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := resolveCatNodes(tt.filters, nodes, tt.localNode)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+			var ids []string
+			for _, node := range nodes {
+				if selected[node.ID] {
+					ids = append(ids, node.ID)
+				}
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
@@ -42,6 +42,8 @@ type elasticsearchClient interface {
 	ClusterHealth(ctx context.Context) (*model.ClusterHealth, error)
 	IndexStats(ctx context.Context, indices []string) (*model.IndexStats, error)
 	ClusterMetadata(ctx context.Context) (*model.ClusterMetadataResponse, error)
+	CatNodes(ctx context.Context) ([]model.CatNode, error)
+	CatIndices(ctx context.Context, indices []string) ([]model.CatIndex, error)
 }
 
 // defaultElasticsearchClient is the main implementation of elasticsearchClient.
@@ -91,6 +93,20 @@ const nodeStatsIndexMetrics = "store,docs,indexing,get,search,merge,refresh,flus
 
 const indexStatsMetrics = "search"
 
+// catNodesColumns is a comma separated list of the columns requested from the _cat/nodes endpoint.
+// The available columns are documented here:
+// https://www.elastic.co/guide/en/elasticsearch/reference/7.9/cat-nodes.html#cat-nodes-api-query-params
+const catNodesColumns = "id,ip,node.role,master,name,heap.current,heap.max,file_desc.current,cpu,load_1m,load_5m,load_15m,disk.total,disk.avail," +
+	"fielddata.memory_size,fielddata.evictions,query_cache.memory_size,query_cache.evictions,query_cache.hit_count,query_cache.miss_count," +
+	"indexing.index_total,indexing.index_time,indexing.delete_total,indexing.delete_time,get.total,get.time," +
+	"search.query_total,search.query_time,search.fetch_total,search.fetch_time," +
+	"merges.total,merges.total_time,refresh.total,refresh.time,flush.total,flush.total_time"
+
+// catIndicesColumns is a comma separated list of the columns requested from the _cat/indices endpoint.
+// The available columns are documented here:
+// https://www.elastic.co/guide/en/elasticsearch/reference/7.9/cat-indices.html#cat-indices-api-query-params
+const catIndicesColumns = "index,search.query_total,search.query_time,search.fetch_total,search.fetch_time"
+
 func (c defaultElasticsearchClient) NodeStats(ctx context.Context, nodes []string) (*model.NodeStats, error) {
 	var nodeSpec string
 	if len(nodes) > 0 {
@@ -154,6 +170,35 @@ func (c defaultElasticsearchClient) ClusterMetadata(ctx context.Context) (*model
 	return &versionResponse, err
 }
 
+func (c defaultElasticsearchClient) CatNodes(ctx context.Context) ([]model.CatNode, error) {
+	body, err := c.doRequest(ctx, fmt.Sprintf("_cat/nodes?format=json&bytes=b&time=ms&full_id=true&h=%s", catNodesColumns))
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []model.CatNode
+	err = json.Unmarshal(body, &nodes)
+	return nodes, err
+}
+
+func (c defaultElasticsearchClient) CatIndices(ctx context.Context, indices []string) ([]model.CatIndex, error) {
+	var indexSpec string
+	if len(indices) > 0 {
+		indexSpec = strings.Join(indices, ",")
+	} else {
+		indexSpec = "_all"
+	}
+
+	body, err := c.doRequest(ctx, fmt.Sprintf("_cat/indices/%s?format=json&time=ms&h=%s", indexSpec, catIndicesColumns))
+	if err != nil {
+		return nil, err
+	}
+
+	var catIndices []model.CatIndex
+	err = json.Unmarshal(body, &catIndices)
+	return catIndices, err
+}
+
 func (c defaultElasticsearchClient) doRequest(ctx context.Context, path string) ([]byte, error) {
 	endpoint, err := c.endpoint.Parse(path)
 	if err != nil {
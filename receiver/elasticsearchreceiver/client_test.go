@@ -478,6 +478,61 @@ func TestIndexStatsBadAuthentication(t *testing.T) {
 
 // mockServer gives a mock elasticsearch server for testing; if username or password is included, they will be required for the client.
 // otherwise, authorization is ignored.
+func TestCatNodes(t *testing.T) {
+	elasticsearchMock := mockServer(t, "", "")
+	defer elasticsearchMock.Close()
+
+	client, err := newElasticsearchClient(componenttest.NewNopTelemetrySettings(), Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: elasticsearchMock.URL,
+		},
+	}, componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	nodes, err := client.CatNodes(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, catNodes(t), nodes)
+	require.Equal(t, "917e13a1b3a3", nodes[0].Name)
+	require.EqualValues(t, 305152000, nodes[0].HeapCurrentInBy)
+	require.Equal(t, 0.58, nodes[0].Load1m)
+}
+
+func TestCatIndices(t *testing.T) {
+	elasticsearchMock := mockServer(t, "", "")
+	defer elasticsearchMock.Close()
+
+	client, err := newElasticsearchClient(componenttest.NewNopTelemetrySettings(), Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: elasticsearchMock.URL,
+		},
+	}, componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	indices, err := client.CatIndices(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, catIndices(t), indices)
+	require.Len(t, indices, 2)
+	require.EqualValues(t, 8, indices[1].SearchQueryTotal)
+	require.EqualValues(t, 0, indices[1].SearchFetchTotal)
+}
+
+func TestCatNodesNoAuthorization(t *testing.T) {
+	elasticsearchMock := mockServer(t, "user", "pass")
+	defer elasticsearchMock.Close()
+
+	client, err := newElasticsearchClient(componenttest.NewNopTelemetrySettings(), Config{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: elasticsearchMock.URL,
+		},
+		Username: "bad_user",
+		Password: "bad_pass",
+	}, componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	_, err = client.CatNodes(context.Background())
+	require.ErrorIs(t, err, errUnauthorized)
+}
+
 func mockServer(t *testing.T, username, password string) *httptest.Server {
 	nodes, err := os.ReadFile("./testdata/sample_payloads/nodes_linux.json")
 	require.NoError(t, err)
@@ -487,6 +542,10 @@ func mockServer(t *testing.T, username, password string) *httptest.Server {
 	require.NoError(t, err)
 	metadata, err := os.ReadFile("./testdata/sample_payloads/metadata.json")
 	require.NoError(t, err)
+	catNodes, err := os.ReadFile("./testdata/sample_payloads/cat_nodes.json")
+	require.NoError(t, err)
+	catIndices, err := os.ReadFile("./testdata/sample_payloads/cat_indices.json")
+	require.NoError(t, err)
 
 	elasticsearchMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if username != "" || password != "" {
@@ -514,6 +573,20 @@ func mockServer(t *testing.T, username, password string) *httptest.Server {
 			return
 		}
 
+		if strings.HasPrefix(req.URL.Path, "/_cat/nodes") {
+			rw.WriteHeader(200)
+			_, err = rw.Write(catNodes)
+			require.NoError(t, err)
+			return
+		}
+
+		if strings.HasPrefix(req.URL.Path, "/_cat/indices/_all") {
+			rw.WriteHeader(200)
+			_, err = rw.Write(catIndices)
+			require.NoError(t, err)
+			return
+		}
+
 		if strings.HasPrefix(req.URL.Path, "/_cluster/health") {
 			rw.WriteHeader(200)
 			_, err = rw.Write(health)
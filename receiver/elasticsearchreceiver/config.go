@@ -35,6 +35,7 @@ var (
 	errUsernameNotSpecified = errors.New("password was specified, but not username")
 	errPasswordNotSpecified = errors.New("username was specified, but not password")
 	errEmptyEndpoint        = errors.New("endpoint must be specified")
+	errLatencyWindow        = errors.New("search_slo.latency_window must be positive")
 )
 
 // Config is the configuration for the elasticsearch receiver
@@ -58,6 +59,33 @@ type Config struct {
 	Username string `mapstructure:"username"`
 	// Password is the password used when making REST calls to elasticsearch. Must be specified if Username is. Not required.
 	Password string `mapstructure:"password"`
+	// CatAPIFallback indicates whether a reduced set of node and index metrics should be scraped from the
+	// _cat/nodes and _cat/indices endpoints when the user is not authorized to access the stats endpoints.
+	CatAPIFallback bool `mapstructure:"cat_api_fallback"`
+	// SearchSLO configures the search latency and error rate metrics derived from consecutive scrapes.
+	// These metrics are approximations, and are disabled by default.
+	SearchSLO SearchSLOConfig `mapstructure:"search_slo"`
+	// Clusters defines the clusters to scrape, each with its own endpoint and credentials.
+	// If Clusters is not empty, the top-level endpoint, username and password are ignored,
+	// and every other setting is shared by all clusters.
+	Clusters []ClusterConfig `mapstructure:"clusters"`
+}
+
+// ClusterConfig configures one of the clusters scraped by the receiver.
+type ClusterConfig struct {
+	confighttp.HTTPClientSettings `mapstructure:",squash"`
+	// Username is the username used when making REST calls to the cluster. Must be specified if Password is. Not required.
+	Username string `mapstructure:"username"`
+	// Password is the password used when making REST calls to the cluster. Must be specified if Username is. Not required.
+	Password string `mapstructure:"password"`
+	// ResourceAttributes are added to the resource of every metric scraped from the cluster.
+	ResourceAttributes map[string]string `mapstructure:"resource_attributes"`
+}
+
+// SearchSLOConfig configures the derived search metrics.
+type SearchSLOConfig struct {
+	// LatencyWindow is the number of scrape intervals used to estimate the search latency percentile.
+	LatencyWindow int `mapstructure:"latency_window"`
 }
 
 // Validate validates the given config, returning an error specifying any issues with the config.
@@ -67,25 +95,72 @@ func (cfg *Config) Validate() error {
 		combinedErr = multierr.Append(combinedErr, err)
 	}
 
-	if cfg.Endpoint == "" {
-		return multierr.Append(combinedErr, errEmptyEndpoint)
+	if cfg.SearchSLO.LatencyWindow <= 0 {
+		combinedErr = multierr.Append(combinedErr, errLatencyWindow)
+	}
+
+	if len(cfg.Clusters) == 0 {
+		return multierr.Append(combinedErr, validateEndpoint(cfg.Endpoint))
+	}
+
+	for i, cluster := range cfg.Clusters {
+		if err := invalidCredentials(cluster.Username, cluster.Password); err != nil {
+			combinedErr = multierr.Append(combinedErr, fmt.Errorf("clusters[%d]: %w", i, err))
+		}
+		if err := validateEndpoint(cluster.Endpoint); err != nil {
+			combinedErr = multierr.Append(combinedErr, fmt.Errorf("clusters[%d]: %w", i, err))
+		}
+	}
+
+	return combinedErr
+}
+
+// validateEndpoint returns an error if the endpoint is not an http or https URL.
+func validateEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return errEmptyEndpoint
 	}
 
-	u, err := url.Parse(cfg.Endpoint)
+	u, err := url.Parse(endpoint)
 	if err != nil {
-		return multierr.Append(
-			combinedErr,
-			fmt.Errorf("invalid endpoint '%s': %w", cfg.Endpoint, err),
-		)
+		return fmt.Errorf("invalid endpoint '%s': %w", endpoint, err)
 	}
 
 	switch u.Scheme {
 	case "http", "https": // ok
 	default:
-		return multierr.Append(combinedErr, errEndpointBadScheme)
+		return errEndpointBadScheme
 	}
 
-	return combinedErr
+	return nil
+}
+
+// scrapedCluster is the config of one of the clusters scraped by the receiver.
+type scrapedCluster struct {
+	cfg                *Config
+	resourceAttributes map[string]string
+}
+
+// scrapedClusters returns the clusters scraped by the receiver: the configured clusters if any,
+// otherwise the one of the top-level endpoint. A cluster without timeout uses the top-level one.
+func (cfg *Config) scrapedClusters() []scrapedCluster {
+	if len(cfg.Clusters) == 0 {
+		return []scrapedCluster{{cfg: cfg}}
+	}
+
+	clusters := make([]scrapedCluster, 0, len(cfg.Clusters))
+	for _, cluster := range cfg.Clusters {
+		clusterCfg := *cfg
+		clusterCfg.HTTPClientSettings = cluster.HTTPClientSettings
+		if clusterCfg.Timeout == 0 {
+			clusterCfg.Timeout = cfg.Timeout
+		}
+		clusterCfg.Username = cluster.Username
+		clusterCfg.Password = cluster.Password
+		clusterCfg.Clusters = nil
+		clusters = append(clusters, scrapedCluster{cfg: &clusterCfg, resourceAttributes: cluster.ResourceAttributes})
+	}
+	return clusters
 }
 
 // invalidCredentials returns true if only one username or password is not empty.
@@ -147,6 +147,67 @@ func TestValidateEndpoint(t *testing.T) {
 	}
 }
 
+func TestValidateSearchSLO(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	require.NoError(t, cfg.Validate())
+
+	cfg.SearchSLO.LatencyWindow = 0
+	require.ErrorIs(t, cfg.Validate(), errLatencyWindow)
+}
+
+func TestValidateClusters(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Clusters = []ClusterConfig{
+		{HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "https://es-eu.example.com:9200"}},
+		{HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "https://es-us.example.com:9200"}, Username: "otel", Password: "password"},
+	}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Clusters[0].Username = "otel"
+	cfg.Clusters[1].Endpoint = "localhost"
+	err := cfg.Validate()
+	require.ErrorIs(t, err, errPasswordNotSpecified)
+	require.ErrorIs(t, err, errEndpointBadScheme)
+	require.Contains(t, err.Error(), "clusters[0]: "+errPasswordNotSpecified.Error())
+	require.Contains(t, err.Error(), "clusters[1]: "+errEndpointBadScheme.Error())
+}
+
+func TestScrapedClusters(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+
+	clusters := cfg.scrapedClusters()
+	require.Len(t, clusters, 1)
+	require.Same(t, cfg, clusters[0].cfg)
+	require.Empty(t, clusters[0].resourceAttributes)
+
+	cfg.Clusters = []ClusterConfig{
+		{
+			HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "https://es-eu.example.com:9200"},
+			Username:           "otel",
+			Password:           "password",
+			ResourceAttributes: map[string]string{"cloud.region": "eu-west-1"},
+		},
+		{
+			HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "https://es-us.example.com:9200", Timeout: 30 * time.Second},
+		},
+	}
+
+	clusters = cfg.scrapedClusters()
+	require.Len(t, clusters, 2)
+
+	assert.Equal(t, "https://es-eu.example.com:9200", clusters[0].cfg.Endpoint)
+	assert.Equal(t, defaultHTTPClientTimeout, clusters[0].cfg.Timeout)
+	assert.Equal(t, "otel", clusters[0].cfg.Username)
+	assert.Equal(t, "password", clusters[0].cfg.Password)
+	assert.Equal(t, map[string]string{"cloud.region": "eu-west-1"}, clusters[0].resourceAttributes)
+	assert.Empty(t, clusters[0].cfg.Clusters)
+
+	assert.Equal(t, "https://es-us.example.com:9200", clusters[1].cfg.Endpoint)
+	assert.Equal(t, 30*time.Second, clusters[1].cfg.Timeout)
+	assert.Empty(t, clusters[1].cfg.Username)
+	assert.Equal(t, cfg.Nodes, clusters[1].cfg.Nodes)
+}
+
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
@@ -155,6 +216,7 @@ func TestLoadConfig(t *testing.T) {
 
 	defaultMetrics := metadata.DefaultMetricsSettings()
 	defaultMetrics.ElasticsearchNodeFsDiskAvailable.Enabled = false
+	defaultMetrics.ElasticsearchNodeOperationsSearchLatencyP99Estimate.Enabled = true
 	tests := []struct {
 		id       config.ComponentID
 		expected config.Receiver
@@ -180,8 +242,32 @@ func TestLoadConfig(t *testing.T) {
 					Timeout:  10000000000,
 					Endpoint: "http://example.com:9200",
 				},
+				SearchSLO: SearchSLOConfig{
+					LatencyWindow: 30,
+				},
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "clusters"),
+			expected: func() config.Receiver {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Clusters = []ClusterConfig{
+					{
+						HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "https://es-eu.example.com:9200"},
+						Username:           "otel",
+						Password:           "password",
+						ResourceAttributes: map[string]string{
+							"deployment.environment": "production",
+							"cloud.region":           "eu-west-1",
+						},
+					},
+					{
+						HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "https://es-us.example.com:9200", Timeout: 30 * time.Second},
+					},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
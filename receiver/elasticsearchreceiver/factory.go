@@ -24,6 +24,7 @@ import (
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver/internal/metadata"
 )
@@ -33,6 +34,7 @@ const (
 	stability                 = component.StabilityLevelBeta
 	defaultCollectionInterval = 10 * time.Second
 	defaultHTTPClientTimeout  = 10 * time.Second
+	defaultLatencyWindow      = 60
 )
 
 // NewFactory creates a factory for elasticsearch receiver.
@@ -57,6 +59,9 @@ func createDefaultConfig() config.Receiver {
 		Metrics: metadata.DefaultMetricsSettings(),
 		Nodes:   []string{"_all"},
 		Indices: []string{"_all"},
+		SearchSLO: SearchSLOConfig{
+			LatencyWindow: defaultLatencyWindow,
+		},
 	}
 }
 
@@ -73,17 +78,30 @@ func createMetricsReceiver(
 	if !ok {
 		return nil, errConfigNotES
 	}
-	es := newElasticSearchScraper(params, c)
 
-	scraper, err := scraperhelper.NewScraper(typeStr, es.scrape, scraperhelper.WithStart(es.start))
-	if err != nil {
-		return nil, err
+	// Each cluster is scraped by its own scraper, so that a failing cluster does not
+	// prevent the metrics of the others from being reported.
+	clusters := c.scrapedClusters()
+	options := make([]scraperhelper.ScraperControllerOption, 0, len(clusters))
+	for _, cluster := range clusters {
+		clusterParams := params
+		if len(c.Clusters) > 0 {
+			clusterParams.Logger = params.Logger.With(zap.String("endpoint", cluster.cfg.Endpoint))
+		}
+		es := newElasticSearchScraper(clusterParams, cluster.cfg)
+		es.resourceAttributes = cluster.resourceAttributes
+
+		scraper, err := scraperhelper.NewScraper(typeStr, es.scrape, scraperhelper.WithStart(es.start))
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, scraperhelper.AddScraper(scraper))
 	}
 
 	return scraperhelper.NewScraperControllerReceiver(
 		&c.ScraperControllerSettings,
 		params,
 		consumer,
-		scraperhelper.AddScraper(scraper),
+		options...,
 	)
 }
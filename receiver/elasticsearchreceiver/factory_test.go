@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 )
 
@@ -44,6 +45,26 @@ func TestCreateMetricsReceiver(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			desc: "Multiple clusters",
+			run: func(t *testing.T) {
+				t.Parallel()
+
+				cfg := createDefaultConfig().(*Config)
+				cfg.Clusters = []ClusterConfig{
+					{HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "https://es-eu.example.com:9200"}},
+					{HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: "https://es-us.example.com:9200"}},
+				}
+				_, err := createMetricsReceiver(
+					context.Background(),
+					componenttest.NewNopReceiverCreateSettings(),
+					cfg,
+					consumertest.NewNop(),
+				)
+
+				require.NoError(t, err)
+			},
+		},
 		{
 			desc: "Nil config",
 			run: func(t *testing.T) {
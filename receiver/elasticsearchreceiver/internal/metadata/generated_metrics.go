@@ -54,6 +54,9 @@ type MetricsSettings struct {
 	ElasticsearchNodeIngestOperationsFailed                   MetricSettings `mapstructure:"elasticsearch.node.ingest.operations.failed"`
 	ElasticsearchNodeOpenFiles                                MetricSettings `mapstructure:"elasticsearch.node.open_files"`
 	ElasticsearchNodeOperationsCompleted                      MetricSettings `mapstructure:"elasticsearch.node.operations.completed"`
+	ElasticsearchNodeOperationsSearchErrorRate                MetricSettings `mapstructure:"elasticsearch.node.operations.search.error_rate"`
+	ElasticsearchNodeOperationsSearchLatencyAverage           MetricSettings `mapstructure:"elasticsearch.node.operations.search.latency.average"`
+	ElasticsearchNodeOperationsSearchLatencyP99Estimate       MetricSettings `mapstructure:"elasticsearch.node.operations.search.latency.p99_estimate"`
 	ElasticsearchNodeOperationsTime                           MetricSettings `mapstructure:"elasticsearch.node.operations.time"`
 	ElasticsearchNodePipelineIngestDocumentsCurrent           MetricSettings `mapstructure:"elasticsearch.node.pipeline.ingest.documents.current"`
 	ElasticsearchNodePipelineIngestDocumentsPreprocessed      MetricSettings `mapstructure:"elasticsearch.node.pipeline.ingest.documents.preprocessed"`
@@ -201,6 +204,15 @@ func DefaultMetricsSettings() MetricsSettings {
 		ElasticsearchNodeOperationsCompleted: MetricSettings{
 			Enabled: true,
 		},
+		ElasticsearchNodeOperationsSearchErrorRate: MetricSettings{
+			Enabled: false,
+		},
+		ElasticsearchNodeOperationsSearchLatencyAverage: MetricSettings{
+			Enabled: false,
+		},
+		ElasticsearchNodeOperationsSearchLatencyP99Estimate: MetricSettings{
+			Enabled: false,
+		},
 		ElasticsearchNodeOperationsTime: MetricSettings{
 			Enabled: true,
 		},
@@ -2732,6 +2744,153 @@ func newMetricElasticsearchNodeOperationsCompleted(settings MetricSettings) metr
 	return m
 }
 
+type metricElasticsearchNodeOperationsSearchErrorRate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills elasticsearch.node.operations.search.error_rate metric with initial data.
+func (m *metricElasticsearchNodeOperationsSearchErrorRate) init() {
+	m.data.SetName("elasticsearch.node.operations.search.error_rate")
+	m.data.SetDescription("Fraction of the search tasks rejected by the search thread pool of a node during the last scrape interval. Only emitted from the second scrape.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricElasticsearchNodeOperationsSearchErrorRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricElasticsearchNodeOperationsSearchErrorRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricElasticsearchNodeOperationsSearchErrorRate) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricElasticsearchNodeOperationsSearchErrorRate(settings MetricSettings) metricElasticsearchNodeOperationsSearchErrorRate {
+	m := metricElasticsearchNodeOperationsSearchErrorRate{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricElasticsearchNodeOperationsSearchLatencyAverage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills elasticsearch.node.operations.search.latency.average metric with initial data.
+func (m *metricElasticsearchNodeOperationsSearchLatencyAverage) init() {
+	m.data.SetName("elasticsearch.node.operations.search.latency.average")
+	m.data.SetDescription("Average latency of the search queries of a node during the last scrape interval. Only emitted from the second scrape, for intervals with queries.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricElasticsearchNodeOperationsSearchLatencyAverage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricElasticsearchNodeOperationsSearchLatencyAverage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricElasticsearchNodeOperationsSearchLatencyAverage) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricElasticsearchNodeOperationsSearchLatencyAverage(settings MetricSettings) metricElasticsearchNodeOperationsSearchLatencyAverage {
+	m := metricElasticsearchNodeOperationsSearchLatencyAverage{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricElasticsearchNodeOperationsSearchLatencyP99Estimate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills elasticsearch.node.operations.search.latency.p99_estimate metric with initial data.
+func (m *metricElasticsearchNodeOperationsSearchLatencyP99Estimate) init() {
+	m.data.SetName("elasticsearch.node.operations.search.latency.p99_estimate")
+	m.data.SetDescription("Estimate of the 99th percentile of the search query latency of a node, computed from the average latencies of the last scrape intervals. This underestimates the actual percentile, as averages smooth out outliers.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricElasticsearchNodeOperationsSearchLatencyP99Estimate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricElasticsearchNodeOperationsSearchLatencyP99Estimate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricElasticsearchNodeOperationsSearchLatencyP99Estimate) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricElasticsearchNodeOperationsSearchLatencyP99Estimate(settings MetricSettings) metricElasticsearchNodeOperationsSearchLatencyP99Estimate {
+	m := metricElasticsearchNodeOperationsSearchLatencyP99Estimate{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricElasticsearchNodeOperationsTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -4407,6 +4566,9 @@ type MetricsBuilder struct {
 	metricElasticsearchNodeIngestOperationsFailed                   metricElasticsearchNodeIngestOperationsFailed
 	metricElasticsearchNodeOpenFiles                                metricElasticsearchNodeOpenFiles
 	metricElasticsearchNodeOperationsCompleted                      metricElasticsearchNodeOperationsCompleted
+	metricElasticsearchNodeOperationsSearchErrorRate                metricElasticsearchNodeOperationsSearchErrorRate
+	metricElasticsearchNodeOperationsSearchLatencyAverage           metricElasticsearchNodeOperationsSearchLatencyAverage
+	metricElasticsearchNodeOperationsSearchLatencyP99Estimate       metricElasticsearchNodeOperationsSearchLatencyP99Estimate
 	metricElasticsearchNodeOperationsTime                           metricElasticsearchNodeOperationsTime
 	metricElasticsearchNodePipelineIngestDocumentsCurrent           metricElasticsearchNodePipelineIngestDocumentsCurrent
 	metricElasticsearchNodePipelineIngestDocumentsPreprocessed      metricElasticsearchNodePipelineIngestDocumentsPreprocessed
@@ -4493,6 +4655,9 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricElasticsearchNodeIngestOperationsFailed:                   newMetricElasticsearchNodeIngestOperationsFailed(settings.ElasticsearchNodeIngestOperationsFailed),
 		metricElasticsearchNodeOpenFiles:                                newMetricElasticsearchNodeOpenFiles(settings.ElasticsearchNodeOpenFiles),
 		metricElasticsearchNodeOperationsCompleted:                      newMetricElasticsearchNodeOperationsCompleted(settings.ElasticsearchNodeOperationsCompleted),
+		metricElasticsearchNodeOperationsSearchErrorRate:                newMetricElasticsearchNodeOperationsSearchErrorRate(settings.ElasticsearchNodeOperationsSearchErrorRate),
+		metricElasticsearchNodeOperationsSearchLatencyAverage:           newMetricElasticsearchNodeOperationsSearchLatencyAverage(settings.ElasticsearchNodeOperationsSearchLatencyAverage),
+		metricElasticsearchNodeOperationsSearchLatencyP99Estimate:       newMetricElasticsearchNodeOperationsSearchLatencyP99Estimate(settings.ElasticsearchNodeOperationsSearchLatencyP99Estimate),
 		metricElasticsearchNodeOperationsTime:                           newMetricElasticsearchNodeOperationsTime(settings.ElasticsearchNodeOperationsTime),
 		metricElasticsearchNodePipelineIngestDocumentsCurrent:           newMetricElasticsearchNodePipelineIngestDocumentsCurrent(settings.ElasticsearchNodePipelineIngestDocumentsCurrent),
 		metricElasticsearchNodePipelineIngestDocumentsPreprocessed:      newMetricElasticsearchNodePipelineIngestDocumentsPreprocessed(settings.ElasticsearchNodePipelineIngestDocumentsPreprocessed),
@@ -4635,6 +4800,9 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricElasticsearchNodeIngestOperationsFailed.emit(ils.Metrics())
 	mb.metricElasticsearchNodeOpenFiles.emit(ils.Metrics())
 	mb.metricElasticsearchNodeOperationsCompleted.emit(ils.Metrics())
+	mb.metricElasticsearchNodeOperationsSearchErrorRate.emit(ils.Metrics())
+	mb.metricElasticsearchNodeOperationsSearchLatencyAverage.emit(ils.Metrics())
+	mb.metricElasticsearchNodeOperationsSearchLatencyP99Estimate.emit(ils.Metrics())
 	mb.metricElasticsearchNodeOperationsTime.emit(ils.Metrics())
 	mb.metricElasticsearchNodePipelineIngestDocumentsCurrent.emit(ils.Metrics())
 	mb.metricElasticsearchNodePipelineIngestDocumentsPreprocessed.emit(ils.Metrics())
@@ -4871,6 +5039,21 @@ func (mb *MetricsBuilder) RecordElasticsearchNodeOperationsCompletedDataPoint(ts
 	mb.metricElasticsearchNodeOperationsCompleted.recordDataPoint(mb.startTime, ts, val, operationAttributeValue.String())
 }
 
+// RecordElasticsearchNodeOperationsSearchErrorRateDataPoint adds a data point to elasticsearch.node.operations.search.error_rate metric.
+func (mb *MetricsBuilder) RecordElasticsearchNodeOperationsSearchErrorRateDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricElasticsearchNodeOperationsSearchErrorRate.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordElasticsearchNodeOperationsSearchLatencyAverageDataPoint adds a data point to elasticsearch.node.operations.search.latency.average metric.
+func (mb *MetricsBuilder) RecordElasticsearchNodeOperationsSearchLatencyAverageDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricElasticsearchNodeOperationsSearchLatencyAverage.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordElasticsearchNodeOperationsSearchLatencyP99EstimateDataPoint adds a data point to elasticsearch.node.operations.search.latency.p99_estimate metric.
+func (mb *MetricsBuilder) RecordElasticsearchNodeOperationsSearchLatencyP99EstimateDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricElasticsearchNodeOperationsSearchLatencyP99Estimate.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordElasticsearchNodeOperationsTimeDataPoint adds a data point to elasticsearch.node.operations.time metric.
 func (mb *MetricsBuilder) RecordElasticsearchNodeOperationsTimeDataPoint(ts pcommon.Timestamp, val int64, operationAttributeValue AttributeOperation) {
 	mb.metricElasticsearchNodeOperationsTime.recordDataPoint(mb.startTime, ts, val, operationAttributeValue.String())
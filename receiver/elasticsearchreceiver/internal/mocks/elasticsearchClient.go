@@ -15,6 +15,52 @@ type MockElasticsearchClient struct {
 	mock.Mock
 }
 
+// CatIndices provides a mock function with given fields: ctx, indices
+func (_m *MockElasticsearchClient) CatIndices(ctx context.Context, indices []string) ([]model.CatIndex, error) {
+	ret := _m.Called(ctx, indices)
+
+	var r0 []model.CatIndex
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []model.CatIndex); ok {
+		r0 = rf(ctx, indices)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.CatIndex)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, indices)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CatNodes provides a mock function with given fields: ctx
+func (_m *MockElasticsearchClient) CatNodes(ctx context.Context) ([]model.CatNode, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []model.CatNode
+	if rf, ok := ret.Get(0).(func(context.Context) []model.CatNode); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.CatNode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ClusterHealth provides a mock function with given fields: ctx
 func (_m *MockElasticsearchClient) ClusterHealth(ctx context.Context) (*model.ClusterHealth, error) {
 	ret := _m.Called(ctx)
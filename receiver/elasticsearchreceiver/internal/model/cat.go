@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver/internal/model"
+
+// CatNode represents a row of elasticsearch's /_cat/nodes endpoint, requested in JSON format
+// with bytes in b and times in ms. The cat APIs return every column as a string, so numeric
+// columns are decoded from their quoted representation.
+// The struct only contains the columns requested by the client.
+type CatNode struct {
+	ID                   string  `json:"id"`
+	IP                   string  `json:"ip"`
+	Roles                string  `json:"node.role"`
+	Master               string  `json:"master"`
+	Name                 string  `json:"name"`
+	HeapCurrentInBy      int64   `json:"heap.current,string"`
+	HeapMaxInBy          int64   `json:"heap.max,string"`
+	FileDescCurrent      int64   `json:"file_desc.current,string"`
+	CPU                  int64   `json:"cpu,string"`
+	Load1m               float64 `json:"load_1m,string"`
+	Load5m               float64 `json:"load_5m,string"`
+	Load15m              float64 `json:"load_15m,string"`
+	DiskTotalInBy        int64   `json:"disk.total,string"`
+	DiskAvailInBy        int64   `json:"disk.avail,string"`
+	FielddataMemoryInBy  int64   `json:"fielddata.memory_size,string"`
+	FielddataEvictions   int64   `json:"fielddata.evictions,string"`
+	QueryCacheMemoryInBy int64   `json:"query_cache.memory_size,string"`
+	QueryCacheEvictions  int64   `json:"query_cache.evictions,string"`
+	QueryCacheHitCount   int64   `json:"query_cache.hit_count,string"`
+	QueryCacheMissCount  int64   `json:"query_cache.miss_count,string"`
+	IndexingIndexTotal   int64   `json:"indexing.index_total,string"`
+	IndexingIndexTimeMs  int64   `json:"indexing.index_time,string"`
+	IndexingDeleteTotal  int64   `json:"indexing.delete_total,string"`
+	IndexingDeleteTimeMs int64   `json:"indexing.delete_time,string"`
+	GetTotal             int64   `json:"get.total,string"`
+	GetTimeMs            int64   `json:"get.time,string"`
+	SearchQueryTotal     int64   `json:"search.query_total,string"`
+	SearchQueryTimeMs    int64   `json:"search.query_time,string"`
+	SearchFetchTotal     int64   `json:"search.fetch_total,string"`
+	SearchFetchTimeMs    int64   `json:"search.fetch_time,string"`
+	MergesTotal          int64   `json:"merges.total,string"`
+	MergesTotalTimeMs    int64   `json:"merges.total_time,string"`
+	RefreshTotal         int64   `json:"refresh.total,string"`
+	RefreshTimeMs        int64   `json:"refresh.time,string"`
+	FlushTotal           int64   `json:"flush.total,string"`
+	FlushTotalTimeMs     int64   `json:"flush.total_time,string"`
+}
+
+// CatIndex represents a row of elasticsearch's /_cat/indices endpoint, requested in JSON format
+// with times in ms. The struct only contains the columns requested by the client.
+type CatIndex struct {
+	Index             string `json:"index"`
+	SearchQueryTotal  int64  `json:"search.query_total,string"`
+	SearchQueryTimeMs int64  `json:"search.query_time,string"`
+	SearchFetchTotal  int64  `json:"search.fetch_total,string"`
+	SearchFetchTimeMs int64  `json:"search.fetch_time,string"`
+}
@@ -15,6 +15,8 @@
 package model // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver/internal/model"
 
 type ClusterMetadataResponse struct {
+	// Name is the name of the node answering the request
+	Name        string `json:"name"`
 	ClusterName string `json:"cluster_name"`
 	Version     struct {
 		Number string `json:"number"`
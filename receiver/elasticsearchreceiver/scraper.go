@@ -25,6 +25,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver/internal/model"
@@ -50,17 +51,33 @@ type elasticsearchScraper struct {
 	mb          *metadata.MetricsBuilder
 	version     *version.Version
 	clusterName string
+	// localNodeName is the name of the node answering the requests, which the _local node filter selects.
+	localNodeName string
+	// catNodes and catIndices are set once the stats endpoints were found to be
+	// unauthorized and the _cat endpoints are used instead.
+	catNodes   bool
+	catIndices bool
+	// searchSLO is only set when one of the derived search metrics is enabled.
+	searchSLO *searchSLOTracker
+	// resourceAttributes are added to the resource of every scraped metric.
+	resourceAttributes map[string]string
 }
 
 func newElasticSearchScraper(
 	settings component.ReceiverCreateSettings,
 	cfg *Config,
 ) *elasticsearchScraper {
-	return &elasticsearchScraper{
+	r := &elasticsearchScraper{
 		settings: settings.TelemetrySettings,
 		cfg:      cfg,
 		mb:       metadata.NewMetricsBuilder(cfg.Metrics, settings.BuildInfo),
 	}
+	if cfg.Metrics.ElasticsearchNodeOperationsSearchErrorRate.Enabled ||
+		cfg.Metrics.ElasticsearchNodeOperationsSearchLatencyAverage.Enabled ||
+		cfg.Metrics.ElasticsearchNodeOperationsSearchLatencyP99Estimate.Enabled {
+		r.searchSLO = newSearchSLOTracker(cfg.SearchSLO.LatencyWindow)
+	}
+	return r
 }
 
 func (r *elasticsearchScraper) start(_ context.Context, host component.Host) (err error) {
@@ -78,18 +95,32 @@ func (r *elasticsearchScraper) scrape(ctx context.Context) (pmetric.Metrics, err
 	r.scrapeClusterMetrics(ctx, now, errs)
 	r.scrapeIndicesMetrics(ctx, now, errs)
 
-	return r.mb.Emit(), errs.Combine()
+	md := r.mb.Emit()
+	if len(r.resourceAttributes) > 0 {
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			attrs := md.ResourceMetrics().At(i).Resource().Attributes()
+			for k, v := range r.resourceAttributes {
+				attrs.PutStr(k, v)
+			}
+		}
+	}
+	return md, errs.Combine()
 }
 
 // scrapeVersion gets and assigns the elasticsearch version number
 func (r *elasticsearchScraper) getClusterMetadata(ctx context.Context, errs *scrapererror.ScrapeErrors) {
 	response, err := r.client.ClusterMetadata(ctx)
 	if err != nil {
+		if r.fallbackAllowed(err) {
+			r.settings.Logger.Debug("Cluster metadata is not accessible, skipping", zap.Error(err))
+			return
+		}
 		errs.AddPartial(2, err)
 		return
 	}
 
 	r.clusterName = response.ClusterName
+	r.localNodeName = response.Name
 
 	esVersion, err := version.NewVersion(response.Version.Number)
 	if err != nil {
@@ -106,13 +137,26 @@ func (r *elasticsearchScraper) scrapeNodeMetrics(ctx context.Context, now pcommo
 		return
 	}
 
+	if r.catNodes {
+		r.scrapeCatNodeMetrics(ctx, now, errs)
+		return
+	}
+
 	nodeStats, err := r.client.NodeStats(ctx, r.cfg.Nodes)
 	if err != nil {
+		if r.fallbackAllowed(err) {
+			r.settings.Logger.Warn("Node stats are not accessible, falling back to the _cat/nodes endpoint", zap.Error(err))
+			r.catNodes = true
+			r.scrapeCatNodeMetrics(ctx, now, errs)
+			return
+		}
 		errs.AddPartial(26, err)
 		return
 	}
 
-	for _, info := range nodeStats.Nodes {
+	for nodeID, info := range nodeStats.Nodes {
+		r.recordSearchSLOMetrics(now, nodeID, info)
+
 		r.mb.RecordElasticsearchNodeCacheMemoryUsageDataPoint(now, info.Indices.FieldDataCache.MemorySizeInBy, metadata.AttributeCacheNameFielddata)
 		r.mb.RecordElasticsearchNodeCacheMemoryUsageDataPoint(now, info.Indices.QueryCache.MemorySizeInBy, metadata.AttributeCacheNameQuery)
 
@@ -280,6 +324,30 @@ func (r *elasticsearchScraper) scrapeNodeMetrics(ctx context.Context, now pcommo
 		r.mb.EmitForResource(metadata.WithElasticsearchClusterName(nodeStats.ClusterName),
 			metadata.WithElasticsearchNodeName(info.Name))
 	}
+
+	if r.searchSLO != nil {
+		nodeIDs := make(map[string]struct{}, len(nodeStats.Nodes))
+		for nodeID := range nodeStats.Nodes {
+			nodeIDs[nodeID] = struct{}{}
+		}
+		r.searchSLO.retain(nodeIDs)
+	}
+}
+
+// recordSearchSLOMetrics records the search metrics derived from the stats of the previous scrape of the node
+func (r *elasticsearchScraper) recordSearchSLOMetrics(now pcommon.Timestamp, nodeID string, info model.NodeStatsNodesInfo) {
+	if r.searchSLO == nil {
+		return
+	}
+
+	slo := r.searchSLO.update(nodeID, info.Indices.SearchOperations, info.ThreadPoolInfo[searchThreadPool])
+	if slo.hasLatency {
+		r.mb.RecordElasticsearchNodeOperationsSearchLatencyAverageDataPoint(now, slo.averageLatency)
+		r.mb.RecordElasticsearchNodeOperationsSearchLatencyP99EstimateDataPoint(now, slo.p99LatencyEstimate)
+	}
+	if slo.hasErrorRate {
+		r.mb.RecordElasticsearchNodeOperationsSearchErrorRateDataPoint(now, slo.errorRate)
+	}
 }
 
 func (r *elasticsearchScraper) scrapeClusterMetrics(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
@@ -289,6 +357,10 @@ func (r *elasticsearchScraper) scrapeClusterMetrics(ctx context.Context, now pco
 
 	clusterHealth, err := r.client.ClusterHealth(ctx)
 	if err != nil {
+		if r.fallbackAllowed(err) {
+			r.settings.Logger.Debug("Cluster health is not accessible, skipping cluster metrics", zap.Error(err))
+			return
+		}
 		errs.AddPartial(4, err)
 		return
 	}
@@ -330,9 +402,20 @@ func (r *elasticsearchScraper) scrapeIndicesMetrics(ctx context.Context, now pco
 		return
 	}
 
+	if r.catIndices {
+		r.scrapeCatIndicesMetrics(ctx, now, errs)
+		return
+	}
+
 	indexStats, err := r.client.IndexStats(ctx, r.cfg.Indices)
 
 	if err != nil {
+		if r.fallbackAllowed(err) {
+			r.settings.Logger.Warn("Index stats are not accessible, falling back to the _cat/indices endpoint", zap.Error(err))
+			r.catIndices = true
+			r.scrapeCatIndicesMetrics(ctx, now, errs)
+			return
+		}
 		errs.AddPartial(4, err)
 		return
 	}
@@ -362,3 +445,113 @@ func (r *elasticsearchScraper) scrapeOneIndexMetrics(now pcommon.Timestamp, name
 
 	r.mb.EmitForResource(metadata.WithElasticsearchIndexName(name), metadata.WithElasticsearchClusterName(r.clusterName))
 }
+
+// fallbackAllowed returns true if the error was caused by missing privileges and the _cat endpoints may be used instead.
+func (r *elasticsearchScraper) fallbackAllowed(err error) bool {
+	return r.cfg.CatAPIFallback && errors.Is(err, errUnauthorized)
+}
+
+// scrapeCatNodeMetrics adds the reduced set of node-level metrics available from the _cat/nodes endpoint.
+// Since _cat/nodes can't filter nodes, the node filters are resolved from the returned nodes.
+func (r *elasticsearchScraper) scrapeCatNodeMetrics(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	nodes, err := r.client.CatNodes(ctx)
+	if err != nil {
+		errs.AddPartial(26, err)
+		return
+	}
+
+	selected, err := resolveCatNodes(r.cfg.Nodes, nodes, r.localNodeName)
+	if err != nil {
+		// the nodes selected by the other filters are still scraped
+		errs.AddPartial(0, err)
+	}
+	for _, node := range nodes {
+		if !selected[node.ID] {
+			continue
+		}
+
+		r.mb.RecordElasticsearchNodeCacheMemoryUsageDataPoint(now, node.FielddataMemoryInBy, metadata.AttributeCacheNameFielddata)
+		r.mb.RecordElasticsearchNodeCacheMemoryUsageDataPoint(now, node.QueryCacheMemoryInBy, metadata.AttributeCacheNameQuery)
+
+		r.mb.RecordElasticsearchNodeCacheEvictionsDataPoint(now, node.FielddataEvictions, metadata.AttributeCacheNameFielddata)
+		r.mb.RecordElasticsearchNodeCacheEvictionsDataPoint(now, node.QueryCacheEvictions, metadata.AttributeCacheNameQuery)
+
+		r.mb.RecordElasticsearchNodeCacheCountDataPoint(now, node.QueryCacheHitCount, metadata.AttributeQueryCacheCountTypeHit)
+		r.mb.RecordElasticsearchNodeCacheCountDataPoint(now, node.QueryCacheMissCount, metadata.AttributeQueryCacheCountTypeMiss)
+
+		r.mb.RecordElasticsearchNodeFsDiskAvailableDataPoint(now, node.DiskAvailInBy)
+		r.mb.RecordElasticsearchNodeFsDiskTotalDataPoint(now, node.DiskTotalInBy)
+
+		r.mb.RecordElasticsearchNodeOperationsCompletedDataPoint(now, node.IndexingIndexTotal, metadata.AttributeOperationIndex)
+		r.mb.RecordElasticsearchNodeOperationsCompletedDataPoint(now, node.IndexingDeleteTotal, metadata.AttributeOperationDelete)
+		r.mb.RecordElasticsearchNodeOperationsCompletedDataPoint(now, node.GetTotal, metadata.AttributeOperationGet)
+		r.mb.RecordElasticsearchNodeOperationsCompletedDataPoint(now, node.SearchQueryTotal, metadata.AttributeOperationQuery)
+		r.mb.RecordElasticsearchNodeOperationsCompletedDataPoint(now, node.SearchFetchTotal, metadata.AttributeOperationFetch)
+		r.mb.RecordElasticsearchNodeOperationsCompletedDataPoint(now, node.MergesTotal, metadata.AttributeOperationMerge)
+		r.mb.RecordElasticsearchNodeOperationsCompletedDataPoint(now, node.RefreshTotal, metadata.AttributeOperationRefresh)
+		r.mb.RecordElasticsearchNodeOperationsCompletedDataPoint(now, node.FlushTotal, metadata.AttributeOperationFlush)
+
+		r.mb.RecordElasticsearchNodeOperationsTimeDataPoint(now, node.IndexingIndexTimeMs, metadata.AttributeOperationIndex)
+		r.mb.RecordElasticsearchNodeOperationsTimeDataPoint(now, node.IndexingDeleteTimeMs, metadata.AttributeOperationDelete)
+		r.mb.RecordElasticsearchNodeOperationsTimeDataPoint(now, node.GetTimeMs, metadata.AttributeOperationGet)
+		r.mb.RecordElasticsearchNodeOperationsTimeDataPoint(now, node.SearchQueryTimeMs, metadata.AttributeOperationQuery)
+		r.mb.RecordElasticsearchNodeOperationsTimeDataPoint(now, node.SearchFetchTimeMs, metadata.AttributeOperationFetch)
+		r.mb.RecordElasticsearchNodeOperationsTimeDataPoint(now, node.MergesTotalTimeMs, metadata.AttributeOperationMerge)
+		r.mb.RecordElasticsearchNodeOperationsTimeDataPoint(now, node.RefreshTimeMs, metadata.AttributeOperationRefresh)
+		r.mb.RecordElasticsearchNodeOperationsTimeDataPoint(now, node.FlushTotalTimeMs, metadata.AttributeOperationFlush)
+
+		r.mb.RecordElasticsearchNodeOpenFilesDataPoint(now, node.FileDescCurrent)
+
+		r.mb.RecordElasticsearchOsCPUUsageDataPoint(now, node.CPU)
+		r.mb.RecordElasticsearchOsCPULoadAvg1mDataPoint(now, node.Load1m)
+		r.mb.RecordElasticsearchOsCPULoadAvg5mDataPoint(now, node.Load5m)
+		r.mb.RecordElasticsearchOsCPULoadAvg15mDataPoint(now, node.Load15m)
+
+		r.mb.RecordJvmMemoryHeapMaxDataPoint(now, node.HeapMaxInBy)
+		r.mb.RecordJvmMemoryHeapUsedDataPoint(now, node.HeapCurrentInBy)
+
+		r.mb.EmitForResource(metadata.WithElasticsearchClusterName(r.clusterName),
+			metadata.WithElasticsearchNodeName(node.Name))
+	}
+}
+
+// scrapeCatIndicesMetrics adds the index-level metrics available from the _cat/indices endpoint.
+// The "_all" aggregate is computed by summing the values of the returned indices.
+func (r *elasticsearchScraper) scrapeCatIndicesMetrics(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	indices, err := r.client.CatIndices(ctx, r.cfg.Indices)
+	if err != nil {
+		errs.AddPartial(4, err)
+		return
+	}
+
+	all := model.CatIndex{Index: "_all"}
+	for _, index := range indices {
+		all.SearchQueryTotal += index.SearchQueryTotal
+		all.SearchQueryTimeMs += index.SearchQueryTimeMs
+		all.SearchFetchTotal += index.SearchFetchTotal
+		all.SearchFetchTimeMs += index.SearchFetchTimeMs
+	}
+	r.scrapeOneCatIndexMetrics(now, all)
+
+	for _, index := range indices {
+		r.scrapeOneCatIndexMetrics(now, index)
+	}
+}
+
+func (r *elasticsearchScraper) scrapeOneCatIndexMetrics(now pcommon.Timestamp, index model.CatIndex) {
+	r.mb.RecordElasticsearchIndexOperationsCompletedDataPoint(
+		now, index.SearchFetchTotal, metadata.AttributeOperationFetch, metadata.AttributeIndexAggregationTypeTotal,
+	)
+	r.mb.RecordElasticsearchIndexOperationsCompletedDataPoint(
+		now, index.SearchQueryTotal, metadata.AttributeOperationQuery, metadata.AttributeIndexAggregationTypeTotal,
+	)
+
+	r.mb.RecordElasticsearchIndexOperationsTimeDataPoint(
+		now, index.SearchFetchTimeMs, metadata.AttributeOperationFetch, metadata.AttributeIndexAggregationTypeTotal,
+	)
+	r.mb.RecordElasticsearchIndexOperationsTimeDataPoint(
+		now, index.SearchQueryTimeMs, metadata.AttributeOperationQuery, metadata.AttributeIndexAggregationTypeTotal,
+	)
+
+	r.mb.EmitForResource(metadata.WithElasticsearchIndexName(index.Index), metadata.WithElasticsearchClusterName(r.clusterName))
+}
@@ -119,6 +119,116 @@ func TestScraperNoNodesMetrics(t *testing.T) {
 	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
 }
 
+func TestScraperResourceAttributes(t *testing.T) {
+	t.Parallel()
+
+	sc := newElasticSearchScraper(componenttest.NewNopReceiverCreateSettings(), createDefaultConfig().(*Config))
+	sc.resourceAttributes = map[string]string{"deployment.environment": "production"}
+
+	err := sc.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	mockClient := mocks.MockElasticsearchClient{}
+	mockClient.On("ClusterMetadata", mock.Anything).Return(clusterMetadata(t), nil)
+	mockClient.On("ClusterHealth", mock.Anything).Return(clusterHealth(t), nil)
+	mockClient.On("NodeStats", mock.Anything, []string{"_all"}).Return(nodeStats(t), nil)
+	mockClient.On("IndexStats", mock.Anything, []string{"_all"}).Return(indexStats(t), nil)
+
+	sc.client = &mockClient
+
+	actualMetrics, err := sc.scrape(context.Background())
+	require.NoError(t, err)
+
+	require.Greater(t, actualMetrics.ResourceMetrics().Len(), 0)
+	for i := 0; i < actualMetrics.ResourceMetrics().Len(); i++ {
+		attrs := actualMetrics.ResourceMetrics().At(i).Resource().Attributes()
+		env, ok := attrs.Get("deployment.environment")
+		require.True(t, ok)
+		require.Equal(t, "production", env.Str())
+		_, ok = attrs.Get("elasticsearch.cluster.name")
+		require.True(t, ok)
+	}
+}
+
+func TestScraperCatAPIFallback(t *testing.T) {
+	t.Parallel()
+
+	conf := createDefaultConfig().(*Config)
+	conf.CatAPIFallback = true
+
+	sc := newElasticSearchScraper(componenttest.NewNopReceiverCreateSettings(), conf)
+
+	err := sc.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	mockClient := mocks.MockElasticsearchClient{}
+	mockClient.On("ClusterMetadata", mock.Anything).Return(nil, errUnauthorized)
+	mockClient.On("ClusterHealth", mock.Anything).Return(nil, errUnauthorized)
+	mockClient.On("NodeStats", mock.Anything, []string{"_all"}).Return(nil, errUnauthorized).Once()
+	mockClient.On("IndexStats", mock.Anything, []string{"_all"}).Return(nil, errUnauthorized).Once()
+	mockClient.On("CatNodes", mock.Anything).Return(catNodes(t), nil)
+	mockClient.On("CatIndices", mock.Anything, []string{"_all"}).Return(catIndices(t), nil)
+
+	sc.client = &mockClient
+
+	for i := 0; i < 2; i++ {
+		actualMetrics, err := sc.scrape(context.Background())
+		require.NoError(t, err)
+
+		// one node resource, plus the "_all" aggregate and one resource per index
+		rms := actualMetrics.ResourceMetrics()
+		require.Equal(t, 4, rms.Len())
+
+		nodeName, ok := rms.At(0).Resource().Attributes().Get("elasticsearch.node.name")
+		require.True(t, ok)
+		require.Equal(t, "917e13a1b3a3", nodeName.Str())
+
+		indexName, ok := rms.At(1).Resource().Attributes().Get("elasticsearch.index.name")
+		require.True(t, ok)
+		require.Equal(t, "_all", indexName.Str())
+
+		metrics := rms.At(1).ScopeMetrics().At(0).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			if metrics.At(j).Name() != "elasticsearch.index.operations.completed" {
+				continue
+			}
+			dps := metrics.At(j).Sum().DataPoints()
+			for k := 0; k < dps.Len(); k++ {
+				op, _ := dps.At(k).Attributes().Get("operation")
+				if op.Str() == "query" {
+					require.EqualValues(t, 51, dps.At(k).IntValue())
+				}
+			}
+		}
+	}
+
+	// the stats endpoints are only attempted once before the fallback is used
+	mockClient.AssertNumberOfCalls(t, "NodeStats", 1)
+	mockClient.AssertNumberOfCalls(t, "IndexStats", 1)
+	mockClient.AssertNumberOfCalls(t, "CatNodes", 2)
+}
+
+func TestScraperCatAPIFallbackDisabled(t *testing.T) {
+	t.Parallel()
+
+	sc := newElasticSearchScraper(componenttest.NewNopReceiverCreateSettings(), createDefaultConfig().(*Config))
+
+	err := sc.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	mockClient := mocks.MockElasticsearchClient{}
+	mockClient.On("ClusterMetadata", mock.Anything).Return(clusterMetadata(t), nil)
+	mockClient.On("ClusterHealth", mock.Anything).Return(clusterHealth(t), nil)
+	mockClient.On("NodeStats", mock.Anything, []string{"_all"}).Return(nil, errUnauthorized)
+	mockClient.On("IndexStats", mock.Anything, []string{"_all"}).Return(indexStats(t), nil)
+
+	sc.client = &mockClient
+
+	_, err = sc.scrape(context.Background())
+	require.ErrorIs(t, err, errUnauthorized)
+	mockClient.AssertNotCalled(t, "CatNodes", mock.Anything)
+}
+
 func TestScraperFailedStart(t *testing.T) {
 	t.Parallel()
 
@@ -343,3 +453,21 @@ func clusterMetadata(t *testing.T) *model.ClusterMetadataResponse {
 	require.NoError(t, json.Unmarshal(metadataJSON, &metadataResponse))
 	return &metadataResponse
 }
+
+func catNodes(t *testing.T) []model.CatNode {
+	catNodesJSON, err := os.ReadFile("./testdata/sample_payloads/cat_nodes.json")
+	require.NoError(t, err)
+
+	var nodes []model.CatNode
+	require.NoError(t, json.Unmarshal(catNodesJSON, &nodes))
+	return nodes
+}
+
+func catIndices(t *testing.T) []model.CatIndex {
+	catIndicesJSON, err := os.ReadFile("./testdata/sample_payloads/cat_indices.json")
+	require.NoError(t, err)
+
+	var indices []model.CatIndex
+	require.NoError(t, json.Unmarshal(catIndicesJSON, &indices))
+	return indices
+}
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver"
+
+import (
+	"math"
+	"sort"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver/internal/model"
+)
+
+// searchThreadPool is the name of the thread pool executing the search requests of a node.
+const searchThreadPool = "search"
+
+// searchSLOTracker derives search latency and error rate metrics of the nodes
+// from the cumulative counters of consecutive scrapes.
+type searchSLOTracker struct {
+	// latencyWindow is the number of scrape intervals used to estimate latency percentiles.
+	latencyWindow int
+	nodes         map[string]*nodeSearchState
+}
+
+// nodeSearchState holds the counters of a node from the previous scrape.
+type nodeSearchState struct {
+	queryTotal     int64
+	queryTimeInMs  int64
+	completedTasks int64
+	rejectedTasks  int64
+	// latencies holds the average latencies of the last scrape intervals with queries.
+	latencies []float64
+}
+
+// searchSLO is the result of comparing the counters of a node between two scrapes.
+type searchSLO struct {
+	averageLatency     float64
+	p99LatencyEstimate float64
+	hasLatency         bool
+	errorRate          float64
+	hasErrorRate       bool
+}
+
+func newSearchSLOTracker(latencyWindow int) *searchSLOTracker {
+	return &searchSLOTracker{
+		latencyWindow: latencyWindow,
+		nodes:         make(map[string]*nodeSearchState),
+	}
+}
+
+// update records the counters of a node and returns the metrics derived from
+// the previous scrape. Nothing is derived on the first scrape of a node, nor
+// when its counters were reset, e.g. by a restart.
+func (t *searchSLOTracker) update(nodeID string, search model.SearchOperations, pool model.ThreadPoolStats) searchSLO {
+	current := &nodeSearchState{
+		queryTotal:     search.QueryTotal,
+		queryTimeInMs:  search.QueryTimeInMs,
+		completedTasks: pool.CompletedTasks,
+		rejectedTasks:  pool.RejectedTasks,
+	}
+	previous, ok := t.nodes[nodeID]
+	t.nodes[nodeID] = current
+
+	var result searchSLO
+	if !ok {
+		return result
+	}
+
+	current.latencies = previous.latencies
+	queries := current.queryTotal - previous.queryTotal
+	queryTime := current.queryTimeInMs - previous.queryTimeInMs
+	if queries >= 0 && queryTime >= 0 {
+		if queries > 0 {
+			current.latencies = append(current.latencies, float64(queryTime)/float64(queries))
+			if len(current.latencies) > t.latencyWindow {
+				current.latencies = current.latencies[len(current.latencies)-t.latencyWindow:]
+			}
+			result.averageLatency = current.latencies[len(current.latencies)-1]
+			result.p99LatencyEstimate = percentile(current.latencies, 0.99)
+			result.hasLatency = true
+		}
+	}
+
+	completed := current.completedTasks - previous.completedTasks
+	rejected := current.rejectedTasks - previous.rejectedTasks
+	if completed >= 0 && rejected >= 0 {
+		result.hasErrorRate = true
+		if total := completed + rejected; total > 0 {
+			result.errorRate = float64(rejected) / float64(total)
+		}
+	}
+	return result
+}
+
+// retain forgets the nodes that are not part of the given ones anymore.
+func (t *searchSLOTracker) retain(nodeIDs map[string]struct{}) {
+	for nodeID := range t.nodes {
+		if _, ok := nodeIDs[nodeID]; !ok {
+			delete(t.nodes, nodeID)
+		}
+	}
+}
+
+// percentile returns the nearest-rank percentile of the values.
+func percentile(values []float64, p float64) float64 {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver/internal/mocks"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver/internal/model"
+)
+
+func TestSearchSLOTracker(t *testing.T) {
+	tracker := newSearchSLOTracker(3)
+
+	// Nothing is derived from the first scrape
+	slo := tracker.update("node", model.SearchOperations{QueryTotal: 10, QueryTimeInMs: 100}, model.ThreadPoolStats{CompletedTasks: 10})
+	assert.False(t, slo.hasLatency)
+	assert.False(t, slo.hasErrorRate)
+
+	slo = tracker.update("node", model.SearchOperations{QueryTotal: 20, QueryTimeInMs: 200}, model.ThreadPoolStats{CompletedTasks: 19, RejectedTasks: 1})
+	require.True(t, slo.hasLatency)
+	assert.Equal(t, 10.0, slo.averageLatency)
+	assert.Equal(t, 10.0, slo.p99LatencyEstimate)
+	require.True(t, slo.hasErrorRate)
+	assert.Equal(t, 0.1, slo.errorRate)
+
+	// Intervals without queries have no latency
+	slo = tracker.update("node", model.SearchOperations{QueryTotal: 20, QueryTimeInMs: 200}, model.ThreadPoolStats{CompletedTasks: 19, RejectedTasks: 1})
+	assert.False(t, slo.hasLatency)
+	require.True(t, slo.hasErrorRate)
+	assert.Equal(t, 0.0, slo.errorRate)
+
+	slo = tracker.update("node", model.SearchOperations{QueryTotal: 30, QueryTimeInMs: 700}, model.ThreadPoolStats{CompletedTasks: 29, RejectedTasks: 1})
+	assert.Equal(t, 50.0, slo.averageLatency)
+	assert.Equal(t, 50.0, slo.p99LatencyEstimate)
+
+	slo = tracker.update("node", model.SearchOperations{QueryTotal: 40, QueryTimeInMs: 720}, model.ThreadPoolStats{CompletedTasks: 39, RejectedTasks: 1})
+	assert.Equal(t, 2.0, slo.averageLatency)
+	assert.Equal(t, 50.0, slo.p99LatencyEstimate)
+
+	// The oldest latencies leave the window
+	tracker.update("node", model.SearchOperations{QueryTotal: 50, QueryTimeInMs: 740}, model.ThreadPoolStats{})
+	slo = tracker.update("node", model.SearchOperations{QueryTotal: 60, QueryTimeInMs: 760}, model.ThreadPoolStats{})
+	assert.Equal(t, 2.0, slo.p99LatencyEstimate)
+
+	// Counters reset by a restart
+	slo = tracker.update("node", model.SearchOperations{QueryTotal: 5, QueryTimeInMs: 50}, model.ThreadPoolStats{})
+	assert.False(t, slo.hasLatency)
+}
+
+func TestSearchSLOTrackerRetain(t *testing.T) {
+	tracker := newSearchSLOTracker(3)
+	tracker.update("a", model.SearchOperations{}, model.ThreadPoolStats{})
+	tracker.update("b", model.SearchOperations{}, model.ThreadPoolStats{})
+
+	tracker.retain(map[string]struct{}{"b": {}})
+	assert.Len(t, tracker.nodes, 1)
+	assert.Contains(t, tracker.nodes, "b")
+}
+
+func TestScraperSearchSLOMetrics(t *testing.T) {
+	conf := createDefaultConfig().(*Config)
+	conf.Metrics.ElasticsearchNodeOperationsSearchErrorRate.Enabled = true
+	conf.Metrics.ElasticsearchNodeOperationsSearchLatencyAverage.Enabled = true
+	conf.Metrics.ElasticsearchNodeOperationsSearchLatencyP99Estimate.Enabled = true
+
+	sc := newElasticSearchScraper(componenttest.NewNopReceiverCreateSettings(), conf)
+	require.NoError(t, sc.start(context.Background(), componenttest.NewNopHost()))
+
+	first := nodeStats(t)
+	second := nodeStats(t)
+	for nodeID, info := range second.Nodes {
+		info.Indices.SearchOperations.QueryTotal += 4
+		info.Indices.SearchOperations.QueryTimeInMs += 10
+		pool := info.ThreadPoolInfo[searchThreadPool]
+		pool.CompletedTasks += 3
+		pool.RejectedTasks++
+		info.ThreadPoolInfo[searchThreadPool] = pool
+		second.Nodes[nodeID] = info
+	}
+
+	mockClient := mocks.MockElasticsearchClient{}
+	mockClient.On("ClusterMetadata", mock.Anything).Return(clusterMetadata(t), nil)
+	mockClient.On("ClusterHealth", mock.Anything).Return(clusterHealth(t), nil)
+	mockClient.On("NodeStats", mock.Anything, []string{"_all"}).Return(first, nil).Once()
+	mockClient.On("NodeStats", mock.Anything, []string{"_all"}).Return(second, nil).Once()
+	mockClient.On("IndexStats", mock.Anything, []string{"_all"}).Return(indexStats(t), nil)
+	sc.client = &mockClient
+
+	metrics, err := sc.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, gaugeValues(metrics, "elasticsearch.node.operations.search.latency.average"))
+
+	metrics, err = sc.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []float64{2.5}, gaugeValues(metrics, "elasticsearch.node.operations.search.latency.average"))
+	assert.Equal(t, []float64{2.5}, gaugeValues(metrics, "elasticsearch.node.operations.search.latency.p99_estimate"))
+	assert.Equal(t, []float64{0.25}, gaugeValues(metrics, "elasticsearch.node.operations.search.error_rate"))
+}
+
+func gaugeValues(metrics pmetric.Metrics, name string) []float64 {
+	var values []float64
+	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+		sms := metrics.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				if ms.At(k).Name() != name {
+					continue
+				}
+				dps := ms.At(k).Gauge().DataPoints()
+				for l := 0; l < dps.Len(); l++ {
+					values = append(values, dps.At(l).DoubleValue())
+				}
+			}
+		}
+	}
+	return values
+}
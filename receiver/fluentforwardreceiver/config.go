@@ -14,7 +14,13 @@
 
 package fluentforwardreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluentforwardreceiver"
 
-import "go.opentelemetry.io/collector/config"
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/config"
+)
+
+var errMissingSharedKey = errors.New(`"shared_key" must be specified when "security" is set`)
 
 // Config defines configuration for the SignalFx receiver.
 type Config struct {
@@ -24,4 +30,26 @@ type Config struct {
 	// of the form `<ip addr>:<port>` (TCP) or `unix://<socket_path>` (Unix
 	// domain socket).
 	ListenAddress string `mapstructure:"endpoint"`
+
+	// Security enables the handshake phase of the forward protocol, which
+	// authenticates the clients with a shared key.
+	Security *SecurityConfig `mapstructure:"security"`
+}
+
+// SecurityConfig defines the settings of the handshake phase of the forward protocol.
+type SecurityConfig struct {
+	// SelfHostname is the hostname sent to the clients. Defaults to the
+	// hostname of the host.
+	SelfHostname string `mapstructure:"self_hostname"`
+
+	// SharedKey is the key shared with the clients to authenticate them.
+	SharedKey string `mapstructure:"shared_key"`
+}
+
+// Validate checks the receiver configuration is valid
+func (c *Config) Validate() error {
+	if c.Security != nil && c.Security.SharedKey == "" {
+		return errMissingSharedKey
+	}
+	return nil
 }
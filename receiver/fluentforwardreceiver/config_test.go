@@ -38,3 +38,33 @@ func TestLoadConfig(t *testing.T) {
 	assert.NoError(t, cfg.Validate())
 	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
 }
+
+func TestLoadConfigSecurity(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(config.NewComponentIDWithName("fluentforward", "secure").String())
+	require.NoError(t, err)
+	require.NoError(t, config.UnmarshalReceiver(sub, cfg))
+
+	assert.NoError(t, cfg.Validate())
+	assert.Equal(t, &Config{
+		ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+		ListenAddress:    "0.0.0.0:24224",
+		Security: &SecurityConfig{
+			SelfHostname: "collector",
+			SharedKey:    "secret",
+		},
+	}, cfg)
+}
+
+func TestValidate(t *testing.T) {
+	cfg := &Config{
+		ListenAddress: "0.0.0.0:24224",
+		Security:      &SecurityConfig{},
+	}
+	assert.ErrorIs(t, cfg.Validate(), errMissingSharedKey)
+}
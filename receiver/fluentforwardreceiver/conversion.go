@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"time"
 
 	"github.com/tinylib/msgp/msgp"
@@ -137,6 +138,12 @@ func timeFromTimestamp(ts interface{}) (time.Time, error) {
 		return time.Unix(int64(v), 0), nil
 	case int64:
 		return time.Unix(v, 0), nil
+	case float64:
+		// some clients send the time as a float with a sub-second part
+		secs, frac := math.Modf(v)
+		return time.Unix(int64(secs), int64(frac*float64(time.Second))), nil
+	case float32:
+		return timeFromTimestamp(float64(v))
 	case *eventTimeExt:
 		return time.Time(*v), nil
 	default:
@@ -378,7 +385,16 @@ func (pfe *PackedForwardEventLogRecords) DecodeMsg(dc *msgp.Reader) error {
 		}
 	}
 
-	err = pfe.parseEntries(entriesRaw, pfe.Compressed() == "gzip", tag)
+	var isGzipped bool
+	switch pfe.Compressed() {
+	case "":
+	case "gzip":
+		isGzipped = true
+	default:
+		return msgp.WrapError(fmt.Errorf("unsupported compression %q", pfe.Compressed()), "Option", "compressed")
+	}
+
+	err = pfe.parseEntries(entriesRaw, isGzipped, tag)
 	if err != nil {
 		return err
 	}
@@ -392,6 +408,8 @@ func (pfe *PackedForwardEventLogRecords) parseEntries(entriesRaw []byte, isGzipp
 
 	if isGzipped {
 		var err error
+		// The gzip reader reads multistream data, as sent by clients which
+		// compress each chunk appended to the entries separately.
 		reader, err = gzip.NewReader(reader)
 		if err != nil {
 			return err
@@ -15,9 +15,12 @@
 package fluentforwardreceiver
 
 import (
+	"bufio"
 	"bytes"
+	"compress/gzip"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -146,6 +149,144 @@ func TestTimeFromTimestampBadType(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestTimeFromTimestamp(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		ts       interface{}
+		expected time.Time
+	}{
+		{
+			desc:     "uint64",
+			ts:       uint64(1593031012),
+			expected: time.Unix(1593031012, 0),
+		},
+		{
+			desc:     "int64",
+			ts:       int64(1593031012),
+			expected: time.Unix(1593031012, 0),
+		},
+		{
+			desc:     "float64",
+			ts:       1593031012.25,
+			expected: time.Unix(1593031012, 250000000),
+		},
+		{
+			desc:     "float32",
+			ts:       float32(1.5),
+			expected: time.Unix(1, 500000000),
+		},
+		{
+			desc: "event time",
+			ts: func() *eventTimeExt {
+				e := eventTimeExt(time.Unix(1593031012, 123456789))
+				return &e
+			}(),
+			expected: time.Unix(1593031012, 123456789),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ts, err := timeFromTimestamp(tc.ts)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, ts)
+		})
+	}
+}
+
+func TestMessageEventTimeEncodings(t *testing.T) {
+	eventTime := []byte{0x5e, 0xf3, 0xf1, 0x64, 0x07, 0x5b, 0xcd, 0x15}
+	testCases := []struct {
+		desc     string
+		time     []byte
+		expected int64
+	}{
+		{
+			desc:     "fixext8 event time",
+			time:     append([]byte{0xd7, 0x00}, eventTime...),
+			expected: 1593045348123456789,
+		},
+		{
+			desc:     "ext8 event time",
+			time:     append([]byte{0xc7, 0x08, 0x00}, eventTime...),
+			expected: 1593045348123456789,
+		},
+		{
+			desc:     "float",
+			time:     msgp.AppendFloat64(nil, 1593045348.5),
+			expected: 1593045348500000000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var b []byte
+			b = msgp.AppendArrayHeader(b, 3)
+			b = msgp.AppendString(b, "my-tag")
+			b = append(b, tc.time...)
+			b = msgp.AppendMapHeader(b, 1)
+			b = msgp.AppendString(b, "log")
+			b = msgp.AppendString(b, "hello")
+
+			mode, err := DetermineNextEventMode(bufio.NewReader(bytes.NewReader(b)))
+			require.NoError(t, err)
+			require.Equal(t, MessageMode, mode)
+
+			var event MessageEventLogRecord
+			require.NoError(t, event.DecodeMsg(msgp.NewReader(bytes.NewReader(b))))
+			require.EqualValues(t, tc.expected, event.LogRecords().At(0).Timestamp())
+		})
+	}
+}
+
+func makePackedForwardEvent(compressed string, entries []byte) []byte {
+	var b []byte
+	b = msgp.AppendArrayHeader(b, 3)
+	b = msgp.AppendString(b, "my-tag")
+	b = msgp.AppendBytes(b, entries)
+	b = msgp.AppendMapHeader(b, 1)
+	b = msgp.AppendString(b, "compressed")
+	b = msgp.AppendString(b, compressed)
+	return b
+}
+
+func TestPackedForwardMultistreamGzip(t *testing.T) {
+	// each chunk is compressed separately and appended to the entries
+	var entries bytes.Buffer
+	for i := 0; i < 2; i++ {
+		var entry []byte
+		entry = msgp.AppendArrayHeader(entry, 2)
+		entry = msgp.AppendInt64(entry, int64(5000+i))
+		entry = msgp.AppendMapHeader(entry, 1)
+		entry = msgp.AppendString(entry, "log")
+		entry = msgp.AppendString(entry, fmt.Sprintf("chunk %d", i))
+
+		gz := gzip.NewWriter(&entries)
+		_, err := gz.Write(entry)
+		require.NoError(t, err)
+		require.NoError(t, gz.Close())
+	}
+
+	var event PackedForwardEventLogRecords
+	reader := msgp.NewReader(bytes.NewReader(makePackedForwardEvent("gzip", entries.Bytes())))
+	require.NoError(t, event.DecodeMsg(reader))
+
+	records := event.LogRecords()
+	require.Equal(t, 2, records.Len())
+	for i := 0; i < records.Len(); i++ {
+		require.Equal(t, fmt.Sprintf("chunk %d", i), records.At(i).Body().Str())
+		require.EqualValues(t, (5000+i)*int(time.Second), records.At(i).Timestamp())
+	}
+}
+
+func TestPackedForwardUnsupportedCompression(t *testing.T) {
+	var event PackedForwardEventLogRecords
+	reader := msgp.NewReader(bytes.NewReader(makePackedForwardEvent("zstd", []byte{})))
+	err := event.DecodeMsg(reader)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `unsupported compression "zstd"`)
+}
+
 func TestMessageEventConversionWithErrors(t *testing.T) {
 	var b []byte
 
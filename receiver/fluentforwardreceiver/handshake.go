@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentforwardreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluentforwardreceiver"
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/tinylib/msgp/msgp"
+	"go.uber.org/zap"
+)
+
+// The time a client has to complete the handshake after connecting.
+const handshakeTimeout = 30 * time.Second
+
+// pingMessage is the PING message a client sends in response to the HELO
+// message of the server.
+type pingMessage struct {
+	hostname        string
+	sharedKeySalt   string
+	sharedKeyDigest string
+}
+
+// handshake performs the handshake phase of the forward protocol, which
+// authenticates the client with the shared key, see
+// https://github.com/fluent/fluentd/wiki/Forward-Protocol-Specification-v1#handshake-messages.
+// User authentication isn't supported, so the HELO message has an empty auth
+// salt and the username and password of the PING message are ignored.
+func (s *server) handshake(conn net.Conn, reader *msgp.Reader) error {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return err
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	var helo []byte
+	helo = msgp.AppendArrayHeader(helo, 2)
+	helo = msgp.AppendString(helo, "HELO")
+	helo = msgp.AppendMapHeader(helo, 3)
+	helo = msgp.AppendString(helo, "nonce")
+	helo = msgp.AppendBytes(helo, nonce)
+	helo = msgp.AppendString(helo, "auth")
+	helo = msgp.AppendBytes(helo, []byte{})
+	helo = msgp.AppendString(helo, "keepalive")
+	helo = msgp.AppendBool(helo, true)
+	if _, err := conn.Write(helo); err != nil {
+		return fmt.Errorf("failed to send HELO: %w", err)
+	}
+
+	ping, err := readPing(reader)
+	if err != nil {
+		return fmt.Errorf("failed to read PING: %w", err)
+	}
+
+	var reason string
+	switch {
+	case ping.hostname == s.security.SelfHostname:
+		reason = "same hostname between input and output: invalid configuration"
+	case subtle.ConstantTimeCompare([]byte(ping.sharedKeyDigest), []byte(s.sharedKeyDigest(ping.sharedKeySalt, ping.hostname, nonce))) != 1:
+		reason = "shared_key mismatch"
+	}
+
+	var pong []byte
+	pong = msgp.AppendArrayHeader(pong, 5)
+	pong = msgp.AppendString(pong, "PONG")
+	pong = msgp.AppendBool(pong, reason == "")
+	pong = msgp.AppendString(pong, reason)
+	pong = msgp.AppendString(pong, s.security.SelfHostname)
+	if reason == "" {
+		pong = msgp.AppendString(pong, s.sharedKeyDigest(ping.sharedKeySalt, s.security.SelfHostname, nonce))
+	} else {
+		pong = msgp.AppendString(pong, "")
+	}
+	if _, err = conn.Write(pong); err != nil {
+		return fmt.Errorf("failed to send PONG: %w", err)
+	}
+
+	if reason != "" {
+		s.logger.Warn("Client failed to authenticate",
+			zap.String("remoteAddr", conn.RemoteAddr().String()),
+			zap.String("hostname", ping.hostname),
+			zap.String("reason", reason))
+		return errors.New(reason)
+	}
+
+	return conn.SetDeadline(time.Time{})
+}
+
+// sharedKeyDigest returns the hex encoded SHA-512 digest of the salt, hostname,
+// nonce and shared key, which proves the knowledge of the shared key.
+func (s *server) sharedKeyDigest(salt, hostname string, nonce []byte) string {
+	h := sha512.New()
+	h.Write([]byte(salt))
+	h.Write([]byte(hostname))
+	h.Write(nonce)
+	h.Write([]byte(s.security.SharedKey))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func readPing(dc *msgp.Reader) (*pingMessage, error) {
+	arrLen, err := dc.ReadArrayHeader()
+	if err != nil {
+		return nil, err
+	}
+	if arrLen != 6 {
+		return nil, msgp.ArrayError{Wanted: 6, Got: arrLen}
+	}
+
+	// The fields are strings, but some clients send them as binary.
+	fields := make([]string, arrLen)
+	for i := range fields {
+		val, err := dc.ReadIntf()
+		if err != nil {
+			return nil, err
+		}
+		switch v := val.(type) {
+		case string:
+			fields[i] = v
+		case []byte:
+			fields[i] = string(v)
+		default:
+			return nil, fmt.Errorf("unexpected type %T of PING field %d", val, i)
+		}
+	}
+	if fields[0] != "PING" {
+		return nil, fmt.Errorf("unexpected message type %q", fields[0])
+	}
+
+	return &pingMessage{
+		hostname:        fields[1],
+		sharedKeySalt:   fields[2],
+		sharedKeyDigest: fields[3],
+	}, nil
+}
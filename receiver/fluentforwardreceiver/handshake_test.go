@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentforwardreceiver
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tinylib/msgp/msgp"
+)
+
+const (
+	testSharedKey  = "secret"
+	testServerName = "collector"
+	testClientName = "fluent-bit"
+	testSalt       = "0123456789abcdef"
+)
+
+func digest(salt, hostname string, nonce []byte, sharedKey string) string {
+	h := sha512.New()
+	h.Write([]byte(salt))
+	h.Write([]byte(hostname))
+	h.Write(nonce)
+	h.Write([]byte(sharedKey))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// readHelo reads the HELO message and returns its nonce
+func readHelo(t *testing.T, reader *msgp.Reader) []byte {
+	arrLen, err := reader.ReadArrayHeader()
+	require.NoError(t, err)
+	require.EqualValues(t, 2, arrLen)
+	msgType, err := reader.ReadString()
+	require.NoError(t, err)
+	require.Equal(t, "HELO", msgType)
+
+	options := map[string]interface{}{}
+	require.NoError(t, reader.ReadMapStrIntf(options))
+	require.Contains(t, options, "auth")
+	require.Empty(t, options["auth"])
+	require.Equal(t, true, options["keepalive"])
+	nonce, ok := options["nonce"].([]byte)
+	require.True(t, ok)
+	require.Len(t, nonce, 16)
+	return nonce
+}
+
+func sendPing(t *testing.T, conn net.Conn, hostname, sharedKeyDigest string) {
+	var b []byte
+	b = msgp.AppendArrayHeader(b, 6)
+	b = msgp.AppendString(b, "PING")
+	b = msgp.AppendString(b, hostname)
+	b = msgp.AppendString(b, testSalt)
+	b = msgp.AppendString(b, sharedKeyDigest)
+	b = msgp.AppendString(b, "")
+	b = msgp.AppendString(b, "")
+	_, err := conn.Write(b)
+	require.NoError(t, err)
+}
+
+func readPong(t *testing.T, reader *msgp.Reader) []interface{} {
+	pong, err := reader.ReadIntf()
+	require.NoError(t, err)
+	fields, ok := pong.([]interface{})
+	require.True(t, ok)
+	require.Len(t, fields, 5)
+	require.Equal(t, "PONG", fields[0])
+	return fields
+}
+
+func TestHandshake(t *testing.T) {
+	connect, next, _, cancel := setupServerWithConfig(t, &Config{
+		ListenAddress: "127.0.0.1:0",
+		Security: &SecurityConfig{
+			SelfHostname: testServerName,
+			SharedKey:    testSharedKey,
+		},
+	})
+	defer cancel()
+
+	conn := connect()
+	defer conn.Close()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	reader := msgp.NewReader(conn)
+
+	nonce := readHelo(t, reader)
+	sendPing(t, conn, testClientName, digest(testSalt, testClientName, nonce, testSharedKey))
+
+	pong := readPong(t, reader)
+	require.Equal(t, []interface{}{
+		"PONG", true, "", testServerName, digest(testSalt, testServerName, nonce, testSharedKey),
+	}, pong)
+
+	_, err := conn.Write(makeSampleEvent("authenticated"))
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return next.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestHandshakeFailure(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		hostname string
+		key      string
+		reason   string
+	}{
+		{
+			desc:     "shared key mismatch",
+			hostname: testClientName,
+			key:      "wrong",
+			reason:   "shared_key mismatch",
+		},
+		{
+			desc:     "same hostname",
+			hostname: testServerName,
+			key:      testSharedKey,
+			reason:   "same hostname between input and output: invalid configuration",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			connect, next, observedLogs, cancel := setupServerWithConfig(t, &Config{
+				ListenAddress: "127.0.0.1:0",
+				Security: &SecurityConfig{
+					SelfHostname: testServerName,
+					SharedKey:    testSharedKey,
+				},
+			})
+			defer cancel()
+
+			conn := connect()
+			defer conn.Close()
+			require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+			reader := msgp.NewReader(conn)
+
+			nonce := readHelo(t, reader)
+			sendPing(t, conn, tc.hostname, digest(testSalt, tc.hostname, nonce, tc.key))
+
+			pong := readPong(t, reader)
+			require.Equal(t, []interface{}{"PONG", false, tc.reason, testServerName, ""}, pong)
+
+			waitForConnectionClose(t, conn)
+			require.Len(t, observedLogs.FilterMessage("Client failed to authenticate").All(), 1)
+			require.Equal(t, 0, next.LogRecordCount())
+		})
+	}
+}
+
+func TestHandshakeNotPing(t *testing.T) {
+	connect, next, _, cancel := setupServerWithConfig(t, &Config{
+		ListenAddress: "127.0.0.1:0",
+		Security: &SecurityConfig{
+			SelfHostname: testServerName,
+			SharedKey:    testSharedKey,
+		},
+	})
+	defer cancel()
+
+	conn := connect()
+	defer conn.Close()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	reader := msgp.NewReader(conn)
+	readHelo(t, reader)
+
+	// events sent without authenticating are rejected
+	_, err := conn.Write(makeSampleEvent("unauthenticated"))
+	require.NoError(t, err)
+
+	waitForConnectionClose(t, conn)
+	require.Equal(t, 0, next.LogRecordCount())
+}
@@ -17,6 +17,7 @@ package fluentforwardreceiver // import "github.com/open-telemetry/opentelemetry
 import (
 	"context"
 	"net"
+	"os"
 	"strings"
 
 	"go.opentelemetry.io/collector/component"
@@ -42,7 +43,19 @@ func newFluentReceiver(logger *zap.Logger, conf *Config, next consumer.Logs) (co
 
 	collector := newCollector(eventCh, next, logger)
 
-	server := newServer(eventCh, logger)
+	security := conf.Security
+	if security != nil && security.SelfHostname == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		security = &SecurityConfig{
+			SelfHostname: hostname,
+			SharedKey:    security.SharedKey,
+		}
+	}
+
+	server := newServer(eventCh, logger, security)
 
 	return &fluentReceiver{
 		collector: collector,
@@ -35,14 +35,16 @@ import (
 const readBufferSize = 10 * 1024
 
 type server struct {
-	outCh  chan<- Event
-	logger *zap.Logger
+	outCh    chan<- Event
+	logger   *zap.Logger
+	security *SecurityConfig
 }
 
-func newServer(outCh chan<- Event, logger *zap.Logger) *server {
+func newServer(outCh chan<- Event, logger *zap.Logger, security *SecurityConfig) *server {
 	return &server{
-		outCh:  outCh,
-		logger: logger,
+		outCh:    outCh,
+		logger:   logger,
+		security: security,
 	}
 }
 
@@ -91,6 +93,12 @@ func (s *server) handleConnections(ctx context.Context, listener net.Listener) {
 func (s *server) handleConn(ctx context.Context, conn net.Conn) error {
 	reader := msgp.NewReaderSize(conn, readBufferSize)
 
+	if s.security != nil {
+		if err := s.handshake(conn, reader); err != nil {
+			return fmt.Errorf("handshake failed: %w", err)
+		}
+	}
+
 	for {
 		mode, err := DetermineNextEventMode(reader.R)
 		if err != nil {
@@ -195,7 +203,7 @@ func DetermineNextEventMode(peeker Peeker) (EventMode, error) {
 	secondElmType := msgp.NextType(chunk[1+tagLen:])
 
 	switch secondElmType {
-	case msgp.IntType, msgp.UintType, msgp.ExtensionType:
+	case msgp.IntType, msgp.UintType, msgp.ExtensionType, msgp.Float64Type, msgp.Float32Type:
 		return MessageMode, nil
 	case msgp.ArrayType:
 		return ForwardMode, nil
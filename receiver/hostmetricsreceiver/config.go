@@ -23,6 +23,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/remote"
 )
 
 const (
@@ -33,6 +34,9 @@ const (
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	Scrapers                                map[string]internal.Config `mapstructure:"-"`
+	// Remote is an experimental option to scrape a remote Linux host over SSH instead of the local host.
+	// Only the cpu, memory and filesystem scrapers support it.
+	Remote *remote.Config `mapstructure:"remote"`
 }
 
 var _ config.Receiver = (*Config)(nil)
@@ -44,6 +48,17 @@ func (cfg *Config) Validate() error {
 		return errors.New("must specify at least one scraper when using hostmetrics receiver")
 	}
 
+	if cfg.Remote != nil {
+		if err := cfg.Remote.Validate(); err != nil {
+			return err
+		}
+		for key, scraperCfg := range cfg.Scrapers {
+			if _, ok := scraperCfg.(internal.RemoteConfig); !ok {
+				return fmt.Errorf("scraper %q does not support the remote mode", key)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -84,6 +99,10 @@ func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 			return fmt.Errorf("error reading settings for scraper type %q: %w", key, err)
 		}
 
+		if remoteCfg, ok := collectorCfg.(internal.RemoteConfig); ok && cfg.Remote != nil {
+			remoteCfg.SetRemote(cfg.Remote)
+		}
+
 		cfg.Scrapers[key] = collectorCfg
 	}
 
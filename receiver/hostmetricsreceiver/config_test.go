@@ -26,10 +26,12 @@ import (
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.opentelemetry.io/collector/service/servicetest"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/remote"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filehandlesscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/loadscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
@@ -74,8 +76,9 @@ func TestLoadConfig(t *testing.T) {
 				cfg.(*loadscraper.Config).CPUAverage = true
 				return cfg
 			})(),
-			filesystemscraper.TypeStr: (&filesystemscraper.Factory{}).CreateDefaultConfig(),
-			memoryscraper.TypeStr:     (&memoryscraper.Factory{}).CreateDefaultConfig(),
+			filehandlesscraper.TypeStr: (&filehandlesscraper.Factory{}).CreateDefaultConfig(),
+			filesystemscraper.TypeStr:  (&filesystemscraper.Factory{}).CreateDefaultConfig(),
+			memoryscraper.TypeStr:      (&memoryscraper.Factory{}).CreateDefaultConfig(),
 			networkscraper.TypeStr: (func() internal.Config {
 				cfg := (&networkscraper.Factory{}).CreateDefaultConfig()
 				cfg.(*networkscraper.Config).Include = networkscraper.MatchConfig{
@@ -121,3 +124,43 @@ func TestLoadInvalidConfig_InvalidScraperKey(t *testing.T) {
 
 	require.EqualError(t, err, "error reading receivers configuration for \"hostmetrics\": invalid scraper key: invalidscraperkey")
 }
+
+func TestLoadConfig_Remote(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[typeStr] = factory
+	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config-remote.yaml"), factories)
+	require.NoError(t, err)
+
+	remoteCfg := &remote.Config{
+		Endpoint:       "appliance:22",
+		Username:       "monitor",
+		KeyFile:        "/etc/otelcol/id_ed25519",
+		KnownHostsFile: "/etc/otelcol/known_hosts",
+		Timeout:        5 * time.Second,
+	}
+	expectedConfig := factory.CreateDefaultConfig().(*Config)
+	expectedConfig.Remote = remoteCfg
+	expectedConfig.Scrapers = map[string]internal.Config{
+		cpuscraper.TypeStr:    (&cpuscraper.Factory{}).CreateDefaultConfig(),
+		memoryscraper.TypeStr: (&memoryscraper.Factory{}).CreateDefaultConfig(),
+	}
+	for _, scraperCfg := range expectedConfig.Scrapers {
+		scraperCfg.(internal.RemoteConfig).SetRemote(remoteCfg)
+	}
+
+	assert.Equal(t, expectedConfig, cfg.Receivers[config.NewComponentID(typeStr)])
+}
+
+func TestLoadInvalidConfig_RemoteUnsupportedScraper(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[typeStr] = factory
+	_, err = servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config-remote-unsupportedscraper.yaml"), factories)
+
+	require.EqualError(t, err, "receiver \"hostmetrics\" has invalid configuration: scraper \"disk\" does not support the remote mode")
+}
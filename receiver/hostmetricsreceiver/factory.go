@@ -26,6 +26,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filehandlesscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/loadscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
@@ -46,15 +47,16 @@ const (
 
 var (
 	scraperFactories = map[string]internal.ScraperFactory{
-		cpuscraper.TypeStr:        &cpuscraper.Factory{},
-		diskscraper.TypeStr:       &diskscraper.Factory{},
-		loadscraper.TypeStr:       &loadscraper.Factory{},
-		filesystemscraper.TypeStr: &filesystemscraper.Factory{},
-		memoryscraper.TypeStr:     &memoryscraper.Factory{},
-		networkscraper.TypeStr:    &networkscraper.Factory{},
-		pagingscraper.TypeStr:     &pagingscraper.Factory{},
-		processesscraper.TypeStr:  &processesscraper.Factory{},
-		processscraper.TypeStr:    &processscraper.Factory{},
+		cpuscraper.TypeStr:         &cpuscraper.Factory{},
+		diskscraper.TypeStr:        &diskscraper.Factory{},
+		filehandlesscraper.TypeStr: &filehandlesscraper.Factory{},
+		loadscraper.TypeStr:        &loadscraper.Factory{},
+		filesystemscraper.TypeStr:  &filesystemscraper.Factory{},
+		memoryscraper.TypeStr:      &memoryscraper.Factory{},
+		networkscraper.TypeStr:     &networkscraper.Factory{},
+		pagingscraper.TypeStr:      &pagingscraper.Factory{},
+		processesscraper.TypeStr:   &processesscraper.Factory{},
+		processscraper.TypeStr:     &processscraper.Factory{},
 	}
 )
 
@@ -36,6 +36,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filehandlesscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/loadscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
@@ -82,15 +83,16 @@ var systemSpecificMetrics = map[string][]string{
 }
 
 var factories = map[string]internal.ScraperFactory{
-	cpuscraper.TypeStr:        &cpuscraper.Factory{},
-	diskscraper.TypeStr:       &diskscraper.Factory{},
-	filesystemscraper.TypeStr: &filesystemscraper.Factory{},
-	loadscraper.TypeStr:       &loadscraper.Factory{},
-	memoryscraper.TypeStr:     &memoryscraper.Factory{},
-	networkscraper.TypeStr:    &networkscraper.Factory{},
-	pagingscraper.TypeStr:     &pagingscraper.Factory{},
-	processesscraper.TypeStr:  &processesscraper.Factory{},
-	processscraper.TypeStr:    &processscraper.Factory{},
+	cpuscraper.TypeStr:         &cpuscraper.Factory{},
+	diskscraper.TypeStr:        &diskscraper.Factory{},
+	filehandlesscraper.TypeStr: &filehandlesscraper.Factory{},
+	filesystemscraper.TypeStr:  &filesystemscraper.Factory{},
+	loadscraper.TypeStr:        &loadscraper.Factory{},
+	memoryscraper.TypeStr:      &memoryscraper.Factory{},
+	networkscraper.TypeStr:     &networkscraper.Factory{},
+	pagingscraper.TypeStr:      &pagingscraper.Factory{},
+	processesscraper.TypeStr:   &processesscraper.Factory{},
+	processscraper.TypeStr:     &processscraper.Factory{},
 }
 
 func TestGatherMetrics_EndToEnd(t *testing.T) {
@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/remote"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/shirou/gopsutil/v3/mem"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.9.0"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// Client reads the stats of a remote Linux host by running commands over SSH.
+// Its methods have the signatures of the gopsutil functions used by the scrapers, so that they can replace them.
+type Client struct {
+	host  string
+	run   func(cmd string) ([]byte, error)
+	close func() error
+}
+
+// Dial connects to the remote host. The connection is re-established when it breaks.
+func Dial(cfg *Config) (*Client, error) {
+	auth, err := authMethods(cfg)
+	if err != nil {
+		return nil, err
+	}
+	hostKeyCallback, err := hostKeyCallback(cfg)
+	if err != nil {
+		return nil, err
+	}
+	clientConfig := &ssh.ClientConfig{
+		User:            cfg.Username,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         cfg.Timeout,
+	}
+
+	c := newConn(func() (runner, error) {
+		sshClient, err := ssh.Dial("tcp", cfg.Endpoint, clientConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to %s: %w", cfg.Endpoint, err)
+		}
+		return &sshRunner{client: sshClient, timeout: cfg.Timeout}, nil
+	})
+	// The first connection is established right away, so that a wrong configuration fails the scraper start
+	if err = c.connect(); err != nil {
+		return nil, err
+	}
+
+	host, _, _ := net.SplitHostPort(cfg.Endpoint)
+	return &Client{
+		host:  host,
+		run:   c.run,
+		close: c.Close,
+	}, nil
+}
+
+func authMethods(cfg *Config) ([]ssh.AuthMethod, error) {
+	var auth []ssh.AuthMethod
+	if cfg.KeyFile != "" {
+		key, err := os.ReadFile(cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key file: %w", err)
+		}
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse key file: %w", err)
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if cfg.Password != "" {
+		auth = append(auth, ssh.Password(cfg.Password))
+	}
+	return auth, nil
+}
+
+func hostKeyCallback(cfg *Config) (ssh.HostKeyCallback, error) {
+	if cfg.InsecureIgnoreHostKey {
+		return ssh.InsecureIgnoreHostKey(), nil // #nosec
+	}
+	callback, err := knownhosts.New(cfg.KnownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read known hosts file: %w", err)
+	}
+	return callback, nil
+}
+
+// sshRunner runs the commands over an SSH connection
+type sshRunner struct {
+	client  *ssh.Client
+	timeout time.Duration
+}
+
+// output runs the command in a new session and returns its standard output
+func (r *sshRunner) output(cmd string) ([]byte, error) {
+	session, err := r.client.NewSession()
+	if err != nil {
+		return nil, &connectionError{err: err}
+	}
+	defer session.Close()
+
+	if r.timeout > 0 {
+		// closing the session interrupts the command
+		timer := time.AfterFunc(r.timeout, func() { _ = session.Close() })
+		defer timer.Stop()
+	}
+	out, err := session.Output(cmd)
+	var exitErr *ssh.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		// the command didn't exit, the connection may be broken
+		return nil, &connectionError{err: err}
+	}
+	return out, err
+}
+
+func (r *sshRunner) Close() error {
+	return r.client.Close()
+}
+
+// Close closes the connection to the remote host
+func (c *Client) Close() error {
+	return c.close()
+}
+
+// SetHostName sets the host.name resource attribute to the remote host.
+// It can be used as a ResourceMetricsOption of the metrics builders.
+func (c *Client) SetHostName(rm pmetric.ResourceMetrics) {
+	rm.Resource().Attributes().PutStr(conventions.AttributeHostName, c.host)
+}
+
+func (c *Client) readFile(path string) ([]byte, error) {
+	data, err := c.run("cat " + quote(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s on %s: %w", path, c.host, err)
+	}
+	return data, nil
+}
+
+// BootTime returns the boot time of the remote host in seconds since the epoch
+func (c *Client) BootTime() (uint64, error) {
+	data, err := c.readFile("/proc/stat")
+	if err != nil {
+		return 0, err
+	}
+	return parseBootTime(data)
+}
+
+// CPUTimes returns the CPU times of the remote host, either per CPU or in total
+func (c *Client) CPUTimes(perCPU bool) ([]cpu.TimesStat, error) {
+	data, err := c.readFile("/proc/stat")
+	if err != nil {
+		return nil, err
+	}
+	return parseCPUTimes(data, perCPU)
+}
+
+// VirtualMemory returns the memory usage of the remote host
+func (c *Client) VirtualMemory() (*mem.VirtualMemoryStat, error) {
+	data, err := c.readFile("/proc/meminfo")
+	if err != nil {
+		return nil, err
+	}
+	return parseMemInfo(data)
+}
+
+// Partitions returns the mounted partitions of the remote host.
+// Unless all is true, only the partitions of physical devices are returned.
+func (c *Client) Partitions(all bool) ([]disk.PartitionStat, error) {
+	mounts, err := c.readFile("/proc/mounts")
+	if err != nil {
+		return nil, err
+	}
+	var physical map[string]bool
+	if !all {
+		filesystems, err := c.readFile("/proc/filesystems")
+		if err != nil {
+			return nil, err
+		}
+		physical = parsePhysicalFilesystems(filesystems)
+	}
+	return parseMounts(mounts, physical)
+}
+
+// Usage returns the usage of the filesystem mounted at path on the remote host
+func (c *Client) Usage(path string) (*disk.UsageStat, error) {
+	data, err := c.run("stat -f -c '%b %f %a %S %c %d' " + quote(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat %s on %s: %w", path, c.host, err)
+	}
+	return parseStatFS(path, data)
+}
+
+// quote quotes s for a POSIX shell
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
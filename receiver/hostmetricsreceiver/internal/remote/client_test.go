@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const (
+	procStat = `cpu  4705 356 584 3699176 23060 0 277 0 0 0
+cpu0 1393 280 283 1846466 10921 0 171 0 0 0
+cpu1 3312 76 301 1852710 12139 0 106 0 0 0
+intr 114930548 113199788 3 0 5 263 0 4 [... lots more numbers ...]
+ctxt 1990473
+btime 1062191376
+processes 2915
+`
+	procMemInfo = `MemTotal:        1921988 kB
+MemFree:          200000 kB
+MemAvailable:    1000000 kB
+Buffers:          100000 kB
+Cached:           500000 kB
+SReclaimable:      50000 kB
+SUnreclaim:        20000 kB
+`
+	procMounts = `sysfs /sys sysfs rw,nosuid,nodev,noexec,relatime 0 0
+/dev/sda1 / ext4 rw,relatime 0 0
+/dev/sdb1 /mnt/my\040data xfs ro,relatime 0 0
+tmpfs /run tmpfs rw,nosuid,nodev 0 0
+`
+	procFilesystems = `nodev	sysfs
+nodev	tmpfs
+	ext4
+	xfs
+`
+)
+
+func newTestClient(outputs map[string]string) *Client {
+	return &Client{
+		host: "appliance",
+		run: func(cmd string) ([]byte, error) {
+			if out, ok := outputs[cmd]; ok {
+				return []byte(out), nil
+			}
+			return nil, errors.New("command not found")
+		},
+		close: func() error { return nil },
+	}
+}
+
+func TestClientCPU(t *testing.T) {
+	client := newTestClient(map[string]string{"cat '/proc/stat'": procStat})
+
+	bootTime, err := client.BootTime()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1062191376, bootTime)
+
+	times, err := client.CPUTimes(true)
+	require.NoError(t, err)
+	require.Len(t, times, 2)
+	assert.Equal(t, cpu.TimesStat{CPU: "cpu0", User: 13.93, Nice: 2.8, System: 2.83, Idle: 18464.66, Iowait: 109.21, Softirq: 1.71}, times[0])
+	assert.Equal(t, "cpu1", times[1].CPU)
+
+	times, err = client.CPUTimes(false)
+	require.NoError(t, err)
+	require.Len(t, times, 1)
+	assert.Equal(t, "cpu-total", times[0].CPU)
+	assert.Equal(t, 47.05, times[0].User)
+}
+
+func TestClientMemory(t *testing.T) {
+	client := newTestClient(map[string]string{"cat '/proc/meminfo'": procMemInfo})
+
+	memInfo, err := client.VirtualMemory()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1921988*1024, memInfo.Total)
+	assert.EqualValues(t, 200000*1024, memInfo.Free)
+	assert.EqualValues(t, 1000000*1024, memInfo.Available)
+	assert.EqualValues(t, 100000*1024, memInfo.Buffers)
+	assert.EqualValues(t, 550000*1024, memInfo.Cached)
+	assert.EqualValues(t, 50000*1024, memInfo.Sreclaimable)
+	assert.EqualValues(t, 20000*1024, memInfo.Sunreclaim)
+	assert.EqualValues(t, (1921988-200000-100000-550000)*1024, memInfo.Used)
+
+	_, err = newTestClient(map[string]string{"cat '/proc/meminfo'": "Buffers: 1 kB\n"}).VirtualMemory()
+	assert.Error(t, err)
+}
+
+func TestClientFilesystem(t *testing.T) {
+	client := newTestClient(map[string]string{
+		"cat '/proc/mounts'":                            procMounts,
+		"cat '/proc/filesystems'":                       procFilesystems,
+		"stat -f -c '%b %f %a %S %c %d' '/mnt/my data'": "1000 400 300 4096 200 50\n",
+	})
+
+	partitions, err := client.Partitions(false)
+	require.NoError(t, err)
+	assert.Equal(t, []disk.PartitionStat{
+		{Device: "/dev/sda1", Mountpoint: "/", Fstype: "ext4", Opts: []string{"rw", "relatime"}},
+		{Device: "/dev/sdb1", Mountpoint: "/mnt/my data", Fstype: "xfs", Opts: []string{"ro", "relatime"}},
+	}, partitions)
+
+	partitions, err = client.Partitions(true)
+	require.NoError(t, err)
+	assert.Len(t, partitions, 4)
+
+	usage, err := client.Usage("/mnt/my data")
+	require.NoError(t, err)
+	assert.Equal(t, &disk.UsageStat{
+		Path:              "/mnt/my data",
+		Total:             1000 * 4096,
+		Free:              300 * 4096,
+		Used:              600 * 4096,
+		UsedPercent:       600.0 / 900.0 * 100.0,
+		InodesTotal:       200,
+		InodesFree:        50,
+		InodesUsed:        150,
+		InodesUsedPercent: 75,
+	}, usage)
+
+	_, err = client.Usage("/")
+	assert.Error(t, err)
+}
+
+func TestClientSetHostName(t *testing.T) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	newTestClient(nil).SetHostName(rm)
+
+	hostName, ok := rm.Resource().Attributes().Get("host.name")
+	require.True(t, ok)
+	assert.Equal(t, "appliance", hostName.Str())
+}
+
+func TestQuote(t *testing.T) {
+	assert.Equal(t, `'/mnt/it'\''s'`, quote("/mnt/it's"))
+}
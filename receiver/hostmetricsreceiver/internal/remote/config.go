@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/remote"
+
+import (
+	"errors"
+	"net"
+	"time"
+)
+
+// Config defines how to connect to a remote host over SSH.
+type Config struct {
+	// Endpoint is the address of the SSH server of the remote host, in the host:port format.
+	Endpoint string `mapstructure:"endpoint"`
+	// Username is the user to authenticate as.
+	Username string `mapstructure:"username"`
+	// Password authenticates the user with a password.
+	Password string `mapstructure:"password"`
+	// KeyFile is the path to an unencrypted private key authenticating the user.
+	KeyFile string `mapstructure:"key_file"`
+	// KnownHostsFile is the path to a known_hosts file used to verify the key of the remote host.
+	KnownHostsFile string `mapstructure:"known_hosts_file"`
+	// InsecureIgnoreHostKey disables the verification of the key of the remote host.
+	InsecureIgnoreHostKey bool `mapstructure:"insecure_ignore_host_key"`
+	// Timeout bounds the time to connect to the remote host and to run each command. 0 means no timeout.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// Validate checks the remote host configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("remote endpoint must be specified")
+	}
+	if _, _, err := net.SplitHostPort(cfg.Endpoint); err != nil {
+		return errors.New("remote endpoint must be in the host:port format")
+	}
+	if cfg.Username == "" {
+		return errors.New("remote username must be specified")
+	}
+	if cfg.Password == "" && cfg.KeyFile == "" {
+		return errors.New("remote password or key_file must be specified")
+	}
+	if cfg.KnownHostsFile == "" && !cfg.InsecureIgnoreHostKey {
+		return errors.New("remote known_hosts_file must be specified unless insecure_ignore_host_key is enabled")
+	}
+	if cfg.Timeout < 0 {
+		return errors.New("remote timeout cannot be negative")
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	valid := func() *Config {
+		return &Config{Endpoint: "appliance:22", Username: "monitor", KeyFile: "id_ed25519", KnownHostsFile: "known_hosts"}
+	}
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+		err    string
+	}{
+		{name: "valid", modify: func(cfg *Config) {}},
+		{name: "missing endpoint", modify: func(cfg *Config) { cfg.Endpoint = "" }, err: "remote endpoint must be specified"},
+		{name: "endpoint without port", modify: func(cfg *Config) { cfg.Endpoint = "appliance" }, err: "remote endpoint must be in the host:port format"},
+		{name: "missing username", modify: func(cfg *Config) { cfg.Username = "" }, err: "remote username must be specified"},
+		{name: "missing credentials", modify: func(cfg *Config) { cfg.KeyFile = "" }, err: "remote password or key_file must be specified"},
+		{name: "password", modify: func(cfg *Config) { cfg.KeyFile, cfg.Password = "", "secret" }},
+		{name: "missing known hosts", modify: func(cfg *Config) { cfg.KnownHostsFile = "" }, err: "remote known_hosts_file must be specified unless insecure_ignore_host_key is enabled"},
+		{name: "insecure", modify: func(cfg *Config) { cfg.KnownHostsFile, cfg.InsecureIgnoreHostKey = "", true }},
+		{name: "negative timeout", modify: func(cfg *Config) { cfg.Timeout = -time.Second }, err: "remote timeout cannot be negative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid()
+			tt.modify(cfg)
+			err := cfg.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/remote"
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// initialRedialInterval is the time to wait before redialing after the first failed attempt
+	initialRedialInterval = time.Second
+	// maxRedialInterval bounds the time to wait between the attempts, which doubles after each failure
+	maxRedialInterval = time.Minute
+)
+
+// runner runs commands on the remote host
+type runner interface {
+	output(cmd string) ([]byte, error)
+	Close() error
+}
+
+// connectionError is returned by a runner whose connection to the remote host may be broken
+type connectionError struct {
+	err error
+}
+
+func (e *connectionError) Error() string {
+	return e.err.Error()
+}
+
+func (e *connectionError) Unwrap() error {
+	return e.err
+}
+
+// conn is a connection to the remote host, which is redialed when it breaks. The failed dials are
+// retried with an exponential backoff, the commands run in the meantime fail right away.
+type conn struct {
+	dial func() (runner, error)
+	now  func() time.Time
+
+	mu     sync.Mutex
+	runner runner
+	// failures is the number of dials which failed in a row
+	failures int
+	// nextDial is the time of the next attempt after a failed dial
+	nextDial time.Time
+	dialErr  error
+}
+
+func newConn(dial func() (runner, error)) *conn {
+	return &conn{dial: dial, now: time.Now}
+}
+
+// connect dials the remote host, unless the backoff of the previous failed attempt is still running.
+// The caller must hold the lock, or own the connection exclusively.
+func (c *conn) connect() error {
+	if c.failures > 0 && c.now().Before(c.nextDial) {
+		return fmt.Errorf("not connected, next attempt in %s: %w", c.nextDial.Sub(c.now()).Round(time.Second), c.dialErr)
+	}
+	r, err := c.dial()
+	if err != nil {
+		interval := maxRedialInterval
+		if c.failures < 6 {
+			interval = initialRedialInterval << c.failures
+		}
+		c.failures++
+		c.nextDial = c.now().Add(interval)
+		c.dialErr = err
+		return err
+	}
+	c.runner = r
+	c.failures = 0
+	c.dialErr = nil
+	return nil
+}
+
+// run runs the command, reconnecting first if the connection is broken. A command failing because
+// of the connection is retried once on a new connection.
+func (c *conn) run(cmd string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for attempt := 0; ; attempt++ {
+		if c.runner == nil {
+			if err := c.connect(); err != nil {
+				return nil, err
+			}
+		}
+		out, err := c.runner.output(cmd)
+		var connErr *connectionError
+		if !errors.As(err, &connErr) {
+			return out, err
+		}
+		_ = c.runner.Close()
+		c.runner = nil
+		if attempt > 0 {
+			return nil, err
+		}
+	}
+}
+
+// Close closes the connection, it isn't redialed afterwards
+func (c *conn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.dial = func() (runner, error) {
+		return nil, errors.New("connection closed")
+	}
+	if c.runner == nil {
+		return nil
+	}
+	err := c.runner.Close()
+	c.runner = nil
+	return err
+}
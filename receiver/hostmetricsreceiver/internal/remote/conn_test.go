@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRunner answers the commands until it is broken
+type fakeRunner struct {
+	broken bool
+	closed bool
+}
+
+func (r *fakeRunner) output(cmd string) ([]byte, error) {
+	if r.broken {
+		return nil, &connectionError{err: errors.New("EOF")}
+	}
+	if cmd == "false" {
+		return nil, errors.New("exit status 1")
+	}
+	return []byte(cmd), nil
+}
+
+func (r *fakeRunner) Close() error {
+	r.closed = true
+	return nil
+}
+
+// fakeDialer returns new runners, or fails while err is set
+type fakeDialer struct {
+	runners []*fakeRunner
+	err     error
+}
+
+func (d *fakeDialer) dial() (runner, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	r := &fakeRunner{}
+	d.runners = append(d.runners, r)
+	return r, nil
+}
+
+func newTestConn(d *fakeDialer, now *time.Time) *conn {
+	c := newConn(d.dial)
+	c.now = func() time.Time { return *now }
+	return c
+}
+
+func TestConnRedialsBrokenConnection(t *testing.T) {
+	d := &fakeDialer{}
+	now := time.Unix(0, 0)
+	c := newTestConn(d, &now)
+	require.NoError(t, c.connect())
+
+	out, err := c.run("echo")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("echo"), out)
+
+	// A failing command doesn't break the connection
+	_, err = c.run("false")
+	assert.EqualError(t, err, "exit status 1")
+	assert.Len(t, d.runners, 1)
+
+	// The command is retried on a new connection
+	d.runners[0].broken = true
+	out, err = c.run("echo")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("echo"), out)
+	require.Len(t, d.runners, 2)
+	assert.True(t, d.runners[0].closed)
+
+	require.NoError(t, c.Close())
+	assert.True(t, d.runners[1].closed)
+	_, err = c.run("echo")
+	assert.Error(t, err)
+}
+
+func TestConnRedialBackoff(t *testing.T) {
+	d := &fakeDialer{}
+	now := time.Unix(0, 0)
+	c := newTestConn(d, &now)
+	require.NoError(t, c.connect())
+
+	d.runners[0].broken = true
+	d.err = errors.New("connection refused")
+	_, err := c.run("echo")
+	assert.EqualError(t, err, "connection refused")
+
+	// No attempt is made until the backoff expires
+	d.err = nil
+	_, err = c.run("echo")
+	assert.EqualError(t, err, "not connected, next attempt in 1s: connection refused")
+	assert.Len(t, d.runners, 1)
+
+	// The backoff doubles after each failed attempt
+	d.err = errors.New("connection refused")
+	now = now.Add(time.Second)
+	_, err = c.run("echo")
+	assert.EqualError(t, err, "connection refused")
+	now = now.Add(time.Second)
+	_, err = c.run("echo")
+	assert.EqualError(t, err, "not connected, next attempt in 1s: connection refused")
+
+	d.err = nil
+	now = now.Add(time.Second)
+	out, err := c.run("echo")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("echo"), out)
+	assert.Equal(t, 0, c.failures)
+}
+
+func TestConnRedialBackoffIsBounded(t *testing.T) {
+	d := &fakeDialer{err: errors.New("connection refused")}
+	now := time.Unix(0, 0)
+	c := newTestConn(d, &now)
+
+	var interval time.Duration
+	for i := 0; i < 10; i++ {
+		assert.Error(t, c.connect())
+		interval = c.nextDial.Sub(now)
+		assert.LessOrEqual(t, interval, maxRedialInterval)
+		now = c.nextDial
+	}
+	assert.Equal(t, maxRedialInterval, interval)
+}
@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/remote"
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/shirou/gopsutil/v3/mem"
+)
+
+// clockTicks is the number of clock ticks per second of the CPU times in /proc/stat (USER_HZ),
+// which is 100 on all the architectures supported by Linux.
+const clockTicks = 100
+
+// parseBootTime parses the boot time from the content of /proc/stat
+func parseBootTime(data []byte) (uint64, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "btime" {
+			return strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+	return 0, fmt.Errorf("boot time not found in /proc/stat")
+}
+
+// parseCPUTimes parses the CPU times from the content of /proc/stat, in the same way as gopsutil
+func parseCPUTimes(data []byte, perCPU bool) ([]cpu.TimesStat, error) {
+	var times []cpu.TimesStat
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || !strings.HasPrefix(fields[0], "cpu") {
+			continue
+		}
+		name := fields[0]
+		if name == "cpu" {
+			if perCPU {
+				continue
+			}
+			name = "cpu-total"
+		} else if !perCPU {
+			continue
+		}
+		// user nice system idle [iowait irq softirq steal guest guest_nice]
+		if len(fields) < 5 {
+			return nil, fmt.Errorf("invalid /proc/stat line for %s", fields[0])
+		}
+
+		values := make([]float64, 10)
+		for i, field := range fields[1:] {
+			if i == len(values) {
+				break
+			}
+			value, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid /proc/stat line for %s: %w", fields[0], err)
+			}
+			values[i] = value / clockTicks
+		}
+		times = append(times, cpu.TimesStat{
+			CPU:       name,
+			User:      values[0],
+			Nice:      values[1],
+			System:    values[2],
+			Idle:      values[3],
+			Iowait:    values[4],
+			Irq:       values[5],
+			Softirq:   values[6],
+			Steal:     values[7],
+			Guest:     values[8],
+			GuestNice: values[9],
+		})
+	}
+	if len(times) == 0 {
+		return nil, fmt.Errorf("cpu times not found in /proc/stat")
+	}
+	return times, nil
+}
+
+// parseMemInfo parses the memory usage from the content of /proc/meminfo, in the same way as gopsutil
+func parseMemInfo(data []byte) (*mem.VirtualMemoryStat, error) {
+	stat := &mem.VirtualMemoryStat{}
+	hasAvailable := false
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		// MemTotal:       16310588 kB
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid /proc/meminfo line for %s: %w", fields[0], err)
+		}
+		value *= 1024
+
+		switch strings.TrimSuffix(fields[0], ":") {
+		case "MemTotal":
+			stat.Total = value
+		case "MemFree":
+			stat.Free = value
+		case "MemAvailable":
+			stat.Available = value
+			hasAvailable = true
+		case "Buffers":
+			stat.Buffers = value
+		case "Cached":
+			stat.Cached = value
+		case "Active":
+			stat.Active = value
+		case "Inactive":
+			stat.Inactive = value
+		case "Slab":
+			stat.Slab = value
+		case "SReclaimable":
+			stat.Sreclaimable = value
+		case "SUnreclaim":
+			stat.Sunreclaim = value
+		case "SwapTotal":
+			stat.SwapTotal = value
+		case "SwapFree":
+			stat.SwapFree = value
+		}
+	}
+	if stat.Total == 0 {
+		return nil, fmt.Errorf("total memory not found in /proc/meminfo")
+	}
+
+	stat.Cached += stat.Sreclaimable
+	if !hasAvailable {
+		stat.Available = stat.Free + stat.Buffers + stat.Cached
+	}
+	stat.Used = stat.Total - stat.Free - stat.Buffers - stat.Cached
+	stat.UsedPercent = float64(stat.Used) / float64(stat.Total) * 100.0
+	return stat, nil
+}
+
+// parsePhysicalFilesystems returns the types of the filesystems backed by a device from the content of /proc/filesystems
+func parsePhysicalFilesystems(data []byte) map[string]bool {
+	// zfs is not backed by a device, but is reported as physical by gopsutil
+	filesystems := map[string]bool{"zfs": true}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 1 {
+			filesystems[fields[0]] = true
+		}
+	}
+	return filesystems
+}
+
+// parseMounts parses the partitions from the content of /proc/mounts.
+// If physical is not nil, only the partitions with one of its filesystem types are returned.
+func parseMounts(data []byte, physical map[string]bool) ([]disk.PartitionStat, error) {
+	var partitions []disk.PartitionStat
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		// /dev/sda1 /boot ext4 rw,relatime 0 0
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 {
+			continue
+		}
+		partition := disk.PartitionStat{
+			Device:     unescapeMountField(fields[0]),
+			Mountpoint: unescapeMountField(fields[1]),
+			Fstype:     fields[2],
+			Opts:       strings.Split(fields[3], ","),
+		}
+		if physical != nil && (partition.Device == "none" || !physical[partition.Fstype]) {
+			continue
+		}
+		partitions = append(partitions, partition)
+	}
+	return partitions, scanner.Err()
+}
+
+// unescapeMountField replaces the octal escapes of the spaces, tabs, newlines and backslashes of /proc/mounts
+func unescapeMountField(field string) string {
+	if !strings.Contains(field, `\`) {
+		return field
+	}
+	var b strings.Builder
+	for i := 0; i < len(field); i++ {
+		if field[i] == '\\' && i+3 < len(field) {
+			if value, err := strconv.ParseUint(field[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(value))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(field[i])
+	}
+	return b.String()
+}
+
+// parseStatFS parses the usage of a filesystem from the output of stat -f -c '%b %f %a %S %c %d',
+// in the same way as gopsutil
+func parseStatFS(path string, data []byte) (*disk.UsageStat, error) {
+	fields := strings.Fields(string(data))
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("unexpected output of stat for %s: %q", path, data)
+	}
+	values := make([]uint64, len(fields))
+	for i, field := range fields {
+		value, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected output of stat for %s: %w", path, err)
+		}
+		values[i] = value
+	}
+	blocks, freeBlocks, availableBlocks, blockSize, inodes, freeInodes := values[0], values[1], values[2], values[3], values[4], values[5]
+
+	usage := &disk.UsageStat{
+		Path:        path,
+		Total:       blocks * blockSize,
+		Free:        availableBlocks * blockSize,
+		Used:        (blocks - freeBlocks) * blockSize,
+		InodesTotal: inodes,
+		InodesFree:  freeInodes,
+		InodesUsed:  inodes - freeInodes,
+	}
+	if usage.Used+usage.Free > 0 {
+		usage.UsedPercent = float64(usage.Used) / float64(usage.Used+usage.Free) * 100.0
+	}
+	if usage.InodesTotal > 0 {
+		usage.InodesUsedPercent = float64(usage.InodesUsed) / float64(usage.InodesTotal) * 100.0
+	}
+	return usage, nil
+}
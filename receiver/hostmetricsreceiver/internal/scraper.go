@@ -19,6 +19,8 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/remote"
 )
 
 // ScraperFactory can create a MetricScraper.
@@ -34,3 +36,9 @@ type ScraperFactory interface {
 // Config is the configuration of a scraper.
 type Config interface {
 }
+
+// RemoteConfig is implemented by the configuration of the scrapers that can scrape a remote host.
+type RemoteConfig interface {
+	// SetRemote sets the remote host to scrape.
+	SetRemote(remote *remote.Config)
+}
@@ -25,6 +25,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/remote"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper/ucal"
 )
@@ -38,6 +39,10 @@ type scraper struct {
 	mb       *metadata.MetricsBuilder
 	ucal     *ucal.CPUUtilizationCalculator
 
+	// client reads the stats of the remote host, if any
+	client      *remote.Client
+	emitOptions []metadata.ResourceMetricsOption
+
 	// for mocking
 	bootTime func() (uint64, error)
 	times    func(bool) ([]cpu.TimesStat, error)
@@ -50,6 +55,17 @@ func newCPUScraper(_ context.Context, settings component.ReceiverCreateSettings,
 }
 
 func (s *scraper) start(context.Context, component.Host) error {
+	if s.config.remote != nil {
+		client, err := remote.Dial(s.config.remote)
+		if err != nil {
+			return err
+		}
+		s.client = client
+		s.bootTime = client.BootTime
+		s.times = client.CPUTimes
+		s.emitOptions = append(s.emitOptions, client.SetHostName)
+	}
+
 	bootTime, err := s.bootTime()
 	if err != nil {
 		return err
@@ -58,6 +74,13 @@ func (s *scraper) start(context.Context, component.Host) error {
 	return nil
 }
 
+func (s *scraper) shutdown(context.Context) error {
+	if s.client != nil {
+		return s.client.Close()
+	}
+	return nil
+}
+
 func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 	now := pcommon.NewTimestampFromTime(s.now())
 	cpuTimes, err := s.times( /*percpu=*/ true)
@@ -74,5 +97,5 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
 	}
 
-	return s.mb.Emit(), nil
+	return s.mb.Emit(s.emitOptions...), nil
 }
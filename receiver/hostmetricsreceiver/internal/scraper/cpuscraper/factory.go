@@ -54,5 +54,6 @@ func (f *Factory) CreateMetricsScraper(
 		TypeStr,
 		s.scrape,
 		scraperhelper.WithStart(s.start),
+		scraperhelper.WithShutdown(s.shutdown),
 	)
 }
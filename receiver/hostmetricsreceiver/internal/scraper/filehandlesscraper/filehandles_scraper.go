@@ -0,0 +1,215 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filehandlesscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filehandlesscraper"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filehandlesscraper/internal/metadata"
+)
+
+const (
+	fileHandlesMetricsLen   = 2
+	inotifyLimitsMetricsLen = 2
+	inotifyUsageMetricsLen  = 2
+
+	// inotifyLink is the target of the links of the inotify file descriptors in /proc/<pid>/fd.
+	inotifyLink = "anon_inode:inotify"
+)
+
+// scraper for File Handles Metrics
+type scraper struct {
+	settings component.ReceiverCreateSettings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+
+	// procPath is the mount point of the proc filesystem, overridden in tests
+	procPath string
+}
+
+type inotifyUsage struct {
+	instances int64
+	watches   int64
+}
+
+// newFileHandlesScraper creates a File Handles Scraper
+func newFileHandlesScraper(settings component.ReceiverCreateSettings, cfg *Config) *scraper {
+	// Honor HOST_PROC like gopsutil, which is used by the other scrapers.
+	procPath := os.Getenv("HOST_PROC")
+	if procPath == "" {
+		procPath = "/proc"
+	}
+	return &scraper{settings: settings, config: cfg, procPath: procPath}
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	bootTime, err := host.BootTime()
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, s.settings.BuildInfo, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	var errors scrapererror.ScrapeErrors
+
+	allocated, limit, err := s.getFileHandles()
+	if err != nil {
+		errors.AddPartial(fileHandlesMetricsLen, err)
+	} else {
+		s.mb.RecordSystemFileHandlesAllocatedDataPoint(now, allocated)
+		s.mb.RecordSystemFileHandlesLimitDataPoint(now, limit)
+	}
+
+	if err = s.recordInotifyLimits(now); err != nil {
+		errors.AddPartial(inotifyLimitsMetricsLen, err)
+	}
+
+	// Counting the inotify resources requires to go through the file descriptors of every process,
+	// so it is skipped if none of the metrics is enabled.
+	if s.config.Metrics.SystemInotifyInstancesCount.Enabled || s.config.Metrics.SystemInotifyWatchesCount.Enabled {
+		var usage inotifyUsage
+		if usage, err = s.getInotifyUsage(); err != nil {
+			errors.AddPartial(inotifyUsageMetricsLen, err)
+		} else {
+			s.mb.RecordSystemInotifyInstancesCountDataPoint(now, usage.instances)
+			s.mb.RecordSystemInotifyWatchesCountDataPoint(now, usage.watches)
+		}
+	}
+
+	return s.mb.Emit(), errors.Combine()
+}
+
+// getFileHandles returns the number of allocated file handles and their limit from /proc/sys/fs/file-nr.
+func (s *scraper) getFileHandles() (allocated int64, limit int64, err error) {
+	contents, err := os.ReadFile(filepath.Join(s.procPath, "sys", "fs", "file-nr"))
+	if err != nil {
+		return 0, 0, err
+	}
+	// The file holds the number of allocated file handles, the number of allocated but unused
+	// file handles (always 0 since Linux 2.6), and the maximum number of file handles.
+	fields := strings.Fields(string(contents))
+	if len(fields) != 3 {
+		return 0, 0, fmt.Errorf("unexpected format of file-nr: %q", contents)
+	}
+	if allocated, err = strconv.ParseInt(fields[0], 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse allocated file handles: %w", err)
+	}
+	if limit, err = strconv.ParseInt(fields[2], 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse maximum file handles: %w", err)
+	}
+	return allocated, limit, nil
+}
+
+func (s *scraper) recordInotifyLimits(now pcommon.Timestamp) error {
+	instancesLimit, err := s.readSysctl("fs", "inotify", "max_user_instances")
+	if err != nil {
+		return err
+	}
+	watchesLimit, err := s.readSysctl("fs", "inotify", "max_user_watches")
+	if err != nil {
+		return err
+	}
+	s.mb.RecordSystemInotifyInstancesLimitDataPoint(now, instancesLimit)
+	s.mb.RecordSystemInotifyWatchesLimitDataPoint(now, watchesLimit)
+	return nil
+}
+
+// readSysctl reads a kernel parameter holding a single integer in /proc/sys.
+func (s *scraper) readSysctl(name ...string) (int64, error) {
+	path := filepath.Join(append([]string{s.procPath, "sys"}, name...)...)
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	value, err := strconv.ParseInt(strings.TrimSpace(string(contents)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return value, nil
+}
+
+// getInotifyUsage counts the inotify instances and watches of all the processes.
+// The processes which can't be inspected, e.g. because they exited or belong to another
+// user without the required permissions, are skipped.
+func (s *scraper) getInotifyUsage() (inotifyUsage, error) {
+	var usage inotifyUsage
+	entries, err := os.ReadDir(s.procPath)
+	if err != nil {
+		return usage, err
+	}
+	for _, entry := range entries {
+		if _, err = strconv.Atoi(entry.Name()); err != nil || !entry.IsDir() {
+			continue
+		}
+		processUsage := getProcessInotifyUsage(filepath.Join(s.procPath, entry.Name()))
+		usage.instances += processUsage.instances
+		usage.watches += processUsage.watches
+	}
+	return usage, nil
+}
+
+// getProcessInotifyUsage counts the inotify instances and watches of the process of a /proc/<pid> directory.
+func getProcessInotifyUsage(pidPath string) inotifyUsage {
+	var usage inotifyUsage
+	fds, err := os.ReadDir(filepath.Join(pidPath, "fd"))
+	if err != nil {
+		return usage
+	}
+	for _, fd := range fds {
+		var link string
+		link, err = os.Readlink(filepath.Join(pidPath, "fd", fd.Name()))
+		if err != nil || link != inotifyLink {
+			continue
+		}
+		usage.instances++
+		usage.watches += countInotifyWatches(filepath.Join(pidPath, "fdinfo", fd.Name()))
+	}
+	return usage
+}
+
+// countInotifyWatches counts the watches listed in the fdinfo file of an inotify file descriptor.
+func countInotifyWatches(path string) int64 {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return 0
+	}
+	var watches int64
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for scanner.Scan() {
+		if strings.HasPrefix(scanner.Text(), "inotify wd:") {
+			watches++
+		}
+	}
+	return watches
+}
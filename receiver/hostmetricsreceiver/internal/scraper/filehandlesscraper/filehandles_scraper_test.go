@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filehandlesscraper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filehandlesscraper/internal/metadata"
+)
+
+// createProcFS creates a fake proc filesystem holding the kernel parameters and two processes,
+// the first one with two inotify instances and the second one with no inotify instance.
+func createProcFS(t *testing.T) string {
+	procPath := t.TempDir()
+	writeFile(t, filepath.Join(procPath, "sys", "fs", "file-nr"), "10240\t0\t9223372036854775807\n")
+	writeFile(t, filepath.Join(procPath, "sys", "fs", "inotify", "max_user_instances"), "128\n")
+	writeFile(t, filepath.Join(procPath, "sys", "fs", "inotify", "max_user_watches"), "8192\n")
+
+	createFD(t, procPath, "1", "3", inotifyLink, "inotify wd:1 ino:2 sdev:3 mask:fc6 ignored_mask:0\ninotify wd:2 ino:3 sdev:3 mask:fc6 ignored_mask:0\n")
+	createFD(t, procPath, "1", "4", inotifyLink, "inotify wd:1 ino:4 sdev:3 mask:fc6 ignored_mask:0\n")
+	createFD(t, procPath, "1", "5", "/var/log/syslog", "pos:0\nflags:0100000\n")
+	createFD(t, procPath, "42", "0", "/dev/null", "pos:0\nflags:0100002\n")
+	require.NoError(t, os.MkdirAll(filepath.Join(procPath, "self"), 0700))
+	return procPath
+}
+
+func createFD(t *testing.T, procPath string, pid string, fd string, target string, fdinfo string) {
+	require.NoError(t, os.MkdirAll(filepath.Join(procPath, pid, "fd"), 0700))
+	require.NoError(t, os.Symlink(target, filepath.Join(procPath, pid, "fd", fd)))
+	writeFile(t, filepath.Join(procPath, pid, "fdinfo", fd), fdinfo)
+}
+
+func writeFile(t *testing.T, path string, contents string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+}
+
+func TestScrape(t *testing.T) {
+	type testCase struct {
+		name            string
+		metricsSettings func(*metadata.MetricsSettings)
+		removeFiles     []string
+		expectedMetrics map[string]int64
+		expectedErr     string
+	}
+
+	testCases := []testCase{
+		{
+			name: "Standard",
+			expectedMetrics: map[string]int64{
+				"system.file_handles.allocated":  10240,
+				"system.file_handles.limit":      9223372036854775807,
+				"system.inotify.instances.count": 2,
+				"system.inotify.instances.limit": 128,
+				"system.inotify.watches.count":   3,
+				"system.inotify.watches.limit":   8192,
+			},
+		},
+		{
+			name: "Usage disabled",
+			metricsSettings: func(settings *metadata.MetricsSettings) {
+				settings.SystemInotifyInstancesCount.Enabled = false
+				settings.SystemInotifyWatchesCount.Enabled = false
+			},
+			expectedMetrics: map[string]int64{
+				"system.file_handles.allocated":  10240,
+				"system.file_handles.limit":      9223372036854775807,
+				"system.inotify.instances.limit": 128,
+				"system.inotify.watches.limit":   8192,
+			},
+		},
+		{
+			name:        "Missing file-nr",
+			removeFiles: []string{"sys/fs/file-nr"},
+			expectedMetrics: map[string]int64{
+				"system.inotify.instances.count": 2,
+				"system.inotify.instances.limit": 128,
+				"system.inotify.watches.count":   3,
+				"system.inotify.watches.limit":   8192,
+			},
+			expectedErr: "file-nr: no such file or directory",
+		},
+		{
+			name:        "Missing inotify limit",
+			removeFiles: []string{"sys/fs/inotify/max_user_watches"},
+			expectedMetrics: map[string]int64{
+				"system.file_handles.allocated":  10240,
+				"system.file_handles.limit":      9223372036854775807,
+				"system.inotify.instances.count": 2,
+				"system.inotify.watches.count":   3,
+			},
+			expectedErr: "max_user_watches: no such file or directory",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			settings := metadata.DefaultMetricsSettings()
+			if test.metricsSettings != nil {
+				test.metricsSettings(&settings)
+			}
+			scraper := newFileHandlesScraper(componenttest.NewNopReceiverCreateSettings(), &Config{Metrics: settings})
+			scraper.procPath = createProcFS(t)
+			for _, file := range test.removeFiles {
+				require.NoError(t, os.Remove(filepath.Join(scraper.procPath, file)))
+			}
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			md, err := scraper.scrape(context.Background())
+			if test.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+				assert.True(t, scrapererror.IsPartialScrapeError(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			metrics := map[string]int64{}
+			if md.ResourceMetrics().Len() > 0 {
+				ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+				for i := 0; i < ms.Len(); i++ {
+					metric := ms.At(i)
+					assert.Equal(t, pmetric.MetricTypeSum, metric.Type())
+					require.Equal(t, 1, metric.Sum().DataPoints().Len())
+					metrics[metric.Name()] = metric.Sum().DataPoints().At(0).IntValue()
+				}
+			}
+			assert.Equal(t, test.expectedMetrics, metrics)
+		})
+	}
+}
+
+func TestGetFileHandlesInvalidFormat(t *testing.T) {
+	scraper := newFileHandlesScraper(componenttest.NewNopReceiverCreateSettings(), &Config{Metrics: metadata.DefaultMetricsSettings()})
+	scraper.procPath = t.TempDir()
+	writeFile(t, filepath.Join(scraper.procPath, "sys", "fs", "file-nr"), "10240\n")
+
+	_, _, err := scraper.getFileHandles()
+	assert.EqualError(t, err, `unexpected format of file-nr: "10240\n"`)
+}
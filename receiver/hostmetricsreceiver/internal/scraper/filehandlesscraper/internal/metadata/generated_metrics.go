@@ -0,0 +1,510 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.9.0"
+)
+
+// MetricSettings provides common settings for a particular metric.
+type MetricSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// MetricsSettings provides settings for hostmetricsreceiver/filehandles metrics.
+type MetricsSettings struct {
+	SystemFileHandlesAllocated  MetricSettings `mapstructure:"system.file_handles.allocated"`
+	SystemFileHandlesLimit      MetricSettings `mapstructure:"system.file_handles.limit"`
+	SystemInotifyInstancesCount MetricSettings `mapstructure:"system.inotify.instances.count"`
+	SystemInotifyInstancesLimit MetricSettings `mapstructure:"system.inotify.instances.limit"`
+	SystemInotifyWatchesCount   MetricSettings `mapstructure:"system.inotify.watches.count"`
+	SystemInotifyWatchesLimit   MetricSettings `mapstructure:"system.inotify.watches.limit"`
+}
+
+func DefaultMetricsSettings() MetricsSettings {
+	return MetricsSettings{
+		SystemFileHandlesAllocated: MetricSettings{
+			Enabled: true,
+		},
+		SystemFileHandlesLimit: MetricSettings{
+			Enabled: true,
+		},
+		SystemInotifyInstancesCount: MetricSettings{
+			Enabled: true,
+		},
+		SystemInotifyInstancesLimit: MetricSettings{
+			Enabled: true,
+		},
+		SystemInotifyWatchesCount: MetricSettings{
+			Enabled: true,
+		},
+		SystemInotifyWatchesLimit: MetricSettings{
+			Enabled: true,
+		},
+	}
+}
+
+type metricSystemFileHandlesAllocated struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.file_handles.allocated metric with initial data.
+func (m *metricSystemFileHandlesAllocated) init() {
+	m.data.SetName("system.file_handles.allocated")
+	m.data.SetDescription("Number of file handles allocated by the kernel.")
+	m.data.SetUnit("{handles}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemFileHandlesAllocated) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemFileHandlesAllocated) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemFileHandlesAllocated) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemFileHandlesAllocated(settings MetricSettings) metricSystemFileHandlesAllocated {
+	m := metricSystemFileHandlesAllocated{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemFileHandlesLimit struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.file_handles.limit metric with initial data.
+func (m *metricSystemFileHandlesLimit) init() {
+	m.data.SetName("system.file_handles.limit")
+	m.data.SetDescription("Maximum number of file handles the kernel can allocate.")
+	m.data.SetUnit("{handles}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemFileHandlesLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemFileHandlesLimit) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemFileHandlesLimit) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemFileHandlesLimit(settings MetricSettings) metricSystemFileHandlesLimit {
+	m := metricSystemFileHandlesLimit{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemInotifyInstancesCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.inotify.instances.count metric with initial data.
+func (m *metricSystemInotifyInstancesCount) init() {
+	m.data.SetName("system.inotify.instances.count")
+	m.data.SetDescription("Number of inotify instances opened by the processes.")
+	m.data.SetUnit("{instances}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemInotifyInstancesCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemInotifyInstancesCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemInotifyInstancesCount) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemInotifyInstancesCount(settings MetricSettings) metricSystemInotifyInstancesCount {
+	m := metricSystemInotifyInstancesCount{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemInotifyInstancesLimit struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.inotify.instances.limit metric with initial data.
+func (m *metricSystemInotifyInstancesLimit) init() {
+	m.data.SetName("system.inotify.instances.limit")
+	m.data.SetDescription("Maximum number of inotify instances a user can open.")
+	m.data.SetUnit("{instances}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemInotifyInstancesLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemInotifyInstancesLimit) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemInotifyInstancesLimit) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemInotifyInstancesLimit(settings MetricSettings) metricSystemInotifyInstancesLimit {
+	m := metricSystemInotifyInstancesLimit{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemInotifyWatchesCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.inotify.watches.count metric with initial data.
+func (m *metricSystemInotifyWatchesCount) init() {
+	m.data.SetName("system.inotify.watches.count")
+	m.data.SetDescription("Number of inotify watches added by the processes.")
+	m.data.SetUnit("{watches}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemInotifyWatchesCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemInotifyWatchesCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemInotifyWatchesCount) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemInotifyWatchesCount(settings MetricSettings) metricSystemInotifyWatchesCount {
+	m := metricSystemInotifyWatchesCount{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemInotifyWatchesLimit struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.inotify.watches.limit metric with initial data.
+func (m *metricSystemInotifyWatchesLimit) init() {
+	m.data.SetName("system.inotify.watches.limit")
+	m.data.SetDescription("Maximum number of inotify watches a user can add.")
+	m.data.SetUnit("{watches}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSystemInotifyWatchesLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemInotifyWatchesLimit) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemInotifyWatchesLimit) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemInotifyWatchesLimit(settings MetricSettings) metricSystemInotifyWatchesLimit {
+	m := metricSystemInotifyWatchesLimit{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+// MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
+// required to produce metric representation defined in metadata and user settings.
+type MetricsBuilder struct {
+	startTime                         pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                   int                 // maximum observed number of metrics per resource.
+	resourceCapacity                  int                 // maximum observed number of resource attributes.
+	metricsBuffer                     pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                         component.BuildInfo // contains version information
+	metricSystemFileHandlesAllocated  metricSystemFileHandlesAllocated
+	metricSystemFileHandlesLimit      metricSystemFileHandlesLimit
+	metricSystemInotifyInstancesCount metricSystemInotifyInstancesCount
+	metricSystemInotifyInstancesLimit metricSystemInotifyInstancesLimit
+	metricSystemInotifyWatchesCount   metricSystemInotifyWatchesCount
+	metricSystemInotifyWatchesLimit   metricSystemInotifyWatchesLimit
+}
+
+// metricBuilderOption applies changes to default metrics builder.
+type metricBuilderOption func(*MetricsBuilder)
+
+// WithStartTime sets startTime on the metrics builder.
+func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
+	return func(mb *MetricsBuilder) {
+		mb.startTime = startTime
+	}
+}
+
+func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo, options ...metricBuilderOption) *MetricsBuilder {
+	mb := &MetricsBuilder{
+		startTime:                         pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                     pmetric.NewMetrics(),
+		buildInfo:                         buildInfo,
+		metricSystemFileHandlesAllocated:  newMetricSystemFileHandlesAllocated(settings.SystemFileHandlesAllocated),
+		metricSystemFileHandlesLimit:      newMetricSystemFileHandlesLimit(settings.SystemFileHandlesLimit),
+		metricSystemInotifyInstancesCount: newMetricSystemInotifyInstancesCount(settings.SystemInotifyInstancesCount),
+		metricSystemInotifyInstancesLimit: newMetricSystemInotifyInstancesLimit(settings.SystemInotifyInstancesLimit),
+		metricSystemInotifyWatchesCount:   newMetricSystemInotifyWatchesCount(settings.SystemInotifyWatchesCount),
+		metricSystemInotifyWatchesLimit:   newMetricSystemInotifyWatchesLimit(settings.SystemInotifyWatchesLimit),
+	}
+	for _, op := range options {
+		op(mb)
+	}
+	return mb
+}
+
+// updateCapacity updates max length of metrics and resource attributes that will be used for the slice capacity.
+func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
+	if mb.metricsCapacity < rm.ScopeMetrics().At(0).Metrics().Len() {
+		mb.metricsCapacity = rm.ScopeMetrics().At(0).Metrics().Len()
+	}
+	if mb.resourceCapacity < rm.Resource().Attributes().Len() {
+		mb.resourceCapacity = rm.Resource().Attributes().Len()
+	}
+}
+
+// ResourceMetricsOption applies changes to provided resource metrics.
+type ResourceMetricsOption func(pmetric.ResourceMetrics)
+
+// WithStartTimeOverride overrides start time for all the resource metrics data points.
+// This option should be only used if different start time has to be set on metrics coming from different resources.
+func WithStartTimeOverride(start pcommon.Timestamp) ResourceMetricsOption {
+	return func(rm pmetric.ResourceMetrics) {
+		var dps pmetric.NumberDataPointSlice
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		for i := 0; i < metrics.Len(); i++ {
+			switch metrics.At(i).Type() {
+			case pmetric.MetricTypeGauge:
+				dps = metrics.At(i).Gauge().DataPoints()
+			case pmetric.MetricTypeSum:
+				dps = metrics.At(i).Sum().DataPoints()
+			}
+			for j := 0; j < dps.Len(); j++ {
+				dps.At(j).SetStartTimestamp(start)
+			}
+		}
+	}
+}
+
+// EmitForResource saves all the generated metrics under a new resource and updates the internal state to be ready for
+// recording another set of data points as part of another resource. This function can be helpful when one scraper
+// needs to emit metrics from several resources. Otherwise calling this function is not required,
+// just `Emit` function can be called instead.
+// Resource attributes should be provided as ResourceMetricsOption arguments.
+func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
+	rm := pmetric.NewResourceMetrics()
+	rm.SetSchemaUrl(conventions.SchemaURL)
+	rm.Resource().Attributes().EnsureCapacity(mb.resourceCapacity)
+	ils := rm.ScopeMetrics().AppendEmpty()
+	ils.Scope().SetName("otelcol/hostmetricsreceiver/filehandles")
+	ils.Scope().SetVersion(mb.buildInfo.Version)
+	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricSystemFileHandlesAllocated.emit(ils.Metrics())
+	mb.metricSystemFileHandlesLimit.emit(ils.Metrics())
+	mb.metricSystemInotifyInstancesCount.emit(ils.Metrics())
+	mb.metricSystemInotifyInstancesLimit.emit(ils.Metrics())
+	mb.metricSystemInotifyWatchesCount.emit(ils.Metrics())
+	mb.metricSystemInotifyWatchesLimit.emit(ils.Metrics())
+	for _, op := range rmo {
+		op(rm)
+	}
+	if ils.Metrics().Len() > 0 {
+		mb.updateCapacity(rm)
+		rm.MoveTo(mb.metricsBuffer.ResourceMetrics().AppendEmpty())
+	}
+}
+
+// Emit returns all the metrics accumulated by the metrics builder and updates the internal state to be ready for
+// recording another set of metrics. This function will be responsible for applying all the transformations required to
+// produce metric representation defined in metadata and user settings, e.g. delta or cumulative.
+func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
+	mb.EmitForResource(rmo...)
+	metrics := pmetric.NewMetrics()
+	mb.metricsBuffer.MoveTo(metrics)
+	return metrics
+}
+
+// RecordSystemFileHandlesAllocatedDataPoint adds a data point to system.file_handles.allocated metric.
+func (mb *MetricsBuilder) RecordSystemFileHandlesAllocatedDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemFileHandlesAllocated.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemFileHandlesLimitDataPoint adds a data point to system.file_handles.limit metric.
+func (mb *MetricsBuilder) RecordSystemFileHandlesLimitDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemFileHandlesLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemInotifyInstancesCountDataPoint adds a data point to system.inotify.instances.count metric.
+func (mb *MetricsBuilder) RecordSystemInotifyInstancesCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemInotifyInstancesCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemInotifyInstancesLimitDataPoint adds a data point to system.inotify.instances.limit metric.
+func (mb *MetricsBuilder) RecordSystemInotifyInstancesLimitDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemInotifyInstancesLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemInotifyWatchesCountDataPoint adds a data point to system.inotify.watches.count metric.
+func (mb *MetricsBuilder) RecordSystemInotifyWatchesCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemInotifyWatchesCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSystemInotifyWatchesLimitDataPoint adds a data point to system.inotify.watches.limit metric.
+func (mb *MetricsBuilder) RecordSystemInotifyWatchesLimitDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSystemInotifyWatchesLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
+// and metrics builder should update its startTime and reset it's internal state accordingly.
+func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
+	mb.startTime = pcommon.NewTimestampFromTime(time.Now())
+	for _, op := range options {
+		op(mb)
+	}
+}
@@ -17,7 +17,8 @@ package filesystemscraper // import "github.com/open-telemetry/opentelemetry-col
 import (
 	"fmt"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/processor/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/remote"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper/internal/metadata"
 )
 
@@ -40,6 +41,14 @@ type Config struct {
 	IncludeMountPoints MountPointMatchConfig `mapstructure:"include_mount_points"`
 	// ExcludeMountPoints specifies a filter on the mount points that should be excluded from the generated metrics.
 	ExcludeMountPoints MountPointMatchConfig `mapstructure:"exclude_mount_points"`
+
+	// remote is the host to scrape over SSH, or nil to scrape the local host.
+	remote *remote.Config
+}
+
+// SetRemote implements internal.RemoteConfig.
+func (cfg *Config) SetRemote(remote *remote.Config) {
+	cfg.remote = remote
 }
 
 type DeviceMatchConfig struct {
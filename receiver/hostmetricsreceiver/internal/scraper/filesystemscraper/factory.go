@@ -60,5 +60,5 @@ func (f *Factory) CreateMetricsScraper(
 	}
 
 	return scraperhelper.NewScraper(
-		TypeStr, s.scrape, scraperhelper.WithStart(s.start))
+		TypeStr, s.scrape, scraperhelper.WithStart(s.start), scraperhelper.WithShutdown(s.shutdown))
 }
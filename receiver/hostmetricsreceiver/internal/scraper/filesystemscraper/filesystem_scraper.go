@@ -26,6 +26,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/remote"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper/internal/metadata"
 )
 
@@ -45,6 +46,10 @@ type scraper struct {
 	bootTime   func() (uint64, error)
 	partitions func(bool) ([]disk.PartitionStat, error)
 	usage      func(string) (*disk.UsageStat, error)
+
+	// client reads the stats of the remote host, if any
+	client      *remote.Client
+	emitOptions []metadata.ResourceMetricsOption
 }
 
 type deviceUsage struct {
@@ -64,6 +69,18 @@ func newFileSystemScraper(_ context.Context, settings component.ReceiverCreateSe
 }
 
 func (s *scraper) start(context.Context, component.Host) error {
+	if s.config.remote != nil {
+		client, err := remote.Dial(s.config.remote)
+		if err != nil {
+			return err
+		}
+		s.client = client
+		s.bootTime = client.BootTime
+		s.partitions = client.Partitions
+		s.usage = client.Usage
+		s.emitOptions = append(s.emitOptions, client.SetHostName)
+	}
+
 	bootTime, err := s.bootTime()
 	if err != nil {
 		return err
@@ -73,6 +90,13 @@ func (s *scraper) start(context.Context, component.Host) error {
 	return nil
 }
 
+func (s *scraper) shutdown(context.Context) error {
+	if s.client != nil {
+		return s.client.Close()
+	}
+	return nil
+}
+
 func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 	now := pcommon.NewTimestampFromTime(time.Now())
 
@@ -110,7 +134,7 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		err = scrapererror.NewPartialScrapeError(err, metricsLen)
 	}
 
-	return s.mb.Emit(), err
+	return s.mb.Emit(s.emitOptions...), err
 }
 
 func getMountMode(opts []string) string {
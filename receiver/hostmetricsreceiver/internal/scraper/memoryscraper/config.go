@@ -15,10 +15,19 @@
 package memoryscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
 
 import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/remote"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper/internal/metadata"
 )
 
 // Config relating to Memory Metric Scraper.
 type Config struct {
 	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
+
+	// remote is the host to scrape over SSH, or nil to scrape the local host.
+	remote *remote.Config
+}
+
+// SetRemote implements internal.RemoteConfig.
+func (cfg *Config) SetRemote(remote *remote.Config) {
+	cfg.remote = remote
 }
@@ -52,5 +52,5 @@ func (f *Factory) CreateMetricsScraper(
 	s := newMemoryScraper(ctx, settings, cfg)
 
 	return scraperhelper.NewScraper(
-		TypeStr, s.scrape, scraperhelper.WithStart(s.start))
+		TypeStr, s.scrape, scraperhelper.WithStart(s.start), scraperhelper.WithShutdown(s.shutdown))
 }
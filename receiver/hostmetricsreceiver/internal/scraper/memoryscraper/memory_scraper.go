@@ -27,6 +27,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/remote"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper/internal/metadata"
 )
 
@@ -43,6 +44,10 @@ type scraper struct {
 	// for mocking gopsutil mem.VirtualMemory
 	bootTime      func() (uint64, error)
 	virtualMemory func() (*mem.VirtualMemoryStat, error)
+
+	// client reads the stats of the remote host, if any
+	client      *remote.Client
+	emitOptions []metadata.ResourceMetricsOption
 }
 
 // newMemoryScraper creates a Memory Scraper
@@ -51,6 +56,17 @@ func newMemoryScraper(_ context.Context, settings component.ReceiverCreateSettin
 }
 
 func (s *scraper) start(context.Context, component.Host) error {
+	if s.config.remote != nil {
+		client, err := remote.Dial(s.config.remote)
+		if err != nil {
+			return err
+		}
+		s.client = client
+		s.bootTime = client.BootTime
+		s.virtualMemory = client.VirtualMemory
+		s.emitOptions = append(s.emitOptions, client.SetHostName)
+	}
+
 	bootTime, err := s.bootTime()
 	if err != nil {
 		return err
@@ -60,6 +76,13 @@ func (s *scraper) start(context.Context, component.Host) error {
 	return nil
 }
 
+func (s *scraper) shutdown(context.Context) error {
+	if s.client != nil {
+		return s.client.Close()
+	}
+	return nil
+}
+
 func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 	now := pcommon.NewTimestampFromTime(time.Now())
 	memInfo, err := s.virtualMemory()
@@ -76,5 +99,5 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		s.recordMemoryUtilizationMetric(now, memInfo)
 	}
 
-	return s.mb.Emit(), nil
+	return s.mb.Emit(s.emitOptions...), nil
 }
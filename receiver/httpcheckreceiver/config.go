@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -28,7 +29,9 @@ import (
 
 // Predefined error responses for configuration validation failures
 var (
-	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>`)
+	errInvalidEndpoint   = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>`)
+	errInvalidStatusCode = errors.New(`"expected_status_codes" must be between 100 and 599`)
+	errInvalidBodyRegex  = errors.New(`"expected_body_regex" must be a valid regular expression`)
 )
 
 const defaultEndpoint = "http://localhost:80"
@@ -37,19 +40,73 @@ const defaultEndpoint = "http://localhost:80"
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	confighttp.HTTPClientSettings           `mapstructure:",squash"`
+	CheckSettings                           `mapstructure:",squash"`
 	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
-	Method                                  string                   `mapstructure:"method"`
+	// Targets are checked instead of the endpoint of the client settings when set.
+	Targets []TargetConfig `mapstructure:"targets"`
+}
+
+// CheckSettings defines the request sent to a target and the response it is expected to return.
+type CheckSettings struct {
+	Method string `mapstructure:"method"`
+	Body   string `mapstructure:"body"`
+	// ExpectedStatusCodes are the status codes of an available target. Any 2xx status code is expected if not set.
+	ExpectedStatusCodes []int `mapstructure:"expected_status_codes"`
+	// ExpectedBodyRegex must match the response body of an available target if set.
+	ExpectedBodyRegex string `mapstructure:"expected_body_regex"`
+}
+
+// TargetConfig is an HTTP endpoint checked by the receiver.
+type TargetConfig struct {
+	Endpoint string `mapstructure:"endpoint"`
+	// Headers are sent in addition to the headers of the client settings.
+	Headers       map[string]string `mapstructure:"headers"`
+	CheckSettings `mapstructure:",squash"`
+}
+
+// targets returns the configured targets, or the endpoint of the client settings if there are none
+func (cfg *Config) targets() []TargetConfig {
+	if len(cfg.Targets) > 0 {
+		return cfg.Targets
+	}
+	return []TargetConfig{{Endpoint: cfg.Endpoint, CheckSettings: cfg.CheckSettings}}
 }
 
 // Validate validates the configuration by checking for missing or invalid fields
 func (cfg *Config) Validate() error {
 	var err error
 
-	_, parseErr := url.Parse(cfg.Endpoint)
+	if len(cfg.Targets) == 0 {
+		return cfg.targets()[0].validate()
+	}
+
+	for i, target := range cfg.Targets {
+		if targetErr := target.validate(); targetErr != nil {
+			err = multierr.Append(err, fmt.Errorf("targets[%d]: %w", i, targetErr))
+		}
+	}
+
+	return err
+}
+
+func (t TargetConfig) validate() error {
+	var err error
+
+	_, parseErr := url.Parse(t.Endpoint)
 	if parseErr != nil {
 		wrappedErr := fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), parseErr)
 		err = multierr.Append(err, wrappedErr)
 	}
 
+	for _, code := range t.ExpectedStatusCodes {
+		if code < 100 || code > 599 {
+			err = multierr.Append(err, fmt.Errorf("%w: %d", errInvalidStatusCode, code))
+		}
+	}
+
+	if _, regexErr := regexp.Compile(t.ExpectedBodyRegex); regexErr != nil {
+		err = multierr.Append(err, fmt.Errorf("%s: %w", errInvalidBodyRegex.Error(), regexErr))
+	}
+
 	return err
 }
@@ -41,6 +41,47 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf("%s: %w", errInvalidEndpoint, errors.New(`parse "invalid://endpoint:  12efg": invalid port ":  12efg" after host`)),
 			),
 		},
+		{
+			desc: "invalid targets",
+			cfg: &Config{
+				Targets: []TargetConfig{
+					{
+						Endpoint: defaultEndpoint,
+					},
+					{
+						Endpoint: defaultEndpoint,
+						CheckSettings: CheckSettings{
+							ExpectedStatusCodes: []int{200, 600},
+							ExpectedBodyRegex:   "(",
+						},
+					},
+				},
+			},
+			expectedErr: multierr.Combine(
+				fmt.Errorf("targets[1]: %w", multierr.Combine(
+					fmt.Errorf("%w: %d", errInvalidStatusCode, 600),
+					fmt.Errorf("%s: %w", errInvalidBodyRegex, errors.New("error parsing regexp: missing closing ): `(`")),
+				)),
+			),
+		},
+		{
+			desc: "valid targets",
+			cfg: &Config{
+				Targets: []TargetConfig{
+					{
+						Endpoint: "https://example.org/health",
+						Headers:  map[string]string{"Authorization": "Bearer token"},
+						CheckSettings: CheckSettings{
+							Method:              "POST",
+							Body:                `{"check":true}`,
+							ExpectedStatusCodes: []int{200, 204},
+							ExpectedBodyRegex:   `"status":\s*"ok"`,
+						},
+					},
+				},
+			},
+			expectedErr: nil,
+		},
 		{
 			desc: "valid config",
 			cfg: &Config{
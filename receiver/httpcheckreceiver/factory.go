@@ -53,8 +53,10 @@ func createDefaultConfig() config.Receiver {
 			Endpoint: defaultEndpoint,
 			Timeout:  10 * time.Second,
 		},
+		CheckSettings: CheckSettings{
+			Method: "GET",
+		},
 		Metrics: metadata.DefaultMetricsSettings(),
-		Method:  "GET",
 	}
 }
 
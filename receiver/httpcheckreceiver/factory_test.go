@@ -55,8 +55,10 @@ func TestNewFactory(t *testing.T) {
 						Endpoint: defaultEndpoint,
 						Timeout:  10 * time.Second,
 					},
+					CheckSettings: CheckSettings{
+						Method: "GET",
+					},
 					Metrics: metadata.DefaultMetricsSettings(),
-					Method:  "GET",
 				}
 
 				require.Equal(t, expectedCfg, factory.CreateDefaultConfig())
@@ -17,13 +17,17 @@ type MetricSettings struct {
 
 // MetricsSettings provides settings for httpcheckreceiver metrics.
 type MetricsSettings struct {
-	HttpcheckDuration MetricSettings `mapstructure:"httpcheck.duration"`
-	HttpcheckError    MetricSettings `mapstructure:"httpcheck.error"`
-	HttpcheckStatus   MetricSettings `mapstructure:"httpcheck.status"`
+	HttpcheckAvailability MetricSettings `mapstructure:"httpcheck.availability"`
+	HttpcheckDuration     MetricSettings `mapstructure:"httpcheck.duration"`
+	HttpcheckError        MetricSettings `mapstructure:"httpcheck.error"`
+	HttpcheckStatus       MetricSettings `mapstructure:"httpcheck.status"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
 	return MetricsSettings{
+		HttpcheckAvailability: MetricSettings{
+			Enabled: true,
+		},
 		HttpcheckDuration: MetricSettings{
 			Enabled: true,
 		},
@@ -36,6 +40,58 @@ func DefaultMetricsSettings() MetricsSettings {
 	}
 }
 
+type metricHttpcheckAvailability struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.availability metric with initial data.
+func (m *metricHttpcheckAvailability) init() {
+	m.data.SetName("httpcheck.availability")
+	m.data.SetDescription("1 if the check succeeded and the response matched the expected status codes and body, otherwise 0.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckAvailability) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, httpURLAttributeValue string, httpMethodAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("http.url", httpURLAttributeValue)
+	dp.Attributes().PutStr("http.method", httpMethodAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckAvailability) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckAvailability) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckAvailability(settings MetricSettings) metricHttpcheckAvailability {
+	m := metricHttpcheckAvailability{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricHttpcheckDuration struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -200,14 +256,15 @@ func newMetricHttpcheckStatus(settings MetricSettings) metricHttpcheckStatus {
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime               pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity         int                 // maximum observed number of metrics per resource.
-	resourceCapacity        int                 // maximum observed number of resource attributes.
-	metricsBuffer           pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo               component.BuildInfo // contains version information
-	metricHttpcheckDuration metricHttpcheckDuration
-	metricHttpcheckError    metricHttpcheckError
-	metricHttpcheckStatus   metricHttpcheckStatus
+	startTime                   pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity             int                 // maximum observed number of metrics per resource.
+	resourceCapacity            int                 // maximum observed number of resource attributes.
+	metricsBuffer               pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                   component.BuildInfo // contains version information
+	metricHttpcheckAvailability metricHttpcheckAvailability
+	metricHttpcheckDuration     metricHttpcheckDuration
+	metricHttpcheckError        metricHttpcheckError
+	metricHttpcheckStatus       metricHttpcheckStatus
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -222,12 +279,13 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:               pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:           pmetric.NewMetrics(),
-		buildInfo:               buildInfo,
-		metricHttpcheckDuration: newMetricHttpcheckDuration(settings.HttpcheckDuration),
-		metricHttpcheckError:    newMetricHttpcheckError(settings.HttpcheckError),
-		metricHttpcheckStatus:   newMetricHttpcheckStatus(settings.HttpcheckStatus),
+		startTime:                   pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:               pmetric.NewMetrics(),
+		buildInfo:                   buildInfo,
+		metricHttpcheckAvailability: newMetricHttpcheckAvailability(settings.HttpcheckAvailability),
+		metricHttpcheckDuration:     newMetricHttpcheckDuration(settings.HttpcheckDuration),
+		metricHttpcheckError:        newMetricHttpcheckError(settings.HttpcheckError),
+		metricHttpcheckStatus:       newMetricHttpcheckStatus(settings.HttpcheckStatus),
 	}
 	for _, op := range options {
 		op(mb)
@@ -280,6 +338,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetName("otelcol/httpcheckreceiver")
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricHttpcheckAvailability.emit(ils.Metrics())
 	mb.metricHttpcheckDuration.emit(ils.Metrics())
 	mb.metricHttpcheckError.emit(ils.Metrics())
 	mb.metricHttpcheckStatus.emit(ils.Metrics())
@@ -302,6 +361,11 @@ func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
 	return metrics
 }
 
+// RecordHttpcheckAvailabilityDataPoint adds a data point to httpcheck.availability metric.
+func (mb *MetricsBuilder) RecordHttpcheckAvailabilityDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string, httpMethodAttributeValue string) {
+	mb.metricHttpcheckAvailability.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, httpMethodAttributeValue)
+}
+
 // RecordHttpcheckDurationDataPoint adds a data point to httpcheck.duration metric.
 func (mb *MetricsBuilder) RecordHttpcheckDurationDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string) {
 	mb.metricHttpcheckDuration.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue)
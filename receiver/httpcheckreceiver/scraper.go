@@ -17,7 +17,12 @@ package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-col
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"regexp"
+	"strings"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -27,6 +32,9 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
 )
 
+// maxBodySize limits how much of a response body is matched against the expected body regex
+const maxBodySize = 1 << 20
+
 var (
 	errClientNotInit    = errors.New("client not initialized")
 	httpResponseClasses = map[string]int{"1xx": 1, "2xx": 2, "3xx": 3, "4xx": 4, "5xx": 5}
@@ -37,15 +45,44 @@ type httpcheckScraper struct {
 	cfg      *Config
 	settings component.TelemetrySettings
 	mb       *metadata.MetricsBuilder
+	targets  []*target
+}
+
+// target is a configured target along with its compiled body expectation
+type target struct {
+	TargetConfig
+	bodyRegex *regexp.Regexp
+}
+
+// checkResult is the outcome of checking a single target
+type checkResult struct {
+	method     string
+	statusCode int
+	// sent is false if the request could not be created
+	sent      bool
+	duration  time.Duration
+	available bool
+	err       error
 }
 
 // start starts the scraper by creating a new HTTP Client on the scraper
 func (h *httpcheckScraper) start(ctx context.Context, host component.Host) (err error) {
+	h.targets = nil
+	for _, cfg := range h.cfg.targets() {
+		t := &target{TargetConfig: cfg}
+		if cfg.ExpectedBodyRegex != "" {
+			if t.bodyRegex, err = regexp.Compile(cfg.ExpectedBodyRegex); err != nil {
+				return err
+			}
+		}
+		h.targets = append(h.targets, t)
+	}
+
 	h.client, err = h.cfg.ToClient(host, h.settings)
 	return
 }
 
-// scrape connects to the endpoint and produces metrics based on the response
+// scrape checks every target concurrently and produces metrics based on the responses
 func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	if h.client == nil {
 		return pmetric.NewMetrics(), errClientNotInit
@@ -53,32 +90,106 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 
 	now := pcommon.NewTimestampFromTime(time.Now())
 
-	req, err := http.NewRequestWithContext(ctx, h.cfg.Method, h.cfg.Endpoint, http.NoBody)
+	results := make([]checkResult, len(h.targets))
+	var wg sync.WaitGroup
+	for i, t := range h.targets {
+		wg.Add(1)
+		go func(i int, t *target) {
+			defer wg.Done()
+			results[i] = h.check(ctx, t)
+		}(i, t)
+	}
+	wg.Wait()
+
+	for i, t := range h.targets {
+		h.record(now, t.Endpoint, results[i])
+	}
+
+	return h.mb.Emit(), nil
+}
+
+// check sends the request of the target and compares the response with the expectations
+func (h *httpcheckScraper) check(ctx context.Context, t *target) checkResult {
+	result := checkResult{method: t.Method}
+
+	var body io.Reader = http.NoBody
+	if t.Body != "" {
+		body = strings.NewReader(t.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, t.Method, t.Endpoint, body)
 	if err != nil {
-		return pmetric.Metrics{}, err
+		result.err = err
+		return result
+	}
+	for name, value := range t.Headers {
+		if strings.EqualFold(name, "host") {
+			req.Host = value
+			continue
+		}
+		req.Header.Set(name, value)
 	}
+	result.method = req.Method
+	result.sent = true
 
 	start := time.Now()
 	resp, err := h.client.Do(req)
-	h.mb.RecordHttpcheckDurationDataPoint(now, time.Since(start).Milliseconds(), h.cfg.Endpoint)
-
-	statusCode := 0
+	result.duration = time.Since(start)
 	if err != nil {
-		h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), h.cfg.Endpoint, err.Error())
-	} else {
-		statusCode = resp.StatusCode
+		result.err = err
+		return result
+	}
+	defer resp.Body.Close()
+
+	result.statusCode = resp.StatusCode
+	result.available = t.expectedStatusCode(resp.StatusCode)
+	if result.available && t.bodyRegex != nil {
+		respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+		if err != nil {
+			result.available = false
+			result.err = fmt.Errorf("failed to read response body: %w", err)
+			return result
+		}
+		result.available = t.bodyRegex.Match(respBody)
 	}
+	return result
+}
 
-	for class, intVal := range httpResponseClasses {
-		if statusCode/100 == intVal {
-			h.mb.RecordHttpcheckStatusDataPoint(now, int64(1), h.cfg.Endpoint, int64(statusCode), req.Method, class)
-		} else {
-			h.mb.RecordHttpcheckStatusDataPoint(now, int64(0), h.cfg.Endpoint, int64(statusCode), req.Method, class)
+// expectedStatusCode checks the status code against the expected status codes, or any 2xx status code if none are set
+func (t *target) expectedStatusCode(statusCode int) bool {
+	if len(t.ExpectedStatusCodes) == 0 {
+		return statusCode/100 == 2
+	}
+	for _, expected := range t.ExpectedStatusCodes {
+		if statusCode == expected {
+			return true
 		}
+	}
+	return false
+}
 
+func (h *httpcheckScraper) record(now pcommon.Timestamp, endpoint string, result checkResult) {
+	if result.err != nil {
+		h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), endpoint, result.err.Error())
 	}
 
-	return h.mb.Emit(), nil
+	available := int64(0)
+	if result.available {
+		available = 1
+	}
+	h.mb.RecordHttpcheckAvailabilityDataPoint(now, available, endpoint, result.method)
+
+	if !result.sent {
+		return
+	}
+	h.mb.RecordHttpcheckDurationDataPoint(now, result.duration.Milliseconds(), endpoint)
+
+	for class, intVal := range httpResponseClasses {
+		if result.statusCode/100 == intVal {
+			h.mb.RecordHttpcheckStatusDataPoint(now, int64(1), endpoint, int64(result.statusCode), result.method, class)
+		} else {
+			h.mb.RecordHttpcheckStatusDataPoint(now, int64(0), endpoint, int64(result.statusCode), result.method, class)
+		}
+	}
 }
 
 func newScraper(conf *Config, settings component.ReceiverCreateSettings) *httpcheckScraper {
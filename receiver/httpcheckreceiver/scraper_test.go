@@ -16,6 +16,7 @@ package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
@@ -181,3 +182,86 @@ func TestNilClient(t *testing.T) {
 	require.NoError(t, scrapertest.CompareMetrics(pmetric.NewMetrics(), actualMetrics))
 
 }
+
+func TestScraperTargets(t *testing.T) {
+	ms := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/health":
+			_, err := rw.Write([]byte(`{"status":"ok"}`))
+			require.NoError(t, err)
+		case "/echo":
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			if req.Method != http.MethodPost || string(body) != "ping" || req.Header.Get("X-Check") != "1" || req.Host != "example.org" {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			rw.WriteHeader(http.StatusCreated)
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ms.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Targets = []TargetConfig{
+		{
+			Endpoint:      ms.URL + "/health",
+			CheckSettings: CheckSettings{ExpectedBodyRegex: `"status":\s*"ok"`},
+		},
+		{
+			Endpoint:      ms.URL + "/health",
+			CheckSettings: CheckSettings{Method: http.MethodHead, ExpectedBodyRegex: `"status":\s*"ok"`},
+		},
+		{
+			Endpoint: ms.URL + "/echo",
+			Headers:  map[string]string{"X-Check": "1", "Host": "example.org"},
+			CheckSettings: CheckSettings{
+				Method:              http.MethodPost,
+				Body:                "ping",
+				ExpectedStatusCodes: []int{http.StatusCreated},
+			},
+		},
+		{
+			Endpoint:      ms.URL + "/missing",
+			CheckSettings: CheckSettings{ExpectedStatusCodes: []int{http.StatusOK, http.StatusNoContent}},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	scraper := newScraper(cfg, componenttest.NewNopReceiverCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	availability := map[string]int64{}
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		require.NotEqual(t, "httpcheck.error", m.Name())
+		if m.Name() != "httpcheck.availability" {
+			continue
+		}
+		for j := 0; j < m.Gauge().DataPoints().Len(); j++ {
+			dp := m.Gauge().DataPoints().At(j)
+			url, _ := dp.Attributes().Get("http.url")
+			method, _ := dp.Attributes().Get("http.method")
+			availability[method.Str()+" "+url.Str()] = dp.IntValue()
+		}
+	}
+
+	require.Equal(t, map[string]int64{
+		"GET " + ms.URL + "/health":  1,
+		"HEAD " + ms.URL + "/health": 0,
+		"POST " + ms.URL + "/echo":   1,
+		"GET " + ms.URL + "/missing": 0,
+	}, availability)
+}
+
+func TestScraperStartInvalidBodyRegex(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ExpectedBodyRegex = "("
+	scraper := newScraper(cfg, componenttest.NewNopReceiverCreateSettings())
+	require.Error(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+}
@@ -75,7 +75,8 @@ func testdataConfigYaml() *JournaldConfig {
 		InputConfig: func() journald.Config {
 			c := journald.NewConfig()
 			c.Units = []string{"ssh"}
-			c.Priority = "info"
+			c.Identifiers = []string{"sshd"}
+			c.Priority = "emerg..info"
 			dir := "/run/log/journal"
 			c.Directory = &dir
 			return *c
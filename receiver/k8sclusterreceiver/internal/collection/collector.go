@@ -27,6 +27,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -49,12 +50,14 @@ const (
 	k8sKeyNamespaceUID             = "k8s.namespace.uid"
 	k8sKeyReplicationControllerUID = "k8s.replicationcontroller.uid"
 	k8sKeyHPAUID                   = "k8s.hpa.uid"
+	k8sKeyPDBUID                   = "k8s.pdb.uid"
 	k8sKeyResourceQuotaUID         = "k8s.resourcequota.uid"
 	k8sKeyClusterResourceQuotaUID  = "openshift.clusterquota.uid"
 
 	// Resource labels keys for Name.
 	k8sKeyReplicationControllerName = "k8s.replicationcontroller.name"
 	k8sKeyHPAName                   = "k8s.hpa.name"
+	k8sKeyPDBName                   = "k8s.pdb.name"
 	k8sKeyResourceQuotaName         = "k8s.resourcequota.name"
 	k8sKeyClusterResourceQuotaName  = "openshift.clusterquota.name"
 
@@ -153,6 +156,8 @@ func (dc *DataCollector) SyncMetrics(obj interface{}) {
 		rm = getMetricsForCronJobBeta(o)
 	case *autoscalingv2beta2.HorizontalPodAutoscaler:
 		rm = getMetricsForHPA(o)
+	case *policyv1.PodDisruptionBudget:
+		rm = getMetricsForPodDisruptionBudget(o)
 	case *quotav1.ClusterResourceQuota:
 		rm = getMetricsForClusterResourceQuota(o)
 	default:
@@ -192,6 +197,8 @@ func (dc *DataCollector) SyncMetadata(obj interface{}) map[metadata.ResourceID]*
 		km = getMetadataForCronJobBeta(o)
 	case *autoscalingv2beta2.HorizontalPodAutoscaler:
 		km = getMetadataForHPA(o)
+	case *policyv1.PodDisruptionBudget:
+		km = getMetadataForPodDisruptionBudget(o)
 	}
 
 	return km
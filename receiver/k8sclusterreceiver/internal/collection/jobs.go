@@ -107,13 +107,22 @@ func getMetricsForJob(j *batchv1.Job) []*resourceMetrics {
 }
 
 func getResourceForJob(j *batchv1.Job) *resourcepb.Resource {
+	labels := map[string]string{
+		conventions.AttributeK8SJobUID:        string(j.UID),
+		conventions.AttributeK8SJobName:       j.Name,
+		conventions.AttributeK8SNamespaceName: j.Namespace,
+	}
+
+	// Jobs spawned by a CronJob carry their owner's identity so that job
+	// metrics can be aggregated per CronJob.
+	if cronJobRef := utils.FindOwnerWithKind(j.OwnerReferences, k8sKindCronJob); cronJobRef != nil {
+		labels[conventions.AttributeK8SCronJobUID] = string(cronJobRef.UID)
+		labels[conventions.AttributeK8SCronJobName] = cronJobRef.Name
+	}
+
 	return &resourcepb.Resource{
-		Type: k8sType,
-		Labels: map[string]string{
-			conventions.AttributeK8SJobUID:        string(j.UID),
-			conventions.AttributeK8SJobName:       j.Name,
-			conventions.AttributeK8SNamespaceName: j.Namespace,
-		},
+		Type:   k8sType,
+		Labels: labels,
 	}
 }
 
@@ -74,6 +74,30 @@ func TestJobMetrics(t *testing.T) {
 		metricspb.MetricDescriptor_GAUGE_INT64, 3)
 }
 
+func TestJobMetricsWithCronJobOwner(t *testing.T) {
+	j := newJob("1")
+	j.OwnerReferences = []v1.OwnerReference{
+		{
+			Kind: "CronJob",
+			Name: "test-cronjob-1",
+			UID:  types.UID("test-cronjob-1-uid"),
+		},
+	}
+
+	actualResourceMetrics := getMetricsForJob(j)
+
+	require.Equal(t, 1, len(actualResourceMetrics))
+	testutils.AssertResource(t, actualResourceMetrics[0].resource, k8sType,
+		map[string]string{
+			"k8s.job.uid":        "test-job-1-uid",
+			"k8s.job.name":       "test-job-1",
+			"k8s.cronjob.uid":    "test-cronjob-1-uid",
+			"k8s.cronjob.name":   "test-cronjob-1",
+			"k8s.namespace.name": "test-namespace",
+		},
+	)
+}
+
 func newJob(id string) *batchv1.Job {
 	p := int32(2)
 	c := int32(10)
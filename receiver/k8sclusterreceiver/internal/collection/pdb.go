@@ -0,0 +1,106 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
+
+import (
+	metricspb "github.com/census-instrumentation/opencensus-proto/gen-go/metrics/v1"
+	resourcepb "github.com/census-instrumentation/opencensus-proto/gen-go/resource/v1"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	policyv1 "k8s.io/api/policy/v1"
+
+	metadata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/utils"
+)
+
+var pdbCurrentHealthyMetric = &metricspb.MetricDescriptor{
+	Name:        "k8s.pdb.current_healthy",
+	Description: "Current number of healthy pods selected by this pod disruption budget",
+	Unit:        "1",
+	Type:        metricspb.MetricDescriptor_GAUGE_INT64,
+}
+
+var pdbDesiredHealthyMetric = &metricspb.MetricDescriptor{
+	Name:        "k8s.pdb.desired_healthy",
+	Description: "Minimum desired number of healthy pods selected by this pod disruption budget",
+	Unit:        "1",
+	Type:        metricspb.MetricDescriptor_GAUGE_INT64,
+}
+
+var pdbDisruptionsAllowedMetric = &metricspb.MetricDescriptor{
+	Name:        "k8s.pdb.disruptions_allowed",
+	Description: "Number of pod disruptions that are currently allowed by this pod disruption budget",
+	Unit:        "1",
+	Type:        metricspb.MetricDescriptor_GAUGE_INT64,
+}
+
+var pdbExpectedPodsMetric = &metricspb.MetricDescriptor{
+	Name:        "k8s.pdb.expected_pods",
+	Description: "Total number of pods counted by this pod disruption budget",
+	Unit:        "1",
+	Type:        metricspb.MetricDescriptor_GAUGE_INT64,
+}
+
+func getMetricsForPodDisruptionBudget(pdb *policyv1.PodDisruptionBudget) []*resourceMetrics {
+	metrics := []*metricspb.Metric{
+		{
+			MetricDescriptor: pdbCurrentHealthyMetric,
+			Timeseries: []*metricspb.TimeSeries{
+				utils.GetInt64TimeSeries(int64(pdb.Status.CurrentHealthy)),
+			},
+		},
+		{
+			MetricDescriptor: pdbDesiredHealthyMetric,
+			Timeseries: []*metricspb.TimeSeries{
+				utils.GetInt64TimeSeries(int64(pdb.Status.DesiredHealthy)),
+			},
+		},
+		{
+			MetricDescriptor: pdbDisruptionsAllowedMetric,
+			Timeseries: []*metricspb.TimeSeries{
+				utils.GetInt64TimeSeries(int64(pdb.Status.DisruptionsAllowed)),
+			},
+		},
+		{
+			MetricDescriptor: pdbExpectedPodsMetric,
+			Timeseries: []*metricspb.TimeSeries{
+				utils.GetInt64TimeSeries(int64(pdb.Status.ExpectedPods)),
+			},
+		},
+	}
+
+	return []*resourceMetrics{
+		{
+			resource: getResourceForPodDisruptionBudget(pdb),
+			metrics:  metrics,
+		},
+	}
+}
+
+func getResourceForPodDisruptionBudget(pdb *policyv1.PodDisruptionBudget) *resourcepb.Resource {
+	return &resourcepb.Resource{
+		Type: k8sType,
+		Labels: map[string]string{
+			k8sKeyPDBUID:                          string(pdb.UID),
+			k8sKeyPDBName:                         pdb.Name,
+			conventions.AttributeK8SNamespaceName: pdb.Namespace,
+		},
+	}
+}
+
+func getMetadataForPodDisruptionBudget(pdb *policyv1.PodDisruptionBudget) map[metadata.ResourceID]*KubernetesMetadata {
+	return map[metadata.ResourceID]*KubernetesMetadata{
+		metadata.ResourceID(pdb.UID): getGenericMetadata(&pdb.ObjectMeta, "PDB"),
+	}
+}
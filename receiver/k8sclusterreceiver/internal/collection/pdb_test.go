@@ -0,0 +1,86 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"testing"
+
+	metricspb "github.com/census-instrumentation/opencensus-proto/gen-go/metrics/v1"
+	"github.com/stretchr/testify/require"
+	policyv1 "k8s.io/api/policy/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestPodDisruptionBudgetMetrics(t *testing.T) {
+	pdb := newPodDisruptionBudget("1")
+
+	actualResourceMetrics := getMetricsForPodDisruptionBudget(pdb)
+
+	require.Equal(t, 1, len(actualResourceMetrics))
+	require.Equal(t, 4, len(actualResourceMetrics[0].metrics))
+
+	rm := actualResourceMetrics[0]
+	testutils.AssertResource(t, rm.resource, k8sType,
+		map[string]string{
+			"k8s.pdb.uid":        "test-pdb-1-uid",
+			"k8s.pdb.name":       "test-pdb-1",
+			"k8s.namespace.name": "test-namespace",
+		},
+	)
+
+	testutils.AssertMetricsInt(t, rm.metrics[0], "k8s.pdb.current_healthy",
+		metricspb.MetricDescriptor_GAUGE_INT64, 4)
+
+	testutils.AssertMetricsInt(t, rm.metrics[1], "k8s.pdb.desired_healthy",
+		metricspb.MetricDescriptor_GAUGE_INT64, 3)
+
+	testutils.AssertMetricsInt(t, rm.metrics[2], "k8s.pdb.disruptions_allowed",
+		metricspb.MetricDescriptor_GAUGE_INT64, 1)
+
+	testutils.AssertMetricsInt(t, rm.metrics[3], "k8s.pdb.expected_pods",
+		metricspb.MetricDescriptor_GAUGE_INT64, 5)
+}
+
+func TestPodDisruptionBudgetMetadata(t *testing.T) {
+	pdb := newPodDisruptionBudget("1")
+
+	actualMetadata := getMetadataForPodDisruptionBudget(pdb)
+
+	require.Equal(t, 1, len(actualMetadata))
+	km := actualMetadata["test-pdb-1-uid"]
+	require.NotNil(t, km)
+	require.Equal(t, "k8s.pdb.uid", km.resourceIDKey)
+	require.Equal(t, "PDB", km.metadata["k8s.workload.kind"])
+	require.Equal(t, "test-pdb-1", km.metadata["k8s.workload.name"])
+}
+
+func newPodDisruptionBudget(id string) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-pdb-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-pdb-" + id + "-uid"),
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{
+			CurrentHealthy:     4,
+			DesiredHealthy:     3,
+			DisruptionsAllowed: 1,
+			ExpectedPods:       5,
+		},
+	}
+}
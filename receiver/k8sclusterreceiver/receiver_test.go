@@ -267,6 +267,12 @@ func newFakeClientWithAllResources() *fake.Clientset {
 				gvkToAPIResource(gvk.HorizontalPodAutoscaler),
 			},
 		},
+		{
+			GroupVersion: "policy/v1",
+			APIResources: []v1.APIResource{
+				gvkToAPIResource(gvk.PodDisruptionBudget),
+			},
+		},
 	}
 	return client
 }
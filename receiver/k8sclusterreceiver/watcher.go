@@ -120,6 +120,7 @@ func (rw *resourceWatcher) prepareSharedInformerFactory() error {
 		"Job":                     {gvk.Job},
 		"CronJob":                 {gvk.CronJob, gvk.CronJobBeta},
 		"HorizontalPodAutoscaler": {gvk.HorizontalPodAutoscaler},
+		"PodDisruptionBudget":     {gvk.PodDisruptionBudget},
 	}
 
 	for kind, gvks := range supportedKinds {
@@ -198,6 +199,8 @@ func (rw *resourceWatcher) setupInformerForKind(kind schema.GroupVersionKind, fa
 		rw.setupInformer(kind, factory.Batch().V1beta1().CronJobs().Informer())
 	case gvk.HorizontalPodAutoscaler:
 		rw.setupInformer(kind, factory.Autoscaling().V2beta2().HorizontalPodAutoscalers().Informer())
+	case gvk.PodDisruptionBudget:
+		rw.setupInformer(kind, factory.Policy().V1().PodDisruptionBudgets().Informer())
 	default:
 		rw.logger.Error("Could not setup an informer for provided group version kind",
 			zap.String("group version kind", kind.String()))
@@ -187,6 +187,12 @@ func TestPrepareSharedInformerFactory(t *testing.T) {
 							gvkToAPIResource(gvk.HorizontalPodAutoscaler),
 						},
 					},
+					{
+						GroupVersion: "policy/v1",
+						APIResources: []metav1.APIResource{
+							gvkToAPIResource(gvk.PodDisruptionBudget),
+						},
+					},
 				}
 				return client
 			}(),
@@ -25,6 +25,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkametricsreceiver/internal/metadata"
 )
 
@@ -40,7 +41,11 @@ func (s *brokerScraper) Name() string {
 	return brokersScraperName
 }
 
-func (s *brokerScraper) start(_ context.Context, _ component.Host) error {
+func (s *brokerScraper) start(_ context.Context, host component.Host) error {
+	// The clients are created on the first scrape, once the authenticator is resolved.
+	if err := kafkaexporter.ResolveAuthenticator(s.saramaConfig, host); err != nil {
+		return err
+	}
 	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, s.settings.BuildInfo)
 	return nil
 }
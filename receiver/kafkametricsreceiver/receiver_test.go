@@ -21,8 +21,10 @@ import (
 
 	"github.com/Shopify/sarama"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -68,6 +70,28 @@ func TestNewReceiver_invalid_auth_error(t *testing.T) {
 	assert.Nil(t, r)
 }
 
+func TestScrapersResolveOAuthBearerAuthenticator(t *testing.T) {
+	for name, create := range map[string]createKafkaScraper{
+		brokersScraperName:   createBrokerScraper,
+		topicsScraperName:    createTopicsScraper,
+		consumersScraperName: createConsumerScraper,
+	} {
+		t.Run(name, func(t *testing.T) {
+			sc := sarama.NewConfig()
+			require.NoError(t, kafkaexporter.ConfigureAuthentication(kafkaexporter.Authentication{
+				SASL: &kafkaexporter.SASLConfig{
+					Mechanism:   sarama.SASLTypeOAuth,
+					OAuthBearer: kafkaexporter.OAuthBearerConfig{Authenticator: config.NewComponentID("oauth2client")},
+				},
+			}, sc))
+
+			s, err := create(context.Background(), Config{}, sc, componenttest.NewNopReceiverCreateSettings())
+			require.NoError(t, err)
+			assert.EqualError(t, s.Start(context.Background(), componenttest.NewNopHost()), `authenticator "oauth2client" not found`)
+		})
+	}
+}
+
 func TestNewReceiver(t *testing.T) {
 	c := createDefaultConfig().(*Config)
 	c.Scrapers = []string{"brokers"}
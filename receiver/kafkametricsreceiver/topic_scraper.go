@@ -28,6 +28,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkametricsreceiver/internal/metadata"
 )
 
@@ -51,7 +52,11 @@ func (s *topicScraper) shutdown(context.Context) error {
 	return nil
 }
 
-func (s *topicScraper) start(_ context.Context, _ component.Host) error {
+func (s *topicScraper) start(_ context.Context, host component.Host) error {
+	// The clients are created on the first scrape, once the authenticator is resolved.
+	if err := kafkaexporter.ResolveAuthenticator(s.saramaConfig, host); err != nil {
+		return err
+	}
 	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, s.settings.BuildInfo)
 	return nil
 }
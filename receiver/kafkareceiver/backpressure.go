@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
+
+import (
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.opencensus.io/stats"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.uber.org/zap"
+)
+
+// partitionPauser pauses and resumes the consumption of partitions, as implemented by sarama.ConsumerGroup
+type partitionPauser interface {
+	Pause(partitions map[string][]int32)
+	Resume(partitions map[string][]int32)
+}
+
+// backpressure delivers the messages to the next consumer, and pauses their partition while
+// the next consumer refuses them, so that no more messages are fetched from the partition.
+type backpressure struct {
+	id     config.ComponentID
+	cfg    Backpressure
+	pauser partitionPauser
+	logger *zap.Logger
+}
+
+// deliver calls the delivery function until it succeeds or fails with a permanent error when backpressure is enabled.
+// The partition of the claim is paused during the retries, which end when the session ends.
+func (b backpressure) deliver(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim, delivery func() error) error {
+	err := delivery()
+	if !b.cfg.Enabled || err == nil || consumererror.IsPermanent(err) {
+		return err
+	}
+
+	partitions := map[string][]int32{claim.Topic(): {claim.Partition()}}
+	fields := []zap.Field{zap.String("topic", claim.Topic()), zap.Int32("partition", claim.Partition())}
+	statsTags := partitionStatsTags(b.id, claim)
+
+	b.pauser.Pause(partitions)
+	_ = stats.RecordWithTags(session.Context(), statsTags, statPartitionPaused.M(1), statPartitionPauses.M(1))
+	b.logger.Warn("Pausing partition, as the next consumer refused the data", append(fields, zap.Error(err))...)
+	defer func() {
+		b.pauser.Resume(partitions)
+		_ = stats.RecordWithTags(session.Context(), statsTags, statPartitionPaused.M(0))
+		b.logger.Info("Resumed partition", fields...)
+	}()
+
+	ticker := time.NewTicker(b.cfg.RetryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-session.Context().Done():
+			return err
+		case <-ticker.C:
+		}
+		if err = delivery(); err == nil || consumererror.IsPermanent(err) {
+			return err
+		}
+		b.logger.Debug("The next consumer still refuses the data", append(fields, zap.Error(err))...)
+	}
+}
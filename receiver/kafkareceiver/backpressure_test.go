@@ -0,0 +1,243 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+)
+
+type recordingPauser struct {
+	mu      sync.Mutex
+	paused  []map[string][]int32
+	resumed []map[string][]int32
+}
+
+func (p *recordingPauser) Pause(partitions map[string][]int32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.paused = append(p.paused, partitions)
+}
+
+func (p *recordingPauser) Resume(partitions map[string][]int32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.resumed = append(p.resumed, partitions)
+}
+
+type contextConsumerGroupSession struct {
+	testConsumerGroupSession
+	ctx context.Context
+}
+
+func (s contextConsumerGroupSession) Context() context.Context {
+	return s.ctx
+}
+
+// refusingDelivery returns a delivery failing with the error the given number of times
+func refusingDelivery(refusals int, err error) (func() error, *int) {
+	calls := 0
+	return func() error {
+		calls++
+		if calls <= refusals {
+			return err
+		}
+		return nil
+	}, &calls
+}
+
+func TestBackpressureDeliver(t *testing.T) {
+	errRefused := errors.New("data refused due to high memory usage")
+	errPermanent := consumererror.NewPermanent(errors.New("invalid data"))
+	partitions := map[string][]int32{testTopic: {testPartition}}
+
+	tests := []struct {
+		name          string
+		enabled       bool
+		refusals      int
+		err           error
+		expectedErr   error
+		expectedCalls int
+		expectPause   bool
+	}{
+		{
+			name:          "accepted",
+			enabled:       true,
+			expectedCalls: 1,
+		},
+		{
+			name:          "disabled",
+			refusals:      1,
+			err:           errRefused,
+			expectedErr:   errRefused,
+			expectedCalls: 1,
+		},
+		{
+			name:          "refused",
+			enabled:       true,
+			refusals:      3,
+			err:           errRefused,
+			expectedCalls: 4,
+			expectPause:   true,
+		},
+		{
+			name:          "permanent error",
+			enabled:       true,
+			refusals:      1,
+			err:           errPermanent,
+			expectedErr:   errPermanent,
+			expectedCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pauser := &recordingPauser{}
+			b := backpressure{
+				id:     config.NewComponentID(typeStr),
+				cfg:    Backpressure{Enabled: tt.enabled, RetryInterval: time.Millisecond},
+				pauser: pauser,
+				logger: zap.NewNop(),
+			}
+			delivery, calls := refusingDelivery(tt.refusals, tt.err)
+
+			err := b.deliver(testConsumerGroupSession{}, testConsumerGroupClaim{}, delivery)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedCalls, *calls)
+			if tt.expectPause {
+				assert.Equal(t, []map[string][]int32{partitions}, pauser.paused)
+				assert.Equal(t, []map[string][]int32{partitions}, pauser.resumed)
+			} else {
+				assert.Empty(t, pauser.paused)
+				assert.Empty(t, pauser.resumed)
+			}
+		})
+	}
+}
+
+func TestBackpressureDeliver_sessionEnded(t *testing.T) {
+	errRefused := errors.New("data refused due to high memory usage")
+	pauser := &recordingPauser{}
+	b := backpressure{
+		id:     config.NewComponentID(typeStr),
+		cfg:    Backpressure{Enabled: true, RetryInterval: time.Millisecond},
+		pauser: pauser,
+		logger: zap.NewNop(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	delivery := func() error {
+		calls++
+		if calls == 3 {
+			cancel()
+		}
+		return errRefused
+	}
+
+	err := b.deliver(contextConsumerGroupSession{ctx: ctx}, testConsumerGroupClaim{}, delivery)
+	assert.Equal(t, errRefused, err)
+	assert.Equal(t, 3, calls)
+	assert.Len(t, pauser.paused, 1)
+	assert.Len(t, pauser.resumed, 1)
+}
+
+func TestTracesConsumerGroupHandler_backpressure(t *testing.T) {
+	view.Unregister(MetricViews()...)
+	views := MetricViews()
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	refusals := 2
+	nextConsumer := &refusingTracesConsumer{refusals: refusals}
+	pauser := &recordingPauser{}
+	c := tracesConsumerGroupHandler{
+		unmarshaler:  newPdataTracesUnmarshaler(ptrace.NewProtoUnmarshaler(), defaultEncoding),
+		logger:       zap.NewNop(),
+		ready:        make(chan bool),
+		nextConsumer: nextConsumer,
+		obsrecv:      obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: componenttest.NewNopReceiverCreateSettings()}),
+		backpressure: backpressure{
+			id:     config.NewComponentID(typeStr),
+			cfg:    Backpressure{Enabled: true, RetryInterval: time.Millisecond},
+			pauser: pauser,
+			logger: zap.NewNop(),
+		},
+	}
+
+	bts, err := ptrace.NewProtoMarshaler().MarshalTraces(testdata.GenerateTracesOneSpan())
+	require.NoError(t, err)
+
+	groupClaim := testConsumerGroupClaim{
+		messageChan: make(chan *sarama.ConsumerMessage),
+	}
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		assert.NoError(t, c.ConsumeClaim(testConsumerGroupSession{}, groupClaim))
+		wg.Done()
+	}()
+	groupClaim.messageChan <- &sarama.ConsumerMessage{Value: bts}
+	close(groupClaim.messageChan)
+	wg.Wait()
+
+	assert.Len(t, nextConsumer.AllTraces(), 1)
+	assert.Equal(t, refusals+1, nextConsumer.calls)
+	assert.Len(t, pauser.paused, 1)
+	assert.Len(t, pauser.resumed, 1)
+
+	viewData, err := view.RetrieveData(statPartitionPauses.Name())
+	require.NoError(t, err)
+	require.Len(t, viewData, 1)
+	assert.Equal(t, float64(1), viewData[0].Data.(*view.SumData).Value)
+	assert.Contains(t, viewData[0].Tags, tag.Tag{Key: tagPartition, Value: "5"})
+
+	viewData, err = view.RetrieveData(statPartitionPaused.Name())
+	require.NoError(t, err)
+	require.Len(t, viewData, 1)
+	assert.Equal(t, float64(0), viewData[0].Data.(*view.LastValueData).Value)
+}
+
+// refusingTracesConsumer refuses the traces the given number of times before accepting them
+type refusingTracesConsumer struct {
+	consumertest.TracesSink
+	refusals int
+	calls    int
+}
+
+func (c *refusingTracesConsumer) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	c.calls++
+	if c.calls <= c.refusals {
+		return errors.New("data refused due to high memory usage")
+	}
+	return c.TracesSink.ConsumeTraces(ctx, td)
+}
@@ -15,6 +15,7 @@
 package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
 
 import (
+	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/config"
@@ -42,6 +43,33 @@ type MessageMarking struct {
 	OnError bool `mapstructure:"on_error"`
 }
 
+// Backpressure defines how the receiver behaves when the next consumer refuses the data.
+type Backpressure struct {
+	// If true, the partition of a message refused by the next consumer with a retryable error is paused,
+	// and the message is delivered again until it is accepted, instead of ending the consumption of the partition.
+	Enabled bool `mapstructure:"enabled"`
+
+	// RetryInterval is the delay between the deliveries of a refused message (default 1s)
+	RetryInterval time.Duration `mapstructure:"retry_interval"`
+}
+
+const (
+	// headerTargetResource sets the attribute of a header on the resources of a message.
+	headerTargetResource = "resource"
+	// headerTargetRecord sets the attribute of a header on the spans, data points or log records of a message.
+	headerTargetRecord = "record"
+)
+
+// HeaderMapping defines an attribute set from a header of the Kafka messages.
+type HeaderMapping struct {
+	// Header is the key of the message header.
+	Header string `mapstructure:"header"`
+	// Attribute is the key of the attribute, the header key by default.
+	Attribute string `mapstructure:"attribute"`
+	// Target is where the attribute is set, "resource" (default) or "record".
+	Target string `mapstructure:"target"`
+}
+
 // Config defines configuration for Kafka receiver.
 type Config struct {
 	config.ReceiverSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
@@ -69,11 +97,30 @@ type Config struct {
 
 	// Controls the way the messages are marked as consumed
 	MessageMarking MessageMarking `mapstructure:"message_marking"`
+
+	// HeadersToAttributes lists the message headers set as attributes of the received data
+	HeadersToAttributes []HeaderMapping `mapstructure:"headers_to_attributes"`
+
+	// Controls the pausing of the partitions when the next consumer refuses the data
+	Backpressure Backpressure `mapstructure:"backpressure"`
 }
 
 var _ config.Receiver = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
+	if cfg.Backpressure.Enabled && cfg.Backpressure.RetryInterval <= 0 {
+		return fmt.Errorf("backpressure: retry_interval must be positive, got %s", cfg.Backpressure.RetryInterval)
+	}
+	for i, mapping := range cfg.HeadersToAttributes {
+		if mapping.Header == "" {
+			return fmt.Errorf("headers_to_attributes[%d]: header must not be empty", i)
+		}
+		switch mapping.Target {
+		case "", headerTargetResource, headerTargetRecord:
+		default:
+			return fmt.Errorf("headers_to_attributes[%d]: target must be %q or %q, got %q", i, headerTargetResource, headerTargetRecord, mapping.Target)
+		}
+	}
 	return nil
 }
@@ -68,6 +68,14 @@ func TestLoadConfig(t *testing.T) {
 					Enable:   true,
 					Interval: 1 * time.Second,
 				},
+				HeadersToAttributes: []HeaderMapping{
+					{Header: "tenant", Attribute: "tenant.id"},
+					{Header: "content-type", Target: "record"},
+				},
+				Backpressure: Backpressure{
+					Enabled:       true,
+					RetryInterval: 5 * time.Second,
+				},
 			},
 		},
 		{
@@ -100,6 +108,10 @@ func TestLoadConfig(t *testing.T) {
 					Enable:   true,
 					Interval: 1 * time.Second,
 				},
+				Backpressure: Backpressure{
+					Enabled:       false,
+					RetryInterval: 1 * time.Second,
+				},
 			},
 		},
 	}
@@ -118,3 +130,50 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateHeadersToAttributes(t *testing.T) {
+	tests := []struct {
+		name        string
+		mappings    []HeaderMapping
+		expectedErr string
+	}{
+		{
+			name:     "valid",
+			mappings: []HeaderMapping{{Header: "tenant"}, {Header: "source", Target: "resource"}, {Header: "content-type", Target: "record"}},
+		},
+		{
+			name:        "empty header",
+			mappings:    []HeaderMapping{{Header: "tenant"}, {Attribute: "source"}},
+			expectedErr: "headers_to_attributes[1]: header must not be empty",
+		},
+		{
+			name:        "invalid target",
+			mappings:    []HeaderMapping{{Header: "tenant", Target: "scope"}},
+			expectedErr: `headers_to_attributes[0]: target must be "resource" or "record", got "scope"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.HeadersToAttributes = tt.mappings
+			err := cfg.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateBackpressure(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Backpressure.RetryInterval = 0
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Backpressure.Enabled = true
+	assert.EqualError(t, cfg.Validate(), "backpressure: retry_interval must be positive, got 0s")
+
+	cfg.Backpressure.RetryInterval = time.Second
+	assert.NoError(t, cfg.Validate())
+}
@@ -47,6 +47,8 @@ const (
 	defaultAutoCommitEnable = true
 	// default from sarama.NewConfig()
 	defaultAutoCommitInterval = 1 * time.Second
+
+	defaultBackpressureRetryInterval = 1 * time.Second
 )
 
 // FactoryOption applies changes to kafkaExporterFactory.
@@ -123,6 +125,10 @@ func createDefaultConfig() config.Receiver {
 			After:   false,
 			OnError: false,
 		},
+		Backpressure: Backpressure{
+			Enabled:       false,
+			RetryInterval: defaultBackpressureRetryInterval,
+		},
 	}
 }
 
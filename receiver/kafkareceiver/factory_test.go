@@ -43,9 +43,10 @@ func TestCreateTracesReceiver(t *testing.T) {
 	cfg.ProtocolVersion = "2.0.0"
 	f := kafkaReceiverFactory{tracesUnmarshalers: defaultTracesUnmarshalers()}
 	r, err := f.createTracesReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, nil)
+	require.NoError(t, err)
 	// no available broker
-	require.Error(t, err)
-	assert.Nil(t, r)
+	require.Error(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, r.Shutdown(context.Background()))
 }
 
 func TestCreateTracesReceiver_error(t *testing.T) {
@@ -87,9 +88,10 @@ func TestCreateMetricsReceiver(t *testing.T) {
 	cfg.ProtocolVersion = "2.0.0"
 	f := kafkaReceiverFactory{metricsUnmarshalers: defaultMetricsUnmarshalers()}
 	r, err := f.createMetricsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, nil)
+	require.NoError(t, err)
 	// no available broker
-	require.Error(t, err)
-	assert.Nil(t, r)
+	require.Error(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, r.Shutdown(context.Background()))
 }
 
 func TestCreateMetricsReceiver_error(t *testing.T) {
@@ -131,9 +133,10 @@ func TestCreateLogsReceiver(t *testing.T) {
 	cfg.ProtocolVersion = "2.0.0"
 	f := kafkaReceiverFactory{logsUnmarshalers: defaultLogsUnmarshalers()}
 	r, err := f.createLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, nil)
+	require.NoError(t, err)
 	// no available broker
-	require.Error(t, err)
-	assert.Nil(t, r)
+	require.Error(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, r.Shutdown(context.Background()))
 }
 
 func TestCreateLogsReceiver_error(t *testing.T) {
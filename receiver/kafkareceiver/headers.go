@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
+
+import (
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// headerAttributes holds the attributes extracted from the headers of a message
+type headerAttributes struct {
+	resource map[string]string
+	record   map[string]string
+}
+
+// extractHeaderAttributes returns the attributes of the message headers listed in the mappings.
+// If a header is repeated, its last value is used.
+func extractHeaderAttributes(mappings []HeaderMapping, headers []*sarama.RecordHeader) headerAttributes {
+	var attrs headerAttributes
+	for _, mapping := range mappings {
+		var value []byte
+		found := false
+		for _, header := range headers {
+			if header != nil && string(header.Key) == mapping.Header {
+				value = header.Value
+				found = true
+			}
+		}
+		if !found {
+			continue
+		}
+
+		key := mapping.Attribute
+		if key == "" {
+			key = mapping.Header
+		}
+		if mapping.Target == headerTargetRecord {
+			if attrs.record == nil {
+				attrs.record = make(map[string]string)
+			}
+			attrs.record[key] = string(value)
+		} else {
+			if attrs.resource == nil {
+				attrs.resource = make(map[string]string)
+			}
+			attrs.resource[key] = string(value)
+		}
+	}
+	return attrs
+}
+
+func (h headerAttributes) empty() bool {
+	return len(h.resource) == 0 && len(h.record) == 0
+}
+
+func (h headerAttributes) applyTraces(traces ptrace.Traces) {
+	for i := 0; i < traces.ResourceSpans().Len(); i++ {
+		rs := traces.ResourceSpans().At(i)
+		upsertAll(rs.Resource().Attributes(), h.resource)
+		if len(h.record) == 0 {
+			continue
+		}
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				upsertAll(spans.At(k).Attributes(), h.record)
+			}
+		}
+	}
+}
+
+func (h headerAttributes) applyMetrics(metrics pmetric.Metrics) {
+	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+		rm := metrics.ResourceMetrics().At(i)
+		upsertAll(rm.Resource().Attributes(), h.resource)
+		if len(h.record) == 0 {
+			continue
+		}
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			ms := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				h.applyMetric(ms.At(k))
+			}
+		}
+	}
+}
+
+func (h headerAttributes) applyMetric(metric pmetric.Metric) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			upsertAll(dps.At(i).Attributes(), h.record)
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			upsertAll(dps.At(i).Attributes(), h.record)
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			upsertAll(dps.At(i).Attributes(), h.record)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			upsertAll(dps.At(i).Attributes(), h.record)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			upsertAll(dps.At(i).Attributes(), h.record)
+		}
+	}
+}
+
+func (h headerAttributes) applyLogs(logs plog.Logs) {
+	for i := 0; i < logs.ResourceLogs().Len(); i++ {
+		rl := logs.ResourceLogs().At(i)
+		upsertAll(rl.Resource().Attributes(), h.resource)
+		if len(h.record) == 0 {
+			continue
+		}
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			records := rl.ScopeLogs().At(j).LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				upsertAll(records.At(k).Attributes(), h.record)
+			}
+		}
+	}
+}
+
+func upsertAll(dest pcommon.Map, attrs map[string]string) {
+	for key, value := range attrs {
+		dest.PutStr(key, value)
+	}
+}
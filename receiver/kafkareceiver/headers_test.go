@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+)
+
+var testHeaderMappings = []HeaderMapping{
+	{Header: "tenant", Attribute: "tenant.id"},
+	{Header: "source", Target: headerTargetResource},
+	{Header: "content-type", Attribute: "kafka.content_type", Target: headerTargetRecord},
+	{Header: "missing"},
+}
+
+var testHeaders = []*sarama.RecordHeader{
+	{Key: []byte("tenant"), Value: []byte("first")},
+	{Key: []byte("tenant"), Value: []byte("acme")},
+	{Key: []byte("source"), Value: []byte("billing")},
+	{Key: []byte("content-type"), Value: []byte("application/x-protobuf")},
+	{Key: []byte("other"), Value: []byte("ignored")},
+	nil,
+}
+
+func TestExtractHeaderAttributes(t *testing.T) {
+	attrs := extractHeaderAttributes(testHeaderMappings, testHeaders)
+	assert.Equal(t, map[string]string{"tenant.id": "acme", "source": "billing"}, attrs.resource)
+	assert.Equal(t, map[string]string{"kafka.content_type": "application/x-protobuf"}, attrs.record)
+	assert.False(t, attrs.empty())
+
+	assert.True(t, extractHeaderAttributes(testHeaderMappings, nil).empty())
+	assert.True(t, extractHeaderAttributes(nil, testHeaders).empty())
+}
+
+func TestHeaderAttributesApply(t *testing.T) {
+	attrs := extractHeaderAttributes(testHeaderMappings, testHeaders)
+
+	traces := testdata.GenerateTracesTwoSpansSameResource()
+	attrs.applyTraces(traces)
+	rs := traces.ResourceSpans().At(0)
+	assertHeaderResourceAttributes(t, rs.Resource().Attributes())
+	spans := rs.ScopeSpans().At(0).Spans()
+	for i := 0; i < spans.Len(); i++ {
+		assertHeaderRecordAttributes(t, spans.At(i).Attributes())
+	}
+
+	metrics := testdata.GenerateMetricsAllTypesEmptyDataPoint()
+	attrs.applyMetrics(metrics)
+	rm := metrics.ResourceMetrics().At(0)
+	assertHeaderResourceAttributes(t, rm.Resource().Attributes())
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		for _, dpAttrs := range dataPointAttributes(ms.At(i)) {
+			assertHeaderRecordAttributes(t, dpAttrs)
+		}
+	}
+
+	logs := testdata.GenerateLogsTwoLogRecordsSameResource()
+	attrs.applyLogs(logs)
+	rl := logs.ResourceLogs().At(0)
+	assertHeaderResourceAttributes(t, rl.Resource().Attributes())
+	records := rl.ScopeLogs().At(0).LogRecords()
+	for i := 0; i < records.Len(); i++ {
+		assertHeaderRecordAttributes(t, records.At(i).Attributes())
+	}
+}
+
+func TestLogsConsumerGroupHandlerHeadersToAttributes(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	c := logsConsumerGroupHandler{
+		unmarshaler:         newPdataLogsUnmarshaler(plog.NewProtoUnmarshaler(), defaultEncoding),
+		logger:              zap.NewNop(),
+		ready:               make(chan bool),
+		nextConsumer:        sink,
+		obsrecv:             obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: componenttest.NewNopReceiverCreateSettings()}),
+		headersToAttributes: testHeaderMappings,
+	}
+
+	bts, err := plog.NewProtoMarshaler().MarshalLogs(testdata.GenerateLogsOneLogRecord())
+	require.NoError(t, err)
+
+	groupClaim := testConsumerGroupClaim{
+		messageChan: make(chan *sarama.ConsumerMessage),
+	}
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		require.NoError(t, c.ConsumeClaim(testConsumerGroupSession{}, groupClaim))
+		wg.Done()
+	}()
+
+	groupClaim.messageChan <- &sarama.ConsumerMessage{Value: bts, Headers: testHeaders}
+	close(groupClaim.messageChan)
+	wg.Wait()
+
+	require.Len(t, sink.AllLogs(), 1)
+	rl := sink.AllLogs()[0].ResourceLogs().At(0)
+	assertHeaderResourceAttributes(t, rl.Resource().Attributes())
+	assertHeaderRecordAttributes(t, rl.ScopeLogs().At(0).LogRecords().At(0).Attributes())
+}
+
+func assertHeaderResourceAttributes(t *testing.T, attrs pcommon.Map) {
+	tenant, ok := attrs.Get("tenant.id")
+	require.True(t, ok)
+	assert.Equal(t, "acme", tenant.Str())
+	source, ok := attrs.Get("source")
+	require.True(t, ok)
+	assert.Equal(t, "billing", source.Str())
+	_, ok = attrs.Get("kafka.content_type")
+	assert.False(t, ok)
+}
+
+func assertHeaderRecordAttributes(t *testing.T, attrs pcommon.Map) {
+	contentType, ok := attrs.Get("kafka.content_type")
+	require.True(t, ok)
+	assert.Equal(t, "application/x-protobuf", contentType.Str())
+	_, ok = attrs.Get("tenant.id")
+	assert.False(t, ok)
+}
+
+func dataPointAttributes(metric pmetric.Metric) []pcommon.Map {
+	var attrs []pcommon.Map
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < metric.Gauge().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Gauge().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Sum().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < metric.Histogram().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Histogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < metric.ExponentialHistogram().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.ExponentialHistogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < metric.Summary().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Summary().DataPoints().At(i).Attributes())
+		}
+	}
+	return attrs
+}
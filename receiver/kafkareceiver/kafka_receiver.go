@@ -40,6 +40,8 @@ var errUnrecognizedEncoding = fmt.Errorf("unrecognized encoding")
 // kafkaTracesConsumer uses sarama to consume and handle messages from kafka.
 type kafkaTracesConsumer struct {
 	id                config.ComponentID
+	config            Config
+	saramaConfig      *sarama.Config
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Traces
 	topics            []string
@@ -48,13 +50,16 @@ type kafkaTracesConsumer struct {
 
 	settings component.ReceiverCreateSettings
 
-	autocommitEnabled bool
-	messageMarking    MessageMarking
+	autocommitEnabled   bool
+	messageMarking      MessageMarking
+	headersToAttributes []HeaderMapping
 }
 
 // kafkaMetricsConsumer uses sarama to consume and handle messages from kafka.
 type kafkaMetricsConsumer struct {
 	id                config.ComponentID
+	config            Config
+	saramaConfig      *sarama.Config
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Metrics
 	topics            []string
@@ -63,13 +68,16 @@ type kafkaMetricsConsumer struct {
 
 	settings component.ReceiverCreateSettings
 
-	autocommitEnabled bool
-	messageMarking    MessageMarking
+	autocommitEnabled   bool
+	messageMarking      MessageMarking
+	headersToAttributes []HeaderMapping
 }
 
 // kafkaLogsConsumer uses sarama to consume and handle messages from kafka.
 type kafkaLogsConsumer struct {
 	id                config.ComponentID
+	config            Config
+	saramaConfig      *sarama.Config
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Logs
 	topics            []string
@@ -78,14 +86,24 @@ type kafkaLogsConsumer struct {
 
 	settings component.ReceiverCreateSettings
 
-	autocommitEnabled bool
-	messageMarking    MessageMarking
+	autocommitEnabled   bool
+	messageMarking      MessageMarking
+	headersToAttributes []HeaderMapping
 }
 
 var _ component.Receiver = (*kafkaTracesConsumer)(nil)
 var _ component.Receiver = (*kafkaMetricsConsumer)(nil)
 var _ component.Receiver = (*kafkaLogsConsumer)(nil)
 
+// newConsumerGroup creates the consumer group when the receiver starts, as the authenticators
+// of the OAUTHBEARER SASL mechanism are extensions of the host.
+func newConsumerGroup(config Config, saramaConfig *sarama.Config, host component.Host) (sarama.ConsumerGroup, error) {
+	if err := kafkaexporter.ResolveAuthenticator(saramaConfig, host); err != nil {
+		return nil, err
+	}
+	return sarama.NewConsumerGroup(config.Brokers, config.GroupID, saramaConfig)
+}
+
 func newTracesReceiver(config Config, set component.ReceiverCreateSettings, unmarshalers map[string]TracesUnmarshaler, nextConsumer consumer.Traces) (*kafkaTracesConsumer, error) {
 	unmarshaler := unmarshalers[config.Encoding]
 	if unmarshaler == nil {
@@ -107,23 +125,28 @@ func newTracesReceiver(config Config, set component.ReceiverCreateSettings, unma
 	if err := kafkaexporter.ConfigureAuthentication(config.Authentication, c); err != nil {
 		return nil, err
 	}
-	client, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
-	if err != nil {
-		return nil, err
-	}
 	return &kafkaTracesConsumer{
-		id:                config.ID(),
-		consumerGroup:     client,
-		topics:            []string{config.Topic},
-		nextConsumer:      nextConsumer,
-		unmarshaler:       unmarshaler,
-		settings:          set,
-		autocommitEnabled: config.AutoCommit.Enable,
-		messageMarking:    config.MessageMarking,
+		id:                  config.ID(),
+		config:              config,
+		saramaConfig:        c,
+		topics:              []string{config.Topic},
+		nextConsumer:        nextConsumer,
+		unmarshaler:         unmarshaler,
+		settings:            set,
+		autocommitEnabled:   config.AutoCommit.Enable,
+		messageMarking:      config.MessageMarking,
+		headersToAttributes: config.HeadersToAttributes,
 	}, nil
 }
 
-func (c *kafkaTracesConsumer) Start(context.Context, component.Host) error {
+func (c *kafkaTracesConsumer) Start(_ context.Context, host component.Host) error {
+	if c.consumerGroup == nil {
+		consumerGroup, err := newConsumerGroup(c.config, c.saramaConfig, host)
+		if err != nil {
+			return err
+		}
+		c.consumerGroup = consumerGroup
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 	c.cancelConsumeLoop = cancel
 	consumerGroup := &tracesConsumerGroupHandler{
@@ -137,8 +160,15 @@ func (c *kafkaTracesConsumer) Start(context.Context, component.Host) error {
 			Transport:              transport,
 			ReceiverCreateSettings: c.settings,
 		}),
-		autocommitEnabled: c.autocommitEnabled,
-		messageMarking:    c.messageMarking,
+		autocommitEnabled:   c.autocommitEnabled,
+		messageMarking:      c.messageMarking,
+		headersToAttributes: c.headersToAttributes,
+		backpressure: backpressure{
+			id:     c.id,
+			cfg:    c.config.Backpressure,
+			pauser: c.consumerGroup,
+			logger: c.settings.Logger,
+		},
 	}
 	go c.consumeLoop(ctx, consumerGroup) // nolint:errcheck
 	<-consumerGroup.ready
@@ -162,6 +192,9 @@ func (c *kafkaTracesConsumer) consumeLoop(ctx context.Context, handler sarama.Co
 }
 
 func (c *kafkaTracesConsumer) Shutdown(context.Context) error {
+	if c.cancelConsumeLoop == nil {
+		return nil
+	}
 	c.cancelConsumeLoop()
 	return c.consumerGroup.Close()
 }
@@ -190,23 +223,28 @@ func newMetricsReceiver(config Config, set component.ReceiverCreateSettings, unm
 	if err := kafkaexporter.ConfigureAuthentication(config.Authentication, c); err != nil {
 		return nil, err
 	}
-	client, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
-	if err != nil {
-		return nil, err
-	}
 	return &kafkaMetricsConsumer{
-		id:                config.ID(),
-		consumerGroup:     client,
-		topics:            []string{config.Topic},
-		nextConsumer:      nextConsumer,
-		unmarshaler:       unmarshaler,
-		settings:          set,
-		autocommitEnabled: config.AutoCommit.Enable,
-		messageMarking:    config.MessageMarking,
+		id:                  config.ID(),
+		config:              config,
+		saramaConfig:        c,
+		topics:              []string{config.Topic},
+		nextConsumer:        nextConsumer,
+		unmarshaler:         unmarshaler,
+		settings:            set,
+		autocommitEnabled:   config.AutoCommit.Enable,
+		messageMarking:      config.MessageMarking,
+		headersToAttributes: config.HeadersToAttributes,
 	}, nil
 }
 
-func (c *kafkaMetricsConsumer) Start(context.Context, component.Host) error {
+func (c *kafkaMetricsConsumer) Start(_ context.Context, host component.Host) error {
+	if c.consumerGroup == nil {
+		consumerGroup, err := newConsumerGroup(c.config, c.saramaConfig, host)
+		if err != nil {
+			return err
+		}
+		c.consumerGroup = consumerGroup
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 	c.cancelConsumeLoop = cancel
 	metricsConsumerGroup := &metricsConsumerGroupHandler{
@@ -220,8 +258,15 @@ func (c *kafkaMetricsConsumer) Start(context.Context, component.Host) error {
 			Transport:              transport,
 			ReceiverCreateSettings: c.settings,
 		}),
-		autocommitEnabled: c.autocommitEnabled,
-		messageMarking:    c.messageMarking,
+		autocommitEnabled:   c.autocommitEnabled,
+		messageMarking:      c.messageMarking,
+		headersToAttributes: c.headersToAttributes,
+		backpressure: backpressure{
+			id:     c.id,
+			cfg:    c.config.Backpressure,
+			pauser: c.consumerGroup,
+			logger: c.settings.Logger,
+		},
 	}
 	go c.consumeLoop(ctx, metricsConsumerGroup) // nolint:errcheck
 	<-metricsConsumerGroup.ready
@@ -245,6 +290,9 @@ func (c *kafkaMetricsConsumer) consumeLoop(ctx context.Context, handler sarama.C
 }
 
 func (c *kafkaMetricsConsumer) Shutdown(context.Context) error {
+	if c.cancelConsumeLoop == nil {
+		return nil
+	}
 	c.cancelConsumeLoop()
 	return c.consumerGroup.Close()
 }
@@ -270,23 +318,28 @@ func newLogsReceiver(config Config, set component.ReceiverCreateSettings, unmars
 	if err := kafkaexporter.ConfigureAuthentication(config.Authentication, c); err != nil {
 		return nil, err
 	}
-	client, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
-	if err != nil {
-		return nil, err
-	}
 	return &kafkaLogsConsumer{
-		id:                config.ID(),
-		consumerGroup:     client,
-		topics:            []string{config.Topic},
-		nextConsumer:      nextConsumer,
-		unmarshaler:       unmarshaler,
-		settings:          set,
-		autocommitEnabled: config.AutoCommit.Enable,
-		messageMarking:    config.MessageMarking,
+		id:                  config.ID(),
+		config:              config,
+		saramaConfig:        c,
+		topics:              []string{config.Topic},
+		nextConsumer:        nextConsumer,
+		unmarshaler:         unmarshaler,
+		settings:            set,
+		autocommitEnabled:   config.AutoCommit.Enable,
+		messageMarking:      config.MessageMarking,
+		headersToAttributes: config.HeadersToAttributes,
 	}, nil
 }
 
-func (c *kafkaLogsConsumer) Start(context.Context, component.Host) error {
+func (c *kafkaLogsConsumer) Start(_ context.Context, host component.Host) error {
+	if c.consumerGroup == nil {
+		consumerGroup, err := newConsumerGroup(c.config, c.saramaConfig, host)
+		if err != nil {
+			return err
+		}
+		c.consumerGroup = consumerGroup
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 	c.cancelConsumeLoop = cancel
 	logsConsumerGroup := &logsConsumerGroupHandler{
@@ -300,8 +353,15 @@ func (c *kafkaLogsConsumer) Start(context.Context, component.Host) error {
 			Transport:              transport,
 			ReceiverCreateSettings: c.settings,
 		}),
-		autocommitEnabled: c.autocommitEnabled,
-		messageMarking:    c.messageMarking,
+		autocommitEnabled:   c.autocommitEnabled,
+		messageMarking:      c.messageMarking,
+		headersToAttributes: c.headersToAttributes,
+		backpressure: backpressure{
+			id:     c.id,
+			cfg:    c.config.Backpressure,
+			pauser: c.consumerGroup,
+			logger: c.settings.Logger,
+		},
 	}
 	go c.consumeLoop(ctx, logsConsumerGroup) // nolint:errcheck
 	<-logsConsumerGroup.ready
@@ -325,6 +385,9 @@ func (c *kafkaLogsConsumer) consumeLoop(ctx context.Context, handler sarama.Cons
 }
 
 func (c *kafkaLogsConsumer) Shutdown(context.Context) error {
+	if c.cancelConsumeLoop == nil {
+		return nil
+	}
 	c.cancelConsumeLoop()
 	return c.consumerGroup.Close()
 }
@@ -340,8 +403,10 @@ type tracesConsumerGroupHandler struct {
 
 	obsrecv *obsreport.Receiver
 
-	autocommitEnabled bool
-	messageMarking    MessageMarking
+	autocommitEnabled   bool
+	messageMarking      MessageMarking
+	headersToAttributes []HeaderMapping
+	backpressure        backpressure
 }
 
 type metricsConsumerGroupHandler struct {
@@ -355,8 +420,10 @@ type metricsConsumerGroupHandler struct {
 
 	obsrecv *obsreport.Receiver
 
-	autocommitEnabled bool
-	messageMarking    MessageMarking
+	autocommitEnabled   bool
+	messageMarking      MessageMarking
+	headersToAttributes []HeaderMapping
+	backpressure        backpressure
 }
 
 type logsConsumerGroupHandler struct {
@@ -370,8 +437,10 @@ type logsConsumerGroupHandler struct {
 
 	obsrecv *obsreport.Receiver
 
-	autocommitEnabled bool
-	messageMarking    MessageMarking
+	autocommitEnabled   bool
+	messageMarking      MessageMarking
+	headersToAttributes []HeaderMapping
+	backpressure        backpressure
 }
 
 var _ sarama.ConsumerGroupHandler = (*tracesConsumerGroupHandler)(nil)
@@ -408,7 +477,7 @@ func (c *tracesConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSe
 		}
 
 		ctx := c.obsrecv.StartTracesOp(session.Context())
-		statsTags := []tag.Mutator{tag.Upsert(tagInstanceName, c.id.String())}
+		statsTags := partitionStatsTags(c.id, claim)
 		_ = stats.RecordWithTags(ctx, statsTags,
 			statMessageCount.M(1),
 			statMessageOffset.M(message.Offset),
@@ -423,8 +492,14 @@ func (c *tracesConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSe
 			return err
 		}
 
+		if attrs := extractHeaderAttributes(c.headersToAttributes, message.Headers); !attrs.empty() {
+			attrs.applyTraces(traces)
+		}
+
 		spanCount := traces.SpanCount()
-		err = c.nextConsumer.ConsumeTraces(session.Context(), traces)
+		err = c.backpressure.deliver(session, claim, func() error {
+			return c.nextConsumer.ConsumeTraces(session.Context(), traces)
+		})
 		c.obsrecv.EndTracesOp(ctx, c.unmarshaler.Encoding(), spanCount, err)
 		if err != nil {
 			if c.messageMarking.After && c.messageMarking.OnError {
@@ -473,7 +548,7 @@ func (c *metricsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupS
 		}
 
 		ctx := c.obsrecv.StartMetricsOp(session.Context())
-		statsTags := []tag.Mutator{tag.Upsert(tagInstanceName, c.id.String())}
+		statsTags := partitionStatsTags(c.id, claim)
 		_ = stats.RecordWithTags(ctx, statsTags,
 			statMessageCount.M(1),
 			statMessageOffset.M(message.Offset),
@@ -488,8 +563,14 @@ func (c *metricsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupS
 			return err
 		}
 
+		if attrs := extractHeaderAttributes(c.headersToAttributes, message.Headers); !attrs.empty() {
+			attrs.applyMetrics(metrics)
+		}
+
 		dataPointCount := metrics.DataPointCount()
-		err = c.nextConsumer.ConsumeMetrics(session.Context(), metrics)
+		err = c.backpressure.deliver(session, claim, func() error {
+			return c.nextConsumer.ConsumeMetrics(session.Context(), metrics)
+		})
 		c.obsrecv.EndMetricsOp(ctx, c.unmarshaler.Encoding(), dataPointCount, err)
 		if err != nil {
 			if c.messageMarking.After && c.messageMarking.OnError {
@@ -543,7 +624,7 @@ func (c *logsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSess
 		ctx := c.obsrecv.StartLogsOp(session.Context())
 		_ = stats.RecordWithTags(
 			ctx,
-			[]tag.Mutator{tag.Upsert(tagInstanceName, c.id.String())},
+			partitionStatsTags(c.id, claim),
 			statMessageCount.M(1),
 			statMessageOffset.M(message.Offset),
 			statMessageOffsetLag.M(claim.HighWaterMarkOffset()-message.Offset-1))
@@ -557,7 +638,13 @@ func (c *logsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSess
 			return err
 		}
 
-		err = c.nextConsumer.ConsumeLogs(session.Context(), logs)
+		if attrs := extractHeaderAttributes(c.headersToAttributes, message.Headers); !attrs.empty() {
+			attrs.applyLogs(logs)
+		}
+
+		err = c.backpressure.deliver(session, claim, func() error {
+			return c.nextConsumer.ConsumeLogs(session.Context(), logs)
+		})
 		// TODO
 		c.obsrecv.EndLogsOp(ctx, c.unmarshaler.Encoding(), logs.LogRecordCount(), err)
 		if err != nil {
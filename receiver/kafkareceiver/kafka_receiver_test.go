@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/obsreport"
@@ -81,6 +82,23 @@ func TestNewTracesReceiver_err_auth_type(t *testing.T) {
 	assert.Nil(t, r)
 }
 
+func TestTracesReceiverStart_authenticator_not_found(t *testing.T) {
+	c := Config{
+		ProtocolVersion: "2.0.0",
+		Authentication: kafkaexporter.Authentication{
+			SASL: &kafkaexporter.SASLConfig{
+				Mechanism:   "OAUTHBEARER",
+				OAuthBearer: kafkaexporter.OAuthBearerConfig{Authenticator: config.NewComponentID("oauth2client")},
+			},
+		},
+		Encoding: defaultEncoding,
+	}
+	r, err := newTracesReceiver(c, componenttest.NewNopReceiverCreateSettings(), defaultTracesUnmarshalers(), consumertest.NewNop())
+	require.NoError(t, err)
+	assert.EqualError(t, r.Start(context.Background(), componenttest.NewNopHost()), `authenticator "oauth2client" not found`)
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
+
 func TestTracesReceiverStart(t *testing.T) {
 	c := kafkaTracesConsumer{
 		nextConsumer:  consumertest.NewNop(),
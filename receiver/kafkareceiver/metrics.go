@@ -15,13 +15,19 @@
 package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
 
 import (
+	"strconv"
+
+	"github.com/Shopify/sarama"
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/config"
 )
 
 var (
 	tagInstanceName, _ = tag.NewKey("name")
+	tagTopic, _        = tag.NewKey("topic")
+	tagPartition, _    = tag.NewKey("partition")
 
 	statMessageCount     = stats.Int64("kafka_receiver_messages", "Number of received messages", stats.UnitDimensionless)
 	statMessageOffset    = stats.Int64("kafka_receiver_current_offset", "Current message offset", stats.UnitDimensionless)
@@ -29,11 +35,15 @@ var (
 
 	statPartitionStart = stats.Int64("kafka_receiver_partition_start", "Number of started partitions", stats.UnitDimensionless)
 	statPartitionClose = stats.Int64("kafka_receiver_partition_close", "Number of finished partitions", stats.UnitDimensionless)
+
+	statPartitionPaused = stats.Int64("kafka_receiver_partition_paused", "Whether the partition is paused because the next consumer refuses the data", stats.UnitDimensionless)
+	statPartitionPauses = stats.Int64("kafka_receiver_partition_pauses", "Number of times the partition was paused", stats.UnitDimensionless)
 )
 
 // MetricViews return metric views for Kafka receiver.
 func MetricViews() []*view.View {
 	tagKeys := []tag.Key{tagInstanceName}
+	partitionTagKeys := []tag.Key{tagInstanceName, tagTopic, tagPartition}
 
 	countMessages := &view.View{
 		Name:        statMessageCount.Name(),
@@ -47,7 +57,7 @@ func MetricViews() []*view.View {
 		Name:        statMessageOffset.Name(),
 		Measure:     statMessageOffset,
 		Description: statMessageOffset.Description(),
-		TagKeys:     tagKeys,
+		TagKeys:     partitionTagKeys,
 		Aggregation: view.LastValue(),
 	}
 
@@ -55,7 +65,7 @@ func MetricViews() []*view.View {
 		Name:        statMessageOffsetLag.Name(),
 		Measure:     statMessageOffsetLag,
 		Description: statMessageOffsetLag.Description(),
-		TagKeys:     tagKeys,
+		TagKeys:     partitionTagKeys,
 		Aggregation: view.LastValue(),
 	}
 
@@ -75,11 +85,38 @@ func MetricViews() []*view.View {
 		Aggregation: view.Sum(),
 	}
 
+	lastValuePartitionPaused := &view.View{
+		Name:        statPartitionPaused.Name(),
+		Measure:     statPartitionPaused,
+		Description: statPartitionPaused.Description(),
+		TagKeys:     partitionTagKeys,
+		Aggregation: view.LastValue(),
+	}
+
+	countPartitionPauses := &view.View{
+		Name:        statPartitionPauses.Name(),
+		Measure:     statPartitionPauses,
+		Description: statPartitionPauses.Description(),
+		TagKeys:     partitionTagKeys,
+		Aggregation: view.Sum(),
+	}
+
 	return []*view.View{
 		countMessages,
 		lastValueOffset,
 		lastValueOffsetLag,
 		countPartitionStart,
 		countPartitionClose,
+		lastValuePartitionPaused,
+		countPartitionPauses,
+	}
+}
+
+// partitionStatsTags returns the tags of the metrics of the partition of a claim
+func partitionStatsTags(id config.ComponentID, claim sarama.ConsumerGroupClaim) []tag.Mutator {
+	return []tag.Mutator{
+		tag.Upsert(tagInstanceName, id.String()),
+		tag.Upsert(tagTopic, claim.Topic()),
+		tag.Upsert(tagPartition, strconv.FormatInt(int64(claim.Partition()), 10)),
 	}
 }
@@ -108,6 +108,13 @@ func (a *metricDataAccumulator) containerStats(sPod stats.PodStats, s stats.Cont
 	addCPUMetrics(a.mbs.ContainerMetricsBuilder, metadata.ContainerCPUMetrics, s.CPU, currentTime)
 	addMemoryMetrics(a.mbs.ContainerMetricsBuilder, metadata.ContainerMemoryMetrics, s.Memory, currentTime)
 	addFilesystemMetrics(a.mbs.ContainerMetricsBuilder, metadata.ContainerFilesystemMetrics, s.Rootfs, currentTime)
+	addCPUThrottlingMetrics(a.mbs.ContainerMetricsBuilder, a.metadata.CPUThrottling[ContainerKey{
+		Namespace: sPod.PodRef.Namespace,
+		Pod:       sPod.PodRef.Name,
+		Container: s.Name,
+	}], currentTime)
+	limit, hasLimit := a.metadata.getContainerEphemeralStorageLimit(sPod.PodRef.UID, s.Name)
+	addEphemeralStorageMetrics(a.mbs.ContainerMetricsBuilder, s, limit, hasLimit, currentTime)
 
 	a.m = append(a.m, a.mbs.ContainerMetricsBuilder.Emit(ro...))
 }
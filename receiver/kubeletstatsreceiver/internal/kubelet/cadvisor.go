@@ -0,0 +1,168 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	cfsPeriodsMetric          = "container_cpu_cfs_periods_total"
+	cfsThrottledPeriodsMetric = "container_cpu_cfs_throttled_periods_total"
+	cfsThrottledSecondsMetric = "container_cpu_cfs_throttled_seconds_total"
+)
+
+// ContainerKey identifies a container in the stats reported by cAdvisor.
+type ContainerKey struct {
+	Namespace string
+	Pod       string
+	Container string
+}
+
+// CPUThrottlingStats holds the CFS throttling counters of a container.
+type CPUThrottlingStats struct {
+	Periods          uint64
+	ThrottledPeriods uint64
+	ThrottledSeconds float64
+}
+
+// CadvisorProvider wraps a RestClient, returning the container stats
+// that are only exposed by the kubelet /metrics/cadvisor endpoint.
+type CadvisorProvider struct {
+	rc RestClient
+}
+
+func NewCadvisorProvider(rc RestClient) *CadvisorProvider {
+	return &CadvisorProvider{rc: rc}
+}
+
+// CPUThrottling calls the /metrics/cadvisor endpoint and returns the
+// CPU throttling stats of every container found in the results.
+func (p *CadvisorProvider) CPUThrottling() (map[ContainerKey]*CPUThrottlingStats, error) {
+	data, err := p.rc.Cadvisor()
+	if err != nil {
+		return nil, err
+	}
+	return parseCPUThrottling(data)
+}
+
+// parseCPUThrottling extracts the CFS throttling samples from the
+// Prometheus text exposition format returned by cAdvisor.
+func parseCPUThrottling(data []byte) (map[ContainerKey]*CPUThrottlingStats, error) {
+	out := map[ContainerKey]*CPUThrottlingStats{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name := line
+		if i := strings.IndexAny(line, "{ "); i >= 0 {
+			name = line[:i]
+		}
+		if name != cfsPeriodsMetric && name != cfsThrottledPeriodsMetric && name != cfsThrottledSecondsMetric {
+			continue
+		}
+
+		labels, rest, err := parseLabels(line[len(name):])
+		if err != nil {
+			return nil, fmt.Errorf("invalid sample %q: %w", line, err)
+		}
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("invalid sample %q: missing value", line)
+		}
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sample %q: %w", line, err)
+		}
+
+		// Samples without a container name are reported for the pod cgroup.
+		if labels["container"] == "" || labels["container"] == "POD" {
+			continue
+		}
+		key := ContainerKey{
+			Namespace: labels["namespace"],
+			Pod:       labels["pod"],
+			Container: labels["container"],
+		}
+		s, ok := out[key]
+		if !ok {
+			s = &CPUThrottlingStats{}
+			out[key] = s
+		}
+		switch name {
+		case cfsPeriodsMetric:
+			s.Periods = uint64(value)
+		case cfsThrottledPeriodsMetric:
+			s.ThrottledPeriods = uint64(value)
+		case cfsThrottledSecondsMetric:
+			s.ThrottledSeconds = value
+		}
+	}
+	return out, scanner.Err()
+}
+
+// parseLabels parses the optional label set at the beginning of s and
+// returns the labels and the remaining part of s.
+func parseLabels(s string) (map[string]string, string, error) {
+	labels := map[string]string{}
+	if !strings.HasPrefix(s, "{") {
+		return labels, s, nil
+	}
+
+	i := 1
+	for {
+		for i < len(s) && (s[i] == ' ' || s[i] == ',') {
+			i++
+		}
+		if i >= len(s) {
+			return nil, "", fmt.Errorf("unterminated label set")
+		}
+		if s[i] == '}' {
+			return labels, s[i+1:], nil
+		}
+
+		eq := strings.IndexByte(s[i:], '=')
+		if eq < 0 || i+eq+1 >= len(s) || s[i+eq+1] != '"' {
+			return nil, "", fmt.Errorf("invalid label at offset %d", i)
+		}
+		key := strings.TrimSpace(s[i : i+eq])
+		i += eq + 2
+
+		var value strings.Builder
+		for ; i < len(s) && s[i] != '"'; i++ {
+			if s[i] == '\\' && i+1 < len(s) {
+				i++
+				if s[i] == 'n' {
+					value.WriteByte('\n')
+					continue
+				}
+			}
+			value.WriteByte(s[i])
+		}
+		if i >= len(s) {
+			return nil, "", fmt.Errorf("unterminated value of label %q", key)
+		}
+		labels[key] = value.String()
+		i++
+	}
+}
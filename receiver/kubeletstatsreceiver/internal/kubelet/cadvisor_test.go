@@ -0,0 +1,106 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPUThrottling(t *testing.T) {
+	provider := NewCadvisorProvider(&fakeRestClient{})
+	throttling, err := provider.CPUThrottling()
+	require.NoError(t, err)
+	assert.Equal(t, map[ContainerKey]*CPUThrottlingStats{
+		{Namespace: "default", Pod: "go-hello-world-5456b4b8cd-99vxc", Container: "server"}: {
+			Periods:          1508,
+			ThrottledPeriods: 312,
+			ThrottledSeconds: 27.513,
+		},
+		{Namespace: "kube-system", Pod: "coredns-66bff467f8-szddj", Container: "coredns"}: {
+			Periods: 30210,
+		},
+	}, throttling)
+}
+
+func TestCPUThrottlingError(t *testing.T) {
+	provider := NewCadvisorProvider(&failingRestClient{})
+	_, err := provider.CPUThrottling()
+	require.Error(t, err)
+}
+
+func TestParseCPUThrottling(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    map[ContainerKey]*CPUThrottlingStats
+		wantErr string
+	}{
+		{
+			name: "escaped_label_values",
+			data: `container_cpu_cfs_periods_total{container="app",namespace="ns",pod="a\"b\\c,d}"} 42` + "\n",
+			want: map[ContainerKey]*CPUThrottlingStats{
+				{Namespace: "ns", Pod: `a"b\c,d}`, Container: "app"}: {Periods: 42},
+			},
+		},
+		{
+			name: "other_metrics_ignored",
+			data: "# TYPE container_cpu_cfs_periods_total counter\n" +
+				`container_cpu_cfs_periods_totals{container="app",namespace="ns",pod="p"} 1` + "\n" +
+				`container_memory_usage_bytes{container="app",namespace="ns",pod="p"} 1` + "\n",
+			want: map[ContainerKey]*CPUThrottlingStats{},
+		},
+		{
+			name:    "invalid_value",
+			data:    `container_cpu_cfs_periods_total{container="app",namespace="ns",pod="p"} abc` + "\n",
+			wantErr: "invalid sample",
+		},
+		{
+			name:    "unterminated_labels",
+			data:    `container_cpu_cfs_periods_total{container="app",namespace="ns"` + "\n",
+			wantErr: "invalid sample",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCPUThrottling([]byte(tt.data))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+type failingRestClient struct{}
+
+func (f failingRestClient) StatsSummary() ([]byte, error) {
+	return os.ReadFile("../../testdata/stats-summary.json")
+}
+
+func (f failingRestClient) Pods() ([]byte, error) {
+	return os.ReadFile("../../testdata/pods.json")
+}
+
+func (f failingRestClient) Cadvisor() ([]byte, error) {
+	return nil, errors.New("failed")
+}
@@ -29,6 +29,15 @@ func addCPUMetrics(mb *metadata.MetricsBuilder, cpuMetrics metadata.CPUMetrics,
 	addCPUTimeMetric(mb, cpuMetrics.Time, s, currentTime)
 }
 
+func addCPUThrottlingMetrics(mb *metadata.MetricsBuilder, s *CPUThrottlingStats, currentTime pcommon.Timestamp) {
+	if s == nil {
+		return
+	}
+	mb.RecordContainerCPUThrottlingPeriodsDataPoint(currentTime, int64(s.Periods))
+	mb.RecordContainerCPUThrottlingThrottledPeriodsDataPoint(currentTime, int64(s.ThrottledPeriods))
+	mb.RecordContainerCPUThrottlingThrottledTimeDataPoint(currentTime, s.ThrottledSeconds)
+}
+
 func addCPUUsageMetric(mb *metadata.MetricsBuilder, recordDataPoint metadata.RecordDoubleDataPointFunc, s *stats.CPUStats, currentTime pcommon.Timestamp) {
 	if s.UsageNanoCores == nil {
 		return
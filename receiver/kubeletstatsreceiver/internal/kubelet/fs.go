@@ -30,3 +30,26 @@ func addFilesystemMetrics(mb *metadata.MetricsBuilder, filesystemMetrics metadat
 	recordIntDataPoint(mb, filesystemMetrics.Capacity, s.CapacityBytes, currentTime)
 	recordIntDataPoint(mb, filesystemMetrics.Usage, s.UsedBytes, currentTime)
 }
+
+// addEphemeralStorageMetrics records the ephemeral storage usage of a container, which the kubelet
+// computes as the usage of its writable layer and of its logs, and compares it to its limit.
+func addEphemeralStorageMetrics(mb *metadata.MetricsBuilder, s stats.ContainerStats, limit int64, hasLimit bool, currentTime pcommon.Timestamp) {
+	if hasLimit {
+		mb.RecordContainerEphemeralStorageLimitDataPoint(currentTime, limit)
+	}
+
+	if (s.Rootfs == nil || s.Rootfs.UsedBytes == nil) && (s.Logs == nil || s.Logs.UsedBytes == nil) {
+		return
+	}
+	var usage uint64
+	if s.Rootfs != nil && s.Rootfs.UsedBytes != nil {
+		usage += *s.Rootfs.UsedBytes
+	}
+	if s.Logs != nil && s.Logs.UsedBytes != nil {
+		usage += *s.Logs.UsedBytes
+	}
+	mb.RecordContainerEphemeralStorageUsageDataPoint(currentTime, int64(usage))
+	if hasLimit && limit > 0 {
+		mb.RecordContainerEphemeralStorageLimitUtilizationDataPoint(currentTime, float64(usage)/float64(limit))
+	}
+}
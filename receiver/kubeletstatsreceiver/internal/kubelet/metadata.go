@@ -60,6 +60,8 @@ type Metadata struct {
 	Labels                    map[MetadataLabel]bool
 	PodsMetadata              *v1.PodList
 	DetailedPVCResourceGetter func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error)
+	// CPUThrottling holds the container CPU throttling stats fetched from cAdvisor, if any.
+	CPUThrottling map[ContainerKey]*CPUThrottlingStats
 }
 
 func NewMetadata(
@@ -152,6 +154,32 @@ func stripContainerID(id string) string {
 	return containerSchemeRegexp.ReplaceAllString(id, "")
 }
 
+// getContainerEphemeralStorageLimit retrieves the ephemeral storage limit of the container
+// with the given name in the pod with the given UID, if pods metadata were fetched and
+// the container has such a limit.
+func (m *Metadata) getContainerEphemeralStorageLimit(podUID string, containerName string) (int64, bool) {
+	if m.PodsMetadata == nil {
+		return 0, false
+	}
+	uid := types.UID(podUID)
+	for _, pod := range m.PodsMetadata.Items {
+		if pod.UID != uid {
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			if container.Name != containerName {
+				continue
+			}
+			limit, ok := container.Resources.Limits[v1.ResourceEphemeralStorage]
+			if !ok {
+				return 0, false
+			}
+			return limit.Value(), true
+		}
+	}
+	return 0, false
+}
+
 func (m *Metadata) getPodVolume(podUID string, volumeName string) (v1.Volume, error) {
 	for _, pod := range m.PodsMetadata.Items {
 		if pod.UID == types.UID(podUID) {
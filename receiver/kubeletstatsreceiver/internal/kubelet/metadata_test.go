@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 
@@ -382,3 +383,71 @@ func TestSetExtraLabelsForVolumeTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestGetContainerEphemeralStorageLimit(t *testing.T) {
+	podsMetadata := &v1.PodList{
+		Items: []v1.Pod{
+			{
+				ObjectMeta: metav1.ObjectMeta{UID: "uid-1234"},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name: "with-limit",
+							Resources: v1.ResourceRequirements{
+								Limits: v1.ResourceList{
+									v1.ResourceEphemeralStorage: resource.MustParse("2Gi"),
+								},
+							},
+						},
+						{
+							Name: "without-limit",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		podsMetadata  *v1.PodList
+		podUID        string
+		containerName string
+		wantLimit     int64
+		wantOK        bool
+	}{
+		{
+			name:          "with_limit",
+			podsMetadata:  podsMetadata,
+			podUID:        "uid-1234",
+			containerName: "with-limit",
+			wantLimit:     2 << 30,
+			wantOK:        true,
+		},
+		{
+			name:          "without_limit",
+			podsMetadata:  podsMetadata,
+			podUID:        "uid-1234",
+			containerName: "without-limit",
+		},
+		{
+			name:          "unknown_pod",
+			podsMetadata:  podsMetadata,
+			podUID:        "uid-5678",
+			containerName: "with-limit",
+		},
+		{
+			name:          "no_metadata",
+			podUID:        "uid-1234",
+			containerName: "with-limit",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := NewMetadata(nil, tt.podsMetadata, nil)
+			limit, ok := md.getContainerEphemeralStorageLimit(tt.podUID, tt.containerName)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantLimit, limit)
+		})
+	}
+}
@@ -17,58 +17,82 @@ type MetricSettings struct {
 
 // MetricsSettings provides settings for kubeletstatsreceiver metrics.
 type MetricsSettings struct {
-	ContainerCPUTime               MetricSettings `mapstructure:"container.cpu.time"`
-	ContainerCPUUtilization        MetricSettings `mapstructure:"container.cpu.utilization"`
-	ContainerFilesystemAvailable   MetricSettings `mapstructure:"container.filesystem.available"`
-	ContainerFilesystemCapacity    MetricSettings `mapstructure:"container.filesystem.capacity"`
-	ContainerFilesystemUsage       MetricSettings `mapstructure:"container.filesystem.usage"`
-	ContainerMemoryAvailable       MetricSettings `mapstructure:"container.memory.available"`
-	ContainerMemoryMajorPageFaults MetricSettings `mapstructure:"container.memory.major_page_faults"`
-	ContainerMemoryPageFaults      MetricSettings `mapstructure:"container.memory.page_faults"`
-	ContainerMemoryRss             MetricSettings `mapstructure:"container.memory.rss"`
-	ContainerMemoryUsage           MetricSettings `mapstructure:"container.memory.usage"`
-	ContainerMemoryWorkingSet      MetricSettings `mapstructure:"container.memory.working_set"`
-	K8sNodeCPUTime                 MetricSettings `mapstructure:"k8s.node.cpu.time"`
-	K8sNodeCPUUtilization          MetricSettings `mapstructure:"k8s.node.cpu.utilization"`
-	K8sNodeFilesystemAvailable     MetricSettings `mapstructure:"k8s.node.filesystem.available"`
-	K8sNodeFilesystemCapacity      MetricSettings `mapstructure:"k8s.node.filesystem.capacity"`
-	K8sNodeFilesystemUsage         MetricSettings `mapstructure:"k8s.node.filesystem.usage"`
-	K8sNodeMemoryAvailable         MetricSettings `mapstructure:"k8s.node.memory.available"`
-	K8sNodeMemoryMajorPageFaults   MetricSettings `mapstructure:"k8s.node.memory.major_page_faults"`
-	K8sNodeMemoryPageFaults        MetricSettings `mapstructure:"k8s.node.memory.page_faults"`
-	K8sNodeMemoryRss               MetricSettings `mapstructure:"k8s.node.memory.rss"`
-	K8sNodeMemoryUsage             MetricSettings `mapstructure:"k8s.node.memory.usage"`
-	K8sNodeMemoryWorkingSet        MetricSettings `mapstructure:"k8s.node.memory.working_set"`
-	K8sNodeNetworkErrors           MetricSettings `mapstructure:"k8s.node.network.errors"`
-	K8sNodeNetworkIo               MetricSettings `mapstructure:"k8s.node.network.io"`
-	K8sPodCPUTime                  MetricSettings `mapstructure:"k8s.pod.cpu.time"`
-	K8sPodCPUUtilization           MetricSettings `mapstructure:"k8s.pod.cpu.utilization"`
-	K8sPodFilesystemAvailable      MetricSettings `mapstructure:"k8s.pod.filesystem.available"`
-	K8sPodFilesystemCapacity       MetricSettings `mapstructure:"k8s.pod.filesystem.capacity"`
-	K8sPodFilesystemUsage          MetricSettings `mapstructure:"k8s.pod.filesystem.usage"`
-	K8sPodMemoryAvailable          MetricSettings `mapstructure:"k8s.pod.memory.available"`
-	K8sPodMemoryMajorPageFaults    MetricSettings `mapstructure:"k8s.pod.memory.major_page_faults"`
-	K8sPodMemoryPageFaults         MetricSettings `mapstructure:"k8s.pod.memory.page_faults"`
-	K8sPodMemoryRss                MetricSettings `mapstructure:"k8s.pod.memory.rss"`
-	K8sPodMemoryUsage              MetricSettings `mapstructure:"k8s.pod.memory.usage"`
-	K8sPodMemoryWorkingSet         MetricSettings `mapstructure:"k8s.pod.memory.working_set"`
-	K8sPodNetworkErrors            MetricSettings `mapstructure:"k8s.pod.network.errors"`
-	K8sPodNetworkIo                MetricSettings `mapstructure:"k8s.pod.network.io"`
-	K8sVolumeAvailable             MetricSettings `mapstructure:"k8s.volume.available"`
-	K8sVolumeCapacity              MetricSettings `mapstructure:"k8s.volume.capacity"`
-	K8sVolumeInodes                MetricSettings `mapstructure:"k8s.volume.inodes"`
-	K8sVolumeInodesFree            MetricSettings `mapstructure:"k8s.volume.inodes.free"`
-	K8sVolumeInodesUsed            MetricSettings `mapstructure:"k8s.volume.inodes.used"`
+	ContainerCPUThrottlingPeriods             MetricSettings `mapstructure:"container.cpu.throttling.periods"`
+	ContainerCPUThrottlingThrottledPeriods    MetricSettings `mapstructure:"container.cpu.throttling.throttled_periods"`
+	ContainerCPUThrottlingThrottledTime       MetricSettings `mapstructure:"container.cpu.throttling.throttled_time"`
+	ContainerCPUTime                          MetricSettings `mapstructure:"container.cpu.time"`
+	ContainerCPUUtilization                   MetricSettings `mapstructure:"container.cpu.utilization"`
+	ContainerEphemeralStorageLimit            MetricSettings `mapstructure:"container.ephemeral_storage.limit"`
+	ContainerEphemeralStorageLimitUtilization MetricSettings `mapstructure:"container.ephemeral_storage.limit_utilization"`
+	ContainerEphemeralStorageUsage            MetricSettings `mapstructure:"container.ephemeral_storage.usage"`
+	ContainerFilesystemAvailable              MetricSettings `mapstructure:"container.filesystem.available"`
+	ContainerFilesystemCapacity               MetricSettings `mapstructure:"container.filesystem.capacity"`
+	ContainerFilesystemUsage                  MetricSettings `mapstructure:"container.filesystem.usage"`
+	ContainerMemoryAvailable                  MetricSettings `mapstructure:"container.memory.available"`
+	ContainerMemoryMajorPageFaults            MetricSettings `mapstructure:"container.memory.major_page_faults"`
+	ContainerMemoryPageFaults                 MetricSettings `mapstructure:"container.memory.page_faults"`
+	ContainerMemoryRss                        MetricSettings `mapstructure:"container.memory.rss"`
+	ContainerMemoryUsage                      MetricSettings `mapstructure:"container.memory.usage"`
+	ContainerMemoryWorkingSet                 MetricSettings `mapstructure:"container.memory.working_set"`
+	K8sNodeCPUTime                            MetricSettings `mapstructure:"k8s.node.cpu.time"`
+	K8sNodeCPUUtilization                     MetricSettings `mapstructure:"k8s.node.cpu.utilization"`
+	K8sNodeFilesystemAvailable                MetricSettings `mapstructure:"k8s.node.filesystem.available"`
+	K8sNodeFilesystemCapacity                 MetricSettings `mapstructure:"k8s.node.filesystem.capacity"`
+	K8sNodeFilesystemUsage                    MetricSettings `mapstructure:"k8s.node.filesystem.usage"`
+	K8sNodeMemoryAvailable                    MetricSettings `mapstructure:"k8s.node.memory.available"`
+	K8sNodeMemoryMajorPageFaults              MetricSettings `mapstructure:"k8s.node.memory.major_page_faults"`
+	K8sNodeMemoryPageFaults                   MetricSettings `mapstructure:"k8s.node.memory.page_faults"`
+	K8sNodeMemoryRss                          MetricSettings `mapstructure:"k8s.node.memory.rss"`
+	K8sNodeMemoryUsage                        MetricSettings `mapstructure:"k8s.node.memory.usage"`
+	K8sNodeMemoryWorkingSet                   MetricSettings `mapstructure:"k8s.node.memory.working_set"`
+	K8sNodeNetworkErrors                      MetricSettings `mapstructure:"k8s.node.network.errors"`
+	K8sNodeNetworkIo                          MetricSettings `mapstructure:"k8s.node.network.io"`
+	K8sPodCPUTime                             MetricSettings `mapstructure:"k8s.pod.cpu.time"`
+	K8sPodCPUUtilization                      MetricSettings `mapstructure:"k8s.pod.cpu.utilization"`
+	K8sPodFilesystemAvailable                 MetricSettings `mapstructure:"k8s.pod.filesystem.available"`
+	K8sPodFilesystemCapacity                  MetricSettings `mapstructure:"k8s.pod.filesystem.capacity"`
+	K8sPodFilesystemUsage                     MetricSettings `mapstructure:"k8s.pod.filesystem.usage"`
+	K8sPodMemoryAvailable                     MetricSettings `mapstructure:"k8s.pod.memory.available"`
+	K8sPodMemoryMajorPageFaults               MetricSettings `mapstructure:"k8s.pod.memory.major_page_faults"`
+	K8sPodMemoryPageFaults                    MetricSettings `mapstructure:"k8s.pod.memory.page_faults"`
+	K8sPodMemoryRss                           MetricSettings `mapstructure:"k8s.pod.memory.rss"`
+	K8sPodMemoryUsage                         MetricSettings `mapstructure:"k8s.pod.memory.usage"`
+	K8sPodMemoryWorkingSet                    MetricSettings `mapstructure:"k8s.pod.memory.working_set"`
+	K8sPodNetworkErrors                       MetricSettings `mapstructure:"k8s.pod.network.errors"`
+	K8sPodNetworkIo                           MetricSettings `mapstructure:"k8s.pod.network.io"`
+	K8sVolumeAvailable                        MetricSettings `mapstructure:"k8s.volume.available"`
+	K8sVolumeCapacity                         MetricSettings `mapstructure:"k8s.volume.capacity"`
+	K8sVolumeInodes                           MetricSettings `mapstructure:"k8s.volume.inodes"`
+	K8sVolumeInodesFree                       MetricSettings `mapstructure:"k8s.volume.inodes.free"`
+	K8sVolumeInodesUsed                       MetricSettings `mapstructure:"k8s.volume.inodes.used"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
 	return MetricsSettings{
+		ContainerCPUThrottlingPeriods: MetricSettings{
+			Enabled: false,
+		},
+		ContainerCPUThrottlingThrottledPeriods: MetricSettings{
+			Enabled: false,
+		},
+		ContainerCPUThrottlingThrottledTime: MetricSettings{
+			Enabled: false,
+		},
 		ContainerCPUTime: MetricSettings{
 			Enabled: true,
 		},
 		ContainerCPUUtilization: MetricSettings{
 			Enabled: true,
 		},
+		ContainerEphemeralStorageLimit: MetricSettings{
+			Enabled: false,
+		},
+		ContainerEphemeralStorageLimitUtilization: MetricSettings{
+			Enabled: false,
+		},
+		ContainerEphemeralStorageUsage: MetricSettings{
+			Enabled: false,
+		},
 		ContainerFilesystemAvailable: MetricSettings{
 			Enabled: true,
 		},
@@ -218,6 +242,159 @@ var MapAttributeDirection = map[string]AttributeDirection{
 	"transmit": AttributeDirectionTransmit,
 }
 
+type metricContainerCPUThrottlingPeriods struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.cpu.throttling.periods metric with initial data.
+func (m *metricContainerCPUThrottlingPeriods) init() {
+	m.data.SetName("container.cpu.throttling.periods")
+	m.data.SetDescription("Number of elapsed CPU enforcement periods of the container, as reported by cAdvisor")
+	m.data.SetUnit("{periods}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricContainerCPUThrottlingPeriods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerCPUThrottlingPeriods) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerCPUThrottlingPeriods) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerCPUThrottlingPeriods(settings MetricSettings) metricContainerCPUThrottlingPeriods {
+	m := metricContainerCPUThrottlingPeriods{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerCPUThrottlingThrottledPeriods struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.cpu.throttling.throttled_periods metric with initial data.
+func (m *metricContainerCPUThrottlingThrottledPeriods) init() {
+	m.data.SetName("container.cpu.throttling.throttled_periods")
+	m.data.SetDescription("Number of CPU enforcement periods in which the container was throttled, as reported by cAdvisor")
+	m.data.SetUnit("{periods}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricContainerCPUThrottlingThrottledPeriods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerCPUThrottlingThrottledPeriods) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerCPUThrottlingThrottledPeriods) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerCPUThrottlingThrottledPeriods(settings MetricSettings) metricContainerCPUThrottlingThrottledPeriods {
+	m := metricContainerCPUThrottlingThrottledPeriods{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerCPUThrottlingThrottledTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.cpu.throttling.throttled_time metric with initial data.
+func (m *metricContainerCPUThrottlingThrottledTime) init() {
+	m.data.SetName("container.cpu.throttling.throttled_time")
+	m.data.SetDescription("Total time the container was throttled, as reported by cAdvisor")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricContainerCPUThrottlingThrottledTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerCPUThrottlingThrottledTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerCPUThrottlingThrottledTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerCPUThrottlingThrottledTime(settings MetricSettings) metricContainerCPUThrottlingThrottledTime {
+	m := metricContainerCPUThrottlingThrottledTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricContainerCPUTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -318,6 +495,153 @@ func newMetricContainerCPUUtilization(settings MetricSettings) metricContainerCP
 	return m
 }
 
+type metricContainerEphemeralStorageLimit struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.ephemeral_storage.limit metric with initial data.
+func (m *metricContainerEphemeralStorageLimit) init() {
+	m.data.SetName("container.ephemeral_storage.limit")
+	m.data.SetDescription("Container ephemeral storage limit")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricContainerEphemeralStorageLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerEphemeralStorageLimit) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerEphemeralStorageLimit) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerEphemeralStorageLimit(settings MetricSettings) metricContainerEphemeralStorageLimit {
+	m := metricContainerEphemeralStorageLimit{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerEphemeralStorageLimitUtilization struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.ephemeral_storage.limit_utilization metric with initial data.
+func (m *metricContainerEphemeralStorageLimitUtilization) init() {
+	m.data.SetName("container.ephemeral_storage.limit_utilization")
+	m.data.SetDescription("Container ephemeral storage usage as a ratio of its limit")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricContainerEphemeralStorageLimitUtilization) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerEphemeralStorageLimitUtilization) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerEphemeralStorageLimitUtilization) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerEphemeralStorageLimitUtilization(settings MetricSettings) metricContainerEphemeralStorageLimitUtilization {
+	m := metricContainerEphemeralStorageLimitUtilization{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerEphemeralStorageUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.ephemeral_storage.usage metric with initial data.
+func (m *metricContainerEphemeralStorageUsage) init() {
+	m.data.SetName("container.ephemeral_storage.usage")
+	m.data.SetDescription("Container ephemeral storage usage, the sum of its writable layer and logs usage")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricContainerEphemeralStorageUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerEphemeralStorageUsage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerEphemeralStorageUsage) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerEphemeralStorageUsage(settings MetricSettings) metricContainerEphemeralStorageUsage {
+	m := metricContainerEphemeralStorageUsage{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricContainerFilesystemAvailable struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -2305,53 +2629,59 @@ func newMetricK8sVolumeInodesUsed(settings MetricSettings) metricK8sVolumeInodes
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                            pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity                      int                 // maximum observed number of metrics per resource.
-	resourceCapacity                     int                 // maximum observed number of resource attributes.
-	metricsBuffer                        pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo                            component.BuildInfo // contains version information
-	metricContainerCPUTime               metricContainerCPUTime
-	metricContainerCPUUtilization        metricContainerCPUUtilization
-	metricContainerFilesystemAvailable   metricContainerFilesystemAvailable
-	metricContainerFilesystemCapacity    metricContainerFilesystemCapacity
-	metricContainerFilesystemUsage       metricContainerFilesystemUsage
-	metricContainerMemoryAvailable       metricContainerMemoryAvailable
-	metricContainerMemoryMajorPageFaults metricContainerMemoryMajorPageFaults
-	metricContainerMemoryPageFaults      metricContainerMemoryPageFaults
-	metricContainerMemoryRss             metricContainerMemoryRss
-	metricContainerMemoryUsage           metricContainerMemoryUsage
-	metricContainerMemoryWorkingSet      metricContainerMemoryWorkingSet
-	metricK8sNodeCPUTime                 metricK8sNodeCPUTime
-	metricK8sNodeCPUUtilization          metricK8sNodeCPUUtilization
-	metricK8sNodeFilesystemAvailable     metricK8sNodeFilesystemAvailable
-	metricK8sNodeFilesystemCapacity      metricK8sNodeFilesystemCapacity
-	metricK8sNodeFilesystemUsage         metricK8sNodeFilesystemUsage
-	metricK8sNodeMemoryAvailable         metricK8sNodeMemoryAvailable
-	metricK8sNodeMemoryMajorPageFaults   metricK8sNodeMemoryMajorPageFaults
-	metricK8sNodeMemoryPageFaults        metricK8sNodeMemoryPageFaults
-	metricK8sNodeMemoryRss               metricK8sNodeMemoryRss
-	metricK8sNodeMemoryUsage             metricK8sNodeMemoryUsage
-	metricK8sNodeMemoryWorkingSet        metricK8sNodeMemoryWorkingSet
-	metricK8sNodeNetworkErrors           metricK8sNodeNetworkErrors
-	metricK8sNodeNetworkIo               metricK8sNodeNetworkIo
-	metricK8sPodCPUTime                  metricK8sPodCPUTime
-	metricK8sPodCPUUtilization           metricK8sPodCPUUtilization
-	metricK8sPodFilesystemAvailable      metricK8sPodFilesystemAvailable
-	metricK8sPodFilesystemCapacity       metricK8sPodFilesystemCapacity
-	metricK8sPodFilesystemUsage          metricK8sPodFilesystemUsage
-	metricK8sPodMemoryAvailable          metricK8sPodMemoryAvailable
-	metricK8sPodMemoryMajorPageFaults    metricK8sPodMemoryMajorPageFaults
-	metricK8sPodMemoryPageFaults         metricK8sPodMemoryPageFaults
-	metricK8sPodMemoryRss                metricK8sPodMemoryRss
-	metricK8sPodMemoryUsage              metricK8sPodMemoryUsage
-	metricK8sPodMemoryWorkingSet         metricK8sPodMemoryWorkingSet
-	metricK8sPodNetworkErrors            metricK8sPodNetworkErrors
-	metricK8sPodNetworkIo                metricK8sPodNetworkIo
-	metricK8sVolumeAvailable             metricK8sVolumeAvailable
-	metricK8sVolumeCapacity              metricK8sVolumeCapacity
-	metricK8sVolumeInodes                metricK8sVolumeInodes
-	metricK8sVolumeInodesFree            metricK8sVolumeInodesFree
-	metricK8sVolumeInodesUsed            metricK8sVolumeInodesUsed
+	startTime                                       pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                                 int                 // maximum observed number of metrics per resource.
+	resourceCapacity                                int                 // maximum observed number of resource attributes.
+	metricsBuffer                                   pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                                       component.BuildInfo // contains version information
+	metricContainerCPUThrottlingPeriods             metricContainerCPUThrottlingPeriods
+	metricContainerCPUThrottlingThrottledPeriods    metricContainerCPUThrottlingThrottledPeriods
+	metricContainerCPUThrottlingThrottledTime       metricContainerCPUThrottlingThrottledTime
+	metricContainerCPUTime                          metricContainerCPUTime
+	metricContainerCPUUtilization                   metricContainerCPUUtilization
+	metricContainerEphemeralStorageLimit            metricContainerEphemeralStorageLimit
+	metricContainerEphemeralStorageLimitUtilization metricContainerEphemeralStorageLimitUtilization
+	metricContainerEphemeralStorageUsage            metricContainerEphemeralStorageUsage
+	metricContainerFilesystemAvailable              metricContainerFilesystemAvailable
+	metricContainerFilesystemCapacity               metricContainerFilesystemCapacity
+	metricContainerFilesystemUsage                  metricContainerFilesystemUsage
+	metricContainerMemoryAvailable                  metricContainerMemoryAvailable
+	metricContainerMemoryMajorPageFaults            metricContainerMemoryMajorPageFaults
+	metricContainerMemoryPageFaults                 metricContainerMemoryPageFaults
+	metricContainerMemoryRss                        metricContainerMemoryRss
+	metricContainerMemoryUsage                      metricContainerMemoryUsage
+	metricContainerMemoryWorkingSet                 metricContainerMemoryWorkingSet
+	metricK8sNodeCPUTime                            metricK8sNodeCPUTime
+	metricK8sNodeCPUUtilization                     metricK8sNodeCPUUtilization
+	metricK8sNodeFilesystemAvailable                metricK8sNodeFilesystemAvailable
+	metricK8sNodeFilesystemCapacity                 metricK8sNodeFilesystemCapacity
+	metricK8sNodeFilesystemUsage                    metricK8sNodeFilesystemUsage
+	metricK8sNodeMemoryAvailable                    metricK8sNodeMemoryAvailable
+	metricK8sNodeMemoryMajorPageFaults              metricK8sNodeMemoryMajorPageFaults
+	metricK8sNodeMemoryPageFaults                   metricK8sNodeMemoryPageFaults
+	metricK8sNodeMemoryRss                          metricK8sNodeMemoryRss
+	metricK8sNodeMemoryUsage                        metricK8sNodeMemoryUsage
+	metricK8sNodeMemoryWorkingSet                   metricK8sNodeMemoryWorkingSet
+	metricK8sNodeNetworkErrors                      metricK8sNodeNetworkErrors
+	metricK8sNodeNetworkIo                          metricK8sNodeNetworkIo
+	metricK8sPodCPUTime                             metricK8sPodCPUTime
+	metricK8sPodCPUUtilization                      metricK8sPodCPUUtilization
+	metricK8sPodFilesystemAvailable                 metricK8sPodFilesystemAvailable
+	metricK8sPodFilesystemCapacity                  metricK8sPodFilesystemCapacity
+	metricK8sPodFilesystemUsage                     metricK8sPodFilesystemUsage
+	metricK8sPodMemoryAvailable                     metricK8sPodMemoryAvailable
+	metricK8sPodMemoryMajorPageFaults               metricK8sPodMemoryMajorPageFaults
+	metricK8sPodMemoryPageFaults                    metricK8sPodMemoryPageFaults
+	metricK8sPodMemoryRss                           metricK8sPodMemoryRss
+	metricK8sPodMemoryUsage                         metricK8sPodMemoryUsage
+	metricK8sPodMemoryWorkingSet                    metricK8sPodMemoryWorkingSet
+	metricK8sPodNetworkErrors                       metricK8sPodNetworkErrors
+	metricK8sPodNetworkIo                           metricK8sPodNetworkIo
+	metricK8sVolumeAvailable                        metricK8sVolumeAvailable
+	metricK8sVolumeCapacity                         metricK8sVolumeCapacity
+	metricK8sVolumeInodes                           metricK8sVolumeInodes
+	metricK8sVolumeInodesFree                       metricK8sVolumeInodesFree
+	metricK8sVolumeInodesUsed                       metricK8sVolumeInodesUsed
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -2366,51 +2696,57 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                            pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                        pmetric.NewMetrics(),
-		buildInfo:                            buildInfo,
-		metricContainerCPUTime:               newMetricContainerCPUTime(settings.ContainerCPUTime),
-		metricContainerCPUUtilization:        newMetricContainerCPUUtilization(settings.ContainerCPUUtilization),
-		metricContainerFilesystemAvailable:   newMetricContainerFilesystemAvailable(settings.ContainerFilesystemAvailable),
-		metricContainerFilesystemCapacity:    newMetricContainerFilesystemCapacity(settings.ContainerFilesystemCapacity),
-		metricContainerFilesystemUsage:       newMetricContainerFilesystemUsage(settings.ContainerFilesystemUsage),
-		metricContainerMemoryAvailable:       newMetricContainerMemoryAvailable(settings.ContainerMemoryAvailable),
-		metricContainerMemoryMajorPageFaults: newMetricContainerMemoryMajorPageFaults(settings.ContainerMemoryMajorPageFaults),
-		metricContainerMemoryPageFaults:      newMetricContainerMemoryPageFaults(settings.ContainerMemoryPageFaults),
-		metricContainerMemoryRss:             newMetricContainerMemoryRss(settings.ContainerMemoryRss),
-		metricContainerMemoryUsage:           newMetricContainerMemoryUsage(settings.ContainerMemoryUsage),
-		metricContainerMemoryWorkingSet:      newMetricContainerMemoryWorkingSet(settings.ContainerMemoryWorkingSet),
-		metricK8sNodeCPUTime:                 newMetricK8sNodeCPUTime(settings.K8sNodeCPUTime),
-		metricK8sNodeCPUUtilization:          newMetricK8sNodeCPUUtilization(settings.K8sNodeCPUUtilization),
-		metricK8sNodeFilesystemAvailable:     newMetricK8sNodeFilesystemAvailable(settings.K8sNodeFilesystemAvailable),
-		metricK8sNodeFilesystemCapacity:      newMetricK8sNodeFilesystemCapacity(settings.K8sNodeFilesystemCapacity),
-		metricK8sNodeFilesystemUsage:         newMetricK8sNodeFilesystemUsage(settings.K8sNodeFilesystemUsage),
-		metricK8sNodeMemoryAvailable:         newMetricK8sNodeMemoryAvailable(settings.K8sNodeMemoryAvailable),
-		metricK8sNodeMemoryMajorPageFaults:   newMetricK8sNodeMemoryMajorPageFaults(settings.K8sNodeMemoryMajorPageFaults),
-		metricK8sNodeMemoryPageFaults:        newMetricK8sNodeMemoryPageFaults(settings.K8sNodeMemoryPageFaults),
-		metricK8sNodeMemoryRss:               newMetricK8sNodeMemoryRss(settings.K8sNodeMemoryRss),
-		metricK8sNodeMemoryUsage:             newMetricK8sNodeMemoryUsage(settings.K8sNodeMemoryUsage),
-		metricK8sNodeMemoryWorkingSet:        newMetricK8sNodeMemoryWorkingSet(settings.K8sNodeMemoryWorkingSet),
-		metricK8sNodeNetworkErrors:           newMetricK8sNodeNetworkErrors(settings.K8sNodeNetworkErrors),
-		metricK8sNodeNetworkIo:               newMetricK8sNodeNetworkIo(settings.K8sNodeNetworkIo),
-		metricK8sPodCPUTime:                  newMetricK8sPodCPUTime(settings.K8sPodCPUTime),
-		metricK8sPodCPUUtilization:           newMetricK8sPodCPUUtilization(settings.K8sPodCPUUtilization),
-		metricK8sPodFilesystemAvailable:      newMetricK8sPodFilesystemAvailable(settings.K8sPodFilesystemAvailable),
-		metricK8sPodFilesystemCapacity:       newMetricK8sPodFilesystemCapacity(settings.K8sPodFilesystemCapacity),
-		metricK8sPodFilesystemUsage:          newMetricK8sPodFilesystemUsage(settings.K8sPodFilesystemUsage),
-		metricK8sPodMemoryAvailable:          newMetricK8sPodMemoryAvailable(settings.K8sPodMemoryAvailable),
-		metricK8sPodMemoryMajorPageFaults:    newMetricK8sPodMemoryMajorPageFaults(settings.K8sPodMemoryMajorPageFaults),
-		metricK8sPodMemoryPageFaults:         newMetricK8sPodMemoryPageFaults(settings.K8sPodMemoryPageFaults),
-		metricK8sPodMemoryRss:                newMetricK8sPodMemoryRss(settings.K8sPodMemoryRss),
-		metricK8sPodMemoryUsage:              newMetricK8sPodMemoryUsage(settings.K8sPodMemoryUsage),
-		metricK8sPodMemoryWorkingSet:         newMetricK8sPodMemoryWorkingSet(settings.K8sPodMemoryWorkingSet),
-		metricK8sPodNetworkErrors:            newMetricK8sPodNetworkErrors(settings.K8sPodNetworkErrors),
-		metricK8sPodNetworkIo:                newMetricK8sPodNetworkIo(settings.K8sPodNetworkIo),
-		metricK8sVolumeAvailable:             newMetricK8sVolumeAvailable(settings.K8sVolumeAvailable),
-		metricK8sVolumeCapacity:              newMetricK8sVolumeCapacity(settings.K8sVolumeCapacity),
-		metricK8sVolumeInodes:                newMetricK8sVolumeInodes(settings.K8sVolumeInodes),
-		metricK8sVolumeInodesFree:            newMetricK8sVolumeInodesFree(settings.K8sVolumeInodesFree),
-		metricK8sVolumeInodesUsed:            newMetricK8sVolumeInodesUsed(settings.K8sVolumeInodesUsed),
+		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                       pmetric.NewMetrics(),
+		buildInfo:                           buildInfo,
+		metricContainerCPUThrottlingPeriods: newMetricContainerCPUThrottlingPeriods(settings.ContainerCPUThrottlingPeriods),
+		metricContainerCPUThrottlingThrottledPeriods:    newMetricContainerCPUThrottlingThrottledPeriods(settings.ContainerCPUThrottlingThrottledPeriods),
+		metricContainerCPUThrottlingThrottledTime:       newMetricContainerCPUThrottlingThrottledTime(settings.ContainerCPUThrottlingThrottledTime),
+		metricContainerCPUTime:                          newMetricContainerCPUTime(settings.ContainerCPUTime),
+		metricContainerCPUUtilization:                   newMetricContainerCPUUtilization(settings.ContainerCPUUtilization),
+		metricContainerEphemeralStorageLimit:            newMetricContainerEphemeralStorageLimit(settings.ContainerEphemeralStorageLimit),
+		metricContainerEphemeralStorageLimitUtilization: newMetricContainerEphemeralStorageLimitUtilization(settings.ContainerEphemeralStorageLimitUtilization),
+		metricContainerEphemeralStorageUsage:            newMetricContainerEphemeralStorageUsage(settings.ContainerEphemeralStorageUsage),
+		metricContainerFilesystemAvailable:              newMetricContainerFilesystemAvailable(settings.ContainerFilesystemAvailable),
+		metricContainerFilesystemCapacity:               newMetricContainerFilesystemCapacity(settings.ContainerFilesystemCapacity),
+		metricContainerFilesystemUsage:                  newMetricContainerFilesystemUsage(settings.ContainerFilesystemUsage),
+		metricContainerMemoryAvailable:                  newMetricContainerMemoryAvailable(settings.ContainerMemoryAvailable),
+		metricContainerMemoryMajorPageFaults:            newMetricContainerMemoryMajorPageFaults(settings.ContainerMemoryMajorPageFaults),
+		metricContainerMemoryPageFaults:                 newMetricContainerMemoryPageFaults(settings.ContainerMemoryPageFaults),
+		metricContainerMemoryRss:                        newMetricContainerMemoryRss(settings.ContainerMemoryRss),
+		metricContainerMemoryUsage:                      newMetricContainerMemoryUsage(settings.ContainerMemoryUsage),
+		metricContainerMemoryWorkingSet:                 newMetricContainerMemoryWorkingSet(settings.ContainerMemoryWorkingSet),
+		metricK8sNodeCPUTime:                            newMetricK8sNodeCPUTime(settings.K8sNodeCPUTime),
+		metricK8sNodeCPUUtilization:                     newMetricK8sNodeCPUUtilization(settings.K8sNodeCPUUtilization),
+		metricK8sNodeFilesystemAvailable:                newMetricK8sNodeFilesystemAvailable(settings.K8sNodeFilesystemAvailable),
+		metricK8sNodeFilesystemCapacity:                 newMetricK8sNodeFilesystemCapacity(settings.K8sNodeFilesystemCapacity),
+		metricK8sNodeFilesystemUsage:                    newMetricK8sNodeFilesystemUsage(settings.K8sNodeFilesystemUsage),
+		metricK8sNodeMemoryAvailable:                    newMetricK8sNodeMemoryAvailable(settings.K8sNodeMemoryAvailable),
+		metricK8sNodeMemoryMajorPageFaults:              newMetricK8sNodeMemoryMajorPageFaults(settings.K8sNodeMemoryMajorPageFaults),
+		metricK8sNodeMemoryPageFaults:                   newMetricK8sNodeMemoryPageFaults(settings.K8sNodeMemoryPageFaults),
+		metricK8sNodeMemoryRss:                          newMetricK8sNodeMemoryRss(settings.K8sNodeMemoryRss),
+		metricK8sNodeMemoryUsage:                        newMetricK8sNodeMemoryUsage(settings.K8sNodeMemoryUsage),
+		metricK8sNodeMemoryWorkingSet:                   newMetricK8sNodeMemoryWorkingSet(settings.K8sNodeMemoryWorkingSet),
+		metricK8sNodeNetworkErrors:                      newMetricK8sNodeNetworkErrors(settings.K8sNodeNetworkErrors),
+		metricK8sNodeNetworkIo:                          newMetricK8sNodeNetworkIo(settings.K8sNodeNetworkIo),
+		metricK8sPodCPUTime:                             newMetricK8sPodCPUTime(settings.K8sPodCPUTime),
+		metricK8sPodCPUUtilization:                      newMetricK8sPodCPUUtilization(settings.K8sPodCPUUtilization),
+		metricK8sPodFilesystemAvailable:                 newMetricK8sPodFilesystemAvailable(settings.K8sPodFilesystemAvailable),
+		metricK8sPodFilesystemCapacity:                  newMetricK8sPodFilesystemCapacity(settings.K8sPodFilesystemCapacity),
+		metricK8sPodFilesystemUsage:                     newMetricK8sPodFilesystemUsage(settings.K8sPodFilesystemUsage),
+		metricK8sPodMemoryAvailable:                     newMetricK8sPodMemoryAvailable(settings.K8sPodMemoryAvailable),
+		metricK8sPodMemoryMajorPageFaults:               newMetricK8sPodMemoryMajorPageFaults(settings.K8sPodMemoryMajorPageFaults),
+		metricK8sPodMemoryPageFaults:                    newMetricK8sPodMemoryPageFaults(settings.K8sPodMemoryPageFaults),
+		metricK8sPodMemoryRss:                           newMetricK8sPodMemoryRss(settings.K8sPodMemoryRss),
+		metricK8sPodMemoryUsage:                         newMetricK8sPodMemoryUsage(settings.K8sPodMemoryUsage),
+		metricK8sPodMemoryWorkingSet:                    newMetricK8sPodMemoryWorkingSet(settings.K8sPodMemoryWorkingSet),
+		metricK8sPodNetworkErrors:                       newMetricK8sPodNetworkErrors(settings.K8sPodNetworkErrors),
+		metricK8sPodNetworkIo:                           newMetricK8sPodNetworkIo(settings.K8sPodNetworkIo),
+		metricK8sVolumeAvailable:                        newMetricK8sVolumeAvailable(settings.K8sVolumeAvailable),
+		metricK8sVolumeCapacity:                         newMetricK8sVolumeCapacity(settings.K8sVolumeCapacity),
+		metricK8sVolumeInodes:                           newMetricK8sVolumeInodes(settings.K8sVolumeInodes),
+		metricK8sVolumeInodesFree:                       newMetricK8sVolumeInodesFree(settings.K8sVolumeInodesFree),
+		metricK8sVolumeInodesUsed:                       newMetricK8sVolumeInodesUsed(settings.K8sVolumeInodesUsed),
 	}
 	for _, op := range options {
 		op(mb)
@@ -2568,8 +2904,14 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetName("otelcol/kubeletstatsreceiver")
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricContainerCPUThrottlingPeriods.emit(ils.Metrics())
+	mb.metricContainerCPUThrottlingThrottledPeriods.emit(ils.Metrics())
+	mb.metricContainerCPUThrottlingThrottledTime.emit(ils.Metrics())
 	mb.metricContainerCPUTime.emit(ils.Metrics())
 	mb.metricContainerCPUUtilization.emit(ils.Metrics())
+	mb.metricContainerEphemeralStorageLimit.emit(ils.Metrics())
+	mb.metricContainerEphemeralStorageLimitUtilization.emit(ils.Metrics())
+	mb.metricContainerEphemeralStorageUsage.emit(ils.Metrics())
 	mb.metricContainerFilesystemAvailable.emit(ils.Metrics())
 	mb.metricContainerFilesystemCapacity.emit(ils.Metrics())
 	mb.metricContainerFilesystemUsage.emit(ils.Metrics())
@@ -2629,6 +2971,21 @@ func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
 	return metrics
 }
 
+// RecordContainerCPUThrottlingPeriodsDataPoint adds a data point to container.cpu.throttling.periods metric.
+func (mb *MetricsBuilder) RecordContainerCPUThrottlingPeriodsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricContainerCPUThrottlingPeriods.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerCPUThrottlingThrottledPeriodsDataPoint adds a data point to container.cpu.throttling.throttled_periods metric.
+func (mb *MetricsBuilder) RecordContainerCPUThrottlingThrottledPeriodsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricContainerCPUThrottlingThrottledPeriods.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerCPUThrottlingThrottledTimeDataPoint adds a data point to container.cpu.throttling.throttled_time metric.
+func (mb *MetricsBuilder) RecordContainerCPUThrottlingThrottledTimeDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricContainerCPUThrottlingThrottledTime.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordContainerCPUTimeDataPoint adds a data point to container.cpu.time metric.
 func (mb *MetricsBuilder) RecordContainerCPUTimeDataPoint(ts pcommon.Timestamp, val float64) {
 	mb.metricContainerCPUTime.recordDataPoint(mb.startTime, ts, val)
@@ -2639,6 +2996,21 @@ func (mb *MetricsBuilder) RecordContainerCPUUtilizationDataPoint(ts pcommon.Time
 	mb.metricContainerCPUUtilization.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordContainerEphemeralStorageLimitDataPoint adds a data point to container.ephemeral_storage.limit metric.
+func (mb *MetricsBuilder) RecordContainerEphemeralStorageLimitDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricContainerEphemeralStorageLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerEphemeralStorageLimitUtilizationDataPoint adds a data point to container.ephemeral_storage.limit_utilization metric.
+func (mb *MetricsBuilder) RecordContainerEphemeralStorageLimitUtilizationDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricContainerEphemeralStorageLimitUtilization.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerEphemeralStorageUsageDataPoint adds a data point to container.ephemeral_storage.usage metric.
+func (mb *MetricsBuilder) RecordContainerEphemeralStorageUsageDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricContainerEphemeralStorageUsage.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordContainerFilesystemAvailableDataPoint adds a data point to container.filesystem.available metric.
 func (mb *MetricsBuilder) RecordContainerFilesystemAvailableDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricContainerFilesystemAvailable.recordDataPoint(mb.startTime, ts, val)
@@ -43,12 +43,15 @@ type scraperOptions struct {
 type kubletScraper struct {
 	statsProvider         *kubelet.StatsProvider
 	metadataProvider      *kubelet.MetadataProvider
+	cadvisorProvider      *kubelet.CadvisorProvider
 	logger                *zap.Logger
 	extraMetadataLabels   []kubelet.MetadataLabel
 	metricGroupsToCollect map[kubelet.MetricGroup]bool
 	k8sAPIClient          kubernetes.Interface
 	cachedVolumeLabels    map[string][]metadata.ResourceMetricsOption
 	mbs                   *metadata.MetricsBuilders
+	needsPodsMetadata     bool
+	needsCPUThrottling    bool
 }
 
 func newKubletScraper(
@@ -60,6 +63,7 @@ func newKubletScraper(
 	ks := &kubletScraper{
 		statsProvider:         kubelet.NewStatsProvider(restClient),
 		metadataProvider:      kubelet.NewMetadataProvider(restClient),
+		cadvisorProvider:      kubelet.NewCadvisorProvider(restClient),
 		logger:                set.Logger,
 		extraMetadataLabels:   rOptions.extraMetadataLabels,
 		metricGroupsToCollect: rOptions.metricGroupsToCollect,
@@ -71,6 +75,13 @@ func newKubletScraper(
 			ContainerMetricsBuilder: metadata.NewMetricsBuilder(metricsConfig, set.BuildInfo),
 			OtherMetricsBuilder:     metadata.NewMetricsBuilder(metricsConfig, set.BuildInfo),
 		},
+		needsPodsMetadata: len(rOptions.extraMetadataLabels) > 0 ||
+			(rOptions.metricGroupsToCollect[kubelet.ContainerMetricGroup] &&
+				(metricsConfig.ContainerEphemeralStorageLimit.Enabled || metricsConfig.ContainerEphemeralStorageLimitUtilization.Enabled)),
+		needsCPUThrottling: rOptions.metricGroupsToCollect[kubelet.ContainerMetricGroup] &&
+			(metricsConfig.ContainerCPUThrottlingPeriods.Enabled ||
+				metricsConfig.ContainerCPUThrottlingThrottledPeriods.Enabled ||
+				metricsConfig.ContainerCPUThrottlingThrottledTime.Enabled),
 	}
 	return scraperhelper.NewScraper(typeStr, ks.scrape)
 }
@@ -83,8 +94,8 @@ func (r *kubletScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	}
 
 	var podsMetadata *v1.PodList
-	// fetch metadata only when extra metadata labels are needed
-	if len(r.extraMetadataLabels) > 0 {
+	// fetch metadata only when extra metadata labels or container limits are needed
+	if r.needsPodsMetadata {
 		podsMetadata, err = r.metadataProvider.Pods()
 		if err != nil {
 			r.logger.Error("call to /pods endpoint failed", zap.Error(err))
@@ -93,6 +104,14 @@ func (r *kubletScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	}
 
 	metadata := kubelet.NewMetadata(r.extraMetadataLabels, podsMetadata, r.detailedPVCLabelsSetter())
+	// fetch cAdvisor stats only when CPU throttling metrics are enabled, since they
+	// are not part of the summary
+	if r.needsCPUThrottling {
+		metadata.CPUThrottling, err = r.cadvisorProvider.CPUThrottling()
+		if err != nil {
+			r.logger.Warn("call to /metrics/cadvisor endpoint failed, CPU throttling metrics won't be reported", zap.Error(err))
+		}
+	}
 	mds := kubelet.MetricsData(r.logger, summary, metadata, r.metricGroupsToCollect, r.mbs)
 	md := pmetric.NewMetrics()
 	for i := range mds {
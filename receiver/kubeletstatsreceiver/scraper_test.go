@@ -73,6 +73,83 @@ func TestScraper(t *testing.T) {
 	require.Equal(t, dataLen, md.DataPointCount())
 }
 
+func TestScraperWithCPUThrottlingAndEphemeralStorage(t *testing.T) {
+	metricsConfig := metadata.DefaultMetricsSettings()
+	metricsConfig.ContainerCPUThrottlingPeriods.Enabled = true
+	metricsConfig.ContainerCPUThrottlingThrottledPeriods.Enabled = true
+	metricsConfig.ContainerCPUThrottlingThrottledTime.Enabled = true
+	metricsConfig.ContainerEphemeralStorageUsage.Enabled = true
+	metricsConfig.ContainerEphemeralStorageLimit.Enabled = true
+	metricsConfig.ContainerEphemeralStorageLimitUtilization.Enabled = true
+
+	tests := []struct {
+		name         string
+		cadvisorFail bool
+		dataLen      int
+	}{
+		{
+			name: "all_endpoints",
+			// ephemeral storage usage for every container, throttling stats for the 2 containers
+			// reported by cadvisor and ephemeral storage limit and utilization for the one container
+			// with a limit.
+			dataLen: numContainers*(containerMetrics+1) + 2*3 + 2,
+		},
+		{
+			name:         "cadvisor_endpoint_error",
+			cadvisorFail: true,
+			dataLen:      numContainers*(containerMetrics+1) + 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := &scraperOptions{
+				metricGroupsToCollect: map[kubelet.MetricGroup]bool{
+					kubelet.ContainerMetricGroup: true,
+				},
+			}
+			r, err := newKubletScraper(
+				&fakeRestClient{cadvisorFail: tt.cadvisorFail},
+				componenttest.NewNopReceiverCreateSettings(),
+				options,
+				metricsConfig,
+			)
+			require.NoError(t, err)
+
+			md, err := r.Scrape(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tt.dataLen, md.DataPointCount())
+
+			found := map[string]bool{}
+			rms := md.ResourceMetrics()
+			for i := 0; i < rms.Len(); i++ {
+				rm := rms.At(i)
+				podName, _ := rm.Resource().Attributes().Get("k8s.pod.name")
+				ms := rm.ScopeMetrics().At(0).Metrics()
+				for j := 0; j < ms.Len(); j++ {
+					m := ms.At(j)
+					if podName.Str() != "go-hello-world-5456b4b8cd-99vxc" {
+						continue
+					}
+					found[m.Name()] = true
+					switch m.Name() {
+					case "container.cpu.throttling.throttled_periods":
+						require.EqualValues(t, 312, m.Sum().DataPoints().At(0).IntValue())
+					case "container.cpu.throttling.throttled_time":
+						require.EqualValues(t, 27.513, m.Sum().DataPoints().At(0).DoubleValue())
+					case "container.ephemeral_storage.limit":
+						require.EqualValues(t, 1<<30, m.Gauge().DataPoints().At(0).IntValue())
+					}
+				}
+			}
+			require.True(t, found["container.ephemeral_storage.usage"])
+			require.True(t, found["container.ephemeral_storage.limit"])
+			require.True(t, found["container.ephemeral_storage.limit_utilization"])
+			require.Equal(t, !tt.cadvisorFail, found["container.cpu.throttling.throttled_periods"])
+		})
+	}
+}
+
 func TestScraperWithMetadata(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -481,6 +558,7 @@ var _ kubelet.RestClient = (*fakeRestClient)(nil)
 type fakeRestClient struct {
 	statsSummaryFail bool
 	podsFail         bool
+	cadvisorFail     bool
 }
 
 func (f *fakeRestClient) StatsSummary() ([]byte, error) {
@@ -496,3 +574,10 @@ func (f *fakeRestClient) Pods() ([]byte, error) {
 	}
 	return os.ReadFile("testdata/pods.json")
 }
+
+func (f *fakeRestClient) Cadvisor() ([]byte, error) {
+	if f.cadvisorFail {
+		return nil, errors.New("")
+	}
+	return os.ReadFile("testdata/cadvisor-metrics.txt")
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"go.mongodb.org/mongo-driver/bson"
@@ -37,6 +38,8 @@ type client interface {
 	DBStats(ctx context.Context, DBName string) (bson.M, error)
 	TopStats(ctx context.Context) (bson.M, error)
 	IndexStats(ctx context.Context, DBName, collectionName string) ([]bson.M, error)
+	ReplSetGetStatus(ctx context.Context) (bson.M, error)
+	OplogWindow(ctx context.Context) (time.Duration, error)
 }
 
 // mongodbClient is a mongodb metric scraper client
@@ -89,6 +92,43 @@ func (c *mongodbClient) TopStats(ctx context.Context) (bson.M, error) {
 	return c.RunCommand(ctx, "admin", bson.M{"top": 1})
 }
 
+// ReplSetGetStatus is an admin command that returns the result of db.adminCommand({ replSetGetStatus: 1 })
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/command/replSetGetStatus/
+func (c *mongodbClient) ReplSetGetStatus(ctx context.Context) (bson.M, error) {
+	return c.RunCommand(ctx, "admin", bson.M{"replSetGetStatus": 1})
+}
+
+// OplogWindow returns the time between the oldest and newest entries of the oplog, which is how far behind
+// a secondary can fall before it needs a full resync.
+// more information can be found here: https://www.mongodb.com/docs/manual/core/replica-set-oplog/
+func (c *mongodbClient) OplogWindow(ctx context.Context) (time.Duration, error) {
+	oplog := c.Database("local").Collection("oplog.rs")
+
+	first, err := oplogTimestamp(ctx, oplog, 1)
+	if err != nil {
+		return 0, fmt.Errorf("unable to find oldest oplog entry: %w", err)
+	}
+	last, err := oplogTimestamp(ctx, oplog, -1)
+	if err != nil {
+		return 0, fmt.Errorf("unable to find newest oplog entry: %w", err)
+	}
+
+	return time.Duration(last.T-first.T) * time.Second, nil
+}
+
+// oplogTimestamp returns the timestamp of the first oplog entry in the given natural order
+func oplogTimestamp(ctx context.Context, oplog *mongo.Collection, order int) (primitive.Timestamp, error) {
+	opts := options.FindOne().SetSort(bson.M{"$natural": order}).SetProjection(bson.M{"ts": 1})
+
+	var entry struct {
+		TS primitive.Timestamp `bson:"ts"`
+	}
+	if err := oplog.FindOne(ctx, bson.D{}, opts).Decode(&entry); err != nil {
+		return primitive.Timestamp{}, err
+	}
+	return entry.TS, nil
+}
+
 // ListCollectionNames returns a list of collection names for a given database
 // SetAuthorizedCollections allows a user without the required privilege to run the command ListCollections.
 // more information can be found here: https://pkg.go.dev/go.mongodb.org/mongo-driver@v1.9.0/mongo#Database.ListCollectionNames
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"github.com/stretchr/testify/mock"
@@ -79,6 +80,16 @@ func (fc *fakeClient) IndexStats(ctx context.Context, dbName, collectionName str
 	return args.Get(0).([]bson.M), args.Error(1)
 }
 
+func (fc *fakeClient) ReplSetGetStatus(ctx context.Context) (bson.M, error) {
+	args := fc.Called(ctx)
+	return args.Get(0).(bson.M), args.Error(1)
+}
+
+func (fc *fakeClient) OplogWindow(ctx context.Context) (time.Duration, error) {
+	args := fc.Called(ctx)
+	return args.Get(0).(time.Duration), args.Error(1)
+}
+
 func TestListDatabaseNames(t *testing.T) {
 	mont := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 	defer mont.Close()
@@ -251,6 +262,41 @@ func TestGetVersionFailures(t *testing.T) {
 
 }
 
+func TestOplogWindow(t *testing.T) {
+	mont := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mont.Close()
+
+	mont.Run("oplog window", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "local.oplog.rs", mtest.FirstBatch, bson.D{
+				primitive.E{Key: "ts", Value: primitive.Timestamp{T: 1000, I: 1}},
+			}),
+			mtest.CreateCursorResponse(0, "local.oplog.rs", mtest.FirstBatch, bson.D{
+				primitive.E{Key: "ts", Value: primitive.Timestamp{T: 4600, I: 3}},
+			}),
+		)
+		client := mongodbClient{
+			Client: mt.Client,
+			logger: zap.NewNop(),
+		}
+
+		window, err := client.OplogWindow(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, time.Hour, window)
+	})
+
+	mont.Run("empty oplog", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "local.oplog.rs", mtest.FirstBatch))
+		client := mongodbClient{
+			Client: mt.Client,
+			logger: zap.NewNop(),
+		}
+
+		_, err := client.OplogWindow(context.Background())
+		require.ErrorContains(t, err, "unable to find oldest oplog entry")
+	})
+}
+
 func loadDBStats() (bson.D, error) {
 	return loadTestFile("./testdata/dbstats.json")
 }
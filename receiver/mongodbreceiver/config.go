@@ -29,6 +29,11 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbreceiver/internal/metadata"
 )
 
+const (
+	scramSHA1   = "SCRAM-SHA-1"
+	scramSHA256 = "SCRAM-SHA-256"
+)
+
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	configtls.TLSClientSetting              `mapstructure:"tls,omitempty"`
@@ -39,6 +44,11 @@ type Config struct {
 	Password   string                   `mapstructure:"password"`
 	ReplicaSet string                   `mapstructure:"replica_set,omitempty"`
 	Timeout    time.Duration            `mapstructure:"timeout"`
+	// AuthMechanism is the SCRAM mechanism used to authenticate the user. Valid values
+	// are SCRAM-SHA-1 and SCRAM-SHA-256. When empty, the mechanism is negotiated with the server.
+	AuthMechanism string `mapstructure:"auth_mechanism,omitempty"`
+	// AuthSource is the database the user is defined in. Defaults to admin.
+	AuthSource string `mapstructure:"auth_source,omitempty"`
 }
 
 func (c *Config) Validate() error {
@@ -59,6 +69,16 @@ func (c *Config) Validate() error {
 		err = multierr.Append(err, errors.New("password provided without user"))
 	}
 
+	switch c.AuthMechanism {
+	case "", scramSHA1, scramSHA256:
+	default:
+		err = multierr.Append(err, fmt.Errorf("auth_mechanism must be either %s or %s", scramSHA1, scramSHA256))
+	}
+
+	if c.Username == "" && (c.AuthMechanism != "" || c.AuthSource != "") {
+		err = multierr.Append(err, errors.New("auth_mechanism and auth_source require a username"))
+	}
+
 	if _, tlsErr := c.LoadTLSConfig(); tlsErr != nil {
 		err = multierr.Append(err, fmt.Errorf("error loading tls configuration: %w", tlsErr))
 	}
@@ -86,8 +106,10 @@ func (c *Config) ClientOptions() *options.ClientOptions {
 
 	if c.Username != "" && c.Password != "" {
 		clientOptions.SetAuth(options.Credential{
-			Username: c.Username,
-			Password: c.Password,
+			AuthMechanism: c.AuthMechanism,
+			AuthSource:    c.AuthSource,
+			Username:      c.Username,
+			Password:      c.Password,
 		})
 	}
 
@@ -29,11 +29,13 @@ import (
 
 func TestValidate(t *testing.T) {
 	testCases := []struct {
-		endpoints []string
-		desc      string
-		username  string
-		password  string
-		expected  error
+		endpoints     []string
+		desc          string
+		username      string
+		password      string
+		authMechanism string
+		authSource    string
+		expected      error
 	}{
 		{
 			desc:      "no username, no password",
@@ -80,6 +82,29 @@ func TestValidate(t *testing.T) {
 			endpoints: []string{""},
 			expected:  errors.New("no endpoint specified for one of the hosts"),
 		},
+		{
+			desc:          "with scram-sha-256 auth mechanism",
+			endpoints:     []string{"localhost:27107"},
+			username:      "user",
+			password:      "pass",
+			authMechanism: "SCRAM-SHA-256",
+			authSource:    "monitoring",
+			expected:      nil,
+		},
+		{
+			desc:          "unsupported auth mechanism",
+			endpoints:     []string{"localhost:27107"},
+			username:      "user",
+			password:      "pass",
+			authMechanism: "PLAIN",
+			expected:      errors.New("auth_mechanism must be either SCRAM-SHA-1 or SCRAM-SHA-256"),
+		},
+		{
+			desc:       "auth source without username",
+			endpoints:  []string{"localhost:27107"},
+			authSource: "monitoring",
+			expected:   errors.New("auth_mechanism and auth_source require a username"),
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -92,9 +117,11 @@ func TestValidate(t *testing.T) {
 			}
 
 			cfg := Config{
-				Username: tc.username,
-				Password: tc.password,
-				Hosts:    hosts,
+				Username:      tc.username,
+				Password:      tc.password,
+				AuthMechanism: tc.authMechanism,
+				AuthSource:    tc.authSource,
+				Hosts:         hosts,
 			}
 			err := cfg.Validate()
 			if tc.expected == nil {
@@ -164,14 +191,18 @@ func TestOptions(t *testing.T) {
 				Endpoint: "localhost:27017",
 			},
 		},
-		Username:   "uname",
-		Password:   "password",
-		Timeout:    2 * time.Minute,
-		ReplicaSet: "rs-1",
+		Username:      "uname",
+		Password:      "password",
+		AuthMechanism: "SCRAM-SHA-256",
+		AuthSource:    "monitoring",
+		Timeout:       2 * time.Minute,
+		ReplicaSet:    "rs-1",
 	}
 
 	clientOptions := cfg.ClientOptions()
 	require.Equal(t, clientOptions.Auth.Username, cfg.Username)
+	require.Equal(t, "SCRAM-SHA-256", clientOptions.Auth.AuthMechanism)
+	require.Equal(t, "monitoring", clientOptions.Auth.AuthSource)
 	require.Equal(t,
 		clientOptions.ConnectTimeout.Milliseconds(),
 		(2 * time.Minute).Milliseconds(),
@@ -17,7 +17,11 @@ type MetricSettings struct {
 
 // MetricsSettings provides settings for mongodbreceiver metrics.
 type MetricsSettings struct {
+	MongodbCacheDirtySize         MetricSettings `mapstructure:"mongodb.cache.dirty.size"`
+	MongodbCacheEvictions         MetricSettings `mapstructure:"mongodb.cache.evictions"`
+	MongodbCacheMaxSize           MetricSettings `mapstructure:"mongodb.cache.max_size"`
 	MongodbCacheOperations        MetricSettings `mapstructure:"mongodb.cache.operations"`
+	MongodbCacheSize              MetricSettings `mapstructure:"mongodb.cache.size"`
 	MongodbCollectionCount        MetricSettings `mapstructure:"mongodb.collection.count"`
 	MongodbConnectionCount        MetricSettings `mapstructure:"mongodb.connection.count"`
 	MongodbCursorCount            MetricSettings `mapstructure:"mongodb.cursor.count"`
@@ -25,6 +29,7 @@ type MetricsSettings struct {
 	MongodbDataSize               MetricSettings `mapstructure:"mongodb.data.size"`
 	MongodbDatabaseCount          MetricSettings `mapstructure:"mongodb.database.count"`
 	MongodbDocumentOperationCount MetricSettings `mapstructure:"mongodb.document.operation.count"`
+	MongodbElectionCount          MetricSettings `mapstructure:"mongodb.election.count"`
 	MongodbExtentCount            MetricSettings `mapstructure:"mongodb.extent.count"`
 	MongodbGlobalLockTime         MetricSettings `mapstructure:"mongodb.global_lock.time"`
 	MongodbIndexAccessCount       MetricSettings `mapstructure:"mongodb.index.access.count"`
@@ -37,15 +42,29 @@ type MetricsSettings struct {
 	MongodbObjectCount            MetricSettings `mapstructure:"mongodb.object.count"`
 	MongodbOperationCount         MetricSettings `mapstructure:"mongodb.operation.count"`
 	MongodbOperationTime          MetricSettings `mapstructure:"mongodb.operation.time"`
+	MongodbOplogWindow            MetricSettings `mapstructure:"mongodb.oplog.window"`
+	MongodbReplicationLag         MetricSettings `mapstructure:"mongodb.replication.lag"`
 	MongodbSessionCount           MetricSettings `mapstructure:"mongodb.session.count"`
 	MongodbStorageSize            MetricSettings `mapstructure:"mongodb.storage.size"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
 	return MetricsSettings{
+		MongodbCacheDirtySize: MetricSettings{
+			Enabled: false,
+		},
+		MongodbCacheEvictions: MetricSettings{
+			Enabled: false,
+		},
+		MongodbCacheMaxSize: MetricSettings{
+			Enabled: false,
+		},
 		MongodbCacheOperations: MetricSettings{
 			Enabled: true,
 		},
+		MongodbCacheSize: MetricSettings{
+			Enabled: false,
+		},
 		MongodbCollectionCount: MetricSettings{
 			Enabled: true,
 		},
@@ -67,6 +86,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		MongodbDocumentOperationCount: MetricSettings{
 			Enabled: true,
 		},
+		MongodbElectionCount: MetricSettings{
+			Enabled: false,
+		},
 		MongodbExtentCount: MetricSettings{
 			Enabled: true,
 		},
@@ -103,6 +125,12 @@ func DefaultMetricsSettings() MetricsSettings {
 		MongodbOperationTime: MetricSettings{
 			Enabled: true,
 		},
+		MongodbOplogWindow: MetricSettings{
+			Enabled: false,
+		},
+		MongodbReplicationLag: MetricSettings{
+			Enabled: false,
+		},
 		MongodbSessionCount: MetricSettings{
 			Enabled: true,
 		},
@@ -142,6 +170,70 @@ var MapAttributeConnectionType = map[string]AttributeConnectionType{
 	"current":   AttributeConnectionTypeCurrent,
 }
 
+// AttributeElectionReason specifies the a value election_reason attribute.
+type AttributeElectionReason int
+
+const (
+	_ AttributeElectionReason = iota
+	AttributeElectionReasonStepUpCmd
+	AttributeElectionReasonPriorityTakeover
+	AttributeElectionReasonCatchUpTakeover
+	AttributeElectionReasonElectionTimeout
+	AttributeElectionReasonFreezeTimeout
+)
+
+// String returns the string representation of the AttributeElectionReason.
+func (av AttributeElectionReason) String() string {
+	switch av {
+	case AttributeElectionReasonStepUpCmd:
+		return "step_up_cmd"
+	case AttributeElectionReasonPriorityTakeover:
+		return "priority_takeover"
+	case AttributeElectionReasonCatchUpTakeover:
+		return "catch_up_takeover"
+	case AttributeElectionReasonElectionTimeout:
+		return "election_timeout"
+	case AttributeElectionReasonFreezeTimeout:
+		return "freeze_timeout"
+	}
+	return ""
+}
+
+// MapAttributeElectionReason is a helper map of string to AttributeElectionReason attribute value.
+var MapAttributeElectionReason = map[string]AttributeElectionReason{
+	"step_up_cmd":       AttributeElectionReasonStepUpCmd,
+	"priority_takeover": AttributeElectionReasonPriorityTakeover,
+	"catch_up_takeover": AttributeElectionReasonCatchUpTakeover,
+	"election_timeout":  AttributeElectionReasonElectionTimeout,
+	"freeze_timeout":    AttributeElectionReasonFreezeTimeout,
+}
+
+// AttributeEvictionType specifies the a value eviction_type attribute.
+type AttributeEvictionType int
+
+const (
+	_ AttributeEvictionType = iota
+	AttributeEvictionTypeModified
+	AttributeEvictionTypeUnmodified
+)
+
+// String returns the string representation of the AttributeEvictionType.
+func (av AttributeEvictionType) String() string {
+	switch av {
+	case AttributeEvictionTypeModified:
+		return "modified"
+	case AttributeEvictionTypeUnmodified:
+		return "unmodified"
+	}
+	return ""
+}
+
+// MapAttributeEvictionType is a helper map of string to AttributeEvictionType attribute value.
+var MapAttributeEvictionType = map[string]AttributeEvictionType{
+	"modified":   AttributeEvictionTypeModified,
+	"unmodified": AttributeEvictionTypeUnmodified,
+}
+
 // AttributeMemoryType specifies the a value memory_type attribute.
 type AttributeMemoryType int
 
@@ -236,6 +328,161 @@ var MapAttributeType = map[string]AttributeType{
 	"miss": AttributeTypeMiss,
 }
 
+type metricMongodbCacheDirtySize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.cache.dirty.size metric with initial data.
+func (m *metricMongodbCacheDirtySize) init() {
+	m.data.SetName("mongodb.cache.dirty.size")
+	m.data.SetDescription("The amount of modified data in the WiredTiger cache that has not been written to disk.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricMongodbCacheDirtySize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbCacheDirtySize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbCacheDirtySize) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbCacheDirtySize(settings MetricSettings) metricMongodbCacheDirtySize {
+	m := metricMongodbCacheDirtySize{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbCacheEvictions struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.cache.evictions metric with initial data.
+func (m *metricMongodbCacheEvictions) init() {
+	m.data.SetName("mongodb.cache.evictions")
+	m.data.SetDescription("The number of pages evicted from the WiredTiger cache.")
+	m.data.SetUnit("{pages}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMongodbCacheEvictions) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, evictionTypeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("type", evictionTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbCacheEvictions) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbCacheEvictions) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbCacheEvictions(settings MetricSettings) metricMongodbCacheEvictions {
+	m := metricMongodbCacheEvictions{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbCacheMaxSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.cache.max_size metric with initial data.
+func (m *metricMongodbCacheMaxSize) init() {
+	m.data.SetName("mongodb.cache.max_size")
+	m.data.SetDescription("The maximum size configured for the WiredTiger cache.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricMongodbCacheMaxSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbCacheMaxSize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbCacheMaxSize) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbCacheMaxSize(settings MetricSettings) metricMongodbCacheMaxSize {
+	m := metricMongodbCacheMaxSize{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMongodbCacheOperations struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -289,6 +536,57 @@ func newMetricMongodbCacheOperations(settings MetricSettings) metricMongodbCache
 	return m
 }
 
+type metricMongodbCacheSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.cache.size metric with initial data.
+func (m *metricMongodbCacheSize) init() {
+	m.data.SetName("mongodb.cache.size")
+	m.data.SetDescription("The amount of data currently in the WiredTiger cache.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricMongodbCacheSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbCacheSize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbCacheSize) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbCacheSize(settings MetricSettings) metricMongodbCacheSize {
+	m := metricMongodbCacheSize{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMongodbCollectionCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -656,6 +954,59 @@ func newMetricMongodbDocumentOperationCount(settings MetricSettings) metricMongo
 	return m
 }
 
+type metricMongodbElectionCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.election.count metric with initial data.
+func (m *metricMongodbElectionCount) init() {
+	m.data.SetName("mongodb.election.count")
+	m.data.SetDescription("The number of elections called by the member.")
+	m.data.SetUnit("{elections}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMongodbElectionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, electionReasonAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("reason", electionReasonAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbElectionCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbElectionCount) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbElectionCount(settings MetricSettings) metricMongodbElectionCount {
+	m := metricMongodbElectionCount{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMongodbExtentCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1286,6 +1637,106 @@ func newMetricMongodbOperationTime(settings MetricSettings) metricMongodbOperati
 	return m
 }
 
+type metricMongodbOplogWindow struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.oplog.window metric with initial data.
+func (m *metricMongodbOplogWindow) init() {
+	m.data.SetName("mongodb.oplog.window")
+	m.data.SetDescription("The amount of time between the oldest and newest entries of the oplog.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricMongodbOplogWindow) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbOplogWindow) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbOplogWindow) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbOplogWindow(settings MetricSettings) metricMongodbOplogWindow {
+	m := metricMongodbOplogWindow{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbReplicationLag struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.replication.lag metric with initial data.
+func (m *metricMongodbReplicationLag) init() {
+	m.data.SetName("mongodb.replication.lag")
+	m.data.SetDescription("The amount of time a replica set member is behind the primary.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMongodbReplicationLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, memberAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("member", memberAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbReplicationLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbReplicationLag) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbReplicationLag(settings MetricSettings) metricMongodbReplicationLag {
+	m := metricMongodbReplicationLag{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMongodbSessionCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1398,7 +1849,11 @@ type MetricsBuilder struct {
 	resourceCapacity                    int                 // maximum observed number of resource attributes.
 	metricsBuffer                       pmetric.Metrics     // accumulates metrics data before emitting.
 	buildInfo                           component.BuildInfo // contains version information
+	metricMongodbCacheDirtySize         metricMongodbCacheDirtySize
+	metricMongodbCacheEvictions         metricMongodbCacheEvictions
+	metricMongodbCacheMaxSize           metricMongodbCacheMaxSize
 	metricMongodbCacheOperations        metricMongodbCacheOperations
+	metricMongodbCacheSize              metricMongodbCacheSize
 	metricMongodbCollectionCount        metricMongodbCollectionCount
 	metricMongodbConnectionCount        metricMongodbConnectionCount
 	metricMongodbCursorCount            metricMongodbCursorCount
@@ -1406,6 +1861,7 @@ type MetricsBuilder struct {
 	metricMongodbDataSize               metricMongodbDataSize
 	metricMongodbDatabaseCount          metricMongodbDatabaseCount
 	metricMongodbDocumentOperationCount metricMongodbDocumentOperationCount
+	metricMongodbElectionCount          metricMongodbElectionCount
 	metricMongodbExtentCount            metricMongodbExtentCount
 	metricMongodbGlobalLockTime         metricMongodbGlobalLockTime
 	metricMongodbIndexAccessCount       metricMongodbIndexAccessCount
@@ -1418,6 +1874,8 @@ type MetricsBuilder struct {
 	metricMongodbObjectCount            metricMongodbObjectCount
 	metricMongodbOperationCount         metricMongodbOperationCount
 	metricMongodbOperationTime          metricMongodbOperationTime
+	metricMongodbOplogWindow            metricMongodbOplogWindow
+	metricMongodbReplicationLag         metricMongodbReplicationLag
 	metricMongodbSessionCount           metricMongodbSessionCount
 	metricMongodbStorageSize            metricMongodbStorageSize
 }
@@ -1437,7 +1895,11 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
 		metricsBuffer:                       pmetric.NewMetrics(),
 		buildInfo:                           buildInfo,
+		metricMongodbCacheDirtySize:         newMetricMongodbCacheDirtySize(settings.MongodbCacheDirtySize),
+		metricMongodbCacheEvictions:         newMetricMongodbCacheEvictions(settings.MongodbCacheEvictions),
+		metricMongodbCacheMaxSize:           newMetricMongodbCacheMaxSize(settings.MongodbCacheMaxSize),
 		metricMongodbCacheOperations:        newMetricMongodbCacheOperations(settings.MongodbCacheOperations),
+		metricMongodbCacheSize:              newMetricMongodbCacheSize(settings.MongodbCacheSize),
 		metricMongodbCollectionCount:        newMetricMongodbCollectionCount(settings.MongodbCollectionCount),
 		metricMongodbConnectionCount:        newMetricMongodbConnectionCount(settings.MongodbConnectionCount),
 		metricMongodbCursorCount:            newMetricMongodbCursorCount(settings.MongodbCursorCount),
@@ -1445,6 +1907,7 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricMongodbDataSize:               newMetricMongodbDataSize(settings.MongodbDataSize),
 		metricMongodbDatabaseCount:          newMetricMongodbDatabaseCount(settings.MongodbDatabaseCount),
 		metricMongodbDocumentOperationCount: newMetricMongodbDocumentOperationCount(settings.MongodbDocumentOperationCount),
+		metricMongodbElectionCount:          newMetricMongodbElectionCount(settings.MongodbElectionCount),
 		metricMongodbExtentCount:            newMetricMongodbExtentCount(settings.MongodbExtentCount),
 		metricMongodbGlobalLockTime:         newMetricMongodbGlobalLockTime(settings.MongodbGlobalLockTime),
 		metricMongodbIndexAccessCount:       newMetricMongodbIndexAccessCount(settings.MongodbIndexAccessCount),
@@ -1457,6 +1920,8 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricMongodbObjectCount:            newMetricMongodbObjectCount(settings.MongodbObjectCount),
 		metricMongodbOperationCount:         newMetricMongodbOperationCount(settings.MongodbOperationCount),
 		metricMongodbOperationTime:          newMetricMongodbOperationTime(settings.MongodbOperationTime),
+		metricMongodbOplogWindow:            newMetricMongodbOplogWindow(settings.MongodbOplogWindow),
+		metricMongodbReplicationLag:         newMetricMongodbReplicationLag(settings.MongodbReplicationLag),
 		metricMongodbSessionCount:           newMetricMongodbSessionCount(settings.MongodbSessionCount),
 		metricMongodbStorageSize:            newMetricMongodbStorageSize(settings.MongodbStorageSize),
 	}
@@ -1518,7 +1983,11 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetName("otelcol/mongodbreceiver")
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricMongodbCacheDirtySize.emit(ils.Metrics())
+	mb.metricMongodbCacheEvictions.emit(ils.Metrics())
+	mb.metricMongodbCacheMaxSize.emit(ils.Metrics())
 	mb.metricMongodbCacheOperations.emit(ils.Metrics())
+	mb.metricMongodbCacheSize.emit(ils.Metrics())
 	mb.metricMongodbCollectionCount.emit(ils.Metrics())
 	mb.metricMongodbConnectionCount.emit(ils.Metrics())
 	mb.metricMongodbCursorCount.emit(ils.Metrics())
@@ -1526,6 +1995,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricMongodbDataSize.emit(ils.Metrics())
 	mb.metricMongodbDatabaseCount.emit(ils.Metrics())
 	mb.metricMongodbDocumentOperationCount.emit(ils.Metrics())
+	mb.metricMongodbElectionCount.emit(ils.Metrics())
 	mb.metricMongodbExtentCount.emit(ils.Metrics())
 	mb.metricMongodbGlobalLockTime.emit(ils.Metrics())
 	mb.metricMongodbIndexAccessCount.emit(ils.Metrics())
@@ -1538,6 +2008,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricMongodbObjectCount.emit(ils.Metrics())
 	mb.metricMongodbOperationCount.emit(ils.Metrics())
 	mb.metricMongodbOperationTime.emit(ils.Metrics())
+	mb.metricMongodbOplogWindow.emit(ils.Metrics())
+	mb.metricMongodbReplicationLag.emit(ils.Metrics())
 	mb.metricMongodbSessionCount.emit(ils.Metrics())
 	mb.metricMongodbStorageSize.emit(ils.Metrics())
 	for _, op := range rmo {
@@ -1559,11 +2031,31 @@ func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
 	return metrics
 }
 
+// RecordMongodbCacheDirtySizeDataPoint adds a data point to mongodb.cache.dirty.size metric.
+func (mb *MetricsBuilder) RecordMongodbCacheDirtySizeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMongodbCacheDirtySize.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordMongodbCacheEvictionsDataPoint adds a data point to mongodb.cache.evictions metric.
+func (mb *MetricsBuilder) RecordMongodbCacheEvictionsDataPoint(ts pcommon.Timestamp, val int64, evictionTypeAttributeValue AttributeEvictionType) {
+	mb.metricMongodbCacheEvictions.recordDataPoint(mb.startTime, ts, val, evictionTypeAttributeValue.String())
+}
+
+// RecordMongodbCacheMaxSizeDataPoint adds a data point to mongodb.cache.max_size metric.
+func (mb *MetricsBuilder) RecordMongodbCacheMaxSizeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMongodbCacheMaxSize.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordMongodbCacheOperationsDataPoint adds a data point to mongodb.cache.operations metric.
 func (mb *MetricsBuilder) RecordMongodbCacheOperationsDataPoint(ts pcommon.Timestamp, val int64, typeAttributeValue AttributeType) {
 	mb.metricMongodbCacheOperations.recordDataPoint(mb.startTime, ts, val, typeAttributeValue.String())
 }
 
+// RecordMongodbCacheSizeDataPoint adds a data point to mongodb.cache.size metric.
+func (mb *MetricsBuilder) RecordMongodbCacheSizeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMongodbCacheSize.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordMongodbCollectionCountDataPoint adds a data point to mongodb.collection.count metric.
 func (mb *MetricsBuilder) RecordMongodbCollectionCountDataPoint(ts pcommon.Timestamp, val int64, databaseAttributeValue string) {
 	mb.metricMongodbCollectionCount.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue)
@@ -1599,6 +2091,11 @@ func (mb *MetricsBuilder) RecordMongodbDocumentOperationCountDataPoint(ts pcommo
 	mb.metricMongodbDocumentOperationCount.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue, operationAttributeValue.String())
 }
 
+// RecordMongodbElectionCountDataPoint adds a data point to mongodb.election.count metric.
+func (mb *MetricsBuilder) RecordMongodbElectionCountDataPoint(ts pcommon.Timestamp, val int64, electionReasonAttributeValue AttributeElectionReason) {
+	mb.metricMongodbElectionCount.recordDataPoint(mb.startTime, ts, val, electionReasonAttributeValue.String())
+}
+
 // RecordMongodbExtentCountDataPoint adds a data point to mongodb.extent.count metric.
 func (mb *MetricsBuilder) RecordMongodbExtentCountDataPoint(ts pcommon.Timestamp, val int64, databaseAttributeValue string) {
 	mb.metricMongodbExtentCount.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue)
@@ -1659,6 +2156,16 @@ func (mb *MetricsBuilder) RecordMongodbOperationTimeDataPoint(ts pcommon.Timesta
 	mb.metricMongodbOperationTime.recordDataPoint(mb.startTime, ts, val, operationAttributeValue.String())
 }
 
+// RecordMongodbOplogWindowDataPoint adds a data point to mongodb.oplog.window metric.
+func (mb *MetricsBuilder) RecordMongodbOplogWindowDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMongodbOplogWindow.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordMongodbReplicationLagDataPoint adds a data point to mongodb.replication.lag metric.
+func (mb *MetricsBuilder) RecordMongodbReplicationLagDataPoint(ts pcommon.Timestamp, val int64, memberAttributeValue string) {
+	mb.metricMongodbReplicationLag.recordDataPoint(mb.startTime, ts, val, memberAttributeValue)
+}
+
 // RecordMongodbSessionCountDataPoint adds a data point to mongodb.session.count metric.
 func (mb *MetricsBuilder) RecordMongodbSessionCountDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricMongodbSessionCount.recordDataPoint(mb.startTime, ts, val)
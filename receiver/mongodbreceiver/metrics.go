@@ -18,9 +18,11 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
@@ -42,6 +44,19 @@ var documentMap = map[string]metadata.AttributeOperation{
 	"deleted":  metadata.AttributeOperationDelete,
 }
 
+var electionReasonMap = map[string]metadata.AttributeElectionReason{
+	"stepUpCmd":        metadata.AttributeElectionReasonStepUpCmd,
+	"priorityTakeover": metadata.AttributeElectionReasonPriorityTakeover,
+	"catchUpTakeover":  metadata.AttributeElectionReasonCatchUpTakeover,
+	"electionTimeout":  metadata.AttributeElectionReasonElectionTimeout,
+	"freezeTimeout":    metadata.AttributeElectionReasonFreezeTimeout,
+}
+
+var cacheEvictionMap = map[string]metadata.AttributeEvictionType{
+	"modified pages evicted":   metadata.AttributeEvictionTypeModified,
+	"unmodified pages evicted": metadata.AttributeEvictionTypeUnmodified,
+}
+
 const (
 	collectMetricError          = "failed to collect metric %s: %w"
 	collectMetricWithAttributes = "failed to collect metric %s with attribute(s) %s: %w"
@@ -263,6 +278,68 @@ func (s *mongodbScraper) recordCacheOperations(now pcommon.Timestamp, doc bson.M
 	s.mb.RecordMongodbCacheOperationsDataPoint(now, cacheHits, metadata.AttributeTypeHit)
 }
 
+func (s *mongodbScraper) recordCacheUsage(now pcommon.Timestamp, doc bson.M, errs *scrapererror.ScrapeErrors) {
+	if !s.config.Metrics.MongodbCacheSize.Enabled && !s.config.Metrics.MongodbCacheMaxSize.Enabled &&
+		!s.config.Metrics.MongodbCacheDirtySize.Enabled && !s.config.Metrics.MongodbCacheEvictions.Enabled {
+		return
+	}
+
+	storageEngine, err := dig(doc, []string{"storageEngine", "name"})
+	if err != nil {
+		errs.AddPartial(1, errors.New("failed to find storage engine for cache usage"))
+		return
+	}
+	if storageEngine != "wiredTiger" {
+		// mongodb is using a different storage engine and these metrics can not be collected
+		return
+	}
+
+	cacheRecorderMap := map[string]func(pcommon.Timestamp, int64){
+		"bytes currently in the cache":     s.mb.RecordMongodbCacheSizeDataPoint,
+		"maximum bytes configured":         s.mb.RecordMongodbCacheMaxSizeDataPoint,
+		"tracked dirty bytes in the cache": s.mb.RecordMongodbCacheDirtySizeDataPoint,
+	}
+	for cacheKey, recorder := range cacheRecorderMap {
+		metricPath := []string{"wiredTiger", "cache", cacheKey}
+		val, err := collectMetric(doc, metricPath)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf(collectMetricError, cacheKey, err))
+			continue
+		}
+		recorder(now, val)
+	}
+
+	for evictionKey, evictionType := range cacheEvictionMap {
+		metricPath := []string{"wiredTiger", "cache", evictionKey}
+		metricName := "mongodb.cache.evictions"
+		val, err := collectMetric(doc, metricPath)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, evictionType.String(), err))
+			continue
+		}
+		s.mb.RecordMongodbCacheEvictionsDataPoint(now, val, evictionType)
+	}
+}
+
+func (s *mongodbScraper) recordElections(now pcommon.Timestamp, doc bson.M, errs *scrapererror.ScrapeErrors) {
+	// Election metrics are available for replica set members in 4.2.1+
+	// https://www.mongodb.com/docs/manual/reference/command/serverStatus/#electionmetrics
+	if !s.config.Metrics.MongodbElectionCount.Enabled {
+		return
+	}
+
+	for reasonKey, reason := range electionReasonMap {
+		metricPath := []string{"electionMetrics", reasonKey, "called"}
+		metricName := "mongodb.election.count"
+		val, err := collectMetric(doc, metricPath)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, reason.String(), err))
+			continue
+		}
+		s.mb.RecordMongodbElectionCountDataPoint(now, val, reason)
+	}
+}
+
 func (s *mongodbScraper) recordGlobalLockTime(now pcommon.Timestamp, doc bson.M, errs *scrapererror.ScrapeErrors) {
 	metricPath := []string{"globalLock", "totalTime"}
 	metricName := "mongodb.global_lock.time"
@@ -314,6 +391,49 @@ func (s *mongodbScraper) recordNetworkCount(now pcommon.Timestamp, doc bson.M, e
 	}
 }
 
+// Replica Set Status
+func (s *mongodbScraper) recordReplicationLag(now pcommon.Timestamp, doc bson.M, errs *scrapererror.ScrapeErrors) {
+	metricName := "mongodb.replication.lag"
+	members, ok := doc["members"].(bson.A)
+	if !ok {
+		errs.AddPartial(1, fmt.Errorf(collectMetricError, metricName, errors.New("could not find replica set members")))
+		return
+	}
+
+	var primaryOptime time.Time
+	memberOptimes := map[string]time.Time{}
+	for _, rawMember := range members {
+		var member bson.M
+		switch m := rawMember.(type) {
+		case bson.M:
+			member = m
+		case bson.D:
+			member = m.Map()
+		default:
+			continue
+		}
+		name, _ := member["name"].(string)
+		optime, ok := member["optimeDate"].(primitive.DateTime)
+		if name == "" || !ok {
+			// arbiters do not hold data and have no optime
+			continue
+		}
+		if member["stateStr"] == "PRIMARY" {
+			primaryOptime = optime.Time()
+		}
+		memberOptimes[name] = optime.Time()
+	}
+
+	if primaryOptime.IsZero() {
+		errs.AddPartial(len(memberOptimes), fmt.Errorf(collectMetricError, metricName, errors.New("could not find replica set primary")))
+		return
+	}
+
+	for name, optime := range memberOptimes {
+		s.mb.RecordMongodbReplicationLagDataPoint(now, int64(primaryOptime.Sub(optime).Seconds()), name)
+	}
+}
+
 // Index Stats
 func (s *mongodbScraper) recordIndexAccess(now pcommon.Timestamp, documents []bson.M, dbName string, collectionName string, errs *scrapererror.ScrapeErrors) {
 	// Collect the index access given a collection and database if version is >= 3.2
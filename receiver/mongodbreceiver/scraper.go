@@ -93,6 +93,7 @@ func (s *mongodbScraper) collectMetrics(ctx context.Context, errs *scrapererror.
 	s.mb.RecordMongodbDatabaseCountDataPoint(now, int64(len(dbNames)))
 	s.collectAdminDatabase(ctx, now, errs)
 	s.collectTopStats(ctx, now, errs)
+	s.collectReplication(ctx, now, errs)
 
 	for _, dbName := range dbNames {
 		s.collectDatabase(ctx, now, dbName, errs)
@@ -151,6 +152,30 @@ func (s *mongodbScraper) collectTopStats(ctx context.Context, now pcommon.Timest
 	s.mb.EmitForResource()
 }
 
+// collectReplication only runs the replica set commands when the related metrics are enabled, as they
+// fail on standalone servers
+func (s *mongodbScraper) collectReplication(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if s.config.Metrics.MongodbReplicationLag.Enabled {
+		status, err := s.client.ReplSetGetStatus(ctx)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf("failed to fetch replica set status metrics: %w", err))
+		} else {
+			s.recordReplicationLag(now, status, errs)
+		}
+	}
+
+	if s.config.Metrics.MongodbOplogWindow.Enabled {
+		window, err := s.client.OplogWindow(ctx)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf("failed to fetch oplog window metrics: %w", err))
+		} else {
+			s.mb.RecordMongodbOplogWindowDataPoint(now, int64(window.Seconds()))
+		}
+	}
+
+	s.mb.EmitForResource()
+}
+
 func (s *mongodbScraper) collectIndexStats(ctx context.Context, now pcommon.Timestamp, databaseName string, collectionName string, errs *scrapererror.ScrapeErrors) {
 	indexStats, err := s.client.IndexStats(ctx, databaseName, collectionName)
 	if err != nil {
@@ -185,6 +210,8 @@ func (s *mongodbScraper) recordAdminStats(now pcommon.Timestamp, document bson.M
 	s.recordNetworkCount(now, document, errs)
 	s.recordOperations(now, document, errs)
 	s.recordSessionCount(now, document, errs)
+	s.recordCacheUsage(now, document, errs)
+	s.recordElections(now, document, errs)
 }
 
 func (s *mongodbScraper) recordIndexStats(now pcommon.Timestamp, indexStats []bson.M, databaseName string, collectionName string, errs *scrapererror.ScrapeErrors) {
@@ -27,8 +27,10 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
@@ -379,3 +381,86 @@ func TestTopMetricsAggregation(t *testing.T) {
 		require.EqualValues(t, expectedCommandValues, actualOperationTimeValues["commands"])
 	})
 }
+
+func TestScraperReplicationMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.MongodbReplicationLag.Enabled = true
+	cfg.Metrics.MongodbOplogWindow.Enabled = true
+	cfg.Metrics.MongodbElectionCount.Enabled = true
+	cfg.Metrics.MongodbCacheSize.Enabled = true
+	cfg.Metrics.MongodbCacheMaxSize.Enabled = true
+	cfg.Metrics.MongodbCacheDirtySize.Enabled = true
+	cfg.Metrics.MongodbCacheEvictions.Enabled = true
+
+	primaryOptime := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	replSetStatus := bson.M{
+		"set": "rs0",
+		"members": bson.A{
+			bson.M{
+				"name":       "mongo-0:27017",
+				"stateStr":   "PRIMARY",
+				"optimeDate": primitive.NewDateTimeFromTime(primaryOptime),
+			},
+			bson.M{
+				"name":       "mongo-1:27017",
+				"stateStr":   "SECONDARY",
+				"optimeDate": primitive.NewDateTimeFromTime(primaryOptime.Add(-30 * time.Second)),
+			},
+			bson.M{
+				"name":     "mongo-2:27017",
+				"stateStr": "ARBITER",
+			},
+		},
+	}
+	adminStatus, err := loadAdminStatusAsMap()
+	require.NoError(t, err)
+
+	fc := &fakeClient{}
+	fc.On("ReplSetGetStatus", mock.Anything).Return(replSetStatus, nil)
+	fc.On("OplogWindow", mock.Anything).Return(2*time.Hour, nil)
+
+	scraper := newMongodbScraper(componenttest.NewNopReceiverCreateSettings(), cfg)
+	scraper.client = fc
+
+	errs := &scrapererror.ScrapeErrors{}
+	now := pcommon.NewTimestampFromTime(time.Now())
+	scraper.recordCacheUsage(now, adminStatus, errs)
+	scraper.recordElections(now, adminStatus, errs)
+	scraper.collectReplication(context.Background(), now, errs)
+	require.NoError(t, errs.Combine())
+	fc.AssertExpectations(t)
+
+	metrics := map[string]pmetric.Metric{}
+	rms := scraper.mb.Emit().ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				metrics[ms.At(k).Name()] = ms.At(k)
+			}
+		}
+	}
+
+	lag, ok := metrics["mongodb.replication.lag"]
+	require.True(t, ok)
+	lagByMember := map[string]int64{}
+	for i := 0; i < lag.Gauge().DataPoints().Len(); i++ {
+		dp := lag.Gauge().DataPoints().At(i)
+		member, ok := dp.Attributes().Get("member")
+		require.True(t, ok)
+		lagByMember[member.Str()] = dp.IntValue()
+	}
+	require.Equal(t, map[string]int64{"mongo-0:27017": 0, "mongo-1:27017": 30}, lagByMember)
+
+	window, ok := metrics["mongodb.oplog.window"]
+	require.True(t, ok)
+	require.EqualValues(t, 7200, window.Gauge().DataPoints().At(0).IntValue())
+
+	// values are taken from testdata/admin.json
+	require.EqualValues(t, 2048, metrics["mongodb.cache.size"].Sum().DataPoints().At(0).IntValue())
+	require.EqualValues(t, 8192, metrics["mongodb.cache.max_size"].Sum().DataPoints().At(0).IntValue())
+	require.EqualValues(t, 512, metrics["mongodb.cache.dirty.size"].Sum().DataPoints().At(0).IntValue())
+	require.Equal(t, 2, metrics["mongodb.cache.evictions"].Sum().DataPoints().Len())
+	require.Equal(t, len(electionReasonMap), metrics["mongodb.election.count"].Sum().DataPoints().Len())
+}
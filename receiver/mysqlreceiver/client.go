@@ -30,6 +30,8 @@ type client interface {
 	getTableIoWaitsStats() ([]TableIoWaitsStats, error)
 	getIndexIoWaitsStats() ([]IndexIoWaitsStats, error)
 	getStatementEventsStats() ([]StatementEventStats, error)
+	getReplicaStatusStats() ([]ReplicaStatusStats, error)
+	getGlobalVariables() (map[string]string, error)
 	Close() error
 }
 
@@ -80,9 +82,15 @@ type StatementEventStats struct {
 	countNoIndexUsed          int64
 }
 
+type ReplicaStatusStats struct {
+	channel string
+	// secondsBehindSource is NULL when the replica SQL thread is not running
+	secondsBehindSource sql.NullInt64
+}
+
 var _ client = (*mySQLClient)(nil)
 
-func newMySQLClient(conf *Config) client {
+func newMySQLClient(conf *Config) (client, error) {
 	driverConf := mysql.Config{
 		User:                 conf.Username,
 		Passwd:               conf.Password,
@@ -91,6 +99,19 @@ func newMySQLClient(conf *Config) client {
 		DBName:               conf.Database,
 		AllowNativePasswords: conf.AllowNativePasswords,
 	}
+
+	tlsConfig, err := conf.TLS.LoadTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		// the driver looks TLS configurations up by name, so each receiver registers its own. Full
+		// caching_sha2_password authentication sends the password over this connection.
+		driverConf.TLSConfig = conf.ID().String()
+		if err = mysql.RegisterTLSConfig(driverConf.TLSConfig, tlsConfig); err != nil {
+			return nil, fmt.Errorf("unable to register TLS config: %w", err)
+		}
+	}
 	connStr := driverConf.FormatDSN()
 
 	return &mySQLClient{
@@ -98,7 +119,7 @@ func newMySQLClient(conf *Config) client {
 		statementEventsDigestTextLimit: conf.StatementEvents.DigestTextLimit,
 		statementEventsLimit:           conf.StatementEvents.Limit,
 		statementEventsTimeLimit:       conf.StatementEvents.TimeLimit,
-	}
+	}, nil
 }
 
 func (c *mySQLClient) Connect() error {
@@ -214,6 +235,64 @@ func (c *mySQLClient) getStatementEventsStats() ([]StatementEventStats, error) {
 	return stats, nil
 }
 
+// getReplicaStatusStats queries the db for the status of every replication channel, it returns no stats when the
+// server is not a replica.
+func (c *mySQLClient) getReplicaStatusStats() ([]ReplicaStatusStats, error) {
+	rows, err := c.client.Query("SHOW REPLICA STATUS;")
+	if err != nil {
+		// servers older than 8.0.22 only support the SLAVE syntax
+		rows, err = c.client.Query("SHOW SLAVE STATUS;")
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer rows.Close()
+
+	// the set of columns differs between versions, so they are looked up by name
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var stats []ReplicaStatusStats
+	for rows.Next() {
+		values := make([]sql.NullString, len(cols))
+		dest := make([]interface{}, len(cols))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		var s ReplicaStatusStats
+		for i, col := range cols {
+			switch col {
+			case "Channel_Name":
+				s.channel = values[i].String
+			case "Seconds_Behind_Source", "Seconds_Behind_Master":
+				if !values[i].Valid {
+					continue
+				}
+				behind, err := parseInt(values[i].String)
+				if err != nil {
+					return nil, err
+				}
+				s.secondsBehindSource = sql.NullInt64{Int64: behind, Valid: true}
+			}
+		}
+		stats = append(stats, s)
+	}
+
+	return stats, nil
+}
+
+// getGlobalVariables queries the db for the global variables used to compute replication and redo log metrics.
+func (c *mySQLClient) getGlobalVariables() (map[string]string, error) {
+	query := "SHOW GLOBAL VARIABLES WHERE Variable_name IN " +
+		"('gtid_executed', 'innodb_redo_log_capacity', 'innodb_log_file_size', 'innodb_log_files_in_group');"
+	return Query(*c, query)
+}
+
 func Query(c mySQLClient, query string) (map[string]string, error) {
 	rows, err := c.client.Query(query)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mysqlreceiver/internal/metadata"
@@ -36,8 +37,9 @@ type Config struct {
 	Database                                string `mapstructure:"database,omitempty"`
 	AllowNativePasswords                    bool   `mapstructure:"allow_native_passwords,omitempty"`
 	confignet.NetAddr                       `mapstructure:",squash"`
-	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
-	StatementEvents                         StatementEventsConfig    `mapstructure:"statement_events"`
+	TLS                                     configtls.TLSClientSetting `mapstructure:"tls,omitempty"`
+	Metrics                                 metadata.MetricsSettings   `mapstructure:"metrics"`
+	StatementEvents                         StatementEventsConfig      `mapstructure:"statement_events"`
 }
 
 type StatementEventsConfig struct {
@@ -21,6 +21,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
@@ -51,6 +52,9 @@ func createDefaultConfig() config.Receiver {
 			Endpoint:  "localhost:3306",
 			Transport: "tcp",
 		},
+		TLS: configtls.TLSClientSetting{
+			Insecure: true,
+		},
 		Metrics: metadata.DefaultMetricsSettings(),
 		StatementEvents: StatementEventsConfig{
 			DigestTextLimit: defaultStatementEventsDigestTextLimit,
@@ -19,33 +19,38 @@ type MetricSettings struct {
 
 // MetricsSettings provides settings for mysqlreceiver metrics.
 type MetricsSettings struct {
-	MysqlBufferPoolDataPages    MetricSettings `mapstructure:"mysql.buffer_pool.data_pages"`
-	MysqlBufferPoolLimit        MetricSettings `mapstructure:"mysql.buffer_pool.limit"`
-	MysqlBufferPoolOperations   MetricSettings `mapstructure:"mysql.buffer_pool.operations"`
-	MysqlBufferPoolPageFlushes  MetricSettings `mapstructure:"mysql.buffer_pool.page_flushes"`
-	MysqlBufferPoolPages        MetricSettings `mapstructure:"mysql.buffer_pool.pages"`
-	MysqlBufferPoolUsage        MetricSettings `mapstructure:"mysql.buffer_pool.usage"`
-	MysqlCommands               MetricSettings `mapstructure:"mysql.commands"`
-	MysqlDoubleWrites           MetricSettings `mapstructure:"mysql.double_writes"`
-	MysqlHandlers               MetricSettings `mapstructure:"mysql.handlers"`
-	MysqlIndexIoWaitCount       MetricSettings `mapstructure:"mysql.index.io.wait.count"`
-	MysqlIndexIoWaitTime        MetricSettings `mapstructure:"mysql.index.io.wait.time"`
-	MysqlLockedConnects         MetricSettings `mapstructure:"mysql.locked_connects"`
-	MysqlLocks                  MetricSettings `mapstructure:"mysql.locks"`
-	MysqlLogOperations          MetricSettings `mapstructure:"mysql.log_operations"`
-	MysqlMysqlxWorkerThreads    MetricSettings `mapstructure:"mysql.mysqlx_worker_threads"`
-	MysqlOpenedResources        MetricSettings `mapstructure:"mysql.opened_resources"`
-	MysqlOperations             MetricSettings `mapstructure:"mysql.operations"`
-	MysqlPageOperations         MetricSettings `mapstructure:"mysql.page_operations"`
-	MysqlRowLocks               MetricSettings `mapstructure:"mysql.row_locks"`
-	MysqlRowOperations          MetricSettings `mapstructure:"mysql.row_operations"`
-	MysqlSorts                  MetricSettings `mapstructure:"mysql.sorts"`
-	MysqlStatementEventCount    MetricSettings `mapstructure:"mysql.statement_event.count"`
-	MysqlStatementEventWaitTime MetricSettings `mapstructure:"mysql.statement_event.wait.time"`
-	MysqlTableIoWaitCount       MetricSettings `mapstructure:"mysql.table.io.wait.count"`
-	MysqlTableIoWaitTime        MetricSettings `mapstructure:"mysql.table.io.wait.time"`
-	MysqlThreads                MetricSettings `mapstructure:"mysql.threads"`
-	MysqlTmpResources           MetricSettings `mapstructure:"mysql.tmp_resources"`
+	MysqlBufferPoolDataPages     MetricSettings `mapstructure:"mysql.buffer_pool.data_pages"`
+	MysqlBufferPoolHitRate       MetricSettings `mapstructure:"mysql.buffer_pool.hit_rate"`
+	MysqlBufferPoolLimit         MetricSettings `mapstructure:"mysql.buffer_pool.limit"`
+	MysqlBufferPoolOperations    MetricSettings `mapstructure:"mysql.buffer_pool.operations"`
+	MysqlBufferPoolPageFlushes   MetricSettings `mapstructure:"mysql.buffer_pool.page_flushes"`
+	MysqlBufferPoolPages         MetricSettings `mapstructure:"mysql.buffer_pool.pages"`
+	MysqlBufferPoolUsage         MetricSettings `mapstructure:"mysql.buffer_pool.usage"`
+	MysqlCommands                MetricSettings `mapstructure:"mysql.commands"`
+	MysqlDoubleWrites            MetricSettings `mapstructure:"mysql.double_writes"`
+	MysqlGtidExecutedGaps        MetricSettings `mapstructure:"mysql.gtid.executed.gaps"`
+	MysqlHandlers                MetricSettings `mapstructure:"mysql.handlers"`
+	MysqlIndexIoWaitCount        MetricSettings `mapstructure:"mysql.index.io.wait.count"`
+	MysqlIndexIoWaitTime         MetricSettings `mapstructure:"mysql.index.io.wait.time"`
+	MysqlLockedConnects          MetricSettings `mapstructure:"mysql.locked_connects"`
+	MysqlLocks                   MetricSettings `mapstructure:"mysql.locks"`
+	MysqlLogOperations           MetricSettings `mapstructure:"mysql.log_operations"`
+	MysqlMysqlxWorkerThreads     MetricSettings `mapstructure:"mysql.mysqlx_worker_threads"`
+	MysqlOpenedResources         MetricSettings `mapstructure:"mysql.opened_resources"`
+	MysqlOperations              MetricSettings `mapstructure:"mysql.operations"`
+	MysqlPageOperations          MetricSettings `mapstructure:"mysql.page_operations"`
+	MysqlRedoLogCapacity         MetricSettings `mapstructure:"mysql.redo_log.capacity"`
+	MysqlRedoLogOccupancy        MetricSettings `mapstructure:"mysql.redo_log.occupancy"`
+	MysqlReplicaTimeBehindSource MetricSettings `mapstructure:"mysql.replica.time_behind_source"`
+	MysqlRowLocks                MetricSettings `mapstructure:"mysql.row_locks"`
+	MysqlRowOperations           MetricSettings `mapstructure:"mysql.row_operations"`
+	MysqlSorts                   MetricSettings `mapstructure:"mysql.sorts"`
+	MysqlStatementEventCount     MetricSettings `mapstructure:"mysql.statement_event.count"`
+	MysqlStatementEventWaitTime  MetricSettings `mapstructure:"mysql.statement_event.wait.time"`
+	MysqlTableIoWaitCount        MetricSettings `mapstructure:"mysql.table.io.wait.count"`
+	MysqlTableIoWaitTime         MetricSettings `mapstructure:"mysql.table.io.wait.time"`
+	MysqlThreads                 MetricSettings `mapstructure:"mysql.threads"`
+	MysqlTmpResources            MetricSettings `mapstructure:"mysql.tmp_resources"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -53,6 +58,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		MysqlBufferPoolDataPages: MetricSettings{
 			Enabled: true,
 		},
+		MysqlBufferPoolHitRate: MetricSettings{
+			Enabled: false,
+		},
 		MysqlBufferPoolLimit: MetricSettings{
 			Enabled: true,
 		},
@@ -74,6 +82,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		MysqlDoubleWrites: MetricSettings{
 			Enabled: true,
 		},
+		MysqlGtidExecutedGaps: MetricSettings{
+			Enabled: false,
+		},
 		MysqlHandlers: MetricSettings{
 			Enabled: true,
 		},
@@ -104,6 +115,15 @@ func DefaultMetricsSettings() MetricsSettings {
 		MysqlPageOperations: MetricSettings{
 			Enabled: true,
 		},
+		MysqlRedoLogCapacity: MetricSettings{
+			Enabled: false,
+		},
+		MysqlRedoLogOccupancy: MetricSettings{
+			Enabled: false,
+		},
+		MysqlReplicaTimeBehindSource: MetricSettings{
+			Enabled: false,
+		},
 		MysqlRowLocks: MetricSettings{
 			Enabled: true,
 		},
@@ -869,6 +889,55 @@ func newMetricMysqlBufferPoolDataPages(settings MetricSettings) metricMysqlBuffe
 	return m
 }
 
+type metricMysqlBufferPoolHitRate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.buffer_pool.hit_rate metric with initial data.
+func (m *metricMysqlBufferPoolHitRate) init() {
+	m.data.SetName("mysql.buffer_pool.hit_rate")
+	m.data.SetDescription("The fraction of InnoDB buffer pool read requests that were satisfied without reading from disk.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricMysqlBufferPoolHitRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlBufferPoolHitRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlBufferPoolHitRate) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlBufferPoolHitRate(settings MetricSettings) metricMysqlBufferPoolHitRate {
+	m := metricMysqlBufferPoolHitRate{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMysqlBufferPoolLimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1236,6 +1305,55 @@ func newMetricMysqlDoubleWrites(settings MetricSettings) metricMysqlDoubleWrites
 	return m
 }
 
+type metricMysqlGtidExecutedGaps struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.gtid.executed.gaps metric with initial data.
+func (m *metricMysqlGtidExecutedGaps) init() {
+	m.data.SetName("mysql.gtid.executed.gaps")
+	m.data.SetDescription("The number of gaps between the transaction ranges in the executed GTID set.")
+	m.data.SetUnit("{gaps}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricMysqlGtidExecutedGaps) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlGtidExecutedGaps) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlGtidExecutedGaps) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlGtidExecutedGaps(settings MetricSettings) metricMysqlGtidExecutedGaps {
+	m := metricMysqlGtidExecutedGaps{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMysqlHandlers struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1770,6 +1888,153 @@ func newMetricMysqlPageOperations(settings MetricSettings) metricMysqlPageOperat
 	return m
 }
 
+type metricMysqlRedoLogCapacity struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.redo_log.capacity metric with initial data.
+func (m *metricMysqlRedoLogCapacity) init() {
+	m.data.SetName("mysql.redo_log.capacity")
+	m.data.SetDescription("The configured capacity of the InnoDB redo log.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricMysqlRedoLogCapacity) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlRedoLogCapacity) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlRedoLogCapacity) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlRedoLogCapacity(settings MetricSettings) metricMysqlRedoLogCapacity {
+	m := metricMysqlRedoLogCapacity{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMysqlRedoLogOccupancy struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.redo_log.occupancy metric with initial data.
+func (m *metricMysqlRedoLogOccupancy) init() {
+	m.data.SetName("mysql.redo_log.occupancy")
+	m.data.SetDescription("The amount of redo log data between the last checkpoint and the current LSN.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricMysqlRedoLogOccupancy) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlRedoLogOccupancy) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlRedoLogOccupancy) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlRedoLogOccupancy(settings MetricSettings) metricMysqlRedoLogOccupancy {
+	m := metricMysqlRedoLogOccupancy{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMysqlReplicaTimeBehindSource struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.replica.time_behind_source metric with initial data.
+func (m *metricMysqlReplicaTimeBehindSource) init() {
+	m.data.SetName("mysql.replica.time_behind_source")
+	m.data.SetDescription("The time the replica SQL thread is behind the source, as reported by Seconds_Behind_Source.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricMysqlReplicaTimeBehindSource) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlReplicaTimeBehindSource) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlReplicaTimeBehindSource) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlReplicaTimeBehindSource(settings MetricSettings) metricMysqlReplicaTimeBehindSource {
+	m := metricMysqlReplicaTimeBehindSource{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMysqlRowLocks struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -2259,38 +2524,43 @@ func newMetricMysqlTmpResources(settings MetricSettings) metricMysqlTmpResources
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                         pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity                   int                 // maximum observed number of metrics per resource.
-	resourceCapacity                  int                 // maximum observed number of resource attributes.
-	metricsBuffer                     pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo                         component.BuildInfo // contains version information
-	metricMysqlBufferPoolDataPages    metricMysqlBufferPoolDataPages
-	metricMysqlBufferPoolLimit        metricMysqlBufferPoolLimit
-	metricMysqlBufferPoolOperations   metricMysqlBufferPoolOperations
-	metricMysqlBufferPoolPageFlushes  metricMysqlBufferPoolPageFlushes
-	metricMysqlBufferPoolPages        metricMysqlBufferPoolPages
-	metricMysqlBufferPoolUsage        metricMysqlBufferPoolUsage
-	metricMysqlCommands               metricMysqlCommands
-	metricMysqlDoubleWrites           metricMysqlDoubleWrites
-	metricMysqlHandlers               metricMysqlHandlers
-	metricMysqlIndexIoWaitCount       metricMysqlIndexIoWaitCount
-	metricMysqlIndexIoWaitTime        metricMysqlIndexIoWaitTime
-	metricMysqlLockedConnects         metricMysqlLockedConnects
-	metricMysqlLocks                  metricMysqlLocks
-	metricMysqlLogOperations          metricMysqlLogOperations
-	metricMysqlMysqlxWorkerThreads    metricMysqlMysqlxWorkerThreads
-	metricMysqlOpenedResources        metricMysqlOpenedResources
-	metricMysqlOperations             metricMysqlOperations
-	metricMysqlPageOperations         metricMysqlPageOperations
-	metricMysqlRowLocks               metricMysqlRowLocks
-	metricMysqlRowOperations          metricMysqlRowOperations
-	metricMysqlSorts                  metricMysqlSorts
-	metricMysqlStatementEventCount    metricMysqlStatementEventCount
-	metricMysqlStatementEventWaitTime metricMysqlStatementEventWaitTime
-	metricMysqlTableIoWaitCount       metricMysqlTableIoWaitCount
-	metricMysqlTableIoWaitTime        metricMysqlTableIoWaitTime
-	metricMysqlThreads                metricMysqlThreads
-	metricMysqlTmpResources           metricMysqlTmpResources
+	startTime                          pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                    int                 // maximum observed number of metrics per resource.
+	resourceCapacity                   int                 // maximum observed number of resource attributes.
+	metricsBuffer                      pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                          component.BuildInfo // contains version information
+	metricMysqlBufferPoolDataPages     metricMysqlBufferPoolDataPages
+	metricMysqlBufferPoolHitRate       metricMysqlBufferPoolHitRate
+	metricMysqlBufferPoolLimit         metricMysqlBufferPoolLimit
+	metricMysqlBufferPoolOperations    metricMysqlBufferPoolOperations
+	metricMysqlBufferPoolPageFlushes   metricMysqlBufferPoolPageFlushes
+	metricMysqlBufferPoolPages         metricMysqlBufferPoolPages
+	metricMysqlBufferPoolUsage         metricMysqlBufferPoolUsage
+	metricMysqlCommands                metricMysqlCommands
+	metricMysqlDoubleWrites            metricMysqlDoubleWrites
+	metricMysqlGtidExecutedGaps        metricMysqlGtidExecutedGaps
+	metricMysqlHandlers                metricMysqlHandlers
+	metricMysqlIndexIoWaitCount        metricMysqlIndexIoWaitCount
+	metricMysqlIndexIoWaitTime         metricMysqlIndexIoWaitTime
+	metricMysqlLockedConnects          metricMysqlLockedConnects
+	metricMysqlLocks                   metricMysqlLocks
+	metricMysqlLogOperations           metricMysqlLogOperations
+	metricMysqlMysqlxWorkerThreads     metricMysqlMysqlxWorkerThreads
+	metricMysqlOpenedResources         metricMysqlOpenedResources
+	metricMysqlOperations              metricMysqlOperations
+	metricMysqlPageOperations          metricMysqlPageOperations
+	metricMysqlRedoLogCapacity         metricMysqlRedoLogCapacity
+	metricMysqlRedoLogOccupancy        metricMysqlRedoLogOccupancy
+	metricMysqlReplicaTimeBehindSource metricMysqlReplicaTimeBehindSource
+	metricMysqlRowLocks                metricMysqlRowLocks
+	metricMysqlRowOperations           metricMysqlRowOperations
+	metricMysqlSorts                   metricMysqlSorts
+	metricMysqlStatementEventCount     metricMysqlStatementEventCount
+	metricMysqlStatementEventWaitTime  metricMysqlStatementEventWaitTime
+	metricMysqlTableIoWaitCount        metricMysqlTableIoWaitCount
+	metricMysqlTableIoWaitTime         metricMysqlTableIoWaitTime
+	metricMysqlThreads                 metricMysqlThreads
+	metricMysqlTmpResources            metricMysqlTmpResources
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -2305,36 +2575,41 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                         pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                     pmetric.NewMetrics(),
-		buildInfo:                         buildInfo,
-		metricMysqlBufferPoolDataPages:    newMetricMysqlBufferPoolDataPages(settings.MysqlBufferPoolDataPages),
-		metricMysqlBufferPoolLimit:        newMetricMysqlBufferPoolLimit(settings.MysqlBufferPoolLimit),
-		metricMysqlBufferPoolOperations:   newMetricMysqlBufferPoolOperations(settings.MysqlBufferPoolOperations),
-		metricMysqlBufferPoolPageFlushes:  newMetricMysqlBufferPoolPageFlushes(settings.MysqlBufferPoolPageFlushes),
-		metricMysqlBufferPoolPages:        newMetricMysqlBufferPoolPages(settings.MysqlBufferPoolPages),
-		metricMysqlBufferPoolUsage:        newMetricMysqlBufferPoolUsage(settings.MysqlBufferPoolUsage),
-		metricMysqlCommands:               newMetricMysqlCommands(settings.MysqlCommands),
-		metricMysqlDoubleWrites:           newMetricMysqlDoubleWrites(settings.MysqlDoubleWrites),
-		metricMysqlHandlers:               newMetricMysqlHandlers(settings.MysqlHandlers),
-		metricMysqlIndexIoWaitCount:       newMetricMysqlIndexIoWaitCount(settings.MysqlIndexIoWaitCount),
-		metricMysqlIndexIoWaitTime:        newMetricMysqlIndexIoWaitTime(settings.MysqlIndexIoWaitTime),
-		metricMysqlLockedConnects:         newMetricMysqlLockedConnects(settings.MysqlLockedConnects),
-		metricMysqlLocks:                  newMetricMysqlLocks(settings.MysqlLocks),
-		metricMysqlLogOperations:          newMetricMysqlLogOperations(settings.MysqlLogOperations),
-		metricMysqlMysqlxWorkerThreads:    newMetricMysqlMysqlxWorkerThreads(settings.MysqlMysqlxWorkerThreads),
-		metricMysqlOpenedResources:        newMetricMysqlOpenedResources(settings.MysqlOpenedResources),
-		metricMysqlOperations:             newMetricMysqlOperations(settings.MysqlOperations),
-		metricMysqlPageOperations:         newMetricMysqlPageOperations(settings.MysqlPageOperations),
-		metricMysqlRowLocks:               newMetricMysqlRowLocks(settings.MysqlRowLocks),
-		metricMysqlRowOperations:          newMetricMysqlRowOperations(settings.MysqlRowOperations),
-		metricMysqlSorts:                  newMetricMysqlSorts(settings.MysqlSorts),
-		metricMysqlStatementEventCount:    newMetricMysqlStatementEventCount(settings.MysqlStatementEventCount),
-		metricMysqlStatementEventWaitTime: newMetricMysqlStatementEventWaitTime(settings.MysqlStatementEventWaitTime),
-		metricMysqlTableIoWaitCount:       newMetricMysqlTableIoWaitCount(settings.MysqlTableIoWaitCount),
-		metricMysqlTableIoWaitTime:        newMetricMysqlTableIoWaitTime(settings.MysqlTableIoWaitTime),
-		metricMysqlThreads:                newMetricMysqlThreads(settings.MysqlThreads),
-		metricMysqlTmpResources:           newMetricMysqlTmpResources(settings.MysqlTmpResources),
+		startTime:                          pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                      pmetric.NewMetrics(),
+		buildInfo:                          buildInfo,
+		metricMysqlBufferPoolDataPages:     newMetricMysqlBufferPoolDataPages(settings.MysqlBufferPoolDataPages),
+		metricMysqlBufferPoolHitRate:       newMetricMysqlBufferPoolHitRate(settings.MysqlBufferPoolHitRate),
+		metricMysqlBufferPoolLimit:         newMetricMysqlBufferPoolLimit(settings.MysqlBufferPoolLimit),
+		metricMysqlBufferPoolOperations:    newMetricMysqlBufferPoolOperations(settings.MysqlBufferPoolOperations),
+		metricMysqlBufferPoolPageFlushes:   newMetricMysqlBufferPoolPageFlushes(settings.MysqlBufferPoolPageFlushes),
+		metricMysqlBufferPoolPages:         newMetricMysqlBufferPoolPages(settings.MysqlBufferPoolPages),
+		metricMysqlBufferPoolUsage:         newMetricMysqlBufferPoolUsage(settings.MysqlBufferPoolUsage),
+		metricMysqlCommands:                newMetricMysqlCommands(settings.MysqlCommands),
+		metricMysqlDoubleWrites:            newMetricMysqlDoubleWrites(settings.MysqlDoubleWrites),
+		metricMysqlGtidExecutedGaps:        newMetricMysqlGtidExecutedGaps(settings.MysqlGtidExecutedGaps),
+		metricMysqlHandlers:                newMetricMysqlHandlers(settings.MysqlHandlers),
+		metricMysqlIndexIoWaitCount:        newMetricMysqlIndexIoWaitCount(settings.MysqlIndexIoWaitCount),
+		metricMysqlIndexIoWaitTime:         newMetricMysqlIndexIoWaitTime(settings.MysqlIndexIoWaitTime),
+		metricMysqlLockedConnects:          newMetricMysqlLockedConnects(settings.MysqlLockedConnects),
+		metricMysqlLocks:                   newMetricMysqlLocks(settings.MysqlLocks),
+		metricMysqlLogOperations:           newMetricMysqlLogOperations(settings.MysqlLogOperations),
+		metricMysqlMysqlxWorkerThreads:     newMetricMysqlMysqlxWorkerThreads(settings.MysqlMysqlxWorkerThreads),
+		metricMysqlOpenedResources:         newMetricMysqlOpenedResources(settings.MysqlOpenedResources),
+		metricMysqlOperations:              newMetricMysqlOperations(settings.MysqlOperations),
+		metricMysqlPageOperations:          newMetricMysqlPageOperations(settings.MysqlPageOperations),
+		metricMysqlRedoLogCapacity:         newMetricMysqlRedoLogCapacity(settings.MysqlRedoLogCapacity),
+		metricMysqlRedoLogOccupancy:        newMetricMysqlRedoLogOccupancy(settings.MysqlRedoLogOccupancy),
+		metricMysqlReplicaTimeBehindSource: newMetricMysqlReplicaTimeBehindSource(settings.MysqlReplicaTimeBehindSource),
+		metricMysqlRowLocks:                newMetricMysqlRowLocks(settings.MysqlRowLocks),
+		metricMysqlRowOperations:           newMetricMysqlRowOperations(settings.MysqlRowOperations),
+		metricMysqlSorts:                   newMetricMysqlSorts(settings.MysqlSorts),
+		metricMysqlStatementEventCount:     newMetricMysqlStatementEventCount(settings.MysqlStatementEventCount),
+		metricMysqlStatementEventWaitTime:  newMetricMysqlStatementEventWaitTime(settings.MysqlStatementEventWaitTime),
+		metricMysqlTableIoWaitCount:        newMetricMysqlTableIoWaitCount(settings.MysqlTableIoWaitCount),
+		metricMysqlTableIoWaitTime:         newMetricMysqlTableIoWaitTime(settings.MysqlTableIoWaitTime),
+		metricMysqlThreads:                 newMetricMysqlThreads(settings.MysqlThreads),
+		metricMysqlTmpResources:            newMetricMysqlTmpResources(settings.MysqlTmpResources),
 	}
 	for _, op := range options {
 		op(mb)
@@ -2395,6 +2670,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricMysqlBufferPoolDataPages.emit(ils.Metrics())
+	mb.metricMysqlBufferPoolHitRate.emit(ils.Metrics())
 	mb.metricMysqlBufferPoolLimit.emit(ils.Metrics())
 	mb.metricMysqlBufferPoolOperations.emit(ils.Metrics())
 	mb.metricMysqlBufferPoolPageFlushes.emit(ils.Metrics())
@@ -2402,6 +2678,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricMysqlBufferPoolUsage.emit(ils.Metrics())
 	mb.metricMysqlCommands.emit(ils.Metrics())
 	mb.metricMysqlDoubleWrites.emit(ils.Metrics())
+	mb.metricMysqlGtidExecutedGaps.emit(ils.Metrics())
 	mb.metricMysqlHandlers.emit(ils.Metrics())
 	mb.metricMysqlIndexIoWaitCount.emit(ils.Metrics())
 	mb.metricMysqlIndexIoWaitTime.emit(ils.Metrics())
@@ -2412,6 +2689,9 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricMysqlOpenedResources.emit(ils.Metrics())
 	mb.metricMysqlOperations.emit(ils.Metrics())
 	mb.metricMysqlPageOperations.emit(ils.Metrics())
+	mb.metricMysqlRedoLogCapacity.emit(ils.Metrics())
+	mb.metricMysqlRedoLogOccupancy.emit(ils.Metrics())
+	mb.metricMysqlReplicaTimeBehindSource.emit(ils.Metrics())
 	mb.metricMysqlRowLocks.emit(ils.Metrics())
 	mb.metricMysqlRowOperations.emit(ils.Metrics())
 	mb.metricMysqlSorts.emit(ils.Metrics())
@@ -2445,6 +2725,11 @@ func (mb *MetricsBuilder) RecordMysqlBufferPoolDataPagesDataPoint(ts pcommon.Tim
 	mb.metricMysqlBufferPoolDataPages.recordDataPoint(mb.startTime, ts, val, bufferPoolDataAttributeValue.String())
 }
 
+// RecordMysqlBufferPoolHitRateDataPoint adds a data point to mysql.buffer_pool.hit_rate metric.
+func (mb *MetricsBuilder) RecordMysqlBufferPoolHitRateDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricMysqlBufferPoolHitRate.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordMysqlBufferPoolLimitDataPoint adds a data point to mysql.buffer_pool.limit metric.
 func (mb *MetricsBuilder) RecordMysqlBufferPoolLimitDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -2510,6 +2795,11 @@ func (mb *MetricsBuilder) RecordMysqlDoubleWritesDataPoint(ts pcommon.Timestamp,
 	return nil
 }
 
+// RecordMysqlGtidExecutedGapsDataPoint adds a data point to mysql.gtid.executed.gaps metric.
+func (mb *MetricsBuilder) RecordMysqlGtidExecutedGapsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMysqlGtidExecutedGaps.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordMysqlHandlersDataPoint adds a data point to mysql.handlers metric.
 func (mb *MetricsBuilder) RecordMysqlHandlersDataPoint(ts pcommon.Timestamp, inputVal string, handlerAttributeValue AttributeHandler) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -2600,6 +2890,26 @@ func (mb *MetricsBuilder) RecordMysqlPageOperationsDataPoint(ts pcommon.Timestam
 	return nil
 }
 
+// RecordMysqlRedoLogCapacityDataPoint adds a data point to mysql.redo_log.capacity metric.
+func (mb *MetricsBuilder) RecordMysqlRedoLogCapacityDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMysqlRedoLogCapacity.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordMysqlRedoLogOccupancyDataPoint adds a data point to mysql.redo_log.occupancy metric.
+func (mb *MetricsBuilder) RecordMysqlRedoLogOccupancyDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for MysqlRedoLogOccupancy, value was %s: %w", inputVal, err)
+	}
+	mb.metricMysqlRedoLogOccupancy.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
+// RecordMysqlReplicaTimeBehindSourceDataPoint adds a data point to mysql.replica.time_behind_source metric.
+func (mb *MetricsBuilder) RecordMysqlReplicaTimeBehindSourceDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMysqlReplicaTimeBehindSource.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordMysqlRowLocksDataPoint adds a data point to mysql.row_locks metric.
 func (mb *MetricsBuilder) RecordMysqlRowLocksDataPoint(ts pcommon.Timestamp, inputVal string, rowLocksAttributeValue AttributeRowLocks) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
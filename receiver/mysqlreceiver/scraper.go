@@ -16,8 +16,11 @@ package mysqlreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -53,9 +56,12 @@ func newMySQLScraper(
 
 // start starts the scraper by initializing the db client connection.
 func (m *mySQLScraper) start(_ context.Context, host component.Host) error {
-	sqlclient := newMySQLClient(m.config)
+	sqlclient, err := newMySQLClient(m.config)
+	if err != nil {
+		return err
+	}
 
-	err := sqlclient.Connect()
+	err = sqlclient.Connect()
 	if err != nil {
 		return err
 	}
@@ -104,6 +110,12 @@ func (m *mySQLScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	// collect global status metrics.
 	m.scrapeGlobalStats(now, errs)
 
+	// collect replication metrics.
+	m.scrapeReplicaStatusStats(now, errs)
+
+	// collect metrics derived from global variables.
+	m.scrapeGlobalVariables(now, errs)
+
 	m.mb.EmitForResource(metadata.WithMysqlInstanceEndpoint(m.config.Endpoint))
 
 	return m.mb.Emit(), errs.Combine()
@@ -119,6 +131,7 @@ func (m *mySQLScraper) scrapeGlobalStats(now pcommon.Timestamp, errs *scrapererr
 
 	m.recordDataPages(now, globalStats, errs)
 	m.recordDataUsage(now, globalStats, errs)
+	m.recordBufferPoolHitRate(now, globalStats, errs)
 
 	for k, v := range globalStats {
 		switch k {
@@ -309,6 +322,10 @@ func (m *mySQLScraper) scrapeGlobalStats(now pcommon.Timestamp, errs *scrapererr
 		case "Opened_table_definitions":
 			addPartialIfError(errs, m.mb.RecordMysqlOpenedResourcesDataPoint(now, v, metadata.AttributeOpenedResourcesTableDefinition))
 
+		// redo_log
+		case "Innodb_redo_log_logical_size":
+			addPartialIfError(errs, m.mb.RecordMysqlRedoLogOccupancyDataPoint(now, v))
+
 		// mysqlx_worker_threads
 		case "Mysqlx_worker_threads":
 			addPartialIfError(errs, m.mb.RecordMysqlMysqlxWorkerThreadsDataPoint(now, v, metadata.AttributeMysqlxThreadsAvailable))
@@ -407,6 +424,85 @@ func (m *mySQLScraper) scrapeStatementEventsStats(now pcommon.Timestamp, errs *s
 	}
 }
 
+func (m *mySQLScraper) scrapeReplicaStatusStats(now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !m.config.Metrics.MysqlReplicaTimeBehindSource.Enabled {
+		return
+	}
+
+	replicaStatusStats, err := m.sqlclient.getReplicaStatusStats()
+	if err != nil {
+		m.logger.Error("Failed to fetch replica status stats", zap.Error(err))
+		errs.AddPartial(1, err)
+		return
+	}
+
+	// a replica with multiple replication channels reports the channel that is furthest behind
+	var behind sql.NullInt64
+	for _, s := range replicaStatusStats {
+		if !s.secondsBehindSource.Valid {
+			m.logger.Debug("Replication SQL thread is not running", zap.String("channel", s.channel))
+			continue
+		}
+		if !behind.Valid || s.secondsBehindSource.Int64 > behind.Int64 {
+			behind = s.secondsBehindSource
+		}
+	}
+	if behind.Valid {
+		m.mb.RecordMysqlReplicaTimeBehindSourceDataPoint(now, behind.Int64)
+	}
+}
+
+func (m *mySQLScraper) scrapeGlobalVariables(now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !m.config.Metrics.MysqlGtidExecutedGaps.Enabled && !m.config.Metrics.MysqlRedoLogCapacity.Enabled {
+		return
+	}
+
+	globalVariables, err := m.sqlclient.getGlobalVariables()
+	if err != nil {
+		m.logger.Error("Failed to fetch global variables", zap.Error(err))
+		errs.AddPartial(2, err)
+		return
+	}
+
+	if m.config.Metrics.MysqlGtidExecutedGaps.Enabled {
+		gaps, err := countGtidGaps(globalVariables["gtid_executed"])
+		if err != nil {
+			errs.AddPartial(1, err)
+		} else {
+			m.mb.RecordMysqlGtidExecutedGapsDataPoint(now, gaps)
+		}
+	}
+
+	if m.config.Metrics.MysqlRedoLogCapacity.Enabled {
+		m.recordRedoLogCapacity(now, globalVariables, errs)
+	}
+}
+
+func (m *mySQLScraper) recordRedoLogCapacity(now pcommon.Timestamp, globalVariables map[string]string, errors *scrapererror.ScrapeErrors) {
+	// innodb_redo_log_capacity replaces innodb_log_file_size and innodb_log_files_in_group in 8.0.30+
+	if capacity, ok := globalVariables["innodb_redo_log_capacity"]; ok {
+		val, err := parseInt(capacity)
+		if err != nil {
+			errors.AddPartial(1, err)
+			return
+		}
+		m.mb.RecordMysqlRedoLogCapacityDataPoint(now, val)
+		return
+	}
+
+	fileSize, err := parseInt(globalVariables["innodb_log_file_size"])
+	if err != nil {
+		errors.AddPartial(1, err)
+		return
+	}
+	files, err := parseInt(globalVariables["innodb_log_files_in_group"])
+	if err != nil {
+		errors.AddPartial(1, err)
+		return
+	}
+	m.mb.RecordMysqlRedoLogCapacityDataPoint(now, fileSize*files)
+}
+
 func addPartialIfError(errors *scrapererror.ScrapeErrors, err error) {
 	if err != nil {
 		errors.AddPartial(1, err)
@@ -445,6 +541,61 @@ func (m *mySQLScraper) recordDataUsage(now pcommon.Timestamp, globalStats map[st
 	m.mb.RecordMysqlBufferPoolUsageDataPoint(now, data-dirty, metadata.AttributeBufferPoolDataClean)
 }
 
+func (m *mySQLScraper) recordBufferPoolHitRate(now pcommon.Timestamp, globalStats map[string]string, errors *scrapererror.ScrapeErrors) {
+	if !m.config.Metrics.MysqlBufferPoolHitRate.Enabled {
+		return
+	}
+
+	requests, err := parseInt(globalStats["Innodb_buffer_pool_read_requests"])
+	if err != nil {
+		errors.AddPartial(1, err)
+		return
+	}
+	reads, err := parseInt(globalStats["Innodb_buffer_pool_reads"])
+	if err != nil {
+		errors.AddPartial(1, err)
+		return
+	}
+	if requests == 0 {
+		// the hit rate is undefined until the buffer pool has been read from
+		return
+	}
+	m.mb.RecordMysqlBufferPoolHitRateDataPoint(now, float64(requests-reads)/float64(requests))
+}
+
+// countGtidGaps returns the number of gaps between the transaction ranges of a GTID set, e.g.
+// "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5:11-18" contains a single gap.
+func countGtidGaps(gtidSet string) (int64, error) {
+	var gaps int64
+	for _, uuidSet := range strings.Split(gtidSet, ",") {
+		uuidSet = strings.TrimSpace(uuidSet)
+		if uuidSet == "" {
+			continue
+		}
+		parts := strings.Split(uuidSet, ":")
+		if len(parts) < 2 {
+			return 0, fmt.Errorf("invalid GTID set %q", uuidSet)
+		}
+
+		// ranges are grouped by tag since 8.3, a non numeric part starts a new group
+		var ranges int64
+		for _, part := range parts[1:] {
+			if part == "" || part[0] < '0' || part[0] > '9' {
+				if ranges > 1 {
+					gaps += ranges - 1
+				}
+				ranges = 0
+				continue
+			}
+			ranges++
+		}
+		if ranges > 1 {
+			gaps += ranges - 1
+		}
+	}
+	return gaps, nil
+}
+
 // parseInt converts string to int64.
 func parseInt(value string) (int64, error) {
 	return strconv.ParseInt(value, 10, 64)
@@ -17,6 +17,7 @@ package mysqlreceiver
 import (
 	"bufio"
 	"context"
+	"database/sql"
 	"errors"
 	"os"
 	"path/filepath"
@@ -27,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest"
@@ -91,6 +93,83 @@ func TestScrape(t *testing.T) {
 		require.Equal(t, partialError.Failed, 5, "Expected partial error count to be 5")
 	})
 
+	t.Run("scrape replication and redo log metrics", func(t *testing.T) {
+		cfg := createDefaultConfig().(*Config)
+		cfg.Username = "otel"
+		cfg.Password = "otel"
+		cfg.NetAddr = confignet.NetAddr{Endpoint: "localhost:3306"}
+		cfg.Metrics.MysqlBufferPoolHitRate.Enabled = true
+		cfg.Metrics.MysqlReplicaTimeBehindSource.Enabled = true
+		cfg.Metrics.MysqlGtidExecutedGaps.Enabled = true
+		cfg.Metrics.MysqlRedoLogOccupancy.Enabled = true
+		cfg.Metrics.MysqlRedoLogCapacity.Enabled = true
+
+		scraper := newMySQLScraper(componenttest.NewNopReceiverCreateSettings(), cfg)
+		scraper.sqlclient = &mockClient{
+			globalStatsFile:     "global_stats_replica",
+			innodbStatsFile:     "innodb_stats",
+			tableIoWaitsFile:    "table_io_waits_stats",
+			indexIoWaitsFile:    "index_io_waits_stats",
+			statementEventsFile: "statement_events",
+			replicaStatusFile:   "replica_status",
+			globalVariablesFile: "global_variables",
+		}
+
+		actualMetrics, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+
+		metrics := map[string]pmetric.Metric{}
+		ms := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		for i := 0; i < ms.Len(); i++ {
+			metrics[ms.At(i).Name()] = ms.At(i)
+		}
+
+		require.InDelta(t, 0.95, metrics["mysql.buffer_pool.hit_rate"].Gauge().DataPoints().At(0).DoubleValue(), 0.0001)
+		require.EqualValues(t, 30, metrics["mysql.replica.time_behind_source"].Gauge().DataPoints().At(0).IntValue())
+		require.EqualValues(t, 2, metrics["mysql.gtid.executed.gaps"].Gauge().DataPoints().At(0).IntValue())
+		require.EqualValues(t, 1048576, metrics["mysql.redo_log.occupancy"].Gauge().DataPoints().At(0).IntValue())
+		require.EqualValues(t, 104857600, metrics["mysql.redo_log.capacity"].Gauge().DataPoints().At(0).IntValue())
+	})
+
+}
+
+func TestCountGtidGaps(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		gtidSet  string
+		expected int64
+	}{
+		{
+			desc:     "empty set",
+			gtidSet:  "",
+			expected: 0,
+		},
+		{
+			desc:     "contiguous set",
+			gtidSet:  "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-27",
+			expected: 0,
+		},
+		{
+			desc:     "multiple sources",
+			gtidSet:  "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5:11-18,\n2174b383-5441-11e8-b90a-c80aa9429562:1-3:5",
+			expected: 2,
+		},
+		{
+			desc:     "tagged transactions",
+			gtidSet:  "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5:9:tag:1-3:6-8",
+			expected: 2,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			gaps, err := countGtidGaps(tc.gtidSet)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, gaps)
+		})
+	}
+
+	_, err := countGtidGaps("3e11fa47-71ca-11e1-9e33-c80aa9429562")
+	require.Error(t, err)
 }
 
 var _ client = (*mockClient)(nil)
@@ -101,6 +180,8 @@ type mockClient struct {
 	tableIoWaitsFile    string
 	indexIoWaitsFile    string
 	statementEventsFile string
+	replicaStatusFile   string
+	globalVariablesFile string
 }
 
 func readFile(fname string) (map[string]string, error) {
@@ -223,6 +304,33 @@ func (c *mockClient) getStatementEventsStats() ([]StatementEventStats, error) {
 	return stats, nil
 }
 
+func (c *mockClient) getReplicaStatusStats() ([]ReplicaStatusStats, error) {
+	var stats []ReplicaStatusStats
+	file, err := os.Open(filepath.Join("testdata", "scraper", c.replicaStatusFile+".txt"))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var s ReplicaStatusStats
+		text := strings.Split(scanner.Text(), "\t")
+
+		s.channel = text[0]
+		if behind, err := parseInt(text[1]); err == nil {
+			s.secondsBehindSource = sql.NullInt64{Int64: behind, Valid: true}
+		}
+
+		stats = append(stats, s)
+	}
+	return stats, nil
+}
+
+func (c *mockClient) getGlobalVariables() (map[string]string, error) {
+	return readFile(c.globalVariablesFile)
+}
+
 func (c *mockClient) Close() error {
 	return nil
 }
@@ -15,14 +15,45 @@
 package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
 )
 
+const (
+	// moduleStubStatus reads the plain text page of ngx_http_stub_status_module.
+	moduleStubStatus = "stub_status"
+	// modulePlus reads the NGINX Plus REST API.
+	modulePlus = "plus"
+	// moduleVTS reads the JSON page of the nginx-module-vts community module.
+	moduleVTS = "vts"
+
+	defaultPlusAPIVersion = 8
+)
+
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	confighttp.HTTPClientSettings           `mapstructure:",squash"`
 	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
+
+	// Module is the nginx module serving the status endpoint, one of "stub_status", "plus" or "vts".
+	Module string `mapstructure:"module"`
+	// PlusAPIVersion is the version of the NGINX Plus REST API to query when Module is "plus".
+	PlusAPIVersion int `mapstructure:"plus_api_version"`
+}
+
+// Validate checks the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	switch cfg.Module {
+	case "", moduleStubStatus, modulePlus, moduleVTS:
+	default:
+		return fmt.Errorf("invalid module %q, must be one of %q, %q or %q", cfg.Module, moduleStubStatus, modulePlus, moduleVTS)
+	}
+	if cfg.Module == modulePlus && cfg.PlusAPIVersion < 1 {
+		return fmt.Errorf("invalid plus_api_version %d, must be positive", cfg.PlusAPIVersion)
+	}
+	return nil
 }
@@ -36,3 +36,31 @@ func TestLoadConfig(t *testing.T) {
 
 	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
 }
+
+func TestLoadConfigPlus(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(config.NewComponentIDWithName(typeStr, "plus").String())
+	require.NoError(t, err)
+	require.NoError(t, config.UnmarshalReceiver(sub, cfg))
+
+	expected := factory.CreateDefaultConfig().(*Config)
+	expected.Endpoint = "http://localhost:8080/api"
+	expected.Module = modulePlus
+	expected.PlusAPIVersion = 7
+	assert.Equal(t, expected, cfg)
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Module = "status"
+	assert.EqualError(t, cfg.Validate(), `invalid module "status", must be one of "stub_status", "plus" or "vts"`)
+
+	cfg.Module = modulePlus
+	cfg.PlusAPIVersion = 0
+	assert.EqualError(t, cfg.Validate(), "invalid plus_api_version 0, must be positive")
+}
@@ -50,7 +50,9 @@ func createDefaultConfig() config.Receiver {
 			Endpoint: "http://localhost:80/status",
 			Timeout:  10 * time.Second,
 		},
-		Metrics: metadata.DefaultMetricsSettings(),
+		Metrics:        metadata.DefaultMetricsSettings(),
+		Module:         moduleStubStatus,
+		PlusAPIVersion: defaultPlusAPIVersion,
 	}
 }
 
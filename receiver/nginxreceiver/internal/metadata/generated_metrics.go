@@ -17,10 +17,18 @@ type MetricSettings struct {
 
 // MetricsSettings provides settings for nginxreceiver metrics.
 type MetricsSettings struct {
-	NginxConnectionsAccepted MetricSettings `mapstructure:"nginx.connections_accepted"`
-	NginxConnectionsCurrent  MetricSettings `mapstructure:"nginx.connections_current"`
-	NginxConnectionsHandled  MetricSettings `mapstructure:"nginx.connections_handled"`
-	NginxRequests            MetricSettings `mapstructure:"nginx.requests"`
+	NginxConnectionsAccepted      MetricSettings `mapstructure:"nginx.connections_accepted"`
+	NginxConnectionsCurrent       MetricSettings `mapstructure:"nginx.connections_current"`
+	NginxConnectionsHandled       MetricSettings `mapstructure:"nginx.connections_handled"`
+	NginxRequests                 MetricSettings `mapstructure:"nginx.requests"`
+	NginxServerZoneIo             MetricSettings `mapstructure:"nginx.server_zone.io"`
+	NginxServerZoneRequestTime    MetricSettings `mapstructure:"nginx.server_zone.request_time"`
+	NginxServerZoneRequests       MetricSettings `mapstructure:"nginx.server_zone.requests"`
+	NginxServerZoneResponses      MetricSettings `mapstructure:"nginx.server_zone.responses"`
+	NginxUpstreamPeerIo           MetricSettings `mapstructure:"nginx.upstream.peer.io"`
+	NginxUpstreamPeerRequests     MetricSettings `mapstructure:"nginx.upstream.peer.requests"`
+	NginxUpstreamPeerResponseTime MetricSettings `mapstructure:"nginx.upstream.peer.response_time"`
+	NginxUpstreamPeerResponses    MetricSettings `mapstructure:"nginx.upstream.peer.responses"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -37,9 +45,59 @@ func DefaultMetricsSettings() MetricsSettings {
 		NginxRequests: MetricSettings{
 			Enabled: true,
 		},
+		NginxServerZoneIo: MetricSettings{
+			Enabled: true,
+		},
+		NginxServerZoneRequestTime: MetricSettings{
+			Enabled: true,
+		},
+		NginxServerZoneRequests: MetricSettings{
+			Enabled: true,
+		},
+		NginxServerZoneResponses: MetricSettings{
+			Enabled: true,
+		},
+		NginxUpstreamPeerIo: MetricSettings{
+			Enabled: true,
+		},
+		NginxUpstreamPeerRequests: MetricSettings{
+			Enabled: true,
+		},
+		NginxUpstreamPeerResponseTime: MetricSettings{
+			Enabled: true,
+		},
+		NginxUpstreamPeerResponses: MetricSettings{
+			Enabled: true,
+		},
 	}
 }
 
+// AttributeDirection specifies the a value direction attribute.
+type AttributeDirection int
+
+const (
+	_ AttributeDirection = iota
+	AttributeDirectionReceived
+	AttributeDirectionSent
+)
+
+// String returns the string representation of the AttributeDirection.
+func (av AttributeDirection) String() string {
+	switch av {
+	case AttributeDirectionReceived:
+		return "received"
+	case AttributeDirectionSent:
+		return "sent"
+	}
+	return ""
+}
+
+// MapAttributeDirection is a helper map of string to AttributeDirection attribute value.
+var MapAttributeDirection = map[string]AttributeDirection{
+	"received": AttributeDirectionReceived,
+	"sent":     AttributeDirectionSent,
+}
+
 // AttributeState specifies the a value state attribute.
 type AttributeState int
 
@@ -74,6 +132,44 @@ var MapAttributeState = map[string]AttributeState{
 	"waiting": AttributeStateWaiting,
 }
 
+// AttributeStatusCodeClass specifies the a value status_code_class attribute.
+type AttributeStatusCodeClass int
+
+const (
+	_ AttributeStatusCodeClass = iota
+	AttributeStatusCodeClass1xx
+	AttributeStatusCodeClass2xx
+	AttributeStatusCodeClass3xx
+	AttributeStatusCodeClass4xx
+	AttributeStatusCodeClass5xx
+)
+
+// String returns the string representation of the AttributeStatusCodeClass.
+func (av AttributeStatusCodeClass) String() string {
+	switch av {
+	case AttributeStatusCodeClass1xx:
+		return "1xx"
+	case AttributeStatusCodeClass2xx:
+		return "2xx"
+	case AttributeStatusCodeClass3xx:
+		return "3xx"
+	case AttributeStatusCodeClass4xx:
+		return "4xx"
+	case AttributeStatusCodeClass5xx:
+		return "5xx"
+	}
+	return ""
+}
+
+// MapAttributeStatusCodeClass is a helper map of string to AttributeStatusCodeClass attribute value.
+var MapAttributeStatusCodeClass = map[string]AttributeStatusCodeClass{
+	"1xx": AttributeStatusCodeClass1xx,
+	"2xx": AttributeStatusCodeClass2xx,
+	"3xx": AttributeStatusCodeClass3xx,
+	"4xx": AttributeStatusCodeClass4xx,
+	"5xx": AttributeStatusCodeClass5xx,
+}
+
 type metricNginxConnectionsAccepted struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -278,18 +374,454 @@ func newMetricNginxRequests(settings MetricSettings) metricNginxRequests {
 	return m
 }
 
+type metricNginxServerZoneIo struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.server_zone.io metric with initial data.
+func (m *metricNginxServerZoneIo) init() {
+	m.data.SetName("nginx.server_zone.io")
+	m.data.SetDescription("The number of bytes received from and sent to clients by the server zone. Only reported for the `plus` and `vts` modules.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxServerZoneIo) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, serverZoneAttributeValue string, directionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("server_zone", serverZoneAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxServerZoneIo) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxServerZoneIo) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxServerZoneIo(settings MetricSettings) metricNginxServerZoneIo {
+	m := metricNginxServerZoneIo{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxServerZoneRequestTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.server_zone.request_time metric with initial data.
+func (m *metricNginxServerZoneRequestTime) init() {
+	m.data.SetName("nginx.server_zone.request_time")
+	m.data.SetDescription("The average time spent processing requests of the server zone. Only reported for the `vts` module.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxServerZoneRequestTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, serverZoneAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("server_zone", serverZoneAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxServerZoneRequestTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxServerZoneRequestTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxServerZoneRequestTime(settings MetricSettings) metricNginxServerZoneRequestTime {
+	m := metricNginxServerZoneRequestTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxServerZoneRequests struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.server_zone.requests metric with initial data.
+func (m *metricNginxServerZoneRequests) init() {
+	m.data.SetName("nginx.server_zone.requests")
+	m.data.SetDescription("The total number of client requests received by the server zone. Only reported for the `plus` and `vts` modules.")
+	m.data.SetUnit("{requests}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxServerZoneRequests) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, serverZoneAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("server_zone", serverZoneAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxServerZoneRequests) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxServerZoneRequests) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxServerZoneRequests(settings MetricSettings) metricNginxServerZoneRequests {
+	m := metricNginxServerZoneRequests{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxServerZoneResponses struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.server_zone.responses metric with initial data.
+func (m *metricNginxServerZoneResponses) init() {
+	m.data.SetName("nginx.server_zone.responses")
+	m.data.SetDescription("The total number of responses sent to clients by the server zone. Only reported for the `plus` and `vts` modules.")
+	m.data.SetUnit("{responses}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxServerZoneResponses) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, serverZoneAttributeValue string, statusCodeClassAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("server_zone", serverZoneAttributeValue)
+	dp.Attributes().PutStr("status_code_class", statusCodeClassAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxServerZoneResponses) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxServerZoneResponses) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxServerZoneResponses(settings MetricSettings) metricNginxServerZoneResponses {
+	m := metricNginxServerZoneResponses{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxUpstreamPeerIo struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.upstream.peer.io metric with initial data.
+func (m *metricNginxUpstreamPeerIo) init() {
+	m.data.SetName("nginx.upstream.peer.io")
+	m.data.SetDescription("The number of bytes sent to and received from the upstream peer. Only reported for the `plus` and `vts` modules.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxUpstreamPeerIo) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamAttributeValue string, upstreamPeerAttributeValue string, directionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("upstream", upstreamAttributeValue)
+	dp.Attributes().PutStr("peer", upstreamPeerAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerIo) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerIo) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxUpstreamPeerIo(settings MetricSettings) metricNginxUpstreamPeerIo {
+	m := metricNginxUpstreamPeerIo{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxUpstreamPeerRequests struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.upstream.peer.requests metric with initial data.
+func (m *metricNginxUpstreamPeerRequests) init() {
+	m.data.SetName("nginx.upstream.peer.requests")
+	m.data.SetDescription("The total number of client requests forwarded to the upstream peer. Only reported for the `plus` and `vts` modules.")
+	m.data.SetUnit("{requests}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxUpstreamPeerRequests) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamAttributeValue string, upstreamPeerAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("upstream", upstreamAttributeValue)
+	dp.Attributes().PutStr("peer", upstreamPeerAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerRequests) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerRequests) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxUpstreamPeerRequests(settings MetricSettings) metricNginxUpstreamPeerRequests {
+	m := metricNginxUpstreamPeerRequests{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxUpstreamPeerResponseTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.upstream.peer.response_time metric with initial data.
+func (m *metricNginxUpstreamPeerResponseTime) init() {
+	m.data.SetName("nginx.upstream.peer.response_time")
+	m.data.SetDescription("The average time to get the full response from the upstream peer. Only reported for the `plus` and `vts` modules.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxUpstreamPeerResponseTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, upstreamAttributeValue string, upstreamPeerAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("upstream", upstreamAttributeValue)
+	dp.Attributes().PutStr("peer", upstreamPeerAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerResponseTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerResponseTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxUpstreamPeerResponseTime(settings MetricSettings) metricNginxUpstreamPeerResponseTime {
+	m := metricNginxUpstreamPeerResponseTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxUpstreamPeerResponses struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.upstream.peer.responses metric with initial data.
+func (m *metricNginxUpstreamPeerResponses) init() {
+	m.data.SetName("nginx.upstream.peer.responses")
+	m.data.SetDescription("The total number of responses obtained from the upstream peer. Only reported for the `plus` and `vts` modules.")
+	m.data.SetUnit("{responses}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxUpstreamPeerResponses) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamAttributeValue string, upstreamPeerAttributeValue string, statusCodeClassAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("upstream", upstreamAttributeValue)
+	dp.Attributes().PutStr("peer", upstreamPeerAttributeValue)
+	dp.Attributes().PutStr("status_code_class", statusCodeClassAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerResponses) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerResponses) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxUpstreamPeerResponses(settings MetricSettings) metricNginxUpstreamPeerResponses {
+	m := metricNginxUpstreamPeerResponses{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                      pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity                int                 // maximum observed number of metrics per resource.
-	resourceCapacity               int                 // maximum observed number of resource attributes.
-	metricsBuffer                  pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo                      component.BuildInfo // contains version information
-	metricNginxConnectionsAccepted metricNginxConnectionsAccepted
-	metricNginxConnectionsCurrent  metricNginxConnectionsCurrent
-	metricNginxConnectionsHandled  metricNginxConnectionsHandled
-	metricNginxRequests            metricNginxRequests
+	startTime                           pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                     int                 // maximum observed number of metrics per resource.
+	resourceCapacity                    int                 // maximum observed number of resource attributes.
+	metricsBuffer                       pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                           component.BuildInfo // contains version information
+	metricNginxConnectionsAccepted      metricNginxConnectionsAccepted
+	metricNginxConnectionsCurrent       metricNginxConnectionsCurrent
+	metricNginxConnectionsHandled       metricNginxConnectionsHandled
+	metricNginxRequests                 metricNginxRequests
+	metricNginxServerZoneIo             metricNginxServerZoneIo
+	metricNginxServerZoneRequestTime    metricNginxServerZoneRequestTime
+	metricNginxServerZoneRequests       metricNginxServerZoneRequests
+	metricNginxServerZoneResponses      metricNginxServerZoneResponses
+	metricNginxUpstreamPeerIo           metricNginxUpstreamPeerIo
+	metricNginxUpstreamPeerRequests     metricNginxUpstreamPeerRequests
+	metricNginxUpstreamPeerResponseTime metricNginxUpstreamPeerResponseTime
+	metricNginxUpstreamPeerResponses    metricNginxUpstreamPeerResponses
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -304,13 +836,21 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                      pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                  pmetric.NewMetrics(),
-		buildInfo:                      buildInfo,
-		metricNginxConnectionsAccepted: newMetricNginxConnectionsAccepted(settings.NginxConnectionsAccepted),
-		metricNginxConnectionsCurrent:  newMetricNginxConnectionsCurrent(settings.NginxConnectionsCurrent),
-		metricNginxConnectionsHandled:  newMetricNginxConnectionsHandled(settings.NginxConnectionsHandled),
-		metricNginxRequests:            newMetricNginxRequests(settings.NginxRequests),
+		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                       pmetric.NewMetrics(),
+		buildInfo:                           buildInfo,
+		metricNginxConnectionsAccepted:      newMetricNginxConnectionsAccepted(settings.NginxConnectionsAccepted),
+		metricNginxConnectionsCurrent:       newMetricNginxConnectionsCurrent(settings.NginxConnectionsCurrent),
+		metricNginxConnectionsHandled:       newMetricNginxConnectionsHandled(settings.NginxConnectionsHandled),
+		metricNginxRequests:                 newMetricNginxRequests(settings.NginxRequests),
+		metricNginxServerZoneIo:             newMetricNginxServerZoneIo(settings.NginxServerZoneIo),
+		metricNginxServerZoneRequestTime:    newMetricNginxServerZoneRequestTime(settings.NginxServerZoneRequestTime),
+		metricNginxServerZoneRequests:       newMetricNginxServerZoneRequests(settings.NginxServerZoneRequests),
+		metricNginxServerZoneResponses:      newMetricNginxServerZoneResponses(settings.NginxServerZoneResponses),
+		metricNginxUpstreamPeerIo:           newMetricNginxUpstreamPeerIo(settings.NginxUpstreamPeerIo),
+		metricNginxUpstreamPeerRequests:     newMetricNginxUpstreamPeerRequests(settings.NginxUpstreamPeerRequests),
+		metricNginxUpstreamPeerResponseTime: newMetricNginxUpstreamPeerResponseTime(settings.NginxUpstreamPeerResponseTime),
+		metricNginxUpstreamPeerResponses:    newMetricNginxUpstreamPeerResponses(settings.NginxUpstreamPeerResponses),
 	}
 	for _, op := range options {
 		op(mb)
@@ -367,6 +907,14 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricNginxConnectionsCurrent.emit(ils.Metrics())
 	mb.metricNginxConnectionsHandled.emit(ils.Metrics())
 	mb.metricNginxRequests.emit(ils.Metrics())
+	mb.metricNginxServerZoneIo.emit(ils.Metrics())
+	mb.metricNginxServerZoneRequestTime.emit(ils.Metrics())
+	mb.metricNginxServerZoneRequests.emit(ils.Metrics())
+	mb.metricNginxServerZoneResponses.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerIo.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerRequests.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerResponseTime.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerResponses.emit(ils.Metrics())
 	for _, op := range rmo {
 		op(rm)
 	}
@@ -406,6 +954,46 @@ func (mb *MetricsBuilder) RecordNginxRequestsDataPoint(ts pcommon.Timestamp, val
 	mb.metricNginxRequests.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordNginxServerZoneIoDataPoint adds a data point to nginx.server_zone.io metric.
+func (mb *MetricsBuilder) RecordNginxServerZoneIoDataPoint(ts pcommon.Timestamp, val int64, serverZoneAttributeValue string, directionAttributeValue AttributeDirection) {
+	mb.metricNginxServerZoneIo.recordDataPoint(mb.startTime, ts, val, serverZoneAttributeValue, directionAttributeValue.String())
+}
+
+// RecordNginxServerZoneRequestTimeDataPoint adds a data point to nginx.server_zone.request_time metric.
+func (mb *MetricsBuilder) RecordNginxServerZoneRequestTimeDataPoint(ts pcommon.Timestamp, val float64, serverZoneAttributeValue string) {
+	mb.metricNginxServerZoneRequestTime.recordDataPoint(mb.startTime, ts, val, serverZoneAttributeValue)
+}
+
+// RecordNginxServerZoneRequestsDataPoint adds a data point to nginx.server_zone.requests metric.
+func (mb *MetricsBuilder) RecordNginxServerZoneRequestsDataPoint(ts pcommon.Timestamp, val int64, serverZoneAttributeValue string) {
+	mb.metricNginxServerZoneRequests.recordDataPoint(mb.startTime, ts, val, serverZoneAttributeValue)
+}
+
+// RecordNginxServerZoneResponsesDataPoint adds a data point to nginx.server_zone.responses metric.
+func (mb *MetricsBuilder) RecordNginxServerZoneResponsesDataPoint(ts pcommon.Timestamp, val int64, serverZoneAttributeValue string, statusCodeClassAttributeValue AttributeStatusCodeClass) {
+	mb.metricNginxServerZoneResponses.recordDataPoint(mb.startTime, ts, val, serverZoneAttributeValue, statusCodeClassAttributeValue.String())
+}
+
+// RecordNginxUpstreamPeerIoDataPoint adds a data point to nginx.upstream.peer.io metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerIoDataPoint(ts pcommon.Timestamp, val int64, upstreamAttributeValue string, upstreamPeerAttributeValue string, directionAttributeValue AttributeDirection) {
+	mb.metricNginxUpstreamPeerIo.recordDataPoint(mb.startTime, ts, val, upstreamAttributeValue, upstreamPeerAttributeValue, directionAttributeValue.String())
+}
+
+// RecordNginxUpstreamPeerRequestsDataPoint adds a data point to nginx.upstream.peer.requests metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerRequestsDataPoint(ts pcommon.Timestamp, val int64, upstreamAttributeValue string, upstreamPeerAttributeValue string) {
+	mb.metricNginxUpstreamPeerRequests.recordDataPoint(mb.startTime, ts, val, upstreamAttributeValue, upstreamPeerAttributeValue)
+}
+
+// RecordNginxUpstreamPeerResponseTimeDataPoint adds a data point to nginx.upstream.peer.response_time metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerResponseTimeDataPoint(ts pcommon.Timestamp, val float64, upstreamAttributeValue string, upstreamPeerAttributeValue string) {
+	mb.metricNginxUpstreamPeerResponseTime.recordDataPoint(mb.startTime, ts, val, upstreamAttributeValue, upstreamPeerAttributeValue)
+}
+
+// RecordNginxUpstreamPeerResponsesDataPoint adds a data point to nginx.upstream.peer.responses metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerResponsesDataPoint(ts pcommon.Timestamp, val int64, upstreamAttributeValue string, upstreamPeerAttributeValue string, statusCodeClassAttributeValue AttributeStatusCodeClass) {
+	mb.metricNginxUpstreamPeerResponses.recordDataPoint(mb.startTime, ts, val, upstreamAttributeValue, upstreamPeerAttributeValue, statusCodeClassAttributeValue.String())
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
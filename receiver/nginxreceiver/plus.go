@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+)
+
+// plusConnections is the response of the NGINX Plus /connections endpoint.
+type plusConnections struct {
+	Accepted int64 `json:"accepted"`
+	Dropped  int64 `json:"dropped"`
+	Active   int64 `json:"active"`
+	Idle     int64 `json:"idle"`
+}
+
+// plusRequests is the response of the NGINX Plus /http/requests endpoint.
+type plusRequests struct {
+	Total   int64 `json:"total"`
+	Current int64 `json:"current"`
+}
+
+// plusServerZone is a single entry of the NGINX Plus /http/server_zones endpoint.
+type plusServerZone struct {
+	Requests  int64            `json:"requests"`
+	Responses map[string]int64 `json:"responses"`
+	Received  int64            `json:"received"`
+	Sent      int64            `json:"sent"`
+}
+
+// plusUpstream is a single entry of the NGINX Plus /http/upstreams endpoint.
+type plusUpstream struct {
+	Peers []plusPeer `json:"peers"`
+}
+
+type plusPeer struct {
+	Server    string           `json:"server"`
+	Requests  int64            `json:"requests"`
+	Responses map[string]int64 `json:"responses"`
+	Received  int64            `json:"received"`
+	Sent      int64            `json:"sent"`
+	// ResponseTime is absent until the peer has served a response.
+	ResponseTime *int64 `json:"response_time"`
+}
+
+// plusEndpoints is the number of NGINX Plus API endpoints queried on each scrape.
+const plusEndpoints = 4
+
+func (r *nginxScraper) plusURL(path string) string {
+	return fmt.Sprintf("%s/%d/%s", strings.TrimSuffix(r.cfg.Endpoint, "/"), r.cfg.PlusAPIVersion, path)
+}
+
+func (r *nginxScraper) scrapePlus(ctx context.Context) (pmetric.Metrics, error) {
+	var (
+		conns       plusConnections
+		requests    plusRequests
+		serverZones map[string]plusServerZone
+		upstreams   map[string]plusUpstream
+	)
+	connsErr := r.getJSON(ctx, r.plusURL("connections"), &conns)
+	requestsErr := r.getJSON(ctx, r.plusURL("http/requests"), &requests)
+	errs := multierr.Combine(
+		connsErr,
+		requestsErr,
+		r.getJSON(ctx, r.plusURL("http/server_zones"), &serverZones),
+		r.getJSON(ctx, r.plusURL("http/upstreams"), &upstreams),
+	)
+	if len(multierr.Errors(errs)) == plusEndpoints {
+		r.settings.Logger.Error("Failed to fetch nginx plus stats", zap.Error(errs))
+		return pmetric.Metrics{}, errs
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	if requestsErr == nil {
+		r.mb.RecordNginxRequestsDataPoint(now, requests.Total)
+	}
+	if connsErr == nil {
+		r.mb.RecordNginxConnectionsAcceptedDataPoint(now, conns.Accepted)
+		r.mb.RecordNginxConnectionsHandledDataPoint(now, conns.Accepted-conns.Dropped)
+		r.mb.RecordNginxConnectionsCurrentDataPoint(now, conns.Active, metadata.AttributeStateActive)
+		r.mb.RecordNginxConnectionsCurrentDataPoint(now, conns.Idle, metadata.AttributeStateWaiting)
+	}
+
+	for _, name := range sortedKeys(serverZones) {
+		zone := serverZones[name]
+		r.mb.RecordNginxServerZoneRequestsDataPoint(now, zone.Requests, name)
+		for _, c := range statusCodeClasses {
+			r.mb.RecordNginxServerZoneResponsesDataPoint(now, zone.Responses[c.key], name, c.class)
+		}
+		r.mb.RecordNginxServerZoneIoDataPoint(now, zone.Received, name, metadata.AttributeDirectionReceived)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, zone.Sent, name, metadata.AttributeDirectionSent)
+	}
+
+	for _, name := range sortedKeys(upstreams) {
+		for _, peer := range upstreams[name].Peers {
+			r.mb.RecordNginxUpstreamPeerRequestsDataPoint(now, peer.Requests, name, peer.Server)
+			for _, c := range statusCodeClasses {
+				r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, peer.Responses[c.key], name, peer.Server, c.class)
+			}
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, peer.Received, name, peer.Server, metadata.AttributeDirectionReceived)
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, peer.Sent, name, peer.Server, metadata.AttributeDirectionSent)
+			if peer.ResponseTime != nil {
+				r.mb.RecordNginxUpstreamPeerResponseTimeDataPoint(now, float64(*peer.ResponseTime), name, peer.Server)
+			}
+		}
+	}
+
+	if errs != nil {
+		return r.mb.Emit(), scrapererror.NewPartialScrapeError(errs, len(multierr.Errors(errs)))
+	}
+	return r.mb.Emit(), nil
+}
@@ -16,7 +16,10 @@ package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/nginxinc/nginx-prometheus-exporter/client"
@@ -58,7 +61,17 @@ func (r *nginxScraper) start(_ context.Context, host component.Host) error {
 	return nil
 }
 
-func (r *nginxScraper) scrape(context.Context) (pmetric.Metrics, error) {
+func (r *nginxScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	switch r.cfg.Module {
+	case modulePlus:
+		return r.scrapePlus(ctx)
+	case moduleVTS:
+		return r.scrapeVTS(ctx)
+	}
+	return r.scrapeStubStatus()
+}
+
+func (r *nginxScraper) scrapeStubStatus() (pmetric.Metrics, error) {
 	// Init client in scrape method in case there are transient errors in the constructor.
 	if r.client == nil {
 		var err error
@@ -87,3 +100,47 @@ func (r *nginxScraper) scrape(context.Context) (pmetric.Metrics, error) {
 
 	return r.mb.Emit(), nil
 }
+
+// getJSON decodes the JSON document served at url into v.
+func (r *nginxScraper) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected %d response from %s, got %d", http.StatusOK, url, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", url, err)
+	}
+	return nil
+}
+
+// statusCodeClasses maps the response counter keys shared by the Plus API and the VTS module
+// to the status_code_class attribute.
+var statusCodeClasses = []struct {
+	key   string
+	class metadata.AttributeStatusCodeClass
+}{
+	{"1xx", metadata.AttributeStatusCodeClass1xx},
+	{"2xx", metadata.AttributeStatusCodeClass2xx},
+	{"3xx", metadata.AttributeStatusCodeClass3xx},
+	{"4xx", metadata.AttributeStatusCodeClass4xx},
+	{"5xx", metadata.AttributeStatusCodeClass5xx},
+}
+
+// sortedKeys returns the keys of m in lexical order so data points are emitted deterministically.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
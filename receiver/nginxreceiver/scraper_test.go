@@ -19,6 +19,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -26,6 +27,8 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest/golden"
@@ -52,6 +55,100 @@ func TestScraper(t *testing.T) {
 	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
 }
 
+func TestScraperPlus(t *testing.T) {
+	nginxMock := newFileServer(t, map[string]string{
+		"/api/8/connections":       "plus/connections.json",
+		"/api/8/http/requests":     "plus/requests.json",
+		"/api/8/http/server_zones": "plus/server_zones.json",
+		"/api/8/http/upstreams":    "plus/upstreams.json",
+	})
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = nginxMock.URL + "/api"
+	cfg.Module = modulePlus
+	require.NoError(t, cfg.Validate())
+
+	scraper := newNginxScraper(componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := metricsByName(actualMetrics)
+
+	require.EqualValues(t, 10624511, dataPoint(t, metrics["nginx.requests"], nil).IntValue())
+	require.EqualValues(t, 4968116, dataPoint(t, metrics["nginx.connections_handled"], nil).IntValue())
+	require.EqualValues(t, 117, dataPoint(t, metrics["nginx.connections_current"], map[string]string{"state": "waiting"}).IntValue())
+	require.Equal(t, 2, metrics["nginx.connections_current"].Gauge().DataPoints().Len())
+
+	zone := map[string]string{"server_zone": "trac.nginx.org"}
+	require.EqualValues(t, 456391, dataPoint(t, metrics["nginx.server_zone.requests"], zone).IntValue())
+	require.EqualValues(t, 26410, dataPoint(t, metrics["nginx.server_zone.responses"],
+		map[string]string{"server_zone": "trac.nginx.org", "status_code_class": "4xx"}).IntValue())
+	require.EqualValues(t, 4609830458, dataPoint(t, metrics["nginx.server_zone.io"],
+		map[string]string{"server_zone": "trac.nginx.org", "direction": "sent"}).IntValue())
+	require.NotContains(t, metrics, "nginx.server_zone.request_time")
+
+	peer := map[string]string{"upstream": "trac-backend", "peer": "10.0.0.1:8080"}
+	require.EqualValues(t, 103974, dataPoint(t, metrics["nginx.upstream.peer.requests"], peer).IntValue())
+	require.EqualValues(t, 3074573087, dataPoint(t, metrics["nginx.upstream.peer.io"],
+		map[string]string{"upstream": "trac-backend", "peer": "10.0.0.1:8080", "direction": "received"}).IntValue())
+	require.EqualValues(t, 73, dataPoint(t, metrics["nginx.upstream.peer.response_time"], peer).DoubleValue())
+	// The backup peer has not served any response yet, so it has no response time.
+	require.Equal(t, 1, metrics["nginx.upstream.peer.response_time"].Gauge().DataPoints().Len())
+	require.Equal(t, 2, metrics["nginx.upstream.peer.requests"].Sum().DataPoints().Len())
+}
+
+func TestScraperPlusPartialError(t *testing.T) {
+	nginxMock := newFileServer(t, map[string]string{
+		"/api/8/http/server_zones": "plus/server_zones.json",
+	})
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = nginxMock.URL + "/api"
+	cfg.Module = modulePlus
+
+	scraper := newNginxScraper(componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	metrics := metricsByName(actualMetrics)
+	require.Contains(t, metrics, "nginx.server_zone.requests")
+	require.NotContains(t, metrics, "nginx.requests")
+}
+
+func TestScraperVTS(t *testing.T) {
+	nginxMock := newFileServer(t, map[string]string{
+		"/status/format/json": "vts/status.json",
+	})
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = nginxMock.URL + "/status/format/json"
+	cfg.Module = moduleVTS
+	require.NoError(t, cfg.Validate())
+
+	scraper := newNginxScraper(componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	metrics := metricsByName(actualMetrics)
+
+	require.EqualValues(t, 845, dataPoint(t, metrics["nginx.requests"], nil).IntValue())
+	require.Equal(t, 4, metrics["nginx.connections_current"].Gauge().DataPoints().Len())
+
+	// The "*" zone sums all other zones and is not reported.
+	require.Equal(t, 1, metrics["nginx.server_zone.requests"].Sum().DataPoints().Len())
+	zone := map[string]string{"server_zone": "example.com"}
+	require.EqualValues(t, 800, dataPoint(t, metrics["nginx.server_zone.requests"], zone).IntValue())
+	require.EqualValues(t, 12, dataPoint(t, metrics["nginx.server_zone.request_time"], zone).DoubleValue())
+	require.EqualValues(t, 204800, dataPoint(t, metrics["nginx.server_zone.io"],
+		map[string]string{"server_zone": "example.com", "direction": "received"}).IntValue())
+
+	peer := map[string]string{"upstream": "backend", "peer": "10.0.0.1:8080"}
+	require.EqualValues(t, 640, dataPoint(t, metrics["nginx.upstream.peer.requests"], peer).IntValue())
+	require.EqualValues(t, 9, dataPoint(t, metrics["nginx.upstream.peer.response_time"], peer).DoubleValue())
+	require.EqualValues(t, 2, dataPoint(t, metrics["nginx.upstream.peer.responses"],
+		map[string]string{"upstream": "backend", "peer": "10.0.0.1:8080", "status_code_class": "5xx"}).IntValue())
+}
+
 func TestScraperError(t *testing.T) {
 	nginxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if req.URL.Path == "/status" {
@@ -116,3 +213,54 @@ Reading: 6 Writing: 179 Waiting: 106
 		rw.WriteHeader(404)
 	}))
 }
+
+func newFileServer(t *testing.T, files map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		file, ok := files[req.URL.Path]
+		if !ok {
+			rw.WriteHeader(404)
+			return
+		}
+		body, err := os.ReadFile(filepath.Join("testdata", file))
+		require.NoError(t, err)
+		rw.WriteHeader(200)
+		_, err = rw.Write(body)
+		require.NoError(t, err)
+	}))
+}
+
+func metricsByName(metrics pmetric.Metrics) map[string]pmetric.Metric {
+	byName := map[string]pmetric.Metric{}
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		byName[ms.At(i).Name()] = ms.At(i)
+	}
+	return byName
+}
+
+// dataPoint returns the data point of m whose attributes include attrs.
+func dataPoint(t *testing.T, m pmetric.Metric, attrs map[string]string) pmetric.NumberDataPoint {
+	var dps pmetric.NumberDataPointSlice
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		dps = m.Gauge().DataPoints()
+	case pmetric.MetricTypeSum:
+		dps = m.Sum().DataPoints()
+	default:
+		require.Failf(t, "unexpected metric type", "metric %q has type %s", m.Name(), m.Type())
+	}
+	for i := 0; i < dps.Len(); i++ {
+		matches := true
+		for k, v := range attrs {
+			if attr, ok := dps.At(i).Attributes().Get(k); !ok || attr.Str() != v {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return dps.At(i)
+		}
+	}
+	require.Failf(t, "data point not found", "metric %q has no data point with attributes %v", m.Name(), attrs)
+	return pmetric.NumberDataPoint{}
+}
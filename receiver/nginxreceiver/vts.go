@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+)
+
+// vtsAllServerZones is the server zone the VTS module uses to report the sum of all other zones.
+const vtsAllServerZones = "*"
+
+// vtsStatus is the JSON document served by the nginx-module-vts status page.
+type vtsStatus struct {
+	Connections struct {
+		Active   int64 `json:"active"`
+		Reading  int64 `json:"reading"`
+		Writing  int64 `json:"writing"`
+		Waiting  int64 `json:"waiting"`
+		Accepted int64 `json:"accepted"`
+		Handled  int64 `json:"handled"`
+		Requests int64 `json:"requests"`
+	} `json:"connections"`
+	ServerZones   map[string]vtsZone   `json:"serverZones"`
+	UpstreamZones map[string][]vtsPeer `json:"upstreamZones"`
+}
+
+type vtsZone struct {
+	RequestCounter int64            `json:"requestCounter"`
+	InBytes        int64            `json:"inBytes"`
+	OutBytes       int64            `json:"outBytes"`
+	Responses      map[string]int64 `json:"responses"`
+	RequestMsec    int64            `json:"requestMsec"`
+}
+
+type vtsPeer struct {
+	Server         string           `json:"server"`
+	RequestCounter int64            `json:"requestCounter"`
+	InBytes        int64            `json:"inBytes"`
+	OutBytes       int64            `json:"outBytes"`
+	Responses      map[string]int64 `json:"responses"`
+	ResponseMsec   int64            `json:"responseMsec"`
+}
+
+func (r *nginxScraper) scrapeVTS(ctx context.Context) (pmetric.Metrics, error) {
+	var status vtsStatus
+	if err := r.getJSON(ctx, r.cfg.Endpoint, &status); err != nil {
+		r.settings.Logger.Error("Failed to fetch nginx vts stats", zap.Error(err))
+		return pmetric.Metrics{}, err
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	r.mb.RecordNginxRequestsDataPoint(now, status.Connections.Requests)
+	r.mb.RecordNginxConnectionsAcceptedDataPoint(now, status.Connections.Accepted)
+	r.mb.RecordNginxConnectionsHandledDataPoint(now, status.Connections.Handled)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, status.Connections.Active, metadata.AttributeStateActive)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, status.Connections.Reading, metadata.AttributeStateReading)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, status.Connections.Writing, metadata.AttributeStateWriting)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, status.Connections.Waiting, metadata.AttributeStateWaiting)
+
+	for _, name := range sortedKeys(status.ServerZones) {
+		if name == vtsAllServerZones {
+			continue
+		}
+		zone := status.ServerZones[name]
+		r.mb.RecordNginxServerZoneRequestsDataPoint(now, zone.RequestCounter, name)
+		for _, c := range statusCodeClasses {
+			r.mb.RecordNginxServerZoneResponsesDataPoint(now, zone.Responses[c.key], name, c.class)
+		}
+		// inBytes is read from clients, outBytes is written to them.
+		r.mb.RecordNginxServerZoneIoDataPoint(now, zone.InBytes, name, metadata.AttributeDirectionReceived)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, zone.OutBytes, name, metadata.AttributeDirectionSent)
+		r.mb.RecordNginxServerZoneRequestTimeDataPoint(now, float64(zone.RequestMsec), name)
+	}
+
+	for _, name := range sortedKeys(status.UpstreamZones) {
+		for _, peer := range status.UpstreamZones[name] {
+			r.mb.RecordNginxUpstreamPeerRequestsDataPoint(now, peer.RequestCounter, name, peer.Server)
+			for _, c := range statusCodeClasses {
+				r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, peer.Responses[c.key], name, peer.Server, c.class)
+			}
+			// For upstreams, inBytes is read from the peer and outBytes is written to it.
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, peer.InBytes, name, peer.Server, metadata.AttributeDirectionReceived)
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, peer.OutBytes, name, peer.Server, metadata.AttributeDirectionSent)
+			r.mb.RecordNginxUpstreamPeerResponseTimeDataPoint(now, float64(peer.ResponseMsec), name, peer.Server)
+		}
+	}
+
+	return r.mb.Emit(), nil
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"go.uber.org/zap"
+)
+
+const (
+	compressionGZIP = "gzip"
+	compressionZSTD = "zstd"
+
+	// messageLengthSize is the size of the unsigned 32 bit integer preceding each compressed message.
+	messageLengthSize = 4
+)
+
+// decompressFunc decompresses a message read from a file.
+type decompressFunc func(src []byte) ([]byte, error)
+
+// zstdDecoder caches the zstd decompressors, it is only used through DecodeAll.
+var zstdDecoder, _ = zstd.NewReader(nil)
+
+var decompressors = map[string]decompressFunc{
+	compressionGZIP: gzipDecompress,
+	compressionZSTD: zstdDecompress,
+}
+
+// gzipDecompress decompresses a gzip message.
+func gzipDecompress(src []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(src))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// zstdDecompress decompresses a zstd message.
+func zstdDecompress(src []byte) ([]byte, error) {
+	return zstdDecoder.DecodeAll(src, nil)
+}
+
+// newScanMessages returns a bufio.SplitFunc returning the messages of a file written with compression
+// by the file exporter, where each message is preceded by its size as a big endian unsigned 32 bit
+// integer. A message that is not entirely written yet is left in the file, to be read on a later poll.
+// A message larger than maxLogSize, or following a corrupt size, can't be buffered: it is skipped
+// instead of stalling the file. The split function keeps the count of bytes left to skip, so a new
+// one must be built for each file.
+func newScanMessages(maxLogSize int, logger *zap.Logger) bufio.SplitFunc {
+	var skip uint64
+	return func(data []byte, _ bool) (int, []byte, error) {
+		if skip == 0 {
+			if len(data) < messageLengthSize {
+				return 0, nil, nil
+			}
+			length := uint64(binary.BigEndian.Uint32(data))
+			if messageLengthSize+length <= uint64(maxLogSize) {
+				if uint64(len(data)) < messageLengthSize+length {
+					return 0, nil, nil
+				}
+				end := messageLengthSize + int(length)
+				return end, data[messageLengthSize:end], nil
+			}
+			logger.Warn("Skipping a message larger than max_log_size, its size may be corrupt",
+				zap.Uint64("size", length), zap.Int("max_log_size", maxLogSize))
+			skip = messageLengthSize + length
+		}
+
+		advance := uint64(len(data))
+		if advance > skip {
+			advance = skip
+		}
+		skip -= advance
+		return int(advance), nil, nil
+	}
+}
+
+// validateCompression returns an error if the compression is not supported.
+func validateCompression(compression string) error {
+	if _, ok := decompressors[compression]; compression != "" && !ok {
+		return fmt.Errorf("compression %q is not supported", compression)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjsonfilereceiver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+)
+
+// compressedMessage returns the message compressed and framed as by the file exporter.
+func compressedMessage(t *testing.T, compression string, message []byte) []byte {
+	var compressed []byte
+	switch compression {
+	case compressionGZIP:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		_, err := w.Write(message)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		compressed = buf.Bytes()
+	case compressionZSTD:
+		encoder, err := zstd.NewWriter(nil)
+		require.NoError(t, err)
+		compressed = encoder.EncodeAll(message, nil)
+	}
+	framed := make([]byte, messageLengthSize, messageLengthSize+len(compressed))
+	binary.BigEndian.PutUint32(framed, uint32(len(compressed)))
+	return append(framed, compressed...)
+}
+
+func TestScanMessages(t *testing.T) {
+	scanMessages := newScanMessages(1024, zap.NewNop())
+	data := append([]byte{0, 0, 0, 3}, []byte("abc")...)
+	data = append(data, 0, 0, 0, 2, 'd')
+
+	advance, token, err := scanMessages(data, false)
+	require.NoError(t, err)
+	assert.Equal(t, 7, advance)
+	assert.Equal(t, []byte("abc"), token)
+
+	// the second message is not entirely written yet
+	advance, token, err = scanMessages(data[advance:], true)
+	require.NoError(t, err)
+	assert.Equal(t, 0, advance)
+	assert.Nil(t, token)
+
+	advance, token, err = scanMessages([]byte{0, 0}, false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, advance)
+	assert.Nil(t, token)
+}
+
+func TestScanMessagesSkipsLargeMessages(t *testing.T) {
+	scanMessages := newScanMessages(8, zap.NewNop())
+
+	// the 10 bytes message is skipped over several calls, as the file is read
+	data := append([]byte{0, 0, 0, 10}, []byte("abcdef")...)
+	advance, token, err := scanMessages(data, false)
+	require.NoError(t, err)
+	assert.Equal(t, 10, advance)
+	assert.Nil(t, token)
+
+	data = append([]byte("ghij"), 0, 0, 0, 2, 'k', 'l')
+	advance, token, err = scanMessages(data, false)
+	require.NoError(t, err)
+	assert.Equal(t, 4, advance)
+	assert.Nil(t, token)
+
+	// the next message is read again
+	advance, token, err = scanMessages(data[advance:], false)
+	require.NoError(t, err)
+	assert.Equal(t, 6, advance)
+	assert.Equal(t, []byte("kl"), token)
+
+	// a corrupt size is skipped as well instead of waiting for the data forever
+	advance, token, err = scanMessages([]byte{0xff, 0xff, 0xff, 0xff, 'm'}, true)
+	require.NoError(t, err)
+	assert.Equal(t, 5, advance)
+	assert.Nil(t, token)
+}
+
+func TestDecompress(t *testing.T) {
+	for _, compression := range []string{compressionGZIP, compressionZSTD} {
+		t.Run(compression, func(t *testing.T) {
+			framed := compressedMessage(t, compression, []byte("message"))
+			_, token, err := newScanMessages(1024, zap.NewNop())(framed, false)
+			require.NoError(t, err)
+			message, err := decompressors[compression](token)
+			require.NoError(t, err)
+			assert.Equal(t, []byte("message"), message)
+		})
+	}
+}
+
+func TestValidateCompression(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Compression = compressionGZIP
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Compression = "lz4"
+	assert.EqualError(t, cfg.Validate(), `compression "lz4" is not supported`)
+}
+
+func TestFileLogsReceiverCompressed(t *testing.T) {
+	for _, compression := range []string{compressionGZIP, compressionZSTD} {
+		t.Run(compression, func(t *testing.T) {
+			tempFolder := t.TempDir()
+			factory := NewFactory()
+			cfg := createDefaultConfig().(*Config)
+			cfg.Config.Include = []string{filepath.Join(tempFolder, "*")}
+			cfg.Config.StartAt = "beginning"
+			cfg.Compression = compression
+			sink := new(consumertest.LogsSink)
+			receiver, err := factory.CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+			require.NoError(t, err)
+			require.NoError(t, receiver.Start(context.Background(), nil))
+
+			ld := testdata.GenerateLogsManyLogRecordsSameResource(5)
+			b, err := plog.NewJSONMarshaler().MarshalLogs(ld)
+			require.NoError(t, err)
+			message := compressedMessage(t, compression, b)
+
+			// write the second message in two steps, as a writer being interrupted
+			data := make([]byte, 0, 2*len(message))
+			data = append(data, message...)
+			data = append(data, message[:len(message)/2]...)
+			path := filepath.Join(tempFolder, "logs.bin")
+			require.NoError(t, os.WriteFile(path, data, 0600))
+			require.Eventually(t, func() bool {
+				return len(sink.AllLogs()) == 1
+			}, 5*time.Second, 10*time.Millisecond)
+
+			f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+			require.NoError(t, err)
+			_, err = f.Write(message[len(message)/2:])
+			require.NoError(t, err)
+			require.NoError(t, f.Close())
+			require.Eventually(t, func() bool {
+				return len(sink.AllLogs()) == 2
+			}, 5*time.Second, 10*time.Millisecond)
+
+			assert.EqualValues(t, ld, sink.AllLogs()[0])
+			assert.EqualValues(t, ld, sink.AllLogs()[1])
+			require.NoError(t, receiver.Shutdown(context.Background()))
+		})
+	}
+}
@@ -15,6 +15,7 @@
 package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
 
 import (
+	"bufio"
 	"context"
 
 	"go.opentelemetry.io/collector/component"
@@ -49,6 +50,41 @@ type Config struct {
 	config.ReceiverSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
 	fileconsumer.Config     `mapstructure:",squash"`
 	StorageID               *config.ComponentID `mapstructure:"storage"`
+
+	// Compression is the compression of the messages of the files, as written by the file exporter
+	// with the same setting: each message is preceded by its size.
+	// Supported compression algorithms: `gzip`, `zstd`. By default, the files contain JSON lines.
+	Compression string `mapstructure:"compression"`
+}
+
+var _ config.Receiver = (*Config)(nil)
+
+// Validate checks if the receiver configuration is valid
+func (cfg *Config) Validate() error {
+	return validateCompression(cfg.Compression)
+}
+
+// buildInput builds the file consumer emitting the uncompressed messages of the files.
+func (cfg *Config) buildInput(settings component.ReceiverCreateSettings, emit func(ctx context.Context, message []byte, err error)) (*fileconsumer.Manager, error) {
+	if cfg.Compression == "" {
+		return cfg.Config.Build(settings.Logger.Sugar(), func(ctx context.Context, _ *fileconsumer.FileAttributes, token []byte) {
+			emit(ctx, token, nil)
+		})
+	}
+
+	decompress, ok := decompressors[cfg.Compression]
+	if !ok {
+		return nil, validateCompression(cfg.Compression)
+	}
+	// The messages are binary, they must not be decoded as text.
+	inputCfg := cfg.Config
+	inputCfg.Splitter.EncodingConfig.Encoding = "nop"
+	return inputCfg.BuildWithSplitFunc(settings.Logger.Sugar(), func(ctx context.Context, _ *fileconsumer.FileAttributes, token []byte) {
+		message, err := decompress(token)
+		emit(ctx, message, err)
+	}, func(maxLogSize int) bufio.SplitFunc {
+		return newScanMessages(maxLogSize, settings.Logger)
+	})
 }
 
 func createDefaultConfig() config.Receiver {
@@ -84,9 +120,13 @@ func createLogsReceiver(_ context.Context, settings component.ReceiverCreateSett
 		ReceiverCreateSettings: settings,
 	})
 	cfg := configuration.(*Config)
-	input, err := cfg.Config.Build(settings.Logger.Sugar(), func(ctx context.Context, attrs *fileconsumer.FileAttributes, token []byte) {
+	input, err := cfg.buildInput(settings, func(ctx context.Context, message []byte, err error) {
 		ctx = obsrecv.StartLogsOp(ctx)
-		l, err := logsUnmarshaler.UnmarshalLogs(token)
+		if err != nil {
+			obsrecv.EndLogsOp(ctx, typeStr, 0, err)
+			return
+		}
+		l, err := logsUnmarshaler.UnmarshalLogs(message)
 		if err != nil {
 			obsrecv.EndLogsOp(ctx, typeStr, 0, err)
 		} else {
@@ -109,9 +149,13 @@ func createMetricsReceiver(_ context.Context, settings component.ReceiverCreateS
 		ReceiverCreateSettings: settings,
 	})
 	cfg := configuration.(*Config)
-	input, err := cfg.Config.Build(settings.Logger.Sugar(), func(ctx context.Context, attrs *fileconsumer.FileAttributes, token []byte) {
+	input, err := cfg.buildInput(settings, func(ctx context.Context, message []byte, err error) {
 		ctx = obsrecv.StartMetricsOp(ctx)
-		m, err := metricsUnmarshaler.UnmarshalMetrics(token)
+		if err != nil {
+			obsrecv.EndMetricsOp(ctx, typeStr, 0, err)
+			return
+		}
+		m, err := metricsUnmarshaler.UnmarshalMetrics(message)
 		if err != nil {
 			obsrecv.EndMetricsOp(ctx, typeStr, 0, err)
 		} else {
@@ -134,9 +178,13 @@ func createTracesReceiver(ctx context.Context, settings component.ReceiverCreate
 		ReceiverCreateSettings: settings,
 	})
 	cfg := configuration.(*Config)
-	input, err := cfg.Config.Build(settings.Logger.Sugar(), func(ctx context.Context, attrs *fileconsumer.FileAttributes, token []byte) {
+	input, err := cfg.buildInput(settings, func(ctx context.Context, message []byte, err error) {
 		ctx = obsrecv.StartTracesOp(ctx)
-		t, err := tracesUnmarshaler.UnmarshalTraces(token)
+		if err != nil {
+			obsrecv.EndTracesOp(ctx, typeStr, 0, err)
+			return
+		}
+		t, err := tracesUnmarshaler.UnmarshalTraces(message)
 		if err != nil {
 			obsrecv.EndTracesOp(ctx, typeStr, 0, err)
 		} else {
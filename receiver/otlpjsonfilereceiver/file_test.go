@@ -156,3 +156,23 @@ func TestLoadConfig(t *testing.T) {
 
 	assert.Equal(t, testdataConfigYamlAsMap(), cfg)
 }
+
+func TestLoadConfigCompressed(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(config.NewComponentIDWithName(typeStr, "compressed").String())
+	require.NoError(t, err)
+	require.NoError(t, config.UnmarshalReceiver(sub, cfg))
+	require.NoError(t, cfg.Validate())
+
+	expected := createDefaultConfig().(*Config)
+	expected.Config.Include = []string{"/var/archive/*.bin"}
+	expected.Config.StartAt = "beginning"
+	expected.Compression = compressionGZIP
+	storageID := config.NewComponentID("file_storage")
+	expected.StorageID = &storageID
+	assert.Equal(t, expected, cfg)
+}
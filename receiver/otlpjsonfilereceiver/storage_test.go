@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjsonfilereceiver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+// logsLine returns a JSON line of logs with a single log record of the given body.
+func logsLine(t *testing.T, body string) []byte {
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(body)
+	b, err := plog.NewJSONMarshaler().MarshalLogs(ld)
+	require.NoError(t, err)
+	return append(b, '\n')
+}
+
+func appendToFile(t *testing.T, path string, data []byte) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+// expectBodies checks that the sink received the logs of the given bodies, in any order.
+func expectBodies(sink *consumertest.LogsSink, expected ...string) func() bool {
+	sort.Strings(expected)
+	return func() bool {
+		var bodies []string
+		for _, ld := range sink.AllLogs() {
+			bodies = append(bodies, ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+		}
+		sort.Strings(bodies)
+		return reflect.DeepEqual(expected, bodies)
+	}
+}
+
+func TestStorageRotation(t *testing.T) {
+	ctx := context.Background()
+	logsDir := t.TempDir()
+	storageDir := t.TempDir()
+	path := filepath.Join(logsDir, "logs.json")
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Config.Include = []string{filepath.Join(logsDir, "*")}
+	cfg.Config.StartAt = "beginning"
+	extID := storagetest.NewFileBackedStorageExtension("test", storageDir).ID()
+	cfg.StorageID = &extID
+
+	start := func(sink *consumertest.LogsSink) (component.LogsReceiver, *storagetest.StorageHost) {
+		ext := storagetest.NewFileBackedStorageExtension("test", storageDir)
+		host := storagetest.NewStorageHost().WithExtension(ext.ID(), ext)
+		rcvr, err := NewFactory().CreateLogsReceiver(ctx, componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+		require.NoError(t, err)
+		require.NoError(t, rcvr.Start(ctx, host))
+		return rcvr, host
+	}
+	stop := func(rcvr component.LogsReceiver, host *storagetest.StorageHost) {
+		require.NoError(t, rcvr.Shutdown(ctx))
+		for _, e := range host.GetExtensions() {
+			require.NoError(t, e.Shutdown(ctx))
+		}
+	}
+
+	appendToFile(t, path, logsLine(t, "before restart"))
+	sink := new(consumertest.LogsSink)
+	rcvr, host := start(sink)
+	require.Eventually(t, expectBodies(sink, "before restart"), 5*time.Second, 10*time.Millisecond)
+	stop(rcvr, host)
+
+	// While the receiver is stopped, the file gets a last message, is rotated,
+	// and the messages are written to a new file.
+	appendToFile(t, path, logsLine(t, "before rotation"))
+	require.NoError(t, os.Rename(path, filepath.Join(logsDir, "logs-1.json")))
+	appendToFile(t, path, logsLine(t, "after rotation"))
+
+	// Only the messages written while the receiver was stopped are read after the restart.
+	sink = new(consumertest.LogsSink)
+	rcvr, host = start(sink)
+	require.Eventually(t, expectBodies(sink, "before rotation", "after rotation"), 5*time.Second, 10*time.Millisecond)
+	stop(rcvr, host)
+}
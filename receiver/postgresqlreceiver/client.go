@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 	"time"
 
@@ -53,6 +54,8 @@ type client interface {
 	getDatabaseTableMetrics(ctx context.Context, db string) (map[tableIdentifier]tableStats, error)
 	getBlocksReadByTable(ctx context.Context, db string) (map[tableIdentifier]tableIOStats, error)
 	getReplicationStats(ctx context.Context) ([]replicationStats, error)
+	getReplicationSlots(ctx context.Context) ([]replicationSlot, error)
+	getQueryStats(ctx context.Context, databases []string, limit int) (map[databaseName][]queryStats, error)
 	getLatestWalAgeSeconds(ctx context.Context) (int64, error)
 	getMaxConnections(ctx context.Context) (int64, error)
 	getIndexStats(ctx context.Context, database string) (map[indexIdentifer]indexStat, error)
@@ -490,6 +493,121 @@ func (c *postgreSQLClient) getReplicationStats(ctx context.Context) ([]replicati
 	return rs, errors
 }
 
+type replicationSlot struct {
+	name     string
+	slotType string
+	lagBytes int64
+}
+
+func (c *postgreSQLClient) getReplicationSlots(ctx context.Context) ([]replicationSlot, error) {
+	// a standby has no current WAL insert location, so the lag of its slots is measured against the last
+	// location received from the primary
+	query := `SELECT
+	slot_name,
+	slot_type,
+	coalesce(pg_wal_lsn_diff(
+		CASE WHEN pg_is_in_recovery() THEN pg_last_wal_receive_lsn() ELSE pg_current_wal_lsn() END,
+		coalesce(confirmed_flush_lsn, restart_lsn)
+	), 0) AS lag_bytes
+	FROM pg_replication_slots;
+	`
+	rows, err := c.client.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query pg_replication_slots: %w", err)
+	}
+	defer rows.Close()
+	var slots []replicationSlot
+	var errors error
+	for rows.Next() {
+		var name, slotType string
+		var lagBytes int64
+		err = rows.Scan(&name, &slotType, &lagBytes)
+		if err != nil {
+			errors = multierr.Append(errors, err)
+			continue
+		}
+		slots = append(slots, replicationSlot{
+			name:     name,
+			slotType: slotType,
+			lagBytes: lagBytes,
+		})
+	}
+
+	return slots, errors
+}
+
+// queryStats contains the pg_stat_statements counters of a normalized statement summed across all users
+type queryStats struct {
+	queryID   string
+	query     string
+	calls     int64
+	totalTime float64
+	meanTime  float64
+	rows      int64
+}
+
+// pgStatStatementsExecTimeVersion is the first server version that ships a pg_stat_statements release with
+// the total_exec_time and mean_exec_time columns, which were previously named total_time and mean_time.
+const pgStatStatementsExecTimeVersion = 130000
+
+func (c *postgreSQLClient) getQueryStats(ctx context.Context, databases []string, limit int) (map[databaseName][]queryStats, error) {
+	var serverVersion int64
+	if err := c.client.QueryRowContext(ctx, "SHOW server_version_num;").Scan(&serverVersion); err != nil {
+		return nil, fmt.Errorf("unable to determine server version: %w", err)
+	}
+	totalTimeColumn := "total_exec_time"
+	if serverVersion < pgStatStatementsExecTimeVersion {
+		totalTimeColumn = "total_time"
+	}
+
+	query := fmt.Sprintf(`SELECT
+	d.datname,
+	s.queryid,
+	s.query,
+	sum(s.calls) AS calls,
+	sum(s.%[1]s) AS total_time,
+	coalesce(sum(s.%[1]s) / nullif(sum(s.calls), 0), 0) AS mean_time,
+	sum(s.rows) AS rows
+	FROM pg_stat_statements s
+	JOIN pg_database d ON d.oid = s.dbid
+	WHERE s.queryid IS NOT NULL`, totalTimeColumn)
+	if len(databases) > 0 {
+		var queryDatabases []string
+		for _, db := range databases {
+			queryDatabases = append(queryDatabases, fmt.Sprintf("'%s'", db))
+		}
+		query += fmt.Sprintf(" AND d.datname IN (%s)", strings.Join(queryDatabases, ","))
+	}
+	query += fmt.Sprintf(" GROUP BY d.datname, s.queryid, s.query ORDER BY total_time DESC LIMIT %d;", limit)
+
+	rows, err := c.client.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query pg_stat_statements: %w", err)
+	}
+	defer rows.Close()
+	stats := map[databaseName][]queryStats{}
+	var errors error
+	for rows.Next() {
+		var datname, queryText string
+		var queryID, calls, rowCount int64
+		var totalTime, meanTime float64
+		err = rows.Scan(&datname, &queryID, &queryText, &calls, &totalTime, &meanTime, &rowCount)
+		if err != nil {
+			errors = multierr.Append(errors, err)
+			continue
+		}
+		stats[databaseName(datname)] = append(stats[databaseName(datname)], queryStats{
+			queryID:   strconv.FormatInt(queryID, 10),
+			query:     queryText,
+			calls:     calls,
+			totalTime: totalTime,
+			meanTime:  meanTime,
+			rows:      rowCount,
+		})
+	}
+	return stats, errors
+}
+
 func (c *postgreSQLClient) getLatestWalAgeSeconds(ctx context.Context) (int64, error) {
 	query := `SELECT
 	coalesce(last_archived_time, CURRENT_TIMESTAMP) AS last_archived_wal,
@@ -34,8 +34,14 @@ const (
 	ErrNotSupported        = "invalid config: field '%s' not supported"
 	ErrTransportsSupported = "invalid config: 'transport' must be 'tcp' or 'unix'"
 	ErrHostPort            = "invalid config: 'endpoint' must be in the form <host>:<port> no matter what 'transport' is configured"
+	ErrQueryStatsTopN      = "invalid config: 'query_stats.top_n' must be between 1 and %d"
+	ErrQueryStatsLength    = "invalid config: 'query_stats.max_query_length' must be positive"
 )
 
+// maxQueryStatsTopN caps the number of statements reported per scrape so that query text attributes can not
+// produce an unbounded number of time series.
+const maxQueryStatsTopN = 1000
+
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	Username                                string                         `mapstructure:"username"`
@@ -43,9 +49,19 @@ type Config struct {
 	Databases                               []string                       `mapstructure:"databases"`
 	confignet.NetAddr                       `mapstructure:",squash"`       // provides Endpoint and Transport
 	configtls.TLSClientSetting              `mapstructure:"tls,omitempty"` // provides SSL details
+	QueryStats                              QueryStatsConfig               `mapstructure:"query_stats"`
 	Metrics                                 metadata.MetricsSettings       `mapstructure:"metrics"`
 }
 
+// QueryStatsConfig controls the statements reported by the postgresql.query.* metrics, which are collected
+// from pg_stat_statements.
+type QueryStatsConfig struct {
+	// TopN is the number of statements with the highest total execution time reported on each scrape.
+	TopN int `mapstructure:"top_n"`
+	// MaxQueryLength is the number of characters of normalized query text kept in the query attribute.
+	MaxQueryLength int `mapstructure:"max_query_length"`
+}
+
 func (cfg *Config) Validate() error {
 	var err error
 	if cfg.Username == "" {
@@ -66,6 +82,13 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, fmt.Errorf(ErrNotSupported, "MinVersion"))
 	}
 
+	if cfg.QueryStats.TopN < 1 || cfg.QueryStats.TopN > maxQueryStatsTopN {
+		err = multierr.Append(err, fmt.Errorf(ErrQueryStatsTopN, maxQueryStatsTopN))
+	}
+	if cfg.QueryStats.MaxQueryLength < 1 {
+		err = multierr.Append(err, errors.New(ErrQueryStatsLength))
+	}
+
 	switch cfg.Transport {
 	case "tcp", "unix":
 		_, _, endpointErr := net.SplitHostPort(cfg.Endpoint)
@@ -97,6 +97,19 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf(ErrNotSupported, "MinVersion"),
 			),
 		},
+		{
+			desc: "bad query stats settings",
+			defaultConfigModifier: func(cfg *Config) {
+				cfg.Username = "otel"
+				cfg.Password = "otel"
+				cfg.QueryStats.TopN = maxQueryStatsTopN + 1
+				cfg.QueryStats.MaxQueryLength = 0
+			},
+			expected: multierr.Combine(
+				fmt.Errorf(ErrQueryStatsTopN, maxQueryStatsTopN),
+				errors.New(ErrQueryStatsLength),
+			),
+		},
 		{
 			desc: "no error",
 			defaultConfigModifier: func(cfg *Config) {
@@ -149,6 +162,10 @@ func TestLoadConfig(t *testing.T) {
 		expected.Password = "$POSTGRESQL_PASSWORD"
 		expected.Databases = []string{"otel"}
 		expected.CollectionInterval = 10 * time.Second
+		expected.QueryStats = QueryStatsConfig{
+			TopN:           25,
+			MaxQueryLength: 512,
+		}
 		expected.TLSClientSetting = configtls.TLSClientSetting{
 			Insecure:           false,
 			InsecureSkipVerify: false,
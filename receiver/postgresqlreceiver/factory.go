@@ -31,6 +31,9 @@ import (
 const (
 	typeStr   = "postgresql"
 	stability = component.StabilityLevelBeta
+
+	defaultQueryStatsTopN = 100
+	defaultMaxQueryLength = 1024
 )
 
 func NewFactory() component.ReceiverFactory {
@@ -54,6 +57,10 @@ func createDefaultConfig() config.Receiver {
 			Insecure:           false,
 			InsecureSkipVerify: true,
 		},
+		QueryStats: QueryStatsConfig{
+			TopN:           defaultQueryStatsTopN,
+			MaxQueryLength: defaultMaxQueryLength,
+		},
 		Metrics: metadata.DefaultMetricsSettings(),
 	}
 }
@@ -31,7 +31,12 @@ type MetricsSettings struct {
 	PostgresqlIndexScans               MetricSettings `mapstructure:"postgresql.index.scans"`
 	PostgresqlIndexSize                MetricSettings `mapstructure:"postgresql.index.size"`
 	PostgresqlOperations               MetricSettings `mapstructure:"postgresql.operations"`
+	PostgresqlQueryCalls               MetricSettings `mapstructure:"postgresql.query.calls"`
+	PostgresqlQueryDurationMean        MetricSettings `mapstructure:"postgresql.query.duration.mean"`
+	PostgresqlQueryDurationTotal       MetricSettings `mapstructure:"postgresql.query.duration.total"`
+	PostgresqlQueryRows                MetricSettings `mapstructure:"postgresql.query.rows"`
 	PostgresqlReplicationDataDelay     MetricSettings `mapstructure:"postgresql.replication.data_delay"`
+	PostgresqlReplicationSlotLag       MetricSettings `mapstructure:"postgresql.replication.slot.lag"`
 	PostgresqlRollbacks                MetricSettings `mapstructure:"postgresql.rollbacks"`
 	PostgresqlRows                     MetricSettings `mapstructure:"postgresql.rows"`
 	PostgresqlTableCount               MetricSettings `mapstructure:"postgresql.table.count"`
@@ -85,9 +90,24 @@ func DefaultMetricsSettings() MetricsSettings {
 		PostgresqlOperations: MetricSettings{
 			Enabled: true,
 		},
+		PostgresqlQueryCalls: MetricSettings{
+			Enabled: false,
+		},
+		PostgresqlQueryDurationMean: MetricSettings{
+			Enabled: false,
+		},
+		PostgresqlQueryDurationTotal: MetricSettings{
+			Enabled: false,
+		},
+		PostgresqlQueryRows: MetricSettings{
+			Enabled: false,
+		},
 		PostgresqlReplicationDataDelay: MetricSettings{
 			Enabled: true,
 		},
+		PostgresqlReplicationSlotLag: MetricSettings{
+			Enabled: false,
+		},
 		PostgresqlRollbacks: MetricSettings{
 			Enabled: true,
 		},
@@ -232,6 +252,32 @@ var MapAttributeOperation = map[string]AttributeOperation{
 	"hot_upd": AttributeOperationHotUpd,
 }
 
+// AttributeReplicationSlotType specifies the a value replication_slot_type attribute.
+type AttributeReplicationSlotType int
+
+const (
+	_ AttributeReplicationSlotType = iota
+	AttributeReplicationSlotTypePhysical
+	AttributeReplicationSlotTypeLogical
+)
+
+// String returns the string representation of the AttributeReplicationSlotType.
+func (av AttributeReplicationSlotType) String() string {
+	switch av {
+	case AttributeReplicationSlotTypePhysical:
+		return "physical"
+	case AttributeReplicationSlotTypeLogical:
+		return "logical"
+	}
+	return ""
+}
+
+// MapAttributeReplicationSlotType is a helper map of string to AttributeReplicationSlotType attribute value.
+var MapAttributeReplicationSlotType = map[string]AttributeReplicationSlotType{
+	"physical": AttributeReplicationSlotTypePhysical,
+	"logical":  AttributeReplicationSlotTypeLogical,
+}
+
 // AttributeSource specifies the a value source attribute.
 type AttributeSource int
 
@@ -1068,6 +1114,220 @@ func newMetricPostgresqlOperations(settings MetricSettings) metricPostgresqlOper
 	return m
 }
 
+type metricPostgresqlQueryCalls struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.calls metric with initial data.
+func (m *metricPostgresqlQueryCalls) init() {
+	m.data.SetName("postgresql.query.calls")
+	m.data.SetDescription("The number of times a statement was executed.")
+	m.data.SetUnit("{calls}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryCalls) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, queryIDAttributeValue string, queryAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("query_id", queryIDAttributeValue)
+	dp.Attributes().PutStr("query", queryAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryCalls) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryCalls) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryCalls(settings MetricSettings) metricPostgresqlQueryCalls {
+	m := metricPostgresqlQueryCalls{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPostgresqlQueryDurationMean struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.duration.mean metric with initial data.
+func (m *metricPostgresqlQueryDurationMean) init() {
+	m.data.SetName("postgresql.query.duration.mean")
+	m.data.SetDescription("The mean time spent executing a statement.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryDurationMean) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, queryIDAttributeValue string, queryAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("query_id", queryIDAttributeValue)
+	dp.Attributes().PutStr("query", queryAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryDurationMean) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryDurationMean) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryDurationMean(settings MetricSettings) metricPostgresqlQueryDurationMean {
+	m := metricPostgresqlQueryDurationMean{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPostgresqlQueryDurationTotal struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.duration.total metric with initial data.
+func (m *metricPostgresqlQueryDurationTotal) init() {
+	m.data.SetName("postgresql.query.duration.total")
+	m.data.SetDescription("The total time spent executing a statement.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryDurationTotal) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, queryIDAttributeValue string, queryAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("query_id", queryIDAttributeValue)
+	dp.Attributes().PutStr("query", queryAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryDurationTotal) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryDurationTotal) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryDurationTotal(settings MetricSettings) metricPostgresqlQueryDurationTotal {
+	m := metricPostgresqlQueryDurationTotal{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPostgresqlQueryRows struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.rows metric with initial data.
+func (m *metricPostgresqlQueryRows) init() {
+	m.data.SetName("postgresql.query.rows")
+	m.data.SetDescription("The number of rows retrieved or affected by a statement.")
+	m.data.SetUnit("{rows}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryRows) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, queryIDAttributeValue string, queryAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("query_id", queryIDAttributeValue)
+	dp.Attributes().PutStr("query", queryAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryRows) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryRows) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryRows(settings MetricSettings) metricPostgresqlQueryRows {
+	m := metricPostgresqlQueryRows{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricPostgresqlReplicationDataDelay struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1119,6 +1379,58 @@ func newMetricPostgresqlReplicationDataDelay(settings MetricSettings) metricPost
 	return m
 }
 
+type metricPostgresqlReplicationSlotLag struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.replication.slot.lag metric with initial data.
+func (m *metricPostgresqlReplicationSlotLag) init() {
+	m.data.SetName("postgresql.replication.slot.lag")
+	m.data.SetDescription("The amount of WAL retained by a replication slot that its consumer has not yet confirmed.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlReplicationSlotLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicationSlotAttributeValue string, replicationSlotTypeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("replication_slot", replicationSlotAttributeValue)
+	dp.Attributes().PutStr("replication_slot_type", replicationSlotTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlReplicationSlotLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlReplicationSlotLag) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlReplicationSlotLag(settings MetricSettings) metricPostgresqlReplicationSlotLag {
+	m := metricPostgresqlReplicationSlotLag{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricPostgresqlRollbacks struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1503,7 +1815,12 @@ type MetricsBuilder struct {
 	metricPostgresqlIndexScans               metricPostgresqlIndexScans
 	metricPostgresqlIndexSize                metricPostgresqlIndexSize
 	metricPostgresqlOperations               metricPostgresqlOperations
+	metricPostgresqlQueryCalls               metricPostgresqlQueryCalls
+	metricPostgresqlQueryDurationMean        metricPostgresqlQueryDurationMean
+	metricPostgresqlQueryDurationTotal       metricPostgresqlQueryDurationTotal
+	metricPostgresqlQueryRows                metricPostgresqlQueryRows
 	metricPostgresqlReplicationDataDelay     metricPostgresqlReplicationDataDelay
+	metricPostgresqlReplicationSlotLag       metricPostgresqlReplicationSlotLag
 	metricPostgresqlRollbacks                metricPostgresqlRollbacks
 	metricPostgresqlRows                     metricPostgresqlRows
 	metricPostgresqlTableCount               metricPostgresqlTableCount
@@ -1542,7 +1859,12 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricPostgresqlIndexScans:               newMetricPostgresqlIndexScans(settings.PostgresqlIndexScans),
 		metricPostgresqlIndexSize:                newMetricPostgresqlIndexSize(settings.PostgresqlIndexSize),
 		metricPostgresqlOperations:               newMetricPostgresqlOperations(settings.PostgresqlOperations),
+		metricPostgresqlQueryCalls:               newMetricPostgresqlQueryCalls(settings.PostgresqlQueryCalls),
+		metricPostgresqlQueryDurationMean:        newMetricPostgresqlQueryDurationMean(settings.PostgresqlQueryDurationMean),
+		metricPostgresqlQueryDurationTotal:       newMetricPostgresqlQueryDurationTotal(settings.PostgresqlQueryDurationTotal),
+		metricPostgresqlQueryRows:                newMetricPostgresqlQueryRows(settings.PostgresqlQueryRows),
 		metricPostgresqlReplicationDataDelay:     newMetricPostgresqlReplicationDataDelay(settings.PostgresqlReplicationDataDelay),
+		metricPostgresqlReplicationSlotLag:       newMetricPostgresqlReplicationSlotLag(settings.PostgresqlReplicationSlotLag),
 		metricPostgresqlRollbacks:                newMetricPostgresqlRollbacks(settings.PostgresqlRollbacks),
 		metricPostgresqlRows:                     newMetricPostgresqlRows(settings.PostgresqlRows),
 		metricPostgresqlTableCount:               newMetricPostgresqlTableCount(settings.PostgresqlTableCount),
@@ -1637,7 +1959,12 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricPostgresqlIndexScans.emit(ils.Metrics())
 	mb.metricPostgresqlIndexSize.emit(ils.Metrics())
 	mb.metricPostgresqlOperations.emit(ils.Metrics())
+	mb.metricPostgresqlQueryCalls.emit(ils.Metrics())
+	mb.metricPostgresqlQueryDurationMean.emit(ils.Metrics())
+	mb.metricPostgresqlQueryDurationTotal.emit(ils.Metrics())
+	mb.metricPostgresqlQueryRows.emit(ils.Metrics())
 	mb.metricPostgresqlReplicationDataDelay.emit(ils.Metrics())
+	mb.metricPostgresqlReplicationSlotLag.emit(ils.Metrics())
 	mb.metricPostgresqlRollbacks.emit(ils.Metrics())
 	mb.metricPostgresqlRows.emit(ils.Metrics())
 	mb.metricPostgresqlTableCount.emit(ils.Metrics())
@@ -1734,11 +2061,36 @@ func (mb *MetricsBuilder) RecordPostgresqlOperationsDataPoint(ts pcommon.Timesta
 	mb.metricPostgresqlOperations.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue, tableAttributeValue, operationAttributeValue.String())
 }
 
+// RecordPostgresqlQueryCallsDataPoint adds a data point to postgresql.query.calls metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryCallsDataPoint(ts pcommon.Timestamp, val int64, queryIDAttributeValue string, queryAttributeValue string) {
+	mb.metricPostgresqlQueryCalls.recordDataPoint(mb.startTime, ts, val, queryIDAttributeValue, queryAttributeValue)
+}
+
+// RecordPostgresqlQueryDurationMeanDataPoint adds a data point to postgresql.query.duration.mean metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryDurationMeanDataPoint(ts pcommon.Timestamp, val float64, queryIDAttributeValue string, queryAttributeValue string) {
+	mb.metricPostgresqlQueryDurationMean.recordDataPoint(mb.startTime, ts, val, queryIDAttributeValue, queryAttributeValue)
+}
+
+// RecordPostgresqlQueryDurationTotalDataPoint adds a data point to postgresql.query.duration.total metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryDurationTotalDataPoint(ts pcommon.Timestamp, val float64, queryIDAttributeValue string, queryAttributeValue string) {
+	mb.metricPostgresqlQueryDurationTotal.recordDataPoint(mb.startTime, ts, val, queryIDAttributeValue, queryAttributeValue)
+}
+
+// RecordPostgresqlQueryRowsDataPoint adds a data point to postgresql.query.rows metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryRowsDataPoint(ts pcommon.Timestamp, val int64, queryIDAttributeValue string, queryAttributeValue string) {
+	mb.metricPostgresqlQueryRows.recordDataPoint(mb.startTime, ts, val, queryIDAttributeValue, queryAttributeValue)
+}
+
 // RecordPostgresqlReplicationDataDelayDataPoint adds a data point to postgresql.replication.data_delay metric.
 func (mb *MetricsBuilder) RecordPostgresqlReplicationDataDelayDataPoint(ts pcommon.Timestamp, val int64, replicationClientAttributeValue string) {
 	mb.metricPostgresqlReplicationDataDelay.recordDataPoint(mb.startTime, ts, val, replicationClientAttributeValue)
 }
 
+// RecordPostgresqlReplicationSlotLagDataPoint adds a data point to postgresql.replication.slot.lag metric.
+func (mb *MetricsBuilder) RecordPostgresqlReplicationSlotLagDataPoint(ts pcommon.Timestamp, val int64, replicationSlotAttributeValue string, replicationSlotTypeAttributeValue AttributeReplicationSlotType) {
+	mb.metricPostgresqlReplicationSlotLag.recordDataPoint(mb.startTime, ts, val, replicationSlotAttributeValue, replicationSlotTypeAttributeValue.String())
+}
+
 // RecordPostgresqlRollbacksDataPoint adds a data point to postgresql.rollbacks metric.
 func (mb *MetricsBuilder) RecordPostgresqlRollbacksDataPoint(ts pcommon.Timestamp, val int64, databaseAttributeValue string) {
 	mb.metricPostgresqlRollbacks.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue)
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -137,6 +138,11 @@ func (p *postgreSQLScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 	}
 	p.retrieveDBMetrics(ctx, listClient, databases, r, &errs)
 
+	var queryStatsByDB map[databaseName][]queryStats
+	if p.emitMetricsWithResourceAttributes && p.queryStatsEnabled() {
+		queryStatsByDB = p.retrieveQueryStats(ctx, listClient, &errs)
+	}
+
 	for _, database := range databases {
 		dbClient, err := p.clientFactory.getClient(p.config, database)
 		if err != nil {
@@ -147,6 +153,7 @@ func (p *postgreSQLScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 		defer dbClient.Close()
 		numTables := p.collectTables(ctx, now, dbClient, database, &errs)
 
+		p.recordQueryStats(now, queryStatsByDB[databaseName(database)])
 		p.recordDatabase(now, database, r, numTables)
 
 		if p.emitMetricsWithResourceAttributes {
@@ -159,6 +166,7 @@ func (p *postgreSQLScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 		p.collectBGWriterStats(ctx, now, listClient, &errs)
 		p.collectWalAge(ctx, now, listClient, &errs)
 		p.collectReplicationStats(ctx, now, listClient, &errs)
+		p.collectReplicationSlots(ctx, now, listClient, &errs)
 		p.collectMaxConnections(ctx, now, listClient, &errs)
 	}
 
@@ -357,6 +365,72 @@ func (p *postgreSQLScraper) collectReplicationStats(
 	}
 }
 
+func (p *postgreSQLScraper) collectReplicationSlots(
+	ctx context.Context,
+	now pcommon.Timestamp,
+	client client,
+	errs *scrapererror.ScrapeErrors,
+) {
+	if !p.config.Metrics.PostgresqlReplicationSlotLag.Enabled {
+		return
+	}
+
+	slots, err := client.getReplicationSlots(ctx)
+	if err != nil {
+		errs.AddPartial(1, err)
+		return
+	}
+	for _, slot := range slots {
+		slotType, ok := metadata.MapAttributeReplicationSlotType[slot.slotType]
+		if !ok {
+			p.logger.Debug("Skipping replication slot with unknown type", zap.String("slot", slot.name), zap.String("type", slot.slotType))
+			continue
+		}
+		p.mb.RecordPostgresqlReplicationSlotLagDataPoint(now, slot.lagBytes, slot.name, slotType)
+	}
+}
+
+func (p *postgreSQLScraper) queryStatsEnabled() bool {
+	return p.config.Metrics.PostgresqlQueryCalls.Enabled ||
+		p.config.Metrics.PostgresqlQueryDurationMean.Enabled ||
+		p.config.Metrics.PostgresqlQueryDurationTotal.Enabled ||
+		p.config.Metrics.PostgresqlQueryRows.Enabled
+}
+
+func (p *postgreSQLScraper) retrieveQueryStats(
+	ctx context.Context,
+	client client,
+	errs *scrapererror.ScrapeErrors,
+) map[databaseName][]queryStats {
+	stats, err := client.getQueryStats(ctx, p.config.Databases, p.config.QueryStats.TopN)
+	if err != nil {
+		p.logger.Error("Errors encountered while fetching query statistics", zap.Error(err))
+		errs.AddPartial(1, err)
+	}
+	return stats
+}
+
+// recordQueryStats records the statement statistics of a database, they are emitted along with the rest of the
+// database level metrics.
+func (p *postgreSQLScraper) recordQueryStats(now pcommon.Timestamp, stats []queryStats) {
+	for _, qs := range stats {
+		query := truncateQuery(qs.query, p.config.QueryStats.MaxQueryLength)
+		p.mb.RecordPostgresqlQueryCallsDataPoint(now, qs.calls, qs.queryID, query)
+		p.mb.RecordPostgresqlQueryDurationTotalDataPoint(now, qs.totalTime, qs.queryID, query)
+		p.mb.RecordPostgresqlQueryDurationMeanDataPoint(now, qs.meanTime, qs.queryID, query)
+		p.mb.RecordPostgresqlQueryRowsDataPoint(now, qs.rows, qs.queryID, query)
+	}
+}
+
+// truncateQuery collapses the whitespace of a normalized statement and shortens it to at most maxLength characters.
+func truncateQuery(query string, maxLength int) string {
+	query = strings.Join(strings.Fields(query), " ")
+	if runes := []rune(query); len(runes) > maxLength {
+		return string(runes[:maxLength])
+	}
+	return query
+}
+
 func (p *postgreSQLScraper) collectWalAge(
 	ctx context.Context,
 	now pcommon.Timestamp,
@@ -136,6 +136,61 @@ func TestScraperWithResourceAttributeFeatureGateSingle(t *testing.T) {
 	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
 }
 
+func TestScraperQueryStatsAndReplicationSlots(t *testing.T) {
+	factory := mockClientFactory{}
+	factory.initMocks([]string{"otel", "open"})
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.QueryStats.MaxQueryLength = 24
+	cfg.Metrics.PostgresqlQueryCalls.Enabled = true
+	cfg.Metrics.PostgresqlQueryDurationTotal.Enabled = true
+	cfg.Metrics.PostgresqlQueryDurationMean.Enabled = true
+	cfg.Metrics.PostgresqlQueryRows.Enabled = true
+	cfg.Metrics.PostgresqlReplicationSlotLag.Enabled = true
+	scraper := newPostgreSQLScraper(componenttest.NewNopReceiverCreateSettings(), cfg, &factory)
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	queryCallsByDB := map[string]int64{}
+	slotLag := map[string]int64{}
+	rms := actualMetrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			m := ms.At(j)
+			switch m.Name() {
+			case "postgresql.query.calls":
+				db, ok := rm.Resource().Attributes().Get("postgresql.database.name")
+				require.True(t, ok)
+				require.Equal(t, 1, m.Sum().DataPoints().Len())
+				dp := m.Sum().DataPoints().At(0)
+				query, ok := dp.Attributes().Get("query")
+				require.True(t, ok)
+				require.Equal(t, "SELECT * FROM public.tab", query.Str())
+				queryCallsByDB[db.Str()] = dp.IntValue()
+			case "postgresql.replication.slot.lag":
+				for k := 0; k < m.Gauge().DataPoints().Len(); k++ {
+					dp := m.Gauge().DataPoints().At(k)
+					slot, ok := dp.Attributes().Get("replication_slot")
+					require.True(t, ok)
+					slotLag[slot.Str()] = dp.IntValue()
+				}
+			}
+		}
+	}
+
+	require.Equal(t, map[string]int64{"otel": 50, "open": 51}, queryCallsByDB)
+	require.Equal(t, map[string]int64{"standby_1": 2048, "cdc": 4096}, slotLag)
+}
+
+func TestTruncateQuery(t *testing.T) {
+	require.Equal(t, "SELECT $1", truncateQuery("SELECT\n  $1", 1024))
+	require.Equal(t, "SELECT", truncateQuery("SELECT * FROM t", 6))
+	require.Equal(t, "SELECT ü", truncateQuery("SELECT üö", 8))
+}
+
 type mockClientFactory struct{ mock.Mock }
 type mockClient struct{ mock.Mock }
 
@@ -196,6 +251,16 @@ func (m *mockClient) getReplicationStats(ctx context.Context) ([]replicationStat
 	return args.Get(0).([]replicationStats), args.Error(1)
 }
 
+func (m *mockClient) getReplicationSlots(ctx context.Context) ([]replicationSlot, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]replicationSlot), args.Error(1)
+}
+
+func (m *mockClient) getQueryStats(ctx context.Context, databases []string, limit int) (map[databaseName][]queryStats, error) {
+	args := m.Called(ctx, databases, limit)
+	return args.Get(0).(map[databaseName][]queryStats), args.Error(1)
+}
+
 func (m *mockClient) listDatabases(_ context.Context) ([]string, error) {
 	args := m.Called()
 	return args.Get(0).([]string), args.Error(1)
@@ -264,6 +329,33 @@ func (m *mockClient) initMocks(database string, databases []string, index int) {
 				writeLag:     800,
 			},
 		}, nil)
+		m.On("getReplicationSlots", mock.Anything).Return([]replicationSlot{
+			{
+				name:     "standby_1",
+				slotType: "physical",
+				lagBytes: 2048,
+			},
+			{
+				name:     "cdc",
+				slotType: "logical",
+				lagBytes: 4096,
+			},
+		}, nil)
+
+		queryStatsByDB := map[databaseName][]queryStats{}
+		for idx, db := range databases {
+			queryStatsByDB[databaseName(db)] = []queryStats{
+				{
+					queryID:   fmt.Sprintf("%d", idx+1000),
+					query:     "SELECT *\n\tFROM public.table1\n\tWHERE id = $1",
+					calls:     int64(idx + 50),
+					totalTime: float64(idx) + 51.5,
+					meanTime:  float64(idx) + 1.25,
+					rows:      int64(idx + 52),
+				},
+			}
+		}
+		m.On("getQueryStats", mock.Anything, mock.Anything, mock.Anything).Return(queryStatsByDB, nil)
 	} else {
 		table1 := "public.table1"
 		table2 := "public.table2"
@@ -32,6 +32,7 @@ import (
 	"github.com/prometheus/prometheus/discovery/kubernetes"
 	"github.com/prometheus/prometheus/discovery/targetgroup"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/confmap"
 	"gopkg.in/yaml.v2"
 )
@@ -62,6 +63,10 @@ type Config struct {
 
 	TargetAllocator *targetAllocator `mapstructure:"target_allocator"`
 
+	// SDRefresh configures an HTTP endpoint that forces the HTTP service discoveries,
+	// including the ones created for the target allocator, to refresh their targets on POST requests.
+	SDRefresh *confighttp.HTTPServerSettings `mapstructure:"sd_refresh"`
+
 	// ConfigPlaceholder is just an entry to make the configuration pass a check
 	// that requires that all keys present in the config actually exist on the
 	// structure, ie.: it will error if an unknown key is present.
@@ -91,6 +96,15 @@ func checkFile(fn string) error {
 	return err
 }
 
+func checkHTTPClientConfig(httpConfig commonconfig.HTTPClientConfig) error {
+	if httpConfig.Authorization != nil {
+		if err := checkFile(httpConfig.Authorization.CredentialsFile); err != nil {
+			return fmt.Errorf("error checking authorization credentials file %q: %w", httpConfig.Authorization.CredentialsFile, err)
+		}
+	}
+	return checkTLSConfig(httpConfig.TLSConfig)
+}
+
 func checkTLSConfig(tlsConfig commonconfig.TLSConfig) error {
 	if err := checkFile(tlsConfig.CertFile); err != nil {
 		return fmt.Errorf("error checking client cert file %q: %w", tlsConfig.CertFile, err)
@@ -154,6 +168,10 @@ func (cfg *Config) Validate() error {
 			return err
 		}
 	}
+
+	if cfg.SDRefresh != nil && cfg.SDRefresh.Endpoint == "" {
+		return errors.New("sd_refresh endpoint must be set")
+	}
 	return nil
 }
 
@@ -196,13 +214,7 @@ func (cfg *Config) validatePromConfig(promConfig *promconfig.Config) error {
 			}
 		}
 
-		if sc.HTTPClientConfig.Authorization != nil {
-			if err := checkFile(sc.HTTPClientConfig.Authorization.CredentialsFile); err != nil {
-				return fmt.Errorf("error checking authorization credentials file %q: %w", sc.HTTPClientConfig.Authorization.CredentialsFile, err)
-			}
-		}
-
-		if err := checkTLSConfig(sc.HTTPClientConfig.TLSConfig); err != nil {
+		if err := checkHTTPClientConfig(sc.HTTPClientConfig); err != nil {
 			return err
 		}
 
@@ -212,6 +224,10 @@ func (cfg *Config) validatePromConfig(promConfig *promconfig.Config) error {
 				if err := checkTLSConfig(c.HTTPClientConfig.TLSConfig); err != nil {
 					return err
 				}
+			case *promHTTP.SDConfig:
+				if err := checkHTTPClientConfig(c.HTTPClientConfig); err != nil {
+					return fmt.Errorf("checking http_sd_configs in scrape job %q: %w", sc.JobName, err)
+				}
 			case *file.SDConfig:
 				for _, file := range c.Files {
 					files, err := filepath.Glob(file)
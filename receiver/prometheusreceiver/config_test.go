@@ -22,6 +22,7 @@ import (
 
 	promConfig "github.com/prometheus/common/config"
 	promModel "github.com/prometheus/common/model"
+	promHTTP "github.com/prometheus/prometheus/discovery/http"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/config"
@@ -317,3 +318,62 @@ func TestFileSDConfigYamlNilTargetGroup(t *testing.T) {
 	gotErrMsg := err.Error()
 	require.Equal(t, wantErrMsg, gotErrMsg)
 }
+
+func TestLoadSDRefreshConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config_sd_refresh.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(config.NewComponentIDWithName(typeStr, "").String())
+	require.NoError(t, err)
+	require.NoError(t, config.UnmarshalReceiver(sub, cfg))
+	require.NoError(t, cfg.Validate())
+
+	r0 := cfg.(*Config)
+	require.NotNil(t, r0.SDRefresh)
+	assert.Equal(t, "localhost:9091", r0.SDRefresh.Endpoint)
+
+	require.Len(t, r0.PrometheusConfig.ScrapeConfigs, 1)
+	sdConfigs := r0.PrometheusConfig.ScrapeConfigs[0].ServiceDiscoveryConfigs
+	require.Len(t, sdConfigs, 1)
+	httpSD, ok := sdConfigs[0].(*promHTTP.SDConfig)
+	require.True(t, ok)
+	assert.Equal(t, "http://localhost:8080/targets", httpSD.URL)
+	assert.Equal(t, promModel.Duration(5*time.Minute), httpSD.RefreshInterval)
+	assert.Equal(t, "scraper", httpSD.HTTPClientConfig.BasicAuth.Username)
+	assert.Equal(t, promConfig.Secret("changeme"), httpSD.HTTPClientConfig.BasicAuth.Password)
+}
+
+func TestSDRefreshConfigWithoutEndpoint(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "invalid-config-sd-refresh.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(config.NewComponentIDWithName(typeStr, "").String())
+	require.NoError(t, err)
+	require.NoError(t, config.UnmarshalReceiver(sub, cfg))
+
+	err = cfg.Validate()
+	require.EqualError(t, err, "sd_refresh endpoint must be set")
+}
+
+func TestHTTPSDConfigNonExistentAuthCredentialsFile(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "invalid-config-prometheus-http-sd-config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(config.NewComponentIDWithName(typeStr, "").String())
+	require.NoError(t, err)
+	require.NoError(t, config.UnmarshalReceiver(sub, cfg))
+
+	err = cfg.Validate()
+	require.NotNil(t, err, "Expected a non-nil error")
+
+	wantErrMsg := `checking http_sd_configs in scrape job "demo": error checking authorization credentials file "/nonexistentauthcredentialsfile"`
+
+	gotErrMsg := err.Error()
+	require.True(t, strings.HasPrefix(gotErrMsg, wantErrMsg))
+}
@@ -17,6 +17,7 @@ package prometheusreceiver // import "github.com/open-telemetry/opentelemetry-co
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -58,6 +59,10 @@ type pReceiver struct {
 	settings         component.ReceiverCreateSettings
 	scrapeManager    *scrape.Manager
 	discoveryManager *discovery.Manager
+
+	sdRefreshNotifier *refreshNotifier
+	sdRefreshServer   *http.Server
+	shutdownWG        sync.WaitGroup
 }
 
 // New creates a new prometheus.Receiver reference.
@@ -89,6 +94,13 @@ func (r *pReceiver) Start(_ context.Context, host component.Host) error {
 		return err
 	}
 
+	if r.cfg.SDRefresh != nil {
+		err = r.startSDRefreshServer(host)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = r.applyCfg(baseCfg)
 	if err != nil {
 		r.settings.Logger.Error("Failed to apply new scrape configuration", zap.Error(err))
@@ -138,6 +150,33 @@ func (r *pReceiver) startTargetAllocator(allocConf *targetAllocator, baseCfg *co
 	return nil
 }
 
+// startSDRefreshServer starts the HTTP server forcing the HTTP service discoveries to refresh their targets.
+func (r *pReceiver) startSDRefreshServer(host component.Host) error {
+	r.sdRefreshNotifier = newRefreshNotifier()
+
+	ln, err := r.cfg.SDRefresh.ToListener()
+	if err != nil {
+		return fmt.Errorf("failed to bind to address %s: %w", r.cfg.SDRefresh.Endpoint, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(sdRefreshPath, r.sdRefreshNotifier)
+	r.sdRefreshServer, err = r.cfg.SDRefresh.ToServer(host, r.settings.TelemetrySettings, mux)
+	if err != nil {
+		return err
+	}
+
+	r.settings.Logger.Info("Starting service discovery refresh server", zap.String("endpoint", r.cfg.SDRefresh.Endpoint))
+	r.shutdownWG.Add(1)
+	go func() {
+		defer r.shutdownWG.Done()
+		if errHTTP := r.sdRefreshServer.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+			host.ReportFatalError(errHTTP)
+		}
+	}()
+	return nil
+}
+
 // syncTargetAllocator request jobs from targetAllocator and update underlying receiver, if the response does not match the provided compareHash.
 // baseDiscoveryCfg can be used to provide additional ScrapeConfigs which will be added to the retrieved jobs.
 func (r *pReceiver) syncTargetAllocator(compareHash uint64, allocConf *targetAllocator, baseCfg *config.Config) (uint64, error) {
@@ -235,7 +274,11 @@ func (r *pReceiver) applyCfg(cfg *config.Config) error {
 
 	discoveryCfg := make(map[string]discovery.Configs)
 	for _, scrapeConfig := range cfg.ScrapeConfigs {
-		discoveryCfg[scrapeConfig.JobName] = scrapeConfig.ServiceDiscoveryConfigs
+		sdConfigs := scrapeConfig.ServiceDiscoveryConfigs
+		if r.sdRefreshNotifier != nil {
+			sdConfigs = withRefreshableHTTPSD(sdConfigs, r.sdRefreshNotifier, r.settings.Logger)
+		}
+		discoveryCfg[scrapeConfig.JobName] = sdConfigs
 		r.settings.Logger.Info("Scrape job added", zap.String("jobName", scrapeConfig.JobName))
 	}
 	if err := r.discoveryManager.ApplyConfig(discoveryCfg); err != nil {
@@ -308,5 +351,10 @@ func (r *pReceiver) Shutdown(context.Context) error {
 	r.cancelFunc()
 	r.scrapeManager.Stop()
 	close(r.targetAllocatorStop)
+	if r.sdRefreshServer != nil {
+		err := r.sdRefreshServer.Close()
+		r.shutdownWG.Wait()
+		return err
+	}
 	return nil
 }
@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver"
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/discovery"
+	promHTTP "github.com/prometheus/prometheus/discovery/http"
+	"github.com/prometheus/prometheus/discovery/targetgroup"
+	"go.uber.org/zap"
+)
+
+// sdRefreshPath is the path of the endpoint forcing the HTTP service discoveries to refresh their targets.
+const sdRefreshPath = "/refresh"
+
+// refreshNotifier broadcasts the requests to refresh the HTTP service discovery targets.
+type refreshNotifier struct {
+	mu sync.Mutex
+	ch chan struct{}
+}
+
+func newRefreshNotifier() *refreshNotifier {
+	return &refreshNotifier{ch: make(chan struct{})}
+}
+
+// refreshed returns a channel that is closed on the next refresh request.
+func (n *refreshNotifier) refreshed() <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.ch
+}
+
+// refresh notifies all the discoverers waiting for a refresh request.
+func (n *refreshNotifier) refresh() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	close(n.ch)
+	n.ch = make(chan struct{})
+}
+
+// ServeHTTP forces a refresh of the HTTP service discoveries on POST requests.
+func (n *refreshNotifier) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "only POST requests are allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	n.refresh()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// refreshableHTTPSDConfig is a HTTP service discovery configuration
+// whose targets can also be refreshed on demand, in between the refresh intervals.
+type refreshableHTTPSDConfig struct {
+	*promHTTP.SDConfig
+	notifier *refreshNotifier
+	logger   *zap.Logger
+}
+
+var _ discovery.Config = (*refreshableHTTPSDConfig)(nil)
+
+// NewDiscoverer returns a discoverer refreshing its targets periodically and on refresh requests.
+func (c *refreshableHTTPSDConfig) NewDiscoverer(opts discovery.DiscovererOptions) (discovery.Discoverer, error) {
+	d, err := c.SDConfig.NewDiscoverer(opts)
+	if err != nil {
+		return nil, err
+	}
+	httpDiscovery, ok := d.(*promHTTP.Discovery)
+	if !ok {
+		return d, nil
+	}
+	return &refreshableDiscoverer{
+		Discovery: httpDiscovery,
+		interval:  time.Duration(c.RefreshInterval),
+		notifier:  c.notifier,
+		logger:    c.logger.With(zap.String("url", c.URL)),
+	}, nil
+}
+
+type refreshableDiscoverer struct {
+	*promHTTP.Discovery
+	interval time.Duration
+	notifier *refreshNotifier
+	logger   *zap.Logger
+}
+
+// Run sends the target groups of the periodic refreshes, as well as the ones of the refresh requests.
+// Both are done by the same goroutine, instead of the one of Discovery.Run, so that they never run concurrently.
+func (d *refreshableDiscoverer) Run(ctx context.Context, ch chan<- []*targetgroup.Group) {
+	// Wait on the notification channel before the first refresh, so that no request is missed.
+	refreshed := d.notifier.refreshed()
+	if !d.refresh(ctx, ch) {
+		return
+	}
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-refreshed:
+			refreshed = d.notifier.refreshed()
+		}
+		if !d.refresh(ctx, ch) {
+			return
+		}
+	}
+}
+
+// refresh sends the current target groups to ch, it returns false once ctx is done.
+func (d *refreshableDiscoverer) refresh(ctx context.Context, ch chan<- []*targetgroup.Group) bool {
+	tgs, err := d.Refresh(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return false
+		}
+		d.logger.Error("Failed to refresh the HTTP service discovery targets", zap.Error(err))
+		return true
+	}
+	select {
+	case ch <- tgs:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// withRefreshableHTTPSD returns the service discovery configurations, with the HTTP service discoveries
+// wrapped so that they can be refreshed by the notifier.
+func withRefreshableHTTPSD(configs discovery.Configs, notifier *refreshNotifier, logger *zap.Logger) discovery.Configs {
+	wrapped := make(discovery.Configs, 0, len(configs))
+	for _, c := range configs {
+		if httpSD, ok := c.(*promHTTP.SDConfig); ok {
+			c = &refreshableHTTPSDConfig{SDConfig: httpSD, notifier: notifier, logger: logger}
+		}
+		wrapped = append(wrapped, c)
+	}
+	return wrapped
+}
@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/discovery"
+	"github.com/prometheus/prometheus/discovery/file"
+	promHTTP "github.com/prometheus/prometheus/discovery/http"
+	"github.com/prometheus/prometheus/discovery/targetgroup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRefreshNotifierServeHTTP(t *testing.T) {
+	notifier := newRefreshNotifier()
+	refreshed := notifier.refreshed()
+
+	rec := httptest.NewRecorder()
+	notifier.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, sdRefreshPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	select {
+	case <-refreshed:
+		t.Fatal("GET requests must not refresh the service discoveries")
+	default:
+	}
+
+	rec = httptest.NewRecorder()
+	notifier.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, sdRefreshPath, nil))
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	select {
+	case <-refreshed:
+	default:
+		t.Fatal("POST requests must refresh the service discoveries")
+	}
+	assert.NotEqual(t, refreshed, notifier.refreshed(), "the next refresh must be notified on a new channel")
+}
+
+func TestWithRefreshableHTTPSD(t *testing.T) {
+	notifier := newRefreshNotifier()
+	httpSD := &promHTTP.SDConfig{URL: "http://localhost:8080/targets"}
+	fileSD := &file.SDConfig{Files: []string{"targets.json"}}
+
+	configs := withRefreshableHTTPSD(discovery.Configs{httpSD, fileSD}, notifier, zap.NewNop())
+	require.Len(t, configs, 2)
+
+	wrapped, ok := configs[0].(*refreshableHTTPSDConfig)
+	require.True(t, ok)
+	assert.Equal(t, httpSD, wrapped.SDConfig)
+	assert.Equal(t, notifier, wrapped.notifier)
+	assert.Equal(t, "http", wrapped.Name())
+	assert.Equal(t, fileSD, configs[1])
+}
+
+func TestRefreshableDiscoverer(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"targets": ["localhost:9090"], "labels": {"env": "test"}}]`))
+	}))
+	defer server.Close()
+
+	sdConfig := promHTTP.DefaultSDConfig
+	sdConfig.URL = server.URL
+	// the periodic refreshes must not interfere with the test
+	sdConfig.RefreshInterval = model.Duration(time.Hour)
+
+	notifier := newRefreshNotifier()
+	cfg := &refreshableHTTPSDConfig{SDConfig: &sdConfig, notifier: notifier, logger: zap.NewNop()}
+	discoverer, err := cfg.NewDiscoverer(discovery.DiscovererOptions{Logger: log.NewNopLogger()})
+	require.NoError(t, err)
+	require.IsType(t, &refreshableDiscoverer{}, discoverer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := make(chan []*targetgroup.Group)
+	go discoverer.Run(ctx, ch)
+
+	receive := func() []*targetgroup.Group {
+		select {
+		case tgs := <-ch:
+			return tgs
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for target groups")
+			return nil
+		}
+	}
+
+	tgs := receive()
+	require.Len(t, tgs, 1)
+	require.Len(t, tgs[0].Targets, 1)
+	assert.Equal(t, model.LabelValue("localhost:9090"), tgs[0].Targets[0][model.AddressLabel])
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	notifier.refresh()
+	tgs = receive()
+	require.Len(t, tgs, 1)
+	assert.Equal(t, model.LabelValue("test"), tgs[0].Labels["env"])
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestRefreshableDiscovererSerializesRefreshes(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"targets": ["localhost:9090"]}]`))
+	}))
+	defer server.Close()
+
+	sdConfig := promHTTP.DefaultSDConfig
+	sdConfig.URL = server.URL
+	// the periodic refreshes overlap with the refresh requests
+	sdConfig.RefreshInterval = model.Duration(time.Millisecond)
+
+	notifier := newRefreshNotifier()
+	cfg := &refreshableHTTPSDConfig{SDConfig: &sdConfig, notifier: notifier, logger: zap.NewNop()}
+	discoverer, err := cfg.NewDiscoverer(discovery.DiscovererOptions{Logger: log.NewNopLogger()})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := make(chan []*targetgroup.Group)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		discoverer.Run(ctx, ch)
+	}()
+
+	for i := 0; i < 50; i++ {
+		notifier.refresh()
+		select {
+		case <-ch:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for target groups")
+		}
+	}
+	cancel()
+	<-done
+	assert.Equal(t, int32(1), atomic.LoadInt32(&maxInFlight), "the refreshes must not run concurrently")
+}
@@ -27,12 +27,18 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/models"
 )
 
-// queuePath is the path to queues endpoint
-const queuePath = "/api/queues"
+const (
+	// queuePath is the path to queues endpoint
+	queuePath = "/api/queues"
+	// nodePath is the path to nodes endpoint
+	nodePath = "/api/nodes"
+)
 
 type client interface {
 	// GetQueues calls "/api/queues" endpoint to get list of queues for the target node
 	GetQueues(ctx context.Context) ([]*models.Queue, error)
+	// GetNodes calls "/api/nodes" endpoint to get list of nodes in the cluster
+	GetNodes(ctx context.Context) ([]*models.Node, error)
 }
 
 var _ client = (*rabbitmqClient)(nil)
@@ -77,6 +83,17 @@ func (c *rabbitmqClient) GetQueues(ctx context.Context) ([]*models.Queue, error)
 	return queues, nil
 }
 
+func (c *rabbitmqClient) GetNodes(ctx context.Context) ([]*models.Node, error) {
+	var nodes []*models.Node
+
+	if err := c.get(ctx, nodePath, &nodes); err != nil {
+		c.logger.Debug("Failed to retrieve nodes", zap.Error(err))
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
 func (c *rabbitmqClient) get(ctx context.Context, path string, respObj interface{}) error {
 	// Construct endpoint and create request
 	url := c.hostEndpoint + path
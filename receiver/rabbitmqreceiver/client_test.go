@@ -36,6 +36,7 @@ import (
 
 const (
 	queuesAPIResponseFile = "get_queues_response.json"
+	nodesAPIResponseFile  = "get_nodes_response.json"
 )
 
 func TestNewClient(t *testing.T) {
@@ -170,6 +171,64 @@ func TestGetQueuesDetails(t *testing.T) {
 	}
 }
 
+func TestGetNodesDetails(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				nodes, err := tc.GetNodes(context.Background())
+				require.Nil(t, nodes)
+				require.EqualError(t, err, "non 200 code returned 401")
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, nodesAPIResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, nodePath, r.URL.Path)
+					_, err := w.Write(data)
+					require.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				nodes, err := tc.GetNodes(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, []*models.Node{
+					{
+						Name:          "rabbit@66a063ecff83",
+						FDUsed:        37,
+						FDTotal:       1048576,
+						MemUsed:       3312644096,
+						MemLimit:      3289763840,
+						MemAlarm:      true,
+						DiskFreeAlarm: false,
+					},
+				}, nodes)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
 func createTestClient(t *testing.T, baseEndpoint string) client {
 	t.Helper()
 	cfg := createDefaultConfig().(*Config)
@@ -32,6 +32,8 @@ var (
 	errMissingPassword = errors.New(`"password" not specified in config`)
 
 	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>`)
+
+	errInvalidQueueFilter = errors.New(`"queues" contains an invalid regular expression`)
 )
 
 const defaultEndpoint = "http://localhost:15672"
@@ -43,6 +45,16 @@ type Config struct {
 	Username                                string                   `mapstructure:"username"`
 	Password                                string                   `mapstructure:"password"`
 	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
+	Queues                                  QueueFilterConfig        `mapstructure:"queues"`
+}
+
+// QueueFilterConfig selects the queues metrics are collected for.
+// A queue is collected if it matches any of the expressions of each non-empty list.
+type QueueFilterConfig struct {
+	// IncludeNames is a list of regular expressions matched against the queue name.
+	IncludeNames []string `mapstructure:"include_names"`
+	// IncludeVhosts is a list of regular expressions matched against the vhost of the queue.
+	IncludeVhosts []string `mapstructure:"include_vhosts"`
 }
 
 // Validate validates the configuration by checking for missing or invalid fields
@@ -62,5 +74,9 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, wrappedErr)
 	}
 
+	if _, filterErr := newQueueFilter(cfg.Queues); filterErr != nil {
+		err = multierr.Append(err, fmt.Errorf("%s: %w", errInvalidQueueFilter.Error(), filterErr))
+	}
+
 	return err
 }
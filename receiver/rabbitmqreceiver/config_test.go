@@ -86,6 +86,20 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf("%s: %w", errInvalidEndpoint, errors.New(`parse "invalid://endpoint:  12efg": invalid port ":  12efg" after host`)),
 			),
 		},
+		{
+			desc: "invalid queue filter",
+			cfg: &Config{
+				Username: "otelu",
+				Password: "otelp",
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: defaultEndpoint,
+				},
+				Queues: QueueFilterConfig{
+					IncludeNames: []string{"orders.*", "("},
+				},
+			},
+			expectedErr: fmt.Errorf("%s: %w", errInvalidQueueFilter, errors.New("error parsing regexp: missing closing ): `(`")),
+		},
 		{
 			desc: "valid config",
 			cfg: &Config{
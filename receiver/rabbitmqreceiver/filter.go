@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rabbitmqreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver"
+
+import (
+	"regexp"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/models"
+)
+
+// queueFilter matches queues against the compiled expressions of a QueueFilterConfig
+type queueFilter struct {
+	names  []*regexp.Regexp
+	vhosts []*regexp.Regexp
+}
+
+// newQueueFilter compiles the filter config. It returns a nil filter if no expressions are configured.
+func newQueueFilter(cfg QueueFilterConfig) (*queueFilter, error) {
+	if len(cfg.IncludeNames) == 0 && len(cfg.IncludeVhosts) == 0 {
+		return nil, nil
+	}
+
+	names, err := compileAll(cfg.IncludeNames)
+	if err != nil {
+		return nil, err
+	}
+	vhosts, err := compileAll(cfg.IncludeVhosts)
+	if err != nil {
+		return nil, err
+	}
+	return &queueFilter{names: names, vhosts: vhosts}, nil
+}
+
+// matches reports whether the queue should be collected. A nil filter matches every queue.
+func (f *queueFilter) matches(queue *models.Queue) bool {
+	if f == nil {
+		return true
+	}
+	return matchAny(f.names, queue.Name) && matchAny(f.vhosts, queue.VHost)
+}
+
+func compileAll(exprs []string) ([]*regexp.Regexp, error) {
+	regexps := make([]*regexp.Regexp, 0, len(exprs))
+	for _, expr := range exprs {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+		regexps = append(regexps, re)
+	}
+	return regexps, nil
+}
+
+// matchAny reports whether s matches any of the expressions, or true if there are none
+func matchAny(regexps []*regexp.Regexp, s string) bool {
+	if len(regexps) == 0 {
+		return true
+	}
+	for _, re := range regexps {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
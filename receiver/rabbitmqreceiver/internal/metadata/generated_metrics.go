@@ -18,11 +18,18 @@ type MetricSettings struct {
 // MetricsSettings provides settings for rabbitmqreceiver metrics.
 type MetricsSettings struct {
 	RabbitmqConsumerCount       MetricSettings `mapstructure:"rabbitmq.consumer.count"`
+	RabbitmqConsumerUtilization MetricSettings `mapstructure:"rabbitmq.consumer.utilization"`
 	RabbitmqMessageAcknowledged MetricSettings `mapstructure:"rabbitmq.message.acknowledged"`
 	RabbitmqMessageCurrent      MetricSettings `mapstructure:"rabbitmq.message.current"`
 	RabbitmqMessageDelivered    MetricSettings `mapstructure:"rabbitmq.message.delivered"`
 	RabbitmqMessageDropped      MetricSettings `mapstructure:"rabbitmq.message.dropped"`
 	RabbitmqMessagePublished    MetricSettings `mapstructure:"rabbitmq.message.published"`
+	RabbitmqMessageRate         MetricSettings `mapstructure:"rabbitmq.message.rate"`
+	RabbitmqNodeAlarm           MetricSettings `mapstructure:"rabbitmq.node.alarm"`
+	RabbitmqNodeFdLimit         MetricSettings `mapstructure:"rabbitmq.node.fd.limit"`
+	RabbitmqNodeFdUsed          MetricSettings `mapstructure:"rabbitmq.node.fd.used"`
+	RabbitmqNodeMemoryLimit     MetricSettings `mapstructure:"rabbitmq.node.memory.limit"`
+	RabbitmqNodeMemoryUsed      MetricSettings `mapstructure:"rabbitmq.node.memory.used"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -30,6 +37,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		RabbitmqConsumerCount: MetricSettings{
 			Enabled: true,
 		},
+		RabbitmqConsumerUtilization: MetricSettings{
+			Enabled: false,
+		},
 		RabbitmqMessageAcknowledged: MetricSettings{
 			Enabled: true,
 		},
@@ -45,9 +55,61 @@ func DefaultMetricsSettings() MetricsSettings {
 		RabbitmqMessagePublished: MetricSettings{
 			Enabled: true,
 		},
+		RabbitmqMessageRate: MetricSettings{
+			Enabled: false,
+		},
+		RabbitmqNodeAlarm: MetricSettings{
+			Enabled: false,
+		},
+		RabbitmqNodeFdLimit: MetricSettings{
+			Enabled: false,
+		},
+		RabbitmqNodeFdUsed: MetricSettings{
+			Enabled: false,
+		},
+		RabbitmqNodeMemoryLimit: MetricSettings{
+			Enabled: false,
+		},
+		RabbitmqNodeMemoryUsed: MetricSettings{
+			Enabled: false,
+		},
 	}
 }
 
+// AttributeMessageOperation specifies the a value message.operation attribute.
+type AttributeMessageOperation int
+
+const (
+	_ AttributeMessageOperation = iota
+	AttributeMessageOperationPublish
+	AttributeMessageOperationDeliver
+	AttributeMessageOperationAck
+	AttributeMessageOperationRedeliver
+)
+
+// String returns the string representation of the AttributeMessageOperation.
+func (av AttributeMessageOperation) String() string {
+	switch av {
+	case AttributeMessageOperationPublish:
+		return "publish"
+	case AttributeMessageOperationDeliver:
+		return "deliver"
+	case AttributeMessageOperationAck:
+		return "ack"
+	case AttributeMessageOperationRedeliver:
+		return "redeliver"
+	}
+	return ""
+}
+
+// MapAttributeMessageOperation is a helper map of string to AttributeMessageOperation attribute value.
+var MapAttributeMessageOperation = map[string]AttributeMessageOperation{
+	"publish":   AttributeMessageOperationPublish,
+	"deliver":   AttributeMessageOperationDeliver,
+	"ack":       AttributeMessageOperationAck,
+	"redeliver": AttributeMessageOperationRedeliver,
+}
+
 // AttributeMessageState specifies the a value message.state attribute.
 type AttributeMessageState int
 
@@ -74,6 +136,32 @@ var MapAttributeMessageState = map[string]AttributeMessageState{
 	"unacknowledged": AttributeMessageStateUnacknowledged,
 }
 
+// AttributeNodeAlarm specifies the a value node.alarm attribute.
+type AttributeNodeAlarm int
+
+const (
+	_ AttributeNodeAlarm = iota
+	AttributeNodeAlarmMemory
+	AttributeNodeAlarmDisk
+)
+
+// String returns the string representation of the AttributeNodeAlarm.
+func (av AttributeNodeAlarm) String() string {
+	switch av {
+	case AttributeNodeAlarmMemory:
+		return "memory"
+	case AttributeNodeAlarmDisk:
+		return "disk"
+	}
+	return ""
+}
+
+// MapAttributeNodeAlarm is a helper map of string to AttributeNodeAlarm attribute value.
+var MapAttributeNodeAlarm = map[string]AttributeNodeAlarm{
+	"memory": AttributeNodeAlarmMemory,
+	"disk":   AttributeNodeAlarmDisk,
+}
+
 type metricRabbitmqConsumerCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -125,6 +213,55 @@ func newMetricRabbitmqConsumerCount(settings MetricSettings) metricRabbitmqConsu
 	return m
 }
 
+type metricRabbitmqConsumerUtilization struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.consumer.utilization metric with initial data.
+func (m *metricRabbitmqConsumerUtilization) init() {
+	m.data.SetName("rabbitmq.consumer.utilization")
+	m.data.SetDescription("The fraction of time the queue is able to immediately deliver messages to consumers.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricRabbitmqConsumerUtilization) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqConsumerUtilization) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqConsumerUtilization) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqConsumerUtilization(settings MetricSettings) metricRabbitmqConsumerUtilization {
+	m := metricRabbitmqConsumerUtilization{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRabbitmqMessageAcknowledged struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -382,6 +519,312 @@ func newMetricRabbitmqMessagePublished(settings MetricSettings) metricRabbitmqMe
 	return m
 }
 
+type metricRabbitmqMessageRate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.message.rate metric with initial data.
+func (m *metricRabbitmqMessageRate) init() {
+	m.data.SetName("rabbitmq.message.rate")
+	m.data.SetDescription("The rate at which operations are performed on messages of the queue.")
+	m.data.SetUnit("{messages}/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqMessageRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, messageOperationAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("operation", messageOperationAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqMessageRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqMessageRate) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqMessageRate(settings MetricSettings) metricRabbitmqMessageRate {
+	m := metricRabbitmqMessageRate{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqNodeAlarm struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.node.alarm metric with initial data.
+func (m *metricRabbitmqNodeAlarm) init() {
+	m.data.SetName("rabbitmq.node.alarm")
+	m.data.SetDescription("Whether the resource alarm of the node is raised, which blocks publishers. 1 if raised, 0 otherwise.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqNodeAlarm) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, nodeAlarmAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("alarm", nodeAlarmAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqNodeAlarm) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqNodeAlarm) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqNodeAlarm(settings MetricSettings) metricRabbitmqNodeAlarm {
+	m := metricRabbitmqNodeAlarm{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqNodeFdLimit struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.node.fd.limit metric with initial data.
+func (m *metricRabbitmqNodeFdLimit) init() {
+	m.data.SetName("rabbitmq.node.fd.limit")
+	m.data.SetDescription("The maximum number of file descriptors the node can use.")
+	m.data.SetUnit("{file_descriptors}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricRabbitmqNodeFdLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqNodeFdLimit) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqNodeFdLimit) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqNodeFdLimit(settings MetricSettings) metricRabbitmqNodeFdLimit {
+	m := metricRabbitmqNodeFdLimit{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqNodeFdUsed struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.node.fd.used metric with initial data.
+func (m *metricRabbitmqNodeFdUsed) init() {
+	m.data.SetName("rabbitmq.node.fd.used")
+	m.data.SetDescription("The number of file descriptors used by the node.")
+	m.data.SetUnit("{file_descriptors}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricRabbitmqNodeFdUsed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqNodeFdUsed) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqNodeFdUsed) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqNodeFdUsed(settings MetricSettings) metricRabbitmqNodeFdUsed {
+	m := metricRabbitmqNodeFdUsed{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqNodeMemoryLimit struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.node.memory.limit metric with initial data.
+func (m *metricRabbitmqNodeMemoryLimit) init() {
+	m.data.SetName("rabbitmq.node.memory.limit")
+	m.data.SetDescription("The memory high watermark of the node, above which publishers are blocked.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricRabbitmqNodeMemoryLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqNodeMemoryLimit) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqNodeMemoryLimit) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqNodeMemoryLimit(settings MetricSettings) metricRabbitmqNodeMemoryLimit {
+	m := metricRabbitmqNodeMemoryLimit{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqNodeMemoryUsed struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.node.memory.used metric with initial data.
+func (m *metricRabbitmqNodeMemoryUsed) init() {
+	m.data.SetName("rabbitmq.node.memory.used")
+	m.data.SetDescription("The memory used by the node.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricRabbitmqNodeMemoryUsed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqNodeMemoryUsed) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqNodeMemoryUsed) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqNodeMemoryUsed(settings MetricSettings) metricRabbitmqNodeMemoryUsed {
+	m := metricRabbitmqNodeMemoryUsed{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
@@ -391,11 +834,18 @@ type MetricsBuilder struct {
 	metricsBuffer                     pmetric.Metrics     // accumulates metrics data before emitting.
 	buildInfo                         component.BuildInfo // contains version information
 	metricRabbitmqConsumerCount       metricRabbitmqConsumerCount
+	metricRabbitmqConsumerUtilization metricRabbitmqConsumerUtilization
 	metricRabbitmqMessageAcknowledged metricRabbitmqMessageAcknowledged
 	metricRabbitmqMessageCurrent      metricRabbitmqMessageCurrent
 	metricRabbitmqMessageDelivered    metricRabbitmqMessageDelivered
 	metricRabbitmqMessageDropped      metricRabbitmqMessageDropped
 	metricRabbitmqMessagePublished    metricRabbitmqMessagePublished
+	metricRabbitmqMessageRate         metricRabbitmqMessageRate
+	metricRabbitmqNodeAlarm           metricRabbitmqNodeAlarm
+	metricRabbitmqNodeFdLimit         metricRabbitmqNodeFdLimit
+	metricRabbitmqNodeFdUsed          metricRabbitmqNodeFdUsed
+	metricRabbitmqNodeMemoryLimit     metricRabbitmqNodeMemoryLimit
+	metricRabbitmqNodeMemoryUsed      metricRabbitmqNodeMemoryUsed
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -414,11 +864,18 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricsBuffer:                     pmetric.NewMetrics(),
 		buildInfo:                         buildInfo,
 		metricRabbitmqConsumerCount:       newMetricRabbitmqConsumerCount(settings.RabbitmqConsumerCount),
+		metricRabbitmqConsumerUtilization: newMetricRabbitmqConsumerUtilization(settings.RabbitmqConsumerUtilization),
 		metricRabbitmqMessageAcknowledged: newMetricRabbitmqMessageAcknowledged(settings.RabbitmqMessageAcknowledged),
 		metricRabbitmqMessageCurrent:      newMetricRabbitmqMessageCurrent(settings.RabbitmqMessageCurrent),
 		metricRabbitmqMessageDelivered:    newMetricRabbitmqMessageDelivered(settings.RabbitmqMessageDelivered),
 		metricRabbitmqMessageDropped:      newMetricRabbitmqMessageDropped(settings.RabbitmqMessageDropped),
 		metricRabbitmqMessagePublished:    newMetricRabbitmqMessagePublished(settings.RabbitmqMessagePublished),
+		metricRabbitmqMessageRate:         newMetricRabbitmqMessageRate(settings.RabbitmqMessageRate),
+		metricRabbitmqNodeAlarm:           newMetricRabbitmqNodeAlarm(settings.RabbitmqNodeAlarm),
+		metricRabbitmqNodeFdLimit:         newMetricRabbitmqNodeFdLimit(settings.RabbitmqNodeFdLimit),
+		metricRabbitmqNodeFdUsed:          newMetricRabbitmqNodeFdUsed(settings.RabbitmqNodeFdUsed),
+		metricRabbitmqNodeMemoryLimit:     newMetricRabbitmqNodeMemoryLimit(settings.RabbitmqNodeMemoryLimit),
+		metricRabbitmqNodeMemoryUsed:      newMetricRabbitmqNodeMemoryUsed(settings.RabbitmqNodeMemoryUsed),
 	}
 	for _, op := range options {
 		op(mb)
@@ -493,11 +950,18 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricRabbitmqConsumerCount.emit(ils.Metrics())
+	mb.metricRabbitmqConsumerUtilization.emit(ils.Metrics())
 	mb.metricRabbitmqMessageAcknowledged.emit(ils.Metrics())
 	mb.metricRabbitmqMessageCurrent.emit(ils.Metrics())
 	mb.metricRabbitmqMessageDelivered.emit(ils.Metrics())
 	mb.metricRabbitmqMessageDropped.emit(ils.Metrics())
 	mb.metricRabbitmqMessagePublished.emit(ils.Metrics())
+	mb.metricRabbitmqMessageRate.emit(ils.Metrics())
+	mb.metricRabbitmqNodeAlarm.emit(ils.Metrics())
+	mb.metricRabbitmqNodeFdLimit.emit(ils.Metrics())
+	mb.metricRabbitmqNodeFdUsed.emit(ils.Metrics())
+	mb.metricRabbitmqNodeMemoryLimit.emit(ils.Metrics())
+	mb.metricRabbitmqNodeMemoryUsed.emit(ils.Metrics())
 	for _, op := range rmo {
 		op(rm)
 	}
@@ -522,6 +986,11 @@ func (mb *MetricsBuilder) RecordRabbitmqConsumerCountDataPoint(ts pcommon.Timest
 	mb.metricRabbitmqConsumerCount.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRabbitmqConsumerUtilizationDataPoint adds a data point to rabbitmq.consumer.utilization metric.
+func (mb *MetricsBuilder) RecordRabbitmqConsumerUtilizationDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricRabbitmqConsumerUtilization.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordRabbitmqMessageAcknowledgedDataPoint adds a data point to rabbitmq.message.acknowledged metric.
 func (mb *MetricsBuilder) RecordRabbitmqMessageAcknowledgedDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricRabbitmqMessageAcknowledged.recordDataPoint(mb.startTime, ts, val)
@@ -547,6 +1016,36 @@ func (mb *MetricsBuilder) RecordRabbitmqMessagePublishedDataPoint(ts pcommon.Tim
 	mb.metricRabbitmqMessagePublished.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRabbitmqMessageRateDataPoint adds a data point to rabbitmq.message.rate metric.
+func (mb *MetricsBuilder) RecordRabbitmqMessageRateDataPoint(ts pcommon.Timestamp, val float64, messageOperationAttributeValue AttributeMessageOperation) {
+	mb.metricRabbitmqMessageRate.recordDataPoint(mb.startTime, ts, val, messageOperationAttributeValue.String())
+}
+
+// RecordRabbitmqNodeAlarmDataPoint adds a data point to rabbitmq.node.alarm metric.
+func (mb *MetricsBuilder) RecordRabbitmqNodeAlarmDataPoint(ts pcommon.Timestamp, val int64, nodeAlarmAttributeValue AttributeNodeAlarm) {
+	mb.metricRabbitmqNodeAlarm.recordDataPoint(mb.startTime, ts, val, nodeAlarmAttributeValue.String())
+}
+
+// RecordRabbitmqNodeFdLimitDataPoint adds a data point to rabbitmq.node.fd.limit metric.
+func (mb *MetricsBuilder) RecordRabbitmqNodeFdLimitDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRabbitmqNodeFdLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRabbitmqNodeFdUsedDataPoint adds a data point to rabbitmq.node.fd.used metric.
+func (mb *MetricsBuilder) RecordRabbitmqNodeFdUsedDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRabbitmqNodeFdUsed.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRabbitmqNodeMemoryLimitDataPoint adds a data point to rabbitmq.node.memory.limit metric.
+func (mb *MetricsBuilder) RecordRabbitmqNodeMemoryLimitDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRabbitmqNodeMemoryLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRabbitmqNodeMemoryUsedDataPoint adds a data point to rabbitmq.node.memory.used metric.
+func (mb *MetricsBuilder) RecordRabbitmqNodeMemoryUsedDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRabbitmqNodeMemoryUsed.recordDataPoint(mb.startTime, ts, val)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -25,7 +25,23 @@ type Queue struct {
 	Consumers              int64 `json:"consumers"`
 	UnacknowledgedMessages int64 `json:"messages_unacknowledged"`
 	ReadyMessages          int64 `json:"messages_ready"`
+	// ConsumerUtilisation is absent or null when the queue has no consumers.
+	ConsumerUtilisation *float64 `json:"consumer_utilisation"`
 
 	// Embedded Metrics
 	MessageStats map[string]interface{} `json:"message_stats"`
 }
+
+// Node represents a node in the API response
+type Node struct {
+	// Identifiers
+	Name string `json:"name"`
+
+	// Metrics
+	FDUsed        int64 `json:"fd_used"`
+	FDTotal       int64 `json:"fd_total"`
+	MemUsed       int64 `json:"mem_used"`
+	MemLimit      int64 `json:"mem_limit"`
+	MemAlarm      bool  `json:"mem_alarm"`
+	DiskFreeAlarm bool  `json:"disk_free_alarm"`
+}
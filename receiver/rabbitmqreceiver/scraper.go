@@ -22,6 +22,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/metadata"
@@ -36,6 +37,7 @@ const (
 	publishStat        = "publish"
 	ackStat            = "ack"
 	dropUnroutableStat = "drop_unroutable"
+	redeliverStat      = "redeliver"
 )
 
 // Metrics to gather from queue message_stats structure
@@ -46,6 +48,20 @@ var messageStatMetrics = []string{
 	dropUnroutableStat,
 }
 
+// Message stats reported as rates and their operation attribute
+var messageRateStats = []struct {
+	stat      string
+	operation metadata.AttributeMessageOperation
+}{
+	{publishStat, metadata.AttributeMessageOperationPublish},
+	{deliverStat, metadata.AttributeMessageOperationDeliver},
+	{ackStat, metadata.AttributeMessageOperationAck},
+	{redeliverStat, metadata.AttributeMessageOperationRedeliver},
+}
+
+// nodeMetricsCount is the number of node metrics lost when the nodes endpoint fails
+const nodeMetricsCount = 5
+
 // rabbitmqScraper handles scraping of RabbitMQ metrics
 type rabbitmqScraper struct {
 	client   client
@@ -53,6 +69,8 @@ type rabbitmqScraper struct {
 	cfg      *Config
 	settings component.TelemetrySettings
 	mb       *metadata.MetricsBuilder
+	// queueFilter is nil when every queue is collected
+	queueFilter *queueFilter
 }
 
 // newScraper creates a new scraper
@@ -67,6 +85,10 @@ func newScraper(logger *zap.Logger, cfg *Config, settings component.ReceiverCrea
 
 // start starts the scraper by creating a new HTTP Client on the scraper
 func (r *rabbitmqScraper) start(ctx context.Context, host component.Host) (err error) {
+	r.queueFilter, err = newQueueFilter(r.cfg.Queues)
+	if err != nil {
+		return err
+	}
 	r.client, err = newClient(r.cfg, host, r.settings, r.logger)
 	return
 }
@@ -88,10 +110,23 @@ func (r *rabbitmqScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 
 	// Collect metrics for each queue
 	for _, queue := range queues {
-
+		if !r.queueFilter.matches(queue) {
+			continue
+		}
 		r.collectQueue(queue, now)
 	}
 
+	// Nodes are only requested if a node metric is enabled
+	if r.nodeMetricsEnabled() {
+		nodes, err := r.client.GetNodes(ctx)
+		if err != nil {
+			return r.mb.Emit(), scrapererror.NewPartialScrapeError(err, nodeMetricsCount)
+		}
+		for _, node := range nodes {
+			r.collectNode(node, now)
+		}
+	}
+
 	return r.mb.Emit(), nil
 }
 
@@ -129,6 +164,18 @@ func (r *rabbitmqScraper) collectQueue(queue *models.Queue, now pcommon.Timestam
 			r.mb.RecordRabbitmqMessageDroppedDataPoint(now, val64)
 		}
 	}
+	if queue.ConsumerUtilisation != nil {
+		r.mb.RecordRabbitmqConsumerUtilizationDataPoint(now, *queue.ConsumerUtilisation)
+	}
+	for _, rateStat := range messageRateStats {
+		// A rate is only reported once the operation has occurred
+		rate, ok := messageStatRate(queue.MessageStats, rateStat.stat)
+		if !ok {
+			continue
+		}
+		r.mb.RecordRabbitmqMessageRateDataPoint(now, rate, rateStat.operation)
+	}
+
 	r.mb.EmitForResource(
 		metadata.WithRabbitmqQueueName(queue.Name),
 		metadata.WithRabbitmqNodeName(queue.Node),
@@ -136,6 +183,44 @@ func (r *rabbitmqScraper) collectQueue(queue *models.Queue, now pcommon.Timestam
 	)
 }
 
+// nodeMetricsEnabled reports whether any metric requiring the nodes endpoint is enabled
+func (r *rabbitmqScraper) nodeMetricsEnabled() bool {
+	m := r.cfg.Metrics
+	return m.RabbitmqNodeFdUsed.Enabled ||
+		m.RabbitmqNodeFdLimit.Enabled ||
+		m.RabbitmqNodeMemoryUsed.Enabled ||
+		m.RabbitmqNodeMemoryLimit.Enabled ||
+		m.RabbitmqNodeAlarm.Enabled
+}
+
+// collectNode collects metrics for a node
+func (r *rabbitmqScraper) collectNode(node *models.Node, now pcommon.Timestamp) {
+	r.mb.RecordRabbitmqNodeFdUsedDataPoint(now, node.FDUsed)
+	r.mb.RecordRabbitmqNodeFdLimitDataPoint(now, node.FDTotal)
+	r.mb.RecordRabbitmqNodeMemoryUsedDataPoint(now, node.MemUsed)
+	r.mb.RecordRabbitmqNodeMemoryLimitDataPoint(now, node.MemLimit)
+	r.mb.RecordRabbitmqNodeAlarmDataPoint(now, boolToInt64(node.MemAlarm), metadata.AttributeNodeAlarmMemory)
+	r.mb.RecordRabbitmqNodeAlarmDataPoint(now, boolToInt64(node.DiskFreeAlarm), metadata.AttributeNodeAlarmDisk)
+	r.mb.EmitForResource(metadata.WithRabbitmqNodeName(node.Name))
+}
+
+// messageStatRate returns the rate of the stat from the "<stat>_details" entry of message_stats
+func messageStatRate(messageStats map[string]interface{}, stat string) (float64, bool) {
+	details, ok := messageStats[stat+"_details"].(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	rate, ok := details["rate"].(float64)
+	return rate, ok
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 // convertValToInt64 values from message state unmarshal as float64s but should be int64.
 // Need to do a double cast to get an int64.
 // This should never fail but worth checking just in case.
@@ -27,6 +27,7 @@ import (
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest"
@@ -156,3 +157,89 @@ func TestScaperScrape(t *testing.T) {
 		})
 	}
 }
+
+func TestScraperQueueDetailsAndNodes(t *testing.T) {
+	var queues []*models.Queue
+	require.NoError(t, json.Unmarshal(loadAPIResponseData(t, queuesAPIResponseFile), &queues))
+	var nodes []*models.Node
+	require.NoError(t, json.Unmarshal(loadAPIResponseData(t, nodesAPIResponseFile), &nodes))
+
+	mockClient := mocks.MockClient{}
+	mockClient.On("GetQueues", mock.Anything).Return(queues, nil)
+	mockClient.On("GetNodes", mock.Anything).Return(nodes, nil)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.RabbitmqMessageRate.Enabled = true
+	cfg.Metrics.RabbitmqConsumerUtilization.Enabled = true
+	cfg.Metrics.RabbitmqNodeFdUsed.Enabled = true
+	cfg.Metrics.RabbitmqNodeMemoryLimit.Enabled = true
+	cfg.Metrics.RabbitmqNodeAlarm.Enabled = true
+	cfg.Queues.IncludeNames = []string{"^web"}
+
+	scraper := newScraper(zap.NewNop(), cfg, componenttest.NewNopReceiverCreateSettings())
+	var err error
+	scraper.queueFilter, err = newQueueFilter(cfg.Queues)
+	require.NoError(t, err)
+	scraper.client = &mockClient
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	// test2 is filtered out, leaving the webq1 queue followed by the node
+	rms := actualMetrics.ResourceMetrics()
+	require.Equal(t, 2, rms.Len())
+
+	queueName, ok := rms.At(0).Resource().Attributes().Get("rabbitmq.queue.name")
+	require.True(t, ok)
+	require.Equal(t, "webq1", queueName.Str())
+	queueMetrics := metricsByName(rms.At(0))
+	require.InDelta(t, 0.5256, queueMetrics["rabbitmq.consumer.utilization"].Gauge().DataPoints().At(0).DoubleValue(), 0.0001)
+	rates := queueMetrics["rabbitmq.message.rate"].Gauge().DataPoints()
+	require.Equal(t, 4, rates.Len())
+	for i := 0; i < rates.Len(); i++ {
+		operation, ok := rates.At(i).Attributes().Get("operation")
+		require.True(t, ok)
+		expected := map[string]float64{"publish": 1.0, "deliver": 1.6, "ack": 1.6, "redeliver": 0}[operation.Str()]
+		require.Equal(t, expected, rates.At(i).DoubleValue(), operation.Str())
+	}
+
+	nodeName, ok := rms.At(1).Resource().Attributes().Get("rabbitmq.node.name")
+	require.True(t, ok)
+	require.Equal(t, "rabbit@66a063ecff83", nodeName.Str())
+	nodeMetrics := metricsByName(rms.At(1))
+	require.Len(t, nodeMetrics, 3)
+	require.EqualValues(t, 37, nodeMetrics["rabbitmq.node.fd.used"].Sum().DataPoints().At(0).IntValue())
+	require.EqualValues(t, 3289763840, nodeMetrics["rabbitmq.node.memory.limit"].Sum().DataPoints().At(0).IntValue())
+	alarms := nodeMetrics["rabbitmq.node.alarm"].Gauge().DataPoints()
+	require.Equal(t, 2, alarms.Len())
+	for i := 0; i < alarms.Len(); i++ {
+		alarm, ok := alarms.At(i).Attributes().Get("alarm")
+		require.True(t, ok)
+		require.Equal(t, map[string]int64{"memory": 1, "disk": 0}[alarm.Str()], alarms.At(i).IntValue(), alarm.Str())
+	}
+}
+
+func TestScraperNodesFailure(t *testing.T) {
+	mockClient := mocks.MockClient{}
+	mockClient.On("GetQueues", mock.Anything).Return([]*models.Queue{}, nil)
+	mockClient.On("GetNodes", mock.Anything).Return(nil, errors.New("some api error"))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.RabbitmqNodeFdUsed.Enabled = true
+
+	scraper := newScraper(zap.NewNop(), cfg, componenttest.NewNopReceiverCreateSettings())
+	scraper.client = &mockClient
+
+	_, err := scraper.scrape(context.Background())
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.EqualError(t, err, "some api error")
+}
+
+func metricsByName(rm pmetric.ResourceMetrics) map[string]pmetric.Metric {
+	byName := map[string]pmetric.Metric{}
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		byName[ms.At(i).Name()] = ms.At(i)
+	}
+	return byName
+}
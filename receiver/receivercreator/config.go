@@ -15,6 +15,7 @@
 package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cast"
@@ -90,6 +91,28 @@ type Config struct {
 	// ResourceAttributes is a map of default resource attributes to add to each resource
 	// object received by this receiver from dynamically created receivers.
 	ResourceAttributes resourceAttributes `mapstructure:"resource_attributes"`
+	// Discovery configures the creation of receivers from discovery hints set as
+	// annotations on observed pods and labels on observed containers.
+	Discovery DiscoveryConfig `mapstructure:"discovery"`
+}
+
+// DiscoveryConfig configures the creation of receivers from discovery hints.
+type DiscoveryConfig struct {
+	// Enabled turns on the creation of receivers from discovery hints.
+	Enabled bool `mapstructure:"enabled"`
+	// AllowedReceivers are the receiver types that can be created from discovery hints.
+	// Hints for any other receiver type are ignored.
+	AllowedReceivers []config.Type `mapstructure:"allowed_receivers"`
+}
+
+// allows reports whether receivers of the given type can be created from discovery hints.
+func (d DiscoveryConfig) allows(receiverType config.Type) bool {
+	for _, allowed := range d.AllowedReceivers {
+		if allowed == receiverType {
+			return true
+		}
+	}
+	return false
 }
 
 func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
@@ -110,6 +133,10 @@ func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 		}
 	}
 
+	if cfg.Discovery.Enabled && len(cfg.Discovery.AllowedReceivers) == 0 {
+		return errors.New("discovery.allowed_receivers must be set when discovery is enabled")
+	}
+
 	receiversCfg, err := componentParser.Sub(receiversConfigKey)
 	if err != nil {
 		return fmt.Errorf("unable to extract key %v: %w", receiversConfigKey, err)
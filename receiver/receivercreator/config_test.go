@@ -113,6 +113,16 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "discovery"),
+			expected: func() config.Receiver {
+				cfg := createDefaultConfig().(*Config)
+				cfg.WatchObservers = []config.ComponentID{config.NewComponentID("mock_observer")}
+				cfg.Discovery.Enabled = true
+				cfg.Discovery.AllowedReceivers = []config.Type{"redis", "nginx"}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -143,6 +153,17 @@ func TestInvalidResourceAttributeEndpointType(t *testing.T) {
 	require.Nil(t, cfg)
 }
 
+func TestDiscoveryWithoutAllowedReceivers(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.Nil(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[typeStr] = factory
+	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "invalid-discovery.yaml"), factories)
+	require.EqualError(t, err, "error reading receivers configuration for \"receiver_creator\": discovery.allowed_receivers must be set when discovery is enabled")
+	require.Nil(t, cfg)
+}
+
 func TestInvalidReceiverResourceAttributeValueType(t *testing.T) {
 	factories, err := componenttest.NopFactories()
 	require.Nil(t, err)
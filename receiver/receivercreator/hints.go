@@ -0,0 +1,167 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/config"
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+const (
+	// hintsPrefix is the prefix of pod annotations and container labels used as discovery hints.
+	// Hints are either unnamed (e.g. io.opentelemetry.discovery/metrics.scraper) or belong to a named
+	// hint set (e.g. io.opentelemetry.discovery/metrics.admin.scraper) so that a single pod can
+	// create several receivers.
+	hintsPrefix = "io.opentelemetry.discovery/metrics."
+
+	// hintScraper is the type of the receiver to create. Required.
+	hintScraper = "scraper"
+	// hintEndpoint overrides the endpoint of the created receiver. It may contain expressions.
+	hintEndpoint = "endpoint"
+	// hintConfig is a YAML map merged into the created receiver's config. It may contain expressions.
+	hintConfig = "config"
+	// hintPort restricts the hint set to the port endpoint (or container endpoint) with that port.
+	hintPort = "port"
+
+	// hintsIDName is the name given to receivers created from hints.
+	hintsIDName = "hints"
+)
+
+// hintSet is a group of discovery hints that together describe a single receiver instance.
+type hintSet struct {
+	// name is empty for unnamed hints.
+	name     string
+	scraper  string
+	endpoint string
+	config   string
+	port     string
+}
+
+// parseHints groups the discovery hints found in annotations by hint set name.
+func parseHints(annotations map[string]string) (map[string]*hintSet, error) {
+	sets := map[string]*hintSet{}
+	for key, value := range annotations {
+		if !strings.HasPrefix(key, hintsPrefix) {
+			continue
+		}
+		hint := strings.TrimPrefix(key, hintsPrefix)
+
+		name, field := "", hint
+		if i := strings.LastIndex(hint, "."); i != -1 {
+			name, field = hint[:i], hint[i+1:]
+		}
+
+		set, ok := sets[name]
+		if !ok {
+			set = &hintSet{name: name}
+			sets[name] = set
+		}
+
+		switch field {
+		case hintScraper:
+			set.scraper = value
+		case hintEndpoint:
+			set.endpoint = value
+		case hintConfig:
+			set.config = value
+		case hintPort:
+			if _, err := strconv.ParseUint(value, 10, 16); err != nil {
+				return nil, fmt.Errorf("invalid port hint %q for %q: %w", value, key, err)
+			}
+			set.port = value
+		default:
+			return nil, fmt.Errorf("unsupported discovery hint %q", key)
+		}
+	}
+
+	for name, set := range sets {
+		if set.scraper == "" {
+			return nil, fmt.Errorf("discovery hint set %q is missing the %q hint", name, hintScraper)
+		}
+	}
+
+	return sets, nil
+}
+
+// hintsForEndpoint returns the hint sets that apply to the given endpoint, sorted by name.
+// Hint sets without a port apply to pod endpoints while hint sets with a port apply to the
+// port or container endpoint exposing that port.
+func hintsForEndpoint(e observer.Endpoint) ([]*hintSet, error) {
+	var annotations map[string]string
+	port := ""
+	switch details := e.Details.(type) {
+	case *observer.Pod:
+		annotations = details.Annotations
+	case *observer.Port:
+		annotations = details.Pod.Annotations
+		port = strconv.FormatUint(uint64(details.Port), 10)
+	case *observer.Container:
+		annotations = details.Labels
+		port = strconv.FormatUint(uint64(details.Port), 10)
+	default:
+		return nil, nil
+	}
+
+	sets, err := parseHints(annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*hintSet
+	for _, set := range sets {
+		if set.port == port {
+			matched = append(matched, set)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].name < matched[j].name
+	})
+
+	return matched, nil
+}
+
+// receiverTemplate creates the receiver template described by the hint set.
+func (h *hintSet) receiverTemplate() (receiverTemplate, error) {
+	idName := hintsIDName
+	if h.name != "" {
+		idName = hintsIDName + "." + h.name
+	}
+
+	// Unmarshal into a plain map so nested maps are also plain maps that expandMap can walk.
+	cfg := map[string]interface{}{}
+	if h.config != "" {
+		if err := yaml.Unmarshal([]byte(h.config), &cfg); err != nil {
+			return receiverTemplate{}, fmt.Errorf("invalid %q hint: %w", hintConfig, err)
+		}
+	}
+	if h.endpoint != "" {
+		cfg[endpointConfigKey] = h.endpoint
+	}
+
+	return receiverTemplate{
+		receiverConfig: receiverConfig{
+			id:     config.NewComponentIDWithName(config.Type(h.scraper), idName),
+			config: cfg,
+		},
+		ResourceAttributes: map[string]interface{}{},
+	}, nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receivercreator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+var hintedPod = observer.Pod{
+	UID:       "uid-2",
+	Namespace: "default",
+	Name:      "pod-2",
+	Annotations: map[string]string{
+		"io.opentelemetry.discovery/metrics.scraper":        "redis",
+		"io.opentelemetry.discovery/metrics.endpoint":       "`endpoint`:6379",
+		"io.opentelemetry.discovery/metrics.config":         "collection_interval: 20s\nmetrics:\n  redis.uptime:\n    enabled: '`name == \"pod-2\"`'\n",
+		"io.opentelemetry.discovery/metrics.admin.scraper":  "nginx",
+		"io.opentelemetry.discovery/metrics.admin.port":     "8080",
+		"io.opentelemetry.discovery/metrics.admin.endpoint": "http://`endpoint`/status",
+		"unrelated": "annotation",
+	},
+}
+
+func TestParseHints(t *testing.T) {
+	sets, err := parseHints(hintedPod.Annotations)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*hintSet{
+		"": {
+			scraper:  "redis",
+			endpoint: "`endpoint`:6379",
+			config:   hintedPod.Annotations["io.opentelemetry.discovery/metrics.config"],
+		},
+		"admin": {
+			name:     "admin",
+			scraper:  "nginx",
+			endpoint: "http://`endpoint`/status",
+			port:     "8080",
+		},
+	}, sets)
+}
+
+func TestParseHintsErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expectedErr string
+	}{
+		{
+			name: "missing scraper",
+			annotations: map[string]string{
+				"io.opentelemetry.discovery/metrics.endpoint": "`endpoint`:6379",
+			},
+			expectedErr: `discovery hint set "" is missing the "scraper" hint`,
+		},
+		{
+			name: "invalid port",
+			annotations: map[string]string{
+				"io.opentelemetry.discovery/metrics.scraper": "redis",
+				"io.opentelemetry.discovery/metrics.port":    "http",
+			},
+			expectedErr: `invalid port hint "http"`,
+		},
+		{
+			name: "unsupported hint",
+			annotations: map[string]string{
+				"io.opentelemetry.discovery/metrics.scraper":  "redis",
+				"io.opentelemetry.discovery/metrics.interval": "10s",
+			},
+			expectedErr: `unsupported discovery hint "io.opentelemetry.discovery/metrics.interval"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseHints(tt.annotations)
+			assert.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestHintsForEndpoint(t *testing.T) {
+	podHints, err := hintsForEndpoint(observer.Endpoint{ID: "pod-2", Target: "1.2.3.4", Details: &hintedPod})
+	require.NoError(t, err)
+	require.Len(t, podHints, 1)
+	assert.Equal(t, "redis", podHints[0].scraper)
+
+	portHints, err := hintsForEndpoint(observer.Endpoint{
+		ID:      "pod-2/8080",
+		Target:  "1.2.3.4:8080",
+		Details: &observer.Port{Name: "http", Pod: hintedPod, Port: 8080, Transport: observer.ProtocolTCP},
+	})
+	require.NoError(t, err)
+	require.Len(t, portHints, 1)
+	assert.Equal(t, "nginx", portHints[0].scraper)
+
+	otherPortHints, err := hintsForEndpoint(observer.Endpoint{
+		ID:      "pod-2/9090",
+		Target:  "1.2.3.4:9090",
+		Details: &observer.Port{Name: "other", Pod: hintedPod, Port: 9090, Transport: observer.ProtocolTCP},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, otherPortHints)
+
+	nodeHints, err := hintsForEndpoint(k8sNodeEndpoint)
+	require.NoError(t, err)
+	assert.Empty(t, nodeHints)
+}
+
+func TestHintSetReceiverTemplate(t *testing.T) {
+	sets, err := parseHints(hintedPod.Annotations)
+	require.NoError(t, err)
+
+	template, err := sets[""].receiverTemplate()
+	require.NoError(t, err)
+	assert.Equal(t, config.NewComponentIDWithName("redis", "hints"), template.id)
+	assert.Equal(t, userConfigMap{
+		"collection_interval": "20s",
+		"endpoint":            "`endpoint`:6379",
+		"metrics": map[string]interface{}{
+			"redis.uptime": map[string]interface{}{
+				"enabled": "`name == \"pod-2\"`",
+			},
+		},
+	}, template.config)
+
+	template, err = sets["admin"].receiverTemplate()
+	require.NoError(t, err)
+	assert.Equal(t, config.NewComponentIDWithName("nginx", "hints.admin"), template.id)
+	assert.Equal(t, userConfigMap{"endpoint": "http://`endpoint`/status"}, template.config)
+
+	_, err = (&hintSet{scraper: "redis", config: "- not\n- a map"}).receiverTemplate()
+	assert.ErrorContains(t, err, `invalid "config" hint`)
+}
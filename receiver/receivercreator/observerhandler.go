@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"sync"
 
+	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -89,75 +90,103 @@ func (obs *observerHandler) OnAdd(added []observer.Endpoint) {
 			} else if !matches {
 				continue
 			}
+			obs.startReceiver(template, env, e)
+		}
 
-			obs.logger.Info("starting receiver",
-				zap.String("name", template.id.String()),
-				zap.String("endpoint", e.Target),
-				zap.String("endpoint_id", string(e.ID)))
+		if !obs.config.Discovery.Enabled {
+			continue
+		}
 
-			resolvedConfig, err := expandMap(template.config, env)
-			if err != nil {
-				obs.logger.Error("unable to resolve template config", zap.String("receiver", template.id.String()), zap.Error(err))
+		hints, err := hintsForEndpoint(e)
+		if err != nil {
+			obs.logger.Error("unable to parse discovery hints", zap.String("endpoint_id", string(e.ID)), zap.Error(err))
+			continue
+		}
+		for _, hint := range hints {
+			if !obs.config.Discovery.allows(config.Type(hint.scraper)) {
+				obs.logger.Warn("ignoring discovery hints for a receiver type not in discovery.allowed_receivers",
+					zap.String("scraper", hint.scraper), zap.String("endpoint_id", string(e.ID)))
 				continue
 			}
-
-			discoveredConfig := userConfigMap{}
-
-			// If user didn't set endpoint set to default value.
-			if _, ok := resolvedConfig[endpointConfigKey]; !ok {
-				discoveredConfig[endpointConfigKey] = e.Target
-			}
-
-			resolvedDiscoveredConfig, err := expandMap(discoveredConfig, env)
-
+			template, err := hint.receiverTemplate()
 			if err != nil {
-				obs.logger.Error("unable to resolve discovered config", zap.String("receiver", template.id.String()), zap.Error(err))
+				obs.logger.Error("unable to create receiver from discovery hints",
+					zap.String("scraper", hint.scraper), zap.String("endpoint_id", string(e.ID)), zap.Error(err))
 				continue
 			}
+			obs.startReceiver(template, env, e)
+		}
+	}
+}
 
-			resAttrs := map[string]string{}
-			for k, v := range template.ResourceAttributes {
-				strVal, ok := v.(string)
-				if !ok {
-					obs.logger.Info(fmt.Sprintf("ignoring unsupported `resource_attributes` %q value %v", k, v))
-					continue
-				}
-				resAttrs[k] = strVal
-			}
+// startReceiver starts a receiver instance from the given template against a matched endpoint.
+func (obs *observerHandler) startReceiver(template receiverTemplate, env observer.EndpointEnv, e observer.Endpoint) {
+	obs.logger.Info("starting receiver",
+		zap.String("name", template.id.String()),
+		zap.String("endpoint", e.Target),
+		zap.String("endpoint_id", string(e.ID)))
+
+	resolvedConfig, err := expandMap(template.config, env)
+	if err != nil {
+		obs.logger.Error("unable to resolve template config", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
+	}
 
-			// Adds default and/or configured resource attributes (e.g. k8s.pod.uid) to resources
-			// as telemetry is emitted.
-			resourceEnhancer, err := newResourceEnhancer(
-				obs.config.ResourceAttributes,
-				resAttrs,
-				env,
-				e,
-				obs.nextConsumer,
-			)
+	discoveredConfig := userConfigMap{}
 
-			if err != nil {
-				obs.logger.Error("failed creating resource enhancer", zap.String("receiver", template.id.String()), zap.Error(err))
-				continue
-			}
+	// If user didn't set endpoint set to default value.
+	if _, ok := resolvedConfig[endpointConfigKey]; !ok {
+		discoveredConfig[endpointConfigKey] = e.Target
+	}
 
-			rcvr, err := obs.runner.start(
-				receiverConfig{
-					id:         template.id,
-					config:     resolvedConfig,
-					endpointID: e.ID,
-				},
-				resolvedDiscoveredConfig,
-				resourceEnhancer,
-			)
+	resolvedDiscoveredConfig, err := expandMap(discoveredConfig, env)
 
-			if err != nil {
-				obs.logger.Error("failed to start receiver", zap.String("receiver", template.id.String()), zap.Error(err))
-				continue
-			}
+	if err != nil {
+		obs.logger.Error("unable to resolve discovered config", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
+	}
 
-			obs.receiversByEndpointID.Put(e.ID, rcvr)
+	resAttrs := map[string]string{}
+	for k, v := range template.ResourceAttributes {
+		strVal, ok := v.(string)
+		if !ok {
+			obs.logger.Info(fmt.Sprintf("ignoring unsupported `resource_attributes` %q value %v", k, v))
+			continue
 		}
+		resAttrs[k] = strVal
+	}
+
+	// Adds default and/or configured resource attributes (e.g. k8s.pod.uid) to resources
+	// as telemetry is emitted.
+	resourceEnhancer, err := newResourceEnhancer(
+		obs.config.ResourceAttributes,
+		resAttrs,
+		env,
+		e,
+		obs.nextConsumer,
+	)
+
+	if err != nil {
+		obs.logger.Error("failed creating resource enhancer", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
 	}
+
+	rcvr, err := obs.runner.start(
+		receiverConfig{
+			id:         template.id,
+			config:     resolvedConfig,
+			endpointID: e.ID,
+		},
+		resolvedDiscoveredConfig,
+		resourceEnhancer,
+	)
+
+	if err != nil {
+		obs.logger.Error("failed to start receiver", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
+	}
+
+	obs.receiversByEndpointID.Put(e.ID, rcvr)
 }
 
 // OnRemove responds to endpoint removal notifications.
@@ -163,3 +163,88 @@ func TestDynamicConfig(t *testing.T) {
 
 	runner.AssertExpectations(t)
 }
+
+func TestOnAddWithHints(t *testing.T) {
+	runner := &mockRunner{}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Discovery.Enabled = true
+	cfg.Discovery.AllowedReceivers = []config.Type{"redis", "nginx"}
+	handler := &observerHandler{
+		config:                cfg,
+		logger:                zap.NewNop(),
+		receiversByEndpointID: receiverMap{},
+		runner:                runner,
+	}
+
+	hintedPodEndpoint := observer.Endpoint{ID: "pod-2", Target: "1.2.3.4", Details: &hintedPod}
+	hintedPortEndpoint := observer.Endpoint{
+		ID:      "pod-2/8080",
+		Target:  "1.2.3.4:8080",
+		Details: &observer.Port{Name: "http", Pod: hintedPod, Port: 8080, Transport: observer.ProtocolTCP},
+	}
+
+	runner.On(
+		"start",
+		receiverConfig{
+			id: config.NewComponentIDWithName("redis", "hints"),
+			config: userConfigMap{
+				"collection_interval": "20s",
+				endpointConfigKey:     "1.2.3.4:6379",
+				"metrics": map[string]interface{}{
+					"redis.uptime": map[string]interface{}{"enabled": true},
+				},
+			},
+			endpointID: hintedPodEndpoint.ID,
+		},
+		userConfigMap{},
+		mock.IsType(&resourceEnhancer{}),
+	).Return(&nopWithEndpointReceiver{}, nil)
+	runner.On(
+		"start",
+		receiverConfig{
+			id:         config.NewComponentIDWithName("nginx", "hints.admin"),
+			config:     userConfigMap{endpointConfigKey: "http://1.2.3.4:8080/status"},
+			endpointID: hintedPortEndpoint.ID,
+		},
+		userConfigMap{},
+		mock.IsType(&resourceEnhancer{}),
+	).Return(&nopWithEndpointReceiver{}, nil)
+
+	handler.OnAdd([]observer.Endpoint{hintedPodEndpoint, hintedPortEndpoint, podEndpoint})
+
+	runner.AssertExpectations(t)
+	assert.Equal(t, 2, handler.receiversByEndpointID.Size())
+}
+
+func TestOnAddWithHintsNotAllowed(t *testing.T) {
+	runner := &mockRunner{}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Discovery.Enabled = true
+	cfg.Discovery.AllowedReceivers = []config.Type{"nginx"}
+	handler := &observerHandler{
+		config:                cfg,
+		logger:                zap.NewNop(),
+		receiversByEndpointID: receiverMap{},
+		runner:                runner,
+	}
+
+	handler.OnAdd([]observer.Endpoint{{ID: "pod-2", Target: "1.2.3.4", Details: &hintedPod}})
+
+	runner.AssertNotCalled(t, "start", mock.Anything, mock.Anything, mock.Anything)
+	assert.Equal(t, 0, handler.receiversByEndpointID.Size())
+}
+
+func TestOnAddWithHintsDisabled(t *testing.T) {
+	runner := &mockRunner{}
+	handler := &observerHandler{
+		config:                createDefaultConfig().(*Config),
+		logger:                zap.NewNop(),
+		receiversByEndpointID: receiverMap{},
+		runner:                runner,
+	}
+
+	handler.OnAdd([]observer.Endpoint{{ID: "pod-2", Target: "1.2.3.4", Details: &hintedPod}})
+
+	runner.AssertNotCalled(t, "start", mock.Anything, mock.Anything, mock.Anything)
+	assert.Equal(t, 0, handler.receiversByEndpointID.Size())
+}
@@ -15,6 +15,9 @@
 package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
 
 import (
+	"fmt"
+	"sync"
+
 	"github.com/go-redis/redis/v7"
 )
 
@@ -41,6 +44,14 @@ func newRedisClient(options *redis.Options) client {
 	}
 }
 
+// Creates a new real Redis client connected to the primary discovered through Sentinel.
+// The client follows the primary when the sentinels fail over to a replica.
+func newRedisFailoverClient(options *redis.FailoverOptions) client {
+	return &redisClient{
+		client: redis.NewFailoverClient(options),
+	}
+}
+
 // Redis strings are CRLF delimited.
 func (c *redisClient) delimiter() string {
 	return "\r\n"
@@ -50,3 +61,56 @@ func (c *redisClient) delimiter() string {
 func (c *redisClient) retrieveInfo() (string, error) {
 	return c.client.Info("all").Result()
 }
+
+// Interface for a Redis Cluster client. Implementation can be faked for testing.
+type clusterClient interface {
+	// retrieves the INFO of every known node in the cluster, keyed by node address.
+	// Nodes that fail to respond are left out of the result and reported in the error.
+	retrieveNodeInfos() (map[string]string, error)
+	// retrieves a string of key/value pairs of cluster state
+	retrieveClusterInfo() (string, error)
+	// line delimiter
+	delimiter() string
+}
+
+// Wraps a real Redis Cluster client, implements `clusterClient` interface.
+type redisClusterClient struct {
+	client *redis.ClusterClient
+}
+
+var _ clusterClient = (*redisClusterClient)(nil)
+
+// Creates a new real Redis Cluster client from the passed-in redis.ClusterOptions.
+func newRedisClusterClient(options *redis.ClusterOptions) clusterClient {
+	return &redisClusterClient{
+		client: redis.NewClusterClient(options),
+	}
+}
+
+// Redis strings are CRLF delimited.
+func (c *redisClusterClient) delimiter() string {
+	return "\r\n"
+}
+
+// Retrieve Redis INFO of primaries and replicas, the nodes are queried concurrently.
+func (c *redisClusterClient) retrieveNodeInfos() (map[string]string, error) {
+	var mu sync.Mutex
+	infos := map[string]string{}
+	err := c.client.ForEachNode(func(node *redis.Client) error {
+		addr := node.Options().Addr
+		inf, err := node.Info("all").Result()
+		if err != nil {
+			return fmt.Errorf("failed to retrieve info from node %s: %w", addr, err)
+		}
+		mu.Lock()
+		infos[addr] = inf
+		mu.Unlock()
+		return nil
+	})
+	return infos, err
+}
+
+// Retrieve CLUSTER INFO, which describes the slot coverage of the cluster.
+func (c *redisClusterClient) retrieveClusterInfo() (string, error) {
+	return c.client.ClusterInfo().Result()
+}
@@ -15,9 +15,12 @@
 package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
 
 import (
+	"errors"
+
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
 )
@@ -36,5 +39,59 @@ type Config struct {
 
 	TLS configtls.TLSClientSetting `mapstructure:"tls,omitempty"`
 
+	// Optional Redis Cluster configuration. When set, every node of the cluster is
+	// scraped and the endpoint is ignored.
+	Cluster *ClusterConfig `mapstructure:"cluster"`
+
+	// Optional Sentinel configuration. When set, the primary monitored by the
+	// sentinels is scraped and the endpoint is ignored.
+	Sentinel *SentinelConfig `mapstructure:"sentinel"`
+
 	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
 }
+
+// ClusterConfig configures scraping a Redis Cluster.
+type ClusterConfig struct {
+	// A seed list of host:port addresses of cluster nodes, the remaining nodes
+	// are discovered from the cluster.
+	Endpoints []string `mapstructure:"endpoints"`
+}
+
+// SentinelConfig configures discovering the primary of a Sentinel monitored deployment.
+type SentinelConfig struct {
+	// A seed list of host:port addresses of sentinel nodes.
+	Endpoints []string `mapstructure:"endpoints"`
+
+	// The name of the primary, as configured in the sentinel monitor directive.
+	PrimaryName string `mapstructure:"primary_name"`
+
+	// Optional password used to authenticate with the sentinels, which can differ
+	// from the password of the Redis nodes.
+	Password string `mapstructure:"password"`
+}
+
+var (
+	errClusterAndSentinel  = errors.New("cluster and sentinel can not both be configured")
+	errNoClusterEndpoints  = errors.New("cluster.endpoints must contain at least one endpoint")
+	errNoSentinelEndpoints = errors.New("sentinel.endpoints must contain at least one endpoint")
+	errNoSentinelPrimary   = errors.New("sentinel.primary_name must be specified")
+)
+
+func (cfg *Config) Validate() error {
+	var err error
+	if cfg.Cluster != nil && cfg.Sentinel != nil {
+		err = multierr.Append(err, errClusterAndSentinel)
+	}
+	if cfg.Cluster != nil && len(cfg.Cluster.Endpoints) == 0 {
+		err = multierr.Append(err, errNoClusterEndpoints)
+	}
+	if cfg.Sentinel != nil {
+		if len(cfg.Sentinel.Endpoints) == 0 {
+			err = multierr.Append(err, errNoSentinelEndpoints)
+		}
+		if cfg.Sentinel.PrimaryName == "" {
+			err = multierr.Append(err, errNoSentinelPrimary)
+		}
+	}
+	return err
+}
@@ -26,6 +26,7 @@ import (
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
 )
@@ -59,3 +60,72 @@ func TestConfig(t *testing.T) {
 		cfg,
 	)
 }
+
+func TestConfigTopology(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+
+	t.Run("cluster", func(t *testing.T) {
+		cfg := factory.CreateDefaultConfig().(*Config)
+		sub, err := cm.Sub(config.NewComponentIDWithName(typeStr, "cluster").String())
+		require.NoError(t, err)
+		require.NoError(t, config.UnmarshalReceiver(sub, cfg))
+
+		assert.Equal(t, &ClusterConfig{Endpoints: []string{"redis-0:6379", "redis-1:6379"}}, cfg.Cluster)
+		assert.Nil(t, cfg.Sentinel)
+		assert.NoError(t, cfg.Validate())
+	})
+
+	t.Run("sentinel", func(t *testing.T) {
+		cfg := factory.CreateDefaultConfig().(*Config)
+		sub, err := cm.Sub(config.NewComponentIDWithName(typeStr, "sentinel").String())
+		require.NoError(t, err)
+		require.NoError(t, config.UnmarshalReceiver(sub, cfg))
+
+		assert.Equal(t, &SentinelConfig{
+			Endpoints:   []string{"sentinel-0:26379"},
+			PrimaryName: "mymaster",
+			Password:    "sentinel",
+		}, cfg.Sentinel)
+		assert.Nil(t, cfg.Cluster)
+		assert.NoError(t, cfg.Validate())
+	})
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		cfg      *Config
+		expected error
+	}{
+		{
+			desc:     "standalone",
+			cfg:      &Config{},
+			expected: nil,
+		},
+		{
+			desc: "cluster and sentinel",
+			cfg: &Config{
+				Cluster:  &ClusterConfig{Endpoints: []string{"redis-0:6379"}},
+				Sentinel: &SentinelConfig{Endpoints: []string{"sentinel-0:26379"}, PrimaryName: "mymaster"},
+			},
+			expected: errClusterAndSentinel,
+		},
+		{
+			desc:     "cluster without endpoints",
+			cfg:      &Config{Cluster: &ClusterConfig{}},
+			expected: errNoClusterEndpoints,
+		},
+		{
+			desc:     "sentinel without endpoints and primary",
+			cfg:      &Config{Sentinel: &SentinelConfig{}},
+			expected: multierr.Combine(errNoSentinelEndpoints, errNoSentinelPrimary),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.cfg.Validate())
+		})
+	}
+}
@@ -21,6 +21,9 @@ type MetricsSettings struct {
 	RedisClientsConnected                  MetricSettings `mapstructure:"redis.clients.connected"`
 	RedisClientsMaxInputBuffer             MetricSettings `mapstructure:"redis.clients.max_input_buffer"`
 	RedisClientsMaxOutputBuffer            MetricSettings `mapstructure:"redis.clients.max_output_buffer"`
+	RedisClusterKnownNodes                 MetricSettings `mapstructure:"redis.cluster.known_nodes"`
+	RedisClusterSlots                      MetricSettings `mapstructure:"redis.cluster.slots"`
+	RedisClusterState                      MetricSettings `mapstructure:"redis.cluster.state"`
 	RedisCmdCalls                          MetricSettings `mapstructure:"redis.cmd.calls"`
 	RedisCmdUsec                           MetricSettings `mapstructure:"redis.cmd.usec"`
 	RedisCommands                          MetricSettings `mapstructure:"redis.commands"`
@@ -66,6 +69,15 @@ func DefaultMetricsSettings() MetricsSettings {
 		RedisClientsMaxOutputBuffer: MetricSettings{
 			Enabled: true,
 		},
+		RedisClusterKnownNodes: MetricSettings{
+			Enabled: true,
+		},
+		RedisClusterSlots: MetricSettings{
+			Enabled: true,
+		},
+		RedisClusterState: MetricSettings{
+			Enabled: true,
+		},
 		RedisCmdCalls: MetricSettings{
 			Enabled: false,
 		},
@@ -182,6 +194,40 @@ var MapAttributeRole = map[string]AttributeRole{
 	"primary": AttributeRolePrimary,
 }
 
+// AttributeSlotState specifies the a value slot_state attribute.
+type AttributeSlotState int
+
+const (
+	_ AttributeSlotState = iota
+	AttributeSlotStateOk
+	AttributeSlotStatePfail
+	AttributeSlotStateFail
+	AttributeSlotStateUnassigned
+)
+
+// String returns the string representation of the AttributeSlotState.
+func (av AttributeSlotState) String() string {
+	switch av {
+	case AttributeSlotStateOk:
+		return "ok"
+	case AttributeSlotStatePfail:
+		return "pfail"
+	case AttributeSlotStateFail:
+		return "fail"
+	case AttributeSlotStateUnassigned:
+		return "unassigned"
+	}
+	return ""
+}
+
+// MapAttributeSlotState is a helper map of string to AttributeSlotState attribute value.
+var MapAttributeSlotState = map[string]AttributeSlotState{
+	"ok":         AttributeSlotStateOk,
+	"pfail":      AttributeSlotStatePfail,
+	"fail":       AttributeSlotStateFail,
+	"unassigned": AttributeSlotStateUnassigned,
+}
+
 type metricRedisClientsBlocked struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -382,6 +428,155 @@ func newMetricRedisClientsMaxOutputBuffer(settings MetricSettings) metricRedisCl
 	return m
 }
 
+type metricRedisClusterKnownNodes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cluster.known_nodes metric with initial data.
+func (m *metricRedisClusterKnownNodes) init() {
+	m.data.SetName("redis.cluster.known_nodes")
+	m.data.SetDescription("Number of nodes known to the Redis Cluster, including nodes in handshake state")
+	m.data.SetUnit("{nodes}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricRedisClusterKnownNodes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClusterKnownNodes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClusterKnownNodes) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClusterKnownNodes(settings MetricSettings) metricRedisClusterKnownNodes {
+	m := metricRedisClusterKnownNodes{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisClusterSlots struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cluster.slots metric with initial data.
+func (m *metricRedisClusterSlots) init() {
+	m.data.SetName("redis.cluster.slots")
+	m.data.SetDescription("Number of Redis Cluster hash slots by state")
+	m.data.SetUnit("{slots}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisClusterSlots) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, slotStateAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("slot_state", slotStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClusterSlots) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClusterSlots) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClusterSlots(settings MetricSettings) metricRedisClusterSlots {
+	m := metricRedisClusterSlots{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisClusterState struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cluster.state metric with initial data.
+func (m *metricRedisClusterState) init() {
+	m.data.SetName("redis.cluster.state")
+	m.data.SetDescription("Whether the Redis Cluster is able to serve queries, 1 when the cluster state is ok and 0 otherwise")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricRedisClusterState) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClusterState) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClusterState) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClusterState(settings MetricSettings) metricRedisClusterState {
+	m := metricRedisClusterState{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisCmdCalls struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1861,6 +2056,9 @@ type MetricsBuilder struct {
 	metricRedisClientsConnected                  metricRedisClientsConnected
 	metricRedisClientsMaxInputBuffer             metricRedisClientsMaxInputBuffer
 	metricRedisClientsMaxOutputBuffer            metricRedisClientsMaxOutputBuffer
+	metricRedisClusterKnownNodes                 metricRedisClusterKnownNodes
+	metricRedisClusterSlots                      metricRedisClusterSlots
+	metricRedisClusterState                      metricRedisClusterState
 	metricRedisCmdCalls                          metricRedisCmdCalls
 	metricRedisCmdUsec                           metricRedisCmdUsec
 	metricRedisCommands                          metricRedisCommands
@@ -1911,6 +2109,9 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricRedisClientsConnected:                  newMetricRedisClientsConnected(settings.RedisClientsConnected),
 		metricRedisClientsMaxInputBuffer:             newMetricRedisClientsMaxInputBuffer(settings.RedisClientsMaxInputBuffer),
 		metricRedisClientsMaxOutputBuffer:            newMetricRedisClientsMaxOutputBuffer(settings.RedisClientsMaxOutputBuffer),
+		metricRedisClusterKnownNodes:                 newMetricRedisClusterKnownNodes(settings.RedisClusterKnownNodes),
+		metricRedisClusterSlots:                      newMetricRedisClusterSlots(settings.RedisClusterSlots),
+		metricRedisClusterState:                      newMetricRedisClusterState(settings.RedisClusterState),
 		metricRedisCmdCalls:                          newMetricRedisCmdCalls(settings.RedisCmdCalls),
 		metricRedisCmdUsec:                           newMetricRedisCmdUsec(settings.RedisCmdUsec),
 		metricRedisCommands:                          newMetricRedisCommands(settings.RedisCommands),
@@ -1960,6 +2161,13 @@ func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
 // ResourceMetricsOption applies changes to provided resource metrics.
 type ResourceMetricsOption func(pmetric.ResourceMetrics)
 
+// WithRedisNodeAddress sets provided value as "redis.node.address" attribute for current resource.
+func WithRedisNodeAddress(val string) ResourceMetricsOption {
+	return func(rm pmetric.ResourceMetrics) {
+		rm.Resource().Attributes().PutStr("redis.node.address", val)
+	}
+}
+
 // WithRedisVersion sets provided value as "redis.version" attribute for current resource.
 func WithRedisVersion(val string) ResourceMetricsOption {
 	return func(rm pmetric.ResourceMetrics) {
@@ -2003,6 +2211,9 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricRedisClientsConnected.emit(ils.Metrics())
 	mb.metricRedisClientsMaxInputBuffer.emit(ils.Metrics())
 	mb.metricRedisClientsMaxOutputBuffer.emit(ils.Metrics())
+	mb.metricRedisClusterKnownNodes.emit(ils.Metrics())
+	mb.metricRedisClusterSlots.emit(ils.Metrics())
+	mb.metricRedisClusterState.emit(ils.Metrics())
 	mb.metricRedisCmdCalls.emit(ils.Metrics())
 	mb.metricRedisCmdUsec.emit(ils.Metrics())
 	mb.metricRedisCommands.emit(ils.Metrics())
@@ -2071,6 +2282,21 @@ func (mb *MetricsBuilder) RecordRedisClientsMaxOutputBufferDataPoint(ts pcommon.
 	mb.metricRedisClientsMaxOutputBuffer.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisClusterKnownNodesDataPoint adds a data point to redis.cluster.known_nodes metric.
+func (mb *MetricsBuilder) RecordRedisClusterKnownNodesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRedisClusterKnownNodes.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisClusterSlotsDataPoint adds a data point to redis.cluster.slots metric.
+func (mb *MetricsBuilder) RecordRedisClusterSlotsDataPoint(ts pcommon.Timestamp, val int64, slotStateAttributeValue AttributeSlotState) {
+	mb.metricRedisClusterSlots.recordDataPoint(mb.startTime, ts, val, slotStateAttributeValue.String())
+}
+
+// RecordRedisClusterStateDataPoint adds a data point to redis.cluster.state metric.
+func (mb *MetricsBuilder) RecordRedisClusterStateDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRedisClusterState.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordRedisCmdCallsDataPoint adds a data point to redis.cmd.calls metric.
 func (mb *MetricsBuilder) RecordRedisCmdCallsDataPoint(ts pcommon.Timestamp, val int64, cmdAttributeValue string) {
 	mb.metricRedisCmdCalls.recordDataPoint(mb.startTime, ts, val, cmdAttributeValue)
@@ -16,6 +16,7 @@ package redisreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -24,6 +25,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/zap"
 
@@ -34,23 +36,49 @@ import (
 // and feeding them to a metricsConsumer.
 type redisScraper struct {
 	redisSvc *redisSvc
+	cluster  clusterClient // set when scraping a Redis Cluster
 	settings component.TelemetrySettings
 	mb       *metadata.MetricsBuilder
 	uptime   time.Duration
 }
 
-const redisMaxDbs = 16 // Maximum possible number of redis databases
+const (
+	redisMaxDbs        = 16    // Maximum possible number of redis databases
+	redisClusterSlots  = 16384 // Number of hash slots in a Redis Cluster
+	clusterStateOK     = "ok"
+	clusterStateFailed = "fail"
+)
 
 func newRedisScraper(cfg *Config, settings component.ReceiverCreateSettings) (scraperhelper.Scraper, error) {
-	opts := &redis.Options{
-		Addr:     cfg.Endpoint,
-		Password: cfg.Password,
-		Network:  cfg.Transport,
+	tlsConfig, err := cfg.TLS.LoadTLSConfig()
+	if err != nil {
+		return nil, err
 	}
 
-	var err error
-	if opts.TLSConfig, err = cfg.TLS.LoadTLSConfig(); err != nil {
-		return nil, err
+	switch {
+	case cfg.Cluster != nil:
+		opts := &redis.ClusterOptions{
+			Addrs:     cfg.Cluster.Endpoints,
+			Password:  cfg.Password,
+			TLSConfig: tlsConfig,
+		}
+		return newRedisClusterScraperWithClient(newRedisClusterClient(opts), settings, cfg)
+	case cfg.Sentinel != nil:
+		opts := &redis.FailoverOptions{
+			MasterName:       cfg.Sentinel.PrimaryName,
+			SentinelAddrs:    cfg.Sentinel.Endpoints,
+			SentinelPassword: cfg.Sentinel.Password,
+			Password:         cfg.Password,
+			TLSConfig:        tlsConfig,
+		}
+		return newRedisScraperWithClient(newRedisFailoverClient(opts), settings, cfg)
+	}
+
+	opts := &redis.Options{
+		Addr:      cfg.Endpoint,
+		Password:  cfg.Password,
+		Network:   cfg.Transport,
+		TLSConfig: tlsConfig,
 	}
 	return newRedisScraperWithClient(newRedisClient(opts), settings, cfg)
 }
@@ -64,12 +92,25 @@ func newRedisScraperWithClient(client client, settings component.ReceiverCreateS
 	return scraperhelper.NewScraper(typeStr, rs.Scrape)
 }
 
+func newRedisClusterScraperWithClient(cluster clusterClient, settings component.ReceiverCreateSettings, cfg *Config) (scraperhelper.Scraper, error) {
+	rs := &redisScraper{
+		cluster:  cluster,
+		settings: settings.TelemetrySettings,
+		mb:       metadata.NewMetricsBuilder(cfg.Metrics, settings.BuildInfo),
+	}
+	return scraperhelper.NewScraper(typeStr, rs.Scrape)
+}
+
 // Scrape is called periodically, querying Redis and building Metrics to send to
 // the next consumer. First builds 'fixed' metrics (non-keyspace metrics)
 // defined at startup time. Then builds 'keyspace' metrics if there are any
 // keyspace lines returned by Redis. There should be one keyspace line per
 // active Redis database, of which there can be 16.
 func (rs *redisScraper) Scrape(context.Context) (pmetric.Metrics, error) {
+	if rs.cluster != nil {
+		return rs.scrapeCluster()
+	}
+
 	inf, err := rs.redisSvc.info()
 	if err != nil {
 		return pmetric.Metrics{}, err
@@ -86,13 +127,97 @@ func (rs *redisScraper) Scrape(context.Context) (pmetric.Metrics, error) {
 	}
 	rs.uptime = currentUptime
 
-	rs.recordCommonMetrics(now, inf)
-	rs.recordKeyspaceMetrics(now, inf)
-	rs.recordRoleMetrics(now, inf)
-	rs.recordCmdStatsMetrics(now, inf)
+	rs.recordNodeMetrics(now, inf)
 	return rs.mb.Emit(metadata.WithRedisVersion(rs.getRedisVersion(inf))), nil
 }
 
+// scrapeCluster builds the metrics of every node in a Redis Cluster, each node
+// is emitted as its own resource. The cluster wide slot coverage is emitted last.
+// Nodes restart independently, so the start time of cumulative metrics is not
+// derived from their uptime.
+func (rs *redisScraper) scrapeCluster() (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+	errs := &scrapererror.ScrapeErrors{}
+
+	nodeInfos, err := rs.cluster.retrieveNodeInfos()
+	if err != nil {
+		errs.AddPartial(1, err)
+	}
+	addrs := make([]string, 0, len(nodeInfos))
+	for addr := range nodeInfos {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	for _, addr := range addrs {
+		inf := parseInfo(nodeInfos[addr], rs.cluster.delimiter())
+		rs.recordNodeMetrics(now, inf)
+		rs.mb.EmitForResource(
+			metadata.WithRedisVersion(rs.getRedisVersion(inf)),
+			metadata.WithRedisNodeAddress(addr),
+		)
+	}
+
+	clusterInfo, err := rs.cluster.retrieveClusterInfo()
+	if err != nil {
+		errs.AddPartial(1, err)
+	} else {
+		rs.recordClusterMetrics(now, parseInfo(clusterInfo, rs.cluster.delimiter()))
+	}
+
+	return rs.mb.Emit(), errs.Combine()
+}
+
+// recordNodeMetrics records the metrics built from the INFO of a single node.
+func (rs *redisScraper) recordNodeMetrics(ts pcommon.Timestamp, inf info) {
+	rs.recordCommonMetrics(ts, inf)
+	rs.recordKeyspaceMetrics(ts, inf)
+	rs.recordRoleMetrics(ts, inf)
+	rs.recordCmdStatsMetrics(ts, inf)
+}
+
+// recordClusterMetrics records metrics from 'CLUSTER INFO' Redis key-value pairs
+// e.g. "cluster_state:ok" or "cluster_slots_ok:16384"
+func (rs *redisScraper) recordClusterMetrics(ts pcommon.Timestamp, inf info) {
+	switch inf["cluster_state"] {
+	case clusterStateOK:
+		rs.mb.RecordRedisClusterStateDataPoint(ts, 1)
+	case clusterStateFailed:
+		rs.mb.RecordRedisClusterStateDataPoint(ts, 0)
+	}
+
+	slotStates := map[string]metadata.AttributeSlotState{
+		"cluster_slots_ok":    metadata.AttributeSlotStateOk,
+		"cluster_slots_pfail": metadata.AttributeSlotStatePfail,
+		"cluster_slots_fail":  metadata.AttributeSlotStateFail,
+	}
+	for key, slotState := range slotStates {
+		if val, ok := rs.parseClusterInfoInt(inf, key); ok {
+			rs.mb.RecordRedisClusterSlotsDataPoint(ts, val, slotState)
+		}
+	}
+	if assigned, ok := rs.parseClusterInfoInt(inf, "cluster_slots_assigned"); ok {
+		rs.mb.RecordRedisClusterSlotsDataPoint(ts, redisClusterSlots-assigned, metadata.AttributeSlotStateUnassigned)
+	}
+
+	if val, ok := rs.parseClusterInfoInt(inf, "cluster_known_nodes"); ok {
+		rs.mb.RecordRedisClusterKnownNodesDataPoint(ts, val)
+	}
+}
+
+func (rs *redisScraper) parseClusterInfoInt(inf info, key string) (int64, bool) {
+	str, ok := inf[key]
+	if !ok {
+		return 0, false
+	}
+	val, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		rs.settings.Logger.Warn("failed to parse cluster info int val", zap.String("key", key),
+			zap.String("val", str), zap.Error(err))
+		return 0, false
+	}
+	return val, true
+}
+
 // recordCommonMetrics records metrics from Redis info key-value pairs.
 func (rs *redisScraper) recordCommonMetrics(ts pcommon.Timestamp, inf info) {
 	recorders := rs.dataPointRecorders()
@@ -46,6 +46,69 @@ func TestRedisRunnable(t *testing.T) {
 	assert.Equal(t, "otelcol/redisreceiver", il.Name())
 }
 
+var _ clusterClient = (*fakeClusterClient)(nil)
+
+type fakeClusterClient struct {
+	fakeClient
+	nodes []string
+}
+
+func (c fakeClusterClient) retrieveNodeInfos() (map[string]string, error) {
+	infos := map[string]string{}
+	for _, node := range c.nodes {
+		inf, err := readFile("info")
+		if err != nil {
+			return nil, err
+		}
+		infos[node] = inf
+	}
+	return infos, nil
+}
+
+func (fakeClusterClient) retrieveClusterInfo() (string, error) {
+	return readFile("cluster_info")
+}
+
+func TestRedisClusterRunnable(t *testing.T) {
+	settings := componenttest.NewNopReceiverCreateSettings()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Cluster = &ClusterConfig{Endpoints: []string{"10.0.0.1:6379"}}
+	runner, err := newRedisClusterScraperWithClient(fakeClusterClient{nodes: []string{"10.0.0.1:6379", "10.0.0.2:6379"}}, settings, cfg)
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+
+	// one resource per node followed by the cluster wide metrics
+	require.Equal(t, 3, md.ResourceMetrics().Len())
+	for i, node := range []string{"10.0.0.1:6379", "10.0.0.2:6379"} {
+		addr, ok := md.ResourceMetrics().At(i).Resource().Attributes().Get("redis.node.address")
+		require.True(t, ok)
+		assert.Equal(t, node, addr.Str())
+	}
+
+	slots := map[string]int64{}
+	ms := md.ResourceMetrics().At(2).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		switch m.Name() {
+		case "redis.cluster.state":
+			assert.EqualValues(t, 1, m.Gauge().DataPoints().At(0).IntValue())
+		case "redis.cluster.known_nodes":
+			assert.EqualValues(t, 6, m.Gauge().DataPoints().At(0).IntValue())
+		case "redis.cluster.slots":
+			for j := 0; j < m.Gauge().DataPoints().Len(); j++ {
+				dp := m.Gauge().DataPoints().At(j)
+				state, ok := dp.Attributes().Get("slot_state")
+				require.True(t, ok)
+				slots[state.Str()] = dp.IntValue()
+			}
+		default:
+			t.Errorf("unexpected cluster metric %s", m.Name())
+		}
+	}
+	assert.Equal(t, map[string]int64{"ok": 15990, "pfail": 6, "fail": 4, "unassigned": 384}, slots)
+}
+
 func TestNewReceiver_invalid_auth_error(t *testing.T) {
 	c := createDefaultConfig().(*Config)
 	c.TLS = configtls.TLSClientSetting{
@@ -39,7 +39,13 @@ func (p *redisSvc) info() (info, error) {
 	if err != nil {
 		return nil, err
 	}
-	lines := strings.Split(str, p.delimiter)
+	return parseInfo(str, p.delimiter), nil
+}
+
+// Parses the key value pairs of an INFO or CLUSTER INFO reply, skipping section
+// headers.
+func parseInfo(str string, delimiter string) info {
+	lines := strings.Split(str, delimiter)
 	attrs := make(map[string]string)
 	for _, line := range lines {
 		if len(line) == 0 || strings.HasPrefix(line, "#") {
@@ -50,5 +56,5 @@ func (p *redisSvc) info() (info, error) {
 			attrs[pair[0]] = pair[1]
 		}
 	}
-	return attrs, nil
+	return attrs
 }
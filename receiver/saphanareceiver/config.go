@@ -40,6 +40,10 @@ type Config struct {
 
 	Username string `mapstructure:"username"`
 	Password string `mapstructure:"password"`
+
+	// TraceQueries enables the emission of a span for each monitoring query run against SAP HANA,
+	// using the tracer provider of the collector's own telemetry.
+	TraceQueries bool `mapstructure:"trace_queries"`
 }
 
 func (cfg *Config) Validate() error {
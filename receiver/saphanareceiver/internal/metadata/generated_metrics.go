@@ -24,6 +24,7 @@ type MetricsSettings struct {
 	SaphanaColumnMemoryUsed                 MetricSettings `mapstructure:"saphana.column.memory.used"`
 	SaphanaComponentMemoryUsed              MetricSettings `mapstructure:"saphana.component.memory.used"`
 	SaphanaConnectionCount                  MetricSettings `mapstructure:"saphana.connection.count"`
+	SaphanaCPUTime                          MetricSettings `mapstructure:"saphana.cpu.time"`
 	SaphanaCPUUsed                          MetricSettings `mapstructure:"saphana.cpu.used"`
 	SaphanaDiskSizeCurrent                  MetricSettings `mapstructure:"saphana.disk.size.current"`
 	SaphanaHostMemoryCurrent                MetricSettings `mapstructure:"saphana.host.memory.current"`
@@ -35,10 +36,13 @@ type MetricsSettings struct {
 	SaphanaLicenseExpirationTime            MetricSettings `mapstructure:"saphana.license.expiration.time"`
 	SaphanaLicenseLimit                     MetricSettings `mapstructure:"saphana.license.limit"`
 	SaphanaLicensePeak                      MetricSettings `mapstructure:"saphana.license.peak"`
+	SaphanaNetworkRequestAverageDuration    MetricSettings `mapstructure:"saphana.network.request.average_duration"`
 	SaphanaNetworkRequestAverageTime        MetricSettings `mapstructure:"saphana.network.request.average_time"`
 	SaphanaNetworkRequestCount              MetricSettings `mapstructure:"saphana.network.request.count"`
 	SaphanaNetworkRequestFinishedCount      MetricSettings `mapstructure:"saphana.network.request.finished.count"`
+	SaphanaReplicationAverageDuration       MetricSettings `mapstructure:"saphana.replication.average_duration"`
 	SaphanaReplicationAverageTime           MetricSettings `mapstructure:"saphana.replication.average_time"`
+	SaphanaReplicationBacklogDuration       MetricSettings `mapstructure:"saphana.replication.backlog.duration"`
 	SaphanaReplicationBacklogSize           MetricSettings `mapstructure:"saphana.replication.backlog.size"`
 	SaphanaReplicationBacklogTime           MetricSettings `mapstructure:"saphana.replication.backlog.time"`
 	SaphanaRowStoreMemoryUsed               MetricSettings `mapstructure:"saphana.row_store.memory.used"`
@@ -62,6 +66,7 @@ type MetricsSettings struct {
 	SaphanaTransactionCount                 MetricSettings `mapstructure:"saphana.transaction.count"`
 	SaphanaUptime                           MetricSettings `mapstructure:"saphana.uptime"`
 	SaphanaVolumeOperationCount             MetricSettings `mapstructure:"saphana.volume.operation.count"`
+	SaphanaVolumeOperationDuration          MetricSettings `mapstructure:"saphana.volume.operation.duration"`
 	SaphanaVolumeOperationSize              MetricSettings `mapstructure:"saphana.volume.operation.size"`
 	SaphanaVolumeOperationTime              MetricSettings `mapstructure:"saphana.volume.operation.time"`
 }
@@ -83,6 +88,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		SaphanaConnectionCount: MetricSettings{
 			Enabled: true,
 		},
+		SaphanaCPUTime: MetricSettings{
+			Enabled: false,
+		},
 		SaphanaCPUUsed: MetricSettings{
 			Enabled: true,
 		},
@@ -116,6 +124,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		SaphanaLicensePeak: MetricSettings{
 			Enabled: true,
 		},
+		SaphanaNetworkRequestAverageDuration: MetricSettings{
+			Enabled: false,
+		},
 		SaphanaNetworkRequestAverageTime: MetricSettings{
 			Enabled: true,
 		},
@@ -125,9 +136,15 @@ func DefaultMetricsSettings() MetricsSettings {
 		SaphanaNetworkRequestFinishedCount: MetricSettings{
 			Enabled: true,
 		},
+		SaphanaReplicationAverageDuration: MetricSettings{
+			Enabled: false,
+		},
 		SaphanaReplicationAverageTime: MetricSettings{
 			Enabled: true,
 		},
+		SaphanaReplicationBacklogDuration: MetricSettings{
+			Enabled: false,
+		},
 		SaphanaReplicationBacklogSize: MetricSettings{
 			Enabled: true,
 		},
@@ -197,6 +214,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		SaphanaVolumeOperationCount: MetricSettings{
 			Enabled: true,
 		},
+		SaphanaVolumeOperationDuration: MetricSettings{
+			Enabled: false,
+		},
 		SaphanaVolumeOperationSize: MetricSettings{
 			Enabled: true,
 		},
@@ -980,6 +1000,59 @@ func newMetricSaphanaConnectionCount(settings MetricSettings) metricSaphanaConne
 	return m
 }
 
+type metricSaphanaCPUTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills saphana.cpu.time metric with initial data.
+func (m *metricSaphanaCPUTime) init() {
+	m.data.SetName("saphana.cpu.time")
+	m.data.SetDescription("Total CPU time spent.")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSaphanaCPUTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, cpuTypeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("type", cpuTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSaphanaCPUTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSaphanaCPUTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSaphanaCPUTime(settings MetricSettings) metricSaphanaCPUTime {
+	m := metricSaphanaCPUTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSaphanaCPUUsed struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1560,6 +1633,55 @@ func newMetricSaphanaLicensePeak(settings MetricSettings) metricSaphanaLicensePe
 	return m
 }
 
+type metricSaphanaNetworkRequestAverageDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills saphana.network.request.average_duration metric with initial data.
+func (m *metricSaphanaNetworkRequestAverageDuration) init() {
+	m.data.SetName("saphana.network.request.average_duration")
+	m.data.SetDescription("The average response time calculated over recent requests.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSaphanaNetworkRequestAverageDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSaphanaNetworkRequestAverageDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSaphanaNetworkRequestAverageDuration) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSaphanaNetworkRequestAverageDuration(settings MetricSettings) metricSaphanaNetworkRequestAverageDuration {
+	m := metricSaphanaNetworkRequestAverageDuration{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSaphanaNetworkRequestAverageTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1715,6 +1837,60 @@ func newMetricSaphanaNetworkRequestFinishedCount(settings MetricSettings) metric
 	return m
 }
 
+type metricSaphanaReplicationAverageDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills saphana.replication.average_duration metric with initial data.
+func (m *metricSaphanaReplicationAverageDuration) init() {
+	m.data.SetName("saphana.replication.average_duration")
+	m.data.SetDescription("The average amount of time consumed replicating a log.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSaphanaReplicationAverageDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, primaryHostAttributeValue string, secondaryHostAttributeValue string, portAttributeValue string, replicationModeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("primary", primaryHostAttributeValue)
+	dp.Attributes().PutStr("secondary", secondaryHostAttributeValue)
+	dp.Attributes().PutStr("port", portAttributeValue)
+	dp.Attributes().PutStr("mode", replicationModeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSaphanaReplicationAverageDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSaphanaReplicationAverageDuration) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSaphanaReplicationAverageDuration(settings MetricSettings) metricSaphanaReplicationAverageDuration {
+	m := metricSaphanaReplicationAverageDuration{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSaphanaReplicationAverageTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1769,6 +1945,62 @@ func newMetricSaphanaReplicationAverageTime(settings MetricSettings) metricSapha
 	return m
 }
 
+type metricSaphanaReplicationBacklogDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills saphana.replication.backlog.duration metric with initial data.
+func (m *metricSaphanaReplicationBacklogDuration) init() {
+	m.data.SetName("saphana.replication.backlog.duration")
+	m.data.SetDescription("The current replication backlog.")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSaphanaReplicationBacklogDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, primaryHostAttributeValue string, secondaryHostAttributeValue string, portAttributeValue string, replicationModeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("primary", primaryHostAttributeValue)
+	dp.Attributes().PutStr("secondary", secondaryHostAttributeValue)
+	dp.Attributes().PutStr("port", portAttributeValue)
+	dp.Attributes().PutStr("mode", replicationModeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSaphanaReplicationBacklogDuration) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSaphanaReplicationBacklogDuration) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSaphanaReplicationBacklogDuration(settings MetricSettings) metricSaphanaReplicationBacklogDuration {
+	m := metricSaphanaReplicationBacklogDuration{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSaphanaReplicationBacklogSize struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -3001,6 +3233,61 @@ func newMetricSaphanaVolumeOperationCount(settings MetricSettings) metricSaphana
 	return m
 }
 
+type metricSaphanaVolumeOperationDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills saphana.volume.operation.duration metric with initial data.
+func (m *metricSaphanaVolumeOperationDuration) init() {
+	m.data.SetName("saphana.volume.operation.duration")
+	m.data.SetDescription("The time spent executing operations.")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSaphanaVolumeOperationDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, pathAttributeValue string, diskUsageTypeAttributeValue string, volumeOperationTypeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("path", pathAttributeValue)
+	dp.Attributes().PutStr("usage_type", diskUsageTypeAttributeValue)
+	dp.Attributes().PutStr("type", volumeOperationTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSaphanaVolumeOperationDuration) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSaphanaVolumeOperationDuration) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSaphanaVolumeOperationDuration(settings MetricSettings) metricSaphanaVolumeOperationDuration {
+	m := metricSaphanaVolumeOperationDuration{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSaphanaVolumeOperationSize struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -3124,6 +3411,7 @@ type MetricsBuilder struct {
 	metricSaphanaColumnMemoryUsed                 metricSaphanaColumnMemoryUsed
 	metricSaphanaComponentMemoryUsed              metricSaphanaComponentMemoryUsed
 	metricSaphanaConnectionCount                  metricSaphanaConnectionCount
+	metricSaphanaCPUTime                          metricSaphanaCPUTime
 	metricSaphanaCPUUsed                          metricSaphanaCPUUsed
 	metricSaphanaDiskSizeCurrent                  metricSaphanaDiskSizeCurrent
 	metricSaphanaHostMemoryCurrent                metricSaphanaHostMemoryCurrent
@@ -3135,10 +3423,13 @@ type MetricsBuilder struct {
 	metricSaphanaLicenseExpirationTime            metricSaphanaLicenseExpirationTime
 	metricSaphanaLicenseLimit                     metricSaphanaLicenseLimit
 	metricSaphanaLicensePeak                      metricSaphanaLicensePeak
+	metricSaphanaNetworkRequestAverageDuration    metricSaphanaNetworkRequestAverageDuration
 	metricSaphanaNetworkRequestAverageTime        metricSaphanaNetworkRequestAverageTime
 	metricSaphanaNetworkRequestCount              metricSaphanaNetworkRequestCount
 	metricSaphanaNetworkRequestFinishedCount      metricSaphanaNetworkRequestFinishedCount
+	metricSaphanaReplicationAverageDuration       metricSaphanaReplicationAverageDuration
 	metricSaphanaReplicationAverageTime           metricSaphanaReplicationAverageTime
+	metricSaphanaReplicationBacklogDuration       metricSaphanaReplicationBacklogDuration
 	metricSaphanaReplicationBacklogSize           metricSaphanaReplicationBacklogSize
 	metricSaphanaReplicationBacklogTime           metricSaphanaReplicationBacklogTime
 	metricSaphanaRowStoreMemoryUsed               metricSaphanaRowStoreMemoryUsed
@@ -3162,6 +3453,7 @@ type MetricsBuilder struct {
 	metricSaphanaTransactionCount                 metricSaphanaTransactionCount
 	metricSaphanaUptime                           metricSaphanaUptime
 	metricSaphanaVolumeOperationCount             metricSaphanaVolumeOperationCount
+	metricSaphanaVolumeOperationDuration          metricSaphanaVolumeOperationDuration
 	metricSaphanaVolumeOperationSize              metricSaphanaVolumeOperationSize
 	metricSaphanaVolumeOperationTime              metricSaphanaVolumeOperationTime
 }
@@ -3186,6 +3478,7 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricSaphanaColumnMemoryUsed:                 newMetricSaphanaColumnMemoryUsed(settings.SaphanaColumnMemoryUsed),
 		metricSaphanaComponentMemoryUsed:              newMetricSaphanaComponentMemoryUsed(settings.SaphanaComponentMemoryUsed),
 		metricSaphanaConnectionCount:                  newMetricSaphanaConnectionCount(settings.SaphanaConnectionCount),
+		metricSaphanaCPUTime:                          newMetricSaphanaCPUTime(settings.SaphanaCPUTime),
 		metricSaphanaCPUUsed:                          newMetricSaphanaCPUUsed(settings.SaphanaCPUUsed),
 		metricSaphanaDiskSizeCurrent:                  newMetricSaphanaDiskSizeCurrent(settings.SaphanaDiskSizeCurrent),
 		metricSaphanaHostMemoryCurrent:                newMetricSaphanaHostMemoryCurrent(settings.SaphanaHostMemoryCurrent),
@@ -3197,10 +3490,13 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricSaphanaLicenseExpirationTime:            newMetricSaphanaLicenseExpirationTime(settings.SaphanaLicenseExpirationTime),
 		metricSaphanaLicenseLimit:                     newMetricSaphanaLicenseLimit(settings.SaphanaLicenseLimit),
 		metricSaphanaLicensePeak:                      newMetricSaphanaLicensePeak(settings.SaphanaLicensePeak),
+		metricSaphanaNetworkRequestAverageDuration:    newMetricSaphanaNetworkRequestAverageDuration(settings.SaphanaNetworkRequestAverageDuration),
 		metricSaphanaNetworkRequestAverageTime:        newMetricSaphanaNetworkRequestAverageTime(settings.SaphanaNetworkRequestAverageTime),
 		metricSaphanaNetworkRequestCount:              newMetricSaphanaNetworkRequestCount(settings.SaphanaNetworkRequestCount),
 		metricSaphanaNetworkRequestFinishedCount:      newMetricSaphanaNetworkRequestFinishedCount(settings.SaphanaNetworkRequestFinishedCount),
+		metricSaphanaReplicationAverageDuration:       newMetricSaphanaReplicationAverageDuration(settings.SaphanaReplicationAverageDuration),
 		metricSaphanaReplicationAverageTime:           newMetricSaphanaReplicationAverageTime(settings.SaphanaReplicationAverageTime),
+		metricSaphanaReplicationBacklogDuration:       newMetricSaphanaReplicationBacklogDuration(settings.SaphanaReplicationBacklogDuration),
 		metricSaphanaReplicationBacklogSize:           newMetricSaphanaReplicationBacklogSize(settings.SaphanaReplicationBacklogSize),
 		metricSaphanaReplicationBacklogTime:           newMetricSaphanaReplicationBacklogTime(settings.SaphanaReplicationBacklogTime),
 		metricSaphanaRowStoreMemoryUsed:               newMetricSaphanaRowStoreMemoryUsed(settings.SaphanaRowStoreMemoryUsed),
@@ -3224,6 +3520,7 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricSaphanaTransactionCount:                 newMetricSaphanaTransactionCount(settings.SaphanaTransactionCount),
 		metricSaphanaUptime:                           newMetricSaphanaUptime(settings.SaphanaUptime),
 		metricSaphanaVolumeOperationCount:             newMetricSaphanaVolumeOperationCount(settings.SaphanaVolumeOperationCount),
+		metricSaphanaVolumeOperationDuration:          newMetricSaphanaVolumeOperationDuration(settings.SaphanaVolumeOperationDuration),
 		metricSaphanaVolumeOperationSize:              newMetricSaphanaVolumeOperationSize(settings.SaphanaVolumeOperationSize),
 		metricSaphanaVolumeOperationTime:              newMetricSaphanaVolumeOperationTime(settings.SaphanaVolumeOperationTime),
 	}
@@ -3297,6 +3594,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSaphanaColumnMemoryUsed.emit(ils.Metrics())
 	mb.metricSaphanaComponentMemoryUsed.emit(ils.Metrics())
 	mb.metricSaphanaConnectionCount.emit(ils.Metrics())
+	mb.metricSaphanaCPUTime.emit(ils.Metrics())
 	mb.metricSaphanaCPUUsed.emit(ils.Metrics())
 	mb.metricSaphanaDiskSizeCurrent.emit(ils.Metrics())
 	mb.metricSaphanaHostMemoryCurrent.emit(ils.Metrics())
@@ -3308,10 +3606,13 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSaphanaLicenseExpirationTime.emit(ils.Metrics())
 	mb.metricSaphanaLicenseLimit.emit(ils.Metrics())
 	mb.metricSaphanaLicensePeak.emit(ils.Metrics())
+	mb.metricSaphanaNetworkRequestAverageDuration.emit(ils.Metrics())
 	mb.metricSaphanaNetworkRequestAverageTime.emit(ils.Metrics())
 	mb.metricSaphanaNetworkRequestCount.emit(ils.Metrics())
 	mb.metricSaphanaNetworkRequestFinishedCount.emit(ils.Metrics())
+	mb.metricSaphanaReplicationAverageDuration.emit(ils.Metrics())
 	mb.metricSaphanaReplicationAverageTime.emit(ils.Metrics())
+	mb.metricSaphanaReplicationBacklogDuration.emit(ils.Metrics())
 	mb.metricSaphanaReplicationBacklogSize.emit(ils.Metrics())
 	mb.metricSaphanaReplicationBacklogTime.emit(ils.Metrics())
 	mb.metricSaphanaRowStoreMemoryUsed.emit(ils.Metrics())
@@ -3335,6 +3636,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSaphanaTransactionCount.emit(ils.Metrics())
 	mb.metricSaphanaUptime.emit(ils.Metrics())
 	mb.metricSaphanaVolumeOperationCount.emit(ils.Metrics())
+	mb.metricSaphanaVolumeOperationDuration.emit(ils.Metrics())
 	mb.metricSaphanaVolumeOperationSize.emit(ils.Metrics())
 	mb.metricSaphanaVolumeOperationTime.emit(ils.Metrics())
 	for _, op := range rmo {
@@ -3406,6 +3708,11 @@ func (mb *MetricsBuilder) RecordSaphanaConnectionCountDataPoint(ts pcommon.Times
 	return nil
 }
 
+// RecordSaphanaCPUTimeDataPoint adds a data point to saphana.cpu.time metric.
+func (mb *MetricsBuilder) RecordSaphanaCPUTimeDataPoint(ts pcommon.Timestamp, val float64, cpuTypeAttributeValue AttributeCPUType) {
+	mb.metricSaphanaCPUTime.recordDataPoint(mb.startTime, ts, val, cpuTypeAttributeValue.String())
+}
+
 // RecordSaphanaCPUUsedDataPoint adds a data point to saphana.cpu.used metric.
 func (mb *MetricsBuilder) RecordSaphanaCPUUsedDataPoint(ts pcommon.Timestamp, inputVal string, cpuTypeAttributeValue AttributeCPUType) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -3516,6 +3823,11 @@ func (mb *MetricsBuilder) RecordSaphanaLicensePeakDataPoint(ts pcommon.Timestamp
 	return nil
 }
 
+// RecordSaphanaNetworkRequestAverageDurationDataPoint adds a data point to saphana.network.request.average_duration metric.
+func (mb *MetricsBuilder) RecordSaphanaNetworkRequestAverageDurationDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricSaphanaNetworkRequestAverageDuration.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordSaphanaNetworkRequestAverageTimeDataPoint adds a data point to saphana.network.request.average_time metric.
 func (mb *MetricsBuilder) RecordSaphanaNetworkRequestAverageTimeDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseFloat(inputVal, 64)
@@ -3546,6 +3858,11 @@ func (mb *MetricsBuilder) RecordSaphanaNetworkRequestFinishedCountDataPoint(ts p
 	return nil
 }
 
+// RecordSaphanaReplicationAverageDurationDataPoint adds a data point to saphana.replication.average_duration metric.
+func (mb *MetricsBuilder) RecordSaphanaReplicationAverageDurationDataPoint(ts pcommon.Timestamp, val float64, primaryHostAttributeValue string, secondaryHostAttributeValue string, portAttributeValue string, replicationModeAttributeValue string) {
+	mb.metricSaphanaReplicationAverageDuration.recordDataPoint(mb.startTime, ts, val, primaryHostAttributeValue, secondaryHostAttributeValue, portAttributeValue, replicationModeAttributeValue)
+}
+
 // RecordSaphanaReplicationAverageTimeDataPoint adds a data point to saphana.replication.average_time metric.
 func (mb *MetricsBuilder) RecordSaphanaReplicationAverageTimeDataPoint(ts pcommon.Timestamp, inputVal string, primaryHostAttributeValue string, secondaryHostAttributeValue string, portAttributeValue string, replicationModeAttributeValue string) error {
 	val, err := strconv.ParseFloat(inputVal, 64)
@@ -3556,6 +3873,11 @@ func (mb *MetricsBuilder) RecordSaphanaReplicationAverageTimeDataPoint(ts pcommo
 	return nil
 }
 
+// RecordSaphanaReplicationBacklogDurationDataPoint adds a data point to saphana.replication.backlog.duration metric.
+func (mb *MetricsBuilder) RecordSaphanaReplicationBacklogDurationDataPoint(ts pcommon.Timestamp, val float64, primaryHostAttributeValue string, secondaryHostAttributeValue string, portAttributeValue string, replicationModeAttributeValue string) {
+	mb.metricSaphanaReplicationBacklogDuration.recordDataPoint(mb.startTime, ts, val, primaryHostAttributeValue, secondaryHostAttributeValue, portAttributeValue, replicationModeAttributeValue)
+}
+
 // RecordSaphanaReplicationBacklogSizeDataPoint adds a data point to saphana.replication.backlog.size metric.
 func (mb *MetricsBuilder) RecordSaphanaReplicationBacklogSizeDataPoint(ts pcommon.Timestamp, inputVal string, primaryHostAttributeValue string, secondaryHostAttributeValue string, portAttributeValue string, replicationModeAttributeValue string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -3786,6 +4108,11 @@ func (mb *MetricsBuilder) RecordSaphanaVolumeOperationCountDataPoint(ts pcommon.
 	return nil
 }
 
+// RecordSaphanaVolumeOperationDurationDataPoint adds a data point to saphana.volume.operation.duration metric.
+func (mb *MetricsBuilder) RecordSaphanaVolumeOperationDurationDataPoint(ts pcommon.Timestamp, val float64, pathAttributeValue string, diskUsageTypeAttributeValue string, volumeOperationTypeAttributeValue AttributeVolumeOperationType) {
+	mb.metricSaphanaVolumeOperationDuration.recordDataPoint(mb.startTime, ts, val, pathAttributeValue, diskUsageTypeAttributeValue, volumeOperationTypeAttributeValue.String())
+}
+
 // RecordSaphanaVolumeOperationSizeDataPoint adds a data point to saphana.volume.operation.size metric.
 func (mb *MetricsBuilder) RecordSaphanaVolumeOperationSizeDataPoint(ts pcommon.Timestamp, inputVal string, pathAttributeValue string, diskUsageTypeAttributeValue string, volumeOperationTypeAttributeValue AttributeVolumeOperationType) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
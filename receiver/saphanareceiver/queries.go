@@ -18,10 +18,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saphanareceiver/internal/metadata"
 )
@@ -58,6 +63,15 @@ func (q *queryStat) collectStat(s *sapHanaScraper, m *monitoringQuery, now pcomm
 	return nil
 }
 
+// toSeconds converts a duration reported by SAP HANA in the specified unit to seconds
+func toSeconds(val string, unit time.Duration) (float64, error) {
+	f, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse float64 for duration, value was %s: %w", val, err)
+	}
+	return f * float64(unit) / float64(time.Second), nil
+}
+
 type monitoringQuery struct {
 	query                 string
 	orderedResourceLabels []string
@@ -402,6 +416,11 @@ var queries = []monitoringQuery{
 				key: "backlog_time",
 				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
 					row map[string]string) error {
+					seconds, err := toSeconds(val, time.Microsecond)
+					if err != nil {
+						return err
+					}
+					mb.RecordSaphanaReplicationBacklogDurationDataPoint(now, seconds, row["host"], row["secondary"], row["port"], row["mode"])
 					return mb.RecordSaphanaReplicationBacklogTimeDataPoint(now, val, row["host"], row["secondary"], row["port"], row["mode"])
 				},
 			},
@@ -409,14 +428,21 @@ var queries = []monitoringQuery{
 				key: "average_time",
 				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
 					row map[string]string) error {
+					seconds, err := toSeconds(val, time.Microsecond)
+					if err != nil {
+						return err
+					}
+					mb.RecordSaphanaReplicationAverageDurationDataPoint(now, seconds, row["host"], row["secondary"], row["port"], row["mode"])
 					return mb.RecordSaphanaReplicationAverageTimeDataPoint(now, val, row["host"], row["secondary"], row["port"], row["mode"])
 				},
 			},
 		},
 		Enabled: func(c *Config) bool {
 			return c.Metrics.SaphanaReplicationAverageTime.Enabled ||
+				c.Metrics.SaphanaReplicationAverageDuration.Enabled ||
 				c.Metrics.SaphanaReplicationBacklogSize.Enabled ||
-				c.Metrics.SaphanaReplicationBacklogTime.Enabled
+				c.Metrics.SaphanaReplicationBacklogTime.Enabled ||
+				c.Metrics.SaphanaReplicationBacklogDuration.Enabled
 		},
 	},
 	{
@@ -455,6 +481,11 @@ var queries = []monitoringQuery{
 				key: "avg_time",
 				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
 					row map[string]string) error {
+					seconds, err := toSeconds(val, time.Millisecond)
+					if err != nil {
+						return err
+					}
+					mb.RecordSaphanaNetworkRequestAverageDurationDataPoint(now, seconds)
 					return mb.RecordSaphanaNetworkRequestAverageTimeDataPoint(now, val)
 				},
 			},
@@ -462,7 +493,8 @@ var queries = []monitoringQuery{
 		Enabled: func(c *Config) bool {
 			return c.Metrics.SaphanaNetworkRequestFinishedCount.Enabled ||
 				c.Metrics.SaphanaNetworkRequestCount.Enabled ||
-				c.Metrics.SaphanaNetworkRequestAverageTime.Enabled
+				c.Metrics.SaphanaNetworkRequestAverageTime.Enabled ||
+				c.Metrics.SaphanaNetworkRequestAverageDuration.Enabled
 		},
 	},
 	{
@@ -502,6 +534,11 @@ var queries = []monitoringQuery{
 				key: "read_time",
 				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
 					row map[string]string) error {
+					seconds, err := toSeconds(val, time.Millisecond)
+					if err != nil {
+						return err
+					}
+					mb.RecordSaphanaVolumeOperationDurationDataPoint(now, seconds, row["path"], row["type"], metadata.AttributeVolumeOperationTypeRead)
 					return mb.RecordSaphanaVolumeOperationTimeDataPoint(now, val, row["path"], row["type"], metadata.AttributeVolumeOperationTypeRead)
 				},
 			},
@@ -509,6 +546,11 @@ var queries = []monitoringQuery{
 				key: "write_time",
 				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
 					row map[string]string) error {
+					seconds, err := toSeconds(val, time.Millisecond)
+					if err != nil {
+						return err
+					}
+					mb.RecordSaphanaVolumeOperationDurationDataPoint(now, seconds, row["path"], row["type"], metadata.AttributeVolumeOperationTypeWrite)
 					return mb.RecordSaphanaVolumeOperationTimeDataPoint(now, val, row["path"], row["type"], metadata.AttributeVolumeOperationTypeWrite)
 				},
 			},
@@ -516,7 +558,8 @@ var queries = []monitoringQuery{
 		Enabled: func(c *Config) bool {
 			return c.Metrics.SaphanaVolumeOperationCount.Enabled ||
 				c.Metrics.SaphanaVolumeOperationSize.Enabled ||
-				c.Metrics.SaphanaVolumeOperationTime.Enabled
+				c.Metrics.SaphanaVolumeOperationTime.Enabled ||
+				c.Metrics.SaphanaVolumeOperationDuration.Enabled
 		},
 	},
 	{
@@ -797,6 +840,11 @@ var queries = []monitoringQuery{
 				key: "cpu_user",
 				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
 					row map[string]string) error {
+					seconds, err := toSeconds(val, time.Millisecond)
+					if err != nil {
+						return err
+					}
+					mb.RecordSaphanaCPUTimeDataPoint(now, seconds, metadata.AttributeCPUTypeUser)
 					return mb.RecordSaphanaCPUUsedDataPoint(now, val, metadata.AttributeCPUTypeUser)
 				},
 			},
@@ -804,6 +852,11 @@ var queries = []monitoringQuery{
 				key: "cpu_system",
 				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
 					row map[string]string) error {
+					seconds, err := toSeconds(val, time.Millisecond)
+					if err != nil {
+						return err
+					}
+					mb.RecordSaphanaCPUTimeDataPoint(now, seconds, metadata.AttributeCPUTypeSystem)
 					return mb.RecordSaphanaCPUUsedDataPoint(now, val, metadata.AttributeCPUTypeSystem)
 				},
 			},
@@ -811,6 +864,11 @@ var queries = []monitoringQuery{
 				key: "cpu_io_wait",
 				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
 					row map[string]string) error {
+					seconds, err := toSeconds(val, time.Millisecond)
+					if err != nil {
+						return err
+					}
+					mb.RecordSaphanaCPUTimeDataPoint(now, seconds, metadata.AttributeCPUTypeIoWait)
 					return mb.RecordSaphanaCPUUsedDataPoint(now, val, metadata.AttributeCPUTypeIoWait)
 				},
 			},
@@ -818,6 +876,11 @@ var queries = []monitoringQuery{
 				key: "cpu_idle",
 				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
 					row map[string]string) error {
+					seconds, err := toSeconds(val, time.Millisecond)
+					if err != nil {
+						return err
+					}
+					mb.RecordSaphanaCPUTimeDataPoint(now, seconds, metadata.AttributeCPUTypeIdle)
 					return mb.RecordSaphanaCPUUsedDataPoint(now, val, metadata.AttributeCPUTypeIdle)
 				},
 			},
@@ -829,18 +892,30 @@ var queries = []monitoringQuery{
 				c.Metrics.SaphanaInstanceMemoryUsedPeak.Enabled ||
 				c.Metrics.SaphanaInstanceCodeSize.Enabled ||
 				c.Metrics.SaphanaInstanceMemorySharedAllocated.Enabled ||
-				c.Metrics.SaphanaCPUUsed.Enabled
+				c.Metrics.SaphanaCPUUsed.Enabled ||
+				c.Metrics.SaphanaCPUTime.Enabled
 		},
 	},
 }
 
 func (m *monitoringQuery) CollectMetrics(ctx context.Context, s *sapHanaScraper, client client, now pcommon.Timestamp,
 	errs *scrapererror.ScrapeErrors) {
+	ctx, span := s.tracer.Start(ctx, "saphana.query",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "saphana"),
+			attribute.String("db.statement", m.query),
+		))
+	defer span.End()
+
 	rows, err := client.collectDataFromQuery(ctx, m)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		errs.AddPartial(len(m.orderedStats), fmt.Errorf("error running query '%s': %w", m.query, err))
 		return
 	}
+	span.SetAttributes(attribute.Int("db.saphana.rows", len(rows)))
 	for _, data := range rows {
 		for _, stat := range m.orderedStats {
 			if err := stat.collectStat(s, m, now, data); err != nil {
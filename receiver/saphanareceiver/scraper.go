@@ -21,14 +21,48 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saphanareceiver/internal/metadata"
 )
 
+const (
+	instrumentationName = "otelcol/saphanareceiver"
+
+	emitLegacyMetricsFeatureGateID  = "receiver.saphana.emitLegacyMetrics"
+	emitSemconvMetricsFeatureGateID = "receiver.saphana.emitSemconvMetrics"
+)
+
+var (
+	emitLegacyMetrics = featuregate.Gate{
+		ID:      emitLegacyMetricsFeatureGateID,
+		Enabled: true,
+		Description: "SAP HANA duration metrics are transitioning from milliseconds and microseconds to seconds, " +
+			"with names aligned with the OpenTelemetry semantic conventions. This feature gate controls emitting " +
+			"the legacy metrics. For more details, see: " +
+			"https://github.com/open-telemetry/opentelemetry-collector-contrib/blob/main/receiver/saphanareceiver/README.md#feature-gate-configurations",
+	}
+
+	emitSemconvMetrics = featuregate.Gate{
+		ID:      emitSemconvMetricsFeatureGateID,
+		Enabled: false,
+		Description: "SAP HANA duration metrics are transitioning from milliseconds and microseconds to seconds, " +
+			"with names aligned with the OpenTelemetry semantic conventions. This feature gate controls emitting " +
+			"the replacement metrics. For more details, see: " +
+			"https://github.com/open-telemetry/opentelemetry-collector-contrib/blob/main/receiver/saphanareceiver/README.md#feature-gate-configurations",
+	}
+)
+
+func init() {
+	featuregate.GetRegistry().MustRegister(emitLegacyMetrics)
+	featuregate.GetRegistry().MustRegister(emitSemconvMetrics)
+}
+
 // Runs intermittently, fetching info from SAP HANA, creating metrics/datapoints,
 // and feeding them to a metricsConsumer.
 type sapHanaScraper struct {
@@ -36,18 +70,50 @@ type sapHanaScraper struct {
 	cfg      *Config
 	mbs      map[string]*metadata.MetricsBuilder
 	factory  sapHanaConnectionFactory
+	tracer   trace.Tracer
 }
 
 func newSapHanaScraper(settings component.ReceiverCreateSettings, cfg *Config, factory sapHanaConnectionFactory) (scraperhelper.Scraper, error) {
+	// The feature gates only affect the metrics emitted by this scraper, not the user provided configuration.
+	scraperCfg := *cfg
+	scraperCfg.Metrics = applyFeatureGates(cfg.Metrics,
+		featuregate.GetRegistry().IsEnabled(emitLegacyMetricsFeatureGateID),
+		featuregate.GetRegistry().IsEnabled(emitSemconvMetricsFeatureGateID))
+
 	rs := &sapHanaScraper{
 		settings: settings,
-		cfg:      cfg,
+		cfg:      &scraperCfg,
 		mbs:      make(map[string]*metadata.MetricsBuilder),
 		factory:  factory,
+		tracer:   trace.NewNoopTracerProvider().Tracer(instrumentationName),
+	}
+	if cfg.TraceQueries {
+		rs.tracer = settings.TracerProvider.Tracer(instrumentationName)
 	}
 	return scraperhelper.NewScraper(typeStr, rs.scrape)
 }
 
+// applyFeatureGates returns the metrics settings to use given the state of the feature gates.
+// When the semantic convention metrics are emitted, each replacement metric is enabled along with the
+// legacy metric it replaces, so that disabling a legacy metric in the configuration also disables its replacement.
+func applyFeatureGates(settings metadata.MetricsSettings, emitLegacy bool, emitSemconv bool) metadata.MetricsSettings {
+	if emitSemconv {
+		settings.SaphanaCPUTime.Enabled = settings.SaphanaCPUTime.Enabled || settings.SaphanaCPUUsed.Enabled
+		settings.SaphanaNetworkRequestAverageDuration.Enabled = settings.SaphanaNetworkRequestAverageDuration.Enabled || settings.SaphanaNetworkRequestAverageTime.Enabled
+		settings.SaphanaReplicationAverageDuration.Enabled = settings.SaphanaReplicationAverageDuration.Enabled || settings.SaphanaReplicationAverageTime.Enabled
+		settings.SaphanaReplicationBacklogDuration.Enabled = settings.SaphanaReplicationBacklogDuration.Enabled || settings.SaphanaReplicationBacklogTime.Enabled
+		settings.SaphanaVolumeOperationDuration.Enabled = settings.SaphanaVolumeOperationDuration.Enabled || settings.SaphanaVolumeOperationTime.Enabled
+	}
+	if !emitLegacy {
+		settings.SaphanaCPUUsed.Enabled = false
+		settings.SaphanaNetworkRequestAverageTime.Enabled = false
+		settings.SaphanaReplicationAverageTime.Enabled = false
+		settings.SaphanaReplicationBacklogTime.Enabled = false
+		settings.SaphanaVolumeOperationTime.Enabled = false
+	}
+	return settings
+}
+
 func (s *sapHanaScraper) getMetricsBuilder(resourceAttributes map[string]string) (*metadata.MetricsBuilder, error) {
 	bytes, err := json.Marshal(resourceAttributes)
 	if err != nil {
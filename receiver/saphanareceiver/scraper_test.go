@@ -18,13 +18,20 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saphanareceiver/internal/metadata"
 )
 
 const fullExpectedMetricsPath = "./testdata/expected_metrics/full.json"
@@ -102,6 +109,7 @@ func TestDisabledMetrics(t *testing.T) {
 	cfg.Metrics.SaphanaVolumeOperationCount.Enabled = false
 	cfg.Metrics.SaphanaVolumeOperationSize.Enabled = false
 	cfg.Metrics.SaphanaVolumeOperationTime.Enabled = false
+	cfg.Metrics.SaphanaVolumeOperationDuration.Enabled = false
 
 	sc, err := newSapHanaScraper(componenttest.NewNopReceiverCreateSettings(), cfg, &testConnectionFactory{dbWrapper})
 	require.NoError(t, err)
@@ -115,6 +123,176 @@ func TestDisabledMetrics(t *testing.T) {
 	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
 }
 
+func TestSemconvMetrics(t *testing.T) {
+	t.Parallel()
+
+	dbWrapper := &testDBWrapper{}
+	initializeWrapper(t, dbWrapper, allQueryMetrics)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics = applyFeatureGates(cfg.Metrics, true, true)
+
+	sc, err := newSapHanaScraper(componenttest.NewNopReceiverCreateSettings(), cfg, &testConnectionFactory{dbWrapper})
+	require.NoError(t, err)
+
+	actualMetrics, err := sc.Scrape(context.Background())
+	require.NoError(t, err)
+
+	replacements := map[string]struct {
+		name  string
+		scale float64
+	}{
+		"saphana.cpu.used":                     {"saphana.cpu.time", 1e-3},
+		"saphana.network.request.average_time": {"saphana.network.request.average_duration", 1e-3},
+		"saphana.replication.average_time":     {"saphana.replication.average_duration", 1e-6},
+		"saphana.replication.backlog.time":     {"saphana.replication.backlog.duration", 1e-6},
+		"saphana.volume.operation.time":        {"saphana.volume.operation.duration", 1e-3},
+	}
+
+	found := map[string]bool{}
+	rms := actualMetrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		metrics := rms.At(i).ScopeMetrics().At(0).Metrics()
+		byName := make(map[string]pmetric.Metric, metrics.Len())
+		for j := 0; j < metrics.Len(); j++ {
+			byName[metrics.At(j).Name()] = metrics.At(j)
+		}
+
+		for legacyName, replacement := range replacements {
+			legacy, ok := byName[legacyName]
+			if !ok {
+				continue
+			}
+			found[legacyName] = true
+			semconv, ok := byName[replacement.name]
+			require.True(t, ok, "missing %s", replacement.name)
+			require.Equal(t, "s", semconv.Unit())
+
+			legacyValues, semconvValues := dataPointValues(legacy), dataPointValues(semconv)
+			require.Len(t, semconvValues, len(legacyValues))
+			for k := range legacyValues {
+				require.InDelta(t, legacyValues[k]*replacement.scale, semconvValues[k], 1e-9)
+			}
+		}
+	}
+	require.Len(t, found, len(replacements))
+}
+
+// TestSemconvMetricUnits checks that once the legacy metrics are disabled, every metric reports
+// seconds, bytes, or a dimensionless count.
+func TestSemconvMetricUnits(t *testing.T) {
+	t.Parallel()
+
+	dbWrapper := &testDBWrapper{}
+	initializeWrapper(t, dbWrapper, allQueryMetrics)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics = applyFeatureGates(cfg.Metrics, false, true)
+
+	sc, err := newSapHanaScraper(componenttest.NewNopReceiverCreateSettings(), cfg, &testConnectionFactory{dbWrapper})
+	require.NoError(t, err)
+
+	actualMetrics, err := sc.Scrape(context.Background())
+	require.NoError(t, err)
+	require.Greater(t, actualMetrics.MetricCount(), 0)
+
+	units := regexp.MustCompile(`^(s|By|1|\{[a-z_]+\})$`)
+	rms := actualMetrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		metrics := rms.At(i).ScopeMetrics().At(0).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			require.Regexp(t, units, metrics.At(j).Unit(), metrics.At(j).Name())
+		}
+	}
+}
+
+func TestApplyFeatureGates(t *testing.T) {
+	t.Parallel()
+
+	defaults := metadata.DefaultMetricsSettings()
+
+	settings := applyFeatureGates(defaults, true, false)
+	require.Equal(t, defaults, settings)
+
+	settings = applyFeatureGates(defaults, true, true)
+	require.True(t, settings.SaphanaCPUUsed.Enabled)
+	require.True(t, settings.SaphanaCPUTime.Enabled)
+
+	settings = applyFeatureGates(defaults, false, true)
+	require.False(t, settings.SaphanaCPUUsed.Enabled)
+	require.True(t, settings.SaphanaCPUTime.Enabled)
+	require.False(t, settings.SaphanaVolumeOperationTime.Enabled)
+	require.True(t, settings.SaphanaVolumeOperationDuration.Enabled)
+
+	defaults.SaphanaCPUUsed.Enabled = false
+	settings = applyFeatureGates(defaults, true, true)
+	require.False(t, settings.SaphanaCPUTime.Enabled)
+}
+
+func TestTraceQueries(t *testing.T) {
+	t.Parallel()
+
+	for _, traceQueries := range []bool{false, true} {
+		dbWrapper := &testDBWrapper{}
+		initializeWrapper(t, dbWrapper, allQueryMetrics)
+
+		recorder := tracetest.NewSpanRecorder()
+		settings := componenttest.NewNopReceiverCreateSettings()
+		settings.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+		cfg := createDefaultConfig().(*Config)
+		cfg.TraceQueries = traceQueries
+		sc, err := newSapHanaScraper(settings, cfg, &testConnectionFactory{dbWrapper})
+		require.NoError(t, err)
+
+		_, err = sc.Scrape(context.Background())
+		require.NoError(t, err)
+
+		if !traceQueries {
+			require.Empty(t, recorder.Ended())
+			continue
+		}
+
+		expectedStatements := map[string]bool{}
+		for _, query := range queries {
+			if query.Enabled == nil || query.Enabled(cfg) {
+				expectedStatements[query.query] = true
+			}
+		}
+		require.Len(t, recorder.Ended(), len(expectedStatements))
+		for _, span := range recorder.Ended() {
+			require.Equal(t, "saphana.query", span.Name())
+			require.Equal(t, trace.SpanKindClient, span.SpanKind())
+			attributes := attribute.NewSet(span.Attributes()...)
+			statement, ok := attributes.Value("db.statement")
+			require.True(t, ok)
+			require.True(t, expectedStatements[statement.AsString()])
+			_, ok = attributes.Value("db.saphana.rows")
+			require.True(t, ok)
+		}
+	}
+}
+
+func dataPointValues(m pmetric.Metric) []float64 {
+	var dps pmetric.NumberDataPointSlice
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		dps = m.Gauge().DataPoints()
+	case pmetric.MetricTypeSum:
+		dps = m.Sum().DataPoints()
+	}
+
+	values := make([]float64, dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		if dps.At(i).ValueType() == pmetric.NumberDataPointValueTypeInt {
+			values[i] = float64(dps.At(i).IntValue())
+		} else {
+			values[i] = dps.At(i).DoubleValue()
+		}
+	}
+	return values
+}
+
 type queryJSON struct {
 	Query  string
 	Result [][]string
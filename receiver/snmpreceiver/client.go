@@ -19,7 +19,6 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/gosnmp/gosnmp"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
@@ -76,7 +75,8 @@ var _ client = (*snmpClient)(nil)
 func newClient(cfg *Config, logger *zap.Logger) (client, error) {
 	// Create goSNMP client
 	goSNMP := newGoSNMPWrapper()
-	goSNMP.SetTimeout(5 * time.Second)
+	goSNMP.SetTimeout(cfg.Timeout)
+	goSNMP.SetMaxRepetitions(cfg.MaxRepetitions)
 
 	// Set goSNMP version based on config
 	switch cfg.Version {
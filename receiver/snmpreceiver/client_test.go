@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gosnmp/gosnmp"
 	"github.com/stretchr/testify/mock"
@@ -45,9 +46,11 @@ func TestNewClient(t *testing.T) {
 		{
 			desc: "Valid v2c configuration",
 			cfg: &Config{
-				Version:   "v2c",
-				Endpoint:  "udp://localhost:161",
-				Community: "public",
+				Version:        "v2c",
+				Endpoint:       "udp://localhost:161",
+				Community:      "public",
+				Timeout:        2 * time.Second,
+				MaxRepetitions: 10,
 			},
 			host:        componenttest.NewNopHost(),
 			settings:    componenttest.NewNopTelemetrySettings(),
@@ -65,6 +68,8 @@ func TestNewClient(t *testing.T) {
 				AuthPassword:    "authpass",
 				PrivacyType:     "DES",
 				PrivacyPassword: "privacypass",
+				Timeout:         defaultTimeout,
+				MaxRepetitions:  defaultMaxRepetitions,
 			},
 			host:        componenttest.NewNopHost(),
 			settings:    componenttest.NewNopTelemetrySettings(),
@@ -97,6 +102,8 @@ func compareConfigToClient(t *testing.T, client *snmpClient, cfg *Config) {
 	require.True(t, strings.Contains(cfg.Endpoint, client.client.GetTarget()))
 	require.True(t, strings.Contains(cfg.Endpoint, strconv.FormatInt(int64(client.client.GetPort()), 10)))
 	require.True(t, strings.Contains(cfg.Endpoint, client.client.GetTransport()))
+	require.Equal(t, cfg.Timeout, client.client.GetTimeout())
+	require.Equal(t, cfg.MaxRepetitions, client.client.GetMaxRepetitions())
 	switch cfg.Version {
 	case "v1":
 		require.Equal(t, gosnmp.Version1, client.client.GetVersion())
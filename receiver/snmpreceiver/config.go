@@ -33,6 +33,8 @@ const (
 	defaultSecurityLevel      = "no_auth_no_priv"
 	defaultAuthType           = "MD5"
 	defaultPrivacyType        = "DES"
+	defaultTimeout            = 5 * time.Second
+	defaultMaxRepetitions     = 50
 )
 
 var (
@@ -74,6 +76,8 @@ var (
 	errBadPrivacyType       = errors.New("privacy_type must be either DES, AES, AES192, AES192C, AES256, AES256C")
 	errEmptyPrivacyPassword = errors.New("privacy_password must be specified when security_level is auth_priv")
 	errMetricRequired       = errors.New("must have at least one config under metrics")
+	errBadTimeout           = errors.New("timeout must be greater than 0")
+	errBadMaxRepetitions    = errors.New("max_repetitions must be greater than 0")
 )
 
 // Config defines the configuration for the various elements of the receiver.
@@ -96,6 +100,17 @@ type Config struct {
 	// Default: public
 	Community string `mapstructure:"community"`
 
+	// Timeout is the amount of time to wait for a response from the SNMP target
+	// for each request before giving up.
+	// Default: 5s
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// MaxRepetitions is the number of table rows requested in each GETBULK request
+	// while walking column OIDs. Lower this for targets that struggle with large responses.
+	// Only used for versions "v2c" and "v3"
+	// Default: 50
+	MaxRepetitions uint32 `mapstructure:"max_repetitions"`
+
 	// User is the SNMP User for this connection.
 	// Only valid for version “v3”
 	User string `mapstructure:"user"`
@@ -246,6 +261,12 @@ func (cfg *Config) Validate() error {
 
 	combinedErr = multierr.Append(combinedErr, validateEndpoint(cfg))
 	combinedErr = multierr.Append(combinedErr, validateVersion(cfg))
+	if cfg.Timeout <= 0 {
+		combinedErr = multierr.Append(combinedErr, errBadTimeout)
+	}
+	if cfg.MaxRepetitions == 0 {
+		combinedErr = multierr.Append(combinedErr, errBadMaxRepetitions)
+	}
 	if strings.ToUpper(cfg.Version) == "V3" {
 		combinedErr = multierr.Append(combinedErr, validateSecurity(cfg))
 	}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -78,6 +79,15 @@ func TestLoadConfigConnectionConfigs(t *testing.T) {
 	expectedConfigBadVersion.Version = "9999"
 	expectedConfigBadVersion.Metrics = metrics
 
+	expectedConfigTimeoutMaxRepetitions := factory.CreateDefaultConfig().(*Config)
+	expectedConfigTimeoutMaxRepetitions.Timeout = 2 * time.Second
+	expectedConfigTimeoutMaxRepetitions.MaxRepetitions = 10
+	expectedConfigTimeoutMaxRepetitions.Metrics = metrics
+
+	expectedConfigBadTimeout := factory.CreateDefaultConfig().(*Config)
+	expectedConfigBadTimeout.Timeout = 0
+	expectedConfigBadTimeout.Metrics = metrics
+
 	expectedConfigV3NoUser := factory.CreateDefaultConfig().(*Config)
 	expectedConfigV3NoUser.Version = "v3"
 	expectedConfigV3NoUser.SecurityLevel = "no_auth_no_priv"
@@ -140,6 +150,18 @@ func TestLoadConfigConnectionConfigs(t *testing.T) {
 	expectedConfigV3NoPrivacyPassword.Metrics = metrics
 
 	testCases := []testCase{
+		{
+			name:        "TimeoutAndMaxRepetitionsNoErrors",
+			nameVal:     "timeout_max_repetitions",
+			expectedCfg: expectedConfigTimeoutMaxRepetitions,
+			expectedErr: "",
+		},
+		{
+			name:        "BadTimeoutErrors",
+			nameVal:     "bad_timeout",
+			expectedCfg: expectedConfigBadTimeout,
+			expectedErr: errBadTimeout.Error(),
+		},
 		{
 			name:        "NoEndpointUsesDefault",
 			nameVal:     "no_endpoint",
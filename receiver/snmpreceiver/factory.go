@@ -49,12 +49,14 @@ func createDefaultConfig() config.Receiver {
 			ReceiverSettings:   config.NewReceiverSettings(config.NewComponentID(typeStr)),
 			CollectionInterval: defaultCollectionInterval,
 		},
-		Endpoint:      defaultEndpoint,
-		Version:       defaultVersion,
-		Community:     defaultCommunity,
-		SecurityLevel: defaultSecurityLevel,
-		AuthType:      defaultAuthType,
-		PrivacyType:   defaultPrivacyType,
+		Endpoint:       defaultEndpoint,
+		Version:        defaultVersion,
+		Community:      defaultCommunity,
+		SecurityLevel:  defaultSecurityLevel,
+		AuthType:       defaultAuthType,
+		PrivacyType:    defaultPrivacyType,
+		Timeout:        defaultTimeout,
+		MaxRepetitions: defaultMaxRepetitions,
 	}
 }
 
@@ -75,7 +77,9 @@ func createMetricsReceiver(
 	}
 
 	snmpScraper := newScraper(params.Logger, snmpConfig, params)
-	scraper, err := scraperhelper.NewScraper(typeStr, snmpScraper.scrape, scraperhelper.WithStart(snmpScraper.start))
+	scraper, err := scraperhelper.NewScraper(typeStr, snmpScraper.scrape,
+		scraperhelper.WithStart(snmpScraper.start),
+		scraperhelper.WithShutdown(snmpScraper.shutdown))
 	if err != nil {
 		return nil, err
 	}
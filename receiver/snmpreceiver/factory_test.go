@@ -47,12 +47,14 @@ func TestNewFactory(t *testing.T) {
 						ReceiverSettings:   config.NewReceiverSettings(config.NewComponentID(typeStr)),
 						CollectionInterval: defaultCollectionInterval,
 					},
-					Endpoint:      defaultEndpoint,
-					Version:       defaultVersion,
-					Community:     defaultCommunity,
-					SecurityLevel: "no_auth_no_priv",
-					AuthType:      "MD5",
-					PrivacyType:   "DES",
+					Endpoint:       defaultEndpoint,
+					Version:        defaultVersion,
+					Community:      defaultCommunity,
+					SecurityLevel:  "no_auth_no_priv",
+					AuthType:       "MD5",
+					PrivacyType:    "DES",
+					Timeout:        defaultTimeout,
+					MaxRepetitions: defaultMaxRepetitions,
 				}
 
 				require.Equal(t, expectedCfg, factory.CreateDefaultConfig())
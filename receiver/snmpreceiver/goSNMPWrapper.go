@@ -92,6 +92,12 @@ type goSNMPWrapper interface {
 	// SetMaxOids sets the MaxOids
 	SetMaxOids(maxOids int)
 
+	// GetMaxRepetitions gets the MaxRepetitions
+	GetMaxRepetitions() uint32
+
+	// SetMaxRepetitions sets the MaxRepetitions
+	SetMaxRepetitions(maxRepetitions uint32)
+
 	// GetMsgFlags gets the MsgFlags
 	GetMsgFlags() gosnmp.SnmpV3MsgFlags
 
@@ -200,6 +206,16 @@ func (w *otelGoSNMPWrapper) SetMaxOids(maxOids int) {
 	w.GoSNMP.MaxOids = maxOids
 }
 
+// GetMaxRepetitions gets the MaxRepetitions
+func (w *otelGoSNMPWrapper) GetMaxRepetitions() uint32 {
+	return w.GoSNMP.MaxRepetitions
+}
+
+// SetMaxRepetitions sets the MaxRepetitions
+func (w *otelGoSNMPWrapper) SetMaxRepetitions(maxRepetitions uint32) {
+	w.GoSNMP.MaxRepetitions = maxRepetitions
+}
+
 // GetMsgFlags gets the MsgFlags
 func (w *otelGoSNMPWrapper) GetMsgFlags() gosnmp.SnmpV3MsgFlags {
 	return w.GoSNMP.MsgFlags
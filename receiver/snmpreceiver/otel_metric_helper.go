@@ -0,0 +1,159 @@
+// Copyright 2020 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// otelMetricHelper builds OTEL metrics from SNMP data, keeping track of the resources
+// and metrics that have already been created during a single scrape
+type otelMetricHelper struct {
+	settings component.ReceiverCreateSettings
+	// resourcesByKey maps a unique resource key to the scope metrics of that resource
+	resourcesByKey map[string]pmetric.ScopeMetrics
+	// metricsByResource maps a unique resource key to the metrics already created for that resource
+	metricsByResource map[string]map[string]pmetric.Metric
+	metrics           pmetric.Metrics
+	dataPointTime     pcommon.Timestamp
+	startTime         pcommon.Timestamp
+}
+
+// newOTELMetricHelper returns a new otelMetricHelper with an initialized master Metrics
+func newOTELMetricHelper(settings component.ReceiverCreateSettings, startTime pcommon.Timestamp, dataPointTime pcommon.Timestamp) *otelMetricHelper {
+	return &otelMetricHelper{
+		settings:          settings,
+		resourcesByKey:    map[string]pmetric.ScopeMetrics{},
+		metricsByResource: map[string]map[string]pmetric.Metric{},
+		metrics:           pmetric.NewMetrics(),
+		dataPointTime:     dataPointTime,
+		startTime:         startTime,
+	}
+}
+
+// getResourceKey creates a unique key for a resource based on its resource attributes
+func getResourceKey(resourceAttributes map[string]string) string {
+	keys := make([]string, 0, len(resourceAttributes))
+	for key := range resourceAttributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+resourceAttributes[key])
+	}
+
+	return strings.Join(pairs, ";")
+}
+
+// getOrCreateResource returns the scope metrics of the resource with the given resource
+// attributes, creating the resource if it does not exist yet
+func (h *otelMetricHelper) getOrCreateResource(resourceKey string, resourceAttributes map[string]string) pmetric.ScopeMetrics {
+	if sm, ok := h.resourcesByKey[resourceKey]; ok {
+		return sm
+	}
+
+	rm := h.metrics.ResourceMetrics().AppendEmpty()
+	for key, value := range resourceAttributes {
+		rm.Resource().Attributes().PutStr(key, value)
+	}
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("otelcol/snmpreceiver")
+	sm.Scope().SetVersion(h.settings.BuildInfo.Version)
+
+	h.resourcesByKey[resourceKey] = sm
+	h.metricsByResource[resourceKey] = map[string]pmetric.Metric{}
+
+	return sm
+}
+
+// getOrCreateMetric returns the metric with the given name attached to the given resource,
+// creating the metric based on its configuration if it does not exist yet
+func (h *otelMetricHelper) getOrCreateMetric(resourceKey string, resourceAttributes map[string]string, name string, metricCfg *MetricConfig) pmetric.Metric {
+	sm := h.getOrCreateResource(resourceKey, resourceAttributes)
+	if metric, ok := h.metricsByResource[resourceKey][name]; ok {
+		return metric
+	}
+
+	metric := sm.Metrics().AppendEmpty()
+	metric.SetName(name)
+	metric.SetDescription(metricCfg.Description)
+	metric.SetUnit(metricCfg.Unit)
+
+	if metricCfg.Gauge != nil {
+		metric.SetEmptyGauge()
+	} else {
+		sum := metric.SetEmptySum()
+		sum.SetIsMonotonic(metricCfg.Sum.Monotonic)
+		if strings.ToLower(metricCfg.Sum.Aggregation) == "delta" {
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		} else {
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		}
+	}
+
+	h.metricsByResource[resourceKey][name] = metric
+
+	return metric
+}
+
+// addMetricDataPoint adds a datapoint with the given SNMP data and attributes to the named
+// metric on the resource with the given resource attributes
+func (h *otelMetricHelper) addMetricDataPoint(resourceAttributes map[string]string, name string, metricCfg *MetricConfig, attributes map[string]string, data snmpData) error {
+	var valueType string
+	var dps pmetric.NumberDataPointSlice
+	metric := h.getOrCreateMetric(getResourceKey(resourceAttributes), resourceAttributes, name, metricCfg)
+	if metricCfg.Gauge != nil {
+		valueType = metricCfg.Gauge.ValueType
+		dps = metric.Gauge().DataPoints()
+	} else {
+		valueType = metricCfg.Sum.ValueType
+		dps = metric.Sum().DataPoints()
+	}
+
+	var intValue int64
+	var floatValue float64
+	switch data.valueType {
+	case integerVal:
+		intValue = data.value.(int64)
+		floatValue = float64(intValue)
+	case floatVal:
+		floatValue = data.value.(float64)
+		intValue = int64(floatValue)
+	default:
+		return fmt.Errorf("cannot create datapoint for metric '%s' from non numeric data", name)
+	}
+
+	dp := dps.AppendEmpty()
+	dp.SetStartTimestamp(h.startTime)
+	dp.SetTimestamp(h.dataPointTime)
+	if strings.ToLower(valueType) == "int" {
+		dp.SetIntValue(intValue)
+	} else {
+		dp.SetDoubleValue(floatValue)
+	}
+	for key, value := range attributes {
+		dp.Attributes().PutStr(key, value)
+	}
+
+	return nil
+}
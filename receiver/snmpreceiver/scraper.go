@@ -1,4 +1,4 @@
-// Copyright 2020 OpenTelemetry Authors
+// Copyright  The OpenTelemetry Authors
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -16,17 +16,37 @@ package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
+var errClientNotInitialized = errors.New("SNMP client not initialized")
+
+// metricOIDRef ties a scalar or column OID back to the metric configuration it belongs to
+type metricOIDRef struct {
+	metricName         string
+	attributes         []Attribute
+	resourceAttributes []string
+}
+
 // snmpScraper handles scraping of SNMP metrics
 type snmpScraper struct {
-	logger   *zap.Logger
-	cfg      *Config
-	settings component.ReceiverCreateSettings
+	client    client
+	logger    *zap.Logger
+	cfg       *Config
+	settings  component.ReceiverCreateSettings
+	startTime pcommon.Timestamp
 }
 
 // newScraper creates an initialized snmpScraper
@@ -40,12 +60,236 @@ func newScraper(logger *zap.Logger, cfg *Config, settings component.ReceiverCrea
 
 // start gets the client ready
 func (s *snmpScraper) start(_ context.Context, _ component.Host) (err error) {
-	return nil
+	s.client, err = newClient(s.cfg, s.logger)
+	if err != nil {
+		return err
+	}
+	s.startTime = pcommon.NewTimestampFromTime(time.Now())
+
+	return s.client.Connect()
+}
+
+// shutdown closes the client connection
+func (s *snmpScraper) shutdown(_ context.Context) error {
+	if s.client == nil {
+		return nil
+	}
+	return s.client.Close()
 }
 
 // scrape collects and creates OTEL metrics from a SNMP environment
 func (s *snmpScraper) scrape(_ context.Context) (pmetric.Metrics, error) {
-	md := pmetric.NewMetrics()
+	if s.client == nil {
+		return pmetric.NewMetrics(), errClientNotInitialized
+	}
+
+	var scraperErrors scrapererror.ScrapeErrors
+	metricHelper := newOTELMetricHelper(s.settings, s.startTime, pcommon.NewTimestampFromTime(time.Now()))
+
+	s.scrapeScalarMetrics(metricHelper, &scraperErrors)
+	s.scrapeIndexedMetrics(metricHelper, &scraperErrors)
+
+	return metricHelper.metrics, scraperErrors.Combine()
+}
+
+// scrapeScalarMetrics retrieves the data of all configured scalar OIDs and adds it to the
+// related metrics as datapoints on a resource without resource attributes
+func (s *snmpScraper) scrapeScalarMetrics(metricHelper *otelMetricHelper, scraperErrors *scrapererror.ScrapeErrors) {
+	oidRefs := map[string][]metricOIDRef{}
+	var oids []string
+	for _, metricName := range sortedMetricNames(s.cfg.Metrics) {
+		for _, scalarOID := range s.cfg.Metrics[metricName].ScalarOIDs {
+			oid := normalizeOID(scalarOID.OID)
+			if _, ok := oidRefs[oid]; !ok {
+				oids = append(oids, oid)
+			}
+			oidRefs[oid] = append(oidRefs[oid], metricOIDRef{
+				metricName: metricName,
+				attributes: scalarOID.Attributes,
+			})
+		}
+	}
+
+	s.client.GetScalarData(oids, func(data snmpData) error {
+		refs, ok := oidRefs[normalizeOID(data.oid)]
+		if !ok {
+			return fmt.Errorf("no metric configured for scalar OID '%s'", data.oid)
+		}
+
+		var combinedErr error
+		for _, ref := range refs {
+			attributes := map[string]string{}
+			for _, attribute := range ref.attributes {
+				attributes[s.attributeKey(attribute.Name)] = attribute.Value
+			}
+			if err := metricHelper.addMetricDataPoint(nil, ref.metricName, s.cfg.Metrics[ref.metricName], attributes, data); err != nil {
+				combinedErr = multierr.Append(combinedErr, err)
+			}
+		}
+		return combinedErr
+	}, scraperErrors)
+}
+
+// scrapeIndexedMetrics walks all configured column OIDs and turns each returned row into a
+// datapoint. The index of each row is used to look up the values of OID based attributes and
+// resource attributes from their own columns, or is appended to an indexed_value_prefix
+func (s *snmpScraper) scrapeIndexedMetrics(metricHelper *otelMetricHelper, scraperErrors *scrapererror.ScrapeErrors) {
+	oidRefs := map[string][]metricOIDRef{}
+	var oids []string
+	attributeOIDs := map[string]bool{}
+	for _, metricName := range sortedMetricNames(s.cfg.Metrics) {
+		for _, columnOID := range s.cfg.Metrics[metricName].ColumnOIDs {
+			oid := normalizeOID(columnOID.OID)
+			if _, ok := oidRefs[oid]; !ok {
+				oids = append(oids, oid)
+			}
+			oidRefs[oid] = append(oidRefs[oid], metricOIDRef{
+				metricName:         metricName,
+				attributes:         columnOID.Attributes,
+				resourceAttributes: columnOID.ResourceAttributes,
+			})
+
+			for _, attribute := range columnOID.Attributes {
+				if attrCfg := s.cfg.Attributes[attribute.Name]; attrCfg != nil && attrCfg.OID != "" {
+					attributeOIDs[normalizeOID(attrCfg.OID)] = true
+				}
+			}
+			for _, name := range columnOID.ResourceAttributes {
+				if resourceAttrCfg := s.cfg.ResourceAttributes[name]; resourceAttrCfg != nil && resourceAttrCfg.OID != "" {
+					attributeOIDs[normalizeOID(resourceAttrCfg.OID)] = true
+				}
+			}
+		}
+	}
+	if len(oids) == 0 {
+		return
+	}
+
+	indexedValues := s.scrapeIndexedAttributeValues(attributeOIDs, scraperErrors)
+
+	s.client.GetIndexedData(oids, func(data snmpData) error {
+		parentOID := normalizeOID(data.parentOID)
+		refs, ok := oidRefs[parentOID]
+		if !ok {
+			return fmt.Errorf("no metric configured for column OID '%s'", data.parentOID)
+		}
+		index := strings.TrimPrefix(normalizeOID(data.oid), parentOID)
 
-	return md, nil
+		var combinedErr error
+		for _, ref := range refs {
+			attributes, err := s.indexedAttributes(ref.attributes, index, indexedValues)
+			if err != nil {
+				combinedErr = multierr.Append(combinedErr, err)
+				continue
+			}
+			resourceAttributes, err := s.indexedResourceAttributes(ref.resourceAttributes, index, indexedValues)
+			if err != nil {
+				combinedErr = multierr.Append(combinedErr, err)
+				continue
+			}
+			if err := metricHelper.addMetricDataPoint(resourceAttributes, ref.metricName, s.cfg.Metrics[ref.metricName], attributes, data); err != nil {
+				combinedErr = multierr.Append(combinedErr, err)
+			}
+		}
+		return combinedErr
+	}, scraperErrors)
+}
+
+// scrapeIndexedAttributeValues walks the given attribute column OIDs and returns their values
+// keyed by column OID and then by row index
+func (s *snmpScraper) scrapeIndexedAttributeValues(attributeOIDs map[string]bool, scraperErrors *scrapererror.ScrapeErrors) map[string]map[string]string {
+	indexedValues := map[string]map[string]string{}
+	if len(attributeOIDs) == 0 {
+		return indexedValues
+	}
+
+	oids := make([]string, 0, len(attributeOIDs))
+	for oid := range attributeOIDs {
+		oids = append(oids, oid)
+		indexedValues[oid] = map[string]string{}
+	}
+	sort.Strings(oids)
+
+	s.client.GetIndexedData(oids, func(data snmpData) error {
+		parentOID := normalizeOID(data.parentOID)
+		index := strings.TrimPrefix(normalizeOID(data.oid), parentOID)
+		indexedValues[parentOID][index] = snmpDataToString(data)
+		return nil
+	}, scraperErrors)
+
+	return indexedValues
+}
+
+// indexedAttributes creates the datapoint attributes for the row with the given index
+func (s *snmpScraper) indexedAttributes(attributes []Attribute, index string, indexedValues map[string]map[string]string) (map[string]string, error) {
+	attributeValues := map[string]string{}
+	for _, attribute := range attributes {
+		attrCfg := s.cfg.Attributes[attribute.Name]
+		switch {
+		case attrCfg.OID != "":
+			value, ok := indexedValues[normalizeOID(attrCfg.OID)][index]
+			if !ok {
+				return nil, fmt.Errorf("no value found for attribute '%s' at index '%s'", attribute.Name, index)
+			}
+			attributeValues[s.attributeKey(attribute.Name)] = value
+		case attrCfg.IndexedValuePrefix != "":
+			attributeValues[s.attributeKey(attribute.Name)] = attrCfg.IndexedValuePrefix + index
+		default:
+			attributeValues[s.attributeKey(attribute.Name)] = attribute.Value
+		}
+	}
+	return attributeValues, nil
+}
+
+// indexedResourceAttributes creates the resource attributes for the row with the given index
+func (s *snmpScraper) indexedResourceAttributes(names []string, index string, indexedValues map[string]map[string]string) (map[string]string, error) {
+	resourceAttributes := map[string]string{}
+	for _, name := range names {
+		resourceAttrCfg := s.cfg.ResourceAttributes[name]
+		if resourceAttrCfg.OID != "" {
+			value, ok := indexedValues[normalizeOID(resourceAttrCfg.OID)][index]
+			if !ok {
+				return nil, fmt.Errorf("no value found for resource_attribute '%s' at index '%s'", name, index)
+			}
+			resourceAttributes[name] = value
+			continue
+		}
+		resourceAttributes[name] = resourceAttrCfg.IndexedValuePrefix + index
+	}
+	return resourceAttributes, nil
+}
+
+// attributeKey returns the key to use on datapoints for the named attribute configuration
+func (s *snmpScraper) attributeKey(name string) string {
+	if attrCfg := s.cfg.Attributes[name]; attrCfg != nil && attrCfg.Value != "" {
+		return attrCfg.Value
+	}
+	return name
+}
+
+// sortedMetricNames returns the configured metric names in a stable order
+func sortedMetricNames(metrics map[string]*MetricConfig) []string {
+	names := make([]string, 0, len(metrics))
+	for name := range metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// normalizeOID makes sure an OID has a leading dot as returned by gosnmp
+func normalizeOID(oid string) string {
+	return "." + strings.TrimPrefix(oid, ".")
+}
+
+// snmpDataToString converts the value of a piece of SNMP data into an attribute value
+func snmpDataToString(data snmpData) string {
+	switch data.valueType {
+	case integerVal:
+		return strconv.FormatInt(data.value.(int64), 10)
+	case floatVal:
+		return strconv.FormatFloat(data.value.(float64), 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", data.value)
+	}
 }
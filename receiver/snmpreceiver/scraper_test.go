@@ -0,0 +1,205 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+)
+
+// fakeClient returns canned SNMP data keyed by OID
+type fakeClient struct {
+	scalarData  map[string]snmpData
+	indexedData map[string][]snmpData
+}
+
+var _ client = (*fakeClient)(nil)
+
+func (c *fakeClient) GetScalarData(oids []string, processFn processFunc, scraperErrors *scrapererror.ScrapeErrors) {
+	for _, oid := range oids {
+		data, ok := c.scalarData[oid]
+		if !ok {
+			scraperErrors.AddPartial(1, fmt.Errorf("data for OID '%s' not found", oid))
+			continue
+		}
+		if err := processFn(data); err != nil {
+			scraperErrors.AddPartial(1, err)
+		}
+	}
+}
+
+func (c *fakeClient) GetIndexedData(oids []string, processFn processFunc, scraperErrors *scrapererror.ScrapeErrors) {
+	for _, oid := range oids {
+		for _, data := range c.indexedData[oid] {
+			data.parentOID = oid
+			if err := processFn(data); err != nil {
+				scraperErrors.AddPartial(1, err)
+			}
+		}
+	}
+}
+
+func (c *fakeClient) Connect() error {
+	return nil
+}
+
+func (c *fakeClient) Close() error {
+	return nil
+}
+
+// ifTableConfig returns a config that turns ifInOctets of the ifTable into a metric with an
+// interface name attribute from ifDescr and a device resource attribute based on the index
+func ifTableConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ResourceAttributes = map[string]*ResourceAttributeConfig{
+		"device": {IndexedValuePrefix: "device"},
+	}
+	cfg.Attributes = map[string]*AttributeConfig{
+		"interface": {Value: "interface.name", OID: "1.3.6.1.2.1.2.2.1.2"},
+		"direction": {Enum: []string{"in", "out"}},
+	}
+	cfg.Metrics = map[string]*MetricConfig{
+		"network.io": {
+			Unit: "By",
+			Sum:  &SumMetric{Aggregation: "cumulative", Monotonic: true, ValueType: "int"},
+			ColumnOIDs: []ColumnOID{
+				{
+					OID:                "1.3.6.1.2.1.2.2.1.10",
+					ResourceAttributes: []string{"device"},
+					Attributes:         []Attribute{{Name: "interface"}, {Name: "direction", Value: "in"}},
+				},
+			},
+		},
+		"system.uptime": {
+			Unit:       "s",
+			Gauge:      &GaugeMetric{ValueType: "float"},
+			ScalarOIDs: []ScalarOID{{OID: "1.3.6.1.2.1.1.3.0"}},
+		},
+	}
+	return cfg
+}
+
+func TestScrape(t *testing.T) {
+	scraper := newScraper(zap.NewNop(), ifTableConfig(), componenttest.NewNopReceiverCreateSettings())
+	scraper.client = &fakeClient{
+		scalarData: map[string]snmpData{
+			".1.3.6.1.2.1.1.3.0": {oid: ".1.3.6.1.2.1.1.3.0", value: int64(42), valueType: integerVal},
+		},
+		indexedData: map[string][]snmpData{
+			".1.3.6.1.2.1.2.2.1.2": {
+				{oid: ".1.3.6.1.2.1.2.2.1.2.1", value: "eth0", valueType: stringVal},
+				{oid: ".1.3.6.1.2.1.2.2.1.2.2", value: "eth1", valueType: stringVal},
+			},
+			".1.3.6.1.2.1.2.2.1.10": {
+				{oid: ".1.3.6.1.2.1.2.2.1.10.1", value: int64(100), valueType: integerVal},
+				{oid: ".1.3.6.1.2.1.2.2.1.10.2", value: int64(200), valueType: integerVal},
+			},
+		},
+	}
+
+	metrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 3, metrics.ResourceMetrics().Len())
+
+	resources := map[string]pmetric.Metric{}
+	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+		rm := metrics.ResourceMetrics().At(i)
+		require.Equal(t, 1, rm.ScopeMetrics().Len())
+		require.Equal(t, "otelcol/snmpreceiver", rm.ScopeMetrics().At(0).Scope().Name())
+		require.Equal(t, 1, rm.ScopeMetrics().At(0).Metrics().Len())
+
+		device := ""
+		if value, ok := rm.Resource().Attributes().Get("device"); ok {
+			device = value.Str()
+		}
+		resources[device] = rm.ScopeMetrics().At(0).Metrics().At(0)
+	}
+
+	uptime := resources[""]
+	require.Equal(t, "system.uptime", uptime.Name())
+	require.Equal(t, pmetric.MetricTypeGauge, uptime.Type())
+	require.Equal(t, float64(42), uptime.Gauge().DataPoints().At(0).DoubleValue())
+
+	for device, expected := range map[string]struct {
+		name  string
+		value int64
+	}{
+		"device.1": {name: "eth0", value: 100},
+		"device.2": {name: "eth1", value: 200},
+	} {
+		metric, ok := resources[device]
+		require.True(t, ok, "missing resource %s", device)
+		require.Equal(t, "network.io", metric.Name())
+		require.Equal(t, pmetric.MetricTypeSum, metric.Type())
+		require.True(t, metric.Sum().IsMonotonic())
+		require.Equal(t, 1, metric.Sum().DataPoints().Len())
+
+		dp := metric.Sum().DataPoints().At(0)
+		require.Equal(t, expected.value, dp.IntValue())
+		require.Equal(t, map[string]interface{}{
+			"interface.name": expected.name,
+			"direction":      "in",
+		}, dp.Attributes().AsRaw())
+	}
+}
+
+func TestScrapeMissingIndexedAttributeValue(t *testing.T) {
+	scraper := newScraper(zap.NewNop(), ifTableConfig(), componenttest.NewNopReceiverCreateSettings())
+	scraper.client = &fakeClient{
+		scalarData: map[string]snmpData{
+			".1.3.6.1.2.1.1.3.0": {oid: ".1.3.6.1.2.1.1.3.0", value: int64(42), valueType: integerVal},
+		},
+		indexedData: map[string][]snmpData{
+			".1.3.6.1.2.1.2.2.1.2": {
+				{oid: ".1.3.6.1.2.1.2.2.1.2.1", value: "eth0", valueType: stringVal},
+			},
+			".1.3.6.1.2.1.2.2.1.10": {
+				{oid: ".1.3.6.1.2.1.2.2.1.10.1", value: int64(100), valueType: integerVal},
+				{oid: ".1.3.6.1.2.1.2.2.1.10.2", value: int64(200), valueType: integerVal},
+			},
+		},
+	}
+
+	metrics, err := scraper.scrape(context.Background())
+	require.ErrorContains(t, err, "no value found for attribute 'interface' at index '.2'")
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.Equal(t, 2, metrics.ResourceMetrics().Len())
+}
+
+func TestScrapeWithoutClient(t *testing.T) {
+	scraper := newScraper(zap.NewNop(), ifTableConfig(), componenttest.NewNopReceiverCreateSettings())
+
+	metrics, err := scraper.scrape(context.Background())
+	require.ErrorIs(t, err, errClientNotInitialized)
+	require.Equal(t, 0, metrics.ResourceMetrics().Len())
+}
+
+func TestGetResourceKey(t *testing.T) {
+	require.Equal(t, "", getResourceKey(nil))
+	require.Equal(t, "a=1;b=2", getResourceKey(map[string]string{"b": "2", "a": "1"}))
+}
+
+func TestSnmpDataToString(t *testing.T) {
+	require.Equal(t, "5", snmpDataToString(snmpData{value: int64(5), valueType: integerVal}))
+	require.Equal(t, "1.5", snmpDataToString(snmpData{value: 1.5, valueType: floatVal}))
+	require.Equal(t, "eth0", snmpDataToString(snmpData{value: "eth0", valueType: stringVal}))
+}
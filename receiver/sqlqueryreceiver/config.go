@@ -17,6 +17,7 @@ package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"errors"
 	"fmt"
+	"text/template"
 	"time"
 
 	"go.opentelemetry.io/collector/config"
@@ -29,6 +30,9 @@ type Config struct {
 	Driver                                  string  `mapstructure:"driver"`
 	DataSource                              string  `mapstructure:"datasource"`
 	Queries                                 []Query `mapstructure:"queries"`
+	// StorageID is the ID of the storage extension used to persist the tracking
+	// column value of log queries across collector restarts.
+	StorageID *config.ComponentID `mapstructure:"storage"`
 }
 
 func (c Config) Validate() error {
@@ -52,6 +56,15 @@ func (c Config) Validate() error {
 type Query struct {
 	SQL     string      `mapstructure:"sql"`
 	Metrics []MetricCfg `mapstructure:"metrics"`
+	Logs    []LogsCfg   `mapstructure:"logs"`
+	// TrackingColumn is the column whose value of the last returned row is passed
+	// as the query parameter of the next execution, so that the rows are ingested
+	// as logs only once, unless the collector stops before recording the value.
+	// It is only supported by the queries with logs.
+	TrackingColumn string `mapstructure:"tracking_column"`
+	// TrackingStartValue is the query parameter used before any tracking value
+	// has been recorded.
+	TrackingStartValue string `mapstructure:"tracking_start_value"`
 }
 
 func (q Query) Validate() error {
@@ -59,17 +72,48 @@ func (q Query) Validate() error {
 	if q.SQL == "" {
 		errs = multierr.Append(errs, errors.New("'query.sql' cannot be empty"))
 	}
-	if len(q.Metrics) == 0 {
-		errs = multierr.Append(errs, errors.New("'query.metrics' cannot be empty"))
+	if len(q.Metrics) == 0 && len(q.Logs) == 0 {
+		errs = multierr.Append(errs, errors.New("'query.metrics' and 'query.logs' cannot both be empty"))
+	}
+	if q.TrackingStartValue != "" && q.TrackingColumn == "" {
+		errs = multierr.Append(errs, errors.New("'query.tracking_start_value' requires 'query.tracking_column' to be set"))
+	}
+	if q.TrackingColumn != "" && len(q.Logs) == 0 {
+		errs = multierr.Append(errs, errors.New("'query.tracking_column' requires 'query.logs' to be set"))
 	}
 	for _, metric := range q.Metrics {
 		if err := metric.Validate(); err != nil {
 			errs = multierr.Append(errs, err)
 		}
 	}
+	for _, logs := range q.Logs {
+		if err := logs.Validate(); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
 	return errs
 }
 
+type LogsCfg struct {
+	BodyColumn       string   `mapstructure:"body_column"`
+	BodyTemplate     string   `mapstructure:"body_template"`
+	AttributeColumns []string `mapstructure:"attribute_columns"`
+}
+
+func (c LogsCfg) Validate() error {
+	switch {
+	case c.BodyColumn == "" && c.BodyTemplate == "":
+		return errors.New("one of 'body_column' or 'body_template' must be set")
+	case c.BodyColumn != "" && c.BodyTemplate != "":
+		return errors.New("only one of 'body_column' or 'body_template' can be set")
+	case c.BodyTemplate != "":
+		if _, err := template.New("body").Parse(c.BodyTemplate); err != nil {
+			return fmt.Errorf("invalid 'body_template': %w", err)
+		}
+	}
+	return nil
+}
+
 type MetricCfg struct {
 	MetricName       string            `mapstructure:"metric_name"`
 	ValueColumn      string            `mapstructure:"value_column"`
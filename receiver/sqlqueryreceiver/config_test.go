@@ -29,6 +29,8 @@ import (
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	storageID := config.NewComponentID("file_storage")
+
 	tests := []struct {
 		fname        string
 		id           config.ComponentID
@@ -64,6 +66,50 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id:    config.NewComponentIDWithName(typeStr, ""),
+			fname: "config-logs.yaml",
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					ReceiverSettings:   config.NewReceiverSettings(config.NewComponentID(typeStr)),
+					CollectionInterval: 10 * time.Second,
+				},
+				Driver:     "mydriver",
+				DataSource: "host=localhost port=5432 user=me password=s3cr3t sslmode=disable",
+				StorageID:  &storageID,
+				Queries: []Query{
+					{
+						SQL:                "select id, message, severity from events where id > ? order by id",
+						TrackingColumn:     "id",
+						TrackingStartValue: "100",
+						Logs: []LogsCfg{
+							{
+								BodyColumn:       "message",
+								AttributeColumns: []string{"severity"},
+							},
+							{
+								BodyTemplate: "{{ .severity }}: {{ .message }}",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			fname:        "config-invalid-logs-missing-body.yaml",
+			id:           config.NewComponentIDWithName(typeStr, ""),
+			errorMessage: "one of 'body_column' or 'body_template' must be set",
+		},
+		{
+			fname:        "config-invalid-tracking-start-value.yaml",
+			id:           config.NewComponentIDWithName(typeStr, ""),
+			errorMessage: "'query.tracking_start_value' requires 'query.tracking_column' to be set",
+		},
+		{
+			fname:        "config-invalid-tracking-column.yaml",
+			id:           config.NewComponentIDWithName(typeStr, ""),
+			errorMessage: "'query.tracking_column' requires 'query.logs' to be set",
+		},
 		{
 			fname:        "config-invalid-datatype.yaml",
 			id:           config.NewComponentIDWithName(typeStr, ""),
@@ -107,7 +153,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			fname:        "config-invalid-missing-metrics.yaml",
 			id:           config.NewComponentIDWithName(typeStr, ""),
-			errorMessage: "'query.metrics' cannot be empty",
+			errorMessage: "'query.metrics' and 'query.logs' cannot both be empty",
 		},
 		{
 			fname:        "config-invalid-missing-datasource.yaml",
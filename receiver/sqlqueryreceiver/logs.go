@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// logsTemplate pairs a logs config with its parsed body template, if any.
+type logsTemplate struct {
+	cfg  LogsCfg
+	body *template.Template
+}
+
+func newLogsTemplate(cfg LogsCfg) (logsTemplate, error) {
+	lt := logsTemplate{cfg: cfg}
+	if cfg.BodyTemplate == "" {
+		return lt, nil
+	}
+	tmpl, err := template.New("body").Option("missingkey=error").Parse(cfg.BodyTemplate)
+	if err != nil {
+		return lt, fmt.Errorf("failed to parse body_template: %w", err)
+	}
+	lt.body = tmpl
+	return lt, nil
+}
+
+func rowToLog(row stringMap, lt logsTemplate, dest plog.LogRecord, ts pcommon.Timestamp) error {
+	dest.SetObservedTimestamp(ts)
+	if lt.body != nil {
+		var body strings.Builder
+		if err := lt.body.Execute(&body, row); err != nil {
+			return fmt.Errorf("rowToLog: failed to render body_template: %w", err)
+		}
+		dest.Body().SetStr(body.String())
+	} else {
+		value, found := row[lt.cfg.BodyColumn]
+		if !found {
+			return fmt.Errorf("rowToLog: body_column '%s' not found in result set", lt.cfg.BodyColumn)
+		}
+		dest.Body().SetStr(value)
+	}
+	attrs := dest.Attributes()
+	for _, columnName := range lt.cfg.AttributeColumns {
+		if attrVal, found := row[columnName]; found {
+			attrs.PutStr(columnName, attrVal)
+		} else {
+			return fmt.Errorf("rowToLog: attribute_column not found: '%s'", columnName)
+		}
+	}
+	return nil
+}
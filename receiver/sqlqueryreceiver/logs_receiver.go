@@ -0,0 +1,240 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+// logsReceiver periodically executes the queries that have logs configured and
+// turns every returned row into log records.
+type logsReceiver struct {
+	id                 config.ComponentID
+	config             *Config
+	logger             *zap.Logger
+	consumer           consumer.Logs
+	sqlOpenerFunc      sqlOpenerFunc
+	clientProviderFunc clientProviderFunc
+
+	queries       []*logsQuery
+	db            *sql.DB
+	storageClient storage.Client
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+}
+
+type logsQuery struct {
+	// storageKey identifies the persisted tracking value of the query.
+	storageKey    string
+	query         Query
+	templates     []logsTemplate
+	client        dbClient
+	trackingValue string
+}
+
+var _ component.LogsReceiver = (*logsReceiver)(nil)
+
+func newLogsReceiver(
+	sqlCfg *Config,
+	settings component.ReceiverCreateSettings,
+	sqlOpenerFunc sqlOpenerFunc,
+	clientProviderFunc clientProviderFunc,
+	consumer consumer.Logs,
+) (*logsReceiver, error) {
+	r := &logsReceiver{
+		id:                 sqlCfg.ID(),
+		config:             sqlCfg,
+		logger:             settings.TelemetrySettings.Logger,
+		consumer:           consumer,
+		sqlOpenerFunc:      sqlOpenerFunc,
+		clientProviderFunc: clientProviderFunc,
+	}
+	for i, query := range sqlCfg.Queries {
+		if len(query.Logs) == 0 {
+			continue
+		}
+		lq := &logsQuery{
+			storageKey:    fmt.Sprintf("query-%d: %s", i, query.SQL),
+			query:         query,
+			trackingValue: query.TrackingStartValue,
+		}
+		for _, logsCfg := range query.Logs {
+			lt, err := newLogsTemplate(logsCfg)
+			if err != nil {
+				return nil, err
+			}
+			lq.templates = append(lq.templates, lt)
+		}
+		r.queries = append(r.queries, lq)
+	}
+	return r, nil
+}
+
+func (r *logsReceiver) Start(ctx context.Context, host component.Host) error {
+	storageClient, err := getStorageClient(ctx, host, r.config.StorageID, r.id)
+	if err != nil {
+		return fmt.Errorf("failed to set up storage: %w", err)
+	}
+	r.storageClient = storageClient
+
+	r.db, err = r.sqlOpenerFunc(r.config.Driver, r.config.DataSource)
+	if err != nil {
+		return fmt.Errorf("failed to open db connection: %w", err)
+	}
+
+	for _, lq := range r.queries {
+		lq.client = r.clientProviderFunc(r.db, lq.query.SQL, r.logger)
+		if lq.query.TrackingColumn == "" {
+			continue
+		}
+		value, err := r.storageClient.Get(ctx, lq.storageKey)
+		if err != nil {
+			r.logger.Error("failed to read persisted tracking value, using tracking_start_value",
+				zap.String("query", lq.query.SQL), zap.Error(err))
+			continue
+		}
+		if value != nil {
+			lq.trackingValue = string(value)
+		}
+	}
+
+	collectCtx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.config.CollectionInterval)
+		defer ticker.Stop()
+
+		r.collect(collectCtx)
+		for {
+			select {
+			case <-ticker.C:
+				r.collect(collectCtx)
+			case <-collectCtx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *logsReceiver) collect(ctx context.Context) {
+	for _, lq := range r.queries {
+		if err := r.collectQuery(ctx, lq); err != nil {
+			r.logger.Error("failed to collect logs", zap.String("query", lq.query.SQL), zap.Error(err))
+		}
+	}
+}
+
+// collectQuery runs a single query and sends its rows down the pipeline. The
+// tracking value only advances once the logs have been accepted by the next
+// consumer, so rows are not skipped if the pipeline refuses them.
+func (r *logsReceiver) collectQuery(ctx context.Context, lq *logsQuery) error {
+	var args []interface{}
+	if lq.query.TrackingColumn != "" {
+		args = append(args, lq.trackingValue)
+	}
+	rows, err := lq.client.queryRows(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	out := plog.NewLogs()
+	lrs := out.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	ts := pcommon.NewTimestampFromTime(time.Now())
+	trackingValue := lq.trackingValue
+	var errs error
+	for i, row := range rows {
+		for _, lt := range lq.templates {
+			if err = rowToLog(row, lt, lrs.AppendEmpty(), ts); err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("row %d: %w", i, err))
+			}
+		}
+		if lq.query.TrackingColumn == "" {
+			continue
+		}
+		value, found := row[lq.query.TrackingColumn]
+		if !found {
+			errs = multierr.Append(errs, fmt.Errorf("row %d: tracking_column '%s' not found in result set", i, lq.query.TrackingColumn))
+			continue
+		}
+		trackingValue = value
+	}
+
+	if err = r.consumer.ConsumeLogs(ctx, out); err != nil {
+		return multierr.Append(errs, fmt.Errorf("failed to consume logs: %w", err))
+	}
+
+	if trackingValue != lq.trackingValue {
+		lq.trackingValue = trackingValue
+		if err = r.storageClient.Set(ctx, lq.storageKey, []byte(trackingValue)); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to persist tracking value: %w", err))
+		}
+	}
+	return errs
+}
+
+func (r *logsReceiver) Shutdown(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+
+	var errs error
+	if r.storageClient != nil {
+		errs = multierr.Append(errs, r.storageClient.Close(ctx))
+	}
+	if r.db != nil {
+		errs = multierr.Append(errs, r.db.Close())
+	}
+	return errs
+}
+
+// getStorageClient returns a client of the configured storage extension, or a
+// no-op client if none is configured.
+func getStorageClient(ctx context.Context, host component.Host, storageID *config.ComponentID, componentID config.ComponentID) (storage.Client, error) {
+	if storageID == nil {
+		return storage.NewNopClient(), nil
+	}
+
+	extension, ok := host.GetExtensions()[*storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExtension.GetClient(ctx, component.KindReceiver, componentID, "")
+}
@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlqueryreceiver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/zap"
+)
+
+func TestLogsReceiver_CollectQuery(t *testing.T) {
+	client := &fakeDBClient{
+		responses: [][]stringMap{
+			{
+				{"id": "101", "message": "first", "severity": "INFO"},
+				{"id": "102", "message": "second", "severity": "WARN"},
+			},
+			{
+				{"id": "103", "message": "third", "severity": "INFO"},
+			},
+		},
+	}
+	sink := &consumertest.LogsSink{}
+	store := newFakeStorageClient()
+	r, lq := newTestLogsReceiver(t, client, sink, store, Query{
+		SQL:                "select id, message, severity from events where id > ? order by id",
+		TrackingColumn:     "id",
+		TrackingStartValue: "100",
+		Logs: []LogsCfg{{
+			BodyColumn:       "message",
+			AttributeColumns: []string{"severity"},
+		}},
+	})
+
+	require.NoError(t, r.collectQuery(context.Background(), lq))
+	require.NoError(t, r.collectQuery(context.Background(), lq))
+
+	assert.Equal(t, [][]interface{}{{"100"}, {"102"}}, client.args)
+	assert.Equal(t, "103", lq.trackingValue)
+	assert.Equal(t, []byte("103"), store.data[lq.storageKey])
+
+	require.Equal(t, 2, len(sink.AllLogs()))
+	lrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, lrs.Len())
+	assert.Equal(t, "first", lrs.At(0).Body().Str())
+	severity, found := lrs.At(0).Attributes().Get("severity")
+	require.True(t, found)
+	assert.Equal(t, "INFO", severity.Str())
+	assert.Equal(t, "second", lrs.At(1).Body().Str())
+	assert.NotZero(t, lrs.At(1).ObservedTimestamp())
+}
+
+func TestLogsReceiver_BodyTemplate(t *testing.T) {
+	client := &fakeDBClient{
+		responses: [][]stringMap{
+			{{"message": "disk full", "severity": "ERROR"}},
+		},
+	}
+	sink := &consumertest.LogsSink{}
+	r, lq := newTestLogsReceiver(t, client, sink, newFakeStorageClient(), Query{
+		SQL: "select message, severity from events",
+		Logs: []LogsCfg{{
+			BodyTemplate: "{{ .severity }}: {{ .message }}",
+		}},
+	})
+
+	require.NoError(t, r.collectQuery(context.Background(), lq))
+
+	assert.Equal(t, [][]interface{}{nil}, client.args)
+	require.Equal(t, 1, sink.LogRecordCount())
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "ERROR: disk full", lr.Body().Str())
+}
+
+func TestLogsReceiver_ConsumerErrorKeepsTrackingValue(t *testing.T) {
+	client := &fakeDBClient{
+		responses: [][]stringMap{
+			{{"id": "101", "message": "first"}},
+		},
+	}
+	store := newFakeStorageClient()
+	r, lq := newTestLogsReceiver(t, client, consumertest.NewErr(errors.New("refused")), store, Query{
+		SQL:                "select id, message from events where id > ?",
+		TrackingColumn:     "id",
+		TrackingStartValue: "100",
+		Logs:               []LogsCfg{{BodyColumn: "message"}},
+	})
+
+	require.ErrorContains(t, r.collectQuery(context.Background(), lq), "failed to consume logs")
+	assert.Equal(t, "100", lq.trackingValue)
+	assert.Empty(t, store.data)
+}
+
+func TestLogsReceiver_MissingColumns(t *testing.T) {
+	client := &fakeDBClient{
+		responses: [][]stringMap{
+			{{"message": "first"}},
+		},
+	}
+	r, lq := newTestLogsReceiver(t, client, &consumertest.LogsSink{}, newFakeStorageClient(), Query{
+		SQL:            "select message from events where id > ?",
+		TrackingColumn: "id",
+		Logs: []LogsCfg{{
+			BodyColumn:       "message",
+			AttributeColumns: []string{"severity"},
+		}},
+	})
+
+	err := r.collectQuery(context.Background(), lq)
+	assert.ErrorContains(t, err, "attribute_column not found: 'severity'")
+	assert.ErrorContains(t, err, "tracking_column 'id' not found in result set")
+}
+
+func TestLogsReceiver_StartRestoresTrackingValue(t *testing.T) {
+	storageID := config.NewComponentID("fake_storage")
+	store := newFakeStorageClient()
+	client := &fakeDBClient{
+		responses: [][]stringMap{{}},
+	}
+	cfg := &Config{
+		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+			ReceiverSettings:   config.NewReceiverSettings(config.NewComponentID(typeStr)),
+			CollectionInterval: time.Hour,
+		},
+		Driver:     "mydriver",
+		DataSource: "my-datasource",
+		StorageID:  &storageID,
+		Queries: []Query{{
+			SQL:                "select id, message from events where id > ?",
+			TrackingColumn:     "id",
+			TrackingStartValue: "0",
+			Logs:               []LogsCfg{{BodyColumn: "message"}},
+		}},
+	}
+	store.data["query-0: select id, message from events where id > ?"] = []byte("42")
+
+	r, err := newLogsReceiver(cfg, componenttest.NewNopReceiverCreateSettings(), fakeDBConnect,
+		func(*sql.DB, string, *zap.Logger) dbClient { return client }, consumertest.NewNop())
+	require.NoError(t, err)
+
+	host := &fakeStorageHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[config.ComponentID]component.Extension{storageID: &fakeStorageExtension{client: store}},
+	}
+	require.NoError(t, r.Start(context.Background(), host))
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	require.Equal(t, 1, len(r.queries))
+	assert.Equal(t, "42", r.queries[0].trackingValue)
+	assert.Equal(t, []interface{}{"42"}, client.args[0])
+}
+
+func TestLogsReceiver_StartMissingStorage(t *testing.T) {
+	storageID := config.NewComponentID("missing_storage")
+	cfg := &Config{
+		Driver:     "mydriver",
+		DataSource: "my-datasource",
+		StorageID:  &storageID,
+	}
+	r, err := newLogsReceiver(cfg, componenttest.NewNopReceiverCreateSettings(), fakeDBConnect, mkFakeClient, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.ErrorContains(t, r.Start(context.Background(), componenttest.NewNopHost()), "storage extension 'missing_storage' not found")
+}
+
+func newTestLogsReceiver(t *testing.T, client dbClient, nextConsumer consumer.Logs, store storage.Client, query Query) (*logsReceiver, *logsQuery) {
+	cfg := &Config{Queries: []Query{query}}
+	r, err := newLogsReceiver(cfg, componenttest.NewNopReceiverCreateSettings(), fakeDBConnect, mkFakeClient, nextConsumer)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(r.queries))
+	r.storageClient = store
+	lq := r.queries[0]
+	lq.client = client
+	return r, lq
+}
+
+type fakeStorageHost struct {
+	component.Host
+	extensions map[config.ComponentID]component.Extension
+}
+
+func (h *fakeStorageHost) GetExtensions() map[config.ComponentID]component.Extension {
+	return h.extensions
+}
+
+type fakeStorageExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	client storage.Client
+}
+
+func (e *fakeStorageExtension) GetClient(context.Context, component.Kind, config.ComponentID, string) (storage.Client, error) {
+	return e.client, nil
+}
+
+type fakeStorageClient struct {
+	data map[string][]byte
+}
+
+func newFakeStorageClient() *fakeStorageClient {
+	return &fakeStorageClient{data: map[string][]byte{}}
+}
+
+func (c *fakeStorageClient) Get(_ context.Context, key string) ([]byte, error) {
+	return c.data[key], nil
+}
+
+func (c *fakeStorageClient) Set(_ context.Context, key string, value []byte) error {
+	c.data[key] = value
+	return nil
+}
+
+func (c *fakeStorageClient) Delete(_ context.Context, key string) error {
+	delete(c.data, key)
+	return nil
+}
+
+func (c *fakeStorageClient) Batch(context.Context, ...storage.Operation) error {
+	return errors.New("not implemented")
+}
+
+func (c *fakeStorageClient) Close(context.Context) error {
+	return nil
+}
@@ -42,6 +42,9 @@ func createReceiverFunc(sqlOpenerFunc sqlOpenerFunc, clientProviderFunc clientPr
 		sqlCfg := cfg.(*Config)
 		var opts []scraperhelper.ScraperControllerOption
 		for i, query := range sqlCfg.Queries {
+			if len(query.Metrics) == 0 {
+				continue
+			}
 			id := config.NewComponentIDWithName("sqlqueryreceiver", fmt.Sprintf("query-%d: %s", i, query.SQL))
 			mp := &scraper{
 				id:        id,
@@ -64,3 +67,14 @@ func createReceiverFunc(sqlOpenerFunc sqlOpenerFunc, clientProviderFunc clientPr
 		)
 	}
 }
+
+func createLogsReceiverFunc(sqlOpenerFunc sqlOpenerFunc, clientProviderFunc clientProviderFunc) component.CreateLogsReceiverFunc {
+	return func(
+		ctx context.Context,
+		settings component.ReceiverCreateSettings,
+		cfg config.Receiver,
+		consumer consumer.Logs,
+	) (component.LogsReceiver, error) {
+		return newLogsReceiver(cfg.(*Config), settings, sqlOpenerFunc, clientProviderFunc, consumer)
+	}
+}
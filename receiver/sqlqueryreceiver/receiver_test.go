@@ -59,5 +59,5 @@ func fakeDBConnect(string, string) (*sql.DB, error) {
 }
 
 func mkFakeClient(db *sql.DB, s string, logger *zap.Logger) dbClient {
-	return &fakeDBClient{responses: [][]metricRow{{{"foo": "111"}}}}
+	return &fakeDBClient{responses: [][]stringMap{{{"foo": "111"}}}}
 }
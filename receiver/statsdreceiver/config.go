@@ -33,6 +33,7 @@ type Config struct {
 	AggregationInterval     time.Duration                    `mapstructure:"aggregation_interval"`
 	EnableMetricType        bool                             `mapstructure:"enable_metric_type"`
 	IsMonotonicCounter      bool                             `mapstructure:"is_monotonic_counter"`
+	CardinalityLimit        int                              `mapstructure:"cardinality_limit"`
 	TimerHistogramMapping   []protocol.TimerHistogramMapping `mapstructure:"timer_histogram_mapping"`
 }
 
@@ -43,6 +44,10 @@ func (c *Config) validate() error {
 		errs = multierr.Append(errs, fmt.Errorf("aggregation_interval must be a positive duration"))
 	}
 
+	if c.CardinalityLimit < 0 {
+		errs = multierr.Append(errs, fmt.Errorf("cardinality_limit must not be negative"))
+	}
+
 	var TimerHistogramMappingMissingObjectName bool
 	for _, eachMap := range c.TimerHistogramMapping {
 
@@ -52,7 +57,7 @@ func (c *Config) validate() error {
 		}
 
 		switch eachMap.StatsdType {
-		case protocol.TimingTypeName, protocol.TimingAltTypeName, protocol.HistogramTypeName:
+		case protocol.TimingTypeName, protocol.TimingAltTypeName, protocol.HistogramTypeName, protocol.DistributionTypeName:
 		default:
 			errs = multierr.Append(errs, fmt.Errorf("statsd_type is not a supported mapping: %s", eachMap.StatsdType))
 		}
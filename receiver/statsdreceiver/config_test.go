@@ -52,6 +52,7 @@ func TestLoadConfig(t *testing.T) {
 					Transport: "custom_transport",
 				},
 				AggregationInterval: 70 * time.Second,
+				CardinalityLimit:    1000,
 				TimerHistogramMapping: []protocol.TimerHistogramMapping{
 					{
 						StatsdType:   "histogram",
@@ -64,6 +65,10 @@ func TestLoadConfig(t *testing.T) {
 							MaxSize: 170,
 						},
 					},
+					{
+						StatsdType:   "distribution",
+						ObserverType: "histogram",
+					},
 				},
 			},
 		},
@@ -96,6 +101,7 @@ func TestValidate(t *testing.T) {
 		noObjectNameErr                = "must specify object id for all TimerHistogramMappings"
 		statsdTypeNotSupportErr        = "statsd_type is not a supported mapping: %s"
 		observerTypeNotSupportErr      = "observer_type is not supported: %s"
+		negativeCardinalityLimitErr    = "cardinality_limit must not be negative"
 	)
 
 	tests := []test{
@@ -149,6 +155,14 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: fmt.Sprintf(observerTypeNotSupportErr, "gauge1"),
 		},
+		{
+			name: "negativeCardinalityLimit",
+			cfg: &Config{
+				AggregationInterval: 10,
+				CardinalityLimit:    -1,
+			},
+			expectedErr: negativeCardinalityLimitErr,
+		},
 	}
 
 	for _, test := range tests {
@@ -20,7 +20,7 @@ import (
 
 // Parser is something that can map input StatsD strings to OTLP Metric representations.
 type Parser interface {
-	Initialize(enableMetricType bool, isMonotonicCounter bool, sendTimerHistogram []TimerHistogramMapping) error
+	Initialize(enableMetricType bool, isMonotonicCounter bool, cardinalityLimit int, sendTimerHistogram []TimerHistogramMapping) error
 	GetMetrics() pmetric.Metrics
 	Aggregate(line string) error
 }
@@ -39,17 +39,24 @@ type (
 
 const (
 	tagMetricType = "metric_type"
-
-	CounterType   MetricType = "c"
-	GaugeType     MetricType = "g"
-	HistogramType MetricType = "h"
-	TimingType    MetricType = "ms"
-
-	CounterTypeName   TypeName = "counter"
-	GaugeTypeName     TypeName = "gauge"
-	HistogramTypeName TypeName = "histogram"
-	TimingTypeName    TypeName = "timing"
-	TimingAltTypeName TypeName = "timer"
+	// attrContainerID is the attribute holding the container ID field of DogStatsD
+	attrContainerID = "container.id"
+	// attrOverflow is the only attribute of the series aggregating the metrics
+	// exceeding the cardinality limit
+	attrOverflow = "otel.metric.overflow"
+
+	CounterType      MetricType = "c"
+	GaugeType        MetricType = "g"
+	HistogramType    MetricType = "h"
+	TimingType       MetricType = "ms"
+	DistributionType MetricType = "d"
+
+	CounterTypeName      TypeName = "counter"
+	GaugeTypeName        TypeName = "gauge"
+	HistogramTypeName    TypeName = "histogram"
+	TimingTypeName       TypeName = "timing"
+	TimingAltTypeName    TypeName = "timer"
+	DistributionTypeName TypeName = "distribution"
 
 	GaugeObserver     ObserverType = "gauge"
 	SummaryObserver   ObserverType = "summary"
@@ -87,9 +94,15 @@ type StatsDParser struct {
 	timersAndDistributions []pmetric.ScopeMetrics
 	enableMetricType       bool
 	isMonotonicCounter     bool
+	cardinalityLimit       int
 	timerEvents            ObserverCategory
 	histogramEvents        ObserverCategory
+	distributionEvents     ObserverCategory
 	lastIntervalTime       time.Time
+	// series holds the series seen during the interval, and seriesPerMetric
+	// their number for each metric name and type
+	series          map[statsDMetricDescription]struct{}
+	seriesPerMetric map[statsDMetricDescription]int
 }
 
 type sampleValue struct {
@@ -132,6 +145,8 @@ func (t MetricType) FullName() TypeName {
 		return TimingTypeName
 	case HistogramType:
 		return HistogramTypeName
+	case DistributionType:
+		return DistributionTypeName
 	}
 	return TypeName(fmt.Sprintf("unknown(%s)", t))
 }
@@ -143,15 +158,19 @@ func (p *StatsDParser) resetState(when time.Time) {
 	p.timersAndDistributions = nil
 	p.summaries = make(map[statsDMetricDescription]summaryMetric)
 	p.histograms = make(map[statsDMetricDescription]histogramMetric)
+	p.series = make(map[statsDMetricDescription]struct{})
+	p.seriesPerMetric = make(map[statsDMetricDescription]int)
 }
 
-func (p *StatsDParser) Initialize(enableMetricType bool, isMonotonicCounter bool, sendTimerHistogram []TimerHistogramMapping) error {
+func (p *StatsDParser) Initialize(enableMetricType bool, isMonotonicCounter bool, cardinalityLimit int, sendTimerHistogram []TimerHistogramMapping) error {
 	p.resetState(timeNowFunc())
 
 	p.histogramEvents = defaultObserverCategory
 	p.timerEvents = defaultObserverCategory
+	p.distributionEvents = defaultObserverCategory
 	p.enableMetricType = enableMetricType
 	p.isMonotonicCounter = isMonotonicCounter
+	p.cardinalityLimit = cardinalityLimit
 	// Note: validation occurs in ("../".Config).validate()
 	for _, eachMap := range sendTimerHistogram {
 		switch eachMap.StatsdType {
@@ -161,6 +180,9 @@ func (p *StatsDParser) Initialize(enableMetricType bool, isMonotonicCounter bool
 		case TimingTypeName, TimingAltTypeName:
 			p.timerEvents.method = eachMap.ObserverType
 			p.timerEvents.histogramConfig = expoHistogramConfig(eachMap.Histogram)
+		case DistributionTypeName:
+			p.distributionEvents.method = eachMap.ObserverType
+			p.distributionEvents.histogramConfig = expoHistogramConfig(eachMap.Histogram)
 		}
 	}
 	return nil
@@ -225,16 +247,46 @@ func (p *StatsDParser) observerCategoryFor(t MetricType) ObserverCategory {
 		return p.histogramEvents
 	case TimingType:
 		return p.timerEvents
+	case DistributionType:
+		return p.distributionEvents
 	}
 	return defaultObserverCategory
 }
 
+// limitCardinality returns the description of the series the metric is aggregated
+// into. Once a metric name and type has as many series as the cardinality limit
+// during the interval, the metrics of new series are aggregated into an overflow
+// series, which only has the overflow attribute.
+func (p *StatsDParser) limitCardinality(desc statsDMetricDescription) statsDMetricDescription {
+	if p.cardinalityLimit <= 0 {
+		return desc
+	}
+	if _, ok := p.series[desc]; ok {
+		return desc
+	}
+
+	metric := statsDMetricDescription{
+		name:       desc.name,
+		metricType: desc.metricType,
+	}
+	if p.seriesPerMetric[metric] >= p.cardinalityLimit {
+		metric.attrs = attribute.NewSet(attribute.Bool(attrOverflow, true))
+		return metric
+	}
+
+	p.series[desc] = struct{}{}
+	p.seriesPerMetric[metric]++
+	return desc
+}
+
 // Aggregate for each metric line.
 func (p *StatsDParser) Aggregate(line string) error {
 	parsedMetric, err := parseMessageToMetric(line, p.enableMetricType)
 	if err != nil {
 		return err
 	}
+	parsedMetric.description = p.limitCardinality(parsedMetric.description)
+
 	switch parsedMetric.description.metricType {
 	case GaugeType:
 		_, ok := p.gauges[parsedMetric.description]
@@ -260,7 +312,7 @@ func (p *StatsDParser) Aggregate(line string) error {
 			point.SetIntValue(point.IntValue() + parsedMetric.counterValue())
 		}
 
-	case TimingType, HistogramType:
+	case TimingType, HistogramType, DistributionType:
 		category := p.observerCategoryFor(parsedMetric.description.metricType)
 		switch category.method {
 		case GaugeObserver:
@@ -331,7 +383,7 @@ func parseMessageToMetric(line string, enableMetricType bool) (statsDMetric, err
 
 	inType := MetricType(parts[1])
 	switch inType {
-	case CounterType, GaugeType, HistogramType, TimingType:
+	case CounterType, GaugeType, HistogramType, TimingType, DistributionType:
 		result.description.metricType = inType
 	default:
 		return result, fmt.Errorf("unsupported metric type: %s", inType)
@@ -366,6 +418,12 @@ func parseMessageToMetric(line string, enableMetricType bool) (statsDMetric, err
 				v := tagParts[1]
 				kvs = append(kvs, attribute.String(k, v))
 			}
+		case strings.HasPrefix(part, "c:"):
+			// The container ID field of the DogStatsD protocol
+			containerID := strings.TrimPrefix(part, "c:")
+			if containerID != "" {
+				kvs = append(kvs, attribute.String(attrContainerID, containerID))
+			}
 		default:
 			return result, fmt.Errorf("unrecognized message part: %s", part)
 		}
@@ -231,6 +231,27 @@ func Test_ParseMessageToMetric(t *testing.T) {
 				false,
 				"h", 0, nil, nil),
 		},
+		{
+			name:  "distribution",
+			input: "test.metric:42|d",
+			wantMetric: testStatsDMetric(
+				"test.metric",
+				42,
+				false,
+				"d", 0, nil, nil),
+		},
+		{
+			name:  "distribution with tag and container id",
+			input: "test.metric:42|d|#key:value|c:83c0a99c0a54c0c187f461c7980e9b57f3f6a8b0c918c8d93df19a9de6f3fe1d",
+			wantMetric: testStatsDMetric(
+				"test.metric",
+				42,
+				false,
+				"d",
+				0,
+				[]string{"key", "container.id"},
+				[]string{"value", "83c0a99c0a54c0c187f461c7980e9b57f3f6a8b0c918c8d93df19a9de6f3fe1d"}),
+		},
 	}
 
 	for _, tt := range tests {
@@ -664,7 +685,7 @@ func TestStatsDParser_Aggregate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+			assert.NoError(t, p.Initialize(false, false, 0, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
 			p.lastIntervalTime = time.Unix(611, 0)
 			for _, line := range tt.input {
 				err = p.Aggregate(line)
@@ -733,7 +754,7 @@ func TestStatsDParser_AggregateWithMetricType(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(true, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+			assert.NoError(t, p.Initialize(true, false, 0, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
 			p.lastIntervalTime = time.Unix(611, 0)
 			for _, line := range tt.input {
 				err = p.Aggregate(line)
@@ -781,7 +802,7 @@ func TestStatsDParser_AggregateWithIsMonotonicCounter(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(false, true, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+			assert.NoError(t, p.Initialize(false, true, 0, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
 			p.lastIntervalTime = time.Unix(611, 0)
 			for _, line := range tt.input {
 				err = p.Aggregate(line)
@@ -877,7 +898,7 @@ func TestStatsDParser_AggregateTimerWithSummary(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "summary"}, {StatsdType: "histogram", ObserverType: "summary"}}))
+			assert.NoError(t, p.Initialize(false, false, 0, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "summary"}, {StatsdType: "histogram", ObserverType: "summary"}}))
 			for _, line := range tt.input {
 				err = p.Aggregate(line)
 			}
@@ -892,7 +913,7 @@ func TestStatsDParser_AggregateTimerWithSummary(t *testing.T) {
 
 func TestStatsDParser_Initialize(t *testing.T) {
 	p := &StatsDParser{}
-	assert.NoError(t, p.Initialize(true, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+	assert.NoError(t, p.Initialize(true, false, 0, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
 	teststatsdDMetricdescription := statsDMetricDescription{
 		name:       "test",
 		metricType: "g",
@@ -905,7 +926,7 @@ func TestStatsDParser_Initialize(t *testing.T) {
 
 func TestStatsDParser_GetMetricsWithMetricType(t *testing.T) {
 	p := &StatsDParser{}
-	assert.NoError(t, p.Initialize(true, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+	assert.NoError(t, p.Initialize(true, false, 0, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
 	p.gauges[testDescription("statsdTestMetric1", "g",
 		[]string{"mykey", "metric_type"}, []string{"myvalue", "gauge"})] =
 		buildGaugeMetric(testStatsDMetric("testGauge1", 1, false, "g", 0, []string{"mykey", "metric_type"}, []string{"myvalue", "gauge"}), time.Unix(711, 0))
@@ -978,7 +999,7 @@ func TestStatsDParser_Mappings(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			p := &StatsDParser{}
 
-			assert.NoError(t, p.Initialize(false, false, tc.mapping))
+			assert.NoError(t, p.Initialize(false, false, 0, tc.mapping))
 
 			assert.NoError(t, p.Aggregate("H:10|h"))
 			assert.NoError(t, p.Aggregate("T:10|ms"))
@@ -1215,7 +1236,7 @@ func TestStatsDParser_AggregateTimerWithHistogram(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(false, false, tt.mapping))
+			assert.NoError(t, p.Initialize(false, false, 0, tt.mapping))
 			for _, line := range tt.input {
 				err = p.Aggregate(line)
 				assert.NoError(t, err)
@@ -1225,3 +1246,59 @@ func TestStatsDParser_AggregateTimerWithHistogram(t *testing.T) {
 		})
 	}
 }
+
+func TestStatsDParser_AggregateDistributionWithHistogram(t *testing.T) {
+	timeNowFunc = func() time.Time {
+		return time.Unix(711, 0)
+	}
+
+	p := &StatsDParser{}
+	assert.NoError(t, p.Initialize(false, false, 0, []TimerHistogramMapping{{StatsdType: "distribution", ObserverType: "histogram"}}))
+	for _, line := range []string{"test.metric:1|d", "test.metric:2|d", "test.metric:4|d"} {
+		assert.NoError(t, p.Aggregate(line))
+	}
+
+	metrics := p.GetMetrics()
+	assert.Equal(t, 1, metrics.ResourceMetrics().At(0).ScopeMetrics().Len())
+	metric := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "test.metric", metric.Name())
+	assert.Equal(t, pmetric.MetricTypeExponentialHistogram, metric.Type())
+	assert.Equal(t, uint64(3), metric.ExponentialHistogram().DataPoints().At(0).Count())
+}
+
+func TestStatsDParser_AggregateWithCardinalityLimit(t *testing.T) {
+	timeNowFunc = func() time.Time {
+		return time.Unix(711, 0)
+	}
+
+	p := &StatsDParser{}
+	assert.NoError(t, p.Initialize(false, false, 2, nil))
+	for _, line := range []string{
+		"test.metric:1|c|#key:value1",
+		"test.metric:1|c|#key:value2",
+		"test.metric:1|c|#key:value1",
+		"test.metric:1|c|#key:value3",
+		"test.metric:1|c|#key:value4",
+		"other.metric:1|c|#key:value3",
+	} {
+		assert.NoError(t, p.Aggregate(line))
+	}
+
+	assert.Len(t, p.counters, 4)
+	assert.Equal(t, int64(2), p.counters[testDescription("test.metric", "c", []string{"key"}, []string{"value1"})].Metrics().At(0).Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(1), p.counters[testDescription("test.metric", "c", []string{"key"}, []string{"value2"})].Metrics().At(0).Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(1), p.counters[testDescription("other.metric", "c", []string{"key"}, []string{"value3"})].Metrics().At(0).Sum().DataPoints().At(0).IntValue())
+
+	overflow := statsDMetricDescription{
+		name:       "test.metric",
+		metricType: "c",
+		attrs:      attribute.NewSet(attribute.Bool(attrOverflow, true)),
+	}
+	assert.Equal(t, int64(2), p.counters[overflow].Metrics().At(0).Sum().DataPoints().At(0).IntValue())
+
+	// The series are counted again in the next interval
+	p.GetMetrics()
+	assert.NoError(t, p.Aggregate("test.metric:1|c|#key:value3"))
+	assert.Len(t, p.counters, 1)
+	assert.Contains(t, p.counters, testDescription("test.metric", "c", []string{"key"}, []string{"value3"}))
+}
@@ -75,10 +75,12 @@ func New(
 }
 
 func buildTransportServer(config Config) (transport.Server, error) {
-	// TODO: Add TCP/unix socket transport implementations
+	// TODO: Add TCP transport implementation
 	switch strings.ToLower(config.NetAddr.Transport) {
 	case "", "udp":
 		return transport.NewUDPServer(config.NetAddr.Endpoint)
+	case "unixgram":
+		return transport.NewUnixgramServer(config.NetAddr.Endpoint)
 	}
 
 	return nil, fmt.Errorf("unsupported transport %q for receiver %v", config.NetAddr.Transport, config.ID())
@@ -89,7 +91,7 @@ func (r *statsdReceiver) Start(ctx context.Context, host component.Host) error {
 	ctx, r.cancel = context.WithCancel(ctx)
 	var transferChan = make(chan string, 10)
 	ticker := time.NewTicker(r.config.AggregationInterval)
-	err := r.parser.Initialize(r.config.EnableMetricType, r.config.IsMonotonicCounter, r.config.TimerHistogramMapping)
+	err := r.parser.Initialize(r.config.EnableMetricType, r.config.IsMonotonicCounter, r.config.CardinalityLimit, r.config.TimerHistogramMapping)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,8 @@ package transport
 
 import (
 	"net"
+	"os"
+	"path/filepath"
 	"runtime"
 	"strconv"
 	"sync"
@@ -114,3 +116,55 @@ func Test_Server_ListenAndServe(t *testing.T) {
 		})
 	}
 }
+
+func Test_UnixgramServer_ListenAndServe(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("unixgram sockets are not supported on windows")
+	}
+	path := filepath.Join(t.TempDir(), "statsd.sock")
+
+	// A socket left by a previous run is replaced
+	stale, err := net.ListenPacket("unixgram", path)
+	require.NoError(t, err)
+	require.NoError(t, stale.Close())
+
+	srv, err := NewUnixgramServer(path)
+	require.NoError(t, err)
+	require.NotNil(t, srv)
+
+	mc := new(consumertest.MetricsSink)
+	p := &protocol.StatsDParser{}
+	mr := NewMockReporter(1)
+	var transferChan = make(chan string, 10)
+
+	wgListenAndServe := sync.WaitGroup{}
+	wgListenAndServe.Add(1)
+	go func() {
+		defer wgListenAndServe.Done()
+		assert.Error(t, srv.ListenAndServe(p, mc, mr, transferChan))
+	}()
+
+	conn, err := net.Dial("unixgram", path)
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("test.metric:42|c|c:container1\ntest.metric:1|d\n"))
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	assert.Eventually(t, func() bool {
+		return len(transferChan) == 2
+	}, 10*time.Second, 100*time.Millisecond)
+
+	require.NoError(t, srv.Close())
+	wgListenAndServe.Wait()
+
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func Test_NewUnixgramServer_NotASocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "statsd.sock")
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0600))
+
+	_, err := NewUnixgramServer(path)
+	assert.Error(t, err)
+}
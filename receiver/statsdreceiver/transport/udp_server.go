@@ -67,7 +67,8 @@ func (u *udpServer) ListenAndServe(
 			u.handlePacket(bufCopy, transferChan)
 		}
 		if err != nil {
-			u.reporter.OnDebugf("UDP Transport (%s) - ReadFrom error: %v",
+			u.reporter.OnDebugf("%s Transport (%s) - ReadFrom error: %v",
+				strings.ToUpper(u.packetConn.LocalAddr().Network()),
 				u.packetConn.LocalAddr(),
 				err)
 			var netErr net.Error
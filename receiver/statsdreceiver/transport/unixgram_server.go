@@ -0,0 +1,67 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/transport"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+)
+
+type unixgramServer struct {
+	*udpServer
+	path string
+}
+
+var _ (Server) = (*unixgramServer)(nil)
+
+// NewUnixgramServer creates a transport.Server using a Unix domain datagram
+// socket at path as its transport, as DogStatsD clients do. A socket left at
+// path by a previous run is removed.
+func NewUnixgramServer(path string) (Server, error) {
+	info, err := os.Lstat(path)
+	switch {
+	case err == nil && info.Mode()&os.ModeSocket == 0:
+		return nil, fmt.Errorf("%s exists and is not a socket", path)
+	case err == nil:
+		if err = os.Remove(path); err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, err
+	}
+
+	packetConn, err := net.ListenPacket("unixgram", path)
+	if err != nil {
+		return nil, err
+	}
+
+	u := unixgramServer{
+		udpServer: &udpServer{
+			packetConn: packetConn,
+		},
+		path: path,
+	}
+	return &u, nil
+}
+
+func (u *unixgramServer) Close() error {
+	err := u.udpServer.Close()
+	if removeErr := os.Remove(u.path); removeErr != nil && !errors.Is(removeErr, os.ErrNotExist) && err == nil {
+		err = removeErr
+	}
+	return err
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver/internal/metadata"
+)
+
+// Predefined error responses for configuration validation failures
+var (
+	errMissingTargets     = errors.New(`at least one entry in "targets" must be specified`)
+	errInvalidTarget      = errors.New(`exactly one of "endpoint" or "file_path" must be specified`)
+	errInvalidEndpoint    = errors.New(`"endpoint" must be in the form of <hostname>:<port>`)
+	errInvalidTimeout     = errors.New(`"timeout" must be positive`)
+	errServerNameWithFile = errors.New(`"server_name" can only be used with "endpoint"`)
+)
+
+const defaultTimeout = 10 * time.Second
+
+// Config defines the configuration for the various elements of the receiver agent.
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
+	Targets                                 []TargetConfig           `mapstructure:"targets"`
+	// Timeout bounds the connection and handshake with each endpoint.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// TargetConfig is a TLS endpoint to connect to, or a local certificate file to read.
+type TargetConfig struct {
+	// Endpoint is the <hostname>:<port> of the TLS endpoint.
+	Endpoint string `mapstructure:"endpoint"`
+	// FilePath is the path of a PEM encoded certificate file. Certificates following the
+	// first one are used as intermediates when verifying the chain.
+	FilePath string `mapstructure:"file_path"`
+	// ServerName overrides the hostname of the endpoint sent for SNI and used to verify the certificate.
+	ServerName string `mapstructure:"server_name"`
+	// CAFile is the path of the PEM encoded CA certificates the chain is verified against.
+	// The system certificate pool is used if not set.
+	CAFile string `mapstructure:"ca_file"`
+}
+
+// target returns the value of the tlscheck.target attribute
+func (t TargetConfig) target() string {
+	if t.Endpoint != "" {
+		return t.Endpoint
+	}
+	return t.FilePath
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *Config) Validate() error {
+	var err error
+
+	if len(cfg.Targets) == 0 {
+		err = multierr.Append(err, errMissingTargets)
+	}
+
+	for i, target := range cfg.Targets {
+		if (target.Endpoint == "") == (target.FilePath == "") {
+			err = multierr.Append(err, fmt.Errorf("targets[%d]: %w", i, errInvalidTarget))
+			continue
+		}
+		if target.Endpoint != "" {
+			if _, _, splitErr := net.SplitHostPort(target.Endpoint); splitErr != nil {
+				err = multierr.Append(err, fmt.Errorf("targets[%d]: %s: %w", i, errInvalidEndpoint.Error(), splitErr))
+			}
+		} else if target.ServerName != "" {
+			err = multierr.Append(err, fmt.Errorf("targets[%d]: %w", i, errServerNameWithFile))
+		}
+	}
+
+	if cfg.Timeout <= 0 {
+		err = multierr.Append(err, errInvalidTimeout)
+	}
+
+	return err
+}
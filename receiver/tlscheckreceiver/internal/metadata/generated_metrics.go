@@ -0,0 +1,387 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// MetricSettings provides common settings for a particular metric.
+type MetricSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// MetricsSettings provides settings for tlscheckreceiver metrics.
+type MetricsSettings struct {
+	TlscheckCertTimeLeft      MetricSettings `mapstructure:"tlscheck.cert.time_left"`
+	TlscheckChainValid        MetricSettings `mapstructure:"tlscheck.chain.valid"`
+	TlscheckError             MetricSettings `mapstructure:"tlscheck.error"`
+	TlscheckHandshakeDuration MetricSettings `mapstructure:"tlscheck.handshake.duration"`
+}
+
+func DefaultMetricsSettings() MetricsSettings {
+	return MetricsSettings{
+		TlscheckCertTimeLeft: MetricSettings{
+			Enabled: true,
+		},
+		TlscheckChainValid: MetricSettings{
+			Enabled: true,
+		},
+		TlscheckError: MetricSettings{
+			Enabled: true,
+		},
+		TlscheckHandshakeDuration: MetricSettings{
+			Enabled: true,
+		},
+	}
+}
+
+type metricTlscheckCertTimeLeft struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills tlscheck.cert.time_left metric with initial data.
+func (m *metricTlscheckCertTimeLeft) init() {
+	m.data.SetName("tlscheck.cert.time_left")
+	m.data.SetDescription("The time until the certificate expires. Negative if the certificate has already expired.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricTlscheckCertTimeLeft) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, tlscheckTargetAttributeValue string, tlscheckX509SubjectAttributeValue string, tlscheckX509IssuerAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("tlscheck.target", tlscheckTargetAttributeValue)
+	dp.Attributes().PutStr("tlscheck.x509.subject", tlscheckX509SubjectAttributeValue)
+	dp.Attributes().PutStr("tlscheck.x509.issuer", tlscheckX509IssuerAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricTlscheckCertTimeLeft) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricTlscheckCertTimeLeft) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricTlscheckCertTimeLeft(settings MetricSettings) metricTlscheckCertTimeLeft {
+	m := metricTlscheckCertTimeLeft{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricTlscheckChainValid struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills tlscheck.chain.valid metric with initial data.
+func (m *metricTlscheckChainValid) init() {
+	m.data.SetName("tlscheck.chain.valid")
+	m.data.SetDescription("1 if the certificate chain of the target is valid, otherwise 0.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricTlscheckChainValid) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, tlscheckTargetAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("tlscheck.target", tlscheckTargetAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricTlscheckChainValid) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricTlscheckChainValid) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricTlscheckChainValid(settings MetricSettings) metricTlscheckChainValid {
+	m := metricTlscheckChainValid{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricTlscheckError struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills tlscheck.error metric with initial data.
+func (m *metricTlscheckError) init() {
+	m.data.SetName("tlscheck.error")
+	m.data.SetDescription("Records errors occurring during the TLS check.")
+	m.data.SetUnit("{error}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricTlscheckError) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, tlscheckTargetAttributeValue string, errorMessageAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("tlscheck.target", tlscheckTargetAttributeValue)
+	dp.Attributes().PutStr("error.message", errorMessageAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricTlscheckError) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricTlscheckError) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricTlscheckError(settings MetricSettings) metricTlscheckError {
+	m := metricTlscheckError{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricTlscheckHandshakeDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills tlscheck.handshake.duration metric with initial data.
+func (m *metricTlscheckHandshakeDuration) init() {
+	m.data.SetName("tlscheck.handshake.duration")
+	m.data.SetDescription("Measures the duration of the TLS handshake with an endpoint, excluding the TCP connection.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricTlscheckHandshakeDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, tlscheckTargetAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("tlscheck.target", tlscheckTargetAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricTlscheckHandshakeDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricTlscheckHandshakeDuration) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricTlscheckHandshakeDuration(settings MetricSettings) metricTlscheckHandshakeDuration {
+	m := metricTlscheckHandshakeDuration{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+// MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
+// required to produce metric representation defined in metadata and user settings.
+type MetricsBuilder struct {
+	startTime                       pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                 int                 // maximum observed number of metrics per resource.
+	resourceCapacity                int                 // maximum observed number of resource attributes.
+	metricsBuffer                   pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                       component.BuildInfo // contains version information
+	metricTlscheckCertTimeLeft      metricTlscheckCertTimeLeft
+	metricTlscheckChainValid        metricTlscheckChainValid
+	metricTlscheckError             metricTlscheckError
+	metricTlscheckHandshakeDuration metricTlscheckHandshakeDuration
+}
+
+// metricBuilderOption applies changes to default metrics builder.
+type metricBuilderOption func(*MetricsBuilder)
+
+// WithStartTime sets startTime on the metrics builder.
+func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
+	return func(mb *MetricsBuilder) {
+		mb.startTime = startTime
+	}
+}
+
+func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo, options ...metricBuilderOption) *MetricsBuilder {
+	mb := &MetricsBuilder{
+		startTime:                       pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                   pmetric.NewMetrics(),
+		buildInfo:                       buildInfo,
+		metricTlscheckCertTimeLeft:      newMetricTlscheckCertTimeLeft(settings.TlscheckCertTimeLeft),
+		metricTlscheckChainValid:        newMetricTlscheckChainValid(settings.TlscheckChainValid),
+		metricTlscheckError:             newMetricTlscheckError(settings.TlscheckError),
+		metricTlscheckHandshakeDuration: newMetricTlscheckHandshakeDuration(settings.TlscheckHandshakeDuration),
+	}
+	for _, op := range options {
+		op(mb)
+	}
+	return mb
+}
+
+// updateCapacity updates max length of metrics and resource attributes that will be used for the slice capacity.
+func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
+	if mb.metricsCapacity < rm.ScopeMetrics().At(0).Metrics().Len() {
+		mb.metricsCapacity = rm.ScopeMetrics().At(0).Metrics().Len()
+	}
+	if mb.resourceCapacity < rm.Resource().Attributes().Len() {
+		mb.resourceCapacity = rm.Resource().Attributes().Len()
+	}
+}
+
+// ResourceMetricsOption applies changes to provided resource metrics.
+type ResourceMetricsOption func(pmetric.ResourceMetrics)
+
+// WithStartTimeOverride overrides start time for all the resource metrics data points.
+// This option should be only used if different start time has to be set on metrics coming from different resources.
+func WithStartTimeOverride(start pcommon.Timestamp) ResourceMetricsOption {
+	return func(rm pmetric.ResourceMetrics) {
+		var dps pmetric.NumberDataPointSlice
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		for i := 0; i < metrics.Len(); i++ {
+			switch metrics.At(i).Type() {
+			case pmetric.MetricTypeGauge:
+				dps = metrics.At(i).Gauge().DataPoints()
+			case pmetric.MetricTypeSum:
+				dps = metrics.At(i).Sum().DataPoints()
+			}
+			for j := 0; j < dps.Len(); j++ {
+				dps.At(j).SetStartTimestamp(start)
+			}
+		}
+	}
+}
+
+// EmitForResource saves all the generated metrics under a new resource and updates the internal state to be ready for
+// recording another set of data points as part of another resource. This function can be helpful when one scraper
+// needs to emit metrics from several resources. Otherwise calling this function is not required,
+// just `Emit` function can be called instead.
+// Resource attributes should be provided as ResourceMetricsOption arguments.
+func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
+	rm := pmetric.NewResourceMetrics()
+	rm.Resource().Attributes().EnsureCapacity(mb.resourceCapacity)
+	ils := rm.ScopeMetrics().AppendEmpty()
+	ils.Scope().SetName("otelcol/tlscheckreceiver")
+	ils.Scope().SetVersion(mb.buildInfo.Version)
+	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricTlscheckCertTimeLeft.emit(ils.Metrics())
+	mb.metricTlscheckChainValid.emit(ils.Metrics())
+	mb.metricTlscheckError.emit(ils.Metrics())
+	mb.metricTlscheckHandshakeDuration.emit(ils.Metrics())
+	for _, op := range rmo {
+		op(rm)
+	}
+	if ils.Metrics().Len() > 0 {
+		mb.updateCapacity(rm)
+		rm.MoveTo(mb.metricsBuffer.ResourceMetrics().AppendEmpty())
+	}
+}
+
+// Emit returns all the metrics accumulated by the metrics builder and updates the internal state to be ready for
+// recording another set of metrics. This function will be responsible for applying all the transformations required to
+// produce metric representation defined in metadata and user settings, e.g. delta or cumulative.
+func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
+	mb.EmitForResource(rmo...)
+	metrics := pmetric.NewMetrics()
+	mb.metricsBuffer.MoveTo(metrics)
+	return metrics
+}
+
+// RecordTlscheckCertTimeLeftDataPoint adds a data point to tlscheck.cert.time_left metric.
+func (mb *MetricsBuilder) RecordTlscheckCertTimeLeftDataPoint(ts pcommon.Timestamp, val int64, tlscheckTargetAttributeValue string, tlscheckX509SubjectAttributeValue string, tlscheckX509IssuerAttributeValue string) {
+	mb.metricTlscheckCertTimeLeft.recordDataPoint(mb.startTime, ts, val, tlscheckTargetAttributeValue, tlscheckX509SubjectAttributeValue, tlscheckX509IssuerAttributeValue)
+}
+
+// RecordTlscheckChainValidDataPoint adds a data point to tlscheck.chain.valid metric.
+func (mb *MetricsBuilder) RecordTlscheckChainValidDataPoint(ts pcommon.Timestamp, val int64, tlscheckTargetAttributeValue string) {
+	mb.metricTlscheckChainValid.recordDataPoint(mb.startTime, ts, val, tlscheckTargetAttributeValue)
+}
+
+// RecordTlscheckErrorDataPoint adds a data point to tlscheck.error metric.
+func (mb *MetricsBuilder) RecordTlscheckErrorDataPoint(ts pcommon.Timestamp, val int64, tlscheckTargetAttributeValue string, errorMessageAttributeValue string) {
+	mb.metricTlscheckError.recordDataPoint(mb.startTime, ts, val, tlscheckTargetAttributeValue, errorMessageAttributeValue)
+}
+
+// RecordTlscheckHandshakeDurationDataPoint adds a data point to tlscheck.handshake.duration metric.
+func (mb *MetricsBuilder) RecordTlscheckHandshakeDurationDataPoint(ts pcommon.Timestamp, val int64, tlscheckTargetAttributeValue string) {
+	mb.metricTlscheckHandshakeDuration.recordDataPoint(mb.startTime, ts, val, tlscheckTargetAttributeValue)
+}
+
+// Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
+// and metrics builder should update its startTime and reset it's internal state accordingly.
+func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
+	mb.startTime = pcommon.NewTimestampFromTime(time.Now())
+	for _, op := range options {
+		op(mb)
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver"
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver/internal/metadata"
+)
+
+type tlscheckScraper struct {
+	cfg      *Config
+	settings component.TelemetrySettings
+	mb       *metadata.MetricsBuilder
+}
+
+// checkResult is the outcome of checking a single target
+type checkResult struct {
+	// certs are the certificates presented by the target, leaf first
+	certs []*x509.Certificate
+	// chainVerified is false if the chain could not be verified at all, e.g. the CA file is unreadable
+	chainVerified bool
+	chainValid    bool
+	// handshakeDuration is only set for endpoints that completed the handshake
+	handshakeDuration time.Duration
+	errs              []error
+}
+
+func newScraper(conf *Config, settings component.ReceiverCreateSettings) *tlscheckScraper {
+	return &tlscheckScraper{
+		cfg:      conf,
+		settings: settings.TelemetrySettings,
+		mb:       metadata.NewMetricsBuilder(conf.Metrics, settings.BuildInfo),
+	}
+}
+
+// scrape checks every target concurrently and produces metrics based on the certificates found
+func (s *tlscheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	results := make([]checkResult, len(s.cfg.Targets))
+	var wg sync.WaitGroup
+	for i, target := range s.cfg.Targets {
+		wg.Add(1)
+		go func(i int, target TargetConfig) {
+			defer wg.Done()
+			if target.Endpoint != "" {
+				results[i] = s.checkEndpoint(ctx, target)
+			} else {
+				results[i] = s.checkFile(target)
+			}
+		}(i, target)
+	}
+	wg.Wait()
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for i, target := range s.cfg.Targets {
+		s.record(now, target, results[i])
+	}
+
+	return s.mb.Emit(), nil
+}
+
+func (s *tlscheckScraper) record(now pcommon.Timestamp, targetConfig TargetConfig, result checkResult) {
+	target := targetConfig.target()
+	for _, err := range result.errs {
+		s.mb.RecordTlscheckErrorDataPoint(now, int64(1), target, err.Error())
+	}
+	if len(result.certs) == 0 {
+		return
+	}
+
+	if targetConfig.Endpoint != "" {
+		s.mb.RecordTlscheckHandshakeDurationDataPoint(now, result.handshakeDuration.Milliseconds(), target)
+	}
+	for _, cert := range result.certs {
+		timeLeft := cert.NotAfter.Sub(now.AsTime())
+		s.mb.RecordTlscheckCertTimeLeftDataPoint(now, int64(timeLeft.Seconds()), target, cert.Subject.CommonName, cert.Issuer.CommonName)
+	}
+	if result.chainVerified {
+		chainValid := int64(0)
+		if result.chainValid {
+			chainValid = 1
+		}
+		s.mb.RecordTlscheckChainValidDataPoint(now, chainValid, target)
+	}
+}
+
+// checkEndpoint connects to the endpoint and verifies the certificates presented during the handshake
+func (s *tlscheckScraper) checkEndpoint(ctx context.Context, target TargetConfig) checkResult {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	serverName := target.ServerName
+	if serverName == "" {
+		host, _, err := net.SplitHostPort(target.Endpoint)
+		if err != nil {
+			return checkResult{errs: []error{err}}
+		}
+		serverName = host
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", target.Endpoint)
+	if err != nil {
+		return checkResult{errs: []error{err}}
+	}
+	defer conn.Close()
+
+	// Verification is skipped during the handshake and done afterwards, so the
+	// certificates of an invalid chain are still reported.
+	tlsConn := tls.Client(conn, &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: true, // #nosec G402
+	})
+	start := time.Now()
+	if err = tlsConn.HandshakeContext(ctx); err != nil {
+		return checkResult{errs: []error{err}}
+	}
+	result := checkResult{
+		certs:             tlsConn.ConnectionState().PeerCertificates,
+		handshakeDuration: time.Since(start),
+	}
+	s.verifyChain(&result, serverName, target.CAFile)
+	return result
+}
+
+// checkFile reads the certificates of the file and verifies them
+func (s *tlscheckScraper) checkFile(target TargetConfig) checkResult {
+	certs, err := loadCertificates(target.FilePath)
+	if err != nil {
+		return checkResult{errs: []error{err}}
+	}
+	result := checkResult{certs: certs}
+	s.verifyChain(&result, "", target.CAFile)
+	return result
+}
+
+// verifyChain verifies the leaf certificate of the result, using the following certificates as intermediates
+func (s *tlscheckScraper) verifyChain(result *checkResult, dnsName string, caFile string) {
+	opts := x509.VerifyOptions{
+		DNSName:       dnsName,
+		Intermediates: x509.NewCertPool(),
+	}
+	if caFile != "" {
+		cas, err := loadCertificates(caFile)
+		if err != nil {
+			result.errs = append(result.errs, err)
+			return
+		}
+		opts.Roots = x509.NewCertPool()
+		for _, ca := range cas {
+			opts.Roots.AddCert(ca)
+		}
+	}
+	for _, intermediate := range result.certs[1:] {
+		opts.Intermediates.AddCert(intermediate)
+	}
+
+	_, err := result.certs[0].Verify(opts)
+	result.chainVerified = true
+	result.chainValid = err == nil
+	if err != nil {
+		s.settings.Logger.Debug(fmt.Sprintf("invalid certificate chain: %s", err))
+	}
+}
+
+// loadCertificates parses the PEM encoded certificates of a file
+func loadCertificates(path string) ([]*x509.Certificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate in %s: %w", path, err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+	return certs, nil
+}
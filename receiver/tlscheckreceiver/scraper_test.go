@@ -0,0 +1,212 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tlscheckreceiver"
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestScrapeEndpoint(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+	endpoint := ts.Listener.Addr().String()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	writeCertificates(t, caFile, ts.Certificate())
+
+	testCases := []struct {
+		desc          string
+		target        TargetConfig
+		expectedValid int64
+	}{
+		{
+			desc:          "untrusted chain",
+			target:        TargetConfig{Endpoint: endpoint},
+			expectedValid: 0,
+		},
+		{
+			desc:          "trusted chain",
+			target:        TargetConfig{Endpoint: endpoint, CAFile: caFile},
+			expectedValid: 1,
+		},
+		{
+			desc:          "hostname mismatch",
+			target:        TargetConfig{Endpoint: endpoint, CAFile: caFile, ServerName: "unknown.example.org"},
+			expectedValid: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			metrics := scrapeTargets(t, tc.target)
+
+			require.NotContains(t, metrics, "tlscheck.error")
+			require.Contains(t, metrics, "tlscheck.handshake.duration")
+
+			timeLeft := metrics["tlscheck.cert.time_left"].Gauge().DataPoints()
+			require.Equal(t, 1, timeLeft.Len())
+			expected := int64(time.Until(ts.Certificate().NotAfter).Seconds())
+			require.InDelta(t, expected, timeLeft.At(0).IntValue(), 60)
+			target, ok := timeLeft.At(0).Attributes().Get("tlscheck.target")
+			require.True(t, ok)
+			require.Equal(t, endpoint, target.Str())
+
+			valid := metrics["tlscheck.chain.valid"].Gauge().DataPoints()
+			require.Equal(t, 1, valid.Len())
+			require.Equal(t, tc.expectedValid, valid.At(0).IntValue())
+		})
+	}
+}
+
+func TestScrapeFile(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	valid := generateCertificate(t, "valid.example.org", now.Add(-time.Hour), now.Add(48*time.Hour))
+	validFile := filepath.Join(dir, "valid.pem")
+	writeCertificates(t, validFile, valid)
+
+	expired := generateCertificate(t, "expired.example.org", now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+	expiredFile := filepath.Join(dir, "expired.pem")
+	writeCertificates(t, expiredFile, expired)
+
+	t.Run("valid", func(t *testing.T) {
+		metrics := scrapeTargets(t, TargetConfig{FilePath: validFile, CAFile: validFile})
+
+		timeLeft := metrics["tlscheck.cert.time_left"].Gauge().DataPoints().At(0)
+		require.InDelta(t, int64(48*time.Hour/time.Second), timeLeft.IntValue(), 60)
+		subject, ok := timeLeft.Attributes().Get("tlscheck.x509.subject")
+		require.True(t, ok)
+		require.Equal(t, "valid.example.org", subject.Str())
+		require.EqualValues(t, 1, metrics["tlscheck.chain.valid"].Gauge().DataPoints().At(0).IntValue())
+		require.NotContains(t, metrics, "tlscheck.handshake.duration")
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		metrics := scrapeTargets(t, TargetConfig{FilePath: expiredFile, CAFile: expiredFile})
+
+		timeLeft := metrics["tlscheck.cert.time_left"].Gauge().DataPoints().At(0)
+		require.InDelta(t, -int64(24*time.Hour/time.Second), timeLeft.IntValue(), 60)
+		require.EqualValues(t, 0, metrics["tlscheck.chain.valid"].Gauge().DataPoints().At(0).IntValue())
+	})
+}
+
+func TestScrapeErrors(t *testing.T) {
+	// reserve a port and release it so nothing is listening on it
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedEndpoint := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	notPEM := filepath.Join(t.TempDir(), "not.pem")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a certificate"), 0600))
+
+	testCases := []struct {
+		desc   string
+		target TargetConfig
+	}{
+		{
+			desc:   "connection refused",
+			target: TargetConfig{Endpoint: closedEndpoint},
+		},
+		{
+			desc:   "missing file",
+			target: TargetConfig{FilePath: filepath.Join(t.TempDir(), "missing.pem")},
+		},
+		{
+			desc:   "no certificates in file",
+			target: TargetConfig{FilePath: notPEM},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			metrics := scrapeTargets(t, tc.target)
+
+			require.Len(t, metrics, 1)
+			errs := metrics["tlscheck.error"].Sum().DataPoints()
+			require.Equal(t, 1, errs.Len())
+			require.EqualValues(t, 1, errs.At(0).IntValue())
+		})
+	}
+}
+
+// scrapeTargets scrapes the targets and returns the emitted metrics by name
+func scrapeTargets(t *testing.T, targets ...TargetConfig) map[string]pmetric.Metric {
+	t.Helper()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Targets = targets
+	require.NoError(t, cfg.Validate())
+
+	scraper := newScraper(cfg, componenttest.NewNopReceiverCreateSettings())
+	metrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	byName := map[string]pmetric.Metric{}
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		byName[ms.At(i).Name()] = ms.At(i)
+	}
+	return byName
+}
+
+// generateCertificate creates a self-signed CA certificate
+func generateCertificate(t *testing.T, commonName string, notBefore, notAfter time.Time) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func writeCertificates(t *testing.T, path string, certs ...*x509.Certificate) {
+	t.Helper()
+	var data []byte
+	for _, cert := range certs {
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+	require.NoError(t, os.WriteFile(path, data, 0600))
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/vmware/govmomi/performance"
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
 	vt "github.com/vmware/govmomi/vim25/types"
 )
 
@@ -123,6 +124,19 @@ func (vc *vcenterClient) VMs(ctx context.Context) ([]*object.VirtualMachine, err
 	return vms, err
 }
 
+// VMProperties retrieves the properties of the virtual machines with a single property collector request
+func (vc *vcenterClient) VMProperties(ctx context.Context, vms []*object.VirtualMachine, properties []string) ([]mo.VirtualMachine, error) {
+	refs := make([]vt.ManagedObjectReference, 0, len(vms))
+	for _, vm := range vms {
+		refs = append(refs, vm.Reference())
+	}
+	var moVMs []mo.VirtualMachine
+	if err := vc.pc.Retrieve(ctx, refs, properties, &moVMs); err != nil {
+		return nil, fmt.Errorf("unable to retrieve virtual machine properties: %w", err)
+	}
+	return moVMs, nil
+}
+
 type perfSampleResult struct {
 	counters map[string]*vt.PerfCounterInfo
 	results  []performance.EntityMetric
@@ -24,6 +24,7 @@ type MetricsSettings struct {
 	VcenterClusterMemoryLimit       MetricSettings `mapstructure:"vcenter.cluster.memory.limit"`
 	VcenterClusterMemoryUsed        MetricSettings `mapstructure:"vcenter.cluster.memory.used"`
 	VcenterClusterVMCount           MetricSettings `mapstructure:"vcenter.cluster.vm.count"`
+	VcenterDatastoreDiskLatency     MetricSettings `mapstructure:"vcenter.datastore.disk.latency"`
 	VcenterDatastoreDiskUsage       MetricSettings `mapstructure:"vcenter.datastore.disk.usage"`
 	VcenterDatastoreDiskUtilization MetricSettings `mapstructure:"vcenter.datastore.disk.utilization"`
 	VcenterHostCPUUsage             MetricSettings `mapstructure:"vcenter.host.cpu.usage"`
@@ -51,6 +52,8 @@ type MetricsSettings struct {
 	VcenterVMNetworkPacketCount     MetricSettings `mapstructure:"vcenter.vm.network.packet.count"`
 	VcenterVMNetworkThroughput      MetricSettings `mapstructure:"vcenter.vm.network.throughput"`
 	VcenterVMNetworkUsage           MetricSettings `mapstructure:"vcenter.vm.network.usage"`
+	VcenterVMSnapshotAge            MetricSettings `mapstructure:"vcenter.vm.snapshot.age"`
+	VcenterVMSnapshotCount          MetricSettings `mapstructure:"vcenter.vm.snapshot.count"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -76,6 +79,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		VcenterClusterVMCount: MetricSettings{
 			Enabled: true,
 		},
+		VcenterDatastoreDiskLatency: MetricSettings{
+			Enabled: false,
+		},
 		VcenterDatastoreDiskUsage: MetricSettings{
 			Enabled: true,
 		},
@@ -157,6 +163,12 @@ func DefaultMetricsSettings() MetricsSettings {
 		VcenterVMNetworkUsage: MetricSettings{
 			Enabled: true,
 		},
+		VcenterVMSnapshotAge: MetricSettings{
+			Enabled: false,
+		},
+		VcenterVMSnapshotCount: MetricSettings{
+			Enabled: false,
+		},
 	}
 }
 
@@ -238,6 +250,36 @@ var MapAttributeDiskType = map[string]AttributeDiskType{
 	"physical": AttributeDiskTypePhysical,
 }
 
+// AttributeLatencyPercentile specifies the a value latency_percentile attribute.
+type AttributeLatencyPercentile int
+
+const (
+	_ AttributeLatencyPercentile = iota
+	AttributeLatencyPercentileP50
+	AttributeLatencyPercentileP95
+	AttributeLatencyPercentileP99
+)
+
+// String returns the string representation of the AttributeLatencyPercentile.
+func (av AttributeLatencyPercentile) String() string {
+	switch av {
+	case AttributeLatencyPercentileP50:
+		return "p50"
+	case AttributeLatencyPercentileP95:
+		return "p95"
+	case AttributeLatencyPercentileP99:
+		return "p99"
+	}
+	return ""
+}
+
+// MapAttributeLatencyPercentile is a helper map of string to AttributeLatencyPercentile attribute value.
+var MapAttributeLatencyPercentile = map[string]AttributeLatencyPercentile{
+	"p50": AttributeLatencyPercentileP50,
+	"p95": AttributeLatencyPercentileP95,
+	"p99": AttributeLatencyPercentileP99,
+}
+
 // AttributeLatencyType specifies the a value latency_type attribute.
 type AttributeLatencyType int
 
@@ -677,6 +719,58 @@ func newMetricVcenterClusterVMCount(settings MetricSettings) metricVcenterCluste
 	return m
 }
 
+type metricVcenterDatastoreDiskLatency struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills vcenter.datastore.disk.latency metric with initial data.
+func (m *metricVcenterDatastoreDiskLatency) init() {
+	m.data.SetName("vcenter.datastore.disk.latency")
+	m.data.SetDescription("The latency of read and write operations to the datastore, as percentiles of the samples reported by the hosts of the cluster.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricVcenterDatastoreDiskLatency) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, diskDirectionAttributeValue string, latencyPercentileAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("direction", diskDirectionAttributeValue)
+	dp.Attributes().PutStr("percentile", latencyPercentileAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcenterDatastoreDiskLatency) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcenterDatastoreDiskLatency) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcenterDatastoreDiskLatency(settings MetricSettings) metricVcenterDatastoreDiskLatency {
+	m := metricVcenterDatastoreDiskLatency{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricVcenterDatastoreDiskUsage struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1656,14 +1750,15 @@ type metricVcenterVMDiskThroughput struct {
 // init fills vcenter.vm.disk.throughput metric with initial data.
 func (m *metricVcenterVMDiskThroughput) init() {
 	m.data.SetName("vcenter.vm.disk.throughput")
-	m.data.SetDescription("The throughput of the virtual machine's disk.")
+	m.data.SetDescription("The throughput of the virtual machine's virtual disks, summed across all of its virtual disks.")
 	m.data.SetUnit("By/sec")
 	m.data.SetEmptySum()
 	m.data.Sum().SetIsMonotonic(false)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricVcenterVMDiskThroughput) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricVcenterVMDiskThroughput) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, diskDirectionAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -1671,6 +1766,7 @@ func (m *metricVcenterVMDiskThroughput) recordDataPoint(start pcommon.Timestamp,
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("direction", diskDirectionAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
@@ -2059,6 +2155,106 @@ func newMetricVcenterVMNetworkUsage(settings MetricSettings) metricVcenterVMNetw
 	return m
 }
 
+type metricVcenterVMSnapshotAge struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills vcenter.vm.snapshot.age metric with initial data.
+func (m *metricVcenterVMSnapshotAge) init() {
+	m.data.SetName("vcenter.vm.snapshot.age")
+	m.data.SetDescription("The age of the oldest snapshot of the virtual machine. Not reported for virtual machines without snapshots.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricVcenterVMSnapshotAge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcenterVMSnapshotAge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcenterVMSnapshotAge) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcenterVMSnapshotAge(settings MetricSettings) metricVcenterVMSnapshotAge {
+	m := metricVcenterVMSnapshotAge{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcenterVMSnapshotCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills vcenter.vm.snapshot.count metric with initial data.
+func (m *metricVcenterVMSnapshotCount) init() {
+	m.data.SetName("vcenter.vm.snapshot.count")
+	m.data.SetDescription("The number of snapshots of the virtual machine.")
+	m.data.SetUnit("{snapshots}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricVcenterVMSnapshotCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcenterVMSnapshotCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcenterVMSnapshotCount) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcenterVMSnapshotCount(settings MetricSettings) metricVcenterVMSnapshotCount {
+	m := metricVcenterVMSnapshotCount{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
@@ -2074,6 +2270,7 @@ type MetricsBuilder struct {
 	metricVcenterClusterMemoryLimit       metricVcenterClusterMemoryLimit
 	metricVcenterClusterMemoryUsed        metricVcenterClusterMemoryUsed
 	metricVcenterClusterVMCount           metricVcenterClusterVMCount
+	metricVcenterDatastoreDiskLatency     metricVcenterDatastoreDiskLatency
 	metricVcenterDatastoreDiskUsage       metricVcenterDatastoreDiskUsage
 	metricVcenterDatastoreDiskUtilization metricVcenterDatastoreDiskUtilization
 	metricVcenterHostCPUUsage             metricVcenterHostCPUUsage
@@ -2101,6 +2298,8 @@ type MetricsBuilder struct {
 	metricVcenterVMNetworkPacketCount     metricVcenterVMNetworkPacketCount
 	metricVcenterVMNetworkThroughput      metricVcenterVMNetworkThroughput
 	metricVcenterVMNetworkUsage           metricVcenterVMNetworkUsage
+	metricVcenterVMSnapshotAge            metricVcenterVMSnapshotAge
+	metricVcenterVMSnapshotCount          metricVcenterVMSnapshotCount
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -2125,6 +2324,7 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricVcenterClusterMemoryLimit:       newMetricVcenterClusterMemoryLimit(settings.VcenterClusterMemoryLimit),
 		metricVcenterClusterMemoryUsed:        newMetricVcenterClusterMemoryUsed(settings.VcenterClusterMemoryUsed),
 		metricVcenterClusterVMCount:           newMetricVcenterClusterVMCount(settings.VcenterClusterVMCount),
+		metricVcenterDatastoreDiskLatency:     newMetricVcenterDatastoreDiskLatency(settings.VcenterDatastoreDiskLatency),
 		metricVcenterDatastoreDiskUsage:       newMetricVcenterDatastoreDiskUsage(settings.VcenterDatastoreDiskUsage),
 		metricVcenterDatastoreDiskUtilization: newMetricVcenterDatastoreDiskUtilization(settings.VcenterDatastoreDiskUtilization),
 		metricVcenterHostCPUUsage:             newMetricVcenterHostCPUUsage(settings.VcenterHostCPUUsage),
@@ -2152,6 +2352,8 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricVcenterVMNetworkPacketCount:     newMetricVcenterVMNetworkPacketCount(settings.VcenterVMNetworkPacketCount),
 		metricVcenterVMNetworkThroughput:      newMetricVcenterVMNetworkThroughput(settings.VcenterVMNetworkThroughput),
 		metricVcenterVMNetworkUsage:           newMetricVcenterVMNetworkUsage(settings.VcenterVMNetworkUsage),
+		metricVcenterVMSnapshotAge:            newMetricVcenterVMSnapshotAge(settings.VcenterVMSnapshotAge),
+		metricVcenterVMSnapshotCount:          newMetricVcenterVMSnapshotCount(settings.VcenterVMSnapshotCount),
 	}
 	for _, op := range options {
 		op(mb)
@@ -2253,6 +2455,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricVcenterClusterMemoryLimit.emit(ils.Metrics())
 	mb.metricVcenterClusterMemoryUsed.emit(ils.Metrics())
 	mb.metricVcenterClusterVMCount.emit(ils.Metrics())
+	mb.metricVcenterDatastoreDiskLatency.emit(ils.Metrics())
 	mb.metricVcenterDatastoreDiskUsage.emit(ils.Metrics())
 	mb.metricVcenterDatastoreDiskUtilization.emit(ils.Metrics())
 	mb.metricVcenterHostCPUUsage.emit(ils.Metrics())
@@ -2280,6 +2483,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricVcenterVMNetworkPacketCount.emit(ils.Metrics())
 	mb.metricVcenterVMNetworkThroughput.emit(ils.Metrics())
 	mb.metricVcenterVMNetworkUsage.emit(ils.Metrics())
+	mb.metricVcenterVMSnapshotAge.emit(ils.Metrics())
+	mb.metricVcenterVMSnapshotCount.emit(ils.Metrics())
 	for _, op := range rmo {
 		op(rm)
 	}
@@ -2334,6 +2539,11 @@ func (mb *MetricsBuilder) RecordVcenterClusterVMCountDataPoint(ts pcommon.Timest
 	mb.metricVcenterClusterVMCount.recordDataPoint(mb.startTime, ts, val, vmCountPowerStateAttributeValue.String())
 }
 
+// RecordVcenterDatastoreDiskLatencyDataPoint adds a data point to vcenter.datastore.disk.latency metric.
+func (mb *MetricsBuilder) RecordVcenterDatastoreDiskLatencyDataPoint(ts pcommon.Timestamp, val int64, diskDirectionAttributeValue AttributeDiskDirection, latencyPercentileAttributeValue AttributeLatencyPercentile) {
+	mb.metricVcenterDatastoreDiskLatency.recordDataPoint(mb.startTime, ts, val, diskDirectionAttributeValue.String(), latencyPercentileAttributeValue.String())
+}
+
 // RecordVcenterDatastoreDiskUsageDataPoint adds a data point to vcenter.datastore.disk.usage metric.
 func (mb *MetricsBuilder) RecordVcenterDatastoreDiskUsageDataPoint(ts pcommon.Timestamp, val int64, diskStateAttributeValue AttributeDiskState) {
 	mb.metricVcenterDatastoreDiskUsage.recordDataPoint(mb.startTime, ts, val, diskStateAttributeValue.String())
@@ -2430,8 +2640,8 @@ func (mb *MetricsBuilder) RecordVcenterVMDiskLatencyMaxDataPoint(ts pcommon.Time
 }
 
 // RecordVcenterVMDiskThroughputDataPoint adds a data point to vcenter.vm.disk.throughput metric.
-func (mb *MetricsBuilder) RecordVcenterVMDiskThroughputDataPoint(ts pcommon.Timestamp, val int64) {
-	mb.metricVcenterVMDiskThroughput.recordDataPoint(mb.startTime, ts, val)
+func (mb *MetricsBuilder) RecordVcenterVMDiskThroughputDataPoint(ts pcommon.Timestamp, val int64, diskDirectionAttributeValue AttributeDiskDirection) {
+	mb.metricVcenterVMDiskThroughput.recordDataPoint(mb.startTime, ts, val, diskDirectionAttributeValue.String())
 }
 
 // RecordVcenterVMDiskUsageDataPoint adds a data point to vcenter.vm.disk.usage metric.
@@ -2469,6 +2679,16 @@ func (mb *MetricsBuilder) RecordVcenterVMNetworkUsageDataPoint(ts pcommon.Timest
 	mb.metricVcenterVMNetworkUsage.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordVcenterVMSnapshotAgeDataPoint adds a data point to vcenter.vm.snapshot.age metric.
+func (mb *MetricsBuilder) RecordVcenterVMSnapshotAgeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricVcenterVMSnapshotAge.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordVcenterVMSnapshotCountDataPoint adds a data point to vcenter.vm.snapshot.count metric.
+func (mb *MetricsBuilder) RecordVcenterVMSnapshotCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricVcenterVMSnapshotCount.recordDataPoint(mb.startTime, ts, val)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -16,6 +16,10 @@ package vcenterreceiver // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/vmware/govmomi/performance"
 	"github.com/vmware/govmomi/vim25/mo"
@@ -80,6 +84,94 @@ func (v *vcenterMetricScraper) recordDatastoreProperties(
 	v.mb.RecordVcenterDatastoreDiskUtilizationDataPoint(now, diskUtilization)
 }
 
+func (v *vcenterMetricScraper) recordVMSnapshots(
+	now pcommon.Timestamp,
+	vm mo.VirtualMachine,
+) {
+	if vm.Snapshot == nil {
+		v.mb.RecordVcenterVMSnapshotCountDataPoint(now, 0)
+		return
+	}
+
+	var count int64
+	var oldest time.Time
+	var walk func(trees []types.VirtualMachineSnapshotTree)
+	walk = func(trees []types.VirtualMachineSnapshotTree) {
+		for _, tree := range trees {
+			count++
+			if oldest.IsZero() || tree.CreateTime.Before(oldest) {
+				oldest = tree.CreateTime
+			}
+			walk(tree.ChildSnapshotList)
+		}
+	}
+	walk(vm.Snapshot.RootSnapshotList)
+
+	v.mb.RecordVcenterVMSnapshotCountDataPoint(now, count)
+	if count > 0 {
+		v.mb.RecordVcenterVMSnapshotAgeDataPoint(now, int64(now.AsTime().Sub(oldest).Seconds()))
+	}
+}
+
+// datastoreLatencySamples are the latency samples reported by the hosts for a datastore
+type datastoreLatencySamples struct {
+	read  []int64
+	write []int64
+}
+
+func (v *vcenterMetricScraper) datastoreLatencySamples(instance string) *datastoreLatencySamples {
+	samples, ok := v.datastoreLatencies[instance]
+	if !ok {
+		samples = &datastoreLatencySamples{}
+		v.datastoreLatencies[instance] = samples
+	}
+	return samples
+}
+
+var latencyPercentiles = []struct {
+	percentile float64
+	attribute  metadata.AttributeLatencyPercentile
+}{
+	{50, metadata.AttributeLatencyPercentileP50},
+	{95, metadata.AttributeLatencyPercentileP95},
+	{99, metadata.AttributeLatencyPercentileP99},
+}
+
+func (v *vcenterMetricScraper) recordDatastoreLatency(
+	now pcommon.Timestamp,
+	ds mo.Datastore,
+) {
+	samples, ok := v.datastoreLatencies[datastoreInstance(ds.Summary.Url)]
+	if !ok {
+		return
+	}
+	for _, p := range latencyPercentiles {
+		if len(samples.read) > 0 {
+			v.mb.RecordVcenterDatastoreDiskLatencyDataPoint(now, percentile(samples.read, p.percentile), metadata.AttributeDiskDirectionRead, p.attribute)
+		}
+		if len(samples.write) > 0 {
+			v.mb.RecordVcenterDatastoreDiskLatencyDataPoint(now, percentile(samples.write, p.percentile), metadata.AttributeDiskDirectionWrite, p.attribute)
+		}
+	}
+}
+
+// datastoreInstance returns the performance counter instance of a datastore, which is the
+// UUID in its URL, e.g. "ds:///vmfs/volumes/vsan:52d8b8c7a7f0a4c1-0e9b6d6f1b4d8b3a/".
+func datastoreInstance(url string) string {
+	url = strings.TrimSuffix(url, "/")
+	return url[strings.LastIndex(url, "/")+1:]
+}
+
+// percentile returns the nearest-rank percentile p of the samples. The samples are sorted in place.
+func percentile(samples []int64, p float64) int64 {
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	rank := int(math.Ceil(p / 100 * float64(len(samples))))
+	if rank < 1 {
+		rank = 1
+	}
+	return samples[rank-1]
+}
+
 func (v *vcenterMetricScraper) recordResourcePool(
 	now pcommon.Timestamp,
 	rp mo.ResourcePool,
@@ -116,6 +208,22 @@ var hostPerfMetricList = []string{
 	"disk.write.average",
 }
 
+// datastorePerfMetricList are the host counters reported per datastore instance, only queried
+// when the datastore latency metric is enabled.
+var datastorePerfMetricList = []string{
+	"datastore.totalReadLatency.average",
+	"datastore.totalWriteLatency.average",
+}
+
+func (v *vcenterMetricScraper) hostPerfMetrics() []string {
+	if !v.config.Metrics.VcenterDatastoreDiskLatency.Enabled {
+		return hostPerfMetricList
+	}
+	names := make([]string, 0, len(hostPerfMetricList)+len(datastorePerfMetricList))
+	names = append(names, hostPerfMetricList...)
+	return append(names, datastorePerfMetricList...)
+}
+
 func (v *vcenterMetricScraper) recordHostPerformanceMetrics(
 	ctx context.Context,
 	host mo.HostSystem,
@@ -131,7 +239,7 @@ func (v *vcenterMetricScraper) recordHostPerformanceMetrics(
 		IntervalId: int32(20),
 	}
 
-	info, err := v.client.performanceQuery(ctx, spec, hostPerfMetricList, []types.ManagedObjectReference{host.Reference()})
+	info, err := v.client.performanceQuery(ctx, spec, v.hostPerfMetrics(), []types.ManagedObjectReference{host.Reference()})
 	if err != nil {
 		errs.AddPartial(1, err)
 		return
@@ -155,6 +263,8 @@ var vmPerfMetricList = []string{
 	"disk.maxTotalLatency.latest",
 	"virtualDisk.totalWriteLatency.average",
 	"virtualDisk.totalReadLatency.average",
+	"virtualDisk.read.average",
+	"virtualDisk.write.average",
 }
 
 func (v *vcenterMetricScraper) recordVMPerformance(
@@ -182,6 +292,10 @@ func (v *vcenterMetricScraper) recordVMPerformance(
 
 func (v *vcenterMetricScraper) processVMPerformanceMetrics(info *perfSampleResult) {
 	for _, m := range info.results {
+		// virtual disk throughput is reported per virtual disk and summed per sample
+		diskRead := make([]int64, len(m.SampleInfo))
+		diskWrite := make([]int64, len(m.SampleInfo))
+		var hasDiskThroughput bool
 		for _, val := range m.Value {
 			for j, nestedValue := range val.Value {
 				si := m.SampleInfo[j]
@@ -209,9 +323,23 @@ func (v *vcenterMetricScraper) processVMPerformanceMetrics(info *perfSampleResul
 					v.mb.RecordVcenterVMDiskLatencyAvgDataPoint(pcommon.NewTimestampFromTime(si.Timestamp), nestedValue, metadata.AttributeDiskDirectionWrite, metadata.AttributeDiskTypeVirtual)
 				case "disk.maxTotalLatency.latest":
 					v.mb.RecordVcenterVMDiskLatencyMaxDataPoint(pcommon.NewTimestampFromTime(si.Timestamp), nestedValue)
+				case "virtualDisk.read.average":
+					diskRead[j] += nestedValue
+					hasDiskThroughput = true
+				case "virtualDisk.write.average":
+					diskWrite[j] += nestedValue
+					hasDiskThroughput = true
 				}
 			}
 		}
+		if !hasDiskThroughput {
+			continue
+		}
+		for j, si := range m.SampleInfo {
+			// the counters are reported in KB/s
+			v.mb.RecordVcenterVMDiskThroughputDataPoint(pcommon.NewTimestampFromTime(si.Timestamp), diskRead[j]*1024, metadata.AttributeDiskDirectionRead)
+			v.mb.RecordVcenterVMDiskThroughputDataPoint(pcommon.NewTimestampFromTime(si.Timestamp), diskWrite[j]*1024, metadata.AttributeDiskDirectionWrite)
+		}
 	}
 }
 
@@ -250,6 +378,12 @@ func (v *vcenterMetricScraper) processHostPerformance(metrics []performance.Enti
 					v.mb.RecordVcenterHostDiskThroughputDataPoint(pcommon.NewTimestampFromTime(si.Timestamp), nestedValue, metadata.AttributeDiskDirectionRead)
 				case "disk.write.average":
 					v.mb.RecordVcenterHostDiskThroughputDataPoint(pcommon.NewTimestampFromTime(si.Timestamp), nestedValue, metadata.AttributeDiskDirectionWrite)
+
+				// Following requires performance level 3, the instance is the datastore UUID
+				case "datastore.totalReadLatency.average":
+					v.datastoreLatencySamples(val.Instance).read = append(v.datastoreLatencySamples(val.Instance).read, nestedValue)
+				case "datastore.totalWriteLatency.average":
+					v.datastoreLatencySamples(val.Instance).write = append(v.datastoreLatencySamples(val.Instance).write, nestedValue)
 				}
 			}
 		}
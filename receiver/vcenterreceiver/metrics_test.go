@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vcenterreceiver // import github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/govmomi/performance"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver/internal/metadata"
+)
+
+func newTestScraper(t *testing.T, configure func(*metadata.MetricsSettings)) *vcenterMetricScraper {
+	t.Helper()
+	cfg := &Config{Metrics: metadata.DefaultMetricsSettings()}
+	configure(&cfg.Metrics)
+	scraper := newVmwareVcenterScraper(zap.NewNop(), cfg, componenttest.NewNopReceiverCreateSettings())
+	scraper.hostNames = map[string]string{}
+	scraper.datastoreLatencies = map[string]*datastoreLatencySamples{}
+	return scraper
+}
+
+func metricsByName(metrics pmetric.Metrics) map[string]pmetric.Metric {
+	byName := map[string]pmetric.Metric{}
+	if metrics.ResourceMetrics().Len() == 0 {
+		return byName
+	}
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		byName[ms.At(i).Name()] = ms.At(i)
+	}
+	return byName
+}
+
+func TestRecordDatastoreLatency(t *testing.T) {
+	scraper := newTestScraper(t, func(m *metadata.MetricsSettings) {
+		m.VcenterDatastoreDiskLatency.Enabled = true
+	})
+	require.Contains(t, scraper.hostPerfMetrics(), "datastore.totalReadLatency.average")
+
+	sampleTime := time.Now()
+	scraper.processHostPerformance([]performance.EntityMetric{
+		{
+			SampleInfo: []types.PerfSampleInfo{{Timestamp: sampleTime}, {Timestamp: sampleTime}, {Timestamp: sampleTime}, {Timestamp: sampleTime}},
+			Value: []performance.MetricSeries{
+				{Name: "datastore.totalReadLatency.average", Instance: "vsan:52d8b8c7a7f0a4c1", Value: []int64{4, 1, 3, 2}},
+				{Name: "datastore.totalWriteLatency.average", Instance: "vsan:52d8b8c7a7f0a4c1", Value: []int64{10, 20, 30, 40}},
+				{Name: "datastore.totalReadLatency.average", Instance: "5f1a2b3c-unrelated", Value: []int64{100, 100, 100, 100}},
+			},
+		},
+	})
+
+	var ds mo.Datastore
+	ds.Summary.Url = "ds:///vmfs/volumes/vsan:52d8b8c7a7f0a4c1/"
+	scraper.recordDatastoreLatency(pcommon.NewTimestampFromTime(sampleTime), ds)
+
+	latency := metricsByName(scraper.mb.Emit())["vcenter.datastore.disk.latency"]
+	dps := latency.Gauge().DataPoints()
+	require.Equal(t, 6, dps.Len())
+	expected := map[string]int64{
+		"read/p50": 2, "read/p95": 4, "read/p99": 4,
+		"write/p50": 20, "write/p95": 40, "write/p99": 40,
+	}
+	for i := 0; i < dps.Len(); i++ {
+		direction, _ := dps.At(i).Attributes().Get("direction")
+		p, _ := dps.At(i).Attributes().Get("percentile")
+		key := direction.Str() + "/" + p.Str()
+		require.Equal(t, expected[key], dps.At(i).IntValue(), key)
+	}
+}
+
+func TestHostPerfMetricsDefault(t *testing.T) {
+	scraper := newTestScraper(t, func(m *metadata.MetricsSettings) {})
+	require.Equal(t, hostPerfMetricList, scraper.hostPerfMetrics())
+}
+
+func TestProcessVMDiskThroughput(t *testing.T) {
+	scraper := newTestScraper(t, func(m *metadata.MetricsSettings) {})
+
+	sampleTime := time.Now()
+	scraper.processVMPerformanceMetrics(&perfSampleResult{
+		results: []performance.EntityMetric{
+			{
+				SampleInfo: []types.PerfSampleInfo{{Timestamp: sampleTime}},
+				Value: []performance.MetricSeries{
+					{Name: "virtualDisk.read.average", Instance: "scsi0:0", Value: []int64{10}},
+					{Name: "virtualDisk.read.average", Instance: "scsi0:1", Value: []int64{5}},
+					{Name: "virtualDisk.write.average", Instance: "scsi0:0", Value: []int64{2}},
+				},
+			},
+		},
+	})
+
+	throughput := metricsByName(scraper.mb.Emit())["vcenter.vm.disk.throughput"]
+	dps := throughput.Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		direction, _ := dps.At(i).Attributes().Get("direction")
+		require.Equal(t, map[string]int64{"read": 15 * 1024, "write": 2 * 1024}[direction.Str()], dps.At(i).IntValue())
+	}
+}
+
+func TestRecordVMSnapshots(t *testing.T) {
+	scraper := newTestScraper(t, func(m *metadata.MetricsSettings) {
+		m.VcenterVMSnapshotCount.Enabled = true
+		m.VcenterVMSnapshotAge.Enabled = true
+	})
+
+	now := time.Now()
+	var vm mo.VirtualMachine
+	vm.Snapshot = &types.VirtualMachineSnapshotInfo{
+		RootSnapshotList: []types.VirtualMachineSnapshotTree{
+			{
+				CreateTime: now.Add(-2 * time.Hour),
+				ChildSnapshotList: []types.VirtualMachineSnapshotTree{
+					{CreateTime: now.Add(-time.Hour)},
+				},
+			},
+			{CreateTime: now.Add(-3 * time.Hour)},
+		},
+	}
+	scraper.recordVMSnapshots(pcommon.NewTimestampFromTime(now), vm)
+
+	metrics := metricsByName(scraper.mb.Emit())
+	require.EqualValues(t, 3, metrics["vcenter.vm.snapshot.count"].Sum().DataPoints().At(0).IntValue())
+	require.EqualValues(t, 3*60*60, metrics["vcenter.vm.snapshot.age"].Gauge().DataPoints().At(0).IntValue())
+
+	// no snapshots reports a zero count and no age
+	scraper.recordVMSnapshots(pcommon.NewTimestampFromTime(now), mo.VirtualMachine{})
+	metrics = metricsByName(scraper.mb.Emit())
+	require.EqualValues(t, 0, metrics["vcenter.vm.snapshot.count"].Sum().DataPoints().At(0).IntValue())
+	require.NotContains(t, metrics, "vcenter.vm.snapshot.age")
+}
+
+func TestDatastoreInstance(t *testing.T) {
+	require.Equal(t, "vsan:52d8b8c7a7f0a4c1", datastoreInstance("ds:///vmfs/volumes/vsan:52d8b8c7a7f0a4c1/"))
+	require.Equal(t, "5f1a2b3c-4d5e6f70", datastoreInstance("ds:///vmfs/volumes/5f1a2b3c-4d5e6f70"))
+}
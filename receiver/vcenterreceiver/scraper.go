@@ -21,6 +21,7 @@ import (
 
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -32,11 +33,20 @@ import (
 
 var _ component.Receiver = (*vcenterMetricScraper)(nil)
 
+// vmPropertiesBatchSize is the maximum number of virtual machines retrieved by a single
+// property collector request, keeping requests bounded on large inventories.
+const vmPropertiesBatchSize = 100
+
 type vcenterMetricScraper struct {
 	client *vcenterClient
 	config *Config
 	mb     *metadata.MetricsBuilder
 	logger *zap.Logger
+
+	// hostNames caches the names of the hosts collected during a scrape by managed object ID
+	hostNames map[string]string
+	// datastoreLatencies holds the latency samples reported by the hosts of the scraped cluster by datastore
+	datastoreLatencies map[string]*datastoreLatencySamples
 }
 
 func newVmwareVcenterScraper(
@@ -76,6 +86,8 @@ func (v *vcenterMetricScraper) scrape(ctx context.Context) (pmetric.Metrics, err
 		return pmetric.NewMetrics(), fmt.Errorf("unable to connect to vSphere SDK: %w", err)
 	}
 
+	v.hostNames = map[string]string{}
+
 	err := v.collectDatacenters(ctx)
 	return v.mb.Emit(), err
 }
@@ -101,6 +113,8 @@ func (v *vcenterMetricScraper) collectClusters(ctx context.Context, datacenter *
 	now := pcommon.NewTimestampFromTime(time.Now())
 
 	for _, c := range clusters {
+		// A datastore mounted in several clusters reports the latencies of the hosts of each cluster
+		v.datastoreLatencies = map[string]*datastoreLatencySamples{}
 		v.collectHosts(ctx, now, c, errs)
 		v.collectDatastores(ctx, now, c, errs)
 		poweredOnVMs, poweredOffVMs := v.collectVMs(ctx, now, c, errs)
@@ -169,6 +183,7 @@ func (v *vcenterMetricScraper) collectDatastore(
 	}
 
 	v.recordDatastoreProperties(now, moDS)
+	v.recordDatastoreLatency(now, moDS)
 	v.mb.EmitForResource(
 		metadata.WithVcenterClusterName(cluster.Name()),
 		metadata.WithVcenterDatastoreName(moDS.Name),
@@ -211,6 +226,7 @@ func (v *vcenterMetricScraper) collectHost(
 		errs.AddPartial(1, err)
 		return
 	}
+	v.hostNames[host.Reference().Value] = host.Name()
 	v.recordHostSystemMemoryUsage(now, hwSum)
 	v.recordHostPerformanceMetrics(ctx, hwSum, errs)
 	v.mb.EmitForResource(
@@ -256,49 +272,84 @@ func (v *vcenterMetricScraper) collectVMs(
 		errs.AddPartial(1, err)
 		return
 	}
-	for _, vm := range vms {
-		var moVM mo.VirtualMachine
-		err := vm.Properties(ctx, vm.Reference(), []string{
-			"config",
-			"runtime",
-			"summary",
-		}, &moVM)
 
-		if err != nil {
-			errs.AddPartial(1, err)
-			continue
-		}
-
-		vmUUID := moVM.Config.InstanceUuid
+	properties := []string{
+		"config",
+		"runtime",
+		"summary",
+	}
+	if v.config.Metrics.VcenterVMSnapshotCount.Enabled || v.config.Metrics.VcenterVMSnapshotAge.Enabled {
+		properties = append(properties, "snapshot")
+	}
 
-		if string(moVM.Runtime.PowerState) == "poweredOff" {
-			poweredOffVMs++
-		} else {
-			poweredOnVMs++
+	for start := 0; start < len(vms); start += vmPropertiesBatchSize {
+		end := start + vmPropertiesBatchSize
+		if end > len(vms) {
+			end = len(vms)
 		}
+		batch := vms[start:end]
 
-		host, err := vm.HostSystem(ctx)
+		moVMs, err := v.client.VMProperties(ctx, batch, properties)
 		if err != nil {
-			errs.AddPartial(1, err)
-			return
+			errs.AddPartial(len(batch), err)
+			continue
 		}
-		hostname, err := host.ObjectName(ctx)
-		if err != nil {
-			errs.AddPartial(1, err)
-			return
+		moVMsByRef := make(map[types.ManagedObjectReference]mo.VirtualMachine, len(moVMs))
+		for _, moVM := range moVMs {
+			moVMsByRef[moVM.Reference()] = moVM
 		}
 
-		v.collectVM(ctx, colTime, moVM, errs)
-		v.mb.EmitForResource(
-			metadata.WithVcenterVMName(vm.Name()),
-			metadata.WithVcenterVMID(vmUUID),
-			metadata.WithVcenterClusterName(cluster.Name()),
-			metadata.WithVcenterHostName(hostname),
-		)
+		for _, vm := range batch {
+			moVM, ok := moVMsByRef[vm.Reference()]
+			// the virtual machine was removed after it was listed
+			if !ok {
+				continue
+			}
+
+			if string(moVM.Runtime.PowerState) == "poweredOff" {
+				poweredOffVMs++
+			} else {
+				poweredOnVMs++
+			}
+
+			hostname, err := v.vmHostName(ctx, vm, moVM)
+			if err != nil {
+				errs.AddPartial(1, err)
+				continue
+			}
+
+			v.collectVM(ctx, colTime, moVM, errs)
+			v.mb.EmitForResource(
+				metadata.WithVcenterVMName(vm.Name()),
+				metadata.WithVcenterVMID(moVM.Config.InstanceUuid),
+				metadata.WithVcenterClusterName(cluster.Name()),
+				metadata.WithVcenterHostName(hostname),
+			)
+		}
 	}
 	return poweredOnVMs, poweredOffVMs
 }
 
+// vmHostName returns the name of the host running the virtual machine. Hosts already collected
+// during the scrape are resolved from the cache instead of requesting the name again.
+func (v *vcenterMetricScraper) vmHostName(
+	ctx context.Context,
+	vm *object.VirtualMachine,
+	moVM mo.VirtualMachine,
+) (string, error) {
+	if moVM.Runtime.Host != nil {
+		if name, ok := v.hostNames[moVM.Runtime.Host.Value]; ok {
+			return name, nil
+		}
+	}
+
+	host, err := vm.HostSystem(ctx)
+	if err != nil {
+		return "", err
+	}
+	return host.ObjectName(ctx)
+}
+
 func (v *vcenterMetricScraper) collectVM(
 	ctx context.Context,
 	colTime pcommon.Timestamp,
@@ -306,5 +357,6 @@ func (v *vcenterMetricScraper) collectVM(
 	errs *scrapererror.ScrapeErrors,
 ) {
 	v.recordVMUsages(colTime, vm)
+	v.recordVMSnapshots(colTime, vm)
 	v.recordVMPerformance(ctx, vm, errs)
 }
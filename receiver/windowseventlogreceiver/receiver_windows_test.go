@@ -56,6 +56,53 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, createTestConfig(), cfg)
 }
 
+func TestLoadRemoteConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(config.NewComponentIDWithName(typeStr, "remote").String())
+	require.NoError(t, err)
+	require.NoError(t, config.UnmarshalReceiver(sub, cfg))
+
+	expected := createTestConfig()
+	expected.ReceiverSettings = config.NewReceiverSettings(config.NewComponentIDWithName(typeStr, "remote"))
+	expected.InputConfig.Channel = "security"
+	expected.InputConfig.StartAt = "beginning"
+	expected.InputConfig.Query = "*[System[(Level=1 or Level=2)]]"
+	expected.InputConfig.LanguageID = 1033
+	expected.InputConfig.Remote = windows.RemoteConfig{
+		Server:   "dc01.example.com",
+		Username: "collector",
+		Password: "s3cr3t",
+		Domain:   "EXAMPLE",
+	}
+	assert.Equal(t, expected, cfg)
+}
+
+func TestCreateWithRemoteCredentialsWithoutServer(t *testing.T) {
+	t.Parallel()
+
+	cfg := &WindowsLogConfig{
+		BaseConfig: adapter.BaseConfig{},
+		InputConfig: func() windows.Config {
+			c := windows.NewConfig()
+			c.Channel = "application"
+			c.Remote.Username = "collector"
+			return *c
+		}(),
+	}
+
+	_, err := NewFactory().CreateLogsReceiver(
+		context.Background(),
+		componenttest.NewNopReceiverCreateSettings(),
+		cfg,
+		new(consumertest.LogsSink),
+	)
+	require.ErrorContains(t, err, "the `remote.server` field must be set when remote credentials are provided")
+}
+
 func TestCreateWithInvalidInputConfig(t *testing.T) {
 	t.Parallel()
 
@@ -15,10 +15,23 @@
 package zipkinreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver"
 
 import (
+	"errors"
+	"fmt"
+
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/confighttp"
 )
 
+// Values of the short_trace_ids setting.
+const (
+	// shortTraceIDsAllow keeps the 64-bit trace IDs as 128-bit trace IDs with the upper 64 bits set to zero.
+	shortTraceIDsAllow = "allow"
+	// shortTraceIDsReject rejects the requests containing spans with a 64-bit trace ID.
+	shortTraceIDsReject = "reject"
+)
+
+var errNegativeMaxSpansPerBatch = errors.New(`"max_spans_per_batch" must not be negative`)
+
 // Config defines configuration for Zipkin receiver.
 type Config struct {
 	config.ReceiverSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
@@ -27,11 +40,27 @@ type Config struct {
 	// If enabled the zipkin receiver will attempt to parse string tags/binary annotations into int/bool/float.
 	// Disabled by default
 	ParseStringTags bool `mapstructure:"parse_string_tags"`
+	// ShortTraceIDs defines how spans with a 64-bit trace ID are handled, either
+	// "allow" or "reject". Defaults to "allow".
+	ShortTraceIDs string `mapstructure:"short_trace_ids"`
+	// MaxSpansPerBatch enables the streaming decoding of Zipkin V2 JSON requests when positive:
+	// the spans are decoded and passed to the next consumer in batches of at most this many
+	// spans, bounding the memory used by large requests. Disabled by default.
+	MaxSpansPerBatch int `mapstructure:"max_spans_per_batch"`
 }
 
 var _ config.Receiver = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
+	switch cfg.ShortTraceIDs {
+	case shortTraceIDsAllow, shortTraceIDsReject:
+	default:
+		return fmt.Errorf(`"short_trace_ids" must be either %q or %q, got %q`,
+			shortTraceIDsAllow, shortTraceIDsReject, cfg.ShortTraceIDs)
+	}
+	if cfg.MaxSpansPerBatch < 0 {
+		return errNegativeMaxSpansPerBatch
+	}
 	return nil
 }
@@ -47,6 +47,7 @@ func TestLoadConfig(t *testing.T) {
 					Endpoint: "localhost:8765",
 				},
 				ParseStringTags: false,
+				ShortTraceIDs:   shortTraceIDsAllow,
 			},
 		},
 		{
@@ -57,6 +58,18 @@ func TestLoadConfig(t *testing.T) {
 					Endpoint: defaultBindEndpoint,
 				},
 				ParseStringTags: true,
+				ShortTraceIDs:   shortTraceIDsAllow,
+			},
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "streaming"),
+			expected: &Config{
+				ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+				HTTPServerSettings: confighttp.HTTPServerSettings{
+					Endpoint: defaultBindEndpoint,
+				},
+				ShortTraceIDs:    shortTraceIDsReject,
+				MaxSpansPerBatch: 500,
 			},
 		},
 	}
@@ -75,3 +88,13 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ShortTraceIDs = "upgrade"
+	assert.EqualError(t, cfg.Validate(), `"short_trace_ids" must be either "allow" or "reject", got "upgrade"`)
+
+	cfg = createDefaultConfig().(*Config)
+	cfg.MaxSpansPerBatch = -1
+	assert.ErrorIs(t, cfg.Validate(), errNegativeMaxSpansPerBatch)
+}
@@ -49,6 +49,7 @@ func createDefaultConfig() config.Receiver {
 			Endpoint: defaultBindEndpoint,
 		},
 		ParseStringTags: false,
+		ShortTraceIDs:   shortTraceIDsAllow,
 	}
 }
 
@@ -18,7 +18,10 @@ import (
 	"compress/gzip"
 	"compress/zlib"
 	"context"
+	"encoding/binary"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net"
 	"net/http"
@@ -28,6 +31,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/obsreport"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
@@ -211,32 +215,54 @@ func (zr *zipkinReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx = obsrecv.StartTracesOp(ctx)
 
 	pr := processBodyIfNecessary(r)
-	slurp, _ := io.ReadAll(pr)
+
+	var spanCount int
+	var err, consumerErr error
+	if zr.config.MaxSpansPerBatch > 0 && transportTag == receiverTransportV2JSON {
+		spanCount, err = zr.consumeV2JSONBatches(ctx, pr)
+		// the errors of the next consumer are wrapped to tell them apart from the decoding errors
+		var tracesErr consumererror.Traces
+		if errors.As(err, &tracesErr) {
+			consumerErr, err = tracesErr.Unwrap(), nil
+		}
+	} else {
+		slurp, _ := io.ReadAll(pr)
+
+		var td ptrace.Traces
+		if asZipkinv1 {
+			td, err = zr.v1ToTraceSpans(slurp, r.Header)
+		} else {
+			td, err = zr.v2ToTraceSpans(slurp, r.Header)
+		}
+		if err == nil {
+			err = zr.processTraceIDs(td)
+		}
+		if err == nil {
+			spanCount = td.SpanCount()
+			consumerErr = zr.nextConsumer.ConsumeTraces(ctx, td)
+		}
+	}
+
 	if c, ok := pr.(io.Closer); ok {
 		_ = c.Close()
 	}
 	_ = r.Body.Close()
 
-	var td ptrace.Traces
-	var err error
+	receiverTagValue := zipkinV2TagValue
 	if asZipkinv1 {
-		td, err = zr.v1ToTraceSpans(slurp, r.Header)
-	} else {
-		td, err = zr.v2ToTraceSpans(slurp, r.Header)
+		receiverTagValue = zipkinV1TagValue
 	}
 
 	if err != nil {
+		if spanCount > 0 {
+			// the batches decoded before the error were already consumed
+			obsrecv.EndTracesOp(ctx, receiverTagValue, spanCount, nil)
+		}
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	consumerErr := zr.nextConsumer.ConsumeTraces(ctx, td)
-
-	receiverTagValue := zipkinV2TagValue
-	if asZipkinv1 {
-		receiverTagValue = zipkinV1TagValue
-	}
-	obsrecv.EndTracesOp(ctx, receiverTagValue, td.SpanCount(), consumerErr)
+	obsrecv.EndTracesOp(ctx, receiverTagValue, spanCount, consumerErr)
 
 	if consumerErr != nil {
 		// Transient error, due to some internal condition.
@@ -262,3 +288,93 @@ func transportType(r *http.Request, asZipkinv1 bool) string {
 	}
 	return receiverTransportV2JSON
 }
+
+// consumeV2JSONBatches decodes the Zipkin V2 JSON array of spans read from r one span at
+// a time, and passes them to the next consumer in batches of at most MaxSpansPerBatch
+// spans. It returns the number of spans consumed, along with the error decoding the
+// spans or the error of the next consumer wrapped in a consumererror.Traces. The batches
+// decoded before the error were consumed already.
+func (zr *zipkinReceiver) consumeV2JSONBatches(ctx context.Context, r io.Reader) (int, error) {
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if err != nil {
+		return 0, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return 0, errors.New("expected a JSON array of spans")
+	}
+
+	spanCount := 0
+	batch := make([]json.RawMessage, 0, zr.config.MaxSpansPerBatch)
+	for dec.More() {
+		var span json.RawMessage
+		if err = dec.Decode(&span); err != nil {
+			return spanCount, err
+		}
+		batch = append(batch, span)
+		if len(batch) < zr.config.MaxSpansPerBatch {
+			continue
+		}
+
+		count, batchErr := zr.consumeV2JSONBatch(ctx, batch)
+		spanCount += count
+		if batchErr != nil {
+			return spanCount, batchErr
+		}
+		batch = batch[:0]
+	}
+	if _, err = dec.Token(); err != nil {
+		return spanCount, err
+	}
+
+	if len(batch) == 0 {
+		return spanCount, nil
+	}
+	count, err := zr.consumeV2JSONBatch(ctx, batch)
+	return spanCount + count, err
+}
+
+// consumeV2JSONBatch converts a batch of Zipkin V2 JSON spans and passes it to the next
+// consumer, wrapping its error in a consumererror.Traces.
+func (zr *zipkinReceiver) consumeV2JSONBatch(ctx context.Context, batch []json.RawMessage) (int, error) {
+	blob, err := json.Marshal(batch)
+	if err != nil {
+		return 0, err
+	}
+	td, err := zr.jsonUnmarshaler.UnmarshalTraces(blob)
+	if err != nil {
+		return 0, err
+	}
+	if err = zr.processTraceIDs(td); err != nil {
+		return 0, err
+	}
+	if err = zr.nextConsumer.ConsumeTraces(ctx, td); err != nil {
+		return 0, consumererror.NewTraces(err, td)
+	}
+	return td.SpanCount(), nil
+}
+
+// processTraceIDs rejects the spans with a 64-bit trace ID when the short_trace_ids
+// setting is "reject".
+func (zr *zipkinReceiver) processTraceIDs(td ptrace.Traces) error {
+	if zr.config.ShortTraceIDs != shortTraceIDsReject {
+		return nil
+	}
+
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		ilss := rss.At(i).ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				traceID := span.TraceID()
+				if traceID.IsEmpty() || binary.BigEndian.Uint64(traceID[:8]) != 0 {
+					continue
+				}
+				return fmt.Errorf("span %s has a 64-bit trace ID %s", span.SpanID().HexString(), traceID.HexString()[16:])
+			}
+		}
+	}
+	return nil
+}
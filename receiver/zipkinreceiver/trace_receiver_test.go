@@ -25,6 +25,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -460,3 +461,151 @@ func TestFromBytesWithNoTimestamp(t *testing.T) {
 	assert.True(t, mapContainedKey)
 	assert.True(t, wasAbsent.Bool())
 }
+
+// makeV2JSONSpans returns Zipkin V2 JSON spans with the given trace IDs
+func makeV2JSONSpans(traceIDs ...string) []string {
+	spans := make([]string, 0, len(traceIDs))
+	for i, traceID := range traceIDs {
+		spans = append(spans, fmt.Sprintf(
+			`{"traceId":"%s","id":"%016x","name":"span-%d","timestamp":1472470996199000,"duration":207000,"localEndpoint":{"serviceName":"svc"}}`,
+			traceID, i+1, i))
+	}
+	return spans
+}
+
+func newTestReceiverWithConfig(t *testing.T, shortTraceIDs string, maxSpansPerBatch int, next consumer.Traces) *zipkinReceiver {
+	cfg := &Config{
+		ReceiverSettings: config.NewReceiverSettings(zipkinReceiverID),
+		HTTPServerSettings: confighttp.HTTPServerSettings{
+			Endpoint: "localhost:9411",
+		},
+		ShortTraceIDs:    shortTraceIDs,
+		MaxSpansPerBatch: maxSpansPerBatch,
+	}
+	zr, err := newReceiver(cfg, next, componenttest.NewNopReceiverCreateSettings())
+	require.NoError(t, err)
+	return zr
+}
+
+func TestReceiverStreamingBatches(t *testing.T) {
+	spans := makeV2JSONSpans(
+		"5af7183fb1d4cf5f5af7183fb1d4cf5f",
+		"5af7183fb1d4cf5f5af7183fb1d4cf5f",
+		"6b221d5bc9e6496c6b221d5bc9e6496c",
+		"6b221d5bc9e6496c6b221d5bc9e6496c",
+		"7c332e6cd0f7507d7c332e6cd0f7507d",
+	)
+
+	testCases := []struct {
+		desc           string
+		body           string
+		expectedCode   int
+		expectedCounts []int
+	}{
+		{
+			desc:           "all spans",
+			body:           "[" + strings.Join(spans, ",") + "]",
+			expectedCode:   http.StatusAccepted,
+			expectedCounts: []int{2, 2, 1},
+		},
+		{
+			desc:           "empty array",
+			body:           "[]",
+			expectedCode:   http.StatusAccepted,
+			expectedCounts: nil,
+		},
+		{
+			desc:           "malformed span",
+			body:           "[" + strings.Join(spans[:3], ",") + `,{"traceId":}]`,
+			expectedCode:   http.StatusBadRequest,
+			expectedCounts: []int{2},
+		},
+		{
+			desc:           "not an array",
+			body:           spans[0],
+			expectedCode:   http.StatusBadRequest,
+			expectedCounts: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sink := new(consumertest.TracesSink)
+			zr := newTestReceiverWithConfig(t, shortTraceIDsAllow, 2, sink)
+
+			r := httptest.NewRequest("POST", "/api/v2/spans", strings.NewReader(tc.body))
+			r.Header.Add("content-type", "application/json")
+			rec := httptest.NewRecorder()
+			zr.ServeHTTP(rec, r)
+
+			require.Equal(t, tc.expectedCode, rec.Code)
+			var counts []int
+			for _, td := range sink.AllTraces() {
+				counts = append(counts, td.SpanCount())
+			}
+			require.Equal(t, tc.expectedCounts, counts)
+		})
+	}
+}
+
+func TestReceiverStreamingConsumerError(t *testing.T) {
+	spans := makeV2JSONSpans("5af7183fb1d4cf5f5af7183fb1d4cf5f", "5af7183fb1d4cf5f5af7183fb1d4cf5f")
+	zr := newTestReceiverWithConfig(t, shortTraceIDsAllow, 1, consumertest.NewErr(errors.New("consumer error")))
+
+	r := httptest.NewRequest("POST", "/api/v2/spans", strings.NewReader("["+strings.Join(spans, ",")+"]"))
+	r.Header.Add("content-type", "application/json")
+	rec := httptest.NewRecorder()
+	zr.ServeHTTP(rec, r)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.Equal(t, "\"Internal Server Error\"", rec.Body.String())
+}
+
+func TestReceiverShortTraceIDs(t *testing.T) {
+	const longTraceID = "5af7183fb1d4cf5f5af7183fb1d4cf5f"
+	const shortTraceID = "6b221d5bc9e6496c"
+	body := "[" + strings.Join(makeV2JSONSpans(longTraceID, shortTraceID), ",") + "]"
+
+	testCases := []struct {
+		desc             string
+		shortTraceIDs    string
+		expectedCode     int
+		expectedTraceIDs []string
+	}{
+		{
+			desc:             "allow",
+			shortTraceIDs:    shortTraceIDsAllow,
+			expectedCode:     http.StatusAccepted,
+			expectedTraceIDs: []string{longTraceID, "0000000000000000" + shortTraceID},
+		},
+		{
+			desc:          "reject",
+			shortTraceIDs: shortTraceIDsReject,
+			expectedCode:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, maxSpansPerBatch := range []int{0, 10} {
+			t.Run(fmt.Sprintf("%s/max_spans_per_batch=%d", tc.desc, maxSpansPerBatch), func(t *testing.T) {
+				sink := new(consumertest.TracesSink)
+				zr := newTestReceiverWithConfig(t, tc.shortTraceIDs, maxSpansPerBatch, sink)
+
+				r := httptest.NewRequest("POST", "/api/v2/spans", strings.NewReader(body))
+				r.Header.Add("content-type", "application/json")
+				rec := httptest.NewRecorder()
+				zr.ServeHTTP(rec, r)
+
+				require.Equal(t, tc.expectedCode, rec.Code)
+				var traceIDs []string
+				for _, td := range sink.AllTraces() {
+					spans := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+					for i := 0; i < spans.Len(); i++ {
+						traceIDs = append(traceIDs, spans.At(i).TraceID().HexString())
+					}
+				}
+				require.ElementsMatch(t, tc.expectedTraceIDs, traceIDs)
+			})
+		}
+	}
+}